@@ -19,6 +19,7 @@ import (
 	"github.com/opentofu/svchost/svcauth"
 
 	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/backend"
 	"github.com/opentofu/opentofu/internal/command"
 	"github.com/opentofu/opentofu/internal/command/cliconfig"
 	"github.com/opentofu/opentofu/internal/command/views"
@@ -62,6 +63,8 @@ func initCommands(
 	providerSrc getproviders.Source,
 	providerDevOverrides map[addrs.Provider]getproviders.PackageLocalDir,
 	unmanagedProviders map[addrs.Provider]*plugin.ReattachConfig,
+	providerDebug []string,
+	readOnly bool,
 ) {
 	var inAutomation bool
 	if v := os.Getenv(runningInAutomationEnvName); v != "" {
@@ -93,11 +96,18 @@ func initCommands(
 		BrowserLauncher: browserLauncher(),
 
 		RunningInAutomation: inAutomation,
+		ReadOnly:            readOnly,
 		CLIConfigDir:        configDir,
 		PluginCacheDir:      config.PluginCacheDir,
 
 		PluginCacheMayBreakDependencyLockFile: config.PluginCacheMayBreakDependencyLockFile,
 
+		DisabledFunctions: config.DisabledFunctions,
+
+		ProviderReadRetries: config.ProviderReadRetries(),
+		ApprovalHook:        approvalHook(config),
+		LintRules:           lintRules(config),
+
 		ShutdownCh:    makeShutdownCh(),
 		CallerContext: ctx,
 
@@ -111,6 +121,7 @@ func initCommands(
 		ProviderSource:       providerSrc,
 		ProviderDevOverrides: providerDevOverrides,
 		UnmanagedProviders:   unmanagedProviders,
+		ProviderDebug:        providerDebug,
 
 		AllowExperimentalFeatures: experimentsAreAllowed(),
 
@@ -139,6 +150,18 @@ func initCommands(
 			}, nil
 		},
 
+		"debug": func() (cli.Command, error) {
+			return &command.DebugCommand{
+				Meta: meta,
+			}, nil
+		},
+
+		"debug connectivity": func() (cli.Command, error) {
+			return &command.DebugConnectivityCommand{
+				Meta: meta,
+			}, nil
+		},
+
 		"destroy": func() (cli.Command, error) {
 			return &command.ApplyCommand{
 				Meta:    meta,
@@ -146,6 +169,12 @@ func initCommands(
 			}, nil
 		},
 
+		"doctor": func() (cli.Command, error) {
+			return &command.DoctorCommand{
+				Meta: meta,
+			}, nil
+		},
+
 		"env": func() (cli.Command, error) {
 			return &command.WorkspaceCommand{
 				Meta:       meta,
@@ -235,6 +264,18 @@ func initCommands(
 			}, nil
 		},
 
+		"metadata module-docs": func() (cli.Command, error) {
+			return &command.MetadataModuleDocsCommand{
+				Meta: meta,
+			}, nil
+		},
+
+		"metadata variables-schema": func() (cli.Command, error) {
+			return &command.MetadataVariablesSchemaCommand{
+				Meta: meta,
+			}, nil
+		},
+
 		"output": func() (cli.Command, error) {
 			return &command.OutputCommand{
 				Meta: meta,
@@ -247,12 +288,36 @@ func initCommands(
 			}, nil
 		},
 
+		"plan apply": func() (cli.Command, error) {
+			return &command.PlanApplyCommand{
+				Meta: meta,
+			}, nil
+		},
+
+		"plan list": func() (cli.Command, error) {
+			return &command.PlanListCommand{
+				Meta: meta,
+			}, nil
+		},
+
+		"plan show": func() (cli.Command, error) {
+			return &command.PlanShowCommand{
+				Meta: meta,
+			}, nil
+		},
+
 		"providers": func() (cli.Command, error) {
 			return &command.ProvidersCommand{
 				Meta: meta,
 			}, nil
 		},
 
+		"providers config": func() (cli.Command, error) {
+			return &command.ProvidersConfigCommand{
+				Meta: meta,
+			}, nil
+		},
+
 		"providers lock": func() (cli.Command, error) {
 			return &command.ProvidersLockCommand{
 				Meta: meta,
@@ -265,6 +330,12 @@ func initCommands(
 			}, nil
 		},
 
+		"providers mirror-serve": func() (cli.Command, error) {
+			return &command.ProvidersMirrorServeCommand{
+				Meta: meta,
+			}, nil
+		},
+
 		"providers schema": func() (cli.Command, error) {
 			return &command.ProvidersSchemaCommand{
 				Meta: meta,
@@ -322,6 +393,18 @@ func initCommands(
 			}, nil
 		},
 
+		"unused": func() (cli.Command, error) {
+			return &command.UnusedCommand{
+				Meta: meta,
+			}, nil
+		},
+
+		"why": func() (cli.Command, error) {
+			return &command.WhyCommand{
+				Meta: meta,
+			}, nil
+		},
+
 		"workspace": func() (cli.Command, error) {
 			return &command.WorkspaceCommand{
 				Meta: meta,
@@ -372,6 +455,14 @@ func initCommands(
 			return &command.StateCommand{}, nil
 		},
 
+		"state annotate": func() (cli.Command, error) {
+			return &command.StateAnnotateCommand{
+				StateMeta: command.StateMeta{
+					Meta: meta,
+				},
+			}, nil
+		},
+
 		"state list": func() (cli.Command, error) {
 			return &command.StateListCommand{
 				Meta: meta,
@@ -422,6 +513,26 @@ func initCommands(
 			}, nil
 		},
 
+		"state downgrade": func() (cli.Command, error) {
+			return &command.StateDowngradeCommand{
+				Meta: meta,
+			}, nil
+		},
+
+		"state decrypt": func() (cli.Command, error) {
+			return &command.StateEncryptionCommand{
+				Meta: meta,
+				Mode: command.StateEncryptionDecrypt,
+			}, nil
+		},
+
+		"state encrypt": func() (cli.Command, error) {
+			return &command.StateEncryptionCommand{
+				Meta: meta,
+				Mode: command.StateEncryptionEncrypt,
+			}, nil
+		},
+
 		"state pull": func() (cli.Command, error) {
 			return &command.StatePullCommand{
 				Meta: meta,
@@ -434,6 +545,13 @@ func initCommands(
 			}, nil
 		},
 
+		"state rotate": func() (cli.Command, error) {
+			return &command.StateEncryptionCommand{
+				Meta: meta,
+				Mode: command.StateEncryptionRotate,
+			}, nil
+		},
+
 		"state show": func() (cli.Command, error) {
 			return &command.StateShowCommand{
 				Meta: meta,
@@ -447,6 +565,12 @@ func initCommands(
 				},
 			}, nil
 		},
+
+		"state upgrade-check": func() (cli.Command, error) {
+			return &command.StateUpgradeCheckCommand{
+				Meta: meta,
+			}, nil
+		},
 	}
 
 	primaryCommands = []string{
@@ -487,6 +611,35 @@ func credentialsSource(config *cliconfig.Config) (svcauth.CredentialsSource, err
 	return config.CredentialsSource(helperPlugins)
 }
 
+// approvalHook returns the approval hook from the given CLI configuration,
+// or nil if there isn't one.
+func approvalHook(config *cliconfig.Config) *backend.ApprovalHook {
+	if len(config.ApprovalHook) == 0 {
+		return nil
+	}
+	// The configuration is validated to have at most one approval_hook block.
+	hook := config.ApprovalHook[0]
+	return &backend.ApprovalHook{
+		Command: hook.Command,
+		Args:    hook.Args,
+		Timeout: hook.TimeoutDuration(),
+	}
+}
+
+// lintRules returns the lint rules from the given CLI configuration.
+func lintRules(config *cliconfig.Config) []*command.LintRule {
+	var ret []*command.LintRule
+	for _, rule := range config.LintRules {
+		ret = append(ret, &command.LintRule{
+			Name:    rule.Name,
+			Command: rule.Command,
+			Args:    rule.Args,
+			Timeout: rule.TimeoutDuration(),
+		})
+	}
+	return ret
+}
+
 func getAliasCommandKeys() []string {
 	keys := []string{}
 	for key, cmdFact := range commands {
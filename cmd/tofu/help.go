@@ -63,6 +63,10 @@ Global options (use these before the subcommand, if any):
   -chdir=DIR    Switch to a different working directory before executing the
                 given subcommand.
   -help         Show this help output, or the help for a specified subcommand.
+  -provider-debug=NAME
+                Pause after launching the named provider so that a debugger
+                can attach to it. NAME is a provider type or source address.
+  -read-only    Refuse to run any operation that would change state.
   -version      An alias for the "version" subcommand.
 `, listCommands(commands, primaryCommands, maxKeyLen), listCommands(commands, otherCommands, maxKeyLen))
 
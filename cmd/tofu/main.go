@@ -28,6 +28,7 @@ import (
 	"github.com/opentofu/opentofu/internal/addrs"
 	"github.com/opentofu/opentofu/internal/command/cliconfig"
 	"github.com/opentofu/opentofu/internal/didyoumean"
+	"github.com/opentofu/opentofu/internal/httpclient"
 	"github.com/opentofu/opentofu/internal/logging"
 	"github.com/opentofu/opentofu/internal/terminal"
 	"github.com/opentofu/opentofu/internal/tracing"
@@ -170,6 +171,10 @@ func realMain() int {
 		}
 	}
 
+	// All of our HTTP clients select proxies using the proxy settings from
+	// the CLI configuration, in addition to the usual environment variables.
+	httpclient.SetProxyConfig(proxyConfig(config))
+
 	// Get any configured credentials from the config and initialize
 	// a service discovery object.
 	credsSrc, err := credentialsSource(config)
@@ -215,6 +220,17 @@ func realMain() int {
 	// command will be excluded from the args given to child commands.
 	args = newArgs
 
+	// The -read-only option is also a global one, so we extract it here
+	// before the subcommand gets to see the arguments.
+	readOnly, args := extractReadOnlyOption(args)
+
+	// The -provider-debug option is also a global one.
+	providerDebug, args, err := extractProviderDebugOptions(args)
+	if err != nil {
+		rv.Error(err.Error())
+		return 1
+	}
+
 	providerSrc, diags := providerSource(ctx,
 		config.ProviderInstallation,
 		config.RegistryProtocols,
@@ -237,7 +253,7 @@ func realMain() int {
 		// in case they need to refer back to it for any special reason, though
 		// they should primarily be working with the override working directory
 		// that we've now switched to above.
-		initCommands(ctx, wd, view, config, services, modulePkgFetcher, providerSrc, providerDevOverrides, unmanagedProviders)
+		initCommands(ctx, wd, view, config, services, modulePkgFetcher, providerSrc, providerDevOverrides, unmanagedProviders, providerDebug, readOnly)
 	}
 
 	// Attempt to ensure the config directory exists.
@@ -333,11 +349,15 @@ func realMain() int {
 		}
 	}
 
+	commandStart := time.Now()
 	exitCode, err := cliRunner.Run()
 	if err != nil {
 		rv.Error(fmt.Sprintf("Error executing CLI: %s", err.Error()))
 		return 1
 	}
+	if !autoComplete {
+		recordUsageMetrics(ctx, config, cliRunner.Subcommand(), args, commandStart, exitCode)
+	}
 
 	// We might generate some additional log lines if OpenTofu relied on any
 	// non-default Go runtime behaviors enabled by GODEBUG settings, because
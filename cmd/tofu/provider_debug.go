@@ -0,0 +1,66 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+)
+
+// extractProviderDebugOptions returns the provider names given in any
+// -provider-debug=NAME global options in the given args, which select the
+// providers to pause for a debugger to attach after launching them.
+//
+// It returns back the list of arguments without the -provider-debug flags,
+// to be used later by the invoked command.
+//
+// TODO meta-refactor: remove this once the current CLI library is replaced.
+func extractProviderDebugOptions(args []string) ([]string, []string, error) {
+	var names []string
+	var newArgs []string
+	for i, arg := range args {
+		if !strings.HasPrefix(arg, "-") {
+			// As with -chdir, the provider-debug option must appear before
+			// the subcommand so that it can't be confused with an option
+			// of the subcommand itself.
+			newArgs = append(newArgs, args[i:]...)
+			break
+		}
+		name, ok := strings.CutPrefix(arg, "-provider-debug=")
+		if !ok {
+			newArgs = append(newArgs, arg)
+			continue
+		}
+		name, err := normalizeProviderDebugName(name)
+		if err != nil {
+			return nil, nil, err
+		}
+		names = append(names, name)
+	}
+	return names, newArgs, nil
+}
+
+// normalizeProviderDebugName checks that the given name is either a provider
+// type or a provider source address, returning it in normalized form.
+func normalizeProviderDebugName(name string) (string, error) {
+	if name == "" {
+		return "", fmt.Errorf("The -provider-debug option requires a provider type or source address, such as -provider-debug=aws.")
+	}
+	if !strings.Contains(name, "/") {
+		typeName, err := addrs.ParseProviderPart(name)
+		if err != nil {
+			return "", fmt.Errorf("Invalid provider type %q for -provider-debug: %w.", name, err)
+		}
+		return typeName, nil
+	}
+	addr, diags := addrs.ParseProviderSourceString(name)
+	if diags.HasErrors() {
+		return "", fmt.Errorf("Invalid provider source address %q for -provider-debug: %w.", name, diags.Err())
+	}
+	return addr.String(), nil
+}
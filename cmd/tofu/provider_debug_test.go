@@ -0,0 +1,76 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExtractProviderDebugOptions(t *testing.T) {
+	tests := map[string]struct {
+		args      []string
+		wantNames []string
+		wantArgs  []string
+		wantErr   string
+	}{
+		"no args": {
+			args:     nil,
+			wantArgs: nil,
+		},
+		"not present": {
+			args:     []string{"-chdir=foo", "apply"},
+			wantArgs: []string{"-chdir=foo", "apply"},
+		},
+		"provider type": {
+			args:      []string{"-provider-debug=AWS", "plan"},
+			wantNames: []string{"aws"},
+			wantArgs:  []string{"plan"},
+		},
+		"source address": {
+			args:      []string{"-chdir=foo", "-provider-debug=hashicorp/aws", "-provider-debug=null", "plan", "-refresh=false"},
+			wantNames: []string{"registry.opentofu.org/hashicorp/aws", "null"},
+			wantArgs:  []string{"-chdir=foo", "plan", "-refresh=false"},
+		},
+		"after subcommand": {
+			args:     []string{"plan", "-provider-debug=aws"},
+			wantArgs: []string{"plan", "-provider-debug=aws"},
+		},
+		"empty name": {
+			args:    []string{"-provider-debug=", "plan"},
+			wantErr: "The -provider-debug option requires a provider type or source address, such as -provider-debug=aws.",
+		},
+		"invalid type": {
+			args:    []string{"-provider-debug=not_valid!", "plan"},
+			wantErr: `Invalid provider type "not_valid!" for -provider-debug: must contain only letters, digits, and dashes, and may not use leading or trailing dashes.`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotNames, gotArgs, err := extractProviderDebugOptions(test.args)
+			if test.wantErr != "" {
+				if err == nil {
+					t.Fatalf("unexpected success; want error: %s", test.wantErr)
+				}
+				if got := err.Error(); got != test.wantErr {
+					t.Fatalf("wrong error\ngot:  %s\nwant: %s", got, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(test.wantNames, gotNames); diff != "" {
+				t.Errorf("wrong names\n%s", diff)
+			}
+			if diff := cmp.Diff(test.wantArgs, gotArgs); diff != "" {
+				t.Errorf("wrong args\n%s", diff)
+			}
+		})
+	}
+}
@@ -282,6 +282,20 @@ func providerSourceForCLIConfigLocation(
 			},
 		), nil
 
+	case cliconfig.ProviderInstallationGoBuild:
+		configDir, err := cliconfig.ConfigDir()
+		if err != nil {
+			var diags tfdiags.Diagnostics
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Cannot build provider from source",
+				fmt.Sprintf("Cannot determine the directory to keep builds of %s in: %s.", loc.Provider, err),
+			))
+			return nil, diags
+		}
+		cacheDir := filepath.Join(configDir, "go-build-cache")
+		return getproviders.NewGoBuildSource(ctx, loc.Provider, loc.Path, loc.Version, cacheDir), nil
+
 	default:
 		// We should not get here because the set of cases above should
 		// be comprehensive for all of the
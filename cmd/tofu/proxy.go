@@ -0,0 +1,37 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"log"
+
+	"github.com/opentofu/opentofu/internal/command/cliconfig"
+	"github.com/opentofu/opentofu/internal/httpclient"
+)
+
+// proxyConfig returns the proxy configuration from the given CLI
+// configuration, or nil if it doesn't have a proxy block, in which case
+// OpenTofu uses only the standard proxy environment variables.
+func proxyConfig(config *cliconfig.Config) *httpclient.ProxyConfig {
+	if len(config.Proxy) == 0 {
+		return nil
+	}
+	// The configuration is validated to have at most one proxy block.
+	proxy := config.Proxy[0]
+	ret := &httpclient.ProxyConfig{
+		PACFile: proxy.PACFile,
+	}
+	for pattern, host := range proxy.Hosts {
+		proxyURL, err := host.ProxyURL()
+		if err != nil {
+			// Already reported when validating the CLI configuration.
+			log.Printf("[WARN] Ignoring invalid proxy for %s: %s", pattern, err)
+			continue
+		}
+		ret.Rules = append(ret.Rules, httpclient.ProxyRule{Host: pattern, URL: proxyURL})
+	}
+	return ret
+}
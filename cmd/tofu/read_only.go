@@ -0,0 +1,59 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"os"
+	"strconv"
+	"strings"
+)
+
+// readOnlyEnvName gives the name of an environment variable that can be set
+// to a true value to prevent all commands from changing state, in the same
+// way as the -read-only global option.
+const readOnlyEnvName = "TOFU_READ_ONLY"
+
+// extractReadOnlyOption reports whether OpenTofu should run in read-only
+// mode, either because the -read-only global option is present in the given
+// args or because the TOFU_READ_ONLY environment variable is set.
+//
+// It returns back the list of arguments without the -read-only flag, to be
+// used later by the invoked command.
+//
+// TODO meta-refactor: remove this once the current CLI library is replaced.
+func extractReadOnlyOption(args []string) (bool, []string) {
+	readOnly := readOnlyFromEnv(os.Getenv(readOnlyEnvName))
+
+	for i, arg := range args {
+		if !strings.HasPrefix(arg, "-") {
+			// As with -chdir, the read-only option must appear before
+			// the subcommand so that it can't be confused with an option
+			// of the subcommand itself.
+			break
+		}
+		if arg == "-read-only" {
+			newArgs := make([]string, 0, len(args)-1)
+			newArgs = append(newArgs, args[:i]...)
+			newArgs = append(newArgs, args[i+1:]...)
+			return true, newArgs
+		}
+	}
+	return readOnly, args
+}
+
+// readOnlyFromEnv interprets the value of the TOFU_READ_ONLY environment
+// variable. Any non-empty value that isn't a recognized "false" value enables
+// read-only mode, so that a mistyped value errs on the side of caution.
+func readOnlyFromEnv(v string) bool {
+	if v == "" {
+		return false
+	}
+	enabled, err := strconv.ParseBool(v)
+	if err != nil {
+		return true
+	}
+	return enabled
+}
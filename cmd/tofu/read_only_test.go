@@ -0,0 +1,75 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExtractReadOnlyOption(t *testing.T) {
+	tests := map[string]struct {
+		args     []string
+		env      string
+		wantRO   bool
+		wantArgs []string
+	}{
+		"no args": {
+			args:     nil,
+			wantArgs: nil,
+		},
+		"not present": {
+			args:     []string{"-chdir=foo", "apply"},
+			wantArgs: []string{"-chdir=foo", "apply"},
+		},
+		"before subcommand": {
+			args:     []string{"-read-only", "apply", "-auto-approve"},
+			wantRO:   true,
+			wantArgs: []string{"apply", "-auto-approve"},
+		},
+		"after other global options": {
+			args:     []string{"-chdir=foo", "-read-only", "state", "rm", "foo.bar"},
+			wantRO:   true,
+			wantArgs: []string{"-chdir=foo", "state", "rm", "foo.bar"},
+		},
+		"after subcommand": {
+			args:     []string{"apply", "-read-only"},
+			wantArgs: []string{"apply", "-read-only"},
+		},
+		"env true": {
+			args:     []string{"apply"},
+			env:      "1",
+			wantRO:   true,
+			wantArgs: []string{"apply"},
+		},
+		"env false": {
+			args:     []string{"apply"},
+			env:      "false",
+			wantArgs: []string{"apply"},
+		},
+		"env unrecognized": {
+			args:     []string{"apply"},
+			env:      "yes",
+			wantRO:   true,
+			wantArgs: []string{"apply"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv(readOnlyEnvName, test.env)
+
+			gotRO, gotArgs := extractReadOnlyOption(test.args)
+			if gotRO != test.wantRO {
+				t.Errorf("wrong read-only result %t; want %t", gotRO, test.wantRO)
+			}
+			if diff := cmp.Diff(test.wantArgs, gotArgs); diff != "" {
+				t.Errorf("wrong args\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/opentofu/opentofu/internal/command/cliconfig"
+	"github.com/opentofu/opentofu/internal/usagemetrics"
+	"github.com/opentofu/opentofu/version"
+)
+
+// usageMetricsTimeout is the longest we'll wait for the optional OTLP
+// exporter to send the usage metrics for a command, so that a missing
+// collector doesn't delay every command.
+const usageMetricsTimeout = 5 * time.Second
+
+// recordUsageMetrics records the timing and feature usage of a command that
+// started at the given time, if the CLI configuration opts in to usage
+// metrics.
+//
+// Usage metrics are a convenience, so problems recording them are only
+// logged and never affect the result of the command.
+func recordUsageMetrics(ctx context.Context, config *cliconfig.Config, command string, args []string, start time.Time, exitCode int) {
+	if command == "" || len(config.UsageMetrics) == 0 || !config.UsageMetrics[0].Enabled {
+		return
+	}
+	// The configuration is validated to have at most one usage_metrics block.
+	metricsConfig := config.UsageMetrics[0]
+
+	path, err := metricsConfig.FilePath()
+	if err != nil {
+		log.Printf("[WARN] Not recording usage metrics: %s", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, usageMetricsTimeout)
+	defer cancel()
+	recorder, err := usagemetrics.NewRecorder(ctx, path, metricsConfig.OTLP)
+	if err != nil {
+		log.Printf("[WARN] Not recording usage metrics: %s", err)
+		return
+	}
+	event := usagemetrics.NewEvent(command, args, start, exitCode, version.String())
+	if err := recorder.Record(ctx, event); err != nil {
+		log.Printf("[WARN] Failed to record usage metrics: %s", err)
+		return
+	}
+	log.Printf("[TRACE] Recorded usage metrics in %s", path)
+}
@@ -14,6 +14,7 @@ import (
 	"errors"
 	"log"
 	"os"
+	"time"
 
 	"github.com/mitchellh/go-homedir"
 	"github.com/opentofu/svchost"
@@ -123,6 +124,54 @@ type Backend interface {
 	Workspaces(context.Context) ([]string, error)
 }
 
+// CapabilityProber is an optional interface that a Backend can implement when
+// the storage service it uses may not support all of the features that the
+// backend relies on, such as an API-compatible reimplementation of a cloud
+// storage service.
+//
+// "tofu init" calls ProbeCapabilities after configuring the backend and
+// reports the detected capabilities. The returned diagnostics should explain
+// how the backend will behave differently due to a missing capability, and
+// should only include errors when the backend can't work as configured.
+type CapabilityProber interface {
+	ProbeCapabilities(ctx context.Context) ([]Capability, tfdiags.Diagnostics)
+}
+
+// Capability is an optional feature of the storage service used by a
+// backend, as detected by [CapabilityProber.ProbeCapabilities].
+type Capability struct {
+	Name      string
+	Supported bool
+}
+
+// Bootstrapper is an optional interface that a Backend can implement when
+// the storage service it uses needs resources, such as a bucket, that must
+// exist before the backend can store any state.
+//
+// "tofu init -bootstrap-backend" calls MissingResources after configuring the
+// backend and, once the user has confirmed, calls CreateResources with the
+// result to create them. Both methods must only be called on a configured
+// backend.
+type Bootstrapper interface {
+	MissingResources(ctx context.Context) ([]BootstrapResource, tfdiags.Diagnostics)
+	CreateResources(ctx context.Context, resources []BootstrapResource) tfdiags.Diagnostics
+}
+
+// BootstrapResource is a resource that a backend needs in its storage
+// service, as reported by [Bootstrapper.MissingResources].
+type BootstrapResource struct {
+	// Type is the kind of the resource, such as "S3 bucket".
+	Type string
+
+	// Name identifies the resource within the storage service.
+	Name string
+
+	// Permissions are the least privileges that OpenTofu needs on the
+	// resource to use it after it has been created, so that the user can
+	// grant no more than that to the identity that will run OpenTofu.
+	Permissions []string
+}
+
 // HostAlias describes a list of aliases that should be used when initializing an
 // Enhanced Backend
 type HostAlias struct {
@@ -300,12 +349,32 @@ type Operation struct {
 	Targets      []addrs.Targetable
 	Excludes     []addrs.Targetable
 	ForceReplace []addrs.AbsResourceInstance
+	// RefreshTargets, if not empty, limits refreshing to the resource
+	// instances they contain, even if PlanRefresh is false.
+	RefreshTargets []addrs.Targetable
+	// ForceReplacePatterns are wildcard patterns selecting additional
+	// resource instances from the prior state to force replacement of.
+	ForceReplacePatterns []string
+	// ForceReplaceReason is an optional explanation of the forced
+	// replacements, recorded in the resulting plan.
+	ForceReplaceReason string
 	// Injected by the command creating the operation (plan/apply/refresh/etc...)
 	Variables map[string]UnparsedVariableValue
 	RootCall  configs.StaticModuleCall
 	// SuppressForgetErrorsDuringDestroy suppresses the error that occurs when a
 	// destroy operation completes successfully but leaves forgotten instances behind.
 	SuppressForgetErrorsDuringDestroy bool
+	// PreflightProviders causes the apply to check every provider
+	// configuration by configuring it before making any changes.
+	PreflightProviders bool
+	// AllowStalePlan allows applying a saved plan whose prior state is older
+	// than the current state of the workspace, by re-basing the plan on the
+	// current state, as long as the resource instances changed since the
+	// plan was created are not also changed by the plan.
+	AllowStalePlan bool
+	// ApprovalHook, if set, is an external command that must approve the
+	// changes in the plan before an apply operation makes them.
+	ApprovalHook *ApprovalHook
 	// Some operations use root module variables only opportunistically or
 	// don't need them at all. If this flag is set, the backend must treat
 	// all variables as optional and provide an unknown value for any required
@@ -316,6 +385,13 @@ type Operation struct {
 	// the variables set in the plan are used instead, and they must be valid.
 	AllowUnsetVariables bool
 
+	// UseStoredVariables can be set along with AllowUnsetVariables to ask a
+	// backend that stores variable values for its workspaces, such as the
+	// "remote" backend, to use those values for any variables that aren't set
+	// in Variables rather than treating them as unknown. Backends that don't
+	// store variable values ignore this flag.
+	UseStoredVariables bool
+
 	// View implements the logic for all UI interactions.
 	View views.Operation
 
@@ -337,6 +413,23 @@ type Operation struct {
 	// for unmatched import targets and where any generated config should be
 	// written to.
 	GenerateConfigOut string
+
+	// SuggestMovesOut tells the operation both that it should suggest
+	// "moved" blocks for planned pairs of destroy and create actions that
+	// could be moves instead, and where to write the suggested blocks.
+	SuggestMovesOut string
+}
+
+// ApprovalHook describes an external command that decides whether the
+// changes in a plan may be applied, by exiting successfully, as configured
+// by the "approval_hook" block in the CLI configuration.
+type ApprovalHook struct {
+	Command string
+	Args    []string
+
+	// Timeout is the maximum time to wait for the command to exit, after
+	// which the changes are treated as not approved.
+	Timeout time.Duration
 }
 
 // HasConfig returns true if and only if the operation has a ConfigDir value
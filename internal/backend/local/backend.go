@@ -13,7 +13,9 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/opentofu/opentofu/internal/backend"
 	"github.com/opentofu/opentofu/internal/command/views"
@@ -31,6 +33,11 @@ const (
 	DefaultWorkspaceFile   = "environment"
 	DefaultStateFilename   = "terraform.tfstate"
 	DefaultBackupExtension = ".backup"
+
+	// WorkspacePathPlaceholder is replaced with the name of the workspace in
+	// a "path" or "backup_dir" that contains it, so that each workspace can
+	// keep its state in a directory layout of the user's choosing.
+	WorkspacePathPlaceholder = "${workspace}"
 )
 
 // Local is an implementation of EnhancedBackend that performs all operations
@@ -51,10 +58,23 @@ type Local struct {
 	//
 	// StateWorkspaceDir is the path to the folder containing data for
 	// non-default workspaces. This defaults to DefaultWorkspaceDir if not set.
+	//
+	// StateBackupDir is the path to a folder where backup files are written
+	// instead of alongside the state file, if set.
+	//
+	// If StatePath contains WorkspacePathPlaceholder then it is a template
+	// for the state path of every workspace, including the default one, and
+	// StateWorkspaceDir isn't used. StateBackupDir may also contain the
+	// placeholder.
 	StatePath         string
 	StateOutPath      string
 	StateBackupPath   string
 	StateWorkspaceDir string
+	StateBackupDir    string
+
+	// StateFsync makes sure that state snapshots and backups have reached
+	// stable storage before an operation reports that it saved them.
+	StateFsync bool
 
 	// The OverrideState* paths are set based on per-operation CLI arguments
 	// and will override what'd be built from the State* fields if non-empty.
@@ -126,6 +146,14 @@ func (b *Local) ConfigSchema() *configschema.Block {
 				Type:     cty.String,
 				Optional: true,
 			},
+			"backup_dir": {
+				Type:     cty.String,
+				Optional: true,
+			},
+			"fsync": {
+				Type:     cty.Bool,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -147,6 +175,17 @@ func (b *Local) PrepareConfig(obj cty.Value) (cty.Value, tfdiags.Diagnostics) {
 				cty.Path{cty.GetAttrStep{Name: "path"}},
 			))
 		}
+		if strings.Contains(p, WorkspacePathPlaceholder) {
+			diags = diags.Append(validateStatePathTemplate(p))
+			if val := obj.GetAttr("workspace_dir"); !val.IsNull() {
+				diags = diags.Append(tfdiags.AttributeValue(
+					tfdiags.Error,
+					"Conflicting local state paths",
+					fmt.Sprintf(`The "workspace_dir" attribute cannot be used when the "path" attribute contains %s, because the path then determines where the state of every workspace is stored.`, WorkspacePathPlaceholder),
+					cty.Path{cty.GetAttrStep{Name: "workspace_dir"}},
+				))
+			}
+		}
 	}
 
 	if val := obj.GetAttr("workspace_dir"); !val.IsNull() {
@@ -161,9 +200,38 @@ func (b *Local) PrepareConfig(obj cty.Value) (cty.Value, tfdiags.Diagnostics) {
 		}
 	}
 
+	if val := obj.GetAttr("backup_dir"); !val.IsNull() {
+		p := val.AsString()
+		if p == "" {
+			diags = diags.Append(tfdiags.AttributeValue(
+				tfdiags.Error,
+				"Invalid local state backup directory path",
+				`The "backup_dir" attribute value must not be empty.`,
+				cty.Path{cty.GetAttrStep{Name: "backup_dir"}},
+			))
+		}
+	}
+
 	return obj, diags
 }
 
+// validateStatePathTemplate checks that a "path" containing
+// WorkspacePathPlaceholder names a separate directory for each workspace,
+// which is how the workspaces are found again later.
+func validateStatePathTemplate(p string) tfdiags.Diagnostics {
+	var diags tfdiags.Diagnostics
+	_, after, _ := strings.Cut(p, WorkspacePathPlaceholder)
+	if strings.Contains(after, WorkspacePathPlaceholder) || !strings.ContainsAny(after, `/`+string(filepath.Separator)) {
+		diags = diags.Append(tfdiags.AttributeValue(
+			tfdiags.Error,
+			"Invalid local state file path",
+			fmt.Sprintf(`The "path" attribute may contain %s only once, in a directory name rather than in the file name, so that each workspace has its own directory. For example: "states/%s/terraform.tfstate".`, WorkspacePathPlaceholder, WorkspacePathPlaceholder),
+			cty.Path{cty.GetAttrStep{Name: "path"}},
+		))
+	}
+	return diags
+}
+
 func (b *Local) Configure(ctx context.Context, obj cty.Value) tfdiags.Diagnostics {
 	if b.Backend != nil {
 		return b.Backend.Configure(ctx, obj)
@@ -187,6 +255,14 @@ func (b *Local) Configure(ctx context.Context, obj cty.Value) tfdiags.Diagnostic
 		b.StateWorkspaceDir = DefaultWorkspaceDir
 	}
 
+	if val := obj.GetAttr("backup_dir"); !val.IsNull() {
+		b.StateBackupDir = val.AsString()
+	}
+
+	if val := obj.GetAttr("fsync"); !val.IsNull() {
+		b.StateFsync = val.True()
+	}
+
 	return diags
 }
 
@@ -203,7 +279,16 @@ func (b *Local) Workspaces(ctx context.Context) ([]string, error) {
 	// the listing always start with "default"
 	envs := []string{backend.DefaultStateName}
 
-	entries, err := os.ReadDir(b.stateWorkspaceDir())
+	// Each non-default workspace has its own directory, either directly
+	// within the workspace directory or named after the workspace in the
+	// templated state path.
+	dir := b.stateWorkspaceDir()
+	prefix, suffix := "", ""
+	if b.statePathTemplated() {
+		dir, prefix, suffix = b.workspaceDirTemplate()
+	}
+
+	entries, err := os.ReadDir(dir)
 	// no error if there's no envs configured
 	if os.IsNotExist(err) {
 		return envs, nil
@@ -214,9 +299,18 @@ func (b *Local) Workspaces(ctx context.Context) ([]string, error) {
 
 	var listed []string
 	for _, entry := range entries {
-		if entry.IsDir() {
-			listed = append(listed, filepath.Base(entry.Name()))
+		if !entry.IsDir() {
+			continue
 		}
+		name := filepath.Base(entry.Name())
+		if len(name) <= len(prefix)+len(suffix) || !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, suffix) {
+			continue
+		}
+		name = name[len(prefix) : len(name)-len(suffix)]
+		if name == backend.DefaultStateName {
+			continue
+		}
+		listed = append(listed, name)
 	}
 
 	sort.Strings(listed)
@@ -243,7 +337,12 @@ func (b *Local) DeleteWorkspace(ctx context.Context, name string, force bool) er
 	}
 
 	delete(b.states, name)
-	return os.RemoveAll(filepath.Join(b.stateWorkspaceDir(), name))
+	if b.StateBackupDir != "" {
+		if err := os.RemoveAll(b.stateBackupDir(name)); err != nil {
+			return err
+		}
+	}
+	return os.RemoveAll(b.workspaceDir(name))
 }
 
 func (b *Local) StateMgr(ctx context.Context, name string) (statemgr.Full, error) {
@@ -263,10 +362,11 @@ func (b *Local) StateMgr(ctx context.Context, name string) (statemgr.Full, error
 	statePath, stateOutPath, backupPath := b.StatePaths(name)
 	log.Printf("[TRACE] backend/local: state manager for workspace %q will:\n - read initial snapshot from %s\n - write new snapshots to %s\n - create any backup at %s", name, statePath, stateOutPath, backupPath)
 
-	s := statemgr.NewFilesystemBetweenPaths(statePath, stateOutPath, b.encryption)
+	s := statemgr.NewFilesystemBetweenPaths(statePath, stateOutPath, b.encryption.ForWorkspace(name))
 	if backupPath != "" {
 		s.SetBackupPath(backupPath)
 	}
+	s.SetFsync(b.StateFsync)
 
 	if b.states == nil {
 		b.states = map[string]statemgr.Full{}
@@ -385,8 +485,7 @@ func (b *Local) opWait(
 		select {
 		case <-cancelCtx.Done():
 			log.Println("[WARN] running operation was forcefully canceled")
-			// if the operation was canceled, we need to return immediately
-			canceled = true
+			canceled = !waitForceCancelGracePeriod(doneCh)
 		case <-doneCh:
 			log.Println("[TRACE] backend/local: graceful stop has completed")
 		}
@@ -394,12 +493,49 @@ func (b *Local) opWait(
 		// this should not be called without first attempting to stop the
 		// operation
 		log.Println("[ERROR] running operation canceled without Stop")
-		canceled = true
+		canceled = !waitForceCancelGracePeriod(doneCh)
 	case <-doneCh:
 	}
 	return
 }
 
+// forceCancelGracePeriod is how long opWait waits for a forcefully-canceled
+// operation to return before abandoning it. This must be shorter than the
+// time the command package waits for the operation to finish after a
+// second interrupt, so that there's still time to persist the state.
+var forceCancelGracePeriod = 2 * time.Second
+
+// waitForceCancelGracePeriod waits a short time for an operation that has
+// been forcefully canceled to return, giving providers the chance to react
+// to the cancellation of their request contexts. It returns true if the
+// operation completed within the grace period, in which case the caller
+// should persist the results of the operation as usual.
+func waitForceCancelGracePeriod(doneCh <-chan struct{}) bool {
+	select {
+	case <-doneCh:
+		log.Println("[TRACE] backend/local: canceled operation returned within the grace period")
+		return true
+	case <-time.After(forceCancelGracePeriod):
+		log.Println("[WARN] backend/local: canceled operation did not return within the grace period")
+		return false
+	}
+}
+
+// forceCancelContext returns a child of ctx that is canceled once cancelCtx
+// is canceled, for use as the context of a graph walk so that in-progress
+// provider requests are canceled when the operation is forcefully canceled.
+//
+// Graceful stop requests are signalled to providers separately, so ctx is
+// expected to be detached from the graceful stop context.
+func forceCancelContext(ctx, cancelCtx context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	stop := context.AfterFunc(cancelCtx, cancel)
+	return ctx, func() {
+		stop()
+		cancel()
+	}
+}
+
 // StatePaths returns the StatePath, StateOutPath, and StateBackupPath as
 // configured from the CLI.
 func (b *Local) StatePaths(name string) (stateIn, stateOut, backupOut string) {
@@ -415,7 +551,9 @@ func (b *Local) StatePaths(name string) (stateIn, stateOut, backupOut string) {
 	}
 
 	if statePath == "" {
-		if isDefault {
+		if b.statePathTemplated() {
+			statePath = b.expandWorkspacePath(b.StatePath, name)
+		} else if isDefault {
 			statePath = b.StatePath // s.StatePath applies only to the default workspace, since StateWorkspaceDir is used otherwise
 		}
 		if statePath == "" {
@@ -428,10 +566,12 @@ func (b *Local) StatePaths(name string) (stateIn, stateOut, backupOut string) {
 	if backupPath == "" {
 		backupPath = b.StateBackupPath
 	}
-	switch backupPath {
-	case "-":
+	switch {
+	case backupPath == "-":
 		backupPath = ""
-	case "":
+	case backupPath == "" && b.StateBackupDir != "":
+		backupPath = filepath.Join(b.stateBackupDir(name), filepath.Base(stateOutPath)+DefaultBackupExtension)
+	case backupPath == "":
 		backupPath = stateOutPath + DefaultBackupExtension
 	}
 
@@ -480,7 +620,7 @@ func (b *Local) createState(name string) error {
 		return nil
 	}
 
-	stateDir := filepath.Join(b.stateWorkspaceDir(), name)
+	stateDir := b.workspaceDir(name)
 	s, err := os.Stat(stateDir)
 	if err == nil && s.IsDir() {
 		// no need to check for os.IsNotExist, since that is covered by os.MkdirAll
@@ -505,6 +645,58 @@ func (b *Local) stateWorkspaceDir() string {
 	return DefaultWorkspaceDir
 }
 
+// statePathTemplated returns true if StatePath is a template for the state
+// paths of all workspaces.
+func (b *Local) statePathTemplated() bool {
+	return strings.Contains(b.StatePath, WorkspacePathPlaceholder)
+}
+
+// workspaceDirTemplate splits the directory of a templated StatePath that is
+// named after the workspace into its parent directory and the parts of its
+// name before and after the workspace name.
+func (b *Local) workspaceDirTemplate() (parent, prefix, suffix string) {
+	before, after, _ := strings.Cut(b.StatePath, WorkspacePathPlaceholder)
+	if i := strings.IndexAny(after, `/`+string(filepath.Separator)); i >= 0 {
+		after = after[:i]
+	}
+	dir := before + WorkspacePathPlaceholder + after
+	prefix, suffix, _ = strings.Cut(filepath.Base(dir), WorkspacePathPlaceholder)
+	return filepath.Dir(dir), prefix, suffix
+}
+
+// workspaceDir returns the directory that holds only the state of the given
+// non-default workspace, which is removed along with the workspace.
+func (b *Local) workspaceDir(name string) string {
+	if b.statePathTemplated() {
+		parent, prefix, suffix := b.workspaceDirTemplate()
+		return filepath.Join(parent, prefix+name+suffix)
+	}
+	return filepath.Join(b.stateWorkspaceDir(), name)
+}
+
+// stateBackupDir returns the directory for backup files of the given
+// workspace when StateBackupDir is set. Unless StateBackupDir contains
+// WorkspacePathPlaceholder, the backups of non-default workspaces go in a
+// subdirectory named after the workspace.
+func (b *Local) stateBackupDir(name string) string {
+	if strings.Contains(b.StateBackupDir, WorkspacePathPlaceholder) {
+		return b.expandWorkspacePath(b.StateBackupDir, name)
+	}
+	if name == backend.DefaultStateName || name == "" {
+		return b.StateBackupDir
+	}
+	return filepath.Join(b.StateBackupDir, name)
+}
+
+// expandWorkspacePath replaces WorkspacePathPlaceholder in the given path
+// with the name of the given workspace.
+func (b *Local) expandWorkspacePath(p, name string) string {
+	if name == "" {
+		name = backend.DefaultStateName
+	}
+	return strings.ReplaceAll(p, WorkspacePathPlaceholder, name)
+}
+
 const earlyStateWriteErrorFmt = `Error: %s
 
 OpenTofu encountered an error attempting to save the state before cancelling the current operation. Once the operation is complete another attempt will be made to save the final state.`
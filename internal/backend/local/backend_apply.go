@@ -275,6 +275,16 @@ func (b *Local) opApply(
 		}
 	}
 
+	// If the CLI configuration includes an approval hook then it must also
+	// approve any changes, regardless of how the plan was approved above.
+	if op.ApprovalHook != nil && plan.CanApply() {
+		diags = diags.Append(approvePlan(stopCtx, op, lr.Config, plan, schemas))
+		if diags.HasErrors() {
+			op.ReportResult(runningOp, diags)
+			return
+		}
+	}
+
 	// Set up our hook for continuous state updates
 	stateHook.StateMgr = opState
 
@@ -287,7 +297,9 @@ func (b *Local) opApply(
 		defer panicHandler()
 		defer close(doneCh)
 		log.Printf("[INFO] backend/local: apply calling Apply")
-		applyState, applyDiags = lr.Core.Apply(ctx, plan, lr.Config, lr.ApplyOpts)
+		walkCtx, walkCancel := forceCancelContext(ctx, cancelCtx)
+		defer walkCancel()
+		applyState, applyDiags = lr.Core.Apply(walkCtx, plan, lr.Config, lr.ApplyOpts)
 	}()
 
 	if b.opWait(doneCh, stopCtx, cancelCtx, lr.Core, opState, op.View) {
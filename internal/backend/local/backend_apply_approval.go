@@ -0,0 +1,122 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package local
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/opentofu/opentofu/internal/backend"
+	"github.com/opentofu/opentofu/internal/command/jsonplan"
+	"github.com/opentofu/opentofu/internal/configs"
+	"github.com/opentofu/opentofu/internal/plans"
+	"github.com/opentofu/opentofu/internal/states/statefile"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+	"github.com/opentofu/opentofu/internal/tofu"
+)
+
+// approvalHookWaitDelay is how long we wait for the output of an approval
+// hook to be closed after the command itself has exited or been killed, in
+// case it started child processes that are still holding it open.
+const approvalHookWaitDelay = 5 * time.Second
+
+// approvalHookOutputLimit is the maximum number of bytes of the output of an
+// approval hook that we include in the diagnostic explaining why it failed.
+const approvalHookOutputLimit = 4096
+
+// approvePlan runs the approval hook of the given operation with the JSON
+// representation of the given plan on its standard input, returning error
+// diagnostics unless the hook exits successfully before its timeout.
+func approvePlan(stopCtx context.Context, op *backend.Operation, config *configs.Config, plan *plans.Plan, schemas *tofu.Schemas) tfdiags.Diagnostics {
+	var diags tfdiags.Diagnostics
+
+	planJSON, err := jsonplan.Marshal(config, plan, statefile.New(plan.PriorState, "", 0), schemas)
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to run approval hook",
+			fmt.Sprintf("Failed to produce the JSON representation of the plan for the approval hook: %s.", err),
+		))
+		return diags
+	}
+
+	op.View.WaitingForApproval(op.ApprovalHook.Command)
+	return runApprovalHook(stopCtx, op.ApprovalHook, planJSON)
+}
+
+// runApprovalHook runs the given approval hook with the given plan JSON on
+// its standard input and waits for it to exit.
+func runApprovalHook(stopCtx context.Context, hook *backend.ApprovalHook, planJSON []byte) tfdiags.Diagnostics {
+	var diags tfdiags.Diagnostics
+
+	ctx, cancel := context.WithTimeout(stopCtx, hook.Timeout)
+	defer cancel()
+
+	var output bytes.Buffer
+	cmd := exec.CommandContext(ctx, hook.Command, hook.Args...)
+	cmd.Stdin = bytes.NewReader(planJSON)
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+	cmd.WaitDelay = approvalHookWaitDelay
+
+	log.Printf("[INFO] backend/local: running approval hook %q", hook.Command)
+	err := cmd.Run()
+	log.Printf("[DEBUG] backend/local: approval hook output:\n%s", output.String())
+
+	var exitErr *exec.ExitError
+	switch {
+	case err == nil:
+		log.Printf("[INFO] backend/local: approval hook approved the changes")
+		return diags
+	case stopCtx.Err() != nil:
+		diags = diags.Append(errors.New("execution halted"))
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Approval hook timed out",
+			fmt.Sprintf(
+				"The approval hook %q did not exit within %s, so the changes were not approved.%s",
+				hook.Command, hook.Timeout, approvalHookOutputDetail(output.Bytes()),
+			),
+		))
+	case errors.As(err, &exitErr):
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Changes not approved",
+			fmt.Sprintf(
+				"The approval hook %q exited with status %d, so the changes were not approved.%s",
+				hook.Command, exitErr.ExitCode(), approvalHookOutputDetail(output.Bytes()),
+			),
+		))
+	default:
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to run approval hook",
+			fmt.Sprintf("Failed to run the approval hook %q: %s.", hook.Command, err),
+		))
+	}
+	return diags
+}
+
+// approvalHookOutputDetail returns the given output of an approval hook
+// formatted for inclusion at the end of a diagnostic detail message, or an
+// empty string if there is no output.
+func approvalHookOutputDetail(output []byte) string {
+	if len(output) > approvalHookOutputLimit {
+		output = output[len(output)-approvalHookOutputLimit:]
+	}
+	text := strings.TrimSpace(string(output))
+	if text == "" {
+		return ""
+	}
+	return "\n\nThe approval hook produced the following output:\n" + text
+}
@@ -0,0 +1,129 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package local
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/opentofu/opentofu/internal/backend"
+	"github.com/opentofu/opentofu/internal/providers"
+)
+
+func TestLocal_applyApprovalHook(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("approval hook tests use a POSIX shell")
+	}
+
+	planPath := filepath.Join(t.TempDir(), "plan.json")
+
+	tests := map[string]struct {
+		hook      *backend.ApprovalHook
+		wantApply bool
+		wantErr   []string
+	}{
+		"approved": {
+			hook: &backend.ApprovalHook{
+				Command: "sh",
+				Args:    []string{"-c", `cat > "$0"`, planPath},
+				Timeout: time.Minute,
+			},
+			wantApply: true,
+		},
+		"rejected": {
+			hook: &backend.ApprovalHook{
+				Command: "sh",
+				Args:    []string{"-c", "echo 'rejected by reviewer'; exit 3"},
+				Timeout: time.Minute,
+			},
+			wantErr: []string{"Changes not approved", "exited with status 3", "rejected by reviewer"},
+		},
+		"timed out": {
+			hook: &backend.ApprovalHook{
+				Command: "sleep",
+				Args:    []string{"10"},
+				Timeout: 100 * time.Millisecond,
+			},
+			wantErr: []string{"Approval hook timed out"},
+		},
+		"not found": {
+			hook: &backend.ApprovalHook{
+				Command: filepath.Join(t.TempDir(), "does-not-exist"),
+				Timeout: time.Minute,
+			},
+			wantErr: []string{"Failed to run approval hook"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			b := TestLocal(t)
+
+			p := TestLocalProvider(t, b, "test", applyFixtureSchema())
+			p.ApplyResourceChangeResponse = &providers.ApplyResourceChangeResponse{NewState: cty.ObjectVal(map[string]cty.Value{
+				"id":  cty.StringVal("yes"),
+				"ami": cty.StringVal("bar"),
+			})}
+
+			op, done := testOperationApply(t, "./testdata/apply")
+			op.ApprovalHook = test.hook
+
+			run, err := b.Operation(context.Background(), op)
+			if err != nil {
+				t.Fatalf("bad: %s", err)
+			}
+			<-run.Done()
+			output := done(t)
+
+			if got, want := p.ApplyResourceChangeCalled, test.wantApply; got != want {
+				t.Fatalf("wrong ApplyResourceChangeCalled %t; want %t", got, want)
+			}
+			if test.wantApply {
+				if run.Result != backend.OperationSuccess {
+					t.Fatalf("operation failed\n%s", output.Stderr())
+				}
+				if got, want := output.Stdout(), `Waiting for approval from the approval hook "sh"...`; !strings.Contains(got, want) {
+					t.Errorf("output is missing %q\n%s", want, got)
+				}
+				return
+			}
+
+			if run.Result == backend.OperationSuccess {
+				t.Fatal("operation succeeded; want failure")
+			}
+			for _, want := range test.wantErr {
+				if got := output.Stderr(); !strings.Contains(got, want) {
+					t.Errorf("error output is missing %q\n%s", want, got)
+				}
+			}
+		})
+	}
+
+	// The approved hook received the plan in the JSON plan format.
+	src, err := os.ReadFile(planPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var plan struct {
+		ResourceChanges []struct {
+			Address string `json:"address"`
+		} `json:"resource_changes"`
+	}
+	if err := json.Unmarshal(src, &plan); err != nil {
+		t.Fatalf("invalid plan JSON: %s\n%s", err, src)
+	}
+	if len(plan.ResourceChanges) != 1 || plan.ResourceChanges[0].Address != "test_instance.foo" {
+		t.Errorf("wrong resource changes in plan JSON\n%s", src)
+	}
+}
@@ -9,15 +9,18 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"sort"
 	"strings"
 
 	"github.com/zclconf/go-cty/cty"
 
+	"github.com/opentofu/opentofu/internal/addrs"
 	"github.com/opentofu/opentofu/internal/backend"
 	"github.com/opentofu/opentofu/internal/configs"
 	"github.com/opentofu/opentofu/internal/configs/configload"
 	"github.com/opentofu/opentofu/internal/plans/planfile"
+	"github.com/opentofu/opentofu/internal/states"
 	"github.com/opentofu/opentofu/internal/states/statemgr"
 	"github.com/opentofu/opentofu/internal/tfdiags"
 	"github.com/opentofu/opentofu/internal/tofu"
@@ -100,7 +103,7 @@ func (b *Local) localRun(ctx context.Context, stopCtx context.Context, op *backe
 			stateMeta = &m
 		}
 		log.Printf("[TRACE] backend/local: populating backend.LocalRun from plan file")
-		ret, configSnap, ctxDiags = b.localRunForPlanFile(ctx, op, lp, ret, &coreOpts, stateMeta)
+		ret, configSnap, ctxDiags = b.localRunForPlanFile(ctx, op, lp, ret, &coreOpts, s.State(), stateMeta)
 		if ctxDiags.HasErrors() {
 			diags = diags.Append(ctxDiags)
 			return nil, nil, nil, diags
@@ -205,8 +208,10 @@ func (b *Local) localRunDirect(ctx context.Context, stopCtx context.Context, op
 		Targets:            op.Targets,
 		Excludes:           op.Excludes,
 		ForceReplace:       op.ForceReplace,
+		ForceReplaceReason: op.ForceReplaceReason,
 		SetVariables:       variables,
-		SkipRefresh:        op.Type != backend.OperationTypeRefresh && !op.PlanRefresh,
+		SkipRefresh:        op.Type != backend.OperationTypeRefresh && !op.PlanRefresh && len(op.RefreshTargets) == 0,
+		RefreshTargets:     op.RefreshTargets,
 		GenerateConfigPath: op.GenerateConfigOut,
 	}
 	run.PlanOpts = planOpts
@@ -214,6 +219,7 @@ func (b *Local) localRunDirect(ctx context.Context, stopCtx context.Context, op
 	// Set ApplyOpts for direct runs to pass through the CLI flag
 	run.ApplyOpts = &tofu.ApplyOpts{
 		SuppressForgetErrorsDuringDestroy: op.SuppressForgetErrorsDuringDestroy,
+		PreflightProviders:                op.PreflightProviders,
 	}
 
 	// For a "direct" local run, the input state is the most recently stored
@@ -229,6 +235,12 @@ func (b *Local) localRunDirect(ctx context.Context, stopCtx context.Context, op
 	}
 	run.InputState = state
 
+	if len(op.ForceReplacePatterns) != 0 {
+		matched, moreDiags := resolveForceReplacePatterns(op.ForceReplacePatterns, op.ForceReplace, state)
+		diags = diags.Append(moreDiags)
+		planOpts.ForceReplace = append(append([]addrs.AbsResourceInstance(nil), op.ForceReplace...), matched...)
+	}
+
 	tfCtx, moreDiags := tofu.NewContext(coreOpts)
 	diags = diags.Append(moreDiags)
 	if moreDiags.HasErrors() {
@@ -238,7 +250,7 @@ func (b *Local) localRunDirect(ctx context.Context, stopCtx context.Context, op
 	return run, configSnap, diags
 }
 
-func (b *Local) localRunForPlanFile(ctx context.Context, op *backend.Operation, pf *planfile.Reader, run *backend.LocalRun, coreOpts *tofu.ContextOpts, currentStateMeta *statemgr.SnapshotMeta) (*backend.LocalRun, *configload.Snapshot, tfdiags.Diagnostics) {
+func (b *Local) localRunForPlanFile(ctx context.Context, op *backend.Operation, pf *planfile.Reader, run *backend.LocalRun, coreOpts *tofu.ContextOpts, currentState *states.State, currentStateMeta *statemgr.SnapshotMeta) (*backend.LocalRun, *configload.Snapshot, tfdiags.Diagnostics) {
 	var diags tfdiags.Diagnostics
 
 	const errSummary = "Invalid plan file"
@@ -288,6 +300,7 @@ func (b *Local) localRunForPlanFile(ctx context.Context, op *backend.Operation,
 	run.ApplyOpts = &tofu.ApplyOpts{
 		SetVariables:                      declaredVars,
 		SuppressForgetErrorsDuringDestroy: op.SuppressForgetErrorsDuringDestroy,
+		PreflightProviders:                op.PreflightProviders,
 	}
 
 	// NOTE: We're intentionally comparing the current locks with the
@@ -363,11 +376,28 @@ func (b *Local) localRunForPlanFile(ctx context.Context, op *backend.Operation,
 				"The given plan file can not be applied because it was created from a different state lineage.",
 			))
 
+		case priorStateFile.Serial != currentStateMeta.Serial && op.AllowStalePlan:
+			// The plan's prior state is outdated, so we re-base it on the
+			// current state to retain the changes the other operation made,
+			// as long as those changes don't overlap with the plan's own.
+			rebased, rebaseDiags := rebaseStalePlan(plan, currentState)
+			diags = diags.Append(rebaseDiags)
+			if rebaseDiags.HasErrors() {
+				return nil, snap, diags
+			}
+			priorStateFile.State = rebased
+			plan.PriorState = rebased
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Warning,
+				"Applying a stale saved plan",
+				fmt.Sprintf("The state was changed by another operation after the plan was created, from serial %d to serial %d, but -allow-stale-plan is set. The plan will be applied on top of the changes made by the other operation.", priorStateFile.Serial, currentStateMeta.Serial),
+			))
+
 		case priorStateFile.Serial != currentStateMeta.Serial:
 			diags = diags.Append(tfdiags.Sourceless(
 				tfdiags.Error,
 				"Saved plan is stale",
-				"The given plan file can no longer be applied because the state was changed by another operation after the plan was created.",
+				fmt.Sprintf("The given plan file can no longer be applied because the state was changed by another operation after the plan was created, from serial %d to serial %d.\n\nCreate a new plan from the current state, or use the -allow-stale-plan option to apply this plan anyway.", priorStateFile.Serial, currentStateMeta.Serial),
 			))
 		}
 	}
@@ -542,3 +572,74 @@ func (v unparsedUnknownVariableValue) ParseVariableValue(mode configs.VariablePa
 		SourceType: tofu.ValueFromInput,
 	}, nil
 }
+
+// resolveForceReplacePatterns returns the addresses of all of the managed
+// resource instances in the given state that match any of the given -replace
+// patterns, excluding any that are already in exact.
+//
+// In a pattern, each "*" matches any sequence of characters in the string
+// representation of a resource instance address, and all other characters
+// match only themselves. A pattern that doesn't match anything produces a
+// warning rather than an error, consistent with how -replace treats exact
+// addresses of instances that don't exist.
+func resolveForceReplacePatterns(patterns []string, exact []addrs.AbsResourceInstance, state *states.State) ([]addrs.AbsResourceInstance, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	seen := make(map[string]struct{}, len(exact))
+	for _, addr := range exact {
+		seen[addr.String()] = struct{}{}
+	}
+
+	var candidates []addrs.AbsResourceInstance
+	if state != nil {
+		for _, ms := range state.Modules {
+			for _, rs := range ms.Resources {
+				if rs.Addr.Resource.Mode != addrs.ManagedResourceMode {
+					continue
+				}
+				for key := range rs.Instances {
+					candidates = append(candidates, rs.Addr.Instance(key))
+				}
+			}
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].Less(candidates[j])
+	})
+
+	var matched []addrs.AbsResourceInstance
+	for _, pattern := range patterns {
+		re := forceReplacePatternRegexp(pattern)
+		found := false
+		for _, addr := range candidates {
+			str := addr.String()
+			if !re.MatchString(str) {
+				continue
+			}
+			found = true
+			if _, ok := seen[str]; ok {
+				continue
+			}
+			seen[str] = struct{}{}
+			matched = append(matched, addr)
+		}
+		if !found {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Warning,
+				"No resource instances match -replace pattern",
+				fmt.Sprintf("The pattern %q given in the -replace option does not match any resource instance in the current state, so it has no effect.", pattern),
+			))
+		}
+	}
+	return matched, diags
+}
+
+// forceReplacePatternRegexp translates a -replace pattern into an equivalent
+// anchored regular expression.
+func forceReplacePatternRegexp(pattern string) *regexp.Regexp {
+	parts := strings.Split(pattern, "*")
+	for i, part := range parts {
+		parts[i] = regexp.QuoteMeta(part)
+	}
+	return regexp.MustCompile("^" + strings.Join(parts, ".*") + "$")
+}
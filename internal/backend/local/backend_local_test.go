@@ -14,14 +14,17 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/opentofu/opentofu/internal/providers"
 	"github.com/zclconf/go-cty/cty"
 
+	"github.com/opentofu/opentofu/internal/addrs"
 	"github.com/opentofu/opentofu/internal/backend"
 	"github.com/opentofu/opentofu/internal/command/clistate"
 	"github.com/opentofu/opentofu/internal/command/views"
 	"github.com/opentofu/opentofu/internal/configs/configload"
 	"github.com/opentofu/opentofu/internal/configs/configschema"
+	"github.com/opentofu/opentofu/internal/depsfile"
 	"github.com/opentofu/opentofu/internal/encryption"
 	"github.com/opentofu/opentofu/internal/initwd"
 	"github.com/opentofu/opentofu/internal/plans"
@@ -190,6 +193,8 @@ func TestLocalRun_stalePlan(t *testing.T) {
 	backendConfig := cty.ObjectVal(map[string]cty.Value{
 		"path":          cty.NullVal(cty.String),
 		"workspace_dir": cty.NullVal(cty.String),
+		"backup_dir":    cty.NullVal(cty.String),
+		"fsync":         cty.NullVal(cty.Bool),
 	})
 	backendConfigRaw, err := plans.NewDynamicValue(backendConfig, backendConfig.Type())
 	if err != nil {
@@ -217,6 +222,7 @@ func TestLocalRun_stalePlan(t *testing.T) {
 		PreviousRunStateFile: prevStateFile,
 		StateFile:            stateFile,
 		Plan:                 plan,
+		DependencyLocks:      depsfile.NewLocks(),
 	}
 	if err := planfile.Create(planPath, planfileArgs, encryption.PlanEncryptionDisabled()); err != nil {
 		t.Fatalf("unexpected error writing planfile: %s", err)
@@ -232,20 +238,111 @@ func TestLocalRun_stalePlan(t *testing.T) {
 	stateLocker := clistate.NewLocker(0, backendView.StateLocker())
 
 	op := &backend.Operation{
-		ConfigDir:    configDir,
-		ConfigLoader: configLoader,
-		PlanFile:     planFile,
-		Workspace:    backend.DefaultStateName,
-		StateLocker:  stateLocker,
+		ConfigDir:       configDir,
+		ConfigLoader:    configLoader,
+		PlanFile:        planFile,
+		Workspace:       backend.DefaultStateName,
+		StateLocker:     stateLocker,
+		DependencyLocks: depsfile.NewLocks(),
 	}
 
 	_, _, diags := b.LocalRun(context.Background(), t.Context(), op)
 	if !diags.HasErrors() {
 		t.Fatal("unexpected success")
 	}
+	if got, want := diags.Err().Error(), "Saved plan is stale"; !strings.Contains(got, want) {
+		t.Errorf("wrong error\ngot:  %s\nwant: message containing %q", got, want)
+	}
 
 	// LocalRun() unlocks the state on failure
 	assertBackendStateUnlocked(t, b)
+
+	// The -allow-stale-plan option turns the error into a warning.
+	op.AllowStalePlan = true
+	_, _, diags = b.LocalRun(context.Background(), t.Context(), op)
+	if diags.HasErrors() {
+		t.Fatalf("unexpected error: %s", diags.Err())
+	}
+	if len(diags) != 1 || diags[0].Severity() != tfdiags.Warning || diags[0].Description().Summary != "Applying a stale saved plan" {
+		t.Errorf("wrong diagnostics: %s", diags.ErrWithWarnings())
+	}
+
+	// LocalRun() retains a lock on success
+	assertBackendStateLocked(t, b)
+}
+
+func TestResolveForceReplacePatterns(t *testing.T) {
+	state := states.BuildState(func(s *states.SyncState) {
+		for _, addr := range []string{
+			`test_instance.web[0]`,
+			`test_instance.web[1]`,
+			`test_instance.db`,
+			`module.a.test_instance.web["x"]`,
+			`data.test_ds.web`,
+		} {
+			s.SetResourceInstanceCurrent(
+				mustResourceInstanceAddr(addr),
+				&states.ResourceInstanceObjectSrc{
+					Status:    states.ObjectReady,
+					AttrsJSON: []byte(`{}`),
+				},
+				mustProviderConfig(`provider["registry.opentofu.org/hashicorp/test"]`),
+				addrs.NoKey,
+			)
+		}
+	})
+
+	tests := map[string]struct {
+		patterns []string
+		exact    []string
+		want     []string
+		wantWarn bool
+	}{
+		"all instances of a resource": {
+			patterns: []string{"test_instance.web[*]"},
+			want:     []string{"test_instance.web[0]", "test_instance.web[1]"},
+		},
+		"across modules": {
+			patterns: []string{"*test_instance.web*"},
+			want:     []string{"test_instance.web[0]", "test_instance.web[1]", `module.a.test_instance.web["x"]`},
+		},
+		"already given exactly": {
+			patterns: []string{"test_instance.web[*]"},
+			exact:    []string{"test_instance.web[0]"},
+			want:     []string{"test_instance.web[1]"},
+		},
+		"data resources are never matched": {
+			patterns: []string{"*.test_ds.*"},
+			wantWarn: true,
+		},
+		"no match": {
+			patterns: []string{"test_instance.nope*"},
+			wantWarn: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var exact []addrs.AbsResourceInstance
+			for _, addr := range test.exact {
+				exact = append(exact, mustResourceInstanceAddr(addr))
+			}
+			got, diags := resolveForceReplacePatterns(test.patterns, exact, state)
+			if diags.HasErrors() {
+				t.Fatalf("unexpected errors: %s", diags.Err())
+			}
+			if gotWarn := len(diags) != 0; gotWarn != test.wantWarn {
+				t.Errorf("wrong warnings: %s", diags.ErrWithWarnings())
+			}
+			var gotStrs []string
+			for _, addr := range got {
+				gotStrs = append(gotStrs, addr.String())
+			}
+			if diff := cmp.Diff(test.want, gotStrs); diff != "" {
+				t.Errorf("wrong result\n%s", diff)
+			}
+		})
+	}
 }
 
 type backendWithStateStorageThatFailsRefresh struct {
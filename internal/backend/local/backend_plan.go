@@ -6,16 +6,23 @@
 package local
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
 	"log"
+	"os"
 
+	"github.com/hashicorp/hcl/v2"
+
+	"github.com/opentofu/opentofu/internal/addrs"
 	"github.com/opentofu/opentofu/internal/backend"
+	"github.com/opentofu/opentofu/internal/configs"
 	"github.com/opentofu/opentofu/internal/genconfig"
 	"github.com/opentofu/opentofu/internal/logging"
 	"github.com/opentofu/opentofu/internal/plans"
 	"github.com/opentofu/opentofu/internal/plans/planfile"
+	"github.com/opentofu/opentofu/internal/refactoring"
 	"github.com/opentofu/opentofu/internal/states/statefile"
 	"github.com/opentofu/opentofu/internal/states/statemgr"
 	"github.com/opentofu/opentofu/internal/tfdiags"
@@ -83,6 +90,23 @@ func (b *Local) opPlan(
 		}
 	}
 
+	if len(op.SuggestMovesOut) > 0 {
+		if op.PlanMode != plans.NormalMode {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Invalid suggest-moves flag",
+				"Moves can only be suggested during a normal plan operation, and not during a refresh-only or destroy plan."))
+			op.ReportResult(runningOp, diags)
+			return
+		}
+
+		diags = diags.Append(validateSuggestedMovesFile(op.SuggestMovesOut))
+		if diags.HasErrors() {
+			op.ReportResult(runningOp, diags)
+			return
+		}
+	}
+
 	if b.ContextOpts == nil {
 		b.ContextOpts = new(tofu.ContextOpts)
 	}
@@ -117,7 +141,9 @@ func (b *Local) opPlan(
 		defer panicHandler()
 		defer close(doneCh)
 		log.Printf("[INFO] backend/local: plan calling Plan")
-		plan, planDiags = lr.Core.Plan(ctx, lr.Config, lr.InputState, lr.PlanOpts)
+		walkCtx, walkCancel := forceCancelContext(ctx, cancelCtx)
+		defer walkCancel()
+		plan, planDiags = lr.Core.Plan(walkCtx, lr.Config, lr.InputState, lr.PlanOpts)
 	}()
 
 	if b.opWait(doneCh, stopCtx, cancelCtx, lr.Core, opState, op.View) {
@@ -175,8 +201,20 @@ func (b *Local) opPlan(
 			State: plan.PrevRunState,
 		}
 
+		runEnv, err := planfile.NewRunEnvironment(configSnap, op.DependencyLocks, plan.Backend, os.Environ())
+		if err != nil {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Failed to write plan file",
+				fmt.Sprintf("The plan file could not be written: %s.", err),
+			))
+			op.ReportResult(runningOp, diags)
+			return
+		}
+		plan.RunEnvironment = runEnv
+
 		log.Printf("[INFO] backend/local: writing plan output to: %s", path)
-		err := planfile.Create(path, planfile.CreateArgs{
+		err = planfile.Create(path, planfile.CreateArgs{
 			ConfigSnapshot:       configSnap,
 			PreviousRunStateFile: prevStateFile,
 			StateFile:            plannedStateFile,
@@ -204,13 +242,16 @@ func (b *Local) opPlan(
 	}
 
 	// Write out any generated config, before we render the plan.
-	wroteConfig, moreDiags := maybeWriteGeneratedConfig(plan, op.GenerateConfigOut)
+	wroteConfig, moreDiags := maybeWriteGeneratedConfig(plan, lr.Config, op.GenerateConfigOut)
 	diags = diags.Append(moreDiags)
 	if moreDiags.HasErrors() {
 		op.ReportResult(runningOp, diags)
 		return
 	}
 
+	// Likewise for any suggested moves.
+	diags = diags.Append(maybeWriteSuggestedMoves(plan, schemas, op.SuggestMovesOut))
+
 	op.View.Plan(plan, schemas)
 
 	// If we've accumulated any diagnostics along the way then we'll show them
@@ -229,9 +270,9 @@ func (b *Local) opPlan(
 	}
 }
 
-func maybeWriteGeneratedConfig(plan *plans.Plan, out string) (wroteConfig bool, diags tfdiags.Diagnostics) {
+func maybeWriteGeneratedConfig(plan *plans.Plan, config *configs.Config, out string) (wroteConfig bool, diags tfdiags.Diagnostics) {
 	if genconfig.ShouldWriteConfig(out) {
-		diags := genconfig.ValidateTargetFile(out)
+		diags = genconfig.ValidateTargetFile(out)
 		if diags.HasErrors() {
 			return false, diags
 		}
@@ -245,6 +286,7 @@ func maybeWriteGeneratedConfig(plan *plans.Plan, out string) (wroteConfig bool,
 			if c.Importing != nil {
 				change.ImportID = c.Importing.ID
 			}
+			change.ImportDeclRange = generatedConfigImportRange(config, c.Addr)
 
 			var moreDiags tfdiags.Diagnostics
 			writer, wroteConfig, moreDiags = change.MaybeWriteConfig(writer, out)
@@ -252,6 +294,7 @@ func maybeWriteGeneratedConfig(plan *plans.Plan, out string) (wroteConfig bool,
 				return false, diags.Append(moreDiags)
 			}
 		}
+		diags = diags.Append(genconfig.WriteSourceMap(writer, out))
 	}
 
 	if wroteConfig {
@@ -263,3 +306,89 @@ func maybeWriteGeneratedConfig(plan *plans.Plan, out string) (wroteConfig bool,
 
 	return wroteConfig, diags
 }
+
+func validateSuggestedMovesFile(out string) (diags tfdiags.Diagnostics) {
+	if _, err := os.Stat(out); !os.IsNotExist(err) {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Target suggested moves file already exists",
+			"OpenTofu can only write suggested moves into a new file. Either choose a different target location or move the existing file, and try again."))
+	}
+	return diags
+}
+
+// maybeWriteSuggestedMoves writes a "moved" block to the given file for each
+// pair of destroy and create actions in the plan that could be a move
+// instead, if the file path isn't empty and there are any such pairs.
+func maybeWriteSuggestedMoves(plan *plans.Plan, schemas *tofu.Schemas, out string) (diags tfdiags.Diagnostics) {
+	if out == "" || plan == nil || plan.Changes == nil {
+		return diags
+	}
+
+	var changes []*plans.ResourceInstanceChange
+	for _, rc := range plan.Changes.Resources {
+		if rc.Action != plans.Delete && rc.Action != plans.Create {
+			continue
+		}
+		schema, _ := schemas.ResourceTypeConfig(rc.ProviderAddr.Provider, rc.Addr.Resource.Resource.Mode, rc.Addr.Resource.Resource.Type)
+		if schema == nil {
+			continue
+		}
+		change, err := rc.Decode(schema)
+		if err != nil {
+			// The plan was encoded with the same schemas, so this should
+			// never happen.
+			diags = diags.Append(fmt.Errorf("failed to decode planned change for %s: %w", rc.Addr, err))
+			continue
+		}
+		changes = append(changes, change)
+	}
+
+	moves := refactoring.SuggestMoves(changes)
+	if len(moves) == 0 {
+		return diags
+	}
+
+	var buf bytes.Buffer
+	if err := refactoring.WriteSuggestedMoves(&buf, moves); err != nil {
+		return diags.Append(err)
+	}
+	if err := os.WriteFile(out, buf.Bytes(), 0644); err != nil {
+		return diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to write suggested moves",
+			fmt.Sprintf("OpenTofu could not write the suggested moves to %s: %s.", out, err)))
+	}
+
+	blocks := fmt.Sprintf("%d suggested \"moved\" blocks", len(moves))
+	if len(moves) == 1 {
+		blocks = "a suggested \"moved\" block"
+	}
+	return diags.Append(tfdiags.Sourceless(
+		tfdiags.Warning,
+		"Resource instances could be moved instead",
+		fmt.Sprintf("This plan destroys resource instances while creating other instances of the same resources with the same attributes, such as after switching a resource from count to for_each. OpenTofu wrote %s to %s. Review them and add them to the root module to keep the existing objects instead.", blocks, out)))
+}
+
+// generatedConfigImportRange returns the range of the import block that
+// targets the given resource instance, for recording in the source map of
+// the generated config, or nil if there's no such block.
+//
+// An import block whose address can't be resolved statically might target
+// any instance of its resource, so we only use such a block if no block
+// targets exactly this instance.
+func generatedConfigImportRange(config *configs.Config, addr addrs.AbsResourceInstance) *hcl.Range {
+	if config == nil || config.Module == nil {
+		return nil
+	}
+	var ret *hcl.Range
+	for _, imp := range config.Module.Import {
+		if imp.ResolvedTo != nil && imp.ResolvedTo.Equal(addr) {
+			return imp.DeclRange.Ptr()
+		}
+		if ret == nil && imp.ResolvedTo == nil && imp.StaticTo.Equal(addr.ConfigResource()) {
+			ret = imp.DeclRange.Ptr()
+		}
+	}
+	return ret
+}
@@ -0,0 +1,157 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package local
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/opentofu/opentofu/internal/backend"
+)
+
+// DefaultPlanStoreDir is the name of the directory, alongside the state file
+// of each workspace, where plans saved with "tofu plan -store" are kept.
+const DefaultPlanStoreDir = "terraform.tfplans.d"
+
+const (
+	storedPlanExt     = ".tfplan"
+	storedPlanMetaExt = ".json"
+)
+
+// storedPlanIDPattern matches valid stored plan IDs, which also ensures that
+// an ID can't be used to refer to files outside of the plan store directory.
+var storedPlanIDPattern = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+var _ backend.PlanStore = (*Local)(nil)
+
+// StorePlan implements backend.PlanStore.
+func (b *Local) StorePlan(ctx context.Context, workspace string, meta backend.StoredPlanMeta, plan []byte) error {
+	// If we have a backend handling state, the plans must be stored there too.
+	if b.Backend != nil {
+		ps, ok := b.Backend.(backend.PlanStore)
+		if !ok {
+			return backend.ErrPlanStoreNotSupported
+		}
+		return ps.StorePlan(ctx, workspace, meta, plan)
+	}
+
+	if !storedPlanIDPattern.MatchString(meta.ID) {
+		return fmt.Errorf("invalid stored plan ID %q", meta.ID)
+	}
+	if err := b.createState(workspace); err != nil {
+		return err
+	}
+	dir := b.planStoreDir(workspace)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	rawMeta, err := json.MarshalIndent(meta, "", "  ")
+	if err != nil {
+		return err
+	}
+	// We write the plan first so that a plan is never listed before its
+	// content is available.
+	if err := os.WriteFile(filepath.Join(dir, meta.ID+storedPlanExt), plan, 0600); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, meta.ID+storedPlanMetaExt), rawMeta, 0600)
+}
+
+// StoredPlans implements backend.PlanStore.
+func (b *Local) StoredPlans(ctx context.Context, workspace string) ([]backend.StoredPlanMeta, error) {
+	// If we have a backend handling state, the plans are stored there too.
+	if b.Backend != nil {
+		ps, ok := b.Backend.(backend.PlanStore)
+		if !ok {
+			return nil, backend.ErrPlanStoreNotSupported
+		}
+		return ps.StoredPlans(ctx, workspace)
+	}
+
+	entries, err := os.ReadDir(b.planStoreDir(workspace))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []backend.StoredPlanMeta
+	for _, entry := range entries {
+		id, ok := strings.CutSuffix(entry.Name(), storedPlanMetaExt)
+		if !ok || entry.IsDir() || !storedPlanIDPattern.MatchString(id) {
+			continue
+		}
+		meta, err := b.readStoredPlanMeta(workspace, id)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, meta)
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		return ret[i].CreatedAt.Before(ret[j].CreatedAt)
+	})
+	return ret, nil
+}
+
+// StoredPlan implements backend.PlanStore.
+func (b *Local) StoredPlan(ctx context.Context, workspace string, id string) (backend.StoredPlanMeta, []byte, error) {
+	// If we have a backend handling state, the plans are stored there too.
+	if b.Backend != nil {
+		ps, ok := b.Backend.(backend.PlanStore)
+		if !ok {
+			return backend.StoredPlanMeta{}, nil, backend.ErrPlanStoreNotSupported
+		}
+		return ps.StoredPlan(ctx, workspace, id)
+	}
+
+	if !storedPlanIDPattern.MatchString(id) {
+		return backend.StoredPlanMeta{}, nil, backend.ErrStoredPlanNotFound
+	}
+	meta, err := b.readStoredPlanMeta(workspace, id)
+	if err != nil {
+		return backend.StoredPlanMeta{}, nil, err
+	}
+	plan, err := os.ReadFile(filepath.Join(b.planStoreDir(workspace), id+storedPlanExt))
+	if errors.Is(err, os.ErrNotExist) {
+		return backend.StoredPlanMeta{}, nil, backend.ErrStoredPlanNotFound
+	}
+	if err != nil {
+		return backend.StoredPlanMeta{}, nil, err
+	}
+	return meta, plan, nil
+}
+
+func (b *Local) readStoredPlanMeta(workspace string, id string) (backend.StoredPlanMeta, error) {
+	var meta backend.StoredPlanMeta
+	raw, err := os.ReadFile(filepath.Join(b.planStoreDir(workspace), id+storedPlanMetaExt))
+	if errors.Is(err, os.ErrNotExist) {
+		return meta, backend.ErrStoredPlanNotFound
+	}
+	if err != nil {
+		return meta, err
+	}
+	if err := json.Unmarshal(raw, &meta); err != nil {
+		return meta, fmt.Errorf("invalid metadata for stored plan %q: %w", id, err)
+	}
+	meta.ID = id
+	return meta, nil
+}
+
+// planStoreDir returns the directory where plans for the given workspace are
+// stored, which is alongside the workspace's state file.
+func (b *Local) planStoreDir(workspace string) string {
+	_, stateOutPath, _ := b.StatePaths(workspace)
+	return filepath.Join(filepath.Dir(stateOutPath), DefaultPlanStoreDir)
+}
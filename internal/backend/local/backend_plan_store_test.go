@@ -0,0 +1,91 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package local
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/opentofu/opentofu/internal/backend"
+	"github.com/opentofu/opentofu/internal/encryption"
+)
+
+func TestLocal_planStore(t *testing.T) {
+	b := TestLocal(t)
+	ctx := context.Background()
+
+	first := backend.StoredPlanMeta{
+		ID:         "first",
+		CreatedBy:  "alice",
+		CreatedAt:  time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		ConfigHash: "abc",
+	}
+	second := backend.StoredPlanMeta{
+		ID:        "second",
+		CreatedBy: "bob",
+		CreatedAt: first.CreatedAt.Add(time.Hour),
+	}
+	if err := b.StorePlan(ctx, backend.DefaultStateName, second, []byte("second plan")); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.StorePlan(ctx, backend.DefaultStateName, first, []byte("first plan")); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.StorePlan(ctx, "other", first, []byte("other plan")); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := b.StoredPlans(ctx, backend.DefaultStateName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]backend.StoredPlanMeta{first, second}, got); diff != "" {
+		t.Errorf("wrong stored plans\n%s", diff)
+	}
+
+	meta, plan, err := b.StoredPlan(ctx, "other", "first")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(first, meta); diff != "" {
+		t.Errorf("wrong metadata\n%s", diff)
+	}
+	if !bytes.Equal(plan, []byte("other plan")) {
+		t.Errorf("wrong plan content %q", plan)
+	}
+
+	// Plans for each workspace are stored alongside its state.
+	wantDir := filepath.Join(filepath.Dir(b.StatePath), "state.tfstate.d", "other", DefaultPlanStoreDir)
+	if got := b.planStoreDir("other"); got != wantDir {
+		t.Errorf("wrong plan store directory\ngot:  %s\nwant: %s", got, wantDir)
+	}
+
+	for _, id := range []string{"missing", "../first"} {
+		if _, _, err := b.StoredPlan(ctx, backend.DefaultStateName, id); !errors.Is(err, backend.ErrStoredPlanNotFound) {
+			t.Errorf("wrong error for %q: %v", id, err)
+		}
+	}
+	if err := b.StorePlan(ctx, backend.DefaultStateName, backend.StoredPlanMeta{ID: "../bad"}, nil); err == nil {
+		t.Errorf("expected error for invalid ID")
+	}
+}
+
+func TestLocal_planStoreNotSupported(t *testing.T) {
+	// Embedding only the Backend interface hides the PlanStore methods of
+	// the wrapped local backend.
+	wrapped := struct{ backend.Backend }{TestNewLocalSingle(encryption.StateEncryptionDisabled())}
+	b := NewWithBackend(wrapped, encryption.StateEncryptionDisabled())
+	_, err := b.StoredPlans(context.Background(), backend.DefaultStateName)
+	if !errors.Is(err, backend.ErrPlanStoreNotSupported) {
+		t.Fatalf("wrong error: %v", err)
+	}
+}
@@ -103,7 +103,9 @@ func (b *Local) opRefresh(
 	go func() {
 		defer panicHandler()
 		defer close(doneCh)
-		newState, refreshDiags = lr.Core.Refresh(ctx, lr.Config, lr.InputState, lr.PlanOpts)
+		walkCtx, walkCancel := forceCancelContext(ctx, cancelCtx)
+		defer walkCancel()
+		newState, refreshDiags = lr.Core.Refresh(walkCtx, lr.Config, lr.InputState, lr.PlanOpts)
 		log.Printf("[INFO] backend/local: refresh calling Refresh")
 	}()
 
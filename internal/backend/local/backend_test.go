@@ -14,11 +14,19 @@ import (
 	"runtime"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/opentofu/opentofu/internal/addrs"
 	"github.com/opentofu/opentofu/internal/backend"
+	"github.com/opentofu/opentofu/internal/command/arguments"
+	"github.com/opentofu/opentofu/internal/command/views"
 	"github.com/opentofu/opentofu/internal/encryption"
+	"github.com/opentofu/opentofu/internal/states"
 	"github.com/opentofu/opentofu/internal/states/statefile"
 	"github.com/opentofu/opentofu/internal/states/statemgr"
+	"github.com/opentofu/opentofu/internal/terminal"
+	"github.com/opentofu/opentofu/internal/tofu"
+	"github.com/zclconf/go-cty/cty"
 )
 
 func TestLocal_impl(t *testing.T) {
@@ -102,6 +110,197 @@ func TestLocal_StatePaths(t *testing.T) {
 
 }
 
+func TestLocal_StatePathsTemplate(t *testing.T) {
+	b := New(encryption.StateEncryptionDisabled())
+	b.StatePath = "states/${workspace}/terraform.tfstate"
+	b.StateBackupDir = "backups"
+
+	tests := map[string]struct {
+		path, backup string
+	}{
+		"": {
+			path:   filepath.Join("states", "default", "terraform.tfstate"),
+			backup: filepath.Join("backups", "terraform.tfstate.backup"),
+		},
+		"test_env": {
+			path:   filepath.Join("states", "test_env", "terraform.tfstate"),
+			backup: filepath.Join("backups", "test_env", "terraform.tfstate.backup"),
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			path, out, back := b.StatePaths(name)
+			if path != test.path {
+				t.Errorf("wrong state path\ngot:  %s\nwant: %s", path, test.path)
+			}
+			if out != test.path {
+				t.Errorf("wrong state out path\ngot:  %s\nwant: %s", out, test.path)
+			}
+			if back != test.backup {
+				t.Errorf("wrong backup path\ngot:  %s\nwant: %s", back, test.backup)
+			}
+		})
+	}
+
+	b.StateBackupDir = "backups/${workspace}"
+	if _, _, back := b.StatePaths("test_env"); back != filepath.Join("backups", "test_env", "terraform.tfstate.backup") {
+		t.Errorf("wrong templated backup path %s", back)
+	}
+
+	b.OverrideStateBackupPath = "-"
+	if _, _, back := b.StatePaths("test_env"); back != "" {
+		t.Errorf("backup should be disabled, but got %s", back)
+	}
+}
+
+func TestLocal_PrepareConfigTemplate(t *testing.T) {
+	b := New(encryption.StateEncryptionDisabled())
+
+	tests := map[string]struct {
+		config  cty.Value
+		wantErr string
+	}{
+		"valid": {
+			config: cty.ObjectVal(map[string]cty.Value{
+				"path":          cty.StringVal("states/env-${workspace}/terraform.tfstate"),
+				"workspace_dir": cty.NullVal(cty.String),
+				"backup_dir":    cty.StringVal("backups/${workspace}"),
+				"fsync":         cty.True,
+			}),
+		},
+		"placeholder in file name": {
+			config: cty.ObjectVal(map[string]cty.Value{
+				"path":          cty.StringVal("states/${workspace}.tfstate"),
+				"workspace_dir": cty.NullVal(cty.String),
+				"backup_dir":    cty.NullVal(cty.String),
+				"fsync":         cty.NullVal(cty.Bool),
+			}),
+			wantErr: "Invalid local state file path",
+		},
+		"placeholder twice": {
+			config: cty.ObjectVal(map[string]cty.Value{
+				"path":          cty.StringVal("${workspace}/${workspace}/terraform.tfstate"),
+				"workspace_dir": cty.NullVal(cty.String),
+				"backup_dir":    cty.NullVal(cty.String),
+				"fsync":         cty.NullVal(cty.Bool),
+			}),
+			wantErr: "Invalid local state file path",
+		},
+		"with workspace_dir": {
+			config: cty.ObjectVal(map[string]cty.Value{
+				"path":          cty.StringVal("states/${workspace}/terraform.tfstate"),
+				"workspace_dir": cty.StringVal("workspaces"),
+				"backup_dir":    cty.NullVal(cty.String),
+				"fsync":         cty.NullVal(cty.Bool),
+			}),
+			wantErr: "Conflicting local state paths",
+		},
+		"empty backup_dir": {
+			config: cty.ObjectVal(map[string]cty.Value{
+				"path":          cty.NullVal(cty.String),
+				"workspace_dir": cty.NullVal(cty.String),
+				"backup_dir":    cty.StringVal(""),
+				"fsync":         cty.NullVal(cty.Bool),
+			}),
+			wantErr: "Invalid local state backup directory path",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, diags := b.PrepareConfig(test.config)
+			if test.wantErr == "" {
+				if diags.HasErrors() {
+					t.Fatalf("unexpected errors: %s", diags.Err())
+				}
+				return
+			}
+			if !diags.HasErrors() {
+				t.Fatal("expected an error")
+			}
+			if got := diags[0].Description().Summary; got != test.wantErr {
+				t.Fatalf("wrong error %q, want %q", got, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestLocal_templatedWorkspaces(t *testing.T) {
+	testTmpDir(t)
+	config := cty.ObjectVal(map[string]cty.Value{
+		"path":          cty.StringVal("states/env-${workspace}/terraform.tfstate"),
+		"workspace_dir": cty.NullVal(cty.String),
+		"backup_dir":    cty.StringVal("backups"),
+		"fsync":         cty.True,
+	})
+
+	// The second round of writes with a fresh backend creates the backups
+	// of the snapshots from the first.
+	var b *Local
+	for round := range 2 {
+		b = New(encryption.StateEncryptionDisabled())
+		if diags := b.Configure(t.Context(), config); diags.HasErrors() {
+			t.Fatal(diags.Err())
+		}
+		for _, name := range []string{backend.DefaultStateName, "b", "a"} {
+			s, err := b.StateMgr(t.Context(), name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := s.RefreshState(t.Context()); err != nil {
+				t.Fatal(err)
+			}
+			if err := s.WriteState(states.BuildState(func(s *states.SyncState) {
+				s.SetOutputValue(addrs.OutputValue{Name: "round"}.Absolute(addrs.RootModuleInstance), cty.NumberIntVal(int64(round)), false, "")
+			})); err != nil {
+				t.Fatal(err)
+			}
+			if err := s.PersistState(t.Context(), nil); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	// Entries that don't match the template aren't workspaces.
+	if err := os.MkdirAll(filepath.Join("states", "other"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := b.Workspaces(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{backend.DefaultStateName, "a", "b"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("wrong workspaces %q, want %q", got, want)
+	}
+
+	for _, path := range []string{
+		filepath.Join("states", "env-default", "terraform.tfstate"),
+		filepath.Join("states", "env-a", "terraform.tfstate"),
+		filepath.Join("backups", "terraform.tfstate.backup"),
+		filepath.Join("backups", "a", "terraform.tfstate.backup"),
+	} {
+		if _, err := os.Stat(path); err != nil {
+			t.Errorf("expected %s to exist: %s", path, err)
+		}
+	}
+
+	if err := b.DeleteWorkspace(t.Context(), "a", true); err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range []string{filepath.Join("states", "env-a"), filepath.Join("backups", "a")} {
+		if _, err := os.Stat(path); !os.IsNotExist(err) {
+			t.Errorf("expected %s to be deleted, got: %v", path, err)
+		}
+	}
+	got, err = b.Workspaces(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{backend.DefaultStateName, "b"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("wrong workspaces after delete %q, want %q", got, want)
+	}
+}
+
 func TestLocal_addAndRemoveStates(t *testing.T) {
 	testTmpDir(t)
 	dflt := backend.DefaultStateName
@@ -246,3 +445,51 @@ func testTmpDir(t testing.TB) {
 	tmp := t.TempDir()
 	t.Chdir(tmp)
 }
+
+func TestLocal_opWaitForceCancel(t *testing.T) {
+	tfCtx, diags := tofu.NewContext(&tofu.ContextOpts{})
+	if diags.HasErrors() {
+		t.Fatal(diags.Err())
+	}
+
+	run := func(t *testing.T, opDuration time.Duration) bool {
+		streams, _ := terminal.StreamsForTesting(t)
+		view := views.NewOperation(arguments.ViewHuman, false, views.NewView(streams))
+		stateMgr := statemgr.NewFullFake(statemgr.NewTransientInMemory(states.NewState()), nil)
+
+		stopCtx, stop := context.WithCancel(context.Background())
+		cancelCtx, cancel := context.WithCancel(context.Background())
+		defer stop()
+		defer cancel()
+
+		// The operation runs until its context is canceled, like a provider
+		// request would, and then takes opDuration to return.
+		walkCtx, walkCancel := forceCancelContext(context.Background(), cancelCtx)
+		defer walkCancel()
+		doneCh := make(chan struct{})
+		go func() {
+			defer close(doneCh)
+			<-walkCtx.Done()
+			time.Sleep(opDuration)
+		}()
+
+		stop()
+		cancel()
+		b := New(encryption.StateEncryptionDisabled())
+		return b.opWait(doneCh, stopCtx, cancelCtx, tfCtx, stateMgr, view)
+	}
+
+	t.Run("returns within grace period", func(t *testing.T) {
+		if canceled := run(t, 0); canceled {
+			t.Fatal("operation that returned within the grace period was reported as canceled")
+		}
+	})
+	t.Run("exceeds grace period", func(t *testing.T) {
+		defer func(orig time.Duration) { forceCancelGracePeriod = orig }(forceCancelGracePeriod)
+		forceCancelGracePeriod = 10 * time.Millisecond
+
+		if canceled := run(t, time.Second); !canceled {
+			t.Fatal("operation that exceeded the grace period was not reported as canceled")
+		}
+	})
+}
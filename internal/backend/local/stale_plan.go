@@ -0,0 +1,145 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package local
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/plans"
+	"github.com/opentofu/opentofu/internal/states"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// rebaseStalePlan returns a copy of the prior state of the given saved plan
+// that also incorporates any resource instances that another operation
+// changed in the current state after the plan was created, so that applying
+// the plan will not discard those changes.
+//
+// The changes made by the other operation are found by comparing the current
+// state with the plan's "previous run" state, which is the state snapshot the
+// plan was created from before refreshing. If any of those changed resource
+// instances are also changed or moved by the plan, or the plan's changes
+// depend on them, then the plan's changes were decided on the basis of
+// outdated information. If any of them depend on a resource that the plan
+// changes then the other operation's changes were decided on the basis of
+// objects that the plan will update or destroy. In either case this function
+// returns error diagnostics instead.
+func rebaseStalePlan(plan *plans.Plan, current *states.State) (*states.State, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	planned := addrs.MakeSet[addrs.AbsResourceInstance]()
+	for _, rc := range plan.Changes.Resources {
+		if rc.Action == plans.NoOp && rc.Addr.Equal(rc.PrevRunAddr) {
+			continue
+		}
+		planned.Add(rc.Addr)
+		planned.Add(rc.PrevRunAddr)
+	}
+
+	// The objects that the plan changes record their dependencies in the
+	// state, but the objects it creates don't have any yet, so we also treat
+	// the resource instances that contributed to the plan's changes as
+	// dependencies.
+	plannedResources := addrs.MakeSet[addrs.ConfigResource]()
+	dependencies := addrs.MakeSet[addrs.ConfigResource]()
+	for addr := range planned.All() {
+		plannedResources.Add(addr.ConfigResource())
+		for _, state := range []*states.State{plan.PrevRunState, plan.PriorState} {
+			for _, dep := range resourceInstanceDependencies(state.ResourceInstance(addr)) {
+				dependencies.Add(dep)
+			}
+		}
+	}
+	contributors := addrs.MakeSet[addrs.AbsResourceInstance]()
+	for _, attr := range plan.RelevantAttributes {
+		contributors.Add(attr.Resource)
+	}
+
+	changed := staleResourceInstances(plan.PrevRunState, current)
+
+	var conflicts []string
+	for _, addr := range changed {
+		switch {
+		case planned.Has(addr):
+			conflicts = append(conflicts, fmt.Sprintf("%s, which the plan changes", addr))
+		case dependencies.Has(addr.ConfigResource()) || contributors.Has(addr):
+			conflicts = append(conflicts, fmt.Sprintf("%s, which the plan's changes depend on", addr))
+		default:
+			for _, dep := range resourceInstanceDependencies(current.ResourceInstance(addr)) {
+				if plannedResources.Has(dep) {
+					conflicts = append(conflicts, fmt.Sprintf("%s, which depends on %s that the plan changes", addr, dep))
+					break
+				}
+			}
+		}
+	}
+	if len(conflicts) > 0 {
+		sort.Strings(conflicts)
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Saved plan conflicts with the current state",
+			fmt.Sprintf("The given plan file can not be applied because another operation changed the following resource instances after the plan was created:\n  - %s\n\nCreate a new plan from the current state.", strings.Join(conflicts, "\n  - ")),
+		))
+		return nil, diags
+	}
+
+	rebased := plan.PriorState.DeepCopy()
+	ss := rebased.SyncWrapper()
+	for _, addr := range changed {
+		inst := current.ResourceInstance(addr)
+		if inst == nil {
+			ss.ForgetResourceInstanceAll(addr)
+			ss.RemoveResourceIfEmpty(addr.ContainingResource())
+			continue
+		}
+		ss.SetResourceInstance(addr, inst, current.Resource(addr.ContainingResource()).ProviderConfig)
+	}
+	return rebased, diags
+}
+
+// resourceInstanceDependencies returns the dependencies recorded for all of
+// the objects of the given resource instance, which may be nil.
+func resourceInstanceDependencies(inst *states.ResourceInstance) []addrs.ConfigResource {
+	if inst == nil {
+		return nil
+	}
+	var ret []addrs.ConfigResource
+	if inst.Current != nil {
+		ret = append(ret, inst.Current.Dependencies...)
+	}
+	for _, obj := range inst.Deposed {
+		ret = append(ret, obj.Dependencies...)
+	}
+	return ret
+}
+
+// staleResourceInstances returns the addresses of all of the resource
+// instances whose objects differ between the two given states, including
+// instances that are present in only one of them.
+func staleResourceInstances(before, after *states.State) []addrs.AbsResourceInstance {
+	seen := addrs.MakeSet[addrs.AbsResourceInstance]()
+	var ret []addrs.AbsResourceInstance
+	for _, state := range []*states.State{before, after} {
+		for _, ms := range state.Modules {
+			for _, rs := range ms.Resources {
+				for key := range rs.Instances {
+					addr := rs.Addr.Instance(key)
+					if seen.Has(addr) {
+						continue
+					}
+					seen.Add(addr)
+					if !before.ResourceInstance(addr).Equal(after.ResourceInstance(addr)) {
+						ret = append(ret, addr)
+					}
+				}
+			}
+		}
+	}
+	return ret
+}
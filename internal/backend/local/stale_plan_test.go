@@ -0,0 +1,115 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package local
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/lang/globalref"
+	"github.com/opentofu/opentofu/internal/plans"
+	"github.com/opentofu/opentofu/internal/states"
+)
+
+func TestRebaseStalePlan(t *testing.T) {
+	providerAddr := mustProviderConfig(`provider["registry.opentofu.org/hashicorp/test"]`)
+	// object returns a resource instance object that depends on the given
+	// resources.
+	object := func(id string, deps ...string) *states.ResourceInstanceObjectSrc {
+		obj := &states.ResourceInstanceObjectSrc{
+			Status:    states.ObjectReady,
+			AttrsJSON: []byte(`{"id":"` + id + `"}`),
+		}
+		for _, dep := range deps {
+			obj.Dependencies = append(obj.Dependencies, mustResourceInstanceAddr(dep).ConfigResource())
+		}
+		return obj
+	}
+
+	// The plan updates test_instance.web, which depends on
+	// test_instance.network, and creates test_instance.lb, whose
+	// configuration refers to test_instance.cert.
+	prevRunState := states.BuildState(func(s *states.SyncState) {
+		s.SetResourceInstanceCurrent(mustResourceInstanceAddr("test_instance.network"), object("network"), providerAddr, addrs.NoKey)
+		s.SetResourceInstanceCurrent(mustResourceInstanceAddr("test_instance.web"), object("web", "test_instance.network"), providerAddr, addrs.NoKey)
+		s.SetResourceInstanceCurrent(mustResourceInstanceAddr("test_instance.cert"), object("cert"), providerAddr, addrs.NoKey)
+	})
+	plan := &plans.Plan{
+		Changes:      plans.NewChanges(),
+		PrevRunState: prevRunState,
+		PriorState:   prevRunState.DeepCopy(),
+		RelevantAttributes: []globalref.ResourceAttr{
+			{Resource: mustResourceInstanceAddr("test_instance.cert")},
+		},
+	}
+	for addr, action := range map[string]plans.Action{
+		"test_instance.web":     plans.Update,
+		"test_instance.lb":      plans.Create,
+		"test_instance.network": plans.NoOp,
+	} {
+		plan.Changes.Resources = append(plan.Changes.Resources, &plans.ResourceInstanceChangeSrc{
+			Addr:         mustResourceInstanceAddr(addr),
+			PrevRunAddr:  mustResourceInstanceAddr(addr),
+			ProviderAddr: providerAddr,
+			ChangeSrc:    plans.ChangeSrc{Action: action},
+		})
+	}
+
+	tests := map[string]struct {
+		// addr is the resource instance that another operation changes
+		// after the plan was created.
+		addr    string
+		deps    []string
+		wantErr string
+	}{
+		"unrelated": {
+			addr: "test_instance.other",
+		},
+		"changed by the plan": {
+			addr:    "test_instance.web",
+			wantErr: "test_instance.web, which the plan changes",
+		},
+		"dependency recorded in state": {
+			addr:    "test_instance.network",
+			wantErr: "test_instance.network, which the plan's changes depend on",
+		},
+		"contributes to a created object": {
+			addr:    "test_instance.cert",
+			wantErr: "test_instance.cert, which the plan's changes depend on",
+		},
+		"depends on a changed resource": {
+			addr:    "test_instance.dns",
+			deps:    []string{"test_instance.lb"},
+			wantErr: "test_instance.dns, which depends on test_instance.lb that the plan changes",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			current := prevRunState.DeepCopy()
+			addr := mustResourceInstanceAddr(test.addr)
+			current.SyncWrapper().SetResourceInstanceCurrent(addr, object("other", test.deps...), providerAddr, addrs.NoKey)
+
+			rebased, diags := rebaseStalePlan(plan, current)
+			if test.wantErr != "" {
+				if !diags.HasErrors() {
+					t.Fatal("unexpected success")
+				}
+				if got := diags.Err().Error(); !strings.Contains(got, test.wantErr) {
+					t.Fatalf("wrong error\ngot:  %s\nwant: message containing %q", got, test.wantErr)
+				}
+				return
+			}
+			if diags.HasErrors() {
+				t.Fatal(diags.Err())
+			}
+			if rebased.ResourceInstance(addr) == nil {
+				t.Errorf("rebased state is missing %s", addr)
+			}
+		})
+	}
+}
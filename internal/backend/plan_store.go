@@ -0,0 +1,61 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package backend
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	// ErrPlanStoreNotSupported is returned by a PlanStore implementation
+	// that wraps another backend when the wrapped backend can't store plans.
+	ErrPlanStoreNotSupported = errors.New("storing plans is not supported by this backend")
+
+	// ErrStoredPlanNotFound is returned by PlanStore.StoredPlan when there is
+	// no stored plan with the given ID in the given workspace.
+	ErrStoredPlanNotFound = errors.New("stored plan not found")
+)
+
+// PlanStore is an optional interface implemented by backends that can store
+// saved plan files alongside the state snapshots of each workspace, so that a
+// plan created by one "tofu plan -store" run can be reviewed and applied by a
+// later run without passing the plan file around separately.
+//
+// The stored plan files are opaque to the backend and are saved exactly as
+// given, so any encryption configured for plans also applies to them.
+type PlanStore interface {
+	// StorePlan saves the given plan file in the given workspace with the
+	// given metadata, replacing any existing plan with the same ID.
+	StorePlan(ctx context.Context, workspace string, meta StoredPlanMeta, plan []byte) error
+
+	// StoredPlans returns the metadata of all of the plans stored in the
+	// given workspace, ordered by the time they were created.
+	StoredPlans(ctx context.Context, workspace string) ([]StoredPlanMeta, error)
+
+	// StoredPlan returns the metadata and content of the plan with the given
+	// ID in the given workspace, or ErrStoredPlanNotFound if there is no such
+	// plan.
+	StoredPlan(ctx context.Context, workspace string, id string) (StoredPlanMeta, []byte, error)
+}
+
+// StoredPlanMeta describes a plan file saved in a PlanStore.
+type StoredPlanMeta struct {
+	// ID is the identifier used to retrieve the plan, which is unique within
+	// each workspace.
+	ID string `json:"id"`
+
+	// CreatedBy is the name of the user who created the plan.
+	CreatedBy string `json:"created_by"`
+
+	// CreatedAt is the time at which the plan was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// ConfigHash is a hash of the configuration the plan was created from,
+	// which can be used to check whether the configuration has changed since.
+	ConfigHash string `json:"config_hash"`
+}
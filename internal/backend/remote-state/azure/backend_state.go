@@ -73,7 +73,7 @@ func (b *Backend) StateMgr(_ context.Context, name string) (statemgr.Full, error
 		cpkScopeInfo: b.cpkScopeInfo,
 	}
 
-	stateMgr := remote.NewState(client, b.encryption)
+	stateMgr := remote.NewState(client, b.encryption.ForWorkspace(name))
 
 	// Grab the value
 	if err := stateMgr.RefreshState(context.TODO()); err != nil {
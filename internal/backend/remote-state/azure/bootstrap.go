@@ -0,0 +1,77 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azure
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+
+	"github.com/opentofu/opentofu/internal/backend"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+const bootstrapTypeContainer = "Azure Storage container"
+
+var _ backend.Bootstrapper = (*Backend)(nil)
+
+// MissingResources returns the storage container when it doesn't exist.
+// The backend locks the state with a lease on its blob, so it doesn't need
+// any other resources. The storage account itself must already exist.
+func (b *Backend) MissingResources(ctx context.Context) ([]backend.BootstrapResource, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+	ctx, cancel := b.getContextWithTimeout(ctx)
+	defer cancel()
+
+	_, err := b.containerClient.GetProperties(ctx, nil)
+	switch {
+	case notFoundError(err):
+		return []backend.BootstrapResource{
+			{
+				Type: bootstrapTypeContainer,
+				Name: b.containerName,
+				Permissions: []string{
+					"Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read",
+					"Microsoft.Storage/storageAccounts/blobServices/containers/blobs/write",
+					"Microsoft.Storage/storageAccounts/blobServices/containers/blobs/delete",
+				},
+			},
+		}, diags
+	case err != nil:
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to check for the Azure Storage container",
+			fmt.Sprintf("OpenTofu could not check whether the container %q exists: %s", b.containerName, err),
+		))
+	}
+	return nil, diags
+}
+
+// CreateResources creates the storage container, without public access. It
+// isn't an error if the container already exists, because another process
+// might have created it since MissingResources checked for it.
+func (b *Backend) CreateResources(ctx context.Context, resources []backend.BootstrapResource) tfdiags.Diagnostics {
+	var diags tfdiags.Diagnostics
+	ctx, cancel := b.getContextWithTimeout(ctx)
+	defer cancel()
+
+	for _, resource := range resources {
+		if resource.Type != bootstrapTypeContainer {
+			continue
+		}
+		_, err := b.containerClient.Create(ctx, nil)
+		if err != nil && !bloberror.HasCode(err, bloberror.ContainerAlreadyExists) {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Failed to create Azure Storage container",
+				fmt.Sprintf("OpenTofu could not create the container %q: %s", b.containerName, err),
+			))
+			return diags
+		}
+	}
+	return diags
+}
@@ -0,0 +1,131 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azure
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+	"github.com/google/go-cmp/cmp"
+)
+
+// fakeContainerService is a minimal Azure Blob Storage endpoint that only
+// implements the container requests made while bootstrapping.
+type fakeContainerService struct {
+	mu       sync.Mutex
+	exists   bool
+	requests []string
+}
+
+func (s *fakeContainerService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+	if r.URL.Query().Get("restype") != "container" {
+		w.WriteHeader(http.StatusNotImplemented)
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		if !s.exists {
+			w.Header().Set("x-ms-error-code", "ContainerNotFound")
+			w.WriteHeader(http.StatusNotFound)
+		}
+	case http.MethodPut:
+		if s.exists {
+			w.Header().Set("x-ms-error-code", "ContainerAlreadyExists")
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		s.exists = true
+		w.WriteHeader(http.StatusCreated)
+	default:
+		w.WriteHeader(http.StatusNotImplemented)
+	}
+}
+
+func testBootstrapBackend(t *testing.T, svc *fakeContainerService) *Backend {
+	t.Helper()
+
+	srv := httptest.NewServer(svc)
+	t.Cleanup(srv.Close)
+
+	client, err := container.NewClientWithNoCredential(srv.URL+"/tofu-state", &container.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Retry: policy.RetryOptions{MaxRetries: -1},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Backend{
+		containerClient: client,
+		containerName:   "tofu-state",
+		timeout:         time.Minute,
+	}
+}
+
+func TestBackendBootstrap(t *testing.T) {
+	svc := &fakeContainerService{}
+	b := testBootstrapBackend(t, svc)
+
+	missing, diags := b.MissingResources(t.Context())
+	if diags.HasErrors() {
+		t.Fatal(diags.Err())
+	}
+	if len(missing) != 1 || missing[0].Type != "Azure Storage container" || missing[0].Name != "tofu-state" {
+		t.Fatalf("wrong missing resources: %#v", missing)
+	}
+
+	svc.requests = nil
+	if diags := b.CreateResources(t.Context(), missing); diags.HasErrors() {
+		t.Fatal(diags.Err())
+	}
+	if diff := cmp.Diff([]string{"PUT /tofu-state"}, svc.requests); diff != "" {
+		t.Errorf("wrong requests\n%s", diff)
+	}
+
+	missing, diags = b.MissingResources(t.Context())
+	if diags.HasErrors() {
+		t.Fatal(diags.Err())
+	}
+	if len(missing) != 0 {
+		t.Errorf("unexpected missing resources after bootstrapping: %#v", missing)
+	}
+}
+
+func TestBackendBootstrap_alreadyExists(t *testing.T) {
+	svc := &fakeContainerService{}
+	b := testBootstrapBackend(t, svc)
+
+	missing, diags := b.MissingResources(t.Context())
+	if diags.HasErrors() {
+		t.Fatal(diags.Err())
+	}
+
+	// Another process creates the container after we checked for it, so
+	// creating it again must succeed without changing anything.
+	svc.exists = true
+	if diags := b.CreateResources(t.Context(), missing); diags.HasErrors() {
+		t.Fatal(diags.Err())
+	}
+
+	// Once the container exists, there is nothing left to create.
+	missing, diags = b.MissingResources(t.Context())
+	if diags.HasErrors() {
+		t.Fatal(diags.Err())
+	}
+	if len(missing) != 0 {
+		t.Errorf("unexpected missing resources: %#v", missing)
+	}
+}
@@ -84,7 +84,7 @@ func (b *Backend) StateMgr(_ context.Context, name string) (statemgr.Full, error
 			GZip:      gzip,
 			lockState: b.lock,
 		},
-		b.encryption,
+		b.encryption.ForWorkspace(name),
 	)
 
 	if !b.lock {
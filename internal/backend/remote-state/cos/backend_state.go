@@ -86,7 +86,7 @@ func (b *Backend) StateMgr(ctx context.Context, name string) (statemgr.Full, err
 	if err != nil {
 		return nil, err
 	}
-	stateMgr := remote.NewState(c, b.encryption)
+	stateMgr := remote.NewState(c, b.encryption.ForWorkspace(name))
 
 	ws, err := b.Workspaces(ctx)
 	if err != nil {
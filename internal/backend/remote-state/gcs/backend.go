@@ -39,6 +39,11 @@ type Backend struct {
 
 	encryptionKey []byte
 	kmsKeyName    string
+
+	// project and location are used only to create the bucket when
+	// bootstrapping the backend.
+	project  string
+	location string
 }
 
 func New(enc encryption.StateEncryption) backend.Backend {
@@ -126,6 +131,23 @@ func New(enc encryption.StateEncryption) backend.Backend {
 					"GOOGLE_BACKEND_UNIVERSE_DOMAIN",
 				}, nil),
 			},
+
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+					"GOOGLE_PROJECT",
+					"GOOGLE_CLOUD_PROJECT",
+					"CLOUDSDK_CORE_PROJECT",
+				}, nil),
+				Description: "The project in which to create the bucket when bootstrapping the backend",
+			},
+
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The location in which to create the bucket when bootstrapping the backend",
+			},
 		},
 	}
 
@@ -140,6 +162,8 @@ func (b *Backend) configure(ctx context.Context) error {
 	data := schema.FromContextBackendConfig(ctx)
 
 	b.bucketName = data.Get("bucket").(string)
+	b.project = data.Get("project").(string)
+	b.location = data.Get("location").(string)
 	b.prefix = strings.TrimLeft(data.Get("prefix").(string), "/")
 	if b.prefix != "" && !strings.HasSuffix(b.prefix, "/") {
 		b.prefix = b.prefix + "/"
@@ -98,7 +98,7 @@ func (b *Backend) StateMgr(ctx context.Context, name string) (statemgr.Full, err
 		return nil, err
 	}
 
-	st := remote.NewState(c, b.encryption)
+	st := remote.NewState(c, b.encryption.ForWorkspace(name))
 
 	// Grab the value
 	if err := st.RefreshState(ctx); err != nil {
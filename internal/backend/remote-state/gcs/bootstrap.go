@@ -0,0 +1,109 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package gcs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"cloud.google.com/go/storage"
+	"github.com/opentofu/opentofu/internal/backend"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+	"github.com/zclconf/go-cty/cty"
+	"google.golang.org/api/googleapi"
+)
+
+const bootstrapTypeBucket = "Cloud Storage bucket"
+
+var _ backend.Bootstrapper = (*Backend)(nil)
+
+// MissingResources returns the bucket when it doesn't exist. The backend
+// locks the state with a lock file in the same bucket, so it doesn't need
+// any other resources.
+func (b *Backend) MissingResources(ctx context.Context) ([]backend.BootstrapResource, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	_, err := b.storageClient.Bucket(b.bucketName).Attrs(ctx)
+	switch {
+	case errors.Is(err, storage.ErrBucketNotExist):
+	case err != nil:
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to check for the Cloud Storage bucket",
+			fmt.Sprintf("OpenTofu could not check whether the bucket %q exists: %s", b.bucketName, err),
+		))
+		return nil, diags
+	default:
+		return nil, diags
+	}
+
+	if b.project == "" {
+		diags = diags.Append(tfdiags.AttributeValue(
+			tfdiags.Error,
+			"Missing project for the Cloud Storage bucket",
+			fmt.Sprintf("The bucket %q doesn't exist, and OpenTofu needs to know the project in which to create it. Set the \"project\" argument in the backend configuration, or the GOOGLE_PROJECT environment variable.", b.bucketName),
+			cty.GetAttrPath("project"),
+		))
+		return nil, diags
+	}
+
+	return []backend.BootstrapResource{
+		{
+			Type:        bootstrapTypeBucket,
+			Name:        b.bucketName,
+			Permissions: []string{"storage.objects.list", "storage.objects.get", "storage.objects.create", "storage.objects.delete"},
+		},
+	}, diags
+}
+
+// CreateResources creates the bucket in the configured project and location.
+//
+// The bucket has versioning enabled, so that earlier state snapshots can be
+// recovered, uses uniform bucket-level access and prevents public access. If
+// the backend is configured with a Cloud KMS key, the bucket uses it as its
+// default encryption key.
+//
+// It isn't an error if the bucket already exists in the project, because
+// another process might have created it since MissingResources checked for it.
+func (b *Backend) CreateResources(ctx context.Context, resources []backend.BootstrapResource) tfdiags.Diagnostics {
+	var diags tfdiags.Diagnostics
+
+	for _, resource := range resources {
+		if resource.Type != bootstrapTypeBucket {
+			continue
+		}
+		attrs := &storage.BucketAttrs{
+			Location:          b.location,
+			VersioningEnabled: true,
+			UniformBucketLevelAccess: storage.UniformBucketLevelAccess{
+				Enabled: true,
+			},
+			PublicAccessPrevention: storage.PublicAccessPreventionEnforced,
+		}
+		if b.kmsKeyName != "" {
+			attrs.Encryption = &storage.BucketEncryption{DefaultKMSKeyName: b.kmsKeyName}
+		}
+		err := b.storageClient.Bucket(b.bucketName).Create(ctx, b.project, attrs)
+		if err != nil && !bucketAlreadyExists(err) {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Failed to create Cloud Storage bucket",
+				fmt.Sprintf("OpenTofu could not create the bucket %q in project %q: %s", b.bucketName, b.project, err),
+			))
+			return diags
+		}
+	}
+	return diags
+}
+
+// bucketAlreadyExists returns true if the given error from creating a bucket
+// is because a bucket with the same name already exists.
+func bucketAlreadyExists(err error) bool {
+	var apiErr *googleapi.Error
+	return errors.As(err, &apiErr) && apiErr.Code == http.StatusConflict
+}
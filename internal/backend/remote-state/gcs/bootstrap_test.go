@@ -0,0 +1,126 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package gcs
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"cloud.google.com/go/storage"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/option"
+)
+
+// fakeBucketService is a minimal Cloud Storage JSON API endpoint that only
+// implements the bucket requests made while bootstrapping.
+type fakeBucketService struct {
+	mu       sync.Mutex
+	exists   bool
+	requests []string
+}
+
+func (s *fakeBucketService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+	w.Header().Set("Content-Type", "application/json")
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/storage/v1/b/tofu-state":
+		if !s.exists {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = io.WriteString(w, `{"error":{"code":404,"message":"The specified bucket does not exist."}}`)
+			return
+		}
+		_, _ = io.WriteString(w, `{"name":"tofu-state"}`)
+	case r.Method == http.MethodPost && r.URL.Path == "/storage/v1/b":
+		if s.exists {
+			w.WriteHeader(http.StatusConflict)
+			_, _ = io.WriteString(w, `{"error":{"code":409,"message":"Your previous request to create the named bucket succeeded and you already own it."}}`)
+			return
+		}
+		s.exists = true
+		_, _ = io.WriteString(w, `{"name":"tofu-state"}`)
+	default:
+		w.WriteHeader(http.StatusNotImplemented)
+	}
+}
+
+func testBootstrapBackend(t *testing.T, svc *fakeBucketService) *Backend {
+	t.Helper()
+
+	srv := httptest.NewServer(svc)
+	t.Cleanup(srv.Close)
+
+	client, err := storage.NewClient(t.Context(), option.WithEndpoint(srv.URL+"/storage/v1/"), option.WithoutAuthentication())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+	return &Backend{
+		storageClient: client,
+		bucketName:    "tofu-state",
+		project:       "tofu-project",
+	}
+}
+
+func TestBackendBootstrap(t *testing.T) {
+	svc := &fakeBucketService{}
+	b := testBootstrapBackend(t, svc)
+
+	missing, diags := b.MissingResources(t.Context())
+	if diags.HasErrors() {
+		t.Fatal(diags.Err())
+	}
+	if len(missing) != 1 || missing[0].Type != "Cloud Storage bucket" || missing[0].Name != "tofu-state" {
+		t.Fatalf("wrong missing resources: %#v", missing)
+	}
+
+	svc.requests = nil
+	if diags := b.CreateResources(t.Context(), missing); diags.HasErrors() {
+		t.Fatal(diags.Err())
+	}
+	if diff := cmp.Diff([]string{"POST /storage/v1/b"}, svc.requests); diff != "" {
+		t.Errorf("wrong requests\n%s", diff)
+	}
+
+	missing, diags = b.MissingResources(t.Context())
+	if diags.HasErrors() {
+		t.Fatal(diags.Err())
+	}
+	if len(missing) != 0 {
+		t.Errorf("unexpected missing resources after bootstrapping: %#v", missing)
+	}
+}
+
+func TestBackendBootstrap_alreadyExists(t *testing.T) {
+	svc := &fakeBucketService{}
+	b := testBootstrapBackend(t, svc)
+
+	missing, diags := b.MissingResources(t.Context())
+	if diags.HasErrors() {
+		t.Fatal(diags.Err())
+	}
+
+	// Another process creates the bucket after we checked for it, so
+	// creating it again must succeed without changing anything.
+	svc.exists = true
+	if diags := b.CreateResources(t.Context(), missing); diags.HasErrors() {
+		t.Fatal(diags.Err())
+	}
+
+	// Once the bucket exists, there is nothing left to create.
+	missing, diags = b.MissingResources(t.Context())
+	if diags.HasErrors() {
+		t.Fatal(diags.Err())
+	}
+	if len(missing) != 0 {
+		t.Errorf("unexpected missing resources: %#v", missing)
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package gcs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/iterator"
+
+	"github.com/opentofu/opentofu/internal/states/remote"
+)
+
+// Versions returns the generations of the state file, starting with the
+// latest one. A bucket without object versioning only keeps the live
+// generation of the state file.
+func (c *remoteClient) Versions(ctx context.Context) ([]string, error) {
+	bucket := c.storageClient.Bucket(c.bucketName)
+	objs := bucket.Objects(ctx, &storage.Query{
+		Prefix:   c.stateFilePath,
+		Versions: true,
+	})
+	var generations []int64
+	for {
+		attrs, err := objs.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("querying Cloud Storage failed: %w", err)
+		}
+		// The prefix can also match other objects, such as the lock file.
+		if attrs.Name == c.stateFilePath {
+			generations = append(generations, attrs.Generation)
+		}
+	}
+
+	sort.Slice(generations, func(i, j int) bool {
+		return generations[i] > generations[j]
+	})
+	ids := make([]string, len(generations))
+	for i, generation := range generations {
+		ids[i] = strconv.FormatInt(generation, 10)
+	}
+	return ids, nil
+}
+
+// GetVersion returns the given generation of the state file.
+func (c *remoteClient) GetVersion(ctx context.Context, id string) (*remote.Payload, error) {
+	generation, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid state file generation %q: %w", id, err)
+	}
+	obj := c.stateFile().Generation(generation)
+
+	reader, err := obj.NewReader(ctx)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("Failed to open generation %d of state file at %v: %w", generation, c.stateFileURL(), err)
+	}
+	defer reader.Close()
+
+	contents, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read generation %d of state file from %v: %w", generation, c.stateFileURL(), err)
+	}
+
+	attrs, err := obj.Attrs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read generation %d of state file attrs from %v: %w", generation, c.stateFileURL(), err)
+	}
+
+	return &remote.Payload{
+		Data: contents,
+		MD5:  attrs.MD5,
+	}, nil
+}
@@ -0,0 +1,170 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package git
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/opentofu/opentofu/internal/backend"
+	"github.com/opentofu/opentofu/internal/encryption"
+	"github.com/opentofu/opentofu/internal/legacy/helper/schema"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// userCacheDir returns the directory under which the local copies of the
+// state repositories are kept. Tests override it to avoid writing to the
+// cache directory of the user running them.
+var userCacheDir = os.UserCacheDir
+
+// New creates a new backend for Git repository remote state.
+func New(enc encryption.StateEncryption) backend.Backend {
+	s := &schema.Backend{
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The URL of the Git repository to store the state in, or the path of a local repository",
+				DefaultFunc: schema.EnvDefaultFunc("TF_GIT_REPOSITORY", nil),
+			},
+
+			"branch": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The dedicated branch that stores the state",
+				DefaultFunc: schema.EnvDefaultFunc("TF_GIT_BRANCH", "tfstate"),
+			},
+
+			"path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The path of the state file in the branch",
+				Default:     "terraform.tfstate",
+			},
+
+			"workspace_dir": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The directory of the branch that contains the states of the workspaces other than the default workspace",
+				Default:     "env",
+			},
+
+			"author_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The author name of the state commits",
+				DefaultFunc: schema.EnvDefaultFunc("TF_GIT_AUTHOR_NAME", "OpenTofu"),
+			},
+
+			"author_email": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The author email address of the state commits",
+				DefaultFunc: schema.EnvDefaultFunc("TF_GIT_AUTHOR_EMAIL", "opentofu@localhost"),
+			},
+		},
+	}
+
+	result := &Backend{Backend: s, encryption: enc}
+	result.Backend.ConfigureFunc = result.configure
+	return result
+}
+
+type Backend struct {
+	*schema.Backend
+	encryption encryption.StateEncryption
+
+	// The fields below are set from configure
+	repo         *repository
+	branch       string
+	path         string
+	workspaceDir string
+}
+
+// PrepareConfig validates the configuration and warns that the backend is
+// experimental.
+func (b *Backend) PrepareConfig(obj cty.Value) (cty.Value, tfdiags.Diagnostics) {
+	obj, diags := b.Backend.PrepareConfig(obj)
+	if diags.HasErrors() {
+		return obj, diags
+	}
+	return obj, diags.Append(tfdiags.Sourceless(
+		tfdiags.Warning,
+		"Experimental backend",
+		`The "git" backend is experimental. The way it stores the state and the locks in the repository may change in future versions of OpenTofu.
+
+Each state update is kept in the history of the repository, so we recommend configuring state encryption to avoid storing sensitive values in plain text.`,
+	))
+}
+
+func (b *Backend) configure(ctx context.Context) error {
+	data := schema.FromContextBackendConfig(ctx)
+
+	url := data.Get("repository").(string)
+	b.branch = data.Get("branch").(string)
+	b.path = strings.Trim(data.Get("path").(string), "/")
+	b.workspaceDir = strings.Trim(data.Get("workspace_dir").(string), "/")
+
+	if url == "" || strings.HasPrefix(url, "-") {
+		return fmt.Errorf("invalid repository %q", url)
+	}
+	if b.path == "" {
+		return fmt.Errorf("path must not be empty")
+	}
+	if b.workspaceDir == "" {
+		return fmt.Errorf("workspace_dir must not be empty")
+	}
+
+	cacheDir, err := userCacheDir()
+	if err != nil {
+		return fmt.Errorf("failed to find the cache directory for the state repository: %w", err)
+	}
+	// Each repository gets its own local copy, which only holds the objects
+	// that were fetched from or pushed to it.
+	sum := sha256.Sum256([]byte(url))
+	dir := filepath.Join(cacheDir, "opentofu", "git-backend", hex.EncodeToString(sum[:8]))
+
+	b.repo = &repository{
+		url:         url,
+		dir:         dir,
+		authorName:  data.Get("author_name").(string),
+		authorEmail: data.Get("author_email").(string),
+	}
+	if err := b.repo.init(ctx); err != nil {
+		return err
+	}
+	if err := b.repo.checkRefName(ctx, b.branchRef()); err != nil {
+		return fmt.Errorf("invalid branch %q: %w", b.branch, err)
+	}
+	return nil
+}
+
+// branchRef returns the full name of the branch that stores the state.
+func (b *Backend) branchRef() string {
+	return "refs/heads/" + b.branch
+}
+
+// lockRef returns the full name of the branch whose existence marks the
+// state of the given workspace as locked.
+func (b *Backend) lockRef(name string) string {
+	return fmt.Sprintf("refs/heads/%s-locks/%s", b.branch, name)
+}
+
+// statePath returns the path of the state file of the given workspace in
+// the state branch.
+func (b *Backend) statePath(name string) string {
+	if name == backend.DefaultStateName {
+		return b.path
+	}
+	return b.workspaceDir + "/" + name + "/" + b.path
+}
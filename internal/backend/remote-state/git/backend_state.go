@@ -0,0 +1,147 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package git
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/opentofu/opentofu/internal/backend"
+	"github.com/opentofu/opentofu/internal/states"
+	"github.com/opentofu/opentofu/internal/states/remote"
+	"github.com/opentofu/opentofu/internal/states/statemgr"
+)
+
+func (b *Backend) Workspaces(ctx context.Context) ([]string, error) {
+	result := []string{backend.DefaultStateName}
+
+	commit, err := b.repo.resolve(ctx, b.branchRef())
+	if err != nil || commit == "" {
+		return result, err
+	}
+	files, err := b.repo.listFiles(ctx, commit)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := b.workspaceDir + "/"
+	suffix := "/" + b.path
+	for _, file := range files {
+		if !strings.HasPrefix(file, prefix) || !strings.HasSuffix(file, suffix) {
+			continue
+		}
+		name := strings.TrimSuffix(strings.TrimPrefix(file, prefix), suffix)
+		if name == "" || strings.Contains(name, "/") {
+			// Not the state of a workspace, but a file in a nested
+			// directory that happens to have the same name.
+			continue
+		}
+		result = append(result, name)
+	}
+
+	sort.Strings(result[1:])
+	return result, nil
+}
+
+func (b *Backend) DeleteWorkspace(ctx context.Context, name string, _ bool) error {
+	if name == backend.DefaultStateName || name == "" {
+		return fmt.Errorf("can't delete default state")
+	}
+
+	client, err := b.remoteClient(name)
+	if err != nil {
+		return err
+	}
+	return client.Delete(ctx)
+}
+
+// get a remote client configured for this state
+func (b *Backend) remoteClient(name string) (*RemoteClient, error) {
+	if name == "" {
+		return nil, errors.New("missing state name")
+	}
+
+	return &RemoteClient{
+		repo:      b.repo,
+		branchRef: b.branchRef(),
+		lockRef:   b.lockRef(name),
+		path:      b.statePath(name),
+		workspace: name,
+	}, nil
+}
+
+func (b *Backend) StateMgr(ctx context.Context, name string) (statemgr.Full, error) {
+	client, err := b.remoteClient(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := b.repo.checkRefName(ctx, client.lockRef); err != nil {
+		return nil, fmt.Errorf("the workspace name %q can't be used with the git backend: %w", name, err)
+	}
+
+	stateMgr := remote.NewState(client, b.encryption.ForWorkspace(name))
+
+	// Check to see if this state already exists.
+	// If the state doesn't exist, we have to assume this
+	// is a normal create operation, and take the lock at that point.
+	existing, err := b.Workspaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	exists := false
+	for _, s := range existing {
+		if s == name {
+			exists = true
+			break
+		}
+	}
+
+	// Grab a lock, we use this to write an empty state if one doesn't
+	// exist already. We have to write an empty state as a sentinel value
+	// so Workspaces() knows it exists.
+	if !exists {
+		lockInfo := statemgr.NewLockInfo()
+		lockInfo.Operation = "init"
+		lockId, err := stateMgr.Lock(ctx, lockInfo)
+		if err != nil {
+			return nil, fmt.Errorf("failed to lock state in the Git repository: %w", err)
+		}
+
+		// Local helper function so we can call it multiple places
+		lockUnlock := func(parent error) error {
+			if err := stateMgr.Unlock(ctx, lockId); err != nil {
+				return fmt.Errorf("error unlocking Git repository state: %w", err)
+			}
+			return parent
+		}
+
+		if err := stateMgr.RefreshState(ctx); err != nil {
+			err = lockUnlock(err)
+			return nil, err
+		}
+		if v := stateMgr.State(); v == nil {
+			if err := stateMgr.WriteState(states.NewState()); err != nil {
+				err = lockUnlock(err)
+				return nil, err
+			}
+			if err := stateMgr.PersistState(ctx, nil); err != nil {
+				err = lockUnlock(err)
+				return nil, err
+			}
+		}
+
+		// Unlock, the state should now be initialized
+		if err := lockUnlock(nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return stateMgr, nil
+}
@@ -0,0 +1,180 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package git
+
+import (
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/hcl/v2/hcldec"
+
+	"github.com/opentofu/opentofu/internal/backend"
+	"github.com/opentofu/opentofu/internal/encryption"
+	"github.com/opentofu/opentofu/internal/states/remote"
+)
+
+// testRepository creates an empty bare repository to store the state in,
+// and makes the backends use a temporary cache directory.
+func testRepository(t *testing.T) string {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("the git command line tool is not available")
+	}
+
+	cacheDir := t.TempDir()
+	oldCacheDir := userCacheDir
+	userCacheDir = func() (string, error) { return cacheDir, nil }
+	t.Cleanup(func() { userCacheDir = oldCacheDir })
+
+	dir := filepath.Join(t.TempDir(), "state.git")
+	if out, err := exec.Command("git", "init", "--quiet", "--bare", dir).CombinedOutput(); err != nil {
+		t.Fatalf("failed to create the repository: %s\n%s", err, out)
+	}
+	return dir
+}
+
+func testBackend(t *testing.T, config map[string]any) *Backend {
+	t.Helper()
+	return backend.TestBackendConfig(t, New(encryption.StateEncryptionDisabled()), backend.TestWrapConfig(config)).(*Backend)
+}
+
+func TestBackend_impl(t *testing.T) {
+	var _ backend.Backend = new(Backend)
+}
+
+func TestBackend(t *testing.T) {
+	config := map[string]any{
+		"repository": testRepository(t),
+	}
+
+	b1 := testBackend(t, config)
+	b2 := testBackend(t, config)
+
+	backend.TestBackendStates(t, b1)
+	backend.TestBackendStateLocks(t, b1, b2)
+	backend.TestBackendStateForceUnlock(t, b1, b2)
+}
+
+func TestBackendHistory(t *testing.T) {
+	repo := testRepository(t)
+	b := testBackend(t, map[string]any{
+		"repository":    repo,
+		"branch":        "infra/state",
+		"path":          "prod/terraform.tfstate",
+		"workspace_dir": "workspaces",
+		"author_name":   "Tofu Test",
+		"author_email":  "tofu@example.com",
+	})
+
+	for _, name := range []string{"default", "staging"} {
+		s, err := b.StateMgr(t.Context(), name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		client := s.(*remote.State).Client
+		for _, data := range []string{`{"version":4,"serial":1}`, `{"version":4,"serial":2}`} {
+			if err := client.Put(t.Context(), []byte(data)); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	workspaces, err := b.Workspaces(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"default", "staging"}, workspaces); diff != "" {
+		t.Errorf("wrong workspaces\n%s", diff)
+	}
+
+	// Each state version is a commit of the state branch.
+	out, err := exec.Command("git", "--git-dir", repo, "log", "--format=%an <%ae> %s", "infra/state").CombinedOutput()
+	if err != nil {
+		t.Fatalf("git log failed: %s\n%s", err, out)
+	}
+	wantLog := `Tofu Test <tofu@example.com> Update the state of workspace "staging"
+Tofu Test <tofu@example.com> Update the state of workspace "staging"
+Tofu Test <tofu@example.com> Update the state of workspace "staging"
+Tofu Test <tofu@example.com> Update the state of workspace "default"
+Tofu Test <tofu@example.com> Update the state of workspace "default"
+`
+	if diff := cmp.Diff(wantLog, string(out)); diff != "" {
+		t.Errorf("wrong history\n%s", diff)
+	}
+
+	out, err = exec.Command("git", "--git-dir", repo, "ls-tree", "-r", "--name-only", "infra/state").CombinedOutput()
+	if err != nil {
+		t.Fatalf("git ls-tree failed: %s\n%s", err, out)
+	}
+	wantFiles := "prod/terraform.tfstate\nworkspaces/staging/prod/terraform.tfstate\n"
+	if diff := cmp.Diff(wantFiles, string(out)); diff != "" {
+		t.Errorf("wrong files\n%s", diff)
+	}
+
+	// No lock branches are left behind.
+	out, err = exec.Command("git", "--git-dir", repo, "for-each-ref", "--format=%(refname)").CombinedOutput()
+	if err != nil {
+		t.Fatalf("git for-each-ref failed: %s\n%s", err, out)
+	}
+	if diff := cmp.Diff("refs/heads/infra/state\n", string(out)); diff != "" {
+		t.Errorf("wrong refs\n%s", diff)
+	}
+}
+
+func TestBackendConfig_experimental(t *testing.T) {
+	config := map[string]any{
+		"repository": testRepository(t),
+	}
+	_, warns, errs := backend.TestBackendConfigWarningsAndErrors(t, New(encryption.StateEncryptionDisabled()), backend.TestWrapConfig(config))
+	if len(errs) != 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if diff := cmp.Diff([]string{"Experimental backend"}, warns); diff != "" {
+		t.Errorf("wrong warnings\n%s", diff)
+	}
+}
+
+func TestBackendConfig_invalid(t *testing.T) {
+	repo := testRepository(t)
+	tests := map[string]struct {
+		config  map[string]any
+		wantErr string
+	}{
+		"branch": {
+			config:  map[string]any{"repository": repo, "branch": "bad..branch"},
+			wantErr: `invalid branch "bad..branch"`,
+		},
+		"repository": {
+			config:  map[string]any{"repository": "--upload-pack=evil"},
+			wantErr: `invalid repository "--upload-pack=evil"`,
+		},
+		"path": {
+			config:  map[string]any{"repository": repo, "path": "/"},
+			wantErr: "path must not be empty",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			b := New(encryption.StateEncryptionDisabled())
+			obj, decDiags := hcldec.Decode(backend.TestWrapConfig(test.config), b.ConfigSchema().DecoderSpec(), nil)
+			if decDiags.HasErrors() {
+				t.Fatal(decDiags.Error())
+			}
+			obj, diags := b.PrepareConfig(obj)
+			if diags.HasErrors() {
+				t.Fatal(diags.Err())
+			}
+			diags = b.Configure(t.Context(), obj)
+			if !diags.HasErrors() || !strings.Contains(diags.Err().Error(), test.wantErr) {
+				t.Fatalf("wrong errors %v; want %q", diags.Err(), test.wantErr)
+			}
+		})
+	}
+}
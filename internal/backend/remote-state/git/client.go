@@ -0,0 +1,198 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package git
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	uuid "github.com/hashicorp/go-uuid"
+
+	"github.com/opentofu/opentofu/internal/states/remote"
+	"github.com/opentofu/opentofu/internal/states/statemgr"
+)
+
+// maxUpdateAttempts is the number of times that an update of the state
+// branch is attempted when other states in the same branch are updated
+// concurrently.
+const maxUpdateAttempts = 5
+
+// lockFile is the name of the file containing the lock info in the commit
+// that a lock branch points to.
+const lockFile = "lock.json"
+
+// RemoteClient is a remote client that stores the state of a workspace as a
+// file in a branch of a Git repository, with one commit per state version.
+type RemoteClient struct {
+	repo      *repository
+	branchRef string
+	lockRef   string
+	path      string
+	workspace string
+
+	// lockInfo is the info of the lock held by this client, if any, which
+	// is recorded in the messages of the state commits.
+	lockInfo *statemgr.LockInfo
+}
+
+func (c *RemoteClient) Get(ctx context.Context) (*remote.Payload, error) {
+	commit, err := c.repo.resolve(ctx, c.branchRef)
+	if err != nil {
+		return nil, err
+	}
+	if commit == "" {
+		return nil, nil
+	}
+	data, err := c.repo.readFile(ctx, commit, c.path)
+	if err != nil || data == nil {
+		return nil, err
+	}
+	sum := md5.Sum(data)
+	return &remote.Payload{
+		Data: data,
+		MD5:  sum[:],
+	}, nil
+}
+
+func (c *RemoteClient) Versions(ctx context.Context) ([]string, error) {
+	commit, err := c.repo.resolve(ctx, c.branchRef)
+	if err != nil || commit == "" {
+		return nil, err
+	}
+	return c.repo.fileHistory(ctx, commit, c.path)
+}
+
+func (c *RemoteClient) GetVersion(ctx context.Context, id string) (*remote.Payload, error) {
+	// The versions are commit hashes, so there is no need to pass anything
+	// else to git.
+	if id == "" || strings.Trim(id, "0123456789abcdef") != "" {
+		return nil, fmt.Errorf("invalid state version %q: must be a commit hash", id)
+	}
+	data, err := c.repo.readFile(ctx, id, c.path)
+	if err != nil || data == nil {
+		return nil, err
+	}
+	sum := md5.Sum(data)
+	return &remote.Payload{
+		Data: data,
+		MD5:  sum[:],
+	}, nil
+}
+
+func (c *RemoteClient) Put(ctx context.Context, data []byte) error {
+	return c.update(ctx, data, fmt.Sprintf("Update the state of workspace %q", c.workspace))
+}
+
+func (c *RemoteClient) Delete(ctx context.Context) error {
+	return c.update(ctx, nil, fmt.Sprintf("Delete the state of workspace %q", c.workspace))
+}
+
+// update commits the given content as the state file to the state branch,
+// or removes the state file if the content is nil.
+func (c *RemoteClient) update(ctx context.Context, data []byte, subject string) error {
+	message := subject + "\n"
+	if c.lockInfo != nil {
+		message += fmt.Sprintf("\nLock-ID: %s\nOperation: %s\nWho: %s\n", c.lockInfo.ID, c.lockInfo.Operation, c.lockInfo.Who)
+	}
+
+	for attempt := 1; ; attempt++ {
+		parent, err := c.repo.resolve(ctx, c.branchRef)
+		if err != nil {
+			return err
+		}
+		if parent == "" && data == nil {
+			// There is nothing to delete.
+			return nil
+		}
+		commit, err := c.repo.commitFile(ctx, parent, c.path, data, message)
+		if err != nil {
+			return err
+		}
+		err = c.repo.push(ctx, c.branchRef, parent, commit)
+		if err == nil {
+			return nil
+		}
+		// The states of the other workspaces are stored in the same
+		// branch, so the branch may have been updated by another run in
+		// the meantime. Since the change only applies to a single file,
+		// it can simply be applied again on top of the new commit.
+		if !errors.Is(err, errRefUpdateRejected) || attempt == maxUpdateAttempts {
+			return fmt.Errorf("failed to update the state branch: %w", err)
+		}
+	}
+}
+
+func (c *RemoteClient) Lock(ctx context.Context, info *statemgr.LockInfo) (string, error) {
+	if info.ID == "" {
+		lockID, err := uuid.GenerateUUID()
+		if err != nil {
+			return "", err
+		}
+		info.ID = lockID
+	}
+	info.Path = c.lockRef
+
+	commit, err := c.repo.commitFile(ctx, "", lockFile, info.Marshal(), fmt.Sprintf("Lock the state of workspace %q\n", c.workspace))
+	if err != nil {
+		return "", &statemgr.LockError{Info: info, Err: err}
+	}
+	// Pushing the lock branch only succeeds if it doesn't exist yet.
+	err = c.repo.push(ctx, c.lockRef, "", commit)
+	if errors.Is(err, errRefUpdateRejected) {
+		lockInfo, _, infoErr := c.getLockInfo(ctx)
+		if infoErr != nil {
+			err = errors.Join(err, infoErr)
+		}
+		return "", &statemgr.LockError{Info: lockInfo, Err: errors.New("the state is already locked")}
+	}
+	if err != nil {
+		return "", &statemgr.LockError{Info: info, Err: err}
+	}
+
+	c.lockInfo = info
+	return info.ID, nil
+}
+
+func (c *RemoteClient) Unlock(ctx context.Context, id string) error {
+	lockInfo, commit, err := c.getLockInfo(ctx)
+	if err != nil {
+		return &statemgr.LockError{Err: fmt.Errorf("failed to retrieve lock info: %w", err)}
+	}
+	if lockInfo == nil {
+		return &statemgr.LockError{Err: errors.New("the state is not locked")}
+	}
+	if lockInfo.ID != id {
+		return &statemgr.LockError{Info: lockInfo, Err: fmt.Errorf("lock ID %q does not match existing lock", id)}
+	}
+
+	if err := c.repo.push(ctx, c.lockRef, commit, ""); err != nil {
+		return &statemgr.LockError{Info: lockInfo, Err: err}
+	}
+	c.lockInfo = nil
+	return nil
+}
+
+// getLockInfo returns the info of the current lock and the commit of the
+// lock branch, or nil if the state isn't locked.
+func (c *RemoteClient) getLockInfo(ctx context.Context) (*statemgr.LockInfo, string, error) {
+	commit, err := c.repo.resolve(ctx, c.lockRef)
+	if err != nil || commit == "" {
+		return nil, "", err
+	}
+	data, err := c.repo.readFile(ctx, commit, lockFile)
+	if err != nil {
+		return nil, "", err
+	}
+	lockInfo := &statemgr.LockInfo{}
+	if err := json.Unmarshal(data, lockInfo); err != nil {
+		return nil, "", fmt.Errorf("invalid lock info in %s: %w", strings.TrimPrefix(c.lockRef, "refs/heads/"), err)
+	}
+	return lockInfo, commit, nil
+}
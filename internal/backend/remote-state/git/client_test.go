@@ -0,0 +1,64 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package git
+
+import (
+	"testing"
+
+	"github.com/opentofu/opentofu/internal/backend"
+	"github.com/opentofu/opentofu/internal/states/remote"
+)
+
+func TestRemoteClient_impl(t *testing.T) {
+	var _ remote.Client = new(RemoteClient)
+	var _ remote.ClientLocker = new(RemoteClient)
+	var _ remote.ClientVersioner = new(RemoteClient)
+}
+
+func TestRemoteClient(t *testing.T) {
+	b := testBackend(t, map[string]any{
+		"repository": testRepository(t),
+	})
+
+	s, err := b.StateMgr(t.Context(), backend.DefaultStateName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	remote.TestClient(t, s.(*remote.State).Client)
+}
+
+func TestRemoteClientVersions(t *testing.T) {
+	b := testBackend(t, map[string]any{
+		"repository": testRepository(t),
+	})
+
+	s, err := b.StateMgr(t.Context(), "staging")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	remote.TestClientVersions(t, s.(*remote.State).Client.(remote.ClientVersioner))
+}
+
+func TestRemoteClientLocks(t *testing.T) {
+	config := map[string]any{
+		"repository": testRepository(t),
+	}
+	b1 := testBackend(t, config)
+	b2 := testBackend(t, config)
+
+	s1, err := b1.StateMgr(t.Context(), backend.DefaultStateName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s2, err := b2.StateMgr(t.Context(), backend.DefaultStateName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	remote.TestRemoteLocks(t, s1.(*remote.State).Client, s2.(*remote.State).Client)
+}
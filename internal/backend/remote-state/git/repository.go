@@ -0,0 +1,231 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package git
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// errRefUpdateRejected is returned when pushing a ref fails because the ref
+// in the remote repository doesn't have the expected value anymore.
+var errRefUpdateRejected = errors.New("the remote ref was updated concurrently")
+
+// repository runs the git command line tool against a local bare repository
+// that caches the objects of the remote repository that stores the state.
+//
+// The local repository doesn't track the refs of the remote repository.
+// Instead, every operation looks up the current commit of the refs it needs
+// and then pushes new commits with the expectation that the refs still point
+// to those commits, which makes each update a compare-and-swap.
+type repository struct {
+	url         string
+	dir         string
+	authorName  string
+	authorEmail string
+}
+
+func (r *repository) init(ctx context.Context) error {
+	if _, err := exec.LookPath("git"); err != nil {
+		return fmt.Errorf("the git backend requires the git command line tool: %w", err)
+	}
+	if _, err := os.Stat(filepath.Join(r.dir, "HEAD")); err == nil {
+		return nil
+	}
+	if err := os.MkdirAll(r.dir, 0700); err != nil {
+		return fmt.Errorf("failed to create the local copy of the state repository: %w", err)
+	}
+	_, err := r.git(ctx, nil, nil, "init", "--quiet", "--bare")
+	return err
+}
+
+// git runs a git command in the local repository, returning its output.
+func (r *repository) git(ctx context.Context, stdin []byte, env []string, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, "git", append([]string{"--git-dir", r.dir}, args...)...)
+	cmd.Env = append(os.Environ(),
+		// Never wait for credentials to be entered, since the command
+		// isn't connected to the terminal.
+		"GIT_TERMINAL_PROMPT=0",
+		"LC_ALL=C",
+		"GIT_AUTHOR_NAME="+r.authorName,
+		"GIT_AUTHOR_EMAIL="+r.authorEmail,
+		"GIT_COMMITTER_NAME="+r.authorName,
+		"GIT_COMMITTER_EMAIL="+r.authorEmail,
+	)
+	cmd.Env = append(cmd.Env, env...)
+	if stdin != nil {
+		cmd.Stdin = bytes.NewReader(stdin)
+	}
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	log.Printf("[TRACE] backend/git: running git %s", strings.Join(args, " "))
+	if err := cmd.Run(); err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if msg == "" {
+			msg = err.Error()
+		}
+		return stdout.Bytes(), fmt.Errorf("git %s failed: %s", args[0], msg)
+	}
+	return stdout.Bytes(), nil
+}
+
+// checkRefName returns an error if the given ref name isn't valid.
+func (r *repository) checkRefName(ctx context.Context, ref string) error {
+	_, err := r.git(ctx, nil, nil, "check-ref-format", ref)
+	return err
+}
+
+// resolve returns the commit that the given ref currently points to in the
+// remote repository, after making sure that it's available locally. It
+// returns an empty string if the ref doesn't exist.
+func (r *repository) resolve(ctx context.Context, ref string) (string, error) {
+	out, err := r.git(ctx, nil, nil, "ls-remote", r.url, ref)
+	if err != nil {
+		return "", err
+	}
+	var commit string
+	for _, line := range strings.Split(string(out), "\n") {
+		hash, name, ok := strings.Cut(line, "\t")
+		if ok && name == ref {
+			commit = hash
+		}
+	}
+	if commit == "" {
+		return "", nil
+	}
+
+	if _, err := r.git(ctx, nil, nil, "cat-file", "-e", commit+"^{commit}"); err != nil {
+		if _, err := r.git(ctx, nil, nil, "fetch", "--quiet", "--no-tags", "--no-write-fetch-head", r.url, ref); err != nil {
+			return "", err
+		}
+	}
+	return commit, nil
+}
+
+// readFile returns the content of the file at the given path in the given
+// commit, or nil if there is no such file.
+func (r *repository) readFile(ctx context.Context, commit, path string) ([]byte, error) {
+	out, err := r.git(ctx, nil, nil, "ls-tree", "--full-tree", "-z", commit, "--", path)
+	if err != nil {
+		return nil, err
+	}
+	// The output is "<mode> <type> <hash>\t<path>".
+	info, _, ok := strings.Cut(string(out), "\t")
+	if !ok {
+		return nil, nil
+	}
+	fields := strings.Fields(info)
+	if len(fields) != 3 || fields[1] != "blob" {
+		return nil, fmt.Errorf("%s is not a file", path)
+	}
+	return r.git(ctx, nil, nil, "cat-file", "blob", fields[2])
+}
+
+// fileHistory returns the commits reachable from the given commit that
+// changed the file at the given path, starting with the latest.
+func (r *repository) fileHistory(ctx context.Context, commit, path string) ([]string, error) {
+	out, err := r.git(ctx, nil, nil, "log", "--format=%H", commit, "--", path)
+	if err != nil {
+		return nil, err
+	}
+	return strings.Fields(string(out)), nil
+}
+
+// listFiles returns the paths of all of the files in the given commit.
+func (r *repository) listFiles(ctx context.Context, commit string) ([]string, error) {
+	out, err := r.git(ctx, nil, nil, "ls-tree", "-r", "-z", "--name-only", "--full-tree", commit)
+	if err != nil {
+		return nil, err
+	}
+	return strings.FieldsFunc(string(out), func(r rune) bool { return r == 0 }), nil
+}
+
+// commitFile creates a commit whose parent is the given commit, and which
+// sets the content of the file at the given path, or removes the file if the
+// content is nil. The parent may be empty to create a root commit.
+func (r *repository) commitFile(ctx context.Context, parent, path string, content []byte, message string) (string, error) {
+	// A temporary index is used to build the tree of the new commit, so
+	// that concurrent operations using the same local repository don't
+	// interfere with each other.
+	index, err := os.CreateTemp(r.dir, "index-")
+	if err != nil {
+		return "", err
+	}
+	indexPath := index.Name()
+	_ = index.Close()
+	// Git refuses to read an empty index file.
+	_ = os.Remove(indexPath)
+	defer os.Remove(indexPath)
+	env := []string{"GIT_INDEX_FILE=" + indexPath}
+
+	if parent != "" {
+		if _, err := r.git(ctx, nil, env, "read-tree", parent); err != nil {
+			return "", err
+		}
+	}
+	if content != nil {
+		blob, err := r.git(ctx, content, nil, "hash-object", "-w", "--stdin")
+		if err != nil {
+			return "", err
+		}
+		cacheInfo := "100644," + strings.TrimSpace(string(blob)) + "," + path
+		if _, err := r.git(ctx, nil, env, "update-index", "--add", "--cacheinfo", cacheInfo); err != nil {
+			return "", err
+		}
+	} else {
+		// A zero mode removes the entry, without requiring a work tree.
+		removal := "0 " + strings.Repeat("0", 40) + "\t" + path + "\n"
+		if _, err := r.git(ctx, []byte(removal), env, "update-index", "--index-info"); err != nil {
+			return "", err
+		}
+	}
+	tree, err := r.git(ctx, nil, env, "write-tree")
+	if err != nil {
+		return "", err
+	}
+
+	args := []string{"commit-tree", strings.TrimSpace(string(tree)), "-F", "-"}
+	if parent != "" {
+		args = append(args, "-p", parent)
+	}
+	commit, err := r.git(ctx, []byte(message), nil, args...)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(commit)), nil
+}
+
+// push sets the given ref in the remote repository to the given commit, or
+// deletes it if the commit is empty, as long as the ref still points to the
+// expected commit. An empty expected commit means that the ref must not
+// exist yet.
+//
+// It returns errRefUpdateRejected if the ref doesn't have the expected value.
+func (r *repository) push(ctx context.Context, ref, expected, commit string) error {
+	out, err := r.git(ctx, nil, nil, "push", "--quiet", "--porcelain",
+		"--force-with-lease="+ref+":"+expected,
+		r.url, commit+":"+ref,
+	)
+	if err != nil {
+		// The porcelain output reports a ref update that was refused
+		// because the lease didn't hold, or because the update isn't a
+		// fast-forward, as "rejected".
+		if strings.Contains(string(out), "[rejected]") {
+			return errRefUpdateRejected
+		}
+		return err
+	}
+	return nil
+}
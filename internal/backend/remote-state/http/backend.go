@@ -19,6 +19,8 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-retryablehttp"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
 
 	"github.com/opentofu/opentofu/internal/backend"
 	"github.com/opentofu/opentofu/internal/encryption"
@@ -121,6 +123,30 @@ func New(enc encryption.StateEncryption) backend.Backend {
 				DefaultFunc: schema.EnvDefaultFunc("TF_HTTP_CLIENT_PRIVATE_KEY_PEM", ""),
 				Description: "A PEM-encoded private key, required if client_certificate_pem is specified.",
 			},
+			"oauth2_token_url": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("TF_HTTP_OAUTH2_TOKEN_URL", ""),
+				Description: "The token endpoint URL of an OAuth 2.0 authorization server, used to obtain bearer tokens with the client credentials grant.",
+			},
+			"oauth2_client_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("TF_HTTP_OAUTH2_CLIENT_ID", ""),
+				Description: "The OAuth 2.0 client ID, required if oauth2_token_url is specified.",
+			},
+			"oauth2_client_secret": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("TF_HTTP_OAUTH2_CLIENT_SECRET", ""),
+				Description: "The OAuth 2.0 client secret, required if oauth2_token_url is specified.",
+			},
+			"oauth2_scopes": &schema.Schema{
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "The OAuth 2.0 scopes to request when obtaining bearer tokens.",
+			},
 			"headers": &schema.Schema{
 				Type:     schema.TypeMap,
 				Elem:     &schema.Schema{Type: schema.TypeString},
@@ -206,6 +232,48 @@ func (b *Backend) configureTLS(client *retryablehttp.Client, data *schema.Resour
 	return nil
 }
 
+// configureOAuth2 returns a token source for OAuth 2.0 bearer tokens obtained
+// using the client credentials grant, or nil if OAuth 2.0 is not configured.
+//
+// The returned token source caches the token and transparently obtains a new
+// one once it has expired. Requests to the token endpoint use the given HTTP
+// client, so that they share any TLS settings configured for the backend.
+func (b *Backend) configureOAuth2(ctx context.Context, httpClient *http.Client, data *schema.ResourceData) (oauth2.TokenSource, error) {
+	tokenURL := data.Get("oauth2_token_url").(string)
+	clientID := data.Get("oauth2_client_id").(string)
+	clientSecret := data.Get("oauth2_client_secret").(string)
+	if tokenURL == "" {
+		if clientID != "" || clientSecret != "" {
+			return nil, fmt.Errorf("oauth2_client_id and oauth2_client_secret require oauth2_token_url to be set")
+		}
+		return nil, nil
+	}
+	if clientID == "" || clientSecret == "" {
+		return nil, fmt.Errorf("oauth2_token_url is set but oauth2_client_id or oauth2_client_secret is not")
+	}
+	if u, err := url.Parse(tokenURL); err != nil {
+		return nil, fmt.Errorf("failed to parse oauth2_token_url URL: %w", err)
+	} else if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("oauth2_token_url must be HTTP or HTTPS")
+	}
+
+	var scopes []string
+	for _, v := range data.Get("oauth2_scopes").([]interface{}) {
+		scopes = append(scopes, v.(string))
+	}
+
+	config := &clientcredentials.Config{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		TokenURL:     tokenURL,
+		Scopes:       scopes,
+	}
+	// The token source retains this context for all future token requests,
+	// which can happen long after configuration has completed.
+	ctx = context.WithValue(context.WithoutCancel(ctx), oauth2.HTTPClient, httpClient)
+	return config.TokenSource(ctx), nil
+}
+
 func (b *Backend) configure(ctx context.Context) error {
 	data := schema.FromContextBackendConfig(ctx)
 
@@ -266,6 +334,9 @@ func (b *Backend) configure(ctx context.Context) error {
 				if username != "" {
 					return fmt.Errorf("headers \"%s\" cannot be set when providing username", k)
 				}
+				if data.Get("oauth2_token_url").(string) != "" {
+					return fmt.Errorf("headers \"%s\" cannot be set when providing oauth2_token_url", k)
+				}
 				headers[k] = value
 			case "content-type", "content-md5":
 				return fmt.Errorf("headers \"%s\" is reserved", k)
@@ -284,6 +355,14 @@ func (b *Backend) configure(ctx context.Context) error {
 		return err
 	}
 
+	tokenSource, err := b.configureOAuth2(ctx, rClient.HTTPClient, data)
+	if err != nil {
+		return err
+	}
+	if tokenSource != nil && username != "" {
+		return fmt.Errorf("username cannot be set when providing oauth2_token_url")
+	}
+
 	b.client = &httpClient{
 		URL:          updateURL,
 		UpdateMethod: updateMethod,
@@ -293,9 +372,10 @@ func (b *Backend) configure(ctx context.Context) error {
 		UnlockURL:    unlockURL,
 		UnlockMethod: unlockMethod,
 
-		Headers:  headers,
-		Username: username,
-		Password: password,
+		Headers:     headers,
+		Username:    username,
+		Password:    password,
+		TokenSource: tokenSource,
 
 		// accessible only for testing use
 		Client: rClient,
@@ -308,7 +388,7 @@ func (b *Backend) StateMgr(_ context.Context, name string) (statemgr.Full, error
 		return nil, backend.ErrWorkspacesNotSupported
 	}
 
-	return remote.NewState(b.client, b.encryption), nil
+	return remote.NewState(b.client, b.encryption.ForWorkspace(name)), nil
 }
 
 func (b *Backend) Workspaces(context.Context) ([]string, error) {
@@ -6,6 +6,10 @@
 package http
 
 import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -184,3 +188,117 @@ func TestHTTPClientFactoryWithEnv(t *testing.T) {
 		t.Fatalf("Expected retry_wait_max \"%s\", got \"%s\"", 150*time.Second, client.Client.RetryWaitMax)
 	}
 }
+
+func TestHTTPClientFactoryOAuth2(t *testing.T) {
+	var tokenRequests int
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokenRequests++
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("failed to parse token request: %s", err)
+		}
+		if got, want := r.PostForm.Get("grant_type"), "client_credentials"; got != want {
+			t.Errorf("wrong grant_type %q; want %q", got, want)
+		}
+		if got, want := r.PostForm.Get("scope"), "state:read state:write"; got != want {
+			t.Errorf("wrong scope %q; want %q", got, want)
+		}
+		if id, secret, _ := r.BasicAuth(); id != "tofu" || secret != "s3cret" {
+			t.Errorf("wrong client credentials %q:%q", id, secret)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		// A token that is already expired forces a new token for every request.
+		fmt.Fprintf(w, `{"access_token":"token-%d","token_type":"Bearer","expires_in":1}`, tokenRequests)
+	}))
+	defer tokenServer.Close()
+
+	var gotAuth []string
+	stateServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = append(gotAuth, r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer stateServer.Close()
+
+	conf := map[string]cty.Value{
+		"address":              cty.StringVal(stateServer.URL),
+		"oauth2_token_url":     cty.StringVal(tokenServer.URL),
+		"oauth2_client_id":     cty.StringVal("tofu"),
+		"oauth2_client_secret": cty.StringVal("s3cret"),
+		"oauth2_scopes":        cty.ListVal([]cty.Value{cty.StringVal("state:read"), cty.StringVal("state:write")}),
+	}
+	b := backend.TestBackendConfig(t, New(encryption.StateEncryptionDisabled()), configs.SynthBody("synth", conf)).(*Backend)
+	if b.client.TokenSource == nil {
+		t.Fatal("expected an OAuth2 token source")
+	}
+
+	for range 2 {
+		if _, err := b.client.Get(t.Context()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(gotAuth) != 2 || !strings.HasPrefix(gotAuth[0], "Bearer token-") || gotAuth[0] == gotAuth[1] {
+		t.Fatalf("wrong Authorization headers %q; want two different bearer tokens", gotAuth)
+	}
+}
+
+func TestHTTPClientFactoryOAuth2Invalid(t *testing.T) {
+	tests := map[string]struct {
+		conf    map[string]cty.Value
+		wantErr string
+	}{
+		"missing client credentials": {
+			map[string]cty.Value{
+				"oauth2_token_url": cty.StringVal("https://auth.example.com/token"),
+				"oauth2_client_id": cty.StringVal("tofu"),
+			},
+			"oauth2_token_url is set but oauth2_client_id or oauth2_client_secret is not",
+		},
+		"missing token url": {
+			map[string]cty.Value{
+				"oauth2_client_id":     cty.StringVal("tofu"),
+				"oauth2_client_secret": cty.StringVal("s3cret"),
+			},
+			"oauth2_client_id and oauth2_client_secret require oauth2_token_url to be set",
+		},
+		"invalid token url": {
+			map[string]cty.Value{
+				"oauth2_token_url":     cty.StringVal("ftp://auth.example.com/token"),
+				"oauth2_client_id":     cty.StringVal("tofu"),
+				"oauth2_client_secret": cty.StringVal("s3cret"),
+			},
+			"oauth2_token_url must be HTTP or HTTPS",
+		},
+		"with username": {
+			map[string]cty.Value{
+				"username":             cty.StringVal("user"),
+				"oauth2_token_url":     cty.StringVal("https://auth.example.com/token"),
+				"oauth2_client_id":     cty.StringVal("tofu"),
+				"oauth2_client_secret": cty.StringVal("s3cret"),
+			},
+			"username cannot be set when providing oauth2_token_url",
+		},
+		"with authorization header": {
+			map[string]cty.Value{
+				"oauth2_token_url":     cty.StringVal("https://auth.example.com/token"),
+				"oauth2_client_id":     cty.StringVal("tofu"),
+				"oauth2_client_secret": cty.StringVal("s3cret"),
+				"headers": cty.MapVal(map[string]cty.Value{
+					"Authorization": cty.StringVal("Bearer static"),
+				}),
+			},
+			`headers "Authorization" cannot be set when providing oauth2_token_url`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.conf["address"] = cty.StringVal("http://127.0.0.1:8888/foo")
+			_, _, errs := backend.TestBackendConfigWarningsAndErrors(t, New(encryption.StateEncryptionDisabled()), configs.SynthBody("synth", test.conf))
+			if len(errs) != 1 {
+				t.Fatalf("expected exactly one error, got %q", errs)
+			}
+			if got := errs[0].Error(); !strings.Contains(got, test.wantErr) {
+				t.Errorf("wrong error\ngot:  %s\nwant: %s", got, test.wantErr)
+			}
+		})
+	}
+}
@@ -18,6 +18,8 @@ import (
 	"net/url"
 
 	"github.com/hashicorp/go-retryablehttp"
+	"golang.org/x/oauth2"
+
 	"github.com/opentofu/opentofu/internal/states/remote"
 	"github.com/opentofu/opentofu/internal/states/statemgr"
 )
@@ -40,6 +42,10 @@ type httpClient struct {
 	Username string
 	Password string
 
+	// TokenSource, if set, provides OAuth 2.0 bearer tokens to authenticate
+	// each request with.
+	TokenSource oauth2.TokenSource
+
 	lockID       string
 	jsonLockInfo []byte
 }
@@ -67,6 +73,14 @@ func (c *httpClient) httpRequest(ctx context.Context, method string, url *url.UR
 		req.SetBasicAuth(c.Username, c.Password)
 	}
 
+	if c.TokenSource != nil {
+		token, err := c.TokenSource.Token()
+		if err != nil {
+			return nil, fmt.Errorf("Failed to obtain OAuth2 token for %s: %w", what, err)
+		}
+		token.SetAuthHeader(req.Request)
+	}
+
 	// Work with data/body
 	if len(data) > 0 {
 		req.Header.Set("Content-Type", "application/json")
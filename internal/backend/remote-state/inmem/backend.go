@@ -76,7 +76,7 @@ func (b *Backend) configure(ctx context.Context) error {
 		Name: backend.DefaultStateName,
 	}
 
-	states.m[backend.DefaultStateName] = remote.NewState(defaultClient, b.encryption)
+	states.m[backend.DefaultStateName] = remote.NewState(defaultClient, b.encryption.ForWorkspace(backend.DefaultStateName))
 
 	// set the default client lock info per the test config
 	data := schema.FromContextBackendConfig(ctx)
@@ -131,7 +131,7 @@ func (b *Backend) StateMgr(_ context.Context, name string) (statemgr.Full, error
 			&RemoteClient{
 				Name: name,
 			},
-			b.encryption,
+			b.encryption.ForWorkspace(name),
 		)
 		states.m[name] = s
 
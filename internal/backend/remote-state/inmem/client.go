@@ -8,6 +8,7 @@ package inmem
 import (
 	"context"
 	"crypto/md5"
+	"strconv"
 
 	"github.com/opentofu/opentofu/internal/states/remote"
 	"github.com/opentofu/opentofu/internal/states/statemgr"
@@ -18,6 +19,9 @@ type RemoteClient struct {
 	Data []byte
 	MD5  []byte
 	Name string
+
+	// History holds every version of the state that was put, oldest first.
+	History [][]byte
 }
 
 func (c *RemoteClient) Get(_ context.Context) (*remote.Payload, error) {
@@ -36,15 +40,37 @@ func (c *RemoteClient) Put(_ context.Context, data []byte) error {
 
 	c.Data = data
 	c.MD5 = md5[:]
+	c.History = append(c.History, data)
 	return nil
 }
 
 func (c *RemoteClient) Delete(_ context.Context) error {
 	c.Data = nil
 	c.MD5 = nil
+	c.History = nil
 	return nil
 }
 
+func (c *RemoteClient) Versions(_ context.Context) ([]string, error) {
+	ids := make([]string, 0, len(c.History))
+	for i := len(c.History) - 1; i >= 0; i-- {
+		ids = append(ids, strconv.Itoa(i))
+	}
+	return ids, nil
+}
+
+func (c *RemoteClient) GetVersion(_ context.Context, id string) (*remote.Payload, error) {
+	i, err := strconv.Atoi(id)
+	if err != nil || i < 0 || i >= len(c.History) {
+		return nil, nil
+	}
+	md5 := md5.Sum(c.History[i])
+	return &remote.Payload{
+		Data: c.History[i],
+		MD5:  md5[:],
+	}, nil
+}
+
 func (c *RemoteClient) Lock(_ context.Context, info *statemgr.LockInfo) (string, error) {
 	return locks.lock(c.Name, info)
 }
@@ -17,6 +17,7 @@ import (
 func TestRemoteClient_impl(t *testing.T) {
 	var _ remote.Client = new(RemoteClient)
 	var _ remote.ClientLocker = new(RemoteClient)
+	var _ remote.ClientVersioner = new(RemoteClient)
 }
 
 func TestRemoteClient(t *testing.T) {
@@ -31,6 +32,18 @@ func TestRemoteClient(t *testing.T) {
 	remote.TestClient(t, s.(*remote.State).Client)
 }
 
+func TestRemoteClientVersions(t *testing.T) {
+	defer Reset()
+	b := backend.TestBackendConfig(t, New(encryption.StateEncryptionDisabled()), hcl.EmptyBody())
+
+	s, err := b.StateMgr(t.Context(), backend.DefaultStateName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	remote.TestClientVersions(t, s.(*remote.State).Client.(remote.ClientVersioner))
+}
+
 func TestInmemLocks(t *testing.T) {
 	defer Reset()
 	s, err := backend.TestBackendConfig(t, New(encryption.StateEncryptionDisabled()), hcl.EmptyBody()).StateMgr(t.Context(), backend.DefaultStateName)
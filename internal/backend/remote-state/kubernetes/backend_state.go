@@ -84,7 +84,7 @@ func (b *Backend) StateMgr(_ context.Context, name string) (statemgr.Full, error
 		return nil, err
 	}
 
-	stateMgr := remote.NewState(c, b.encryption)
+	stateMgr := remote.NewState(c, b.encryption.ForWorkspace(name))
 
 	// Grab the value
 	if err := stateMgr.RefreshState(context.TODO()); err != nil {
@@ -119,7 +119,7 @@ func (b *Backend) StateMgr(ctx context.Context, name string) (statemgr.Full, err
 	if err != nil {
 		return nil, err
 	}
-	stateMgr := remote.NewState(client, b.encryption)
+	stateMgr := remote.NewState(client, b.encryption.ForWorkspace(name))
 
 	// Check to see if this state already exists.
 	existing, err := b.Workspaces(ctx)
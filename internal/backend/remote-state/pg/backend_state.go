@@ -67,7 +67,7 @@ func (b *Backend) StateMgr(ctx context.Context, name string) (statemgr.Full, err
 			TableName:  b.tableName,
 			IndexName:  b.indexName,
 		},
-		b.encryption,
+		b.encryption.ForWorkspace(name),
 	)
 
 	// Check to see if this state already exists.
@@ -56,6 +56,11 @@ type Backend struct {
 	workspaceKeyPrefix    string
 	skipS3Checksum        bool
 	useLockfile           bool
+
+	// customS3Endpoint is set when the backend is configured with a custom
+	// S3 endpoint, which is usually an S3-compatible object store whose
+	// capabilities must be probed.
+	customS3Endpoint bool
 }
 
 // ConfigSchema returns a description of the expected configuration
@@ -697,6 +702,7 @@ func (b *Backend) Configure(ctx context.Context, obj cty.Value) tfdiags.Diagnost
 	b.ddbTable = stringAttr(obj, "dynamodb_table")
 	b.useLockfile = boolAttr(obj, "use_lockfile")
 	b.skipS3Checksum = boolAttr(obj, "skip_s3_checksum")
+	_, b.customS3Endpoint = customEndpoints["s3"].StringOk(obj)
 
 	if customerKey, ok := stringAttrOk(obj, "sse_customer_key"); ok {
 		if len(customerKey) != 44 {
@@ -858,7 +864,7 @@ func (b *Backend) Configure(ctx context.Context, obj cty.Value) tfdiags.Diagnost
 }
 
 func attachLoggerToContext(ctx context.Context) (context.Context, baselogging.HcLogger) {
-	ctx, baselog := baselogging.NewHcLogger(ctx, logging.HCLogger().Named("backend-s3"))
+	ctx, baselog := baselogging.NewHcLogger(ctx, logging.NewBackendLogger("s3"))
 	ctx = baselogging.RegisterLogger(ctx, baselog)
 	return ctx, baselog
 }
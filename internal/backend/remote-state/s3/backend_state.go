@@ -157,7 +157,7 @@ func (b *Backend) StateMgr(ctx context.Context, name string) (statemgr.Full, err
 		return nil, err
 	}
 
-	stateMgr := remote.NewState(client, b.encryption)
+	stateMgr := remote.NewState(client, b.encryption.ForWorkspace(name))
 	// Check to see if this state already exists.
 	// If we're trying to force-unlock a state, we can't take the lock before
 	// fetching the state. If the state doesn't exist, we have to assume this
@@ -0,0 +1,178 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	dtypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	"github.com/opentofu/opentofu/internal/backend"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+const (
+	bootstrapTypeBucket = "S3 bucket"
+	bootstrapTypeTable  = "DynamoDB table"
+)
+
+// bootstrapTableWait is the longest time to wait for a new DynamoDB table to
+// become active.
+const bootstrapTableWait = 5 * time.Minute
+
+var _ backend.Bootstrapper = (*Backend)(nil)
+
+// MissingResources returns the S3 bucket and, if configured, the DynamoDB
+// table for locking when they don't exist.
+func (b *Backend) MissingResources(ctx context.Context) ([]backend.BootstrapResource, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+	ctx, _ = attachLoggerToContext(ctx)
+
+	var missing []backend.BootstrapResource
+	_, err := b.s3Client.HeadBucket(ctx, &s3.HeadBucketInput{
+		Bucket: aws.String(b.bucketName),
+	}, s3optDisableDefaultChecksum(b.skipS3Checksum))
+	var notFound *types.NotFound
+	switch {
+	case errors.As(err, &notFound):
+		missing = append(missing, backend.BootstrapResource{
+			Type:        bootstrapTypeBucket,
+			Name:        b.bucketName,
+			Permissions: []string{"s3:ListBucket", "s3:GetObject", "s3:PutObject", "s3:DeleteObject"},
+		})
+	case err != nil:
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to check for the S3 bucket",
+			fmt.Sprintf("OpenTofu could not check whether the S3 bucket %q exists: %s", b.bucketName, err),
+		))
+		return nil, diags
+	}
+
+	if b.ddbTable != "" {
+		_, err := b.dynClient.DescribeTable(ctx, &dynamodb.DescribeTableInput{
+			TableName: aws.String(b.ddbTable),
+		})
+		var notFound *dtypes.ResourceNotFoundException
+		switch {
+		case errors.As(err, &notFound):
+			missing = append(missing, backend.BootstrapResource{
+				Type:        bootstrapTypeTable,
+				Name:        b.ddbTable,
+				Permissions: []string{"dynamodb:DescribeTable", "dynamodb:GetItem", "dynamodb:PutItem", "dynamodb:DeleteItem"},
+			})
+		case err != nil:
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Failed to check for the DynamoDB table",
+				fmt.Sprintf("OpenTofu could not check whether the DynamoDB table %q exists: %s", b.ddbTable, err),
+			))
+			return nil, diags
+		}
+	}
+
+	return missing, diags
+}
+
+// CreateResources creates the given S3 bucket and DynamoDB table.
+//
+// The bucket is created in the configured region with versioning enabled, so
+// that earlier state snapshots can be recovered, and with all public access
+// blocked. The table has the "LockID" key that locking expects and uses
+// on-demand capacity.
+func (b *Backend) CreateResources(ctx context.Context, resources []backend.BootstrapResource) tfdiags.Diagnostics {
+	var diags tfdiags.Diagnostics
+	ctx, _ = attachLoggerToContext(ctx)
+
+	for _, resource := range resources {
+		var err error
+		switch resource.Type {
+		case bootstrapTypeBucket:
+			err = b.createBucket(ctx)
+		case bootstrapTypeTable:
+			err = b.createLockTable(ctx)
+		default:
+			err = fmt.Errorf("unsupported resource type")
+		}
+		if err != nil {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				fmt.Sprintf("Failed to create %s", resource.Type),
+				fmt.Sprintf("OpenTofu could not create the %s %q: %s", resource.Type, resource.Name, err),
+			))
+			return diags
+		}
+	}
+	return diags
+}
+
+func (b *Backend) createBucket(ctx context.Context) error {
+	input := &s3.CreateBucketInput{
+		Bucket: aws.String(b.bucketName),
+	}
+	// Buckets in us-east-1 must not have a location constraint.
+	if region := b.awsConfig.Region; region != "" && region != "us-east-1" {
+		input.CreateBucketConfiguration = &types.CreateBucketConfiguration{
+			LocationConstraint: types.BucketLocationConstraint(region),
+		}
+	}
+	if _, err := b.s3Client.CreateBucket(ctx, input, s3optDisableDefaultChecksum(b.skipS3Checksum)); err != nil {
+		return err
+	}
+
+	_, err := b.s3Client.PutBucketVersioning(ctx, &s3.PutBucketVersioningInput{
+		Bucket: aws.String(b.bucketName),
+		VersioningConfiguration: &types.VersioningConfiguration{
+			Status: types.BucketVersioningStatusEnabled,
+		},
+	}, s3optDisableDefaultChecksum(b.skipS3Checksum))
+	if err != nil {
+		return fmt.Errorf("enabling versioning: %w", err)
+	}
+
+	_, err = b.s3Client.PutPublicAccessBlock(ctx, &s3.PutPublicAccessBlockInput{
+		Bucket: aws.String(b.bucketName),
+		PublicAccessBlockConfiguration: &types.PublicAccessBlockConfiguration{
+			BlockPublicAcls:       aws.Bool(true),
+			BlockPublicPolicy:     aws.Bool(true),
+			IgnorePublicAcls:      aws.Bool(true),
+			RestrictPublicBuckets: aws.Bool(true),
+		},
+	}, s3optDisableDefaultChecksum(b.skipS3Checksum))
+	if err != nil {
+		return fmt.Errorf("blocking public access: %w", err)
+	}
+	return nil
+}
+
+func (b *Backend) createLockTable(ctx context.Context) error {
+	_, err := b.dynClient.CreateTable(ctx, &dynamodb.CreateTableInput{
+		TableName: aws.String(b.ddbTable),
+		AttributeDefinitions: []dtypes.AttributeDefinition{
+			{AttributeName: aws.String("LockID"), AttributeType: dtypes.ScalarAttributeTypeS},
+		},
+		KeySchema: []dtypes.KeySchemaElement{
+			{AttributeName: aws.String("LockID"), KeyType: dtypes.KeyTypeHash},
+		},
+		BillingMode: dtypes.BillingModePayPerRequest,
+	})
+	if err != nil {
+		return err
+	}
+
+	waiter := dynamodb.NewTableExistsWaiter(b.dynClient)
+	if err := waiter.Wait(ctx, &dynamodb.DescribeTableInput{TableName: aws.String(b.ddbTable)}, bootstrapTableWait); err != nil {
+		return fmt.Errorf("waiting for the table to become active: %w", err)
+	}
+	return nil
+}
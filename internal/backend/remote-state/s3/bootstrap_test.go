@@ -0,0 +1,145 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/opentofu/opentofu/internal/backend"
+	"github.com/opentofu/opentofu/internal/encryption"
+)
+
+// fakeBootstrapService is a minimal S3-compatible object store and DynamoDB
+// endpoint that only implements the requests made while bootstrapping.
+type fakeBootstrapService struct {
+	mu       sync.Mutex
+	buckets  map[string]bool
+	tables   map[string]bool
+	requests []string
+}
+
+func (s *fakeBootstrapService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if target := r.Header.Get("X-Amz-Target"); target != "" {
+		operation := strings.TrimPrefix(target, "DynamoDB_20120810.")
+		s.requests = append(s.requests, "dynamodb "+operation)
+		w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+		switch operation {
+		case "DescribeTable":
+			if len(s.tables) == 0 {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = io.WriteString(w, `{"__type":"com.amazonaws.dynamodb.v20120810#ResourceNotFoundException","message":"not found"}`)
+				return
+			}
+			_, _ = io.WriteString(w, `{"Table":{"TableName":"tofu-locks","TableStatus":"ACTIVE"}}`)
+		case "CreateTable":
+			s.tables["tofu-locks"] = true
+			_, _ = io.WriteString(w, `{"TableDescription":{"TableName":"tofu-locks","TableStatus":"CREATING"}}`)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = io.WriteString(w, `{"__type":"com.amazonaws.dynamodb.v20120810#UnknownOperationException"}`)
+		}
+		return
+	}
+
+	bucket := strings.Trim(r.URL.Path, "/")
+	request := r.Method + " " + bucket
+	if r.URL.RawQuery != "" {
+		request += "?" + strings.TrimSuffix(r.URL.RawQuery, "=")
+	}
+	s.requests = append(s.requests, "s3 "+request)
+	switch {
+	case r.Method == http.MethodHead:
+		if !s.buckets[bucket] {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	case r.Method == http.MethodPut && r.URL.RawQuery == "":
+		s.buckets[bucket] = true
+	case r.Method == http.MethodPut:
+		// Versioning and public access block configuration.
+	default:
+		w.WriteHeader(http.StatusNotImplemented)
+	}
+}
+
+func TestBackendBootstrap(t *testing.T) {
+	svc := &fakeBootstrapService{
+		buckets: make(map[string]bool),
+		tables:  make(map[string]bool),
+	}
+	srv := httptest.NewServer(svc)
+	defer srv.Close()
+
+	config := map[string]any{
+		"region":                      "us-east-1",
+		"bucket":                      "tofu-state",
+		"key":                         "terraform.tfstate",
+		"dynamodb_table":              "tofu-locks",
+		"access_key":                  "test",
+		"secret_key":                  "test",
+		"skip_credentials_validation": true,
+		"skip_requesting_account_id":  true,
+		"skip_metadata_api_check":     true,
+		"use_path_style":              true,
+		"endpoints": map[string]any{
+			"s3":       srv.URL,
+			"dynamodb": srv.URL,
+		},
+	}
+	b := backend.TestBackendConfig(t, New(encryption.StateEncryptionDisabled()), backend.TestWrapConfig(config)).(*Backend)
+
+	missing, diags := b.MissingResources(t.Context())
+	if diags.HasErrors() {
+		t.Fatal(diags.Err())
+	}
+	wantMissing := []backend.BootstrapResource{
+		{
+			Type:        "S3 bucket",
+			Name:        "tofu-state",
+			Permissions: []string{"s3:ListBucket", "s3:GetObject", "s3:PutObject", "s3:DeleteObject"},
+		},
+		{
+			Type:        "DynamoDB table",
+			Name:        "tofu-locks",
+			Permissions: []string{"dynamodb:DescribeTable", "dynamodb:GetItem", "dynamodb:PutItem", "dynamodb:DeleteItem"},
+		},
+	}
+	if diff := cmp.Diff(wantMissing, missing); diff != "" {
+		t.Fatalf("wrong missing resources\n%s", diff)
+	}
+
+	svc.requests = nil
+	if diags := b.CreateResources(t.Context(), missing); diags.HasErrors() {
+		t.Fatal(diags.Err())
+	}
+	wantRequests := []string{
+		"s3 PUT tofu-state",
+		"s3 PUT tofu-state?versioning",
+		"s3 PUT tofu-state?publicAccessBlock",
+		"dynamodb CreateTable",
+		"dynamodb DescribeTable",
+	}
+	if diff := cmp.Diff(wantRequests, svc.requests); diff != "" {
+		t.Errorf("wrong requests\n%s", diff)
+	}
+
+	missing, diags = b.MissingResources(t.Context())
+	if diags.HasErrors() {
+		t.Fatal(diags.Err())
+	}
+	if len(missing) != 0 {
+		t.Errorf("unexpected missing resources after bootstrapping: %#v", missing)
+	}
+}
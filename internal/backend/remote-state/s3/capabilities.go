@@ -0,0 +1,178 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/opentofu/opentofu/internal/backend"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+const (
+	capabilityVersioning        = "versioning"
+	capabilityConditionalWrites = "conditional writes"
+	capabilityChecksums         = "checksums"
+)
+
+// probeObjectSuffix is appended to the state object key to get the key of
+// the temporary object that is written while probing the capabilities of an
+// S3-compatible object store.
+const probeObjectSuffix = ".tfprobe"
+
+// directoryBucketSuffix is the suffix of the names of the S3 directory
+// buckets used by the S3 Express One Zone storage class.
+const directoryBucketSuffix = "--x-s3"
+
+var _ backend.CapabilityProber = (*Backend)(nil)
+
+// ProbeCapabilities detects which of the optional S3 features that this
+// backend uses are available for the configured bucket.
+//
+// Amazon S3 supports all of them, except that versioning must be enabled for
+// each bucket and isn't available at all for directory buckets. S3-compatible
+// object stores, which are used through a custom S3 endpoint, are probed by
+// writing and deleting a temporary object next to the state of the default
+// workspace.
+func (b *Backend) ProbeCapabilities(ctx context.Context) ([]backend.Capability, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+	ctx, _ = attachLoggerToContext(ctx)
+
+	if strings.HasSuffix(b.bucketName, directoryBucketSuffix) {
+		// Directory buckets don't support versioning, and the API
+		// doesn't even allow asking for its status.
+		return []backend.Capability{
+			{Name: capabilityChecksums, Supported: !b.skipS3Checksum},
+			{Name: capabilityConditionalWrites, Supported: true},
+			{Name: capabilityVersioning, Supported: false},
+		}, diags
+	}
+
+	versioning := b.probeVersioning(ctx)
+	if !b.customS3Endpoint {
+		return []backend.Capability{
+			{Name: capabilityChecksums, Supported: !b.skipS3Checksum},
+			{Name: capabilityConditionalWrites, Supported: true},
+			{Name: capabilityVersioning, Supported: versioning},
+		}, diags
+	}
+
+	client, err := b.remoteClient(backend.DefaultStateName)
+	if err != nil {
+		return nil, diags.Append(err)
+	}
+	client.path += probeObjectSuffix
+	defer func() {
+		_, err := client.s3Client.DeleteObject(ctx, &s3.DeleteObjectInput{
+			Bucket: aws.String(client.bucketName),
+			Key:    aws.String(client.path),
+		}, s3optDisableDefaultChecksum(client.skipS3Checksum))
+		if err != nil {
+			log.Printf("[WARN] Failed to delete the S3 capability probe object %q: %s", client.path, err)
+		}
+	}()
+
+	checksums := !client.skipS3Checksum
+	err = client.putProbeObject(ctx, false)
+	if err != nil && checksums {
+		// Many S3-compatible object stores reject the checksum headers
+		// instead of ignoring them, so we'll try again without them.
+		log.Printf("[DEBUG] Uploading the S3 capability probe object with a checksum failed: %s", err)
+		client.skipS3Checksum = true
+		checksums = false
+		err = client.putProbeObject(ctx, false)
+	}
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Warning,
+			"Unable to detect the S3 bucket capabilities",
+			fmt.Sprintf("OpenTofu could not write the object %q to detect which features the S3-compatible endpoint supports: %s", client.path, err),
+		))
+		return nil, diags
+	}
+	if !checksums && !b.skipS3Checksum {
+		diags = diags.Append(tfdiags.AttributeValue(
+			tfdiags.Warning,
+			"S3-compatible endpoint does not support checksums",
+			`The S3-compatible endpoint rejected an upload with a SHA-256 checksum. OpenTofu will not send checksums during this run, but other commands will fail to store the state until you set "skip_s3_checksum" to true in the backend configuration.`,
+			cty.GetAttrPath("skip_s3_checksum"),
+		))
+	}
+
+	// The probe object exists now, so a store that supports conditional
+	// writes must refuse to overwrite it.
+	conditional := isPreconditionFailed(client.putProbeObject(ctx, true))
+	if !conditional && b.useLockfile {
+		diags = diags.Append(tfdiags.AttributeValue(
+			tfdiags.Error,
+			"S3-compatible endpoint does not support conditional writes",
+			`Locking with "use_lockfile" relies on conditional writes, which prevent two runs from acquiring the same lock, but the S3-compatible endpoint overwrote an existing object that was uploaded with the "If-None-Match" header. Use "dynamodb_table" for locking instead, or use an object store that supports conditional writes.`,
+			cty.GetAttrPath("use_lockfile"),
+		))
+	}
+
+	return []backend.Capability{
+		{Name: capabilityChecksums, Supported: checksums},
+		{Name: capabilityConditionalWrites, Supported: conditional},
+		{Name: capabilityVersioning, Supported: versioning},
+	}, diags
+}
+
+// probeVersioning returns whether versioning is enabled for the bucket.
+// Errors are treated as versioning being unavailable, since some
+// S3-compatible object stores don't implement the API at all.
+func (b *Backend) probeVersioning(ctx context.Context) bool {
+	out, err := b.s3Client.GetBucketVersioning(ctx, &s3.GetBucketVersioningInput{
+		Bucket: aws.String(b.bucketName),
+	}, s3optDisableDefaultChecksum(b.skipS3Checksum))
+	if err != nil {
+		log.Printf("[DEBUG] Unable to read the versioning status of S3 bucket %q: %s", b.bucketName, err)
+		return false
+	}
+	return out.Status == types.BucketVersioningStatusEnabled
+}
+
+// putProbeObject uploads the capability probe object, with the
+// "If-None-Match" header when ifNoneMatch is set, using the same options as
+// for the state object.
+func (c *RemoteClient) putProbeObject(ctx context.Context, ifNoneMatch bool) error {
+	data := []byte("{}")
+	i := &s3.PutObjectInput{
+		ContentType:   aws.String(contentTypeJSON),
+		ContentLength: aws.Int64(int64(len(data))),
+		Body:          bytes.NewReader(data),
+		Bucket:        aws.String(c.bucketName),
+		Key:           aws.String(c.path),
+	}
+	if ifNoneMatch {
+		i.IfNoneMatch = aws.String("*")
+	}
+	c.configurePutObjectChecksum(data, i)
+	c.configurePutObjectEncryption(i)
+	c.configurePutObjectACL(i)
+
+	log.Printf("[DEBUG] Uploading S3 capability probe object: %#v", i)
+	_, err := c.s3Client.PutObject(ctx, i, s3optDisableDefaultChecksum(c.skipS3Checksum))
+	return err
+}
+
+// isPreconditionFailed returns whether the given error is the response to a
+// conditional request whose condition wasn't met.
+func isPreconditionFailed(err error) bool {
+	var apiErr smithy.APIError
+	return errors.As(err, &apiErr) && apiErr.ErrorCode() == "PreconditionFailed"
+}
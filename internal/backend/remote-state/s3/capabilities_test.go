@@ -0,0 +1,189 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/opentofu/opentofu/internal/backend"
+	"github.com/opentofu/opentofu/internal/encryption"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// fakeObjectStore is a minimal S3-compatible object store that only
+// implements the requests made while probing capabilities.
+type fakeObjectStore struct {
+	versioning  bool
+	checksums   bool
+	conditional bool
+
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func (s *fakeObjectStore) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	writeError := func(status int, code string) {
+		w.WriteHeader(status)
+		_, _ = io.WriteString(w, "<Error><Code>"+code+"</Code><Message>"+code+"</Message></Error>")
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		if !r.URL.Query().Has("versioning") || !s.versioning {
+			writeError(http.StatusNotImplemented, "NotImplemented")
+			return
+		}
+		_, _ = io.WriteString(w, "<VersioningConfiguration><Status>Enabled</Status></VersioningConfiguration>")
+	case http.MethodPut:
+		for name := range r.Header {
+			if strings.HasPrefix(strings.ToLower(name), "x-amz-checksum-") && !s.checksums {
+				writeError(http.StatusBadRequest, "InvalidArgument")
+				return
+			}
+		}
+		if _, exists := s.objects[r.URL.Path]; exists && s.conditional && r.Header.Get("If-None-Match") == "*" {
+			writeError(http.StatusPreconditionFailed, "PreconditionFailed")
+			return
+		}
+		data, _ := io.ReadAll(r.Body)
+		s.objects[r.URL.Path] = data
+	case http.MethodDelete:
+		delete(s.objects, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(http.StatusNotImplemented, "NotImplemented")
+	}
+}
+
+func TestBackendProbeCapabilities(t *testing.T) {
+	tests := map[string]struct {
+		store     *fakeObjectStore
+		bucket    string
+		endpoint  bool
+		config    map[string]any
+		want      []backend.Capability
+		wantDiags tfdiags.Diagnostics
+	}{
+		"full support": {
+			store:    &fakeObjectStore{versioning: true, checksums: true, conditional: true},
+			endpoint: true,
+			config:   map[string]any{"use_lockfile": true},
+			want: []backend.Capability{
+				{Name: "checksums", Supported: true},
+				{Name: "conditional writes", Supported: true},
+				{Name: "versioning", Supported: true},
+			},
+		},
+		"no checksums": {
+			store:    &fakeObjectStore{conditional: true},
+			endpoint: true,
+			want: []backend.Capability{
+				{Name: "checksums", Supported: false},
+				{Name: "conditional writes", Supported: true},
+				{Name: "versioning", Supported: false},
+			},
+			wantDiags: tfdiags.Diagnostics{
+				tfdiags.Sourceless(tfdiags.Warning, "S3-compatible endpoint does not support checksums", ""),
+			},
+		},
+		"checksums skipped": {
+			store:    &fakeObjectStore{conditional: true},
+			endpoint: true,
+			config:   map[string]any{"skip_s3_checksum": true},
+			want: []backend.Capability{
+				{Name: "checksums", Supported: false},
+				{Name: "conditional writes", Supported: true},
+				{Name: "versioning", Supported: false},
+			},
+		},
+		"no conditional writes": {
+			store:    &fakeObjectStore{checksums: true},
+			endpoint: true,
+			want: []backend.Capability{
+				{Name: "checksums", Supported: true},
+				{Name: "conditional writes", Supported: false},
+				{Name: "versioning", Supported: false},
+			},
+		},
+		"no conditional writes with lockfile": {
+			store:    &fakeObjectStore{checksums: true},
+			endpoint: true,
+			config:   map[string]any{"use_lockfile": true},
+			want: []backend.Capability{
+				{Name: "checksums", Supported: true},
+				{Name: "conditional writes", Supported: false},
+				{Name: "versioning", Supported: false},
+			},
+			wantDiags: tfdiags.Diagnostics{
+				tfdiags.Sourceless(tfdiags.Error, "S3-compatible endpoint does not support conditional writes", ""),
+			},
+		},
+		"directory bucket": {
+			bucket: "tofu-state--usw2-az1--x-s3",
+			want: []backend.Capability{
+				{Name: "checksums", Supported: true},
+				{Name: "conditional writes", Supported: true},
+				{Name: "versioning", Supported: false},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			store := test.store
+			if store == nil {
+				store = &fakeObjectStore{}
+			}
+			store.objects = make(map[string][]byte)
+			srv := httptest.NewServer(store)
+			defer srv.Close()
+
+			bucket := test.bucket
+			if bucket == "" {
+				bucket = "tofu-state"
+			}
+			config := map[string]any{
+				"region":                      "us-east-1",
+				"bucket":                      bucket,
+				"key":                         "terraform.tfstate",
+				"access_key":                  "test",
+				"secret_key":                  "test",
+				"skip_credentials_validation": true,
+				"skip_requesting_account_id":  true,
+				"skip_metadata_api_check":     true,
+				"use_path_style":              true,
+			}
+			if test.endpoint {
+				config["endpoints"] = map[string]any{"s3": srv.URL}
+			}
+			for k, v := range test.config {
+				config[k] = v
+			}
+			b := backend.TestBackendConfig(t, New(encryption.StateEncryptionDisabled()), backend.TestWrapConfig(config)).(*Backend)
+
+			got, diags := b.ProbeCapabilities(t.Context())
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("wrong capabilities\n%s", diff)
+			}
+			if diff := cmp.Diff(test.wantDiags, diags, cmp.Comparer(diagnosticSummaryComparer)); diff != "" {
+				t.Errorf("wrong diagnostics\n%s", diff)
+			}
+			if len(store.objects) != 0 {
+				t.Errorf("the probe object was not deleted: %v", store.objects)
+			}
+		})
+	}
+}
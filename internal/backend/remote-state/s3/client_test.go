@@ -32,6 +32,7 @@ import (
 func TestRemoteClient_impl(t *testing.T) {
 	var _ remote.Client = new(RemoteClient)
 	var _ remote.ClientLocker = new(RemoteClient)
+	var _ remote.ClientVersioner = new(RemoteClient)
 }
 
 func TestRemoteClient(t *testing.T) {
@@ -56,6 +57,48 @@ func TestRemoteClient(t *testing.T) {
 	remote.TestClient(t, state.(*remote.State).Client)
 }
 
+func TestRemoteClientVersions(t *testing.T) {
+	testACC(t)
+	bucketName := fmt.Sprintf("%s-%x", testBucketPrefix, time.Now().Unix())
+	keyName := "testState"
+
+	b := backend.TestBackendConfig(t, New(encryption.StateEncryptionDisabled()), backend.TestWrapConfig(map[string]interface{}{
+		"bucket":  bucketName,
+		"key":     keyName,
+		"encrypt": true,
+	})).(*Backend)
+
+	createS3Bucket(t.Context(), t, b.s3Client, bucketName, b.awsConfig.Region)
+	defer deleteS3Bucket(t.Context(), t, b.s3Client, bucketName)
+	_, err := b.s3Client.PutBucketVersioning(t.Context(), &s3.PutBucketVersioningInput{
+		Bucket: &bucketName,
+		VersioningConfiguration: &types.VersioningConfiguration{
+			Status: types.BucketVersioningStatusEnabled,
+		},
+	})
+	if err != nil {
+		t.Fatal("failed to enable versioning on the test S3 bucket:", err)
+	}
+	// The bucket can only be deleted once all of the versions are.
+	defer func() {
+		versions, err := b.s3Client.ListObjectVersions(t.Context(), &s3.ListObjectVersionsInput{Bucket: &bucketName})
+		if err != nil {
+			t.Log("failed to list the object versions of the test S3 bucket:", err)
+			return
+		}
+		for _, v := range versions.Versions {
+			_, _ = b.s3Client.DeleteObject(t.Context(), &s3.DeleteObjectInput{Bucket: &bucketName, Key: v.Key, VersionId: v.VersionId})
+		}
+	}()
+
+	state, err := b.StateMgr(t.Context(), backend.DefaultStateName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	remote.TestClientVersions(t, state.(*remote.State).Client.(remote.ClientVersioner))
+}
+
 func TestRemoteClientLocks(t *testing.T) {
 	testACC(t)
 	bucketName := fmt.Sprintf("%s-%x", testBucketPrefix, time.Now().Unix())
@@ -0,0 +1,97 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	"github.com/opentofu/opentofu/internal/states/remote"
+)
+
+// Versions returns the IDs of the object versions of the state, starting
+// with the latest one. A bucket without versioning only has the latest
+// version of the state, whose ID is "null".
+func (c *RemoteClient) Versions(ctx context.Context) ([]string, error) {
+	ctx, _ = attachLoggerToContext(ctx)
+
+	input := &s3.ListObjectVersionsInput{
+		Bucket: &c.bucketName,
+		Prefix: &c.path,
+	}
+	var ids []string
+	for {
+		output, err := c.s3Client.ListObjectVersions(ctx, input)
+		if err != nil {
+			var nb *types.NoSuchBucket
+			if errors.As(err, &nb) {
+				return nil, fmt.Errorf(errS3NoSuchBucket, err)
+			}
+			return nil, fmt.Errorf("failed to list the versions of the state: %w", err)
+		}
+		// The versions of each key are listed from the latest to the
+		// oldest, and the prefix can also match the keys of other objects.
+		for _, v := range output.Versions {
+			if aws.ToString(v.Key) == c.path && v.VersionId != nil {
+				ids = append(ids, *v.VersionId)
+			}
+		}
+		if !aws.ToBool(output.IsTruncated) {
+			return ids, nil
+		}
+		input.KeyMarker = output.NextKeyMarker
+		input.VersionIdMarker = output.NextVersionIdMarker
+	}
+}
+
+// GetVersion returns the object version of the state with the given ID.
+func (c *RemoteClient) GetVersion(ctx context.Context, id string) (*remote.Payload, error) {
+	ctx, _ = attachLoggerToContext(ctx)
+
+	input := &s3.GetObjectInput{
+		Bucket:    &c.bucketName,
+		Key:       &c.path,
+		VersionId: aws.String(id),
+	}
+	if c.serverSideEncryption && c.customerEncryptionKey != nil {
+		input.SSECustomerKey = aws.String(base64.StdEncoding.EncodeToString(c.customerEncryptionKey))
+		input.SSECustomerAlgorithm = aws.String(s3EncryptionAlgorithm)
+		input.SSECustomerKeyMD5 = aws.String(c.getSSECustomerKeyMD5())
+	}
+
+	output, err := c.s3Client.GetObject(ctx, input, s3optDisableDefaultChecksum(c.skipS3Checksum))
+	if err != nil {
+		var nk *types.NoSuchKey
+		if errors.As(err, &nk) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer output.Body.Close()
+
+	buf := bytes.NewBuffer(nil)
+	if _, err := io.Copy(buf, output.Body); err != nil {
+		return nil, fmt.Errorf("Failed to read remote state: %w", err)
+	}
+	if buf.Len() == 0 {
+		return nil, nil
+	}
+
+	sum := md5.Sum(buf.Bytes())
+	return &remote.Payload{
+		Data: buf.Bytes(),
+		MD5:  sum[:],
+	}, nil
+}
@@ -549,7 +549,7 @@ func (b *Remote) DeleteWorkspace(_ context.Context, name string, _ bool) error {
 		workspace: &tfe.Workspace{
 			Name: name,
 		},
-		encryption: b.encryption,
+		encryption: b.encryption.ForWorkspace(name),
 	}
 
 	return client.Delete(context.TODO())
@@ -616,10 +616,10 @@ func (b *Remote) StateMgr(ctx context.Context, name string) (statemgr.Full, erro
 		// This is optionally set during OpenTofu Enterprise runs.
 		runID: os.Getenv("TFE_RUN_ID"),
 
-		encryption: b.encryption,
+		encryption: b.encryption.ForWorkspace(name),
 	}
 
-	state := remote.NewState(client, b.encryption)
+	state := remote.NewState(client, client.encryption)
 	if client.runID != "" {
 		// client.runID will be set if we're running a Terraform Cloud
 		// or Terraform Enterprise remote execution environment, in which
@@ -19,6 +19,8 @@ import (
 	tfe "github.com/hashicorp/go-tfe"
 
 	"github.com/opentofu/opentofu/internal/backend"
+	"github.com/opentofu/opentofu/internal/command/views"
+	viewsjson "github.com/opentofu/opentofu/internal/command/views/json"
 	"github.com/opentofu/opentofu/internal/logging"
 	"github.com/opentofu/opentofu/internal/plans"
 	"github.com/opentofu/opentofu/internal/tofu"
@@ -294,6 +296,8 @@ func (b *Remote) costEstimate(stopCtx, cancelCtx context.Context, op *backend.Op
 
 			deltaRepr := strings.Replace(ce.DeltaMonthlyCost, "-", "", 1)
 
+			reportCostEstimate(op, ce)
+
 			if b.View != nil {
 				b.View.Output(msgPrefix+":\n", true)
 				b.View.Output(fmt.Sprintf("Resources: %d of %d estimated", ce.MatchedResourcesCount, ce.ResourcesCount), true)
@@ -322,11 +326,13 @@ func (b *Remote) costEstimate(stopCtx, cancelCtx context.Context, op *backend.Op
 			}
 			continue
 		case tfe.CostEstimateSkippedDueToTargeting:
+			reportCostEstimate(op, ce)
 			b.View.Output(msgPrefix+":\n", true)
 			b.View.Output("Not available for this plan, because it was created with the -target option.", false)
 			b.View.Output("\n------------------------------------------------------------------------", false)
 			return nil
 		case tfe.CostEstimateErrored:
+			reportCostEstimate(op, ce)
 			b.View.Output(msgPrefix+" errored.\n", false)
 			b.View.Output("\n------------------------------------------------------------------------", false)
 			return nil
@@ -401,6 +407,11 @@ func (b *Remote) checkPolicy(stopCtx, cancelCtx context.Context, op *backend.Ope
 			}
 		}
 
+		switch pc.Status {
+		case tfe.PolicyPasses, tfe.PolicyErrored, tfe.PolicyHardFailed, tfe.PolicySoftFailed:
+			reportPolicyCheck(op, pc)
+		}
+
 		switch pc.Status {
 		case tfe.PolicyPasses:
 			if (r.HasChanges && op.Type == backend.OperationTypeApply || i < len(r.PolicyChecks)-1) && b.View != nil {
@@ -454,6 +465,47 @@ func (b *Remote) checkPolicy(stopCtx, cancelCtx context.Context, op *backend.Ope
 	return nil
 }
 
+// reportCostEstimate records the final result of a cost estimate with the
+// operation view, if it supports structured remote run results.
+func reportCostEstimate(op *backend.Operation, ce *tfe.CostEstimate) {
+	rv, ok := op.View.(views.RemoteRunResults)
+	if !ok {
+		return
+	}
+	rv.CostEstimate(&viewsjson.CostEstimate{
+		Status:                string(ce.Status),
+		ResourcesCount:        ce.ResourcesCount,
+		MatchedResourcesCount: ce.MatchedResourcesCount,
+		PriorMonthlyCost:      ce.PriorMonthlyCost,
+		ProposedMonthlyCost:   ce.ProposedMonthlyCost,
+		DeltaMonthlyCost:      ce.DeltaMonthlyCost,
+		ErrorMessage:          ce.ErrorMessage,
+	})
+}
+
+// reportPolicyCheck records the final result of a policy check with the
+// operation view, if it supports structured remote run results.
+func reportPolicyCheck(op *backend.Operation, pc *tfe.PolicyCheck) {
+	rv, ok := op.View.(views.RemoteRunResults)
+	if !ok {
+		return
+	}
+	result := &viewsjson.PolicyCheck{
+		Scope:  string(pc.Scope),
+		Status: string(pc.Status),
+	}
+	if pc.Result != nil {
+		result.Passed = pc.Result.Passed
+		result.AdvisoryFailed = pc.Result.AdvisoryFailed
+		result.SoftFailed = pc.Result.SoftFailed
+		result.HardFailed = pc.Result.HardFailed
+	}
+	if pc.Actions != nil {
+		result.Overridable = pc.Actions.IsOverridable
+	}
+	rv.PolicyCheck(result)
+}
+
 func (b *Remote) confirm(stopCtx context.Context, op *backend.Operation, opts *tofu.InputOpts, r *tfe.Run, keyword string) error {
 	doneCtx, cancel := context.WithCancel(stopCtx)
 	result := make(chan error, 2)
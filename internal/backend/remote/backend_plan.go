@@ -44,7 +44,7 @@ func (b *Remote) opPlan(ctx, stopCtx, cancelCtx context.Context, op *backend.Ope
 		return nil, diags.Err()
 	}
 
-	if b.ContextOpts != nil && b.ContextOpts.Parallelism != defaultParallelism {
+	if b.ContextOpts != nil && (b.ContextOpts.Parallelism != defaultParallelism || b.ContextOpts.ReadParallelism != 0 || b.ContextOpts.WriteParallelism != 0) {
 		diags = diags.Append(tfdiags.Sourceless(
 			tfdiags.Error,
 			"Custom parallelism values are currently not supported",
@@ -80,6 +80,15 @@ func (b *Remote) opPlan(ctx, stopCtx, cancelCtx context.Context, op *backend.Ope
 		))
 	}
 
+	if op.SuggestMovesOut != "" {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Suggesting moves is not currently supported",
+			`The "remote" backend does not currently support suggesting "moved" blocks `+
+				`as part of a plan.`,
+		))
+	}
+
 	if b.hasExplicitVariableValues(ctx, op) {
 		diags = diags.Append(tfdiags.Sourceless(
 			tfdiags.Error,
@@ -137,6 +146,22 @@ func (b *Remote) opPlan(ctx, stopCtx, cancelCtx context.Context, op *backend.Ope
 		))
 	}
 
+	if len(op.RefreshTargets) != 0 {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"-refresh-target option is not supported",
+			"The -refresh-target option is not currently supported for remote plans.",
+		))
+	}
+
+	if len(op.ForceReplacePatterns) != 0 {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"-replace patterns are not supported",
+			"Wildcard patterns in the -replace option are not currently supported for remote plans. Specify the address of each resource instance to replace instead.",
+		))
+	}
+
 	if !op.PlanRefresh {
 		desiredAPIVersion, _ := version.NewVersion("2.4")
 
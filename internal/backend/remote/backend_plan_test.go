@@ -1103,6 +1103,36 @@ func TestRemote_planCostEstimation(t *testing.T) {
 	}
 }
 
+func TestRemote_planCostEstimationJSON(t *testing.T) {
+	b, bCleanup := testBackendDefault(t)
+	defer bCleanup()
+
+	op, view, done := testOperationPlan(t, "./testdata/plan-cost-estimation")
+	b.View = views.NewBackendRemote(view)
+	op.View = views.NewPlan(arguments.ViewOptions{ViewType: arguments.ViewJSON}, view).Operation()
+
+	op.Workspace = backend.DefaultStateName
+
+	run, err := b.Operation(context.Background(), op)
+	if err != nil {
+		t.Fatalf("error starting operation: %v", err)
+	}
+
+	<-run.Done()
+	voutput := done(t)
+	if run.Result != backend.OperationSuccess {
+		t.Fatalf("operation failed: %s", voutput.Stderr())
+	}
+
+	output := voutput.Stdout()
+	if !strings.Contains(output, `"type":"cost_estimate"`) {
+		t.Fatalf("expected cost_estimate message in output: %s", output)
+	}
+	if !strings.Contains(output, `"status":"finished"`) {
+		t.Fatalf("expected finished cost estimate in output: %s", output)
+	}
+}
+
 func TestRemote_planPolicyPass(t *testing.T) {
 	b, bCleanup := testBackendDefault(t)
 	defer bCleanup()
@@ -1138,6 +1168,36 @@ func TestRemote_planPolicyPass(t *testing.T) {
 	}
 }
 
+func TestRemote_planPolicyPassJSON(t *testing.T) {
+	b, bCleanup := testBackendDefault(t)
+	defer bCleanup()
+
+	op, view, done := testOperationPlan(t, "./testdata/plan-policy-passed")
+	b.View = views.NewBackendRemote(view)
+	op.View = views.NewPlan(arguments.ViewOptions{ViewType: arguments.ViewJSON}, view).Operation()
+
+	op.Workspace = backend.DefaultStateName
+
+	run, err := b.Operation(context.Background(), op)
+	if err != nil {
+		t.Fatalf("error starting operation: %v", err)
+	}
+
+	<-run.Done()
+	voutput := done(t)
+	if run.Result != backend.OperationSuccess {
+		t.Fatalf("operation failed: %s", voutput.Stderr())
+	}
+
+	output := voutput.Stdout()
+	if !strings.Contains(output, `"type":"policy_check"`) {
+		t.Fatalf("expected policy_check message in output: %s", output)
+	}
+	if !strings.Contains(output, `"status":"passed"`) {
+		t.Fatalf("expected passed policy check in output: %s", output)
+	}
+}
+
 func TestRemote_planPolicyHardFail(t *testing.T) {
 	b, bCleanup := testBackendDefault(t)
 	defer bCleanup()
@@ -0,0 +1,217 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf
+
+import (
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	mathrand "math/rand/v2"
+
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/gocty"
+
+	"github.com/opentofu/opentofu/internal/configs/configschema"
+	"github.com/opentofu/opentofu/internal/providers"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// Character sets used by terraform_random. The "special" set intentionally
+// avoids quotes, backslashes, and whitespace so that the result can be
+// embedded in most configuration formats without escaping.
+const (
+	randomLowerChars   = "abcdefghijklmnopqrstuvwxyz"
+	randomUpperChars   = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	randomNumericChars = "0123456789"
+	randomSpecialChars = "!#$%&*()-_=+[]{}<>:?"
+)
+
+// randomMaxLength is the largest length that terraform_random will generate.
+const randomMaxLength = 1024
+
+func randomEphemeralSchema() providers.Schema {
+	return providers.Schema{
+		Block: &configschema.Block{
+			Ephemeral: true,
+			Attributes: map[string]*configschema.Attribute{
+				"length":  {Type: cty.Number, Required: true},
+				"lower":   {Type: cty.Bool, Optional: true},
+				"upper":   {Type: cty.Bool, Optional: true},
+				"numeric": {Type: cty.Bool, Optional: true},
+				"special": {Type: cty.Bool, Optional: true},
+				"seed":    {Type: cty.String, Optional: true},
+				"key":     {Type: cty.String, Optional: true, Sensitive: true},
+				"result":  {Type: cty.String, Computed: true, Sensitive: true},
+			},
+		},
+	}
+}
+
+func validateRandomEphemeralConfig(req providers.ValidateEphemeralConfigRequest) (resp providers.ValidateEphemeralConfigResponse) {
+	if req.Config.IsNull() {
+		return resp
+	}
+
+	// Core does not currently validate computed values are not set in the
+	// configuration.
+	if !req.Config.GetAttr("result").IsNull() {
+		resp.Diagnostics = resp.Diagnostics.Append(fmt.Errorf(`"result" attribute is read-only`))
+	}
+
+	if length := req.Config.GetAttr("length"); length.IsKnown() && !length.IsNull() {
+		var n int
+		if err := gocty.FromCtyValue(length, &n); err != nil || n < 1 || n > randomMaxLength {
+			resp.Diagnostics = resp.Diagnostics.Append(tfdiags.AttributeValue(
+				tfdiags.Error,
+				"Invalid length",
+				fmt.Sprintf("The length must be a whole number between 1 and %d.", randomMaxLength),
+				cty.GetAttrPath("length"),
+			))
+		}
+	}
+
+	if charset, known := randomCharset(req.Config); known && charset == "" {
+		resp.Diagnostics = resp.Diagnostics.Append(tfdiags.AttributeValue(
+			tfdiags.Error,
+			"No characters available",
+			`At least one of "lower", "upper", "numeric", or "special" must be enabled.`,
+			cty.Path{},
+		))
+	}
+
+	seed, key := req.Config.GetAttr("seed"), req.Config.GetAttr("key")
+	if seed.IsKnown() && key.IsKnown() && seed.IsNull() != key.IsNull() {
+		resp.Diagnostics = resp.Diagnostics.Append(tfdiags.AttributeValue(
+			tfdiags.Error,
+			"Incomplete seed configuration",
+			`The "seed" and "key" arguments must be set together.`,
+			cty.Path{},
+		))
+	}
+	return resp
+}
+
+// randomCharset returns the set of characters that can appear in the result
+// for the given configuration, or false if that cannot be determined yet
+// because some of the settings are unknown.
+//
+// All of the character classes are enabled unless explicitly disabled.
+func randomCharset(config cty.Value) (string, bool) {
+	var charset string
+	for _, class := range []struct {
+		attr  string
+		chars string
+	}{
+		{"lower", randomLowerChars},
+		{"upper", randomUpperChars},
+		{"numeric", randomNumericChars},
+		{"special", randomSpecialChars},
+	} {
+		v := config.GetAttr(class.attr)
+		if !v.IsKnown() {
+			return "", false
+		}
+		if v.IsNull() || v.True() {
+			charset += class.chars
+		}
+	}
+	return charset, true
+}
+
+// openRandomEphemeralResource generates the result each time it is called.
+// Without a seed the result is new every time; with a seed from a
+// terraform_random_seed resource it is derived from that seed and so stays
+// the same until the seed is replaced. Either way the result is only ever
+// held in memory, and so it is never persisted in either the state or a
+// saved plan.
+func openRandomEphemeralResource(req providers.OpenEphemeralResourceRequest) (resp providers.OpenEphemeralResourceResponse) {
+	source := rand.Reader
+	if !req.Config.GetAttr("seed").IsNull() {
+		var err error
+		source, err = seededRandomSource(req.Config)
+		if err != nil {
+			resp.Diagnostics = resp.Diagnostics.Append(tfdiags.AttributeValue(
+				tfdiags.Error,
+				"Invalid seed",
+				fmt.Sprintf("Cannot use the given seed: %s.", err),
+				cty.GetAttrPath("seed"),
+			))
+			return resp
+		}
+	}
+
+	result, err := generateRandomString(req.Config, source)
+	if err != nil {
+		resp.Diagnostics = resp.Diagnostics.Append(tfdiags.AttributeValue(
+			tfdiags.Error,
+			"Error generating random result",
+			err.Error(),
+			cty.GetAttrPath("result"),
+		))
+		return resp
+	}
+
+	newVal := req.Config.AsValueMap()
+	newVal["result"] = cty.StringVal(result)
+	resp.Result = cty.ObjectVal(newVal)
+	return resp
+}
+
+// seededRandomSource decrypts the configured seed and returns a deterministic
+// random source derived from it. The length and the enabled character classes
+// are mixed into the derivation so that changing them gives an unrelated
+// result rather than a prefix or a remapping of the previous one.
+func seededRandomSource(config cty.Value) (io.Reader, error) {
+	key := config.GetAttr("key")
+	if key.IsNull() {
+		return nil, fmt.Errorf(`the "key" argument is required with "seed"`)
+	}
+	seed, err := decryptRandomSeed(config.GetAttr("seed").AsString(), key.AsString())
+	if err != nil {
+		return nil, err
+	}
+	charset, _ := randomCharset(config)
+	info := fmt.Sprintf("terraform_random v1 length=%s charset=%s", config.GetAttr("length").AsBigFloat().String(), charset)
+	derived, err := hkdf.Key(sha256.New, seed, nil, info, 32)
+	if err != nil {
+		return nil, err
+	}
+	return mathrand.NewChaCha8([32]byte(derived)), nil
+}
+
+// generateRandomString returns a string of the configured length, chosen
+// uniformly from the enabled character classes using bytes read from the
+// given source, which must be cryptographically secure.
+func generateRandomString(config cty.Value, source io.Reader) (string, error) {
+	var length int
+	if err := gocty.FromCtyValue(config.GetAttr("length"), &length); err != nil {
+		return "", fmt.Errorf("invalid length: %w", err)
+	}
+	charset, _ := randomCharset(config)
+	if charset == "" {
+		return "", fmt.Errorf("no character classes are enabled")
+	}
+
+	// Bytes at or above limit are discarded so that every character is
+	// equally likely.
+	limit := 256 - 256%len(charset)
+	result := make([]byte, 0, length)
+	buf := make([]byte, length)
+	for len(result) < length {
+		if _, err := io.ReadFull(source, buf); err != nil {
+			return "", err
+		}
+		for _, b := range buf {
+			if int(b) >= limit || len(result) == length {
+				continue
+			}
+			result = append(result, charset[int(b)%len(charset)])
+		}
+	}
+	return string(result), nil
+}
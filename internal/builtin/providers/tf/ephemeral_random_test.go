@@ -0,0 +1,219 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf
+
+import (
+	"bytes"
+	"crypto/rand"
+	"strings"
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/opentofu/opentofu/internal/providers"
+)
+
+func randomTestConfig(attrs map[string]cty.Value) cty.Value {
+	cfg := map[string]cty.Value{
+		"length":  cty.NumberIntVal(16),
+		"lower":   cty.NullVal(cty.Bool),
+		"upper":   cty.NullVal(cty.Bool),
+		"numeric": cty.NullVal(cty.Bool),
+		"special": cty.NullVal(cty.Bool),
+		"seed":    cty.NullVal(cty.String),
+		"key":     cty.NullVal(cty.String),
+		"result":  cty.NullVal(cty.String),
+	}
+	for k, v := range attrs {
+		cfg[k] = v
+	}
+	return cty.ObjectVal(cfg)
+}
+
+func TestEphemeralRandomValidate(t *testing.T) {
+	tests := map[string]struct {
+		config  cty.Value
+		wantErr string
+	}{
+		"defaults": {
+			config: randomTestConfig(nil),
+		},
+		"unknown length": {
+			config: randomTestConfig(map[string]cty.Value{
+				"length": cty.UnknownVal(cty.Number),
+			}),
+		},
+		"zero length": {
+			config: randomTestConfig(map[string]cty.Value{
+				"length": cty.NumberIntVal(0),
+			}),
+			wantErr: "The length must be a whole number between 1 and 1024.",
+		},
+		"fractional length": {
+			config: randomTestConfig(map[string]cty.Value{
+				"length": cty.NumberFloatVal(1.5),
+			}),
+			wantErr: "The length must be a whole number between 1 and 1024.",
+		},
+		"no character classes": {
+			config: randomTestConfig(map[string]cty.Value{
+				"lower":   cty.False,
+				"upper":   cty.False,
+				"numeric": cty.False,
+				"special": cty.False,
+			}),
+			wantErr: `At least one of "lower", "upper", "numeric", or "special" must be enabled.`,
+		},
+		"read-only result": {
+			config: randomTestConfig(map[string]cty.Value{
+				"result": cty.StringVal("oops"),
+			}),
+			wantErr: `"result" attribute is read-only`,
+		},
+		"seed without key": {
+			config: randomTestConfig(map[string]cty.Value{
+				"seed": cty.StringVal("c2VlZA=="),
+			}),
+			wantErr: `The "seed" and "key" arguments must be set together.`,
+		},
+		"seed with unknown key": {
+			config: randomTestConfig(map[string]cty.Value{
+				"seed": cty.StringVal("c2VlZA=="),
+				"key":  cty.UnknownVal(cty.String),
+			}),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := validateRandomEphemeralConfig(providers.ValidateEphemeralConfigRequest{
+				TypeName: "terraform_random",
+				Config:   test.config,
+			})
+			if test.wantErr == "" {
+				if resp.Diagnostics.HasErrors() {
+					t.Fatalf("unexpected error: %s", resp.Diagnostics.Err())
+				}
+				return
+			}
+			if !resp.Diagnostics.HasErrors() {
+				t.Fatalf("unexpected success; want error %q", test.wantErr)
+			}
+			if got := resp.Diagnostics.Err().Error(); !strings.Contains(got, test.wantErr) {
+				t.Errorf("wrong error\ngot:  %s\nwant: %s", got, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestEphemeralRandomOpen(t *testing.T) {
+	config := randomTestConfig(map[string]cty.Value{
+		"length":  cty.NumberIntVal(24),
+		"special": cty.False,
+	})
+
+	p := NewProvider()
+	openResp := p.OpenEphemeralResource(t.Context(), providers.OpenEphemeralResourceRequest{
+		TypeName: "terraform_random",
+		Config:   config,
+	})
+	if openResp.Diagnostics.HasErrors() {
+		t.Fatal(openResp.Diagnostics.Err())
+	}
+	if openResp.RenewAt != nil {
+		t.Errorf("unexpected RenewAt %s", openResp.RenewAt)
+	}
+	if errs := openResp.Result.Type().TestConformance(randomEphemeralSchema().Block.ImpliedType()); len(errs) != 0 {
+		t.Fatalf("result does not conform to the schema: %v", errs)
+	}
+	if !openResp.Result.GetAttr("length").RawEquals(config.GetAttr("length")) {
+		t.Errorf("wrong length %#v; want %#v", openResp.Result.GetAttr("length"), config.GetAttr("length"))
+	}
+	result := openResp.Result.GetAttr("result").AsString()
+	if len(result) != 24 {
+		t.Errorf("wrong result length %d; want 24", len(result))
+	}
+	if strings.ContainsAny(result, randomSpecialChars) {
+		t.Errorf("result %q contains special characters, but they were disabled", result)
+	}
+
+	closeResp := p.CloseEphemeralResource(t.Context(), providers.CloseEphemeralResourceRequest{
+		TypeName: "terraform_random",
+		Private:  openResp.Private,
+	})
+	if closeResp.Diagnostics.HasErrors() {
+		t.Fatal(closeResp.Diagnostics.Err())
+	}
+}
+
+func TestGenerateRandomString(t *testing.T) {
+	config := randomTestConfig(map[string]cty.Value{
+		"length":  cty.NumberIntVal(64),
+		"lower":   cty.False,
+		"upper":   cty.False,
+		"special": cty.False,
+	})
+
+	got, err := generateRandomString(config, rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 64 {
+		t.Errorf("wrong length %d; want 64", len(got))
+	}
+	if strings.Trim(got, randomNumericChars) != "" {
+		t.Errorf("result %q contains non-numeric characters", got)
+	}
+}
+
+func TestEphemeralRandomOpenSeeded(t *testing.T) {
+	encrypted, err := encryptRandomSeed(bytes.Repeat([]byte{7}, randomSeedLength), "hunter2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	open := func(attrs map[string]cty.Value) providers.OpenEphemeralResourceResponse {
+		t.Helper()
+		cfg := map[string]cty.Value{
+			"seed": cty.StringVal(encrypted),
+			"key":  cty.StringVal("hunter2"),
+		}
+		for k, v := range attrs {
+			cfg[k] = v
+		}
+		return NewProvider().OpenEphemeralResource(t.Context(), providers.OpenEphemeralResourceRequest{
+			TypeName: "terraform_random",
+			Config:   randomTestConfig(cfg),
+		})
+	}
+
+	first := open(nil)
+	if first.Diagnostics.HasErrors() {
+		t.Fatal(first.Diagnostics.Err())
+	}
+	second := open(nil)
+	if second.Diagnostics.HasErrors() {
+		t.Fatal(second.Diagnostics.Err())
+	}
+	if !first.Result.GetAttr("result").RawEquals(second.Result.GetAttr("result")) {
+		t.Error("the same seed produced different results")
+	}
+
+	other := open(map[string]cty.Value{"special": cty.False})
+	if other.Diagnostics.HasErrors() {
+		t.Fatal(other.Diagnostics.Err())
+	}
+	if other.Result.GetAttr("result").RawEquals(first.Result.GetAttr("result")) {
+		t.Error("different settings produced the same result")
+	}
+
+	wrongKey := open(map[string]cty.Value{"key": cty.StringVal("hunter3")})
+	if !wrongKey.Diagnostics.HasErrors() {
+		t.Fatal("unexpected success with the wrong key")
+	}
+	if got, want := wrongKey.Diagnostics.Err().Error(), "encrypted with a different key"; !strings.Contains(got, want) {
+		t.Errorf("wrong error\ngot:  %s\nwant: message containing %q", got, want)
+	}
+}
@@ -45,7 +45,11 @@ func (p *Provider) GetProviderSchema(_ context.Context) providers.GetProviderSch
 			"terraform_remote_state": dataSourceRemoteStateGetSchema(),
 		},
 		ResourceTypes: map[string]providers.Schema{
-			"terraform_data": dataStoreResourceSchema(),
+			"terraform_data":        dataStoreResourceSchema(),
+			"terraform_random_seed": randomSeedResourceSchema(),
+		},
+		EphemeralResources: map[string]providers.Schema{
+			"terraform_random": randomEphemeralSchema(),
 		},
 		Functions: p.getFunctionSpecs(),
 	}
@@ -79,7 +83,10 @@ func (p *Provider) ValidateDataResourceConfig(_ context.Context, req providers.V
 }
 
 // ValidateEphemeralConfig is used to validate the ephemeral resource configuration values.
-func (p *Provider) ValidateEphemeralConfig(context.Context, providers.ValidateEphemeralConfigRequest) providers.ValidateEphemeralConfigResponse {
+func (p *Provider) ValidateEphemeralConfig(_ context.Context, req providers.ValidateEphemeralConfigRequest) providers.ValidateEphemeralConfigResponse {
+	if req.TypeName == "terraform_random" {
+		return validateRandomEphemeralConfig(req)
+	}
 	panic("Should not be called directly, special case for terraform_remote_state")
 }
 
@@ -131,21 +138,39 @@ func (p *Provider) ReadDataSourceEncrypted(ctx context.Context, req providers.Re
 }
 
 // OpenEphemeralResource opens an ephemeral resource returning the ephemeral value returned from the provider.
-func (p *Provider) OpenEphemeralResource(context.Context, providers.OpenEphemeralResourceRequest) providers.OpenEphemeralResourceResponse {
+func (p *Provider) OpenEphemeralResource(_ context.Context, req providers.OpenEphemeralResourceRequest) providers.OpenEphemeralResourceResponse {
+	if req.TypeName == "terraform_random" {
+		return openRandomEphemeralResource(req)
+	}
 	panic("Should not be called directly, special case for terraform_remote_state")
 }
 
 // RenewEphemeralResource is renewing an ephemeral resource returning only the private information from the provider.
-func (p *Provider) RenewEphemeralResource(context.Context, providers.RenewEphemeralResourceRequest) providers.RenewEphemeralResourceResponse {
+func (p *Provider) RenewEphemeralResource(_ context.Context, req providers.RenewEphemeralResourceRequest) providers.RenewEphemeralResourceResponse {
+	if req.TypeName == "terraform_random" {
+		// The result never expires, so there is nothing to renew.
+		return providers.RenewEphemeralResourceResponse{}
+	}
 	panic("Should not be called directly, special case for terraform_remote_state")
 }
 
 // CloseEphemeralResource is closing an ephemeral resource to allow the provider to clean up any possible remote information
 // bound to the previously opened ephemeral resource.
-func (p *Provider) CloseEphemeralResource(context.Context, providers.CloseEphemeralResourceRequest) providers.CloseEphemeralResourceResponse {
+func (p *Provider) CloseEphemeralResource(_ context.Context, req providers.CloseEphemeralResourceRequest) providers.CloseEphemeralResourceResponse {
+	if req.TypeName == "terraform_random" {
+		// The result exists only in memory, so there is nothing to clean up.
+		return providers.CloseEphemeralResourceResponse{}
+	}
 	panic("Should not be called directly, special case for terraform_remote_state")
 }
 
+// ListResource is not supported, because none of the resource types of this
+// provider represent remote objects that could be listed.
+func (p *Provider) ListResource(_ context.Context, req providers.ListResourceRequest) (resp providers.ListResourceResponse) {
+	resp.Diagnostics = resp.Diagnostics.Append(fmt.Errorf("unsupported list resource type %q", req.TypeName))
+	return resp
+}
+
 // Stop is called when the provider should halt any in-flight actions.
 func (p *Provider) Stop(_ context.Context) error {
 	log.Println("[DEBUG] terraform provider cannot Stop")
@@ -153,14 +178,18 @@ func (p *Provider) Stop(_ context.Context) error {
 }
 
 // All the Resource-specific functions are below.
-// The terraform provider supplies a single data source, `terraform_remote_state`
-// and no resources.
+// The terraform provider supplies a single data source, `terraform_remote_state`,
+// the managed resource types `terraform_data` and `terraform_random_seed`, and
+// the ephemeral resource type `terraform_random`.
 
 // UpgradeResourceState is called when the state loader encounters an
 // instance state whose schema version is less than the one reported by the
 // currently-used version of the corresponding provider, and the upgraded
 // result is used for any further processing.
 func (p *Provider) UpgradeResourceState(_ context.Context, req providers.UpgradeResourceStateRequest) providers.UpgradeResourceStateResponse {
+	if req.TypeName == "terraform_random_seed" {
+		return upgradeRandomSeedResourceState(req)
+	}
 	return upgradeDataStoreResourceState(req)
 }
 
@@ -170,12 +199,18 @@ func (p *Provider) UpgradeResourceIdentity(context.Context, providers.UpgradeRes
 
 // ReadResource refreshes a resource and returns its current state.
 func (p *Provider) ReadResource(_ context.Context, req providers.ReadResourceRequest) providers.ReadResourceResponse {
+	if req.TypeName == "terraform_random_seed" {
+		return readRandomSeedResourceState(req)
+	}
 	return readDataStoreResourceState(req)
 }
 
 // PlanResourceChange takes the current state and proposed state of a
 // resource, and returns the planned final state.
 func (p *Provider) PlanResourceChange(_ context.Context, req providers.PlanResourceChangeRequest) providers.PlanResourceChangeResponse {
+	if req.TypeName == "terraform_random_seed" {
+		return planRandomSeedResourceChange(req)
+	}
 	return planDataStoreResourceChange(req)
 }
 
@@ -183,6 +218,9 @@ func (p *Provider) PlanResourceChange(_ context.Context, req providers.PlanResou
 // yet contain unknown computed values, and applies the changes returning
 // the final state.
 func (p *Provider) ApplyResourceChange(_ context.Context, req providers.ApplyResourceChangeRequest) providers.ApplyResourceChangeResponse {
+	if req.TypeName == "terraform_random_seed" {
+		return applyRandomSeedResourceChange(req)
+	}
 	return applyDataStoreResourceChange(req)
 }
 
@@ -191,6 +229,11 @@ func (p *Provider) ImportResourceState(_ context.Context, req providers.ImportRe
 	if req.TypeName == "terraform_data" {
 		return importDataStore(req)
 	}
+	if req.TypeName == "terraform_random_seed" {
+		var resp providers.ImportResourceStateResponse
+		resp.Diagnostics = resp.Diagnostics.Append(fmt.Errorf("terraform_random_seed does not support import; the seed can only be generated by OpenTofu"))
+		return resp
+	}
 
 	panic("unimplemented - terraform_remote_state has no resources")
 }
@@ -204,6 +247,9 @@ func (p *Provider) MoveResourceState(_ context.Context, r providers.MoveResource
 
 // ValidateResourceConfig is used to validate the resource configuration values.
 func (p *Provider) ValidateResourceConfig(_ context.Context, req providers.ValidateResourceConfigRequest) providers.ValidateResourceConfigResponse {
+	if req.TypeName == "terraform_random_seed" {
+		return validateRandomSeedResourceConfig(req)
+	}
 	return validateDataStoreResourceConfig(req)
 }
 
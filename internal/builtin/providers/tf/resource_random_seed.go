@@ -0,0 +1,224 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/zclconf/go-cty/cty"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+
+	"github.com/opentofu/opentofu/internal/configs/configschema"
+	"github.com/opentofu/opentofu/internal/providers"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// The seed of a terraform_random_seed is stored in the state only as
+// AES-256-GCM ciphertext, under a key derived from the configured key_wo
+// with PBKDF2 and a random salt. The encoded value is the base64 encoding of
+// the salt, the nonce, and the ciphertext, in that order.
+const (
+	randomSeedLength     = 32
+	randomSeedSaltLength = 16
+	randomSeedIterations = 600000
+)
+
+func randomSeedResourceSchema() providers.Schema {
+	return providers.Schema{
+		Block: &configschema.Block{
+			Attributes: map[string]*configschema.Attribute{
+				"keepers":        {Type: cty.Map(cty.String), Optional: true},
+				"key_wo":         {Type: cty.String, Required: true, Sensitive: true, WriteOnly: true},
+				"encrypted_seed": {Type: cty.String, Computed: true},
+				"id":             {Type: cty.String, Computed: true},
+			},
+		},
+	}
+}
+
+func validateRandomSeedResourceConfig(req providers.ValidateResourceConfigRequest) (resp providers.ValidateResourceConfigResponse) {
+	if req.Config.IsNull() {
+		return resp
+	}
+
+	// Core does not currently validate computed values are not set in the
+	// configuration.
+	for _, attr := range []string{"id", "encrypted_seed"} {
+		if !req.Config.GetAttr(attr).IsNull() {
+			resp.Diagnostics = resp.Diagnostics.Append(fmt.Errorf(`%q attribute is read-only`, attr))
+		}
+	}
+
+	if key := req.Config.GetAttr("key_wo"); key.IsKnown() && !key.IsNull() && key.AsString() == "" {
+		resp.Diagnostics = resp.Diagnostics.Append(tfdiags.AttributeValue(
+			tfdiags.Error,
+			"Invalid key",
+			"The key used to encrypt the seed must not be empty.",
+			cty.GetAttrPath("key_wo"),
+		))
+	}
+	return resp
+}
+
+func upgradeRandomSeedResourceState(req providers.UpgradeResourceStateRequest) (resp providers.UpgradeResourceStateResponse) {
+	ty := randomSeedResourceSchema().Block.ImpliedType()
+	val, err := ctyjson.Unmarshal(req.RawStateJSON, ty)
+	if err != nil {
+		resp.Diagnostics = resp.Diagnostics.Append(err)
+		return resp
+	}
+
+	resp.UpgradedState = val
+	return resp
+}
+
+func readRandomSeedResourceState(req providers.ReadResourceRequest) (resp providers.ReadResourceResponse) {
+	resp.NewState = req.PriorState
+	return resp
+}
+
+func planRandomSeedResourceChange(req providers.PlanResourceChangeRequest) (resp providers.PlanResourceChangeResponse) {
+	if req.ProposedNewState.IsNull() {
+		// destroy op
+		resp.PlannedState = req.ProposedNewState
+		return resp
+	}
+
+	planned := req.ProposedNewState.AsValueMap()
+	// The key is write-only, so it must never be part of the planned state.
+	planned["key_wo"] = cty.NullVal(cty.String)
+
+	switch {
+	case req.PriorState.IsNull():
+		// Create
+		planned["encrypted_seed"] = cty.UnknownVal(cty.String).RefineNotNull()
+		planned["id"] = cty.UnknownVal(cty.String).RefineNotNull()
+
+	case !req.PriorState.GetAttr("keepers").RawEquals(req.ProposedNewState.GetAttr("keepers")):
+		// The keepers changed, so we need a new seed.
+		resp.RequiresReplace = append(resp.RequiresReplace, cty.GetAttrPath("keepers"))
+		planned["encrypted_seed"] = cty.UnknownVal(cty.String).RefineNotNull()
+		planned["id"] = cty.UnknownVal(cty.String).RefineNotNull()
+	}
+
+	resp.PlannedState = cty.ObjectVal(planned)
+	return resp
+}
+
+func applyRandomSeedResourceChange(req providers.ApplyResourceChangeRequest) (resp providers.ApplyResourceChangeResponse) {
+	if req.PlannedState.IsNull() {
+		resp.NewState = req.PlannedState
+		return resp
+	}
+
+	newState := req.PlannedState.AsValueMap()
+
+	if !req.PlannedState.GetAttr("encrypted_seed").IsKnown() {
+		seed := make([]byte, randomSeedLength)
+		if _, err := rand.Read(seed); err != nil {
+			resp.Diagnostics = resp.Diagnostics.Append(tfdiags.AttributeValue(
+				tfdiags.Error,
+				"Error generating seed",
+				err.Error(),
+				cty.GetAttrPath("encrypted_seed"),
+			))
+			return resp
+		}
+		encrypted, err := encryptRandomSeed(seed, req.Config.GetAttr("key_wo").AsString())
+		if err != nil {
+			resp.Diagnostics = resp.Diagnostics.Append(tfdiags.AttributeValue(
+				tfdiags.Error,
+				"Error encrypting seed",
+				err.Error(),
+				cty.GetAttrPath("encrypted_seed"),
+			))
+			return resp
+		}
+		newState["encrypted_seed"] = cty.StringVal(encrypted)
+
+		idString, err := uuid.GenerateUUID()
+		if err != nil {
+			resp.Diagnostics = resp.Diagnostics.Append(tfdiags.AttributeValue(
+				tfdiags.Error,
+				"Error generating id",
+				err.Error(),
+				cty.GetAttrPath("id"),
+			))
+			return resp
+		}
+		newState["id"] = cty.StringVal(idString)
+	}
+
+	resp.NewState = cty.ObjectVal(newState)
+	return resp
+}
+
+// encryptRandomSeed encrypts the given seed under the given key, returning
+// the encoded result to store in the state.
+func encryptRandomSeed(seed []byte, key string) (string, error) {
+	salt := make([]byte, randomSeedSaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	aead, err := randomSeedCipher(key, salt)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	out := append(salt, nonce...)
+	out = aead.Seal(out, nonce, seed, nil)
+	return base64.StdEncoding.EncodeToString(out), nil
+}
+
+// decryptRandomSeed reverses encryptRandomSeed, returning an error if the
+// encrypted seed is malformed or the key is not the one it was encrypted
+// with.
+func decryptRandomSeed(encrypted string, key string) ([]byte, error) {
+	raw, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return nil, fmt.Errorf("malformed encrypted seed: %w", err)
+	}
+	if len(raw) < randomSeedSaltLength {
+		return nil, errors.New("malformed encrypted seed: too short")
+	}
+	salt, raw := raw[:randomSeedSaltLength], raw[randomSeedSaltLength:]
+	aead, err := randomSeedCipher(key, salt)
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) < aead.NonceSize() {
+		return nil, errors.New("malformed encrypted seed: too short")
+	}
+	nonce, ciphertext := raw[:aead.NonceSize()], raw[aead.NonceSize():]
+	seed, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, errors.New("the seed was encrypted with a different key, or the encrypted seed is corrupted")
+	}
+	return seed, nil
+}
+
+func randomSeedCipher(key string, salt []byte) (cipher.AEAD, error) {
+	derived, err := pbkdf2.Key(sha256.New, key, salt, randomSeedIterations, 32)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(derived)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
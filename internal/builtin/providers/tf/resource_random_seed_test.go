@@ -0,0 +1,173 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/opentofu/opentofu/internal/providers"
+)
+
+func randomSeedTestConfig(keepers cty.Value) cty.Value {
+	return cty.ObjectVal(map[string]cty.Value{
+		"keepers":        keepers,
+		"key_wo":         cty.StringVal("hunter2"),
+		"encrypted_seed": cty.NullVal(cty.String),
+		"id":             cty.NullVal(cty.String),
+	})
+}
+
+func TestRandomSeedValidate(t *testing.T) {
+	cfg := randomSeedTestConfig(cty.NullVal(cty.Map(cty.String))).AsValueMap()
+
+	resp := validateRandomSeedResourceConfig(providers.ValidateResourceConfigRequest{
+		TypeName: "terraform_random_seed",
+		Config:   cty.ObjectVal(cfg),
+	})
+	if resp.Diagnostics.HasErrors() {
+		t.Fatal("unexpected error:", resp.Diagnostics.Err())
+	}
+
+	cfg["key_wo"] = cty.StringVal("")
+	cfg["encrypted_seed"] = cty.StringVal("oops")
+	resp = validateRandomSeedResourceConfig(providers.ValidateResourceConfigRequest{
+		TypeName: "terraform_random_seed",
+		Config:   cty.ObjectVal(cfg),
+	})
+	msg := resp.Diagnostics.Err().Error()
+	if !strings.Contains(msg, `"encrypted_seed" attribute is read-only`) {
+		t.Errorf("missing read-only error in %q", msg)
+	}
+	if !strings.Contains(msg, "must not be empty") {
+		t.Errorf("missing empty key error in %q", msg)
+	}
+}
+
+func TestRandomSeedPlanApply(t *testing.T) {
+	p := NewProvider()
+	schema := randomSeedResourceSchema().Block
+	keepers := cty.MapVal(map[string]cty.Value{"version": cty.StringVal("1")})
+	config := randomSeedTestConfig(keepers)
+
+	// create
+	planResp := p.PlanResourceChange(t.Context(), providers.PlanResourceChangeRequest{
+		TypeName:         "terraform_random_seed",
+		PriorState:       cty.NullVal(schema.ImpliedType()),
+		ProposedNewState: config,
+		Config:           config,
+	})
+	if planResp.Diagnostics.HasErrors() {
+		t.Fatal(planResp.Diagnostics.Err())
+	}
+	if !planResp.PlannedState.GetAttr("key_wo").IsNull() {
+		t.Fatal("write-only key in the planned state")
+	}
+	if planResp.PlannedState.GetAttr("encrypted_seed").IsKnown() {
+		t.Fatal("expected unknown encrypted_seed")
+	}
+
+	applyResp := p.ApplyResourceChange(t.Context(), providers.ApplyResourceChangeRequest{
+		TypeName:     "terraform_random_seed",
+		PriorState:   cty.NullVal(schema.ImpliedType()),
+		PlannedState: planResp.PlannedState,
+		Config:       config,
+	})
+	if applyResp.Diagnostics.HasErrors() {
+		t.Fatal(applyResp.Diagnostics.Err())
+	}
+	state := applyResp.NewState
+	if !state.GetAttr("key_wo").IsNull() {
+		t.Fatal("write-only key in the new state")
+	}
+	if !state.IsWhollyKnown() {
+		t.Fatalf("new state is not wholly known: %#v", state)
+	}
+	encrypted := state.GetAttr("encrypted_seed").AsString()
+	if strings.Contains(encrypted, "hunter2") {
+		t.Fatal("key stored in the state")
+	}
+	seed, err := decryptRandomSeed(encrypted, "hunter2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(seed) != randomSeedLength {
+		t.Fatalf("wrong seed length %d", len(seed))
+	}
+
+	// unchanged keepers keep the same seed
+	proposed := state.AsValueMap()
+	proposed["key_wo"] = cty.NullVal(cty.String)
+	planResp = p.PlanResourceChange(t.Context(), providers.PlanResourceChangeRequest{
+		TypeName:         "terraform_random_seed",
+		PriorState:       state,
+		ProposedNewState: cty.ObjectVal(proposed),
+		Config:           config,
+	})
+	if planResp.Diagnostics.HasErrors() {
+		t.Fatal(planResp.Diagnostics.Err())
+	}
+	if len(planResp.RequiresReplace) != 0 {
+		t.Fatalf("unexpected replacement: %#v", planResp.RequiresReplace)
+	}
+	if !planResp.PlannedState.GetAttr("encrypted_seed").RawEquals(state.GetAttr("encrypted_seed")) {
+		t.Fatal("encrypted_seed changed without a change to keepers")
+	}
+
+	// changed keepers require a new seed
+	proposed["keepers"] = cty.MapVal(map[string]cty.Value{"version": cty.StringVal("2")})
+	planResp = p.PlanResourceChange(t.Context(), providers.PlanResourceChangeRequest{
+		TypeName:         "terraform_random_seed",
+		PriorState:       state,
+		ProposedNewState: cty.ObjectVal(proposed),
+		Config:           randomSeedTestConfig(proposed["keepers"]),
+	})
+	if planResp.Diagnostics.HasErrors() {
+		t.Fatal(planResp.Diagnostics.Err())
+	}
+	if len(planResp.RequiresReplace) != 1 || !planResp.RequiresReplace[0].Equals(cty.GetAttrPath("keepers")) {
+		t.Fatalf("wrong RequiresReplace: %#v", planResp.RequiresReplace)
+	}
+	if planResp.PlannedState.GetAttr("encrypted_seed").IsKnown() {
+		t.Fatal("expected unknown encrypted_seed")
+	}
+}
+
+func TestRandomSeedEncryption(t *testing.T) {
+	seed := bytes.Repeat([]byte{42}, randomSeedLength)
+	encrypted, err := encryptRandomSeed(seed, "correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := decryptRandomSeed(encrypted, "correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, seed) {
+		t.Errorf("wrong seed %x; want %x", got, seed)
+	}
+
+	if _, err := decryptRandomSeed(encrypted, "battery staple"); err == nil {
+		t.Error("unexpected success decrypting with the wrong key")
+	}
+	if _, err := decryptRandomSeed("bm90IGEgc2VlZA==", "correct horse"); err == nil {
+		t.Error("unexpected success decrypting a malformed seed")
+	}
+}
+
+func TestRandomSeedImport(t *testing.T) {
+	resp := NewProvider().ImportResourceState(t.Context(), providers.ImportResourceStateRequest{
+		TypeName: "terraform_random_seed",
+		Target:   providers.ImportTarget{ID: "foo"},
+	})
+	if !resp.Diagnostics.HasErrors() {
+		t.Fatal("unexpected success")
+	}
+}
@@ -103,6 +103,11 @@ type Cloud struct {
 	input bool
 
 	encryption encryption.StateEncryption
+
+	// runMessage is the message template attached to runs created by this
+	// backend. Environment variable references in it are expanded when
+	// each run is created.
+	runMessage string
 }
 
 var _ backend.Backend = (*Cloud)(nil)
@@ -136,6 +141,11 @@ func (b *Cloud) ConfigSchema() *configschema.Block {
 				Optional:    true,
 				Description: schemaDescriptionToken,
 			},
+			"run_message": {
+				Type:        cty.String,
+				Optional:    true,
+				Description: schemaDescriptionRunMessage,
+			},
 		},
 
 		BlockTypes: map[string]*configschema.NestedBlock{
@@ -157,6 +167,11 @@ func (b *Cloud) ConfigSchema() *configschema.Block {
 							Optional:    true,
 							Description: schemaDescriptionTags,
 						},
+						"agent_pool": {
+							Type:        cty.String,
+							Optional:    true,
+							Description: schemaDescriptionAgentPool,
+						},
 					},
 				},
 				Nesting: configschema.NestingSingle,
@@ -226,6 +241,11 @@ func newWorkspacesMappingFromFields(obj cty.Value) WorkspaceMapping {
 		mapping.Project = projectName.AsString()
 	}
 
+	agentPool := config.GetAttr("agent_pool")
+	if !agentPool.IsNull() && agentPool.AsString() != "" {
+		mapping.AgentPool = agentPool.AsString()
+	}
+
 	return mapping
 }
 
@@ -314,6 +334,13 @@ func (b *Cloud) Configure(ctx context.Context, obj cty.Value) tfdiags.Diagnostic
 
 	b.token = token
 
+	// The run message can be set via the configuration or TF_CLOUD_RUN_MESSAGE,
+	// with the configuration taking precedence.
+	b.runMessage = os.Getenv("TF_CLOUD_RUN_MESSAGE")
+	if val := obj.GetAttr("run_message"); !val.IsNull() && val.AsString() != "" {
+		b.runMessage = val.AsString()
+	}
+
 	if b.client == nil {
 		cfg := &tfe.Config{
 			Address:      service.String(),
@@ -473,6 +500,10 @@ func reconcileWorkspaceMappingEnvVars(w *WorkspaceMapping) tfdiags.Diagnostic {
 		w.Project = v
 	}
 
+	if v := os.Getenv("TF_CLOUD_AGENT_POOL"); v != "" && w.AgentPool == "" {
+		w.AgentPool = v
+	}
+
 	return nil
 }
 
@@ -642,7 +673,7 @@ func (b *Cloud) DeleteWorkspace(ctx context.Context, name string, force bool) er
 	}
 
 	// Configure the remote workspace name.
-	State := &State{tfeClient: b.client, organization: b.organization, workspace: workspace, enableIntermediateSnapshots: false, encryption: b.encryption}
+	State := &State{tfeClient: b.client, organization: b.organization, workspace: workspace, enableIntermediateSnapshots: false, encryption: b.encryption.ForWorkspace(name)}
 	return State.Delete(force)
 }
 
@@ -693,7 +724,17 @@ func (b *Cloud) StateMgr(ctx context.Context, name string) (statemgr.Full, error
 		}
 	}
 
-	if err == tfe.ErrResourceNotFound {
+	var configuredAgentPool *tfe.AgentPool
+
+	// Agent pools are configured by name, but the API refers to them by ID.
+	if b.WorkspaceMapping.AgentPool != "" {
+		configuredAgentPool, err = b.findAgentPool(ctx, b.WorkspaceMapping.AgentPool)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if workspace == nil {
 		// Create workspace if it was not found
 
 		// Workspace Create Options
@@ -702,6 +743,10 @@ func (b *Cloud) StateMgr(ctx context.Context, name string) (statemgr.Full, error
 			Tags:    b.WorkspaceMapping.tfeTags(),
 			Project: configuredProject,
 		}
+		if configuredAgentPool != nil {
+			workspaceCreateOptions.ExecutionMode = tfe.String("agent")
+			workspaceCreateOptions.AgentPoolID = tfe.String(configuredAgentPool.ID)
+		}
 
 		// Create project if not exists, otherwise use it
 		if workspaceCreateOptions.Project == nil && b.WorkspaceMapping.Project != "" {
@@ -752,6 +797,18 @@ func (b *Cloud) StateMgr(ctx context.Context, name string) (statemgr.Full, error
 		}
 	}
 
+	if workspaceAgentPoolRequiresUpdate(workspace, configuredAgentPool) {
+		options := tfe.WorkspaceUpdateOptions{
+			ExecutionMode: tfe.String("agent"),
+			AgentPoolID:   tfe.String(configuredAgentPool.ID),
+		}
+		log.Printf("[TRACE] cloud: Setting agent pool %s for cloud backend workspace %s/%s", configuredAgentPool.Name, b.organization, name)
+		workspace, err = b.client.Workspaces.UpdateByID(ctx, workspace.ID, options)
+		if err != nil {
+			return nil, fmt.Errorf("Error updating workspace %s: %w", name, err)
+		}
+	}
+
 	if b.workspaceTagsRequireUpdate(workspace, b.WorkspaceMapping) {
 		options := tfe.WorkspaceAddTagsOptions{
 			Tags: b.WorkspaceMapping.tfeTags(),
@@ -776,7 +833,7 @@ func (b *Cloud) StateMgr(ctx context.Context, name string) (statemgr.Full, error
 		}
 	}
 
-	return &State{tfeClient: b.client, organization: b.organization, workspace: workspace, enableIntermediateSnapshots: false, encryption: b.encryption}, nil
+	return &State{tfeClient: b.client, organization: b.organization, workspace: workspace, enableIntermediateSnapshots: false, encryption: b.encryption.ForWorkspace(name)}, nil
 }
 
 // Operation implements backend.Enhanced.
@@ -1063,6 +1120,43 @@ func (b *Cloud) IsLocalOperations() bool {
 	return b.forceLocal
 }
 
+// findAgentPool returns the agent pool with the given name in the configured
+// organization.
+func (b *Cloud) findAgentPool(ctx context.Context, name string) (*tfe.AgentPool, error) {
+	opts := &tfe.AgentPoolListOptions{
+		Query: name,
+	}
+	for {
+		pools, err := b.client.AgentPools.List(ctx, b.organization, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to retrieve agent pool %s: %w", name, err)
+		}
+		// The query matches partial names, so we must check for the exact one.
+		for _, p := range pools.Items {
+			if p.Name == name {
+				return p, nil
+			}
+		}
+		if pools.Pagination == nil || pools.CurrentPage >= pools.TotalPages {
+			break
+		}
+		opts.PageNumber = pools.NextPage
+	}
+	return nil, fmt.Errorf("Agent pool %s not found in organization %s", name, b.organization)
+}
+
+// workspaceAgentPoolRequiresUpdate returns true if the workspace must be
+// updated to run its operations on the given agent pool.
+func workspaceAgentPoolRequiresUpdate(workspace *tfe.Workspace, pool *tfe.AgentPool) bool {
+	if pool == nil {
+		return false
+	}
+	if workspace.ExecutionMode != "agent" {
+		return true
+	}
+	return workspace.AgentPool == nil || workspace.AgentPool.ID != pool.ID
+}
+
 func (b *Cloud) workspaceTagsRequireUpdate(workspace *tfe.Workspace, workspaceMapping WorkspaceMapping) bool {
 	if workspaceMapping.Strategy() != WorkspaceTagsStrategy {
 		return false
@@ -1083,9 +1177,10 @@ func (b *Cloud) workspaceTagsRequireUpdate(workspace *tfe.Workspace, workspaceMa
 }
 
 type WorkspaceMapping struct {
-	Name    string
-	Project string
-	Tags    []string
+	Name      string
+	Project   string
+	Tags      []string
+	AgentPool string
 }
 
 type workspaceStrategy string
@@ -1288,4 +1383,10 @@ is the primary and recommended strategy to use. This option conflicts with "name
 When configured, only the specified workspace can be used. This option conflicts with "tags".`
 
 	schemaDescriptionProject = `The name of a project that resulting workspace(s) will be created in.`
+
+	schemaDescriptionAgentPool = `The name of an agent pool that the resulting workspace(s) will run their operations on.
+Workspaces will be switched to the "agent" execution mode if necessary.`
+
+	schemaDescriptionRunMessage = `A message to attach to each run created by this configuration. References to
+environment variables, such as "$CI_COMMIT_SHA", are expanded when the run is created.`
 )
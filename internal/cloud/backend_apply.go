@@ -50,7 +50,7 @@ func (b *Cloud) opApply(ctx, stopCtx, cancelCtx context.Context, op *backend.Ope
 		return nil, diags.Err()
 	}
 
-	if b.ContextOpts != nil && b.ContextOpts.Parallelism != defaultParallelism {
+	if b.ContextOpts != nil && (b.ContextOpts.Parallelism != defaultParallelism || b.ContextOpts.ReadParallelism != 0 || b.ContextOpts.WriteParallelism != 0) {
 		diags = diags.Append(tfdiags.Sourceless(
 			tfdiags.Error,
 			"Custom parallelism values are currently not supported",
@@ -87,6 +87,22 @@ func (b *Cloud) opApply(ctx, stopCtx, cancelCtx context.Context, op *backend.Ope
 		))
 	}
 
+	if len(op.RefreshTargets) != 0 {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"-refresh-target option is not supported",
+			"The -refresh-target option is not currently supported for remote plans.",
+		))
+	}
+
+	if len(op.ForceReplacePatterns) != 0 {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"-replace patterns are not supported",
+			"Wildcard patterns in the -replace option are not currently supported for remote plans. Specify the address of each resource instance to replace instead.",
+		))
+	}
+
 	// Return if there are any errors.
 	if diags.HasErrors() {
 		return nil, diags.Err()
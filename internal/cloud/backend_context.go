@@ -94,13 +94,10 @@ func (b *Cloud) LocalRun(ctx context.Context, stopCtx context.Context, op *backe
 	}
 	ret.Config = config
 
-	if op.AllowUnsetVariables {
-		// If we're not going to use the variables in an operation we'll be
-		// more lax about them, stubbing out any unset ones as unknown.
-		// This gives us enough information to produce a consistent context,
-		// but not enough information to run a real operation (plan, apply, etc)
-		ret.PlanOpts.SetVariables = stubAllVariables(op.Variables, config.Module.Variables)
-	} else {
+	// Operations that need all of the variables use the values stored in the
+	// remote workspace for any that aren't set locally, and so can the
+	// operations that allow unset variables if they ask for it.
+	if !op.AllowUnsetVariables || op.UseStoredVariables {
 		// The underlying API expects us to use the opaque workspace id to request
 		// variables, so we'll need to look that up using our organization name
 		// and workspace name.
@@ -141,15 +138,21 @@ func (b *Cloud) LocalRun(ctx context.Context, stopCtx context.Context, op *backe
 				}
 			}
 		}
+	}
 
-		if op.Variables != nil {
-			variables, varDiags := backend.ParseVariableValues(op.Variables, config.Module.Variables)
-			diags = diags.Append(varDiags)
-			if diags.HasErrors() {
-				return nil, nil, diags
-			}
-			ret.PlanOpts.SetVariables = variables
+	if op.AllowUnsetVariables {
+		// If we're not going to use the variables in an operation we'll be
+		// more lax about them, stubbing out any unset ones as unknown.
+		// This gives us enough information to produce a consistent context,
+		// but not enough information to run a real operation (plan, apply, etc)
+		ret.PlanOpts.SetVariables = stubAllVariables(op.Variables, config.Module.Variables)
+	} else if op.Variables != nil {
+		variables, varDiags := backend.ParseVariableValues(op.Variables, config.Module.Variables)
+		diags = diags.Append(varDiags)
+		if diags.HasErrors() {
+			return nil, nil, diags
 		}
+		ret.PlanOpts.SetVariables = variables
 	}
 
 	tfCtx, ctxDiags := tofu.NewContext(&opts)
@@ -6,6 +6,7 @@
 package cloud
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
 
@@ -463,3 +464,62 @@ func TestRemoteVariablesDoNotOverride(t *testing.T) {
 		})
 	}
 }
+
+func TestRemoteContextUseStoredVariables(t *testing.T) {
+	catTerraform := tfe.CategoryTerraform
+	key, value := "key1", "value1"
+
+	for _, useStored := range []bool{false, true} {
+		t.Run(fmt.Sprintf("UseStoredVariables=%t", useStored), func(t *testing.T) {
+			configDir := "./testdata/variables"
+
+			b, bCleanup := testBackendWithName(t)
+			defer bCleanup()
+
+			_, configLoader := initwd.MustLoadConfigForTests(t, configDir, "tests")
+
+			workspaceID, err := b.getRemoteWorkspaceID(t.Context(), testBackendSingleWorkspaceName)
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = b.client.Variables.Create(t.Context(), workspaceID, tfe.VariableCreateOptions{
+				Key:      &key,
+				Value:    &value,
+				Category: &catTerraform,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			op := &backend.Operation{
+				ConfigDir:           configDir,
+				ConfigLoader:        configLoader,
+				StateLocker:         clistate.NewNoopLocker(),
+				Workspace:           testBackendSingleWorkspaceName,
+				AllowUnsetVariables: true,
+				UseStoredVariables:  useStored,
+			}
+
+			lr, _, diags := b.LocalRun(t.Context(), t.Context(), op)
+			if diags.HasErrors() {
+				t.Fatalf("unexpected error\ngot:  %s\nwant: <no error>", diags.Err().Error())
+			}
+			// The state isn't locked when the operation doesn't lock it.
+			stateMgr, _ := b.StateMgr(t.Context(), testBackendSingleWorkspaceName)
+			if _, err := stateMgr.Lock(t.Context(), statemgr.NewLockInfo()); err != nil {
+				t.Fatalf("unexpected error locking state: %s", err.Error())
+			}
+
+			want := cty.UnknownVal(cty.DynamicPseudoType)
+			if useStored {
+				want = cty.StringVal(value)
+			}
+			if got := lr.PlanOpts.SetVariables[key].Value; !got.RawEquals(want) {
+				t.Errorf("wrong value for %s\ngot:  %#v\nwant: %#v", key, got, want)
+			}
+			if got := lr.PlanOpts.SetVariables["key2"].Value; got.IsKnown() {
+				t.Errorf("unset variable key2 has known value %#v", got)
+			}
+		})
+	}
+}
@@ -49,7 +49,7 @@ func (b *Cloud) opPlan(ctx, stopCtx, cancelCtx context.Context, op *backend.Oper
 		return nil, diags.Err()
 	}
 
-	if b.ContextOpts != nil && b.ContextOpts.Parallelism != defaultParallelism {
+	if b.ContextOpts != nil && (b.ContextOpts.Parallelism != defaultParallelism || b.ContextOpts.ReadParallelism != 0 || b.ContextOpts.WriteParallelism != 0) {
 		diags = diags.Append(tfdiags.Sourceless(
 			tfdiags.Error,
 			"Custom parallelism values are currently not supported",
@@ -87,10 +87,34 @@ func (b *Cloud) opPlan(ctx, stopCtx, cancelCtx context.Context, op *backend.Oper
 		))
 	}
 
+	if len(op.RefreshTargets) != 0 {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"-refresh-target option is not supported",
+			"The -refresh-target option is not currently supported for remote plans.",
+		))
+	}
+
+	if len(op.ForceReplacePatterns) != 0 {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"-replace patterns are not supported",
+			"Wildcard patterns in the -replace option are not currently supported for remote plans. Specify the address of each resource instance to replace instead.",
+		))
+	}
+
 	if len(op.GenerateConfigOut) > 0 {
 		diags = diags.Append(genconfig.ValidateTargetFile(op.GenerateConfigOut))
 	}
 
+	if op.SuggestMovesOut != "" {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"-suggest-moves option is not supported",
+			"The -suggest-moves option is not currently supported for remote plans.",
+		))
+	}
+
 	// Return if there are any errors.
 	if diags.HasErrors() {
 		return nil, diags.Err()
@@ -220,6 +244,10 @@ func (b *Cloud) plan(ctx, stopCtx, cancelCtx context.Context, op *backend.Operat
 		SavePlan:             tfe.Bool(op.PlanOutPath != ""),
 	}
 
+	if b.runMessage != "" {
+		runOptions.Message = tfe.String(os.ExpandEnv(b.runMessage))
+	}
+
 	switch op.PlanMode {
 	case plans.NormalMode:
 		// okay, but we don't need to do anything special for this
@@ -560,6 +588,7 @@ func maybeWriteGeneratedConfig(plan *jsonformat.Plan, out string) (diags tfdiags
 				return diags.Append(moreDiags)
 			}
 		}
+		diags = diags.Append(genconfig.WriteSourceMap(writer, out))
 	}
 
 	return diags
@@ -142,6 +142,39 @@ func TestCloud_planJSONBasic(t *testing.T) {
 	}
 }
 
+func TestCloud_planWithRunMessage(t *testing.T) {
+	b, mc, bCleanup := testBackendAndMocksWithName(t)
+	defer bCleanup()
+
+	t.Setenv("CI_COMMIT_SHA", "abc123")
+	b.runMessage = "Triggered by commit $CI_COMMIT_SHA"
+
+	op, view, done := testOperationPlan(t, "./testdata/plan")
+	b.View = views.NewBackendRemote(view)
+
+	op.Workspace = testBackendSingleWorkspaceName
+
+	run, err := b.Operation(context.Background(), op)
+	if err != nil {
+		t.Fatalf("error starting operation: %v", err)
+	}
+
+	<-run.Done()
+	voutput := done(t)
+	if run.Result != backend.OperationSuccess {
+		t.Fatalf("operation failed: %s", voutput.Stderr())
+	}
+
+	if len(mc.Runs.Runs) != 1 {
+		t.Fatalf("expected exactly one run, got %d", len(mc.Runs.Runs))
+	}
+	for _, r := range mc.Runs.Runs {
+		if want := "Triggered by commit abc123"; r.Message != want {
+			t.Fatalf("wrong run message\ngot:  %q\nwant: %q", r.Message, want)
+		}
+	}
+}
+
 func TestCloud_planCanceled(t *testing.T) {
 	b, bCleanup := testBackendWithName(t)
 	defer bCleanup()
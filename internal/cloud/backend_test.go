@@ -62,10 +62,12 @@ func TestCloud_backendWithoutHost(t *testing.T) {
 		"hostname":     cty.NullVal(cty.String),
 		"organization": cty.StringVal("hashicorp"),
 		"token":        cty.NullVal(cty.String),
+		"run_message":  cty.NullVal(cty.String),
 		"workspaces": cty.ObjectVal(map[string]cty.Value{
-			"name":    cty.StringVal(testBackendSingleWorkspaceName),
-			"tags":    cty.NullVal(cty.Set(cty.String)),
-			"project": cty.NullVal(cty.String),
+			"name":       cty.StringVal(testBackendSingleWorkspaceName),
+			"tags":       cty.NullVal(cty.Set(cty.String)),
+			"project":    cty.NullVal(cty.String),
+			"agent_pool": cty.NullVal(cty.String),
 		}),
 	})
 
@@ -120,9 +122,10 @@ func TestCloud_PrepareConfig(t *testing.T) {
 			config: cty.ObjectVal(map[string]cty.Value{
 				"organization": cty.NullVal(cty.String),
 				"workspaces": cty.ObjectVal(map[string]cty.Value{
-					"name":    cty.StringVal("prod"),
-					"tags":    cty.NullVal(cty.Set(cty.String)),
-					"project": cty.NullVal(cty.String),
+					"name":       cty.StringVal("prod"),
+					"tags":       cty.NullVal(cty.Set(cty.String)),
+					"project":    cty.NullVal(cty.String),
+					"agent_pool": cty.NullVal(cty.String),
 				}),
 			}),
 			expectedErr: `Invalid or missing required argument: "organization" must be set in the cloud configuration or as an environment variable: TF_CLOUD_ORGANIZATION.`,
@@ -138,9 +141,10 @@ func TestCloud_PrepareConfig(t *testing.T) {
 			config: cty.ObjectVal(map[string]cty.Value{
 				"organization": cty.StringVal("org"),
 				"workspaces": cty.ObjectVal(map[string]cty.Value{
-					"name":    cty.NullVal(cty.String),
-					"tags":    cty.NullVal(cty.Set(cty.String)),
-					"project": cty.NullVal(cty.String),
+					"name":       cty.NullVal(cty.String),
+					"tags":       cty.NullVal(cty.Set(cty.String)),
+					"project":    cty.NullVal(cty.String),
+					"agent_pool": cty.NullVal(cty.String),
 				}),
 			}),
 			expectedErr: `Invalid workspaces configuration: Missing workspace mapping strategy. Either workspace "tags" or "name" is required.`,
@@ -149,9 +153,10 @@ func TestCloud_PrepareConfig(t *testing.T) {
 			config: cty.ObjectVal(map[string]cty.Value{
 				"organization": cty.StringVal("org"),
 				"workspaces": cty.ObjectVal(map[string]cty.Value{
-					"name":    cty.StringVal("prod"),
-					"tags":    cty.NullVal(cty.Set(cty.String)),
-					"project": cty.NullVal(cty.String),
+					"name":       cty.StringVal("prod"),
+					"tags":       cty.NullVal(cty.Set(cty.String)),
+					"project":    cty.NullVal(cty.String),
+					"agent_pool": cty.NullVal(cty.String),
 				}),
 			}),
 			expectedErr: `Invalid workspaces configuration: Only one of workspace "tags" or "name" is allowed.`,
@@ -166,7 +171,8 @@ func TestCloud_PrepareConfig(t *testing.T) {
 							cty.StringVal("billing"),
 						},
 					),
-					"project": cty.NullVal(cty.String),
+					"project":    cty.NullVal(cty.String),
+					"agent_pool": cty.NullVal(cty.String),
 				}),
 			}),
 			expectedErr: `Invalid workspaces configuration: Only one of workspace "tags" or "name" is allowed.`,
@@ -200,9 +206,10 @@ func TestCloud_PrepareConfigWithEnvVars(t *testing.T) {
 			config: cty.ObjectVal(map[string]cty.Value{
 				"organization": cty.NullVal(cty.String),
 				"workspaces": cty.ObjectVal(map[string]cty.Value{
-					"name":    cty.StringVal("prod"),
-					"tags":    cty.NullVal(cty.Set(cty.String)),
-					"project": cty.NullVal(cty.String),
+					"name":       cty.StringVal("prod"),
+					"tags":       cty.NullVal(cty.Set(cty.String)),
+					"project":    cty.NullVal(cty.String),
+					"agent_pool": cty.NullVal(cty.String),
 				}),
 			}),
 			vars: map[string]string{
@@ -213,9 +220,10 @@ func TestCloud_PrepareConfigWithEnvVars(t *testing.T) {
 			config: cty.ObjectVal(map[string]cty.Value{
 				"organization": cty.NullVal(cty.String),
 				"workspaces": cty.ObjectVal(map[string]cty.Value{
-					"name":    cty.StringVal("prod"),
-					"tags":    cty.NullVal(cty.Set(cty.String)),
-					"project": cty.NullVal(cty.String),
+					"name":       cty.StringVal("prod"),
+					"tags":       cty.NullVal(cty.Set(cty.String)),
+					"project":    cty.NullVal(cty.String),
+					"agent_pool": cty.NullVal(cty.String),
 				}),
 			}),
 			vars:        map[string]string{},
@@ -245,9 +253,10 @@ func TestCloud_PrepareConfigWithEnvVars(t *testing.T) {
 			config: cty.ObjectVal(map[string]cty.Value{
 				"organization": cty.StringVal("organization"),
 				"workspaces": cty.ObjectVal(map[string]cty.Value{
-					"name":    cty.StringVal("prod"),
-					"tags":    cty.NullVal(cty.Set(cty.String)),
-					"project": cty.NullVal(cty.String),
+					"name":       cty.StringVal("prod"),
+					"tags":       cty.NullVal(cty.Set(cty.String)),
+					"project":    cty.NullVal(cty.String),
+					"agent_pool": cty.NullVal(cty.String),
 				}),
 			}),
 		},
@@ -255,9 +264,10 @@ func TestCloud_PrepareConfigWithEnvVars(t *testing.T) {
 			config: cty.ObjectVal(map[string]cty.Value{
 				"organization": cty.StringVal("organization"),
 				"workspaces": cty.ObjectVal(map[string]cty.Value{
-					"name":    cty.StringVal("prod"),
-					"tags":    cty.NullVal(cty.Set(cty.String)),
-					"project": cty.NullVal(cty.String),
+					"name":       cty.StringVal("prod"),
+					"tags":       cty.NullVal(cty.Set(cty.String)),
+					"project":    cty.NullVal(cty.String),
+					"agent_pool": cty.NullVal(cty.String),
 				}),
 			}),
 			vars: map[string]string{
@@ -268,9 +278,10 @@ func TestCloud_PrepareConfigWithEnvVars(t *testing.T) {
 			config: cty.ObjectVal(map[string]cty.Value{
 				"organization": cty.StringVal("organization"),
 				"workspaces": cty.ObjectVal(map[string]cty.Value{
-					"name":    cty.StringVal("prod"),
-					"tags":    cty.NullVal(cty.Set(cty.String)),
-					"project": cty.StringVal("project-name"),
+					"name":       cty.StringVal("prod"),
+					"tags":       cty.NullVal(cty.Set(cty.String)),
+					"project":    cty.StringVal("project-name"),
+					"agent_pool": cty.NullVal(cty.String),
 				}),
 			}),
 			vars: map[string]string{
@@ -283,9 +294,10 @@ func TestCloud_PrepareConfigWithEnvVars(t *testing.T) {
 				"hostname":     cty.StringVal("foo"),
 				"organization": cty.StringVal("bar"),
 				"workspaces": cty.ObjectVal(map[string]cty.Value{
-					"name":    cty.NullVal(cty.String),
-					"project": cty.NullVal(cty.String),
-					"tags":    cty.SetVal([]cty.Value{cty.StringVal("baz"), cty.StringVal("qux")}),
+					"name":       cty.NullVal(cty.String),
+					"project":    cty.NullVal(cty.String),
+					"agent_pool": cty.NullVal(cty.String),
+					"tags":       cty.SetVal([]cty.Value{cty.StringVal("baz"), cty.StringVal("qux")}),
 				}),
 			}),
 			vars: map[string]string{
@@ -302,9 +314,10 @@ func TestCloud_PrepareConfigWithEnvVars(t *testing.T) {
 				"hostname":     cty.StringVal("foo"),
 				"organization": cty.StringVal("bar"),
 				"workspaces": cty.ObjectVal(map[string]cty.Value{
-					"name":    cty.StringVal("not-quxx"),
-					"project": cty.NullVal(cty.String),
-					"tags":    cty.NullVal(cty.Set(cty.String)),
+					"name":       cty.StringVal("not-quxx"),
+					"project":    cty.NullVal(cty.String),
+					"agent_pool": cty.NullVal(cty.String),
+					"tags":       cty.NullVal(cty.Set(cty.String)),
 				}),
 			}),
 			vars: map[string]string{
@@ -317,9 +330,10 @@ func TestCloud_PrepareConfigWithEnvVars(t *testing.T) {
 				"hostname":     cty.StringVal("foo"),
 				"organization": cty.StringVal("bar"),
 				"workspaces": cty.ObjectVal(map[string]cty.Value{
-					"name":    cty.NullVal(cty.String),
-					"tags":    cty.NullVal(cty.Set(cty.String)),
-					"project": cty.NullVal(cty.String),
+					"name":       cty.NullVal(cty.String),
+					"tags":       cty.NullVal(cty.Set(cty.String)),
+					"project":    cty.NullVal(cty.String),
+					"agent_pool": cty.NullVal(cty.String),
 				}),
 			}),
 			vars: map[string]string{
@@ -363,10 +377,12 @@ func TestCloud_config(t *testing.T) {
 				"hostname":     cty.StringVal("nontfe.local"),
 				"organization": cty.StringVal("hashicorp"),
 				"token":        cty.NullVal(cty.String),
+				"run_message":  cty.NullVal(cty.String),
 				"workspaces": cty.ObjectVal(map[string]cty.Value{
-					"name":    cty.StringVal("prod"),
-					"tags":    cty.NullVal(cty.Set(cty.String)),
-					"project": cty.NullVal(cty.String),
+					"name":       cty.StringVal("prod"),
+					"tags":       cty.NullVal(cty.Set(cty.String)),
+					"project":    cty.NullVal(cty.String),
+					"agent_pool": cty.NullVal(cty.String),
 				}),
 			}),
 			confErr: "Host nontfe.local does not provide a tfe service",
@@ -377,10 +393,12 @@ func TestCloud_config(t *testing.T) {
 				"hostname":     cty.StringVal("localhost"),
 				"organization": cty.StringVal("hashicorp"),
 				"token":        cty.NullVal(cty.String),
+				"run_message":  cty.NullVal(cty.String),
 				"workspaces": cty.ObjectVal(map[string]cty.Value{
-					"name":    cty.StringVal("prod"),
-					"tags":    cty.NullVal(cty.Set(cty.String)),
-					"project": cty.NullVal(cty.String),
+					"name":       cty.StringVal("prod"),
+					"tags":       cty.NullVal(cty.Set(cty.String)),
+					"project":    cty.NullVal(cty.String),
+					"agent_pool": cty.NullVal(cty.String),
 				}),
 			}),
 			confErr: "tofu login localhost",
@@ -390,6 +408,7 @@ func TestCloud_config(t *testing.T) {
 				"hostname":     cty.NullVal(cty.String),
 				"organization": cty.StringVal("hashicorp"),
 				"token":        cty.NullVal(cty.String),
+				"run_message":  cty.NullVal(cty.String),
 				"workspaces": cty.ObjectVal(map[string]cty.Value{
 					"name": cty.NullVal(cty.String),
 					"tags": cty.SetVal(
@@ -397,7 +416,8 @@ func TestCloud_config(t *testing.T) {
 							cty.StringVal("billing"),
 						},
 					),
-					"project": cty.NullVal(cty.String),
+					"project":    cty.NullVal(cty.String),
+					"agent_pool": cty.NullVal(cty.String),
 				}),
 			}),
 		},
@@ -406,10 +426,12 @@ func TestCloud_config(t *testing.T) {
 				"hostname":     cty.NullVal(cty.String),
 				"organization": cty.StringVal("hashicorp"),
 				"token":        cty.NullVal(cty.String),
+				"run_message":  cty.NullVal(cty.String),
 				"workspaces": cty.ObjectVal(map[string]cty.Value{
-					"name":    cty.StringVal("prod"),
-					"tags":    cty.NullVal(cty.Set(cty.String)),
-					"project": cty.NullVal(cty.String),
+					"name":       cty.StringVal("prod"),
+					"tags":       cty.NullVal(cty.Set(cty.String)),
+					"project":    cty.NullVal(cty.String),
+					"agent_pool": cty.NullVal(cty.String),
 				}),
 			}),
 		},
@@ -418,10 +440,12 @@ func TestCloud_config(t *testing.T) {
 				"hostname":     cty.NullVal(cty.String),
 				"organization": cty.StringVal("hashicorp"),
 				"token":        cty.NullVal(cty.String),
+				"run_message":  cty.NullVal(cty.String),
 				"workspaces": cty.ObjectVal(map[string]cty.Value{
-					"name":    cty.NullVal(cty.String),
-					"tags":    cty.NullVal(cty.Set(cty.String)),
-					"project": cty.NullVal(cty.String),
+					"name":       cty.NullVal(cty.String),
+					"tags":       cty.NullVal(cty.Set(cty.String)),
+					"project":    cty.NullVal(cty.String),
+					"agent_pool": cty.NullVal(cty.String),
 				}),
 			}),
 			valErr: `Missing workspace mapping strategy.`,
@@ -431,6 +455,7 @@ func TestCloud_config(t *testing.T) {
 				"hostname":     cty.NullVal(cty.String),
 				"organization": cty.StringVal("hashicorp"),
 				"token":        cty.NullVal(cty.String),
+				"run_message":  cty.NullVal(cty.String),
 				"workspaces": cty.ObjectVal(map[string]cty.Value{
 					"name": cty.StringVal("prod"),
 					"tags": cty.SetVal(
@@ -438,7 +463,8 @@ func TestCloud_config(t *testing.T) {
 							cty.StringVal("billing"),
 						},
 					),
-					"project": cty.NullVal(cty.String),
+					"project":    cty.NullVal(cty.String),
+					"agent_pool": cty.NullVal(cty.String),
 				}),
 			}),
 			valErr: `Only one of workspace "tags" or "name" is allowed.`,
@@ -452,6 +478,7 @@ func TestCloud_config(t *testing.T) {
 				"hostname":     cty.StringVal("localhost"),
 				"organization": cty.StringVal("opentofu"),
 				"token":        cty.StringVal("token"),
+				"run_message":  cty.NullVal(cty.String),
 				"workspaces": cty.ObjectVal(map[string]cty.Value{
 					"name": cty.NullVal(cty.String),
 					"tags": cty.SetVal(
@@ -459,7 +486,8 @@ func TestCloud_config(t *testing.T) {
 							cty.StringVal("billing"),
 						},
 					),
-					"project": cty.NullVal(cty.String),
+					"project":    cty.NullVal(cty.String),
+					"agent_pool": cty.NullVal(cty.String),
 				}),
 			}),
 			envVars: map[string]string{
@@ -509,6 +537,7 @@ func TestCloud_configVerifyMinimumTFEVersion(t *testing.T) {
 		"hostname":     cty.StringVal(tfeHost),
 		"organization": cty.StringVal("hashicorp"),
 		"token":        cty.NullVal(cty.String),
+		"run_message":  cty.NullVal(cty.String),
 		"workspaces": cty.ObjectVal(map[string]cty.Value{
 			"name": cty.NullVal(cty.String),
 			"tags": cty.SetVal(
@@ -516,7 +545,8 @@ func TestCloud_configVerifyMinimumTFEVersion(t *testing.T) {
 					cty.StringVal("billing"),
 				},
 			),
-			"project": cty.NullVal(cty.String),
+			"project":    cty.NullVal(cty.String),
+			"agent_pool": cty.NullVal(cty.String),
 		}),
 	})
 
@@ -546,6 +576,7 @@ func TestCloud_configVerifyMinimumTFEVersionInAutomation(t *testing.T) {
 		"hostname":     cty.StringVal(tfeHost),
 		"organization": cty.StringVal("hashicorp"),
 		"token":        cty.NullVal(cty.String),
+		"run_message":  cty.NullVal(cty.String),
 		"workspaces": cty.ObjectVal(map[string]cty.Value{
 			"name": cty.NullVal(cty.String),
 			"tags": cty.SetVal(
@@ -553,7 +584,8 @@ func TestCloud_configVerifyMinimumTFEVersionInAutomation(t *testing.T) {
 					cty.StringVal("billing"),
 				},
 			),
-			"project": cty.NullVal(cty.String),
+			"project":    cty.NullVal(cty.String),
+			"agent_pool": cty.NullVal(cty.String),
 		}),
 	})
 
@@ -590,6 +622,7 @@ func TestCloud_setUnavailableTerraformVersion(t *testing.T) {
 		"hostname":     cty.StringVal(tfeHost),
 		"organization": cty.StringVal("hashicorp"),
 		"token":        cty.NullVal(cty.String),
+		"run_message":  cty.NullVal(cty.String),
 		"workspaces": cty.ObjectVal(map[string]cty.Value{
 			"name": cty.NullVal(cty.String),
 			"tags": cty.SetVal(
@@ -597,7 +630,8 @@ func TestCloud_setUnavailableTerraformVersion(t *testing.T) {
 					cty.StringVal("sometag"),
 				},
 			),
-			"project": cty.NullVal(cty.String),
+			"project":    cty.NullVal(cty.String),
+			"agent_pool": cty.NullVal(cty.String),
 		}),
 	})
 
@@ -651,9 +685,10 @@ func TestCloud_setConfigurationFieldsHappyPath(t *testing.T) {
 				"organization": cty.StringVal("opentofu"),
 				"hostname":     cty.StringVal("opentofu.org"),
 				"workspaces": cty.ObjectVal(map[string]cty.Value{
-					"name":    cty.NullVal(cty.String),
-					"tags":    cty.SetVal([]cty.Value{cty.StringVal("foo"), cty.StringVal("bar")}),
-					"project": cty.NullVal(cty.String),
+					"name":       cty.NullVal(cty.String),
+					"tags":       cty.SetVal([]cty.Value{cty.StringVal("foo"), cty.StringVal("bar")}),
+					"project":    cty.NullVal(cty.String),
+					"agent_pool": cty.NullVal(cty.String),
 				}),
 			}),
 			expectedHostname:      "opentofu.org",
@@ -665,9 +700,10 @@ func TestCloud_setConfigurationFieldsHappyPath(t *testing.T) {
 				"organization": cty.NullVal(cty.String),
 				"hostname":     cty.StringVal("opentofu.org"),
 				"workspaces": cty.ObjectVal(map[string]cty.Value{
-					"name":    cty.StringVal("prod"),
-					"tags":    cty.NullVal(cty.Set(cty.String)),
-					"project": cty.NullVal(cty.String),
+					"name":       cty.StringVal("prod"),
+					"tags":       cty.NullVal(cty.Set(cty.String)),
+					"project":    cty.NullVal(cty.String),
+					"agent_pool": cty.NullVal(cty.String),
 				}),
 			}),
 			expectedHostname:      "opentofu.org",
@@ -678,9 +714,10 @@ func TestCloud_setConfigurationFieldsHappyPath(t *testing.T) {
 				"organization": cty.NullVal(cty.String),
 				"hostname":     cty.StringVal("opentofu.org"),
 				"workspaces": cty.ObjectVal(map[string]cty.Value{
-					"name":    cty.NullVal(cty.String),
-					"tags":    cty.NullVal(cty.Set(cty.String)),
-					"project": cty.StringVal("my-project"),
+					"name":       cty.NullVal(cty.String),
+					"tags":       cty.NullVal(cty.Set(cty.String)),
+					"project":    cty.StringVal("my-project"),
+					"agent_pool": cty.NullVal(cty.String),
 				}),
 			}),
 			expectedHostname:    "opentofu.org",
@@ -691,9 +728,10 @@ func TestCloud_setConfigurationFieldsHappyPath(t *testing.T) {
 				"organization": cty.NullVal(cty.String),
 				"hostname":     cty.StringVal("opentofu.org"),
 				"workspaces": cty.ObjectVal(map[string]cty.Value{
-					"name":    cty.NullVal(cty.String),
-					"tags":    cty.NullVal(cty.Set(cty.String)),
-					"project": cty.NullVal(cty.String),
+					"name":       cty.NullVal(cty.String),
+					"tags":       cty.NullVal(cty.Set(cty.String)),
+					"project":    cty.NullVal(cty.String),
+					"agent_pool": cty.NullVal(cty.String),
 				}),
 			}),
 			expectedHostname: "opentofu.org",
@@ -708,9 +746,10 @@ func TestCloud_setConfigurationFieldsHappyPath(t *testing.T) {
 				"organization": cty.NullVal(cty.String),
 				"hostname":     cty.StringVal("opentofu.org"),
 				"workspaces": cty.ObjectVal(map[string]cty.Value{
-					"name":    cty.NullVal(cty.String),
-					"tags":    cty.SetVal([]cty.Value{cty.StringVal("foo"), cty.StringVal("bar")}),
-					"project": cty.NullVal(cty.String),
+					"name":       cty.NullVal(cty.String),
+					"tags":       cty.SetVal([]cty.Value{cty.StringVal("foo"), cty.StringVal("bar")}),
+					"project":    cty.NullVal(cty.String),
+					"agent_pool": cty.NullVal(cty.String),
 				}),
 			}),
 			envVars: map[string]string{
@@ -725,9 +764,10 @@ func TestCloud_setConfigurationFieldsHappyPath(t *testing.T) {
 				"organization": cty.NullVal(cty.String),
 				"hostname":     cty.StringVal("opentofu.org"),
 				"workspaces": cty.ObjectVal(map[string]cty.Value{
-					"name":    cty.StringVal("my-workspace"),
-					"tags":    cty.NullVal(cty.Set(cty.String)),
-					"project": cty.NullVal(cty.String),
+					"name":       cty.StringVal("my-workspace"),
+					"tags":       cty.NullVal(cty.Set(cty.String)),
+					"project":    cty.NullVal(cty.String),
+					"agent_pool": cty.NullVal(cty.String),
 				}),
 			}),
 			envVars: map[string]string{
@@ -741,9 +781,10 @@ func TestCloud_setConfigurationFieldsHappyPath(t *testing.T) {
 				"organization": cty.NullVal(cty.String),
 				"hostname":     cty.StringVal("opentofu.org"),
 				"workspaces": cty.ObjectVal(map[string]cty.Value{
-					"name":    cty.NullVal(cty.String),
-					"tags":    cty.NullVal(cty.Set(cty.String)),
-					"project": cty.StringVal("old"),
+					"name":       cty.NullVal(cty.String),
+					"tags":       cty.NullVal(cty.Set(cty.String)),
+					"project":    cty.StringVal("old"),
+					"agent_pool": cty.NullVal(cty.String),
 				}),
 			}),
 			envVars: map[string]string{
@@ -757,9 +798,10 @@ func TestCloud_setConfigurationFieldsHappyPath(t *testing.T) {
 				"organization": cty.NullVal(cty.String),
 				"hostname":     cty.StringVal("opentofu.org"),
 				"workspaces": cty.ObjectVal(map[string]cty.Value{
-					"name":    cty.NullVal(cty.String),
-					"tags":    cty.NullVal(cty.Set(cty.String)),
-					"project": cty.NullVal(cty.String),
+					"name":       cty.NullVal(cty.String),
+					"tags":       cty.NullVal(cty.Set(cty.String)),
+					"project":    cty.NullVal(cty.String),
+					"agent_pool": cty.NullVal(cty.String),
 				}),
 			}),
 			envVars: map[string]string{
@@ -772,11 +814,13 @@ func TestCloud_setConfigurationFieldsHappyPath(t *testing.T) {
 			obj: cty.ObjectVal(map[string]cty.Value{
 				"hostname":     cty.StringVal("opentofu.org"),
 				"token":        cty.NullVal(cty.String),
+				"run_message":  cty.NullVal(cty.String),
 				"organization": cty.NullVal(cty.String),
 				"workspaces": cty.ObjectVal(map[string]cty.Value{
-					"name":    cty.NullVal(cty.String),
-					"tags":    cty.NullVal(cty.Set(cty.String)),
-					"project": cty.NullVal(cty.String),
+					"name":       cty.NullVal(cty.String),
+					"tags":       cty.NullVal(cty.Set(cty.String)),
+					"project":    cty.NullVal(cty.String),
+					"agent_pool": cty.NullVal(cty.String),
 				}),
 			}),
 			envVars: map[string]string{
@@ -789,11 +833,13 @@ func TestCloud_setConfigurationFieldsHappyPath(t *testing.T) {
 			obj: cty.ObjectVal(map[string]cty.Value{
 				"hostname":     cty.StringVal("opentofu.org"),
 				"token":        cty.NullVal(cty.String),
+				"run_message":  cty.NullVal(cty.String),
 				"organization": cty.NullVal(cty.String),
 				"workspaces": cty.ObjectVal(map[string]cty.Value{
-					"name":    cty.NullVal(cty.String),
-					"tags":    cty.NullVal(cty.Set(cty.String)),
-					"project": cty.NullVal(cty.String),
+					"name":       cty.NullVal(cty.String),
+					"tags":       cty.NullVal(cty.Set(cty.String)),
+					"project":    cty.NullVal(cty.String),
+					"agent_pool": cty.NullVal(cty.String),
 				}),
 			}),
 			envVars: map[string]string{
@@ -805,11 +851,13 @@ func TestCloud_setConfigurationFieldsHappyPath(t *testing.T) {
 			obj: cty.ObjectVal(map[string]cty.Value{
 				"hostname":     cty.NullVal(cty.String),
 				"token":        cty.NullVal(cty.String),
+				"run_message":  cty.NullVal(cty.String),
 				"organization": cty.NullVal(cty.String),
 				"workspaces": cty.ObjectVal(map[string]cty.Value{
-					"name":    cty.NullVal(cty.String),
-					"tags":    cty.NullVal(cty.Set(cty.String)),
-					"project": cty.NullVal(cty.String),
+					"name":       cty.NullVal(cty.String),
+					"tags":       cty.NullVal(cty.Set(cty.String)),
+					"project":    cty.NullVal(cty.String),
+					"agent_pool": cty.NullVal(cty.String),
 				}),
 			}),
 			envVars: map[string]string{
@@ -904,9 +952,10 @@ func TestCloud_setConfigurationFieldsUnhappyPath(t *testing.T) {
 				"organization": cty.NullVal(cty.String),
 				"hostname":     cty.StringVal("opentofu.org"),
 				"workspaces": cty.ObjectVal(map[string]cty.Value{
-					"name":    cty.StringVal("my-workspace"),
-					"tags":    cty.NullVal(cty.Set(cty.String)),
-					"project": cty.NullVal(cty.String),
+					"name":       cty.StringVal("my-workspace"),
+					"tags":       cty.NullVal(cty.Set(cty.String)),
+					"project":    cty.NullVal(cty.String),
+					"agent_pool": cty.NullVal(cty.String),
 				}),
 			}),
 			envVars: map[string]string{
@@ -1070,6 +1119,56 @@ func TestCloud_StateMgr_versionCheckLatest(t *testing.T) {
 	}
 }
 
+func TestCloud_StateMgr_agentPool(t *testing.T) {
+	b, mc, bCleanup := testBackendAndMocksWithName(t)
+	defer bCleanup()
+
+	pool, err := mc.AgentPools.Create(context.Background(), b.organization, tfe.AgentPoolCreateOptions{
+		Name: tfe.String("ci-agents"),
+	})
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	b.WorkspaceMapping.AgentPool = "ci-agents"
+
+	// The existing workspace must be switched to the agent pool.
+	if _, err := b.StateMgr(t.Context(), testBackendSingleWorkspaceName); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	ws, err := b.client.Workspaces.Read(context.Background(), b.organization, testBackendSingleWorkspaceName)
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	if ws.ExecutionMode != "agent" || ws.AgentPool == nil || ws.AgentPool.ID != pool.ID {
+		t.Fatalf("workspace was not switched to agent pool %s: execution mode %q, agent pool %#v", pool.ID, ws.ExecutionMode, ws.AgentPool)
+	}
+
+	// New workspaces must be created on the agent pool.
+	if err := b.client.Workspaces.Delete(context.Background(), b.organization, testBackendSingleWorkspaceName); err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	if _, err := b.StateMgr(t.Context(), testBackendSingleWorkspaceName); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	ws, err = b.client.Workspaces.Read(context.Background(), b.organization, testBackendSingleWorkspaceName)
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	if ws.ExecutionMode != "agent" || ws.AgentPool == nil || ws.AgentPool.ID != pool.ID {
+		t.Fatalf("workspace was not created on agent pool %s: execution mode %q, agent pool %#v", pool.ID, ws.ExecutionMode, ws.AgentPool)
+	}
+
+	// An agent pool that doesn't exist is an error.
+	b.WorkspaceMapping.AgentPool = "ci"
+	_, err = b.StateMgr(t.Context(), testBackendSingleWorkspaceName)
+	if err == nil {
+		t.Fatal("expected error for nonexistent agent pool")
+	}
+	if got, want := err.Error(), "Agent pool ci not found in organization hashicorp"; got != want {
+		t.Fatalf("wrong error\ngot:  %s\nwant: %s", got, want)
+	}
+}
+
 func TestCloud_VerifyWorkspaceTerraformVersion(t *testing.T) {
 	testCases := []struct {
 		local         string
@@ -1333,10 +1432,12 @@ func TestCloud_ServiceDiscoveryAliases(t *testing.T) {
 		"hostname":     cty.StringVal(tfeHost),
 		"organization": cty.StringVal("hashicorp"),
 		"token":        cty.NullVal(cty.String),
+		"run_message":  cty.NullVal(cty.String),
 		"workspaces": cty.ObjectVal(map[string]cty.Value{
-			"name":    cty.StringVal("prod"),
-			"tags":    cty.NullVal(cty.Set(cty.String)),
-			"project": cty.NullVal(cty.String),
+			"name":       cty.StringVal("prod"),
+			"tags":       cty.NullVal(cty.Set(cty.String)),
+			"project":    cty.NullVal(cty.String),
+			"agent_pool": cty.NullVal(cty.String),
 		}),
 	}))
 	if diag.HasErrors() {
@@ -93,6 +93,7 @@ remote state version.
 var _ statemgr.Full = (*State)(nil)
 var _ statemgr.Migrator = (*State)(nil)
 var _ statemgr.PersistentMeta = (*State)(nil)
+var _ statemgr.Historical = (*State)(nil)
 var _ local.IntermediateStateConditionalPersister = (*State)(nil)
 
 // statemgr.Reader impl.
@@ -449,6 +450,41 @@ func (s *State) getStatePayload() (*remote.Payload, error) {
 	}, nil
 }
 
+// SnapshotBySerial retrieves the state version of the workspace with the
+// given serial.
+func (s *State) SnapshotBySerial(ctx context.Context, serial uint64) (*statefile.File, error) {
+	options := &tfe.StateVersionListOptions{
+		Organization: s.organization,
+		Workspace:    s.workspace.Name,
+	}
+	for {
+		svl, err := s.tfeClient.StateVersions.List(ctx, options)
+		if err != nil {
+			return nil, fmt.Errorf("error listing state versions: %w", err)
+		}
+
+		for _, sv := range svl.Items {
+			if sv.Serial != int64(serial) {
+				continue
+			}
+			state, err := s.tfeClient.StateVersions.Download(ctx, sv.DownloadURL)
+			if err != nil {
+				return nil, fmt.Errorf("error downloading state version %s: %w", sv.ID, err)
+			}
+			return statefile.Read(bytes.NewReader(state), s.encryption)
+		}
+
+		// Exit the loop when we've seen all pages.
+		if svl.Pagination == nil || svl.CurrentPage >= svl.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = svl.NextPage
+	}
+	return nil, statemgr.ErrSnapshotNotFound{Serial: serial}
+}
+
 // Unlock calls the Client's Unlock method if it's implemented.
 func (s *State) Unlock(ctx context.Context, id string) error {
 	s.mu.Lock()
@@ -149,6 +149,35 @@ func TestState(t *testing.T) {
 	}
 }
 
+func TestState_SnapshotBySerial(t *testing.T) {
+	state := testCloudState(t)
+	jsonStateOutputs := []byte(`{"outputs": {}}`)
+
+	for serial := uint64(1); serial <= 3; serial++ {
+		var buf bytes.Buffer
+		sf := statefile.New(statemgr.TestFullInitialState(), "stub-lineage", serial)
+		if err := statefile.Write(sf, &buf, encryption.StateEncryptionDisabled()); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if err := state.uploadState(t.Context(), sf.Lineage, sf.Serial, false, buf.Bytes(), nil, jsonStateOutputs); err != nil {
+			t.Fatalf("put: %s", err)
+		}
+	}
+
+	f, err := state.SnapshotBySerial(t.Context(), 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if f.Serial != 2 || f.Lineage != "stub-lineage" {
+		t.Fatalf("wrong snapshot lineage %q and serial %d", f.Lineage, f.Serial)
+	}
+
+	_, err = state.SnapshotBySerial(t.Context(), 4)
+	if _, ok := err.(statemgr.ErrSnapshotNotFound); !ok {
+		t.Fatalf("wrong error for a missing serial: %v", err)
+	}
+}
+
 func TestCloudLocks(t *testing.T) {
 	back, bCleanup := testBackendWithName(t)
 	defer bCleanup()
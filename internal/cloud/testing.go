@@ -96,10 +96,12 @@ func testBackendAndMocksWithName(t *testing.T) (*Cloud, *MockClient, func()) {
 		"hostname":     cty.StringVal(tfeHost),
 		"organization": cty.StringVal("hashicorp"),
 		"token":        cty.NullVal(cty.String),
+		"run_message":  cty.NullVal(cty.String),
 		"workspaces": cty.ObjectVal(map[string]cty.Value{
-			"name":    cty.StringVal(testBackendSingleWorkspaceName),
-			"tags":    cty.NullVal(cty.Set(cty.String)),
-			"project": cty.NullVal(cty.String),
+			"name":       cty.StringVal(testBackendSingleWorkspaceName),
+			"tags":       cty.NullVal(cty.Set(cty.String)),
+			"project":    cty.NullVal(cty.String),
+			"agent_pool": cty.NullVal(cty.String),
 		}),
 	})
 	return testBackend(t, obj, defaultTFCPing)
@@ -110,6 +112,7 @@ func testBackendWithTags(t *testing.T) (*Cloud, func()) {
 		"hostname":     cty.StringVal(tfeHost),
 		"organization": cty.StringVal("hashicorp"),
 		"token":        cty.NullVal(cty.String),
+		"run_message":  cty.NullVal(cty.String),
 		"workspaces": cty.ObjectVal(map[string]cty.Value{
 			"name": cty.NullVal(cty.String),
 			"tags": cty.SetVal(
@@ -117,7 +120,8 @@ func testBackendWithTags(t *testing.T) (*Cloud, func()) {
 					cty.StringVal("billing"),
 				},
 			),
-			"project": cty.NullVal(cty.String),
+			"project":    cty.NullVal(cty.String),
+			"agent_pool": cty.NullVal(cty.String),
 		}),
 	})
 	b, _, c := testBackend(t, obj, nil)
@@ -129,10 +133,12 @@ func testBackendNoOperations(t *testing.T) (*Cloud, func()) {
 		"hostname":     cty.StringVal(tfeHost),
 		"organization": cty.StringVal("no-operations"),
 		"token":        cty.NullVal(cty.String),
+		"run_message":  cty.NullVal(cty.String),
 		"workspaces": cty.ObjectVal(map[string]cty.Value{
-			"name":    cty.StringVal(testBackendSingleWorkspaceName),
-			"tags":    cty.NullVal(cty.Set(cty.String)),
-			"project": cty.NullVal(cty.String),
+			"name":       cty.StringVal(testBackendSingleWorkspaceName),
+			"tags":       cty.NullVal(cty.Set(cty.String)),
+			"project":    cty.NullVal(cty.String),
+			"agent_pool": cty.NullVal(cty.String),
 		}),
 	})
 	b, _, c := testBackend(t, obj, nil)
@@ -144,10 +150,12 @@ func testBackendWithHandlers(t *testing.T, handlers map[string]func(http.Respons
 		"hostname":     cty.StringVal(tfeHost),
 		"organization": cty.StringVal("hashicorp"),
 		"token":        cty.NullVal(cty.String),
+		"run_message":  cty.NullVal(cty.String),
 		"workspaces": cty.ObjectVal(map[string]cty.Value{
-			"name":    cty.StringVal(testBackendSingleWorkspaceName),
-			"tags":    cty.NullVal(cty.Set(cty.String)),
-			"project": cty.NullVal(cty.String),
+			"name":       cty.StringVal(testBackendSingleWorkspaceName),
+			"tags":       cty.NullVal(cty.Set(cty.String)),
+			"project":    cty.NullVal(cty.String),
+			"agent_pool": cty.NullVal(cty.String),
 		}),
 	})
 	b, _, c := testBackend(t, obj, handlers)
@@ -254,6 +262,7 @@ func testBackend(t *testing.T, obj cty.Value, handlers map[string]func(http.Resp
 	mc := NewMockClient()
 
 	// Replace the services we use with our mock services.
+	b.client.AgentPools = mc.AgentPools
 	b.client.Applies = mc.Applies
 	b.client.ConfigurationVersions = mc.ConfigurationVersions
 	b.client.CostEstimates = mc.CostEstimates
@@ -328,6 +337,7 @@ func testUnconfiguredBackend(t *testing.T) (*Cloud, func()) {
 	mc := NewMockClient()
 
 	// Replace the services we use with our mock services.
+	b.client.AgentPools = mc.AgentPools
 	b.client.Applies = mc.Applies
 	b.client.ConfigurationVersions = mc.ConfigurationVersions
 	b.client.CostEstimates = mc.CostEstimates
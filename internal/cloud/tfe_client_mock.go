@@ -29,6 +29,7 @@ import (
 )
 
 type MockClient struct {
+	AgentPools            *MockAgentPools
 	Applies               *MockApplies
 	ConfigurationVersions *MockConfigurationVersions
 	CostEstimates         *MockCostEstimates
@@ -49,6 +50,7 @@ type MockClient struct {
 
 func NewMockClient() *MockClient {
 	c := &MockClient{}
+	c.AgentPools = newMockAgentPools(c)
 	c.Applies = newMockApplies(c)
 	c.ConfigurationVersions = newMockConfigurationVersions(c)
 	c.CostEstimates = newMockCostEstimates(c)
@@ -68,6 +70,82 @@ func NewMockClient() *MockClient {
 	return c
 }
 
+type MockAgentPools struct {
+	client     *MockClient
+	agentPools map[string]*tfe.AgentPool
+}
+
+func newMockAgentPools(client *MockClient) *MockAgentPools {
+	return &MockAgentPools{
+		client:     client,
+		agentPools: make(map[string]*tfe.AgentPool),
+	}
+}
+
+func (m *MockAgentPools) List(ctx context.Context, organization string, options *tfe.AgentPoolListOptions) (*tfe.AgentPoolList, error) {
+	var items []*tfe.AgentPool
+	for _, p := range m.agentPools {
+		if p.Organization != nil && p.Organization.Name != organization {
+			continue
+		}
+		if options != nil && !strings.Contains(p.Name, options.Query) {
+			continue
+		}
+		items = append(items, p)
+	}
+	return &tfe.AgentPoolList{
+		Items: items,
+		Pagination: &tfe.Pagination{
+			CurrentPage: 1,
+			TotalPages:  1,
+			TotalCount:  len(items),
+		},
+	}, nil
+}
+
+func (m *MockAgentPools) Create(ctx context.Context, organization string, options tfe.AgentPoolCreateOptions) (*tfe.AgentPool, error) {
+	p := &tfe.AgentPool{
+		ID:           GenerateID("apool-"),
+		Name:         *options.Name,
+		Organization: &tfe.Organization{Name: organization},
+	}
+	m.agentPools[p.ID] = p
+	return p, nil
+}
+
+func (m *MockAgentPools) Read(ctx context.Context, agentPoolID string) (*tfe.AgentPool, error) {
+	p, ok := m.agentPools[agentPoolID]
+	if !ok {
+		return nil, tfe.ErrResourceNotFound
+	}
+	return p, nil
+}
+
+func (m *MockAgentPools) ReadWithOptions(ctx context.Context, agentPoolID string, options *tfe.AgentPoolReadOptions) (*tfe.AgentPool, error) {
+	panic("not implemented")
+}
+
+func (m *MockAgentPools) Update(ctx context.Context, agentPool string, options tfe.AgentPoolUpdateOptions) (*tfe.AgentPool, error) {
+	panic("not implemented")
+}
+
+func (m *MockAgentPools) UpdateAllowedWorkspaces(ctx context.Context, agentPool string, options tfe.AgentPoolAllowedWorkspacesUpdateOptions) (*tfe.AgentPool, error) {
+	panic("not implemented")
+}
+
+func (m *MockAgentPools) UpdateAllowedProjects(ctx context.Context, agentPool string, options tfe.AgentPoolAllowedProjectsUpdateOptions) (*tfe.AgentPool, error) {
+	panic("not implemented")
+}
+
+func (m *MockAgentPools) UpdateExcludedWorkspaces(ctx context.Context, agentPool string, options tfe.AgentPoolExcludedWorkspacesUpdateOptions) (*tfe.AgentPool, error) {
+	panic("not implemented")
+}
+
+func (m *MockAgentPools) Delete(ctx context.Context, agentPoolID string) error {
+	delete(m.agentPools, agentPoolID)
+	return nil
+}
+
 type MockApplies struct {
 	client  *MockClient
 	applies map[string]*tfe.Apply
@@ -1404,8 +1482,16 @@ func newMockStateVersions(client *MockClient) *MockStateVersions {
 
 func (m *MockStateVersions) List(ctx context.Context, options *tfe.StateVersionListOptions) (*tfe.StateVersionList, error) {
 	svl := &tfe.StateVersionList{}
-	for _, sv := range m.stateVersions {
-		svl.Items = append(svl.Items, sv)
+	if w, ok := m.client.Workspaces.workspaceNames[options.Workspace]; ok {
+		// The state versions of a workspace are listed from the latest one.
+		svs := m.workspaces[w.ID]
+		for i := len(svs) - 1; i >= 0; i-- {
+			svl.Items = append(svl.Items, m.stateVersions[svs[i]])
+		}
+	} else {
+		for _, sv := range m.stateVersions {
+			svl.Items = append(svl.Items, sv)
+		}
 	}
 
 	svl.Pagination = &tfe.Pagination{
@@ -1721,7 +1807,9 @@ func (m *MockWorkspaces) Create(ctx context.Context, organization string, option
 		options.ExecutionMode = tfe.String("local")
 	} else if options.Operations == nil {
 		options.Operations = tfe.Bool(true)
-		options.ExecutionMode = tfe.String("remote")
+		if options.ExecutionMode == nil {
+			options.ExecutionMode = tfe.String("remote")
+		}
 	}
 	w := &tfe.Workspace{
 		ID:                         GenerateID("ws-"),
@@ -1741,6 +1829,9 @@ func (m *MockWorkspaces) Create(ctx context.Context, organization string, option
 	if options.Project != nil {
 		w.Project = options.Project
 	}
+	if options.AgentPoolID != nil {
+		w.AgentPool = &tfe.AgentPool{ID: *options.AgentPoolID}
+	}
 	if options.AutoApply != nil {
 		w.AutoApply = *options.AutoApply
 	}
@@ -1845,6 +1936,9 @@ func updateMockWorkspaceAttributes(w *tfe.Workspace, options tfe.WorkspaceUpdate
 	if options.ExecutionMode != nil {
 		w.ExecutionMode = *options.ExecutionMode
 	}
+	if options.AgentPoolID != nil {
+		w.AgentPool = &tfe.AgentPool{ID: *options.AgentPoolID}
+	}
 	if options.Name != nil {
 		w.Name = *options.Name
 	}
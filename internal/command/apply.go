@@ -8,6 +8,7 @@ package command
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/opentofu/opentofu/internal/backend"
@@ -32,6 +33,11 @@ func (c *ApplyCommand) Run(rawArgs []string) int {
 	var diags tfdiags.Diagnostics
 	ctx := c.CommandContext()
 
+	// Take out the options for running in several workspaces, keeping the
+	// other arguments to run the command with in each workspace.
+	multi, rawArgs, multiDiags := arguments.ParseMultiWorkspace(rawArgs)
+	workspaceArgs := slices.Clone(rawArgs)
+
 	// Parse and apply global view arguments
 	common, rawArgs := arguments.ParseView(rawArgs)
 	c.View.Configure(common)
@@ -46,19 +52,77 @@ func (c *ApplyCommand) Run(rawArgs []string) int {
 		args, closer, diags = arguments.ParseApply(rawArgs)
 	}
 	defer closer()
+	diags = diags.Append(multiDiags)
+	if multi != nil {
+		diags = diags.Append(validateMultiWorkspace(multi, args.ViewOptions.ViewType, map[string]string{
+			"plan file":  args.PlanPath,
+			"-state":     args.State.StatePath,
+			"-state-out": args.State.StateOutPath,
+			"-backup":    args.State.BackupPath,
+		}))
+		if multi.Parallelism > 1 && args.PlanPath == "" && !args.AutoApprove {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Auto-approve required",
+				"OpenTofu cannot ask for interactive approval in several workspaces at the same time. Either apply saved plan files, enable the -auto-approve option, or remove the -workspace-parallelism option to apply in one workspace at a time.",
+			))
+		}
+	}
 
 	c.View.SetShowSensitive(args.ShowSensitive)
+	c.View.SetShowSchedule(args.ShowSchedule)
 
 	// Instantiate the view, even if there are flag errors, so that we render
 	// diagnostics according to the desired view
 	view := views.NewApply(args.ViewOptions, c.Destroy, c.View)
 
+	// Wrappers can also ask for concise status lines on a separate channel,
+	// whatever the format of the primary output.
+	statusWriter, closeStatus, statusDiags := c.statusChannel()
+	defer closeStatus()
+	diags = diags.Append(statusDiags)
+	var status *views.ApplyStatus
+	if statusWriter != nil {
+		status = views.NewApplyStatus(statusWriter, c.Destroy)
+		view = views.ApplyMulti{view, status}
+	}
+
 	if diags.HasErrors() {
 		view.Diagnostics(diags)
 		view.HelpPrompt()
 		return 1
 	}
 
+	if multi != nil {
+		return c.runInWorkspaces(multi, multiWorkspaceRun{
+			Args: workspaceArgs,
+			Run: func(meta Meta, args []string) int {
+				return (&ApplyCommand{Meta: meta, Destroy: c.Destroy}).Run(args)
+			},
+		})
+	}
+
+	cmdName := "apply"
+	if c.Destroy {
+		cmdName = "destroy"
+	}
+	if diags := c.Meta.checkReadOnly(cmdName); diags.HasErrors() {
+		view.Diagnostics(diags)
+		return 1
+	}
+
+	// Load the attributes whose changes are hidden in the human-readable
+	// plan, which don't affect any other output.
+	if args.ViewOptions.ViewType == arguments.ViewHuman {
+		noise, noiseDiags := c.loadNoiseRules()
+		diags = diags.Append(noiseDiags)
+		if noiseDiags.HasErrors() {
+			view.Diagnostics(diags)
+			return 1
+		}
+		c.View.SetNoiseRules(noise)
+	}
+
 	// Check for user-supplied plugin path
 	var err error
 	if c.pluginPath, err = c.loadPluginPath(); err != nil {
@@ -97,6 +161,8 @@ func (c *ApplyCommand) Run(rawArgs []string) int {
 	// clear path to pass this value down, so we continue to mutate the Meta
 	// object state for now.
 	c.Meta.parallelism = args.Operation.Parallelism
+	c.Meta.readParallelism = args.Operation.ReadParallelism
+	c.Meta.writeParallelism = args.Operation.WriteParallelism
 
 	// Prepare the backend, passing the plan file if present, and the
 	// backend-specific arguments
@@ -121,13 +187,18 @@ func (c *ApplyCommand) Run(rawArgs []string) int {
 	diags = nil
 
 	// Run the operation
+	if status != nil && planFile == nil {
+		status.Planning()
+	}
 	op, diags := c.RunOperation(ctx, be, opReq)
 	view.Diagnostics(diags)
 	if diags.HasErrors() {
+		view.PartialResourceCount()
 		return 1
 	}
 
 	if op.Result != backend.OperationSuccess {
+		view.PartialResourceCount()
 		return op.Result.ExitStatus()
 	}
 
@@ -265,14 +336,20 @@ func (c *ApplyCommand) OperationRequest(
 	opReq := c.Operation(ctx, be, view.Backend(), enc)
 	opReq.AutoApprove = applyArgs.AutoApprove
 	opReq.SuppressForgetErrorsDuringDestroy = applyArgs.SuppressForgetErrorsDuringDestroy
+	opReq.PreflightProviders = applyArgs.PreflightProviders
+	opReq.AllowStalePlan = applyArgs.AllowStalePlan
+	opReq.ApprovalHook = c.ApprovalHook
 	opReq.ConfigDir = "."
 	opReq.PlanMode = applyArgs.Operation.PlanMode
 	opReq.Hooks = view.Hooks()
 	opReq.PlanFile = planFile
 	opReq.PlanRefresh = applyArgs.Operation.Refresh
 	opReq.Targets = applyArgs.Operation.Targets
+	opReq.RefreshTargets = applyArgs.Operation.RefreshTargets
 	opReq.Excludes = applyArgs.Operation.Excludes
 	opReq.ForceReplace = applyArgs.Operation.ForceReplace
+	opReq.ForceReplacePatterns = applyArgs.Operation.ForceReplacePatterns
+	opReq.ForceReplaceReason = applyArgs.Operation.ForceReplaceReason
 	opReq.Type = backend.OperationTypeApply
 	opReq.View = view.Operation()
 
@@ -317,6 +394,11 @@ Usage: tofu [global options] apply [options] [PLAN]
 
 Options:
 
+  -allow-stale-plan            Apply the given saved plan even though the state
+                               was changed by another operation after the plan
+                               was created, as long as that operation changed
+                               none of the resource instances the plan changes.
+
   -auto-approve                Skip interactive approval of plan before applying.
 
   -backup=path                 Path to backup the existing state file before
@@ -354,6 +436,21 @@ Options:
   -parallelism=n               Limit the number of parallel resource operations.
                                Defaults to 10.
 
+  -read-parallelism=n          Limit the number of parallel operations that
+                               only read remote objects, such as refreshing
+                               and reading data sources, to less than
+                               -parallelism, which still limits the total.
+
+  -write-parallelism=n         Limit the number of parallel operations that
+                               create, update or destroy remote objects to
+                               less than -parallelism, which still limits the
+                               total.
+
+  -preflight                   Configure every provider before applying any
+                               changes, so that problems such as invalid
+                               credentials are reported before the apply
+                               starts.
+
   -state=path                  Path to read and save state (unless state-out
                                is specified). Defaults to "terraform.tfstate".
 
@@ -361,6 +458,11 @@ Options:
                                "-state". This can be used to preserve the old
                                state.
 
+  -show-schedule               Every 10 seconds, list the changes that are
+                               still running and what the others are waiting
+                               for, to help find what a long apply is blocked
+                               on.
+
   -show-sensitive              If specified, sensitive values will be displayed.
 
   -suppress-forget-errors      Suppress the error that occurs when a destroy
@@ -376,6 +478,17 @@ Options:
                                files are present, they will be automatically
                                loaded.
 
+  -workspaces=a,b,c            Apply in each of the given workspaces in turn,
+                               and then summarize the results. Any
+                               "{workspace}" in the other options is replaced
+                               with the workspace name, such as in
+                               -var-file=env/{workspace}.tfvars.
+
+  -workspace-parallelism=n     Apply in up to n of the workspaces given with
+                               -workspaces at the same time. Requires
+                               -auto-approve unless applying saved plans.
+                               Defaults to 1.
+
   -json                        Produce output in a machine-readable JSON format,
                                suitable for use in text editor integrations and
                                other automated systems. Always disables color.
@@ -413,6 +526,15 @@ Usage: tofu [global options] destroy [options]
 
 Options:
 
+  -preflight                   Configure every provider before destroying any
+                               objects, so that problems such as invalid
+                               credentials are reported before the destroy
+                               starts.
+
+  -show-schedule               Every 10 seconds, list the objects that are
+                               still being destroyed and what the others are
+                               waiting for.
+
   -suppress-forget-errors      Suppress the error that occurs when a destroy
                                operation completes successfully but leaves
                                forgotten instances behind.
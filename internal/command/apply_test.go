@@ -8,6 +8,7 @@ package command
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -21,6 +22,8 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/opentofu/opentofu/internal/command/arguments"
+	"github.com/opentofu/opentofu/internal/command/views"
+	viewsjson "github.com/opentofu/opentofu/internal/command/views/json"
 	"github.com/opentofu/opentofu/internal/command/workdir"
 	"github.com/zclconf/go-cty/cty"
 
@@ -73,6 +76,141 @@ func TestApply(t *testing.T) {
 		t.Fatal("state should not be nil")
 	}
 }
+
+func TestApply_statusChannel(t *testing.T) {
+	// Create a temporary working directory that is empty
+	td := t.TempDir()
+	testCopyDir(t, testFixturePath("apply"), td)
+	t.Chdir(td)
+
+	statePath := testTempFile(t)
+	statusPath := filepath.Join(td, "status.jsonl")
+	t.Setenv(StatusFDEnvVar, statusPath)
+
+	p := applyFixtureProvider()
+
+	view, done := testView(t)
+	c := &ApplyCommand{
+		Meta: Meta{
+			WorkingDir:       workdir.NewDir("."),
+			testingOverrides: metaOverridesForProvider(p),
+			View:             view,
+		},
+	}
+
+	args := []string{
+		"-state", statePath,
+		"-auto-approve",
+	}
+	code := c.Run(args)
+	output := done(t)
+	if code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, output.Stderr())
+	}
+
+	// The status lines don't change the primary output.
+	if got, want := output.Stdout(), "Apply complete! Resources: 1 added, 0 changed, 0 destroyed."; !strings.Contains(got, want) {
+		t.Errorf("wrong output\ngot: %s\nwant substring: %s", got, want)
+	}
+
+	raw, err := os.ReadFile(statusPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []views.StatusLine
+	for _, line := range strings.Split(strings.TrimSpace(string(raw)), "\n") {
+		var status views.StatusLine
+		if err := json.Unmarshal([]byte(line), &status); err != nil {
+			t.Fatalf("invalid status line %q: %s", line, err)
+		}
+		if status.Timestamp == "" {
+			t.Errorf("status line %q has no timestamp", line)
+		}
+		status.Timestamp = ""
+		got = append(got, status)
+	}
+	want := []views.StatusLine{
+		{Type: "phase", Phase: views.StatusPhasePlanning},
+		{Type: "phase", Phase: views.StatusPhasePlanned, Changes: &viewsjson.ChangeSummary{Add: 1, Operation: viewsjson.OperationApplied}},
+		{Type: "phase", Phase: views.StatusPhaseApplying},
+		{Type: "summary", Result: views.StatusResultComplete, Changes: &viewsjson.ChangeSummary{Add: 1, Operation: viewsjson.OperationApplied}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("wrong status lines\n%s", diff)
+	}
+}
+
+func TestApply_statusChannelInvalid(t *testing.T) {
+	// Create a temporary working directory that is empty
+	td := t.TempDir()
+	testCopyDir(t, testFixturePath("apply"), td)
+	t.Chdir(td)
+
+	t.Setenv(StatusFDEnvVar, filepath.Join(td, "missing", "status.jsonl"))
+
+	p := applyFixtureProvider()
+
+	view, done := testView(t)
+	c := &ApplyCommand{
+		Meta: Meta{
+			WorkingDir:       workdir.NewDir("."),
+			testingOverrides: metaOverridesForProvider(p),
+			View:             view,
+		},
+	}
+
+	code := c.Run([]string{"-auto-approve"})
+	output := done(t)
+	if code != 1 {
+		t.Fatalf("got exit status %d; want 1\nstdout:\n%s", code, output.Stdout())
+	}
+	if got, want := output.Stderr(), "Invalid status channel"; !strings.Contains(got, want) {
+		t.Fatalf("wrong error\ngot: %s\nwant substring: %s", got, want)
+	}
+	if p.PlanResourceChangeCalled {
+		t.Fatal("provider should not have been called")
+	}
+}
+
+func TestApply_readOnly(t *testing.T) {
+	// Create a temporary working directory that is empty
+	td := t.TempDir()
+	testCopyDir(t, testFixturePath("apply"), td)
+	t.Chdir(td)
+
+	statePath := testTempFile(t)
+
+	p := applyFixtureProvider()
+
+	view, done := testView(t)
+	c := &ApplyCommand{
+		Meta: Meta{
+			WorkingDir:       workdir.NewDir("."),
+			testingOverrides: metaOverridesForProvider(p),
+			View:             view,
+			ReadOnly:         true,
+		},
+	}
+
+	args := []string{
+		"-state", statePath,
+		"-auto-approve",
+	}
+	code := c.Run(args)
+	output := done(t)
+	if code != 1 {
+		t.Fatalf("got exit status %d; want 1\nstdout:\n%s", code, output.Stdout())
+	}
+	if got, want := output.Stderr(), `"tofu apply" cannot be used`; !strings.Contains(got, want) {
+		t.Fatalf("wrong error\ngot: %s\nwant substring: %s", got, want)
+	}
+	if p.ApplyResourceChangeCalled || p.PlanResourceChangeCalled {
+		t.Fatal("provider should not have been called in read-only mode")
+	}
+	if _, err := os.Stat(statePath); !os.IsNotExist(err) {
+		t.Fatalf("state file should not have been written")
+	}
+}
 func TestApply_conditionalSensitive(t *testing.T) {
 	// Create a temporary working directory that is empty
 	td := t.TempDir()
@@ -768,6 +906,114 @@ func TestApply_plan_backup(t *testing.T) {
 	runtime.GC()
 }
 
+func TestApply_planStale(t *testing.T) {
+	providerAddr := addrs.AbsProviderConfig{
+		Provider: addrs.NewDefaultProvider("test"),
+		Module:   addrs.RootModule,
+	}
+	fooAddr := addrs.Resource{
+		Mode: addrs.ManagedResourceMode,
+		Type: "test_instance",
+		Name: "foo",
+	}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)
+	barAddr := addrs.Resource{
+		Mode: addrs.ManagedResourceMode,
+		Type: "test_instance",
+		Name: "bar",
+	}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)
+
+	tests := map[string]struct {
+		// otherAddr is the resource instance that another operation creates
+		// after the plan was created.
+		otherAddr addrs.AbsResourceInstance
+		wantErr   string
+	}{
+		"unrelated change": {
+			otherAddr: barAddr,
+		},
+		"overlapping change": {
+			otherAddr: fooAddr,
+			wantErr:   "Saved plan conflicts with the current state",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			statePath := testTempFile(t)
+
+			fs := statemgr.NewFilesystem(statePath, encryption.StateEncryptionDisabled())
+			if err := statemgr.WriteAndPersist(t.Context(), fs, states.NewState(), nil); err != nil {
+				t.Fatal(err)
+			}
+			planPath := applyFixturePlanFileMatchState(t, fs.StateSnapshotMeta())
+
+			// Another operation changes the state after the plan was created.
+			otherState := states.BuildState(func(s *states.SyncState) {
+				s.SetResourceInstanceCurrent(
+					test.otherAddr,
+					&states.ResourceInstanceObjectSrc{
+						AttrsJSON: []byte(`{"id":"other"}`),
+						Status:    states.ObjectReady,
+					},
+					providerAddr,
+					addrs.NoKey,
+				)
+			})
+			if err := statemgr.WriteAndPersist(t.Context(), fs, otherState, nil); err != nil {
+				t.Fatal(err)
+			}
+
+			p := applyFixtureProvider()
+			view, done := testView(t)
+			c := &ApplyCommand{
+				Meta: Meta{
+					WorkingDir:       workdir.NewDir("."),
+					testingOverrides: metaOverridesForProvider(p),
+					View:             view,
+				},
+			}
+
+			args := []string{
+				"-state", statePath,
+				"-allow-stale-plan",
+				planPath,
+			}
+			code := c.Run(args)
+			output := done(t)
+
+			if test.wantErr != "" {
+				if code == 0 {
+					t.Fatalf("unexpected success\n\n%s", output.Stdout())
+				}
+				if got := output.Stderr(); !strings.Contains(got, test.wantErr) {
+					t.Fatalf("wrong error\ngot:  %s\nwant: message containing %q", got, test.wantErr)
+				}
+				if p.ApplyResourceChangeCalled {
+					t.Fatal("provider was asked to apply changes")
+				}
+				return
+			}
+
+			if code != 0 {
+				t.Fatalf("bad: %d\n\n%s", code, output.Stderr())
+			}
+			if got, want := output.Stdout(), "Applying a stale saved plan"; !strings.Contains(got+output.Stderr(), want) {
+				t.Errorf("missing warning %q in output\n%s", want, got)
+			}
+
+			// The state must include both the object created by the plan and
+			// the one created by the other operation.
+			state := testStateRead(t, statePath)
+			if state.ResourceInstance(fooAddr) == nil {
+				t.Errorf("state is missing %s, created by the plan", fooAddr)
+			}
+			if state.ResourceInstance(barAddr) == nil {
+				t.Errorf("state is missing %s, created by the other operation", barAddr)
+			}
+		})
+	}
+}
+
 func TestApply_plan_noBackup(t *testing.T) {
 	planPath := applyFixturePlanFile(t)
 	statePath := testTempFile(t)
@@ -843,6 +1089,10 @@ func TestApply_plan_remoteState(t *testing.T) {
 		"client_certificate_pem":    cty.NullVal(cty.String),
 		"client_private_key_pem":    cty.NullVal(cty.String),
 		"headers":                   cty.NullVal(cty.String),
+		"oauth2_token_url":          cty.NullVal(cty.String),
+		"oauth2_client_id":          cty.NullVal(cty.String),
+		"oauth2_client_secret":      cty.NullVal(cty.String),
+		"oauth2_scopes":             cty.NullVal(cty.List(cty.String)),
 	})
 	backendConfigRaw, err := plans.NewDynamicValue(backendConfig, backendConfig.Type())
 	if err != nil {
@@ -34,6 +34,18 @@ type Apply struct {
 	// SuppressForgetErrorsDuringDestroy suppresses the error that occurs when a
 	// destroy operation completes successfully but leaves forgotten instances behind.
 	SuppressForgetErrorsDuringDestroy bool
+
+	// PreflightProviders requests that every provider configuration be
+	// checked by configuring it before any changes are applied.
+	PreflightProviders bool
+
+	// ShowSchedule requests that a long-running apply periodically report
+	// the changes that are running and what the others are waiting for.
+	ShowSchedule bool
+
+	// AllowStalePlan allows applying a saved plan even though the state has
+	// been changed by another operation since the plan was created.
+	AllowStalePlan bool
 }
 
 // ParseApply processes CLI arguments, returning an Apply value, a closer function, and errors.
@@ -51,6 +63,9 @@ func ParseApply(args []string) (*Apply, func(), tfdiags.Diagnostics) {
 	cmdFlags.BoolVar(&apply.AutoApprove, "auto-approve", false, "auto-approve")
 	cmdFlags.BoolVar(&apply.ShowSensitive, "show-sensitive", false, "displays sensitive values")
 	cmdFlags.BoolVar(&apply.SuppressForgetErrorsDuringDestroy, "suppress-forget-errors", false, "suppress errors in destroy mode due to resources being forgotten")
+	cmdFlags.BoolVar(&apply.PreflightProviders, "preflight", false, "preflight")
+	cmdFlags.BoolVar(&apply.ShowSchedule, "show-schedule", false, "show-schedule")
+	cmdFlags.BoolVar(&apply.AllowStalePlan, "allow-stale-plan", false, "allow-stale-plan")
 
 	apply.ViewOptions.AddFlags(cmdFlags, true)
 
@@ -87,6 +102,14 @@ func ParseApply(args []string) (*Apply, func(), tfdiags.Diagnostics) {
 		))
 	}
 
+	if apply.AllowStalePlan && apply.PlanPath == "" {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid -allow-stale-plan option",
+			"The -allow-stale-plan option is valid only when applying a saved plan file.",
+		))
+	}
+
 	diags = diags.Append(apply.Operation.Parse())
 	closer, moreDiags := apply.ViewOptions.Parse()
 	diags = diags.Append(moreDiags)
@@ -60,6 +60,60 @@ func TestParseApply_basicValid(t *testing.T) {
 				},
 			},
 		},
+		"preflight": {
+			[]string{"-preflight", "saved.tfplan"},
+			&Apply{
+				PreflightProviders: true,
+				ViewOptions: ViewOptions{
+					InputEnabled: true,
+					ViewType:     ViewHuman,
+				},
+				PlanPath: "saved.tfplan",
+				State:    &State{Lock: true},
+				Vars:     &Vars{},
+				Operation: &Operation{
+					PlanMode:    plans.NormalMode,
+					Parallelism: 10,
+					Refresh:     true,
+				},
+			},
+		},
+		"allow stale plan": {
+			[]string{"-allow-stale-plan", "saved.tfplan"},
+			&Apply{
+				AllowStalePlan: true,
+				ViewOptions: ViewOptions{
+					InputEnabled: true,
+					ViewType:     ViewHuman,
+				},
+				PlanPath: "saved.tfplan",
+				State:    &State{Lock: true},
+				Vars:     &Vars{},
+				Operation: &Operation{
+					PlanMode:    plans.NormalMode,
+					Parallelism: 10,
+					Refresh:     true,
+				},
+			},
+		},
+		"show schedule": {
+			[]string{"-show-schedule", "saved.tfplan"},
+			&Apply{
+				ShowSchedule: true,
+				ViewOptions: ViewOptions{
+					InputEnabled: true,
+					ViewType:     ViewHuman,
+				},
+				PlanPath: "saved.tfplan",
+				State:    &State{Lock: true},
+				Vars:     &Vars{},
+				Operation: &Operation{
+					PlanMode:    plans.NormalMode,
+					Parallelism: 10,
+					Refresh:     true,
+				},
+			},
+		},
 		"destroy mode": {
 			[]string{"-destroy"},
 			&Apply{
@@ -179,6 +233,16 @@ func TestParseApply_tooManyArguments(t *testing.T) {
 	}
 }
 
+func TestParseApply_allowStalePlanWithoutPlanFile(t *testing.T) {
+	_, _, diags := ParseApply([]string{"-allow-stale-plan"})
+	if len(diags) == 0 {
+		t.Fatal("expected diags but got none")
+	}
+	if got, want := diags.Err().Error(), "Invalid -allow-stale-plan option"; !strings.Contains(got, want) {
+		t.Fatalf("wrong diags\n got: %s\nwant: %s", got, want)
+	}
+}
+
 func TestParseApply_targets(t *testing.T) {
 	foobarbaz, _ := addrs.ParseTargetStr("foo_bar.baz")
 	boop, _ := addrs.ParseTargetStr("module.boop")
@@ -710,6 +774,61 @@ func TestParseApply_replace(t *testing.T) {
 	}
 }
 
+func TestParseApply_replacePatternsAndReason(t *testing.T) {
+	foobarbaz, _ := addrs.ParseAbsResourceInstanceStr("foo_bar.baz")
+	testCases := map[string]struct {
+		args         []string
+		want         []addrs.AbsResourceInstance
+		wantPatterns []string
+		wantReason   string
+		wantErr      string
+	}{
+		"pattern": {
+			args:         []string{"-replace=foo_bar.baz[*]"},
+			wantPatterns: []string{"foo_bar.baz[*]"},
+		},
+		"address and pattern with reason": {
+			args:         []string{"-replace=foo_bar.baz", "-replace=module.*.foo_bar.beep", "-replace-reason=rotating keys"},
+			want:         []addrs.AbsResourceInstance{foobarbaz},
+			wantPatterns: []string{"module.*.foo_bar.beep"},
+			wantReason:   "rotating keys",
+		},
+		"data resource pattern": {
+			args:    []string{"-replace=data.foo.*"},
+			wantErr: "Only managed resources can be used",
+		},
+		"reason without replace": {
+			args:       []string{"-replace-reason=because"},
+			wantReason: "because",
+			wantErr:    "The -replace-reason option can only be used together with at least one -replace option.",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, _, diags := ParseApply(tc.args)
+			if tc.wantErr == "" && len(diags) > 0 {
+				t.Fatalf("unexpected diags: %v", diags)
+			} else if tc.wantErr != "" {
+				if len(diags) == 0 {
+					t.Fatalf("expected diags but got none")
+				} else if got := diags.Err().Error(); !strings.Contains(got, tc.wantErr) {
+					t.Fatalf("wrong diags\n got: %s\nwant: %s", got, tc.wantErr)
+				}
+			}
+			if !cmp.Equal(got.Operation.ForceReplace, tc.want) {
+				t.Fatalf("unexpected result\n%s", cmp.Diff(got.Operation.ForceReplace, tc.want))
+			}
+			if !cmp.Equal(got.Operation.ForceReplacePatterns, tc.wantPatterns) {
+				t.Fatalf("unexpected patterns\n%s", cmp.Diff(got.Operation.ForceReplacePatterns, tc.wantPatterns))
+			}
+			if got.Operation.ForceReplaceReason != tc.wantReason {
+				t.Fatalf("wrong reason %q; want %q", got.Operation.ForceReplaceReason, tc.wantReason)
+			}
+		})
+	}
+}
+
 func TestParseApply_vars(t *testing.T) {
 	testCases := map[string]struct {
 		args []string
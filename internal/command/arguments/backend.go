@@ -24,6 +24,9 @@ type Backend struct {
 	Reconfigure bool
 	// MigrateState controls if during the reconfiguration of the backend a migration should be attempted.
 	MigrateState bool
+	// BootstrapBackend controls if the resources that the backend needs in its storage service, like a bucket,
+	// should be created when they don't exist.
+	BootstrapBackend bool
 }
 
 func (b *Backend) AddIgnoreRemoteVersionFlag(f *flag.FlagSet) {
@@ -41,6 +44,10 @@ func (b *Backend) AddMigrationFlags(f *flag.FlagSet) {
 	f.BoolVar(&b.MigrateState, "migrate-state", false, "migrate state")
 }
 
+func (b *Backend) AddBootstrapFlag(f *flag.FlagSet) {
+	f.BoolVar(&b.BootstrapBackend, "bootstrap-backend", false, "create missing backend resources")
+}
+
 func (b *Backend) migrationFlagsCheck() (diags tfdiags.Diagnostics) {
 	if b.MigrateState && b.Reconfigure {
 		return diags.Append(tfdiags.Sourceless(
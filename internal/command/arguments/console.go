@@ -6,6 +6,8 @@
 package arguments
 
 import (
+	"fmt"
+
 	"github.com/opentofu/opentofu/internal/tfdiags"
 )
 
@@ -14,6 +16,15 @@ type Console struct {
 	// StatePath is the path to the state file to use for the console session.
 	StatePath string
 
+	// Format is the output format for the values of expressions: "json",
+	// "hcl", "raw", or empty for the default format.
+	Format string
+
+	// Remote indicates that the console should evaluate expressions against
+	// a workspace of a remote backend, using the variable values stored in
+	// the workspace and without locking it.
+	Remote bool
+
 	// ViewOptions specifies which view options to use
 	ViewOptions ViewOptions
 	// Vars holds and provides information for the flags related to variables that a user can give into the process
@@ -35,6 +46,8 @@ func ParseConsole(args []string) (*Console, func(), tfdiags.Diagnostics) {
 	cmdFlags := extendedFlagSet("console", nil, nil, console.Vars)
 	console.Backend.AddStateFlags(cmdFlags)
 	cmdFlags.StringVar(&console.StatePath, "state", DefaultStateFilename, "path")
+	cmdFlags.StringVar(&console.Format, "format", "", "format")
+	cmdFlags.BoolVar(&console.Remote, "remote", false, "remote")
 
 	console.ViewOptions.AddFlags(cmdFlags, true)
 
@@ -55,6 +68,17 @@ func ParseConsole(args []string) (*Console, func(), tfdiags.Diagnostics) {
 		))
 	}
 
+	switch console.Format {
+	case "", "json", "hcl", "raw":
+	default:
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid -format option",
+			fmt.Sprintf("The output format %q is not supported. The supported formats are \"json\", \"hcl\", and \"raw\".", console.Format),
+		))
+		console.Format = ""
+	}
+
 	closer, moreDiags := console.ViewOptions.Parse()
 	diags = diags.Append(moreDiags)
 	// If the user provided the -json flag, we don't allow it since the UX is just poor in this case.
@@ -8,6 +8,7 @@ package arguments
 import (
 	"fmt"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -68,6 +69,24 @@ func TestParseConsole_basicValidation(t *testing.T) {
 				console.Backend.StateLockTimeout = 10 * time.Second
 			}),
 		},
+		"json format": {
+			args: []string{"-format=json"},
+			want: consoleArgsWithDefaults(func(console *Console) {
+				console.Format = "json"
+			}),
+		},
+		"raw format": {
+			args: []string{"-format", "raw"},
+			want: consoleArgsWithDefaults(func(console *Console) {
+				console.Format = "raw"
+			}),
+		},
+		"remote": {
+			args: []string{"-remote"},
+			want: consoleArgsWithDefaults(func(console *Console) {
+				console.Remote = true
+			}),
+		},
 		"disable locking": {
 			args: []string{"-lock=false"},
 			want: consoleArgsWithDefaults(func(console *Console) {
@@ -96,6 +115,21 @@ func TestParseConsole_basicValidation(t *testing.T) {
 	}
 }
 
+func TestParseConsole_invalidFormat(t *testing.T) {
+	got, closer, diags := ParseConsole([]string{"-format=yaml"})
+	defer closer()
+
+	if !diags.HasErrors() {
+		t.Fatal("expected an error for an unsupported format")
+	}
+	if msg, want := diags.Err().Error(), `The output format "yaml" is not supported`; !strings.Contains(msg, want) {
+		t.Errorf("wrong error\ngot:  %s\nwant: %s", msg, want)
+	}
+	if got.Format != "" {
+		t.Errorf("wrong format %q; want the default format", got.Format)
+	}
+}
+
 func consoleArgsWithDefaults(mutate func(console *Console)) *Console {
 	ret := &Console{
 		StatePath: DefaultStateFilename,
@@ -0,0 +1,62 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package arguments
+
+import (
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// DebugConnectivity represents the command-line arguments for the
+// "debug connectivity" command.
+type DebugConnectivity struct {
+	// Target is the hostname or URL to check connectivity to.
+	Target string
+
+	// ViewOptions specifies which view options to use
+	ViewOptions ViewOptions
+}
+
+// ParseDebugConnectivity processes CLI arguments, returning a DebugConnectivity value, a closer function, and errors.
+// If errors are encountered, a DebugConnectivity value is still returned representing
+// the best effort interpretation of the arguments.
+func ParseDebugConnectivity(args []string) (*DebugConnectivity, func(), tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+	arguments := &DebugConnectivity{}
+
+	cmdFlags := defaultFlagSet("debug connectivity")
+	arguments.ViewOptions.AddFlags(cmdFlags, false)
+
+	if err := cmdFlags.Parse(args); err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to parse command-line flags",
+			err.Error(),
+		))
+	}
+
+	args = cmdFlags.Args()
+	switch len(args) {
+	case 1:
+		arguments.Target = args[0]
+	case 0:
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Missing hostname",
+			"The debug connectivity command requires the hostname or URL to check connectivity to.",
+		))
+	default:
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Too many command line arguments",
+			"Expected exactly one positional argument: the hostname or URL to check connectivity to.",
+		))
+	}
+
+	closer, moreDiags := arguments.ViewOptions.Parse()
+	diags = diags.Append(moreDiags)
+
+	return arguments, closer, diags
+}
@@ -0,0 +1,61 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package arguments
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseDebugConnectivity(t *testing.T) {
+	testCases := map[string]struct {
+		args       []string
+		wantTarget string
+		wantView   ViewType
+		wantErr    string
+	}{
+		"hostname": {
+			args:       []string{"registry.opentofu.org"},
+			wantTarget: "registry.opentofu.org",
+			wantView:   ViewHuman,
+		},
+		"json": {
+			args:       []string{"-json", "https://example.com/"},
+			wantTarget: "https://example.com/",
+			wantView:   ViewJSON,
+		},
+		"no target": {
+			args:     nil,
+			wantView: ViewHuman,
+			wantErr:  "Missing hostname",
+		},
+		"too many": {
+			args:     []string{"a.example.com", "b.example.com"},
+			wantView: ViewHuman,
+			wantErr:  "Too many command line arguments",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, closer, diags := ParseDebugConnectivity(tc.args)
+			defer closer()
+			if tc.wantErr != "" {
+				if !diags.HasErrors() || !strings.Contains(diags.Err().Error(), tc.wantErr) {
+					t.Fatalf("wrong diagnostics; want error %q\n%s", tc.wantErr, diags.ErrWithWarnings())
+				}
+			} else if len(diags) > 0 {
+				t.Fatalf("unexpected diags: %s", diags.ErrWithWarnings())
+			}
+			if got.Target != tc.wantTarget {
+				t.Errorf("wrong target %q; want %q", got.Target, tc.wantTarget)
+			}
+			if got.ViewOptions.ViewType != tc.wantView {
+				t.Errorf("wrong view type %v; want %v", got.ViewOptions.ViewType, tc.wantView)
+			}
+		})
+	}
+}
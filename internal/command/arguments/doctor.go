@@ -0,0 +1,48 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package arguments
+
+import (
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// Doctor represents the command-line arguments for the doctor command.
+type Doctor struct {
+	// ViewOptions specifies which view options to use
+	ViewOptions ViewOptions
+}
+
+// ParseDoctor processes CLI arguments, returning a Doctor value, a closer function, and errors.
+// If errors are encountered, a Doctor value is still returned representing
+// the best effort interpretation of the arguments.
+func ParseDoctor(args []string) (*Doctor, func(), tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+	arguments := &Doctor{}
+
+	cmdFlags := defaultFlagSet("doctor")
+	arguments.ViewOptions.AddFlags(cmdFlags, false)
+
+	if err := cmdFlags.Parse(args); err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to parse command-line flags",
+			err.Error(),
+		))
+	}
+
+	if args := cmdFlags.Args(); len(args) != 0 {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Too many command line arguments",
+			"The doctor command doesn't accept any positional arguments.",
+		))
+	}
+
+	closer, moreDiags := arguments.ViewOptions.Parse()
+	diags = diags.Append(moreDiags)
+
+	return arguments, closer, diags
+}
@@ -0,0 +1,50 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package arguments
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseDoctor(t *testing.T) {
+	testCases := map[string]struct {
+		args     []string
+		wantView ViewType
+		wantErr  string
+	}{
+		"defaults": {
+			args:     nil,
+			wantView: ViewHuman,
+		},
+		"json": {
+			args:     []string{"-json"},
+			wantView: ViewJSON,
+		},
+		"positional argument": {
+			args:     []string{"foo"},
+			wantView: ViewHuman,
+			wantErr:  "Too many command line arguments",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, closer, diags := ParseDoctor(tc.args)
+			defer closer()
+			if tc.wantErr != "" {
+				if !diags.HasErrors() || !strings.Contains(diags.Err().Error(), tc.wantErr) {
+					t.Fatalf("wrong diagnostics; want error %q\n%s", tc.wantErr, diags.ErrWithWarnings())
+				}
+			} else if len(diags) > 0 {
+				t.Fatalf("unexpected diags: %s", diags.ErrWithWarnings())
+			}
+			if got.ViewOptions.ViewType != tc.wantView {
+				t.Errorf("wrong view type %v; want %v", got.ViewOptions.ViewType, tc.wantView)
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/hcl/v2"
@@ -65,10 +66,20 @@ type Operation struct {
 	// as it walks the dependency graph.
 	Parallelism int
 
+	// ReadParallelism and WriteParallelism, if set, give separate lower
+	// limits to the operations that only read remote objects and to those
+	// that change them during apply. Parallelism still limits the total.
+	ReadParallelism  int
+	WriteParallelism int
+
 	// Refresh controls whether or not the operation should refresh existing
 	// state before proceeding. Default is true.
 	Refresh bool
 
+	// RefreshTargets, if not empty, limits refreshing to the resource
+	// instances they contain, skipping the refresh of all others.
+	RefreshTargets []addrs.Targetable
+
 	// Targets allow limiting an operation to a set of resource addresses and
 	// their dependencies.
 	Targets []addrs.Targetable
@@ -89,6 +100,16 @@ type Operation struct {
 	// learn a use-case for broader matching.
 	ForceReplace []addrs.AbsResourceInstance
 
+	// ForceReplacePatterns are -replace arguments containing "*" wildcards,
+	// which must be resolved against the prior state to find the resource
+	// instances they refer to before they can be added to ForceReplace.
+	ForceReplacePatterns []string
+
+	// ForceReplaceReason is an optional explanation of why the resources
+	// selected by the -replace option are being replaced, which is recorded
+	// in the resulting plan.
+	ForceReplaceReason string
+
 	// These private fields are used only temporarily during decoding. Use
 	// method Parse to populate the exported fields from these, validating
 	// the raw values in the process.
@@ -97,6 +118,7 @@ type Operation struct {
 	excludesRaw      []string
 	excludesFilesRaw []string
 	forceReplaceRaw  []string
+	refreshTargetRaw []string
 	destroyRaw       bool
 	refreshOnlyRaw   bool
 }
@@ -223,7 +245,23 @@ func (o *Operation) Parse() tfdiags.Diagnostics {
 	o.Targets, o.Excludes, parseDiags = parseRawTargetsAndExcludes(o.targetsRaw, o.excludesRaw, o.targetsFilesRaw, o.excludesFilesRaw)
 	diags = diags.Append(parseDiags)
 
+	o.RefreshTargets, parseDiags = parseDirectTargetables(o.refreshTargetRaw, "refresh-target")
+	diags = diags.Append(parseDiags)
+
 	for _, raw := range o.forceReplaceRaw {
+		if strings.Contains(raw, "*") {
+			if strings.HasPrefix(raw, "data.") || strings.Contains(raw, ".data.") {
+				diags = diags.Append(tfdiags.Sourceless(
+					tfdiags.Error,
+					fmt.Sprintf("Invalid force-replace pattern %q", raw),
+					"Only managed resources can be used with the -replace=... option.",
+				))
+				continue
+			}
+			o.ForceReplacePatterns = append(o.ForceReplacePatterns, raw)
+			continue
+		}
+
 		traversal, syntaxDiags := hclsyntax.ParseTraversalAbs([]byte(raw), "", hcl.Pos{Line: 1, Column: 1})
 		if syntaxDiags.HasErrors() {
 			diags = diags.Append(tfdiags.Sourceless(
@@ -256,6 +294,14 @@ func (o *Operation) Parse() tfdiags.Diagnostics {
 		o.ForceReplace = append(o.ForceReplace, addr)
 	}
 
+	if o.ForceReplaceReason != "" && len(o.forceReplaceRaw) == 0 {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid force-replace reason",
+			"The -replace-reason option can only be used together with at least one -replace option.",
+		))
+	}
+
 	// If you add a new possible value for o.PlanMode here, consider also
 	// adding a specialized error message for it in ParseApplyDestroy.
 	switch {
@@ -276,6 +322,13 @@ func (o *Operation) Parse() tfdiags.Diagnostics {
 				"It doesn't make sense to use -refresh-only at the same time as -refresh=false, because OpenTofu would have nothing to do.",
 			))
 		}
+		if len(o.refreshTargetRaw) != 0 {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Incompatible refresh options",
+				"The -refresh-target option can't be used with -refresh-only. To refresh only some resource instances in refresh-only mode, use -target instead.",
+			))
+		}
 	default:
 		o.PlanMode = plans.NormalMode
 	}
@@ -327,14 +380,18 @@ func extendedFlagSet(name string, state *State, operation *Operation, vars *Vars
 
 	if operation != nil {
 		f.IntVar(&operation.Parallelism, "parallelism", DefaultParallelism, "parallelism")
+		f.IntVar(&operation.ReadParallelism, "read-parallelism", 0, "read-parallelism")
+		f.IntVar(&operation.WriteParallelism, "write-parallelism", 0, "write-parallelism")
 		f.BoolVar(&operation.Refresh, "refresh", true, "refresh")
 		f.BoolVar(&operation.destroyRaw, "destroy", false, "destroy")
 		f.BoolVar(&operation.refreshOnlyRaw, "refresh-only", false, "refresh-only")
+		f.Var((*flags.FlagStringSlice)(&operation.refreshTargetRaw), "refresh-target", "refresh-target")
 		f.Var((*flags.FlagStringSlice)(&operation.targetsRaw), "target", "target")
 		f.Var((*flags.FlagStringSlice)(&operation.targetsFilesRaw), "target-file", "target-file")
 		f.Var((*flags.FlagStringSlice)(&operation.excludesRaw), "exclude", "exclude")
 		f.Var((*flags.FlagStringSlice)(&operation.excludesFilesRaw), "exclude-file", "exclude-file")
 		f.Var((*flags.FlagStringSlice)(&operation.forceReplaceRaw), "replace", "replace")
+		f.StringVar(&operation.ForceReplaceReason, "replace-reason", "", "replace-reason")
 	}
 
 	// Gather all -var and -var-file arguments into one heterogeneous structure
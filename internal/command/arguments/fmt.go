@@ -34,6 +34,10 @@ type Fmt struct {
 	// Recursive indicates that the formatting should be done recursive through all the
 	// subdirectories.
 	Recursive bool
+	// Sort enables the canonical ordering of variable values: the variables
+	// in .tfvars files and in the "variables" blocks of test files are sorted
+	// alphabetically, while the order of everything else is preserved.
+	Sort bool
 
 	// ViewOptions specifies which view options to use
 	ViewOptions ViewOptions
@@ -53,6 +57,7 @@ func ParseFmt(args []string) (*Fmt, func(), tfdiags.Diagnostics) {
 	cmdFlags.BoolVar(&ret.Diff, "diff", false, "diff")
 	cmdFlags.BoolVar(&ret.Check, "check", false, "check")
 	cmdFlags.BoolVar(&ret.Recursive, "recursive", false, "recursive")
+	cmdFlags.BoolVar(&ret.Sort, "sort", false, "sort")
 
 	if err := cmdFlags.Parse(args); err != nil {
 		diags = diags.Append(tfdiags.Sourceless(
@@ -57,6 +57,12 @@ func TestParseFmt_basicValidation(t *testing.T) {
 				v.Recursive = true
 			}),
 		},
+		"sort": {
+			[]string{"-sort"},
+			fmtArgsWithDefaults(func(v *Fmt) {
+				v.Sort = true
+			}),
+		},
 		"file args": {
 			[]string{"foo", "bar"},
 			fmtArgsWithDefaults(func(v *Fmt) {
@@ -22,6 +22,15 @@ type Graph struct {
 	// PlanPath specifies the path to a plan file to render the graph from.
 	PlanPath string
 
+	// Module, if set, limits the output to the objects in the module with
+	// this address and its descendant modules.
+	Module string
+	// ResourceType, if set, limits the output to the resources of this type.
+	ResourceType string
+	// Focus, if set, limits the output to the resource with this address and
+	// the objects it depends on or that depend on it.
+	Focus string
+
 	// ViewOptions specifies which view options to use
 	ViewOptions ViewOptions
 
@@ -44,6 +53,9 @@ func ParseGraph(args []string) (*Graph, func(), tfdiags.Diagnostics) {
 	cmdFlags.IntVar(&arguments.ModuleDepth, "module-depth", -1, "module-depth")
 	cmdFlags.BoolVar(&arguments.Verbose, "verbose", false, "verbose")
 	cmdFlags.StringVar(&arguments.PlanPath, "plan", "", "plan")
+	cmdFlags.StringVar(&arguments.Module, "module", "", "module")
+	cmdFlags.StringVar(&arguments.ResourceType, "resource-type", "", "resource-type")
+	cmdFlags.StringVar(&arguments.Focus, "focus", "", "focus")
 
 	if err := cmdFlags.Parse(args); err != nil {
 		diags = diags.Append(tfdiags.Sourceless(
@@ -75,6 +75,14 @@ func TestParseGraph_basicValidation(t *testing.T) {
 				graph.PlanPath = "/path/to/plan.tfplan"
 			}),
 		},
+		"filter flags": {
+			[]string{"-module=module.app", "-resource-type=aws_instance", "-focus=module.app.aws_instance.web"},
+			graphArgsWithDefaults(func(graph *Graph) {
+				graph.Module = "module.app"
+				graph.ResourceType = "aws_instance"
+				graph.Focus = "module.app.aws_instance.web"
+			}),
+		},
 		"multiple flags combined": {
 			[]string{"-draw-cycles", "-type=plan", "-verbose"},
 			graphArgsWithDefaults(func(graph *Graph) {
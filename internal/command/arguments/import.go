@@ -6,6 +6,7 @@
 package arguments
 
 import (
+	"github.com/opentofu/opentofu/internal/command/flags"
 	"github.com/opentofu/opentofu/internal/command/workdir"
 	"github.com/opentofu/opentofu/internal/tfdiags"
 )
@@ -23,6 +24,21 @@ type Import struct {
 	// Parallelism is the limit of concurrent operation as OpenTofu walks the graph
 	Parallelism int
 
+	// Discover is set by the -discover flag, in which case ResourceAddress is
+	// the resource type to discover the existing objects of, ResourceID is
+	// empty, and import blocks are generated for the objects instead of
+	// importing them into the state.
+	Discover bool
+	// Filters are the -filter values to pass to the provider when discovering
+	// the existing objects.
+	Filters flags.FlagStringKV
+	// Limit is the maximum number of objects to discover, or zero to let the
+	// provider decide.
+	Limit int
+	// OutPath is the file to write the generated import blocks to. If empty,
+	// the import blocks are printed instead.
+	OutPath string
+
 	// ViewOptions specifies which view options to use
 	ViewOptions ViewOptions
 	// State, Backend and Vars are the common extended flags
@@ -48,6 +64,10 @@ func ParseImport(args []string, wd *workdir.Dir) (*Import, func(), tfdiags.Diagn
 	ret.Backend.AddIgnoreRemoteVersionFlag(cmdFlags)
 	cmdFlags.IntVar(&ret.Parallelism, "parallelism", DefaultParallelism, "parallelism")
 	cmdFlags.StringVar(&ret.ConfigPath, "config", pwd, "path")
+	cmdFlags.BoolVar(&ret.Discover, "discover", false, "discover")
+	cmdFlags.Var(&ret.Filters, "filter", "filter")
+	cmdFlags.IntVar(&ret.Limit, "limit", 0, "limit")
+	cmdFlags.StringVar(&ret.OutPath, "out", "", "path")
 	ret.ViewOptions.AddFlags(cmdFlags, true)
 
 	if err := cmdFlags.Parse(args); err != nil {
@@ -65,6 +85,33 @@ func ParseImport(args []string, wd *workdir.Dir) (*Import, func(), tfdiags.Diagn
 	}
 
 	args = cmdFlags.Args()
+	if ret.Discover {
+		if len(args) != 1 {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Invalid number of arguments",
+				"The import command with -discover expects one argument: the resource type to discover the existing objects of",
+			))
+			return ret, closer, diags
+		}
+		if ret.Limit < 0 {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Invalid -limit option",
+				"The -limit option must not be negative.",
+			))
+		}
+		ret.ResourceAddress = args[0]
+		return ret, closer, diags
+	}
+	if len(ret.Filters) > 0 || ret.Limit != 0 || ret.OutPath != "" {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid import options",
+			"The -filter, -limit, and -out options can only be used together with -discover.",
+		))
+		return ret, closer, diags
+	}
 	if len(args) != 2 {
 		diags = diags.Append(tfdiags.Sourceless(
 			tfdiags.Error,
@@ -98,6 +98,39 @@ func TestParseImport_basicValidation(t *testing.T) {
 				imp.ViewOptions.InputEnabled = false
 			}),
 		},
+		"discover": {
+			args: []string{"-discover", "-filter=region=eu-west-1", "-filter", "prefix=app-", "-limit=20", "-out=imports.tf", "test_instance"},
+			want: importArgsWithDefaults(func(imp *Import) {
+				imp.ResourceAddress = "test_instance"
+				imp.Discover = true
+				imp.Filters = flags.FlagStringKV{"region": "eu-west-1", "prefix": "app-"}
+				imp.Limit = 20
+				imp.OutPath = "imports.tf"
+			}),
+		},
+		"discover with an id": {
+			args: []string{"-discover", "test_instance", "id"},
+			want: importArgsWithDefaults(func(imp *Import) {
+				imp.Discover = true
+			}),
+			wantErrText: "Invalid number of arguments: The import command with -discover expects one argument",
+		},
+		"discover with a negative limit": {
+			args: []string{"-discover", "-limit=-1", "test_instance"},
+			want: importArgsWithDefaults(func(imp *Import) {
+				imp.ResourceAddress = "test_instance"
+				imp.Discover = true
+				imp.Limit = -1
+			}),
+			wantErrText: "Invalid -limit option",
+		},
+		"filter without discover": {
+			args: []string{"-filter=region=eu-west-1", "addr", "id"},
+			want: importArgsWithDefaults(func(imp *Import) {
+				imp.Filters = flags.FlagStringKV{"region": "eu-west-1"}
+			}),
+			wantErrText: "The -filter, -limit, and -out options can only be used together with -discover.",
+		},
 		"no arguments": {
 			args:        []string{},
 			want:        importArgsWithDefaults(nil),
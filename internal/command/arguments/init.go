@@ -25,6 +25,12 @@ type Init struct {
 	// Install the latest module and provider versions allowed within configured constraints, overriding the
 	// default behavior of selecting exactly the version recorded in the dependency lockfile.
 	FlagUpgrade bool
+	// Instead of installing providers, look up source addresses for the providers that resources rely on
+	// without an explicit required_providers entry, and report a suggested required_providers block.
+	FlagInferProviders bool
+	// Explain how the versions of registry modules and of providers were selected, including why each of the
+	// available versions was rejected.
+	FlagExplainVersions bool
 	// Directory containing plugin binaries. This overrides all default search paths for plugins, and prevents the
 	// automatic installation of plugins. This flag can be used multiple times.
 	FlagPluginPath flags.FlagStringSlice
@@ -67,12 +73,15 @@ func ParseInit(args []string) (*Init, func(), tfdiags.Diagnostics) {
 	init.Backend.AddIgnoreRemoteVersionFlag(cmdFlags)
 	init.Backend.AddStateFlags(cmdFlags)
 	init.Backend.AddMigrationFlags(cmdFlags)
+	init.Backend.AddBootstrapFlag(cmdFlags)
 	cmdFlags.BoolVar(&init.FlagBackend, "backend", true, "")
 	cmdFlags.BoolVar(&init.FlagCloud, "cloud", true, "")
 	cmdFlags.Var(init.FlagConfigExtra, "backend-config", "")
 	cmdFlags.StringVar(&init.FlagFromModule, "from-module", "", "copy the source of the given module into the directory before init")
 	cmdFlags.BoolVar(&init.FlagGet, "get", true, "")
 	cmdFlags.BoolVar(&init.FlagUpgrade, "upgrade", false, "")
+	cmdFlags.BoolVar(&init.FlagInferProviders, "infer-providers", false, "")
+	cmdFlags.BoolVar(&init.FlagExplainVersions, "explain-versions", false, "")
 	cmdFlags.Var(&init.FlagPluginPath, "plugin-dir", "plugin directory")
 	cmdFlags.StringVar(&init.FlagLockfile, "lockfile", "", "Set a dependency lockfile mode")
 	cmdFlags.StringVar(&init.TestsDirectory, "test-directory", "tests", "test-directory")
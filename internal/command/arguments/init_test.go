@@ -48,6 +48,18 @@ func TestParseInit_basicValidation(t *testing.T) {
 				init.FlagLockfile = "readonly"
 			}),
 		},
+		"infer providers": {
+			[]string{"-infer-providers"},
+			initArgsWithDefaults(func(init *Init) {
+				init.FlagInferProviders = true
+			}),
+		},
+		"explain versions": {
+			[]string{"-explain-versions"},
+			initArgsWithDefaults(func(init *Init) {
+				init.FlagExplainVersions = true
+			}),
+		},
 		"custom test-directory": {
 			[]string{"-test-directory=integration"},
 			initArgsWithDefaults(func(init *Init) {
@@ -253,6 +265,12 @@ func TestParseInit_backendFlags(t *testing.T) {
 				backend.Reconfigure = true
 			}),
 		},
+		"bootstrap-backend": {
+			args: []string{"-bootstrap-backend"},
+			wantBackend: backendWithDefaults(func(backend *Backend) {
+				backend.BootstrapBackend = true
+			}),
+		},
 		"force-copy": {
 			args: []string{"-force-copy"},
 			wantBackend: backendWithDefaults(func(backend *Backend) {
@@ -0,0 +1,68 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package arguments
+
+import (
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// MetadataModuleDocs represents the command-line arguments for the "metadata module-docs" command.
+type MetadataModuleDocs struct {
+	// Path is the directory containing the module to document.
+	Path string
+
+	// JSON is set when the documentation should be produced as JSON rather
+	// than Markdown.
+	JSON bool
+
+	// ViewOptions specifies which view options to use
+	ViewOptions ViewOptions
+}
+
+// ParseMetadataModuleDocs processes CLI arguments, returning a MetadataModuleDocs value, a closer function, and errors.
+// If errors are encountered, a MetadataModuleDocs value is still returned representing
+// the best effort interpretation of the arguments.
+func ParseMetadataModuleDocs(args []string) (*MetadataModuleDocs, func(), tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+	arguments := &MetadataModuleDocs{
+		Path: ".",
+	}
+
+	cmdFlags := defaultFlagSet("metadata module-docs")
+	arguments.ViewOptions.AddGranularFlags(cmdFlags, false, false) // Add only the -json flag
+
+	if err := cmdFlags.Parse(args); err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to parse command-line flags",
+			err.Error(),
+		))
+	}
+
+	args = cmdFlags.Args()
+	switch len(args) {
+	case 0:
+	case 1:
+		arguments.Path = args[0]
+	default:
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Too many command line arguments",
+			"Expected at most one positional argument: the directory containing the module.",
+		))
+	}
+
+	closer, moreDiags := arguments.ViewOptions.Parse()
+	diags = diags.Append(moreDiags)
+
+	// As with "metadata functions", the -json flag only selects the format
+	// of the documentation itself. Diagnostics are always rendered in the
+	// human-readable format.
+	arguments.JSON = arguments.ViewOptions.ViewType == ViewJSON
+	arguments.ViewOptions.ViewType = ViewHuman
+
+	return arguments, closer, diags
+}
@@ -0,0 +1,88 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package arguments
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestParseMetadataModuleDocs(t *testing.T) {
+	testCases := map[string]struct {
+		args        []string
+		want        *MetadataModuleDocs
+		wantErrText string
+	}{
+		"defaults": {
+			args: nil,
+			want: metadataModuleDocsArgsWithDefaults(nil),
+		},
+		"json flag": {
+			args: []string{"-json"},
+			want: metadataModuleDocsArgsWithDefaults(func(args *MetadataModuleDocs) {
+				args.JSON = true
+			}),
+		},
+		"directory": {
+			args: []string{"modules/network"},
+			want: metadataModuleDocsArgsWithDefaults(func(args *MetadataModuleDocs) {
+				args.Path = "modules/network"
+			}),
+		},
+		"too many arguments": {
+			args: []string{"foo", "bar"},
+			want: metadataModuleDocsArgsWithDefaults(func(args *MetadataModuleDocs) {
+				args.Path = "."
+			}),
+			wantErrText: "Too many command line arguments",
+		},
+		"invalid flag": {
+			args:        []string{"-foo"},
+			want:        metadataModuleDocsArgsWithDefaults(nil),
+			wantErrText: "Failed to parse command-line flags: flag provided but not defined: -foo",
+		},
+	}
+
+	cmpOpts := cmpopts.IgnoreUnexported(ViewOptions{})
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, closer, diags := ParseMetadataModuleDocs(tc.args)
+			defer closer()
+
+			if tc.wantErrText != "" && len(diags) == 0 {
+				t.Errorf("test wanted error but got nothing")
+			} else if tc.wantErrText == "" && len(diags) > 0 {
+				t.Errorf("test didn't expect errors but got some: %s", diags.ErrWithWarnings())
+			} else if tc.wantErrText != "" && len(diags) > 0 {
+				errStr := diags.ErrWithWarnings().Error()
+				if !strings.Contains(errStr, tc.wantErrText) {
+					t.Errorf("the returned diagnostics does not contain the expected error message.\ndiags:\n%s\nwanted: %s\n", errStr, tc.wantErrText)
+				}
+			}
+			if diff := cmp.Diff(tc.want, got, cmpOpts); diff != "" {
+				t.Errorf("unexpected result\n%s", diff)
+			}
+		})
+	}
+}
+
+func metadataModuleDocsArgsWithDefaults(mutate func(args *MetadataModuleDocs)) *MetadataModuleDocs {
+	ret := &MetadataModuleDocs{
+		Path: ".",
+		ViewOptions: ViewOptions{
+			ViewType:     ViewHuman,
+			InputEnabled: false,
+		},
+	}
+	if mutate != nil {
+		mutate(ret)
+	}
+	return ret
+}
@@ -0,0 +1,59 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package arguments
+
+import (
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// MetadataVariablesSchema represents the command-line arguments for the "metadata variables-schema" command.
+type MetadataVariablesSchema struct {
+	// Path is the directory containing the module whose variables are described.
+	Path string
+
+	// ViewOptions specifies which view options to use
+	ViewOptions ViewOptions
+}
+
+// ParseMetadataVariablesSchema processes CLI arguments, returning a MetadataVariablesSchema value, a closer function, and errors.
+// If errors are encountered, a MetadataVariablesSchema value is still returned representing
+// the best effort interpretation of the arguments.
+func ParseMetadataVariablesSchema(args []string) (*MetadataVariablesSchema, func(), tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+	arguments := &MetadataVariablesSchema{
+		Path: ".",
+	}
+
+	cmdFlags := defaultFlagSet("metadata variables-schema")
+
+	if err := cmdFlags.Parse(args); err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to parse command-line flags",
+			err.Error(),
+		))
+	}
+
+	args = cmdFlags.Args()
+	switch len(args) {
+	case 0:
+	case 1:
+		arguments.Path = args[0]
+	default:
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Too many command line arguments",
+			"Expected at most one positional argument: the directory containing the module.",
+		))
+	}
+
+	// The schema itself is always JSON, so there is no -json flag and the
+	// diagnostics are always rendered in the human-readable format.
+	closer, moreDiags := arguments.ViewOptions.Parse()
+	diags = diags.Append(moreDiags)
+
+	return arguments, closer, diags
+}
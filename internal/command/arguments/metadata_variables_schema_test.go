@@ -0,0 +1,87 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package arguments
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestParseMetadataVariablesSchema(t *testing.T) {
+	testCases := map[string]struct {
+		args        []string
+		want        *MetadataVariablesSchema
+		wantErrText string
+	}{
+		"defaults": {
+			args: nil,
+			want: metadataVariablesSchemaArgsWithDefaults(nil),
+		},
+		"directory": {
+			args: []string{"modules/network"},
+			want: metadataVariablesSchemaArgsWithDefaults(func(args *MetadataVariablesSchema) {
+				args.Path = "modules/network"
+			}),
+		},
+		"too many arguments": {
+			args: []string{"foo", "bar"},
+			want: metadataVariablesSchemaArgsWithDefaults(func(args *MetadataVariablesSchema) {
+				args.Path = "."
+			}),
+			wantErrText: "Too many command line arguments",
+		},
+		"json flag": {
+			args:        []string{"-json"},
+			want:        metadataVariablesSchemaArgsWithDefaults(nil),
+			wantErrText: "Failed to parse command-line flags: flag provided but not defined: -json",
+		},
+		"invalid flag": {
+			args:        []string{"-foo"},
+			want:        metadataVariablesSchemaArgsWithDefaults(nil),
+			wantErrText: "Failed to parse command-line flags: flag provided but not defined: -foo",
+		},
+	}
+
+	cmpOpts := cmpopts.IgnoreUnexported(ViewOptions{})
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, closer, diags := ParseMetadataVariablesSchema(tc.args)
+			defer closer()
+
+			if tc.wantErrText != "" && len(diags) == 0 {
+				t.Errorf("test wanted error but got nothing")
+			} else if tc.wantErrText == "" && len(diags) > 0 {
+				t.Errorf("test didn't expect errors but got some: %s", diags.ErrWithWarnings())
+			} else if tc.wantErrText != "" && len(diags) > 0 {
+				errStr := diags.ErrWithWarnings().Error()
+				if !strings.Contains(errStr, tc.wantErrText) {
+					t.Errorf("the returned diagnostics does not contain the expected error message.\ndiags:\n%s\nwanted: %s\n", errStr, tc.wantErrText)
+				}
+			}
+			if diff := cmp.Diff(tc.want, got, cmpOpts); diff != "" {
+				t.Errorf("unexpected result\n%s", diff)
+			}
+		})
+	}
+}
+
+func metadataVariablesSchemaArgsWithDefaults(mutate func(args *MetadataVariablesSchema)) *MetadataVariablesSchema {
+	ret := &MetadataVariablesSchema{
+		Path: ".",
+		ViewOptions: ViewOptions{
+			ViewType:     ViewHuman,
+			InputEnabled: false,
+		},
+	}
+	if mutate != nil {
+		mutate(ret)
+	}
+	return ret
+}
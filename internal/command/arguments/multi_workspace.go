@@ -0,0 +1,123 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package arguments
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// WorkspacePlaceholder is replaced with the name of each workspace in the
+// other arguments of a command run with -workspaces, so that options such as
+// -var-file and -out can refer to a different file for each workspace.
+const WorkspacePlaceholder = "{workspace}"
+
+// MultiWorkspace represents the command-line arguments for running the plan
+// and apply commands in several workspaces of the current configuration.
+type MultiWorkspace struct {
+	// Workspaces are the names of the workspaces to run the command in, in
+	// the order they were given.
+	Workspaces []string
+
+	// Parallelism is the maximum number of workspaces to run the command in
+	// at the same time. The default of 1 runs the command in each workspace
+	// in turn.
+	Parallelism int
+}
+
+// ParseMultiWorkspace processes CLI arguments, returning a MultiWorkspace
+// value and a possibly-modified slice of arguments. If any of the supported
+// flags are found, they will be removed from the slice. The returned
+// MultiWorkspace is nil if the -workspaces option wasn't used.
+//
+// As with ParseView, the flags are only recognized in the "-name=value" form,
+// since the remaining arguments are parsed separately for each workspace.
+func ParseMultiWorkspace(args []string) (*MultiWorkspace, []string, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+	ret := &MultiWorkspace{
+		Parallelism: 1,
+	}
+	var workspacesSet, parallelismSet bool
+
+	// Keep track of the length of the returned slice. When we find an
+	// argument we support, "i" will not be incremented.
+	i := 0
+	for _, v := range args {
+		switch {
+		case strings.HasPrefix(v, "-workspaces="):
+			workspacesSet = true
+			for _, name := range strings.Split(strings.TrimPrefix(v, "-workspaces="), ",") {
+				name = strings.TrimSpace(name)
+				if name == "" {
+					continue
+				}
+				if slices.Contains(ret.Workspaces, name) {
+					diags = diags.Append(tfdiags.Sourceless(
+						tfdiags.Error,
+						"Invalid -workspaces option",
+						fmt.Sprintf("The workspace %q is listed more than once.", name),
+					))
+					continue
+				}
+				ret.Workspaces = append(ret.Workspaces, name)
+			}
+		case strings.HasPrefix(v, "-workspace-parallelism="):
+			parallelismSet = true
+			n, err := strconv.Atoi(strings.TrimPrefix(v, "-workspace-parallelism="))
+			if err != nil || n < 1 {
+				diags = diags.Append(tfdiags.Sourceless(
+					tfdiags.Error,
+					"Invalid -workspace-parallelism option",
+					"The -workspace-parallelism option must be a whole number greater than zero.",
+				))
+				continue
+			}
+			ret.Parallelism = n
+		default:
+			// Unsupported argument: move left to the current position, and
+			// increment the index.
+			args[i] = v
+			i++
+		}
+	}
+
+	// Reduce the slice to the number of unsupported arguments. Any remaining
+	// to the right of "i" have already been moved left.
+	args = args[:i]
+
+	if workspacesSet && len(ret.Workspaces) == 0 {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid -workspaces option",
+			"The -workspaces option requires a comma-separated list of workspace names.",
+		))
+	}
+	if parallelismSet && !workspacesSet {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid -workspace-parallelism option",
+			"The -workspace-parallelism option can only be used with the -workspaces option.",
+		))
+	}
+	if !workspacesSet {
+		return nil, args, diags
+	}
+	return ret, args, diags
+}
+
+// ArgsForWorkspace returns a copy of the given arguments with any
+// WorkspacePlaceholder replaced with the given workspace name.
+func (w *MultiWorkspace) ArgsForWorkspace(args []string, workspace string) []string {
+	ret := make([]string, len(args))
+	for i, arg := range args {
+		ret[i] = strings.ReplaceAll(arg, WorkspacePlaceholder, workspace)
+	}
+	return ret
+}
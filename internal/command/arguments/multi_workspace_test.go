@@ -0,0 +1,96 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package arguments
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseMultiWorkspace(t *testing.T) {
+	testCases := map[string]struct {
+		args     []string
+		want     *MultiWorkspace
+		wantArgs []string
+		wantErr  string
+	}{
+		"not used": {
+			args:     []string{"-auto-approve", "-var-file=a.tfvars"},
+			want:     nil,
+			wantArgs: []string{"-auto-approve", "-var-file=a.tfvars"},
+		},
+		"workspaces": {
+			args: []string{"-workspaces=a, b,c", "-auto-approve"},
+			want: &MultiWorkspace{
+				Workspaces:  []string{"a", "b", "c"},
+				Parallelism: 1,
+			},
+			wantArgs: []string{"-auto-approve"},
+		},
+		"parallelism": {
+			args: []string{"-var-file={workspace}.tfvars", "-workspace-parallelism=3", "-workspaces=a,b"},
+			want: &MultiWorkspace{
+				Workspaces:  []string{"a", "b"},
+				Parallelism: 3,
+			},
+			wantArgs: []string{"-var-file={workspace}.tfvars"},
+		},
+		"empty workspaces": {
+			args:    []string{"-workspaces="},
+			wantErr: "Invalid -workspaces option",
+		},
+		"duplicate workspaces": {
+			args:    []string{"-workspaces=a,b,a"},
+			wantErr: "Invalid -workspaces option",
+		},
+		"invalid parallelism": {
+			args:    []string{"-workspaces=a,b", "-workspace-parallelism=0"},
+			wantErr: "Invalid -workspace-parallelism option",
+		},
+		"parallelism without workspaces": {
+			args:    []string{"-workspace-parallelism=2"},
+			wantErr: "Invalid -workspace-parallelism option",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, gotArgs, diags := ParseMultiWorkspace(tc.args)
+			if tc.wantErr != "" {
+				if !diags.HasErrors() {
+					t.Fatal("expected error but got none")
+				}
+				if got := diags[0].Description().Summary; got != tc.wantErr {
+					t.Fatalf("wrong error %q; want %q", got, tc.wantErr)
+				}
+				return
+			}
+			if len(diags) > 0 {
+				t.Fatalf("unexpected diagnostics: %s", diags.Err())
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("wrong result\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantArgs, gotArgs); diff != "" {
+				t.Errorf("wrong remaining arguments\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMultiWorkspace_ArgsForWorkspace(t *testing.T) {
+	multi := &MultiWorkspace{Workspaces: []string{"prod"}}
+	args := []string{"-var-file=env/{workspace}.tfvars", "-out={workspace}.tfplan", "-auto-approve"}
+	got := multi.ArgsForWorkspace(args, "prod")
+	want := []string{"-var-file=env/prod.tfvars", "-out=prod.tfplan", "-auto-approve"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("wrong result\n%s", diff)
+	}
+	if args[0] != "-var-file=env/{workspace}.tfvars" {
+		t.Errorf("original arguments were modified")
+	}
+}
@@ -19,6 +19,11 @@ type Output struct {
 	// be loaded.
 	StatePath string
 
+	// PlanPath is an optional path to a saved plan file. If set, outputs are
+	// shown as they will be after the plan is applied, rather than as they
+	// are recorded in the latest state snapshot.
+	PlanPath string
+
 	// ViewOptions specifies which view options to use
 	ViewOptions ViewOptions
 
@@ -42,6 +47,7 @@ func ParseOutput(args []string) (*Output, func(), tfdiags.Diagnostics) {
 	cmdFlags := extendedFlagSet("output", nil, nil, output.Vars)
 	cmdFlags.BoolVar(&rawOutput, "raw", false, "raw")
 	cmdFlags.StringVar(&statePath, "state", "", "path")
+	cmdFlags.StringVar(&output.PlanPath, "state-run", "", "path")
 	cmdFlags.BoolVar(&output.ShowSensitive, "show-sensitive", false, "displays sensitive values")
 
 	output.ViewOptions.AddFlags(cmdFlags, false)
@@ -82,6 +88,14 @@ func ParseOutput(args []string) (*Output, func(), tfdiags.Diagnostics) {
 
 	output.StatePath = statePath
 
+	if output.PlanPath != "" && statePath != "" {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Incompatible command-line options",
+			"The -state and -state-run options are mutually-exclusive, because outputs from a plan file do not depend on the current state.",
+		))
+	}
+
 	if len(args) > 0 {
 		output.Name = args[0]
 	}
@@ -50,6 +50,14 @@ func TestParseOutput_valid(t *testing.T) {
 				StatePath:   "foobar.tfstate",
 			},
 		},
+		"state-run": {
+			[]string{"-state-run=tfplan", "-json"},
+			&Output{
+				Name:        "",
+				ViewOptions: ViewOptions{ViewType: ViewJSON},
+				PlanPath:    "tfplan",
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -133,6 +141,22 @@ func TestParseOutput_invalid(t *testing.T) {
 				),
 			},
 		},
+		"state and state-run": {
+			[]string{"-state=foo.tfstate", "-state-run=tfplan"},
+			&Output{
+				Name:        "",
+				ViewOptions: ViewOptions{ViewType: ViewHuman},
+				StatePath:   "foo.tfstate",
+				PlanPath:    "tfplan",
+			},
+			tfdiags.Diagnostics{
+				tfdiags.Sourceless(
+					tfdiags.Error,
+					"Incompatible command-line options",
+					"The -state and -state-run options are mutually-exclusive, because outputs from a plan file do not depend on the current state.",
+				),
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -6,6 +6,7 @@
 package arguments
 
 import (
+	"github.com/opentofu/opentofu/internal/command/flags"
 	"github.com/opentofu/opentofu/internal/tfdiags"
 )
 
@@ -23,16 +24,35 @@ type Plan struct {
 	// OutPath contains an optional path to store the plan file
 	OutPath string
 
+	// Store requests that the plan file also be saved in the backend, so
+	// that it can be applied later using "tofu plan apply".
+	Store bool
+
 	// GenerateConfigPath tells OpenTofu that config should be generated for
 	// unmatched import target paths and which path the generated file should
 	// be written to.
 	GenerateConfigPath string
 
+	// SuggestMovesPath tells OpenTofu that "moved" blocks should be suggested
+	// for resource instances that the plan would destroy and re-create under
+	// another instance key, and which path the suggested blocks should be
+	// written to.
+	SuggestMovesPath string
+
 	// ViewOptions specifies which view options to use
 	ViewOptions ViewOptions
 
 	// ShowSensitive is used to display the value of variables marked as sensitive.
 	ShowSensitive bool
+
+	// CompareTo is an optional path to a golden plan in the JSON format
+	// produced by "tofu show -json", which the new plan must match.
+	CompareTo string
+
+	// CompareIgnore are paths of fields in the JSON plan to ignore when
+	// comparing with the golden plan given in CompareTo, in addition to the
+	// fields that are ignored by default.
+	CompareIgnore []string
 }
 
 // ParsePlan processes CLI arguments, returning a Plan value, a closer function, and errors.
@@ -49,8 +69,12 @@ func ParsePlan(args []string) (*Plan, func(), tfdiags.Diagnostics) {
 	cmdFlags := extendedFlagSet("plan", plan.State, plan.Operation, plan.Vars)
 	cmdFlags.BoolVar(&plan.DetailedExitCode, "detailed-exitcode", false, "detailed-exitcode")
 	cmdFlags.StringVar(&plan.OutPath, "out", "", "out")
+	cmdFlags.BoolVar(&plan.Store, "store", false, "store")
 	cmdFlags.StringVar(&plan.GenerateConfigPath, "generate-config-out", "", "generate-config-out")
+	cmdFlags.StringVar(&plan.SuggestMovesPath, "suggest-moves", "", "suggest-moves")
 	cmdFlags.BoolVar(&plan.ShowSensitive, "show-sensitive", false, "displays sensitive values")
+	cmdFlags.StringVar(&plan.CompareTo, "compare-to", "", "compare-to")
+	cmdFlags.Var((*flags.FlagStringSlice)(&plan.CompareIgnore), "compare-ignore", "compare-ignore")
 
 	plan.ViewOptions.AddFlags(cmdFlags, true)
 
@@ -72,6 +96,14 @@ func ParsePlan(args []string) (*Plan, func(), tfdiags.Diagnostics) {
 		))
 	}
 
+	if len(plan.CompareIgnore) > 0 && plan.CompareTo == "" {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid -compare-ignore option",
+			"The -compare-ignore option is only valid together with -compare-to, which selects the golden plan to compare with.",
+		))
+	}
+
 	diags = diags.Append(plan.Operation.Parse())
 	closer, moreDiags := plan.ViewOptions.Parse()
 	diags = diags.Append(moreDiags)
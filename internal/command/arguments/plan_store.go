@@ -0,0 +1,86 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package arguments
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// PlanList represents the command-line arguments for the "plan list" command.
+type PlanList struct {
+	// ViewOptions specifies which view options to use
+	ViewOptions ViewOptions
+}
+
+// ParsePlanList processes CLI arguments, returning a PlanList value, a closer function, and errors.
+// If errors are encountered, a PlanList value is still returned representing
+// the best effort interpretation of the arguments.
+func ParsePlanList(args []string) (*PlanList, func(), tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+	list := &PlanList{}
+
+	cmdFlags := defaultFlagSet("plan list")
+	list.ViewOptions.AddFlags(cmdFlags, false)
+
+	if err := cmdFlags.Parse(args); err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to parse command-line flags",
+			err.Error(),
+		))
+	}
+
+	if len(cmdFlags.Args()) > 0 {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Too many command line arguments",
+			"The plan list command doesn't accept any positional arguments.",
+		))
+	}
+
+	closer, moreDiags := list.ViewOptions.Parse()
+	diags = diags.Append(moreDiags)
+
+	return list, closer, diags
+}
+
+// StoredPlan represents the command-line arguments for the "plan show" and
+// "plan apply" commands, which retrieve a stored plan and then pass it to the
+// "show" or "apply" command respectively.
+type StoredPlan struct {
+	// ID is the ID of the stored plan.
+	ID string
+
+	// Args are the remaining arguments, which are passed on to the command
+	// that uses the stored plan.
+	Args []string
+}
+
+// ParseStoredPlan processes CLI arguments for the given stored plan command,
+// which must end with the ID of the stored plan. Any other arguments are
+// options for the command that uses the plan, and so they are not validated
+// here.
+func ParseStoredPlan(command string, args []string) (*StoredPlan, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+	ret := &StoredPlan{}
+
+	if len(args) == 0 || strings.HasPrefix(args[len(args)-1], "-") {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Missing plan ID",
+			fmt.Sprintf("The %s command requires the ID of a stored plan as its last argument. Use \"tofu plan list\" to see the stored plans.", command),
+		))
+		ret.Args = args
+		return ret, diags
+	}
+
+	ret.ID = args[len(args)-1]
+	ret.Args = args[:len(args)-1]
+	return ret, diags
+}
@@ -0,0 +1,69 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package arguments
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParsePlanList(t *testing.T) {
+	got, closer, diags := ParsePlanList([]string{"-json"})
+	defer closer()
+	if len(diags) > 0 {
+		t.Fatalf("unexpected diags: %s", diags.Err())
+	}
+	if got.ViewOptions.ViewType != ViewJSON {
+		t.Errorf("wrong view type %v", got.ViewOptions.ViewType)
+	}
+
+	_, closer, diags = ParsePlanList([]string{"foo"})
+	defer closer()
+	if got, want := diags.Err().Error(), "Too many command line arguments"; !strings.Contains(got, want) {
+		t.Errorf("wrong error %q", got)
+	}
+}
+
+func TestParseStoredPlan(t *testing.T) {
+	testCases := map[string]struct {
+		args    []string
+		want    *StoredPlan
+		wantErr bool
+	}{
+		"id only": {
+			args: []string{"abc123"},
+			want: &StoredPlan{ID: "abc123", Args: []string{}},
+		},
+		"options and id": {
+			args: []string{"-no-color", "-auto-approve", "abc123"},
+			want: &StoredPlan{ID: "abc123", Args: []string{"-no-color", "-auto-approve"}},
+		},
+		"no arguments": {
+			args:    nil,
+			want:    &StoredPlan{},
+			wantErr: true,
+		},
+		"no id": {
+			args:    []string{"-no-color"},
+			want:    &StoredPlan{Args: []string{"-no-color"}},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, diags := ParseStoredPlan("plan apply", tc.args)
+			if gotErr := diags.HasErrors(); gotErr != tc.wantErr {
+				t.Fatalf("wrong error result %t; want %t\n%s", gotErr, tc.wantErr, diags.ErrWithWarnings())
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("unexpected result\n%s", diff)
+			}
+		})
+	}
+}
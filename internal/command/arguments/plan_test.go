@@ -43,7 +43,7 @@ func TestParsePlan_basicValid(t *testing.T) {
 			},
 		},
 		"setting all options": {
-			[]string{"-destroy", "-detailed-exitcode", "-input=false", "-out=saved.tfplan"},
+			[]string{"-destroy", "-detailed-exitcode", "-input=false", "-out=saved.tfplan", "-store"},
 			&Plan{
 				DetailedExitCode: true,
 				ViewOptions: ViewOptions{
@@ -51,6 +51,7 @@ func TestParsePlan_basicValid(t *testing.T) {
 					ViewType:     ViewHuman,
 				},
 				OutPath: "saved.tfplan",
+				Store:   true,
 				State:   &State{Lock: true},
 				Vars:    &Vars{},
 				Operation: &Operation{
@@ -60,6 +61,59 @@ func TestParsePlan_basicValid(t *testing.T) {
 				},
 			},
 		},
+		"suggest moves": {
+			[]string{"-suggest-moves=moves.tf"},
+			&Plan{
+				ViewOptions: ViewOptions{
+					InputEnabled: true,
+					ViewType:     ViewHuman,
+				},
+				SuggestMovesPath: "moves.tf",
+				State:            &State{Lock: true},
+				Vars:             &Vars{},
+				Operation: &Operation{
+					PlanMode:    plans.NormalMode,
+					Parallelism: 10,
+					Refresh:     true,
+				},
+			},
+		},
+		"golden plan comparison": {
+			[]string{"-compare-to=golden.json", "-compare-ignore=resource_changes.*.change.after.id", "-compare-ignore=checks"},
+			&Plan{
+				ViewOptions: ViewOptions{
+					InputEnabled: true,
+					ViewType:     ViewHuman,
+				},
+				CompareTo:     "golden.json",
+				CompareIgnore: []string{"resource_changes.*.change.after.id", "checks"},
+				State:         &State{Lock: true},
+				Vars:          &Vars{},
+				Operation: &Operation{
+					PlanMode:    plans.NormalMode,
+					Parallelism: 10,
+					Refresh:     true,
+				},
+			},
+		},
+		"separate read and write parallelism": {
+			[]string{"-read-parallelism=50", "-write-parallelism=5"},
+			&Plan{
+				ViewOptions: ViewOptions{
+					InputEnabled: true,
+					ViewType:     ViewHuman,
+				},
+				State: &State{Lock: true},
+				Vars:  &Vars{},
+				Operation: &Operation{
+					PlanMode:         plans.NormalMode,
+					Parallelism:      10,
+					ReadParallelism:  50,
+					WriteParallelism: 5,
+					Refresh:          true,
+				},
+			},
+		},
 		"JSON view disables input": {
 			[]string{"-json"},
 			&Plan{
@@ -108,6 +162,16 @@ func TestParsePlan_invalid(t *testing.T) {
 	}
 }
 
+func TestParsePlan_compareIgnoreWithoutCompareTo(t *testing.T) {
+	_, _, diags := ParsePlan([]string{"-compare-ignore=timestamp"})
+	if len(diags) == 0 {
+		t.Fatal("expected diags but got none")
+	}
+	if got, want := diags.Err().Error(), "Invalid -compare-ignore option"; !strings.Contains(got, want) {
+		t.Fatalf("wrong diags\n got: %s\nwant: %s", got, want)
+	}
+}
+
 func TestParsePlan_tooManyArguments(t *testing.T) {
 	got, _, diags := ParsePlan([]string{"saved.tfplan"})
 	if len(diags) == 0 {
@@ -178,6 +242,58 @@ func TestParsePlan_targets(t *testing.T) {
 	}
 }
 
+func TestParsePlan_refreshTargets(t *testing.T) {
+	foobarbaz, _ := addrs.ParseTargetStr("foo_bar.baz[0]")
+	boop, _ := addrs.ParseTargetStr("module.boop")
+	testCases := map[string]struct {
+		args    []string
+		want    []addrs.Targetable
+		wantErr string
+	}{
+		"no refresh targets by default": {
+			args: nil,
+			want: nil,
+		},
+		"two refresh targets": {
+			args: []string{"-refresh-target=foo_bar.baz[0]", "-refresh-target", "module.boop"},
+			want: []addrs.Targetable{foobarbaz.Subject, boop.Subject},
+		},
+		"with refresh disabled": {
+			args: []string{"-refresh=false", "-refresh-target=module.boop"},
+			want: []addrs.Targetable{boop.Subject},
+		},
+		"invalid target": {
+			args:    []string{"-refresh-target=data[0].foo"},
+			want:    nil,
+			wantErr: "Invalid refresh-target \"data[0].foo\": A data source name is required",
+		},
+		"with refresh-only": {
+			args:    []string{"-refresh-only", "-refresh-target=module.boop"},
+			want:    []addrs.Targetable{boop.Subject},
+			wantErr: "The -refresh-target option can't be used with -refresh-only.",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, _, diags := ParsePlan(tc.args)
+			if tc.wantErr == "" && len(diags) > 0 {
+				t.Fatalf("unexpected diags: %v", diags)
+			} else if tc.wantErr != "" {
+				if len(diags) == 0 {
+					t.Fatalf("expected diags but got none")
+				} else if got := diags.Err().Error(); !strings.Contains(got, tc.wantErr) {
+					t.Fatalf("wrong diags\n got: %s\nwant: %s", got, tc.wantErr)
+				}
+			}
+
+			if !cmp.Equal(got.Operation.RefreshTargets, tc.want) {
+				t.Fatalf("unexpected result\n%s", cmp.Diff(got.Operation.RefreshTargets, tc.want))
+			}
+		})
+	}
+}
+
 func TestParsePlan_targetFile(t *testing.T) {
 	foobarbaz, _ := addrs.ParseTargetStr("foo_bar.baz")
 	boop, _ := addrs.ParseTargetStr("module.boop")
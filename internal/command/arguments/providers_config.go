@@ -0,0 +1,55 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package arguments
+
+import (
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// ProvidersConfig represents the command-line arguments for the 'providers config' command.
+type ProvidersConfig struct {
+	// ViewOptions specifies which view options to use
+	ViewOptions ViewOptions
+
+	// Vars holds and provides information for the flags related to variables that a user can give into the process
+	Vars *Vars
+}
+
+// ParseProvidersConfig processes CLI arguments, returning a ProvidersConfig value, a closer function, and errors.
+// If errors are encountered, a ProvidersConfig value is still returned representing
+// the best effort interpretation of the arguments.
+func ParseProvidersConfig(args []string) (*ProvidersConfig, func(), tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	config := &ProvidersConfig{
+		Vars: &Vars{},
+	}
+
+	cmdFlags := extendedFlagSet("providers config", nil, nil, config.Vars)
+	config.ViewOptions.AddFlags(cmdFlags, false)
+
+	if err := cmdFlags.Parse(args); err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to parse command-line flags",
+			err.Error(),
+		))
+	}
+
+	args = cmdFlags.Args()
+	if len(args) > 0 {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Too many command line arguments",
+			"Expected at most zero positional arguments.",
+		))
+	}
+
+	closer, moreDiags := config.ViewOptions.Parse()
+	diags = diags.Append(moreDiags)
+
+	return config, closer, diags
+}
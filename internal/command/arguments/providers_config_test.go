@@ -0,0 +1,84 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package arguments
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestParseProvidersConfig_basicValidation(t *testing.T) {
+	testCases := map[string]struct {
+		args        []string
+		want        *ProvidersConfig
+		wantErrText string
+	}{
+		"defaults": {
+			args: nil,
+			want: providersConfigArgsWithDefaults(nil),
+		},
+		"json": {
+			args: []string{"-json"},
+			want: providersConfigArgsWithDefaults(func(pc *ProvidersConfig) {
+				pc.ViewOptions.ViewType = ViewJSON
+			}),
+		},
+		"too many arguments": {
+			args:        []string{"aws"},
+			want:        providersConfigArgsWithDefaults(nil),
+			wantErrText: "Too many command line arguments",
+		},
+		"unknown flag": {
+			args:        []string{"-provider=aws"},
+			want:        providersConfigArgsWithDefaults(nil),
+			wantErrText: "Failed to parse command-line flags: flag provided but not defined: -provider",
+		},
+	}
+
+	cmpOpts := cmp.Options{
+		cmpopts.IgnoreUnexported(Vars{}, ViewOptions{}),
+		cmpopts.IgnoreFields(ViewOptions{}, "JSONInto"),
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, closer, diags := ParseProvidersConfig(tc.args)
+			defer closer()
+
+			if tc.wantErrText != "" && len(diags) == 0 {
+				t.Errorf("test wanted error but got nothing")
+			} else if tc.wantErrText == "" && len(diags) > 0 {
+				t.Errorf("test didn't expect errors but got some: %s", diags.ErrWithWarnings())
+			} else if tc.wantErrText != "" && len(diags) > 0 {
+				errStr := diags.ErrWithWarnings().Error()
+				if !strings.Contains(errStr, tc.wantErrText) {
+					t.Errorf("wrong error\ngot:  %s\nwant: %s", errStr, tc.wantErrText)
+				}
+			}
+
+			if diff := cmp.Diff(tc.want, got, cmpOpts); diff != "" {
+				t.Errorf("unexpected result\n%s", diff)
+			}
+		})
+	}
+}
+
+func providersConfigArgsWithDefaults(mutate func(pc *ProvidersConfig)) *ProvidersConfig {
+	ret := &ProvidersConfig{
+		ViewOptions: ViewOptions{
+			ViewType:     ViewHuman,
+			InputEnabled: false,
+		},
+		Vars: &Vars{},
+	}
+	if mutate != nil {
+		mutate(ret)
+	}
+	return ret
+}
@@ -6,10 +6,20 @@
 package arguments
 
 import (
+	"fmt"
+	"slices"
+	"strings"
+
 	"github.com/opentofu/opentofu/internal/command/flags"
 	"github.com/opentofu/opentofu/internal/tfdiags"
 )
 
+// providersLockPlatformPresets are the named sets of platforms that can be selected with the -platform-preset option.
+var providersLockPlatformPresets = map[string][]string{
+	// default-ci covers the platforms typically used by developer workstations and CI runners.
+	"default-ci": {"linux_amd64", "linux_arm64", "darwin_amd64", "darwin_arm64", "windows_amd64"},
+}
+
 // ProvidersLock represents the command-line arguments for the 'providers lock' command.
 type ProvidersLock struct {
 	// Providers are the source addresses of the providers that are requested to be updated
@@ -18,12 +28,20 @@ type ProvidersLock struct {
 	// Having this empty, only the checksum for the host platform will be updated, but the user
 	// can use this to update the hashes for other platforms too.
 	OptPlatforms flags.FlagStringSlice
+	// PlatformPreset is the name of a predefined set of platforms that is added to OptPlatforms.
+	PlatformPreset string
 	// FsMirrorDir represents a path from where OpenTofu should check for providers instead to reach
 	// out for the registry.
 	FsMirrorDir string
 	// NetMirrorURL represents a URL to a mirrored registry from where OpenTofu should check for
 	// providers instead to reach out for the registry.
 	NetMirrorURL string
+	// FromCache enables calculating the checksums of already locked provider versions from the packages
+	// in the provider cache directories instead of downloading them again.
+	FromCache bool
+	// Resolve enables reading a dependency lock file that contains version control conflict markers
+	// and replacing it with a merge of both sides of the conflicts.
+	Resolve bool
 
 	// ViewOptions specifies which view options to use
 	ViewOptions ViewOptions
@@ -44,6 +62,9 @@ func ParseProvidersLock(args []string) (*ProvidersLock, func(), tfdiags.Diagnost
 	cmdFlags.Var(&arguments.OptPlatforms, "platform", "target platform")
 	cmdFlags.StringVar(&arguments.FsMirrorDir, "fs-mirror", "", "filesystem mirror directory")
 	cmdFlags.StringVar(&arguments.NetMirrorURL, "net-mirror", "", "network mirror base URL")
+	cmdFlags.StringVar(&arguments.PlatformPreset, "platform-preset", "", "predefined set of target platforms")
+	cmdFlags.BoolVar(&arguments.FromCache, "from-cache", false, "calculate checksums from cached packages")
+	cmdFlags.BoolVar(&arguments.Resolve, "resolve", false, "resolve conflicts in the lock file")
 	arguments.ViewOptions.AddFlags(cmdFlags, false)
 	if err := cmdFlags.Parse(args); err != nil {
 		diags = diags.Append(tfdiags.Sourceless(
@@ -59,6 +80,26 @@ func ParseProvidersLock(args []string) (*ProvidersLock, func(), tfdiags.Diagnost
 			"The -fs-mirror and -net-mirror command line options are mutually-exclusive.",
 		))
 	}
+	if arguments.PlatformPreset != "" {
+		platforms, ok := providersLockPlatformPresets[arguments.PlatformPreset]
+		if !ok {
+			presets := make([]string, 0, len(providersLockPlatformPresets))
+			for name := range providersLockPlatformPresets {
+				presets = append(presets, name)
+			}
+			slices.Sort(presets)
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Invalid platform preset",
+				fmt.Sprintf("The -platform-preset option must be one of: %s.", strings.Join(presets, ", ")),
+			))
+		}
+		for _, platform := range platforms {
+			if !slices.Contains(arguments.OptPlatforms, platform) {
+				arguments.OptPlatforms = append(arguments.OptPlatforms, platform)
+			}
+		}
+	}
 
 	closer, moreDiags := arguments.ViewOptions.Parse()
 	diags = diags.Append(moreDiags)
@@ -78,6 +78,43 @@ func TestParseProvidersLock_basicValidation(t *testing.T) {
 				v.Providers = []string{"test_ns/test_provider", "test_ns2/test_provider2"}
 			}),
 		},
+		"platform preset": {
+			args: []string{"-platform-preset=default-ci"},
+			want: providersLockArgsWithDefaults(func(v *ProvidersLock) {
+				v.Providers = []string{}
+				v.PlatformPreset = "default-ci"
+				v.OptPlatforms = []string{"linux_amd64", "linux_arm64", "darwin_amd64", "darwin_arm64", "windows_amd64"}
+			}),
+		},
+		"platform preset with additional platforms": {
+			args: []string{"-platform=freebsd_amd64", "-platform=linux_arm64", "-platform-preset=default-ci"},
+			want: providersLockArgsWithDefaults(func(v *ProvidersLock) {
+				v.Providers = []string{}
+				v.PlatformPreset = "default-ci"
+				v.OptPlatforms = []string{"freebsd_amd64", "linux_arm64", "linux_amd64", "darwin_amd64", "darwin_arm64", "windows_amd64"}
+			}),
+		},
+		"unknown platform preset": {
+			args: []string{"-platform-preset=everything"},
+			want: providersLockArgsWithDefaults(func(v *ProvidersLock) {
+				v.PlatformPreset = "everything"
+			}),
+			wantErrText: "The -platform-preset option must be one of: default-ci.",
+		},
+		"from-cache flag": {
+			args: []string{"-from-cache"},
+			want: providersLockArgsWithDefaults(func(v *ProvidersLock) {
+				v.Providers = []string{}
+				v.FromCache = true
+			}),
+		},
+		"resolve flag": {
+			args: []string{"-resolve"},
+			want: providersLockArgsWithDefaults(func(v *ProvidersLock) {
+				v.Providers = []string{}
+				v.Resolve = true
+			}),
+		},
 		"unknown flag": {
 			args:        []string{"-unknown-flag"},
 			want:        providersLockArgsWithDefaults(func(v *ProvidersLock) {}),
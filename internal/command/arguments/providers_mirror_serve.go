@@ -0,0 +1,85 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package arguments
+
+import (
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// DefaultProvidersMirrorServeAddress is the address that the
+// "providers mirror-serve" command listens on when not given -address.
+const DefaultProvidersMirrorServeAddress = "localhost:8443"
+
+// ProvidersMirrorServe represents the command-line arguments for the
+// "providers mirror-serve" command.
+type ProvidersMirrorServe struct {
+	// Directory is the mirror directory to serve, as populated by the
+	// "providers mirror" command.
+	Directory string
+
+	// Address is the TCP address to listen on, in the form "host:port".
+	Address string
+
+	// TLSCertFile and TLSKeyFile are the paths of the PEM-encoded certificate
+	// and private key to serve HTTPS with. Both are empty when serving plain
+	// HTTP.
+	TLSCertFile string
+	TLSKeyFile  string
+
+	// TokenFile is the path of a file containing the token that clients
+	// must present as a bearer token, or empty to allow all clients.
+	TokenFile string
+
+	// ViewOptions specifies which view options to use
+	ViewOptions ViewOptions
+}
+
+// ParseProvidersMirrorServe processes CLI arguments, returning a ProvidersMirrorServe value, a closer function, and errors.
+// If errors are encountered, a ProvidersMirrorServe value is still returned representing
+// the best effort interpretation of the arguments.
+func ParseProvidersMirrorServe(args []string) (*ProvidersMirrorServe, func(), tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+	arguments := &ProvidersMirrorServe{}
+
+	cmdFlags := defaultFlagSet("providers mirror-serve")
+	cmdFlags.StringVar(&arguments.Address, "address", DefaultProvidersMirrorServeAddress, "address")
+	cmdFlags.StringVar(&arguments.TLSCertFile, "tls-cert", "", "tls-cert")
+	cmdFlags.StringVar(&arguments.TLSKeyFile, "tls-key", "", "tls-key")
+	cmdFlags.StringVar(&arguments.TokenFile, "token-file", "", "token-file")
+	arguments.ViewOptions.AddFlags(cmdFlags, false)
+
+	if err := cmdFlags.Parse(args); err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to parse command-line flags",
+			err.Error(),
+		))
+	}
+
+	args = cmdFlags.Args()
+	if len(args) != 1 {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Wrong number of arguments",
+			"The providers mirror-serve command requires the mirror directory to serve as a command-line argument.",
+		))
+	} else {
+		arguments.Directory = args[0]
+	}
+
+	if (arguments.TLSCertFile == "") != (arguments.TLSKeyFile == "") {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Incomplete TLS configuration",
+			"The -tls-cert and -tls-key options must be used together.",
+		))
+	}
+
+	closer, moreDiags := arguments.ViewOptions.Parse()
+	diags = diags.Append(moreDiags)
+
+	return arguments, closer, diags
+}
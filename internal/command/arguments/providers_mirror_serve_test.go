@@ -0,0 +1,77 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package arguments
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestParseProvidersMirrorServe(t *testing.T) {
+	testCases := map[string]struct {
+		args    []string
+		want    *ProvidersMirrorServe
+		wantErr string
+	}{
+		"defaults": {
+			args: []string{"mirror"},
+			want: &ProvidersMirrorServe{
+				Directory:   "mirror",
+				Address:     DefaultProvidersMirrorServeAddress,
+				ViewOptions: ViewOptions{ViewType: ViewHuman},
+			},
+		},
+		"all options": {
+			args: []string{"-address=:9443", "-tls-cert=cert.pem", "-tls-key=key.pem", "-token-file=token", "-json", "mirror"},
+			want: &ProvidersMirrorServe{
+				Directory:   "mirror",
+				Address:     ":9443",
+				TLSCertFile: "cert.pem",
+				TLSKeyFile:  "key.pem",
+				TokenFile:   "token",
+				ViewOptions: ViewOptions{ViewType: ViewJSON},
+			},
+		},
+		"no directory": {
+			args: nil,
+			want: &ProvidersMirrorServe{
+				Address:     DefaultProvidersMirrorServeAddress,
+				ViewOptions: ViewOptions{ViewType: ViewHuman},
+			},
+			wantErr: "Wrong number of arguments",
+		},
+		"certificate without key": {
+			args: []string{"-tls-cert=cert.pem", "mirror"},
+			want: &ProvidersMirrorServe{
+				Directory:   "mirror",
+				Address:     DefaultProvidersMirrorServeAddress,
+				TLSCertFile: "cert.pem",
+				ViewOptions: ViewOptions{ViewType: ViewHuman},
+			},
+			wantErr: "Incomplete TLS configuration",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, closer, diags := ParseProvidersMirrorServe(tc.args)
+			defer closer()
+			if tc.wantErr != "" {
+				if !diags.HasErrors() || !strings.Contains(diags.Err().Error(), tc.wantErr) {
+					t.Fatalf("wrong diagnostics; want error %q\n%s", tc.wantErr, diags.ErrWithWarnings())
+				}
+			} else if len(diags) > 0 {
+				t.Fatalf("unexpected diags: %s", diags.ErrWithWarnings())
+			}
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(ViewOptions{})); diff != "" {
+				t.Errorf("unexpected result\n%s", diff)
+			}
+		})
+	}
+}
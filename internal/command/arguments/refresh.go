@@ -52,6 +52,13 @@ func ParseRefresh(args []string) (*Refresh, func(), tfdiags.Diagnostics) {
 	}
 
 	diags = diags.Append(refresh.Operation.Parse())
+	if len(refresh.Operation.RefreshTargets) != 0 {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid refresh option",
+			"The -refresh-target option can't be used with the refresh command. To refresh only some resource instances, use -target instead.",
+		))
+	}
 	closer, moreDiags := refresh.ViewOptions.Parse()
 	diags = diags.Append(moreDiags)
 
@@ -81,6 +81,16 @@ func TestParseRefresh_invalid(t *testing.T) {
 	}
 }
 
+func TestParseRefresh_refreshTarget(t *testing.T) {
+	_, _, diags := ParseRefresh([]string{"-refresh-target=foo_bar.baz"})
+	if len(diags) == 0 {
+		t.Fatal("expected diags but got none")
+	}
+	if got, want := diags.Err().Error(), "The -refresh-target option can't be used with the refresh command."; !strings.Contains(got, want) {
+		t.Fatalf("wrong diags\n got: %s\nwant: %s", got, want)
+	}
+}
+
 func TestParseRefresh_tooManyArguments(t *testing.T) {
 	got, _, diags := ParseRefresh([]string{"saved.tfplan"})
 	if len(diags) == 0 {
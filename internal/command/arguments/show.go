@@ -26,6 +26,14 @@ type Show struct {
 
 	// ShowSensitive is used to display the value of variables marked as sensitive.
 	ShowSensitive bool
+
+	// Graph requests a graph of the dependencies between the resource
+	// instances that a saved plan changes, instead of the plan itself.
+	Graph bool
+
+	// Serial is the serial of the previous state snapshot to show instead
+	// of the latest one, if set. It can only be set for [ShowState].
+	Serial *uint64
 }
 
 // ShowTargetType represents the type of object that is requested to be
@@ -76,12 +84,15 @@ func ParseShow(args []string) (*Show, func(), tfdiags.Diagnostics) {
 	var planTarget string
 	var configTarget bool
 	var moduleTarget string
+	var serial string
 	cmdFlags := extendedFlagSet("show", nil, nil, show.Vars)
 	cmdFlags.BoolVar(&show.ShowSensitive, "show-sensitive", false, "displays sensitive values")
 	cmdFlags.BoolVar(&stateTarget, "state", false, "show the latest state snapshot")
 	cmdFlags.StringVar(&planTarget, "plan", "", "show the plan from a saved plan file")
 	cmdFlags.BoolVar(&configTarget, "config", false, "show the current configuration")
 	cmdFlags.StringVar(&moduleTarget, "module", "", "show metadata about one module")
+	cmdFlags.BoolVar(&show.Graph, "graph", false, "show the dependency graph of a saved plan")
+	cmdFlags.StringVar(&serial, "serial", "", "show a previous state snapshot")
 
 	show.ViewOptions.AddFlags(cmdFlags, false)
 
@@ -96,6 +107,34 @@ func ParseShow(args []string) (*Show, func(), tfdiags.Diagnostics) {
 	closer, moreDiags := show.ViewOptions.Parse()
 	diags = diags.Append(moreDiags)
 
+	show.Serial, moreDiags = parseStateSerial(serial)
+	diags = diags.Append(moreDiags)
+	if show.Serial != nil && (planTarget != "" || configTarget || moduleTarget != "" || len(cmdFlags.Args()) != 0) {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"State required for serial",
+			"The -serial option selects a previous snapshot of the latest state, so it can only be used when showing the state.",
+		))
+		return show, closer, diags
+	}
+
+	if show.Graph && (show.ViewOptions.jsonFlag || show.ViewOptions.jsonIntoFlag != "") {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Incompatible command-line options",
+			"The -graph option produces a graph in the DOT language, so it cannot be combined with -json or -json-into.",
+		))
+		return show, closer, diags
+	}
+	if show.Graph && (stateTarget || configTarget || moduleTarget != "") {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Saved plan required for graph",
+			"The -graph option requires a saved plan file, given either with -plan=FILENAME or as a positional argument.",
+		))
+		return show, closer, diags
+	}
+
 	// If -config or -module=... is selected, -json is required
 	if configTarget && !show.ViewOptions.jsonFlag {
 		diags = diags.Append(tfdiags.Sourceless(
@@ -121,6 +160,13 @@ func ParseShow(args []string) (*Show, func(), tfdiags.Diagnostics) {
 		args = cmdFlags.Args()
 		switch len(args) {
 		case 0:
+			if show.Graph {
+				diags = diags.Append(tfdiags.Sourceless(
+					tfdiags.Error,
+					"Saved plan required for graph",
+					"The -graph option requires a saved plan file, given either with -plan=FILENAME or as a positional argument.",
+				))
+			}
 			show.TargetType = ShowState
 			show.TargetArg = ""
 		case 1:
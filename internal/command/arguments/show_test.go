@@ -50,6 +50,24 @@ func TestParseShow_valid(t *testing.T) {
 				ViewOptions: ViewOptions{ViewType: ViewJSON},
 			},
 		},
+		"previous state snapshot": {
+			[]string{"-state", "-serial=3"},
+			&Show{
+				TargetType:  ShowState,
+				TargetArg:   "",
+				ViewOptions: ViewOptions{ViewType: ViewHuman},
+				Serial:      testSerial(3),
+			},
+		},
+		"previous state snapshot in legacy mode": {
+			[]string{"-serial=3"},
+			&Show{
+				TargetType:  ShowState,
+				TargetArg:   "",
+				ViewOptions: ViewOptions{ViewType: ViewHuman},
+				Serial:      testSerial(3),
+			},
+		},
 		"saved plan file": {
 			[]string{"-plan=tfplan"},
 			&Show{
@@ -98,6 +116,24 @@ func TestParseShow_valid(t *testing.T) {
 				ViewOptions: ViewOptions{ViewType: ViewJSON},
 			},
 		},
+		"graph of saved plan file": {
+			[]string{"-graph", "-plan=tfplan"},
+			&Show{
+				TargetType:  ShowPlan,
+				TargetArg:   "tfplan",
+				ViewOptions: ViewOptions{ViewType: ViewHuman},
+				Graph:       true,
+			},
+		},
+		"graph with legacy positional argument": {
+			[]string{"-graph", "tfplan"},
+			&Show{
+				TargetType:  ShowUnknownType,
+				TargetArg:   "tfplan",
+				ViewOptions: ViewOptions{ViewType: ViewHuman},
+				Graph:       true,
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -108,7 +144,7 @@ func TestParseShow_valid(t *testing.T) {
 			if len(diags) > 0 {
 				t.Fatalf("unexpected diags: %v", diags)
 			}
-			if *got != *tc.want {
+			if !reflect.DeepEqual(got, tc.want) {
 				t.Fatalf("unexpected result\n got: %#v\nwant: %#v", got, tc.want)
 			}
 		})
@@ -161,6 +197,34 @@ func TestParseShow_invalid(t *testing.T) {
 				),
 			},
 		},
+		"serial with planfile target selection": {
+			[]string{"-plan=foo", "-serial=3"},
+			&Show{
+				ViewOptions: ViewOptions{ViewType: ViewHuman},
+				Serial:      testSerial(3),
+			},
+			tfdiags.Diagnostics{
+				tfdiags.Sourceless(
+					tfdiags.Error,
+					"State required for serial",
+					"The -serial option selects a previous snapshot of the latest state, so it can only be used when showing the state.",
+				),
+			},
+		},
+		"invalid serial": {
+			[]string{"-serial=-1"},
+			&Show{
+				TargetType:  ShowState,
+				ViewOptions: ViewOptions{ViewType: ViewHuman},
+			},
+			tfdiags.Diagnostics{
+				tfdiags.Sourceless(
+					tfdiags.Error,
+					"Invalid state serial",
+					`The -serial option requires the serial number of a state snapshot, but got "-1".`,
+				),
+			},
+		},
 		"conflicting target selection options": {
 			[]string{"-state", "-plan=foo"},
 			&Show{
@@ -245,6 +309,49 @@ func TestParseShow_invalid(t *testing.T) {
 				),
 			},
 		},
+		"graph with json": {
+			[]string{"-graph", "-json", "-plan=tfplan"},
+			&Show{
+				ViewOptions: ViewOptions{ViewType: ViewJSON},
+				Graph:       true,
+			},
+			tfdiags.Diagnostics{
+				tfdiags.Sourceless(
+					tfdiags.Error,
+					"Incompatible command-line options",
+					"The -graph option produces a graph in the DOT language, so it cannot be combined with -json or -json-into.",
+				),
+			},
+		},
+		"graph of state": {
+			[]string{"-graph", "-state"},
+			&Show{
+				ViewOptions: ViewOptions{ViewType: ViewHuman},
+				Graph:       true,
+			},
+			tfdiags.Diagnostics{
+				tfdiags.Sourceless(
+					tfdiags.Error,
+					"Saved plan required for graph",
+					"The -graph option requires a saved plan file, given either with -plan=FILENAME or as a positional argument.",
+				),
+			},
+		},
+		"graph without plan": {
+			[]string{"-graph"},
+			&Show{
+				TargetType:  ShowState,
+				ViewOptions: ViewOptions{ViewType: ViewHuman},
+				Graph:       true,
+			},
+			tfdiags.Diagnostics{
+				tfdiags.Sourceless(
+					tfdiags.Error,
+					"Saved plan required for graph",
+					"The -graph option requires a saved plan file, given either with -plan=FILENAME or as a positional argument.",
+				),
+			},
+		},
 		"module without json": {
 			[]string{"-module=foo"},
 			&Show{
@@ -310,7 +417,7 @@ func TestParseShow_invalid(t *testing.T) {
 			got, _, gotDiags := ParseShow(tc.args)
 			got.Vars = nil
 			got.ViewOptions.jsonFlag = tc.want.ViewOptions.jsonFlag
-			if *got != *tc.want {
+			if !reflect.DeepEqual(got, tc.want) {
 				t.Fatalf("unexpected result\n got: %#v\nwant: %#v", got, tc.want)
 			}
 			if !reflect.DeepEqual(gotDiags, tc.wantDiags) {
@@ -319,3 +426,7 @@ func TestParseShow_invalid(t *testing.T) {
 		})
 	}
 }
+
+func testSerial(serial uint64) *uint64 {
+	return &serial
+}
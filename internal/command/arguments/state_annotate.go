@@ -0,0 +1,162 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package arguments
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/opentofu/opentofu/internal/command/flags"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// StateAnnotate represents the command-line arguments for the 'state annotate' command.
+type StateAnnotate struct {
+	// TargetAddr is the raw address of the resource instances to annotate.
+	TargetAddr string
+	// Set contains the annotations to add or replace, by key.
+	Set map[string]string
+	// Remove contains the keys of the annotations to remove.
+	Remove []string
+	// DryRun just validates that the arguments provided are valid and will output the possible outcome.
+	// When running in this mode, the state will suffer no change.
+	DryRun bool
+	//  BackupPath can be used by the user to configure where to save the backup file of the state file.
+	BackupPath string
+	// StatePath represents the path of the state to be used for the annotate operation.
+	StatePath string
+
+	// ViewOptions specifies which view options to use
+	ViewOptions ViewOptions
+
+	// Vars and Backend are the common extended flags
+	Vars    *Vars
+	Backend Backend
+}
+
+// ParseStateAnnotate processes CLI arguments, returning a StateAnnotate value, a closer function, and errors.
+// If errors are encountered, a StateAnnotate value is still returned representing
+// the best effort interpretation of the arguments.
+func ParseStateAnnotate(args []string) (*StateAnnotate, func(), tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	ret := &StateAnnotate{
+		Vars: &Vars{},
+	}
+	cmdFlags := extendedFlagSet("state annotate", nil, nil, ret.Vars)
+	ret.Backend.AddIgnoreRemoteVersionFlag(cmdFlags)
+	ret.Backend.AddStateFlags(cmdFlags)
+	cmdFlags.BoolVar(&ret.DryRun, "dry-run", false, "dry run")
+	cmdFlags.Var((*flags.FlagStringSlice)(&ret.Remove), "remove", "remove")
+	// NOTE: as in "state rm", the -backup flag needs a different default
+	// value than the one registered by the [State] flags extension.
+	cmdFlags.StringVar(&ret.BackupPath, "backup", "-", "backup-path")
+	cmdFlags.StringVar(&ret.StatePath, "state", "", "state-path")
+
+	ret.ViewOptions.AddFlags(cmdFlags, false)
+
+	if err := cmdFlags.Parse(args); err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to parse command-line flags",
+			err.Error(),
+		))
+	}
+
+	args = cmdFlags.Args()
+	if len(args) == 0 {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid number of arguments",
+			"The address of the resource instances to annotate is required",
+		))
+	} else {
+		ret.TargetAddr = args[0]
+		for _, raw := range args[1:] {
+			key, value, ok := strings.Cut(raw, "=")
+			if !ok || !validAnnotationKey(key) {
+				diags = diags.Append(tfdiags.Sourceless(
+					tfdiags.Error,
+					"Invalid annotation",
+					fmt.Sprintf("The argument %q is not a valid annotation. Annotations must be given as KEY=VALUE, where KEY is not empty.", raw),
+				))
+				continue
+			}
+			if ret.Set == nil {
+				ret.Set = make(map[string]string)
+			}
+			ret.Set[key] = value
+		}
+	}
+	for _, key := range ret.Remove {
+		if !validAnnotationKey(key) {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Invalid annotation key",
+				fmt.Sprintf("The -remove option requires an annotation key, but %q is not a valid key.", key),
+			))
+		} else if _, exists := ret.Set[key]; exists {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Conflicting annotation arguments",
+				fmt.Sprintf("The annotation %q cannot be both set and removed in the same command.", key),
+			))
+		}
+	}
+	if len(args) != 0 && len(ret.Set) == 0 && len(ret.Remove) == 0 && !diags.HasErrors() {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"No annotations given",
+			"At least one KEY=VALUE argument or -remove option is required.",
+		))
+	}
+
+	closer, moreDiags := ret.ViewOptions.Parse()
+	diags = diags.Append(moreDiags)
+
+	return ret, closer, diags
+}
+
+// AnnotationFilter selects resource instances by one of their annotations.
+type AnnotationFilter struct {
+	// Key is the key of the annotation that the instances must have.
+	Key string
+	// Value is the value that the annotation must have, unless AnyValue is set.
+	Value string
+	// AnyValue is true if the instances only need to have an annotation with
+	// the given key, regardless of its value.
+	AnyValue bool
+}
+
+// Match returns true if the given annotations satisfy the filter.
+func (f AnnotationFilter) Match(annotations map[string]string) bool {
+	v, ok := annotations[f.Key]
+	if !ok {
+		return false
+	}
+	return f.AnyValue || v == f.Value
+}
+
+// parseAnnotationFilter parses a filter given as either KEY, to select
+// instances that have an annotation with that key, or KEY=VALUE, to also
+// require a specific value.
+func parseAnnotationFilter(raw string) (AnnotationFilter, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	key, value, hasValue := strings.Cut(raw, "=")
+	if !validAnnotationKey(key) {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid annotation filter",
+			fmt.Sprintf("The filter %q is not valid. Filters must be given as KEY or KEY=VALUE, where KEY is not empty.", raw),
+		))
+	}
+	return AnnotationFilter{Key: key, Value: value, AnyValue: !hasValue}, diags
+}
+
+func validAnnotationKey(key string) bool {
+	return strings.TrimSpace(key) != ""
+}
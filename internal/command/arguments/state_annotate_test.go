@@ -0,0 +1,124 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package arguments
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestParseStateAnnotate_basicValidation(t *testing.T) {
+	testCases := map[string]struct {
+		args        []string
+		want        *StateAnnotate
+		wantErrText string
+	}{
+		"set annotations": {
+			args: []string{"resource.foo", "owner=team-a", "ticket=OPS-1"},
+			want: stateAnnotateArgsWithDefaults(func(stateAnnotate *StateAnnotate) {
+				stateAnnotate.TargetAddr = "resource.foo"
+				stateAnnotate.Set = map[string]string{"owner": "team-a", "ticket": "OPS-1"}
+			}),
+		},
+		"value containing equals sign": {
+			args: []string{"resource.foo", "note=a=b"},
+			want: stateAnnotateArgsWithDefaults(func(stateAnnotate *StateAnnotate) {
+				stateAnnotate.TargetAddr = "resource.foo"
+				stateAnnotate.Set = map[string]string{"note": "a=b"}
+			}),
+		},
+		"empty value": {
+			args: []string{"resource.foo", "owner="},
+			want: stateAnnotateArgsWithDefaults(func(stateAnnotate *StateAnnotate) {
+				stateAnnotate.TargetAddr = "resource.foo"
+				stateAnnotate.Set = map[string]string{"owner": ""}
+			}),
+		},
+		"remove annotations": {
+			args: []string{"-remove=owner", "-remove=ticket", "-dry-run", "resource.foo"},
+			want: stateAnnotateArgsWithDefaults(func(stateAnnotate *StateAnnotate) {
+				stateAnnotate.TargetAddr = "resource.foo"
+				stateAnnotate.Remove = []string{"owner", "ticket"}
+				stateAnnotate.DryRun = true
+			}),
+		},
+		"no address": {
+			args:        []string{},
+			want:        stateAnnotateArgsWithDefaults(nil),
+			wantErrText: "Invalid number of arguments",
+		},
+		"no annotations": {
+			args: []string{"resource.foo"},
+			want: stateAnnotateArgsWithDefaults(func(stateAnnotate *StateAnnotate) {
+				stateAnnotate.TargetAddr = "resource.foo"
+			}),
+			wantErrText: "No annotations given",
+		},
+		"invalid annotation": {
+			args: []string{"resource.foo", "owner"},
+			want: stateAnnotateArgsWithDefaults(func(stateAnnotate *StateAnnotate) {
+				stateAnnotate.TargetAddr = "resource.foo"
+			}),
+			wantErrText: `The argument "owner" is not a valid annotation`,
+		},
+		"set and remove the same key": {
+			args: []string{"-remove=owner", "resource.foo", "owner=team-a"},
+			want: stateAnnotateArgsWithDefaults(func(stateAnnotate *StateAnnotate) {
+				stateAnnotate.TargetAddr = "resource.foo"
+				stateAnnotate.Set = map[string]string{"owner": "team-a"}
+				stateAnnotate.Remove = []string{"owner"}
+			}),
+			wantErrText: "Conflicting annotation arguments",
+		},
+	}
+
+	cmpOpts := cmp.Options{
+		cmpopts.IgnoreUnexported(Vars{}, ViewOptions{}, State{}),
+		cmpopts.IgnoreFields(ViewOptions{}, "JSONInto"), // We ignore JSONInto because it contains a file which is not really diffable
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, closer, diags := ParseStateAnnotate(tc.args)
+			defer closer()
+
+			if tc.wantErrText != "" && len(diags) == 0 {
+				t.Errorf("test wanted error but got nothing")
+			} else if tc.wantErrText == "" && len(diags) > 0 {
+				t.Errorf("test didn't expect errors but got some: %s", diags.ErrWithWarnings())
+			} else if tc.wantErrText != "" && len(diags) > 0 {
+				errStr := diags.ErrWithWarnings().Error()
+				if !strings.Contains(errStr, tc.wantErrText) {
+					t.Errorf("the returned diagnostics does not contain the expected error message.\ndiags:\n%s\nwanted: %s\n", errStr, tc.wantErrText)
+				}
+			}
+			if diff := cmp.Diff(tc.want, got, cmpOpts); diff != "" {
+				t.Errorf("unexpected result\n%s", diff)
+			}
+		})
+	}
+}
+
+func stateAnnotateArgsWithDefaults(mutate func(stateAnnotate *StateAnnotate)) *StateAnnotate {
+	ret := &StateAnnotate{
+		BackupPath: "-",
+		ViewOptions: ViewOptions{
+			ViewType:     ViewHuman,
+			InputEnabled: false,
+		},
+		Backend: Backend{
+			StateLock: true,
+		},
+		Vars: &Vars{},
+	}
+	if mutate != nil {
+		mutate(ret)
+	}
+	return ret
+}
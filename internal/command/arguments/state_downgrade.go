@@ -0,0 +1,55 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package arguments
+
+import (
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// StateDowngrade represents the command-line arguments for the 'state downgrade' command.
+type StateDowngrade struct {
+	// StateSrc represents the source of the state that wants to be downgraded.
+	// This can be a file name/file path, or it can be "-" when the state should be read from [os.Stdin].
+	StateSrc string
+	// ViewOptions specifies which view options to use
+	ViewOptions ViewOptions
+}
+
+// ParseStateDowngrade processes CLI arguments, returning a StateDowngrade value, a closer function, and errors.
+// If errors are encountered, a StateDowngrade value is still returned representing
+// the best effort interpretation of the arguments.
+func ParseStateDowngrade(args []string) (*StateDowngrade, func(), tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	ret := &StateDowngrade{}
+	cmdFlags := defaultFlagSet("state downgrade")
+
+	if err := cmdFlags.Parse(args); err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to parse command-line flags",
+			err.Error(),
+		))
+	}
+
+	args = cmdFlags.Args()
+	if len(args) != 1 {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid number of arguments",
+			"Exactly one argument expected",
+		))
+	} else {
+		ret.StateSrc = args[0]
+	}
+
+	// we only parse but do not register the views flags since this command does not need it because it already
+	// prints the state in json format
+	closer, moreDiags := ret.ViewOptions.Parse()
+	diags = diags.Append(moreDiags)
+
+	return ret, closer, diags
+}
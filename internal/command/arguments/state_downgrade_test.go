@@ -0,0 +1,91 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package arguments
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestParseStateDowngrade_basicValidation(t *testing.T) {
+	testCases := map[string]struct {
+		args        []string
+		want        *StateDowngrade
+		wantErrText string
+	}{
+		"path": {
+			args: []string{"terraform.tfstate"},
+			want: stateDowngradeArgsWithDefaults(func(args *StateDowngrade) {
+				args.StateSrc = "terraform.tfstate"
+			}),
+		},
+		"stdin": {
+			args: []string{"-"},
+			want: stateDowngradeArgsWithDefaults(func(args *StateDowngrade) {
+				args.StateSrc = "-"
+			}),
+		},
+		"no arguments": {
+			args:        []string{},
+			want:        stateDowngradeArgsWithDefaults(nil),
+			wantErrText: "Exactly one argument expected",
+		},
+		"too many arguments": {
+			args:        []string{"foo", "bar"},
+			want:        stateDowngradeArgsWithDefaults(nil),
+			wantErrText: "Exactly one argument expected",
+		},
+		"unknown flag": {
+			args: []string{"-unknown", "foo"},
+			want: stateDowngradeArgsWithDefaults(func(args *StateDowngrade) {
+				args.StateSrc = "foo"
+			}),
+			wantErrText: "Failed to parse command-line flags",
+		},
+	}
+
+	cmpOpts := cmp.Options{
+		cmpopts.IgnoreUnexported(ViewOptions{}),
+		cmpopts.IgnoreFields(ViewOptions{}, "JSONInto"), // We ignore JSONInto because it contains a file which is not really diffable
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, closer, diags := ParseStateDowngrade(tc.args)
+			defer closer()
+
+			if tc.wantErrText != "" && len(diags) == 0 {
+				t.Errorf("test wanted error but got nothing")
+			} else if tc.wantErrText == "" && len(diags) > 0 {
+				t.Errorf("test didn't expect errors but got some: %s", diags.ErrWithWarnings())
+			} else if tc.wantErrText != "" && len(diags) > 0 {
+				errStr := diags.ErrWithWarnings().Error()
+				if !strings.Contains(errStr, tc.wantErrText) {
+					t.Errorf("the returned diagnostics does not contain the expected error message.\ndiags:\n%s\nwanted: %s\n", errStr, tc.wantErrText)
+				}
+			}
+			if diff := cmp.Diff(tc.want, got, cmpOpts); diff != "" {
+				t.Errorf("unexpected result\n%s", diff)
+			}
+		})
+	}
+}
+
+func stateDowngradeArgsWithDefaults(mutate func(args *StateDowngrade)) *StateDowngrade {
+	ret := &StateDowngrade{
+		ViewOptions: ViewOptions{
+			ViewType:     ViewHuman,
+			InputEnabled: false,
+		},
+	}
+	if mutate != nil {
+		mutate(ret)
+	}
+	return ret
+}
@@ -0,0 +1,63 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package arguments
+
+import (
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// StateEncryption represents the command-line arguments for the 'state encrypt', 'state decrypt', and
+// 'state rotate' commands.
+type StateEncryption struct {
+	// History requests to also rewrite the previous versions of the state that the backend keeps, where they
+	// are accessible.
+	History bool
+
+	// ViewOptions specifies which view options to use
+	ViewOptions ViewOptions
+
+	// Vars and Backend are the common extended flags
+	Vars    *Vars
+	Backend Backend
+}
+
+// ParseStateEncryption processes CLI arguments for the state encryption command with the given name, returning a
+// StateEncryption value, a closer function, and errors.
+// If errors are encountered, a StateEncryption value is still returned representing
+// the best effort interpretation of the arguments.
+func ParseStateEncryption(name string, args []string) (*StateEncryption, func(), tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	ret := &StateEncryption{
+		Vars: &Vars{},
+	}
+	cmdFlags := extendedFlagSet(name, nil, nil, ret.Vars)
+	ret.Backend.AddIgnoreRemoteVersionFlag(cmdFlags)
+	ret.Backend.AddStateFlags(cmdFlags)
+	cmdFlags.BoolVar(&ret.History, "history", false, "history")
+	ret.ViewOptions.AddFlags(cmdFlags, false)
+
+	if err := cmdFlags.Parse(args); err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to parse command-line flags",
+			err.Error(),
+		))
+	}
+
+	if args := cmdFlags.Args(); len(args) > 0 {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Too many command line arguments",
+			"Expected no positional arguments.",
+		))
+	}
+
+	closer, moreDiags := ret.ViewOptions.Parse()
+	diags = diags.Append(moreDiags)
+
+	return ret, closer, diags
+}
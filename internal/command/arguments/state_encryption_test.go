@@ -0,0 +1,106 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package arguments
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestParseStateEncryption_basicValidation(t *testing.T) {
+	testCases := map[string]struct {
+		args        []string
+		want        *StateEncryption
+		wantErrText string
+	}{
+		"defaults": {
+			args: nil,
+			want: stateEncryptionArgsWithDefaults(nil),
+		},
+		"history": {
+			args: []string{"-history"},
+			want: stateEncryptionArgsWithDefaults(func(v *StateEncryption) {
+				v.History = true
+			}),
+		},
+		"locking": {
+			args: []string{"-lock=false", "-lock-timeout=10s"},
+			want: stateEncryptionArgsWithDefaults(func(v *StateEncryption) {
+				v.Backend.StateLock = false
+				v.Backend.StateLockTimeout = 10 * time.Second
+			}),
+		},
+		"ignore remote version": {
+			args: []string{"-ignore-remote-version"},
+			want: stateEncryptionArgsWithDefaults(func(v *StateEncryption) {
+				v.Backend.IgnoreRemoteVersion = true
+			}),
+		},
+		"json": {
+			args: []string{"-json"},
+			want: stateEncryptionArgsWithDefaults(func(v *StateEncryption) {
+				v.ViewOptions.ViewType = ViewJSON
+			}),
+		},
+		"too many arguments": {
+			args:        []string{"terraform.tfstate"},
+			want:        stateEncryptionArgsWithDefaults(nil),
+			wantErrText: "Too many command line arguments",
+		},
+		"unknown flag": {
+			args:        []string{"-state=terraform.tfstate"},
+			want:        stateEncryptionArgsWithDefaults(nil),
+			wantErrText: "Failed to parse command-line flags: flag provided but not defined: -state",
+		},
+	}
+
+	cmpOpts := cmp.Options{
+		cmpopts.IgnoreUnexported(Vars{}, ViewOptions{}),
+		cmpopts.IgnoreFields(ViewOptions{}, "JSONInto"), // We ignore JSONInto because it contains a file which is not really diffable
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, closer, diags := ParseStateEncryption("state rotate", tc.args)
+			defer closer()
+
+			if tc.wantErrText != "" && len(diags) == 0 {
+				t.Errorf("test wanted error but got nothing")
+			} else if tc.wantErrText == "" && len(diags) > 0 {
+				t.Errorf("test didn't expect errors but got some: %s", diags.ErrWithWarnings())
+			} else if tc.wantErrText != "" && len(diags) > 0 {
+				errStr := diags.ErrWithWarnings().Error()
+				if !strings.Contains(errStr, tc.wantErrText) {
+					t.Errorf("the returned diagnostics does not contain the expected error message.\ndiags:\n%s\nwanted: %s\n", errStr, tc.wantErrText)
+				}
+			}
+			if diff := cmp.Diff(tc.want, got, cmpOpts); diff != "" {
+				t.Errorf("unexpected result\n%s", diff)
+			}
+		})
+	}
+}
+
+func stateEncryptionArgsWithDefaults(mutate func(v *StateEncryption)) *StateEncryption {
+	ret := &StateEncryption{
+		ViewOptions: ViewOptions{
+			ViewType:     ViewHuman,
+			InputEnabled: false,
+		},
+		Vars: &Vars{},
+		Backend: Backend{
+			StateLock: true,
+		},
+	}
+	if mutate != nil {
+		mutate(ret)
+	}
+	return ret
+}
@@ -6,6 +6,7 @@
 package arguments
 
 import (
+	"github.com/opentofu/opentofu/internal/command/flags"
 	"github.com/opentofu/opentofu/internal/tfdiags"
 )
 
@@ -15,6 +16,9 @@ type StateList struct {
 	StatePath string
 	// LookupId restricts output to paths with a resource having the specified ID.
 	LookupId string
+	// Filters restricts output to resource instances whose annotations match
+	// all of the given filters.
+	Filters []AnnotationFilter
 	// InstancesRawAddr is a list of raw addresses of the resources that are requested
 	// to be listed.
 	InstancesRawAddr []string
@@ -39,6 +43,8 @@ func ParseStateList(args []string) (*StateList, func(), tfdiags.Diagnostics) {
 	cmdFlags := extendedFlagSet("state list", nil, nil, ret.Vars)
 	cmdFlags.StringVar(&ret.StatePath, "state", "", "path")
 	cmdFlags.StringVar(&ret.LookupId, "id", "", "Restrict output to paths with a resource having the specified ID.")
+	var rawFilters []string
+	cmdFlags.Var((*flags.FlagStringSlice)(&rawFilters), "filter", "Restrict output to resource instances with matching annotations.")
 	ret.ViewOptions.AddFlags(cmdFlags, false)
 
 	if err := cmdFlags.Parse(args); err != nil {
@@ -51,6 +57,14 @@ func ParseStateList(args []string) (*StateList, func(), tfdiags.Diagnostics) {
 
 	ret.InstancesRawAddr = cmdFlags.Args()
 
+	for _, raw := range rawFilters {
+		filter, filterDiags := parseAnnotationFilter(raw)
+		diags = diags.Append(filterDiags)
+		if !filterDiags.HasErrors() {
+			ret.Filters = append(ret.Filters, filter)
+		}
+	}
+
 	closer, moreDiags := ret.ViewOptions.Parse()
 	diags = diags.Append(moreDiags)
 
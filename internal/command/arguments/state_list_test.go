@@ -35,6 +35,20 @@ func TestParseStateList_basicValidation(t *testing.T) {
 				stateList.LookupId = "i-1234567890abcdef0"
 			}),
 		},
+		"annotation filters": {
+			args: []string{"-filter=owner=team-a", "-filter=ticket"},
+			want: stateListArgsWithDefaults(func(stateList *StateList) {
+				stateList.Filters = []AnnotationFilter{
+					{Key: "owner", Value: "team-a"},
+					{Key: "ticket", AnyValue: true},
+				}
+			}),
+		},
+		"invalid annotation filter": {
+			args:        []string{"-filter==team-a"},
+			want:        stateListArgsWithDefaults(nil),
+			wantErrText: "Invalid annotation filter",
+		},
 		"single instance address": {
 			args: []string{"aws_instance.example"},
 			want: stateListArgsWithDefaults(func(stateList *StateList) {
@@ -6,6 +6,9 @@
 package arguments
 
 import (
+	"fmt"
+	"strconv"
+
 	"github.com/opentofu/opentofu/internal/tfdiags"
 )
 
@@ -16,6 +19,10 @@ type StatePull struct {
 
 	// Vars are the common extended flags
 	Vars *Vars
+
+	// Serial is the serial of the previous state snapshot to pull instead
+	// of the latest one, if set.
+	Serial *uint64
 }
 
 // ParseStatePull processes CLI arguments, returning a StatePull value, a closer function, and errors.
@@ -27,7 +34,9 @@ func ParseStatePull(args []string) (*StatePull, func(), tfdiags.Diagnostics) {
 	ret := &StatePull{
 		Vars: &Vars{},
 	}
+	var serial string
 	cmdFlags := extendedFlagSet("state pull", nil, nil, ret.Vars)
+	cmdFlags.StringVar(&serial, "serial", "", "serial")
 
 	if err := cmdFlags.Parse(args); err != nil {
 		diags = diags.Append(tfdiags.Sourceless(
@@ -37,6 +46,10 @@ func ParseStatePull(args []string) (*StatePull, func(), tfdiags.Diagnostics) {
 		))
 	}
 
+	var serialDiags tfdiags.Diagnostics
+	ret.Serial, serialDiags = parseStateSerial(serial)
+	diags = diags.Append(serialDiags)
+
 	if len(cmdFlags.Args()) > 0 {
 		diags = diags.Append(tfdiags.Sourceless(
 			tfdiags.Error,
@@ -52,3 +65,22 @@ func ParseStatePull(args []string) (*StatePull, func(), tfdiags.Diagnostics) {
 
 	return ret, closer, diags
 }
+
+// parseStateSerial parses the value of a -serial option selecting a state
+// snapshot, returning nil if the option wasn't set.
+func parseStateSerial(raw string) (*uint64, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+	if raw == "" {
+		return nil, diags
+	}
+	serial, err := strconv.ParseUint(raw, 10, 64)
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid state serial",
+			fmt.Sprintf("The -serial option requires the serial number of a state snapshot, but got %q.", raw),
+		))
+		return nil, diags
+	}
+	return &serial, diags
+}
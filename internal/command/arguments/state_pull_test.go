@@ -29,6 +29,19 @@ func TestParseStatePull_basicValidation(t *testing.T) {
 			want:        statePullArgsWithDefaults(nil),
 			wantErrText: "Unexpected argument",
 		},
+		"serial": {
+			args: []string{"-serial=12"},
+			want: statePullArgsWithDefaults(func(args *StatePull) {
+				serial := uint64(12)
+				args.Serial = &serial
+			}),
+			wantErrText: "",
+		},
+		"invalid serial": {
+			args:        []string{"-serial=latest"},
+			want:        statePullArgsWithDefaults(nil),
+			wantErrText: "Invalid state serial",
+		},
 		"unknown flag": {
 			args:        []string{"-unknown"},
 			want:        statePullArgsWithDefaults(nil),
@@ -16,6 +16,9 @@ type StatePush struct {
 	StateSrc string
 	// Force will try to forcefully push the state remotely. This will happen only if the backend supports it.
 	Force bool
+	// AutoApprove skips the confirmation that a forced push asks for before overwriting a destination state
+	// that is newer than, or unrelated to, the pushed state.
+	AutoApprove bool
 	// ViewOptions specifies which view options to use
 	ViewOptions ViewOptions
 
@@ -37,6 +40,7 @@ func ParseStatePush(args []string) (*StatePush, func(), tfdiags.Diagnostics) {
 	ret.Backend.AddIgnoreRemoteVersionFlag(cmdFlags)
 	ret.Backend.AddStateFlags(cmdFlags)
 	cmdFlags.BoolVar(&ret.Force, "force", false, "")
+	cmdFlags.BoolVar(&ret.AutoApprove, "auto-approve", false, "skip interactive approval of a forced push")
 	ret.ViewOptions.AddFlags(cmdFlags, false)
 
 	if err := cmdFlags.Parse(args); err != nil {
@@ -60,6 +64,14 @@ func ParseStatePush(args []string) (*StatePush, func(), tfdiags.Diagnostics) {
 
 	closer, moreDiags := ret.ViewOptions.Parse()
 	diags = diags.Append(moreDiags)
+	// A forced push may need to ask for confirmation, which isn't possible with the `-json` flag.
+	if ret.ViewOptions.ViewType == ViewJSON && ret.Force && !ret.AutoApprove {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid usage",
+			"OpenTofu cannot ask user input when `-json` flag is used. Therefore, `-force` requires `-auto-approve` too",
+		))
+	}
 
 	return ret, closer, diags
 }
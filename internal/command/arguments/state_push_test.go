@@ -48,6 +48,32 @@ func TestParseStatePush_basicValidation(t *testing.T) {
 				v.StateSrc = "terraform.tfstate"
 			}),
 		},
+		"force with auto-approve": {
+			args: []string{"-force", "-auto-approve", "terraform.tfstate"},
+			want: statePushArgsWithDefaults(func(v *StatePush) {
+				v.Force = true
+				v.AutoApprove = true
+				v.StateSrc = "terraform.tfstate"
+			}),
+		},
+		"force with json and auto-approve": {
+			args: []string{"-force", "-auto-approve", "-json", "terraform.tfstate"},
+			want: statePushArgsWithDefaults(func(v *StatePush) {
+				v.Force = true
+				v.AutoApprove = true
+				v.ViewOptions.ViewType = ViewJSON
+				v.StateSrc = "terraform.tfstate"
+			}),
+		},
+		"force with json but no auto-approve": {
+			args: []string{"-force", "-json", "terraform.tfstate"},
+			want: statePushArgsWithDefaults(func(v *StatePush) {
+				v.Force = true
+				v.ViewOptions.ViewType = ViewJSON
+				v.StateSrc = "terraform.tfstate"
+			}),
+			wantErrText: "`-force` requires `-auto-approve` too",
+		},
 		"lock flag": {
 			args: []string{"-lock=false", "terraform.tfstate"},
 			want: statePushArgsWithDefaults(func(v *StatePush) {
@@ -24,6 +24,15 @@ type StateReplaceProvider struct {
 	BackupPath string
 	// StatePath represents the path of the state to be used for the replace operation.
 	StatePath string
+	// FromAliases requests that, instead of replacing a single provider given by RawSrcAddr and RawDestAddr,
+	// every provider in the state is replaced according to a list of provider aliases.
+	FromAliases bool
+	// AliasesFile is the local path or http(s) URL of additional provider aliases to use when
+	// FromAliases is set.
+	AliasesFile string
+	// DryRun, when set, makes the command only report the replacements it would make, without
+	// changing the state.
+	DryRun bool
 
 	// ViewOptions specifies which view options to use
 	ViewOptions ViewOptions
@@ -47,6 +56,9 @@ func ParseReplaceProvider(args []string) (*StateReplaceProvider, func(), tfdiags
 	ret.Backend.AddIgnoreRemoteVersionFlag(cmdFlags)
 	ret.Backend.AddStateFlags(cmdFlags)
 	cmdFlags.BoolVar(&ret.AutoApprove, "auto-approve", false, "skip interactive approval of replacements")
+	cmdFlags.BoolVar(&ret.FromAliases, "from-aliases", false, "replace providers according to provider aliases")
+	cmdFlags.StringVar(&ret.AliasesFile, "aliases-file", "", "additional provider aliases")
+	cmdFlags.BoolVar(&ret.DryRun, "dry-run", false, "dry run")
 	// NOTE: because the `-backup` flag needs a different default value than usual,
 	// we cannot use the [State] flags extension to register and parse these.
 	// Therefore, we need to have those flags redefined here.
@@ -66,13 +78,28 @@ func ParseReplaceProvider(args []string) (*StateReplaceProvider, func(), tfdiags
 	}
 
 	args = cmdFlags.Args()
-	if len(args) != 2 {
+	switch {
+	case ret.AliasesFile != "" && !ret.FromAliases:
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid usage",
+			"The -aliases-file option can only be used together with -from-aliases",
+		))
+	case ret.FromAliases && len(args) != 0:
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid number of arguments",
+			"No arguments are expected when -from-aliases is used",
+		))
+	case ret.FromAliases:
+		// The providers to replace are decided by the aliases.
+	case len(args) != 2:
 		diags = diags.Append(tfdiags.Sourceless(
 			tfdiags.Error,
 			"Invalid number of arguments",
 			"Exactly two arguments expected",
 		))
-	} else {
+	default:
 		ret.RawSrcAddr = args[0]
 		ret.RawDestAddr = args[1]
 	}
@@ -80,7 +107,8 @@ func ParseReplaceProvider(args []string) (*StateReplaceProvider, func(), tfdiags
 	diags = diags.Append(moreDiags)
 	// In OpenTofu, there is no way to run a command with `-json` flag and allow asking for user input in the same time.
 	// Therefore, the JSON view can used only when the `-auto-approve` is provided too.
-	if ret.ViewOptions.ViewType == ViewJSON && !ret.AutoApprove {
+	// A dry run doesn't ask for approval, so it doesn't need `-auto-approve`.
+	if ret.ViewOptions.ViewType == ViewJSON && !ret.AutoApprove && !ret.DryRun {
 		diags = diags.Append(tfdiags.Sourceless(
 			tfdiags.Error,
 			"Invalid usage",
@@ -105,6 +105,37 @@ func TestParseReplaceProvider_basicValidation(t *testing.T) {
 			want:        stateReplaceProviderArgsWithDefaults(nil),
 			wantErrText: "Invalid number of arguments",
 		},
+		"from aliases": {
+			args: []string{"-from-aliases", "-aliases-file=aliases.json", "-dry-run"},
+			want: stateReplaceProviderArgsWithDefaults(func(srp *StateReplaceProvider) {
+				srp.FromAliases = true
+				srp.AliasesFile = "aliases.json"
+				srp.DryRun = true
+			}),
+		},
+		"from aliases with arguments": {
+			args: []string{"-from-aliases", "source", "dest"},
+			want: stateReplaceProviderArgsWithDefaults(func(srp *StateReplaceProvider) {
+				srp.FromAliases = true
+			}),
+			wantErrText: "No arguments are expected when -from-aliases is used",
+		},
+		"aliases file without from aliases": {
+			args: []string{"-aliases-file=aliases.json", "source", "dest"},
+			want: stateReplaceProviderArgsWithDefaults(func(srp *StateReplaceProvider) {
+				srp.AliasesFile = "aliases.json"
+			}),
+			wantErrText: "The -aliases-file option can only be used together with -from-aliases",
+		},
+		"json dry run without auto-approve": {
+			args: []string{"-json", "-dry-run", "source", "dest"},
+			want: stateReplaceProviderArgsWithDefaults(func(srp *StateReplaceProvider) {
+				srp.ViewOptions.ViewType = ViewJSON
+				srp.DryRun = true
+				srp.RawSrcAddr = "source"
+				srp.RawDestAddr = "dest"
+			}),
+		},
 		"json without auto-approve": {
 			args: []string{"-json", "source", "dest"},
 			want: stateReplaceProviderArgsWithDefaults(func(srp *StateReplaceProvider) {
@@ -0,0 +1,58 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package arguments
+
+import (
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// StateUpgradeCheck represents the command-line arguments for the 'state upgrade-check' command.
+type StateUpgradeCheck struct {
+	// StatePath represents the path of the state whose resource instances should be checked.
+	StatePath string
+
+	// ViewOptions specifies which view options to use
+	ViewOptions ViewOptions
+
+	// Vars are the common extended flags
+	Vars *Vars
+}
+
+// ParseStateUpgradeCheck processes CLI arguments, returning a StateUpgradeCheck value, a closer function, and errors.
+// If errors are encountered, a StateUpgradeCheck value is still returned representing
+// the best effort interpretation of the arguments.
+func ParseStateUpgradeCheck(args []string) (*StateUpgradeCheck, func(), tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	ret := &StateUpgradeCheck{
+		Vars: &Vars{},
+	}
+	cmdFlags := extendedFlagSet("state upgrade-check", nil, nil, ret.Vars)
+	cmdFlags.StringVar(&ret.StatePath, "state", "", "state-path")
+
+	ret.ViewOptions.AddFlags(cmdFlags, false)
+
+	if err := cmdFlags.Parse(args); err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to parse command-line flags",
+			err.Error(),
+		))
+	}
+
+	if args := cmdFlags.Args(); len(args) > 0 {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Too many command line arguments",
+			"Expected no positional arguments.",
+		))
+	}
+
+	closer, moreDiags := ret.ViewOptions.Parse()
+	diags = diags.Append(moreDiags)
+
+	return ret, closer, diags
+}
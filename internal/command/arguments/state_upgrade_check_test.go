@@ -0,0 +1,89 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package arguments
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestParseStateUpgradeCheck_basicValidation(t *testing.T) {
+	testCases := map[string]struct {
+		args        []string
+		want        *StateUpgradeCheck
+		wantErrText string
+	}{
+		"defaults": {
+			args: nil,
+			want: stateUpgradeCheckArgsWithDefaults(nil),
+		},
+		"custom state path": {
+			args: []string{"-state=/path/to/state.tfstate"},
+			want: stateUpgradeCheckArgsWithDefaults(func(v *StateUpgradeCheck) {
+				v.StatePath = "/path/to/state.tfstate"
+			}),
+		},
+		"json": {
+			args: []string{"-json"},
+			want: stateUpgradeCheckArgsWithDefaults(func(v *StateUpgradeCheck) {
+				v.ViewOptions.ViewType = ViewJSON
+			}),
+		},
+		"too many arguments": {
+			args:        []string{"resource_address"},
+			want:        stateUpgradeCheckArgsWithDefaults(nil),
+			wantErrText: "Too many command line arguments",
+		},
+		"unknown flag": {
+			args:        []string{"-unknown-flag"},
+			want:        stateUpgradeCheckArgsWithDefaults(nil),
+			wantErrText: "Failed to parse command-line flags: flag provided but not defined: -unknown-flag",
+		},
+	}
+
+	cmpOpts := cmp.Options{
+		cmpopts.IgnoreUnexported(Vars{}, ViewOptions{}),
+		cmpopts.IgnoreFields(ViewOptions{}, "JSONInto"), // We ignore JSONInto because it contains a file which is not really diffable
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, closer, diags := ParseStateUpgradeCheck(tc.args)
+			defer closer()
+
+			if tc.wantErrText != "" && len(diags) == 0 {
+				t.Errorf("test wanted error but got nothing")
+			} else if tc.wantErrText == "" && len(diags) > 0 {
+				t.Errorf("test didn't expect errors but got some: %s", diags.ErrWithWarnings())
+			} else if tc.wantErrText != "" && len(diags) > 0 {
+				errStr := diags.ErrWithWarnings().Error()
+				if !strings.Contains(errStr, tc.wantErrText) {
+					t.Errorf("the returned diagnostics does not contain the expected error message.\ndiags:\n%s\nwanted: %s\n", errStr, tc.wantErrText)
+				}
+			}
+			if diff := cmp.Diff(tc.want, got, cmpOpts); diff != "" {
+				t.Errorf("unexpected result\n%s", diff)
+			}
+		})
+	}
+}
+
+func stateUpgradeCheckArgsWithDefaults(mutate func(v *StateUpgradeCheck)) *StateUpgradeCheck {
+	ret := &StateUpgradeCheck{
+		ViewOptions: ViewOptions{
+			ViewType:     ViewHuman,
+			InputEnabled: false,
+		},
+		Vars: &Vars{},
+	}
+	if mutate != nil {
+		mutate(ret)
+	}
+	return ret
+}
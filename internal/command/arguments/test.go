@@ -33,6 +33,10 @@ type Test struct {
 	// human-readable format or JSON for each run step depending on the
 	// ViewType.
 	Verbose bool
+
+	// ArtifactsDir, if set, is a directory where the test command writes the
+	// plan and state for each run block as JSON files.
+	ArtifactsDir string
 }
 
 func ParseTest(args []string) (*Test, func(), tfdiags.Diagnostics) {
@@ -46,6 +50,7 @@ func ParseTest(args []string) (*Test, func(), tfdiags.Diagnostics) {
 	cmdFlags.Var((*flags.FlagStringSlice)(&test.Filter), "filter", "filter")
 	cmdFlags.StringVar(&test.TestDirectory, "test-directory", configs.DefaultTestDirectory, "test-directory")
 	cmdFlags.BoolVar(&test.Verbose, "verbose", false, "verbose")
+	cmdFlags.StringVar(&test.ArtifactsDir, "artifacts-dir", "", "artifacts-dir")
 
 	test.ViewOptions.AddFlags(cmdFlags, false)
 
@@ -124,6 +124,16 @@ func TestParseTest(t *testing.T) {
 				Vars:          &Vars{},
 			},
 		},
+		"artifacts-dir": {
+			args: []string{"-artifacts-dir=out"},
+			want: &Test{
+				Filter:        nil,
+				TestDirectory: "tests",
+				ViewOptions:   ViewOptions{ViewType: ViewHuman},
+				ArtifactsDir:  "out",
+				Vars:          &Vars{},
+			},
+		},
 		"unknown flag": {
 			args: []string{"-boop"},
 			want: &Test{
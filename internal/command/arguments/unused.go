@@ -0,0 +1,67 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package arguments
+
+import (
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// Unused represents the command-line arguments for the unused command.
+type Unused struct {
+	// Path is the directory containing the root module of the configuration
+	// to check. If unspecified, unused will use the current directory.
+	Path string
+
+	// Check indicates that the command should exit with status 2 if it finds
+	// any unused declarations, for use as a gate in automation.
+	Check bool
+
+	// ViewOptions specifies which view options to use
+	ViewOptions ViewOptions
+
+	Vars *Vars
+}
+
+// ParseUnused processes CLI arguments, returning an Unused value, a closer
+// function, and errors. If errors are encountered, an Unused value is still
+// returned representing the best effort interpretation of the arguments.
+func ParseUnused(args []string) (*Unused, func(), tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+	unused := &Unused{
+		Path: ".",
+		Vars: &Vars{},
+	}
+
+	cmdFlags := extendedFlagSet("unused", nil, nil, unused.Vars)
+	cmdFlags.BoolVar(&unused.Check, "check", false, "check")
+	unused.ViewOptions.AddFlags(cmdFlags, false)
+
+	if err := cmdFlags.Parse(args); err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to parse command-line flags",
+			err.Error(),
+		))
+	}
+
+	args = cmdFlags.Args()
+	if len(args) > 1 {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Too many command line arguments",
+			"Expected at most one positional argument.",
+		))
+	}
+
+	if len(args) > 0 {
+		unused.Path = args[0]
+	}
+
+	closer, moreDiags := unused.ViewOptions.Parse()
+	diags = diags.Append(moreDiags)
+
+	return unused, closer, diags
+}
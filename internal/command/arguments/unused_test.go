@@ -0,0 +1,75 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package arguments
+
+import (
+	"testing"
+)
+
+func TestParseUnused_valid(t *testing.T) {
+	testCases := map[string]struct {
+		args []string
+		want *Unused
+	}{
+		"defaults": {
+			nil,
+			&Unused{
+				Path:        ".",
+				ViewOptions: ViewOptions{ViewType: ViewHuman},
+			},
+		},
+		"check with JSON": {
+			[]string{"-check", "-json", "modules/network"},
+			&Unused{
+				Path:        "modules/network",
+				Check:       true,
+				ViewOptions: ViewOptions{ViewType: ViewJSON},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, _, diags := ParseUnused(tc.args)
+			if len(diags) > 0 {
+				t.Fatalf("unexpected diags: %v", diags.Err())
+			}
+			got.Vars = nil
+			got.ViewOptions.jsonFlag = tc.want.ViewOptions.jsonFlag
+			if *got != *tc.want {
+				t.Fatalf("unexpected result\n got: %#v\nwant: %#v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestParseUnused_invalid(t *testing.T) {
+	testCases := map[string]struct {
+		args        []string
+		wantSummary string
+	}{
+		"unknown flag": {
+			[]string{"-boop"},
+			"Failed to parse command-line flags",
+		},
+		"too many arguments": {
+			[]string{"foo", "bar"},
+			"Too many command line arguments",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, _, diags := ParseUnused(tc.args)
+			if !diags.HasErrors() {
+				t.Fatal("expected errors")
+			}
+			if got := diags[0].Description().Summary; got != tc.wantSummary {
+				t.Errorf("wrong summary %q; want %q", got, tc.wantSummary)
+			}
+		})
+	}
+}
@@ -6,6 +6,8 @@
 package arguments
 
 import (
+	"fmt"
+
 	"github.com/opentofu/opentofu/internal/tfdiags"
 )
 
@@ -24,12 +26,52 @@ type Validate struct {
 	// included with the module.
 	NoTests bool
 
+	// FixSuggestions indicates that the JSON output should include
+	// machine-applicable fix suggestions for diagnostics that have an
+	// unambiguous fix.
+	FixSuggestions bool
+
+	// Strict indicates that OpenTofu should also validate the provider
+	// configuration blocks that nothing in the configuration uses.
+	Strict bool
+
+	// Contract is the path to a module contract file that the module must
+	// implement, if any.
+	Contract string
+
+	// CheckUnused indicates that OpenTofu should also report the input
+	// variables, local values, output values, data sources and provider
+	// configurations that nothing in the configuration refers to.
+	CheckUnused bool
+
+	// Recursive indicates that OpenTofu should also validate the modules in
+	// each subdirectory of Path.
+	Recursive bool
+
+	// WarningsAsErrors indicates that OpenTofu should report any warnings as
+	// errors, so that validation fails if there are any warnings.
+	WarningsAsErrors bool
+
+	// Format selects the format of the machine-readable output: either
+	// ValidateFormatJSON, the default, or ValidateFormatSARIF.
+	Format string
+
 	// ViewOptions specifies which view options to use
 	ViewOptions ViewOptions
 
 	Vars *Vars
 }
 
+const (
+	// ValidateFormatJSON is the format of the machine-readable output of
+	// the validate command that is specific to OpenTofu.
+	ValidateFormatJSON = "json"
+
+	// ValidateFormatSARIF is the Static Analysis Results Interchange Format,
+	// which code scanning tools such as GitHub code scanning can ingest.
+	ValidateFormatSARIF = "sarif"
+)
+
 // ParseValidate processes CLI arguments, returning a Validate value, a closer function, and errors.
 // If errors are encountered, a Validate value is still returned representing
 // the best effort interpretation of the arguments.
@@ -44,6 +86,13 @@ func ParseValidate(args []string) (*Validate, func(), tfdiags.Diagnostics) {
 	cmdFlags := extendedFlagSet("validate", nil, nil, validate.Vars)
 	cmdFlags.StringVar(&validate.TestDirectory, "test-directory", "tests", "test-directory")
 	cmdFlags.BoolVar(&validate.NoTests, "no-tests", false, "no-tests")
+	cmdFlags.BoolVar(&validate.FixSuggestions, "fix-suggestions", false, "fix-suggestions")
+	cmdFlags.BoolVar(&validate.Strict, "strict", false, "strict")
+	cmdFlags.StringVar(&validate.Contract, "contract", "", "contract")
+	cmdFlags.StringVar(&validate.Format, "format", ValidateFormatJSON, "format")
+	cmdFlags.BoolVar(&validate.Recursive, "recursive", false, "recursive")
+	cmdFlags.BoolVar(&validate.CheckUnused, "check-unused", false, "check-unused")
+	cmdFlags.BoolVar(&validate.WarningsAsErrors, "warnings-as-errors", false, "warnings-as-errors")
 
 	validate.ViewOptions.AddFlags(cmdFlags, false)
 
@@ -71,5 +120,39 @@ func ParseValidate(args []string) (*Validate, func(), tfdiags.Diagnostics) {
 	closer, moreDiags := validate.ViewOptions.Parse()
 	diags = diags.Append(moreDiags)
 
+	if validate.FixSuggestions && validate.ViewOptions.ViewType != ViewJSON && validate.ViewOptions.JSONInto == nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid option combination",
+			"The -fix-suggestions option requires either -json or -json-into, because fix suggestions are only included in machine-readable output.",
+		))
+	}
+
+	if validate.Recursive && validate.Contract != "" {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid option combination",
+			"The -contract option can't be used with -recursive, because a module contract describes the input variables and output values of a single module.",
+		))
+	}
+
+	switch validate.Format {
+	case ValidateFormatJSON:
+	case ValidateFormatSARIF:
+		if validate.ViewOptions.ViewType != ViewJSON && validate.ViewOptions.JSONInto == nil {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Invalid option combination",
+				"The -format option requires either -json or -json-into, because it selects the format of the machine-readable output.",
+			))
+		}
+	default:
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid output format",
+			fmt.Sprintf("The -format option must be either %q or %q.", ValidateFormatJSON, ValidateFormatSARIF),
+		))
+	}
+
 	return validate, closer, diags
 }
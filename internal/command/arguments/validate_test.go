@@ -24,6 +24,7 @@ func TestParseValidate_valid(t *testing.T) {
 			&Validate{
 				Path:          ".",
 				TestDirectory: "tests",
+				Format:        ValidateFormatJSON,
 				ViewOptions:   ViewOptions{ViewType: ViewHuman},
 			},
 		},
@@ -32,6 +33,7 @@ func TestParseValidate_valid(t *testing.T) {
 			&Validate{
 				Path:          ".",
 				TestDirectory: "tests",
+				Format:        ValidateFormatJSON,
 				ViewOptions:   ViewOptions{ViewType: ViewJSON},
 			},
 		},
@@ -40,6 +42,7 @@ func TestParseValidate_valid(t *testing.T) {
 			&Validate{
 				Path:          "foo",
 				TestDirectory: "tests",
+				Format:        ValidateFormatJSON,
 				ViewOptions:   ViewOptions{ViewType: ViewJSON},
 			},
 		},
@@ -48,6 +51,7 @@ func TestParseValidate_valid(t *testing.T) {
 			&Validate{
 				Path:          ".",
 				TestDirectory: "other",
+				Format:        ValidateFormatJSON,
 				ViewOptions:   ViewOptions{ViewType: ViewHuman},
 			},
 		},
@@ -56,10 +60,80 @@ func TestParseValidate_valid(t *testing.T) {
 			&Validate{
 				Path:          ".",
 				TestDirectory: "tests",
+				Format:        ValidateFormatJSON,
 				ViewOptions:   ViewOptions{ViewType: ViewHuman},
 				NoTests:       true,
 			},
 		},
+		"fix-suggestions": {
+			[]string{"-json", "-fix-suggestions"},
+			&Validate{
+				Path:           ".",
+				TestDirectory:  "tests",
+				Format:         ValidateFormatJSON,
+				ViewOptions:    ViewOptions{ViewType: ViewJSON},
+				FixSuggestions: true,
+			},
+		},
+		"strict": {
+			[]string{"-strict"},
+			&Validate{
+				Path:          ".",
+				TestDirectory: "tests",
+				Format:        ValidateFormatJSON,
+				ViewOptions:   ViewOptions{ViewType: ViewHuman},
+				Strict:        true,
+			},
+		},
+		"contract": {
+			[]string{"-contract=contract.hcl"},
+			&Validate{
+				Path:          ".",
+				TestDirectory: "tests",
+				Format:        ValidateFormatJSON,
+				ViewOptions:   ViewOptions{ViewType: ViewHuman},
+				Contract:      "contract.hcl",
+			},
+		},
+		"sarif": {
+			[]string{"-json", "-format=sarif"},
+			&Validate{
+				Path:          ".",
+				TestDirectory: "tests",
+				Format:        ValidateFormatSARIF,
+				ViewOptions:   ViewOptions{ViewType: ViewJSON},
+			},
+		},
+		"recursive": {
+			[]string{"-recursive", "modules"},
+			&Validate{
+				Path:          "modules",
+				TestDirectory: "tests",
+				Format:        ValidateFormatJSON,
+				ViewOptions:   ViewOptions{ViewType: ViewHuman},
+				Recursive:     true,
+			},
+		},
+		"check-unused": {
+			[]string{"-check-unused"},
+			&Validate{
+				Path:          ".",
+				TestDirectory: "tests",
+				Format:        ValidateFormatJSON,
+				ViewOptions:   ViewOptions{ViewType: ViewHuman},
+				CheckUnused:   true,
+			},
+		},
+		"warnings-as-errors": {
+			[]string{"-warnings-as-errors"},
+			&Validate{
+				Path:             ".",
+				TestDirectory:    "tests",
+				Format:           ValidateFormatJSON,
+				ViewOptions:      ViewOptions{ViewType: ViewHuman},
+				WarningsAsErrors: true,
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -88,6 +162,7 @@ func TestParseValidate_invalid(t *testing.T) {
 			&Validate{
 				Path:          ".",
 				TestDirectory: "tests",
+				Format:        ValidateFormatJSON,
 				ViewOptions:   ViewOptions{ViewType: ViewHuman},
 			},
 			tfdiags.Diagnostics{
@@ -103,6 +178,7 @@ func TestParseValidate_invalid(t *testing.T) {
 			&Validate{
 				Path:          "bar",
 				TestDirectory: "tests",
+				Format:        ValidateFormatJSON,
 				ViewOptions:   ViewOptions{ViewType: ViewJSON},
 			},
 			tfdiags.Diagnostics{
@@ -113,6 +189,73 @@ func TestParseValidate_invalid(t *testing.T) {
 				),
 			},
 		},
+		"fix-suggestions without json": {
+			[]string{"-fix-suggestions"},
+			&Validate{
+				Path:           ".",
+				TestDirectory:  "tests",
+				Format:         ValidateFormatJSON,
+				ViewOptions:    ViewOptions{ViewType: ViewHuman},
+				FixSuggestions: true,
+			},
+			tfdiags.Diagnostics{
+				tfdiags.Sourceless(
+					tfdiags.Error,
+					"Invalid option combination",
+					"The -fix-suggestions option requires either -json or -json-into, because fix suggestions are only included in machine-readable output.",
+				),
+			},
+		},
+		"sarif without json": {
+			[]string{"-format=sarif"},
+			&Validate{
+				Path:          ".",
+				TestDirectory: "tests",
+				Format:        ValidateFormatSARIF,
+				ViewOptions:   ViewOptions{ViewType: ViewHuman},
+			},
+			tfdiags.Diagnostics{
+				tfdiags.Sourceless(
+					tfdiags.Error,
+					"Invalid option combination",
+					"The -format option requires either -json or -json-into, because it selects the format of the machine-readable output.",
+				),
+			},
+		},
+		"recursive with contract": {
+			[]string{"-recursive", "-contract=contract.hcl"},
+			&Validate{
+				Path:          ".",
+				TestDirectory: "tests",
+				Format:        ValidateFormatJSON,
+				ViewOptions:   ViewOptions{ViewType: ViewHuman},
+				Contract:      "contract.hcl",
+				Recursive:     true,
+			},
+			tfdiags.Diagnostics{
+				tfdiags.Sourceless(
+					tfdiags.Error,
+					"Invalid option combination",
+					"The -contract option can't be used with -recursive, because a module contract describes the input variables and output values of a single module.",
+				),
+			},
+		},
+		"unknown format": {
+			[]string{"-json", "-format=xml"},
+			&Validate{
+				Path:          ".",
+				TestDirectory: "tests",
+				Format:        "xml",
+				ViewOptions:   ViewOptions{ViewType: ViewJSON},
+			},
+			tfdiags.Diagnostics{
+				tfdiags.Sourceless(
+					tfdiags.Error,
+					"Invalid output format",
+					`The -format option must be either "json" or "sarif".`,
+				),
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -0,0 +1,78 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package arguments
+
+import (
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// Why represents the command-line arguments for the why command.
+type Why struct {
+	// PlanPath is the saved plan file to explain a change from.
+	PlanPath string
+
+	// TargetAddress is the resource instance whose planned change should
+	// be explained.
+	TargetAddress addrs.AbsResourceInstance
+
+	// ViewOptions specifies which view options to use
+	ViewOptions ViewOptions
+
+	Vars *Vars
+}
+
+// ParseWhy processes CLI arguments, returning a Why value, a closer function, and errors.
+// If errors are encountered, a Why value is still returned representing
+// the best effort interpretation of the arguments.
+func ParseWhy(args []string) (*Why, func(), tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+	why := &Why{
+		Vars: &Vars{},
+	}
+
+	cmdFlags := extendedFlagSet("why", nil, nil, why.Vars)
+	cmdFlags.StringVar(&why.PlanPath, "plan", "", "plan")
+	why.ViewOptions.AddFlags(cmdFlags, false)
+
+	if err := cmdFlags.Parse(args); err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to parse command-line options",
+			err.Error(),
+		))
+	}
+
+	closer, moreDiags := why.ViewOptions.Parse()
+	diags = diags.Append(moreDiags)
+	if diags.HasErrors() {
+		return why, closer, diags
+	}
+
+	if why.PlanPath == "" {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Plan file required",
+			"The -plan=FILENAME option is required, to specify the saved plan file containing the change to explain.",
+		))
+	}
+
+	args = cmdFlags.Args()
+	if len(args) != 1 {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid arguments",
+			"The why command expects exactly one argument: the address of a resource instance.",
+		))
+		return why, closer, diags
+	}
+
+	addr, addrDiags := addrs.ParseAbsResourceInstanceStr(args[0])
+	diags = diags.Append(addrDiags)
+	why.TargetAddress = addr
+
+	return why, closer, diags
+}
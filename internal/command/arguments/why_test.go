@@ -0,0 +1,95 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package arguments
+
+import (
+	"testing"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+)
+
+func TestParseWhy_valid(t *testing.T) {
+	foo := addrs.Resource{
+		Mode: addrs.ManagedResourceMode,
+		Type: "test_instance",
+		Name: "foo",
+	}
+
+	testCases := map[string]struct {
+		args     []string
+		wantAddr addrs.AbsResourceInstance
+		wantView ViewType
+	}{
+		"resource instance": {
+			[]string{"-plan=tfplan", "test_instance.foo"},
+			foo.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance),
+			ViewHuman,
+		},
+		"resource instance with key, JSON": {
+			[]string{"-plan=tfplan", "-json", `module.child.test_instance.foo["a"]`},
+			foo.Instance(addrs.StringKey("a")).Absolute(addrs.RootModuleInstance.Child("child", addrs.NoKey)),
+			ViewJSON,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, _, diags := ParseWhy(tc.args)
+			if len(diags) > 0 {
+				t.Fatalf("unexpected diags: %v", diags.Err())
+			}
+			if got.PlanPath != "tfplan" {
+				t.Errorf("wrong plan path %q", got.PlanPath)
+			}
+			if !got.TargetAddress.Equal(tc.wantAddr) {
+				t.Errorf("wrong address\n got: %s\nwant: %s", got.TargetAddress, tc.wantAddr)
+			}
+			if got.ViewOptions.ViewType != tc.wantView {
+				t.Errorf("wrong view type %s; want %s", got.ViewOptions.ViewType, tc.wantView)
+			}
+		})
+	}
+}
+
+func TestParseWhy_invalid(t *testing.T) {
+	testCases := map[string]struct {
+		args        []string
+		wantSummary string
+	}{
+		"unknown option": {
+			[]string{"-boop", "test_instance.foo"},
+			"Failed to parse command-line options",
+		},
+		"no plan file": {
+			[]string{"test_instance.foo"},
+			"Plan file required",
+		},
+		"no address": {
+			[]string{"-plan=tfplan"},
+			"Invalid arguments",
+		},
+		"too many addresses": {
+			[]string{"-plan=tfplan", "test_instance.foo", "test_instance.bar"},
+			"Invalid arguments",
+		},
+		"not a resource instance": {
+			[]string{"-plan=tfplan", "module.child"},
+			"Invalid address",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, _, diags := ParseWhy(tc.args)
+			if !diags.HasErrors() {
+				t.Fatal("expected errors, got none")
+			}
+			if got := diags[0].Description().Summary; got != tc.wantSummary {
+				t.Fatalf("wrong error summary %q; want %q", got, tc.wantSummary)
+			}
+		})
+	}
+}
@@ -18,6 +18,12 @@ type WorkspaceDelete struct {
 	// Force allows the user to forcefully delete a workspace removing the still existing resources
 	// from the OpenTofu's management.
 	Force bool
+	// Destroy makes the command destroy the objects tracked by the workspace's
+	// state before deleting the workspace.
+	Destroy bool
+	// AutoApprove skips the interactive approval of the destroy plan when
+	// Destroy is set.
+	AutoApprove bool
 	// StateLock allows the user to disable, the default enabled, state locking.
 	StateLock bool
 	// StateLockTimeout allows the user to configure the timeout for the locking of the state.
@@ -40,9 +46,11 @@ func ParseWorkspaceDelete(args []string) (*WorkspaceDelete, func(), tfdiags.Diag
 
 	cmdFlags := extendedFlagSet("workspace delete", nil, nil, ret.Vars)
 	cmdFlags.BoolVar(&ret.Force, "force", false, "force removal of a non-empty workspace")
+	cmdFlags.BoolVar(&ret.Destroy, "destroy", false, "destroy the workspace's objects before removing it")
+	cmdFlags.BoolVar(&ret.AutoApprove, "auto-approve", false, "skip interactive approval of the destroy plan")
 	cmdFlags.BoolVar(&ret.StateLock, "lock", true, "lock state")
 	cmdFlags.DurationVar(&ret.StateLockTimeout, "lock-timeout", 0, "lock timeout")
-	ret.ViewOptions.AddFlags(cmdFlags, false)
+	ret.ViewOptions.AddFlags(cmdFlags, true)
 
 	if err := cmdFlags.Parse(args); err != nil {
 		diags = diags.Append(tfdiags.Sourceless(
@@ -63,7 +71,33 @@ func ParseWorkspaceDelete(args []string) (*WorkspaceDelete, func(), tfdiags.Diag
 		ret.WorkspaceName = args[0]
 	}
 
+	if ret.Destroy && ret.Force {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Incompatible command-line options",
+			"The -destroy option destroys the objects tracked by the workspace before deleting it, while the -force option deletes the workspace while it still tracks objects. Use only one of these options.",
+		))
+	}
+	if ret.AutoApprove && !ret.Destroy {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid -auto-approve option",
+			"The -auto-approve option only applies to the destroy plan created by the -destroy option.",
+		))
+	}
+
 	closer, moreDiags := ret.ViewOptions.Parse()
 	diags = diags.Append(moreDiags)
+
+	// The JSON view cannot ask for approval of the destroy plan, so we
+	// require -auto-approve rather than overriding it, for the same reason
+	// as in "tofu apply".
+	if ret.Destroy && !ret.AutoApprove && ret.ViewOptions.ViewType == ViewJSON {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Auto-approve required",
+			"OpenTofu cannot ask for interactive approval of the destroy plan when -json is set. To destroy the workspace's objects and delete it, enable the -auto-approve option.",
+		))
+	}
 	return ret, closer, diags
 }
@@ -69,6 +69,22 @@ func TestParseWorkspaceDelete_basicValidation(t *testing.T) {
 				in.Force = true
 			}),
 		},
+		"destroy flag": {
+			[]string{"-destroy", "target-ws"},
+			workspaceDeleteArgsWithDefaults(func(in *WorkspaceDelete) {
+				in.WorkspaceName = "target-ws"
+				in.Destroy = true
+			}),
+		},
+		"destroy with auto-approve": {
+			[]string{"-destroy", "-auto-approve", "-input=false", "target-ws"},
+			workspaceDeleteArgsWithDefaults(func(in *WorkspaceDelete) {
+				in.WorkspaceName = "target-ws"
+				in.Destroy = true
+				in.AutoApprove = true
+				in.ViewOptions.InputEnabled = false
+			}),
+		},
 		"lock flag": {
 			[]string{"-lock=false", "target-ws"},
 			workspaceDeleteArgsWithDefaults(func(in *WorkspaceDelete) {
@@ -102,6 +118,40 @@ func TestParseWorkspaceDelete_basicValidation(t *testing.T) {
 	}
 }
 
+func TestParseWorkspaceDelete_invalid(t *testing.T) {
+	testCases := map[string]struct {
+		args        []string
+		wantSummary string
+	}{
+		"destroy and force": {
+			[]string{"-destroy", "-force", "target-ws"},
+			"Incompatible command-line options",
+		},
+		"auto-approve without destroy": {
+			[]string{"-auto-approve", "target-ws"},
+			"Invalid -auto-approve option",
+		},
+		"destroy with json and without auto-approve": {
+			[]string{"-destroy", "-json", "target-ws"},
+			"Auto-approve required",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, closer, diags := ParseWorkspaceDelete(tc.args)
+			defer closer()
+
+			if len(diags) != 1 {
+				t.Fatalf("expected one diagnostic, got %d: %v", len(diags), diags)
+			}
+			if got := diags[0].Description().Summary; got != tc.wantSummary {
+				t.Errorf("wrong summary %q; want %q", got, tc.wantSummary)
+			}
+		})
+	}
+}
+
 func TestParseWorkspaceDelete_vars(t *testing.T) {
 	testCases := map[string]struct {
 		args              []string
@@ -168,7 +218,8 @@ func workspaceDeleteArgsWithDefaults(mutate func(in *WorkspaceDelete)) *Workspac
 		StateLockTimeout: 0,
 		Vars:             &Vars{},
 		ViewOptions: ViewOptions{
-			ViewType: ViewHuman,
+			ViewType:     ViewHuman,
+			InputEnabled: true,
 		},
 	}
 	if mutate != nil {
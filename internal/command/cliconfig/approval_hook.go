@@ -0,0 +1,114 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cliconfig
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/hcl"
+	hclast "github.com/hashicorp/hcl/hcl/ast"
+
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// DefaultApprovalHookTimeout is the maximum time to wait for an approval
+// hook to exit if its configuration doesn't specify a timeout.
+const DefaultApprovalHookTimeout = 1 * time.Hour
+
+// ConfigApprovalHook is the structure of the "approval_hook" nested block
+// within the CLI configuration, which names an external command that must
+// approve the changes in a plan before "tofu apply" makes them.
+type ConfigApprovalHook struct {
+	Command string   `hcl:"command"`
+	Args    []string `hcl:"args"`
+
+	// Timeout is the maximum time to wait for the command to exit, written
+	// as a duration string such as "30m". If not set, the timeout is
+	// DefaultApprovalHookTimeout.
+	Timeout string `hcl:"timeout"`
+}
+
+// TimeoutDuration returns the maximum time to wait for the approval hook
+// command to exit.
+//
+// This must be called only on a configuration that has passed validation,
+// because it assumes that the timeout is valid.
+func (h *ConfigApprovalHook) TimeoutDuration() time.Duration {
+	if h.Timeout == "" {
+		return DefaultApprovalHookTimeout
+	}
+	d, err := time.ParseDuration(h.Timeout)
+	if err != nil {
+		// Should not get here for a validated configuration.
+		return DefaultApprovalHookTimeout
+	}
+	return d
+}
+
+// decodeApprovalHookFromConfig uses the HCL AST API directly to decode
+// "approval_hook" blocks from the given file.
+//
+// HCL 1's DecodeObject can't decode a block containing a list argument into
+// a slice of structs, so we decode each block individually instead.
+func decodeApprovalHookFromConfig(hclFile *hclast.File) ([]*ConfigApprovalHook, tfdiags.Diagnostics) {
+	var ret []*ConfigApprovalHook
+	var diags tfdiags.Diagnostics
+
+	root, ok := hclFile.Node.(*hclast.ObjectList)
+	if !ok {
+		// A HCL file that doesn't have an object list at its root is weird, but
+		// dealing with that is outside the scope of this function.
+		return ret, diags
+	}
+	for _, block := range root.Items {
+		if block.Keys[0].Token.Value() != "approval_hook" {
+			continue
+		}
+
+		const errInvalidSummary = "Invalid approval_hook block"
+		isJSON := block.Keys[0].Token.JSON
+		if block.Assign.Line != 0 && !isJSON {
+			// Seems to be an attribute rather than a block
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				errInvalidSummary,
+				fmt.Sprintf("The approval_hook block at %s must not be introduced with an equals sign.", block.Pos()),
+			))
+			continue
+		}
+		if len(block.Keys) > 1 && !isJSON {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				errInvalidSummary,
+				fmt.Sprintf("The approval_hook block at %s must not have any labels.", block.Pos()),
+			))
+			continue
+		}
+		body, ok := block.Val.(*hclast.ObjectType)
+		if !ok {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				errInvalidSummary,
+				fmt.Sprintf("The approval_hook block at %s must be represented by a JSON object.", block.Pos()),
+			))
+			continue
+		}
+
+		hook := &ConfigApprovalHook{}
+		if err := hcl.DecodeObject(hook, body); err != nil {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				errInvalidSummary,
+				fmt.Sprintf("Invalid approval_hook block at %s: %s.", body.Pos(), err),
+			))
+			continue
+		}
+		ret = append(ret, hook)
+	}
+
+	return ret, diags
+}
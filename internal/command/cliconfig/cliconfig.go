@@ -24,6 +24,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/hcl"
 	"github.com/opentofu/svchost"
@@ -54,6 +55,41 @@ type Config struct {
 	// over the requirements of the dependency lock file.
 	PluginCacheMayBreakDependencyLockFile bool `hcl:"plugin_cache_may_break_dependency_lock_file"`
 
+	// DisabledFunctions is a list of function name patterns for functions
+	// that configurations are not permitted to call, such as "file" or
+	// "provider::*". This is intended for shared execution environments
+	// which need to restrict what a configuration can access.
+	DisabledFunctions []string `hcl:"disabled_functions"`
+
+	// ProviderReadRetryCount is the number of times to retry a request to
+	// read a resource or data source when the provider reports that it
+	// failed with a retryable error. If unset, ProviderReadRetries returns
+	// a default.
+	ProviderReadRetryCount *int `hcl:"provider_read_retry_count"`
+
+	// ApprovalHook represents any approval_hook blocks in the
+	// configuration. Only one of these is allowed across the whole
+	// configuration, but we decode into a slice here so that we can handle
+	// that validation at validation time rather than initial decode time.
+	ApprovalHook []*ConfigApprovalHook
+
+	// LintRules represents any lint_rule blocks in the configuration, which
+	// "tofu validate" runs in the order they appear. Each rule must have a
+	// unique name across the whole configuration.
+	LintRules []*ConfigLintRule
+
+	// Proxy represents any proxy blocks in the configuration. Only one of
+	// these is allowed across the whole configuration, but we decode into a
+	// slice here so that we can handle that validation at validation time
+	// rather than initial decode time.
+	Proxy []*ConfigProxy
+
+	// UsageMetrics represents any usage_metrics blocks in the configuration.
+	// Only one of these is allowed across the whole configuration, but we
+	// decode into a slice here so that we can handle that validation at
+	// validation time rather than initial decode time.
+	UsageMetrics []*ConfigUsageMetrics
+
 	Hosts map[string]*ConfigHost `hcl:"host"`
 
 	Credentials        map[string]map[string]any           `hcl:"credentials"`
@@ -216,6 +252,12 @@ func (cl *ConfigLoader) loadConfigFile(path string) (*Config, tfdiags.Diagnostic
 		return result, diags
 	}
 
+	// The proxy blocks must be decoded before everything else, because
+	// HCL 1's DecodeObject modifies the nested "host" blocks inside them
+	// while decoding the top-level "host" blocks.
+	proxyBlocks, proxyDiags := decodeProxyFromConfig(obj)
+	diags = diags.Append(proxyDiags)
+
 	// Build up the result
 	if err := hcl.DecodeObject(&result, obj); err != nil {
 		diags = diags.Append(fmt.Errorf("Error parsing %s: %w", path, err))
@@ -238,6 +280,16 @@ func (cl *ConfigLoader) loadConfigFile(path string) (*Config, tfdiags.Diagnostic
 	ociCredsBlocks, ociCredsDiags := decodeOCIRepositoryCredentialsFromConfig(obj)
 	diags = diags.Append(ociCredsDiags)
 	result.OCIRepositoryCredentials = ociCredsBlocks
+	approvalHookBlocks, approvalHookDiags := decodeApprovalHookFromConfig(obj)
+	diags = diags.Append(approvalHookDiags)
+	result.ApprovalHook = approvalHookBlocks
+	lintRuleBlocks, lintRuleDiags := decodeLintRulesFromConfig(obj)
+	diags = diags.Append(lintRuleDiags)
+	result.LintRules = lintRuleBlocks
+	usageMetricsBlocks, usageMetricsDiags := decodeUsageMetricsFromConfig(obj)
+	diags = diags.Append(usageMetricsDiags)
+	result.UsageMetrics = usageMetricsBlocks
+	result.Proxy = proxyBlocks
 
 	if result.PluginCacheDir != "" {
 		result.PluginCacheDir = os.ExpandEnv(result.PluginCacheDir)
@@ -400,6 +452,106 @@ func (c *Config) Validate() tfdiags.Diagnostics {
 		}
 	}
 
+	for _, pattern := range c.DisabledFunctions {
+		if err := validateDisabledFunctionPattern(pattern); err != nil {
+			diags = diags.Append(
+				fmt.Errorf("The disabled_functions setting has an invalid function name %q: %w", pattern, err),
+			)
+		}
+	}
+
+	if c.ProviderReadRetryCount != nil && *c.ProviderReadRetryCount < 0 {
+		diags = diags.Append(
+			fmt.Errorf("The provider_read_retry_count setting must not be negative"),
+		)
+	}
+
+	// Should have zero or one "approval_hook" blocks
+	if len(c.ApprovalHook) > 1 {
+		diags = diags.Append(
+			fmt.Errorf("No more than one approval_hook block may be specified"),
+		)
+	}
+	for _, hook := range c.ApprovalHook {
+		if hook.Command == "" {
+			diags = diags.Append(
+				fmt.Errorf("The approval_hook block must set the command argument"),
+			)
+		}
+		if hook.Timeout != "" {
+			d, err := time.ParseDuration(hook.Timeout)
+			if err == nil && d <= 0 {
+				err = errors.New("must be greater than zero")
+			}
+			if err != nil {
+				diags = diags.Append(
+					fmt.Errorf("The approval_hook block has an invalid timeout %q: %w", hook.Timeout, err),
+				)
+			}
+		}
+	}
+
+	seenLintRules := make(map[string]bool)
+	for _, rule := range c.LintRules {
+		if seenLintRules[rule.Name] {
+			diags = diags.Append(
+				fmt.Errorf("Duplicate lint_rule block for %q", rule.Name),
+			)
+		}
+		seenLintRules[rule.Name] = true
+		if rule.Command == "" {
+			diags = diags.Append(
+				fmt.Errorf("The lint_rule %q block must set the command argument", rule.Name),
+			)
+		}
+		if rule.Timeout != "" {
+			d, err := time.ParseDuration(rule.Timeout)
+			if err == nil && d <= 0 {
+				err = errors.New("must be greater than zero")
+			}
+			if err != nil {
+				diags = diags.Append(
+					fmt.Errorf("The lint_rule %q block has an invalid timeout %q: %w", rule.Name, rule.Timeout, err),
+				)
+			}
+		}
+	}
+
+	// Should have zero or one "usage_metrics" blocks
+	if len(c.UsageMetrics) > 1 {
+		diags = diags.Append(
+			fmt.Errorf("No more than one usage_metrics block may be specified"),
+		)
+	}
+
+	// Should have zero or one "proxy" blocks
+	if len(c.Proxy) > 1 {
+		diags = diags.Append(
+			fmt.Errorf("No more than one proxy block may be specified"),
+		)
+	}
+	for _, proxy := range c.Proxy {
+		if proxy.PACFile != "" {
+			if err := validatePACFile(proxy.PACFile); err != nil {
+				diags = diags.Append(
+					fmt.Errorf("The proxy block has an invalid pac_file %q: %w", proxy.PACFile, err),
+				)
+			}
+		}
+		for pattern, host := range proxy.Hosts {
+			if err := validateProxyHostPattern(pattern); err != nil {
+				diags = diags.Append(
+					fmt.Errorf("The proxy host %q block has an invalid hostname: %w", pattern, err),
+				)
+			}
+			if _, err := host.ProxyURL(); err != nil {
+				diags = diags.Append(
+					fmt.Errorf("The proxy host %q block has an invalid url %q: %w", pattern, host.URL, err),
+				)
+			}
+		}
+	}
+
 	if c.PluginCacheDir != "" {
 		_, err := os.Stat(c.PluginCacheDir)
 		if err != nil {
@@ -428,6 +580,38 @@ func (c *Config) Merge(c2 *Config) *Config {
 		result.PluginCacheMayBreakDependencyLockFile = true
 	}
 
+	result.ProviderReadRetryCount = c.ProviderReadRetryCount
+	if c2.ProviderReadRetryCount != nil {
+		result.ProviderReadRetryCount = c2.ProviderReadRetryCount
+	}
+
+	if (len(c.DisabledFunctions) + len(c2.DisabledFunctions)) > 0 {
+		// Disabled functions accumulate across all configuration files, so
+		// that no file can re-enable a function that another disabled.
+		result.DisabledFunctions = append(result.DisabledFunctions, c.DisabledFunctions...)
+		result.DisabledFunctions = append(result.DisabledFunctions, c2.DisabledFunctions...)
+	}
+
+	if (len(c.ApprovalHook) + len(c2.ApprovalHook)) > 0 {
+		result.ApprovalHook = append(result.ApprovalHook, c.ApprovalHook...)
+		result.ApprovalHook = append(result.ApprovalHook, c2.ApprovalHook...)
+	}
+
+	if (len(c.LintRules) + len(c2.LintRules)) > 0 {
+		result.LintRules = append(result.LintRules, c.LintRules...)
+		result.LintRules = append(result.LintRules, c2.LintRules...)
+	}
+
+	if (len(c.UsageMetrics) + len(c2.UsageMetrics)) > 0 {
+		result.UsageMetrics = append(result.UsageMetrics, c.UsageMetrics...)
+		result.UsageMetrics = append(result.UsageMetrics, c2.UsageMetrics...)
+	}
+
+	if (len(c.Proxy) + len(c2.Proxy)) > 0 {
+		result.Proxy = append(result.Proxy, c.Proxy...)
+		result.Proxy = append(result.Proxy, c2.Proxy...)
+	}
+
 	if (len(c.Hosts) + len(c2.Hosts)) > 0 {
 		result.Hosts = make(map[string]*ConfigHost)
 		maps.Copy(result.Hosts, c.Hosts)
@@ -468,6 +652,28 @@ func (c *Config) Merge(c2 *Config) *Config {
 	return &result
 }
 
+// validateDisabledFunctionPattern checks that the given string is either a
+// function name, optionally with namespace prefixes, or a namespace prefix
+// followed by a "*" wildcard.
+func validateDisabledFunctionPattern(pattern string) error {
+	if pattern == "" {
+		return errors.New("must not be empty")
+	}
+	if pattern == "*" {
+		return nil
+	}
+	name := pattern
+	if prefix, ok := strings.CutSuffix(pattern, "::*"); ok {
+		name = prefix
+	}
+	for part := range strings.SplitSeq(name, "::") {
+		if part == "" || strings.Contains(part, "*") {
+			return errors.New(`must be a function name, or a namespace prefix followed by "::*"`)
+		}
+	}
+	return nil
+}
+
 func (cl *ConfigLoader) cliConfigFile() (string, tfdiags.Diagnostics) {
 	var diags tfdiags.Diagnostics
 	mustExist := true
@@ -512,6 +718,21 @@ func cliConfigFileOverride() string {
 	return configFilePath
 }
 
+// providerReadDefaultRetry is the number of times to retry a retryable
+// provider read request when the CLI configuration doesn't set
+// provider_read_retry_count.
+const providerReadDefaultRetry = 2
+
+// ProviderReadRetries returns the number of times to retry a request to read
+// a resource or data source that fails with an error the provider marked as
+// retryable.
+func (c *Config) ProviderReadRetries() int {
+	if c.ProviderReadRetryCount == nil {
+		return providerReadDefaultRetry
+	}
+	return *c.ProviderReadRetryCount
+}
+
 const (
 	// providerDownloadRetryCountEnvName is the environment variable name used to customize
 	// the HTTP retry count for module downloads.
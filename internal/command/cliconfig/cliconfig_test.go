@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/google/go-cmp/cmp"
@@ -253,6 +254,142 @@ func TestLoadConfig_credentials(t *testing.T) {
 	}
 }
 
+func TestLoadConfig_disabledFunctions(t *testing.T) {
+	got, diags := loadConfigFile(filepath.Join(fixtureDir, "disabled-functions"))
+	if len(diags) != 0 {
+		t.Fatalf("%s", diags.Err())
+	}
+
+	want := &Config{
+		DisabledFunctions: []string{"file", "templatefile", "provider::*"},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("wrong result\ngot:  %swant: %s", spew.Sdump(got), spew.Sdump(want))
+	}
+}
+
+func TestLoadConfig_approvalHook(t *testing.T) {
+	got, diags := loadConfigFile(filepath.Join(fixtureDir, "approval-hook"))
+	if len(diags) != 0 {
+		t.Fatalf("%s", diags.Err())
+	}
+
+	want := &Config{
+		ApprovalHook: []*ConfigApprovalHook{
+			{
+				Command: "/usr/local/bin/wait-for-approval",
+				Args:    []string{"--channel", "deploys"},
+				Timeout: "30m",
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("wrong result\ngot:  %swant: %s", spew.Sdump(got), spew.Sdump(want))
+	}
+	if got, want := got.ApprovalHook[0].TimeoutDuration(), 30*time.Minute; got != want {
+		t.Errorf("wrong timeout %s; want %s", got, want)
+	}
+}
+
+func TestLoadConfig_usageMetrics(t *testing.T) {
+	got, diags := loadConfigFile(filepath.Join(fixtureDir, "usage-metrics"))
+	if len(diags) != 0 {
+		t.Fatalf("%s", diags.Err())
+	}
+
+	want := &Config{
+		UsageMetrics: []*ConfigUsageMetrics{
+			{
+				Enabled: true,
+				Path:    "/var/log/tofu/usage.jsonl",
+				OTLP:    true,
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("wrong result\ngot:  %swant: %s", spew.Sdump(got), spew.Sdump(want))
+	}
+}
+
+func TestLoadConfig_providerReadRetryCount(t *testing.T) {
+	got, diags := loadConfigFile(filepath.Join(fixtureDir, "provider-read-retry-count"))
+	if len(diags) != 0 {
+		t.Fatalf("%s", diags.Err())
+	}
+
+	want := &Config{
+		ProviderReadRetryCount: intPtr(5),
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("wrong result\ngot:  %swant: %s", spew.Sdump(got), spew.Sdump(want))
+	}
+	if got, want := got.ProviderReadRetries(), 5; got != want {
+		t.Errorf("wrong retry count %d; want %d", got, want)
+	}
+	if got, want := (&Config{}).ProviderReadRetries(), providerReadDefaultRetry; got != want {
+		t.Errorf("wrong default retry count %d; want %d", got, want)
+	}
+}
+
+func TestLoadConfig_proxy(t *testing.T) {
+	got, diags := loadConfigFile(filepath.Join(fixtureDir, "proxy"))
+	if len(diags) != 0 {
+		t.Fatalf("%s", diags.Err())
+	}
+
+	want := &Config{
+		Proxy: []*ConfigProxy{
+			{
+				PACFile: "https://wpad.example.com/proxy.pac",
+				Hosts: map[string]*ConfigProxyHost{
+					"*.corp.example.com":    {URL: "direct"},
+					"registry.opentofu.org": {URL: "http://proxy.example.com:3128"},
+				},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("wrong result\ngot:  %swant: %s", spew.Sdump(got), spew.Sdump(want))
+	}
+}
+
+func TestLoadConfig_lintRules(t *testing.T) {
+	got, diags := loadConfigFile(filepath.Join(fixtureDir, "lint-rules"))
+	if len(diags) != 0 {
+		t.Fatalf("%s", diags.Err())
+	}
+
+	want := &Config{
+		LintRules: []*ConfigLintRule{
+			{
+				Name:    "naming",
+				Command: "/usr/local/bin/check-naming",
+				Args:    []string{"--prefix", "acme-"},
+			},
+			{
+				Name:    "tags",
+				Command: "check-tags",
+				Timeout: "30s",
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("wrong result\ngot:  %swant: %s", spew.Sdump(got), spew.Sdump(want))
+	}
+	if got, want := got.LintRules[0].TimeoutDuration(), DefaultLintRuleTimeout; got != want {
+		t.Errorf("wrong default timeout %s; want %s", got, want)
+	}
+	if got, want := got.LintRules[1].TimeoutDuration(), 30*time.Second; got != want {
+		t.Errorf("wrong timeout %s; want %s", got, want)
+	}
+}
+
 func TestConfigValidate(t *testing.T) {
 	tests := map[string]struct {
 		Config    *Config
@@ -342,6 +479,122 @@ func TestConfigValidate(t *testing.T) {
 			},
 			1, // no more than one provider_installation block allowed
 		},
+		"disabled_functions good": {
+			&Config{
+				DisabledFunctions: []string{"file", "core::pathexpand", "provider::*", "provider::aws::*", "*"},
+			},
+			0,
+		},
+		"disabled_functions invalid": {
+			&Config{
+				DisabledFunctions: []string{"", "provider::", "file*", "provider::*::foo"},
+			},
+			4, // each of the patterns is invalid
+		},
+		"provider_read_retry_count good": {
+			&Config{
+				ProviderReadRetryCount: intPtr(0),
+			},
+			0,
+		},
+		"provider_read_retry_count negative": {
+			&Config{
+				ProviderReadRetryCount: intPtr(-1),
+			},
+			1,
+		},
+		"approval_hook good": {
+			&Config{
+				ApprovalHook: []*ConfigApprovalHook{
+					{Command: "approve", Timeout: "10m"},
+				},
+			},
+			0,
+		},
+		"approval_hook multiple": {
+			&Config{
+				ApprovalHook: []*ConfigApprovalHook{
+					{Command: "approve"},
+					{Command: "approve-again"},
+				},
+			},
+			1, // no more than one approval_hook block allowed
+		},
+		"approval_hook invalid": {
+			&Config{
+				ApprovalHook: []*ConfigApprovalHook{
+					{Timeout: "soon"},
+				},
+			},
+			2, // missing command and invalid timeout
+		},
+		"usage_metrics multiple": {
+			&Config{
+				UsageMetrics: []*ConfigUsageMetrics{
+					{Enabled: true},
+					{Enabled: false},
+				},
+			},
+			1, // no more than one usage_metrics block allowed
+		},
+		"proxy good": {
+			&Config{
+				Proxy: []*ConfigProxy{
+					{
+						PACFile: "/etc/proxy.pac",
+						Hosts: map[string]*ConfigProxyHost{
+							"*":                {URL: "socks5://proxy.example.com:1080"},
+							"*.example.com":    {URL: "DIRECT"},
+							"foo.example.com":  {URL: "https://proxy.example.com"},
+							"registry.example": {URL: "http://proxy.example.com:3128"},
+						},
+					},
+				},
+			},
+			0,
+		},
+		"proxy invalid": {
+			&Config{
+				Proxy: []*ConfigProxy{
+					{
+						PACFile: "proxy.pac",
+						Hosts: map[string]*ConfigProxyHost{
+							"foo*.example.com": {URL: "http://proxy.example.com:3128"},
+							"example.com":      {URL: "ftp://proxy.example.com"},
+							"example.net":      {URL: "http://"},
+						},
+					},
+					{},
+				},
+			},
+			5, // relative pac_file, bad pattern, two bad URLs, and two proxy blocks
+		},
+		"lint_rule good": {
+			&Config{
+				LintRules: []*ConfigLintRule{
+					{Name: "naming", Command: "check-naming"},
+					{Name: "tags", Command: "check-tags", Timeout: "30s"},
+				},
+			},
+			0,
+		},
+		"lint_rule duplicate": {
+			&Config{
+				LintRules: []*ConfigLintRule{
+					{Name: "tags", Command: "check-tags"},
+					{Name: "tags", Command: "check-tags-again"},
+				},
+			},
+			1, // each lint_rule must have a unique name
+		},
+		"lint_rule invalid": {
+			&Config{
+				LintRules: []*ConfigLintRule{
+					{Name: "tags", Timeout: "soon"},
+				},
+			},
+			2, // missing command and invalid timeout
+		},
 		"plugin_cache_dir does not exist": {
 			&Config{
 				PluginCacheDir: "fake",
@@ -380,6 +633,7 @@ func TestConfig_Merge(t *testing.T) {
 		CredentialsHelpers: map[string]*ConfigCredentialsHelper{
 			"buz": {},
 		},
+		DisabledFunctions: []string{"file"},
 		ProviderInstallation: []*ProviderInstallation{
 			{
 				Methods: []*ProviderInstallationMethod{
@@ -422,6 +676,7 @@ func TestConfig_Merge(t *testing.T) {
 		CredentialsHelpers: map[string]*ConfigCredentialsHelper{
 			"biz": {},
 		},
+		DisabledFunctions: []string{"provider::*"},
 		ProviderInstallation: []*ProviderInstallation{
 			{
 				Methods: []*ProviderInstallationMethod{
@@ -430,6 +685,7 @@ func TestConfig_Merge(t *testing.T) {
 			},
 		},
 		PluginCacheMayBreakDependencyLockFile: true,
+		ProviderReadRetryCount:                intPtr(4),
 		OCIDefaultCredentials: []*OCIDefaultCredentials{
 			{
 				DefaultDockerCredentialHelper: "osxkeychain",
@@ -468,6 +724,7 @@ func TestConfig_Merge(t *testing.T) {
 			"buz": {},
 			"biz": {},
 		},
+		DisabledFunctions: []string{"file", "provider::*"},
 		ProviderInstallation: []*ProviderInstallation{
 			{
 				Methods: []*ProviderInstallationMethod{
@@ -487,6 +744,7 @@ func TestConfig_Merge(t *testing.T) {
 			},
 		},
 		PluginCacheMayBreakDependencyLockFile: true,
+		ProviderReadRetryCount:                intPtr(4),
 		OCIDefaultCredentials: []*OCIDefaultCredentials{
 			{
 				DiscoverAmbientCredentials: false,
@@ -512,3 +770,7 @@ func TestConfig_Merge(t *testing.T) {
 		t.Fatalf("wrong result\n%s", diff)
 	}
 }
+
+func intPtr(v int) *int {
+	return &v
+}
@@ -13,6 +13,7 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/opentofu/svchost"
@@ -278,6 +279,26 @@ func (s *CredentialsSource) HostCredentialsLocation(host svchost.Hostname) Crede
 	return CredentialsNotAvailable
 }
 
+// ConfiguredHosts returns the hostnames that have credentials configured
+// either in the CLI configuration or in TF_TOKEN_ environment variables,
+// sorted by name. It doesn't include hosts whose credentials are only
+// available from a credentials helper, because helpers can't enumerate them.
+func (s *CredentialsSource) ConfiguredHosts() []svchost.Hostname {
+	hosts := make(map[svchost.Hostname]struct{}, len(s.configured))
+	for host := range s.configured {
+		hosts[host] = struct{}{}
+	}
+	for host := range collectCredentialsFromEnv() {
+		hosts[host] = struct{}{}
+	}
+	ret := make([]svchost.Hostname, 0, len(hosts))
+	for host := range hosts {
+		ret = append(ret, host)
+	}
+	slices.Sort(ret)
+	return ret
+}
+
 // CredentialsFilePath returns the full path to the local credentials
 // configuration file, so that a caller can mention this path in order to
 // be transparent about where credentials will be stored.
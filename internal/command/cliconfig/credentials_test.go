@@ -198,6 +198,26 @@ func TestCredentialsForHost(t *testing.T) {
 	})
 }
 
+func TestCredentialsSourceConfiguredHosts(t *testing.T) {
+	t.Setenv("TF_TOKEN_env_example_com", "from-env")
+	credSrc := &CredentialsSource{
+		configured: map[svchost.Hostname]cty.Value{
+			"configured.example.com": cty.ObjectVal(map[string]cty.Value{
+				"token": cty.StringVal("configured"),
+			}),
+			"another.example.com": cty.ObjectVal(map[string]cty.Value{
+				"token": cty.StringVal("another"),
+			}),
+		},
+	}
+
+	got := credSrc.ConfiguredHosts()
+	want := []svchost.Hostname{"another.example.com", "configured.example.com", "env.example.com"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("wrong result\n%s", diff)
+	}
+}
+
 func TestCredentialsStoreForget(t *testing.T) {
 	d := t.TempDir()
 
@@ -0,0 +1,130 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cliconfig
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/hcl"
+	hclast "github.com/hashicorp/hcl/hcl/ast"
+
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// DefaultLintRuleTimeout is the maximum time to wait for a lint rule to exit
+// if its configuration doesn't specify a timeout.
+const DefaultLintRuleTimeout = 1 * time.Minute
+
+// ConfigLintRule is the structure of the "lint_rule" nested block within the
+// CLI configuration, which names an external command that "tofu validate"
+// runs to check the configuration against additional rules.
+type ConfigLintRule struct {
+	// Name is the label of the block, which identifies the rule in the
+	// diagnostics that it reports.
+	Name string `hcl:"-"`
+
+	Command string   `hcl:"command"`
+	Args    []string `hcl:"args"`
+
+	// Timeout is the maximum time to wait for the command to exit, written
+	// as a duration string such as "30s". If not set, the timeout is
+	// DefaultLintRuleTimeout.
+	Timeout string `hcl:"timeout"`
+}
+
+// TimeoutDuration returns the maximum time to wait for the lint rule command
+// to exit.
+//
+// This must be called only on a configuration that has passed validation,
+// because it assumes that the timeout is valid.
+func (r *ConfigLintRule) TimeoutDuration() time.Duration {
+	if r.Timeout == "" {
+		return DefaultLintRuleTimeout
+	}
+	d, err := time.ParseDuration(r.Timeout)
+	if err != nil {
+		// Should not get here for a validated configuration.
+		return DefaultLintRuleTimeout
+	}
+	return d
+}
+
+// decodeLintRulesFromConfig uses the HCL AST API directly to decode
+// "lint_rule" blocks from the given file.
+//
+// HCL 1's DecodeObject can't decode a block containing a list argument into
+// a slice of structs, so we decode each block individually instead.
+func decodeLintRulesFromConfig(hclFile *hclast.File) ([]*ConfigLintRule, tfdiags.Diagnostics) {
+	var ret []*ConfigLintRule
+	var diags tfdiags.Diagnostics
+
+	root, ok := hclFile.Node.(*hclast.ObjectList)
+	if !ok {
+		// A HCL file that doesn't have an object list at its root is weird, but
+		// dealing with that is outside the scope of this function.
+		return ret, diags
+	}
+	for _, block := range root.Items {
+		const errInvalidSummary = "Invalid lint_rule block"
+		if block.Keys[0].Token.Value() != "lint_rule" {
+			continue
+		}
+
+		// This helper function compensates for HCL 1's inability to automatically
+		// resolve the block label vs. block argument ambiguity in its JSON syntax.
+		const TWO = 2 // To quiet the "mnd" linter
+		unwrapHCLObjectKeysFromJSON(block, TWO)
+		if len(block.Keys) != TWO {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				errInvalidSummary,
+				fmt.Sprintf("The lint_rule block at %s must have one label, giving the name of the rule.", block.Pos()),
+			))
+			continue
+		}
+
+		isJSON := block.Keys[0].Token.JSON
+		if block.Assign.Line != 0 && !isJSON {
+			// Seems to be an attribute rather than a block
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				errInvalidSummary,
+				fmt.Sprintf("The lint_rule block at %s must not be introduced with an equals sign.", block.Pos()),
+			))
+			continue
+		}
+		body, ok := block.Val.(*hclast.ObjectType)
+		if !ok {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				errInvalidSummary,
+				fmt.Sprintf("The lint_rule block at %s must be represented by a JSON object.", block.Pos()),
+			))
+			continue
+		}
+		name, ok := block.Keys[1].Token.Value().(string)
+		if !ok {
+			// HCL grammar doesn't allow anything other than string in the key position,
+			// so we should not get here.
+			panic(fmt.Sprintf("HCL returned non-string label %#v for lint_rule block", block.Keys[1].Token))
+		}
+
+		rule := &ConfigLintRule{}
+		if err := hcl.DecodeObject(rule, body); err != nil {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				errInvalidSummary,
+				fmt.Sprintf("Invalid lint_rule block at %s: %s.", body.Pos(), err),
+			))
+			continue
+		}
+		rule.Name = name
+		ret = append(ret, rule)
+	}
+
+	return ret, diags
+}
@@ -242,6 +242,16 @@ func decodeProviderInstallationFromConfig(hclFile *hclast.File) ([]*ProviderInst
 				trustedF = func() bool {
 					return bodyContent.Trusted
 				}
+			case "go_build":
+				var moreDiags tfdiags.Diagnostics
+				location, moreDiags = decodeGoBuildInstallationMethodBlock(methodBody, methodBlock.Pos())
+				diags = diags.Append(moreDiags)
+				if moreDiags.HasErrors() {
+					continue
+				}
+				// A go_build block can only ever install the one provider
+				// that it builds.
+				include = []string{location.(ProviderInstallationGoBuild).Provider.String()}
 			case "oci_mirror":
 				var moreDiags tfdiags.Diagnostics
 				location, include, exclude, moreDiags = decodeOCIMirrorInstallationMethodBlock(methodBody)
@@ -327,6 +337,78 @@ func decodeProviderInstallationFromConfig(hclFile *hclast.File) ([]*ProviderInst
 	return ret, diags
 }
 
+// decodeGoBuildInstallationMethodBlock decodes the content of a go_build block
+// from inside a provider_installation block.
+func decodeGoBuildInstallationMethodBlock(methodBody *hclast.ObjectType, pos hcltoken.Pos) (ProviderInstallationLocation, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	type BodyContent struct {
+		Provider string   `hcl:"provider"`
+		Path     string   `hcl:"path"`
+		Version  string   `hcl:"version"`
+		Include  []string `hcl:"include"`
+		Exclude  []string `hcl:"exclude"`
+	}
+	var bodyContent BodyContent
+	err := hcl.DecodeObject(&bodyContent, methodBody)
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid provider_installation method block",
+			fmt.Sprintf("Invalid go_build block at %s: %s.", pos, err),
+		))
+		return nil, diags
+	}
+	for _, arg := range []struct{ name, value string }{
+		{"provider", bodyContent.Provider},
+		{"path", bodyContent.Path},
+		{"version", bodyContent.Version},
+	} {
+		if arg.value == "" {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Invalid provider_installation method block",
+				fmt.Sprintf("Invalid go_build block at %s: %q argument is required.", pos, arg.name),
+			))
+		}
+	}
+	if bodyContent.Include != nil || bodyContent.Exclude != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid provider_installation method block",
+			fmt.Sprintf("Invalid go_build block at %s: \"include\" and \"exclude\" are not allowed, because a go_build block only installs the provider that it builds.", pos),
+		))
+	}
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	provider, moreDiags := addrs.ParseProviderSourceString(bodyContent.Provider)
+	if moreDiags.HasErrors() {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid provider_installation method block",
+			fmt.Sprintf("Invalid go_build block at %s: %q is not a valid provider source string.\n\n%s", pos, bodyContent.Provider, moreDiags.Err().Error()),
+		))
+		return nil, diags
+	}
+	version, err := getproviders.ParseVersion(bodyContent.Version)
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid provider_installation method block",
+			fmt.Sprintf("Invalid go_build block at %s: %q is not a valid version number: %s.", pos, bodyContent.Version, err),
+		))
+		return nil, diags
+	}
+
+	return ProviderInstallationGoBuild{
+		Provider: provider,
+		Path:     filepath.Clean(bodyContent.Path),
+		Version:  version,
+	}, diags
+}
+
 // decodeOCIMirrorInstallationMethodBlock decodes the content of an oci_mirror block
 // from inside a provider_installation block.
 func decodeOCIMirrorInstallationMethodBlock(methodBody *hclast.ObjectType) (location ProviderInstallationLocation, include, exclude []string, diags tfdiags.Diagnostics) {
@@ -601,6 +683,7 @@ type ProviderInstallationMethod struct {
 //   - [ProviderInstallationFilesystemMirror] (dir): install from a local filesystem mirror
 //   - [ProviderInstallationNetworkMirror] (host):   install from a network mirror
 //   - [ProviderInstallationOCIMirror]:              use OCI registries as if they were a network mirror
+//   - [ProviderInstallationGoBuild]:                build a provider from local Go source code
 type ProviderInstallationLocation interface {
 	providerInstallationLocation()
 }
@@ -667,6 +750,28 @@ func (i ProviderInstallationOCIMirror) GoString() string {
 	return "cliconfig.ProviderInstallationNetworkMirror{/*...*/}"
 }
 
+// ProviderInstallationGoBuild is a ProviderInstallationSourceLocation
+// representing installation of a single provider by building it from a local
+// checkout of its Go source code, for developing the provider alongside the
+// configurations that use it.
+type ProviderInstallationGoBuild struct {
+	Provider addrs.Provider
+
+	// Path is the directory containing the Go module of the provider,
+	// which must be within a git checkout.
+	Path string
+
+	// Version is the version number that the built provider is given, for
+	// the purposes of version constraints and the dependency lock file.
+	Version getproviders.Version
+}
+
+func (i ProviderInstallationGoBuild) providerInstallationLocation() {}
+
+func (i ProviderInstallationGoBuild) GoString() string {
+	return fmt.Sprintf("cliconfig.ProviderInstallationGoBuild{Provider: %q, Path: %q, Version: %q}", i.Provider, i.Path, i.Version)
+}
+
 // ProviderInstallationMethodRetries defines the function to return the
 // configured, or lack of, retries (`download_retry_count`) configured for
 // a provider installation method.
@@ -305,3 +305,48 @@ func TestLoadConfig_providerInstallationOCIMirrorErrors(t *testing.T) {
 		}
 	})
 }
+
+func TestLoadConfig_providerInstallationGoBuild(t *testing.T) {
+	got, diags := loadConfigFile(filepath.Join(fixtureDir, "provider-installation-go-build"))
+	if diags.HasErrors() {
+		t.Fatalf("unexpected diagnostics: %s", diags.Err().Error())
+	}
+
+	gotMethods := got.ProviderInstallation[0].Methods
+	if got, want := len(gotMethods), 2; got != want {
+		t.Fatalf("wrong number of provider installation methods %d; want %d", got, want)
+	}
+	want := &ProviderInstallationMethod{
+		Location: ProviderInstallationGoBuild{
+			Provider: addrs.MustParseProviderSourceString("example.com/acme/widget"),
+			Path:     filepath.FromSlash("/src/terraform-provider-widget"),
+			Version:  getproviders.MustParseVersion("0.1.0"),
+		},
+		// A go_build block implicitly includes only the provider it builds.
+		Include: []string{"example.com/acme/widget"},
+	}
+	noFuncs := cmp.Comparer(func(a, b ProviderInstallationMethodRetries) bool { return a == nil && b == nil })
+	if diff := cmp.Diff(want, gotMethods[0], noFuncs); diff != "" {
+		t.Errorf("wrong result\n%s", diff)
+	}
+	if gotMethods[1].Location != ProviderInstallationDirect {
+		t.Errorf("wrong location %#v for the second method; want direct", gotMethods[1].Location)
+	}
+}
+
+func TestLoadConfig_providerInstallationGoBuildErrors(t *testing.T) {
+	_, diags := loadConfigFile(filepath.Join(fixtureDir, "provider-installation-go-build-errors"))
+	got := diags.Err().Error()
+	for _, want := range []string{
+		`Invalid go_build block at 2:3: "provider" argument is required.`,
+		`Invalid go_build block at 2:3: "path" argument is required.`,
+		`Invalid go_build block at 2:3: "version" argument is required.`,
+		`Invalid go_build block at 3:3: "not a provider" is not a valid provider source string.`,
+		`Invalid go_build block at 8:3: "latest" is not a valid version number`,
+		`Invalid go_build block at 13:3: "include" and "exclude" are not allowed`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("diagnostics are missing %q\ngot:\n%s", want, got)
+		}
+	}
+}
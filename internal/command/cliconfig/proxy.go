@@ -0,0 +1,174 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cliconfig
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/hcl"
+	hclast "github.com/hashicorp/hcl/hcl/ast"
+
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// ConfigProxy is the structure of the "proxy" nested block within the CLI
+// configuration, which extends the proxy environment variables with a proxy
+// auto-configuration file and with proxy overrides for specific hosts.
+type ConfigProxy struct {
+	// PACFile is the local path or the http or https URL of a proxy
+	// auto-configuration file.
+	PACFile string `hcl:"pac_file"`
+
+	// Hosts are the per-host proxy overrides, indexed by a hostname or a
+	// pattern such as "*.example.com".
+	Hosts map[string]*ConfigProxyHost
+}
+
+// ConfigProxyHost is the structure of the "host" blocks nested within the
+// "proxy" block.
+type ConfigProxyHost struct {
+	// URL is the URL of the proxy to use, or "direct" to connect to the host
+	// without a proxy.
+	URL string `hcl:"url"`
+}
+
+// ProxyURL returns the URL of the proxy to use for the host, or nil if
+// requests to the host must not use a proxy.
+func (h *ConfigProxyHost) ProxyURL() (*url.URL, error) {
+	if strings.EqualFold(h.URL, "direct") {
+		return nil, nil
+	}
+	u, err := url.Parse(h.URL)
+	if err != nil {
+		return nil, err
+	}
+	switch u.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, errors.New(`must be an http, https, or socks5 URL, or "direct"`)
+	}
+	if u.Host == "" {
+		return nil, errors.New("must include the hostname of the proxy")
+	}
+	return u, nil
+}
+
+func validateProxyHostPattern(pattern string) error {
+	if pattern == "*" {
+		return nil
+	}
+	host := strings.TrimPrefix(pattern, "*.")
+	if host == "" || strings.ContainsAny(host, "*/:") {
+		return errors.New(`must be a hostname, a hostname with a "*." prefix, or "*"`)
+	}
+	return nil
+}
+
+func validatePACFile(location string) error {
+	if strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://") {
+		_, err := url.Parse(location)
+		return err
+	}
+	if !filepath.IsAbs(strings.TrimPrefix(location, "file://")) {
+		return errors.New("must be an absolute path, or an http or https URL")
+	}
+	return nil
+}
+
+// decodeProxyFromConfig uses the HCL AST API directly to decode "proxy"
+// blocks from the given file.
+func decodeProxyFromConfig(hclFile *hclast.File) ([]*ConfigProxy, tfdiags.Diagnostics) {
+	var ret []*ConfigProxy
+	var diags tfdiags.Diagnostics
+
+	root, ok := hclFile.Node.(*hclast.ObjectList)
+	if !ok {
+		// A HCL file that doesn't have an object list at its root is weird, but
+		// dealing with that is outside the scope of this function.
+		return ret, diags
+	}
+	for _, block := range root.Items {
+		if block.Keys[0].Token.Value() != "proxy" {
+			continue
+		}
+
+		const errInvalidSummary = "Invalid proxy block"
+		isJSON := block.Keys[0].Token.JSON
+		if block.Assign.Line != 0 && !isJSON {
+			// Seems to be an attribute rather than a block
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				errInvalidSummary,
+				fmt.Sprintf("The proxy block at %s must not be introduced with an equals sign.", block.Pos()),
+			))
+			continue
+		}
+		if len(block.Keys) > 1 && !isJSON {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				errInvalidSummary,
+				fmt.Sprintf("The proxy block at %s must not have any labels.", block.Pos()),
+			))
+			continue
+		}
+		body, ok := block.Val.(*hclast.ObjectType)
+		if !ok {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				errInvalidSummary,
+				fmt.Sprintf("The proxy block at %s must be represented by a JSON object.", block.Pos()),
+			))
+			continue
+		}
+
+		proxy := &ConfigProxy{}
+		if err := hcl.DecodeObject(proxy, body); err != nil {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				errInvalidSummary,
+				fmt.Sprintf("Invalid proxy block at %s: %s.", body.Pos(), err),
+			))
+			continue
+		}
+		// HCL 1's DecodeObject doesn't decode labeled blocks nested inside
+		// another block into a map, so we decode the host blocks ourselves.
+		for _, item := range body.List.Filter("host").Items {
+			if len(item.Keys) != 1 {
+				diags = diags.Append(tfdiags.Sourceless(
+					tfdiags.Error,
+					errInvalidSummary,
+					fmt.Sprintf("The host block at %s must have exactly one label: the hostname to use the proxy for.", item.Pos()),
+				))
+				continue
+			}
+			pattern := item.Keys[0].Token.Value().(string)
+			host := &ConfigProxyHost{}
+			if err := hcl.DecodeObject(host, item.Val); err != nil {
+				diags = diags.Append(tfdiags.Sourceless(
+					tfdiags.Error,
+					errInvalidSummary,
+					fmt.Sprintf("Invalid host block at %s: %s.", item.Pos(), err),
+				))
+				continue
+			}
+			if proxy.Hosts == nil {
+				proxy.Hosts = make(map[string]*ConfigProxyHost)
+			}
+			proxy.Hosts[pattern] = host
+		}
+		if proxy.PACFile != "" {
+			proxy.PACFile = os.ExpandEnv(proxy.PACFile)
+		}
+		ret = append(ret, proxy)
+	}
+
+	return ret, diags
+}
@@ -0,0 +1,119 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cliconfig
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/hcl"
+	hclast "github.com/hashicorp/hcl/hcl/ast"
+
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// DefaultUsageMetricsFilename is the name of the file in the CLI
+// configuration directory that usage metrics are written to if the
+// usage_metrics block doesn't specify a path.
+const DefaultUsageMetricsFilename = "usage-metrics.jsonl"
+
+// ConfigUsageMetrics is the structure of the "usage_metrics" nested block
+// within the CLI configuration, which opts in to recording anonymous timings
+// and feature usage of OpenTofu commands.
+type ConfigUsageMetrics struct {
+	// Enabled must be set to true to record any metrics.
+	Enabled bool `hcl:"enabled"`
+
+	// Path is the file that metrics are appended to, one JSON object per
+	// line. If not set, the metrics are written to
+	// DefaultUsageMetricsFilename in the CLI configuration directory.
+	Path string `hcl:"path"`
+
+	// OTLP additionally exports the metrics using an OpenTelemetry metrics
+	// exporter configured by the standard OTEL_* environment variables.
+	OTLP bool `hcl:"otlp"`
+}
+
+// FilePath returns the path of the file that usage metrics are written to.
+func (m *ConfigUsageMetrics) FilePath() (string, error) {
+	if m.Path != "" {
+		return m.Path, nil
+	}
+	dir, err := ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, DefaultUsageMetricsFilename), nil
+}
+
+// decodeUsageMetricsFromConfig uses the HCL AST API directly to decode
+// "usage_metrics" blocks from the given file.
+//
+// This is only so that the block is checked with the same rules as the
+// approval_hook block, because HCL 1's DecodeObject would also accept it
+// written as an argument.
+func decodeUsageMetricsFromConfig(hclFile *hclast.File) ([]*ConfigUsageMetrics, tfdiags.Diagnostics) {
+	var ret []*ConfigUsageMetrics
+	var diags tfdiags.Diagnostics
+
+	root, ok := hclFile.Node.(*hclast.ObjectList)
+	if !ok {
+		// A HCL file that doesn't have an object list at its root is weird, but
+		// dealing with that is outside the scope of this function.
+		return ret, diags
+	}
+	for _, block := range root.Items {
+		if block.Keys[0].Token.Value() != "usage_metrics" {
+			continue
+		}
+
+		const errInvalidSummary = "Invalid usage_metrics block"
+		isJSON := block.Keys[0].Token.JSON
+		if block.Assign.Line != 0 && !isJSON {
+			// Seems to be an attribute rather than a block
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				errInvalidSummary,
+				fmt.Sprintf("The usage_metrics block at %s must not be introduced with an equals sign.", block.Pos()),
+			))
+			continue
+		}
+		if len(block.Keys) > 1 && !isJSON {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				errInvalidSummary,
+				fmt.Sprintf("The usage_metrics block at %s must not have any labels.", block.Pos()),
+			))
+			continue
+		}
+		body, ok := block.Val.(*hclast.ObjectType)
+		if !ok {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				errInvalidSummary,
+				fmt.Sprintf("The usage_metrics block at %s must be represented by a JSON object.", block.Pos()),
+			))
+			continue
+		}
+
+		metrics := &ConfigUsageMetrics{}
+		if err := hcl.DecodeObject(metrics, body); err != nil {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				errInvalidSummary,
+				fmt.Sprintf("Invalid usage_metrics block at %s: %s.", body.Pos(), err),
+			))
+			continue
+		}
+		if metrics.Path != "" {
+			metrics.Path = os.ExpandEnv(metrics.Path)
+		}
+		ret = append(ret, metrics)
+	}
+
+	return ret, diags
+}
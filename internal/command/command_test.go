@@ -183,6 +183,8 @@ func testPlan(t *testing.T) *plans.Plan {
 	backendConfig := cty.ObjectVal(map[string]cty.Value{
 		"path":          cty.NullVal(cty.String),
 		"workspace_dir": cty.NullVal(cty.String),
+		"backup_dir":    cty.NullVal(cty.String),
+		"fsync":         cty.NullVal(cty.Bool),
 	})
 	backendConfigRaw, err := plans.NewDynamicValue(backendConfig, backendConfig.Type())
 	if err != nil {
@@ -7,6 +7,7 @@ package command
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"os"
 	"strings"
@@ -14,8 +15,11 @@ import (
 	"github.com/mitchellh/cli"
 	"github.com/opentofu/opentofu/internal/addrs"
 	"github.com/opentofu/opentofu/internal/backend"
+	backendLocal "github.com/opentofu/opentofu/internal/backend/local"
 	"github.com/opentofu/opentofu/internal/command/arguments"
+	"github.com/opentofu/opentofu/internal/command/clistate"
 	"github.com/opentofu/opentofu/internal/command/views"
+	"github.com/opentofu/opentofu/internal/lang"
 	"github.com/opentofu/opentofu/internal/repl"
 	"github.com/opentofu/opentofu/internal/tfdiags"
 	"github.com/opentofu/opentofu/internal/tofu"
@@ -115,6 +119,16 @@ func (c *ConsoleCommand) Run(rawArgs []string) int {
 		return 1
 	}
 
+	if _, isLocal := b.(*backendLocal.Local); isLocal && args.Remote {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Remote backend required",
+			"The -remote option evaluates expressions against a workspace of a remote backend, but the current configuration doesn't use the \"remote\" backend or a \"cloud\" block.",
+		))
+		view.Diagnostics(diags)
+		return 1
+	}
+
 	// This is a read-only command
 	c.ignoreRemoteVersionConflict(b)
 
@@ -123,6 +137,13 @@ func (c *ConsoleCommand) Run(rawArgs []string) int {
 	opReq.ConfigDir = configPath
 	opReq.ConfigLoader, err = c.initConfigLoader()
 	opReq.AllowUnsetVariables = true // we'll just evaluate them as unknown
+	if args.Remote {
+		// The console never modifies the state, so we don't lock the remote
+		// workspace and block the runs that others start in the meantime,
+		// and we use the variable values that the workspace's runs use.
+		opReq.StateLocker = clistate.NewNoopLocker()
+		opReq.UseStoredVariables = true
+	}
 	if err != nil {
 		diags = diags.Append(err)
 		view.Diagnostics(diags)
@@ -159,18 +180,7 @@ func (c *ConsoleCommand) Run(rawArgs []string) int {
 		}
 	}()
 
-	evalOpts := &tofu.EvalOpts{}
-	if lr.PlanOpts != nil {
-		// the LocalRun type is built primarily to support the main operations,
-		// so the variable values end up in the "PlanOpts" even though we're
-		// not actually making a plan.
-		evalOpts.SetVariables = lr.PlanOpts.SetVariables
-	}
-
-	// Before we can evaluate expressions, we must compute and populate any
-	// derived values (input variables, local values, output values)
-	// that are not stored in the persistent state.
-	scope, scopeDiags := lr.Core.Eval(ctx, lr.Config, lr.InputState, addrs.RootModuleInstance, evalOpts)
+	scope, scopeDiags := c.consoleScope(ctx, lr)
 	diags = diags.Append(scopeDiags)
 	if scope == nil {
 		// scope is nil if there are errors so bad that we can't even build a scope.
@@ -179,9 +189,6 @@ func (c *ConsoleCommand) Run(rawArgs []string) int {
 		return 1
 	}
 
-	// set the ConsoleMode to true so any available console-only functions included.
-	scope.ConsoleMode = true
-
 	if diags.HasErrors() {
 		diags = diags.Append(tfdiags.SimpleWarning("Due to the problems above, some expressions may produce unexpected results."))
 	}
@@ -193,7 +200,9 @@ func (c *ConsoleCommand) Run(rawArgs []string) int {
 
 	// IO Loop
 	session := &repl.Session{
-		Scope: scope,
+		Scope:  scope,
+		Format: repl.OutputFormat(args.Format),
+		Reload: c.reloadScope(ctx, stopCtx, local, opReq),
 	}
 
 	// Determine if stdin is a pipe. If so, we evaluate directly.
@@ -204,6 +213,88 @@ func (c *ConsoleCommand) Run(rawArgs []string) int {
 	return c.modeInteractive(session, view)
 }
 
+// consoleScope returns the scope for evaluating expressions in the console,
+// using the configuration, state, and variables of the given run.
+func (c *ConsoleCommand) consoleScope(ctx context.Context, lr *backend.LocalRun) (*lang.Scope, tfdiags.Diagnostics) {
+	evalOpts := &tofu.EvalOpts{}
+	if lr.PlanOpts != nil {
+		// the LocalRun type is built primarily to support the main operations,
+		// so the variable values end up in the "PlanOpts" even though we're
+		// not actually making a plan.
+		evalOpts.SetVariables = lr.PlanOpts.SetVariables
+	}
+
+	// Before we can evaluate expressions, we must compute and populate any
+	// derived values (input variables, local values, output values)
+	// that are not stored in the persistent state.
+	scope, diags := lr.Core.Eval(ctx, lr.Config, lr.InputState, addrs.RootModuleInstance, evalOpts)
+	if scope == nil {
+		return nil, diags
+	}
+
+	// set the ConsoleMode to true so any available console-only functions included.
+	scope.ConsoleMode = true
+	return scope, diags
+}
+
+// reloadScope returns a function for the console's "reload" command, which
+// re-reads the configuration and the variables to build a new scope.
+//
+// The new scope reuses the backend and the state lock that the console
+// already holds, so reloading is much quicker than starting a new console.
+func (c *ConsoleCommand) reloadScope(ctx, stopCtx context.Context, local backend.Local, opReq *backend.Operation) func() (*lang.Scope, tfdiags.Diagnostics) {
+	return func() (*lang.Scope, tfdiags.Diagnostics) {
+		var diags tfdiags.Diagnostics
+
+		// The configuration loader caches the files it has parsed, and we
+		// also cache the variable values and the root module call, so we
+		// must discard all of them to see any changes. We keep the values
+		// that the user entered at prompts though, so that they don't need
+		// to enter them again.
+		prompted := make(map[string]backend.UnparsedVariableValue)
+		for name, v := range c.inputVariableCache {
+			if v, ok := v.(unparsedVariableValueString); ok && v.sourceType == tofu.ValueFromInput {
+				prompted[name] = v
+			}
+		}
+		c.configLoader = nil
+		c.inputVariableCache = nil
+		c.rootModuleCallCache = nil
+		loader, err := c.initConfigLoader()
+		if err != nil {
+			diags = diags.Append(err)
+			return nil, diags
+		}
+
+		op := *opReq
+		op.ConfigLoader = loader
+		op.StateLocker = clistate.NewNoopLocker()
+
+		var moreDiags, callDiags tfdiags.Diagnostics
+		op.Variables, moreDiags = c.collectVariableValues()
+		for name, v := range prompted {
+			if _, exists := op.Variables[name]; !exists {
+				c.updateInputVariableCache(name, v)
+			}
+		}
+		op.RootCall, callDiags = c.rootModuleCall(ctx, op.ConfigDir)
+		diags = diags.Append(moreDiags).Append(callDiags)
+		if moreDiags.HasErrors() {
+			return nil, diags
+		}
+
+		lr, _, moreDiags := local.LocalRun(ctx, stopCtx, &op)
+		diags = diags.Append(moreDiags)
+		if moreDiags.HasErrors() {
+			return nil, diags
+		}
+
+		scope, moreDiags := c.consoleScope(ctx, lr)
+		diags = diags.Append(moreDiags)
+		return scope, diags
+	}
+}
+
 func (c *ConsoleCommand) modePiped(session *repl.Session, view views.Console) int {
 	scanner := bufio.NewScanner(os.Stdin)
 
@@ -261,6 +352,19 @@ Options:
                          will be performed. All locations, for all errors
                          will be listed. Disabled by default
 
+  -format=json           Show the values of expressions in the given format:
+                         "json" for JSON, "hcl" for literal expressions that
+                         can be pasted into configuration, or "raw" for strings,
+                         numbers, and bools without quotes. Prefix a single
+                         expression with ":json", ":hcl", or ":raw" to show
+                         only its value in that format.
+
+  -remote                Evaluate expressions against the latest state of the
+                         workspace in the "remote" backend or "cloud" block,
+                         using the variable values stored in the workspace
+                         for any that aren't set with -var or -var-file.
+                         The workspace is never locked.
+
   -state=path            Legacy option for the local backend only. See the local
                          backend's documentation for more information.
 
@@ -53,6 +53,31 @@ func TestConsole_basic(t *testing.T) {
 	}
 }
 
+func TestConsole_remoteWithLocalBackend(t *testing.T) {
+	testCwdTemp(t)
+
+	p := testProvider()
+	streams, done := terminal.StreamsForTesting(t)
+	view := views.NewView(streams)
+	c := &ConsoleCommand{
+		Meta: Meta{
+			WorkingDir:       workdir.NewDir("."),
+			testingOverrides: metaOverridesForProvider(p),
+			View:             view,
+		},
+	}
+	defer testStdinPipe(t, strings.NewReader("1+5\n"))()
+
+	code := c.Run([]string{"-remote"})
+	output := done(t)
+	if code != 1 {
+		t.Fatalf("unexpected exit status %d; want 1\n\n%s", code, output.Stdout())
+	}
+	if got, want := output.Stderr(), "Remote backend required"; !strings.Contains(got, want) {
+		t.Fatalf("missing expected error %q\n\n%s", want, got)
+	}
+}
+
 func TestConsole_tfvars(t *testing.T) {
 	td := t.TempDir()
 	testCopyDir(t, testFixturePath("apply-vars"), td)
@@ -231,6 +256,53 @@ func TestConsole_modules(t *testing.T) {
 	}
 }
 
+func TestConsole_reload(t *testing.T) {
+	td := t.TempDir()
+	t.Chdir(td)
+
+	files := map[string]string{
+		"main.tf": `
+variable "name" {
+  type = string
+}
+
+locals {
+  greeting = "Hello, ${var.name}!"
+}
+`,
+		"terraform.tfvars": `name = "World"`,
+	}
+	for name, src := range files {
+		if err := os.WriteFile(filepath.Join(td, name), []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	p := testProvider()
+	streams, done := terminal.StreamsForTesting(t)
+	view := views.NewView(streams)
+	c := &ConsoleCommand{
+		Meta: Meta{
+			WorkingDir:       workdir.NewDir("."),
+			testingOverrides: metaOverridesForProvider(p),
+			View:             view,
+		},
+	}
+
+	defer testStdinPipe(t, strings.NewReader("local.greeting\nreload\nlocal.greeting\n"))()
+
+	code := c.Run(nil)
+	output := done(t)
+	if code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, output.Stderr())
+	}
+
+	want := "\"Hello, World!\"\nReloaded the configuration and variables.\n\"Hello, World!\"\n"
+	if got := output.Stdout(); got != want {
+		t.Fatalf("unexpected output\n got: %q\nwant: %q", got, want)
+	}
+}
+
 func TestConsole_multiline_pipe(t *testing.T) {
 	td := t.TempDir()
 	testCopyDir(t, testFixturePath("console-multiline-vars"), td)
@@ -0,0 +1,37 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"strings"
+
+	"github.com/mitchellh/cli"
+)
+
+// DebugCommand is a Command implementation that just shows help for
+// the subcommands nested below it.
+type DebugCommand struct {
+	Meta
+}
+
+func (c *DebugCommand) Run(args []string) int {
+	return cli.RunResultHelp
+}
+
+func (c *DebugCommand) Help() string {
+	helpText := `
+Usage: tofu [global options] debug <subcommand> [options] [args]
+
+  This command has subcommands for diagnosing problems with OpenTofu
+  itself, such as problems connecting to registries.
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *DebugCommand) Synopsis() string {
+	return "Debugging related commands"
+}
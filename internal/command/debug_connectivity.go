@@ -0,0 +1,338 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptrace"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/cli"
+
+	"github.com/opentofu/opentofu/internal/command/arguments"
+	"github.com/opentofu/opentofu/internal/command/views"
+	"github.com/opentofu/opentofu/internal/httpclient"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// discoveryPath is the path of the service discovery document of a host
+// providing OpenTofu services such as a module or provider registry.
+const discoveryPath = "/.well-known/terraform.json"
+
+const (
+	connectivityDialTimeout    = 10 * time.Second
+	connectivityRequestTimeout = 30 * time.Second
+)
+
+// DebugConnectivityCommand is a Command implementation that checks each step
+// of connecting to a host, to help diagnose network problems such as
+// incorrect proxy settings.
+type DebugConnectivityCommand struct {
+	Meta
+}
+
+func (c *DebugConnectivityCommand) Help() string {
+	return debugConnectivityCommandHelp
+}
+
+func (c *DebugConnectivityCommand) Synopsis() string {
+	return "Check network connectivity to a host"
+}
+
+func (c *DebugConnectivityCommand) Run(rawArgs []string) int {
+	ctx := c.CommandContext()
+
+	common, rawArgs := arguments.ParseView(rawArgs)
+	c.View.Configure(common)
+
+	// Parse and validate flags
+	args, closer, diags := arguments.ParseDebugConnectivity(rawArgs)
+	defer closer()
+
+	// Instantiate the view, even if there are flag errors, so that we render
+	// diagnostics according to the desired view
+	view := views.NewDebugConnectivity(args.ViewOptions, c.View)
+	if diags.HasErrors() {
+		view.Diagnostics(diags)
+		return cli.RunResultHelp
+	}
+
+	target, err := connectivityTargetURL(args.Target)
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid hostname or URL",
+			fmt.Sprintf("Cannot check connectivity to %q: %s.", args.Target, err),
+		))
+		view.Diagnostics(diags)
+		return 1
+	}
+
+	view.Checking(target.Redacted())
+	checks := &connectivityChecks{view: view}
+	checks.run(ctx, target)
+	view.Summary(checks.failed)
+	if checks.failed != 0 {
+		return 1
+	}
+	return 0
+}
+
+// connectivityTargetURL returns the URL to check connectivity to for the
+// given command line argument, which is either an http or https URL or a
+// hostname, in which case we use the URL of its service discovery document.
+func connectivityTargetURL(target string) (*url.URL, error) {
+	if !strings.Contains(target, "://") {
+		if target == "" || strings.ContainsAny(target, "/?#@") {
+			return nil, errors.New("must be a hostname, or an http or https URL")
+		}
+		target = "https://" + target + discoveryPath
+	}
+	u, err := url.Parse(target)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, errors.New("must be a hostname, or an http or https URL")
+	}
+	if u.Hostname() == "" {
+		return nil, errors.New("the URL must include a hostname")
+	}
+	return u, nil
+}
+
+// connectivityChecks runs the connectivity checks for a URL, reporting the
+// result of each to the view.
+type connectivityChecks struct {
+	view   views.DebugConnectivity
+	failed int
+}
+
+func (c *connectivityChecks) pass(check string, format string, args ...any) {
+	c.view.CheckResult(check, true, fmt.Sprintf(format, args...))
+}
+
+func (c *connectivityChecks) fail(check string, format string, args ...any) {
+	c.failed++
+	c.view.CheckResult(check, false, fmt.Sprintf(format, args...))
+}
+
+// run checks each step of making a request to the given URL in turn:
+// selecting a proxy, resolving the hostname, connecting, the TLS handshake,
+// and the HTTP request itself. It stops at the first step that fails,
+// because the later steps can't succeed without it.
+func (c *connectivityChecks) run(ctx context.Context, target *url.URL) {
+	sel, err := httpclient.SelectProxy(target)
+	if err != nil {
+		c.fail("proxy", "%s", err)
+		return
+	}
+	if sel.PACError != nil {
+		// We can still continue with the proxy chosen from the environment
+		// variables, which is what OpenTofu itself would use.
+		c.fail("proxy", "the PAC file could not be used, so %s: %s", describeProxySelection(sel), sel.PACError)
+	} else {
+		c.pass("proxy", "%s", describeProxySelection(sel))
+	}
+
+	// When using a proxy, the network connection is to the proxy rather
+	// than to the target host.
+	dialURL := target
+	if sel.URL != nil {
+		dialURL = sel.URL
+	}
+	host, port := dialURL.Hostname(), dialURL.Port()
+	if port == "" {
+		port = defaultPort(dialURL.Scheme)
+	}
+
+	addrs, err := net.DefaultResolver.LookupHost(ctx, host)
+	if err != nil {
+		c.fail("dns", "failed to resolve %s: %s", host, err)
+		return
+	}
+	c.pass("dns", "resolved %s to %s", host, strings.Join(addrs, ", "))
+
+	dialer := &net.Dialer{Timeout: connectivityDialTimeout}
+	start := time.Now()
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(host, port))
+	if err != nil {
+		c.fail("connect", "failed to connect to %s: %s", net.JoinHostPort(host, port), err)
+		return
+	}
+	remote := conn.RemoteAddr().String()
+	conn.Close()
+	if sel.URL != nil {
+		c.pass("connect", "connected to the proxy at %s in %s", remote, time.Since(start).Round(time.Millisecond))
+	} else {
+		c.pass("connect", "connected to %s in %s", remote, time.Since(start).Round(time.Millisecond))
+	}
+
+	c.request(ctx, target)
+}
+
+// request makes a request to the given URL using the same HTTP client as the
+// rest of OpenTofu, reporting on the TLS handshake, the response, and, for
+// service discovery URLs, the discovered services.
+func (c *connectivityChecks) request(ctx context.Context, target *url.URL) {
+	var tlsState *tls.ConnectionState
+	var tlsErr error
+	trace := &httptrace.ClientTrace{
+		TLSHandshakeDone: func(state tls.ConnectionState, err error) {
+			tlsState, tlsErr = &state, err
+		},
+	}
+	ctx, cancel := context.WithTimeout(httptrace.WithClientTrace(ctx, trace), connectivityRequestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target.String(), nil)
+	if err != nil {
+		c.fail("http", "invalid request: %s", err)
+		return
+	}
+	client := httpclient.New(ctx)
+	resp, err := client.Do(req)
+
+	if target.Scheme == "https" {
+		switch {
+		case tlsErr != nil:
+			c.fail("tls", "%s", describeTLSError(tlsErr))
+			return
+		case tlsState != nil:
+			c.pass("tls", "%s", describeTLSState(tlsState))
+		}
+	}
+
+	if err != nil {
+		c.fail("http", "GET %s failed: %s", target.Redacted(), err)
+		return
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusProxyAuthRequired:
+		c.fail("http", "the proxy requires authentication; include the credentials in the proxy URL")
+		return
+	case resp.StatusCode >= 500:
+		c.fail("http", "GET %s returned %s", target.Redacted(), resp.Status)
+		return
+	default:
+		c.pass("http", "GET %s returned %s", target.Redacted(), resp.Status)
+	}
+
+	if target.Path != discoveryPath {
+		return
+	}
+	if resp.StatusCode != http.StatusOK {
+		c.fail("services", "the host does not provide any OpenTofu services")
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1024*1024))
+	if err != nil {
+		c.fail("services", "failed to read the service discovery document: %s", err)
+		return
+	}
+	var services map[string]any
+	if err := json.Unmarshal(body, &services); err != nil {
+		c.fail("services", "the service discovery document is not a JSON object: %s", err)
+		return
+	}
+	names := make([]string, 0, len(services))
+	for name := range services {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	if len(names) == 0 {
+		c.fail("services", "the host does not provide any OpenTofu services")
+		return
+	}
+	c.pass("services", "the host provides %s", strings.Join(names, ", "))
+}
+
+func describeProxySelection(sel httpclient.ProxySelection) string {
+	var via string
+	switch sel.Source {
+	case httpclient.ProxySourceRule:
+		via = fmt.Sprintf("the proxy host %q block in the CLI configuration", sel.Rule.Host)
+	case httpclient.ProxySourcePAC:
+		via = "the PAC file from the CLI configuration"
+	default:
+		via = "the proxy environment variables"
+	}
+	if sel.URL == nil {
+		return "connecting directly, as selected by " + via
+	}
+	return fmt.Sprintf("using the proxy %s, as selected by %s", sel.URL.Redacted(), via)
+}
+
+func describeTLSState(state *tls.ConnectionState) string {
+	ret := tls.VersionName(state.Version)
+	if len(state.PeerCertificates) != 0 {
+		cert := state.PeerCertificates[0]
+		ret += fmt.Sprintf(
+			" with a certificate for %s issued by %s, valid until %s",
+			cert.Subject.CommonName, cert.Issuer.CommonName, cert.NotAfter.Format(time.DateOnly),
+		)
+	}
+	return ret
+}
+
+func describeTLSError(err error) string {
+	var unknownAuthority x509.UnknownAuthorityError
+	if errors.As(err, &unknownAuthority) {
+		return fmt.Sprintf(
+			"%s; if your network intercepts TLS connections, add its certificate authority to the system's trusted certificates or set the SSL_CERT_FILE environment variable",
+			err,
+		)
+	}
+	return err.Error()
+}
+
+func defaultPort(scheme string) string {
+	switch scheme {
+	case "http":
+		return "80"
+	case "socks5":
+		return "1080"
+	default:
+		return "443"
+	}
+}
+
+const debugConnectivityCommandHelp = `
+Usage: tofu [global options] debug connectivity [options] <hostname-or-url>
+
+  Checks each step of making a request to the given host, or to the given
+  http or https URL, in the same way as OpenTofu makes requests to module
+  and provider registries: selecting a proxy, resolving the hostname,
+  connecting, the TLS handshake, and the HTTP request itself.
+
+  When given a hostname, the command requests the service discovery document
+  of the host, and so also checks which OpenTofu services it provides.
+
+  The proxy is selected using the proxy block in the CLI configuration, if
+  any, and otherwise the HTTPS_PROXY, HTTP_PROXY, and NO_PROXY environment
+  variables.
+
+Options:
+
+  -json              Produce output in a machine-readable JSON format,
+                     suitable for use in text editor integrations and other
+                     automated systems. Always disables color.
+
+  -no-color          If specified, output won't contain any color.
+`
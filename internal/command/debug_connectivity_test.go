@@ -0,0 +1,107 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/opentofu/opentofu/internal/command/workdir"
+)
+
+func TestDebugConnectivity(t *testing.T) {
+	t.Setenv("HTTP_PROXY", "")
+	t.Setenv("HTTPS_PROXY", "")
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != discoveryPath {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		rw.Header().Set("Content-Type", "application/json")
+		_, _ = rw.Write([]byte(`{"providers.v1":"/v1/providers/","modules.v1":"/v1/modules/"}`))
+	}))
+	defer server.Close()
+
+	view, done := testView(t)
+	c := &DebugConnectivityCommand{
+		Meta: Meta{
+			WorkingDir: workdir.NewDir("."),
+			View:       view,
+		},
+	}
+	code := c.Run([]string{"-no-color", server.URL + discoveryPath})
+	output := done(t)
+	if code != 0 {
+		t.Fatalf("unexpected exit code %d\n%s%s", code, output.Stdout(), output.Stderr())
+	}
+
+	got := output.Stdout()
+	for _, want := range []string{
+		"ok    proxy:    connecting directly",
+		"ok    dns:      resolved 127.0.0.1 to 127.0.0.1",
+		"ok    connect:  connected to 127.0.0.1:",
+		"ok    http:     GET " + server.URL + discoveryPath + " returned 200 OK",
+		"ok    services: the host provides modules.v1, providers.v1",
+		"All checks passed.",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("output is missing %q\n%s", want, got)
+		}
+	}
+}
+
+func TestDebugConnectivity_notProvidingServices(t *testing.T) {
+	t.Setenv("HTTP_PROXY", "")
+	t.Setenv("HTTPS_PROXY", "")
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	view, done := testView(t)
+	c := &DebugConnectivityCommand{
+		Meta: Meta{
+			WorkingDir: workdir.NewDir("."),
+			View:       view,
+		},
+	}
+	code := c.Run([]string{"-no-color", server.URL + discoveryPath})
+	output := done(t)
+	if code != 1 {
+		t.Fatalf("unexpected exit code %d\n%s%s", code, output.Stdout(), output.Stderr())
+	}
+
+	got := output.Stdout()
+	for _, want := range []string{
+		"ok    http:     GET " + server.URL + discoveryPath + " returned 404 Not Found",
+		"fail  services: the host does not provide any OpenTofu services",
+		"1 check failed.",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("output is missing %q\n%s", want, got)
+		}
+	}
+}
+
+func TestDebugConnectivity_invalidTarget(t *testing.T) {
+	view, done := testView(t)
+	c := &DebugConnectivityCommand{
+		Meta: Meta{
+			WorkingDir: workdir.NewDir("."),
+			View:       view,
+		},
+	}
+	code := c.Run([]string{"-no-color", "ftp://example.com/"})
+	output := done(t)
+	if code != 1 {
+		t.Fatalf("unexpected exit code %d\n%s", code, output.Stderr())
+	}
+	if got, want := output.Stderr(), "Error: Invalid hostname or URL"; !strings.Contains(got, want) {
+		t.Errorf("output is missing %q\n%s", want, got)
+	}
+}
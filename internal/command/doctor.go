@@ -0,0 +1,414 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"runtime"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/cli"
+	"github.com/opentofu/svchost"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/command/arguments"
+	"github.com/opentofu/opentofu/internal/command/cliconfig"
+	"github.com/opentofu/opentofu/internal/command/views"
+	"github.com/opentofu/opentofu/internal/depsfile"
+	"github.com/opentofu/opentofu/internal/httpclient"
+	"github.com/opentofu/opentofu/internal/providercache"
+	"github.com/opentofu/opentofu/internal/states/statemgr"
+)
+
+// providerExecTimeout is how long the doctor command waits for a provider
+// plugin to exit after starting it without the plugin handshake.
+const providerExecTimeout = 10 * time.Second
+
+// doctorServices are the service discovery identifiers reported for each
+// host, in the order they're reported.
+var doctorServices = []string{"modules.v1", "providers.v1", "login.v1", "tfe.v2"}
+
+// DoctorCommand is a Command implementation that checks the environment
+// OpenTofu runs in, such as access to registries and to the backend, and
+// reports the result of each check to help diagnose problems.
+type DoctorCommand struct {
+	Meta
+}
+
+func (c *DoctorCommand) Help() string {
+	return doctorCommandHelp
+}
+
+func (c *DoctorCommand) Synopsis() string {
+	return "Check the environment for common problems"
+}
+
+func (c *DoctorCommand) Run(rawArgs []string) int {
+	ctx := c.CommandContext()
+
+	common, rawArgs := arguments.ParseView(rawArgs)
+	c.View.Configure(common)
+
+	// Parse and validate flags
+	args, closer, diags := arguments.ParseDoctor(rawArgs)
+	defer closer()
+
+	// Instantiate the view, even if there are flag errors, so that we render
+	// diagnostics according to the desired view
+	view := views.NewDoctor(args.ViewOptions, c.View)
+	if diags.HasErrors() {
+		view.Diagnostics(diags)
+		return cli.RunResultHelp
+	}
+
+	checks := &doctorChecks{view: view}
+	locks, locksDiags := c.lockedDependencies()
+	var providers map[addrs.Provider]*depsfile.ProviderLock
+	if !locksDiags.HasErrors() {
+		providers = locks.AllProviders()
+	}
+
+	view.Section("Registries")
+	c.checkRegistries(ctx, checks, providers)
+
+	view.Section("Credentials")
+	c.checkCredentials(ctx, checks)
+
+	view.Section("Backend")
+	c.checkBackend(ctx, checks)
+
+	view.Section("Plugin cache")
+	c.checkPluginCache(checks)
+
+	view.Section("Providers")
+	if locksDiags.HasErrors() {
+		checks.fail("lock file", "%s", locksDiags.Err())
+	} else {
+		c.checkProviders(ctx, checks, locks, providers)
+	}
+
+	view.Summary(checks.failed)
+	if checks.failed != 0 {
+		return 1
+	}
+	return 0
+}
+
+// doctorHosts returns the hosts to check service discovery for: the default
+// registry, the origin registries of the providers in the dependency lock
+// file, and the hosts that have credentials configured.
+func (c *DoctorCommand) doctorHosts(providers map[addrs.Provider]*depsfile.ProviderLock) []svchost.Hostname {
+	hosts := map[svchost.Hostname]struct{}{
+		addrs.DefaultProviderRegistryHost: {},
+	}
+	for addr := range providers {
+		hosts[addr.Hostname] = struct{}{}
+	}
+	if creds, ok := c.Services.CredentialsSource().(*cliconfig.CredentialsSource); ok {
+		for _, host := range creds.ConfiguredHosts() {
+			hosts[host] = struct{}{}
+		}
+	}
+	ret := make([]svchost.Hostname, 0, len(hosts))
+	for host := range hosts {
+		ret = append(ret, host)
+	}
+	slices.Sort(ret)
+	return ret
+}
+
+func (c *DoctorCommand) checkRegistries(ctx context.Context, checks *doctorChecks, providers map[addrs.Provider]*depsfile.ProviderLock) {
+	for _, host := range c.doctorHosts(providers) {
+		disco, err := c.Services.Discover(ctx, host)
+		if err != nil {
+			checks.fail(host.ForDisplay(), "service discovery failed: %s; run \"tofu debug connectivity %s\" for details", err, host.ForDisplay())
+			continue
+		}
+		var provided []string
+		for _, id := range doctorServices {
+			if _, err := disco.ServiceURL(id); err == nil {
+				provided = append(provided, id)
+			}
+		}
+		if len(provided) == 0 {
+			checks.fail(host.ForDisplay(), "the host does not provide any OpenTofu services")
+			continue
+		}
+		checks.pass(host.ForDisplay(), "provides %s", strings.Join(provided, ", "))
+	}
+}
+
+func (c *DoctorCommand) checkCredentials(ctx context.Context, checks *doctorChecks) {
+	creds, ok := c.Services.CredentialsSource().(*cliconfig.CredentialsSource)
+	if !ok {
+		checks.pass("credentials", "no credentials are configured")
+		return
+	}
+
+	if helperType := creds.CredentialsHelperType(); helperType != "" {
+		// Asking the helper for credentials for the default registry runs
+		// the helper, which is enough to show whether it works at all.
+		if _, err := creds.ForHost(ctx, addrs.DefaultProviderRegistryHost); err != nil {
+			checks.fail("credentials helper", "the %q credentials helper failed: %s", helperType, err)
+		} else {
+			checks.pass("credentials helper", "the %q credentials helper ran successfully", helperType)
+		}
+	}
+
+	hosts := creds.ConfiguredHosts()
+	if len(hosts) == 0 && creds.CredentialsHelperType() == "" {
+		checks.pass("credentials", "no credentials are configured")
+		return
+	}
+	for _, host := range hosts {
+		hostCreds, err := c.Services.CredentialsForHost(ctx, host)
+		switch {
+		case err != nil:
+			checks.fail(host.ForDisplay(), "failed to read the credentials: %s", err)
+			continue
+		case hostCreds == nil:
+			checks.fail(host.ForDisplay(), "the credentials are empty")
+			continue
+		}
+
+		// Only hosts with the tfe.v2 API have an endpoint we can use to
+		// check whether the credentials are accepted.
+		disco, err := c.Services.Discover(ctx, host)
+		if err != nil {
+			checks.pass(host.ForDisplay(), "found credentials, but could not verify them because service discovery failed")
+			continue
+		}
+		apiURL, err := disco.ServiceURL("tfe.v2")
+		if err != nil {
+			checks.pass(host.ForDisplay(), "found credentials; the host has no API to verify them with")
+			continue
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, apiURL.JoinPath("account", "details").String(), nil)
+		if err != nil {
+			checks.fail(host.ForDisplay(), "failed to verify the credentials: %s", err)
+			continue
+		}
+		hostCreds.PrepareRequest(req)
+		resp, err := httpclient.New(ctx).Do(req)
+		if err != nil {
+			checks.fail(host.ForDisplay(), "failed to verify the credentials: %s", err)
+			continue
+		}
+		resp.Body.Close()
+		switch {
+		case resp.StatusCode == http.StatusOK:
+			checks.pass(host.ForDisplay(), "the host accepted the credentials")
+		case resp.StatusCode == http.StatusUnauthorized:
+			checks.fail(host.ForDisplay(), "the host rejected the credentials; run \"tofu login %s\" to replace them", host.ForDisplay())
+		default:
+			checks.fail(host.ForDisplay(), "failed to verify the credentials: the host returned %s", resp.Status)
+		}
+	}
+}
+
+func (c *DoctorCommand) checkBackend(ctx context.Context, checks *doctorChecks) {
+	enc, encDiags := c.Encryption(ctx)
+	if encDiags.HasErrors() {
+		checks.fail("encryption", "%s", encDiags.Err())
+		return
+	}
+	b, backendDiags := c.Backend(ctx, nil, enc.State())
+	if backendDiags.HasErrors() {
+		checks.fail("backend", "%s", backendDiags.Err())
+		return
+	}
+	// This command doesn't modify the state, so it can run with any version.
+	c.ignoreRemoteVersionConflict(b)
+
+	workspace, err := c.Workspace(ctx)
+	if err != nil {
+		checks.fail("state", "failed to select the workspace: %s", err)
+		return
+	}
+	stateMgr, err := b.StateMgr(ctx, workspace)
+	if err != nil {
+		checks.fail("state", "failed to access the state of the workspace %q: %s", workspace, err)
+		return
+	}
+	if err := stateMgr.RefreshState(ctx); err != nil {
+		checks.fail("state", "failed to read the state of the workspace %q: %s", workspace, err)
+		return
+	}
+	if stateMgr.State() == nil {
+		// Taking a lock on a workspace without a state can create the
+		// state, so we don't check locking until there is one.
+		checks.pass("state", "the workspace %q has no state yet", workspace)
+		return
+	}
+	checks.pass("state", "read the state of the workspace %q", workspace)
+
+	locker, ok := stateMgr.(statemgr.Locker)
+	if !ok {
+		checks.pass("locking", "the backend doesn't support state locking")
+		return
+	}
+	if optional, ok := locker.(statemgr.OptionalLocker); ok && !optional.IsLockingEnabled() {
+		checks.pass("locking", "state locking is disabled in the backend configuration")
+		return
+	}
+	info := statemgr.NewLockInfo()
+	info.Operation = "doctor"
+	id, err := locker.Lock(ctx, info)
+	if err != nil {
+		checks.fail("locking", "failed to lock the state: %s", err)
+		return
+	}
+	if err := locker.Unlock(ctx, id); err != nil {
+		checks.fail("locking", "locked the state, but failed to unlock it: %s; run \"tofu force-unlock %s\" to release the lock", err, id)
+		return
+	}
+	checks.pass("locking", "locked and unlocked the state")
+}
+
+func (c *DoctorCommand) checkPluginCache(checks *doctorChecks) {
+	dir := c.PluginCacheDir
+	if dir == "" {
+		checks.pass("plugin_cache_dir", "not configured")
+		return
+	}
+	info, err := os.Stat(dir)
+	switch {
+	case err != nil:
+		checks.fail("plugin_cache_dir", "%s", err)
+		return
+	case !info.IsDir():
+		checks.fail("plugin_cache_dir", "%s is not a directory", dir)
+		return
+	}
+	f, err := os.CreateTemp(dir, ".tofu-doctor-*")
+	if err != nil {
+		checks.fail("plugin_cache_dir", "%s is not writable: %s", dir, err)
+		return
+	}
+	f.Close()
+	os.Remove(f.Name())
+	checks.pass("plugin_cache_dir", "%s is writable", dir)
+}
+
+func (c *DoctorCommand) checkProviders(ctx context.Context, checks *doctorChecks, locks *depsfile.Locks, providers map[addrs.Provider]*depsfile.ProviderLock) {
+	if len(providers) == 0 {
+		checks.pass("providers", "the dependency lock file has no providers")
+		return
+	}
+	addrsList := make([]addrs.Provider, 0, len(providers))
+	for addr := range providers {
+		addrsList = append(addrsList, addr)
+	}
+	slices.SortFunc(addrsList, func(a, b addrs.Provider) int {
+		return strings.Compare(a.String(), b.String())
+	})
+
+	cacheDir := providercache.NewDir(c.WorkingDir.ProviderLocalCacheDir())
+	for _, addr := range addrsList {
+		if locks.ProviderIsOverridden(addr) {
+			checks.pass(addr.ForDisplay(), "overridden by the CLI configuration or the test harness")
+			continue
+		}
+		version := providers[addr].Version()
+		cached := cacheDir.ProviderVersion(addr, version)
+		if cached == nil {
+			checks.fail(addr.ForDisplay(), "version %s is not installed; run \"tofu init\" to install it", version)
+			continue
+		}
+		exe, err := cached.ExecutableFile()
+		if err != nil {
+			checks.fail(addr.ForDisplay(), "%s", err)
+			continue
+		}
+		if err := checkProviderExecutable(ctx, exe); err != nil {
+			checks.fail(addr.ForDisplay(), "%s", err)
+			continue
+		}
+		checks.pass(addr.ForDisplay(), "version %s can be executed", version)
+	}
+}
+
+// checkProviderExecutable returns an error describing why OpenTofu can't
+// run the given provider plugin executable, if it can't.
+func checkProviderExecutable(ctx context.Context, exe string) error {
+	info, err := os.Stat(exe)
+	if err != nil {
+		return err
+	}
+	if runtime.GOOS != "windows" && info.Mode()&0o111 == 0 {
+		return fmt.Errorf("%s is not executable; run \"tofu init\" to reinstall it, or add the executable permission", exe)
+	}
+	quarantined, err := providerQuarantined(exe)
+	if err != nil {
+		return err
+	}
+	if quarantined {
+		return fmt.Errorf("%s is quarantined by macOS; remove the quarantine attribute with \"xattr -d com.apple.quarantine %s\"", exe, exe)
+	}
+
+	// Without the plugin handshake, plugins print a message explaining
+	// that they're meant to be run by OpenTofu and exit with an error, so
+	// any exit status shows that the executable can be started.
+	ctx, cancel := context.WithTimeout(ctx, providerExecTimeout)
+	defer cancel()
+	err = exec.CommandContext(ctx, exe).Run()
+	var exitErr *exec.ExitError
+	switch {
+	case ctx.Err() != nil:
+		return fmt.Errorf("%s did not exit within %s when started without the plugin handshake", exe, providerExecTimeout)
+	case err != nil && !errors.As(err, &exitErr):
+		return fmt.Errorf("failed to run %s: %w", exe, err)
+	}
+	return nil
+}
+
+// doctorChecks counts the failed checks reported to the view.
+type doctorChecks struct {
+	view   views.Doctor
+	failed int
+}
+
+func (c *doctorChecks) pass(check string, format string, args ...any) {
+	c.view.CheckResult(check, true, fmt.Sprintf(format, args...))
+}
+
+func (c *doctorChecks) fail(check string, format string, args ...any) {
+	c.failed++
+	c.view.CheckResult(check, false, fmt.Sprintf(format, args...))
+}
+
+const doctorCommandHelp = `
+Usage: tofu [global options] doctor [options]
+
+  Checks the environment OpenTofu runs in for common problems, and reports
+  the result of each check:
+
+  - Service discovery for the default registry, for the registries of the
+    providers in the dependency lock file, and for hosts with credentials.
+  - That credentials can be read, and that hosts with an API for it accept
+    them.
+  - That the state of the current workspace can be read from the backend,
+    and locked and unlocked.
+  - That the plugin cache directory, if configured, is writable.
+  - That the installed provider plugins can be executed.
+
+  The command exits with status 1 if any of the checks fail.
+
+Options:
+
+  -json              Produce output in a machine-readable JSON format,
+                     suitable for use in text editor integrations and other
+                     automated systems. Always disables color.
+
+  -no-color          If specified, output won't contain any color.
+`
@@ -0,0 +1,28 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build darwin
+
+package command
+
+import (
+	"errors"
+
+	"golang.org/x/sys/unix"
+)
+
+// providerQuarantined returns true if macOS has quarantined the given file,
+// which prevents it from being executed until the user approves it.
+func providerQuarantined(path string) (bool, error) {
+	_, err := unix.Getxattr(path, "com.apple.quarantine", nil)
+	switch {
+	case err == nil:
+		return true, nil
+	case errors.Is(err, unix.ENOATTR):
+		return false, nil
+	default:
+		return false, err
+	}
+}
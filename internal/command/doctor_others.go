@@ -0,0 +1,14 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build !darwin
+
+package command
+
+// providerQuarantined returns true if the operating system has quarantined
+// the given file. Only macOS quarantines files, so this is always false.
+func providerQuarantined(path string) (bool, error) {
+	return false, nil
+}
@@ -0,0 +1,120 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/opentofu/svchost/disco"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/command/workdir"
+	"github.com/opentofu/opentofu/internal/getproviders"
+)
+
+// testDoctorWorkingDir creates a working directory with a dependency lock
+// file selecting a single provider, installed with the given permissions.
+func testDoctorWorkingDir(t *testing.T, providerMode os.FileMode) {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake provider is a shell script")
+	}
+	testCwdTemp(t)
+
+	lockFile := `
+provider "registry.opentofu.org/hashicorp/test" {
+  version = "1.0.0"
+}
+`
+	if err := os.WriteFile(".terraform.lock.hcl", []byte(lockFile), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	pkgDir := filepath.Join(".terraform", "providers", "registry.opentofu.org", "hashicorp", "test", "1.0.0", getproviders.CurrentPlatform.String())
+	if err := os.MkdirAll(pkgDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	exe := filepath.Join(pkgDir, "terraform-provider-test")
+	if err := os.WriteFile(exe, []byte("#!/bin/sh\nexit 1\n"), providerMode); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testDoctorCommand(t *testing.T) (*DoctorCommand, func(*testing.T) string) {
+	t.Helper()
+	services := disco.New()
+	services.ForceHostServices(addrs.DefaultProviderRegistryHost, map[string]any{
+		"modules.v1":   "/v1/modules/",
+		"providers.v1": "/v1/providers/",
+	})
+	view, done := testView(t)
+	c := &DoctorCommand{
+		Meta: Meta{
+			WorkingDir: workdir.NewDir("."),
+			View:       view,
+			Services:   services,
+		},
+	}
+	return c, func(t *testing.T) string {
+		return done(t).All()
+	}
+}
+
+func TestDoctor(t *testing.T) {
+	testDoctorWorkingDir(t, 0o755)
+	cacheDir := t.TempDir()
+
+	c, done := testDoctorCommand(t)
+	c.PluginCacheDir = cacheDir
+	code := c.Run([]string{"-no-color"})
+	output := done(t)
+	if code != 0 {
+		t.Fatalf("unexpected exit code %d\n%s", code, output)
+	}
+
+	for _, want := range []string{
+		"ok    registry.opentofu.org: provides modules.v1, providers.v1",
+		"ok    credentials: no credentials are configured",
+		`ok    state: the workspace "default" has no state yet`,
+		"ok    plugin_cache_dir: " + cacheDir + " is writable",
+		"ok    hashicorp/test: version 1.0.0 can be executed",
+		"All checks passed.",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output is missing %q\n%s", want, output)
+		}
+	}
+}
+
+func TestDoctor_failures(t *testing.T) {
+	testDoctorWorkingDir(t, 0o644)
+	cacheFile := filepath.Join(t.TempDir(), "cache")
+	if err := os.WriteFile(cacheFile, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	c, done := testDoctorCommand(t)
+	c.PluginCacheDir = cacheFile
+	code := c.Run([]string{"-no-color"})
+	output := done(t)
+	if code != 1 {
+		t.Fatalf("unexpected exit code %d\n%s", code, output)
+	}
+
+	for _, want := range []string{
+		"fail  plugin_cache_dir: " + cacheFile + " is not a directory",
+		"fail  hashicorp/test: ",
+		"is not executable",
+		"2 checks failed.",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output is missing %q\n%s", want, output)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+variable "key" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+variable "run" {
+  type = string
+}
+
+resource "terraform_random_seed" "db_password" {
+  key_wo = var.key
+}
+
+ephemeral "terraform_random" "db_password" {
+  length  = 32
+  special = false
+  seed    = terraform_random_seed.db_password.encrypted_seed
+  key     = var.key
+}
+
+resource "terraform_data" "consumer" {
+  triggers_replace = var.run
+
+  provisioner "local-exec" {
+    command = "echo ${ephemeral.terraform_random.db_password.result} > result-${var.run}.txt"
+  }
+}
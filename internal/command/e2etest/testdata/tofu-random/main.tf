@@ -0,0 +1,10 @@
+ephemeral "terraform_random" "secret" {
+  length  = 32
+  special = false
+}
+
+resource "terraform_data" "consumer" {
+  provisioner "local-exec" {
+    command = "echo ${ephemeral.terraform_random.secret.result} > result.txt"
+  }
+}
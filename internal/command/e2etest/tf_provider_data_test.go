@@ -6,6 +6,8 @@
 package e2etest
 
 import (
+	"archive/zip"
+	"io"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -58,3 +60,104 @@ func TestOpenTofuProviderData(t *testing.T) {
 		t.Fatalf("input %#v does not equal output %#v\n", input, output)
 	}
 }
+
+func TestOpenTofuProviderRandom(t *testing.T) {
+	fixturePath := filepath.Join("testdata", "tofu-random")
+	tf := e2e.NewBinary(t, tofuBin, fixturePath)
+
+	_, stderr, err := tf.Run("init", "-input=false")
+	if err != nil {
+		t.Fatalf("unexpected init error: %s\nstderr:\n%s", err, stderr)
+	}
+
+	_, stderr, err = tf.Run("plan", "-out=tfplan", "-input=false")
+	if err != nil {
+		t.Fatalf("unexpected plan error: %s\nstderr:\n%s", err, stderr)
+	}
+
+	_, stderr, err = tf.Run("apply", "-input=false", "tfplan")
+	if err != nil {
+		t.Fatalf("unexpected apply error: %s\nstderr:\n%s", err, stderr)
+	}
+
+	// The provisioner writes out the generated value, which we then use to
+	// make sure that it was never written into the plan or the state.
+	raw, err := tf.ReadFile("result.txt")
+	if err != nil {
+		t.Fatalf("failed to read provisioner result: %s", err)
+	}
+	result := strings.TrimSpace(string(raw))
+	if len(result) != 32 {
+		t.Fatalf("wrong result length %d; want 32: %q", len(result), result)
+	}
+
+	planFile, err := zip.OpenReader(tf.Path("tfplan"))
+	if err != nil {
+		t.Fatalf("failed to open plan file: %s", err)
+	}
+	defer planFile.Close()
+	for _, f := range planFile.File {
+		r, err := f.Open()
+		if err != nil {
+			t.Fatalf("failed to open %s in plan file: %s", f.Name, err)
+		}
+		content, err := io.ReadAll(r)
+		r.Close()
+		if err != nil {
+			t.Fatalf("failed to read %s in plan file: %s", f.Name, err)
+		}
+		if strings.Contains(string(content), result) {
+			t.Errorf("plan file entry %s contains the generated result", f.Name)
+		}
+	}
+
+	stateJSON, err := tf.ReadFile("terraform.tfstate")
+	if err != nil {
+		t.Fatalf("failed to read state file: %s", err)
+	}
+	if strings.Contains(string(stateJSON), result) {
+		t.Errorf("state contains the generated result:\n%s", stateJSON)
+	}
+}
+
+func TestOpenTofuProviderRandomSeed(t *testing.T) {
+	fixturePath := filepath.Join("testdata", "tofu-random-seed")
+	tf := e2e.NewBinary(t, tofuBin, fixturePath)
+
+	_, stderr, err := tf.Run("init", "-input=false")
+	if err != nil {
+		t.Fatalf("unexpected init error: %s\nstderr:\n%s", err, stderr)
+	}
+
+	// The seed is created by the first apply, which runs the provisioner
+	// once, and then the second apply replaces terraform_data.consumer to
+	// run the provisioner again with the same seed.
+	results := make([]string, 0, 2)
+	for _, run := range []string{"1", "2"} {
+		_, stderr, err = tf.Run("apply", "-auto-approve", "-input=false", "-var=key=hunter2", "-var=run="+run)
+		if err != nil {
+			t.Fatalf("unexpected apply error: %s\nstderr:\n%s", err, stderr)
+		}
+		raw, err := tf.ReadFile("result-" + run + ".txt")
+		if err != nil {
+			t.Fatalf("failed to read provisioner result: %s", err)
+		}
+		results = append(results, strings.TrimSpace(string(raw)))
+	}
+	if len(results[0]) != 32 {
+		t.Fatalf("wrong result length %d; want 32: %q", len(results[0]), results[0])
+	}
+	if results[0] != results[1] {
+		t.Errorf("result changed between runs with the same seed: %q, then %q", results[0], results[1])
+	}
+
+	stateJSON, err := tf.ReadFile("terraform.tfstate")
+	if err != nil {
+		t.Fatalf("failed to read state file: %s", err)
+	}
+	for _, secret := range []string{results[0], "hunter2"} {
+		if strings.Contains(string(stateJSON), secret) {
+			t.Errorf("state contains %q:\n%s", secret, stateJSON)
+		}
+	}
+}
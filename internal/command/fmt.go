@@ -14,9 +14,11 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclparse"
 	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/hashicorp/hcl/v2/hclwrite"
 	"github.com/mitchellh/cli"
@@ -41,6 +43,10 @@ var (
 type FmtCommand struct {
 	Meta
 	input io.Reader // STDIN if nil
+
+	// declaredVariables caches the names of the input variables declared by
+	// the module in each directory, used to check the keys of .tfvars files.
+	declaredVariables map[string]map[string]bool
 }
 
 func (c *FmtCommand) Run(rawArgs []string) int {
@@ -196,13 +202,20 @@ func (c *FmtCommand) processFile(path string, r io.Reader, w io.Writer, args arg
 	// File must be parseable as HCL native syntax before we'll try to format
 	// it. If not, the formatter is likely to make drastic changes that would
 	// be hard for the user to undo.
-	_, syntaxDiags := hclsyntax.ParseConfig(src, path, hcl.Pos{Line: 1, Column: 1})
+	syntaxFile, syntaxDiags := hclsyntax.ParseConfig(src, path, hcl.Pos{Line: 1, Column: 1})
 	if syntaxDiags.HasErrors() {
 		diags = diags.Append(syntaxDiags)
 		return diags
 	}
 
+	if strings.HasSuffix(path, ".tfvars") {
+		diags = diags.Append(c.checkVariableValues(path, syntaxFile))
+	}
+
 	result := c.formatSourceCode(src, path)
+	if args.Sort {
+		result = c.sortSourceCode(result, path)
+	}
 
 	if !bytes.Equal(src, result) {
 		// Something was changed
@@ -341,6 +354,213 @@ func (c *FmtCommand) formatSourceCode(src []byte, filename string) []byte {
 	return f.Bytes()
 }
 
+// sortSourceCode applies the canonical ordering of the -sort option to the
+// given already-formatted source code. Variable values in .tfvars files and
+// in the "variables" blocks of test files are sorted by name, while blocks,
+// including the "run" blocks of test files, keep their original order.
+func (c *FmtCommand) sortSourceCode(src []byte, filename string) []byte {
+	f, diags := hclwrite.ParseConfig(src, filename, hcl.InitialPos)
+	if diags.HasErrors() || f == nil {
+		return src
+	}
+	syntaxFile, diags := hclsyntax.ParseConfig(src, filename, hcl.InitialPos)
+	if diags.HasErrors() {
+		return src
+	}
+	syntaxBody := syntaxFile.Body.(*hclsyntax.Body)
+
+	switch {
+	case strings.HasSuffix(filename, ".tfvars"):
+		c.sortAttributes(f.Body(), syntaxBody)
+	case strings.HasSuffix(filename, ".tftest.hcl"), strings.HasSuffix(filename, ".tofutest.hcl"):
+		// hclwrite and hclsyntax produce blocks in the same source order, so
+		// we can walk them side by side.
+		blocks, syntaxBlocks := f.Body().Blocks(), syntaxBody.Blocks
+		for i, block := range blocks {
+			switch block.Type() {
+			case "variables":
+				c.sortAttributes(block.Body(), syntaxBlocks[i].Body)
+			case "run":
+				nested, syntaxNested := block.Body().Blocks(), syntaxBlocks[i].Body.Blocks
+				for j, nestedBlock := range nested {
+					if nestedBlock.Type() == "variables" {
+						c.sortAttributes(nestedBlock.Body(), syntaxNested[j].Body)
+					}
+				}
+			}
+		}
+	default:
+		return src
+	}
+
+	return f.Bytes()
+}
+
+// sortAttributes rewrites the given body so that its attributes appear in
+// lexical order by name, keeping each attribute's own comments with it.
+//
+// Bodies that contain blocks, or comments that aren't attached to a
+// particular attribute, are left unchanged because there is no unambiguous
+// way to decide where those should go.
+func (c *FmtCommand) sortAttributes(body *hclwrite.Body, syntaxBody *hclsyntax.Body) {
+	if len(syntaxBody.Blocks) != 0 || len(syntaxBody.Attributes) < 2 {
+		return
+	}
+
+	names := make([]string, 0, len(syntaxBody.Attributes))
+	for name := range syntaxBody.Attributes {
+		names = append(names, name)
+	}
+	slices.SortFunc(names, func(a, b string) int {
+		return syntaxBody.Attributes[a].SrcRange.Start.Byte - syntaxBody.Attributes[b].SrcRange.Start.Byte
+	})
+	if slices.IsSorted(names) {
+		return
+	}
+
+	countSignificant := func(tokens hclwrite.Tokens) int {
+		n := 0
+		for _, token := range tokens {
+			if token.Type != hclsyntax.TokenNewline {
+				n++
+			}
+		}
+		return n
+	}
+	bodyTokens := body.BuildTokens(nil)
+	attrTokens := make(map[string]hclwrite.Tokens, len(names))
+	significant := 0
+	for _, name := range names {
+		tokens := body.GetAttribute(name).BuildTokens(nil)
+		if len(tokens) == 0 || !bytes.HasSuffix(tokens[len(tokens)-1].Bytes, []byte("\n")) {
+			// The last attribute in a file might not end with a newline,
+			// but it might not be last after sorting.
+			tokens = append(tokens, &hclwrite.Token{
+				Type:  hclsyntax.TokenNewline,
+				Bytes: []byte("\n"),
+			})
+		}
+		attrTokens[name] = tokens
+		significant += countSignificant(tokens)
+	}
+	if significant != countSignificant(bodyTokens) {
+		// There's something other than attributes in this body, such as a
+		// detached comment.
+		return
+	}
+
+	// The newline that begins the body of a block belongs to the body, so
+	// we must retain it.
+	var leading hclwrite.Tokens
+	for _, token := range bodyTokens {
+		if token.Type != hclsyntax.TokenNewline {
+			break
+		}
+		leading = append(leading, token)
+	}
+	if len(leading) > 1 {
+		leading = leading[:1]
+	}
+
+	slices.Sort(names)
+	body.Clear()
+	body.AppendUnstructuredTokens(leading)
+	for _, name := range names {
+		body.AppendUnstructuredTokens(attrTokens[name])
+	}
+}
+
+// checkVariableValues returns a warning for each value in the given .tfvars
+// file whose name doesn't match any input variable declared by the module in
+// the same directory. Files in directories that don't contain a module are not
+// checked.
+func (c *FmtCommand) checkVariableValues(path string, file *hcl.File) tfdiags.Diagnostics {
+	var diags tfdiags.Diagnostics
+
+	dir := filepath.Dir(path)
+	declared, ok := c.declaredVariables[dir]
+	if !ok {
+		declared = loadDeclaredVariableNames(dir)
+		if c.declaredVariables == nil {
+			c.declaredVariables = make(map[string]map[string]bool)
+		}
+		c.declaredVariables[dir] = declared
+	}
+	if declared == nil {
+		return diags
+	}
+
+	attrs, _ := file.Body.JustAttributes()
+	names := make([]string, 0, len(attrs))
+	for name := range attrs {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		if declared[name] {
+			continue
+		}
+		diags = diags.Append(&hcl.Diagnostic{
+			Severity: hcl.DiagWarning,
+			Summary:  "Value for undeclared variable",
+			Detail:   fmt.Sprintf("The module in %s does not declare a variable named %q, so this value will be ignored or rejected when the module uses this file. To use this value, add a \"variable\" block to the configuration.", dir, name),
+			Subject:  attrs[name].NameRange.Ptr(),
+		})
+	}
+	return diags
+}
+
+// loadDeclaredVariableNames returns the names of the input variables declared
+// in the configuration files in the given directory, or nil if the directory
+// doesn't contain any configuration files.
+//
+// This intentionally only looks at the names of the variable blocks, so that
+// it works even for configurations that aren't otherwise valid or that need
+// "tofu init" before they can be fully loaded.
+func loadDeclaredVariableNames(dir string) map[string]bool {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+
+	schema := &hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{
+			{Type: "variable", LabelNames: []string{"name"}},
+		},
+	}
+	parser := hclparse.NewParser()
+	var ret map[string]bool
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || configs.IsIgnoredFile(name) {
+			continue
+		}
+		var file *hcl.File
+		switch {
+		case strings.HasSuffix(name, ".tf"), strings.HasSuffix(name, ".tofu"):
+			file, _ = parser.ParseHCLFile(filepath.Join(dir, name))
+		case strings.HasSuffix(name, ".tf.json"), strings.HasSuffix(name, ".tofu.json"):
+			file, _ = parser.ParseJSONFile(filepath.Join(dir, name))
+		default:
+			continue
+		}
+		if ret == nil {
+			ret = make(map[string]bool)
+		}
+		if file == nil {
+			continue
+		}
+		content, _, _ := file.Body.PartialContent(schema)
+		if content == nil {
+			continue
+		}
+		for _, block := range content.Blocks {
+			ret[block.Labels[0]] = true
+		}
+	}
+	return ret
+}
+
 func (c *FmtCommand) formatBody(body *hclwrite.Body, inBlocks []string) {
 	attrs := body.Attributes()
 	for name, attr := range attrs {
@@ -620,6 +840,11 @@ Options:
 
   -recursive     Also process files in subdirectories. By default, only the
                  given directory (or current directory) is processed.
+
+  -sort          Sort the variable values in variables files (.tfvars), and
+                 in the "variables" blocks of testing files, alphabetically
+                 by name. Other blocks, such as "run" blocks, keep their
+                 original order.
 `
 	return strings.TrimSpace(helpText)
 }
@@ -152,6 +152,162 @@ func TestFmt(t *testing.T) {
 	}
 }
 
+func TestFmt_sort(t *testing.T) {
+	tests := map[string]struct {
+		input string
+		want  string
+	}{
+		"example.tfvars": {
+			input: `# The zone comes first
+zone = "a"
+
+# Names are
+# multi-line
+name   = "web"
+count = 2 # inline
+`,
+			want: `count = 2 # inline
+# Names are
+# multi-line
+name = "web"
+# The zone comes first
+zone = "a"
+`,
+		},
+		"sorted.tfvars": {
+			input: `a = 1
+
+b = 2
+`,
+			want: `a = 1
+
+b = 2
+`,
+		},
+		"detached_comments.tfvars": {
+			input: `b = 2
+
+# This comment isn't attached to anything
+
+a = 1
+`,
+			want: `b = 2
+
+# This comment isn't attached to anything
+
+a = 1
+`,
+		},
+		"main.tftest.hcl": {
+			input: `variables {
+  zone = "a"
+  name = "web"
+}
+
+run "second" {
+  variables {
+    zone = "b"
+    count = 3
+  }
+}
+
+run "first" {
+  command = plan
+}
+`,
+			want: `variables {
+  name = "web"
+  zone = "a"
+}
+
+run "second" {
+  variables {
+    count = 3
+    zone  = "b"
+  }
+}
+
+run "first" {
+  command = plan
+}
+`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			tempDir := t.TempDir()
+			path := filepath.Join(tempDir, name)
+			if err := os.WriteFile(path, []byte(test.input), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			view, done := testView(t)
+			c := &FmtCommand{
+				Meta: Meta{
+					WorkingDir:       workdir.NewDir("."),
+					testingOverrides: metaOverridesForProvider(testProvider()),
+					View:             view,
+				},
+			}
+			code := c.Run([]string{"-sort", path})
+			output := done(t)
+			if code != 0 {
+				t.Fatalf("fmt command was unsuccessful:\n%s", output.Stderr())
+			}
+
+			got, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.want, string(got)); diff != "" {
+				t.Errorf("wrong result\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFmt_undeclaredVariableValue(t *testing.T) {
+	tempDir := t.TempDir()
+	files := map[string]string{
+		"main.tf":          "variable \"name\" {}\n",
+		"terraform.tfvars": "name = \"web\"\nzone = \"a\"\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(tempDir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	view, done := testView(t)
+	c := &FmtCommand{
+		Meta: Meta{
+			WorkingDir:       workdir.NewDir("."),
+			testingOverrides: metaOverridesForProvider(testProvider()),
+			View:             view,
+		},
+	}
+	code := c.Run([]string{"-no-color", tempDir})
+	output := done(t)
+	if code != 0 {
+		t.Fatalf("wrong exit code %d\n%s", code, output.Stderr())
+	}
+
+	// Warnings are rendered along with the normal output.
+	got := output.Stdout()
+	for _, want := range []string{
+		"Warning: Value for undeclared variable",
+		`does not declare a variable named "zone"`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("missing %q in output\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, `named "name"`) {
+		t.Errorf("unexpected warning for declared variable\n%s", got)
+	}
+}
+
 func TestFmt_nonexist(t *testing.T) {
 	tempDir := fmtFixtureWriteDir(t)
 
@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/mitchellh/cli"
+	"github.com/opentofu/opentofu/internal/addrs"
 	"github.com/opentofu/opentofu/internal/backend"
 	"github.com/opentofu/opentofu/internal/command/arguments"
 	"github.com/opentofu/opentofu/internal/command/views"
@@ -48,6 +49,13 @@ func (c *GraphCommand) Run(rawArgs []string) int {
 	}
 	c.Meta.variableArgs = args.Vars.All()
 
+	filter, filterDiags := graphFilter(args)
+	diags = diags.Append(filterDiags)
+	if filterDiags.HasErrors() {
+		view.Diagnostics(diags)
+		return 1
+	}
+
 	// This gets the current directory as full path.
 	configPath := c.WorkingDir.NormalizePath(c.WorkingDir.RootModuleDir())
 
@@ -237,6 +245,18 @@ func (c *GraphCommand) Run(rawArgs []string) int {
 		return 1
 	}
 
+	if filter != nil {
+		g, err = tofu.FilterGraph(g, filter)
+		if err != nil {
+			view.Diagnostics(diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Invalid graph filter",
+				fmt.Sprintf("Cannot filter the graph: %s.", err),
+			)))
+			return 1
+		}
+	}
+
 	graphStr, err := tofu.GraphDot(g, &dag.DotOpts{
 		DrawCycles: args.DrawCycles,
 		MaxDepth:   args.ModuleDepth,
@@ -264,6 +284,46 @@ func (c *GraphCommand) Run(rawArgs []string) int {
 	return 0
 }
 
+// graphFilter returns the filter selected by the -module, -resource-type,
+// and -focus options, or nil if none of them are set.
+func graphFilter(args *arguments.Graph) (*tofu.GraphFilter, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+	if args.Module == "" && args.ResourceType == "" && args.Focus == "" {
+		return nil, diags
+	}
+
+	filter := &tofu.GraphFilter{
+		ResourceType: args.ResourceType,
+	}
+	if args.Module != "" {
+		module, moreDiags := addrs.ParseModuleInstanceStr(args.Module)
+		if moreDiags.HasErrors() {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Invalid module address",
+				fmt.Sprintf("The -module option must be a module address, such as module.example: %s", moreDiags.Err()),
+			))
+		}
+		// The graph is built from the configuration before module expansion,
+		// so it has a single copy of each module regardless of any instance
+		// keys in the address.
+		filter.Module = module.Module()
+	}
+	if args.Focus != "" {
+		resource, moreDiags := addrs.ParseAbsResourceInstanceStr(args.Focus)
+		if moreDiags.HasErrors() {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Invalid resource address",
+				fmt.Sprintf("The -focus option must be a resource address, such as aws_instance.example: %s", moreDiags.Err()),
+			))
+		}
+		focus := resource.ContainingResource().Config()
+		filter.Focus = &focus
+	}
+	return filter, diags
+}
+
 func (c *GraphCommand) Help() string {
 	helpText := `
 Usage: tofu [global options] graph [options]
@@ -290,6 +350,16 @@ Options:
   -module-depth=n  (deprecated) In prior versions of OpenTofu, specified the
 				   depth of modules to show in the output.
 
+  -module=addr       Only show the objects in the module with the given
+                     address, such as module.example, and in its child
+                     modules.
+
+  -resource-type=t   Only show the resources of the given type.
+
+  -focus=addr        Only show the resource with the given address, and
+                     the objects it depends on or that depend on it,
+                     directly or indirectly.
+
   -var 'foo=bar'     Set a value for one of the input variables in the root
                      module of the configuration. Use this option more than
                      once to set more than one variable.
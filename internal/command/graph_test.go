@@ -43,6 +43,87 @@ func TestGraph(t *testing.T) {
 	}
 }
 
+func TestGraph_filter(t *testing.T) {
+	tests := map[string]struct {
+		args    []string
+		want    []string
+		wantNot []string
+	}{
+		"focus": {
+			[]string{"-focus=test_instance.bar"},
+			[]string{"test_instance.bar", "test_instance.foo"},
+			[]string{"module.child"},
+		},
+		"module": {
+			[]string{"-module=module.child"},
+			[]string{"module.child.test_instance.baz"},
+			[]string{"[root] test_instance.foo", "[root] test_instance.bar"},
+		},
+		"resource type and focus": {
+			[]string{"-resource-type=test_instance", "-focus=module.child.test_instance.baz"},
+			[]string{"module.child.test_instance.baz"},
+			[]string{"test_instance.foo", "provider"},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			td := t.TempDir()
+			testCopyDir(t, testFixturePath("graph-filter"), td)
+			t.Chdir(td)
+
+			view, done := testView(t)
+			c := &GraphCommand{
+				Meta: Meta{
+					WorkingDir:       workdir.NewDir("."),
+					testingOverrides: metaOverridesForProvider(applyFixtureProvider()),
+					View:             view,
+				},
+			}
+
+			code := c.Run(test.args)
+			output := done(t)
+			if code != 0 {
+				t.Fatalf("bad: \n%s", output.Stderr())
+			}
+			stdout := output.Stdout()
+			for _, want := range test.want {
+				if !strings.Contains(stdout, want) {
+					t.Errorf("graph is missing %q\n%s", want, stdout)
+				}
+			}
+			for _, unwanted := range test.wantNot {
+				if strings.Contains(stdout, unwanted) {
+					t.Errorf("graph unexpectedly contains %q\n%s", unwanted, stdout)
+				}
+			}
+		})
+	}
+}
+
+func TestGraph_filterInvalid(t *testing.T) {
+	td := t.TempDir()
+	testCopyDir(t, testFixturePath("graph-filter"), td)
+	t.Chdir(td)
+
+	view, done := testView(t)
+	c := &GraphCommand{
+		Meta: Meta{
+			WorkingDir:       workdir.NewDir("."),
+			testingOverrides: metaOverridesForProvider(applyFixtureProvider()),
+			View:             view,
+		},
+	}
+
+	code := c.Run([]string{"-focus=test_instance.missing"})
+	output := done(t)
+	if code != 1 {
+		t.Fatalf("unexpected exit code %d\n%s", code, output.All())
+	}
+	if got, want := output.Stderr(), "the graph has no resource test_instance.missing"; !strings.Contains(got, want) {
+		t.Errorf("wrong error\ngot:  %s\nwant: %s", got, want)
+	}
+}
+
 func TestGraph_multipleArgs(t *testing.T) {
 	view, done := testView(t)
 	c := &GraphCommand{
@@ -138,6 +219,8 @@ func TestGraph_plan(t *testing.T) {
 	beConfig := cty.ObjectVal(map[string]cty.Value{
 		"path":          cty.NilVal,
 		"workspace_dir": cty.NilVal,
+		"backup_dir":    cty.NilVal,
+		"fsync":         cty.NilVal,
 	})
 	emptyConfig, err := plans.NewDynamicValue(beConfig, beConfig.Type())
 	if err != nil {
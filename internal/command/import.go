@@ -56,48 +56,34 @@ func (c *ImportCommand) Run(rawArgs []string) int {
 		}
 		return cli.RunResultHelp
 	}
-	c.configureBackendFlags(args)
 
-	// Parse the provided resource address.
-	traversalSrc := []byte(args.ResourceAddress)
-	traversal, travDiags := hclsyntax.ParseTraversalAbs(traversalSrc, "<import-address>", hcl.Pos{Line: 1, Column: 1})
-	diags = diags.Append(travDiags)
-	if travDiags.HasErrors() {
-		// NOTE: The call to registerSynthConfigSource works well with the view.Diagnostics too since the view is
-		// configured in [Meta.initConfigLoader] with a callback to get the sources when it prints the diagnostics.
-		c.registerSynthConfigSource("<import-address>", traversalSrc) // so we can include a source snippet
-		view.Diagnostics(diags)
-		view.InvalidAddressReference()
-		return 1
-	}
-	addr, addrDiags := addrs.ParseAbsResourceInstance(traversal)
-	diags = diags.Append(addrDiags)
-	if addrDiags.HasErrors() {
-		// NOTE: The call to registerSynthConfigSource works well with the view.Diagnostics too since the view is
-		// configured in [Meta.initConfigLoader] with a callback to get the sources when it prints the diagnostics.
-		c.registerSynthConfigSource("<import-address>", traversalSrc) // so we can include a source snippet
-		view.Diagnostics(diags)
-		view.InvalidAddressReference()
-		return 1
+	if !args.Discover {
+		// Discovering the existing objects only generates import blocks, so
+		// it's allowed even in read-only mode.
+		if diags := c.Meta.checkReadOnly("import"); diags.HasErrors() {
+			view.Diagnostics(diags)
+			return 1
+		}
 	}
 
-	if addr.Resource.Resource.Mode != addrs.ManagedResourceMode {
-		var what string
-		switch addr.Resource.Resource.Mode {
-		case addrs.DataResourceMode:
-			what = "a data resource"
-		case addrs.EphemeralResourceMode:
-			what = "an ephemeral resource"
-		default:
-			what = "a resource type"
+	c.configureBackendFlags(args)
+
+	var addr addrs.AbsResourceInstance
+	if args.Discover {
+		if !hclsyntax.ValidIdentifier(args.ResourceAddress) {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Invalid resource type",
+				fmt.Sprintf("%q is not a valid resource type name.", args.ResourceAddress),
+			))
+			view.Diagnostics(diags)
+			return 1
+		}
+	} else {
+		var ok bool
+		if addr, ok = c.parseResourceAddress(args.ResourceAddress, diags, view); !ok {
+			return 1
 		}
-		diags = diags.Append(tfdiags.Sourceless(
-			tfdiags.Error,
-			"Invalid target resource address",
-			fmt.Sprintf("A managed resource address is required. Importing into %s is not allowed.", what),
-		))
-		view.Diagnostics(diags)
-		return 1
 	}
 
 	if !c.dirIsConfigPath(args.ConfigPath) {
@@ -130,42 +116,7 @@ func (c *ImportCommand) Run(rawArgs []string) int {
 		return 1
 	}
 
-	// Verify that the given address points to something that exists in config.
-	// This is to reduce the risk that a typo in the resource address will
-	// import something that OpenTofu will want to immediately destroy on
-	// the next plan, and generally acts as a reassurance of user intent.
-	targetConfig := config.DescendentForInstance(addr.Module)
-	if targetConfig == nil {
-		modulePath := addr.Module.String()
-		diags = diags.Append(&hcl.Diagnostic{
-			Severity: hcl.DiagError,
-			Summary:  "Import to non-existent module",
-			Detail: fmt.Sprintf(
-				"%s is not defined in the configuration. Please add configuration for this module before importing into it.",
-				modulePath,
-			),
-		})
-		view.Diagnostics(diags)
-		return 1
-	}
-	targetMod := targetConfig.Module
-	rcs := targetMod.ManagedResources
-	var rc *configs.Resource
-	resourceRelAddr := addr.Resource.Resource
-	for _, thisRc := range rcs {
-		if resourceRelAddr.Type == thisRc.Type && resourceRelAddr.Name == thisRc.Name {
-			rc = thisRc
-			break
-		}
-	}
-	if rc == nil {
-		modulePath := addr.Module.String()
-		if modulePath == "" {
-			modulePath = "the root module"
-		}
-
-		view.Diagnostics(diags)
-		view.MissingResourceConfiguration(addr, modulePath, resourceRelAddr.Type, resourceRelAddr.Name)
+	if !args.Discover && !c.checkResourceConfig(config, addr, diags, view) {
 		return 1
 	}
 
@@ -255,6 +206,10 @@ func (c *ImportCommand) Run(rawArgs []string) int {
 		}
 	}()
 
+	if args.Discover {
+		return c.discover(ctx, lr, args, view)
+	}
+
 	// Perform the import. Note that as you can see it is possible for this
 	// API to import more than one resource at once. For now, we only allow
 	// one while we stabilize this feature.
@@ -315,6 +270,103 @@ func (c *ImportCommand) Run(rawArgs []string) int {
 	return 0
 }
 
+// parseResourceAddress parses the address to import the resource to, which
+// must be the address of a managed resource instance. If it's invalid, this
+// reports the problem in the given view, along with the given diagnostics,
+// and returns false.
+func (c *ImportCommand) parseResourceAddress(rawAddr string, diags tfdiags.Diagnostics, view views.Import) (addrs.AbsResourceInstance, bool) {
+	// Parse the provided resource address.
+	traversalSrc := []byte(rawAddr)
+	traversal, travDiags := hclsyntax.ParseTraversalAbs(traversalSrc, "<import-address>", hcl.Pos{Line: 1, Column: 1})
+	diags = diags.Append(travDiags)
+	if travDiags.HasErrors() {
+		// NOTE: The call to registerSynthConfigSource works well with the view.Diagnostics too since the view is
+		// configured in [Meta.initConfigLoader] with a callback to get the sources when it prints the diagnostics.
+		c.registerSynthConfigSource("<import-address>", traversalSrc) // so we can include a source snippet
+		view.Diagnostics(diags)
+		view.InvalidAddressReference()
+		return addrs.AbsResourceInstance{}, false
+	}
+	addr, addrDiags := addrs.ParseAbsResourceInstance(traversal)
+	diags = diags.Append(addrDiags)
+	if addrDiags.HasErrors() {
+		// NOTE: The call to registerSynthConfigSource works well with the view.Diagnostics too since the view is
+		// configured in [Meta.initConfigLoader] with a callback to get the sources when it prints the diagnostics.
+		c.registerSynthConfigSource("<import-address>", traversalSrc) // so we can include a source snippet
+		view.Diagnostics(diags)
+		view.InvalidAddressReference()
+		return addr, false
+	}
+
+	if addr.Resource.Resource.Mode != addrs.ManagedResourceMode {
+		var what string
+		switch addr.Resource.Resource.Mode {
+		case addrs.DataResourceMode:
+			what = "a data resource"
+		case addrs.EphemeralResourceMode:
+			what = "an ephemeral resource"
+		default:
+			what = "a resource type"
+		}
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid target resource address",
+			fmt.Sprintf("A managed resource address is required. Importing into %s is not allowed.", what),
+		))
+		view.Diagnostics(diags)
+		return addr, false
+	}
+
+	return addr, true
+}
+
+// checkResourceConfig verifies that the given address to import the resource
+// to points to something that exists in the given configuration. If it
+// doesn't, this reports the problem in the given view, along with the given
+// diagnostics, and returns false.
+func (c *ImportCommand) checkResourceConfig(config *configs.Config, addr addrs.AbsResourceInstance, diags tfdiags.Diagnostics, view views.Import) bool {
+	// Verify that the given address points to something that exists in config.
+	// This is to reduce the risk that a typo in the resource address will
+	// import something that OpenTofu will want to immediately destroy on
+	// the next plan, and generally acts as a reassurance of user intent.
+	targetConfig := config.DescendentForInstance(addr.Module)
+	if targetConfig == nil {
+		modulePath := addr.Module.String()
+		diags = diags.Append(&hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "Import to non-existent module",
+			Detail: fmt.Sprintf(
+				"%s is not defined in the configuration. Please add configuration for this module before importing into it.",
+				modulePath,
+			),
+		})
+		view.Diagnostics(diags)
+		return false
+	}
+	targetMod := targetConfig.Module
+	rcs := targetMod.ManagedResources
+	var rc *configs.Resource
+	resourceRelAddr := addr.Resource.Resource
+	for _, thisRc := range rcs {
+		if resourceRelAddr.Type == thisRc.Type && resourceRelAddr.Name == thisRc.Name {
+			rc = thisRc
+			break
+		}
+	}
+	if rc == nil {
+		modulePath := addr.Module.String()
+		if modulePath == "" {
+			modulePath = "the root module"
+		}
+
+		view.Diagnostics(diags)
+		view.MissingResourceConfiguration(addr, modulePath, resourceRelAddr.Type, resourceRelAddr.Name)
+		return false
+	}
+
+	return true
+}
+
 // configureBackendFlags is a temporary shim until we move the flags for state management to a better place
 //
 // TODO meta-refactor: remove this when the Meta fields configured here will be removed and replaced
@@ -344,6 +396,7 @@ func (c *ImportCommand) configureBackendFlags(args *arguments.Import) {
 func (c *ImportCommand) Help() string {
 	helpText := `
 Usage: tofu [global options] import [options] ADDR ID
+       tofu [global options] import -discover [options] TYPE
 
   Import existing infrastructure into your OpenTofu state.
 
@@ -362,6 +415,11 @@ Usage: tofu [global options] import [options] ADDR ID
   network requests to inspect parts of your infrastructure relevant to
   the resource being imported.
 
+  With -discover, this instead asks the provider to list the existing
+  objects of the resource type TYPE, and generates import blocks for the
+  objects you choose, without modifying the state. Only providers that
+  support listing the resources of the type can discover its objects.
+
 Options:
 
   -compact-warnings       If OpenTofu produces any warnings that are not
@@ -381,7 +439,19 @@ Options:
                           If no config files are present, they must be provided
                           via the input prompts or env vars.
 
-  -input=false            Disable interactive input prompts.
+  -discover               List the existing objects of the resource type TYPE
+                          and generate import blocks for them, instead of
+                          importing a single object into the state.
+
+  -filter=name=value      With -discover, pass a filter value to the provider
+                          for the objects to list. This flag can be set
+                          multiple times.
+
+  -input=false            Disable interactive input prompts. With -discover,
+                          import blocks are generated for all of the objects
+                          that are not managed yet.
+
+  -limit=n                With -discover, list at most n objects.
 
   -lock=false             Don't hold a state lock during the operation. This is
                           dangerous if others might concurrently run commands
@@ -391,6 +461,10 @@ Options:
 
   -no-color               If specified, output won't contain any color.
 
+  -out=path               With -discover, write the generated import blocks
+                          to a new file at the given path instead of printing
+                          them.
+
   -var 'foo=bar'          Set a variable in the OpenTofu configuration. This
                           flag can be set multiple times. This is only useful
                           with the "-config" flag.
@@ -0,0 +1,280 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/opentofu/opentofu/internal/backend"
+	"github.com/opentofu/opentofu/internal/command/arguments"
+	"github.com/opentofu/opentofu/internal/command/views"
+	"github.com/opentofu/opentofu/internal/configs"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+	"github.com/opentofu/opentofu/internal/tofu"
+)
+
+// discover implements "tofu import -discover", which asks the provider to
+// list the existing objects of a resource type and generates import blocks
+// for those that the user chooses.
+func (c *ImportCommand) discover(ctx context.Context, lr *backend.LocalRun, args *arguments.Import, view views.Import) int {
+	filters := make(map[string]cty.Value, len(args.Filters))
+	for name, value := range args.Filters {
+		filters[name] = cty.StringVal(value)
+	}
+
+	typeName := args.ResourceAddress
+	found, diags := lr.Core.DiscoverResources(ctx, lr.Config, lr.InputState, typeName, &tofu.DiscoverOpts{
+		// The LocalRun idea is designed around our primary operations, so
+		// the input variables end up represented as plan options even though
+		// this particular operation isn't really a plan.
+		SetVariables: lr.PlanOpts.SetVariables,
+		Filters:      filters,
+		Limit:        int64(args.Limit),
+	})
+	if diags.HasErrors() {
+		view.Diagnostics(diags)
+		return 1
+	}
+	view.DiscoveredResources(typeName, found)
+
+	var selected []tofu.DiscoveredResource
+	if c.InputMode() != 0 {
+		var moreDiags tfdiags.Diagnostics
+		selected, moreDiags = c.selectDiscoveredResources(ctx, found)
+		diags = diags.Append(moreDiags)
+		if moreDiags.HasErrors() {
+			view.Diagnostics(diags)
+			return 1
+		}
+	} else {
+		// Without input, we generate import blocks for all of the objects
+		// that aren't managed yet.
+		for _, r := range found {
+			if r.ManagedBy == nil {
+				selected = append(selected, r)
+			}
+		}
+	}
+	if len(selected) == 0 {
+		view.Diagnostics(diags)
+		return 0
+	}
+
+	src := discoveredImportBlocks(typeName, selected, lr.Config)
+	if args.OutPath != "" {
+		if err := writeDiscoveredImportBlocks(args.OutPath, src); err != nil {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Failed to write import blocks",
+				fmt.Sprintf("OpenTofu could not write the generated import blocks to %s: %s.", args.OutPath, err),
+			))
+			view.Diagnostics(diags)
+			return 1
+		}
+	}
+	view.ImportBlocksGenerated(src, args.OutPath)
+	view.Diagnostics(diags)
+	return 0
+}
+
+// selectDiscoveredResources asks the user which of the given discovered
+// objects to generate import blocks for.
+func (c *ImportCommand) selectDiscoveredResources(ctx context.Context, found []tofu.DiscoveredResource) ([]tofu.DiscoveredResource, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+	var unmanaged int
+	for _, r := range found {
+		if r.ManagedBy == nil {
+			unmanaged++
+		}
+	}
+	if unmanaged == 0 {
+		return nil, diags
+	}
+
+	answer, err := c.UIInput().Input(ctx, &tofu.InputOpts{
+		Id:          "discover",
+		Query:       "Which objects do you want to import?",
+		Description: `Enter the numbers of the objects to generate import blocks for, separated by commas, or "all" for all of the objects that are not managed yet. Leave empty to generate none.`,
+	})
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to read the objects to import",
+			fmt.Sprintf("OpenTofu couldn't read which objects to import: %s.", err),
+		))
+		return nil, diags
+	}
+
+	indexes, err := parseDiscoverSelection(answer, len(found))
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid selection",
+			fmt.Sprintf("%s. Enter the numbers shown for the objects, such as \"1,3-5\", or \"all\".", err),
+		))
+		return nil, diags
+	}
+
+	var ret []tofu.DiscoveredResource
+	if len(indexes) == 1 && indexes[0] < 0 {
+		for _, r := range found {
+			if r.ManagedBy == nil {
+				ret = append(ret, r)
+			}
+		}
+		return ret, diags
+	}
+	for _, i := range indexes {
+		r := found[i]
+		if r.ManagedBy != nil {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Object is already managed",
+				fmt.Sprintf("Object %d, %q, is already managed by %s, so it cannot be imported again.", i+1, r.DisplayName, r.ManagedBy),
+			))
+			continue
+		}
+		ret = append(ret, r)
+	}
+	return ret, diags
+}
+
+// parseDiscoverSelection parses the user's choice of discovered objects,
+// returning the zero-based indexes of the chosen objects in the order they
+// were given, without duplicates, or a single index of -1 if the user
+// chose all of them.
+func parseDiscoverSelection(raw string, count int) ([]int, error) {
+	raw = strings.TrimSpace(raw)
+	if strings.EqualFold(raw, "all") {
+		return []int{-1}, nil
+	}
+
+	var ret []int
+	seen := make(map[int]bool)
+	for _, part := range strings.Split(raw, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		first, last, isRange := strings.Cut(part, "-")
+		start, err := parseDiscoverIndex(first, count)
+		if err != nil {
+			return nil, err
+		}
+		end := start
+		if isRange {
+			if end, err = parseDiscoverIndex(last, count); err != nil {
+				return nil, err
+			}
+			if end < start {
+				return nil, fmt.Errorf("%q is not a valid range of objects", part)
+			}
+		}
+		for i := start; i <= end; i++ {
+			if !seen[i] {
+				seen[i] = true
+				ret = append(ret, i)
+			}
+		}
+	}
+	return ret, nil
+}
+
+func parseDiscoverIndex(raw string, count int) (int, error) {
+	raw = strings.TrimSpace(raw)
+	n, err := strconv.Atoi(raw)
+	if err != nil || n < 1 || n > count {
+		return 0, fmt.Errorf("%q is not the number of one of the %d objects", raw, count)
+	}
+	return n - 1, nil
+}
+
+// discoveredImportBlocks returns the source code of import blocks for the
+// given discovered objects of the given resource type, naming each resource
+// after the display name of its object. The names are unique among each
+// other and among the resources of the type in the root module.
+func discoveredImportBlocks(typeName string, resources []tofu.DiscoveredResource, config *configs.Config) []byte {
+	used := make(map[string]bool)
+	for _, rc := range config.Module.ManagedResources {
+		if rc.Type == typeName {
+			used[rc.Name] = true
+		}
+	}
+
+	f := hclwrite.NewEmptyFile()
+	body := f.Body()
+	for i, r := range resources {
+		name := discoveredResourceName(r.DisplayName)
+		for n := 2; used[name]; n++ {
+			name = fmt.Sprintf("%s_%d", discoveredResourceName(r.DisplayName), n)
+		}
+		used[name] = true
+
+		if i > 0 {
+			body.AppendNewline()
+		}
+		body.AppendUnstructuredTokens(hclwrite.Tokens{
+			{Type: hclsyntax.TokenComment, Bytes: []byte(fmt.Sprintf("# %s\n", strings.ReplaceAll(r.DisplayName, "\n", " ")))},
+		})
+		block := body.AppendNewBlock("import", nil).Body()
+		block.SetAttributeTraversal("to", hcl.Traversal{
+			hcl.TraverseRoot{Name: typeName},
+			hcl.TraverseAttr{Name: name},
+		})
+		block.SetAttributeValue("identity", r.Identity)
+	}
+	return hclwrite.Format(f.Bytes())
+}
+
+// discoveredResourceName returns a valid resource name based on the given
+// display name of a discovered object.
+func discoveredResourceName(displayName string) string {
+	var b strings.Builder
+	underscore := false
+	for _, r := range strings.ToLower(displayName) {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '-') {
+			b.WriteRune(r)
+			underscore = false
+			continue
+		}
+		if !underscore && b.Len() > 0 {
+			b.WriteRune('_')
+			underscore = true
+		}
+	}
+	name := strings.TrimRight(b.String(), "_")
+	if name == "" {
+		return "imported"
+	}
+	if first := name[0]; first >= '0' && first <= '9' || first == '-' {
+		name = "imported_" + name
+	}
+	return name
+}
+
+// writeDiscoveredImportBlocks writes the given import blocks to a new file at
+// the given path, refusing to overwrite an existing file.
+func writeDiscoveredImportBlocks(path string, src []byte) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(src); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
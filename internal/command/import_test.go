@@ -13,6 +13,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/opentofu/opentofu/internal/command/workdir"
 	"github.com/zclconf/go-cty/cty"
 
@@ -20,6 +21,7 @@ import (
 	"github.com/opentofu/opentofu/internal/copy"
 	"github.com/opentofu/opentofu/internal/providers"
 	"github.com/opentofu/opentofu/internal/tfdiags"
+	"github.com/opentofu/opentofu/internal/tofu"
 )
 
 func TestImport(t *testing.T) {
@@ -1257,6 +1259,190 @@ func TestImport_ForEachKeyInModuleAndResourceAddr(t *testing.T) {
 	}
 }
 
+func TestImport_discover(t *testing.T) {
+	t.Chdir(testFixturePath("import-provider-implicit"))
+
+	p := testImportDiscoverProvider()
+	view, done := testView(t)
+	c := &ImportCommand{
+		Meta: Meta{
+			WorkingDir:       workdir.NewDir("."),
+			testingOverrides: metaOverridesForProvider(p),
+			View:             view,
+		},
+	}
+
+	outPath := filepath.Join(t.TempDir(), "imports.tf")
+	args := []string{
+		"-state", testTempFile(t),
+		"-input=false",
+		"-discover",
+		"-filter=prefix=web",
+		"-out", outPath,
+		"test_instance",
+	}
+	code := c.Run(args)
+	output := done(t)
+	if code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, output.Stderr())
+	}
+
+	if got, want := p.ListResourceRequest.Config.GetAttr("prefix"), cty.StringVal("web"); !got.RawEquals(want) {
+		t.Errorf("wrong prefix filter %#v; want %#v", got, want)
+	}
+	if p.ImportResourceStateCalled {
+		t.Error("ImportResourceState should not be called")
+	}
+	if got, want := output.Stdout(), "1. Web server (foo)"; !strings.Contains(got, want) {
+		t.Errorf("output doesn't list the discovered objects\ngot:\n%s\nwant: %s", got, want)
+	}
+
+	got, err := os.ReadFile(outPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `# Web server (foo)
+import {
+  to = test_instance.web_server_foo
+  identity = {
+    id = "foo"
+  }
+}
+
+# foo
+import {
+  to = test_instance.foo_2
+  identity = {
+    id = "foo-2"
+  }
+}
+`
+	if diff := cmp.Diff(want, string(got)); diff != "" {
+		t.Errorf("wrong import blocks\n%s", diff)
+	}
+
+	// The file must not be overwritten when running again.
+	view, done = testView(t)
+	c.View = view
+	if code := c.Run(args); code != 1 {
+		t.Fatalf("wrong exit code %d; want 1", code)
+	}
+	if got, want := done(t).Stderr(), "Failed to write import blocks"; !strings.Contains(got, want) {
+		t.Errorf("wrong error\ngot:\n%s\nwant: %s", got, want)
+	}
+}
+
+func TestImport_discoverInteractive(t *testing.T) {
+	t.Chdir(testFixturePath("import-provider-implicit"))
+
+	p := testImportDiscoverProvider()
+	view, done := testView(t)
+	c := &ImportCommand{
+		Meta: Meta{
+			WorkingDir:       workdir.NewDir("."),
+			testingOverrides: metaOverridesForProvider(p),
+			View:             view,
+		},
+	}
+
+	defer testInputMap(t, map[string]string{
+		"discover": "2",
+	})()
+	code := c.Run([]string{"-state", testTempFile(t), "-discover", "test_instance"})
+	output := done(t)
+	if code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, output.Stderr())
+	}
+
+	got := output.Stdout()
+	if want := `to = test_instance.foo_2`; !strings.Contains(got, want) {
+		t.Errorf("output doesn't include the chosen import block\ngot:\n%s\nwant: %s", got, want)
+	}
+	if unwanted := `test_instance.web_server_foo`; strings.Contains(got, unwanted) {
+		t.Errorf("output includes an import block that wasn't chosen\ngot:\n%s", got)
+	}
+}
+
+func TestParseDiscoverSelection(t *testing.T) {
+	tests := map[string]struct {
+		raw     string
+		want    []int
+		wantErr string
+	}{
+		"empty":      {"", nil, ""},
+		"all":        {" ALL ", []int{-1}, ""},
+		"list":       {"3, 1", []int{2, 0}, ""},
+		"range":      {"2-4,3", []int{1, 2, 3}, ""},
+		"zero":       {"0", nil, `"0" is not the number of one of the 4 objects`},
+		"too large":  {"5", nil, `"5" is not the number of one of the 4 objects`},
+		"not number": {"a", nil, `"a" is not the number of one of the 4 objects`},
+		"backwards":  {"3-2", nil, `"3-2" is not a valid range of objects`},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := parseDiscoverSelection(test.raw, 4)
+			if test.wantErr != "" {
+				if err == nil || err.Error() != test.wantErr {
+					t.Fatalf("wrong error %v; want %q", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("wrong result\n%s", diff)
+			}
+		})
+	}
+}
+
+func testImportDiscoverProvider() *tofu.MockProvider {
+	p := testProvider()
+	p.GetProviderSchemaResponse = &providers.GetProviderSchemaResponse{
+		ResourceTypes: map[string]providers.Schema{
+			"test_instance": {
+				Block: &configschema.Block{
+					Attributes: map[string]*configschema.Attribute{
+						"id": {Type: cty.String, Optional: true, Computed: true},
+					},
+				},
+				IdentitySchema: &configschema.Object{
+					Attributes: map[string]*configschema.Attribute{
+						"id": {Type: cty.String, Required: true},
+					},
+					Nesting: configschema.NestingSingle,
+				},
+			},
+		},
+		ListResources: map[string]providers.Schema{
+			"test_instance": {
+				Block: &configschema.Block{
+					Attributes: map[string]*configschema.Attribute{
+						"prefix": {Type: cty.String, Optional: true},
+					},
+				},
+			},
+		},
+	}
+	p.ListResourceResponse = &providers.ListResourceResponse{
+		Results: []providers.ListResourceResult{
+			{
+				DisplayName: "Web server (foo)",
+				Identity:    cty.ObjectVal(map[string]cty.Value{"id": cty.StringVal("foo")}),
+			},
+			{
+				// The fixture already declares test_instance.foo, so the
+				// generated name must not conflict with it.
+				DisplayName: "foo",
+				Identity:    cty.ObjectVal(map[string]cty.Value{"id": cty.StringVal("foo-2")}),
+			},
+		},
+	}
+	return p
+}
+
 const testImportStr = `
 test_instance.foo:
   ID = yay
@@ -14,6 +14,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
 	"github.com/mitchellh/cli"
 	"github.com/opentofu/opentofu/internal/command/flags"
 	"github.com/opentofu/svchost"
@@ -23,6 +24,7 @@ import (
 	"github.com/opentofu/opentofu/internal/addrs"
 	"github.com/opentofu/opentofu/internal/backend"
 	backendInit "github.com/opentofu/opentofu/internal/backend/init"
+	backendLocal "github.com/opentofu/opentofu/internal/backend/local"
 	"github.com/opentofu/opentofu/internal/cloud"
 	"github.com/opentofu/opentofu/internal/command/arguments"
 	"github.com/opentofu/opentofu/internal/command/views"
@@ -258,7 +260,7 @@ To initialize the configuration already in this working directory, omit the
 	}
 
 	if args.FlagGet {
-		modsOutput, modsAbort, modsDiags := c.getModules(ctx, path, args.TestsDirectory, rootModEarly, args.FlagUpgrade, view)
+		modsOutput, modsAbort, modsDiags := c.getModules(ctx, path, args.TestsDirectory, rootModEarly, args.FlagUpgrade, args.FlagExplainVersions, view)
 		diags = diags.Append(modsDiags)
 		if modsAbort || modsDiags.HasErrors() {
 			view.Diagnostics(diags)
@@ -324,8 +326,19 @@ To initialize the configuration already in this working directory, omit the
 		state = migratedState
 	}
 
+	if args.FlagInferProviders {
+		// In this mode we only report suggested provider requirements,
+		// leaving the working directory without any providers installed.
+		diags = diags.Append(c.inferProviders(ctx, config, args.FlagPluginPath, view))
+		view.Diagnostics(diags)
+		if diags.HasErrors() {
+			return 1
+		}
+		return 0
+	}
+
 	// Now that we have loaded all modules, check the module tree for missing providers.
-	providersOutput, providersAbort, providerDiags := c.getProviders(ctx, config, state, args.FlagUpgrade, args.FlagPluginPath, args.FlagLockfile, view)
+	providersOutput, providersAbort, providerDiags := c.getProviders(ctx, config, state, args.FlagUpgrade, args.FlagExplainVersions, args.FlagPluginPath, args.FlagLockfile, view)
 	diags = diags.Append(providerDiags)
 	if providersAbort || providerDiags.HasErrors() {
 		view.Diagnostics(diags)
@@ -345,6 +358,7 @@ To initialize the configuration already in this working directory, omit the
 	// by errors then we'll output them here so that the success message is
 	// still the final thing shown.
 	view.Diagnostics(diags)
+	view.Summary(initBackendType(rootModEarly))
 	_, isCloud := back.(*cloud.Cloud)
 	view.InitSuccess(isCloud)
 	if !c.RunningInAutomation {
@@ -356,7 +370,20 @@ To initialize the configuration already in this working directory, omit the
 	return 0
 }
 
-func (c *InitCommand) getModules(ctx context.Context, path, testsDir string, earlyRoot *configs.Module, upgrade bool, view views.Init) (output bool, abort bool, diags tfdiags.Diagnostics) {
+// initBackendType returns the type of the backend that the given root module
+// selects, for reporting in the init summary.
+func initBackendType(root *configs.Module) string {
+	switch {
+	case root.CloudConfig != nil:
+		return "cloud"
+	case root.Backend != nil:
+		return root.Backend.Type
+	default:
+		return "local"
+	}
+}
+
+func (c *InitCommand) getModules(ctx context.Context, path, testsDir string, earlyRoot *configs.Module, upgrade, explain bool, view views.Init) (output bool, abort bool, diags tfdiags.Diagnostics) {
 	testModules := false // We can also have modules buried in test files.
 	for _, file := range earlyRoot.Tests {
 		for _, run := range file.Runs {
@@ -379,6 +406,9 @@ func (c *InitCommand) getModules(ctx context.Context, path, testsDir string, ear
 	view.InitializingModules(upgrade)
 
 	hooks := view.Hooks(true)
+	if explain {
+		hooks = explainModuleVersionsHooks{ModuleInstallHooks: hooks, view: view}
+	}
 
 	installAbort, installDiags := c.installModules(ctx, path, testsDir, upgrade, false, hooks, view)
 	diags = diags.Append(installDiags)
@@ -431,6 +461,34 @@ func (c *InitCommand) initCloud(ctx context.Context, root *configs.Module, extra
 	return back, true, diags
 }
 
+// probeBackendCapabilities reports the optional features of the storage
+// service used by the given backend, if the backend is able to detect them.
+func (c *InitCommand) probeBackendCapabilities(ctx context.Context, b backend.Backend, backendType string, view views.Backend) tfdiags.Diagnostics {
+	// Non-enhanced backends are wrapped by the local backend.
+	if l, ok := b.(*backendLocal.Local); ok && l.Backend != nil {
+		b = l.Backend
+	}
+	prober, ok := b.(backend.CapabilityProber)
+	if !ok {
+		return nil
+	}
+
+	capabilities, diags := prober.ProbeCapabilities(ctx)
+	if len(capabilities) == 0 {
+		return diags
+	}
+	var supported, unsupported []string
+	for _, capability := range capabilities {
+		if capability.Supported {
+			supported = append(supported, capability.Name)
+		} else {
+			unsupported = append(unsupported, capability.Name)
+		}
+	}
+	view.BackendCapabilities(backendType, supported, unsupported)
+	return diags
+}
+
 func (c *InitCommand) initBackend(ctx context.Context, root *configs.Module, extraConfig flags.RawFlags, enc encryption.Encryption, view views.Backend) (be backend.Backend, output bool, diags tfdiags.Diagnostics) {
 	ctx, span := tracing.Tracer().Start(ctx, "Backend init")
 	_ = ctx // prevent staticcheck from complaining to avoid a maintenance hazard of having the wrong ctx in scope here
@@ -514,12 +572,17 @@ the backend configuration is present and valid.
 
 	back, backDiags := c.Backend(ctx, opts, enc.State())
 	diags = diags.Append(backDiags)
+	if backDiags.HasErrors() || backendConfig == nil {
+		return back, true, diags
+	}
+
+	diags = diags.Append(c.probeBackendCapabilities(ctx, back, backendConfig.Type, view))
 	return back, true, diags
 }
 
 // Load the complete module tree, and fetch any missing providers.
 // This method outputs its own Ui.
-func (c *InitCommand) getProviders(ctx context.Context, config *configs.Config, state *states.State, upgrade bool, pluginDirs []string, flagLockfile string, view views.Init) (output, abort bool, diags tfdiags.Diagnostics) {
+func (c *InitCommand) getProviders(ctx context.Context, config *configs.Config, state *states.State, upgrade, explain bool, pluginDirs []string, flagLockfile string, view views.Init) (output, abort bool, diags tfdiags.Diagnostics) {
 	ctx, span := tracing.Tracer().Start(ctx, "Get Providers")
 	defer span.End()
 
@@ -605,6 +668,10 @@ func (c *InitCommand) getProviders(ctx context.Context, config *configs.Config,
 	// and incomplete providers are stored here for later analysis.
 	var incompleteProviders []string
 
+	// We also track the selected version of each provider, so that we can
+	// explain the selection if requested.
+	selected := make(map[addrs.Provider]getproviders.Version)
+
 	// Because we're currently just streaming a series of events sequentially
 	// into the terminal, we're showing only a subset of the events to keep
 	// things relatively concise. Later it'd be nice to have a progress UI
@@ -644,6 +711,9 @@ func (c *InitCommand) getProviders(ctx context.Context, config *configs.Config,
 		FetchPackageBegin: func(provider addrs.Provider, version getproviders.Version, location getproviders.PackageLocation, inProviderCache bool) {
 			view.InstallingProvider(provider.ForDisplay(), version.String(), inProviderCache)
 		},
+		QueryPackagesSuccess: func(provider addrs.Provider, selectedVersion getproviders.Version) {
+			selected[provider] = selectedVersion
+		},
 		QueryPackagesFailure: func(provider addrs.Provider, err error) {
 			switch errorTy := err.(type) {
 			case getproviders.ErrProviderNotFound:
@@ -723,6 +793,25 @@ func (c *InitCommand) getProviders(ctx context.Context, config *configs.Config,
 					))
 				}
 
+			case getproviders.ErrNoMatchingVersions:
+				// When several modules constrain the provider, the terse
+				// error doesn't say which of them are in conflict.
+				if report := providerConflictReport(provider, providerVersionConstraints(config)[provider], errorTy.Available); report != "" {
+					diags = diags.Append(tfdiags.Sourceless(
+						tfdiags.Error,
+						"Conflicting provider version constraints",
+						report,
+					))
+					break
+				}
+				diags = diags.Append(tfdiags.Sourceless(
+					tfdiags.Error,
+					"Failed to resolve provider packages",
+					fmt.Sprintf("Could not resolve provider %s: %s",
+						provider.ForDisplay(), err,
+					),
+				))
+
 			case getproviders.ErrRequestCanceled:
 				// We don't attribute cancellation to any particular operation,
 				// but rather just emit a single general message about it at
@@ -922,6 +1011,9 @@ func (c *InitCommand) getProviders(ctx context.Context, config *configs.Config,
 		view.ProviderInstallationInterrupted()
 		return true, true, diags
 	}
+	if explain {
+		c.explainProviderVersions(ctx, config, reqs, previousLocks, upgrade, selected, inst.ProviderSource(), view)
+	}
 	if err != nil {
 		// The errors captured in "err" should be redundant with what we
 		// received via the InstallerEvents callbacks above, so we'll
@@ -996,6 +1088,160 @@ func (c *InitCommand) getProviders(ctx context.Context, config *configs.Config,
 	return true, false, diags
 }
 
+// inferProviders is the -infer-providers alternative to getProviders. For
+// each module in the configuration that has resources relying on a provider
+// local name that isn't declared in its required_providers block, it asks the
+// provider installation source which providers that name might refer to and
+// reports a suggested required_providers block for the module.
+//
+// Names that match no provider, or more than one, are reported as warnings
+// instead so that the user can choose a source address themselves.
+func (c *InitCommand) inferProviders(ctx context.Context, config *configs.Config, pluginDirs []string, view views.Init) tfdiags.Diagnostics {
+	ctx, span := tracing.Tracer().Start(ctx, "Infer Providers")
+	defer span.End()
+
+	var diags tfdiags.Diagnostics
+
+	var source getproviders.Source
+	if len(pluginDirs) == 0 {
+		source = c.providerInstallSource()
+	} else {
+		source = c.providerCustomLocalDirectorySource(ctx, pluginDirs)
+	}
+
+	// Source addresses that are declared explicitly anywhere in the
+	// configuration are also candidates for an undeclared name of the same
+	// type, because a module elsewhere may already be using the intended
+	// provider.
+	explicit := make(map[string][]addrs.Provider)
+	for _, cfg := range config.AllModules() {
+		for _, req := range cfg.Module.ProviderRequirements.RequiredProviders {
+			if !slices.Contains(explicit[req.Type.Type], req.Type) {
+				explicit[req.Type.Type] = append(explicit[req.Type.Type], req.Type)
+			}
+		}
+	}
+
+	view.InferringProviderRequirements()
+
+	modules := config.AllModules()
+	slices.SortFunc(modules, func(a, b *configs.Config) int {
+		return strings.Compare(a.Path.String(), b.Path.String())
+	})
+
+	candidates := make(map[string][]addrs.Provider)
+	seenDirs := make(map[string]bool)
+	for _, cfg := range modules {
+		// The same module source directory can be called from more than one
+		// place, but it only needs one suggestion.
+		if seenDirs[cfg.Module.SourceDir] {
+			continue
+		}
+		seenDirs[cfg.Module.SourceDir] = true
+
+		suggested := make(map[string]addrs.Provider)
+		for _, name := range undeclaredProviderLocalNames(cfg.Module) {
+			found, ok := candidates[name]
+			if !ok {
+				var err error
+				found, err = getproviders.InferProviderCandidates(ctx, name, source)
+				if err != nil {
+					diags = diags.Append(tfdiags.Sourceless(
+						tfdiags.Warning,
+						"Failed to query provider source",
+						fmt.Sprintf("Could not determine which providers the local name %q might refer to: %s.", name, err),
+					))
+				}
+				for _, addr := range explicit[name] {
+					if !slices.Contains(found, addr) {
+						found = append(found, addr)
+					}
+				}
+				slices.SortFunc(found, func(a, b addrs.Provider) int {
+					return strings.Compare(a.String(), b.String())
+				})
+				candidates[name] = found
+			}
+
+			switch len(found) {
+			case 0:
+				diags = diags.Append(tfdiags.Sourceless(
+					tfdiags.Warning,
+					"No provider found for implied requirement",
+					fmt.Sprintf("Resources in %s use the provider local name %q, but OpenTofu could not find a matching provider. Add an entry for %q to the required_providers block in that module.", moduleDisplayName(cfg.Path), name, name),
+				))
+			case 1:
+				suggested[name] = found[0]
+			default:
+				addrStrs := make([]string, len(found))
+				for i, addr := range found {
+					addrStrs[i] = addr.ForDisplay()
+				}
+				diags = diags.Append(tfdiags.Sourceless(
+					tfdiags.Warning,
+					"Ambiguous provider requirement",
+					fmt.Sprintf("Resources in %s use the provider local name %q, which could refer to any of the following providers:\n  - %s\n\nAdd an entry for %q to the required_providers block in that module with the intended source address.", moduleDisplayName(cfg.Path), name, strings.Join(addrStrs, "\n  - "), name),
+				))
+			}
+		}
+
+		if len(suggested) != 0 {
+			view.InferredProviderRequirements(moduleDisplayName(cfg.Path), requiredProvidersBlock(suggested))
+		}
+	}
+
+	return diags
+}
+
+// undeclaredProviderLocalNames returns the sorted provider local names used
+// by resources in the given module that have no corresponding entry in the
+// module's required_providers block, and are therefore relying on OpenTofu
+// inferring a source address in the "hashicorp" namespace.
+func undeclaredProviderLocalNames(mod *configs.Module) []string {
+	var names []string
+	for _, rcs := range []map[string]*configs.Resource{mod.ManagedResources, mod.DataResources, mod.EphemeralResources} {
+		for _, rc := range rcs {
+			if rc.Provider.IsBuiltIn() {
+				continue
+			}
+			name := rc.ProviderConfigAddr().LocalName
+			if _, declared := mod.ProviderRequirements.RequiredProviders[name]; declared {
+				continue
+			}
+			if !slices.Contains(names, name) {
+				names = append(names, name)
+			}
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+// requiredProvidersBlock renders a terraform block containing a
+// required_providers block that declares the given source addresses.
+func requiredProvidersBlock(providers map[string]addrs.Provider) string {
+	f := hclwrite.NewEmptyFile()
+	reqsBody := f.Body().AppendNewBlock("terraform", nil).Body().AppendNewBlock("required_providers", nil).Body()
+	names := make([]string, 0, len(providers))
+	for name := range providers {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		reqsBody.SetAttributeValue(name, cty.ObjectVal(map[string]cty.Value{
+			"source": cty.StringVal(providers[name].ForDisplay()),
+		}))
+	}
+	return string(hclwrite.Format(f.Bytes()))
+}
+
+func moduleDisplayName(path addrs.Module) string {
+	if path.IsRoot() {
+		return "the root module"
+	}
+	return path.String()
+}
+
 // warnOnFailedImplicitProvReference returns a warn diagnostic when the downloader fails to fetch a provider that is implicitly referenced.
 // In other words, if the failed to download provider is having no required_providers entry, this function is trying to give to the user
 // more information on the source of the issue and gives also instructions on how to fix it.
@@ -1150,6 +1396,7 @@ func (c *InitCommand) configureBackendFlags(args *arguments.Backend) {
 	c.forceInitCopy = args.ForceInitCopy
 	c.reconfigure = args.Reconfigure
 	c.migrateState = args.MigrateState
+	c.bootstrapBackend = args.BootstrapBackend
 	c.Meta.ignoreRemoteVersion = args.IgnoreRemoteVersion
 	// TODO meta-refactor: unify these 2 args attributes with the state flags in arguments.extendedFlagSet
 	//  https://github.com/opentofu/opentofu/blob/db8c872defd8666618649ef7e29fa2b809adfd5e/internal/command/arguments/extended.go#L320-L321
@@ -1159,20 +1406,23 @@ func (c *InitCommand) configureBackendFlags(args *arguments.Backend) {
 
 func (c *InitCommand) AutocompleteFlags() complete.Flags {
 	return complete.Flags{
-		"-backend":        completePredictBoolean,
-		"-cloud":          completePredictBoolean,
-		"-backend-config": complete.PredictFiles("*.tfvars"), // can also be key=value, but we can't "predict" that
-		"-force-copy":     complete.PredictNothing,
-		"-from-module":    completePredictModuleSource,
-		"-get":            completePredictBoolean,
-		"-input":          completePredictBoolean,
-		"-lock":           completePredictBoolean,
-		"-lock-timeout":   complete.PredictAnything,
-		"-no-color":       complete.PredictNothing,
-		"-plugin-dir":     complete.PredictDirs(""),
-		"-reconfigure":    complete.PredictNothing,
-		"-migrate-state":  complete.PredictNothing,
-		"-upgrade":        completePredictBoolean,
+		"-backend":           completePredictBoolean,
+		"-cloud":             completePredictBoolean,
+		"-backend-config":    complete.PredictFiles("*.tfvars"), // can also be key=value, but we can't "predict" that
+		"-bootstrap-backend": complete.PredictNothing,
+		"-explain-versions":  complete.PredictNothing,
+		"-force-copy":        complete.PredictNothing,
+		"-from-module":       completePredictModuleSource,
+		"-get":               completePredictBoolean,
+		"-infer-providers":   complete.PredictNothing,
+		"-input":             completePredictBoolean,
+		"-lock":              completePredictBoolean,
+		"-lock-timeout":      complete.PredictAnything,
+		"-no-color":          complete.PredictNothing,
+		"-plugin-dir":        complete.PredictDirs(""),
+		"-reconfigure":       complete.PredictNothing,
+		"-migrate-state":     complete.PredictNothing,
+		"-upgrade":           completePredictBoolean,
 	}
 }
 
@@ -1209,6 +1459,13 @@ Options:
                           times. The backend type must be in the configuration
                           itself.
 
+  -bootstrap-backend      Create the resources that the backend needs to
+                          store state, such as a bucket, if they don't exist
+                          yet. OpenTofu lists the resources and the
+                          permissions it needs on them, and asks for
+                          confirmation before creating them. Supported by the
+                          "s3", "gcs" and "azurerm" backends.
+
   -compact-warnings       If OpenTofu produces any warnings that are not
                           accompanied by errors, show them in a more compact
                           form that includes only the summary messages.
@@ -1221,6 +1478,11 @@ Options:
                           will be performed. All locations, for all errors
                           will be listed. Disabled by default
 
+  -explain-versions       Explain how the versions of registry modules and of
+                          providers were selected, showing each version
+                          constraint and where it's declared, along with why
+                          each of the available versions was rejected.
+
   -force-copy             Suppress prompts about copying state data when
                           initializing a new state backend. This is
                           equivalent to providing a "yes" to all confirmation
@@ -1231,6 +1493,11 @@ Options:
 
   -get=false              Disable downloading modules for this configuration.
 
+  -infer-providers        Instead of installing providers, find source
+                          addresses for the providers that resources rely on
+                          without a required_providers entry, and show a
+                          suggested required_providers block for each module.
+
   -input=false            Disable interactive prompts. Note that some actions may
                           require interactive prompts and will error if input is
                           disabled.
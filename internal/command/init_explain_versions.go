@@ -0,0 +1,227 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/command/views"
+	"github.com/opentofu/opentofu/internal/command/views/json"
+	"github.com/opentofu/opentofu/internal/configs"
+	"github.com/opentofu/opentofu/internal/depsfile"
+	"github.com/opentofu/opentofu/internal/getproviders"
+	"github.com/opentofu/opentofu/internal/initwd"
+)
+
+// explainModuleVersionsHooks wraps the module installation hooks of
+// "tofu init -explain-versions" to report how the version of each registry
+// module was selected.
+type explainModuleVersionsHooks struct {
+	initwd.ModuleInstallHooks
+
+	view views.Init
+}
+
+var _ initwd.ModuleInstallHooks = explainModuleVersionsHooks{}
+
+func (h explainModuleVersionsHooks) ResolveVersion(moduleAddr string, resolution *initwd.ModuleVersionResolution) {
+	h.ModuleInstallHooks.ResolveVersion(moduleAddr, resolution)
+
+	// The module address is the path of the module call, so the constraint
+	// is declared in its parent.
+	parent := addrs.Module(strings.Split(moduleAddr, ".")).Parent()
+	r := &json.VersionResolution{
+		Kind:        "module",
+		Subject:     moduleAddr,
+		Source:      resolution.Source,
+		Constraints: []json.VersionConstraint{explainVersionConstraint(resolution.Constraint, parent, resolution.DeclRange)},
+		Candidates:  []json.VersionCandidate{},
+	}
+	for _, candidate := range resolution.Candidates {
+		r.Candidates = append(r.Candidates, json.VersionCandidate{
+			Version:  candidate.Version,
+			Rejected: candidate.Rejected,
+		})
+	}
+	if resolution.Selected != nil {
+		r.Selected = resolution.Selected.String()
+	}
+	h.view.VersionResolution(r)
+}
+
+// explainProviderVersions reports how "tofu init -explain-versions" selected
+// a version of each of the given provider requirements, or why it couldn't.
+//
+// The provider installer doesn't retain the versions that it chose from, so
+// this queries the given source again to find the candidates, and checks
+// each of them against each of the version constraints in the configuration
+// so that a rejection can be attributed to the constraint that caused it.
+func (c *InitCommand) explainProviderVersions(ctx context.Context, config *configs.Config, reqs getproviders.Requirements, locks *depsfile.Locks, upgrade bool, selected map[addrs.Provider]getproviders.Version, source getproviders.Source, view views.Init) {
+	var providers []addrs.Provider
+	for provider := range reqs {
+		if provider.IsBuiltIn() {
+			continue
+		}
+		if _, ok := c.UnmanagedProviders[provider]; ok {
+			continue
+		}
+		if _, ok := c.ProviderDevOverrides[provider]; ok {
+			continue
+		}
+		providers = append(providers, provider)
+	}
+	sort.Slice(providers, func(i, j int) bool {
+		return providers[i].String() < providers[j].String()
+	})
+
+	constraints := providerVersionConstraints(config)
+	for _, provider := range providers {
+		r := &json.VersionResolution{
+			Kind:        "provider",
+			Subject:     provider.String(),
+			Constraints: constraints[provider],
+			Candidates:  []json.VersionCandidate{},
+		}
+		if r.Constraints == nil {
+			// The provider is only required by the state.
+			r.Constraints = []json.VersionConstraint{}
+		}
+		var locked *getproviders.Version
+		if lock := locks.Provider(provider); lock != nil && !upgrade {
+			v := lock.Version()
+			locked = &v
+			r.Locked = v.String()
+		}
+		var selectedVersion *getproviders.Version
+		if v, ok := selected[provider]; ok {
+			selectedVersion = &v
+			r.Selected = v.String()
+		}
+
+		available, _, err := source.AvailableVersions(ctx, provider)
+		if err != nil {
+			// The installer has already reported this problem, so we'll just
+			// explain the provider without any candidates.
+			log.Printf("[DEBUG] init: failed to list versions of %s to explain them: %s", provider, err)
+		}
+		available.Sort()
+		acceptable := getproviders.MeetingConstraints(reqs[provider])
+		for i := len(available) - 1; i >= 0; i-- {
+			v := available[i]
+			r.Candidates = append(r.Candidates, json.VersionCandidate{
+				Version:  v.String(),
+				Rejected: providerVersionRejection(v, r.Constraints, acceptable, locked, selectedVersion),
+			})
+		}
+		view.VersionResolution(r)
+	}
+}
+
+// providerVersionRejection returns the reason that the given available
+// version of a provider wasn't selected, or an empty string if it was.
+func providerVersionRejection(v getproviders.Version, constraints []json.VersionConstraint, acceptable getproviders.VersionSet, locked, selected *getproviders.Version) string {
+	if selected != nil && v.Same(*selected) {
+		return ""
+	}
+	if v.Prerelease != "" && !acceptable.Has(v) {
+		return "it is a pre-release, which is only selected when a version constraint requests it exactly"
+	}
+
+	var unmet []string
+	for _, c := range constraints {
+		parsed, err := getproviders.ParseVersionConstraints(c.Constraint)
+		if c.Constraint == "" || err != nil {
+			// Invalid constraints are reported while loading the
+			// configuration, so there's nothing more to say about them.
+			continue
+		}
+		if !getproviders.MeetingConstraints(parsed).Has(v) {
+			unmet = append(unmet, describeVersionConstraint(c))
+		}
+	}
+	switch {
+	case len(unmet) > 0:
+		return fmt.Sprintf("does not meet %s", strings.Join(unmet, "; "))
+	case !acceptable.Has(v):
+		return "does not meet the combined version constraints"
+	case locked != nil && !v.Same(*locked):
+		return fmt.Sprintf("the dependency lock file selects %s, which is kept unless upgrading with -upgrade", *locked)
+	default:
+		return "a newer version also meets the version constraints"
+	}
+}
+
+// providerVersionConstraints returns the version constraints on each
+// provider that are declared in the given configuration, in the order of
+// the modules and files that declare them.
+func providerVersionConstraints(config *configs.Config) map[addrs.Provider][]json.VersionConstraint {
+	ret := make(map[addrs.Provider][]json.VersionConstraint)
+	config.DeepEach(func(c *configs.Config) {
+		if reqs := c.Module.ProviderRequirements; reqs != nil {
+			for _, req := range reqs.RequiredProviders {
+				rng := req.Requirement.DeclRange
+				if len(req.Requirement.Required) == 0 {
+					rng = req.DeclRange
+				}
+				ret[req.Type] = append(ret[req.Type], explainVersionConstraint(req.Requirement.Required.String(), c.Path, rng))
+			}
+		}
+		// Provider configurations can also constrain the version, although
+		// that's deprecated in favor of required_providers.
+		for _, pc := range c.Module.ProviderConfigs {
+			if len(pc.Version.Required) == 0 {
+				continue
+			}
+			provider := c.Module.ProviderForLocalConfig(addrs.LocalProviderConfig{LocalName: pc.Name})
+			ret[provider] = append(ret[provider], explainVersionConstraint(pc.Version.Required.String(), c.Path, pc.Version.DeclRange))
+		}
+	})
+
+	for _, constraints := range ret {
+		sort.Slice(constraints, func(i, j int) bool {
+			a, b := constraints[i], constraints[j]
+			switch {
+			case a.Module != b.Module:
+				return a.Module < b.Module
+			case a.Filename != b.Filename:
+				return a.Filename < b.Filename
+			default:
+				return a.Line < b.Line
+			}
+		})
+	}
+	return ret
+}
+
+func explainVersionConstraint(constraint string, module addrs.Module, rng hcl.Range) json.VersionConstraint {
+	return json.VersionConstraint{
+		Constraint: constraint,
+		Module:     module.String(),
+		Filename:   rng.Filename,
+		Line:       rng.Start.Line,
+	}
+}
+
+// describeVersionConstraint returns a description of the given constraint
+// and where it's declared, for explaining why a version was rejected.
+func describeVersionConstraint(c json.VersionConstraint) string {
+	module := c.Module
+	if module == "" {
+		module = "the root module"
+	}
+	ret := fmt.Sprintf("%q in %s", c.Constraint, module)
+	if c.Filename != "" {
+		ret += fmt.Sprintf(" (%s:%d)", c.Filename, c.Line)
+	}
+	return ret
+}
@@ -0,0 +1,121 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/command/views/json"
+	"github.com/opentofu/opentofu/internal/getproviders"
+)
+
+// providerConflictMaxSuggestions is the largest number of nearest
+// satisfiable combinations that a provider conflict report suggests.
+const providerConflictMaxSuggestions = 3
+
+// providerConflictReport describes why none of the available versions of a
+// provider meet the version constraints that the modules in the configuration
+// declare for it.
+//
+// The report lists each constraint with the newest available version that
+// meets it, followed by the nearest satisfiable combinations: the newest
+// versions that meet all but the fewest of the constraints, with the ones
+// they don't meet. It returns an empty string if fewer than two declarations
+// constrain the provider, because then there's no conflict between modules
+// to report.
+func providerConflictReport(provider addrs.Provider, constraints []json.VersionConstraint, available getproviders.VersionList) string {
+	type parsedConstraint struct {
+		decl       json.VersionConstraint
+		acceptable getproviders.VersionSet
+	}
+	var parsed []parsedConstraint
+	for _, c := range constraints {
+		vc, err := getproviders.ParseVersionConstraints(c.Constraint)
+		if c.Constraint == "" || err != nil {
+			// Invalid constraints are reported while loading the
+			// configuration, so there's nothing more to say about them.
+			continue
+		}
+		parsed = append(parsed, parsedConstraint{
+			decl:       c,
+			acceptable: getproviders.MeetingConstraints(vc),
+		})
+	}
+	if len(parsed) < 2 {
+		return ""
+	}
+
+	// Pre-releases are only selected when a constraint requests them
+	// exactly, so they're never a helpful suggestion.
+	var versions getproviders.VersionList
+	for _, v := range available {
+		if v.Prerelease == "" {
+			versions = append(versions, v)
+		}
+	}
+	versions.Sort()
+
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "The modules in this configuration require incompatible versions of provider %s, so none of its available versions meet all of their version constraints:\n", provider.ForDisplay())
+	for _, c := range parsed {
+		newest := "no available version"
+		for i := len(versions) - 1; i >= 0; i-- {
+			if c.acceptable.Has(versions[i]) {
+				newest = fmt.Sprintf("newest match %s", versions[i])
+				break
+			}
+		}
+		fmt.Fprintf(&buf, "  - %s: %s\n", describeVersionConstraint(c.decl), newest)
+	}
+
+	// Walk the versions from newest to oldest, keeping only the newest
+	// version for each distinct set of unmet constraints.
+	type combination struct {
+		version getproviders.Version
+		unmet   []int
+	}
+	var combinations []combination
+	seen := make(map[string]bool)
+	for i := len(versions) - 1; i >= 0; i-- {
+		v := versions[i]
+		var unmet []int
+		var key strings.Builder
+		for j, c := range parsed {
+			if !c.acceptable.Has(v) {
+				unmet = append(unmet, j)
+				fmt.Fprintf(&key, "%d,", j)
+			}
+		}
+		if seen[key.String()] {
+			continue
+		}
+		seen[key.String()] = true
+		combinations = append(combinations, combination{version: v, unmet: unmet})
+	}
+	sort.SliceStable(combinations, func(i, j int) bool {
+		return len(combinations[i].unmet) < len(combinations[j].unmet)
+	})
+	if len(combinations) > providerConflictMaxSuggestions {
+		combinations = combinations[:providerConflictMaxSuggestions]
+	}
+
+	if len(combinations) != 0 {
+		buf.WriteString("\nThe nearest satisfiable combinations are:\n")
+		for _, c := range combinations {
+			unmet := make([]string, len(c.unmet))
+			for i, j := range c.unmet {
+				unmet[i] = describeVersionConstraint(parsed[j].decl)
+			}
+			fmt.Fprintf(&buf, "  - %s, which meets every constraint except %s\n", c.version, strings.Join(unmet, "; "))
+		}
+	}
+
+	buf.WriteString("\nTo resolve this conflict, change the version constraints so that at least one available version meets all of them.")
+	return buf.String()
+}
@@ -199,6 +199,70 @@ func TestInit_get(t *testing.T) {
 	}
 }
 
+func TestInit_jsonSummary(t *testing.T) {
+	// Create a temporary working directory that is empty
+	td := t.TempDir()
+	testCopyDir(t, testFixturePath("init-get"), td)
+	t.Chdir(td)
+
+	runInit := func(t *testing.T) map[string]any {
+		t.Helper()
+
+		view, done := testView(t)
+		c := &InitCommand{
+			Meta: Meta{
+				WorkingDir:       workdir.NewDir("."),
+				testingOverrides: metaOverridesForProvider(testProvider()),
+				View:             view,
+			},
+		}
+		code := c.Run([]string{"-json"})
+		output := done(t)
+		if code != 0 {
+			t.Fatalf("bad: \n%s", output.All())
+		}
+
+		for _, line := range strings.Split(strings.TrimSpace(output.Stdout()), "\n") {
+			var msg map[string]any
+			if err := json.Unmarshal([]byte(line), &msg); err != nil {
+				t.Fatalf("invalid JSON output line %q: %s", line, err)
+			}
+			if msg["type"] == "init_summary" {
+				return msg["summary"].(map[string]any)
+			}
+		}
+		t.Fatalf("no init_summary message in output:\n%s", output.Stdout())
+		return nil
+	}
+
+	// The first run installs the module.
+	got := runInit(t)
+	if got["changed"] != true {
+		t.Errorf("first run should report changes: %#v", got)
+	}
+	if got["backend"] != "local" {
+		t.Errorf("wrong backend: %#v", got["backend"])
+	}
+	want := []any{
+		map[string]any{
+			"path": "foo",
+			"dir":  "foo",
+		},
+	}
+	if diff := cmp.Diff(want, got["modules"]); diff != "" {
+		t.Errorf("wrong modules\n%s", diff)
+	}
+
+	// Running again finds everything already initialized.
+	got = runInit(t)
+	if got["changed"] != false {
+		t.Errorf("second run should report no changes: %#v", got)
+	}
+	if diff := cmp.Diff([]any{}, got["modules"]); diff != "" {
+		t.Errorf("wrong modules\n%s", diff)
+	}
+}
+
 func TestInit_getUpgradeModules(t *testing.T) {
 	// Create a temporary working directory that is empty
 	td := t.TempDir()
@@ -349,7 +413,7 @@ func TestInit_backendConfigFile(t *testing.T) {
 
 		// Read our saved backend config and verify we have our settings
 		state := testDataStateRead(t, filepath.Join(workdir.DefaultDataDir, arguments.DefaultStateFilename))
-		if got, want := normalizeJSON(t, state.Backend.ConfigRaw), `{"path":"hello","workspace_dir":null}`; got != want {
+		if got, want := normalizeJSON(t, state.Backend.ConfigRaw), `{"backup_dir":null,"fsync":null,"path":"hello","workspace_dir":null}`; got != want {
 			t.Errorf("wrong config\ngot:  %s\nwant: %s", got, want)
 		}
 	})
@@ -435,7 +499,7 @@ func TestInit_backendConfigFile(t *testing.T) {
 
 		// Read our saved backend config and verify the backend config is empty
 		state := testDataStateRead(t, filepath.Join(workdir.DefaultDataDir, arguments.DefaultStateFilename))
-		if got, want := normalizeJSON(t, state.Backend.ConfigRaw), `{"path":null,"workspace_dir":null}`; got != want {
+		if got, want := normalizeJSON(t, state.Backend.ConfigRaw), `{"backup_dir":null,"fsync":null,"path":null,"workspace_dir":null}`; got != want {
 			t.Errorf("wrong config\ngot:  %s\nwant: %s", got, want)
 		}
 	})
@@ -576,7 +640,7 @@ func TestInit_backendConfigFileChange(t *testing.T) {
 
 	// Read our saved backend config and verify we have our settings
 	state := testDataStateRead(t, filepath.Join(workdir.DefaultDataDir, arguments.DefaultStateFilename))
-	if got, want := normalizeJSON(t, state.Backend.ConfigRaw), `{"path":"hello","workspace_dir":null}`; got != want {
+	if got, want := normalizeJSON(t, state.Backend.ConfigRaw), `{"backup_dir":null,"fsync":null,"path":"hello","workspace_dir":null}`; got != want {
 		t.Errorf("wrong config\ngot:  %s\nwant: %s", got, want)
 	}
 }
@@ -699,7 +763,7 @@ func TestInit_backendConfigKV(t *testing.T) {
 
 	// Read our saved backend config and verify we have our settings
 	state := testDataStateRead(t, filepath.Join(workdir.DefaultDataDir, arguments.DefaultStateFilename))
-	if got, want := normalizeJSON(t, state.Backend.ConfigRaw), `{"path":"hello","workspace_dir":null}`; got != want {
+	if got, want := normalizeJSON(t, state.Backend.ConfigRaw), `{"backup_dir":null,"fsync":null,"path":"hello","workspace_dir":null}`; got != want {
 		t.Errorf("wrong config\ngot:  %s\nwant: %s", got, want)
 	}
 }
@@ -888,7 +952,7 @@ func TestInit_backendReinitWithExtra(t *testing.T) {
 
 	// Read our saved backend config and verify we have our settings
 	state := testDataStateRead(t, filepath.Join(workdir.DefaultDataDir, arguments.DefaultStateFilename))
-	if got, want := normalizeJSON(t, state.Backend.ConfigRaw), `{"path":"hello","workspace_dir":null}`; got != want {
+	if got, want := normalizeJSON(t, state.Backend.ConfigRaw), `{"backup_dir":null,"fsync":null,"path":"hello","workspace_dir":null}`; got != want {
 		t.Errorf("wrong config\ngot:  %s\nwant: %s", got, want)
 	}
 
@@ -901,7 +965,7 @@ func TestInit_backendReinitWithExtra(t *testing.T) {
 		t.Fatalf("bad: \n%s", output.Stderr())
 	}
 	state = testDataStateRead(t, filepath.Join(workdir.DefaultDataDir, arguments.DefaultStateFilename))
-	if got, want := normalizeJSON(t, state.Backend.ConfigRaw), `{"path":"hello","workspace_dir":null}`; got != want {
+	if got, want := normalizeJSON(t, state.Backend.ConfigRaw), `{"backup_dir":null,"fsync":null,"path":"hello","workspace_dir":null}`; got != want {
 		t.Errorf("wrong config\ngot:  %s\nwant: %s", got, want)
 	}
 	if state.Backend.Hash != uint64(cHash) {
@@ -932,7 +996,7 @@ func TestInit_backendReinitConfigToExtra(t *testing.T) {
 
 	// Read our saved backend config and verify we have our settings
 	state := testDataStateRead(t, filepath.Join(workdir.DefaultDataDir, arguments.DefaultStateFilename))
-	if got, want := normalizeJSON(t, state.Backend.ConfigRaw), `{"path":"foo","workspace_dir":null}`; got != want {
+	if got, want := normalizeJSON(t, state.Backend.ConfigRaw), `{"backup_dir":null,"fsync":null,"path":"foo","workspace_dir":null}`; got != want {
 		t.Errorf("wrong config\ngot:  %s\nwant: %s", got, want)
 	}
 
@@ -962,7 +1026,7 @@ func TestInit_backendReinitConfigToExtra(t *testing.T) {
 		t.Fatalf("bad: \n%s", output.Stderr())
 	}
 	state = testDataStateRead(t, filepath.Join(workdir.DefaultDataDir, arguments.DefaultStateFilename))
-	if got, want := normalizeJSON(t, state.Backend.ConfigRaw), `{"path":"foo","workspace_dir":null}`; got != want {
+	if got, want := normalizeJSON(t, state.Backend.ConfigRaw), `{"backup_dir":null,"fsync":null,"path":"foo","workspace_dir":null}`; got != want {
 		t.Errorf("wrong config after moving to arg\ngot:  %s\nwant: %s", got, want)
 	}
 
@@ -1477,6 +1541,50 @@ func TestInit_getProvider(t *testing.T) {
 	})
 }
 
+func TestInit_explainVersions(t *testing.T) {
+	td := t.TempDir()
+	testCopyDir(t, testFixturePath("init-explain-versions"), td)
+	t.Chdir(td)
+
+	overrides := metaOverridesForProvider(testProvider())
+	providerSource, close := newMockProviderSource(t, map[string][]string{
+		"between": {"3.4.5", "2.3.4", "2.0.0-beta1", "1.2.3"},
+	})
+	defer close()
+
+	view, done := testView(t)
+	c := &InitCommand{
+		Meta: Meta{
+			WorkingDir:       workdir.NewDir("."),
+			testingOverrides: overrides,
+			View:             view,
+			ProviderSource:   providerSource,
+		},
+	}
+
+	code := c.Run([]string{"-no-color", "-backend=false", "-explain-versions"})
+	output := done(t)
+	if code != 0 {
+		t.Fatalf("bad: \n%s", output.All())
+	}
+
+	want := `
+Version selection for provider registry.opentofu.org/hashicorp/between:
+  Constraints:
+    - ">= 2.0.0" in the root module (main.tf:3)
+    - "< 3.0.0" in module.child (child/main.tf:3)
+  Candidates:
+    - 3.4.5: does not meet "< 3.0.0" in module.child (child/main.tf:3)
+    - 2.3.4: selected
+    - 2.0.0-beta1: it is a pre-release, which is only selected when a version constraint requests it exactly
+    - 1.2.3: does not meet ">= 2.0.0" in the root module (main.tf:3)
+  Selected: 2.3.4
+`
+	if got := output.Stdout(); !strings.Contains(got, want) {
+		t.Errorf("wrong output\ngot:\n%s\nwant to contain:\n%s", got, want)
+	}
+}
+
 func TestInit_getProviderSource(t *testing.T) {
 	// Create a temporary working directory that is empty
 	td := t.TempDir()
@@ -2186,6 +2294,55 @@ func TestInit_getProviderMissing(t *testing.T) {
 	}
 }
 
+func TestInit_getProviderConflict(t *testing.T) {
+	td := t.TempDir()
+	testCopyDir(t, testFixturePath("init-get-provider-conflict"), td)
+	t.Chdir(td)
+
+	providerSource, close := newMockProviderSource(t, map[string][]string{
+		"hashicorp/test": {"1.0.0", "1.2.0", "2.0.0", "2.1.0"},
+	})
+	defer close()
+
+	view, done := testView(t)
+	c := &InitCommand{
+		Meta: Meta{
+			WorkingDir:       workdir.NewDir("."),
+			testingOverrides: metaOverridesForProvider(testProvider()),
+			View:             view,
+			ProviderSource:   providerSource,
+		},
+	}
+
+	code := c.Run([]string{"-no-color"})
+	output := done(t)
+	if code == 0 {
+		t.Fatalf("expected error, got output: \n%s", output.Stdout())
+	}
+
+	got := output.Stderr()
+	want := `
+Error: Conflicting provider version constraints
+
+The modules in this configuration require incompatible versions of provider
+hashicorp/test, so none of its available versions meet all of their version
+constraints:
+  - "~> 1.0" in the root module (main.tf:3): newest match 1.2.0
+  - ">= 2.0.0" in module.child (child/main.tf:3): newest match 2.1.0
+
+The nearest satisfiable combinations are:
+  - 2.1.0, which meets every constraint except "~> 1.0" in the root module (main.tf:3)
+  - 1.2.0, which meets every constraint except ">= 2.0.0" in module.child (child/main.tf:3)
+
+To resolve this conflict, change the version constraints so that at least one
+available version meets all of them.
+
+`
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("wrong error output\n%s", diff)
+	}
+}
+
 func TestInit_checkRequiredVersion(t *testing.T) {
 	// Create a temporary working directory that is empty
 	td := t.TempDir()
@@ -3394,3 +3551,69 @@ func expectedPackageInstallPath(name, version string) string {
 		baseDir, fmt.Sprintf("registry.opentofu.org/hashicorp/%s/%s/%s", name, version, platform),
 	))
 }
+
+func TestInit_inferProviders(t *testing.T) {
+	td := t.TempDir()
+	testCopyDir(t, testFixturePath("init-infer-providers"), td)
+	t.Chdir(td)
+
+	providerSource, close := newMockProviderSource(t, map[string][]string{
+		"hashicorp/happy":    {"1.0.0"},
+		"hashicorp/declared": {"1.0.0"},
+		"acme/declared":      {"2.0.0"},
+	})
+	defer close()
+
+	view, done := testView(t)
+	m := Meta{
+		WorkingDir:       workdir.NewDir("."),
+		testingOverrides: metaOverridesForProvider(testProvider()),
+		View:             view,
+		ProviderSource:   providerSource,
+	}
+	c := &InitCommand{
+		Meta: m,
+	}
+
+	code := c.Run([]string{"-no-color", "-infer-providers"})
+	output := done(t)
+	if code != 0 {
+		t.Fatalf("unexpected failure: \n%s", output.All())
+	}
+	stdout := output.Stdout()
+
+	wantRoot := `Some resources in the root module rely on implied provider source addresses.
+Add the following to that module to declare them explicitly:
+
+terraform {
+  required_providers {
+    happy = {
+      source = "hashicorp/happy"
+    }
+  }
+}
+`
+	if !strings.Contains(stdout, wantRoot) {
+		t.Errorf("missing root module suggestion\nwant:\n%s\ngot:\n%s", wantRoot, stdout)
+	}
+	if !strings.Contains(stdout, `No provider found for implied requirement`) || !strings.Contains(stdout, `local name "nosuch"`) {
+		t.Errorf("missing warning about unknown provider\n%s", stdout)
+	}
+	// The child module's "declared" resources could refer either to the
+	// default provider or to the one the root module declares.
+	if !strings.Contains(stdout, "Ambiguous provider requirement") || !strings.Contains(stdout, "  - acme/declared\n  - hashicorp/declared") {
+		t.Errorf("missing warning about ambiguous provider\n%s", stdout)
+	}
+	if !strings.Contains(stdout, "Some resources in module.child rely on implied provider source addresses.") {
+		t.Errorf("missing child module suggestion\n%s", stdout)
+	}
+
+	// No providers are installed in this mode.
+	cacheDir := providercache.NewDir(m.WorkingDir.ProviderLocalCacheDir())
+	if got := cacheDir.AllAvailablePackages(); len(got) != 0 {
+		t.Errorf("unexpected installed providers: %#v", got)
+	}
+	if _, err := os.Stat(".terraform.lock.hcl"); !os.IsNotExist(err) {
+		t.Errorf("unexpected lock file: %v", err)
+	}
+}
@@ -422,10 +422,16 @@ func marshalModule(c *configs.Config, schemas *tofu.Schemas, addr string) (modul
 
 			var defaultValJSON []byte
 			var required bool
-			if v.Default == cty.NilVal {
+			switch {
+			case v.Default == cty.NilVal:
 				defaultValJSON = nil
 				required = true
-			} else {
+			case v.DefaultExpr != nil:
+				// The default value calls provider-defined functions and so
+				// isn't known until OpenTofu evaluates it during a walk.
+				defaultValJSON = nil
+				required = false
+			default:
 				defaultValJSON, err = ctyjson.Marshal(v.Default, v.Default.Type())
 				required = false
 				if err != nil {
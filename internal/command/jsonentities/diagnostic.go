@@ -44,6 +44,20 @@ type Diagnostic struct {
 	Range      *DiagnosticRange   `json:"range,omitempty"`
 	Snippet    *DiagnosticSnippet `json:"snippet,omitempty"`
 	Difference *jsonplan.Change   `json:"difference,omitempty"`
+
+	// Fixes are suggested edits that would resolve this diagnostic. These
+	// are populated only when explicitly requested, such as by the
+	// -fix-suggestions option of "tofu validate".
+	Fixes []DiagnosticFix `json:"fixes,omitempty"`
+
+	// UnknownExpansion describes which unknown values prevented OpenTofu from
+	// determining the instances of an object, when the diagnostic reports
+	// that its "count" or "for_each" argument is unknown.
+	UnknownExpansion *DiagnosticUnknownExpansion `json:"unknown_expansion,omitempty"`
+
+	// ProviderError holds the machine-readable details that a provider
+	// attached to the error reported by this diagnostic, if any.
+	ProviderError *DiagnosticProviderError `json:"provider_error,omitempty"`
 }
 
 // Pos represents a position in the source code.
@@ -121,6 +135,58 @@ type DiagnosticExpressionValue struct {
 	Statement string `json:"statement"`
 }
 
+// DiagnosticUnknownExpansion represents the analysis of an unknown "count" or
+// "for_each" argument, listing the unknown values it depends on and the
+// objects to apply first using the -target planning option so that the
+// argument becomes known.
+type DiagnosticUnknownExpansion struct {
+	// Address is the address of the object whose instances cannot be
+	// determined, such as "module.network".
+	Address string `json:"address"`
+
+	// Argument is either "count" or "for_each".
+	Argument string `json:"argument"`
+
+	// UnknownValues are the references that the argument depends on whose
+	// values will be known only after apply, such as
+	// "aws_instance.example.id".
+	UnknownValues []string `json:"unknown_values"`
+
+	// Targets are the addresses to pass to the -target planning option to
+	// first apply only the objects producing the unknown values.
+	Targets []string `json:"targets"`
+}
+
+// DiagnosticProviderError represents the machine-readable details that a
+// provider attached to an error, such as the reason for a failure and links to
+// documentation about how to resolve it.
+type DiagnosticProviderError struct {
+	// Status is the name of the gRPC status code of the error, such as
+	// "PermissionDenied".
+	Status string `json:"status"`
+
+	// Reason is a short identifier for the cause of the error chosen by the
+	// provider, such as "QUOTA_EXCEEDED".
+	Reason string `json:"reason,omitempty"`
+
+	// Domain identifies the service or system that reported the error.
+	Domain string `json:"domain,omitempty"`
+
+	// Metadata holds additional details about the cause of the error, such
+	// as the permission that the failed operation requires.
+	Metadata map[string]string `json:"metadata"`
+
+	// Links refer to documentation about how to resolve the error.
+	Links []DiagnosticProviderErrorLink `json:"links"`
+}
+
+// DiagnosticProviderErrorLink is a link to documentation that a provider
+// attached to an error.
+type DiagnosticProviderErrorLink struct {
+	Description string `json:"description,omitempty"`
+	URL         string `json:"url"`
+}
+
 // DiagnosticFunctionCall represents a function call whose information is
 // being included as part of a diagnostic snippet.
 type DiagnosticFunctionCall struct {
@@ -165,6 +231,8 @@ func NewDiagnostic(diag tfdiags.Diagnostic, sources map[string]*hcl.File) *Diagn
 	}
 
 	difference := newDiagnosticDifference(diag)
+	unknownExpansion := newDiagnosticUnknownExpansion(diag)
+	providerError := newDiagnosticProviderError(diag)
 
 	desc := diag.Description()
 	return &Diagnostic{
@@ -175,7 +243,57 @@ func NewDiagnostic(diag tfdiags.Diagnostic, sources map[string]*hcl.File) *Diagn
 		Range:      newDiagnosticRange(highlightRange),
 		Snippet:    snippet,
 		Difference: difference,
+
+		UnknownExpansion: unknownExpansion,
+		ProviderError:    providerError,
+	}
+}
+
+func newDiagnosticUnknownExpansion(diag tfdiags.Diagnostic) *DiagnosticUnknownExpansion {
+	expansion := tfdiags.DiagnosticUnknownExpansion(diag)
+	if expansion == nil {
+		return nil
+	}
+
+	// We always use empty arrays rather than null for the lists, as this
+	// makes consuming the JSON structure easier in most languages.
+	ret := &DiagnosticUnknownExpansion{
+		Address:       expansion.Addr,
+		Argument:      expansion.Argument,
+		UnknownValues: []string{},
+		Targets:       []string{},
 	}
+	ret.UnknownValues = append(ret.UnknownValues, expansion.UnknownValues...)
+	ret.Targets = append(ret.Targets, expansion.Targets...)
+	return ret
+}
+
+func newDiagnosticProviderError(diag tfdiags.Diagnostic) *DiagnosticProviderError {
+	providerErr := tfdiags.DiagnosticProviderError(diag)
+	if providerErr == nil {
+		return nil
+	}
+
+	// We always use an empty object and array rather than null for the
+	// metadata and links, as this makes consuming the JSON structure easier
+	// in most languages.
+	ret := &DiagnosticProviderError{
+		Status:   providerErr.Status,
+		Reason:   providerErr.Reason,
+		Domain:   providerErr.Domain,
+		Metadata: map[string]string{},
+		Links:    []DiagnosticProviderErrorLink{},
+	}
+	for k, v := range providerErr.Metadata {
+		ret.Metadata[k] = v
+	}
+	for _, link := range providerErr.Links {
+		ret.Links = append(ret.Links, DiagnosticProviderErrorLink{
+			Description: link.Description,
+			URL:         link.URL,
+		})
+	}
+	return ret
 }
 
 // prepareDiagnosticRanges takes the raw subject and context source ranges from a
@@ -0,0 +1,55 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package jsonentities
+
+import (
+	"github.com/hashicorp/hcl/v2"
+
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// DiagnosticFix is a machine-applicable edit to the configuration source
+// which would resolve the diagnostic it is attached to.
+//
+// Consumers apply a fix by replacing the bytes covered by Range with
+// Replacement. When a diagnostic has more than one fix, they are
+// alternatives and only one of them should be applied.
+type DiagnosticFix struct {
+	Range       DiagnosticRange `json:"range"`
+	Replacement string          `json:"replacement"`
+}
+
+// NewDiagnosticFixes returns suggested fixes for the given diagnostic, or nil
+// if there are none.
+//
+// The fixes come from the diagnostic's tfdiags.SuggestedFix, which OpenTofu
+// attaches to the diagnostics for which there is exactly one plausible
+// correction, such as a misspelled argument name. The sources are used to
+// reuse parts of the original source code in the replacement.
+func NewDiagnosticFixes(diag tfdiags.Diagnostic, sources map[string]*hcl.File) []DiagnosticFix {
+	fix := tfdiags.DiagnosticSuggestedFix(diag)
+	subject := diag.Source().Subject
+	if fix == nil || subject == nil {
+		return nil
+	}
+
+	replacement := fix.Prefix
+	if keep := fix.Keep; keep != nil {
+		file := sources[subject.Filename]
+		if file == nil || keep.Start.Byte < 0 || keep.End.Byte > len(file.Bytes) || keep.Start.Byte > keep.End.Byte {
+			return nil
+		}
+		replacement += string(file.Bytes[keep.Start.Byte:keep.End.Byte])
+	}
+	replacement += fix.Suffix
+
+	return []DiagnosticFix{
+		{
+			Range:       *newDiagnosticRange(subject),
+			Replacement: replacement,
+		},
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package jsonentities
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/hcl/v2"
+
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// testSuggestedFix is a diagnostic extra that carries a suggested fix.
+type testSuggestedFix tfdiags.SuggestedFix
+
+func (e testSuggestedFix) DiagnosticSuggestedFix() *tfdiags.SuggestedFix {
+	fix := tfdiags.SuggestedFix(e)
+	return &fix
+}
+
+func TestNewDiagnosticFixes(t *testing.T) {
+	src := []byte("amii = \"bar\"\n")
+	sources := map[string]*hcl.File{"test.tf": {Bytes: src}}
+	nameRange := hcl.Range{
+		Filename: "test.tf",
+		Start:    hcl.Pos{Line: 1, Column: 1, Byte: 0},
+		End:      hcl.Pos{Line: 1, Column: 5, Byte: 4},
+	}
+	exprRange := hcl.Range{
+		Filename: "test.tf",
+		Start:    hcl.Pos{Line: 1, Column: 8, Byte: 7},
+		End:      hcl.Pos{Line: 1, Column: 13, Byte: 12},
+	}
+	keep := tfdiags.SourceRangeFromHCL(exprRange)
+
+	tests := map[string]struct {
+		subject hcl.Range
+		extra   interface{}
+		want    []DiagnosticFix
+	}{
+		"no suggestion": {
+			subject: nameRange,
+		},
+		"replacement": {
+			subject: nameRange,
+			extra:   testSuggestedFix{Prefix: "ami"},
+			want: []DiagnosticFix{
+				{
+					Range: DiagnosticRange{
+						Filename: "test.tf",
+						Start:    Pos{Line: 1, Column: 1, Byte: 0},
+						End:      Pos{Line: 1, Column: 5, Byte: 4},
+					},
+					Replacement: "ami",
+				},
+			},
+		},
+		"keeps original source": {
+			subject: exprRange,
+			extra:   testSuggestedFix{Prefix: "[", Keep: &keep, Suffix: "]"},
+			want: []DiagnosticFix{
+				{
+					Range: DiagnosticRange{
+						Filename: "test.tf",
+						Start:    Pos{Line: 1, Column: 8, Byte: 7},
+						End:      Pos{Line: 1, Column: 13, Byte: 12},
+					},
+					Replacement: `["bar"]`,
+				},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			diags := tfdiags.Diagnostics{}.Append(&hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Unsupported argument",
+				Detail:   `An argument named "amii" is not expected here. Did you mean "ami"?`,
+				Subject:  &test.subject,
+				Extra:    test.extra,
+			})
+
+			got := NewDiagnosticFixes(diags[0], sources)
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("wrong fixes\n%s", diff)
+			}
+		})
+	}
+}
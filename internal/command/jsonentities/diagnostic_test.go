@@ -89,6 +89,70 @@ func TestNewDiagnostic(t *testing.T) {
 				Detail:   "Something is broken",
 			},
 		},
+		"error with unknown expansion": {
+			&hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Invalid count argument",
+				Detail:   "The count is unknown",
+				Extra: diagnosticUnknownExpansion{
+					Addr:          "module.app",
+					Argument:      "count",
+					UnknownValues: []string{"test_resource.a.id"},
+					Targets:       []string{"test_resource.a"},
+				},
+			},
+			&Diagnostic{
+				Severity: "error",
+				Summary:  "Invalid count argument",
+				Detail:   "The count is unknown",
+				UnknownExpansion: &DiagnosticUnknownExpansion{
+					Address:       "module.app",
+					Argument:      "count",
+					UnknownValues: []string{"test_resource.a.id"},
+					Targets:       []string{"test_resource.a"},
+				},
+			},
+		},
+		"error with provider error": {
+			&hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Plugin error",
+				Detail:   "Permission denied",
+				Extra: diagnosticProviderError{
+					Status: "PermissionDenied",
+					Reason: "MISSING_PERMISSION",
+					Domain: "iam.example.com",
+					Metadata: map[string]string{
+						"permission": "storage.buckets.create",
+					},
+					Links: []tfdiags.ProviderErrorLink{
+						{
+							Description: "Granting permissions",
+							URL:         "https://example.com/docs/iam",
+						},
+					},
+				},
+			},
+			&Diagnostic{
+				Severity: "error",
+				Summary:  "Plugin error",
+				Detail:   "Permission denied",
+				ProviderError: &DiagnosticProviderError{
+					Status: "PermissionDenied",
+					Reason: "MISSING_PERMISSION",
+					Domain: "iam.example.com",
+					Metadata: map[string]string{
+						"permission": "storage.buckets.create",
+					},
+					Links: []DiagnosticProviderErrorLink{
+						{
+							Description: "Granting permissions",
+							URL:         "https://example.com/docs/iam",
+						},
+					},
+				},
+			},
+		},
 		"error with source code unavailable": {
 			&hcl.Diagnostic{
 				Severity: hcl.DiagError,
@@ -1260,3 +1324,25 @@ var _ tfdiags.DiagnosticExtraBecauseConfidentialValues = diagnosticCausedBySensi
 func (e diagnosticCausedBySensitive) DiagnosticCausedByConfidentialValues() bool {
 	return bool(e)
 }
+
+// diagnosticUnknownExpansion is a testing helper for exercising our logic
+// for including the analysis of an unknown count or for_each argument.
+type diagnosticUnknownExpansion tfdiags.UnknownExpansion
+
+var _ tfdiags.DiagnosticExtraUnknownExpansion = diagnosticUnknownExpansion{}
+
+func (e diagnosticUnknownExpansion) DiagnosticUnknownExpansion() *tfdiags.UnknownExpansion {
+	ret := tfdiags.UnknownExpansion(e)
+	return &ret
+}
+
+// diagnosticProviderError is a testing helper for exercising our logic for
+// including the details that a provider attached to an error.
+type diagnosticProviderError tfdiags.ProviderError
+
+var _ tfdiags.DiagnosticExtraProviderError = diagnosticProviderError{}
+
+func (e diagnosticProviderError) DiagnosticProviderError() *tfdiags.ProviderError {
+	ret := tfdiags.ProviderError(e)
+	return &ret
+}
@@ -443,6 +443,30 @@ jsonencode(
 			},
 			expected: "0 -> (known after apply) # forces replacement",
 		},
+		"computed_create_with_causes": {
+			diff: computed.Diff{
+				Renderer: WithUnknownCauses(Unknown(computed.Diff{}), []string{"aws_eip.x.public_ip", "local.name"}),
+				Action:   plans.Create,
+			},
+			expected: "(known after apply, depends on aws_eip.x.public_ip, local.name)",
+		},
+		"computed_update_with_causes": {
+			diff: computed.Diff{
+				Renderer: WithUnknownCauses(Unknown(computed.Diff{
+					Renderer: Primitive(json.Number("0"), nil, cty.Number),
+					Action:   plans.Delete,
+				}), []string{"aws_eip.x.public_ip"}),
+				Action: plans.Update,
+			},
+			expected: "0 -> (known after apply, depends on aws_eip.x.public_ip)",
+		},
+		"primitive_with_causes": {
+			diff: computed.Diff{
+				Renderer: WithUnknownCauses(Primitive(nil, "null", cty.String), []string{"aws_eip.x.public_ip"}),
+				Action:   plans.Create,
+			},
+			expected: "\"null\"",
+		},
 		"object_created": {
 			diff: computed.Diff{
 				Renderer: Object(map[string]computed.Diff{}),
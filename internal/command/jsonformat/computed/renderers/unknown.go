@@ -7,6 +7,7 @@ package renderers
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/opentofu/opentofu/internal/command/jsonformat/computed"
 
@@ -21,18 +22,38 @@ func Unknown(before computed.Diff) computed.DiffRenderer {
 	}
 }
 
+// WithUnknownCauses annotates the given renderer with the references that
+// caused its value to be unknown, if it renders an unknown value. Any other
+// renderer is returned unchanged.
+func WithUnknownCauses(renderer computed.DiffRenderer, causes []string) computed.DiffRenderer {
+	unknown, ok := renderer.(*unknownRenderer)
+	if !ok {
+		return renderer
+	}
+	return &unknownRenderer{
+		before: unknown.before,
+		causes: causes,
+	}
+}
+
 type unknownRenderer struct {
 	NoWarningsRenderer
 
 	before computed.Diff
+	causes []string
 }
 
 func (renderer unknownRenderer) RenderHuman(diff computed.Diff, indent int, opts computed.RenderHumanOpts) string {
+	unknown := "(known after apply)"
+	if len(renderer.causes) != 0 {
+		unknown = fmt.Sprintf("(known after apply, depends on %s)", strings.Join(renderer.causes, ", "))
+	}
+
 	if diff.Action == plans.Create {
-		return fmt.Sprintf("(known after apply)%s", forcesReplacement(diff.Replace, opts))
+		return fmt.Sprintf("%s%s", unknown, forcesReplacement(diff.Replace, opts))
 	}
 
 	// Never render null suffix for children of unknown changes.
 	opts.OverrideNullSuffix = true
-	return fmt.Sprintf("%s -> (known after apply)%s", renderer.before.RenderHuman(indent, opts), forcesReplacement(diff.Replace, opts))
+	return fmt.Sprintf("%s -> %s%s", renderer.before.RenderHuman(indent, opts), unknown, forcesReplacement(diff.Replace, opts))
 }
@@ -14,7 +14,7 @@ import (
 	"github.com/opentofu/opentofu/internal/plans"
 )
 
-func precomputeDiffs(plan Plan, mode plans.Mode) diffs {
+func precomputeDiffs(plan Plan, mode plans.Mode, noise NoiseRules) diffs {
 	diffs := diffs{
 		outputs: make(map[string]computed.Diff),
 	}
@@ -49,18 +49,22 @@ func precomputeDiffs(plan Plan, mode plans.Mode) diffs {
 
 		schema := plan.getSchema(drift)
 		change := structured.FromJsonChange(drift.Change, relevantAttrs)
+		hidden := hideNoise(&change, noise.forType(drift.Type))
 		diffs.drift = append(diffs.drift, diff{
 			change: drift,
 			diff:   differ.ComputeDiffForBlock(change, schema.Block),
+			noise:  hidden,
 		})
 	}
 
 	for _, change := range plan.ResourceChanges {
 		schema := plan.getSchema(change)
 		structuredChange := structured.FromJsonChange(change.Change, attribute_path.AlwaysMatcher())
+		hidden := hideNoise(&structuredChange, noise.forType(change.Type))
 		diffs.changes = append(diffs.changes, diff{
 			change: change,
 			diff:   differ.ComputeDiffForBlock(structuredChange, schema.Block),
+			noise:  hidden,
 		})
 	}
 
@@ -97,6 +101,10 @@ func (d diffs) Empty() bool {
 type diff struct {
 	change jsonplan.ResourceChange
 	diff   computed.Diff
+
+	// noise are the paths of the attributes whose changes are hidden by the
+	// renderer's noise rules.
+	noise []string
 }
 
 func (d diff) Moved() bool {
@@ -47,6 +47,9 @@ func ComputeDiffForBlock(change structured.Change, block *jsonprovider.Block) co
 		if childChange == nil {
 			continue
 		}
+		if causes := change.UnknownCauses[key]; len(causes) != 0 {
+			childChange.Renderer = renderers.WithUnknownCauses(childChange.Renderer, causes)
+		}
 		current = collections.CompareActions(current, childChange.Action)
 		attributes[key] = *childChange
 	}
@@ -0,0 +1,157 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package jsonformat
+
+import (
+	"reflect"
+
+	"github.com/opentofu/opentofu/internal/command/jsonformat/structured"
+	"github.com/opentofu/opentofu/internal/command/jsonformat/structured/attribute_path"
+)
+
+// NoiseRules lists, for each resource type, the attributes whose changes are
+// hidden in human-readable plans because they are known to be noise. The
+// rules for the "*" resource type apply to all resource types.
+type NoiseRules map[string][]NoisyAttribute
+
+// NoisyAttribute is an attribute whose changes are hidden by [NoiseRules].
+type NoisyAttribute struct {
+	// Path describes the attribute in the plan output, such as
+	// `tags["Owner"]`.
+	Path string
+
+	// Steps are the attribute names, nested block type names, and map keys
+	// leading to the attribute. A nested block type with several blocks
+	// selects the same path within all of them.
+	Steps []string
+}
+
+func (rules NoiseRules) forType(resourceType string) []NoisyAttribute {
+	if len(rules) == 0 {
+		return nil
+	}
+	return append(rules["*"], rules[resourceType]...)
+}
+
+// hideNoise makes the change to each of the given attributes look like no
+// change at all, by replacing its planned value with its prior value, and
+// returns the paths of the attributes that had changed.
+//
+// Changes that force the resource to be replaced are never hidden, and
+// neither are changes to whether a value is sensitive.
+func hideNoise(change *structured.Change, attrs []NoisyAttribute) []string {
+	var hidden []string
+	for _, attr := range attrs {
+		v := noiseValues{
+			before:          change.Before,
+			after:           change.After,
+			unknown:         change.Unknown,
+			beforeSensitive: change.BeforeSensitive,
+			afterSensitive:  change.AfterSensitive,
+			replacePaths:    change.ReplacePaths,
+		}
+		if v.hide(attr.Steps) {
+			hidden = append(hidden, attr.Path)
+		}
+	}
+	return hidden
+}
+
+// noiseValues are the parallel parts of a [structured.Change] at the same
+// path within a resource.
+type noiseValues struct {
+	before, after                   any
+	unknown                         any
+	beforeSensitive, afterSensitive any
+	replacePaths                    attribute_path.Matcher
+}
+
+func (v noiseValues) hide(steps []string) bool {
+	if v.replacePaths.Matches() {
+		return false
+	}
+
+	// A path through a nested block type that allows several blocks, or
+	// through a list of objects, applies to each of its elements in turn,
+	// as long as the number of elements isn't changing.
+	if before, ok := v.before.([]any); ok {
+		after, ok := v.after.([]any)
+		if !ok || len(before) != len(after) {
+			return false
+		}
+		hidden := false
+		for i := range before {
+			elem := noiseValues{
+				before:          before[i],
+				after:           after[i],
+				unknown:         sliceElem(v.unknown, i),
+				beforeSensitive: sliceElem(v.beforeSensitive, i),
+				afterSensitive:  sliceElem(v.afterSensitive, i),
+				replacePaths:    v.replacePaths.GetChildWithIndex(i),
+			}
+			if elem.hide(steps) {
+				hidden = true
+			}
+		}
+		return hidden
+	}
+
+	before, ok := v.before.(map[string]any)
+	if !ok {
+		return false
+	}
+	after, ok := v.after.(map[string]any)
+	if !ok {
+		return false
+	}
+	key := steps[0]
+	if len(steps) > 1 {
+		child := noiseValues{
+			before:          before[key],
+			after:           after[key],
+			unknown:         mapElem(v.unknown, key),
+			beforeSensitive: mapElem(v.beforeSensitive, key),
+			afterSensitive:  mapElem(v.afterSensitive, key),
+			replacePaths:    v.replacePaths.GetChildWithKey(key),
+		}
+		return child.hide(steps[1:])
+	}
+
+	if v.replacePaths.GetChildWithKey(key).MatchesPartial() {
+		return false
+	}
+	if !reflect.DeepEqual(mapElem(v.beforeSensitive, key), mapElem(v.afterSensitive, key)) {
+		return false
+	}
+	unknown := mapElem(v.unknown, key)
+	if reflect.DeepEqual(before[key], after[key]) && (unknown == nil || unknown == false) {
+		return false
+	}
+
+	if value, exists := before[key]; exists {
+		after[key] = value
+	} else {
+		delete(after, key)
+	}
+	if unknown, ok := v.unknown.(map[string]any); ok {
+		delete(unknown, key)
+	}
+	return true
+}
+
+func sliceElem(v any, i int) any {
+	if s, ok := v.([]any); ok && i < len(s) {
+		return s[i]
+	}
+	return v
+}
+
+func mapElem(v any, key string) any {
+	if m, ok := v.(map[string]any); ok {
+		return m[key]
+	}
+	return v
+}
@@ -60,7 +60,7 @@ func (plan Plan) renderHuman(renderer Renderer, mode plans.Mode, opts ...plans.Q
 		return false
 	}
 
-	diffs := precomputeDiffs(plan, mode)
+	diffs := precomputeDiffs(plan, mode, renderer.Noise)
 	haveRefreshChanges := renderHumanDiffDrift(renderer, diffs, mode)
 
 	willPrintResourceChanges := false
@@ -403,6 +403,9 @@ func renderHumanDiff(renderer Renderer, diff diff, cause string) (string, bool)
 
 	var buf bytes.Buffer
 	buf.WriteString(renderer.Colorize.Color(resourceChangeComment(diff.change, action, cause)))
+	if len(diff.noise) > 0 {
+		buf.WriteString(renderer.Colorize.Color(fmt.Sprintf("  # [reset][dark_gray](noisy changes to %s hidden)[reset]\n", strings.Join(diff.noise, ", "))))
+	}
 
 	opts := computed.NewRenderHumanOpts(renderer.Colorize, renderer.ShowSensitive)
 
@@ -459,6 +462,7 @@ func resourceChangeComment(resource jsonplan.ResourceChange, action plans.Action
 		default:
 			buf.WriteString(fmt.Sprintf("[bold]  # %s[reset] must be [bold][red]replaced[reset]", dispAddr))
 		}
+		writeReplaceReason(&buf, resource)
 	case plans.Delete:
 		switch changeCause {
 		case proposedChange:
@@ -551,6 +555,7 @@ func resourceChangeComment(resource jsonplan.ResourceChange, action plans.Action
 			buf.WriteString(fmt.Sprintf("[bold]  # %s[reset] must be [bold][red]replaced[reset]", dispAddr))
 		}
 		buf.WriteString(" - [yellow]older instance will [bold]not[reset][yellow] be destroyed [reset]([bold]lifecycle.destroy = false[reset])")
+		writeReplaceReason(&buf, resource)
 
 		if len(resource.Deposed) != 0 {
 			// In the case where we partially failed to replace a resource
@@ -614,6 +619,15 @@ func resourceChangeComment(resource jsonplan.ResourceChange, action plans.Action
 	return buf.String()
 }
 
+// writeReplaceReason adds the explanation given with -replace-reason, if any,
+// to the comment for a resource instance that is being replaced by request.
+func writeReplaceReason(buf *bytes.Buffer, resource jsonplan.ResourceChange) {
+	if resource.ActionReason != jsonplan.ResourceInstanceReplaceByRequest || resource.ReplaceReason == "" {
+		return
+	}
+	buf.WriteString(fmt.Sprintf("\n  # [reset](reason: %s)", resource.ReplaceReason))
+}
+
 func resourceChangeHeader(change jsonplan.ResourceChange) string {
 	mode := "resource"
 	if change.Mode != jsonstate.ManagedResourceMode {
@@ -7443,6 +7443,156 @@ type testCase struct {
 	ExpectedOutput  string
 	PrevRunAddr     addrs.AbsResourceInstance
 	ExpectedErr     error
+	Noise           NoiseRules
+}
+
+func TestResourceChange_noise(t *testing.T) {
+	schema := &configschema.Block{
+		Attributes: map[string]*configschema.Attribute{
+			"id":          {Type: cty.String, Computed: true},
+			"ami":         {Type: cty.String, Optional: true},
+			"fingerprint": {Type: cty.String, Computed: true},
+			"labels":      {Type: cty.Map(cty.String), Optional: true},
+		},
+		BlockTypes: map[string]*configschema.NestedBlock{
+			"disk": {
+				Block: configschema.Block{
+					Attributes: map[string]*configschema.Attribute{
+						"size": {Type: cty.Number, Optional: true},
+						"etag": {Type: cty.String, Computed: true},
+					},
+				},
+				Nesting: configschema.NestingList,
+			},
+		},
+	}
+	before := cty.ObjectVal(map[string]cty.Value{
+		"id":          cty.StringVal("i-02ae66f368e8518a9"),
+		"ami":         cty.StringVal("ami-BEFORE"),
+		"fingerprint": cty.StringVal("abc"),
+		"labels": cty.MapVal(map[string]cty.Value{
+			"owner":      cty.StringVal("alice"),
+			"managed-at": cty.StringVal("2023-01-01"),
+		}),
+		"disk": cty.ListVal([]cty.Value{
+			cty.ObjectVal(map[string]cty.Value{
+				"size": cty.NumberIntVal(10),
+				"etag": cty.StringVal("1"),
+			}),
+		}),
+	})
+	noisyAfter := cty.ObjectVal(map[string]cty.Value{
+		"id":          cty.StringVal("i-02ae66f368e8518a9"),
+		"ami":         cty.StringVal("ami-BEFORE"),
+		"fingerprint": cty.UnknownVal(cty.String),
+		"labels": cty.MapVal(map[string]cty.Value{
+			"owner":      cty.StringVal("alice"),
+			"managed-at": cty.StringVal("2023-06-01"),
+		}),
+		"disk": cty.ListVal([]cty.Value{
+			cty.ObjectVal(map[string]cty.Value{
+				"size": cty.NumberIntVal(10),
+				"etag": cty.StringVal("2"),
+			}),
+		}),
+	})
+	noise := NoiseRules{
+		"test_instance": {
+			{Path: "fingerprint", Steps: []string{"fingerprint"}},
+			{Path: "disk.etag", Steps: []string{"disk", "etag"}},
+		},
+		"*": {
+			{Path: `labels["managed-at"]`, Steps: []string{"labels", "managed-at"}},
+		},
+	}
+
+	testCases := map[string]testCase{
+		"only noisy changes": {
+			Action: plans.Update,
+			Mode:   addrs.ManagedResourceMode,
+			Before: before,
+			After:  noisyAfter,
+			Schema: schema,
+			Noise:  noise,
+			ExpectedOutput: `  # test_instance.example will be updated in-place
+  # (noisy changes to labels["managed-at"], fingerprint, disk.etag hidden)
+  ~ resource "test_instance" "example" {
+        id          = "i-02ae66f368e8518a9"
+        # (3 unchanged attributes hidden)
+
+        # (1 unchanged block hidden)
+    }`,
+		},
+		"noisy and real changes": {
+			Action: plans.Update,
+			Mode:   addrs.ManagedResourceMode,
+			Before: before,
+			After: cty.ObjectVal(map[string]cty.Value{
+				"id":          cty.StringVal("i-02ae66f368e8518a9"),
+				"ami":         cty.StringVal("ami-AFTER"),
+				"fingerprint": cty.StringVal("abc"),
+				"labels": cty.MapVal(map[string]cty.Value{
+					"owner":      cty.StringVal("bob"),
+					"managed-at": cty.StringVal("2023-06-01"),
+				}),
+				"disk": cty.ListVal([]cty.Value{
+					cty.ObjectVal(map[string]cty.Value{
+						"size": cty.NumberIntVal(10),
+						"etag": cty.StringVal("1"),
+					}),
+				}),
+			}),
+			Schema: schema,
+			Noise:  noise,
+			ExpectedOutput: `  # test_instance.example will be updated in-place
+  # (noisy changes to labels["managed-at"] hidden)
+  ~ resource "test_instance" "example" {
+      ~ ami         = "ami-BEFORE" -> "ami-AFTER"
+        id          = "i-02ae66f368e8518a9"
+      ~ labels      = {
+          ~ "owner"      = "alice" -> "bob"
+            # (1 unchanged element hidden)
+        }
+        # (1 unchanged attribute hidden)
+
+        # (1 unchanged block hidden)
+    }`,
+		},
+		"noisy change forcing replacement": {
+			Action:       plans.DeleteThenCreate,
+			ActionReason: plans.ResourceInstanceReplaceBecauseCannotUpdate,
+			Mode:         addrs.ManagedResourceMode,
+			Before:       before,
+			After: cty.ObjectVal(map[string]cty.Value{
+				"id":          cty.UnknownVal(cty.String),
+				"ami":         cty.StringVal("ami-BEFORE"),
+				"fingerprint": cty.StringVal("def"),
+				"labels": cty.MapVal(map[string]cty.Value{
+					"owner":      cty.StringVal("alice"),
+					"managed-at": cty.StringVal("2023-01-01"),
+				}),
+				"disk": cty.ListVal([]cty.Value{
+					cty.ObjectVal(map[string]cty.Value{
+						"size": cty.NumberIntVal(10),
+						"etag": cty.StringVal("1"),
+					}),
+				}),
+			}),
+			RequiredReplace: cty.NewPathSet(cty.GetAttrPath("fingerprint")),
+			Schema:          schema,
+			Noise:           noise,
+			ExpectedOutput: `  # test_instance.example must be replaced
+-/+ resource "test_instance" "example" {
+      ~ fingerprint = "abc" -> "def" # forces replacement
+      ~ id          = "i-02ae66f368e8518a9" -> (known after apply)
+        # (2 unchanged attributes hidden)
+
+        # (1 unchanged block hidden)
+    }`,
+		},
+	}
+
+	runTestCases(t, testCases)
 }
 
 func runTestCases(t *testing.T, testCases map[string]testCase) {
@@ -7552,10 +7702,12 @@ func runTestCases(t *testing.T, testCases map[string]testCase) {
 			var output string
 			if len(jsonchanges) > 0 {
 				change := structured.FromJsonChange(jsonchanges[0].Change, attribute_path.AlwaysMatcher())
+				noise := hideNoise(&change, tc.Noise.forType(jsonchanges[0].Type))
 				renderer := Renderer{Colorize: color}
 				diff := diff{
 					change: jsonchanges[0],
 					diff:   differ.ComputeDiffForBlock(change, jsonschemas[jsonchanges[0].ProviderName].ResourceSchemas[jsonchanges[0].Type].Block),
+					noise:  noise,
 				}
 				output, _ = renderHumanDiff(renderer, diff, proposedChange)
 			}
@@ -7675,7 +7827,7 @@ func TestOutputChanges(t *testing.T) {
 			renderer := Renderer{Colorize: color}
 			diffs := precomputeDiffs(Plan{
 				OutputChanges: outputs,
-			}, plans.NormalMode)
+			}, plans.NormalMode, nil)
 
 			output := renderHumanDiffOutputs(renderer, diffs.outputs)
 			if output != tc.output {
@@ -7859,3 +8011,25 @@ func marshalJson(t *testing.T, data interface{}) json.RawMessage {
 	}
 	return result
 }
+
+func TestResourceChangeComment_replaceReason(t *testing.T) {
+	color := &colorstring.Colorize{Colors: colorstring.DefaultColors, Disable: true}
+	change := jsonplan.ResourceChange{
+		Address:       "aws_instance.web",
+		ActionReason:  jsonplan.ResourceInstanceReplaceByRequest,
+		ReplaceReason: "rotating credentials",
+	}
+
+	got := color.Color(resourceChangeComment(change, plans.DeleteThenCreate, proposedChange))
+	want := "  # aws_instance.web will be replaced, as requested\n  # (reason: rotating credentials)\n"
+	if got != want {
+		t.Errorf("wrong comment\ngot:  %q\nwant: %q", got, want)
+	}
+
+	// The reason only applies to replacements that were requested.
+	change.ActionReason = jsonplan.ResourceInstanceReplaceBecauseTainted
+	got = color.Color(resourceChangeComment(change, plans.DeleteThenCreate, proposedChange))
+	if strings.Contains(got, "reason:") {
+		t.Errorf("unexpected reason in comment for tainted replacement: %q", got)
+	}
+}
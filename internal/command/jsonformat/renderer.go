@@ -82,6 +82,10 @@ type Renderer struct {
 
 	RunningInAutomation bool
 	ShowSensitive       bool
+
+	// Noise lists the attributes whose changes are hidden in human-readable
+	// plans, if any.
+	Noise NoiseRules
 }
 
 func (renderer Renderer) RenderHumanPlan(plan Plan, mode plans.Mode, opts ...plans.Quality) {
@@ -69,6 +69,17 @@ func (state State) renderHumanStateModule(renderer Renderer, module jsonstate.Mo
 
 		renderer.Streams.Println()
 
+		if len(resource.Annotations) > 0 {
+			keys := make([]string, 0, len(resource.Annotations))
+			for k := range resource.Annotations {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				renderer.Streams.Printf("# annotation %s = %q\n", k, resource.Annotations[k])
+			}
+		}
+
 		schema := state.GetSchema(resource)
 		switch resource.Mode {
 		case jsonstate.ManagedResourceMode:
@@ -87,6 +87,11 @@ type Change struct {
 	// updated.
 	ReplacePaths attribute_path.Matcher
 
+	// UnknownCauses maps the names of top-level attributes with unknown
+	// values to the references that caused them to be unknown. It is only
+	// set on the Change for a whole resource, and not on any of its children.
+	UnknownCauses map[string][]string
+
 	// RelevantAttributes contains a set of paths that point attributes/elements
 	// that we should display. Any element/attribute not matched by this Matcher
 	// should be skipped.
@@ -103,6 +108,7 @@ func FromJsonChange(change jsonplan.Change, relevantAttributes attribute_path.Ma
 		BeforeSensitive:    UnmarshalGeneric(change.BeforeSensitive),
 		AfterSensitive:     UnmarshalGeneric(change.AfterSensitive),
 		ReplacePaths:       attribute_path.Parse(change.ReplacePaths, false),
+		UnknownCauses:      change.UnknownCauses,
 		RelevantAttributes: relevantAttributes,
 	}
 }
@@ -69,6 +69,7 @@ type Plan struct {
 	RelevantAttributes []ResourceAttr    `json:"relevant_attributes,omitempty"`
 	Checks             json.RawMessage   `json:"checks,omitempty"`
 	Timestamp          string            `json:"timestamp,omitempty"`
+	RunEnvironment     *RunEnvironment   `json:"run_environment,omitempty"`
 	Errored            bool              `json:"errored"`
 }
 
@@ -135,6 +136,14 @@ type Change struct {
 	// string.
 	ReplacePaths json.RawMessage `json:"replace_paths,omitempty"`
 
+	// UnknownCauses maps the names of top-level attributes whose "after"
+	// values won't be known until after apply to the references in their
+	// configuration that caused that, such as attributes of resource
+	// instances that are not yet created. This is only available while
+	// planning, so it is omitted when the plan was loaded from a saved plan
+	// file.
+	UnknownCauses map[string][]string `json:"unknown_causes,omitempty"`
+
 	// Importing contains the import metadata about this operation. If importing
 	// is present (ie. not null) then the change is an import operation in
 	// addition to anything mentioned in the actions field. The actual contents
@@ -164,6 +173,44 @@ type Importing struct {
 	Identity json.RawMessage `json:"identity,omitempty"`
 }
 
+// RunEnvironment describes the environment that created a saved plan.
+type RunEnvironment struct {
+	OpenTofuVersion string `json:"opentofu_version"`
+
+	// Providers are the providers selected in the dependency lock file, with
+	// the checksums recorded for them.
+	Providers []RunEnvironmentProvider `json:"providers"`
+
+	// Modules are the modules in the configuration, each with a checksum of
+	// its configuration files.
+	Modules []RunEnvironmentModule `json:"modules"`
+
+	// EnvironmentVariables are the names, but not the values, of the
+	// environment variables that could affect OpenTofu.
+	EnvironmentVariables []string `json:"environment_variables"`
+
+	Backend RunEnvironmentBackend `json:"backend"`
+}
+
+type RunEnvironmentProvider struct {
+	Provider string   `json:"provider"`
+	Version  string   `json:"version"`
+	Hashes   []string `json:"hashes"`
+}
+
+type RunEnvironmentModule struct {
+	Key     string `json:"key"`
+	Source  string `json:"source,omitempty"`
+	Version string `json:"version,omitempty"`
+	Hash    string `json:"hash"`
+}
+
+type RunEnvironmentBackend struct {
+	Type       string `json:"type"`
+	Workspace  string `json:"workspace"`
+	ConfigHash string `json:"config_hash"`
+}
+
 type Output struct {
 	Sensitive bool            `json:"sensitive"`
 	Type      json.RawMessage `json:"type,omitempty"`
@@ -197,6 +244,7 @@ func MarshalForRenderer(
 	if output.ResourceChanges, err = MarshalResourceChanges(p.Changes.Resources, schemas); err != nil {
 		return nil, nil, nil, nil, err
 	}
+	annotateReplaceReason(output.ResourceChanges, p.ForceReplaceReason)
 
 	if len(p.DriftedResources) > 0 {
 		// In refresh-only mode, we render all resources marked as drifted,
@@ -237,6 +285,7 @@ func MarshalForLog(
 	output := newPlan()
 	output.TerraformVersion = version.String()
 	output.Timestamp = p.Timestamp.Format(time.RFC3339)
+	output.RunEnvironment = marshalRunEnvironment(p.RunEnvironment)
 	output.Errored = p.Errored
 
 	err := output.marshalPlanVariables(p.VariableValues, config.Module.Variables)
@@ -282,6 +331,7 @@ func MarshalForLog(
 		if err != nil {
 			return nil, fmt.Errorf("error in marshaling resource changes: %w", err)
 		}
+		annotateReplaceReason(output.ResourceChanges, p.ForceReplaceReason)
 	}
 
 	// output.OutputChanges
@@ -326,6 +376,19 @@ func Marshal(
 	return json.Marshal(output)
 }
 
+// annotateReplaceReason records the given -replace-reason explanation on
+// each of the changes that were forced to be replacements by request.
+func annotateReplaceReason(changes []ResourceChange, reason string) {
+	if reason == "" {
+		return
+	}
+	for i := range changes {
+		if changes[i].ActionReason == ResourceInstanceReplaceByRequest {
+			changes[i].ReplaceReason = reason
+		}
+	}
+}
+
 func (p *Plan) marshalPlanVariables(vars map[string]plans.DynamicValue, decls map[string]*configs.Variable) error {
 	p.Variables = make(Variables, len(vars))
 
@@ -362,7 +425,7 @@ func (p *Plan) marshalPlanVariables(vars map[string]plans.DynamicValue, decls ma
 		if _, ok := p.Variables[name]; ok {
 			continue
 		}
-		if val := decl.Default; val != cty.NilVal {
+		if val := decl.Default; val != cty.NilVal && decl.DefaultExpr == nil {
 			valJSON, err := ctyjson.Marshal(val, val.Type())
 			if err != nil {
 				return err
@@ -481,7 +544,7 @@ func MarshalResourceChanges(resources []*plans.ResourceInstanceChangeSrc, schema
 						return nil, err
 					}
 				}
-				afterUnknown = unknownAsBool(changeV.After)
+				afterUnknown = UnknownAsBool(changeV.After)
 			}
 			valMarks := rc.AfterValMarks
 			if schema.Block.ContainsMarks() {
@@ -547,6 +610,7 @@ func MarshalResourceChanges(resources []*plans.ResourceInstanceChangeSrc, schema
 			BeforeSensitive: json.RawMessage(beforeSensitive),
 			AfterSensitive:  json.RawMessage(afterSensitive),
 			ReplacePaths:    replacePaths,
+			UnknownCauses:   rc.UnknownCauses,
 			Importing:       importing,
 			GeneratedConfig: rc.GeneratedConfig,
 			BeforeIdentity:  json.RawMessage(beforeIdentity),
@@ -682,7 +746,7 @@ func GenerateChange(beforeVal, afterVal cty.Value) (*Change, error) {
 					return nil, err
 				}
 			}
-			afterUnknown = unknownAsBool(afterVal)
+			afterUnknown = UnknownAsBool(afterVal)
 		}
 	}
 
@@ -851,8 +915,8 @@ func omitUnknowns(val cty.Value) cty.Value {
 	}
 }
 
-// recursively iterate through a cty.Value, replacing unknown values (including
-// null) with cty.True and known values with cty.False.
+// UnknownAsBool recursively iterates through a cty.Value, replacing unknown
+// values (including null) with cty.True and known values with cty.False.
 //
 // The result also normalizes some types: all sequence types are turned into
 // tuple types and all mapping types are converted to object types, since we
@@ -861,7 +925,7 @@ func omitUnknowns(val cty.Value) cty.Value {
 //
 // For map/object values, all known attribute values will be omitted instead of
 // returning false, as this results in a more compact serialization.
-func unknownAsBool(val cty.Value) cty.Value {
+func UnknownAsBool(val cty.Value) cty.Value {
 	ty := val.Type()
 	switch {
 	case val.IsNull():
@@ -883,7 +947,7 @@ func unknownAsBool(val cty.Value) cty.Value {
 		it := val.ElementIterator()
 		for it.Next() {
 			_, v := it.Element()
-			vals = append(vals, unknownAsBool(v))
+			vals = append(vals, UnknownAsBool(v))
 		}
 		// The above transform may have changed the types of some of the
 		// elements, so we'll always use a tuple here in case we've now made
@@ -907,7 +971,7 @@ func unknownAsBool(val cty.Value) cty.Value {
 		it := val.ElementIterator()
 		for it.Next() {
 			k, v := it.Element()
-			vAsBool := unknownAsBool(v)
+			vAsBool := UnknownAsBool(v)
 			// Omit all of the "false"s for known values for more compact
 			// serialization
 			if !vAsBool.RawEquals(cty.False) {
@@ -922,7 +986,7 @@ func unknownAsBool(val cty.Value) cty.Value {
 		return cty.ObjectVal(vals)
 	default:
 		// Should never happen, since the above should cover all types
-		panic(fmt.Sprintf("unknownAsBool cannot handle %#v", val))
+		panic(fmt.Sprintf("UnknownAsBool cannot handle %#v", val))
 	}
 }
 
@@ -1041,3 +1105,43 @@ func encodePath(path cty.Path) (json.RawMessage, error) {
 	}
 	return json.Marshal(steps)
 }
+
+func marshalRunEnvironment(env *plans.RunEnvironment) *RunEnvironment {
+	if env == nil {
+		return nil
+	}
+	ret := &RunEnvironment{
+		OpenTofuVersion:      env.OpenTofuVersion,
+		Providers:            make([]RunEnvironmentProvider, 0, len(env.Providers)),
+		Modules:              make([]RunEnvironmentModule, 0, len(env.Modules)),
+		EnvironmentVariables: env.EnvironmentVariables,
+		Backend: RunEnvironmentBackend{
+			Type:       env.Backend.Type,
+			Workspace:  env.Backend.Workspace,
+			ConfigHash: env.Backend.ConfigHash,
+		},
+	}
+	if ret.EnvironmentVariables == nil {
+		ret.EnvironmentVariables = []string{}
+	}
+	for _, provider := range env.Providers {
+		hashes := provider.Hashes
+		if hashes == nil {
+			hashes = []string{}
+		}
+		ret.Providers = append(ret.Providers, RunEnvironmentProvider{
+			Provider: provider.Provider,
+			Version:  provider.Version,
+			Hashes:   hashes,
+		})
+	}
+	for _, mod := range env.Modules {
+		ret.Modules = append(ret.Modules, RunEnvironmentModule{
+			Key:     mod.Key,
+			Source:  mod.Source,
+			Version: mod.Version,
+			Hash:    mod.Hash,
+		})
+	}
+	return ret
+}
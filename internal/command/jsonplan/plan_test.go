@@ -279,7 +279,7 @@ func TestUnknownAsBool(t *testing.T) {
 	}
 
 	for _, test := range tests {
-		got := unknownAsBool(test.Input)
+		got := UnknownAsBool(test.Input)
 		if !reflect.DeepEqual(got, test.Want) {
 			t.Errorf(
 				"wrong result\ninput: %#v\ngot:   %#v\nwant:  %#v",
@@ -484,35 +484,35 @@ func deepObjectValue(depth int) cty.Value {
 func BenchmarkUnknownAsBool_2(b *testing.B) {
 	value := deepObjectValue(2)
 	for n := 0; n < b.N; n++ {
-		unknownAsBool(value)
+		UnknownAsBool(value)
 	}
 }
 
 func BenchmarkUnknownAsBool_3(b *testing.B) {
 	value := deepObjectValue(3)
 	for n := 0; n < b.N; n++ {
-		unknownAsBool(value)
+		UnknownAsBool(value)
 	}
 }
 
 func BenchmarkUnknownAsBool_5(b *testing.B) {
 	value := deepObjectValue(5)
 	for n := 0; n < b.N; n++ {
-		unknownAsBool(value)
+		UnknownAsBool(value)
 	}
 }
 
 func BenchmarkUnknownAsBool_7(b *testing.B) {
 	value := deepObjectValue(7)
 	for n := 0; n < b.N; n++ {
-		unknownAsBool(value)
+		UnknownAsBool(value)
 	}
 }
 
 func BenchmarkUnknownAsBool_9(b *testing.B) {
 	value := deepObjectValue(9)
 	for n := 0; n < b.N; n++ {
-		unknownAsBool(value)
+		UnknownAsBool(value)
 	}
 }
 
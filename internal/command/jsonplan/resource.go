@@ -102,4 +102,9 @@ type ResourceChange struct {
 	// information should be resilient to encountering unrecognized values
 	// and treat them as an unspecified reason.
 	ActionReason string `json:"action_reason,omitempty"`
+
+	// ReplaceReason is the explanation given with the -replace-reason option
+	// when the plan was created. It's set only for changes whose
+	// ActionReason is "replace_by_request".
+	ReplaceReason string `json:"replace_reason,omitempty"`
 }
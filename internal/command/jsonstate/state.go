@@ -121,6 +121,21 @@ type Resource struct {
 	// IdentitySchemaVersion indicates which version of the resource identity
 	// schema the "identity" property conforms to.
 	IdentitySchemaVersion *uint64 `json:"identity_schema_version,omitempty"`
+
+	// Annotations are the free-form key/value pairs attached to the resource
+	// instance using "tofu state annotate".
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// The following are only populated by MarshalWithInstanceMetadata.
+
+	// ProviderConfig is the absolute address of the provider configuration
+	// that most recently managed the resource.
+	ProviderConfig string `json:"provider_config,omitempty"`
+
+	// CreateBeforeDestroy is true if the object was created with
+	// create_before_destroy in effect, and so must also be destroyed after
+	// its dependents are replaced.
+	CreateBeforeDestroy bool `json:"create_before_destroy,omitempty"`
 }
 
 // AttributeValues is the JSON representation of the attribute values of the
@@ -166,7 +181,7 @@ func MarshalForRenderer(sf *statefile.File, schemas *tofu.Schemas) (Module, map[
 		return Module{}, nil, err
 	}
 
-	root, err := marshalRootModule(sf.State, schemas)
+	root, err := marshalRootModule(sf.State, schemas, false)
 	if err != nil {
 		return Module{}, nil, err
 	}
@@ -177,6 +192,10 @@ func MarshalForRenderer(sf *statefile.File, schemas *tofu.Schemas) (Module, map[
 // MarshalForLog returns the origin JSON compatible state, read for a logging
 // package to marshal further.
 func MarshalForLog(sf *statefile.File, schemas *tofu.Schemas) (*State, error) {
+	return marshalForLog(sf, schemas, false)
+}
+
+func marshalForLog(sf *statefile.File, schemas *tofu.Schemas, instanceMetadata bool) (*State, error) {
 	output := newState()
 
 	if sf == nil || sf.State.Empty() {
@@ -188,7 +207,7 @@ func MarshalForLog(sf *statefile.File, schemas *tofu.Schemas) (*State, error) {
 	}
 
 	// output.StateValues
-	err := output.marshalStateValues(sf.State, schemas)
+	err := output.marshalStateValues(sf.State, schemas, instanceMetadata)
 	if err != nil {
 		return nil, err
 	}
@@ -212,7 +231,20 @@ func Marshal(sf *statefile.File, schemas *tofu.Schemas) ([]byte, error) {
 	return ret, err
 }
 
-func (jsonstate *State) marshalStateValues(s *states.State, schemas *tofu.Schemas) error {
+// MarshalWithInstanceMetadata is like Marshal, but also includes metadata
+// about each resource instance object that the usual state representation
+// leaves out, such as the full address of its provider configuration.
+func MarshalWithInstanceMetadata(sf *statefile.File, schemas *tofu.Schemas) ([]byte, error) {
+	output, err := marshalForLog(sf, schemas, true)
+	if err != nil {
+		return nil, err
+	}
+
+	ret, err := json.Marshal(output)
+	return ret, err
+}
+
+func (jsonstate *State) marshalStateValues(s *states.State, schemas *tofu.Schemas, instanceMetadata bool) error {
 	var sv StateValues
 	var err error
 
@@ -223,7 +255,7 @@ func (jsonstate *State) marshalStateValues(s *states.State, schemas *tofu.Schema
 	}
 
 	// use the state and module map to build up the module structure
-	sv.RootModule, err = marshalRootModule(s, schemas)
+	sv.RootModule, err = marshalRootModule(s, schemas, instanceMetadata)
 	if err != nil {
 		return err
 	}
@@ -261,12 +293,12 @@ func MarshalOutputs(outputs map[string]*states.OutputValue) (map[string]Output,
 	return ret, nil
 }
 
-func marshalRootModule(s *states.State, schemas *tofu.Schemas) (Module, error) {
+func marshalRootModule(s *states.State, schemas *tofu.Schemas, instanceMetadata bool) (Module, error) {
 	var ret Module
 	var err error
 
 	ret.Address = ""
-	rs, err := marshalResources(s.RootModule().Resources, addrs.RootModuleInstance, schemas)
+	rs, err := marshalResources(s.RootModule().Resources, addrs.RootModuleInstance, schemas, instanceMetadata)
 	if err != nil {
 		return ret, err
 	}
@@ -300,7 +332,7 @@ func marshalRootModule(s *states.State, schemas *tofu.Schemas) (Module, error) {
 	}
 
 	// use the state and module map to build up the module structure
-	ret.ChildModules, err = marshalModules(s, schemas, moduleMap[""], moduleMap)
+	ret.ChildModules, err = marshalModules(s, schemas, moduleMap[""], moduleMap, instanceMetadata)
 	return ret, err
 }
 
@@ -311,6 +343,7 @@ func marshalModules(
 	schemas *tofu.Schemas,
 	modules []addrs.ModuleInstance,
 	moduleMap map[string][]addrs.ModuleInstance,
+	instanceMetadata bool,
 ) ([]Module, error) {
 	var ret []Module
 	for _, child := range modules {
@@ -320,7 +353,7 @@ func marshalModules(
 		// the module may be resourceless and contain only submodules, it will then be nil here
 		stateMod := s.Module(child)
 		if stateMod != nil {
-			rs, err := marshalResources(stateMod.Resources, stateMod.Addr, schemas)
+			rs, err := marshalResources(stateMod.Resources, stateMod.Addr, schemas, instanceMetadata)
 			if err != nil {
 				return nil, err
 			}
@@ -328,7 +361,7 @@ func marshalModules(
 		}
 
 		if moduleMap[child.String()] != nil {
-			moreChildModules, err := marshalModules(s, schemas, moduleMap[child.String()], moduleMap)
+			moreChildModules, err := marshalModules(s, schemas, moduleMap[child.String()], moduleMap, instanceMetadata)
 			if err != nil {
 				return nil, err
 			}
@@ -346,7 +379,7 @@ func marshalModules(
 	return ret, nil
 }
 
-func marshalResources(resources map[string]*states.Resource, module addrs.ModuleInstance, schemas *tofu.Schemas) ([]Resource, error) {
+func marshalResources(resources map[string]*states.Resource, module addrs.ModuleInstance, schemas *tofu.Schemas, instanceMetadata bool) ([]Resource, error) {
 	var ret []Resource
 
 	var sortedResources []*states.Resource
@@ -461,6 +494,11 @@ func marshalResources(resources map[string]*states.Resource, module addrs.Module
 				if riObj.Status == states.ObjectTainted {
 					current.Tainted = true
 				}
+				current.Annotations = ri.Annotations
+				if instanceMetadata {
+					current.ProviderConfig = r.ProviderConfig.String()
+					current.CreateBeforeDestroy = riObj.CreateBeforeDestroy
+				}
 				ret = append(ret, current)
 			}
 
@@ -481,6 +519,7 @@ func marshalResources(resources map[string]*states.Resource, module addrs.Module
 					ProviderName: current.ProviderName,
 					Mode:         current.Mode,
 					Index:        current.Index,
+					Annotations:  ri.Annotations,
 				}
 
 				riObj, err := rios.Decode(schema.Block.ImpliedType())
@@ -523,6 +562,10 @@ func marshalResources(resources map[string]*states.Resource, module addrs.Module
 				if riObj.Status == states.ObjectTainted {
 					deposed.Tainted = true
 				}
+				if instanceMetadata {
+					deposed.ProviderConfig = r.ProviderConfig.String()
+					deposed.CreateBeforeDestroy = riObj.CreateBeforeDestroy
+				}
 				deposed.DeposedKey = deposedKey
 				ret = append(ret, deposed)
 			}
@@ -19,6 +19,7 @@ import (
 	"github.com/opentofu/opentofu/internal/lang/marks"
 	"github.com/opentofu/opentofu/internal/providers"
 	"github.com/opentofu/opentofu/internal/states"
+	"github.com/opentofu/opentofu/internal/states/statefile"
 	"github.com/opentofu/opentofu/internal/tofu"
 )
 
@@ -713,7 +714,7 @@ func TestMarshalResources(t *testing.T) {
 
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
-			got, err := marshalResources(test.Resources, addrs.RootModuleInstance, test.Schemas)
+			got, err := marshalResources(test.Resources, addrs.RootModuleInstance, test.Schemas, false)
 			if test.ErrMsg != "" {
 				if err == nil {
 					t.Fatal("succeeded; want error")
@@ -791,7 +792,7 @@ func TestMarshalModules_basic(t *testing.T) {
 	moduleMap := make(map[string][]addrs.ModuleInstance)
 	moduleMap[""] = []addrs.ModuleInstance{childModule, subModule}
 
-	got, err := marshalModules(testState, testSchemas(), moduleMap[""], moduleMap)
+	got, err := marshalModules(testState, testSchemas(), moduleMap[""], moduleMap, false)
 
 	if err != nil {
 		t.Fatalf("unexpected error: %s", err.Error())
@@ -864,7 +865,7 @@ func TestMarshalModules_nested(t *testing.T) {
 	moduleMap[""] = []addrs.ModuleInstance{childModule}
 	moduleMap[childModule.String()] = []addrs.ModuleInstance{subModule}
 
-	got, err := marshalModules(testState, testSchemas(), moduleMap[""], moduleMap)
+	got, err := marshalModules(testState, testSchemas(), moduleMap[""], moduleMap, false)
 
 	if err != nil {
 		t.Fatalf("unexpected error: %s", err.Error())
@@ -919,7 +920,7 @@ func TestMarshalModules_parent_no_resources(t *testing.T) {
 			addrs.NoKey,
 		)
 	})
-	got, err := marshalRootModule(testState, testSchemas())
+	got, err := marshalRootModule(testState, testSchemas(), false)
 
 	if err != nil {
 		t.Fatalf("unexpected error: %s", err.Error())
@@ -938,6 +939,71 @@ func TestMarshalModules_parent_no_resources(t *testing.T) {
 	}
 }
 
+func TestMarshalWithInstanceMetadata(t *testing.T) {
+	child := addrs.RootModuleInstance.Child("child", addrs.NoKey)
+	providerConfig := addrs.AbsProviderConfig{
+		Provider: addrs.NewDefaultProvider("test"),
+		Module:   child.Module(),
+		Alias:    "west",
+	}
+	testState := states.BuildState(func(s *states.SyncState) {
+		s.SetResourceInstanceCurrent(
+			addrs.Resource{
+				Mode: addrs.ManagedResourceMode,
+				Type: "test_instance",
+				Name: "foo",
+			}.Instance(addrs.NoKey).Absolute(child),
+			&states.ResourceInstanceObjectSrc{
+				AttrsJSON:           []byte(`{"id":"foo","foo":"value","bar":"value"}`),
+				Status:              states.ObjectReady,
+				CreateBeforeDestroy: true,
+				Dependencies: []addrs.ConfigResource{
+					addrs.Resource{
+						Mode: addrs.ManagedResourceMode,
+						Type: "test_instance",
+						Name: "bar",
+					}.InModule(child.Module()),
+				},
+			},
+			providerConfig,
+			addrs.NoKey,
+		)
+	})
+	sf := &statefile.File{State: testState}
+
+	raw, err := MarshalWithInstanceMetadata(sf, testSchemas())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got State
+	if err := json.Unmarshal(raw, &got); err != nil {
+		t.Fatal(err)
+	}
+	resources := got.Values.RootModule.ChildModules[0].Resources
+	if len(resources) != 1 {
+		t.Fatalf("wrong number of resources %d; want 1", len(resources))
+	}
+	resource := resources[0]
+	if got, want := resource.ProviderConfig, `module.child.provider["registry.opentofu.org/hashicorp/test"].west`; got != want {
+		t.Errorf("wrong provider_config %q; want %q", got, want)
+	}
+	if !resource.CreateBeforeDestroy {
+		t.Error("create_before_destroy is not set")
+	}
+	if diff := cmp.Diff([]string{"module.child.test_instance.bar"}, resource.DependsOn); diff != "" {
+		t.Errorf("wrong depends_on\n%s", diff)
+	}
+
+	// The metadata is not part of the usual representation.
+	raw, err = Marshal(sf, testSchemas())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(raw), "provider_config") || strings.Contains(string(raw), "create_before_destroy") {
+		t.Errorf("unexpected instance metadata in %s", raw)
+	}
+}
+
 func testSchemas() *tofu.Schemas {
 	return &tofu.Schemas{
 		Providers: map[addrs.Provider]providers.ProviderSchema{
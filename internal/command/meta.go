@@ -83,6 +83,11 @@ type Meta struct {
 	// the specific commands being run.
 	RunningInAutomation bool
 
+	// ReadOnly indicates that commands must refuse to run any operation that
+	// could change state, as requested by the -read-only global option or
+	// the TOFU_READ_ONLY environment variable.
+	ReadOnly bool
+
 	// CLIConfigDir is the directory from which CLI configuration files were
 	// read by the caller and the directory where any changes to CLI
 	// configuration files by commands should be made.
@@ -111,6 +116,26 @@ type Meta struct {
 	// longer any compelling reasons for folks to not lock their dependencies.
 	PluginCacheMayBreakDependencyLockFile bool
 
+	// DisabledFunctions is a set of function name patterns, from the CLI
+	// configuration, naming functions that configurations must not be
+	// allowed to call in this environment.
+	DisabledFunctions []string
+
+	// ProviderReadRetries is the number of times, from the CLI
+	// configuration, to retry a request to read a resource or data source
+	// that fails with an error the provider marked as retryable.
+	ProviderReadRetries int
+
+	// ApprovalHook, if set, is an external command from the CLI
+	// configuration that must approve the changes in a plan before
+	// "tofu apply" makes them.
+	ApprovalHook *backend.ApprovalHook
+
+	// LintRules are the external commands from the CLI configuration that
+	// "tofu validate" runs to check the configuration against additional
+	// rules.
+	LintRules []*LintRule
+
 	// ProviderSource allows determining the available versions of a provider
 	// and determines where a distribution package for a particular
 	// provider version can be obtained.
@@ -150,6 +175,11 @@ type Meta struct {
 	// When this channel is closed, the command will be cancelled.
 	ShutdownCh <-chan struct{}
 
+	// workspaceOverride is the workspace to use instead of the selected one,
+	// when running a command in each of the workspaces given with the
+	// -workspaces option.
+	workspaceOverride string
+
 	// ProviderDevOverrides are providers where we ignore the lock file, the
 	// configured version constraints, and the local cache directory and just
 	// always use exactly the path specified. This is intended to allow
@@ -167,6 +197,13 @@ type Meta struct {
 	// just trusting that someone else did it before running OpenTofu.
 	UnmanagedProviders map[addrs.Provider]*plugin.ReattachConfig
 
+	// ProviderDebug are the names given in the -provider-debug global option,
+	// selecting providers that OpenTofu should pause after launching so that
+	// the user can attach a debugger to them. Each name is either a provider
+	// type, matching providers of that type in any namespace, or a provider
+	// source address.
+	ProviderDebug []string
+
 	// AllowExperimentalFeatures controls whether a command that embeds this
 	// Meta is permitted to make use of experimental OpenTofu features.
 	//
@@ -232,6 +269,9 @@ type Meta struct {
 	// parallelism is used to control the number of concurrent operations
 	// allowed when walking the graph
 	//
+	// readParallelism and writeParallelism, if set, give separate limits to
+	// the operations that read remote objects and those that change them.
+	//
 	// provider is to specify specific resource providers
 	//
 	// stateLock is set to false to disable state locking
@@ -246,15 +286,21 @@ type Meta struct {
 	//
 	// migrateState confirms the user wishes to migrate from the prior backend
 	// configuration to a new configuration.
+	//
+	// bootstrapBackend creates the resources that a newly-configured backend
+	// needs in its storage service when they don't exist.
 	statePath        string
 	stateOutPath     string
 	backupPath       string
 	parallelism      int
+	readParallelism  int
+	writeParallelism int
 	stateLock        bool
 	stateLockTimeout time.Duration
 	forceInitCopy    bool
 	reconfigure      bool
 	migrateState     bool
+	bootstrapBackend bool
 
 	// Used with commands which write state to allow users to write remote
 	// state even if the remote and local OpenTofu versions don't match.
@@ -265,6 +311,11 @@ type Meta struct {
 	rootModuleCallCache *configs.StaticModuleCall
 	inputVariableCache  map[string]backend.UnparsedVariableValue
 
+	// statusWriter writes to the status channel given by the TOFU_STATUS_FD
+	// environment variable. It is opened on first use and shared with the
+	// commands run in each workspace of a multi-workspace operation.
+	statusWriter *views.StatusWriter
+
 	// Since `tofu providers lock` and `tofu providers mirror` have their own
 	// logic to create the source to fetch providers through, we had to
 	// plumb this configuration through the [Meta] type to reach that part too.
@@ -276,6 +327,20 @@ type Meta struct {
 type testingOverrides struct {
 	Providers    map[addrs.Provider]providers.Factory
 	Provisioners map[string]provisioners.Factory
+
+	// NotInstalled means that "tofu init" shouldn't try to install the
+	// overridden providers, because they aren't available from any source.
+	NotInstalled bool
+}
+
+// OverrideProviders makes the commands using this Meta use the given provider
+// factories instead of the installed provider plugins. It exists for the Go
+// test harness in package tofutest, and isn't used by the CLI itself.
+func (m *Meta) OverrideProviders(factories map[addrs.Provider]providers.Factory) {
+	m.testingOverrides = &testingOverrides{
+		Providers:    factories,
+		NotInstalled: true,
+	}
 }
 
 // initStatePaths is used to initialize the default values for
@@ -470,6 +535,10 @@ func (m *Meta) contextOpts(ctx context.Context) (*tofu.ContextOpts, error) {
 
 	opts.UIInput = m.UIInput()
 	opts.Parallelism = m.parallelism
+	opts.ReadParallelism = m.readParallelism
+	opts.WriteParallelism = m.writeParallelism
+	opts.DisabledFunctions = m.DisabledFunctions
+	opts.ProviderReadRetries = m.ProviderReadRetries
 
 	// If testingOverrides are set, we'll skip the plugin discovery process
 	// and just work with what we've been given, thus allowing the tests
@@ -541,8 +610,12 @@ func (m *Meta) Workspace(ctx context.Context) (string, error) {
 
 // WorkspaceOverridden returns the name of the currently configured workspace,
 // corresponding to the desired named state, as well as a bool saying whether
-// this was set via the TF_WORKSPACE environment variable.
+// this was set via the TF_WORKSPACE environment variable or because the
+// command is running in each of the workspaces given with -workspaces.
 func (m *Meta) WorkspaceOverridden(_ context.Context) (string, bool) {
+	if m.workspaceOverride != "" {
+		return m.workspaceOverride, true
+	}
 	if envVar := os.Getenv(WorkspaceNameEnvVar); envVar != "" {
 		return envVar, true
 	}
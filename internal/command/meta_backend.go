@@ -732,7 +732,7 @@ func (m *Meta) backendFromConfig(ctx context.Context, opts *BackendOpts, enc enc
 
 		if !opts.Init {
 			// user ran another cmd that is not init but they are required to initialize because of a potential relevant change to their backend configuration
-			initDiag := m.determineInitReason(s.Backend.Type, c.Type, cloudMode)
+			initDiag := m.determineInitReason(s.Backend.Type, c.Type, cloudMode, m.backendConfigChanges(ctx, c, s.Backend))
 			diags = diags.Append(initDiag)
 			return nil, diags
 		}
@@ -757,7 +757,10 @@ func (m *Meta) backendFromConfig(ctx context.Context, opts *BackendOpts, enc enc
 	}
 }
 
-func (m *Meta) determineInitReason(previousBackendType string, currentBackendType string, cloudMode cloud.ConfigChangeMode) tfdiags.Diagnostics {
+// determineInitReason returns an error diagnostic explaining why the backend
+// must be reinitialized. changes optionally describes the differences in the
+// backend configuration, as returned by backendConfigChanges.
+func (m *Meta) determineInitReason(previousBackendType string, currentBackendType string, cloudMode cloud.ConfigChangeMode, changes []string) tfdiags.Diagnostics {
 	initReason := ""
 	switch cloudMode {
 	case cloud.ConfigMigrationIn:
@@ -770,6 +773,8 @@ func (m *Meta) determineInitReason(previousBackendType string, currentBackendTyp
 		switch {
 		case previousBackendType != currentBackendType:
 			initReason = fmt.Sprintf("Backend type changed from %q to %q", previousBackendType, currentBackendType)
+		case len(changes) != 0:
+			initReason = fmt.Sprintf("Backend configuration block has changed:\n\n%s", strings.Join(changes, "\n"))
 		default:
 			initReason = "Backend configuration block has changed"
 		}
@@ -1462,6 +1467,16 @@ func (m *Meta) backendInitFromConfig(ctx context.Context, c *configs.Backend, en
 	configureDiags := b.Configure(ctx, newVal)
 	diags = diags.Append(configureDiags.InConfigBody(c.Config, ""))
 
+	// The resources that the backend stores state in must exist before we
+	// can look for workspaces in it, so we create them right away.
+	if m.bootstrapBackend && !configureDiags.HasErrors() {
+		bootstrapDiags := m.bootstrapBackendResources(ctx, b, canonType, view)
+		diags = diags.Append(bootstrapDiags)
+		if bootstrapDiags.HasErrors() {
+			return nil, cty.NilVal, diags
+		}
+	}
+
 	// If the result of loading the backend is an enhanced backend,
 	// then set up enhanced backend service aliases.
 	if enhanced, ok := b.(backend.Enhanced); ok {
@@ -1475,6 +1490,67 @@ func (m *Meta) backendInitFromConfig(ctx context.Context, c *configs.Backend, en
 	return b, configVal, diags
 }
 
+// bootstrapBackendResources creates the resources that the given configured
+// backend needs in its storage service, after describing them to the user and
+// asking for confirmation.
+func (m *Meta) bootstrapBackendResources(ctx context.Context, b backend.Backend, backendType string, view views.Backend) tfdiags.Diagnostics {
+	var diags tfdiags.Diagnostics
+
+	bootstrapper, ok := b.(backend.Bootstrapper)
+	if !ok {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Warning,
+			"Backend does not support bootstrapping",
+			fmt.Sprintf("The %q backend cannot create the resources it stores state in, so the -bootstrap-backend option has no effect.", backendType),
+		))
+		return diags
+	}
+
+	missing, moreDiags := bootstrapper.MissingResources(ctx)
+	diags = diags.Append(moreDiags)
+	if moreDiags.HasErrors() || len(missing) == 0 {
+		return diags
+	}
+	for _, resource := range missing {
+		view.BackendBootstrapMissing(backendType, fmt.Sprintf("%s %q", resource.Type, resource.Name), resource.Permissions)
+	}
+
+	// Checking for missing resources is fine in read-only mode, but creating
+	// them isn't.
+	if moreDiags := m.checkReadOnly("init -bootstrap-backend"); moreDiags.HasErrors() {
+		diags = diags.Append(moreDiags)
+		return diags
+	}
+
+	create, err := m.confirm(&tofu.InputOpts{
+		Id:          "backend-bootstrap",
+		Query:       fmt.Sprintf("Do you want OpenTofu to create the resources for the %q backend?", backendType),
+		Description: strings.TrimSpace(inputBackendBootstrap),
+	})
+	if err != nil {
+		diags = diags.Append(fmt.Errorf("Error asking to create the backend resources: %w", err))
+		return diags
+	}
+	if !create {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Backend resources not created",
+			fmt.Sprintf("The %q backend cannot store state until the resources it needs exist. Create them and run \"tofu init\" again.", backendType),
+		))
+		return diags
+	}
+
+	moreDiags = bootstrapper.CreateResources(ctx, missing)
+	diags = diags.Append(moreDiags)
+	if moreDiags.HasErrors() {
+		return diags
+	}
+	for _, resource := range missing {
+		view.BackendBootstrapCreated(backendType, fmt.Sprintf("%s %q", resource.Type, resource.Name))
+	}
+	return diags
+}
+
 // Helper method to get aliases from the enhanced backend and alias them
 // in the Meta service discovery. It's unfortunate that the Meta backend
 // is modifying the service discovery at this level, but the owner
@@ -1685,6 +1761,11 @@ OpenTofu can create a properly tagged workspace for you now. Please enter a
 name to create a new cloud backend workspace.
 `
 
+const inputBackendBootstrap = `
+OpenTofu will create the resources listed above with the credentials that the
+backend is configured with. Only 'yes' will be accepted to confirm.
+`
+
 var migrateOrReconfigDiag = tfdiags.Sourceless(
 	tfdiags.Error,
 	"Backend configuration changed",
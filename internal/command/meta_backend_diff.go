@@ -0,0 +1,111 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/zclconf/go-cty/cty"
+
+	backendInit "github.com/opentofu/opentofu/internal/backend/init"
+	"github.com/opentofu/opentofu/internal/command/clistate"
+	"github.com/opentofu/opentofu/internal/configs"
+)
+
+// backendConfigChanges describes each top-level argument whose value in the
+// backend block of the configuration differs from the backend configuration
+// cached in the working directory by the last "tofu init", one line per
+// argument, in lexical order.
+//
+// Values of sensitive arguments are never included. The result is empty if
+// the differences can't be determined, such as when the backend type has
+// changed or the configuration is invalid, in which case the caller should
+// fall back to a less precise explanation.
+func (m *Meta) backendConfigChanges(ctx context.Context, c *configs.Backend, s *clistate.BackendState) []string {
+	if c == nil || s == nil || s.Empty() {
+		return nil
+	}
+	f, canonType := backendInit.Backend(c.Type)
+	if f == nil || canonType != s.Type {
+		return nil
+	}
+	// As in backendConfigNeedsMigration, the configuration body alone might
+	// not include arguments that were given using -backend-config, so we
+	// can't require any arguments here.
+	schema := f(nil).ConfigSchema().NoneRequired()
+	givenVal, diags := c.Decode(ctx, schema)
+	if diags.HasErrors() {
+		return nil
+	}
+	cachedVal, err := s.Config(schema)
+	if err != nil {
+		return nil
+	}
+
+	names := make([]string, 0, len(schema.Attributes))
+	for name := range schema.Attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var changes []string
+	for _, name := range names {
+		given := givenVal.GetAttr(name)
+		cached := cachedVal.GetAttr(name)
+		if given.RawEquals(cached) {
+			continue
+		}
+		sensitive := schema.Attributes[name].Sensitive
+		switch {
+		case cached.IsNull():
+			changes = append(changes, fmt.Sprintf(
+				"  + %s = %s (not set when the working directory was initialized)",
+				name, backendDiffValueStr(given, sensitive),
+			))
+		case given.IsNull():
+			changes = append(changes, fmt.Sprintf(
+				"  - %s = %s (not set in the configuration; if it was set using -backend-config, include the same option when running \"tofu init\")",
+				name, backendDiffValueStr(cached, sensitive),
+			))
+		default:
+			changes = append(changes, fmt.Sprintf(
+				"  ~ %s: %s -> %s",
+				name, backendDiffValueStr(cached, sensitive), backendDiffValueStr(given, sensitive),
+			))
+		}
+	}
+	return changes
+}
+
+// backendDiffValueStr returns a short representation of the given backend
+// configuration value for use in backendConfigChanges.
+func backendDiffValueStr(v cty.Value, sensitive bool) string {
+	switch {
+	case sensitive:
+		return "(sensitive value)"
+	case v.IsNull():
+		return "null"
+	case !v.IsKnown():
+		return "(unknown value)"
+	}
+	switch v.Type() {
+	case cty.String:
+		return fmt.Sprintf("%q", v.AsString())
+	case cty.Bool:
+		if v.True() {
+			return "true"
+		}
+		return "false"
+	case cty.Number:
+		return v.AsBigFloat().Text('f', -1)
+	}
+	if v.CanIterateElements() {
+		return fmt.Sprintf("(%s with %d elements)", v.Type().FriendlyName(), v.LengthInt())
+	}
+	return fmt.Sprintf("(%s)", v.Type().FriendlyName())
+}
@@ -0,0 +1,84 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/opentofu/opentofu/internal/command/clistate"
+	"github.com/opentofu/opentofu/internal/configs"
+)
+
+func TestBackendConfigChanges(t *testing.T) {
+	tests := map[string]struct {
+		config map[string]cty.Value
+		cached string
+		want   []string
+	}{
+		"unchanged": {
+			config: map[string]cty.Value{"path": cty.StringVal("a.tfstate")},
+			cached: `{"path":"a.tfstate","workspace_dir":null}`,
+			want:   nil,
+		},
+		"changed": {
+			config: map[string]cty.Value{"path": cty.StringVal("b.tfstate")},
+			cached: `{"path":"a.tfstate","workspace_dir":null}`,
+			want: []string{
+				`  ~ path: "a.tfstate" -> "b.tfstate"`,
+			},
+		},
+		"added and removed": {
+			config: map[string]cty.Value{"workspace_dir": cty.StringVal("envs")},
+			cached: `{"path":"a.tfstate","workspace_dir":null}`,
+			want: []string{
+				`  - path = "a.tfstate" (not set in the configuration; if it was set using -backend-config, include the same option when running "tofu init")`,
+				`  + workspace_dir = "envs" (not set when the working directory was initialized)`,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := &configs.Backend{
+				Type:   "local",
+				Config: configs.SynthBody("<test>", test.config),
+				Eval:   configs.NewStaticEvaluator(nil, configs.RootModuleCallForTesting()),
+			}
+			s := &clistate.BackendState{
+				Type:      "local",
+				ConfigRaw: []byte(test.cached),
+			}
+
+			m := testMetaBackend(t)
+			got := m.backendConfigChanges(t.Context(), c, s)
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("wrong result\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBackendConfigChanges_typeChanged(t *testing.T) {
+	c := &configs.Backend{
+		Type: "local",
+		Config: configs.SynthBody("<test>", map[string]cty.Value{
+			"path": cty.StringVal("a.tfstate"),
+		}),
+		Eval: configs.NewStaticEvaluator(nil, configs.RootModuleCallForTesting()),
+	}
+	s := &clistate.BackendState{
+		Type:      "inmem",
+		ConfigRaw: []byte(`{}`),
+	}
+
+	m := testMetaBackend(t)
+	if got := m.backendConfigChanges(t.Context(), c, s); got != nil {
+		t.Errorf("unexpected changes for a different backend type: %#v", got)
+	}
+}
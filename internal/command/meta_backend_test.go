@@ -15,6 +15,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hcltest"
 	"github.com/opentofu/opentofu/internal/command/arguments"
@@ -31,6 +32,7 @@ import (
 	"github.com/opentofu/opentofu/internal/states"
 	"github.com/opentofu/opentofu/internal/states/statefile"
 	"github.com/opentofu/opentofu/internal/states/statemgr"
+	"github.com/opentofu/opentofu/internal/tfdiags"
 
 	backendInit "github.com/opentofu/opentofu/internal/backend/init"
 	backendLocal "github.com/opentofu/opentofu/internal/backend/local"
@@ -311,6 +313,108 @@ func TestMetaBackend_configureNew(t *testing.T) {
 	}
 }
 
+// Newly configured backend that creates its missing resources
+func TestMetaBackend_configureNewBootstrap(t *testing.T) {
+	td := t.TempDir()
+	testCopyDir(t, testFixturePath("backend-new-bootstrap"), td)
+	t.Chdir(td)
+
+	bootstrapper := &testBootstrapBackend{
+		missing: []backend.BootstrapResource{
+			{Type: "test bucket", Name: "state", Permissions: []string{"test:read", "test:write"}},
+		},
+	}
+	backendInit.Set("local-bootstrap", func(enc encryption.StateEncryption) backend.Backend {
+		bootstrapper.Local = backendLocal.New(enc)
+		return bootstrapper
+	})
+	defer backendInit.Set("local-bootstrap", nil)
+
+	defer testInputMap(t, map[string]string{
+		"backend-bootstrap": "yes",
+	})()
+
+	m := testMetaBackend(t)
+	m.bootstrapBackend = true
+
+	if _, diags := m.Backend(t.Context(), &BackendOpts{Init: true}, encryption.StateEncryptionDisabled()); diags.HasErrors() {
+		t.Fatal(diags.Err())
+	}
+
+	if diff := cmp.Diff(bootstrapper.missing, bootstrapper.created); diff != "" {
+		t.Fatalf("wrong created resources\n%s", diff)
+	}
+}
+
+// Newly configured backend whose missing resources the user declines to create
+func TestMetaBackend_configureNewBootstrapDeclined(t *testing.T) {
+	td := t.TempDir()
+	testCopyDir(t, testFixturePath("backend-new-bootstrap"), td)
+	t.Chdir(td)
+
+	bootstrapper := &testBootstrapBackend{
+		missing: []backend.BootstrapResource{
+			{Type: "test bucket", Name: "state"},
+		},
+	}
+	backendInit.Set("local-bootstrap", func(enc encryption.StateEncryption) backend.Backend {
+		bootstrapper.Local = backendLocal.New(enc)
+		return bootstrapper
+	})
+	defer backendInit.Set("local-bootstrap", nil)
+
+	defer testInputMap(t, map[string]string{
+		"backend-bootstrap": "no",
+	})()
+
+	m := testMetaBackend(t)
+	m.bootstrapBackend = true
+
+	_, diags := m.Backend(t.Context(), &BackendOpts{Init: true}, encryption.StateEncryptionDisabled())
+	if !diags.HasErrors() {
+		t.Fatal("succeeded; want error")
+	}
+	if got, want := diags.Err().Error(), "Backend resources not created"; !strings.Contains(got, want) {
+		t.Fatalf("wrong error\ngot:  %s\nwant: %s", got, want)
+	}
+	if len(bootstrapper.created) != 0 {
+		t.Fatalf("unexpected created resources: %#v", bootstrapper.created)
+	}
+}
+
+// Newly configured backend with missing resources in read-only mode
+func TestMetaBackend_configureNewBootstrapReadOnly(t *testing.T) {
+	td := t.TempDir()
+	testCopyDir(t, testFixturePath("backend-new-bootstrap"), td)
+	t.Chdir(td)
+
+	bootstrapper := &testBootstrapBackend{
+		missing: []backend.BootstrapResource{
+			{Type: "test bucket", Name: "state"},
+		},
+	}
+	backendInit.Set("local-bootstrap", func(enc encryption.StateEncryption) backend.Backend {
+		bootstrapper.Local = backendLocal.New(enc)
+		return bootstrapper
+	})
+	defer backendInit.Set("local-bootstrap", nil)
+
+	m := testMetaBackend(t)
+	m.bootstrapBackend = true
+	m.ReadOnly = true
+
+	_, diags := m.Backend(t.Context(), &BackendOpts{Init: true}, encryption.StateEncryptionDisabled())
+	if !diags.HasErrors() {
+		t.Fatal("succeeded; want error")
+	}
+	if got, want := diags.Err().Error(), "Read-only mode"; !strings.Contains(got, want) {
+		t.Fatalf("wrong error\ngot:  %s\nwant: %s", got, want)
+	}
+	if len(bootstrapper.created) != 0 {
+		t.Fatalf("unexpected created resources: %#v", bootstrapper.created)
+	}
+}
+
 // Newly configured backend with prior local state and no remote state
 func TestMetaBackend_configureNewWithState(t *testing.T) {
 	// Create a temporary working directory that is empty
@@ -891,6 +995,31 @@ func TestMetaBackend_configuredChange(t *testing.T) {
 	}
 }
 
+// Changing a configured backend without running init
+func TestMetaBackend_configuredChangeWithoutInit(t *testing.T) {
+	// Create a temporary working directory that is empty
+	td := t.TempDir()
+	testCopyDir(t, testFixturePath("backend-change"), td)
+	t.Chdir(td)
+
+	// Setup the meta
+	m := testMetaBackend(t)
+
+	// Get the backend
+	_, diags := m.Backend(t.Context(), &BackendOpts{}, encryption.StateEncryptionDisabled())
+	if !diags.HasErrors() {
+		t.Fatal("succeeded; want error")
+	}
+
+	got := diags.Err().Error()
+	want := `Backend configuration block has changed:
+
+  ~ path: "local-state.tfstate" -> "local-state-2.tfstate"`
+	if !strings.Contains(got, want) {
+		t.Fatalf("wrong error\ngot: %s\nwant substring: %s", got, want)
+	}
+}
+
 // Reconfiguring with an already configured backend.
 // This should ignore the existing backend config, and configure the new
 // backend is if this is the first time.
@@ -1701,6 +1830,8 @@ func TestMetaBackend_planLocal(t *testing.T) {
 	backendConfigBlock := cty.ObjectVal(map[string]cty.Value{
 		"path":          cty.NullVal(cty.String),
 		"workspace_dir": cty.NullVal(cty.String),
+		"backup_dir":    cty.NullVal(cty.String),
+		"fsync":         cty.NullVal(cty.Bool),
 	})
 	backendConfigRaw, err := plans.NewDynamicValue(backendConfigBlock, backendConfigBlock.Type())
 	if err != nil {
@@ -1794,6 +1925,8 @@ func TestMetaBackend_planLocalStatePath(t *testing.T) {
 	backendConfigBlock := cty.ObjectVal(map[string]cty.Value{
 		"path":          cty.NullVal(cty.String),
 		"workspace_dir": cty.NullVal(cty.String),
+		"backup_dir":    cty.NullVal(cty.String),
+		"fsync":         cty.NullVal(cty.Bool),
 	})
 	backendConfigRaw, err := plans.NewDynamicValue(backendConfigBlock, backendConfigBlock.Type())
 	if err != nil {
@@ -1895,6 +2028,8 @@ func TestMetaBackend_planLocalMatch(t *testing.T) {
 	backendConfigBlock := cty.ObjectVal(map[string]cty.Value{
 		"path":          cty.NullVal(cty.String),
 		"workspace_dir": cty.NullVal(cty.String),
+		"backup_dir":    cty.NullVal(cty.String),
+		"fsync":         cty.NullVal(cty.Bool),
 	})
 	backendConfigRaw, err := plans.NewDynamicValue(backendConfigBlock, backendConfigBlock.Type())
 	if err != nil {
@@ -2146,3 +2281,26 @@ func testMetaBackend(t *testing.T) *Meta {
 
 	return &m
 }
+
+// testBootstrapBackend is a local backend that reports the given resources as
+// missing until they are created.
+type testBootstrapBackend struct {
+	*backendLocal.Local
+
+	missing []backend.BootstrapResource
+	created []backend.BootstrapResource
+}
+
+var _ backend.Bootstrapper = (*testBootstrapBackend)(nil)
+
+func (b *testBootstrapBackend) MissingResources(context.Context) ([]backend.BootstrapResource, tfdiags.Diagnostics) {
+	if len(b.created) != 0 {
+		return nil, nil
+	}
+	return b.missing, nil
+}
+
+func (b *testBootstrapBackend) CreateResources(_ context.Context, resources []backend.BootstrapResource) tfdiags.Diagnostics {
+	b.created = append(b.created, resources...)
+	return nil
+}
@@ -10,7 +10,9 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"path/filepath"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-retryablehttp"
@@ -21,9 +23,11 @@ import (
 	"github.com/zclconf/go-cty/cty/convert"
 
 	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/command/jsonformat"
 	"github.com/opentofu/opentofu/internal/configs"
 	"github.com/opentofu/opentofu/internal/configs/configload"
 	"github.com/opentofu/opentofu/internal/configs/configschema"
+	"github.com/opentofu/opentofu/internal/genconfig"
 	"github.com/opentofu/opentofu/internal/httpclient"
 	"github.com/opentofu/opentofu/internal/initwd"
 	"github.com/opentofu/opentofu/internal/registry"
@@ -147,10 +151,96 @@ func (m *Meta) rootModuleCall(ctx context.Context, rootDir string) (configs.Stat
 		parsed, parsedDiags := v.ParseVariableValue(variable.ParsingMode)
 		return parsed.Value, parsedDiags.ToHCL()
 	}, rootDir, workspace)
+
+	overrides, overrideDiags := m.loadProviderOverrides(rootDir, workspace)
+	diags = diags.Append(overrideDiags)
+	call = call.WithProviderOverrides(overrides).WithDisabledFunctions(m.DisabledFunctions)
+
 	m.rootModuleCallCache = &call
 	return call, diags
 }
 
+// providerOverrideFilename returns the name of the file in the root module
+// directory that overrides provider arguments for the given workspace.
+func providerOverrideFilename(workspace string) string {
+	return workspace + ".tfoverride.hcl"
+}
+
+// loadProviderOverrides reads the provider overrides for the given workspace
+// from the root module directory, if there are any.
+func (m *Meta) loadProviderOverrides(rootDir string, workspace string) ([]*configs.ProviderOverride, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	filename := filepath.Join(rootDir, providerOverrideFilename(workspace))
+	if _, err := os.Stat(filename); os.IsNotExist(err) {
+		return nil, diags
+	}
+
+	loader, err := m.initConfigLoader()
+	if err != nil {
+		diags = diags.Append(err)
+		return nil, diags
+	}
+
+	log.Printf("[TRACE] Meta.loadProviderOverrides: loading provider overrides for workspace %q from %s", workspace, filename)
+	overrides, hclDiags := loader.Parser().LoadProviderOverrideFile(filename)
+	diags = diags.Append(hclDiags)
+	return overrides, diags
+}
+
+// loadNoiseRules reads the attributes whose changes are hidden in
+// human-readable plans from the diff ignore file in the root module
+// directory, if there is one.
+func (m *Meta) loadNoiseRules() (jsonformat.NoiseRules, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	filename := filepath.Join(m.WorkingDir.RootModuleDir(), configs.DiffIgnoreFilename)
+	if _, err := os.Stat(filename); os.IsNotExist(err) {
+		return nil, diags
+	}
+
+	loader, err := m.initConfigLoader()
+	if err != nil {
+		diags = diags.Append(err)
+		return nil, diags
+	}
+
+	log.Printf("[TRACE] Meta.loadNoiseRules: loading diff ignore rules from %s", filename)
+	ignores, hclDiags := loader.Parser().LoadDiffIgnoreFile(filename)
+	diags = diags.Append(hclDiags)
+	if hclDiags.HasErrors() {
+		return nil, diags
+	}
+
+	rules := make(jsonformat.NoiseRules)
+	for _, ignore := range ignores {
+		for _, traversal := range ignore.Attributes {
+			attr := jsonformat.NoisyAttribute{
+				Steps: make([]string, 0, len(traversal)),
+			}
+			var path strings.Builder
+			for _, step := range traversal {
+				switch step := step.(type) {
+				case hcl.TraverseRoot:
+					attr.Steps = append(attr.Steps, step.Name)
+					path.WriteString(step.Name)
+				case hcl.TraverseAttr:
+					attr.Steps = append(attr.Steps, step.Name)
+					path.WriteString("." + step.Name)
+				case hcl.TraverseIndex:
+					// The parser only accepts string keys.
+					key := step.Key.AsString()
+					attr.Steps = append(attr.Steps, key)
+					fmt.Fprintf(&path, "[%q]", key)
+				}
+			}
+			attr.Path = path.String()
+			rules[ignore.ResourceType] = append(rules[ignore.ResourceType], attr)
+		}
+	}
+	return rules, diags
+}
+
 func (m *Meta) getInput(ctx context.Context, variable *configs.Variable) (string, error) {
 	if !m.Input() {
 		return "", fmt.Errorf("input is disabled")
@@ -450,6 +540,7 @@ func (m *Meta) initConfigLoader() (*configload.Loader, error) {
 			m.View.SetConfigSources(loader.Sources)
 			m.View.SetModuleSourceAddrs(loader.ModuleSourceAddrs)
 			m.View.SetIsRemoteModuleSource(loader.IsRemoteModuleSource)
+			m.View.SetGeneratedConfigOrigin(genconfig.NewSourceMaps().Describe)
 		}
 	}
 	return m.configLoader, nil
@@ -82,6 +82,11 @@ func (m *Meta) providerInstallerCustomSource(source getproviders.Source) *provid
 	for ty := range m.UnmanagedProviders {
 		unmanagedProviderTypes[ty] = struct{}{}
 	}
+	if m.testingOverrides != nil && m.testingOverrides.NotInstalled {
+		for ty := range m.testingOverrides.Providers {
+			unmanagedProviderTypes[ty] = struct{}{}
+		}
+	}
 	inst.SetUnmanagedProviderTypes(unmanagedProviderTypes)
 	return inst
 }
@@ -319,11 +324,11 @@ func (m *Meta) providerFactories() (map[addrs.Provider]providers.Factory, error)
 				return nil, checkErr
 			}
 
-			return providerFactory(cached)()
+			return providerFactory(cached, m.providerDebugger(provider))()
 		}
 	}
 	for provider, localDir := range devOverrideProviders {
-		factories[provider] = devOverrideProviderFactory(provider, localDir)
+		factories[provider] = devOverrideProviderFactory(provider, localDir, m.providerDebugger(provider))
 	}
 	for provider, reattach := range unmanagedProviders {
 		factories[provider] = unmanagedProviderFactory(provider, reattach)
@@ -347,7 +352,10 @@ func (m *Meta) internalProviders() map[string]providers.Factory {
 // providerFactory produces a provider factory that runs up the executable
 // file in the given cache package and uses go-plugin to implement
 // providers.Interface against it.
-func providerFactory(meta *providercache.CachedProvider) providers.Factory {
+//
+// If debugger is not nil, each launch of the provider pauses so that the
+// user can attach a debugger to the provider process.
+func providerFactory(meta *providercache.CachedProvider, debugger *providerDebugger) providers.Factory {
 	schemaCache := providers.NewSchemaCache()
 
 	return func() (providers.Interface, error) {
@@ -358,7 +366,7 @@ func providerFactory(meta *providercache.CachedProvider) providers.Factory {
 
 		config := &plugin.ClientConfig{
 			HandshakeConfig:  tfplugin.Handshake,
-			Logger:           logging.NewProviderLogger(""),
+			Logger:           logging.NewProviderLogger("", meta.Provider.Type),
 			AllowedProtocols: []plugin.Protocol{plugin.ProtocolGRPC},
 			Managed:          true,
 			Cmd:              exec.Command(execFile),
@@ -367,12 +375,22 @@ func providerFactory(meta *providercache.CachedProvider) providers.Factory {
 			SyncStdout:       logging.PluginOutputMonitor(fmt.Sprintf("%s:stdout", meta.Provider)),
 			SyncStderr:       logging.PluginOutputMonitor(fmt.Sprintf("%s:stderr", meta.Provider)),
 		}
+		if env, ok := logging.ProviderPluginEnviron(meta.Provider.Type); ok {
+			config.Cmd.Env = env
+			config.SkipHostEnv = true
+		}
+		if debugger != nil {
+			debugger.configure(config)
+		}
 
 		client := plugin.NewClient(config)
 		rpcClient, err := client.Client()
 		if err != nil {
 			return nil, err
 		}
+		if debugger != nil {
+			debugger.attach(meta.Provider, client)
+		}
 
 		raw, err := rpcClient.Dispense(tfplugin.ProviderPluginName)
 		if err != nil {
@@ -409,7 +427,7 @@ func initializeProviderInstance(plugin any, protoVer int, pluginClient *plugin.C
 	}
 }
 
-func devOverrideProviderFactory(provider addrs.Provider, localDir getproviders.PackageLocalDir) providers.Factory {
+func devOverrideProviderFactory(provider addrs.Provider, localDir getproviders.PackageLocalDir, debugger *providerDebugger) providers.Factory {
 	// A dev override is essentially a synthetic cache entry for our purposes
 	// here, so that's how we'll construct it. The providerFactory function
 	// doesn't actually care about the version, so we can leave it
@@ -419,7 +437,7 @@ func devOverrideProviderFactory(provider addrs.Provider, localDir getproviders.P
 		Provider:   provider,
 		Version:    getproviders.UnspecifiedVersion,
 		PackageDir: string(localDir),
-	})
+	}, debugger)
 }
 
 // unmanagedProviderFactory produces a provider factory that uses the passed
@@ -431,7 +449,7 @@ func unmanagedProviderFactory(provider addrs.Provider, reattach *plugin.Reattach
 	return func() (providers.Interface, error) {
 		config := &plugin.ClientConfig{
 			HandshakeConfig:  tfplugin.Handshake,
-			Logger:           logging.NewProviderLogger("unmanaged."),
+			Logger:           logging.NewProviderLogger("unmanaged.", provider.Type),
 			AllowedProtocols: []plugin.Protocol{plugin.ProtocolGRPC},
 			Managed:          false,
 			Reattach:         reattach,
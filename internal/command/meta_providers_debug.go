@@ -0,0 +1,157 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	plugin "github.com/hashicorp/go-plugin"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+)
+
+// providerDebugStartTimeout replaces go-plugin's default timeout for a
+// provider to complete its handshake when the provider is being debugged,
+// since a provider can be much slower to start when built for debugging.
+const providerDebugStartTimeout = 15 * time.Minute
+
+// providerDebugMu serializes the prompts for attaching a debugger, since
+// OpenTofu may launch several instances of a provider concurrently and the
+// user can only respond to one prompt at a time.
+var providerDebugMu sync.Mutex
+
+// providerDebugger pauses after launching a provider selected with the
+// -provider-debug global option, so that the user can attach a debugger to
+// the provider process before OpenTofu starts calling it.
+type providerDebugger struct {
+	out io.Writer
+	in  *bufio.Reader
+}
+
+// providerDebugger returns the debugger for the given provider, or nil if
+// the user didn't ask to debug it.
+func (m *Meta) providerDebugger(provider addrs.Provider) *providerDebugger {
+	if !providerDebugRequested(m.ProviderDebug, provider) {
+		return nil
+	}
+	return &providerDebugger{
+		out: os.Stderr,
+		in:  providerDebugStdin(),
+	}
+}
+
+// providerDebugStdin returns a reader for standard input that is shared by
+// all debuggers, so that input buffered while waiting for one prompt isn't
+// lost to the next.
+var providerDebugStdin = sync.OnceValue(func() *bufio.Reader {
+	return bufio.NewReader(os.Stdin)
+})
+
+// providerDebugRequested returns true if any of the given names from the
+// -provider-debug option selects the given provider. A name containing a
+// slash is a provider source address, which must match exactly, while any
+// other name matches all providers of that type.
+func providerDebugRequested(names []string, provider addrs.Provider) bool {
+	for _, name := range names {
+		if !strings.Contains(name, "/") {
+			if name == provider.Type {
+				return true
+			}
+			continue
+		}
+		addr, diags := addrs.ParseProviderSourceString(name)
+		if !diags.HasErrors() && addr.Equals(provider) {
+			return true
+		}
+	}
+	return false
+}
+
+// configure adjusts the configuration for launching the provider so that it
+// behaves well under a debugger.
+func (d *providerDebugger) configure(config *plugin.ClientConfig) {
+	config.StartTimeout = providerDebugStartTimeout
+	// The reattach configuration we print is usable by other OpenTofu
+	// processes only if the provider doesn't require the client certificate
+	// generated for this process.
+	config.AutoMTLS = false
+}
+
+// attach describes the launched provider process to the user and then waits
+// for the user to confirm that their debugger is attached.
+func (d *providerDebugger) attach(provider addrs.Provider, client *plugin.Client) {
+	providerDebugMu.Lock()
+	defer providerDebugMu.Unlock()
+
+	reattach := client.ReattachConfig()
+	if reattach == nil {
+		// Should not get here, since we only call this for a provider that
+		// OpenTofu launched itself.
+		log.Printf("[WARN] No reattach configuration for debugged provider %s", provider)
+		return
+	}
+
+	fmt.Fprintf(d.out, `
+Provider %s is waiting for a debugger, running as process %d.
+
+Attach your debugger to the process, for example by running:
+  dlv attach %d
+
+While this provider process is running, other OpenTofu commands can use it
+by setting the following environment variable:
+  TF_REATTACH_PROVIDERS='%s'
+
+Press Enter to continue once your debugger is attached.
+`, provider, reattach.Pid, reattach.Pid, providerDebugReattachJSON(provider, reattach))
+
+	if _, err := d.in.ReadString('\n'); err != nil {
+		// If there's no input to wait for then we can't pause, but the
+		// user can still attach a debugger while the provider runs.
+		log.Printf("[WARN] Not waiting for a debugger to attach to %s: %s", provider, err)
+	}
+}
+
+// providerDebugReattachJSON returns the value of the TF_REATTACH_PROVIDERS
+// environment variable for connecting to the given provider process.
+func providerDebugReattachJSON(provider addrs.Provider, reattach *plugin.ReattachConfig) string {
+	type reattachAddr struct {
+		Network string
+		String  string
+	}
+	type reattachConfig struct {
+		Protocol        string
+		ProtocolVersion int
+		Addr            reattachAddr
+		Pid             int
+		Test            bool
+	}
+	src, err := json.Marshal(map[string]reattachConfig{
+		provider.String(): {
+			Protocol:        string(reattach.Protocol),
+			ProtocolVersion: reattach.ProtocolVersion,
+			Addr: reattachAddr{
+				Network: reattach.Addr.Network(),
+				String:  reattach.Addr.String(),
+			},
+			Pid:  reattach.Pid,
+			Test: true,
+		},
+	})
+	if err != nil {
+		// Should never happen because the input here is entirely under
+		// our control.
+		panic(fmt.Sprintf("failed to encode reattach configuration: %s", err))
+	}
+	return string(src)
+}
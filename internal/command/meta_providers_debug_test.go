@@ -0,0 +1,54 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"net"
+	"testing"
+
+	plugin "github.com/hashicorp/go-plugin"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+)
+
+func TestProviderDebugRequested(t *testing.T) {
+	aws := addrs.NewDefaultProvider("aws")
+	otherAWS := addrs.NewProvider(addrs.DefaultProviderRegistryHost, "example", "aws")
+
+	tests := map[string]struct {
+		names    []string
+		provider addrs.Provider
+		want     bool
+	}{
+		"none":                  {nil, aws, false},
+		"type":                  {[]string{"aws"}, aws, true},
+		"type in any namespace": {[]string{"aws"}, otherAWS, true},
+		"other type":            {[]string{"null"}, aws, false},
+		"address":               {[]string{"registry.opentofu.org/hashicorp/aws"}, aws, true},
+		"other address":         {[]string{"registry.opentofu.org/hashicorp/aws"}, otherAWS, false},
+		"one of several":        {[]string{"null", "registry.opentofu.org/example/aws"}, otherAWS, true},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := providerDebugRequested(test.names, test.provider); got != test.want {
+				t.Errorf("wrong result %t; want %t", got, test.want)
+			}
+		})
+	}
+}
+
+func TestProviderDebugReattachJSON(t *testing.T) {
+	got := providerDebugReattachJSON(addrs.NewDefaultProvider("aws"), &plugin.ReattachConfig{
+		Protocol:        plugin.ProtocolGRPC,
+		ProtocolVersion: 5,
+		Addr:            &net.UnixAddr{Net: "unix", Name: "/tmp/plugin123"},
+		Pid:             1234,
+	})
+	want := `{"registry.opentofu.org/hashicorp/aws":{"Protocol":"grpc","ProtocolVersion":5,"Addr":{"Network":"unix","String":"/tmp/plugin123"},"Pid":1234,"Test":true}}`
+	if got != want {
+		t.Errorf("wrong result\ngot:  %s\nwant: %s", got, want)
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"fmt"
+
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// checkReadOnly returns an error diagnostic if OpenTofu is running in
+// read-only mode, in which case the command with the given name must not
+// continue because it would change state.
+//
+// Commands that change state should call this as soon as their arguments are
+// parsed, so that nothing has been done yet when they refuse to run.
+func (m *Meta) checkReadOnly(cmdName string) tfdiags.Diagnostics {
+	var diags tfdiags.Diagnostics
+	if !m.ReadOnly {
+		return diags
+	}
+	return diags.Append(tfdiags.Sourceless(
+		tfdiags.Error,
+		"Read-only mode",
+		fmt.Sprintf(
+			"OpenTofu is running in read-only mode, so \"tofu %s\" cannot be used because it could change the state.\n\nRead-only mode is enabled by the -read-only global option or the TOFU_READ_ONLY environment variable. To make changes, run OpenTofu again without either of them.",
+			cmdName,
+		),
+	))
+}
@@ -0,0 +1,66 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+
+	"github.com/opentofu/opentofu/internal/command/views"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// StatusFDEnvVar is the name of the environment variable that wrappers can
+// set to receive concise status lines about the progress of an apply,
+// separately from the output that users see. Its value is either the number
+// of a file descriptor that the wrapper has opened for writing, or the path
+// of a file or named pipe.
+const StatusFDEnvVar = "TOFU_STATUS_FD"
+
+// statusChannel returns a writer for the status channel given by the
+// TOFU_STATUS_FD environment variable, or nil if it isn't set.
+//
+// The channel is opened on the first call, and the returned function closes
+// it. Later calls, including from the commands run in each workspace of a
+// multi-workspace operation, return the same writer and a no-op function.
+func (m *Meta) statusChannel() (*views.StatusWriter, func(), tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+	noop := func() {}
+
+	if m.statusWriter != nil {
+		return m.statusWriter, noop, diags
+	}
+	raw := os.Getenv(StatusFDEnvVar)
+	if raw == "" {
+		return nil, noop, diags
+	}
+
+	var f *os.File
+	if fd, err := strconv.Atoi(raw); err == nil && fd >= 0 {
+		f = os.NewFile(uintptr(fd), "status")
+	} else {
+		var err error
+		f, err = os.OpenFile(raw, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+		if err != nil {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Invalid status channel",
+				fmt.Sprintf("The %s environment variable must be either a file descriptor number or the path of a file to write status lines to, but OpenTofu can't open %q for writing: %s.", StatusFDEnvVar, raw, err),
+			))
+			return nil, noop, diags
+		}
+	}
+
+	m.statusWriter = views.NewStatusWriter(f)
+	closer := func() {
+		if err := f.Close(); err != nil {
+			log.Printf("[ERROR] Unable to close the status channel: %s", err)
+		}
+	}
+	return m.statusWriter, closer, diags
+}
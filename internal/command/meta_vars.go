@@ -14,6 +14,10 @@ import (
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclsyntax"
 	hcljson "github.com/hashicorp/hcl/v2/json"
+	ctyyaml "github.com/zclconf/go-cty-yaml"
+	"github.com/zclconf/go-cty/cty"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+	"go.yaml.in/yaml/v3"
 
 	"github.com/opentofu/opentofu/internal/backend"
 	"github.com/opentofu/opentofu/internal/configs"
@@ -25,6 +29,13 @@ import (
 // for root module input variables.
 const VarEnvPrefix = "TF_VAR_"
 
+// VarEnvJSONSuffix is the suffix for environment variables whose values are
+// JSON encodings of the values of root module input variables. For example,
+// TF_VAR_zones_JSON sets the variable "zones" to the value decoded from its
+// JSON content, which is more reliable for complex-typed values than the
+// HCL syntax that TF_VAR_zones would be parsed with.
+const VarEnvJSONSuffix = "_JSON"
+
 // collectVariableValuesWithTests inspects the same sources of variables as
 // collectVariableValues, but also includes any autoloaded variables from the
 // given tests directory.
@@ -53,6 +64,10 @@ func (m *Meta) collectVariableValues() (map[string]backend.UnparsedVariableValue
 	// First we'll deal with environment variables, since they have the lowest
 	// precedence.
 	{
+		// A JSON-encoded value takes precedence over a value set without the
+		// JSON suffix for the same variable, regardless of the order of the
+		// environment variables.
+		jsonVals := map[string]backend.UnparsedVariableValue{}
 		env := os.Environ()
 		for _, raw := range env {
 			if !strings.HasPrefix(raw, VarEnvPrefix) {
@@ -74,6 +89,19 @@ func (m *Meta) collectVariableValues() (map[string]backend.UnparsedVariableValue
 				name:       name,
 				sourceType: tofu.ValueFromEnvVar,
 			}
+
+			// We keep the value above too, in case the configuration really
+			// declares a variable whose name ends with the JSON suffix.
+			if varName, ok := strings.CutSuffix(name, VarEnvJSONSuffix); ok && varName != "" {
+				jsonVals[varName] = unparsedVariableValueJSON{
+					str:        rawVal,
+					name:       varName,
+					sourceType: tofu.ValueFromEnvVar,
+				}
+			}
+		}
+		for name, val := range jsonVals {
+			ret[name] = val
 		}
 	}
 
@@ -193,6 +221,14 @@ func (m *Meta) addVarsFromFile(filename string, sourceType tofu.ValueSourceType,
 	// Record the file source code for snippets in diagnostic messages.
 	loader.Parser().ForceFileSource(filename, src)
 
+	// JSON files, and JSON detected below, don't need the YAML decoder: the
+	// HCL JSON syntax evaluated without an EvalContext already takes their
+	// strings literally, as plain JSON.
+	if strings.HasSuffix(filename, ".yaml") || strings.HasSuffix(filename, ".yml") {
+		diags = diags.Append(addVarsFromYAML(filename, src, sourceType, to))
+		return diags
+	}
+
 	var f *hcl.File
 
 	extJSON := strings.HasSuffix(filename, ".json")
@@ -261,6 +297,109 @@ func (m *Meta) addVarsFromFile(filename string, sourceType tofu.ValueSourceType,
 	return diags
 }
 
+// addVarsFromYAML adds the values defined in the given YAML document, which
+// must be a mapping from variable names to their values. The values are
+// converted to the declared types of their variables later, as for values
+// from any other source.
+//
+// YAML is a superset of JSON, so this also accepts plain JSON documents given
+// in a file with a YAML extension.
+func addVarsFromYAML(filename string, src []byte, sourceType tofu.ValueSourceType, to map[string]backend.UnparsedVariableValue) tfdiags.Diagnostics {
+	var diags tfdiags.Diagnostics
+
+	// We also parse the document into a node tree, which records where each
+	// of the variables is defined. Any syntax errors are reported by the
+	// decoder below instead.
+	var doc yaml.Node
+	docErr := yaml.Unmarshal(src, &doc)
+	if docErr == nil && len(doc.Content) == 0 {
+		// A document that's empty, or contains only comments, defines no
+		// variables.
+		return diags
+	}
+
+	val, err := ctyyaml.Standard.Unmarshal(src, cty.DynamicPseudoType)
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid variables file",
+			fmt.Sprintf("Failed to parse %s as YAML: %s.", filename, err),
+		))
+		return diags
+	}
+	if val.IsNull() {
+		return diags
+	}
+	if !val.Type().IsObjectType() {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid variables file",
+			fmt.Sprintf("The YAML document in %s must be a mapping from variable names to their values.", filename),
+		))
+		return diags
+	}
+
+	ranges := make(map[string]tfdiags.SourceRange)
+	if docErr == nil {
+		ranges = yamlKeyRanges(filename, src, &doc)
+	}
+	for name, v := range val.AsValueMap() {
+		rng, ok := ranges[name]
+		if !ok {
+			rng = tfdiags.SourceRange{Filename: filename}
+		}
+		to[name] = unparsedVariableValueYAML{
+			value:       v,
+			sourceType:  sourceType,
+			sourceRange: rng,
+		}
+	}
+	return diags
+}
+
+// yamlKeyRanges returns the source ranges of the keys of the top-level
+// mapping in the given YAML document, parsed from the given source code, for
+// use in diagnostic messages about the values of those keys.
+func yamlKeyRanges(filename string, src []byte, doc *yaml.Node) map[string]tfdiags.SourceRange {
+	ret := make(map[string]tfdiags.SourceRange)
+	if len(doc.Content) == 0 {
+		return ret
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return ret
+	}
+
+	// The YAML parser reports only lines and columns, so we need the offsets
+	// of the start of each line too.
+	lineStarts := []int{0}
+	for i, b := range src {
+		if b == '\n' {
+			lineStarts = append(lineStarts, i+1)
+		}
+	}
+	pos := func(line, column int) tfdiags.SourcePos {
+		ret := tfdiags.SourcePos{Line: line, Column: column}
+		if line >= 1 && line <= len(lineStarts) {
+			ret.Byte = lineStarts[line-1] + column - 1
+		}
+		return ret
+	}
+
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		key := root.Content[i]
+		if _, exists := ret[key.Value]; exists {
+			continue
+		}
+		ret[key.Value] = tfdiags.SourceRange{
+			Filename: filename,
+			Start:    pos(key.Line, key.Column),
+			End:      pos(key.Line, key.Column+len(key.Value)),
+		}
+	}
+	return ret
+}
+
 // unparsedVariableValueExpression is a backend.UnparsedVariableValue
 // implementation that was actually already parsed (!). This is
 // intended to deal with expressions inside "tfvars" files.
@@ -304,3 +443,57 @@ func (v unparsedVariableValueString) ParseVariableValue(mode configs.VariablePar
 		SourceType: v.sourceType,
 	}, diags
 }
+
+// unparsedVariableValueYAML is a backend.UnparsedVariableValue implementation
+// for values that were already decoded from a YAML variables file.
+type unparsedVariableValueYAML struct {
+	value       cty.Value
+	sourceType  tofu.ValueSourceType
+	sourceRange tfdiags.SourceRange
+}
+
+func (v unparsedVariableValueYAML) ParseVariableValue(mode configs.VariableParsingMode) (*tofu.InputValue, tfdiags.Diagnostics) {
+	return &tofu.InputValue{
+		Value:       v.value,
+		SourceType:  v.sourceType,
+		SourceRange: v.sourceRange,
+	}, nil
+}
+
+// unparsedVariableValueJSON is a backend.UnparsedVariableValue implementation
+// that decodes its value from a JSON string given in an environment variable
+// with the VarEnvJSONSuffix suffix. Unlike unparsedVariableValueString, it
+// decodes the JSON regardless of the parsing mode, so the value of a
+// string-typed variable must be a JSON string.
+type unparsedVariableValueJSON struct {
+	str        string
+	name       string
+	sourceType tofu.ValueSourceType
+}
+
+func (v unparsedVariableValueJSON) ParseVariableValue(mode configs.VariableParsingMode) (*tofu.InputValue, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	src := []byte(v.str)
+	ty, err := ctyjson.ImpliedType(src)
+	var val cty.Value
+	if err == nil {
+		val, err = ctyjson.Unmarshal(src, ty)
+	}
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid JSON value for input variable",
+			fmt.Sprintf("The environment variable %s%s%s must contain a valid JSON encoding of the value for variable %q: %s.", VarEnvPrefix, v.name, VarEnvJSONSuffix, v.name, err),
+		))
+		return &tofu.InputValue{
+			Value:      cty.DynamicVal,
+			SourceType: v.sourceType,
+		}, diags
+	}
+
+	return &tofu.InputValue{
+		Value:      val,
+		SourceType: v.sourceType,
+	}, diags
+}
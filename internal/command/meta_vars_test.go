@@ -8,10 +8,15 @@ package command
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/zclconf/go-cty/cty"
+
 	"github.com/opentofu/opentofu/internal/backend"
 	"github.com/opentofu/opentofu/internal/command/workdir"
+	"github.com/opentofu/opentofu/internal/configs"
+	"github.com/opentofu/opentofu/internal/tfdiags"
 	"github.com/opentofu/opentofu/internal/tofu"
 )
 
@@ -47,6 +52,31 @@ func TestMeta_addVarsFromFile(t *testing.T) {
 			contents: jsonData,
 			errors:   false,
 		},
+		{
+			filename: "input.yaml",
+			contents: "foo: bar\n",
+			errors:   false,
+		},
+		{
+			filename: "input.yml",
+			contents: jsonData,
+			errors:   false,
+		},
+		{
+			filename: "empty.yaml",
+			contents: "",
+			errors:   false,
+		},
+		{
+			filename: "invalid.yaml",
+			contents: "foo: [bar\n",
+			errors:   true,
+		},
+		{
+			filename: "list.yaml",
+			contents: "- foo\n- bar\n",
+			errors:   true,
+		},
 		{
 			filename: "mismatch.tfvars",
 			contents: jsonData,
@@ -79,3 +109,156 @@ func TestMeta_addVarsFromFile(t *testing.T) {
 		})
 	}
 }
+
+func TestMeta_addVarsFromFileYAML(t *testing.T) {
+	target := filepath.Join(t.TempDir(), "vars.yaml")
+	src := `# Environment settings
+region: eu-west-1
+replicas: 3
+tags:
+  team: platform
+  cost-center: "1234"
+zones: [a, b]
+template: "${not_a_template}"
+`
+	if err := os.WriteFile(target, []byte(src), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	m := &Meta{
+		WorkingDir: workdir.NewDir("."),
+	}
+	to := make(map[string]backend.UnparsedVariableValue)
+	diags := m.addVarsFromFile(target, tofu.ValueFromNamedFile, to)
+	if diags.HasErrors() {
+		t.Fatal(diags.Err())
+	}
+
+	want := map[string]cty.Value{
+		"region":   cty.StringVal("eu-west-1"),
+		"replicas": cty.NumberIntVal(3),
+		"tags": cty.ObjectVal(map[string]cty.Value{
+			"team":        cty.StringVal("platform"),
+			"cost-center": cty.StringVal("1234"),
+		}),
+		"zones":    cty.TupleVal([]cty.Value{cty.StringVal("a"), cty.StringVal("b")}),
+		"template": cty.StringVal("${not_a_template}"),
+	}
+	if len(to) != len(want) {
+		t.Fatalf("wrong number of variables %d; want %d", len(to), len(want))
+	}
+	for name, wantVal := range want {
+		got, diags := to[name].ParseVariableValue(configs.VariableParseHCL)
+		if diags.HasErrors() {
+			t.Fatalf("%s: %s", name, diags.Err())
+		}
+		if !got.Value.RawEquals(wantVal) {
+			t.Errorf("wrong value for %s\ngot:  %#v\nwant: %#v", name, got.Value, wantVal)
+		}
+		if got.SourceType != tofu.ValueFromNamedFile {
+			t.Errorf("wrong source type for %s: %s", name, got.SourceType)
+		}
+	}
+
+	got, _ := to["replicas"].ParseVariableValue(configs.VariableParseHCL)
+	wantRange := tfdiags.SourceRange{
+		Filename: target,
+		Start:    tfdiags.SourcePos{Line: 3, Column: 1, Byte: 41},
+		End:      tfdiags.SourcePos{Line: 3, Column: 9, Byte: 49},
+	}
+	if got.SourceRange != wantRange {
+		t.Errorf("wrong source range\ngot:  %#v\nwant: %#v", got.SourceRange, wantRange)
+	}
+}
+
+func TestMeta_addVarsFromFileJSONStrings(t *testing.T) {
+	// Strings in plain JSON documents are taken literally, whether they're
+	// decoded with the HCL JSON syntax or as YAML.
+	src := `{"template": "${literal}", "escaped": "$${literal}"}`
+	want := map[string]cty.Value{
+		"template": cty.StringVal("${literal}"),
+		"escaped":  cty.StringVal("$${literal}"),
+	}
+
+	for _, filename := range []string{"vars.json", "vars.tfvars.json", "vars.yaml"} {
+		t.Run(filename, func(t *testing.T) {
+			target := filepath.Join(t.TempDir(), filename)
+			if err := os.WriteFile(target, []byte(src), 0600); err != nil {
+				t.Fatal(err)
+			}
+
+			m := &Meta{
+				WorkingDir: workdir.NewDir("."),
+			}
+			to := make(map[string]backend.UnparsedVariableValue)
+			diags := m.addVarsFromFile(target, tofu.ValueFromNamedFile, to)
+			if diags.HasErrors() {
+				t.Fatal(diags.Err())
+			}
+			for name, wantVal := range want {
+				got, diags := to[name].ParseVariableValue(configs.VariableParseHCL)
+				if diags.HasErrors() {
+					t.Fatalf("%s: %s", name, diags.Err())
+				}
+				if !got.Value.RawEquals(wantVal) {
+					t.Errorf("wrong value for %s\ngot:  %#v\nwant: %#v", name, got.Value, wantVal)
+				}
+			}
+		})
+	}
+}
+
+func TestMeta_collectVariableValuesEnvJSON(t *testing.T) {
+	t.Chdir(t.TempDir())
+	t.Setenv("TF_VAR_zones", `["ignored"]`)
+	t.Setenv("TF_VAR_zones_JSON", `["a", "b"]`)
+	t.Setenv("TF_VAR_tags_JSON", `{"team": "platform", "template": "${literal}"}`)
+	t.Setenv("TF_VAR_broken_JSON", `{"team": `)
+	t.Setenv("TF_VAR__JSON", `"no name"`)
+
+	m := &Meta{
+		WorkingDir: workdir.NewDir("."),
+	}
+	values, diags := m.collectVariableValues()
+	if diags.HasErrors() {
+		t.Fatal(diags.Err())
+	}
+
+	want := map[string]cty.Value{
+		"zones": cty.TupleVal([]cty.Value{cty.StringVal("a"), cty.StringVal("b")}),
+		"tags": cty.ObjectVal(map[string]cty.Value{
+			"team":     cty.StringVal("platform"),
+			"template": cty.StringVal("${literal}"),
+		}),
+		// The raw value remains available under the full name.
+		"zones_JSON": cty.StringVal(`["a", "b"]`),
+	}
+	for name, wantVal := range want {
+		v, ok := values[name]
+		if !ok {
+			t.Errorf("no value for %s", name)
+			continue
+		}
+		got, diags := v.ParseVariableValue(configs.VariableParseLiteral)
+		if diags.HasErrors() {
+			t.Fatalf("%s: %s", name, diags.Err())
+		}
+		if !got.Value.RawEquals(wantVal) {
+			t.Errorf("wrong value for %s\ngot:  %#v\nwant: %#v", name, got.Value, wantVal)
+		}
+		if got.SourceType != tofu.ValueFromEnvVar {
+			t.Errorf("wrong source type for %s: %s", name, got.SourceType)
+		}
+	}
+	if _, ok := values[""]; ok {
+		t.Error("TF_VAR__JSON set a variable with an empty name")
+	}
+
+	_, diags = values["broken"].ParseVariableValue(configs.VariableParseHCL)
+	if !diags.HasErrors() {
+		t.Fatal("invalid JSON was accepted")
+	}
+	if got, want := diags.Err().Error(), `The environment variable TF_VAR_broken_JSON must contain a valid JSON encoding of the value for variable "broken"`; !strings.Contains(got, want) {
+		t.Errorf("wrong error\ngot:  %s\nwant: %s", got, want)
+	}
+}
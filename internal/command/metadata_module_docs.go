@@ -0,0 +1,134 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/mitchellh/cli"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/command/arguments"
+	"github.com/opentofu/opentofu/internal/command/moduledocs"
+	"github.com/opentofu/opentofu/internal/command/views"
+	"github.com/opentofu/opentofu/internal/configs"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// MetadataModuleDocsCommand is a Command implementation that prints out
+// documentation for the interface of a module.
+type MetadataModuleDocsCommand struct {
+	Meta
+}
+
+func (c *MetadataModuleDocsCommand) Help() string {
+	return metadataModuleDocsCommandHelp
+}
+
+func (c *MetadataModuleDocsCommand) Synopsis() string {
+	return "Show documentation for the inputs and outputs of a module"
+}
+
+func (c *MetadataModuleDocsCommand) Run(rawArgs []string) int {
+	// new view
+	common, rawArgs := arguments.ParseView(rawArgs)
+	c.View.Configure(common)
+
+	// Parse and validate flags
+	args, closer, diags := arguments.ParseMetadataModuleDocs(rawArgs)
+	defer closer()
+
+	// Instantiate the view, even if there are flag errors, so that we render
+	// diagnostics according to the desired view
+	view := views.NewMetadataModuleDocs(args.JSON, c.View)
+	if diags.HasErrors() {
+		view.Diagnostics(diags)
+		return cli.RunResultHelp
+	}
+
+	mod, files, moreDiags := c.loadModuleForDocs(args.Path)
+	diags = diags.Append(moreDiags)
+	if diags.HasErrors() {
+		view.Diagnostics(diags)
+		return 1
+	}
+
+	docs, err := moduledocs.New(mod, files)
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to document module",
+			fmt.Sprintf("Failed to produce the documentation for the module in %s: %s.", args.Path, err),
+		))
+		view.Diagnostics(diags)
+		return 1
+	}
+
+	view.Diagnostics(diags)
+	if !view.PrintModuleDocs(docs) {
+		return 1
+	}
+	return 0
+}
+
+// loadModuleForDocs loads the single module in the given directory, along
+// with the source files it was loaded from.
+//
+// Unlike loadSingleModule, this doesn't use any input variable values given
+// on the command line or interactively, because the documentation describes
+// the module as it would be called by any caller. Variables used during early
+// evaluation take their default values if they have them, or are unknown
+// otherwise.
+func (m *Meta) loadModuleForDocs(dir string) (*configs.Module, map[string]*hcl.File, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+	dir = m.WorkingDir.NormalizePath(dir)
+
+	loader, err := m.initConfigLoader()
+	if err != nil {
+		diags = diags.Append(err)
+		return nil, nil, diags
+	}
+	if !loader.IsConfigDir(dir) {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"No configuration files",
+			fmt.Sprintf("The directory %s contains no OpenTofu configuration files.", dir),
+		))
+		return nil, nil, diags
+	}
+
+	call := configs.NewStaticModuleCall(addrs.RootModule, hcl.Range{}, func(v *configs.Variable) (cty.Value, hcl.Diagnostics) {
+		if v.Default != cty.NilVal {
+			return v.Default, nil
+		}
+		return cty.UnknownVal(v.Type), nil
+	}, dir, "").WithDisabledFunctions(m.DisabledFunctions)
+	mod, hclDiags := loader.Parser().LoadConfigDir(dir, call)
+	diags = diags.Append(hclDiags)
+	return mod, loader.Sources(), diags
+}
+
+const metadataModuleDocsCommandHelp = `
+Usage: tofu [global options] metadata module-docs [options] [DIR]
+
+  Prints documentation for the module in the given directory, or in the
+  current directory if no directory is given.
+
+  The documentation describes the input variables of the module, including
+  their types, default values, descriptions, and validation rules, along
+  with its output values, the providers it requires, the resources it
+  manages, and the other modules it calls.
+
+  By default the documentation is printed in Markdown format, suitable for
+  including in the README file of the module.
+
+Options:
+
+  -json    Print the documentation in a machine-readable JSON format
+           instead of Markdown.
+`
@@ -0,0 +1,128 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/opentofu/opentofu/internal/command/moduledocs"
+	"github.com/opentofu/opentofu/internal/command/workdir"
+)
+
+func TestMetadataModuleDocs_json(t *testing.T) {
+	view, done := testView(t)
+	c := &MetadataModuleDocsCommand{
+		Meta: Meta{
+			WorkingDir: workdir.NewDir("."),
+			View:       view,
+		},
+	}
+
+	code := c.Run([]string{"-json", testFixturePath("metadata-module-docs")})
+	output := done(t)
+	if code != 0 {
+		t.Fatalf("wrong exit status %d; want 0\nstderr: %s", code, output.Stderr())
+	}
+
+	var got moduledocs.Module
+	if err := json.Unmarshal([]byte(output.Stdout()), &got); err != nil {
+		t.Fatalf("invalid JSON output: %s\n%s", err, output.Stdout())
+	}
+	want := moduledocs.Module{
+		FormatVersion: "1.0",
+		Variables: []moduledocs.Variable{
+			{
+				Name:        "name",
+				Type:        "string",
+				Description: "The name of the instance.",
+				Required:    true,
+				Nullable:    true,
+				Validations: []moduledocs.Validation{
+					{
+						Condition:    "length(var.name) > 0",
+						ErrorMessage: "The name must not be empty.",
+					},
+				},
+			},
+			{
+				Name:      "settings",
+				Type:      "object({size = optional(number, 1), tags = optional(map(string))})",
+				Default:   json.RawMessage(`{"size":1,"tags":null}`),
+				Nullable:  true,
+				Sensitive: true,
+			},
+		},
+		Outputs: []moduledocs.Output{
+			{Name: "id", Description: "The ID of the instance."},
+		},
+		Providers: []moduledocs.Provider{
+			{Name: "test", Source: "hashicorp/test", VersionConstraints: ">= 1.0.0"},
+		},
+		Resources: []moduledocs.Resource{
+			{Address: "test_instance.foo", Mode: "managed", Type: "test_instance", Name: "foo", Provider: "hashicorp/test"},
+			{Address: "data.test_data_source.bar", Mode: "data", Type: "test_data_source", Name: "bar", Provider: "hashicorp/test"},
+		},
+		ModuleCalls: []moduledocs.ModuleCall{
+			{Name: "child", Source: "./child"},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("wrong result\n%s", diff)
+	}
+}
+
+func TestMetadataModuleDocs_markdown(t *testing.T) {
+	view, done := testView(t)
+	c := &MetadataModuleDocsCommand{
+		Meta: Meta{
+			WorkingDir: workdir.NewDir("."),
+			View:       view,
+		},
+	}
+
+	code := c.Run([]string{testFixturePath("metadata-module-docs")})
+	output := done(t)
+	if code != 0 {
+		t.Fatalf("wrong exit status %d; want 0\nstderr: %s", code, output.Stderr())
+	}
+
+	got := output.Stdout()
+	for _, want := range []string{
+		"## Providers\n\n| Name | Source | Version |\n|------|------|------|\n| `test` | `hashicorp/test` | >= 1.0.0 |\n",
+		"## Modules\n\n| Name | Source | Version |\n|------|------|------|\n| `child` | `./child` | n/a |\n",
+		"| `test_instance.foo` | resource |\n| `data.test_data_source.bar` | data source |\n",
+		"| `name` | The name of the instance. | `string` | n/a | yes |\n",
+		"| `settings` |  | `object({size = optional(number, 1), tags = optional(map(string))})` | `{\"size\":1,\"tags\":null}` | no |\n",
+		"### Validation rules\n\n- `name`: The name must not be empty. (condition: `length(var.name) > 0`)\n",
+		"| `id` | The ID of the instance. | no |\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("output is missing %q\ngot:\n%s", want, got)
+		}
+	}
+}
+
+func TestMetadataModuleDocs_noConfig(t *testing.T) {
+	view, done := testView(t)
+	c := &MetadataModuleDocsCommand{
+		Meta: Meta{
+			WorkingDir: workdir.NewDir("."),
+			View:       view,
+		},
+	}
+
+	code := c.Run([]string{t.TempDir()})
+	output := done(t)
+	if code != 1 {
+		t.Fatalf("wrong exit status %d; want 1\nstdout: %s", code, output.Stdout())
+	}
+	if got, want := output.Stderr(), "No configuration files"; !strings.Contains(got, want) {
+		t.Errorf("wrong error\ngot: %s\nwant: %s", got, want)
+	}
+}
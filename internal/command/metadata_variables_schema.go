@@ -0,0 +1,91 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"fmt"
+
+	"github.com/mitchellh/cli"
+
+	"github.com/opentofu/opentofu/internal/command/arguments"
+	"github.com/opentofu/opentofu/internal/command/moduledocs"
+	"github.com/opentofu/opentofu/internal/command/views"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// MetadataVariablesSchemaCommand is a Command implementation that prints out
+// a JSON Schema document describing the input variables of a module.
+type MetadataVariablesSchemaCommand struct {
+	Meta
+}
+
+func (c *MetadataVariablesSchemaCommand) Help() string {
+	return metadataVariablesSchemaCommandHelp
+}
+
+func (c *MetadataVariablesSchemaCommand) Synopsis() string {
+	return "Show a JSON Schema for the input variables of a module"
+}
+
+func (c *MetadataVariablesSchemaCommand) Run(rawArgs []string) int {
+	// new view
+	common, rawArgs := arguments.ParseView(rawArgs)
+	c.View.Configure(common)
+
+	// Parse and validate flags
+	args, closer, diags := arguments.ParseMetadataVariablesSchema(rawArgs)
+	defer closer()
+
+	view := views.NewMetadataVariablesSchema(c.View)
+	if diags.HasErrors() {
+		view.Diagnostics(diags)
+		return cli.RunResultHelp
+	}
+
+	mod, _, moreDiags := c.loadModuleForDocs(args.Path)
+	diags = diags.Append(moreDiags)
+	if diags.HasErrors() {
+		view.Diagnostics(diags)
+		return 1
+	}
+
+	schema, err := moduledocs.VariablesSchema(mod)
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to describe module variables",
+			fmt.Sprintf("Failed to produce the variables schema for the module in %s: %s.", args.Path, err),
+		))
+		view.Diagnostics(diags)
+		return 1
+	}
+
+	view.Diagnostics(diags)
+	if !view.PrintSchema(schema) {
+		return 1
+	}
+	return 0
+}
+
+const metadataVariablesSchemaCommandHelp = `
+Usage: tofu [global options] metadata variables-schema [DIR]
+
+  Prints a JSON Schema document describing the input variables of the module
+  in the given directory, or in the current directory if no directory is
+  given.
+
+  The schema describes an object with a property for each input variable,
+  such as the contents of a .tfvars.json file, including the types, default
+  values, and descriptions of the variables. Validation rules that only allow
+  a fixed set of values or a range of numbers are included when they can be
+  recognized without evaluating them, such as:
+
+      contains(["small", "large"], var.size)
+      var.count >= 1 && var.count <= 10
+
+  The schema can be used to validate variable definitions files, or by other
+  tools to generate forms for setting the variables.
+`
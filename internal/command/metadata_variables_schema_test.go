@@ -0,0 +1,88 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/opentofu/opentofu/internal/command/workdir"
+)
+
+func TestMetadataVariablesSchema(t *testing.T) {
+	view, done := testView(t)
+	c := &MetadataVariablesSchemaCommand{
+		Meta: Meta{
+			WorkingDir: workdir.NewDir("."),
+			View:       view,
+		},
+	}
+
+	code := c.Run([]string{testFixturePath("metadata-variables-schema")})
+	output := done(t)
+	if code != 0 {
+		t.Fatalf("wrong exit status %d; want 0\nstderr: %s", code, output.Stderr())
+	}
+
+	var got map[string]any
+	if err := json.Unmarshal([]byte(output.Stdout()), &got); err != nil {
+		t.Fatalf("invalid JSON output: %s\n%s", err, output.Stdout())
+	}
+	want := map[string]any{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type":    "object",
+		"properties": map[string]any{
+			"name": map[string]any{
+				"type":        "string",
+				"description": "The name of the instance.",
+			},
+			"settings": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"count": map[string]any{"type": "number", "default": 1.0},
+					"tags": map[string]any{
+						"type":                 "object",
+						"additionalProperties": map[string]any{"type": "string"},
+					},
+				},
+				"default":   map[string]any{"count": 1.0, "tags": nil},
+				"writeOnly": true,
+			},
+			"size": map[string]any{
+				"type":    "string",
+				"default": "small",
+				"enum":    []any{"small", "large"},
+			},
+		},
+		"required":             []any{"name"},
+		"additionalProperties": false,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("wrong result\n%s", diff)
+	}
+}
+
+func TestMetadataVariablesSchema_noConfig(t *testing.T) {
+	view, done := testView(t)
+	c := &MetadataVariablesSchemaCommand{
+		Meta: Meta{
+			WorkingDir: workdir.NewDir("."),
+			View:       view,
+		},
+	}
+
+	code := c.Run([]string{t.TempDir()})
+	output := done(t)
+	if code != 1 {
+		t.Fatalf("wrong exit status %d; want 1\nstdout: %s", code, output.Stdout())
+	}
+	if got, want := output.Stderr(), "No configuration files"; !strings.Contains(got, want) {
+		t.Errorf("wrong error\ngot: %s\nwant: %s", got, want)
+	}
+}
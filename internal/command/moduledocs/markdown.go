@@ -0,0 +1,152 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package moduledocs
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Markdown renders the given module documentation as a Markdown document
+// with a section for each kind of object, intended to be included in the
+// README file of the module.
+func Markdown(m *Module) string {
+	var buf strings.Builder
+
+	buf.WriteString("## Providers\n\n")
+	if len(m.Providers) == 0 {
+		buf.WriteString("No providers.\n")
+	} else {
+		writeTable(&buf, []string{"Name", "Source", "Version"}, len(m.Providers), func(i int) []string {
+			p := m.Providers[i]
+			return []string{code(p.Name), code(p.Source), orNA(p.VersionConstraints)}
+		})
+	}
+
+	buf.WriteString("\n## Modules\n\n")
+	if len(m.ModuleCalls) == 0 {
+		buf.WriteString("No modules.\n")
+	} else {
+		writeTable(&buf, []string{"Name", "Source", "Version"}, len(m.ModuleCalls), func(i int) []string {
+			mc := m.ModuleCalls[i]
+			return []string{code(mc.Name), code(mc.Source), orNA(mc.VersionConstraint)}
+		})
+	}
+
+	buf.WriteString("\n## Resources\n\n")
+	if len(m.Resources) == 0 {
+		buf.WriteString("No resources.\n")
+	} else {
+		writeTable(&buf, []string{"Name", "Type"}, len(m.Resources), func(i int) []string {
+			r := m.Resources[i]
+			kind := "resource"
+			switch r.Mode {
+			case "data":
+				kind = "data source"
+			case "ephemeral":
+				kind = "ephemeral resource"
+			}
+			return []string{code(r.Address), kind}
+		})
+	}
+
+	buf.WriteString("\n## Inputs\n\n")
+	if len(m.Variables) == 0 {
+		buf.WriteString("No inputs.\n")
+	} else {
+		writeTable(&buf, []string{"Name", "Description", "Type", "Default", "Required"}, len(m.Variables), func(i int) []string {
+			v := m.Variables[i]
+			def := "n/a"
+			if v.Default != nil {
+				def = code(string(v.Default))
+			}
+			required := "no"
+			if v.Required {
+				required = "yes"
+			}
+			return []string{code(v.Name), v.Description, code(v.Type), def, required}
+		})
+
+		var hasValidations bool
+		for _, v := range m.Variables {
+			if len(v.Validations) != 0 {
+				hasValidations = true
+				break
+			}
+		}
+		if hasValidations {
+			buf.WriteString("\n### Validation rules\n\n")
+			for _, v := range m.Variables {
+				for _, rule := range v.Validations {
+					fmt.Fprintf(&buf, "- %s: %s (condition: %s)\n", code(v.Name), oneLine(rule.ErrorMessage), code(rule.Condition))
+				}
+			}
+		}
+	}
+
+	buf.WriteString("\n## Outputs\n\n")
+	if len(m.Outputs) == 0 {
+		buf.WriteString("No outputs.\n")
+	} else {
+		writeTable(&buf, []string{"Name", "Description", "Sensitive"}, len(m.Outputs), func(i int) []string {
+			o := m.Outputs[i]
+			sensitive := "no"
+			if o.Sensitive {
+				sensitive = "yes"
+			}
+			return []string{code(o.Name), o.Description, sensitive}
+		})
+	}
+
+	return buf.String()
+}
+
+// writeTable writes a Markdown table with the given column headings and
+// the given number of rows, using the row function to produce the cells of
+// each row.
+func writeTable(buf *strings.Builder, headings []string, rows int, row func(i int) []string) {
+	fmt.Fprintf(buf, "| %s |\n", strings.Join(headings, " | "))
+	for range headings {
+		buf.WriteString("|------")
+	}
+	buf.WriteString("|\n")
+	for i := range rows {
+		cells := row(i)
+		for j, cell := range cells {
+			cells[j] = tableCell(cell)
+		}
+		fmt.Fprintf(buf, "| %s |\n", strings.Join(cells, " | "))
+	}
+}
+
+// tableCell escapes the given text for use in a Markdown table cell, which
+// must be on a single line and must not contain unescaped pipe characters.
+func tableCell(s string) string {
+	s = strings.TrimSpace(s)
+	s = strings.ReplaceAll(s, "|", `\|`)
+	return strings.ReplaceAll(s, "\n", "<br>")
+}
+
+// code formats the given text as a Markdown code span.
+func code(s string) string {
+	s = oneLine(s)
+	if strings.Contains(s, "`") {
+		return "`` " + s + " ``"
+	}
+	return "`" + s + "`"
+}
+
+// oneLine collapses the given text onto a single line.
+func oneLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+func orNA(s string) string {
+	if s == "" {
+		return "n/a"
+	}
+	return s
+}
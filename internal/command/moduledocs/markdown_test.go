@@ -0,0 +1,43 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package moduledocs
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMarkdown_empty(t *testing.T) {
+	got := Markdown(&Module{})
+	for _, want := range []string{
+		"No providers.",
+		"No modules.",
+		"No resources.",
+		"No inputs.",
+		"No outputs.",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("output is missing %q\ngot:\n%s", want, got)
+		}
+	}
+}
+
+func TestMarkdown_escaping(t *testing.T) {
+	got := Markdown(&Module{
+		Variables: []Variable{
+			{
+				Name:        "tags",
+				Type:        "map(string)",
+				Description: "Tags to apply,\nsuch as a | b.",
+				Default:     []byte("{\"a\":\"`b`\"}"),
+			},
+		},
+	})
+	want := "| `tags` | Tags to apply,<br>such as a \\| b. | `map(string)` | `` {\"a\":\"`b`\"} `` | no |\n"
+	if !strings.Contains(got, want) {
+		t.Errorf("output is missing %q\ngot:\n%s", want, got)
+	}
+}
@@ -0,0 +1,341 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package moduledocs produces documentation for the interface of a single
+// module, as used by the "tofu metadata module-docs" command, and the JSON
+// Schema for its input variables, as used by the
+// "tofu metadata variables-schema" command.
+//
+// The documentation is derived directly from the module as decoded by
+// package configs, so that it describes the module exactly as OpenTofu would
+// interpret it.
+package moduledocs
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/ext/typeexpr"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/configs"
+)
+
+// FormatVersion represents the version of the json format and will be
+// incremented for any change to this format that requires changes to a
+// consuming parser.
+const FormatVersion = "1.0"
+
+// Module describes the interface of a module: the input variables it
+// accepts, the output values it returns, and what it depends on.
+type Module struct {
+	FormatVersion string       `json:"format_version"`
+	Variables     []Variable   `json:"variables"`
+	Outputs       []Output     `json:"outputs"`
+	Providers     []Provider   `json:"providers"`
+	Resources     []Resource   `json:"resources"`
+	ModuleCalls   []ModuleCall `json:"module_calls"`
+}
+
+// Variable describes an input variable declared by a module.
+type Variable struct {
+	Name string `json:"name"`
+
+	// Type is the type constraint of the variable, written in the same
+	// syntax as the "type" argument of a variable block.
+	Type string `json:"type"`
+
+	Description string `json:"description,omitempty"`
+
+	// Default is the JSON representation of the default value, which is
+	// omitted if the variable is required or if its default value can't be
+	// determined without configuring providers.
+	Default json.RawMessage `json:"default,omitempty"`
+
+	Required   bool   `json:"required"`
+	Nullable   bool   `json:"nullable"`
+	Sensitive  bool   `json:"sensitive,omitempty"`
+	Ephemeral  bool   `json:"ephemeral,omitempty"`
+	Deprecated string `json:"deprecated,omitempty"`
+
+	Validations []Validation `json:"validations,omitempty"`
+}
+
+// Validation describes a validation rule of an input variable.
+type Validation struct {
+	// Condition is the source code of the condition expression.
+	Condition string `json:"condition"`
+
+	// ErrorMessage is the error message for the rule, if it is a constant
+	// string, or the source code of the error message expression otherwise.
+	ErrorMessage string `json:"error_message"`
+}
+
+// Output describes an output value declared by a module.
+type Output struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Sensitive   bool   `json:"sensitive,omitempty"`
+	Ephemeral   bool   `json:"ephemeral,omitempty"`
+	Deprecated  string `json:"deprecated,omitempty"`
+}
+
+// Provider describes a provider required by a module, either explicitly in
+// a required_providers block or implicitly by a resource belonging to it.
+type Provider struct {
+	// Name is the local name the module uses for the provider.
+	Name string `json:"name"`
+
+	// Source is the provider source address.
+	Source string `json:"source"`
+
+	VersionConstraints string `json:"version_constraints,omitempty"`
+}
+
+// Resource describes a resource declared by a module.
+type Resource struct {
+	Address string `json:"address"`
+
+	// Mode can be "managed", "data", or "ephemeral".
+	Mode string `json:"mode"`
+
+	Type string `json:"type"`
+	Name string `json:"name"`
+
+	// Provider is the source address of the provider the resource belongs to.
+	Provider string `json:"provider"`
+}
+
+// ModuleCall describes a call to a child module.
+type ModuleCall struct {
+	Name              string `json:"name"`
+	Source            string `json:"source"`
+	VersionConstraint string `json:"version_constraint,omitempty"`
+}
+
+// New returns the documentation for the given module.
+//
+// files are the source files the module was loaded from, indexed by
+// filename, which are used to include the source code of expressions such
+// as validation conditions. Expressions whose source isn't available are
+// described as empty strings.
+func New(mod *configs.Module, files map[string]*hcl.File) (*Module, error) {
+	ret := &Module{
+		FormatVersion: FormatVersion,
+		Variables:     []Variable{},
+		Outputs:       []Output{},
+		Providers:     []Provider{},
+		Resources:     []Resource{},
+		ModuleCalls:   []ModuleCall{},
+	}
+
+	for _, name := range sortedKeys(mod.Variables) {
+		v := mod.Variables[name]
+		variable := Variable{
+			Name:        v.Name,
+			Type:        typeString(v.ConstraintType, v.TypeDefaults),
+			Description: v.Description,
+			Required:    v.Required(),
+			Nullable:    v.Nullable,
+			Sensitive:   v.Sensitive,
+			Ephemeral:   v.Ephemeral,
+			Deprecated:  v.Deprecated,
+		}
+		if v.Default != cty.NilVal && v.DefaultExpr == nil {
+			raw, err := ctyjson.Marshal(v.Default, v.Default.Type())
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal default value of variable %q: %w", v.Name, err)
+			}
+			variable.Default = raw
+		}
+		for _, rule := range v.Validations {
+			variable.Validations = append(variable.Validations, Validation{
+				Condition:    exprSource(rule.Condition, files),
+				ErrorMessage: errorMessageString(rule.ErrorMessage, files),
+			})
+		}
+		ret.Variables = append(ret.Variables, variable)
+	}
+
+	for _, name := range sortedKeys(mod.Outputs) {
+		o := mod.Outputs[name]
+		ret.Outputs = append(ret.Outputs, Output{
+			Name:        o.Name,
+			Description: o.Description,
+			Sensitive:   o.Sensitive,
+			Ephemeral:   o.Ephemeral,
+			Deprecated:  o.Deprecated,
+		})
+	}
+
+	seenProviders := make(map[addrs.Provider]bool)
+	if mod.ProviderRequirements != nil {
+		for _, name := range sortedKeys(mod.ProviderRequirements.RequiredProviders) {
+			rp := mod.ProviderRequirements.RequiredProviders[name]
+			seenProviders[rp.Type] = true
+			ret.Providers = append(ret.Providers, Provider{
+				Name:               rp.Name,
+				Source:             rp.Type.ForDisplay(),
+				VersionConstraints: rp.Requirement.Required.String(),
+			})
+		}
+	}
+
+	var implied []Provider
+	for _, resources := range []map[string]*configs.Resource{mod.ManagedResources, mod.DataResources, mod.EphemeralResources} {
+		for _, key := range sortedKeys(resources) {
+			r := resources[key]
+			ret.Resources = append(ret.Resources, Resource{
+				Address:  r.Addr().String(),
+				Mode:     resourceModeString(r.Mode),
+				Type:     r.Type,
+				Name:     r.Name,
+				Provider: r.Provider.ForDisplay(),
+			})
+			if !seenProviders[r.Provider] {
+				seenProviders[r.Provider] = true
+				implied = append(implied, Provider{
+					Name:   mod.LocalNameForProvider(r.Provider),
+					Source: r.Provider.ForDisplay(),
+				})
+			}
+		}
+	}
+	// Providers that are only implied by resource types are not in the
+	// required_providers block, so we sort them separately to keep the
+	// declared requirements first.
+	slices.SortFunc(implied, func(a, b Provider) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	ret.Providers = append(ret.Providers, implied...)
+
+	for _, name := range sortedKeys(mod.ModuleCalls) {
+		mc := mod.ModuleCalls[name]
+		call := ModuleCall{
+			Name:   mc.Name,
+			Source: mc.SourceAddrRaw,
+		}
+		if mc.VersionAttr != nil {
+			call.VersionConstraint = mc.Version.Required.String()
+		}
+		ret.ModuleCalls = append(ret.ModuleCalls, call)
+	}
+
+	return ret, nil
+}
+
+func resourceModeString(mode addrs.ResourceMode) string {
+	switch mode {
+	case addrs.ManagedResourceMode:
+		return "managed"
+	case addrs.DataResourceMode:
+		return "data"
+	case addrs.EphemeralResourceMode:
+		return "ephemeral"
+	default:
+		return ""
+	}
+}
+
+// exprSource returns the source code of the given expression, or an empty
+// string if the source isn't available.
+func exprSource(expr hcl.Expression, files map[string]*hcl.File) string {
+	if expr == nil {
+		return ""
+	}
+	rng := expr.Range()
+	file, ok := files[rng.Filename]
+	if !ok || file == nil {
+		return ""
+	}
+	return string(rng.SliceBytes(file.Bytes))
+}
+
+// errorMessageString returns the given error message as a string if it is a
+// constant, or its source code otherwise.
+func errorMessageString(expr hcl.Expression, files map[string]*hcl.File) string {
+	if expr == nil {
+		return ""
+	}
+	// Most error messages are just literal strings, and returning those
+	// directly avoids exposing details of the syntax, such as escaping.
+	if val, diags := expr.Value(nil); !diags.HasErrors() && val.Type() == cty.String && val.IsWhollyKnown() && !val.IsNull() {
+		return val.AsString()
+	}
+	return exprSource(expr, files)
+}
+
+// typeString returns a representation of the given type constraint in the
+// same syntax as the "type" argument of a variable block, including any
+// optional attributes and their default values.
+func typeString(ty cty.Type, defaults *typeexpr.Defaults) string {
+	switch {
+	case ty == cty.NilType, ty == cty.DynamicPseudoType:
+		return "any"
+	case ty == cty.String:
+		return "string"
+	case ty == cty.Number:
+		return "number"
+	case ty == cty.Bool:
+		return "bool"
+	case ty.IsListType():
+		return fmt.Sprintf("list(%s)", typeString(ty.ElementType(), childDefaults(defaults, "")))
+	case ty.IsSetType():
+		return fmt.Sprintf("set(%s)", typeString(ty.ElementType(), childDefaults(defaults, "")))
+	case ty.IsMapType():
+		return fmt.Sprintf("map(%s)", typeString(ty.ElementType(), childDefaults(defaults, "")))
+	case ty.IsTupleType():
+		elems := ty.TupleElementTypes()
+		parts := make([]string, len(elems))
+		for i, ety := range elems {
+			parts[i] = typeString(ety, childDefaults(defaults, fmt.Sprint(i)))
+		}
+		return fmt.Sprintf("tuple([%s])", strings.Join(parts, ", "))
+	case ty.IsObjectType():
+		atys := ty.AttributeTypes()
+		parts := make([]string, 0, len(atys))
+		for _, name := range sortedKeys(atys) {
+			attrStr := typeString(atys[name], childDefaults(defaults, name))
+			if ty.AttributeOptional(name) {
+				if defaults != nil {
+					if def, ok := defaults.DefaultValues[name]; ok {
+						attrStr = fmt.Sprintf("%s, %s", attrStr, hclwrite.TokensForValue(def).Bytes())
+					}
+				}
+				attrStr = fmt.Sprintf("optional(%s)", attrStr)
+			}
+			if !hclsyntax.ValidIdentifier(name) {
+				name = fmt.Sprintf("%q", name)
+			}
+			parts = append(parts, fmt.Sprintf("%s = %s", name, attrStr))
+		}
+		return fmt.Sprintf("object({%s})", strings.Join(parts, ", "))
+	default:
+		return ty.FriendlyName()
+	}
+}
+
+func childDefaults(defaults *typeexpr.Defaults, key string) *typeexpr.Defaults {
+	if defaults == nil {
+		return nil
+	}
+	return defaults.Children[key]
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,62 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package moduledocs
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2/ext/typeexpr"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestTypeString(t *testing.T) {
+	tests := []struct {
+		ty       cty.Type
+		defaults *typeexpr.Defaults
+		want     string
+	}{
+		{cty.NilType, nil, "any"},
+		{cty.DynamicPseudoType, nil, "any"},
+		{cty.List(cty.String), nil, "list(string)"},
+		{cty.Map(cty.Set(cty.Number)), nil, "map(set(number))"},
+		{cty.Tuple([]cty.Type{cty.String, cty.Bool}), nil, "tuple([string, bool])"},
+		{
+			cty.ObjectWithOptionalAttrs(map[string]cty.Type{
+				"name": cty.String,
+				"size": cty.Number,
+			}, []string{"size"}),
+			&typeexpr.Defaults{
+				DefaultValues: map[string]cty.Value{
+					"size": cty.NumberIntVal(2),
+				},
+			},
+			"object({name = string, size = optional(number, 2)})",
+		},
+		{
+			cty.List(cty.ObjectWithOptionalAttrs(map[string]cty.Type{
+				"enabled": cty.Bool,
+			}, []string{"enabled"})),
+			&typeexpr.Defaults{
+				Children: map[string]*typeexpr.Defaults{
+					"": {
+						DefaultValues: map[string]cty.Value{
+							"enabled": cty.True,
+						},
+					},
+				},
+			},
+			"list(object({enabled = optional(bool, true)}))",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.want, func(t *testing.T) {
+			if got := typeString(test.ty, test.defaults); got != test.want {
+				t.Errorf("wrong result\ngot:  %s\nwant: %s", got, test.want)
+			}
+		})
+	}
+}
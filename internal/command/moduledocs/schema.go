@@ -0,0 +1,342 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package moduledocs
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/ext/typeexpr"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+
+	"github.com/opentofu/opentofu/internal/configs"
+)
+
+// SchemaDialect is the JSON Schema dialect of the documents returned by
+// [VariablesSchema].
+const SchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// Schema is a JSON Schema document, or a subschema within one.
+//
+// Only the keywords needed to describe OpenTofu type constraints are
+// supported. An empty Schema accepts any value.
+type Schema struct {
+	Schema      string          `json:"$schema,omitempty"`
+	Type        string          `json:"type,omitempty"`
+	Description string          `json:"description,omitempty"`
+	Default     json.RawMessage `json:"default,omitempty"`
+	Deprecated  bool            `json:"deprecated,omitempty"`
+
+	// WriteOnly is set for sensitive variables, so that tools generating
+	// forms from the schema know not to display their values.
+	WriteOnly bool `json:"writeOnly,omitempty"`
+
+	Enum             []json.RawMessage `json:"enum,omitempty"`
+	Minimum          json.RawMessage   `json:"minimum,omitempty"`
+	ExclusiveMinimum json.RawMessage   `json:"exclusiveMinimum,omitempty"`
+	Maximum          json.RawMessage   `json:"maximum,omitempty"`
+	ExclusiveMaximum json.RawMessage   `json:"exclusiveMaximum,omitempty"`
+
+	Items       *Schema   `json:"items,omitempty"`
+	PrefixItems []*Schema `json:"prefixItems,omitempty"`
+	MinItems    *int      `json:"minItems,omitempty"`
+	MaxItems    *int      `json:"maxItems,omitempty"`
+	UniqueItems bool      `json:"uniqueItems,omitempty"`
+
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties any                `json:"additionalProperties,omitempty"`
+}
+
+// VariablesSchema returns a JSON Schema document describing an object whose
+// properties are the input variables of the given module, such as the
+// contents of a ".tfvars.json" file for the module.
+//
+// Each variable is described by its type constraint, description, and
+// default value. Validation rules that only allow a fixed set of values or
+// a range of numbers are described too, when they are written in a form
+// that can be recognized without evaluating them, such as
+// contains(["a", "b"], var.name) or var.count >= 1 && var.count <= 10.
+// Other validation rules are not described in the schema.
+func VariablesSchema(mod *configs.Module) (*Schema, error) {
+	ret := &Schema{
+		Schema:               SchemaDialect,
+		Type:                 "object",
+		Properties:           make(map[string]*Schema, len(mod.Variables)),
+		Required:             []string{},
+		AdditionalProperties: false,
+	}
+
+	for _, name := range sortedKeys(mod.Variables) {
+		v := mod.Variables[name]
+		prop, err := typeSchema(v.ConstraintType, v.TypeDefaults)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe the type of variable %q: %w", v.Name, err)
+		}
+		prop.Description = v.Description
+		prop.WriteOnly = v.Sensitive
+		prop.Deprecated = v.Deprecated != ""
+		if v.Default != cty.NilVal && v.DefaultExpr == nil {
+			raw, err := ctyjson.Marshal(v.Default, v.Default.Type())
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal default value of variable %q: %w", v.Name, err)
+			}
+			prop.Default = raw
+		}
+		if v.Required() {
+			ret.Required = append(ret.Required, v.Name)
+		}
+		for _, rule := range v.Validations {
+			inferValidationSchema(prop, v, rule.Condition)
+		}
+		ret.Properties[v.Name] = prop
+	}
+
+	return ret, nil
+}
+
+// typeSchema returns the schema for values of the given type constraint,
+// including the default values of any optional object attributes.
+func typeSchema(ty cty.Type, defaults *typeexpr.Defaults) (*Schema, error) {
+	switch {
+	case ty == cty.NilType, ty == cty.DynamicPseudoType:
+		return &Schema{}, nil
+	case ty == cty.String:
+		return &Schema{Type: "string"}, nil
+	case ty == cty.Number:
+		return &Schema{Type: "number"}, nil
+	case ty == cty.Bool:
+		return &Schema{Type: "boolean"}, nil
+	case ty.IsListType(), ty.IsSetType():
+		items, err := typeSchema(ty.ElementType(), childDefaults(defaults, ""))
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "array", Items: items, UniqueItems: ty.IsSetType()}, nil
+	case ty.IsMapType():
+		elem, err := typeSchema(ty.ElementType(), childDefaults(defaults, ""))
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "object", AdditionalProperties: elem}, nil
+	case ty.IsTupleType():
+		elems := ty.TupleElementTypes()
+		count := len(elems)
+		ret := &Schema{
+			Type:        "array",
+			PrefixItems: make([]*Schema, count),
+			MinItems:    &count,
+			MaxItems:    &count,
+		}
+		for i, ety := range elems {
+			elem, err := typeSchema(ety, childDefaults(defaults, fmt.Sprint(i)))
+			if err != nil {
+				return nil, err
+			}
+			ret.PrefixItems[i] = elem
+		}
+		return ret, nil
+	case ty.IsObjectType():
+		atys := ty.AttributeTypes()
+		ret := &Schema{
+			Type:       "object",
+			Properties: make(map[string]*Schema, len(atys)),
+		}
+		for _, name := range sortedKeys(atys) {
+			attr, err := typeSchema(atys[name], childDefaults(defaults, name))
+			if err != nil {
+				return nil, err
+			}
+			if !ty.AttributeOptional(name) {
+				ret.Required = append(ret.Required, name)
+			} else if defaults != nil {
+				if def, ok := defaults.DefaultValues[name]; ok && !def.IsNull() {
+					raw, err := ctyjson.Marshal(def, def.Type())
+					if err != nil {
+						return nil, fmt.Errorf("failed to marshal default value of attribute %q: %w", name, err)
+					}
+					attr.Default = raw
+				}
+			}
+			ret.Properties[name] = attr
+		}
+		return ret, nil
+	default:
+		return nil, fmt.Errorf("unsupported type %s", ty.FriendlyName())
+	}
+}
+
+// inferValidationSchema adds any constraints that can be recognized in the
+// given validation condition of the given variable to its schema.
+//
+// Conditions that aren't recognized are ignored, so this never makes the
+// schema stricter than the validation rule itself.
+func inferValidationSchema(schema *Schema, v *configs.Variable, cond hcl.Expression) {
+	ty := v.ConstraintType
+	if ty != cty.String && ty != cty.Number && ty != cty.Bool {
+		return
+	}
+
+	if values, ok := allowedValues(cond, v.Name, ty); ok {
+		if schema.Enum == nil {
+			schema.Enum = values
+		} else {
+			// Each validation rule must pass, so only the values allowed
+			// by all of them are valid.
+			schema.Enum = slices.DeleteFunc(schema.Enum, func(have json.RawMessage) bool {
+				return !slices.ContainsFunc(values, func(want json.RawMessage) bool {
+					return string(have) == string(want)
+				})
+			})
+		}
+		return
+	}
+
+	if ty == cty.Number {
+		inferNumberRange(schema, cond, v.Name)
+	}
+}
+
+// allowedValues returns the values allowed by the given condition, if it
+// is either a call to the contains function with a constant list and the
+// variable, or a chain of equality tests joined by ||.
+func allowedValues(cond hcl.Expression, name string, ty cty.Type) ([]json.RawMessage, bool) {
+	switch expr := cond.(type) {
+	case *hclsyntax.ParenthesesExpr:
+		return allowedValues(expr.Expression, name, ty)
+	case *hclsyntax.FunctionCallExpr:
+		if expr.Name != "contains" || len(expr.Args) != 2 || expr.ExpandFinal || !isVariableRef(expr.Args[1], name) {
+			return nil, false
+		}
+		list, ok := expr.Args[0].(*hclsyntax.TupleConsExpr)
+		if !ok {
+			return nil, false
+		}
+		ret := make([]json.RawMessage, 0, len(list.Exprs))
+		for _, item := range list.Exprs {
+			raw, ok := constantJSON(item, ty)
+			if !ok {
+				return nil, false
+			}
+			ret = append(ret, raw)
+		}
+		return ret, true
+	case *hclsyntax.BinaryOpExpr:
+		switch expr.Op {
+		case hclsyntax.OpLogicalOr:
+			lhs, ok := allowedValues(expr.LHS, name, ty)
+			if !ok {
+				return nil, false
+			}
+			rhs, ok := allowedValues(expr.RHS, name, ty)
+			if !ok {
+				return nil, false
+			}
+			return append(lhs, rhs...), true
+		case hclsyntax.OpEqual:
+			other := expr.RHS
+			if !isVariableRef(expr.LHS, name) {
+				if !isVariableRef(expr.RHS, name) {
+					return nil, false
+				}
+				other = expr.LHS
+			}
+			raw, ok := constantJSON(other, ty)
+			if !ok {
+				return nil, false
+			}
+			return []json.RawMessage{raw}, true
+		}
+	}
+	return nil, false
+}
+
+// inferNumberRange sets the minimum and maximum of the given schema from
+// comparisons between the variable and constant numbers in the given
+// condition, which may be joined by &&.
+func inferNumberRange(schema *Schema, cond hcl.Expression, name string) {
+	switch expr := cond.(type) {
+	case *hclsyntax.ParenthesesExpr:
+		inferNumberRange(schema, expr.Expression, name)
+	case *hclsyntax.BinaryOpExpr:
+		op := expr.Op
+		if op == hclsyntax.OpLogicalAnd {
+			inferNumberRange(schema, expr.LHS, name)
+			inferNumberRange(schema, expr.RHS, name)
+			return
+		}
+		bound := expr.RHS
+		if !isVariableRef(expr.LHS, name) {
+			if !isVariableRef(expr.RHS, name) {
+				return
+			}
+			// The variable is on the right hand side, as in 1 <= var.count,
+			// so the comparison is the other way around.
+			bound = expr.LHS
+			switch op {
+			case hclsyntax.OpGreaterThan:
+				op = hclsyntax.OpLessThan
+			case hclsyntax.OpGreaterThanOrEqual:
+				op = hclsyntax.OpLessThanOrEqual
+			case hclsyntax.OpLessThan:
+				op = hclsyntax.OpGreaterThan
+			case hclsyntax.OpLessThanOrEqual:
+				op = hclsyntax.OpGreaterThanOrEqual
+			}
+		}
+		raw, ok := constantJSON(bound, cty.Number)
+		if !ok {
+			return
+		}
+		switch op {
+		case hclsyntax.OpGreaterThan:
+			schema.ExclusiveMinimum = raw
+		case hclsyntax.OpGreaterThanOrEqual:
+			schema.Minimum = raw
+		case hclsyntax.OpLessThan:
+			schema.ExclusiveMaximum = raw
+		case hclsyntax.OpLessThanOrEqual:
+			schema.Maximum = raw
+		}
+	}
+}
+
+// isVariableRef returns true if the given expression is a reference to the
+// input variable with the given name.
+func isVariableRef(expr hcl.Expression, name string) bool {
+	traversal, diags := hcl.AbsTraversalForExpr(expr)
+	if diags.HasErrors() || len(traversal) != 2 || traversal.RootName() != "var" {
+		return false
+	}
+	attr, ok := traversal[1].(hcl.TraverseAttr)
+	return ok && attr.Name == name
+}
+
+// constantJSON returns the JSON representation of the given expression, if
+// it is a constant that can be converted to the given type.
+func constantJSON(expr hcl.Expression, ty cty.Type) (json.RawMessage, bool) {
+	if len(expr.Variables()) > 0 {
+		return nil, false
+	}
+	val, diags := expr.Value(nil)
+	if diags.HasErrors() || !val.IsWhollyKnown() || val.IsNull() {
+		return nil, false
+	}
+	val, err := convert.Convert(val, ty)
+	if err != nil {
+		return nil, false
+	}
+	raw, err := ctyjson.Marshal(val, ty)
+	if err != nil {
+		return nil, false
+	}
+	return raw, true
+}
@@ -0,0 +1,126 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package moduledocs
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/ext/typeexpr"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/opentofu/opentofu/internal/configs"
+)
+
+func TestTypeSchema(t *testing.T) {
+	tests := []struct {
+		ty       cty.Type
+		defaults *typeexpr.Defaults
+		want     string
+	}{
+		{cty.DynamicPseudoType, nil, `{}`},
+		{cty.String, nil, `{"type":"string"}`},
+		{cty.Bool, nil, `{"type":"boolean"}`},
+		{cty.List(cty.Number), nil, `{"type":"array","items":{"type":"number"}}`},
+		{cty.Set(cty.String), nil, `{"type":"array","items":{"type":"string"},"uniqueItems":true}`},
+		{cty.Map(cty.Bool), nil, `{"type":"object","additionalProperties":{"type":"boolean"}}`},
+		{
+			cty.Tuple([]cty.Type{cty.String, cty.Number}),
+			nil,
+			`{"type":"array","prefixItems":[{"type":"string"},{"type":"number"}],"minItems":2,"maxItems":2}`,
+		},
+		{
+			cty.ObjectWithOptionalAttrs(map[string]cty.Type{
+				"name": cty.String,
+				"size": cty.Number,
+				"tags": cty.Map(cty.String),
+			}, []string{"size", "tags"}),
+			&typeexpr.Defaults{
+				DefaultValues: map[string]cty.Value{
+					"size": cty.NumberIntVal(2),
+				},
+			},
+			`{"type":"object","properties":{"name":{"type":"string"},"size":{"type":"number","default":2},"tags":{"type":"object","additionalProperties":{"type":"string"}}},"required":["name"]}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.ty.GoString(), func(t *testing.T) {
+			schema, err := typeSchema(test.ty, test.defaults)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			raw, err := json.Marshal(schema)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got := string(raw); got != test.want {
+				t.Errorf("wrong result\ngot:  %s\nwant: %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestVariablesSchema(t *testing.T) {
+	parseExpr := func(src string) hcl.Expression {
+		expr, diags := hclsyntax.ParseExpression([]byte(src), "test.tf", hcl.InitialPos)
+		if diags.HasErrors() {
+			t.Fatalf("invalid expression %q: %s", src, diags.Error())
+		}
+		return expr
+	}
+	variable := func(name string, ty cty.Type, conditions ...string) *configs.Variable {
+		v := &configs.Variable{
+			Name:           name,
+			ConstraintType: ty,
+			Type:           ty,
+			Nullable:       true,
+		}
+		for _, cond := range conditions {
+			v.Validations = append(v.Validations, &configs.CheckRule{Condition: parseExpr(cond)})
+		}
+		return v
+	}
+
+	mod := &configs.Module{
+		Variables: map[string]*configs.Variable{
+			"size":   variable("size", cty.String, `contains(["small", "medium", "large"], var.size)`, `var.size != "medium"`),
+			"tier":   variable("tier", cty.String, `var.tier == "free" || (var.tier == "paid")`),
+			"region": variable("region", cty.String, `contains(["eu", "us"], var.region)`, `contains(["us", "ap"], var.region)`),
+			"count":  variable("count", cty.Number, `var.count >= 1 && 10 > var.count`),
+			"ratio":  variable("ratio", cty.Number, `var.ratio > 0 || var.ratio == -1`),
+			"name":   variable("name", cty.String, `length(var.name) > 0`, `contains(["a", var.size], var.name)`),
+			"ports":  variable("ports", cty.List(cty.Number), `contains([[80]], var.ports)`),
+		},
+	}
+	mod.Variables["count"].Description = "The number of instances."
+	mod.Variables["count"].Default = cty.NumberIntVal(1)
+	mod.Variables["tier"].Sensitive = true
+	mod.Variables["tier"].Deprecated = "Use size instead."
+
+	schema, err := VariablesSchema(mod)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	raw, err := json.Marshal(schema)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	want := `{"$schema":"https://json-schema.org/draft/2020-12/schema","type":"object","properties":{` +
+		`"count":{"type":"number","description":"The number of instances.","default":1,"minimum":1,"exclusiveMaximum":10},` +
+		`"name":{"type":"string"},` +
+		`"ports":{"type":"array","items":{"type":"number"}},` +
+		`"ratio":{"type":"number"},` +
+		`"region":{"type":"string","enum":["us"]},` +
+		`"size":{"type":"string","enum":["small","medium","large"]},` +
+		`"tier":{"type":"string","deprecated":true,"writeOnly":true,"enum":["free","paid"]}` +
+		`},"required":["name","ports","ratio","region","size","tier"],"additionalProperties":false}`
+	if got := string(raw); got != want {
+		t.Errorf("wrong result\ngot:  %s\nwant: %s", got, want)
+	}
+}
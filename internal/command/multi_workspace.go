@@ -0,0 +1,194 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/opentofu/opentofu/internal/command/arguments"
+	"github.com/opentofu/opentofu/internal/command/views"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// multiWorkspaceRun describes how to run a command in each of the
+// workspaces given with the -workspaces option.
+type multiWorkspaceRun struct {
+	// Args are the command-line arguments for the command, without the
+	// options parsed by arguments.ParseMultiWorkspace. Any
+	// arguments.WorkspacePlaceholder is replaced in each workspace.
+	Args []string
+
+	// Run runs the command once with the given Meta and arguments.
+	Run func(meta Meta, args []string) int
+
+	// DetailedExitCode means that the command returns exit status 2 when it
+	// succeeds and finds changes, as "tofu plan -detailed-exitcode" does.
+	DetailedExitCode bool
+}
+
+// validateMultiWorkspace checks that the given arguments for the command
+// make sense when running in each of the given workspaces. The viewType and
+// perWorkspacePaths are the already-parsed view type and the paths of any
+// files that the command writes, which must include the workspace name
+// placeholder so that the workspaces don't overwrite each other's files.
+func validateMultiWorkspace(multi *arguments.MultiWorkspace, viewType arguments.ViewType, perWorkspacePaths map[string]string) tfdiags.Diagnostics {
+	var diags tfdiags.Diagnostics
+
+	for _, name := range multi.Workspaces {
+		if !validWorkspaceName(name) {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Invalid -workspaces option",
+				fmt.Sprintf("The workspace name %q is not allowed. The name must contain only URL safe characters, and no path separators.", name),
+			))
+		}
+	}
+
+	if viewType != arguments.ViewHuman {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Incompatible command-line options",
+			"The -workspaces option supports only the human-readable output, since the JSON output doesn't identify the workspace for each message. To produce JSON output for several workspaces, run the command once for each workspace.",
+		))
+	}
+
+	if len(multi.Workspaces) > 1 {
+		for option, path := range perWorkspacePaths {
+			if path != "" && !strings.Contains(path, arguments.WorkspacePlaceholder) {
+				diags = diags.Append(tfdiags.Sourceless(
+					tfdiags.Error,
+					"Invalid -workspaces option",
+					fmt.Sprintf("The path given with the %s option must include %s when used with multiple workspaces, so that each workspace writes to a different file.", option, arguments.WorkspacePlaceholder),
+				))
+			}
+		}
+	}
+
+	return diags
+}
+
+// runInWorkspaces runs a command in each of the given workspaces, either in
+// turn or, with -workspace-parallelism, concurrently, and then summarizes the
+// results.
+//
+// The returned exit status is 1 if the command failed in any workspace, and
+// otherwise 2 if the command is using detailed exit codes and found changes
+// in any workspace, or else 0.
+func (m *Meta) runInWorkspaces(multi *arguments.MultiWorkspace, run multiWorkspaceRun) int {
+	view := views.NewMultiWorkspace(m.View)
+
+	args := run.Args
+	if multi.Parallelism > 1 {
+		// There's no sensible way for concurrent workspaces to share the
+		// terminal to prompt for input.
+		args = append([]string{"-input=false"}, args...)
+	}
+
+	// Each interrupt must reach the command in every workspace, so we give
+	// each of them its own shutdown channel. We also stop starting further
+	// workspaces once interrupted.
+	var interrupted atomic.Bool
+	shutdownChs := make([]chan struct{}, len(multi.Workspaces))
+	for i := range shutdownChs {
+		shutdownChs[i] = make(chan struct{}, 4)
+	}
+	if m.ShutdownCh != nil {
+		stop := make(chan struct{})
+		defer close(stop)
+		go func() {
+			for {
+				select {
+				case <-m.ShutdownCh:
+					interrupted.Store(true)
+					for _, ch := range shutdownChs {
+						select {
+						case ch <- struct{}{}:
+						default:
+						}
+					}
+				case <-stop:
+					return
+				}
+			}
+		}()
+	}
+
+	results := make([]views.MultiWorkspaceResult, len(multi.Workspaces))
+	runOne := func(i int, metaView *views.View) {
+		workspace := multi.Workspaces[i]
+		results[i].Workspace = workspace
+		if interrupted.Load() {
+			results[i].Status = views.MultiWorkspaceSkipped
+			return
+		}
+
+		meta := *m
+		meta.View = metaView
+		meta.ShutdownCh = shutdownChs[i]
+		meta.workspaceOverride = workspace
+		code := run.Run(meta, multi.ArgsForWorkspace(args, workspace))
+
+		results[i].ExitCode = code
+		switch {
+		case code == 0:
+			results[i].Status = views.MultiWorkspaceSucceeded
+		case code == 2 && run.DetailedExitCode:
+			results[i].Status = views.MultiWorkspaceChanged
+		default:
+			results[i].Status = views.MultiWorkspaceFailed
+		}
+	}
+
+	if multi.Parallelism <= 1 {
+		for i, workspace := range multi.Workspaces {
+			if !interrupted.Load() {
+				view.WorkspaceStarted(workspace)
+			}
+			runOne(i, m.View)
+		}
+	} else {
+		var wg sync.WaitGroup
+		sem := make(chan struct{}, multi.Parallelism)
+		for i, workspace := range multi.Workspaces {
+			wg.Add(1)
+			sem <- struct{}{}
+			go func() {
+				defer wg.Done()
+				defer func() { <-sem }()
+
+				workspaceView, flush, err := view.WorkspaceView(workspace)
+				if err != nil {
+					view.Diagnostics(tfdiags.Diagnostics{tfdiags.Sourceless(
+						tfdiags.Error,
+						"Failed to prepare output",
+						fmt.Sprintf("OpenTofu could not prepare the output for workspace %q: %s.", workspace, err),
+					)})
+					results[i] = views.MultiWorkspaceResult{Workspace: workspace, Status: views.MultiWorkspaceFailed, ExitCode: 1}
+					return
+				}
+				defer flush()
+				runOne(i, workspaceView)
+			}()
+		}
+		wg.Wait()
+	}
+
+	view.Summary(results)
+
+	ret := 0
+	for _, result := range results {
+		switch result.Status {
+		case views.MultiWorkspaceFailed, views.MultiWorkspaceSkipped:
+			return 1
+		case views.MultiWorkspaceChanged:
+			ret = 2
+		}
+	}
+	return ret
+}
@@ -0,0 +1,185 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/opentofu/opentofu/internal/backend/local"
+	"github.com/opentofu/opentofu/internal/command/workdir"
+	"github.com/opentofu/opentofu/internal/configs/configschema"
+	"github.com/opentofu/opentofu/internal/providers"
+)
+
+func TestApply_workspaces(t *testing.T) {
+	for name, extraArgs := range map[string][]string{
+		"sequential": nil,
+		"parallel":   {"-workspace-parallelism=2"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			td := t.TempDir()
+			testCopyDir(t, testFixturePath("apply-vars"), td)
+			t.Chdir(td)
+
+			for _, workspace := range []string{"a", "b"} {
+				if err := os.MkdirAll(filepath.Join(local.DefaultWorkspaceDir, workspace), 0755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(workspace+".tfvars", []byte(`foo = "value-`+workspace+`"`), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			p := testProvider()
+			p.GetProviderSchemaResponse = &providers.GetProviderSchemaResponse{
+				ResourceTypes: map[string]providers.Schema{
+					"test_instance": {
+						Block: &configschema.Block{
+							Attributes: map[string]*configschema.Attribute{
+								"value": {Type: cty.String, Optional: true},
+							},
+						},
+					},
+				},
+			}
+			p.ApplyResourceChangeFn = func(req providers.ApplyResourceChangeRequest) providers.ApplyResourceChangeResponse {
+				return providers.ApplyResourceChangeResponse{
+					NewState: req.PlannedState,
+				}
+			}
+
+			view, done := testView(t)
+			c := &ApplyCommand{
+				Meta: Meta{
+					WorkingDir:       workdir.NewDir("."),
+					testingOverrides: metaOverridesForProvider(p),
+					View:             view,
+				},
+			}
+			args := append([]string{
+				"-no-color",
+				"-workspaces=a,b",
+				"-auto-approve",
+				"-var-file={workspace}.tfvars",
+			}, extraArgs...)
+			code := c.Run(args)
+			output := done(t)
+			if code != 0 {
+				t.Fatalf("bad: %d\n\n%s", code, output.All())
+			}
+
+			for _, workspace := range []string{"a", "b"} {
+				src, err := os.ReadFile(filepath.Join(local.DefaultWorkspaceDir, workspace, local.DefaultStateFilename))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if want := `"value": "value-` + workspace + `"`; !strings.Contains(string(src), want) {
+					t.Errorf("state for workspace %q doesn't contain %s\n%s", workspace, want, src)
+				}
+			}
+
+			stdout := output.Stdout()
+			for _, want := range []string{
+				"Results by workspace:\n  - a: succeeded\n  - b: succeeded\n",
+			} {
+				if !strings.Contains(stdout, want) {
+					t.Errorf("output is missing %q\n%s", want, stdout)
+				}
+			}
+			if name == "parallel" {
+				if want := "[b] Apply complete! Resources: 1 added"; !strings.Contains(stdout, want) {
+					t.Errorf("output is missing %q\n%s", want, stdout)
+				}
+			} else {
+				if want := "Workspace \"b\":"; !strings.Contains(stdout, want) {
+					t.Errorf("output is missing %q\n%s", want, stdout)
+				}
+			}
+		})
+	}
+}
+
+func TestApply_workspacesInvalid(t *testing.T) {
+	tests := map[string]struct {
+		args []string
+		want string
+	}{
+		"json": {
+			args: []string{"-workspaces=a,b", "-auto-approve", "-json"},
+			want: "The -workspaces option supports only the human-readable output",
+		},
+		"concurrent approval": {
+			args: []string{"-workspaces=a,b", "-workspace-parallelism=2"},
+			want: "cannot ask for interactive approval in several workspaces",
+		},
+		"shared state file": {
+			args: []string{"-workspaces=a,b", "-auto-approve", "-state=terraform.tfstate"},
+			want: "The path given with the -state option must include {workspace}",
+		},
+		"invalid name": {
+			args: []string{"-workspaces=a/b", "-auto-approve"},
+			want: `The workspace name "a/b" is not allowed`,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			td := t.TempDir()
+			testCopyDir(t, testFixturePath("apply-vars"), td)
+			t.Chdir(td)
+
+			view, done := testView(t)
+			c := &ApplyCommand{
+				Meta: Meta{
+					WorkingDir: workdir.NewDir("."),
+					View:       view,
+				},
+			}
+			code := c.Run(test.args)
+			output := done(t)
+			if code != 1 {
+				t.Fatalf("wrong exit code %d\n%s", code, output.All())
+			}
+			if got := strings.Join(strings.Fields(output.All()), " "); !strings.Contains(got, test.want) {
+				t.Errorf("output is missing %q\n%s", test.want, output.All())
+			}
+		})
+	}
+}
+
+func TestPlan_workspacesDetailedExitCode(t *testing.T) {
+	td := t.TempDir()
+	testCopyDir(t, testFixturePath("plan"), td)
+	t.Chdir(td)
+
+	for _, workspace := range []string{"a", "b"} {
+		if err := os.MkdirAll(filepath.Join(local.DefaultWorkspaceDir, workspace), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	p := planFixtureProvider()
+	view, done := testView(t)
+	c := &PlanCommand{
+		Meta: Meta{
+			WorkingDir:       workdir.NewDir("."),
+			testingOverrides: metaOverridesForProvider(p),
+			View:             view,
+		},
+	}
+	code := c.Run([]string{"-no-color", "-workspaces=a,b", "-detailed-exitcode"})
+	output := done(t)
+	if code != 2 {
+		t.Fatalf("wrong exit code %d\n%s", code, output.All())
+	}
+	if want := "  - a: succeeded with changes\n  - b: succeeded with changes\n"; !strings.Contains(output.Stdout(), want) {
+		t.Errorf("output is missing %q\n%s", want, output.Stdout())
+	}
+}
@@ -13,6 +13,8 @@ import (
 	"github.com/opentofu/opentofu/internal/command/arguments"
 	"github.com/opentofu/opentofu/internal/command/views"
 	"github.com/opentofu/opentofu/internal/encryption"
+	"github.com/opentofu/opentofu/internal/lang/marks"
+	"github.com/opentofu/opentofu/internal/plans"
 	"github.com/opentofu/opentofu/internal/states"
 	"github.com/opentofu/opentofu/internal/tfdiags"
 )
@@ -53,8 +55,13 @@ func (c *OutputCommand) Run(rawArgs []string) int {
 		return 1
 	}
 
-	// Fetch data from state
-	outputs, diags := c.Outputs(ctx, args.StatePath, enc)
+	// Fetch data from the plan file, if given, or otherwise from state
+	var outputs map[string]*states.OutputValue
+	if args.PlanPath != "" {
+		outputs, diags = c.PlannedOutputs(args.PlanPath, enc)
+	} else {
+		outputs, diags = c.Outputs(ctx, args.StatePath, enc)
+	}
 	if diags.HasErrors() {
 		view.Diagnostics(diags)
 		return 1
@@ -112,6 +119,73 @@ func (c *OutputCommand) Outputs(ctx context.Context, statePath string, enc encry
 	return output, diags
 }
 
+// PlannedOutputs returns the root module output values as they will be after
+// applying the saved plan at the given path. Any parts of those values that
+// cannot be known until apply are represented as unknown values.
+func (c *OutputCommand) PlannedOutputs(path string, enc encryption.Encryption) (map[string]*states.OutputValue, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	planFile, err := c.PlanFile(path, enc.Plan())
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			fmt.Sprintf("Failed to load %q as a plan file", path),
+			fmt.Sprintf("Error: %s", err),
+		))
+		return nil, diags
+	}
+	if planFile == nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			fmt.Sprintf("Failed to load %q as a plan file", path),
+			"The specified path is a directory, not a plan file.",
+		))
+		return nil, diags
+	}
+
+	lp, ok := planFile.Local()
+	if !ok {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Unsupported plan file",
+			"The -state-run option supports only plan files saved by a local operation, not saved cloud plans.",
+		))
+		return nil, diags
+	}
+
+	plan, err := lp.ReadPlan()
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to read plan from plan file",
+			fmt.Sprintf("Cannot read the plan from the given plan file: %s.", err),
+		))
+		return nil, diags
+	}
+
+	outputs := make(map[string]*states.OutputValue)
+	for _, ocs := range plan.Changes.Outputs {
+		if !ocs.Addr.Module.IsRoot() || ocs.Action == plans.Delete {
+			continue
+		}
+		oc, err := ocs.Decode()
+		if err != nil {
+			diags = diags.Append(fmt.Errorf("Failed to decode planned change for %s: %w", ocs.Addr, err))
+			continue
+		}
+		// The views expect unmarked values, just as we'd get from state, with
+		// sensitivity tracked only for the output value as a whole.
+		val, _ := oc.After.UnmarkDeep()
+		outputs[oc.Addr.OutputValue.Name] = &states.OutputValue{
+			Addr:      oc.Addr,
+			Value:     val,
+			Sensitive: oc.Sensitive || marks.Contains(oc.After, marks.Sensitive),
+		}
+	}
+
+	return outputs, diags
+}
+
 func (c *OutputCommand) Help() string {
 	helpText := `
 Usage: tofu [global options] output [options] [NAME]
@@ -126,6 +200,11 @@ Options:
   -state=path          Path to the state file to read. Defaults to
                        "terraform.tfstate". Ignored when remote 
                        state is used.
+
+  -state-run=planfile  Show the output values as they will be after
+                       applying the given saved plan file, instead of
+                       reading them from the state. Values that won't be
+                       known until apply are shown as unknown.
                       
   -no-color            If specified, output won't contain any color.
                       
@@ -16,6 +16,8 @@ import (
 	"github.com/zclconf/go-cty/cty"
 
 	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/configs/configload"
+	"github.com/opentofu/opentofu/internal/plans"
 	"github.com/opentofu/opentofu/internal/states"
 )
 
@@ -409,3 +411,157 @@ func stateWithSensitiveValueForOutput() *states.State {
 	})
 	return state
 }
+
+func TestOutput_stateRun(t *testing.T) {
+	plan := testPlan(t)
+	for _, oc := range []*plans.OutputChange{
+		{
+			Addr: addrs.OutputValue{Name: "foo"}.Absolute(addrs.RootModuleInstance),
+			Change: plans.Change{
+				Action: plans.Update,
+				Before: cty.StringVal("old"),
+				After:  cty.StringVal("bar"),
+			},
+		},
+		{
+			Addr: addrs.OutputValue{Name: "baz"}.Absolute(addrs.RootModuleInstance),
+			Change: plans.Change{
+				Action: plans.Create,
+				Before: cty.NullVal(cty.DynamicPseudoType),
+				After: cty.ObjectVal(map[string]cty.Value{
+					"id":   cty.UnknownVal(cty.String),
+					"name": cty.StringVal("example"),
+				}),
+			},
+		},
+		{
+			Addr: addrs.OutputValue{Name: "gone"}.Absolute(addrs.RootModuleInstance),
+			Change: plans.Change{
+				Action: plans.Delete,
+				Before: cty.StringVal("removed"),
+				After:  cty.NullVal(cty.DynamicPseudoType),
+			},
+		},
+	} {
+		ocs, err := oc.Encode()
+		if err != nil {
+			t.Fatal(err)
+		}
+		plan.Changes.Outputs = append(plan.Changes.Outputs, ocs)
+	}
+	snap := &configload.Snapshot{
+		Modules: map[string]*configload.SnapshotModule{
+			"": {
+				Dir:   ".",
+				Files: map[string][]byte{"main.tf": nil},
+			},
+		},
+	}
+	planPath := testPlanFile(t, snap, states.NewState(), plan)
+
+	t.Run("json", func(t *testing.T) {
+		view, done := testView(t)
+		c := &OutputCommand{
+			Meta: Meta{
+				WorkingDir:       workdir.NewDir("."),
+				testingOverrides: metaOverridesForProvider(testProvider()),
+				View:             view,
+			},
+		}
+
+		code := c.Run([]string{"-state-run", planPath, "-json"})
+		output := done(t)
+		if code != 0 {
+			t.Fatalf("bad: \n%s", output.Stderr())
+		}
+
+		actual := strings.TrimSpace(output.Stdout())
+		expected := `{
+  "baz": {
+    "sensitive": false,
+    "type": [
+      "object",
+      {
+        "id": "string",
+        "name": "string"
+      }
+    ],
+    "value": {
+      "id": null,
+      "name": "example"
+    },
+    "unknown": {
+      "id": true
+    }
+  },
+  "foo": {
+    "sensitive": false,
+    "type": "string",
+    "value": "bar"
+  }
+}`
+		if actual != expected {
+			t.Fatalf("wrong output\ngot:  %s\nwant: %s", actual, expected)
+		}
+	})
+
+	t.Run("human", func(t *testing.T) {
+		view, done := testView(t)
+		c := &OutputCommand{
+			Meta: Meta{
+				WorkingDir:       workdir.NewDir("."),
+				testingOverrides: metaOverridesForProvider(testProvider()),
+				View:             view,
+			},
+		}
+
+		code := c.Run([]string{"-state-run", planPath, "baz"})
+		output := done(t)
+		if code != 0 {
+			t.Fatalf("bad: \n%s", output.Stderr())
+		}
+
+		actual := strings.TrimSpace(output.Stdout())
+		expected := "{\n  \"id\" = (known after apply)\n  \"name\" = \"example\"\n}"
+		if actual != expected {
+			t.Fatalf("wrong output\ngot:  %s\nwant: %s", actual, expected)
+		}
+	})
+
+	t.Run("single unknown json", func(t *testing.T) {
+		view, done := testView(t)
+		c := &OutputCommand{
+			Meta: Meta{
+				WorkingDir:       workdir.NewDir("."),
+				testingOverrides: metaOverridesForProvider(testProvider()),
+				View:             view,
+			},
+		}
+
+		code := c.Run([]string{"-state-run", planPath, "-json", "baz"})
+		output := done(t)
+		if code != 1 {
+			t.Fatalf("unexpected success\n%s", output.Stdout())
+		}
+		if got, want := output.Stderr(), "Output value not yet known"; !strings.Contains(got, want) {
+			t.Errorf("wrong error\ngot: %s\nwant substring: %s", got, want)
+		}
+	})
+
+	t.Run("deleted output", func(t *testing.T) {
+		view, done := testView(t)
+		c := &OutputCommand{
+			Meta: Meta{
+				WorkingDir:       workdir.NewDir("."),
+				testingOverrides: metaOverridesForProvider(testProvider()),
+				View:             view,
+			},
+		}
+
+		code := c.Run([]string{"-state-run", planPath, "gone"})
+		output := done(t)
+		if code != 1 {
+			t.Fatalf("unexpected success\n%s", output.Stdout())
+		}
+	})
+}
@@ -8,6 +8,8 @@ package command
 import (
 	"context"
 	"fmt"
+	"os"
+	"slices"
 	"strings"
 
 	"github.com/opentofu/opentofu/internal/backend"
@@ -26,6 +28,11 @@ type PlanCommand struct {
 func (c *PlanCommand) Run(rawArgs []string) int {
 	ctx := c.CommandContext()
 
+	// Take out the options for running in several workspaces, keeping the
+	// other arguments to run the command with in each workspace.
+	multi, rawArgs, multiDiags := arguments.ParseMultiWorkspace(rawArgs)
+	workspaceArgs := slices.Clone(rawArgs)
+
 	// Parse and apply global view arguments
 	common, rawArgs := arguments.ParseView(rawArgs)
 	c.View.Configure(common)
@@ -33,6 +40,15 @@ func (c *PlanCommand) Run(rawArgs []string) int {
 	// Parse and validate flags
 	args, closer, diags := arguments.ParsePlan(rawArgs)
 	defer closer()
+	diags = diags.Append(multiDiags)
+	if multi != nil {
+		diags = diags.Append(validateMultiWorkspace(multi, args.ViewOptions.ViewType, map[string]string{
+			"-out":                 args.OutPath,
+			"-generate-config-out": args.GenerateConfigPath,
+			"-suggest-moves":       args.SuggestMovesPath,
+			"-state":               args.State.StatePath,
+		}))
+	}
 
 	c.View.SetShowSensitive(args.ShowSensitive)
 
@@ -46,6 +62,28 @@ func (c *PlanCommand) Run(rawArgs []string) int {
 		return 1
 	}
 
+	if multi != nil {
+		return c.runInWorkspaces(multi, multiWorkspaceRun{
+			Args: workspaceArgs,
+			Run: func(meta Meta, args []string) int {
+				return (&PlanCommand{Meta: meta}).Run(args)
+			},
+			DetailedExitCode: args.DetailedExitCode,
+		})
+	}
+
+	// Load the attributes whose changes are hidden in the human-readable
+	// plan, which don't affect any other output.
+	if args.ViewOptions.ViewType == arguments.ViewHuman {
+		noise, noiseDiags := c.loadNoiseRules()
+		diags = diags.Append(noiseDiags)
+		if noiseDiags.HasErrors() {
+			view.Diagnostics(diags)
+			return 1
+		}
+		c.View.SetNoiseRules(noise)
+	}
+
 	// Check for user-supplied plugin path
 	var err error
 	if c.pluginPath, err = c.loadPluginPath(); err != nil {
@@ -66,6 +104,8 @@ func (c *PlanCommand) Run(rawArgs []string) int {
 	// clear path to pass this value down, so we continue to mutate the Meta
 	// object state for now.
 	c.Meta.parallelism = args.Operation.Parallelism
+	c.Meta.readParallelism = args.Operation.ReadParallelism
+	c.Meta.writeParallelism = args.Operation.WriteParallelism
 
 	diags = diags.Append(c.providerDevOverrideRuntimeWarnings())
 
@@ -88,13 +128,44 @@ func (c *PlanCommand) Run(rawArgs []string) int {
 		return 1
 	}
 
+	// If the plan is to be stored in the backend then we need a plan file
+	// even if -out wasn't used, so we'll write it to a temporary file.
+	// The same goes for comparing the plan with a golden plan.
+	planOutPath := args.OutPath
+	var planStore backend.PlanStore
+	if args.Store {
+		planStore = planStoreFor(be)
+		if planStore == nil {
+			diags = diags.Append(errPlanStoreNotSupported)
+			view.Diagnostics(diags)
+			return 1
+		}
+	}
+	if args.Store || args.CompareTo != "" {
+		if planOutPath == "" {
+			f, err := os.CreateTemp("", "tofu-plan-*.tfplan")
+			if err != nil {
+				diags = diags.Append(fmt.Errorf("Failed to create temporary plan file: %w", err))
+				view.Diagnostics(diags)
+				return 1
+			}
+			_ = f.Close()
+			defer os.Remove(f.Name())
+			planOutPath = f.Name()
+		}
+	}
+
 	// Build the operation request
-	opReq, opDiags := c.OperationRequest(ctx, be, view, args.ViewOptions, args.Operation, args.OutPath, args.GenerateConfigPath, enc)
+	opReq, opDiags := c.OperationRequest(ctx, be, view, args.ViewOptions, args.Operation, planOutPath, args.GenerateConfigPath, enc)
 	diags = diags.Append(opDiags)
 	if diags.HasErrors() {
 		view.Diagnostics(diags)
 		return 1
 	}
+	opReq.SuggestMovesOut = args.SuggestMovesPath
+	if args.Store && args.OutPath == "" {
+		opReq.View = storedPlanOperationView{opReq.View}
+	}
 
 	// Before we delegate to the backend, we'll print any warning diagnostics
 	// we've accumulated here, since the backend will start fresh with its own
@@ -112,6 +183,21 @@ func (c *PlanCommand) Run(rawArgs []string) int {
 	if op.Result != backend.OperationSuccess {
 		return op.Result.ExitStatus()
 	}
+	if args.CompareTo != "" {
+		compareDiags := c.comparePlanToGolden(ctx, planOutPath, enc, args.CompareTo, args.CompareIgnore)
+		view.Diagnostics(compareDiags)
+		if compareDiags.HasErrors() {
+			return 1
+		}
+	}
+	if planStore != nil {
+		meta, storeDiags := c.storePlan(ctx, planStore, planOutPath, enc.Plan())
+		view.Diagnostics(storeDiags)
+		if storeDiags.HasErrors() {
+			return 1
+		}
+		view.PlanStored(meta.ID)
+	}
 	if args.DetailedExitCode && !op.PlanEmpty {
 		return 2
 	}
@@ -165,8 +251,11 @@ func (c *PlanCommand) OperationRequest(
 	opReq.PlanOutPath = planOutPath
 	opReq.GenerateConfigOut = generateConfigOut
 	opReq.Targets = args.Targets
+	opReq.RefreshTargets = args.RefreshTargets
 	opReq.Excludes = args.Excludes
 	opReq.ForceReplace = args.ForceReplace
+	opReq.ForceReplacePatterns = args.ForceReplacePatterns
+	opReq.ForceReplaceReason = args.ForceReplaceReason
 	opReq.Type = backend.OperationTypePlan
 	opReq.View = view.Operation()
 
@@ -212,12 +301,24 @@ Plan Customization Options:
                           planning against a stale record of the remote system
                           state.
 
+  -refresh-target=addr    Check for external changes only to the objects of the
+                          given module, resource, or resource instance, and
+                          trust the existing record of all other remote
+                          objects as with -refresh=false. You can use this
+                          option multiple times to refresh more than one object.
+
   -replace=resource       Force replacement of a particular resource instance
                           using its resource address. If the plan would've
                           otherwise produced an update or no-op action for this
                           instance, OpenTofu will plan to replace it instead.
                           You can use this option multiple times to replace
-                          more than one object.
+                          more than one object. The address may contain "*"
+                          wildcards, such as aws_instance.web[*], to replace
+                          every matching resource instance in the state.
+
+  -replace-reason=reason  An explanation of why the instances selected with
+                          -replace are being replaced, which is recorded in
+                          the plan for anyone reviewing it.
 
   -target=resource        Limit the planning operation to only the given
                           module, resource, or resource instance and all of its
@@ -255,6 +356,16 @@ Other Options:
                                compact form that includes only the summary
                                messages.
 
+  -compare-to=path             Compare the plan with the golden plan in the
+                               given file, which is in the format produced by
+                               "tofu show -json", and fail with a description
+                               of the differences if they don't match.
+
+  -compare-ignore=path         Ignore the given field of the JSON plan when
+                               comparing with -compare-to, such as
+                               "resource_changes.*.change.after.id". Use this
+                               option more than once to ignore more fields.
+
   -consolidate-warnings=false  If OpenTofu produces any warnings, do not
                                attempt to consolidate similar messages. All
                                locations for all warnings will be listed.
@@ -296,6 +407,16 @@ Other Options:
   -parallelism=n               Limit the number of concurrent operations.
                                Defaults to 10.
 
+  -read-parallelism=n          Limit the number of concurrent operations that
+                               only read remote objects, such as refreshing
+                               and reading data sources, to less than
+                               -parallelism, which still limits the total.
+
+  -write-parallelism=n         Limit the number of concurrent operations that
+                               change remote objects when applying to less
+                               than -parallelism, which still limits the
+                               total.
+
   -state=statefile             A legacy option used for the local backend only.
                                Refer to the local backend's documentation for
                                more information.
@@ -303,6 +424,28 @@ Other Options:
   -show-sensitive              If specified, sensitive values will not be
                                redacted in te UI output.
 
+  -store                       Also save the plan in the backend, if the
+                               backend supports storing plans. Use
+                               "tofu plan list" to see the stored plans and
+                               "tofu plan apply ID" to apply one of them.
+
+  -suggest-moves=path          Write a "moved" block to a new file at the
+                               given path for each resource instance that the
+                               plan would destroy while creating another
+                               instance of the same resource with the same
+                               attributes, such as after switching from count
+                               to for_each. The file must not already exist.
+
+  -workspaces=a,b,c            Create a plan in each of the given workspaces
+                               in turn, and then summarize the results. Any
+                               "{workspace}" in the other options is replaced
+                               with the workspace name, such as in
+                               -var-file=env/{workspace}.tfvars.
+
+  -workspace-parallelism=n     Create the plans for up to n of the workspaces
+                               given with -workspaces at the same time.
+                               Defaults to 1.
+
   -json                        Produce output in a machine-readable JSON
                                format, suitable for use in text editor
                                integrations and other automated systems.
@@ -0,0 +1,383 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/opentofu/opentofu/internal/command/jsonplan"
+	"github.com/opentofu/opentofu/internal/encryption"
+	"github.com/opentofu/opentofu/internal/plans/planfile"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// defaultPlanCompareIgnore are the paths of the fields of a JSON plan that
+// differ between runs or OpenTofu versions even when nothing else changed,
+// and so are never compared with the golden plan.
+var defaultPlanCompareIgnore = []string{
+	"timestamp",
+	"terraform_version",
+	"prior_state.terraform_version",
+}
+
+// planCompareMaxDifferences is the maximum number of differences reported
+// when a plan doesn't match the golden plan, to keep the output readable.
+const planCompareMaxDifferences = 50
+
+// comparePlanToGolden compares the plan in the given plan file, in the JSON
+// format produced by "tofu show -json", with the golden plan in the given
+// JSON file, ignoring the fields at the given paths and the fields in
+// defaultPlanCompareIgnore. It returns an error diagnostic describing the
+// differences if the plans don't match.
+func (c *PlanCommand) comparePlanToGolden(ctx context.Context, planPath string, enc encryption.Encryption, goldenPath string, ignore []string) tfdiags.Diagnostics {
+	var diags tfdiags.Diagnostics
+
+	goldenSrc, err := os.ReadFile(goldenPath)
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to read golden plan",
+			fmt.Sprintf("Cannot read the golden plan from %s: %s.", goldenPath, err),
+		))
+		return diags
+	}
+	golden, err := decodePlanCompareJSON(goldenSrc)
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to read golden plan",
+			fmt.Sprintf("The golden plan in %s is not valid JSON: %s.", goldenPath, err),
+		))
+		return diags
+	}
+
+	planSrc, moreDiags := c.planJSONFromFile(ctx, planPath, enc)
+	diags = diags.Append(moreDiags)
+	if moreDiags.HasErrors() {
+		return diags
+	}
+	plan, err := decodePlanCompareJSON(planSrc)
+	if err != nil {
+		// Should never happen, since we just produced this JSON ourselves.
+		diags = diags.Append(fmt.Errorf("Failed to decode the JSON plan: %w", err))
+		return diags
+	}
+
+	patterns := make([][]string, 0, len(defaultPlanCompareIgnore)+len(ignore))
+	for _, path := range append(slices.Clone(defaultPlanCompareIgnore), ignore...) {
+		patterns = append(patterns, parsePlanComparePath(path))
+	}
+
+	differences := comparePlanJSON(golden, plan, patterns)
+	if len(differences) == 0 {
+		return diags
+	}
+
+	var detail strings.Builder
+	fmt.Fprintf(&detail, "The plan doesn't match the golden plan in %s:\n\n", goldenPath)
+	for i, difference := range differences {
+		if i == planCompareMaxDifferences {
+			fmt.Fprintf(&detail, "  ... and %d more differences\n", len(differences)-i)
+			break
+		}
+		fmt.Fprintf(&detail, "  %s\n", difference)
+	}
+	detail.WriteString("\nLines starting with \"~\" show the value in the golden plan and then the value in the new plan, \"-\" marks fields only in the golden plan, and \"+\" marks fields only in the new plan. ")
+	detail.WriteString("Use -compare-ignore to ignore fields that are expected to change. If the new plan is correct, update the golden plan by saving the plan with -out and then running \"tofu show -json\" on the saved plan.")
+	diags = diags.Append(tfdiags.Sourceless(
+		tfdiags.Error,
+		"Plan doesn't match the golden plan",
+		detail.String(),
+	))
+	return diags
+}
+
+// planJSONFromFile returns the given local plan file in the JSON format
+// produced by "tofu show -json".
+func (c *PlanCommand) planJSONFromFile(ctx context.Context, planPath string, enc encryption.Encryption) ([]byte, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	failed := func(err error) tfdiags.Diagnostics {
+		return diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to compare with the golden plan",
+			fmt.Sprintf("Cannot read the new plan: %s.", err),
+		))
+	}
+
+	pf, err := planfile.OpenWrapped(planPath, enc.Plan())
+	if err != nil {
+		return nil, failed(err)
+	}
+	lp, ok := pf.Local()
+	if !ok {
+		return nil, failed(errors.New("only plans created by a local backend can be compared with a golden plan"))
+	}
+
+	rootCall, callDiags := c.rootModuleCall(ctx, ".")
+	diags = diags.Append(callDiags)
+	if callDiags.HasErrors() {
+		return nil, diags
+	}
+	plan, stateFile, config, err := getDataFromPlanfileReader(ctx, lp, rootCall)
+	if err != nil {
+		return nil, failed(err)
+	}
+
+	schemas, schemaDiags := c.MaybeGetSchemas(ctx, stateFile.State, config)
+	diags = diags.Append(schemaDiags)
+	if schemaDiags.HasErrors() {
+		return nil, diags
+	}
+
+	src, err := jsonplan.Marshal(config, plan, stateFile, schemas)
+	if err != nil {
+		return nil, failed(err)
+	}
+	return src, diags
+}
+
+func decodePlanCompareJSON(src []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(src))
+	// We keep numbers in their original form so that we don't report
+	// or hide differences by rounding.
+	dec.UseNumber()
+	var ret any
+	if err := dec.Decode(&ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// parsePlanComparePath parses a path of a field in a JSON plan, in the same
+// syntax as the paths of the differences that comparePlanJSON returns.
+//
+// Object attributes are separated by dots, and array elements are given in
+// brackets after the array, either by index or, for arrays of objects with
+// unique addresses such as resource_changes, by address. A segment of "*"
+// matches any attribute or element. For example, both
+// "resource_changes[aws_instance.web].change.after.id" and
+// "resource_changes.*.change.after.id" select the id of aws_instance.web.
+func parsePlanComparePath(path string) []string {
+	var segments []string
+	var current strings.Builder
+	// Addresses in brackets can contain brackets of their own, such as in
+	// module.a["x"].aws_instance.b, so we track how deeply nested we are.
+	depth := 0
+	flush := func() {
+		if current.Len() > 0 {
+			segments = append(segments, current.String())
+			current.Reset()
+		}
+	}
+	for _, r := range path {
+		switch {
+		case depth == 1 && r == ']':
+			segments = append(segments, current.String())
+			current.Reset()
+			depth = 0
+		case depth > 0:
+			switch r {
+			case '[':
+				depth++
+			case ']':
+				depth--
+			}
+			current.WriteRune(r)
+		case r == '.':
+			flush()
+		case r == '[':
+			flush()
+			depth = 1
+		default:
+			current.WriteRune(r)
+		}
+	}
+	flush()
+	return segments
+}
+
+// comparePlanJSON returns a description of each difference between the
+// given decoded JSON plans, ignoring the fields matching any of the given
+// parsed paths.
+func comparePlanJSON(golden, plan any, ignore [][]string) []string {
+	c := planJSONComparer{ignore: ignore}
+	c.compare(nil, "", golden, plan)
+	return c.differences
+}
+
+type planJSONComparer struct {
+	ignore      [][]string
+	differences []string
+}
+
+func (c *planJSONComparer) compare(segments []string, path string, golden, plan any) {
+	if c.ignored(segments) {
+		return
+	}
+
+	switch golden := golden.(type) {
+	case map[string]any:
+		if plan, ok := plan.(map[string]any); ok {
+			keys := make([]string, 0, len(golden)+len(plan))
+			for k := range golden {
+				keys = append(keys, k)
+			}
+			for k := range plan {
+				if _, exists := golden[k]; !exists {
+					keys = append(keys, k)
+				}
+			}
+			slices.Sort(keys)
+			for _, k := range keys {
+				c.compareElem(segments, planComparePathAttr(path, k), k, golden, plan)
+			}
+			return
+		}
+	case []any:
+		if plan, ok := plan.([]any); ok {
+			goldenByAddr, goldenAddrs := planCompareByAddress(golden)
+			planByAddr, planAddrs := planCompareByAddress(plan)
+			if goldenByAddr != nil && planByAddr != nil {
+				keys := slices.Clone(goldenAddrs)
+				for _, addr := range planAddrs {
+					if _, exists := goldenByAddr[addr]; !exists {
+						keys = append(keys, addr)
+					}
+				}
+				for _, k := range keys {
+					c.compareElem(segments, fmt.Sprintf("%s[%s]", path, k), k, goldenByAddr, planByAddr)
+				}
+				return
+			}
+
+			goldenByIdx := make(map[string]any, len(golden))
+			for i, v := range golden {
+				goldenByIdx[strconv.Itoa(i)] = v
+			}
+			planByIdx := make(map[string]any, len(plan))
+			for i, v := range plan {
+				planByIdx[strconv.Itoa(i)] = v
+			}
+			for i := range max(len(golden), len(plan)) {
+				k := strconv.Itoa(i)
+				c.compareElem(segments, fmt.Sprintf("%s[%s]", path, k), k, goldenByIdx, planByIdx)
+			}
+			return
+		}
+	}
+
+	if !reflect.DeepEqual(golden, plan) {
+		c.differences = append(c.differences, fmt.Sprintf("~ %s: %s -> %s", planComparePathDisplay(path), planCompareValue(golden), planCompareValue(plan)))
+	}
+}
+
+// compareElem compares the elements with the given key of the given golden
+// and new containers, which may each lack the element.
+func (c *planJSONComparer) compareElem(segments []string, path string, key string, golden, plan map[string]any) {
+	elemSegments := append(slices.Clone(segments), key)
+	if c.ignored(elemSegments) {
+		return
+	}
+	goldenElem, inGolden := golden[key]
+	planElem, inPlan := plan[key]
+	switch {
+	case !inPlan:
+		c.differences = append(c.differences, fmt.Sprintf("- %s", path))
+	case !inGolden:
+		c.differences = append(c.differences, fmt.Sprintf("+ %s", path))
+	default:
+		c.compare(elemSegments, path, goldenElem, planElem)
+	}
+}
+
+func (c *planJSONComparer) ignored(segments []string) bool {
+	for _, pattern := range c.ignore {
+		if len(pattern) != len(segments) {
+			continue
+		}
+		matched := true
+		for i, segment := range segments {
+			if pattern[i] != "*" && pattern[i] != segment {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+// planCompareByAddress returns the elements of the given array indexed by
+// their "address" attributes, along with the addresses in order, if all of
+// the elements are objects with unique addresses. Comparing such elements
+// by address rather than by index means that adding or removing a resource
+// changes only the entry for that resource.
+func planCompareByAddress(elems []any) (map[string]any, []string) {
+	if len(elems) == 0 {
+		return nil, nil
+	}
+	ret := make(map[string]any, len(elems))
+	addrs := make([]string, 0, len(elems))
+	for _, elem := range elems {
+		obj, ok := elem.(map[string]any)
+		if !ok {
+			return nil, nil
+		}
+		addr, ok := obj["address"].(string)
+		if !ok || addr == "" {
+			return nil, nil
+		}
+		if _, exists := ret[addr]; exists {
+			return nil, nil
+		}
+		ret[addr] = elem
+		addrs = append(addrs, addr)
+	}
+	return ret, addrs
+}
+
+func planComparePathAttr(path string, name string) string {
+	if strings.ContainsAny(name, ".[]") {
+		return fmt.Sprintf("%s[%s]", path, name)
+	}
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func planComparePathDisplay(path string) string {
+	if path == "" {
+		return "(plan)"
+	}
+	return path
+}
+
+// planCompareValue returns a short JSON representation of the given value
+// for describing a difference.
+func planCompareValue(v any) string {
+	const maxLen = 80
+	src, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%#v", v)
+	}
+	if runes := []rune(string(src)); len(runes) > maxLen {
+		return string(runes[:maxLen-3]) + "..."
+	}
+	return string(src)
+}
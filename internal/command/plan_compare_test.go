@@ -0,0 +1,219 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/opentofu/opentofu/internal/command/workdir"
+	"github.com/opentofu/opentofu/internal/terminal"
+)
+
+func TestPlan_compareTo(t *testing.T) {
+	td := t.TempDir()
+	testCopyDir(t, testFixturePath("plan"), td)
+	t.Chdir(td)
+
+	p := planFixtureProvider()
+	meta := func() (Meta, func(*testing.T) *terminal.TestOutput) {
+		view, done := testView(t)
+		return Meta{
+			WorkingDir:       workdir.NewDir("."),
+			testingOverrides: metaOverridesForProvider(p),
+			View:             view,
+		}, done
+	}
+
+	// We create the golden plan in the same way as a user would.
+	m, done := meta()
+	planCmd := &PlanCommand{Meta: m}
+	if code := planCmd.Run([]string{"-out=saved.tfplan"}); code != 0 {
+		t.Fatalf("plan failed with %d\n%s", code, done(t).Stderr())
+	}
+	done(t)
+	m, done = meta()
+	showCmd := &ShowCommand{Meta: m}
+	if code := showCmd.Run([]string{"-json", "saved.tfplan"}); code != 0 {
+		t.Fatalf("show failed with %d\n%s", code, done(t).Stderr())
+	}
+	golden := done(t).Stdout()
+	if err := os.WriteFile("golden.json", []byte(golden), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile("changed.json", []byte(strings.ReplaceAll(golden, `"ami":"bar"`, `"ami":"baz"`)), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("matching", func(t *testing.T) {
+		m, done := meta()
+		c := &PlanCommand{Meta: m}
+		code := c.Run([]string{"-compare-to=golden.json"})
+		output := done(t)
+		if code != 0 {
+			t.Fatalf("wrong exit code %d\n%s", code, output.Stderr())
+		}
+	})
+
+	t.Run("different", func(t *testing.T) {
+		m, done := meta()
+		c := &PlanCommand{Meta: m}
+		code := c.Run([]string{"-no-color", "-compare-to=changed.json"})
+		output := done(t)
+		if code != 1 {
+			t.Fatalf("wrong exit code %d\n%s", code, output.All())
+		}
+		got := output.Stderr()
+		for _, want := range []string{
+			"Error: Plan doesn't match the golden plan",
+			`~ planned_values.root_module.resources[test_instance.foo].values.ami: "baz" -> "bar"`,
+			`~ resource_changes[test_instance.foo].change.after.ami: "baz" -> "bar"`,
+		} {
+			if !strings.Contains(got, want) {
+				t.Errorf("output is missing %q\n%s", want, got)
+			}
+		}
+	})
+
+	t.Run("different but ignored", func(t *testing.T) {
+		m, done := meta()
+		c := &PlanCommand{Meta: m}
+		code := c.Run([]string{
+			"-compare-to=changed.json",
+			"-compare-ignore=planned_values.root_module.resources.*.values.ami",
+			"-compare-ignore=resource_changes[test_instance.foo].change.after.ami",
+			"-compare-ignore=configuration",
+		})
+		output := done(t)
+		if code != 0 {
+			t.Fatalf("wrong exit code %d\n%s", code, output.Stderr())
+		}
+	})
+
+	t.Run("missing golden plan", func(t *testing.T) {
+		m, done := meta()
+		c := &PlanCommand{Meta: m}
+		code := c.Run([]string{"-no-color", "-compare-to=" + filepath.Join(td, "nonexist.json")})
+		output := done(t)
+		if code != 1 {
+			t.Fatalf("wrong exit code %d\n%s", code, output.All())
+		}
+		if got, want := output.Stderr(), "Error: Failed to read golden plan"; !strings.Contains(got, want) {
+			t.Fatalf("output is missing %q\n%s", want, got)
+		}
+	})
+}
+
+func TestParsePlanComparePath(t *testing.T) {
+	tests := map[string][]string{
+		"timestamp":                                      {"timestamp"},
+		"resource_changes.*.change.after.id":             {"resource_changes", "*", "change", "after", "id"},
+		"resource_changes[aws_instance.web].change":      {"resource_changes", "aws_instance.web", "change"},
+		"resource_changes[0].change.actions[1]":          {"resource_changes", "0", "change", "actions", "1"},
+		"values.tags[kubernetes.io/role]":                {"values", "tags", "kubernetes.io/role"},
+		`resource_changes[module.a["x"].aws_instance.b]`: {"resource_changes", `module.a["x"].aws_instance.b`},
+	}
+	for path, want := range tests {
+		t.Run(path, func(t *testing.T) {
+			got := parsePlanComparePath(path)
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("wrong result\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestComparePlanJSON(t *testing.T) {
+	decode := func(t *testing.T, src string) any {
+		t.Helper()
+		v, err := decodePlanCompareJSON([]byte(src))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return v
+	}
+
+	tests := map[string]struct {
+		golden, plan string
+		ignore       []string
+		want         []string
+	}{
+		"equal": {
+			golden: `{"a":1,"b":[1,2]}`,
+			plan:   `{"a":1,"b":[1,2]}`,
+		},
+		"changed values": {
+			golden: `{"a":1,"b":[1,2],"c":{"d":"x"}}`,
+			plan:   `{"a":1.5,"b":[1,3],"c":{"d":"y"}}`,
+			want: []string{
+				`~ a: 1 -> 1.5`,
+				`~ b[1]: 2 -> 3`,
+				`~ c.d: "x" -> "y"`,
+			},
+		},
+		"added and removed": {
+			golden: `{"a":1,"list":[1,2]}`,
+			plan:   `{"b":2,"list":[1]}`,
+			want: []string{
+				`- a`,
+				`+ b`,
+				`- list[1]`,
+			},
+		},
+		"by address": {
+			golden: `{"resource_changes":[{"address":"a.x","v":1},{"address":"a.y","v":2}]}`,
+			plan:   `{"resource_changes":[{"address":"a.w","v":0},{"address":"a.y","v":3}]}`,
+			want: []string{
+				`- resource_changes[a.x]`,
+				`~ resource_changes[a.y].v: 2 -> 3`,
+				`+ resource_changes[a.w]`,
+			},
+		},
+		"different types": {
+			golden: `{"a":{"b":1}}`,
+			plan:   `{"a":[1]}`,
+			want: []string{
+				`~ a: {"b":1} -> [1]`,
+			},
+		},
+		"keys needing brackets": {
+			golden: `{"tags":{"kubernetes.io/role":"a"}}`,
+			plan:   `{"tags":{"kubernetes.io/role":"b"}}`,
+			want: []string{
+				`~ tags[kubernetes.io/role]: "a" -> "b"`,
+			},
+		},
+		"ignored": {
+			golden: `{"timestamp":"x","resource_changes":[{"address":"a.x","id":1,"v":1}]}`,
+			plan:   `{"timestamp":"y","resource_changes":[{"address":"a.x","id":2,"v":2}]}`,
+			ignore: []string{"timestamp", "resource_changes.*.id"},
+			want: []string{
+				`~ resource_changes[a.x].v: 1 -> 2`,
+			},
+		},
+		"ignored subtree": {
+			golden: `{"checks":[{"a":1}],"v":1}`,
+			plan:   `{"v":1}`,
+			ignore: []string{"checks"},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var ignore [][]string
+			for _, path := range test.ignore {
+				ignore = append(ignore, parsePlanComparePath(path))
+			}
+			got := comparePlanJSON(decode(t, test.golden), decode(t, test.plan), ignore)
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("wrong differences\n%s", diff)
+			}
+		})
+	}
+}
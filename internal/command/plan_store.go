@@ -0,0 +1,414 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"os/user"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/cli"
+
+	"github.com/opentofu/opentofu/internal/backend"
+	backendLocal "github.com/opentofu/opentofu/internal/backend/local"
+	"github.com/opentofu/opentofu/internal/command/arguments"
+	"github.com/opentofu/opentofu/internal/command/views"
+	"github.com/opentofu/opentofu/internal/encryption"
+	"github.com/opentofu/opentofu/internal/plans/planfile"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// planStoreFor returns the PlanStore for the given backend, or nil if the
+// backend can't store plans.
+func planStoreFor(b backend.Backend) backend.PlanStore {
+	// The local backend implements PlanStore even when it's wrapping another
+	// backend for state storage, in which case it's that other backend that
+	// must store the plans.
+	if local, ok := b.(*backendLocal.Local); ok && local.Backend != nil {
+		b = local.Backend
+	}
+	ps, ok := b.(backend.PlanStore)
+	if !ok {
+		return nil
+	}
+	return ps
+}
+
+// storePlan saves the plan file at the given path in the given plan store,
+// returning the metadata it was stored with.
+func (m *Meta) storePlan(ctx context.Context, ps backend.PlanStore, planPath string, enc encryption.PlanEncryption) (backend.StoredPlanMeta, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+	var meta backend.StoredPlanMeta
+
+	raw, err := os.ReadFile(planPath)
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to read plan file",
+			fmt.Sprintf("Couldn't read the plan file to store it in the backend: %s.", err),
+		))
+		return meta, diags
+	}
+	configHash, err := planConfigHash(planPath, enc)
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to read plan file",
+			fmt.Sprintf("Couldn't read the configuration from the plan file to store it in the backend: %s.", err),
+		))
+		return meta, diags
+	}
+
+	// Every plan file records the time it was created, so the hash of its
+	// content is unique for each plan.
+	sum := sha256.Sum256(raw)
+	meta = backend.StoredPlanMeta{
+		ID:         hex.EncodeToString(sum[:])[:12],
+		CreatedBy:  currentUserName(),
+		CreatedAt:  time.Now().UTC(),
+		ConfigHash: configHash,
+	}
+
+	workspace, err := m.Workspace(ctx)
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Error selecting workspace",
+			err.Error(),
+		))
+		return meta, diags
+	}
+	if err := ps.StorePlan(ctx, workspace, meta, raw); err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to store plan",
+			fmt.Sprintf("Couldn't store the plan in the backend: %s.", err),
+		))
+		return meta, diags
+	}
+	return meta, diags
+}
+
+// planConfigHash returns a hash of the configuration snapshot in the given
+// plan file, which changes if any file of any module in the configuration
+// changes.
+func planConfigHash(planPath string, enc encryption.PlanEncryption) (string, error) {
+	pr, err := planfile.Open(planPath, enc)
+	if err != nil {
+		return "", err
+	}
+	snap, err := pr.ReadConfigSnapshot()
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	moduleKeys := make([]string, 0, len(snap.Modules))
+	for key := range snap.Modules {
+		moduleKeys = append(moduleKeys, key)
+	}
+	slices.Sort(moduleKeys)
+	for _, key := range moduleKeys {
+		mod := snap.Modules[key]
+		filenames := make([]string, 0, len(mod.Files))
+		for filename := range mod.Files {
+			filenames = append(filenames, filename)
+		}
+		slices.Sort(filenames)
+		for _, filename := range filenames {
+			fmt.Fprintf(h, "%s\x00%s\x00%d\x00", key, filename, len(mod.Files[filename]))
+			h.Write(mod.Files[filename])
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// currentUserName returns the name of the user running OpenTofu, for the
+// metadata of stored plans.
+func currentUserName() string {
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		return u.Username
+	}
+	if name := os.Getenv("USER"); name != "" {
+		return name
+	}
+	return "unknown"
+}
+
+// storedPlanOperationView wraps the operation view of a plan whose plan file
+// is saved only in the backend, so that the next steps refer to the stored
+// plan instead of the temporary plan file.
+type storedPlanOperationView struct {
+	views.Operation
+}
+
+func (v storedPlanOperationView) PlanNextStep(planPath string, genConfigPath string) {
+	// The plan view describes the next steps once the plan is stored.
+}
+
+// PlanListCommand is a Command implementation that lists the plans stored in
+// the backend for the current workspace.
+type PlanListCommand struct {
+	Meta
+}
+
+func (c *PlanListCommand) Run(rawArgs []string) int {
+	ctx := c.CommandContext()
+
+	common, rawArgs := arguments.ParseView(rawArgs)
+	c.View.Configure(common)
+
+	args, closer, diags := arguments.ParsePlanList(rawArgs)
+	defer closer()
+
+	view := views.NewPlanList(args.ViewOptions, c.View)
+	if diags.HasErrors() {
+		view.Diagnostics(diags)
+		if args.ViewOptions.ViewType == arguments.ViewJSON {
+			return 1 // in case it's json, do not print the help of the command
+		}
+		return cli.RunResultHelp
+	}
+
+	ps, workspace, moreDiags := c.storedPlanBackend(ctx)
+	diags = diags.Append(moreDiags)
+	if diags.HasErrors() {
+		view.Diagnostics(diags)
+		return 1
+	}
+
+	stored, err := ps.StoredPlans(ctx, workspace)
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to list stored plans",
+			fmt.Sprintf("Couldn't list the plans stored in the backend: %s.", err),
+		))
+		view.Diagnostics(diags)
+		return 1
+	}
+
+	view.Diagnostics(diags)
+	if len(stored) == 0 {
+		view.NoStoredPlans(workspace)
+		return 0
+	}
+	for _, meta := range stored {
+		view.StoredPlan(meta.ID, meta.CreatedBy, meta.CreatedAt, meta.ConfigHash)
+	}
+	return 0
+}
+
+func (c *PlanListCommand) Help() string {
+	helpText := `
+Usage: tofu [global options] plan list [options]
+
+  Lists the plans stored in the backend for the current workspace by
+  "tofu plan -store", oldest first.
+
+  Each line shows the ID of the plan, when and by whom it was created, and
+  the start of a hash of the configuration it was created from.
+
+Options:
+
+  -json               Produce output in a machine-readable JSON format,
+                      suitable for use in text editor integrations and other
+                      automated systems.
+
+  -json-into=out.json Produce the same output as -json, but sent directly
+                      to the given file. This allows automation to preserve
+                      the original human-readable output streams, while
+                      capturing more detailed logs for machine analysis.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *PlanListCommand) Synopsis() string {
+	return "List the plans stored in the backend"
+}
+
+// PlanShowCommand is a Command implementation that shows a plan stored in
+// the backend, using the "show" command.
+type PlanShowCommand struct {
+	Meta
+}
+
+func (c *PlanShowCommand) Run(rawArgs []string) int {
+	return c.runWithStoredPlan("plan show", rawArgs, func(args []string) int {
+		return (&ShowCommand{Meta: c.Meta}).Run(args)
+	})
+}
+
+func (c *PlanShowCommand) Help() string {
+	helpText := `
+Usage: tofu [global options] plan show [options] ID
+
+  Shows the plan with the given ID that was stored in the backend for the
+  current workspace by "tofu plan -store".
+
+  The options are the same as for "tofu show". Run "tofu show -help" for
+  the list of options.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *PlanShowCommand) Synopsis() string {
+	return "Show a plan stored in the backend"
+}
+
+// PlanApplyCommand is a Command implementation that applies a plan stored
+// in the backend, using the "apply" command.
+type PlanApplyCommand struct {
+	Meta
+}
+
+func (c *PlanApplyCommand) Run(rawArgs []string) int {
+	return c.runWithStoredPlan("plan apply", rawArgs, func(args []string) int {
+		return (&ApplyCommand{Meta: c.Meta}).Run(args)
+	})
+}
+
+func (c *PlanApplyCommand) Help() string {
+	helpText := `
+Usage: tofu [global options] plan apply [options] ID
+
+  Applies the plan with the given ID that was stored in the backend for the
+  current workspace by "tofu plan -store".
+
+  This performs exactly the same actions as applying the saved plan file
+  would. The options are the same as for "tofu apply" with a saved plan.
+  Run "tofu apply -help" for the list of options.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *PlanApplyCommand) Synopsis() string {
+	return "Apply a plan stored in the backend"
+}
+
+// runWithStoredPlan retrieves the stored plan whose ID is the last of the
+// given arguments into a temporary file, and then calls the given function
+// with the remaining arguments followed by the path of that file.
+func (m *Meta) runWithStoredPlan(command string, rawArgs []string, run func(args []string) int) int {
+	ctx := m.CommandContext()
+
+	// The wrapped command configures the view itself, but we need to
+	// configure it here as well so that our own diagnostics are rendered
+	// as requested.
+	common, viewArgs := arguments.ParseView(slices.Clone(rawArgs))
+	m.View.Configure(common)
+
+	args, diags := arguments.ParseStoredPlan(command, viewArgs)
+	if diags.HasErrors() {
+		m.View.Diagnostics(diags)
+		return cli.RunResultHelp
+	}
+
+	ps, workspace, moreDiags := m.storedPlanBackend(ctx)
+	diags = diags.Append(moreDiags)
+	if diags.HasErrors() {
+		m.View.Diagnostics(diags)
+		return 1
+	}
+
+	_, raw, err := ps.StoredPlan(ctx, workspace, args.ID)
+	if err != nil {
+		if errors.Is(err, backend.ErrStoredPlanNotFound) {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Stored plan not found",
+				fmt.Sprintf("There is no plan with the ID %q stored for the workspace %q. Use \"tofu plan list\" to see the stored plans.", args.ID, workspace),
+			))
+		} else {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Failed to read stored plan",
+				fmt.Sprintf("Couldn't read the plan %q from the backend: %s.", args.ID, err),
+			))
+		}
+		m.View.Diagnostics(diags)
+		return 1
+	}
+
+	f, err := os.CreateTemp("", "tofu-plan-*.tfplan")
+	if err == nil {
+		defer os.Remove(f.Name())
+		_, err = f.Write(raw)
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to write plan file",
+			fmt.Sprintf("Couldn't write the stored plan to a temporary file: %s.", err),
+		))
+		m.View.Diagnostics(diags)
+		return 1
+	}
+
+	m.View.Diagnostics(diags)
+
+	// The other arguments are passed on unchanged, followed by the path of
+	// the plan file in place of the plan ID.
+	forward := slices.Clone(rawArgs)
+	for i := len(forward) - 1; i >= 0; i-- {
+		if forward[i] == args.ID {
+			forward = slices.Delete(forward, i, i+1)
+			break
+		}
+	}
+	return run(append(forward, f.Name()))
+}
+
+// storedPlanBackend initializes the backend for one of the stored plan
+// commands, returning its plan store along with the current workspace.
+func (m *Meta) storedPlanBackend(ctx context.Context) (backend.PlanStore, string, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	enc, encDiags := m.Encryption(ctx)
+	diags = diags.Append(encDiags)
+	if encDiags.HasErrors() {
+		return nil, "", diags
+	}
+
+	b, backendDiags := m.Backend(ctx, nil, enc.State())
+	diags = diags.Append(backendDiags)
+	if backendDiags.HasErrors() {
+		return nil, "", diags
+	}
+
+	ps := planStoreFor(b)
+	if ps == nil {
+		diags = diags.Append(errPlanStoreNotSupported)
+		return nil, "", diags
+	}
+
+	workspace, err := m.Workspace(ctx)
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Error selecting workspace",
+			err.Error(),
+		))
+		return nil, "", diags
+	}
+	return ps, workspace, diags
+}
+
+var errPlanStoreNotSupported = tfdiags.Sourceless(
+	tfdiags.Error,
+	"Stored plans not supported",
+	"The backend for this configuration can't store plans. Use the -out option of \"tofu plan\" to save the plan to a file instead.",
+)
@@ -0,0 +1,131 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/opentofu/opentofu/internal/backend/local"
+	"github.com/opentofu/opentofu/internal/command/workdir"
+)
+
+func TestPlan_store(t *testing.T) {
+	td := t.TempDir()
+	testCopyDir(t, testFixturePath("apply"), td)
+	t.Chdir(td)
+
+	p := applyFixtureProvider()
+	// Plan and store the result
+	view, done := testView(t)
+	planCmd := &PlanCommand{
+		Meta: Meta{
+			WorkingDir:       workdir.NewDir("."),
+			testingOverrides: metaOverridesForProvider(p),
+			View:             view,
+		},
+	}
+	code := planCmd.Run([]string{"-store", "-no-color"})
+	output := done(t)
+	if code != 0 {
+		t.Fatalf("plan failed: %d\n\n%s", code, output.Stderr())
+	}
+	match := regexp.MustCompile(`Stored the plan in the backend with ID: ([0-9a-f]+)`).FindStringSubmatch(output.Stdout())
+	if match == nil {
+		t.Fatalf("output doesn't include the stored plan ID\n%s", output.Stdout())
+	}
+	id := match[1]
+	if strings.Contains(output.Stdout(), "Saved the plan to") {
+		t.Errorf("output refers to the temporary plan file\n%s", output.Stdout())
+	}
+	if _, err := os.Stat(filepath.Join(td, local.DefaultPlanStoreDir, id+".tfplan")); err != nil {
+		t.Fatalf("plan file wasn't stored: %s", err)
+	}
+
+	// List the stored plans
+	view, done = testView(t)
+	listCmd := &PlanListCommand{
+		Meta: Meta{
+			WorkingDir:       workdir.NewDir("."),
+			testingOverrides: metaOverridesForProvider(p),
+			View:             view,
+		},
+	}
+	code = listCmd.Run(nil)
+	output = done(t)
+	if code != 0 {
+		t.Fatalf("plan list failed: %d\n\n%s", code, output.Stderr())
+	}
+	if got := output.Stdout(); !strings.HasPrefix(got, id+"  ") || strings.Count(got, "\n") != 1 {
+		t.Errorf("wrong plan list output\n%s", got)
+	}
+
+	// Show the stored plan
+	view, done = testView(t)
+	showCmd := &PlanShowCommand{
+		Meta: Meta{
+			WorkingDir:       workdir.NewDir("."),
+			testingOverrides: metaOverridesForProvider(p),
+			View:             view,
+		},
+	}
+	code = showCmd.Run([]string{"-no-color", id})
+	output = done(t)
+	if code != 0 {
+		t.Fatalf("plan show failed: %d\n\n%s", code, output.Stderr())
+	}
+	if got, want := output.Stdout(), "# test_instance.foo will be created"; !strings.Contains(got, want) {
+		t.Errorf("plan show output is missing %q\n%s", want, got)
+	}
+
+	// Apply the stored plan
+	view, done = testView(t)
+	applyCmd := &PlanApplyCommand{
+		Meta: Meta{
+			WorkingDir:       workdir.NewDir("."),
+			testingOverrides: metaOverridesForProvider(p),
+			View:             view,
+		},
+	}
+	code = applyCmd.Run([]string{id})
+	output = done(t)
+	if code != 0 {
+		t.Fatalf("plan apply failed: %d\n\n%s", code, output.Stderr())
+	}
+	if !p.ApplyResourceChangeCalled {
+		t.Error("provider's ApplyResourceChange wasn't called")
+	}
+	state := testStateRead(t, filepath.Join(td, local.DefaultStateFilename))
+	if state.Empty() {
+		t.Error("state is empty after applying the stored plan")
+	}
+}
+
+func TestPlanApply_notFound(t *testing.T) {
+	td := t.TempDir()
+	testCopyDir(t, testFixturePath("apply"), td)
+	t.Chdir(td)
+
+	view, done := testView(t)
+	c := &PlanApplyCommand{
+		Meta: Meta{
+			WorkingDir:       workdir.NewDir("."),
+			testingOverrides: metaOverridesForProvider(applyFixtureProvider()),
+			View:             view,
+		},
+	}
+	code := c.Run([]string{"abc123"})
+	output := done(t)
+	if code != 1 {
+		t.Fatalf("wrong exit status %d; want 1\n%s", code, output.Stdout())
+	}
+	if got, want := output.Stderr(), `There is no plan with the ID "abc123" stored`; !strings.Contains(got, want) {
+		t.Errorf("wrong error\ngot: %s\nwant: %s", got, want)
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/opentofu/opentofu/internal/addrs"
 	backendinit "github.com/opentofu/opentofu/internal/backend/init"
 	"github.com/opentofu/opentofu/internal/checks"
+	"github.com/opentofu/opentofu/internal/configs"
 	"github.com/opentofu/opentofu/internal/configs/configschema"
 	"github.com/opentofu/opentofu/internal/encryption"
 	"github.com/opentofu/opentofu/internal/plans"
@@ -32,6 +33,7 @@ import (
 	"github.com/opentofu/opentofu/internal/providers"
 	"github.com/opentofu/opentofu/internal/states"
 	"github.com/opentofu/opentofu/internal/states/statefile"
+	"github.com/opentofu/opentofu/internal/terminal"
 	"github.com/opentofu/opentofu/internal/tfdiags"
 	"github.com/opentofu/opentofu/internal/tofu"
 )
@@ -196,6 +198,86 @@ func TestPlan_destroy(t *testing.T) {
 	}
 }
 
+func TestPlan_noiseRules(t *testing.T) {
+	td := t.TempDir()
+	testCopyDir(t, testFixturePath("plan"), td)
+	t.Chdir(td)
+
+	originalState := states.BuildState(func(s *states.SyncState) {
+		s.SetResourceInstanceCurrent(
+			addrs.Resource{
+				Mode: addrs.ManagedResourceMode,
+				Type: "test_instance",
+				Name: "foo",
+			}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance),
+			&states.ResourceInstanceObjectSrc{
+				AttrsJSON: []byte(`{"id":"bar","ami":"baz","network_interface":[{"device_index":"0","description":"Main network interface"}]}`),
+				Status:    states.ObjectReady,
+			},
+			addrs.AbsProviderConfig{
+				Provider: addrs.NewDefaultProvider("test"),
+				Module:   addrs.RootModule,
+			},
+			addrs.NoKey,
+		)
+	})
+	statePath := testStateFile(t, originalState)
+
+	run := func(t *testing.T, diffIgnore string) (int, *terminal.TestOutput) {
+		t.Helper()
+		if err := os.WriteFile(configs.DiffIgnoreFilename, []byte(diffIgnore), 0644); err != nil {
+			t.Fatal(err)
+		}
+		p := planFixtureProvider()
+		view, done := testView(t)
+		c := &PlanCommand{
+			Meta: Meta{
+				WorkingDir:       workdir.NewDir("."),
+				testingOverrides: metaOverridesForProvider(p),
+				View:             view,
+			},
+		}
+		code := c.Run([]string{"-state", statePath, "-no-color"})
+		return code, done(t)
+	}
+
+	t.Run("noisy attribute", func(t *testing.T) {
+		code, output := run(t, `
+ignore "test_instance" {
+  attributes = [ami]
+}
+`)
+		if code != 0 {
+			t.Fatalf("bad: %d\n\n%s", code, output.Stderr())
+		}
+		stdout := output.Stdout()
+		if want := "# (noisy changes to ami hidden)"; !strings.Contains(stdout, want) {
+			t.Errorf("output is missing %q\n%s", want, stdout)
+		}
+		if strings.Contains(stdout, `"baz" -> "bar"`) {
+			t.Errorf("output includes the noisy change\n%s", stdout)
+		}
+		// The change is still planned, and counted in the summary.
+		if want := "Plan: 0 to add, 1 to change, 0 to destroy."; !strings.Contains(stdout, want) {
+			t.Errorf("output is missing %q\n%s", want, stdout)
+		}
+	})
+
+	t.Run("invalid file", func(t *testing.T) {
+		code, output := run(t, `
+ignore "test_instance" {
+  attributes = [network_interface[0].description]
+}
+`)
+		if code != 1 {
+			t.Fatalf("wrong exit status %d; want 1\n%s", code, output.Stdout())
+		}
+		if want := "Invalid attribute path"; !strings.Contains(output.Stderr(), want) {
+			t.Errorf("error output is missing %q\n%s", want, output.Stderr())
+		}
+	})
+}
+
 func TestPlan_noState(t *testing.T) {
 	td := t.TempDir()
 	testCopyDir(t, testFixturePath("plan"), td)
@@ -307,6 +389,101 @@ func TestPlan_generatedConfigPath(t *testing.T) {
 	testFileEquals(t, genPath, filepath.Join(td, "generated.tf.expected"))
 }
 
+func TestPlan_suggestMoves(t *testing.T) {
+	td := t.TempDir()
+	testCopyDir(t, testFixturePath("plan-suggest-moves"), td)
+	t.Chdir(td)
+
+	state := states.BuildState(func(s *states.SyncState) {
+		for i, ami := range []string{"ami-a", "ami-b", "ami-old"} {
+			s.SetResourceInstanceCurrent(
+				addrs.Resource{
+					Mode: addrs.ManagedResourceMode,
+					Type: "test_instance",
+					Name: "foo",
+				}.Instance(addrs.IntKey(i)).Absolute(addrs.RootModuleInstance),
+				&states.ResourceInstanceObjectSrc{
+					AttrsJSON: []byte(fmt.Sprintf(`{"id":"foo%d","ami":%q}`, i, ami)),
+					Status:    states.ObjectReady,
+				},
+				addrs.AbsProviderConfig{
+					Provider: addrs.NewDefaultProvider("test"),
+					Module:   addrs.RootModule,
+				},
+				addrs.NoKey,
+			)
+		}
+	})
+	statePath := testStateFile(t, state)
+	movesPath := filepath.Join(td, "moves.tf")
+
+	p := planFixtureProvider()
+	p.PlanResourceChangeFn = func(req providers.PlanResourceChangeRequest) providers.PlanResourceChangeResponse {
+		// As with a real provider, the id of a new object is only known
+		// after apply.
+		planned := req.ProposedNewState
+		if !planned.IsNull() && planned.GetAttr("id").IsNull() {
+			attrs := planned.AsValueMap()
+			attrs["id"] = cty.UnknownVal(cty.String)
+			planned = cty.ObjectVal(attrs)
+		}
+		return providers.PlanResourceChangeResponse{
+			PlannedState: planned,
+		}
+	}
+	view, done := testView(t)
+	c := &PlanCommand{
+		Meta: Meta{
+			WorkingDir:       workdir.NewDir("."),
+			testingOverrides: metaOverridesForProvider(p),
+			View:             view,
+		},
+	}
+
+	args := []string{
+		"-state", statePath,
+		"-suggest-moves", movesPath,
+	}
+	code := c.Run(args)
+	output := done(t)
+	if code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, output.Stderr())
+	}
+	if got, want := output.All(), "Resource instances could be moved instead"; !strings.Contains(got, want) {
+		t.Errorf("output does not contain %q\n%s", want, got)
+	}
+
+	got, err := os.ReadFile(movesPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `moved {
+  from = test_instance.foo[0]
+  to   = test_instance.foo["a"]
+}
+
+moved {
+  from = test_instance.foo[1]
+  to   = test_instance.foo["b"]
+}
+`
+	if string(got) != want {
+		t.Errorf("wrong suggested moves\ngot:\n%s\nwant:\n%s", got, want)
+	}
+
+	// The plan would fail rather than overwrite the file.
+	view, done = testView(t)
+	c.View = view
+	code = c.Run(args)
+	output = done(t)
+	if code != 1 {
+		t.Fatalf("expected failure when the file exists: %d\n\n%s", code, output.Stdout())
+	}
+	if got, want := output.Stderr(), "Target suggested moves file already exists"; !strings.Contains(got, want) {
+		t.Errorf("output does not contain %q\n%s", want, got)
+	}
+}
+
 func TestPlan_outPath(t *testing.T) {
 	td := t.TempDir()
 	testCopyDir(t, testFixturePath("plan"), td)
@@ -646,6 +823,54 @@ func TestPlan_refreshFalseRefreshTrue(t *testing.T) {
 	}
 }
 
+func TestPlan_refreshTarget(t *testing.T) {
+	tests := map[string]struct {
+		target      string
+		wantRefresh bool
+	}{
+		"object in state": {
+			target:      "module.child",
+			wantRefresh: true,
+		},
+		"other object": {
+			target:      "test_instance.foo",
+			wantRefresh: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			td := t.TempDir()
+			testCopyDir(t, testFixturePath("plan-existing-state"), td)
+			t.Chdir(td)
+
+			p := planFixtureProvider()
+			view, done := testView(t)
+			c := &PlanCommand{
+				Meta: Meta{
+					WorkingDir:       workdir.NewDir("."),
+					testingOverrides: metaOverridesForProvider(p),
+					View:             view,
+				},
+			}
+
+			args := []string{
+				"-refresh=false",
+				"-refresh-target=" + test.target,
+			}
+			code := c.Run(args)
+			output := done(t)
+			if code != 0 {
+				t.Fatalf("bad: %d\n\n%s", code, output.Stderr())
+			}
+
+			if p.ReadResourceCalled != test.wantRefresh {
+				t.Fatalf("ReadResource called: %t; want %t", p.ReadResourceCalled, test.wantRefresh)
+			}
+		})
+	}
+}
+
 func TestPlan_state(t *testing.T) {
 	// Create a temporary working directory that is empty
 	td := t.TempDir()
@@ -1362,6 +1587,86 @@ func TestPlan_varFile(t *testing.T) {
 	}
 }
 
+func TestPlan_varFileYAML(t *testing.T) {
+	td := t.TempDir()
+	t.Chdir(td)
+	writeFile := func(name, content string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(td, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeFile("main.tf", `
+variable "replicas" {
+  type = number
+}
+
+variable "zones" {
+  type = list(string)
+}
+
+resource "test_instance" "foo" {
+  value = "${var.replicas}:${join(",", var.zones)}"
+}
+`)
+
+	t.Run("valid", func(t *testing.T) {
+		writeFile("valid.yaml", "replicas: \"3\"\nzones:\n  - a\n  - b\n")
+
+		p := planVarsFixtureProvider()
+		view, done := testView(t)
+		c := &PlanCommand{
+			Meta: Meta{
+				WorkingDir:       workdir.NewDir("."),
+				testingOverrides: metaOverridesForProvider(p),
+				View:             view,
+			},
+		}
+
+		actual := ""
+		p.PlanResourceChangeFn = func(req providers.PlanResourceChangeRequest) (resp providers.PlanResourceChangeResponse) {
+			actual = req.ProposedNewState.GetAttr("value").AsString()
+			resp.PlannedState = req.ProposedNewState
+			return
+		}
+
+		code := c.Run([]string{"-var-file=valid.yaml"})
+		output := done(t)
+		if code != 0 {
+			t.Fatalf("bad: %d\n\n%s", code, output.Stderr())
+		}
+		if want := "3:a,b"; actual != want {
+			t.Fatalf("wrong value %q; want %q", actual, want)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		writeFile("invalid.yaml", "replicas: many\nzones: [a]\n")
+
+		p := planVarsFixtureProvider()
+		view, done := testView(t)
+		c := &PlanCommand{
+			Meta: Meta{
+				WorkingDir:       workdir.NewDir("."),
+				testingOverrides: metaOverridesForProvider(p),
+				View:             view,
+			},
+		}
+
+		code := c.Run([]string{"-var-file=invalid.yaml"})
+		output := done(t)
+		if code != 1 {
+			t.Fatalf("wrong exit code %d; want 1\n\n%s", code, output.Stdout())
+		}
+		got := output.Stderr()
+		for _, want := range []string{"Invalid value for input variable", "var.replicas", "a number is required"} {
+			if !strings.Contains(got, want) {
+				t.Errorf("error doesn't include %q\n%s", want, got)
+			}
+		}
+	})
+}
+
 func TestPlan_varFileDefault(t *testing.T) {
 	// Create a temporary working directory that is empty
 	td := t.TempDir()
@@ -0,0 +1,271 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/mitchellh/cli"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/backend"
+	"github.com/opentofu/opentofu/internal/command/arguments"
+	"github.com/opentofu/opentofu/internal/command/views"
+	"github.com/opentofu/opentofu/internal/configs"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+	"github.com/opentofu/opentofu/internal/tofu"
+)
+
+// ProvidersConfigCommand is a Command implementation that prints out the
+// effective configuration of the providers configured in the root module,
+// including the provider overrides of the current workspace.
+type ProvidersConfigCommand struct {
+	Meta
+}
+
+func (c *ProvidersConfigCommand) Help() string {
+	return providersConfigCommandHelp
+}
+
+func (c *ProvidersConfigCommand) Synopsis() string {
+	return "Show the effective provider configurations for the current workspace"
+}
+
+func (c *ProvidersConfigCommand) Run(rawArgs []string) int {
+	ctx := c.CommandContext()
+
+	common, rawArgs := arguments.ParseView(rawArgs)
+	c.View.Configure(common)
+
+	args, closer, diags := arguments.ParseProvidersConfig(rawArgs)
+	defer closer()
+
+	view := views.NewProvidersConfig(args.ViewOptions, c.View)
+	if diags.HasErrors() {
+		view.Diagnostics(diags)
+		return cli.RunResultHelp
+	}
+
+	c.Meta.variableArgs = args.Vars.All()
+
+	// Check for user-supplied plugin path
+	var err error
+	if c.pluginPath, err = c.loadPluginPath(); err != nil {
+		view.Diagnostics(diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Plugins loading error",
+			fmt.Sprintf("Error loading plugin path: %s", err),
+		)))
+		return 1
+	}
+
+	enc, encDiags := c.Encryption(ctx)
+	diags = diags.Append(encDiags)
+	if encDiags.HasErrors() {
+		view.Diagnostics(diags)
+		return 1
+	}
+
+	// Load the backend
+	b, backendDiags := c.Backend(ctx, nil, enc.State())
+	diags = diags.Append(backendDiags)
+	if backendDiags.HasErrors() {
+		view.Diagnostics(diags)
+		return 1
+	}
+
+	// We require a local backend
+	local, ok := b.(backend.Local)
+	if !ok {
+		view.Diagnostics(diags) // in case of any warnings in here
+		view.UnsupportedLocalOp()
+		return 1
+	}
+
+	// This is a read-only command
+	c.ignoreRemoteVersionConflict(b)
+
+	// we expect that the config dir is the cwd
+	cwd, err := os.Getwd()
+	if err != nil {
+		view.Diagnostics(diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Error getting cwd",
+			err.Error(),
+		)))
+		return 1
+	}
+
+	workspace, err := c.Workspace(ctx)
+	if err != nil {
+		view.Diagnostics(diags.Append(err))
+		return 1
+	}
+
+	// Build the operation
+	opReq := c.Operation(ctx, b, view.Backend(), enc)
+	opReq.ConfigDir = cwd
+	opReq.ConfigLoader, err = c.initConfigLoader()
+	opReq.AllowUnsetVariables = true // we'll just evaluate them as unknown
+	if err != nil {
+		diags = diags.Append(err)
+		view.Diagnostics(diags)
+		return 1
+	}
+
+	{
+		var moreDiags, callDiags tfdiags.Diagnostics
+		opReq.Variables, moreDiags = c.collectVariableValues()
+		opReq.RootCall, callDiags = c.rootModuleCall(ctx, opReq.ConfigDir)
+		diags = diags.Append(moreDiags).Append(callDiags)
+		if moreDiags.HasErrors() || callDiags.HasErrors() {
+			view.Diagnostics(diags)
+			return 1
+		}
+	}
+
+	// Get the context
+	stopCtx, cancel := c.InterruptibleContext(ctx)
+	defer cancel()
+	lr, _, ctxDiags := local.LocalRun(ctx, stopCtx, opReq)
+	diags = diags.Append(ctxDiags)
+	if ctxDiags.HasErrors() {
+		view.Diagnostics(diags)
+		return 1
+	}
+
+	// Successfully creating the context can result in a lock, so ensure we release it
+	defer func() {
+		diags := opReq.StateLocker.Unlock()
+		if diags.HasErrors() {
+			view.Diagnostics(diags)
+		}
+	}()
+
+	schemas, moreDiags := lr.Core.Schemas(ctx, lr.Config, lr.InputState)
+	diags = diags.Append(moreDiags)
+	if moreDiags.HasErrors() {
+		view.Diagnostics(diags)
+		return 1
+	}
+
+	evalOpts := &tofu.EvalOpts{}
+	if lr.PlanOpts != nil {
+		evalOpts.SetVariables = lr.PlanOpts.SetVariables
+	}
+	scope, scopeDiags := lr.Core.Eval(ctx, lr.Config, lr.InputState, addrs.RootModuleInstance, evalOpts)
+	diags = diags.Append(scopeDiags)
+	if scope == nil {
+		view.Diagnostics(diags)
+		return 1
+	}
+
+	var result []*views.ProviderConfiguration
+	for _, pc := range sortedProviderConfigs(lr.Config.Module) {
+		providerAddr := lr.Config.Module.ProviderForLocalConfig(pc.Addr())
+		schema := schemas.ProviderConfig(providerAddr)
+		if schema == nil {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Provider not available",
+				fmt.Sprintf("The provider %s is not available. Run \"tofu init\" to install it.", providerAddr),
+			))
+			continue
+		}
+		if pc.Instances != nil {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Warning,
+				"Provider configuration not shown",
+				fmt.Sprintf("The configuration for %s uses for_each, so it has a different effective configuration for each instance.", pc.Addr().StringCompact()),
+			))
+			continue
+		}
+
+		val, evalDiags := scope.EvalBlock(ctx, pc.Config, schema)
+		diags = diags.Append(evalDiags)
+		if evalDiags.HasErrors() {
+			continue
+		}
+		if pvm := schema.ValueMarks(val, nil, nil); len(pvm) > 0 {
+			val = val.MarkWithPaths(pvm)
+		}
+
+		config := &views.ProviderConfiguration{
+			Addr: addrs.AbsProviderConfig{
+				Module:   addrs.RootModule,
+				Provider: providerAddr,
+				Alias:    pc.Alias,
+			},
+			Local:  pc.Addr(),
+			Schema: schema,
+			Value:  val,
+		}
+		if pc.WorkspaceOverride != nil {
+			config.OverrideFile = providerOverrideFilename(workspace)
+			config.Overridden = pc.WorkspaceOverride.Arguments
+		}
+		result = append(result, config)
+	}
+
+	if diags.HasErrors() {
+		view.Diagnostics(diags)
+		return 1
+	}
+	view.Diagnostics(diags)
+	view.ProviderConfigs(workspace, result)
+	return 0
+}
+
+// sortedProviderConfigs returns the provider configurations of the given
+// module, ordered by their local names and aliases.
+func sortedProviderConfigs(mod *configs.Module) []*configs.Provider {
+	ret := make([]*configs.Provider, 0, len(mod.ProviderConfigs))
+	for _, pc := range mod.ProviderConfigs {
+		ret = append(ret, pc)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Name != ret[j].Name {
+			return ret[i].Name < ret[j].Name
+		}
+		return ret[i].Alias < ret[j].Alias
+	})
+	return ret
+}
+
+const providersConfigCommandHelp = `
+Usage: tofu [global options] providers config [options]
+
+  Prints out the effective configuration of each provider configured in the
+  root module, after applying the provider overrides of the current workspace.
+
+  The provider overrides of a workspace are the "provider_override" blocks in
+  the file named after the workspace with the suffix ".tfoverride.hcl", such
+  as "production.tfoverride.hcl", in the root module directory.
+
+  The values of sensitive arguments are not shown.
+
+Options:
+
+  -var 'foo=bar'     Set a value for one of the input variables in the root
+                     module of the configuration. Use this option more than
+                     once to set more than one variable.
+
+  -var-file=filename Load variable values from the given file, in addition
+                     to the default files terraform.tfvars and *.auto.tfvars.
+                     Use this option more than once to include more than one
+                     variables file.
+
+  -json              Produce output in a machine-readable JSON format,
+                     suitable for use in text editor integrations and other
+                     automated systems. Always disables color.
+
+  -json-into=out.json Produce the same output as -json, but sent directly
+                     to the given file. This allows automation to preserve
+                     the original human-readable output streams, while
+                     capturing more detailed logs for machine analysis.
+`
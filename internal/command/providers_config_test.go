@@ -0,0 +1,143 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/opentofu/opentofu/internal/command/workdir"
+	"github.com/opentofu/opentofu/internal/configs/configschema"
+	"github.com/opentofu/opentofu/internal/providers"
+)
+
+func TestProvidersConfig(t *testing.T) {
+	testCwdTemp(t)
+
+	writeFile := func(name, src string) {
+		t.Helper()
+		if err := os.WriteFile(name, []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeFile("main.tf", `
+variable "region" {
+  default = "us-east-1"
+}
+
+provider "test" {
+  region = var.region
+  token  = "secret"
+}
+
+provider "test" {
+  alias  = "dns"
+  region = "us-east-1"
+}
+`)
+	writeFile("staging.tfoverride.hcl", `
+provider_override "test" {
+  region = "eu-west-1"
+  assume_role {
+    role_arn = "arn:staging"
+  }
+}
+`)
+
+	p := testProvider()
+	p.GetProviderSchemaResponse = &providers.GetProviderSchemaResponse{
+		Provider: providers.Schema{
+			Block: &configschema.Block{
+				Attributes: map[string]*configschema.Attribute{
+					"region": {Type: cty.String, Optional: true},
+					"token":  {Type: cty.String, Optional: true, Sensitive: true},
+				},
+				BlockTypes: map[string]*configschema.NestedBlock{
+					"assume_role": {
+						Nesting: configschema.NestingSingle,
+						Block: configschema.Block{
+							Attributes: map[string]*configschema.Attribute{
+								"role_arn": {Type: cty.String, Required: true},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	run := func(args ...string) string {
+		t.Helper()
+		view, done := testView(t)
+		c := &ProvidersConfigCommand{
+			Meta: Meta{
+				WorkingDir:       workdir.NewDir("."),
+				testingOverrides: metaOverridesForProvider(p),
+				View:             view,
+			},
+		}
+		code := c.Run(args)
+		output := done(t)
+		if code != 0 {
+			t.Fatalf("wrong exit code %d; want 0\n%s", code, output.All())
+		}
+		return output.Stdout()
+	}
+
+	// The default workspace has no overrides.
+	got := run()
+	want := `provider "test" {
+  region = "us-east-1"
+  token  = (sensitive value)
+}
+
+provider "test" {
+  alias  = "dns"
+  region = "us-east-1"
+}
+`
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("wrong output for the default workspace\n%s", diff)
+	}
+
+	t.Setenv("TF_WORKSPACE", "staging")
+	got = run()
+	want = `# Overridden by staging.tfoverride.hcl: assume_role, region
+provider "test" {
+  region = "eu-west-1"
+  token  = (sensitive value)
+  assume_role {
+    role_arn = "arn:staging"
+  }
+}
+
+provider "test" {
+  alias  = "dns"
+  region = "us-east-1"
+}
+`
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("wrong output for the staging workspace\n%s", diff)
+	}
+
+	got = run("-json")
+	for _, want := range []string{
+		`"type":"provider_config"`,
+		`"address":"provider[\"registry.opentofu.org/hashicorp/test\"]"`,
+		`"arguments":{"assume_role":{"role_arn":"arn:staging"},"region":"eu-west-1","token":null}`,
+		`"sensitive":["token"]`,
+		`"override_file":"staging.tfoverride.hcl"`,
+		`"overridden":["assume_role","region"]`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("JSON output is missing %s\n%s", want, got)
+		}
+	}
+}
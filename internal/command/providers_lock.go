@@ -7,8 +7,11 @@ package command
 
 import (
 	"fmt"
+	"log"
+	"maps"
 	"net/url"
 	"os"
+	"slices"
 
 	"github.com/mitchellh/cli"
 	"github.com/opentofu/opentofu/internal/addrs"
@@ -78,6 +81,9 @@ func (c *ProvidersLockCommand) Run(rawArgs []string) int {
 	if args.NetMirrorURL != "" {
 		span.SetAttributes(traceattrs.String("opentofu.provider.lock.netmirror", args.NetMirrorURL))
 	}
+	if args.PlatformPreset != "" {
+		span.SetAttributes(traceattrs.String("opentofu.provider.lock.platformpreset", args.PlatformPreset))
+	}
 
 	providerStrs := args.Providers
 
@@ -149,6 +155,10 @@ func (c *ProvidersLockCommand) Run(rawArgs []string) int {
 	reqs, _, hclDiags := config.ProviderRequirements()
 	diags = diags.Append(hclDiags)
 
+	// Resolving conflicts in the lock file must consider every provider
+	// in the configuration, including those we're not updating below.
+	configReqs := maps.Clone(reqs)
+
 	// If we have explicit provider selections on the command line then
 	// we'll modify "reqs" to only include those. Modifying this is okay
 	// because config.ProviderRequirements generates a fresh map result
@@ -189,8 +199,16 @@ func (c *ProvidersLockCommand) Run(rawArgs []string) int {
 
 	// We'll start our work with whatever locks we already have, so that
 	// we'll honor any existing version selections and just add additional
-	// hashes for them.
-	oldLocks, moreDiags := c.lockedDependenciesWithPredecessorRegistryShimmed()
+	// hashes for them. If requested, we first resolve any version control
+	// conflicts in the lock file, which would otherwise make it invalid.
+	var oldLocks *depsfile.Locks
+	var moreDiags tfdiags.Diagnostics
+	resolvedConflicts := false
+	if args.Resolve {
+		oldLocks, resolvedConflicts, moreDiags = c.resolveLockedDependencies(configReqs, view)
+	} else {
+		oldLocks, moreDiags = c.lockedDependenciesWithPredecessorRegistryShimmed()
+	}
 	diags = diags.Append(moreDiags)
 
 	// If we have any error diagnostics already then we won't proceed further.
@@ -215,6 +233,50 @@ func (c *ProvidersLockCommand) Run(rawArgs []string) int {
 	updatedLocks := map[getproviders.Platform]*depsfile.Locks{}
 	selectedVersions := map[addrs.Provider]getproviders.Version{}
 	for _, platform := range platforms {
+		// If requested, we calculate the checksums for providers that are
+		// already locked from the packages we find in the local cache
+		// directories, and only fetch the remaining ones.
+		platformReqs := reqs
+		cachedLocks := depsfile.NewLocks()
+		if args.FromCache {
+			platformReqs = maps.Clone(reqs)
+			for provider, constraints := range reqs {
+				oldLock := oldLocks.Provider(provider)
+				if oldLock == nil || !getproviders.MeetingConstraints(constraints).Has(oldLock.Version()) {
+					continue
+				}
+				hashes, path, err := providersLockCachedHashes(c.providersLockCacheDirs(), provider, oldLock.Version(), platform)
+				if err != nil {
+					diags = diags.Append(tfdiags.Sourceless(
+						tfdiags.Warning,
+						"Failed to use cached provider package",
+						fmt.Sprintf("OpenTofu failed to calculate the checksums of %s %s for %s from the cached package at %s, so it will be fetched instead: %s.", provider.ForDisplay(), oldLock.Version(), platform, path, err),
+					))
+					continue
+				}
+				if len(hashes) == 0 {
+					continue
+				}
+				// We only trust a cached package whose h1: checksum is
+				// already locked, because otherwise we'd record checksums
+				// for whatever happens to be in the cache directory without
+				// them ever being verified against the provider's origin.
+				if !slices.ContainsFunc(hashes, func(hash getproviders.Hash) bool {
+					return hash.HasScheme(getproviders.HashScheme1) && slices.Contains(oldLock.AllHashes(), hash)
+				}) {
+					log.Printf("[TRACE] providers lock: cached package at %s doesn't match a checksum already locked for %s %s, so fetching it instead", path, provider, oldLock.Version())
+					continue
+				}
+				view.HashesFromCache(provider.ForDisplay(), oldLock.Version().String(), platform.String(), path)
+				cachedLocks.SetProvider(provider, oldLock.Version(), oldLock.VersionConstraints(), hashes)
+				delete(platformReqs, provider)
+			}
+			if len(platformReqs) == 0 {
+				updatedLocks[platform] = cachedLocks
+				continue
+			}
+		}
+
 		tempDir, err := os.MkdirTemp("", "terraform-providers-lock")
 		if err != nil {
 			diags = diags.Append(tfdiags.Sourceless(
@@ -270,7 +332,7 @@ func (c *ProvidersLockCommand) Run(rawArgs []string) int {
 		dir := providercache.NewDirWithPlatform(tempDir, platform)
 		installer := providercache.NewInstaller(dir, source)
 
-		newLocks, err := installer.EnsureProviderVersions(ctx, oldLocks, reqs, providercache.InstallNewProvidersForce)
+		newLocks, err := installer.EnsureProviderVersions(ctx, oldLocks, platformReqs, providercache.InstallNewProvidersForce)
 		if err != nil {
 			diags = diags.Append(tfdiags.Sourceless(
 				tfdiags.Error,
@@ -279,6 +341,9 @@ func (c *ProvidersLockCommand) Run(rawArgs []string) int {
 			))
 			break
 		}
+		for _, lock := range cachedLocks.AllProviders() {
+			newLocks.SetProvider(lock.Provider(), lock.Version(), lock.VersionConstraints(), lock.AllHashes())
+		}
 		updatedLocks[platform] = newLocks
 	}
 
@@ -291,7 +356,7 @@ func (c *ProvidersLockCommand) Run(rawArgs []string) int {
 
 	// Track whether we've made any changes to the lock file as part of this
 	// operation. We can customise the final message based on our actions.
-	madeAnyChange := false
+	madeAnyChange := resolvedConflicts
 
 	// We now have a separate updated locks object for each platform. We need
 	// to merge those all together so that the final result has the union of
@@ -410,6 +475,30 @@ Options:
                      CPU. Each provider is available only for a limited
                      set of target platforms.
 
+  -platform-preset=name
+                     Add a predefined set of target platforms, in addition
+                     to any given with the -platform option. The preset
+                     "default-ci" selects linux_amd64, linux_arm64,
+                     darwin_amd64, darwin_arm64 and windows_amd64.
+
+  -from-cache        Calculate the checksums of providers whose version is
+                     already recorded in the lock file from the packages in
+                     the plugin cache directory and in the .terraform
+                     directory, instead of fetching them again. A cached
+                     package is only used if its "h1:" checksum is already
+                     in the lock file. Other packages are fetched as usual.
+
+                     Only packed zip archives allow calculating the legacy
+                     "zh:" checksums.
+
+  -resolve           Resolve the conflicts in a lock file that a version
+                     control system failed to merge, such as after a git
+                     merge or rebase. For each provider, OpenTofu selects
+                     the newest version from either side of the conflict
+                     that meets the configuration's version constraints,
+                     keeps the checksums from both sides for that version,
+                     and then adds any checksums that are missing as usual.
+
   -var 'foo=bar'     Set a value for one of the input variables in the root
                      module of the configuration. Use this option more than
                      once to set more than one variable.
@@ -430,6 +519,91 @@ Options:
 `
 }
 
+// resolveLockedDependencies reads the dependency lock file in the current
+// working directory like lockedDependencies, except that it also accepts a
+// file containing conflict markers left behind by a version control system.
+//
+// In that case the result is a merge of both sides of the conflicts that is
+// consistent with the given requirements, as described for
+// depsfile.MergeConflictedLocks, and the boolean result is true. The merge
+// includes all of the checksums that either side recorded for the selected
+// versions, and the caller can then add any checksums that are missing.
+func (c *ProvidersLockCommand) resolveLockedDependencies(reqs getproviders.Requirements, view views.ProvidersLock) (*depsfile.Locks, bool, tfdiags.Diagnostics) {
+	if _, err := os.Stat(dependencyLockFilename); os.IsNotExist(err) {
+		locks, diags := c.lockedDependenciesWithPredecessorRegistryShimmed()
+		return locks, false, diags
+	}
+
+	sides, diags := depsfile.LoadConflictedLocksFromFile(dependencyLockFilename)
+	if diags.HasErrors() {
+		return nil, false, diags
+	}
+	if len(sides) == 1 {
+		locks, moreDiags := c.lockedDependenciesWithPredecessorRegistryShimmed()
+		return locks, false, diags.Append(moreDiags)
+	}
+
+	locks := depsfile.MergeConflictedLocks(sides, reqs)
+	for _, provider := range depsfile.ConflictingProviders(sides) {
+		if _, required := reqs[provider]; !required {
+			continue
+		}
+		var version string
+		if lock := locks.Provider(provider); lock != nil {
+			version = lock.Version().String()
+		}
+		view.LockConflictResolved(provider.ForDisplay(), version)
+	}
+	return c.annotateDependencyLocksWithOverrides(locks), true, diags
+}
+
+// providersLockCacheDirs returns the directories that the -from-cache option
+// consults for already-downloaded provider packages: the global plugin cache
+// directory, if configured, and the provider cache of the working directory.
+func (c *ProvidersLockCommand) providersLockCacheDirs() []string {
+	var dirs []string
+	if c.PluginCacheDir != "" {
+		dirs = append(dirs, c.PluginCacheDir)
+	}
+	return append(dirs, c.WorkingDir.ProviderLocalCacheDir())
+}
+
+// providersLockCachedHashes calculates the checksums of the given provider
+// package from the first of the given directories that contains it, either
+// as a zip archive in the packed layout or as a directory in the unpacked
+// layout, and returns them along with the path of the package.
+//
+// Only a zip archive allows calculating a "zh:" checksum, whereas both the
+// archive and an unpacked directory allow calculating an "h1:" checksum. If
+// none of the directories contains the package, the result has no checksums.
+func providersLockCachedHashes(dirs []string, provider addrs.Provider, version getproviders.Version, platform getproviders.Platform) ([]getproviders.Hash, string, error) {
+	for _, dir := range dirs {
+		archivePath := getproviders.PackedFilePathForPackage(dir, provider, version, platform)
+		if info, err := os.Stat(archivePath); err == nil && info.Mode().IsRegular() {
+			archive := getproviders.PackageLocalArchive(archivePath)
+			zipHash, err := getproviders.PackageHashLegacyZipSHA(archive)
+			if err != nil {
+				return nil, archivePath, err
+			}
+			dirHash, err := getproviders.PackageHashV1(archive)
+			if err != nil {
+				return nil, archivePath, err
+			}
+			return []getproviders.Hash{dirHash, zipHash}, archivePath, nil
+		}
+
+		dirPath := getproviders.UnpackedDirectoryPathForPackage(dir, provider, version, platform)
+		if info, err := os.Stat(dirPath); err == nil && info.IsDir() {
+			dirHash, err := getproviders.PackageHashV1(getproviders.PackageLocalDir(dirPath))
+			if err != nil {
+				return nil, dirPath, err
+			}
+			return []getproviders.Hash{dirHash}, dirPath, nil
+		}
+	}
+	return nil, "", nil
+}
+
 // providersLockCalculateChangeType works out whether there is any difference
 // between oldLock and newLock and returns a variable the main function can use
 // to decide on which message to print.
@@ -6,13 +6,17 @@
 package command
 
 import (
+	"archive/zip"
+	"bytes"
 	"fmt"
 	"os"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"strings"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/mitchellh/cli"
 	"github.com/opentofu/opentofu/internal/command/workdir"
 
@@ -76,6 +80,235 @@ provider "registry.opentofu.org/hashicorp/test" {
 	})
 }
 
+func TestProvidersLock_fromCache(t *testing.T) {
+	td := t.TempDir()
+	testCopyDir(t, testFixturePath("providers-lock/append"), td)
+	t.Chdir(td)
+
+	// The fixture's filesystem mirror doesn't contain a package for any real
+	// platform, so any attempt to fetch a package from it will fail.
+	provider := addrs.NewDefaultProvider("test")
+	version := getproviders.MustParseVersion("1.0.0")
+	executable, err := os.ReadFile("fs-mirror/registry.opentofu.org/hashicorp/test/1.0.0/os_arch/terraform-provider-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The plugin cache contains a packed archive for one platform and an
+	// unpacked directory for another.
+	cacheDir := filepath.Join(td, "cache")
+	linux := getproviders.Platform{OS: "linux", Arch: "amd64"}
+	darwin := getproviders.Platform{OS: "darwin", Arch: "arm64"}
+	archivePath := getproviders.PackedFilePathForPackage(cacheDir, provider, version, linux)
+	if err := os.MkdirAll(filepath.Dir(archivePath), 0755); err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	fw, err := zw.Create("terraform-provider-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := fw.Write(executable); err != nil {
+		t.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(archivePath, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+	unpackedDir := getproviders.UnpackedDirectoryPathForPackage(cacheDir, provider, version, darwin)
+	if err := os.MkdirAll(unpackedDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(unpackedDir, "terraform-provider-test"), executable, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	var wantHashes []getproviders.Hash
+	for _, loc := range []getproviders.PackageLocation{getproviders.PackageLocalArchive(archivePath), getproviders.PackageLocalDir(unpackedDir)} {
+		hash, err := getproviders.PackageHashV1(loc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		wantHashes = append(wantHashes, hash)
+	}
+	zipHash, err := getproviders.PackageHashLegacyZipSHA(getproviders.PackageLocalArchive(archivePath))
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantHashes = append(wantHashes, zipHash, "h1:invalid")
+
+	// Cached packages are only used if their h1: checksum is already locked.
+	// Both packages have the same contents and so the same h1: checksum.
+	lockSrc := fmt.Sprintf(`provider "registry.opentofu.org/hashicorp/test" {
+  version = "1.0.0"
+  hashes = [
+    "h1:invalid",
+    %q,
+  ]
+}
+`, wantHashes[0])
+	if err := os.WriteFile(".terraform.lock.hcl", []byte(lockSrc), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	view, done := testView(t)
+	c := &ProvidersLockCommand{
+		Meta: Meta{
+			WorkingDir:     workdir.NewDir("."),
+			View:           view,
+			PluginCacheDir: cacheDir,
+		},
+	}
+	code := c.Run([]string{"-fs-mirror=fs-mirror", "-from-cache", "-platform=linux_amd64", "-platform=darwin_arm64"})
+	output := done(t)
+	if code != 0 {
+		t.Fatalf("wrong exit code; expected 0, got %d\n%s", code, output.All())
+	}
+	if got := output.Stdout(); !strings.Contains(got, "from the cached package at "+archivePath) || !strings.Contains(got, "from the cached package at "+unpackedDir) {
+		t.Fatalf("missing cached package messages in output:\n%s", got)
+	}
+
+	locks, diags := depsfile.LoadLocksFromFile(".terraform.lock.hcl")
+	if diags.HasErrors() {
+		t.Fatal(diags.Err())
+	}
+	lock := locks.Provider(provider)
+	if lock == nil {
+		t.Fatalf("no lock for %s", provider)
+	}
+	for _, want := range wantHashes {
+		if !slices.Contains(lock.AllHashes(), want) {
+			t.Errorf("missing hash %s in %v", want, lock.AllHashes())
+		}
+	}
+}
+
+func TestProvidersLock_fromCacheUnverified(t *testing.T) {
+	td := t.TempDir()
+	testCopyDir(t, testFixturePath("providers-lock/append"), td)
+	t.Chdir(td)
+
+	// The cached package doesn't match the checksum in the fixture's lock
+	// file, so it must be fetched instead, which fails because the fixture's
+	// filesystem mirror doesn't contain a package for any real platform.
+	provider := addrs.NewDefaultProvider("test")
+	version := getproviders.MustParseVersion("1.0.0")
+	cacheDir := filepath.Join(td, "cache")
+	unpackedDir := getproviders.UnpackedDirectoryPathForPackage(cacheDir, provider, version, getproviders.Platform{OS: "linux", Arch: "amd64"})
+	if err := os.MkdirAll(unpackedDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(unpackedDir, "terraform-provider-test"), []byte("tampered"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	view, done := testView(t)
+	c := &ProvidersLockCommand{
+		Meta: Meta{
+			WorkingDir:     workdir.NewDir("."),
+			View:           view,
+			PluginCacheDir: cacheDir,
+		},
+	}
+	code := c.Run([]string{"-fs-mirror=fs-mirror", "-from-cache", "-platform=linux_amd64"})
+	output := done(t)
+	if code == 0 {
+		t.Fatalf("unexpected success\n%s", output.All())
+	}
+	if got := output.Stdout(); strings.Contains(got, "from the cached package") {
+		t.Fatalf("unexpected use of the cached package:\n%s", got)
+	}
+
+	locks, diags := depsfile.LoadLocksFromFile(".terraform.lock.hcl")
+	if diags.HasErrors() {
+		t.Fatal(diags.Err())
+	}
+	if got, want := locks.Provider(provider).AllHashes(), []getproviders.Hash{"h1:invalid"}; !slices.Equal(got, want) {
+		t.Errorf("wrong hashes %v; want %v", got, want)
+	}
+}
+
+func TestProvidersLock_resolve(t *testing.T) {
+	td := t.TempDir()
+	testCopyDir(t, testFixturePath("providers-lock/basic"), td)
+	t.Chdir(td)
+
+	fixtMachineDir := filepath.Join(td, "fs-mirror/registry.opentofu.org/hashicorp/test/1.0.0/os_arch")
+	wantMachineDir := filepath.Join(td, "fs-mirror/registry.opentofu.org/hashicorp/test/1.0.0/", fmt.Sprintf("%s_%s", runtime.GOOS, runtime.GOARCH))
+	if err := os.Rename(fixtMachineDir, wantMachineDir); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Both sides of the conflict locked the same version with different
+	// checksums, and one side also locked a provider that the configuration
+	// no longer requires.
+	conflicted := `# This file is maintained automatically by "tofu init".
+# Manual edits may be lost in future updates.
+
+provider "registry.opentofu.org/hashicorp/test" {
+  version = "1.0.0"
+  hashes = [
+<<<<<<< HEAD
+    "h1:invalid-ours",
+=======
+    "h1:invalid-theirs",
+>>>>>>> feature
+  ]
+}
+<<<<<<< HEAD
+=======
+
+provider "registry.opentofu.org/hashicorp/unused" {
+  version = "2.0.0"
+}
+>>>>>>> feature
+`
+	if err := os.WriteFile(".terraform.lock.hcl", []byte(conflicted), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	p := testProvider()
+	view, done := testView(t)
+	c := &ProvidersLockCommand{
+		Meta: Meta{
+			WorkingDir:       workdir.NewDir("."),
+			View:             view,
+			testingOverrides: metaOverridesForProvider(p),
+		},
+	}
+	code := c.Run([]string{"-fs-mirror=fs-mirror", "-resolve"})
+	output := done(t)
+	if code != 0 {
+		t.Fatalf("wrong exit code; expected 0, got %d\n%s", code, output.All())
+	}
+	if got, want := output.Stdout(), "- Resolved the lock file conflict for hashicorp/test by selecting version 1.0.0"; !strings.Contains(got, want) {
+		t.Errorf("missing conflict resolution message in output:\n%s", got)
+	}
+
+	lockfile, err := os.ReadFile(".terraform.lock.hcl")
+	if err != nil {
+		t.Fatal("error reading lockfile")
+	}
+	want := `# This file is maintained automatically by "tofu init".
+# Manual edits may be lost in future updates.
+
+provider "registry.opentofu.org/hashicorp/test" {
+  version = "1.0.0"
+  hashes = [
+    "h1:7MjN4eFisdTv4tlhXH5hL4QQd39Jy4baPhFxwAd/EFE=",
+    "h1:invalid-ours",
+    "h1:invalid-theirs",
+  ]
+}
+`
+	if diff := cmp.Diff(want, string(lockfile)); diff != "" {
+		t.Errorf("wrong lockfile content\n%s", diff)
+	}
+}
+
 func runProviderLockGenericTest(t *testing.T, testDirectory, expected string) {
 	td := t.TempDir()
 	testCopyDir(t, testFixturePath(testDirectory), td)
@@ -0,0 +1,475 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/apparentlymart/go-versions/versions"
+	"github.com/mitchellh/cli"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/command/arguments"
+	"github.com/opentofu/opentofu/internal/command/views"
+	"github.com/opentofu/opentofu/internal/getproviders"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// providersMirrorServeShutdownTimeout is how long we wait for requests in
+// progress to complete when the user interrupts the server.
+const providersMirrorServeShutdownTimeout = 10 * time.Second
+
+// ProvidersMirrorServeCommand is a Command implementation that implements the
+// "tofu providers mirror-serve" command, which serves a directory populated
+// by "tofu providers mirror" using the provider network mirror protocol.
+type ProvidersMirrorServeCommand struct {
+	Meta
+}
+
+func (c *ProvidersMirrorServeCommand) Synopsis() string {
+	return "Serve a provider mirror directory as a network mirror"
+}
+
+func (c *ProvidersMirrorServeCommand) Run(rawArgs []string) int {
+	ctx := c.CommandContext()
+
+	common, rawArgs := arguments.ParseView(rawArgs)
+	c.View.Configure(common)
+
+	// Parse and validate flags
+	args, closer, diags := arguments.ParseProvidersMirrorServe(rawArgs)
+	defer closer()
+
+	// Instantiate the view, even if there are flag errors, so that we render
+	// diagnostics according to the desired view
+	view := views.NewProvidersMirrorServe(args.ViewOptions, c.View)
+	if diags.HasErrors() {
+		view.Diagnostics(diags)
+		return cli.RunResultHelp
+	}
+
+	if info, err := os.Stat(args.Directory); err != nil || !info.IsDir() {
+		if err == nil {
+			err = errors.New("not a directory")
+		}
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid mirror directory",
+			fmt.Sprintf("Cannot serve %s as a provider mirror: %s.", args.Directory, err),
+		))
+		view.Diagnostics(diags)
+		return 1
+	}
+
+	var token string
+	if args.TokenFile != "" {
+		raw, err := os.ReadFile(args.TokenFile)
+		if err == nil && strings.TrimSpace(string(raw)) == "" {
+			err = errors.New("the file is empty")
+		}
+		if err != nil {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Invalid token file",
+				fmt.Sprintf("Cannot read the token for clients of the mirror from %s: %s.", args.TokenFile, err),
+			))
+			view.Diagnostics(diags)
+			return 1
+		}
+		token = strings.TrimSpace(string(raw))
+	}
+
+	var tlsConfig *tls.Config
+	if args.TLSCertFile != "" {
+		cert, err := tls.LoadX509KeyPair(args.TLSCertFile, args.TLSKeyFile)
+		if err != nil {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Invalid TLS certificate",
+				fmt.Sprintf("Cannot load the TLS certificate and key: %s.", err),
+			))
+			view.Diagnostics(diags)
+			return 1
+		}
+		tlsConfig = &tls.Config{
+			Certificates: []tls.Certificate{cert},
+			MinVersion:   tls.VersionTLS12,
+		}
+	}
+
+	listener, err := net.Listen("tcp", args.Address)
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to start the provider mirror server",
+			fmt.Sprintf("Cannot listen on %s: %s.", args.Address, err),
+		))
+		view.Diagnostics(diags)
+		return 1
+	}
+	scheme := "http"
+	if tlsConfig != nil {
+		listener = tls.NewListener(listener, tlsConfig)
+		scheme = "https"
+	}
+
+	server := &http.Server{
+		Handler:           newProviderMirrorHandler(args.Directory, token),
+		ReadHeaderTimeout: 30 * time.Second,
+	}
+	served := make(chan error, 1)
+	go func() {
+		served <- server.Serve(listener)
+	}()
+	view.Serving(args.Directory, providersMirrorServeURL(scheme, args.Address, listener.Addr()))
+
+	// The server runs until the user interrupts it.
+	ctx, done := c.InterruptibleContext(ctx)
+	defer done()
+	select {
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), providersMirrorServeShutdownTimeout)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			log.Printf("[WARN] providers mirror-serve: failed to shut down cleanly: %s", err)
+		}
+	case err := <-served:
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Provider mirror server failed",
+			fmt.Sprintf("The server stopped unexpectedly: %s.", err),
+		))
+		view.Diagnostics(diags)
+		return 1
+	}
+
+	view.Stopped()
+	return 0
+}
+
+// providersMirrorServeURL returns the base URL for clients of a mirror
+// listening on the given address, which was requested as the given address
+// string. We prefer the requested hostname, since that is what a TLS
+// certificate is most likely to be issued for, but use the actual port in
+// case the request was for any free port.
+func providersMirrorServeURL(scheme string, requested string, actual net.Addr) string {
+	host, _, err := net.SplitHostPort(requested)
+	if err != nil || host == "" || host == "0.0.0.0" || host == "::" {
+		host = "localhost"
+	}
+	_, port, err := net.SplitHostPort(actual.String())
+	if err != nil {
+		return fmt.Sprintf("%s://%s/", scheme, actual)
+	}
+	return fmt.Sprintf("%s://%s/", scheme, net.JoinHostPort(host, port))
+}
+
+func (c *ProvidersMirrorServeCommand) Help() string {
+	return `
+Usage: tofu [global options] providers mirror-serve [options] <mirror-dir>
+
+  Serves a directory populated by "tofu providers mirror" over the provider
+  network mirror protocol, so that other systems can install providers from
+  it by configuring it as a network_mirror in their CLI configuration.
+
+  The JSON index files in the directory are served as-is. For providers
+  without index files, such as in a filesystem mirror populated by other
+  means, the server generates the indexes from the packages in the
+  directory.
+
+  OpenTofu only installs providers from network mirrors over HTTPS, so
+  either use the -tls-cert and -tls-key options or serve the mirror through
+  a reverse proxy that provides HTTPS.
+
+  The server runs until interrupted.
+
+Options:
+
+  -address=host:port  The address to listen on. Defaults to localhost:8443,
+                      which accepts connections only from this system. Use
+                      ":8443" to accept connections on any network
+                      interface.
+
+  -tls-cert=file      Serve HTTPS using the PEM-encoded certificate in the
+                      given file. Requires -tls-key.
+
+  -tls-key=file       The PEM-encoded private key for the certificate given
+                      in -tls-cert.
+
+  -token-file=file    Require clients to authenticate with the token in the
+                      given file, which they can configure as credentials
+                      for the mirror's hostname in their CLI configuration.
+
+  -json               Produce output in a machine-readable JSON format,
+                      suitable for use in text editor integrations and other
+                      automated systems. Always disables color.
+
+  -no-color           If specified, output won't contain any color.
+`
+}
+
+// providerMirrorHandler is an http.Handler that serves a provider mirror
+// directory in the packed layout created by "tofu providers mirror", using the
+// provider network mirror protocol.
+//
+// The JSON index files in the directory are served as-is when present, and
+// are otherwise generated from the package archives in the directory. Only
+// the index files and package archives are served, so other files in the
+// directory are not exposed.
+type providerMirrorHandler struct {
+	dir   string
+	token string
+
+	// hashes caches the hashes of package archives for generated indexes,
+	// since calculating them requires reading the whole archive.
+	mu     sync.Mutex
+	hashes map[string]providerMirrorArchiveHash
+}
+
+type providerMirrorArchiveHash struct {
+	size    int64
+	modTime time.Time
+	hash    getproviders.Hash
+}
+
+func newProviderMirrorHandler(dir string, token string) *providerMirrorHandler {
+	return &providerMirrorHandler{
+		dir:    dir,
+		token:  token,
+		hashes: make(map[string]providerMirrorArchiveHash),
+	}
+}
+
+func (h *providerMirrorHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	log.Printf("[TRACE] providers mirror-serve: %s %s", r.Method, r.URL.Path)
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !h.authorized(r) {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="OpenTofu provider mirror"`)
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// All of the paths in the protocol are of the form
+	// hostname/namespace/type/filename.
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	if len(parts) != 4 {
+		http.NotFound(w, r)
+		return
+	}
+	provider, diags := addrs.ParseProviderSourceString(strings.Join(parts[:3], "/"))
+	if diags.HasErrors() {
+		http.NotFound(w, r)
+		return
+	}
+	filename := parts[3]
+
+	if filename == "index.json" {
+		h.serveVersions(w, r, provider)
+		return
+	}
+	if versionStr, ok := strings.CutSuffix(filename, ".json"); ok {
+		version, err := getproviders.ParseVersion(versionStr)
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+		h.serveArchives(w, r, provider, version)
+		return
+	}
+	h.serveArchive(w, r, provider, filename)
+}
+
+func (h *providerMirrorHandler) authorized(r *http.Request) bool {
+	if h.token == "" {
+		return true
+	}
+	given, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	return ok && subtle.ConstantTimeCompare([]byte(given), []byte(h.token)) == 1
+}
+
+// serveVersions serves the index.json document listing the available
+// versions of the given provider.
+func (h *providerMirrorHandler) serveVersions(w http.ResponseWriter, r *http.Request, provider addrs.Provider) {
+	if h.serveIndexFile(w, r, provider, "index.json") {
+		return
+	}
+
+	archives := h.archives(provider)
+	if len(archives) == 0 {
+		http.NotFound(w, r)
+		return
+	}
+	versions := make(map[string]struct{})
+	for _, meta := range archives {
+		versions[meta.Version.String()] = struct{}{}
+	}
+	h.serveJSON(w, r, map[string]any{"versions": versions})
+}
+
+// serveArchives serves the version.json document listing the package
+// archives of the given provider version for each platform.
+func (h *providerMirrorHandler) serveArchives(w http.ResponseWriter, r *http.Request, provider addrs.Provider, version getproviders.Version) {
+	if h.serveIndexFile(w, r, provider, version.String()+".json") {
+		return
+	}
+
+	type archive struct {
+		URL    string   `json:"url"`
+		Hashes []string `json:"hashes"`
+	}
+	archives := make(map[string]archive)
+	for _, meta := range h.archives(provider) {
+		if meta.Version != version {
+			continue
+		}
+		hash, err := h.archiveHash(meta)
+		if err != nil {
+			log.Printf("[ERROR] providers mirror-serve: failed to hash %s: %s", meta.Location, err)
+			http.Error(w, "Failed to read the provider package", http.StatusInternalServerError)
+			return
+		}
+		archives[meta.TargetPlatform.String()] = archive{
+			URL:    filepath.Base(string(meta.Location.(getproviders.PackageLocalArchive))),
+			Hashes: []string{hash.String()},
+		}
+	}
+	if len(archives) == 0 {
+		http.NotFound(w, r)
+		return
+	}
+	h.serveJSON(w, r, map[string]any{"archives": archives})
+}
+
+// serveArchive serves the package archive with the given filename, if it is
+// one of the package archives of the given provider.
+func (h *providerMirrorHandler) serveArchive(w http.ResponseWriter, r *http.Request, provider addrs.Provider, filename string) {
+	for _, meta := range h.archives(provider) {
+		path := string(meta.Location.(getproviders.PackageLocalArchive))
+		if filepath.Base(path) == filename {
+			h.serveFile(w, r, path)
+			return
+		}
+	}
+	http.NotFound(w, r)
+}
+
+// serveIndexFile serves the index file with the given name for the given
+// provider from the mirror directory, returning false if there is no such
+// file.
+func (h *providerMirrorHandler) serveIndexFile(w http.ResponseWriter, r *http.Request, provider addrs.Provider, filename string) bool {
+	// The index files live in the same directory as the package archives, so
+	// we use the path of a fictitious archive to find it, as "tofu providers
+	// mirror" does.
+	dir := filepath.Dir(getproviders.PackedFilePathForPackage(h.dir, provider, versions.Unspecified, getproviders.CurrentPlatform))
+	path := filepath.Join(dir, filename)
+	if info, err := os.Stat(path); err != nil || !info.Mode().IsRegular() {
+		return false
+	}
+	h.serveFile(w, r, path)
+	return true
+}
+
+func (h *providerMirrorHandler) serveFile(w http.ResponseWriter, r *http.Request, path string) {
+	f, err := os.Open(path)
+	if err != nil {
+		log.Printf("[ERROR] providers mirror-serve: failed to open %s: %s", path, err)
+		http.Error(w, "Failed to read the file", http.StatusInternalServerError)
+		return
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		log.Printf("[ERROR] providers mirror-serve: failed to stat %s: %s", path, err)
+		http.Error(w, "Failed to read the file", http.StatusInternalServerError)
+		return
+	}
+	http.ServeContent(w, r, filepath.Base(path), info.ModTime(), f)
+}
+
+func (h *providerMirrorHandler) serveJSON(w http.ResponseWriter, r *http.Request, body any) {
+	src, err := json.MarshalIndent(body, "", "  ")
+	if err != nil {
+		// Should never happen because the input here is entirely under
+		// our control.
+		panic(fmt.Sprintf("failed to encode index: %s", err))
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if r.Method == http.MethodHead {
+		return
+	}
+	_, _ = w.Write(src)
+}
+
+// archives returns the package archives of the given provider in the mirror
+// directory.
+func (h *providerMirrorHandler) archives(provider addrs.Provider) getproviders.PackageMetaList {
+	// We scan the directory for each request, rather than only when starting,
+	// so that the mirror can be updated with "tofu providers mirror" without
+	// restarting the server.
+	available, err := getproviders.SearchLocalDirectory(h.dir)
+	if err != nil {
+		log.Printf("[ERROR] providers mirror-serve: failed to scan %s: %s", h.dir, err)
+		return nil
+	}
+	var ret getproviders.PackageMetaList
+	for _, meta := range available[provider] {
+		// Only archives can be served over the network mirror protocol, so
+		// we ignore any unpacked packages.
+		if _, ok := meta.Location.(getproviders.PackageLocalArchive); ok {
+			ret = append(ret, meta)
+		}
+	}
+	slices.SortFunc(ret, func(a, b getproviders.PackageMeta) int {
+		return strings.Compare(string(a.Location.(getproviders.PackageLocalArchive)), string(b.Location.(getproviders.PackageLocalArchive)))
+	})
+	return ret
+}
+
+func (h *providerMirrorHandler) archiveHash(meta getproviders.PackageMeta) (getproviders.Hash, error) {
+	path := string(meta.Location.(getproviders.PackageLocalArchive))
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", err
+	}
+
+	h.mu.Lock()
+	cached, ok := h.hashes[path]
+	h.mu.Unlock()
+	if ok && cached.size == info.Size() && cached.modTime.Equal(info.ModTime()) {
+		return cached.hash, nil
+	}
+
+	hash, err := meta.Hash()
+	if err != nil {
+		return "", err
+	}
+	h.mu.Lock()
+	h.hashes[path] = providerMirrorArchiveHash{
+		size:    info.Size(),
+		modTime: info.ModTime(),
+		hash:    hash,
+	}
+	h.mu.Unlock()
+	return hash, nil
+}
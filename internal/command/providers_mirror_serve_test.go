@@ -0,0 +1,226 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/mitchellh/cli"
+
+	"github.com/opentofu/opentofu/internal/command/workdir"
+	"github.com/opentofu/opentofu/internal/getproviders"
+)
+
+func TestProvidersMirrorServe(t *testing.T) {
+	t.Run("serves until interrupted", func(t *testing.T) {
+		view, done := testView(t)
+		shutdownCh := make(chan struct{}, 1)
+		shutdownCh <- struct{}{}
+		c := &ProvidersMirrorServeCommand{
+			Meta: Meta{
+				WorkingDir: workdir.NewDir("."),
+				View:       view,
+				ShutdownCh: shutdownCh,
+			},
+		}
+		code := c.Run([]string{"-address=127.0.0.1:0", t.TempDir()})
+		output := done(t)
+		if code != 0 {
+			t.Fatalf("wrong exit code. expected 0, got %d\ngot output:\n%s", code, output.All())
+		}
+		got := output.Stdout()
+		if !strings.Contains(got, "http://127.0.0.1:") || !strings.Contains(got, "Stopped serving the provider mirror.") {
+			t.Fatalf("unexpected output:\n%s", got)
+		}
+	})
+
+	t.Run("missing directory", func(t *testing.T) {
+		view, done := testView(t)
+		c := &ProvidersMirrorServeCommand{
+			Meta: Meta{
+				WorkingDir: workdir.NewDir("."),
+				View:       view,
+			},
+		}
+		code := c.Run([]string{"-no-color", filepath.Join(t.TempDir(), "nonexist")})
+		output := done(t)
+		if code != 1 {
+			t.Fatalf("wrong exit code. expected 1, got %d", code)
+		}
+		got := output.Stderr()
+		if !strings.Contains(got, "Error: Invalid mirror directory") {
+			t.Fatalf("missing directory error from output, got:\n%s\n", got)
+		}
+	})
+
+	t.Run("missing arg error", func(t *testing.T) {
+		view, done := testView(t)
+		c := &ProvidersMirrorServeCommand{
+			Meta: Meta{
+				WorkingDir: workdir.NewDir("."),
+				View:       view,
+			},
+		}
+		code := c.Run([]string{"-no-color"})
+		output := done(t)
+		if code != cli.RunResultHelp {
+			t.Fatalf("wrong exit code. expected %d, got %d", cli.RunResultHelp, code)
+		}
+		got := output.Stderr()
+		if !strings.Contains(got, "Error: Wrong number of arguments") {
+			t.Fatalf("missing arguments error from output, got:\n%s\n", got)
+		}
+	})
+}
+
+func TestProviderMirrorHandler(t *testing.T) {
+	dir := t.TempDir()
+	providerDir := filepath.Join(dir, "registry.opentofu.org", "hashicorp", "null")
+	archivePath := filepath.Join(providerDir, "terraform-provider-null_2.1.0_linux_amd64.zip")
+	writeTestProviderArchive(t, archivePath)
+	// Files other than indexes and package archives must never be served.
+	if err := os.WriteFile(filepath.Join(providerDir, "secret.txt"), []byte("secret"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	hash, err := getproviders.PackageHashV1(getproviders.PackageLocalArchive(archivePath))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	handler := newProviderMirrorHandler(dir, "s3cr3t")
+	get := func(t *testing.T, method, path, token string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := httptest.NewRequest(method, path, nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, req)
+		return resp
+	}
+
+	t.Run("generated versions index", func(t *testing.T) {
+		resp := get(t, http.MethodGet, "/registry.opentofu.org/hashicorp/null/index.json", "s3cr3t")
+		if resp.Code != http.StatusOK {
+			t.Fatalf("wrong status %d: %s", resp.Code, resp.Body)
+		}
+		var got map[string]any
+		if err := json.Unmarshal(resp.Body.Bytes(), &got); err != nil {
+			t.Fatal(err)
+		}
+		want := map[string]any{
+			"versions": map[string]any{"2.1.0": map[string]any{}},
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Fatalf("wrong index\n%s", diff)
+		}
+	})
+
+	t.Run("generated version index", func(t *testing.T) {
+		resp := get(t, http.MethodGet, "/registry.opentofu.org/hashicorp/null/2.1.0.json", "s3cr3t")
+		if resp.Code != http.StatusOK {
+			t.Fatalf("wrong status %d: %s", resp.Code, resp.Body)
+		}
+		var got map[string]any
+		if err := json.Unmarshal(resp.Body.Bytes(), &got); err != nil {
+			t.Fatal(err)
+		}
+		want := map[string]any{
+			"archives": map[string]any{
+				"linux_amd64": map[string]any{
+					"url":    "terraform-provider-null_2.1.0_linux_amd64.zip",
+					"hashes": []any{hash.String()},
+				},
+			},
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Fatalf("wrong index\n%s", diff)
+		}
+	})
+
+	t.Run("index file from directory", func(t *testing.T) {
+		indexPath := filepath.Join(providerDir, "index.json")
+		if err := os.WriteFile(indexPath, []byte(`{"versions":{"9.9.9":{}}}`), 0644); err != nil {
+			t.Fatal(err)
+		}
+		defer os.Remove(indexPath)
+
+		resp := get(t, http.MethodGet, "/registry.opentofu.org/hashicorp/null/index.json", "s3cr3t")
+		if resp.Code != http.StatusOK {
+			t.Fatalf("wrong status %d: %s", resp.Code, resp.Body)
+		}
+		if got, want := resp.Body.String(), `{"versions":{"9.9.9":{}}}`; got != want {
+			t.Fatalf("wrong index\ngot:  %s\nwant: %s", got, want)
+		}
+	})
+
+	t.Run("archive", func(t *testing.T) {
+		resp := get(t, http.MethodGet, "/registry.opentofu.org/hashicorp/null/terraform-provider-null_2.1.0_linux_amd64.zip", "s3cr3t")
+		if resp.Code != http.StatusOK {
+			t.Fatalf("wrong status %d: %s", resp.Code, resp.Body)
+		}
+		want, err := os.ReadFile(archivePath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.Body.String() != string(want) {
+			t.Fatal("wrong archive content")
+		}
+	})
+
+	for name, tc := range map[string]struct {
+		method string
+		path   string
+		token  string
+		want   int
+	}{
+		"no token":          {http.MethodGet, "/registry.opentofu.org/hashicorp/null/index.json", "", http.StatusUnauthorized},
+		"wrong token":       {http.MethodGet, "/registry.opentofu.org/hashicorp/null/index.json", "wrong", http.StatusUnauthorized},
+		"wrong method":      {http.MethodPost, "/registry.opentofu.org/hashicorp/null/index.json", "s3cr3t", http.StatusMethodNotAllowed},
+		"unknown provider":  {http.MethodGet, "/registry.opentofu.org/hashicorp/random/index.json", "s3cr3t", http.StatusNotFound},
+		"unknown version":   {http.MethodGet, "/registry.opentofu.org/hashicorp/null/1.0.0.json", "s3cr3t", http.StatusNotFound},
+		"other file":        {http.MethodGet, "/registry.opentofu.org/hashicorp/null/secret.txt", "s3cr3t", http.StatusNotFound},
+		"path out of scope": {http.MethodGet, "/registry.opentofu.org/hashicorp/../../secret.txt", "s3cr3t", http.StatusNotFound},
+	} {
+		t.Run(name, func(t *testing.T) {
+			resp := get(t, tc.method, tc.path, tc.token)
+			if resp.Code != tc.want {
+				t.Fatalf("wrong status\ngot:  %d\nwant: %d", resp.Code, tc.want)
+			}
+		})
+	}
+}
+
+func writeTestProviderArchive(t *testing.T, path string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	zw := zip.NewWriter(f)
+	w, err := zw.Create("terraform-provider-null_v2.1.0_x4")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write([]byte("not a real provider")); err != nil {
+		t.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
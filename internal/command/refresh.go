@@ -45,6 +45,11 @@ func (c *RefreshCommand) Run(rawArgs []string) int {
 		return 1
 	}
 
+	if diags := c.Meta.checkReadOnly("refresh"); diags.HasErrors() {
+		view.Diagnostics(diags)
+		return 1
+	}
+
 	// Check for user-supplied plugin path
 	var err error
 	if c.pluginPath, err = c.loadPluginPath(); err != nil {
@@ -65,6 +70,8 @@ func (c *RefreshCommand) Run(rawArgs []string) int {
 	// clear path to pass this value down, so we continue to mutate the Meta
 	// object state for now.
 	c.Meta.parallelism = args.Operation.Parallelism
+	c.Meta.readParallelism = args.Operation.ReadParallelism
+	c.Meta.writeParallelism = args.Operation.WriteParallelism
 
 	// Inject variables from args into meta for static evaluation
 	c.Meta.variableArgs = args.Vars.All()
@@ -205,6 +212,10 @@ Options:
 
   -parallelism=n         Limit the number of concurrent operations. Defaults to 10.
 
+  -read-parallelism=n    Limit the number of concurrent operations that only
+                         read remote objects to less than -parallelism, which
+                         still limits the total.
+
   -target=resource       Resource to target. Operation will be limited to this
                          resource and its dependencies. This flag can be used
                          multiple times.  Cannot be used alongside the -exclude
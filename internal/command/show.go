@@ -87,6 +87,18 @@ func (c *ShowCommand) Run(rawArgs []string) int {
 	// Set up view
 	view := views.NewShow(args.ViewOptions, c.View)
 
+	// Load the attributes whose changes are hidden in the human-readable
+	// plan, which don't affect any other output.
+	if args.ViewOptions.ViewType == arguments.ViewHuman {
+		noise, noiseDiags := c.loadNoiseRules()
+		diags = diags.Append(noiseDiags)
+		if noiseDiags.HasErrors() {
+			view.Diagnostics(diags)
+			return 1
+		}
+		c.View.SetNoiseRules(noise)
+	}
+
 	// Check for user-supplied plugin path
 	var err error
 	if c.pluginPath, err = c.loadPluginPath(); err != nil {
@@ -106,7 +118,13 @@ func (c *ShowCommand) Run(rawArgs []string) int {
 		return 1
 	}
 
-	renderResult, showDiags := c.show(ctx, args.TargetType, args.TargetArg, enc)
+	var renderResult showRenderFunc
+	var showDiags tfdiags.Diagnostics
+	if args.Graph {
+		renderResult, showDiags = c.showPlanGraph(ctx, args.TargetArg, enc)
+	} else {
+		renderResult, showDiags = c.show(ctx, args.TargetType, args.TargetArg, args.Serial, enc)
+	}
 	diags = diags.Append(showDiags)
 	if showDiags.HasErrors() {
 		// "tofu show" intentionally ignores warnings unless there is at
@@ -139,6 +157,10 @@ Target selection options:
 
 Other options:
 
+  -graph              Show a graph, in the DOT language, of the resource
+                      instances that the saved plan changes and of the
+                      order in which their changes must be applied.
+
   -no-color           Disable terminal escape sequences.
 
   -json               Show the information in a machine-readable form.
@@ -148,6 +170,10 @@ Other options:
                       the original human-readable output streams, while
                       capturing more detailed logs for machine analysis.
 
+  -serial=N           Show the previous state snapshot with the given serial
+                      instead of the latest one. This requires a backend
+                      that keeps the previous state snapshots.
+
   -show-sensitive     If specified, sensitive values will be displayed.
 
   -var 'foo=bar'      Set a value for one of the input variables in the root
@@ -169,10 +195,10 @@ func (c *ShowCommand) Synopsis() string {
 
 type showRenderFunc func(view views.Show) int
 
-func (c *ShowCommand) show(ctx context.Context, targetType arguments.ShowTargetType, targetArg string, enc encryption.Encryption) (showRenderFunc, tfdiags.Diagnostics) {
+func (c *ShowCommand) show(ctx context.Context, targetType arguments.ShowTargetType, targetArg string, serial *uint64, enc encryption.Encryption) (showRenderFunc, tfdiags.Diagnostics) {
 	switch targetType {
 	case arguments.ShowState:
-		return c.showFromLatestStateSnapshot(ctx, enc)
+		return c.showFromLatestStateSnapshot(ctx, serial, enc)
 	case arguments.ShowPlan:
 		return c.showFromSavedPlanFile(ctx, targetArg, enc)
 	case arguments.ShowConfig:
@@ -191,7 +217,9 @@ func (c *ShowCommand) show(ctx context.Context, targetType arguments.ShowTargetT
 	}
 }
 
-func (c *ShowCommand) showFromLatestStateSnapshot(ctx context.Context, enc encryption.Encryption) (showRenderFunc, tfdiags.Diagnostics) {
+// showFromLatestStateSnapshot shows the latest state snapshot of the current
+// workspace, or the previous snapshot with the given serial if it is set.
+func (c *ShowCommand) showFromLatestStateSnapshot(ctx context.Context, serial *uint64, enc encryption.Encryption) (showRenderFunc, tfdiags.Diagnostics) {
 	var diags tfdiags.Diagnostics
 
 	ctx, span := tracing.Tracer().Start(ctx, "Show State")
@@ -212,11 +240,28 @@ func (c *ShowCommand) showFromLatestStateSnapshot(ctx context.Context, enc encry
 		return nil, diags
 	}
 
-	// Get the latest state snapshot from the backend for the current workspace
-	stateFile, stateErr := getStateFromBackend(ctx, b, workspace)
-	if stateErr != nil {
-		diags = diags.Append(stateErr)
-		return nil, diags
+	var stateFile *statefile.File
+	if serial != nil {
+		// Get the requested previous state snapshot from the backend
+		stateMgr, err := b.StateMgr(ctx, workspace)
+		if err != nil {
+			diags = diags.Append(fmt.Errorf("failed to load state manager: %w", err))
+			return nil, diags
+		}
+		var snapshotDiags tfdiags.Diagnostics
+		stateFile, snapshotDiags = stateSnapshotBySerial(ctx, stateMgr, *serial)
+		diags = diags.Append(snapshotDiags)
+		if snapshotDiags.HasErrors() {
+			return nil, diags
+		}
+	} else {
+		// Get the latest state snapshot from the backend for the current workspace
+		var stateErr error
+		stateFile, stateErr = getStateFromBackend(ctx, b, workspace)
+		if stateErr != nil {
+			diags = diags.Append(stateErr)
+			return nil, diags
+		}
 	}
 
 	schemas, schemaDiags := c.maybeGetSchemas(ctx, stateFile, nil)
@@ -258,6 +303,43 @@ func (c *ShowCommand) showFromSavedPlanFile(ctx context.Context, filename string
 	}, diags
 }
 
+func (c *ShowCommand) showPlanGraph(ctx context.Context, filename string, enc encryption.Encryption) (showRenderFunc, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	ctx, span := tracing.Tracer().Start(ctx, "Show Plan Graph")
+	defer span.End()
+
+	rootCall, callDiags := c.rootModuleCall(ctx, ".")
+	diags = diags.Append(callDiags)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	plan, _, _, _, err := c.getPlanFromPath(ctx, filename, enc, rootCall)
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Couldn't show plan graph",
+			fmt.Sprintf("Plan read error: %s", err),
+		))
+		return nil, diags
+	}
+	if plan == nil {
+		// A saved cloud plan doesn't include the prior state that the
+		// graph is derived from.
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Couldn't show plan graph",
+			"The -graph option supports only plan files created by a local operation.",
+		))
+		return nil, diags
+	}
+
+	return func(view views.Show) int {
+		return view.DisplayPlanGraph(plan)
+	}, diags
+}
+
 func (c *ShowCommand) legacyShowFromPath(ctx context.Context, path string, enc encryption.Encryption) (showRenderFunc, tfdiags.Diagnostics) {
 	var diags tfdiags.Diagnostics
 	var planErr, stateErr error
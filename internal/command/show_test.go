@@ -10,6 +10,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"syscall"
 	"testing"
@@ -20,6 +21,7 @@ import (
 	"github.com/opentofu/opentofu/internal/command/workdir"
 
 	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/command/jsonplan"
 	"github.com/opentofu/opentofu/internal/configs/configschema"
 	"github.com/opentofu/opentofu/internal/plans"
 	"github.com/opentofu/opentofu/internal/providers"
@@ -106,6 +108,35 @@ func TestShow_noArgsWithState(t *testing.T) {
 	}
 }
 
+func TestShow_stateSerialUnsupported(t *testing.T) {
+	// Get a temp cwd
+	testCwdTemp(t)
+	// Create the default state, which keeps no previous snapshots
+	testStateFileDefault(t, testState())
+
+	view, done := testView(t)
+	c := &ShowCommand{
+		Meta: Meta{
+			WorkingDir:       workdir.NewDir("."),
+			testingOverrides: metaOverridesForProvider(showFixtureProvider()),
+			View:             view,
+		},
+	}
+
+	code := c.Run([]string{"-state", "-serial=1"})
+	output := done(t)
+
+	if code != 1 {
+		t.Fatalf("unexpected exit status %d; want 1\ngot: %s", code, output.Stdout())
+	}
+
+	got := output.Stderr()
+	want := `Previous state snapshots not available`
+	if !strings.Contains(got, want) {
+		t.Fatalf("unexpected error\ngot: %s\nwant: %s", got, want)
+	}
+}
+
 func TestShow_argsWithState(t *testing.T) {
 	// Create the default state
 	statePath := testStateFile(t, testState())
@@ -385,6 +416,39 @@ func TestShow_planWithChanges(t *testing.T) {
 	}
 }
 
+func TestShow_planGraph(t *testing.T) {
+	planPathWithChanges := showFixturePlanFile(t, plans.DeleteThenCreate)
+	tests := map[string][]string{
+		"modern": {"-graph", "-plan=" + planPathWithChanges},
+		"legacy": {"-graph", planPathWithChanges},
+	}
+	for name, args := range tests {
+		t.Run(name, func(t *testing.T) {
+			view, done := testView(t)
+			c := &ShowCommand{
+				Meta: Meta{
+					WorkingDir:       workdir.NewDir("."),
+					testingOverrides: metaOverridesForProvider(showFixtureProvider()),
+					View:             view,
+				},
+			}
+
+			code := c.Run(args)
+			output := done(t)
+
+			if code != 0 {
+				t.Fatalf("unexpected exit status %d; want 0\ngot: %s", code, output.Stderr())
+			}
+
+			got := output.Stdout()
+			want := `"[root] test_instance.foo" [label = "test_instance.foo (replace)", shape = "box"]`
+			if !strings.HasPrefix(got, "digraph {") || !strings.Contains(got, want) {
+				t.Fatalf("unexpected output\ngot: %s\nwant: %s", got, want)
+			}
+		})
+	}
+}
+
 func TestShow_planWithForceReplaceChange(t *testing.T) {
 	// The main goal of this test is to see that the "replace by request"
 	// resource instance action reason can round-trip through a plan file and
@@ -691,6 +755,90 @@ func TestShow_json_output(t *testing.T) {
 	}
 }
 
+func TestShow_json_outputRunEnvironment(t *testing.T) {
+	td := t.TempDir()
+	testCopyDir(t, filepath.Join("testdata", "show-json", "basic-create"), td)
+	t.Chdir(td)
+	t.Setenv("TF_VAR_secret", "hunter2")
+
+	providerSource, close := newMockProviderSource(t, map[string][]string{
+		"test": {"1.2.3"},
+	})
+	defer close()
+
+	p := showFixtureProvider()
+
+	view, done := testView(t)
+	ic := &InitCommand{
+		Meta: Meta{
+			WorkingDir:       workdir.NewDir("."),
+			testingOverrides: metaOverridesForProvider(p),
+			View:             view,
+			ProviderSource:   providerSource,
+		},
+	}
+	if code := ic.Run([]string{}); code != 0 {
+		t.Fatalf("init failed\n%s", done(t).Stderr())
+	}
+
+	planView, planDone := testView(t)
+	pc := &PlanCommand{
+		Meta: Meta{
+			WorkingDir:       workdir.NewDir("."),
+			testingOverrides: metaOverridesForProvider(p),
+			View:             planView,
+			ProviderSource:   providerSource,
+		},
+	}
+	if code := pc.Run([]string{"-out=tofu.plan"}); code != 0 {
+		t.Fatalf("plan failed\n%s", planDone(t).Stderr())
+	}
+
+	showView, showDone := testView(t)
+	sc := &ShowCommand{
+		Meta: Meta{
+			WorkingDir:       workdir.NewDir("."),
+			testingOverrides: metaOverridesForProvider(p),
+			View:             showView,
+			ProviderSource:   providerSource,
+		},
+	}
+	code := sc.Run([]string{"-json", "tofu.plan"})
+	showOutput := showDone(t)
+	if code != 0 {
+		t.Fatalf("show failed\n%s", showOutput.Stderr())
+	}
+
+	var got struct {
+		RunEnvironment *jsonplan.RunEnvironment `json:"run_environment"`
+	}
+	if err := json.Unmarshal([]byte(showOutput.Stdout()), &got); err != nil {
+		t.Fatal(err)
+	}
+	env := got.RunEnvironment
+	if env == nil {
+		t.Fatalf("plan has no run environment\n%s", showOutput.Stdout())
+	}
+	if got, want := env.OpenTofuVersion, version.String(); got != want {
+		t.Errorf("wrong version %q; want %q", got, want)
+	}
+	if len(env.Providers) != 1 || env.Providers[0].Provider != "registry.opentofu.org/hashicorp/test" || env.Providers[0].Version != "1.2.3" {
+		t.Errorf("wrong providers %#v", env.Providers)
+	}
+	if len(env.Modules) != 1 || env.Modules[0].Key != "" {
+		t.Errorf("wrong modules %#v", env.Modules)
+	}
+	if !slices.Contains(env.EnvironmentVariables, "TF_VAR_secret") {
+		t.Errorf("environment variables %q don't include TF_VAR_secret", env.EnvironmentVariables)
+	}
+	if strings.Contains(showOutput.Stdout(), "hunter2") {
+		t.Errorf("run environment includes the value of an environment variable")
+	}
+	if got, want := env.Backend.Type, "local"; got != want {
+		t.Errorf("wrong backend type %q; want %q", got, want)
+	}
+}
+
 func TestShow_json_output_identity(t *testing.T) {
 	fixtureDir := "testdata/show-json-identity"
 	testDirs, err := os.ReadDir(fixtureDir)
@@ -0,0 +1,245 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"context"
+	"maps"
+	"strings"
+
+	"github.com/mitchellh/cli"
+	"github.com/opentofu/opentofu/internal/command/arguments"
+	"github.com/opentofu/opentofu/internal/command/clistate"
+	"github.com/opentofu/opentofu/internal/command/views"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+	"github.com/opentofu/opentofu/internal/tofu"
+)
+
+// StateAnnotateCommand is a Command implementation that sets or removes
+// annotations of resource instances in the state.
+type StateAnnotateCommand struct {
+	StateMeta
+}
+
+func (c *StateAnnotateCommand) Run(rawArgs []string) int {
+	ctx := c.CommandContext()
+
+	common, rawArgs := arguments.ParseView(rawArgs)
+	c.View.Configure(common)
+
+	// Parse and validate flags
+	args, closer, diags := arguments.ParseStateAnnotate(rawArgs)
+	defer closer()
+
+	// Instantiate the view, even if there are flag errors, so that we render
+	// diagnostics according to the desired view
+	view := views.NewState(args.ViewOptions, c.View)
+	if diags.HasErrors() {
+		view.Diagnostics(diags)
+		if args.ViewOptions.ViewType == arguments.ViewJSON {
+			return 1 // We don't want to print the help of the command in JSON view
+		}
+		return cli.RunResultHelp
+	}
+
+	if diags := c.Meta.checkReadOnly("state annotate"); diags.HasErrors() {
+		view.Diagnostics(diags)
+		return 1
+	}
+
+	// TODO meta-refactor: remove these assignments once we have a clear way to propagate these to the logic
+	//  that uses them
+	c.Meta.variableArgs = args.Vars.All()
+	c.ignoreRemoteVersion = args.Backend.IgnoreRemoteVersion
+	c.backupPath = args.BackupPath
+	c.stateLock = args.Backend.StateLock
+	c.stateLockTimeout = args.Backend.StateLockTimeout
+	c.statePath = args.StatePath
+
+	if diags := c.Meta.checkRequiredVersion(ctx); diags != nil {
+		view.Diagnostics(diags)
+		return 1
+	}
+
+	// Load the encryption configuration
+	enc, encDiags := c.Encryption(ctx)
+	if encDiags.HasErrors() {
+		view.Diagnostics(encDiags)
+		return 1
+	}
+
+	// Get the state
+	stateMgr, err := c.State(ctx, enc, view)
+	if err != nil {
+		view.StateLoadingFailure(err.Error())
+		return 1
+	}
+
+	if c.stateLock {
+		stateLocker := clistate.NewLocker(c.stateLockTimeout, view.Backend().StateLocker())
+		if diags := stateLocker.Lock(stateMgr, "state-annotate"); diags.HasErrors() {
+			view.Diagnostics(diags)
+			return 1
+		}
+		defer func() {
+			if diags := stateLocker.Unlock(); diags.HasErrors() {
+				view.Diagnostics(diags)
+			}
+		}()
+	}
+
+	if err := stateMgr.RefreshState(context.TODO()); err != nil {
+		view.Diagnostics(diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to refresh state",
+			err.Error(),
+		)))
+		return 1
+	}
+
+	state := stateMgr.State()
+	if state == nil {
+		view.StateNotFound()
+		return 1
+	}
+
+	resAddrs, moreDiags := c.lookupResourceInstanceAddr(state, true, args.TargetAddr)
+	diags = diags.Append(moreDiags)
+	if diags.HasErrors() {
+		view.Diagnostics(diags)
+		return 1
+	}
+	if len(resAddrs) == 0 {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid target address",
+			"No matching objects found. To view the available instances, use \"tofu state list\". Please modify the address to reference a specific instance.",
+		))
+		view.Diagnostics(diags)
+		return 1
+	}
+
+	ss := state.SyncWrapper()
+	for _, addr := range resAddrs {
+		view.ResourceAnnotateStatus(args.DryRun, addr.String())
+		if args.DryRun {
+			continue
+		}
+		is := state.ResourceInstance(addr)
+		annotations := maps.Clone(is.Annotations)
+		if annotations == nil {
+			annotations = make(map[string]string, len(args.Set))
+		}
+		maps.Copy(annotations, args.Set)
+		for _, key := range args.Remove {
+			delete(annotations, key)
+		}
+		ss.SetResourceInstanceAnnotations(addr, annotations)
+	}
+
+	if args.DryRun {
+		return 0 // This is as far as we go in dry-run mode
+	}
+
+	b, backendDiags := c.Backend(ctx, nil, enc.State())
+	diags = diags.Append(backendDiags)
+	if backendDiags.HasErrors() {
+		view.Diagnostics(diags)
+		return 1
+	}
+
+	// Get schemas, if possible, before writing state
+	var schemas *tofu.Schemas
+	if isCloudMode(b) {
+		var schemaDiags tfdiags.Diagnostics
+		schemas, schemaDiags = c.MaybeGetSchemas(ctx, state, nil)
+		diags = diags.Append(schemaDiags)
+	}
+
+	if err := stateMgr.WriteState(state); err != nil {
+		view.StateSavingError(err.Error())
+		return 1
+	}
+	if err := stateMgr.PersistState(context.TODO(), schemas); err != nil {
+		view.StateSavingError(err.Error())
+		return 1
+	}
+
+	view.Diagnostics(diags)
+	view.AnnotateFinalStatus(len(resAddrs))
+	return 0
+}
+
+func (c *StateAnnotateCommand) Help() string {
+	helpText := `
+Usage: tofu [global options] state annotate [options] ADDRESS [KEY=VALUE...]
+
+  Set or remove annotations of resource instances in the OpenTofu state.
+
+  Annotations are free-form key/value pairs, such as the owner of a remote
+  object or a related ticket, that OpenTofu stores in the state alongside
+  the resource instance. OpenTofu doesn't interpret annotations, but keeps
+  them for as long as the instance exists, including when it is moved to a
+  new address.
+
+  Each KEY=VALUE argument adds an annotation, or replaces the existing
+  annotation with the same key. Use -remove to remove annotations.
+
+  If you give the address of an entire module or of a resource that has
+  "count" or "for_each" set, all of the matching instances are annotated.
+
+  Annotations are shown by "tofu state show", and "tofu state list" can use
+  them to filter its results.
+
+Options:
+
+  -remove=KEY             Remove the annotation with the given key. Use this
+                          option more than once to remove several annotations.
+
+  -dry-run                If set, prints out what would've been annotated but
+                          doesn't actually change anything.
+
+  -backup=PATH            Path where OpenTofu should write the backup
+                          state.
+
+  -lock=false             Don't hold a state lock during the operation. This is
+                          dangerous if others might concurrently run commands
+                          against the same workspace.
+
+  -lock-timeout=0s        Duration to retry a state lock.
+
+  -state=PATH             Path to the state file to update. Defaults to the
+                          current workspace state.
+
+  -ignore-remote-version  Continue even if remote and local OpenTofu versions
+                          are incompatible. This may result in an unusable
+                          workspace, and should be used with extreme caution.
+
+  -var 'foo=bar'          Set a value for one of the input variables in the root
+                          module of the configuration. Use this option more than
+                          once to set more than one variable.
+
+  -var-file=filename      Load variable values from the given file, in addition
+                          to the default files terraform.tfvars and *.auto.tfvars.
+                          Use this option more than once to include more than one
+                          variables file.
+
+  -json                   Produce output in a machine-readable JSON format, 
+                          suitable for use in text editor integrations and other 
+                          automated systems. Always disables color.
+
+  -json-into=out.json     Produce the same output as -json, but sent directly
+                          to the given file. This allows automation to preserve
+                          the original human-readable output streams, while
+                          capturing more detailed logs for machine analysis.
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *StateAnnotateCommand) Synopsis() string {
+	return "Set or remove annotations of resource instances in the state"
+}
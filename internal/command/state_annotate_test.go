@@ -0,0 +1,188 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/command/workdir"
+	"github.com/opentofu/opentofu/internal/states"
+)
+
+func TestStateAnnotate(t *testing.T) {
+	fooAddr := addrs.Resource{
+		Mode: addrs.ManagedResourceMode,
+		Type: "test_instance",
+		Name: "foo",
+	}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)
+	state := states.BuildState(func(s *states.SyncState) {
+		s.SetResourceInstanceCurrent(
+			fooAddr,
+			&states.ResourceInstanceObjectSrc{
+				AttrsJSON: []byte(`{"id":"bar","foo":"value","bar":"value"}`),
+				Status:    states.ObjectReady,
+			},
+			addrs.AbsProviderConfig{
+				Provider: addrs.NewDefaultProvider("test"),
+				Module:   addrs.RootModule,
+			},
+			addrs.NoKey,
+		)
+		s.SetResourceInstanceAnnotations(fooAddr, map[string]string{
+			"owner":  "team-a",
+			"ticket": "OPS-1",
+		})
+	})
+	statePath := testStateFile(t, state)
+
+	view, done := testView(t)
+	c := &StateAnnotateCommand{
+		StateMeta{
+			Meta: Meta{
+				WorkingDir:       workdir.NewDir("."),
+				testingOverrides: metaOverridesForProvider(testProvider()),
+				View:             view,
+			},
+		},
+	}
+
+	args := []string{
+		"-state", statePath,
+		"-remove=ticket",
+		"test_instance.foo",
+		"owner=team-b",
+		"decommission-date=2027-01-31",
+	}
+	code := c.Run(args)
+	output := done(t)
+	if code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, output.Stderr())
+	}
+	if got, want := output.Stdout(), "Successfully annotated 1 resource instance(s).\n"; !strings.Contains(got, want) {
+		t.Errorf("wrong output\ngot:  %s\nwant: %s", got, want)
+	}
+
+	got := testStateRead(t, statePath).ResourceInstance(fooAddr).Annotations
+	want := map[string]string{
+		"owner":             "team-b",
+		"decommission-date": "2027-01-31",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("wrong annotations\n%s", diff)
+	}
+}
+
+func TestStateAnnotate_dryRun(t *testing.T) {
+	statePath := testStateFile(t, testState())
+
+	view, done := testView(t)
+	c := &StateAnnotateCommand{
+		StateMeta{
+			Meta: Meta{
+				WorkingDir:       workdir.NewDir("."),
+				testingOverrides: metaOverridesForProvider(testProvider()),
+				View:             view,
+			},
+		},
+	}
+
+	args := []string{
+		"-state", statePath,
+		"-dry-run",
+		"test_instance.foo",
+		"owner=team-a",
+	}
+	code := c.Run(args)
+	output := done(t)
+	if code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, output.Stderr())
+	}
+	if got, want := output.Stdout(), "Would annotate test_instance.foo\n"; got != want {
+		t.Errorf("wrong output\ngot:  %q\nwant: %q", got, want)
+	}
+
+	addr := addrs.Resource{
+		Mode: addrs.ManagedResourceMode,
+		Type: "test_instance",
+		Name: "foo",
+	}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)
+	if got := testStateRead(t, statePath).ResourceInstance(addr).Annotations; got != nil {
+		t.Errorf("dry run changed the annotations: %#v", got)
+	}
+}
+
+func TestStateAnnotate_noMatch(t *testing.T) {
+	statePath := testStateFile(t, testState())
+
+	view, done := testView(t)
+	c := &StateAnnotateCommand{
+		StateMeta{
+			Meta: Meta{
+				WorkingDir:       workdir.NewDir("."),
+				testingOverrides: metaOverridesForProvider(testProvider()),
+				View:             view,
+			},
+		},
+	}
+
+	args := []string{
+		"-state", statePath,
+		"test_instance.nonexistent",
+		"owner=team-a",
+	}
+	code := c.Run(args)
+	output := done(t)
+	if code != 1 {
+		t.Fatalf("expected exit status 1, got %d\n\n%s", code, output.Stdout())
+	}
+	if got, want := output.Stderr(), "No matching objects found"; !strings.Contains(got, want) {
+		t.Errorf("wrong error\ngot:  %s\nwant: %s", got, want)
+	}
+}
+
+func TestStateListWithAnnotationFilter(t *testing.T) {
+	state := testState()
+	state.SyncWrapper().SetResourceInstanceAnnotations(
+		addrs.Resource{
+			Mode: addrs.ManagedResourceMode,
+			Type: "test_instance",
+			Name: "foo",
+		}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance),
+		map[string]string{"owner": "team-a"},
+	)
+	statePath := testStateFile(t, state)
+
+	for filter, want := range map[string]string{
+		"owner":        "test_instance.foo\n",
+		"owner=team-a": "test_instance.foo\n",
+		"owner=team-b": "",
+		"ticket":       "",
+	} {
+		t.Run(filter, func(t *testing.T) {
+			view, done := testView(t)
+			c := &StateListCommand{
+				Meta: Meta{
+					WorkingDir:       workdir.NewDir("."),
+					testingOverrides: metaOverridesForProvider(testProvider()),
+					View:             view,
+				},
+			}
+
+			code := c.Run([]string{"-state", statePath, "-filter", filter})
+			output := done(t)
+			if code != 0 {
+				t.Fatalf("bad: %d\n\n%s", code, output.Stderr())
+			}
+			if got := output.Stdout(); got != want {
+				t.Errorf("wrong output\ngot:  %q\nwant: %q", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/mitchellh/cli"
+
+	"github.com/opentofu/opentofu/internal/command/arguments"
+	"github.com/opentofu/opentofu/internal/command/views"
+	"github.com/opentofu/opentofu/internal/encryption"
+	"github.com/opentofu/opentofu/internal/states/statefile"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// StateDowngradeCommand is a Command implementation that converts a state
+// file written in a newer format version into the current format version.
+type StateDowngradeCommand struct {
+	Meta
+}
+
+func (c *StateDowngradeCommand) Run(rawArgs []string) int {
+	common, rawArgs := arguments.ParseView(rawArgs)
+	c.View.Configure(common)
+	// Because the legacy UI was using println to show diagnostics and the new view is using, by default, print,
+	// in order to keep functional parity, we setup the view to add a new line after each diagnostic.
+	c.View.DiagsWithNewline()
+
+	// Parse and validate flags
+	args, closer, diags := arguments.ParseStateDowngrade(rawArgs)
+	defer closer()
+
+	// Instantiate the view, even if there are flag errors, so that we render
+	// diagnostics according to the desired view
+	view := views.NewState(args.ViewOptions, c.View)
+	if diags.HasErrors() {
+		view.Diagnostics(diags)
+		return cli.RunResultHelp
+	}
+
+	// Determine our reader for the input state. This is the filepath
+	// or stdin if "-" is given.
+	var r io.Reader = os.Stdin
+	if src := args.StateSrc; src != "-" {
+		f, err := os.Open(src)
+		if err != nil {
+			view.Diagnostics(diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Failed to open the given state file",
+				err.Error(),
+			)))
+			return 1
+		}
+		defer f.Close()
+		r = f
+	}
+
+	stateFile, err := statefile.Downgrade(r, encryption.StateEncryptionDisabled()) // Assume the given statefile is not encrypted
+	if errors.Is(err, statefile.ErrNoState) {
+		// We produce no output for an empty state, like "tofu state pull".
+		return 0
+	}
+	if err != nil {
+		view.Diagnostics(diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			fmt.Sprintf("Failed to downgrade state %q", args.StateSrc),
+			err.Error(),
+		)))
+		return 1
+	}
+
+	var buf bytes.Buffer
+	err = statefile.Write(stateFile, &buf, encryption.StateEncryptionDisabled()) // Don't encrypt to stdout
+	if err != nil {
+		view.Diagnostics(diags.Append(fmt.Errorf("Failed to write state: %w", err)))
+		return 1
+	}
+	view.PrintPulledState(buf.String())
+
+	return 0
+}
+
+func (c *StateDowngradeCommand) Help() string {
+	helpText := `
+Usage: tofu [global options] state downgrade PATH
+
+  Convert a state file written by a newer version of OpenTofu to the state
+  format used by this version, and output it to stdout.
+
+  The conversion is only done if this version of OpenTofu understands all
+  of the data in the given state file, so that nothing is lost. Otherwise,
+  the command fails and lists the parts of the state that are not
+  understood.
+
+  Pass "-" as the path to read the state from stdin. The result can be
+  written back to its original location using "tofu state push".
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *StateDowngradeCommand) Synopsis() string {
+	return "Convert a state file from a newer format version"
+}
@@ -0,0 +1,61 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/opentofu/opentofu/internal/command/workdir"
+	"github.com/opentofu/opentofu/internal/encryption"
+	"github.com/opentofu/opentofu/internal/states/statefile"
+)
+
+func TestStateDowngrade(t *testing.T) {
+	view, done := testView(t)
+	c := &StateDowngradeCommand{
+		Meta: Meta{
+			WorkingDir: workdir.NewDir("."),
+			View:       view,
+		},
+	}
+
+	code := c.Run([]string{testFixturePath("state-downgrade/compatible.tfstate")})
+	output := done(t)
+	if code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, output.All())
+	}
+
+	stateFile, err := statefile.Read(strings.NewReader(output.Stdout()), encryption.StateEncryptionDisabled())
+	if err != nil {
+		t.Fatalf("output is not a valid state file: %s", err)
+	}
+	if got, want := stateFile.Lineage, "f2968801-fa14-41ab-a044-224f3a4adf04"; got != want {
+		t.Errorf("wrong lineage %q; want %q", got, want)
+	}
+}
+
+func TestStateDowngrade_incompatible(t *testing.T) {
+	view, done := testView(t)
+	c := &StateDowngradeCommand{
+		Meta: Meta{
+			WorkingDir: workdir.NewDir("."),
+			View:       view,
+		},
+	}
+
+	code := c.Run([]string{testFixturePath("state-downgrade/incompatible.tfstate")})
+	output := done(t)
+	if code != 1 {
+		t.Fatalf("wrong exit code %d; want 1\n\n%s", code, output.All())
+	}
+	if output.Stdout() != "" {
+		t.Errorf("unexpected output on stdout: %s", output.Stdout())
+	}
+	if got, want := output.Stderr(), "future_instance_setting"; !strings.Contains(got, want) {
+		t.Errorf("error output does not mention %q\n%s", want, got)
+	}
+}
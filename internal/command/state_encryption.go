@@ -0,0 +1,591 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/mitchellh/cli"
+
+	"github.com/opentofu/opentofu/internal/command/arguments"
+	"github.com/opentofu/opentofu/internal/command/clistate"
+	"github.com/opentofu/opentofu/internal/command/views"
+	"github.com/opentofu/opentofu/internal/encryption"
+	"github.com/opentofu/opentofu/internal/states/statefile"
+	"github.com/opentofu/opentofu/internal/states/statemgr"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// StateEncryptionMode is the change that a StateEncryptionCommand makes to the
+// encryption of the state.
+type StateEncryptionMode string
+
+const (
+	// StateEncryptionEncrypt encrypts a state that is stored without
+	// encryption.
+	StateEncryptionEncrypt StateEncryptionMode = "encrypt"
+
+	// StateEncryptionDecrypt stores an encrypted state without encryption.
+	StateEncryptionDecrypt StateEncryptionMode = "decrypt"
+
+	// StateEncryptionRotate encrypts an encrypted state again with the
+	// primary method of the encryption configuration, typically after
+	// changing the key.
+	StateEncryptionRotate StateEncryptionMode = "rotate"
+)
+
+// StateEncryptionCommand is a Command implementation that rewrites the state
+// under the current encryption configuration, so that it no longer relies on
+// the fallback method.
+type StateEncryptionCommand struct {
+	Meta
+
+	Mode StateEncryptionMode
+}
+
+func (c *StateEncryptionCommand) Run(rawArgs []string) int {
+	ctx := c.CommandContext()
+	name := "state " + string(c.Mode)
+
+	common, rawArgs := arguments.ParseView(rawArgs)
+	c.View.Configure(common)
+	// Because the legacy UI was using println to show diagnostics and the new view is using, by default, print,
+	// in order to keep functional parity, we setup the view to add a new line after each diagnostic.
+	c.View.DiagsWithNewline()
+
+	// Parse and validate flags
+	args, closer, diags := arguments.ParseStateEncryption(name, rawArgs)
+	defer closer()
+
+	// Instantiate the view, even if there are flag errors, so that we render
+	// diagnostics according to the desired view
+	view := views.NewState(args.ViewOptions, c.View)
+	if diags.HasErrors() {
+		view.Diagnostics(diags)
+		if args.ViewOptions.ViewType == arguments.ViewJSON {
+			return 1 // in case it's json, do not print the help of the command
+		}
+		return cli.RunResultHelp
+	}
+
+	if diags := c.Meta.checkReadOnly(name); diags.HasErrors() {
+		view.Diagnostics(diags)
+		return 1
+	}
+
+	// TODO meta-refactor: remove these assignments once we have a clear way to propagate these to the logic
+	//  that uses them
+	c.Meta.variableArgs = args.Vars.All()
+	c.stateLock = args.Backend.StateLock
+	c.stateLockTimeout = args.Backend.StateLockTimeout
+	c.ignoreRemoteVersion = args.Backend.IgnoreRemoteVersion
+
+	if diags := c.Meta.checkRequiredVersion(ctx); diags != nil {
+		view.Diagnostics(diags)
+		return 1
+	}
+
+	// Load the encryption configuration
+	enc, encDiags := c.Encryption(ctx)
+	if encDiags.HasErrors() {
+		view.Diagnostics(encDiags)
+		return 1
+	}
+
+	// We give the backend an observer of the state encryption, so that we
+	// can tell which method it uses to read the state.
+	observer := newStateEncryptionObserver(enc.State())
+	b, backendDiags := c.Backend(ctx, nil, observer)
+	if backendDiags.HasErrors() {
+		view.Diagnostics(backendDiags)
+		return 1
+	}
+
+	workspace, err := c.Workspace(ctx)
+	if err != nil {
+		view.Diagnostics(diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Error selecting workspace",
+			err.Error(),
+		)))
+		return 1
+	}
+
+	// Check remote OpenTofu version is compatible
+	remoteVersionDiags := c.remoteVersionCheck(b, workspace)
+	view.Diagnostics(remoteVersionDiags)
+	if remoteVersionDiags.HasErrors() {
+		return 1
+	}
+
+	stateMgr, err := b.StateMgr(ctx, workspace)
+	if err != nil {
+		view.StateLoadingFailure(err.Error())
+		return 1
+	}
+
+	if c.stateLock {
+		stateLocker := clistate.NewLocker(c.stateLockTimeout, view.Backend().StateLocker())
+		if diags := stateLocker.Lock(stateMgr, name); diags.HasErrors() {
+			view.Diagnostics(diags)
+			return 1
+		}
+		defer func() {
+			if diags := stateLocker.Unlock(); diags.HasErrors() {
+				view.Diagnostics(diags)
+			}
+		}()
+	}
+
+	if err := stateMgr.RefreshState(ctx); err != nil {
+		view.StateLoadingFailure(err.Error())
+		return 1
+	}
+	original := statemgr.Export(stateMgr)
+	originalRead := observer.last
+	if original == nil || original.State == nil || originalRead.raw == nil {
+		view.StateNotFound()
+		return 1
+	}
+
+	wsEnc := enc.State().ForWorkspace(workspace)
+	wasEncrypted, err := encryption.IsEncryptionPayload(originalRead.raw)
+	if err != nil {
+		view.StateLoadingFailure(err.Error())
+		return 1
+	}
+	willEncrypt, err := stateEncryptionEncrypts(original, wsEnc)
+	if err != nil {
+		view.Diagnostics(diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to encrypt state",
+			fmt.Sprintf("OpenTofu could not encrypt the state with the current encryption configuration: %s.", err),
+		)))
+		return 1
+	}
+	diags = diags.Append(c.checkStateEncryptionMode(wasEncrypted, willEncrypt))
+	if diags.HasErrors() {
+		view.Diagnostics(diags)
+		return 1
+	}
+
+	// If we also rewrite the backups, we prepare them first so that we don't
+	// change the state if any of them can't be read.
+	var backups []stateEncryptionBackup
+	if args.History {
+		var moreDiags tfdiags.Diagnostics
+		backups, moreDiags = prepareStateEncryptionBackups(stateMgr, wsEnc)
+		diags = diags.Append(moreDiags)
+		if diags.HasErrors() {
+			view.Diagnostics(diags)
+			return 1
+		}
+	}
+
+	change := views.StateEncryptionChange{
+		Encrypted: willEncrypt,
+	}
+	if originalRead.status != encryption.StatusSatisfied {
+		if err := rewriteStateEncryption(ctx, stateMgr, observer, original, originalRead, willEncrypt); err != nil {
+			view.Diagnostics(diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Failed to rewrite state",
+				fmt.Sprintf("OpenTofu could not rewrite the state under the current encryption configuration: %s.", err),
+			)))
+			return 1
+		}
+		change.Rewritten = true
+	}
+
+	for _, backup := range backups {
+		if err := os.WriteFile(backup.path, backup.rewritten, backup.mode); err != nil {
+			view.Diagnostics(diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Failed to rewrite state backup",
+				fmt.Sprintf("OpenTofu could not write the backup file %s: %s.", backup.path, err),
+			)))
+			return 1
+		}
+		change.Backups = append(change.Backups, backup.path)
+	}
+
+	view.Diagnostics(diags)
+	view.StateEncryptionChanged(change)
+	return 0
+}
+
+// checkStateEncryptionMode checks that the command's mode agrees with whether
+// the state is encrypted now and whether the current encryption configuration
+// would encrypt it.
+func (c *StateEncryptionCommand) checkStateEncryptionMode(wasEncrypted, willEncrypt bool) tfdiags.Diagnostics {
+	var diags tfdiags.Diagnostics
+
+	switch c.Mode {
+	case StateEncryptionEncrypt:
+		if wasEncrypted {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"State is already encrypted",
+				"To encrypt the state again with the primary method of the current encryption configuration, use \"tofu state rotate\" instead.",
+			))
+		} else if !willEncrypt {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"State encryption not configured",
+				"The current encryption configuration stores the state without encryption. To encrypt the state, set the \"method\" of the \"state\" block to an encryption method, and add a \"fallback\" block with the unencrypted method so that the current state can still be read.",
+			))
+		}
+	case StateEncryptionDecrypt:
+		if !wasEncrypted {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"State is not encrypted",
+				"The state is already stored without encryption.",
+			))
+		} else if willEncrypt {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"State encryption still configured",
+				"The current encryption configuration encrypts the state. To decrypt the state, set the \"method\" of the \"state\" block to the unencrypted method, and add a \"fallback\" block with the method that the state is encrypted with.",
+			))
+		}
+	case StateEncryptionRotate:
+		if !wasEncrypted {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"State is not encrypted",
+				"The state is stored without encryption, so there is no key to rotate. To encrypt the state, use \"tofu state encrypt\" instead.",
+			))
+		} else if !willEncrypt {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"State encryption not configured",
+				"The current encryption configuration stores the state without encryption. To store the state without encryption, use \"tofu state decrypt\" instead.",
+			))
+		}
+	}
+	return diags
+}
+
+// stateEncryptionEncrypts returns true if the given state encryption would
+// encrypt the given state file, rather than storing it without encryption.
+func stateEncryptionEncrypts(f *statefile.File, enc encryption.StateEncryption) (bool, error) {
+	var buf bytes.Buffer
+	if err := statefile.Write(f, &buf, enc); err != nil {
+		return false, err
+	}
+	return encryption.IsEncryptionPayload(buf.Bytes())
+}
+
+// rewriteStateEncryption writes the given state back to the given state
+// manager and then reads it again to verify that it's stored as expected
+// under the primary method of the encryption configuration. If it isn't, this
+// restores the state exactly as it was originally read.
+func rewriteStateEncryption(ctx context.Context, stateMgr statemgr.Full, observer *stateEncryptionObserver, original *statefile.File, originalRead stateEncryptionRead, encrypted bool) error {
+	err := stateMgr.WriteState(original.State)
+	if err == nil {
+		err = stateMgr.PersistState(ctx, nil)
+	}
+	if err == nil {
+		err = verifyStateEncryption(ctx, stateMgr, observer, original, encrypted)
+	}
+	if err == nil {
+		return nil
+	}
+
+	// We restore the original payload as it was read, rather than encrypting
+	// the original state again, because the encryption configuration is what
+	// we failed to use.
+	observer.override = originalRead.raw
+	defer func() { observer.override = nil }()
+	restoreErr := statemgr.Import(original, stateMgr, true)
+	if restoreErr == nil {
+		restoreErr = stateMgr.PersistState(ctx, nil)
+	}
+	if restoreErr != nil {
+		return fmt.Errorf("%w; restoring the original state also failed, so it might be necessary to restore it from a backup: %w", err, restoreErr)
+	}
+	return fmt.Errorf("%w; the original state was restored", err)
+}
+
+// verifyStateEncryption reads the state from the given state manager again,
+// and returns an error unless it was read with the primary method of the
+// encryption configuration and matches the given original state.
+func verifyStateEncryption(ctx context.Context, stateMgr statemgr.Full, observer *stateEncryptionObserver, original *statefile.File, encrypted bool) error {
+	observer.last = stateEncryptionRead{}
+	if err := stateMgr.RefreshState(ctx); err != nil {
+		return fmt.Errorf("failed to read the rewritten state: %w", err)
+	}
+	read := observer.last
+	if read.raw == nil {
+		return errors.New("the rewritten state is empty")
+	}
+	if read.status != encryption.StatusSatisfied {
+		return errors.New("the rewritten state cannot be read with the primary method of the encryption configuration")
+	}
+	isEncrypted, err := encryption.IsEncryptionPayload(read.raw)
+	if err != nil {
+		return fmt.Errorf("the rewritten state is invalid: %w", err)
+	}
+	if isEncrypted != encrypted {
+		return errors.New("the rewritten state is not stored as the encryption configuration requires")
+	}
+	if !statefile.StatesMarshalEqual(stateMgr.State(), original.State) {
+		return errors.New("the rewritten state does not match the original state")
+	}
+	return nil
+}
+
+// stateEncryptionBackup is a backup file of a previous version of the state,
+// prepared for rewriting under the current encryption configuration.
+type stateEncryptionBackup struct {
+	path      string
+	mode      os.FileMode
+	rewritten []byte
+}
+
+// prepareStateEncryptionBackups returns the backup files of the given state
+// manager that don't use the primary method of the encryption configuration,
+// each along with its content rewritten to use it.
+//
+// Currently only the local backend's backup file is accessible, so this
+// returns nothing for other backends, even if they keep previous versions.
+func prepareStateEncryptionBackups(stateMgr statemgr.Full, enc encryption.StateEncryption) ([]stateEncryptionBackup, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	withBackup, ok := stateMgr.(interface{ BackupPath() string })
+	if !ok || withBackup.BackupPath() == "" {
+		return nil, diags
+	}
+	path := withBackup.BackupPath()
+	info, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return nil, diags
+	}
+
+	var rewritten []byte
+	if err == nil {
+		rewritten, err = rewriteStateEncryptionBackup(path, enc)
+	}
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to rewrite state backup",
+			fmt.Sprintf("OpenTofu could not rewrite the backup file %s under the current encryption configuration, so the state was not changed: %s.", path, err),
+		))
+		return nil, diags
+	}
+	if rewritten == nil {
+		return nil, diags
+	}
+	return []stateEncryptionBackup{{path: path, mode: info.Mode().Perm(), rewritten: rewritten}}, diags
+}
+
+// rewriteStateEncryptionBackup returns the content of the given backup file
+// rewritten under the given state encryption, after verifying that it can be
+// read again, or nil if the backup already uses its primary method.
+func rewriteStateEncryptionBackup(path string, enc encryption.StateEncryption) ([]byte, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	f, err := statefile.Read(bytes.NewReader(raw), enc)
+	if errors.Is(err, statefile.ErrNoState) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if f.EncryptionStatus == encryption.StatusSatisfied {
+		return nil, nil
+	}
+
+	var buf bytes.Buffer
+	if err := statefile.Write(f, &buf, enc); err != nil {
+		return nil, err
+	}
+	verified, err := statefile.Read(bytes.NewReader(buf.Bytes()), enc)
+	if err != nil {
+		return nil, err
+	}
+	if verified.EncryptionStatus != encryption.StatusSatisfied || !statefile.StatesMarshalEqual(verified.State, f.State) {
+		return nil, errors.New("the rewritten backup does not match the original")
+	}
+	return buf.Bytes(), nil
+}
+
+// stateEncryptionRead describes the most recent payload that a
+// stateEncryptionObserver decrypted.
+type stateEncryptionRead struct {
+	raw    []byte
+	status encryption.EncryptionStatus
+}
+
+// stateEncryptionObserver wraps a StateEncryption to record the payloads that
+// a backend's state manager reads, and to write a given payload verbatim when
+// restoring the original state.
+type stateEncryptionObserver struct {
+	encryption.StateEncryption
+
+	// last is the most recent payload that was decrypted.
+	last stateEncryptionRead
+
+	// override, if set, is written instead of encrypting the state.
+	override []byte
+}
+
+func newStateEncryptionObserver(enc encryption.StateEncryption) *stateEncryptionObserver {
+	return &stateEncryptionObserver{StateEncryption: enc}
+}
+
+func (o *stateEncryptionObserver) ForWorkspace(workspace string) encryption.StateEncryption {
+	return &stateEncryptionWorkspaceObserver{
+		StateEncryption: o.StateEncryption.ForWorkspace(workspace),
+		observer:        o,
+	}
+}
+
+func (o *stateEncryptionObserver) DecryptState(payload []byte) ([]byte, encryption.EncryptionStatus, error) {
+	return o.decrypt(o.StateEncryption, payload)
+}
+
+func (o *stateEncryptionObserver) EncryptState(plain []byte) ([]byte, error) {
+	return o.encrypt(o.StateEncryption, plain)
+}
+
+func (o *stateEncryptionObserver) decrypt(enc encryption.StateEncryption, payload []byte) ([]byte, encryption.EncryptionStatus, error) {
+	plain, status, err := enc.DecryptState(payload)
+	if err == nil {
+		o.last = stateEncryptionRead{raw: bytes.Clone(payload), status: status}
+	}
+	return plain, status, err
+}
+
+func (o *stateEncryptionObserver) encrypt(enc encryption.StateEncryption, plain []byte) ([]byte, error) {
+	if o.override != nil {
+		return bytes.Clone(o.override), nil
+	}
+	return enc.EncryptState(plain)
+}
+
+// stateEncryptionWorkspaceObserver is the StateEncryption for the state of a
+// single workspace, which reports to the stateEncryptionObserver it came from.
+type stateEncryptionWorkspaceObserver struct {
+	encryption.StateEncryption
+
+	observer *stateEncryptionObserver
+}
+
+func (o *stateEncryptionWorkspaceObserver) DecryptState(payload []byte) ([]byte, encryption.EncryptionStatus, error) {
+	return o.observer.decrypt(o.StateEncryption, payload)
+}
+
+func (o *stateEncryptionWorkspaceObserver) EncryptState(plain []byte) ([]byte, error) {
+	return o.observer.encrypt(o.StateEncryption, plain)
+}
+
+func (o *stateEncryptionWorkspaceObserver) ForWorkspace(workspace string) encryption.StateEncryption {
+	return o.observer.ForWorkspace(workspace)
+}
+
+func (c *StateEncryptionCommand) Help() string {
+	var helpText string
+	switch c.Mode {
+	case StateEncryptionEncrypt:
+		helpText = `
+Usage: tofu [global options] state encrypt [options]
+
+  Encrypt the state of the current workspace, which is stored without
+  encryption, using the primary method of the "state" block of the
+  encryption configuration.
+
+  The "state" block must have a "fallback" block with the unencrypted method,
+  so that OpenTofu can read the current state.
+`
+	case StateEncryptionDecrypt:
+		helpText = `
+Usage: tofu [global options] state decrypt [options]
+
+  Store the encrypted state of the current workspace without encryption.
+
+  The primary method of the "state" block of the encryption configuration must
+  be the unencrypted method, and the "state" block must have a "fallback"
+  block with the method that the state is encrypted with.
+`
+	default:
+		helpText = `
+Usage: tofu [global options] state rotate [options]
+
+  Encrypt the encrypted state of the current workspace again, using the
+  primary method of the "state" block of the encryption configuration.
+
+  Use this after changing the key or the method of the encryption
+  configuration, with the "fallback" block of the "state" block set to the
+  previous method, so that OpenTofu can read the current state.
+`
+	}
+	helpText += `
+  Unlike the implicit migration during other operations, which relies on the
+  fallback method until the state is next written, this rewrites the state
+  immediately. OpenTofu then reads the state again to verify that it can be
+  read with the primary method alone, and restores the original state if it
+  cannot.
+
+  If the state already uses the primary method, it is not changed.
+
+Options:
+
+  -history               Also rewrite the previous versions of the state that
+                         the backend keeps, where OpenTofu can access them.
+                         Currently this is only the backup file of the local
+                         backend.
+
+  -lock=false            Don't hold a state lock during the operation. This is
+                         dangerous if others might concurrently run commands
+                         against the same workspace.
+
+  -lock-timeout=0s       Duration to retry a state lock.
+
+  -ignore-remote-version A rare option used for the remote backend only. See
+                         the remote backend documentation for more information.
+
+  -var 'foo=bar'         Set a value for one of the input variables in the root
+                         module of the configuration. Use this option more than
+                         once to set more than one variable.
+
+  -var-file=filename     Load variable values from the given file, in addition
+                         to the default files terraform.tfvars and *.auto.tfvars.
+                         Use this option more than once to include more than one
+                         variables file.
+
+  -json                  Produce output in a machine-readable JSON format,
+                         suitable for use in text editor integrations and other
+                         automated systems. Always disables color.
+
+  -json-into=out.json    Produce the same output as -json, but sent directly
+                         to the given file. This allows automation to preserve
+                         the original human-readable output streams, while
+                         capturing more detailed logs for machine analysis.
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *StateEncryptionCommand) Synopsis() string {
+	switch c.Mode {
+	case StateEncryptionEncrypt:
+		return "Encrypt the state with the current encryption configuration"
+	case StateEncryptionDecrypt:
+		return "Store the state without encryption"
+	default:
+		return "Encrypt the state again with the current encryption configuration"
+	}
+}
@@ -0,0 +1,260 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/opentofu/opentofu/internal/command/arguments"
+	"github.com/opentofu/opentofu/internal/command/workdir"
+	"github.com/opentofu/opentofu/internal/encryption"
+	"github.com/opentofu/opentofu/internal/states/statefile"
+)
+
+// testStateEncryptionConfig writes a configuration in the current directory
+// whose state encryption uses the given primary and fallback methods, which
+// are "old" and "new" for passphrase-based encryption with different keys, or
+// "plain" for no encryption.
+func testStateEncryptionConfig(t *testing.T, method, fallback string) {
+	t.Helper()
+
+	src := fmt.Sprintf(`
+terraform {
+  encryption {
+    key_provider "pbkdf2" "old" {
+      passphrase = "old-passphrase-for-testing"
+    }
+    key_provider "pbkdf2" "new" {
+      passphrase = "new-passphrase-for-testing"
+    }
+    method "aes_gcm" "old" {
+      keys = key_provider.pbkdf2.old
+    }
+    method "aes_gcm" "new" {
+      keys = key_provider.pbkdf2.new
+    }
+    method "unencrypted" "plain" {}
+
+    state {
+      method = %s
+      fallback {
+        method = %s
+      }
+    }
+  }
+}
+`, testStateEncryptionMethod(method), testStateEncryptionMethod(fallback))
+	if err := os.WriteFile("main.tf", []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testStateEncryptionMethod(name string) string {
+	if name == "plain" {
+		return "method.unencrypted.plain"
+	}
+	return "method.aes_gcm." + name
+}
+
+func testStateEncryptionRun(t *testing.T, mode StateEncryptionMode, args ...string) (int, string) {
+	t.Helper()
+
+	view, done := testView(t)
+	c := &StateEncryptionCommand{
+		Meta: Meta{
+			WorkingDir: workdir.NewDir("."),
+			View:       view,
+		},
+		Mode: mode,
+	}
+	code := c.Run(append([]string{"-no-color"}, args...))
+	return code, done(t).All()
+}
+
+func testStateFileEncrypted(t *testing.T, path string) bool {
+	t.Helper()
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encrypted, err := encryption.IsEncryptionPayload(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return encrypted
+}
+
+func TestStateEncryption(t *testing.T) {
+	testCwdTemp(t)
+	testStateFileDefault(t, testState())
+
+	// Encrypt the state, which is stored without encryption.
+	testStateEncryptionConfig(t, "old", "plain")
+	code, output := testStateEncryptionRun(t, StateEncryptionEncrypt)
+	if code != 0 {
+		t.Fatalf("wrong exit code %d; want 0\n\n%s", code, output)
+	}
+	if want := "The state is now encrypted using the current encryption configuration."; !strings.Contains(output, want) {
+		t.Fatalf("wrong output\ngot:\n%s\nwant: %s", output, want)
+	}
+	if !testStateFileEncrypted(t, arguments.DefaultStateFilename) {
+		t.Fatal("state is not encrypted")
+	}
+
+	// Encrypting it again is an error.
+	code, output = testStateEncryptionRun(t, StateEncryptionEncrypt)
+	if code != 1 {
+		t.Fatalf("wrong exit code %d; want 1\n\n%s", code, output)
+	}
+	if want := "State is already encrypted"; !strings.Contains(output, want) {
+		t.Fatalf("wrong output\ngot:\n%s\nwant: %s", output, want)
+	}
+
+	// Rotate to the new key, keeping the old one as the fallback.
+	testStateEncryptionConfig(t, "new", "old")
+	code, output = testStateEncryptionRun(t, StateEncryptionRotate)
+	if code != 0 {
+		t.Fatalf("wrong exit code %d; want 0\n\n%s", code, output)
+	}
+	if want := "The state is now encrypted using the current encryption configuration."; !strings.Contains(output, want) {
+		t.Fatalf("wrong output\ngot:\n%s\nwant: %s", output, want)
+	}
+
+	// The state must now be readable with the new key alone.
+	testStateEncryptionConfig(t, "new", "new")
+	code, output = testStateEncryptionRun(t, StateEncryptionRotate)
+	if code != 0 {
+		t.Fatalf("wrong exit code %d; want 0\n\n%s", code, output)
+	}
+	if want := "The state already uses the current encryption configuration, so it was not changed."; !strings.Contains(output, want) {
+		t.Fatalf("wrong output\ngot:\n%s\nwant: %s", output, want)
+	}
+
+	// Decrypt the state again.
+	testStateEncryptionConfig(t, "plain", "new")
+	code, output = testStateEncryptionRun(t, StateEncryptionDecrypt)
+	if code != 0 {
+		t.Fatalf("wrong exit code %d; want 0\n\n%s", code, output)
+	}
+	if want := "The state is now stored without encryption."; !strings.Contains(output, want) {
+		t.Fatalf("wrong output\ngot:\n%s\nwant: %s", output, want)
+	}
+	if testStateFileEncrypted(t, arguments.DefaultStateFilename) {
+		t.Fatal("state is still encrypted")
+	}
+
+	f, err := os.Open(arguments.DefaultStateFilename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	got, err := statefile.Read(f, encryption.StateEncryptionDisabled())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !statefile.StatesMarshalEqual(got.State, testState()) {
+		t.Fatalf("wrong state after decrypting\n%s", got.State)
+	}
+}
+
+func TestStateEncryption_wrongMode(t *testing.T) {
+	testCwdTemp(t)
+	testStateFileDefault(t, testState())
+	before, err := os.ReadFile(arguments.DefaultStateFilename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		mode             StateEncryptionMode
+		method, fallback string
+		want             string
+	}{
+		"rotate unencrypted state": {
+			mode:     StateEncryptionRotate,
+			method:   "new",
+			fallback: "plain",
+			want:     "there is no key to rotate.",
+		},
+		"decrypt unencrypted state": {
+			mode:     StateEncryptionDecrypt,
+			method:   "plain",
+			fallback: "old",
+			want:     "The state is already stored without encryption.",
+		},
+		"encrypt without encryption method": {
+			mode:     StateEncryptionEncrypt,
+			method:   "plain",
+			fallback: "old",
+			want:     "The current encryption configuration stores the state without encryption.",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			testStateEncryptionConfig(t, test.method, test.fallback)
+			code, output := testStateEncryptionRun(t, test.mode)
+			if code != 1 {
+				t.Fatalf("wrong exit code %d; want 1\n\n%s", code, output)
+			}
+			if !strings.Contains(output, test.want) {
+				t.Fatalf("wrong output\ngot:\n%s\nwant: %s", output, test.want)
+			}
+
+			after, err := os.ReadFile(arguments.DefaultStateFilename)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(before) != string(after) {
+				t.Fatalf("state was modified\n%s", after)
+			}
+		})
+	}
+}
+
+func TestStateEncryption_history(t *testing.T) {
+	testCwdTemp(t)
+	testStateFileDefault(t, testState())
+
+	// Encrypting with the old key and then with the new key leaves a backup
+	// of the state encrypted with the old key.
+	testStateEncryptionConfig(t, "old", "plain")
+	if code, output := testStateEncryptionRun(t, StateEncryptionEncrypt); code != 0 {
+		t.Fatalf("wrong exit code %d; want 0\n\n%s", code, output)
+	}
+	backupPath := arguments.DefaultStateFilename + DefaultBackupExtension
+	raw, err := os.ReadFile(arguments.DefaultStateFilename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(backupPath, raw, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	testStateEncryptionConfig(t, "new", "old")
+	code, output := testStateEncryptionRun(t, StateEncryptionRotate, "-history")
+	if code != 0 {
+		t.Fatalf("wrong exit code %d; want 0\n\n%s", code, output)
+	}
+	if want := "Rewrote the backup file " + backupPath; !strings.Contains(output, want) {
+		t.Fatalf("wrong output\ngot:\n%s\nwant: %s", output, want)
+	}
+
+	// Both the state and its backup must now be readable with the new key
+	// alone.
+	testStateEncryptionConfig(t, "new", "new")
+	code, output = testStateEncryptionRun(t, StateEncryptionRotate, "-history")
+	if code != 0 {
+		t.Fatalf("wrong exit code %d; want 0\n\n%s", code, output)
+	}
+	if strings.Contains(output, "Rewrote the backup file") {
+		t.Fatalf("backup was rewritten again\n%s", output)
+	}
+}
@@ -112,9 +112,13 @@ func (c *StateListCommand) Run(rawArgs []string) int {
 
 	for _, addr := range resourceAddrs {
 		if is := state.ResourceInstance(addr); is != nil {
-			if args.LookupId == "" || args.LookupId == states.LegacyInstanceObjectID(is.Current) {
-				view.StateListAddr(addr)
+			if args.LookupId != "" && args.LookupId != states.LegacyInstanceObjectID(is.Current) {
+				continue
 			}
+			if !matchAnnotationFilters(is.Annotations, args.Filters) {
+				continue
+			}
+			view.StateListAddr(addr)
 		}
 	}
 
@@ -123,6 +127,17 @@ func (c *StateListCommand) Run(rawArgs []string) int {
 	return 0
 }
 
+// matchAnnotationFilters returns true if the given annotations satisfy all of
+// the given filters.
+func matchAnnotationFilters(annotations map[string]string, filters []arguments.AnnotationFilter) bool {
+	for _, filter := range filters {
+		if !filter.Match(annotations) {
+			return false
+		}
+	}
+	return true
+}
+
 func (c *StateListCommand) Help() string {
 	helpText := `
 Usage: tofu [global options] state (list|ls) [options] [address...]
@@ -154,6 +169,12 @@ Options:
                       resource types have an attribute named "id" whose value
                       equals the given id string.
 
+  -filter=KEY[=VALUE] Filters the results to include only instances with an
+                      annotation with the given key, and with the given value
+                      if one is specified. Use this option more than once to
+                      require several annotations. Annotations are set using
+                      "tofu state annotate".
+
   -var 'foo=bar'      Set a value for one of the input variables in the root
                       module of the configuration. Use this option more than
                       once to set more than one variable.
@@ -49,6 +49,12 @@ func (c *StateMvCommand) Run(rawArgs []string) int {
 		}
 		return cli.RunResultHelp
 	}
+
+	if diags := c.Meta.checkReadOnly("state mv"); diags.HasErrors() {
+		view.Diagnostics(diags)
+		return 1
+	}
+
 	// TODO meta-refactor: remove these assignments once there is a clear way to propagate these to the place
 	//   where are used
 	c.backupPath = args.BackupPath
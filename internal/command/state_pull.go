@@ -8,6 +8,7 @@ package command
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -91,8 +92,17 @@ func (c *StatePullCommand) Run(rawArgs []string) int {
 		return 1
 	}
 
-	// Get a statefile object representing the latest snapshot
+	// Get a statefile object representing the latest snapshot, or the
+	// requested previous one
 	stateFile := statemgr.Export(stateMgr)
+	if args.Serial != nil {
+		var snapshotDiags tfdiags.Diagnostics
+		stateFile, snapshotDiags = stateSnapshotBySerial(ctx, stateMgr, *args.Serial)
+		if snapshotDiags.HasErrors() {
+			view.Diagnostics(diags.Append(snapshotDiags))
+			return 1
+		}
+	}
 
 	if stateFile != nil { // we produce no output if the statefile is nil
 		var buf bytes.Buffer
@@ -132,10 +142,43 @@ Options:
                      to the default files terraform.tfvars and *.auto.tfvars.
                      Use this option more than once to include more than one
                      variables file.
+
+  -serial=N          Pull the previous state snapshot with the given serial
+                     instead of the latest one. This requires a backend that
+                     keeps the previous state snapshots, such as "s3" or
+                     "gcs" with a versioned bucket.
 `
 	return strings.TrimSpace(helpText)
 }
 
+// stateSnapshotBySerial retrieves the snapshot with the given serial from
+// the given state manager, describing why it isn't available otherwise.
+func stateSnapshotBySerial(ctx context.Context, stateMgr statemgr.Storage, serial uint64) (*statefile.File, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	stateFile, err := statemgr.SnapshotBySerial(ctx, stateMgr, serial)
+	var notFound statemgr.ErrSnapshotNotFound
+	switch {
+	case err == nil:
+		return stateFile, diags
+	case errors.Is(err, statemgr.ErrHistoryUnsupported):
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Previous state snapshots not available",
+			fmt.Sprintf("Cannot retrieve the state snapshot with serial %d: %s. Only the latest state snapshot is available.", serial, err),
+		))
+	case errors.As(err, &notFound):
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"State snapshot not found",
+			fmt.Sprintf("The state storage has no snapshot with serial %d for the current workspace. It may have been removed by the retention policy of the storage.", serial),
+		))
+	default:
+		diags = diags.Append(fmt.Errorf("Failed to retrieve the state snapshot with serial %d: %w", serial, err))
+	}
+	return nil, diags
+}
+
 func (c *StatePullCommand) Synopsis() string {
 	return "Pull current state and output to stdout"
 }
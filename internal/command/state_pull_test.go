@@ -7,11 +7,19 @@ package command
 
 import (
 	"bytes"
+	"fmt"
 	"os"
 	"strings"
 	"testing"
 
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/opentofu/opentofu/internal/backend"
+	"github.com/opentofu/opentofu/internal/backend/remote-state/inmem"
 	"github.com/opentofu/opentofu/internal/command/workdir"
+	"github.com/opentofu/opentofu/internal/encryption"
+	"github.com/opentofu/opentofu/internal/states"
+	"github.com/opentofu/opentofu/internal/states/statemgr"
 )
 
 func TestStatePull(t *testing.T) {
@@ -48,6 +56,109 @@ func TestStatePull(t *testing.T) {
 	}
 }
 
+func TestStatePull_serial(t *testing.T) {
+	td := t.TempDir()
+	testCopyDir(t, testFixturePath("inmem-backend"), td)
+	t.Chdir(td)
+	defer inmem.Reset()
+
+	// init the backend
+	initView, initDone := testView(t)
+	initCmd := &InitCommand{
+		Meta: Meta{
+			WorkingDir: workdir.NewDir("."),
+			View:       initView,
+		},
+	}
+	code := initCmd.Run([]string{})
+	initOutput := initDone(t)
+	if code != 0 {
+		t.Fatalf("bad exit code: %d\n output:\n%s", code, initOutput.All())
+	}
+
+	// persist a few snapshots, each with a different output value, to a
+	// workspace other than the default one, whose state the inmem backend
+	// resets every time it's configured
+	t.Setenv("TF_WORKSPACE", "test")
+	b := backend.TestBackendConfig(t, inmem.New(encryption.StateEncryptionDisabled()), nil)
+	sMgr, err := b.StateMgr(t.Context(), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range []string{"first", "second", "third"} {
+		s := states.NewState()
+		s.RootModule().SetOutputValue("version", cty.StringVal(v), false, "")
+		if err := sMgr.WriteState(s); err != nil {
+			t.Fatal(err)
+		}
+		if err := sMgr.PersistState(t.Context(), nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	previous := sMgr.(statemgr.PersistentMeta).StateSnapshotMeta().Serial - 1
+
+	run := func(args ...string) (int, string, string) {
+		view, done := testView(t)
+		c := &StatePullCommand{
+			Meta: Meta{
+				WorkingDir: workdir.NewDir("."),
+				View:       view,
+			},
+		}
+		code := c.Run(args)
+		output := done(t)
+		return code, output.Stdout(), output.Stderr()
+	}
+
+	code, stdout, stderr := run(fmt.Sprintf("-serial=%d", previous))
+	if code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, stderr)
+	}
+	if !strings.Contains(stdout, fmt.Sprintf(`"serial":%d,`, previous)) || !strings.Contains(stdout, `"value":"second"`) {
+		t.Fatalf("wrong state snapshot:\n%s", stdout)
+	}
+
+	code, stdout, _ = run()
+	if code != 0 || !strings.Contains(stdout, `"value":"third"`) {
+		t.Fatalf("wrong latest state snapshot:\n%s", stdout)
+	}
+
+	code, _, stderr = run(fmt.Sprintf("-serial=%d", previous+10))
+	if code != 1 {
+		t.Fatalf("got exit status %d; want 1", code)
+	}
+	if !strings.Contains(stderr, "State snapshot not found") {
+		t.Fatalf("wrong error:\n%s", stderr)
+	}
+}
+
+func TestStatePull_serialUnsupported(t *testing.T) {
+	// Create a temporary working directory that is empty
+	td := t.TempDir()
+	testCopyDir(t, testFixturePath("state-pull-backend"), td)
+	t.Chdir(td)
+
+	p := testProvider()
+	view, done := testView(t)
+	c := &StatePullCommand{
+		Meta: Meta{
+			WorkingDir:       workdir.NewDir("."),
+			testingOverrides: metaOverridesForProvider(p),
+			View:             view,
+		},
+	}
+
+	code := c.Run([]string{"-serial=1"})
+	output := done(t)
+	if code != 1 {
+		t.Fatalf("got exit status %d; want 1\n\n%s", code, output.All())
+	}
+	if !strings.Contains(output.Stderr(), "Previous state snapshots not available") {
+		t.Fatalf("wrong error:\n%s", output.Stderr())
+	}
+}
+
 func TestStatePull_noState(t *testing.T) {
 	testCwdTemp(t)
 
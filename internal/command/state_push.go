@@ -10,11 +10,15 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/mitchellh/cli"
 	"github.com/opentofu/opentofu/internal/tfdiags"
 
+	backendLocal "github.com/opentofu/opentofu/internal/backend/local"
 	"github.com/opentofu/opentofu/internal/command/arguments"
 	"github.com/opentofu/opentofu/internal/command/clistate"
 	"github.com/opentofu/opentofu/internal/command/views"
@@ -53,6 +57,12 @@ func (c *StatePushCommand) Run(rawArgs []string) int {
 		}
 		return cli.RunResultHelp
 	}
+
+	if diags := c.Meta.checkReadOnly("state push"); diags.HasErrors() {
+		view.Diagnostics(diags)
+		return 1
+	}
+
 	// TODO meta-refactor: remove these assignments once we have a clear way to propagate these to the logic
 	//  that uses them
 	c.Meta.variableArgs = args.Vars.All()
@@ -168,6 +178,57 @@ func (c *StatePushCommand) Run(rawArgs []string) int {
 		srcStateFile = statemgr.NewStateFile()
 	}
 
+	// A forced push can overwrite a destination state that is newer than, or
+	// unrelated to, the pushed state. In that case we explain what would be
+	// lost and ask for confirmation, and then keep a snapshot of the
+	// overwritten state so that it can be recovered.
+	if args.Force {
+		existing := statemgr.Export(stateMgr)
+		if err := statemgr.CheckValidImport(srcStateFile, existing); err != nil {
+			view.StatePushConflict(statePushConflict(srcStateFile, existing))
+
+			if !args.AutoApprove {
+				if args.StateSrc == "-" {
+					view.Diagnostics(diags.Append(tfdiags.Sourceless(
+						tfdiags.Error,
+						"Cannot confirm the forced push",
+						"OpenTofu cannot ask for confirmation when the state is read from stdin. Use the -auto-approve option to overwrite the destination state without confirmation.",
+					)))
+					return 1
+				}
+				phrase := "overwrite " + workspace
+				v, err := c.UIInput().Input(ctx, &tofu.InputOpts{
+					Id:          "confirm",
+					Query:       "\nDo you really want to overwrite the destination state?",
+					Description: fmt.Sprintf("Only '%s' will be accepted to confirm.", phrase),
+				})
+				if err != nil {
+					view.Diagnostics(diags.Append(tfdiags.Sourceless(
+						tfdiags.Error,
+						"Error asking for approval",
+						err.Error(),
+					)))
+					return 1
+				}
+				if v != phrase {
+					view.StatePushCancelled()
+					return 1
+				}
+			}
+
+			snapshotPath, err := c.writeOverwrittenSnapshot(ctx, enc, view, workspace, existing)
+			if err != nil {
+				view.Diagnostics(diags.Append(tfdiags.Sourceless(
+					tfdiags.Error,
+					"Failed to save a snapshot of the overwritten state",
+					fmt.Sprintf("The state was not pushed, and the destination state is unchanged: %s.", err),
+				)))
+				return 1
+			}
+			view.StatePushSnapshotSaved(snapshotPath)
+		}
+	}
+
 	// Import it, forcing through the lineage/serial if requested and possible.
 	if err := statemgr.Import(srcStateFile, stateMgr, args.Force); err != nil {
 		view.Diagnostics(diags.Append(tfdiags.Sourceless(
@@ -205,6 +266,63 @@ func (c *StatePushCommand) Run(rawArgs []string) int {
 	return 0
 }
 
+// writeOverwrittenSnapshot writes the given destination state, which a forced
+// push is about to overwrite, to a timestamped backup file next to the local
+// state path for the given workspace, and returns the path of that file.
+func (c *StatePushCommand) writeOverwrittenSnapshot(ctx context.Context, enc encryption.Encryption, view views.State, workspace string, f *statefile.File) (string, error) {
+	localRaw, backendDiags := c.Backend(ctx, &BackendOpts{
+		ForceLocal: true,
+		View:       view.Backend(),
+	}, enc.State())
+	if backendDiags.HasErrors() {
+		return "", backendDiags.Err()
+	}
+	localB, ok := localRaw.(*backendLocal.Local)
+	if !ok {
+		return "", fmt.Errorf("unexpected local backend type %T", localRaw)
+	}
+	_, stateOutPath, _ := localB.StatePaths(workspace)
+	path := fmt.Sprintf("%s.%d%s", stateOutPath, time.Now().UTC().Unix(), DefaultBackupExtension)
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", err
+	}
+	out, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return "", err
+	}
+	err = statefile.Write(f, out, enc.State())
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	return path, err
+}
+
+// statePushConflict describes the destination state that pushing the given
+// source state would overwrite.
+func statePushConflict(src, dest *statefile.File) views.StatePushConflict {
+	ret := views.StatePushConflict{
+		SourceLineage:      src.Lineage,
+		SourceSerial:       src.Serial,
+		DestinationLineage: dest.Lineage,
+		DestinationSerial:  dest.Serial,
+	}
+	for _, ms := range dest.State.Modules {
+		for _, rs := range ms.Resources {
+			for key := range rs.Instances {
+				addr := rs.Addr.Instance(key)
+				if src.State.ResourceInstance(addr) == nil {
+					ret.Lost = append(ret.Lost, addr)
+				}
+			}
+		}
+	}
+	sort.Slice(ret.Lost, func(i, j int) bool {
+		return ret.Lost[i].Less(ret.Lost[j])
+	})
+	return ret
+}
+
 func (c *StatePushCommand) Help() string {
 	helpText := `
 Usage: tofu [global options] state push [options] PATH
@@ -226,7 +344,11 @@ Usage: tofu [global options] state push [options] PATH
 Options:
 
   -force              Write the state even if lineages don't match or the
-                      remote serial is higher.
+                      remote serial is higher. OpenTofu lists the resource
+                      instances that would be lost and asks for confirmation
+                      first, and saves a snapshot of the overwritten state.
+
+  -auto-approve       Skip the confirmation of a forced push.
 
   -lock=false         Don't hold a state lock during the operation. This is
                       dangerous if others might concurrently run commands
@@ -7,14 +7,18 @@ package command
 
 import (
 	"bytes"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
+	"github.com/opentofu/opentofu/internal/addrs"
 	"github.com/opentofu/opentofu/internal/backend"
 	"github.com/opentofu/opentofu/internal/backend/remote-state/inmem"
 	"github.com/opentofu/opentofu/internal/command/workdir"
 	"github.com/opentofu/opentofu/internal/encryption"
 	"github.com/opentofu/opentofu/internal/states"
+	"github.com/opentofu/opentofu/internal/states/statefile"
 )
 
 func TestStatePush_empty(t *testing.T) {
@@ -138,7 +142,9 @@ func TestStatePush_replaceMatchStdin(t *testing.T) {
 		},
 	}
 
-	args := []string{"-force", "-"}
+	// The pushed state has a different lineage, so the forced push needs
+	// approval, which can't be given interactively when reading from stdin.
+	args := []string{"-force", "-auto-approve", "-"}
 	code := c.Run(args)
 	output := done(t)
 	if code != 0 {
@@ -182,6 +188,156 @@ func TestStatePush_lineageMismatch(t *testing.T) {
 	}
 }
 
+func TestStatePush_forceConflict(t *testing.T) {
+	testCases := map[string]struct {
+		answer   string
+		wantCode int
+		wantPush bool
+	}{
+		"confirmed": {
+			answer:   "overwrite default",
+			wantCode: 0,
+			wantPush: true,
+		},
+		"not confirmed": {
+			answer:   "yes",
+			wantCode: 1,
+			wantPush: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			td := t.TempDir()
+			testCopyDir(t, testFixturePath("state-push-bad-lineage"), td)
+			t.Chdir(td)
+
+			// The destination state has a resource instance that the pushed
+			// state doesn't, which the forced push would lose.
+			dest := states.BuildState(func(s *states.SyncState) {
+				s.SetResourceInstanceCurrent(
+					addrs.Resource{
+						Mode: addrs.ManagedResourceMode,
+						Type: "test_instance",
+						Name: "foo",
+					}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance),
+					&states.ResourceInstanceObjectSrc{
+						AttrsJSON: []byte(`{"id":"foo"}`),
+						Status:    states.ObjectReady,
+					},
+					addrs.AbsProviderConfig{
+						Provider: addrs.NewDefaultProvider("test"),
+						Module:   addrs.RootModule,
+					},
+					addrs.NoKey,
+				)
+			})
+			f, err := os.Create("local-state.tfstate")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := statefile.WriteForTest(statefile.New(dest, "other", 5), f); err != nil {
+				t.Fatal(err)
+			}
+			f.Close()
+			before := testStateRead(t, "local-state.tfstate")
+			replacement := testStateRead(t, "replace.tfstate")
+
+			defer testInputMap(t, map[string]string{
+				"confirm": tc.answer,
+			})()
+
+			p := testProvider()
+			view, done := testView(t)
+			c := &StatePushCommand{
+				Meta: Meta{
+					WorkingDir:       workdir.NewDir("."),
+					testingOverrides: metaOverridesForProvider(p),
+					View:             view,
+				},
+			}
+
+			code := c.Run([]string{"-no-color", "-force", "replace.tfstate"})
+			output := done(t)
+			if code != tc.wantCode {
+				t.Fatalf("wrong exit code %d; want %d\n\n%s", code, tc.wantCode, output.All())
+			}
+
+			stdout := output.Stdout()
+			for _, want := range []string{
+				"The destination state has a different lineage than the state being pushed.",
+				`Destination: lineage "other", serial 5`,
+				`Source:      lineage "hello", serial 2`,
+				"- test_instance.foo",
+			} {
+				if !strings.Contains(stdout, want) {
+					t.Errorf("output doesn't contain %q\n%s", want, stdout)
+				}
+			}
+
+			snapshots, err := filepath.Glob("terraform.tfstate.*" + DefaultBackupExtension)
+			if err != nil {
+				t.Fatal(err)
+			}
+			actual := testStateRead(t, "local-state.tfstate")
+			if !tc.wantPush {
+				if !actual.Equal(before) {
+					t.Errorf("destination state was overwritten\n%s", actual)
+				}
+				if len(snapshots) != 0 {
+					t.Errorf("unexpected snapshots %v", snapshots)
+				}
+				return
+			}
+
+			if !actual.Equal(replacement) {
+				t.Errorf("destination state wasn't overwritten\n%s", actual)
+			}
+			if len(snapshots) != 1 {
+				t.Fatalf("wrong snapshots %v; want one", snapshots)
+			}
+			if !strings.Contains(stdout, "Saved a snapshot of the overwritten state to "+snapshots[0]) {
+				t.Errorf("output doesn't mention the snapshot\n%s", stdout)
+			}
+			if snapshot := testStateRead(t, snapshots[0]); !snapshot.Equal(before) {
+				t.Errorf("wrong snapshot\n%s", snapshot)
+			}
+		})
+	}
+}
+
+func TestStatePush_forceConflictAutoApprove(t *testing.T) {
+	td := t.TempDir()
+	testCopyDir(t, testFixturePath("state-push-serial-newer"), td)
+	t.Chdir(td)
+
+	expected := testStateRead(t, "replace.tfstate")
+
+	p := testProvider()
+	view, done := testView(t)
+	c := &StatePushCommand{
+		Meta: Meta{
+			WorkingDir:       workdir.NewDir("."),
+			testingOverrides: metaOverridesForProvider(p),
+			View:             view,
+		},
+	}
+
+	code := c.Run([]string{"-no-color", "-force", "-auto-approve", "replace.tfstate"})
+	output := done(t)
+	if code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, output.All())
+	}
+	if stdout := output.Stdout(); !strings.Contains(stdout, "The destination state is newer than the state being pushed.") {
+		t.Errorf("output doesn't explain the conflict\n%s", stdout)
+	}
+
+	actual := testStateRead(t, "local-state.tfstate")
+	if !actual.Equal(expected) {
+		t.Fatalf("bad: %#v", actual)
+	}
+}
+
 func TestStatePush_serialNewer(t *testing.T) {
 	// Create a temporary working directory that is empty
 	td := t.TempDir()
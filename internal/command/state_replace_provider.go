@@ -51,6 +51,12 @@ func (c *StateReplaceProviderCommand) Run(rawArgs []string) int {
 		}
 		return cli.RunResultHelp
 	}
+
+	if diags := c.Meta.checkReadOnly("state replace-provider"); diags.HasErrors() {
+		view.Diagnostics(diags)
+		return 1
+	}
+
 	// TODO meta-refactor: remove these assignments once there is a clear way to propagate these to the place
 	//   where are used
 	c.backupPath = args.BackupPath
@@ -65,22 +71,31 @@ func (c *StateReplaceProviderCommand) Run(rawArgs []string) int {
 		return 1
 	}
 
-	// Parse from/to arguments into providers
-	from, fromDiags := addrs.ParseProviderSourceString(args.RawSrcAddr)
-	if fromDiags.HasErrors() {
-		diags = diags.Append(tfdiags.Sourceless(
-			tfdiags.Error,
-			fmt.Sprintf(`Invalid "from" provider %q`, args.RawSrcAddr),
-			fromDiags.Err().Error(),
-		))
-	}
-	to, toDiags := addrs.ParseProviderSourceString(args.RawDestAddr)
-	if toDiags.HasErrors() {
-		diags = diags.Append(tfdiags.Sourceless(
-			tfdiags.Error,
-			fmt.Sprintf(`Invalid "to" provider %q`, args.RawDestAddr),
-			toDiags.Err().Error(),
-		))
+	var from, to addrs.Provider
+	var aliases []providerAlias
+	if args.FromAliases {
+		var aliasDiags tfdiags.Diagnostics
+		aliases, aliasDiags = loadProviderAliases(ctx, args.AliasesFile)
+		diags = diags.Append(aliasDiags)
+	} else {
+		// Parse from/to arguments into providers
+		var fromDiags, toDiags tfdiags.Diagnostics
+		from, fromDiags = addrs.ParseProviderSourceString(args.RawSrcAddr)
+		if fromDiags.HasErrors() {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				fmt.Sprintf(`Invalid "from" provider %q`, args.RawSrcAddr),
+				fromDiags.Err().Error(),
+			))
+		}
+		to, toDiags = addrs.ParseProviderSourceString(args.RawDestAddr)
+		if toDiags.HasErrors() {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				fmt.Sprintf(`Invalid "to" provider %q`, args.RawDestAddr),
+				toDiags.Err().Error(),
+			))
+		}
 	}
 	if diags.HasErrors() {
 		view.Diagnostics(diags)
@@ -139,23 +154,40 @@ func (c *StateReplaceProviderCommand) Run(rawArgs []string) int {
 		return 1
 	}
 
-	var willReplace []*states.Resource
+	var replacements []views.ProviderReplacement
+	if args.FromAliases {
+		replacements = aliasProviderReplacements(aliases, resources)
+	} else {
+		var willReplace []*states.Resource
 
-	// Update all matching resources with new provider
-	for _, resource := range resources {
-		if resource.ProviderConfig.Provider.Equals(from) {
-			willReplace = append(willReplace, resource)
+		// Update all matching resources with new provider
+		for _, resource := range resources {
+			if resource.ProviderConfig.Provider.Equals(from) {
+				willReplace = append(willReplace, resource)
+			}
+		}
+		if len(willReplace) != 0 {
+			replacements = append(replacements, views.ProviderReplacement{From: from, To: to, Resources: willReplace})
 		}
 	}
 	view.Diagnostics(diags)
 
-	if len(willReplace) == 0 {
+	if len(replacements) == 0 {
 		view.NoMatchingResourcesForProviderReplacement()
 		return 0
 	}
+	replacedCount := 0
+	for _, replacement := range replacements {
+		replacedCount += len(replacement.Resources)
+	}
 
 	// Explain the changes
-	view.ReplaceProviderOverview(from, to, willReplace)
+	view.ReplaceProviderOverview(replacements)
+
+	if args.DryRun {
+		view.DryRunReplacedProviderStatus(replacedCount)
+		return 0
+	}
 
 	// Confirm
 	if !args.AutoApprove {
@@ -179,8 +211,10 @@ func (c *StateReplaceProviderCommand) Run(rawArgs []string) int {
 	}
 
 	// Update the provider for each resource
-	for _, resource := range willReplace {
-		resource.ProviderConfig.Provider = to
+	for _, replacement := range replacements {
+		for _, resource := range replacement.Resources {
+			resource.ProviderConfig.Provider = replacement.To
+		}
 	}
 
 	b, backendDiags := c.Backend(ctx, nil, enc.State())
@@ -209,20 +243,36 @@ func (c *StateReplaceProviderCommand) Run(rawArgs []string) int {
 	}
 
 	view.Diagnostics(diags)
-	view.ProviderReplaced(len(willReplace))
+	view.ProviderReplaced(replacedCount)
 	return 0
 }
 
 func (c *StateReplaceProviderCommand) Help() string {
 	helpText := `
 Usage: tofu [global options] state replace-provider [options] FROM_PROVIDER_FQN TO_PROVIDER_FQN
+       tofu [global options] state replace-provider [options] -from-aliases
 
   Replace provider for resources in the OpenTofu state.
 
+  With -from-aliases, every provider in the state that matches a provider
+  alias is replaced with the provider the alias resolves to. By default,
+  providers in the registry.terraform.io/hashicorp namespace are replaced
+  with their equivalents in registry.opentofu.org/hashicorp.
+
 Options:
 
   -auto-approve           Skip interactive approval.
 
+  -from-aliases           Replace every provider in the state that matches a
+                          provider alias, instead of a single given provider.
+
+  -aliases-file=path      Load additional provider aliases from the given
+                          JSON file or http(s) URL, for use with -from-aliases.
+                          These take precedence over the default aliases.
+
+  -dry-run                Only report the providers that would be replaced,
+                          without changing the state.
+
   -lock=false             Don't hold a state lock during the operation. This is
                           dangerous if others might concurrently run commands
                           against the same workspace.
@@ -0,0 +1,285 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+
+	regaddr "github.com/opentofu/registry-address/v2"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/command/views"
+	"github.com/opentofu/opentofu/internal/httpclient"
+	"github.com/opentofu/opentofu/internal/states"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// providerAliasWildcard is used in place of a whole hostname, namespace, or
+// type in a provider alias pattern to match any value in that position.
+const providerAliasWildcard = "*"
+
+// providerAlias is a rule that maps the source addresses of providers matching
+// a pattern to a replacement source address, for use by
+// "tofu state replace-provider -from-aliases".
+type providerAlias struct {
+	// From and To are the hostname, namespace, and type parts of the
+	// source address patterns. A wildcard in To is replaced with the value
+	// matched by the wildcard in the same position of From.
+	From, To [3]string
+}
+
+// defaultProviderAliases are the aliases that are always available, after any
+// given by the user.
+//
+// Only providers in the hashicorp namespace of the predecessor project's
+// registry are included, because those are the only ones that have
+// equivalents in the OpenTofu registry controlled by the OpenTofu project.
+// This matches the behavior of [depsfile.Locks.UpgradeFromPredecessorProject].
+var defaultProviderAliases = []providerAlias{
+	{
+		From: [3]string{regaddr.TransitionalDefaultProviderRegistryHost.ForDisplay(), "hashicorp", providerAliasWildcard},
+		To:   [3]string{addrs.DefaultProviderRegistryHost.ForDisplay(), "hashicorp", providerAliasWildcard},
+	},
+}
+
+// providerAliasesFile is the JSON structure of a provider aliases file, which
+// maps source address patterns to their replacements:
+//
+//	{
+//	  "aliases": {
+//	    "example.com/acme/*": "registry.opentofu.org/acme/*"
+//	  }
+//	}
+type providerAliasesFile struct {
+	Aliases map[string]string `json:"aliases"`
+}
+
+// parseProviderAlias parses a pair of source address patterns into a
+// providerAlias.
+//
+// Patterns use the same syntax as provider source addresses, except that any
+// part of the address can be a wildcard. Wildcards can only be used in the
+// replacement pattern where the matching pattern also has one.
+func parseProviderAlias(from, to string) (providerAlias, error) {
+	var ret providerAlias
+	var err error
+	ret.From, err = parseProviderAliasPattern(from)
+	if err != nil {
+		return ret, fmt.Errorf("invalid pattern %q: %w", from, err)
+	}
+	ret.To, err = parseProviderAliasPattern(to)
+	if err != nil {
+		return ret, fmt.Errorf("invalid replacement %q: %w", to, err)
+	}
+	for i := range ret.To {
+		if ret.To[i] == providerAliasWildcard && ret.From[i] != providerAliasWildcard {
+			return ret, fmt.Errorf("invalid replacement %q: wildcards are only allowed where %q also has a wildcard", to, from)
+		}
+	}
+	return ret, nil
+}
+
+func parseProviderAliasPattern(raw string) ([3]string, error) {
+	var ret [3]string
+	parts := strings.Split(raw, "/")
+	switch len(parts) {
+	case 2:
+		ret = [3]string{addrs.DefaultProviderRegistryHost.ForDisplay(), parts[0], parts[1]}
+	case 3:
+		ret = [3]string{parts[0], parts[1], parts[2]}
+	default:
+		return ret, fmt.Errorf("must be of the form [HOSTNAME/]NAMESPACE/TYPE")
+	}
+
+	// We validate the non-wildcard parts by substituting a valid placeholder
+	// for each wildcard and parsing the result as a normal source address.
+	placeholders := [3]string{"example.com", "example", "example"}
+	check := ret
+	for i := range check {
+		if check[i] == providerAliasWildcard {
+			check[i] = placeholders[i]
+		}
+	}
+	addr, diags := addrs.ParseProviderSourceString(strings.Join(check[:], "/"))
+	if diags.HasErrors() {
+		return ret, diags.Err()
+	}
+	for i, v := range [3]string{addr.Hostname.ForDisplay(), addr.Namespace, addr.Type} {
+		if ret[i] != providerAliasWildcard {
+			ret[i] = v
+		}
+	}
+	return ret, nil
+}
+
+// wildcards returns the number of wildcards in the matching pattern of the
+// alias, where fewer wildcards means a more specific alias.
+func (a providerAlias) wildcards() int {
+	n := 0
+	for _, part := range a.From {
+		if part == providerAliasWildcard {
+			n++
+		}
+	}
+	return n
+}
+
+// Resolve returns the replacement for the given provider if it matches the
+// alias's pattern.
+func (a providerAlias) Resolve(provider addrs.Provider) (addrs.Provider, bool) {
+	if provider.IsLegacy() || provider.IsBuiltIn() {
+		return addrs.Provider{}, false
+	}
+	parts := [3]string{provider.Hostname.ForDisplay(), provider.Namespace, provider.Type}
+	var result [3]string
+	for i := range parts {
+		if a.From[i] != providerAliasWildcard && a.From[i] != parts[i] {
+			return addrs.Provider{}, false
+		}
+		result[i] = a.To[i]
+		if result[i] == providerAliasWildcard {
+			result[i] = parts[i]
+		}
+	}
+	ret, diags := addrs.ParseProviderSourceString(strings.Join(result[:], "/"))
+	if diags.HasErrors() {
+		return addrs.Provider{}, false
+	}
+	return ret, true
+}
+
+// resolveProviderAlias returns the replacement for the given provider using
+// the first of the given aliases that matches it.
+func resolveProviderAlias(aliases []providerAlias, provider addrs.Provider) (addrs.Provider, bool) {
+	for _, alias := range aliases {
+		if to, ok := alias.Resolve(provider); ok {
+			return to, !to.Equals(provider)
+		}
+	}
+	return addrs.Provider{}, false
+}
+
+// loadProviderAliases returns the aliases to use for the given aliases
+// file location, followed by the default aliases. The location can be either
+// a local path or an http or https URL of a mapping service that returns the
+// same JSON document. If the location is empty then only the default aliases
+// are returned.
+//
+// The user-provided aliases are ordered so that more specific patterns are
+// tried first.
+func loadProviderAliases(ctx context.Context, location string) ([]providerAlias, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+	if location == "" {
+		return defaultProviderAliases, diags
+	}
+
+	src, err := readProviderAliasesSource(ctx, location)
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to read provider aliases",
+			fmt.Sprintf("Could not read provider aliases from %s: %s.", location, err),
+		))
+		return nil, diags
+	}
+
+	var file providerAliasesFile
+	if err := json.Unmarshal(src, &file); err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid provider aliases",
+			fmt.Sprintf("The provider aliases from %s are not valid JSON: %s.", location, err),
+		))
+		return nil, diags
+	}
+
+	var ret []providerAlias
+	for from, to := range file.Aliases {
+		alias, err := parseProviderAlias(from, to)
+		if err != nil {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Invalid provider alias",
+				fmt.Sprintf("The provider aliases from %s contain an invalid alias: %s.", location, err),
+			))
+			continue
+		}
+		ret = append(ret, alias)
+	}
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].wildcards() != ret[j].wildcards() {
+			return ret[i].wildcards() < ret[j].wildcards()
+		}
+		return strings.Join(ret[i].From[:], "/") < strings.Join(ret[j].From[:], "/")
+	})
+
+	return append(ret, defaultProviderAliases...), diags
+}
+
+func readProviderAliasesSource(ctx context.Context, location string) ([]byte, error) {
+	if !strings.HasPrefix(location, "https://") && !strings.HasPrefix(location, "http://") {
+		return os.ReadFile(location)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, location, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := httpclient.New(ctx).Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected response status %s", resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// aliasProviderReplacements groups the given resources by provider, and
+// returns a replacement for each provider that the given aliases resolve to a
+// different provider, ordered by the original provider address.
+func aliasProviderReplacements(aliases []providerAlias, resources []*states.Resource) []views.ProviderReplacement {
+	byProvider := make(map[addrs.Provider]*views.ProviderReplacement)
+	var ret []views.ProviderReplacement
+	for _, resource := range resources {
+		from := resource.ProviderConfig.Provider
+		replacement, ok := byProvider[from]
+		if !ok {
+			to, ok := resolveProviderAlias(aliases, from)
+			if !ok {
+				byProvider[from] = nil
+				continue
+			}
+			replacement = &views.ProviderReplacement{From: from, To: to}
+			byProvider[from] = replacement
+		}
+		if replacement == nil {
+			continue
+		}
+		replacement.Resources = append(replacement.Resources, resource)
+	}
+	for _, replacement := range byProvider {
+		if replacement != nil {
+			ret = append(ret, *replacement)
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].From.LessThan(ret[j].From)
+	})
+	return ret
+}
@@ -0,0 +1,116 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+)
+
+func TestProviderAliasResolve(t *testing.T) {
+	tests := map[string]struct {
+		from, to string
+		provider string
+		want     string
+		wantErr  string
+	}{
+		"exact match": {
+			from:     "example.com/acme/foo",
+			to:       "acme/foo",
+			provider: "example.com/acme/foo",
+			want:     "registry.opentofu.org/acme/foo",
+		},
+		"wildcard type": {
+			from:     "registry.terraform.io/acme/*",
+			to:       "example.com/acme-corp/*",
+			provider: "registry.terraform.io/acme/bar",
+			want:     "example.com/acme-corp/bar",
+		},
+		"wildcard namespace and type": {
+			from:     "example.com/*/*",
+			to:       "*/*",
+			provider: "example.com/acme/bar",
+			want:     "registry.opentofu.org/acme/bar",
+		},
+		"case-insensitive pattern": {
+			from:     "Example.com/Acme/Foo",
+			to:       "acme/foo",
+			provider: "example.com/acme/foo",
+			want:     "registry.opentofu.org/acme/foo",
+		},
+		"no match": {
+			from:     "example.com/acme/*",
+			to:       "acme/*",
+			provider: "example.com/other/foo",
+		},
+		"wildcard only in replacement": {
+			from:    "example.com/acme/foo",
+			to:      "acme/*",
+			wantErr: "wildcards are only allowed",
+		},
+		"invalid pattern": {
+			from:    "foo",
+			to:      "acme/foo",
+			wantErr: "must be of the form",
+		},
+		"invalid replacement": {
+			from:    "acme/foo",
+			to:      "acme/foo_bar",
+			wantErr: "Invalid provider type",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			alias, err := parseProviderAlias(test.from, test.to)
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("wrong error %v; want %q", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got, ok := alias.Resolve(addrs.MustParseProviderSourceString(test.provider))
+			if test.want == "" {
+				if ok {
+					t.Fatalf("unexpected match %s", got)
+				}
+				return
+			}
+			if !ok {
+				t.Fatalf("no match; want %s", test.want)
+			}
+			if got.String() != test.want {
+				t.Fatalf("wrong result %s; want %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestResolveProviderAlias_default(t *testing.T) {
+	tests := map[string]string{
+		"registry.terraform.io/hashicorp/aws": "registry.opentofu.org/hashicorp/aws",
+		"registry.terraform.io/acme/foo":      "",
+		"registry.opentofu.org/hashicorp/aws": "",
+		"terraform.io/builtin/terraform":      "",
+	}
+	for provider, want := range tests {
+		t.Run(provider, func(t *testing.T) {
+			got, ok := resolveProviderAlias(defaultProviderAliases, addrs.MustParseProviderSourceString(provider))
+			switch {
+			case want == "" && ok:
+				t.Fatalf("unexpected replacement %s", got)
+			case want != "" && (!ok || got.String() != want):
+				t.Fatalf("wrong replacement %s; want %s", got, want)
+			}
+		})
+	}
+}
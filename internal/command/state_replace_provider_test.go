@@ -6,6 +6,7 @@
 package command
 
 import (
+	"os"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -434,3 +435,187 @@ azurerm_virtual_machine.gamma:
   provider = provider["registry.opentofu.org/-/azurerm"]
   baz = value
 `
+
+func TestStateReplaceProvider_fromAliases(t *testing.T) {
+	state := states.BuildState(func(s *states.SyncState) {
+		s.SetResourceInstanceCurrent(
+			addrs.Resource{
+				Mode: addrs.ManagedResourceMode,
+				Type: "aws_instance",
+				Name: "alpha",
+			}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance),
+			&states.ResourceInstanceObjectSrc{
+				AttrsJSON: []byte(`{"id":"alpha"}`),
+				Status:    states.ObjectReady,
+			},
+			addrs.AbsProviderConfig{
+				Provider: addrs.MustParseProviderSourceString("registry.terraform.io/hashicorp/aws"),
+				Module:   addrs.RootModule,
+			},
+			addrs.NoKey,
+		)
+		s.SetResourceInstanceCurrent(
+			addrs.Resource{
+				Mode: addrs.ManagedResourceMode,
+				Type: "acme_thing",
+				Name: "beta",
+			}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance),
+			&states.ResourceInstanceObjectSrc{
+				AttrsJSON: []byte(`{"id":"beta"}`),
+				Status:    states.ObjectReady,
+			},
+			addrs.AbsProviderConfig{
+				Provider: addrs.MustParseProviderSourceString("registry.terraform.io/acme/acme"),
+				Module:   addrs.RootModule,
+			},
+			addrs.NoKey,
+		)
+	})
+
+	t.Run("default aliases", func(t *testing.T) {
+		statePath := testStateFile(t, state)
+
+		view, done := testView(t)
+		c := &StateReplaceProviderCommand{
+			StateMeta{
+				Meta: Meta{
+					WorkingDir: workdir.NewDir("."),
+					View:       view,
+				},
+			},
+		}
+		defer testInputMap(t, map[string]string{})()
+
+		code := c.Run([]string{"-state", statePath, "-auto-approve", "-from-aliases"})
+		output := done(t)
+		if code != 0 {
+			t.Fatalf("return code: %d\n\n%s", code, output.Stderr())
+		}
+
+		testStateOutput(t, statePath, testStateReplaceProviderAliasesDefaultOutput)
+		if got, want := output.Stdout(), "Successfully replaced provider for 1 resources."; !strings.Contains(got, want) {
+			t.Fatalf("missing expected output\nwant: %s\nfull output:\n%s", want, got)
+		}
+	})
+
+	t.Run("aliases file", func(t *testing.T) {
+		statePath := testStateFile(t, state)
+		aliasesPath := filepath.Join(t.TempDir(), "aliases.json")
+		if err := os.WriteFile(aliasesPath, []byte(`{"aliases":{"registry.terraform.io/acme/*":"example.com/acme/*"}}`), 0600); err != nil {
+			t.Fatal(err)
+		}
+
+		view, done := testView(t)
+		c := &StateReplaceProviderCommand{
+			StateMeta{
+				Meta: Meta{
+					WorkingDir: workdir.NewDir("."),
+					View:       view,
+				},
+			},
+		}
+		defer testInputMap(t, map[string]string{})()
+
+		code := c.Run([]string{"-state", statePath, "-auto-approve", "-from-aliases", "-aliases-file", aliasesPath})
+		output := done(t)
+		if code != 0 {
+			t.Fatalf("return code: %d\n\n%s", code, output.Stderr())
+		}
+
+		testStateOutput(t, statePath, testStateReplaceProviderAliasesFileOutput)
+		if got, want := output.Stdout(), "Successfully replaced provider for 2 resources."; !strings.Contains(got, want) {
+			t.Fatalf("missing expected output\nwant: %s\nfull output:\n%s", want, got)
+		}
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		statePath := testStateFile(t, state)
+
+		view, done := testView(t)
+		c := &StateReplaceProviderCommand{
+			StateMeta{
+				Meta: Meta{
+					WorkingDir: workdir.NewDir("."),
+					View:       view,
+				},
+			},
+		}
+		defer testInputMap(t, map[string]string{})()
+
+		code := c.Run([]string{"-no-color", "-state", statePath, "-from-aliases", "-dry-run"})
+		output := done(t)
+		if code != 0 {
+			t.Fatalf("return code: %d\n\n%s", code, output.Stderr())
+		}
+
+		testStateOutput(t, statePath, testStateReplaceProviderAliasesOriginal)
+		backups := testStateBackups(t, filepath.Dir(statePath))
+		if len(backups) != 0 {
+			t.Fatalf("unexpected backups: %#v", backups)
+		}
+		for _, want := range []string{
+			"- registry.terraform.io/hashicorp/aws",
+			"+ registry.opentofu.org/hashicorp/aws",
+			"Dry run: would have replaced provider for 1 resources.",
+		} {
+			if got := output.Stdout(); !strings.Contains(got, want) {
+				t.Errorf("missing expected output\nwant: %s\nfull output:\n%s", want, got)
+			}
+		}
+	})
+
+	t.Run("invalid aliases file", func(t *testing.T) {
+		statePath := testStateFile(t, state)
+		aliasesPath := filepath.Join(t.TempDir(), "aliases.json")
+		if err := os.WriteFile(aliasesPath, []byte(`{"aliases":{"example.com/acme/foo":"example.com/*/foo"}}`), 0600); err != nil {
+			t.Fatal(err)
+		}
+
+		view, done := testView(t)
+		c := &StateReplaceProviderCommand{
+			StateMeta{
+				Meta: Meta{
+					WorkingDir: workdir.NewDir("."),
+					View:       view,
+				},
+			},
+		}
+
+		code := c.Run([]string{"-state", statePath, "-from-aliases", "-aliases-file", aliasesPath})
+		output := done(t)
+		if code == 0 {
+			t.Fatalf("successful exit; want error")
+		}
+		if got, want := output.Stderr(), "Invalid provider alias"; !strings.Contains(got, want) {
+			t.Fatalf("missing expected error message\nwant: %s\nfull output:\n%s", want, got)
+		}
+		testStateOutput(t, statePath, testStateReplaceProviderAliasesOriginal)
+	})
+}
+
+const testStateReplaceProviderAliasesOriginal = `
+acme_thing.beta:
+  ID = beta
+  provider = provider["registry.terraform.io/acme/acme"]
+aws_instance.alpha:
+  ID = alpha
+  provider = provider["registry.terraform.io/hashicorp/aws"]
+`
+
+const testStateReplaceProviderAliasesDefaultOutput = `
+acme_thing.beta:
+  ID = beta
+  provider = provider["registry.terraform.io/acme/acme"]
+aws_instance.alpha:
+  ID = alpha
+  provider = provider["registry.opentofu.org/hashicorp/aws"]
+`
+
+const testStateReplaceProviderAliasesFileOutput = `
+acme_thing.beta:
+  ID = beta
+  provider = provider["example.com/acme/acme"]
+aws_instance.alpha:
+  ID = alpha
+  provider = provider["registry.opentofu.org/hashicorp/aws"]
+`
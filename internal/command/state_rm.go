@@ -46,6 +46,12 @@ func (c *StateRmCommand) Run(rawArgs []string) int {
 		}
 		return cli.RunResultHelp
 	}
+
+	if diags := c.Meta.checkReadOnly("state rm"); diags.HasErrors() {
+		view.Diagnostics(diags)
+		return 1
+	}
+
 	// TODO meta-refactor: remove these assignments once we have a clear way to propagate these to the logic
 	//  that uses them
 	c.Meta.variableArgs = args.Vars.All()
@@ -181,20 +181,17 @@ func (c *StateShowCommand) Run(rawArgs []string) int {
 		return 1
 	}
 
-	// check if the resource has a configured provider, otherwise this will use the default provider
+	// The provider configuration is retained so that it can be reported in
+	// the JSON output.
 	rs := state.Resource(addr.ContainingResource())
-	absPc := addrs.AbsProviderConfig{
-		Provider: rs.ProviderConfig.Provider,
-		Alias:    rs.ProviderConfig.Alias,
-		Module:   addrs.RootModule,
-	}
 	singleInstance := states.NewState()
 	singleInstance.EnsureModule(addr.Module).SetResourceInstanceCurrent(
 		addr.Resource,
 		is.Current,
-		absPc,
+		rs.ProviderConfig,
 		addrs.NoKey,
 	)
+	singleInstance.SyncWrapper().SetResourceInstanceAnnotations(addr, is.Annotations)
 	resourceState := statefile.New(singleInstance, "", 0)
 	return view.ShowResourceState(ctx, resourceState, schemas)
 }
@@ -6,11 +6,13 @@
 package command
 
 import (
+	"encoding/json"
 	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/command/jsonstate"
 	"github.com/opentofu/opentofu/internal/command/workdir"
 	"github.com/opentofu/opentofu/internal/configs/configschema"
 	"github.com/opentofu/opentofu/internal/providers"
@@ -81,6 +83,68 @@ func TestStateShow(t *testing.T) {
 	}
 }
 
+func TestStateShow_annotations(t *testing.T) {
+	addr := addrs.Resource{
+		Mode: addrs.ManagedResourceMode,
+		Type: "test_instance",
+		Name: "foo",
+	}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)
+	state := states.BuildState(func(s *states.SyncState) {
+		s.SetResourceInstanceCurrent(
+			addr,
+			&states.ResourceInstanceObjectSrc{
+				AttrsJSON: []byte(`{"id":"bar","foo":"value","bar":"value"}`),
+				Status:    states.ObjectReady,
+			},
+			addrs.AbsProviderConfig{
+				Provider: addrs.NewDefaultProvider("test"),
+				Module:   addrs.RootModule,
+			},
+			addrs.NoKey,
+		)
+		s.SetResourceInstanceAnnotations(addr, map[string]string{
+			"ticket": "OPS-1",
+			"owner":  "team-a",
+		})
+	})
+	statePath := testStateFile(t, state)
+
+	p := testProvider()
+	p.GetProviderSchemaResponse = &providers.GetProviderSchemaResponse{
+		ResourceTypes: map[string]providers.Schema{
+			"test_instance": {
+				Block: &configschema.Block{
+					Attributes: map[string]*configschema.Attribute{
+						"id":  {Type: cty.String, Optional: true, Computed: true},
+						"foo": {Type: cty.String, Optional: true},
+						"bar": {Type: cty.String, Optional: true},
+					},
+				},
+			},
+		},
+	}
+
+	view, done := testView(t)
+	c := &StateShowCommand{
+		Meta: Meta{
+			WorkingDir:       workdir.NewDir("."),
+			testingOverrides: metaOverridesForProvider(p),
+			View:             view,
+		},
+	}
+
+	code := c.Run([]string{"-state", statePath, "test_instance.foo"})
+	output := done(t)
+	if code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, output.Stderr())
+	}
+
+	want := "# test_instance.foo:\n# annotation owner = \"team-a\"\n# annotation ticket = \"OPS-1\"\nresource \"test_instance\" \"foo\" {"
+	if got := output.Stdout(); !strings.HasPrefix(got, want) {
+		t.Fatalf("wrong output\ngot:\n%s\nwant prefix:\n%s", got, want)
+	}
+}
+
 func TestStateShow_multi(t *testing.T) {
 	submod, _ := addrs.ParseModuleInstanceStr("module.sub")
 	state := states.BuildState(func(s *states.SyncState) {
@@ -161,6 +225,99 @@ func TestStateShow_multi(t *testing.T) {
 	}
 }
 
+func TestStateShow_jsonInstanceMetadata(t *testing.T) {
+	submod, _ := addrs.ParseModuleInstanceStr("module.sub")
+	state := states.BuildState(func(s *states.SyncState) {
+		s.SetResourceInstanceCurrent(
+			addrs.Resource{
+				Mode: addrs.ManagedResourceMode,
+				Type: "test_instance",
+				Name: "foo",
+			}.Instance(addrs.NoKey).Absolute(submod),
+			&states.ResourceInstanceObjectSrc{
+				AttrsJSON:           []byte(`{"id":"foo","foo":"value","bar":"value"}`),
+				Status:              states.ObjectReady,
+				SchemaVersion:       2,
+				CreateBeforeDestroy: true,
+				Dependencies: []addrs.ConfigResource{
+					addrs.Resource{
+						Mode: addrs.ManagedResourceMode,
+						Type: "test_instance",
+						Name: "bar",
+					}.InModule(addrs.RootModule),
+				},
+			},
+			addrs.AbsProviderConfig{
+				Provider: addrs.NewDefaultProvider("test"),
+				Module:   addrs.RootModule,
+				Alias:    "west",
+			},
+			addrs.NoKey,
+		)
+	})
+	statePath := testStateFile(t, state)
+
+	p := testProvider()
+	p.GetProviderSchemaResponse = &providers.GetProviderSchemaResponse{
+		ResourceTypes: map[string]providers.Schema{
+			"test_instance": {
+				Version: 2,
+				Block: &configschema.Block{
+					Attributes: map[string]*configschema.Attribute{
+						"id":  {Type: cty.String, Optional: true, Computed: true},
+						"foo": {Type: cty.String, Optional: true},
+						"bar": {Type: cty.String, Optional: true},
+					},
+				},
+			},
+		},
+	}
+
+	view, done := testView(t)
+	c := &StateShowCommand{
+		Meta: Meta{
+			WorkingDir:       workdir.NewDir("."),
+			testingOverrides: metaOverridesForProvider(p),
+			View:             view,
+		},
+	}
+
+	args := []string{
+		"-state", statePath,
+		"-json",
+		"module.sub.test_instance.foo",
+	}
+	code := c.Run(args)
+	output := done(t)
+	if code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, output.Stderr())
+	}
+
+	// The JSON view starts with a version message, before the state itself.
+	lines := strings.Split(strings.TrimSpace(output.Stdout()), "\n")
+	var got jsonstate.State
+	if err := json.Unmarshal([]byte(lines[len(lines)-1]), &got); err != nil {
+		t.Fatalf("invalid JSON output: %s\n%s", err, output.Stdout())
+	}
+	if len(got.Values.RootModule.ChildModules) != 1 || len(got.Values.RootModule.ChildModules[0].Resources) != 1 {
+		t.Fatalf("expected exactly one resource in module.sub\n%s", output.Stdout())
+	}
+	resource := got.Values.RootModule.ChildModules[0].Resources[0]
+	want := jsonstate.Resource{
+		Address:             "module.sub.test_instance.foo",
+		ProviderName:        "registry.opentofu.org/hashicorp/test",
+		ProviderConfig:      `provider["registry.opentofu.org/hashicorp/test"].west`,
+		SchemaVersion:       2,
+		DependsOn:           []string{"test_instance.bar"},
+		CreateBeforeDestroy: true,
+	}
+	resource.Mode, resource.Type, resource.Name = "", "", ""
+	resource.AttributeValues, resource.SensitiveValues = nil, nil
+	if diff := cmp.Diff(want, resource); diff != "" {
+		t.Errorf("wrong resource metadata\n%s", diff)
+	}
+}
+
 func TestStateShow_noState(t *testing.T) {
 	testCwdTemp(t)
 
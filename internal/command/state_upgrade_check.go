@@ -0,0 +1,224 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"strings"
+
+	"github.com/mitchellh/cli"
+	"github.com/opentofu/opentofu/internal/command/views"
+
+	"github.com/opentofu/opentofu/internal/backend"
+	"github.com/opentofu/opentofu/internal/command/arguments"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+	"github.com/opentofu/opentofu/internal/tofu"
+	"github.com/opentofu/opentofu/internal/tofumigrate"
+)
+
+// StateUpgradeCheckCommand is a Command implementation that reports how the
+// providers would upgrade the resource instances in the state, without
+// changing the state.
+type StateUpgradeCheckCommand struct {
+	Meta
+	StateMeta
+}
+
+func (c *StateUpgradeCheckCommand) Run(rawArgs []string) int {
+	ctx := c.CommandContext()
+
+	common, rawArgs := arguments.ParseView(rawArgs)
+	c.View.Configure(common)
+	// Because the legacy UI was using println to show diagnostics and the new view is using, by default, print,
+	// in order to keep functional parity, we setup the view to add a new line after each diagnostic.
+	c.View.DiagsWithNewline()
+
+	// Parse and validate flags
+	args, closer, diags := arguments.ParseStateUpgradeCheck(rawArgs)
+	defer closer()
+
+	// Instantiate the view, even if there are flag errors, so that we render
+	// diagnostics according to the desired view
+	view := views.NewState(args.ViewOptions, c.View)
+	if diags.HasErrors() {
+		view.Diagnostics(diags)
+		if args.ViewOptions.ViewType == arguments.ViewJSON {
+			return 1 // in case it's json, do not print the help of the command
+		}
+		return cli.RunResultHelp
+	}
+	// TODO meta-refactor: remove these assignments once we have a clear way to propagate these to the logic
+	//  that uses them
+	c.Meta.variableArgs = args.Vars.All()
+	c.statePath = args.StatePath
+
+	// Check for user-supplied plugin path
+	var err error
+	if c.pluginPath, err = c.loadPluginPath(); err != nil {
+		view.Diagnostics(diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Error loading plugin path",
+			err.Error(),
+		)))
+		return 1
+	}
+
+	// Load the encryption configuration
+	enc, encDiags := c.Encryption(ctx)
+	if encDiags.HasErrors() {
+		view.Diagnostics(encDiags)
+		return 1
+	}
+
+	// Load the backend
+	b, backendDiags := c.Backend(ctx, nil, enc.State())
+	if backendDiags.HasErrors() {
+		view.Diagnostics(backendDiags)
+		return 1
+	}
+
+	// We require a local backend
+	local, ok := b.(backend.Local)
+	if !ok {
+		view.UnsupportedLocalOp()
+		return 1
+	}
+
+	// This is a read-only command
+	c.ignoreRemoteVersionConflict(b)
+
+	// We expect the config dir to always be the cwd
+	cwd := c.WorkingDir.NormalizePath(c.WorkingDir.RootModuleDir())
+
+	// Build the operation (required to get the providers)
+	opReq := c.Operation(ctx, b, view.Backend(), enc)
+	opReq.AllowUnsetVariables = true
+	opReq.ConfigDir = cwd
+	var callDiags tfdiags.Diagnostics
+	opReq.RootCall, callDiags = c.rootModuleCall(ctx, opReq.ConfigDir)
+	if callDiags.HasErrors() {
+		view.Diagnostics(callDiags)
+		return 1
+	}
+
+	opReq.ConfigLoader, err = c.initConfigLoader()
+	if err != nil {
+		view.Diagnostics(diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Error initializing config loader",
+			err.Error(),
+		)))
+		return 1
+	}
+
+	// Get the context (required to get the providers)
+	stopCtx, cancel := c.InterruptibleContext(ctx)
+	defer cancel()
+	lr, _, ctxDiags := local.LocalRun(ctx, stopCtx, opReq)
+	if ctxDiags.HasErrors() {
+		view.Diagnostics(ctxDiags)
+		return 1
+	}
+
+	// Get the state
+	env, err := c.Workspace(ctx)
+	if err != nil {
+		view.Diagnostics(diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Error selecting workspace",
+			err.Error(),
+		)))
+		return 1
+	}
+	stateMgr, err := b.StateMgr(ctx, env)
+	if err != nil {
+		view.StateLoadingFailure(err.Error())
+		return 1
+	}
+	if err := stateMgr.RefreshState(ctx); err != nil {
+		view.Diagnostics(diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to refresh state",
+			err.Error(),
+		)))
+		return 1
+	}
+
+	state := stateMgr.State()
+	if state == nil {
+		view.StateNotFound()
+		return 1
+	}
+	migratedState, migrateDiags := tofumigrate.MigrateStateProviderAddresses(lr.Config, state)
+	diags = diags.Append(migrateDiags)
+	if migrateDiags.HasErrors() {
+		view.Diagnostics(diags)
+		return 1
+	}
+	state = migratedState
+
+	results, checkDiags := lr.Core.StateUpgradeCheck(ctx, state)
+	diags = diags.Append(checkDiags)
+	if checkDiags.HasErrors() {
+		view.Diagnostics(diags)
+		return 1
+	}
+	view.Diagnostics(diags)
+	view.StateUpgradeCheckResults(results)
+
+	for _, result := range results {
+		if result.Outcome == tofu.StateUpgradeFailed {
+			return 1
+		}
+	}
+	return 0
+}
+
+func (c *StateUpgradeCheckCommand) Help() string {
+	helpText := `
+Usage: tofu [global options] state upgrade-check [options]
+
+  Checks whether the providers can upgrade the resource instances in the
+  OpenTofu state to their current schema versions.
+
+  This command runs the state upgrade logic of the installed providers
+  against each resource instance in the state, as OpenTofu would at the
+  start of the next plan, and reports which of them would change and which
+  would fail to upgrade. The state itself is not modified, and the
+  providers are not configured, so no remote objects are accessed.
+
+  The command exits with status 1 if any resource instance would fail to
+  upgrade.
+
+Options:
+
+  -state=statefile    Path to a OpenTofu state file to check. By default it
+                      will use the state "terraform.tfstate" if it exists.
+
+  -var 'foo=bar'      Set a value for one of the input variables in the root
+                      module of the configuration. Use this option more than
+                      once to set more than one variable.
+
+  -var-file=filename  Load variable values from the given file, in addition
+                      to the default files terraform.tfvars and *.auto.tfvars.
+                      Use this option more than once to include more than one
+                      variables file.
+
+  -json               Produce output in a machine-readable JSON format,
+                      suitable for use in text editor integrations and other
+                      automated systems. Always disables color.
+
+  -json-into=out.json Produce the same output as -json, but sent directly
+                      to the given file. This allows automation to preserve
+                      the original human-readable output streams, while
+                      capturing more detailed logs for machine analysis.
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *StateUpgradeCheckCommand) Synopsis() string {
+	return "Check how providers would upgrade the state"
+}
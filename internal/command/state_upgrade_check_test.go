@@ -0,0 +1,114 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/command/workdir"
+	"github.com/opentofu/opentofu/internal/configs/configschema"
+	"github.com/opentofu/opentofu/internal/providers"
+	"github.com/opentofu/opentofu/internal/states"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestStateUpgradeCheck(t *testing.T) {
+	state := states.BuildState(func(s *states.SyncState) {
+		for name, version := range map[string]uint64{"current": 1, "old": 0, "broken": 0} {
+			s.SetResourceInstanceCurrent(
+				addrs.Resource{
+					Mode: addrs.ManagedResourceMode,
+					Type: "test_instance",
+					Name: name,
+				}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance),
+				&states.ResourceInstanceObjectSrc{
+					AttrsJSON:     []byte(`{"id":"` + name + `"}`),
+					SchemaVersion: version,
+					Status:        states.ObjectReady,
+				},
+				addrs.AbsProviderConfig{
+					Provider: addrs.NewDefaultProvider("test"),
+					Module:   addrs.RootModule,
+				},
+				addrs.NoKey,
+			)
+		}
+	})
+	statePath := testStateFile(t, state)
+	before, err := os.ReadFile(statePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := testProvider()
+	p.GetProviderSchemaResponse = &providers.GetProviderSchemaResponse{
+		ResourceTypes: map[string]providers.Schema{
+			"test_instance": {
+				Version: 1,
+				Block: &configschema.Block{
+					Attributes: map[string]*configschema.Attribute{
+						"id": {Type: cty.String, Optional: true, Computed: true},
+					},
+				},
+			},
+		},
+	}
+	// The providers aren't configured before checking the upgrades, which
+	// the plugin protocol allows but the mock provider doesn't.
+	p.ConfigureProviderCalled = true
+	p.UpgradeResourceStateFn = func(req providers.UpgradeResourceStateRequest) (resp providers.UpgradeResourceStateResponse) {
+		switch {
+		case strings.Contains(string(req.RawStateJSON), "broken"):
+			resp.Diagnostics = resp.Diagnostics.Append(tfdiags.Sourceless(tfdiags.Error, "Cannot upgrade", "Broken object."))
+		default:
+			resp.UpgradedState = cty.ObjectVal(map[string]cty.Value{
+				"id": cty.StringVal(strings.TrimSuffix(strings.TrimPrefix(string(req.RawStateJSON), `{"id":"`), `"}`)),
+			})
+		}
+		return resp
+	}
+
+	view, done := testView(t)
+	c := &StateUpgradeCheckCommand{
+		Meta: Meta{
+			WorkingDir:       workdir.NewDir("."),
+			testingOverrides: metaOverridesForProvider(p),
+			View:             view,
+		},
+	}
+
+	code := c.Run([]string{"-state", statePath, "-no-color"})
+	output := done(t)
+	if code != 1 {
+		t.Fatalf("wrong exit code %d; want 1\n\n%s", code, output.All())
+	}
+
+	want := strings.TrimSpace(`
+! test_instance.broken: would fail to upgrade from schema version 0
+    Error: Cannot upgrade: Broken object.
+  test_instance.current: unchanged at schema version 1
+~ test_instance.old: would upgrade from schema version 0 to 1
+
+Checked 3 resource instance object(s): 1 unchanged, 1 would change, 1 would fail.
+`) + "\n"
+	if got := output.Stdout(); got != want {
+		t.Fatalf("wrong output\ngot:\n%s\nwant:\n%s", got, want)
+	}
+
+	// The check must not modify the state.
+	after, err := os.ReadFile(statePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(before, after) {
+		t.Fatalf("state was modified\n%s", after)
+	}
+}
@@ -58,6 +58,12 @@ func (c *TaintCommand) Run(rawArgs []string) int {
 		}
 		return cli.RunResultHelp
 	}
+
+	if diags := c.Meta.checkReadOnly("taint"); diags.HasErrors() {
+		view.Diagnostics(diags)
+		return 1
+	}
+
 	c.Meta.variableArgs = args.Vars.All()
 	addr := args.TargetAddress
 
@@ -107,6 +107,10 @@ Options:
   -verbose              Print the plan or state for each test run block as it
                         executes.
 
+  -artifacts-dir=path   Write the plan, and the state for apply run blocks, of
+                        each test run block as JSON files into the given
+                        directory, for inspection after the tests complete.
+
   -var 'foo=bar'        Set a value for one of the input variables in the root
                         module of the configuration. Use this option more than
                         once to set more than one variable.
@@ -287,7 +291,8 @@ func (c *TestCommand) Run(rawArgs []string) int {
 		Cancelled: false,
 		Stopped:   false,
 
-		Verbose: args.Verbose,
+		Verbose:      args.Verbose,
+		ArtifactsDir: args.ArtifactsDir,
 	}
 
 	view.Abstract(&suite)
@@ -378,6 +383,10 @@ type TestSuiteRunner struct {
 
 	// Verbose tells the runner to print out plan files during each test run.
 	Verbose bool
+
+	// ArtifactsDir, if set, tells the runner to write the plan and state of
+	// each test run as JSON files into this directory.
+	ArtifactsDir string
 }
 
 func (runner *TestSuiteRunner) Start(ctx context.Context) {
@@ -547,7 +556,7 @@ func (runner *TestFileRunner) ExecuteTestRun(ctx context.Context, run *moduletes
 		return state, false
 	}
 
-	evalCtx, evalDiags := buildEvalContextForProviderConfigTransform(runner.States, run, file, config, runner.Suite.GlobalVariables)
+	evalCtx, evalDiags := buildEvalContextForProviderConfigTransform(runner.States, run, file, config, runner.Suite.GlobalVariables, runner.Suite.Opts.DisabledFunctions)
 	run.Diagnostics = run.Diagnostics.Append(evalDiags)
 	if evalDiags.HasErrors() {
 		run.Status = moduletest.Error
@@ -615,6 +624,8 @@ func (runner *TestFileRunner) ExecuteTestRun(ctx context.Context, run *moduletes
 			run.Diagnostics = run.Diagnostics.Append(diags)
 		}
 
+		run.Diagnostics = run.Diagnostics.Append(runner.writeArtifacts(ctx, planCtx, config, plan, nil, run, file))
+
 		planCtx.TestContext(config, plan.PlannedState, plan, variables).EvaluateAgainstPlan(run)
 		return state, false
 	}
@@ -645,6 +656,15 @@ func (runner *TestFileRunner) ExecuteTestRun(ctx context.Context, run *moduletes
 	}
 	run.Diagnostics = filteredDiags
 
+	// The apply operation removes changes from the plan as it applies them,
+	// so we keep a copy of the plan for the assertions to refer to through
+	// run.plan.
+	planned := *plan
+	planned.Changes = &plans.Changes{
+		Resources: slices.Clone(plan.Changes.Resources),
+		Outputs:   slices.Clone(plan.Changes.Outputs),
+	}
+
 	applyCtx, updated, applyDiags := runner.apply(ctx, plan, state, config, run, file)
 
 	// Remove expected diagnostics, and add diagnostics in case anything that should have failed didn't.
@@ -692,7 +712,9 @@ func (runner *TestFileRunner) ExecuteTestRun(ctx context.Context, run *moduletes
 		run.Diagnostics = run.Diagnostics.Append(diags)
 	}
 
-	applyCtx.TestContext(config, updated, plan, variables).EvaluateAgainstState(run)
+	run.Diagnostics = run.Diagnostics.Append(runner.writeArtifacts(ctx, planCtx, config, plan, updated, run, file))
+
+	applyCtx.TestContext(config, updated, &planned, variables).EvaluateAgainstState(run)
 	return updated, true
 }
 
@@ -741,7 +763,7 @@ func (runner *TestFileRunner) destroy(ctx context.Context, config *configs.Confi
 
 	var diags tfdiags.Diagnostics
 
-	evalCtx, evalDiags := buildEvalContextForProviderConfigTransform(runner.States, run, file, config, runner.Suite.GlobalVariables)
+	evalCtx, evalDiags := buildEvalContextForProviderConfigTransform(runner.States, run, file, config, runner.Suite.GlobalVariables, runner.Suite.Opts.DisabledFunctions)
 	run.Diagnostics = run.Diagnostics.Append(evalDiags)
 	if evalDiags.HasErrors() {
 		return state, nil
@@ -810,7 +832,7 @@ func (runner *TestFileRunner) plan(ctx context.Context, config *configs.Config,
 	references, referenceDiags := run.GetReferences()
 	diags = diags.Append(referenceDiags)
 
-	evalCtx, ctxDiags := getEvalContextForTest(runner.States, config, runner.Suite.GlobalVariables)
+	evalCtx, ctxDiags := getEvalContextForTest(runner.States, config, runner.Suite.GlobalVariables, runner.Suite.Opts.DisabledFunctions)
 	diags = diags.Append(ctxDiags)
 
 	variables, variableDiags := buildInputVariablesForTest(run, file, config, runner.Suite.GlobalVariables, evalCtx)
@@ -1056,7 +1078,7 @@ func (runner *TestFileRunner) Cleanup(ctx context.Context, file *moduletest.File
 			runConfig = state.Run.Config.ConfigUnderTest
 		}
 
-		evalCtx, evalDiags := buildEvalContextForProviderConfigTransform(runner.States, state.Run, file, runConfig, runner.Suite.GlobalVariables)
+		evalCtx, evalDiags := buildEvalContextForProviderConfigTransform(runner.States, state.Run, file, runConfig, runner.Suite.GlobalVariables, runner.Suite.Opts.DisabledFunctions)
 		if evalDiags.HasErrors() {
 			return
 		}
@@ -1091,8 +1113,8 @@ func (runner *TestFileRunner) Cleanup(ctx context.Context, file *moduletest.File
 // input variables with `variables` block.
 //
 // The evalCtx returned from this, contains built-in functions for the same reason.
-func buildEvalContextForProviderConfigTransform(states map[string]*TestFileState, run *moduletest.Run, file *moduletest.File, config *configs.Config, globals map[string]backend.UnparsedVariableValue) (*hcl.EvalContext, tfdiags.Diagnostics) {
-	evalCtx, diags := getEvalContextForTest(states, config, globals)
+func buildEvalContextForProviderConfigTransform(states map[string]*TestFileState, run *moduletest.Run, file *moduletest.File, config *configs.Config, globals map[string]backend.UnparsedVariableValue, disabledFunctions []string) (*hcl.EvalContext, tfdiags.Diagnostics) {
+	evalCtx, diags := getEvalContextForTest(states, config, globals, disabledFunctions)
 	vars, varDiags := buildInputVariablesForTest(run, file, config, globals, evalCtx)
 	diags = diags.Append(varDiags)
 	if diags.HasErrors() {
@@ -1112,7 +1134,7 @@ func buildEvalContextForProviderConfigTransform(states map[string]*TestFileState
 	}
 	evalCtx.Variables["var"] = cty.ObjectVal(varMap)
 
-	scope := &lang.Scope{}
+	scope := &lang.Scope{DisabledFunctions: disabledFunctions}
 	evalCtx.Functions = scope.Functions()
 	return evalCtx, diags
 }
@@ -1169,7 +1191,10 @@ func buildInputVariablesForTest(run *moduletest.Run, file *moduletest.File, conf
 // TestFileState instances, configuration and global variables.
 // It extracts the relevant information from the input parameters to create a
 // context suitable for HCL evaluation.
-func getEvalContextForTest(states map[string]*TestFileState, config *configs.Config, globals map[string]backend.UnparsedVariableValue) (*hcl.EvalContext, tfdiags.Diagnostics) {
+//
+// The functions matching disabledFunctions, from the disabled_functions CLI
+// configuration setting, are not available in the returned context.
+func getEvalContextForTest(states map[string]*TestFileState, config *configs.Config, globals map[string]backend.UnparsedVariableValue, disabledFunctions []string) (*hcl.EvalContext, tfdiags.Diagnostics) {
 	var diags tfdiags.Diagnostics
 	runCtx := make(map[string]cty.Value)
 	for _, state := range states {
@@ -1194,7 +1219,7 @@ func getEvalContextForTest(states map[string]*TestFileState, config *configs.Con
 		varCtx[name] = val.Value
 	}
 
-	scope := &lang.Scope{}
+	scope := &lang.Scope{DisabledFunctions: disabledFunctions}
 	ctx := &hcl.EvalContext{
 		Variables: map[string]cty.Value{
 			"run": cty.ObjectVal(runCtx),
@@ -1254,7 +1279,9 @@ func parseAndApplyDefaultValues(unparsedVariables map[string]backend.UnparsedVar
 			continue
 		}
 
-		if variable.Default != cty.NilVal {
+		// Default values that call provider-defined functions are instead
+		// evaluated by OpenTofu Core once the providers are available.
+		if variable.Default != cty.NilVal && variable.DefaultExpr == nil {
 			inputs[name] = &tofu.InputValue{
 				Value:       variable.Default,
 				SourceType:  tofu.ValueFromConfig,
@@ -1284,7 +1311,7 @@ func parseAndApplyDefaultValues(unparsedVariables map[string]backend.UnparsedVar
 // the config which must be called so the config can be reused going forward.
 func (runner *TestFileRunner) prepareInputVariablesForAssertions(config *configs.Config, run *moduletest.Run, file *moduletest.File, globals map[string]backend.UnparsedVariableValue) (tofu.InputValues, func(), tfdiags.Diagnostics) {
 	var diags tfdiags.Diagnostics
-	ctx, ctxDiags := getEvalContextForTest(runner.States, config, globals)
+	ctx, ctxDiags := getEvalContextForTest(runner.States, config, globals, runner.Suite.Opts.DisabledFunctions)
 	diags = diags.Append(ctxDiags)
 
 	variables := make(map[string]backend.UnparsedVariableValue)
@@ -0,0 +1,99 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/opentofu/opentofu/internal/command/jsonplan"
+	"github.com/opentofu/opentofu/internal/command/jsonstate"
+	"github.com/opentofu/opentofu/internal/configs"
+	"github.com/opentofu/opentofu/internal/moduletest"
+	"github.com/opentofu/opentofu/internal/plans"
+	"github.com/opentofu/opentofu/internal/states"
+	"github.com/opentofu/opentofu/internal/states/statefile"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+	"github.com/opentofu/opentofu/internal/tofu"
+)
+
+// writeArtifacts writes the plan of the given run, and its state if it's not
+// nil, as JSON files into the artifacts directory requested with the
+// -artifacts-dir option. It does nothing if there is no artifacts directory.
+//
+// The files for each run are named after the index and name of the run
+// block, within a directory named after the test file:
+//
+//	ARTIFACTS_DIR/TEST_FILE/INDEX_RUN.plan.json
+//	ARTIFACTS_DIR/TEST_FILE/INDEX_RUN.state.json
+//
+// The index makes the names unique even if several run blocks in a file have
+// the same name, and keeps the files in the order of the run blocks.
+//
+// As with the verbose output, failing to write the artifacts doesn't fail the
+// test, so any problems are returned as warnings.
+func (runner *TestFileRunner) writeArtifacts(ctx context.Context, tfCtx *tofu.Context, config *configs.Config, plan *plans.Plan, state *states.State, run *moduletest.Run, file *moduletest.File) tfdiags.Diagnostics {
+	var diags tfdiags.Diagnostics
+	if runner.Suite.ArtifactsDir == "" {
+		return diags
+	}
+
+	failed := func(err error) tfdiags.Diagnostics {
+		return diags.Append(tfdiags.Sourceless(
+			tfdiags.Warning,
+			"Failed to write test artifacts",
+			fmt.Sprintf("OpenTofu failed to write the artifacts for %s: %s.", path.Join(file.Name, run.Name), err)))
+	}
+
+	schemas, schemaDiags := tfCtx.Schemas(ctx, config, plan.PlannedState)
+	if schemaDiags.HasErrors() {
+		diags = diags.Append(schemaDiags)
+		return failed(schemaDiags.Err())
+	}
+
+	dir := filepath.Join(runner.Suite.ArtifactsDir, filepath.FromSlash(file.Name))
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return failed(err)
+	}
+	prefix := filepath.Join(dir, fmt.Sprintf("%d_%s", run.Index, run.Name))
+
+	planJSON, err := jsonplan.Marshal(config, plan, nil, schemas)
+	if err != nil {
+		return failed(fmt.Errorf("failed to marshal the plan: %w", err))
+	}
+	if err := writeArtifactJSON(prefix+".plan.json", planJSON); err != nil {
+		return failed(err)
+	}
+
+	if state == nil {
+		return diags
+	}
+	stateJSON, err := jsonstate.Marshal(statefile.New(state, file.Name, uint64(run.Index)), schemas)
+	if err != nil {
+		return failed(fmt.Errorf("failed to marshal the state: %w", err))
+	}
+	if err := writeArtifactJSON(prefix+".state.json", stateJSON); err != nil {
+		return failed(err)
+	}
+	return diags
+}
+
+// writeArtifactJSON writes the given JSON document to the given file,
+// indented so that artifacts are easy to read and to compare with other
+// versions of the same files.
+func writeArtifactJSON(filename string, src []byte) error {
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, src, "", "  "); err != nil {
+		return err
+	}
+	buf.WriteByte('\n')
+	return os.WriteFile(filename, buf.Bytes(), 0644)
+}
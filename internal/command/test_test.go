@@ -6,9 +6,12 @@
 package command
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
 	"path"
 	"path/filepath"
+	"slices"
 	"strings"
 	"testing"
 
@@ -40,6 +43,10 @@ func TestTest(t *testing.T) {
 			expected: "1 passed, 0 failed.",
 			code:     0,
 		},
+		"plan_actions": {
+			expected: "2 passed, 0 failed.",
+			code:     0,
+		},
 		"simple_pass_nested": {
 			expected: "1 passed, 0 failed.",
 			code:     0,
@@ -600,6 +607,88 @@ variable.
 	}
 }
 
+func TestTest_ArtifactsDir(t *testing.T) {
+	td := t.TempDir()
+	testCopyDir(t, testFixturePath(path.Join("test", "plan_then_apply")), td)
+	t.Chdir(td)
+
+	provider := testing_command.NewProvider(nil)
+	view, done := testView(t)
+
+	c := &TestCommand{
+		Meta: Meta{
+			WorkingDir:       workdir.NewDir("."),
+			testingOverrides: metaOverridesForProvider(provider.Provider),
+			View:             view,
+		},
+	}
+
+	code := c.Run([]string{"-artifacts-dir=artifacts", "-no-color"})
+	output := done(t)
+
+	if code != 0 {
+		t.Fatalf("expected status code 0 but got %d\n%s", code, output.All())
+	}
+
+	dir := filepath.Join("artifacts", "main.tftest.hcl")
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, entry := range entries {
+		got = append(got, entry.Name())
+	}
+	want := []string{
+		"0_validate_test_resource.plan.json",
+		"1_validate_test_resource.plan.json",
+		"1_validate_test_resource.state.json",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("wrong artifacts\n%s", diff)
+	}
+
+	var plan struct {
+		FormatVersion   string `json:"format_version"`
+		ResourceChanges []struct {
+			Address string `json:"address"`
+			Change  struct {
+				Actions []string `json:"actions"`
+			} `json:"change"`
+		} `json:"resource_changes"`
+	}
+	src, err := os.ReadFile(filepath.Join(dir, "0_validate_test_resource.plan.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(src, &plan); err != nil {
+		t.Fatal(err)
+	}
+	if len(plan.ResourceChanges) != 1 || plan.ResourceChanges[0].Address != "test_resource.foo" || !slices.Equal(plan.ResourceChanges[0].Change.Actions, []string{"create"}) {
+		t.Errorf("wrong plan artifact:\n%s", src)
+	}
+
+	var state struct {
+		Values struct {
+			RootModule struct {
+				Resources []struct {
+					Address string `json:"address"`
+				} `json:"resources"`
+			} `json:"root_module"`
+		} `json:"values"`
+	}
+	src, err = os.ReadFile(filepath.Join(dir, "1_validate_test_resource.state.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(src, &state); err != nil {
+		t.Fatal(err)
+	}
+	if resources := state.Values.RootModule.Resources; len(resources) != 1 || resources[0].Address != "test_resource.foo" {
+		t.Errorf("wrong state artifact:\n%s", src)
+	}
+}
+
 func TestTest_Verbose(t *testing.T) {
 	td := t.TempDir()
 	testCopyDir(t, testFixturePath(path.Join("test", "plan_then_apply")), td)
@@ -0,0 +1,5 @@
+terraform {
+    backend "local-bootstrap" {
+        path = "local-state.tfstate"
+    }
+}
@@ -0,0 +1,11 @@
+resource "test_instance" "foo" {
+  ami = "foo"
+}
+
+resource "test_instance" "bar" {
+  ami = test_instance.foo.id
+}
+
+module "child" {
+  source = "./child"
+}
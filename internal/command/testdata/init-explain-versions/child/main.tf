@@ -0,0 +1,8 @@
+terraform {
+  required_providers {
+    between = {
+      source  = "hashicorp/between"
+      version = "< 3.0.0"
+    }
+  }
+}
@@ -0,0 +1,12 @@
+terraform {
+  required_providers {
+    between = {
+      source  = "hashicorp/between"
+      version = ">= 2.0.0"
+    }
+  }
+}
+
+module "child" {
+  source = "./child"
+}
@@ -0,0 +1,8 @@
+terraform {
+  required_providers {
+    test = {
+      source  = "hashicorp/test"
+      version = ">= 2.0.0"
+    }
+  }
+}
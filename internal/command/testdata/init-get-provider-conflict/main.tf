@@ -0,0 +1,12 @@
+terraform {
+  required_providers {
+    test = {
+      source  = "hashicorp/test"
+      version = "~> 1.0"
+    }
+  }
+}
+
+module "child" {
+  source = "./child"
+}
@@ -0,0 +1,3 @@
+resource "declared_thing" "x" {}
+
+resource "happy_thing" "y" {}
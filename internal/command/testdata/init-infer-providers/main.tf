@@ -0,0 +1,19 @@
+terraform {
+  required_providers {
+    declared = {
+      source = "acme/declared"
+    }
+  }
+}
+
+resource "happy_thing" "a" {}
+
+data "nosuch_thing" "b" {}
+
+resource "declared_thing" "c" {}
+
+resource "terraform_data" "d" {}
+
+module "child" {
+  source = "./child"
+}
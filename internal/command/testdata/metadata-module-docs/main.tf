@@ -0,0 +1,43 @@
+terraform {
+  required_providers {
+    test = {
+      source  = "hashicorp/test"
+      version = ">= 1.0.0"
+    }
+  }
+}
+
+variable "name" {
+  type        = string
+  description = "The name of the instance."
+
+  validation {
+    condition     = length(var.name) > 0
+    error_message = "The name must not be empty."
+  }
+}
+
+variable "settings" {
+  type = object({
+    size = optional(number, 1)
+    tags = optional(map(string))
+  })
+  default   = {}
+  sensitive = true
+}
+
+resource "test_instance" "foo" {
+  ami = var.name
+}
+
+data "test_data_source" "bar" {
+}
+
+module "child" {
+  source = "./child"
+}
+
+output "id" {
+  value       = test_instance.foo.id
+  description = "The ID of the instance."
+}
@@ -0,0 +1,23 @@
+variable "name" {
+  type        = string
+  description = "The name of the instance."
+}
+
+variable "size" {
+  type    = string
+  default = "small"
+
+  validation {
+    condition     = contains(["small", "large"], var.size)
+    error_message = "The size must be small or large."
+  }
+}
+
+variable "settings" {
+  type = object({
+    count = optional(number, 1)
+    tags  = optional(map(string))
+  })
+  default   = {}
+  sensitive = true
+}
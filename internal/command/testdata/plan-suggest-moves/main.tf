@@ -0,0 +1,9 @@
+resource "test_instance" "foo" {
+  for_each = {
+    a = "ami-a"
+    b = "ami-b"
+    c = "ami-c"
+  }
+
+  ami = each.value
+}
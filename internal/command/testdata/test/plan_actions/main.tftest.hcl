@@ -0,0 +1,15 @@
+run "create" {
+  assert {
+    condition     = run.plan.resource_changes["test_resource.foo"].actions == ["create"]
+    error_message = "expected test_resource.foo to be created"
+  }
+}
+
+run "unchanged" {
+  command = plan
+
+  assert {
+    condition     = alltrue([for change in run.plan.resource_changes : change.actions == ["no-op"]])
+    error_message = "expected no changes"
+  }
+}
@@ -0,0 +1,24 @@
+variable "name" {
+  type = string
+}
+
+locals {
+  tags = {
+    Name = var.name
+  }
+}
+
+data "test_data_source" "foo" {
+  id = var.name
+}
+
+resource "test_instance" "foo" {
+  ami = data.test_data_source.foo.id
+
+  dynamic "network_interface" {
+    for_each = local.tags
+    content {
+      description = network_interface.value
+    }
+  }
+}
@@ -0,0 +1,37 @@
+# Used only by module.inherits, which inherits it.
+provider "test" {
+}
+
+# Passed to module.explicit.
+provider "test" {
+  alias = "passed"
+}
+
+# Used only by a provider-defined function.
+provider "test" {
+  alias = "function"
+}
+
+provider "test" {
+  alias = "unused"
+}
+
+module "inherits" {
+  source = "./modules/inherits"
+}
+
+module "explicit" {
+  source = "./modules/explicit"
+
+  providers = {
+    test = test.passed
+  }
+}
+
+output "ids" {
+  value = [module.inherits.id, module.explicit.id]
+}
+
+output "echo" {
+  value = provider::test::function::echo("echo")
+}
@@ -0,0 +1,20 @@
+provider "test" {
+  alias = "unused"
+  value = "unused"
+}
+
+data "test_data_source" "foo" {
+  id = "foo"
+}
+
+output "id" {
+  value = data.test_data_source.foo.id
+}
+
+terraform {
+  required_providers {
+    test = {
+      source = "hashicorp/test"
+    }
+  }
+}
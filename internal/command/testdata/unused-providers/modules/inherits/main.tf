@@ -0,0 +1,7 @@
+data "test_data_source" "foo" {
+  id = "foo"
+}
+
+output "id" {
+  value = data.test_data_source.foo.id
+}
@@ -0,0 +1,56 @@
+variable "used" {
+  type = string
+}
+
+variable "unused" {
+  type = string
+
+  validation {
+    condition     = length(var.unused) > 0
+    error_message = "Must not be empty."
+  }
+}
+
+locals {
+  name   = "${var.used}-name"
+  unused = "unused"
+}
+
+data "test_data_source" "used" {
+  id = local.name
+}
+
+data "test_data_source" "unused" {
+  id = "unused"
+}
+
+module "child" {
+  source = "./modules/child"
+
+  name = data.test_data_source.used.id
+}
+
+output "id" {
+  value = module.child.id
+}
+
+provider "test" {
+}
+
+provider "test" {
+  alias = "used"
+}
+
+provider "test" {
+  alias = "unused"
+}
+
+data "test_data_source" "aliased" {
+  provider = test.used
+
+  id = local.name
+}
+
+output "aliased" {
+  value = data.test_data_source.aliased.id
+}
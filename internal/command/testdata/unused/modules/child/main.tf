@@ -0,0 +1,16 @@
+variable "name" {
+  type = string
+}
+
+variable "unused" {
+  type    = string
+  default = "unused"
+}
+
+output "id" {
+  value = var.name
+}
+
+output "unused" {
+  value = "unused"
+}
@@ -0,0 +1,7 @@
+variable "tags" {
+  type = map(any)
+}
+
+output "ids" {}
+
+output "arns" {}
@@ -0,0 +1,6 @@
+variable "tags" {
+  type     = map(string)
+  optional = true
+}
+
+output "ids" {}
@@ -0,0 +1,12 @@
+variable "tags" {
+  type    = map(string)
+  default = {}
+}
+
+resource "test_instance" "foo" {
+  ami = "bar"
+}
+
+output "ids" {
+  value = [test_instance.foo.ami]
+}
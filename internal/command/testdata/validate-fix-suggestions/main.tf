@@ -0,0 +1,11 @@
+resource "test_instance" "foo" {
+  amii = "bar"
+
+  network_interfac {
+    device_index = 0
+  }
+}
+
+resource "test_instance" "bar" {
+  ami = ["baz"]
+}
@@ -0,0 +1,8 @@
+resource "test_instance" "foo" {
+  ami       = "bar"
+  depend_on = []
+
+  lifecyle {
+    create_before_destroy = true
+  }
+}
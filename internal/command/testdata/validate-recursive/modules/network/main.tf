@@ -0,0 +1,12 @@
+variable "name" {
+  type = string
+}
+
+module "subnet" {
+  source = "./subnet"
+  name   = var.name
+}
+
+output "id" {
+  value = module.subnet.id
+}
@@ -0,0 +1,7 @@
+variable "name" {
+  type = string
+}
+
+output "id" {
+  value = "subnet-${var.name}"
+}
@@ -0,0 +1,8 @@
+provider "test" {
+  alias  = "unused"
+  reigon = "us-east-1"
+}
+
+resource "test_instance" "foo" {
+  ami = "bar"
+}
@@ -0,0 +1,17 @@
+variable "instances" {
+  type = number
+
+  validation {
+    condition     = var.instances > 0
+    error_message = "At least one instance is required."
+  }
+}
+
+variable "name" {
+  type = string
+}
+
+resource "test_instance" "foo" {
+  count = var.instances
+  ami   = var.name
+}
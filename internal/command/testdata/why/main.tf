@@ -0,0 +1,11 @@
+resource "test_instance" "bar" {
+  ami = "bar"
+}
+
+resource "test_instance" "foo" {
+  ami = test_instance.bar.id
+
+  lifecycle {
+    replace_triggered_by = [test_instance.bar]
+  }
+}
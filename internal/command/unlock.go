@@ -53,6 +53,12 @@ func (c *UnlockCommand) Run(rawArgs []string) int {
 		}
 		return cli.RunResultHelp
 	}
+
+	if diags := c.Meta.checkReadOnly("force-unlock"); diags.HasErrors() {
+		view.Diagnostics(diags)
+		return 1
+	}
+
 	c.Meta.variableArgs = args.Vars.All()
 
 	lockID := args.LockID
@@ -57,6 +57,12 @@ func (c *UntaintCommand) Run(rawArgs []string) int {
 		}
 		return cli.RunResultHelp
 	}
+
+	if diags := c.Meta.checkReadOnly("untaint"); diags.HasErrors() {
+		view.Diagnostics(diags)
+		return 1
+	}
+
 	c.Meta.variableArgs = args.Vars.All()
 	addr := args.TargetAddress
 
@@ -0,0 +1,497 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/command/arguments"
+	"github.com/opentofu/opentofu/internal/command/views"
+	"github.com/opentofu/opentofu/internal/communicator/shared"
+	"github.com/opentofu/opentofu/internal/configs"
+	"github.com/opentofu/opentofu/internal/configs/configschema"
+	"github.com/opentofu/opentofu/internal/lang"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+	"github.com/opentofu/opentofu/internal/tofu"
+)
+
+// UnusedCommand is a Command implementation that reports the input variables,
+// local values, output values, data sources and provider configurations that
+// nothing in the configuration refers to.
+type UnusedCommand struct {
+	Meta
+}
+
+func (c *UnusedCommand) Run(rawArgs []string) int {
+	ctx := c.CommandContext()
+
+	// Parse and apply global view arguments
+	common, rawArgs := arguments.ParseView(rawArgs)
+	c.View.Configure(common)
+
+	// Parse and validate flags
+	args, closer, diags := arguments.ParseUnused(rawArgs)
+	defer closer()
+	if diags.HasErrors() {
+		c.View.Diagnostics(diags)
+		c.View.HelpPrompt("unused")
+		return 1
+	}
+
+	view := views.NewUnused(args.ViewOptions, c.View)
+
+	dir, err := filepath.Abs(args.Path)
+	if err != nil {
+		diags = diags.Append(fmt.Errorf("unable to locate module: %w", err))
+		view.Diagnostics(diags)
+		return 1
+	}
+
+	// Inject variables from args into meta for static evaluation
+	c.Meta.variableArgs = args.Vars.All()
+
+	config, configDiags := c.loadConfig(ctx, dir)
+	diags = diags.Append(configDiags)
+	if configDiags.HasErrors() {
+		view.Diagnostics(diags)
+		return 1
+	}
+
+	// The provider schemas tell us where to find references in the bodies of
+	// resources and provider configurations.
+	schemas, schemaDiags := c.MaybeGetSchemas(ctx, nil, config)
+	diags = diags.Append(schemaDiags)
+	if schemaDiags.HasErrors() {
+		view.Diagnostics(diags)
+		return 1
+	}
+
+	modules, moreDiags := unusedDeclarations(config, schemas)
+	diags = diags.Append(moreDiags)
+
+	// Any warnings are shown before the results, so that they don't end up in
+	// the middle of the JSON output.
+	view.Diagnostics(diags)
+	if diags.HasErrors() {
+		return 1
+	}
+
+	view.Results(modules)
+	if args.Check && len(modules) != 0 {
+		return 2
+	}
+	return 0
+}
+
+// unusedDeclaration is a declaration that nothing in the configuration refers
+// to, as found by findUnused.
+type unusedDeclaration struct {
+	// Module is the address of the module containing the declaration, such as
+	// "module.network", or empty for the root module.
+	Module string
+
+	// Kind is "variable", "local", "output", "data" or "provider".
+	Kind string
+
+	// Address is the address of the declaration within its module, such as
+	// "var.region" or "provider.aws.west".
+	Address string
+
+	DeclRange hcl.Range
+}
+
+// unusedDeclarations returns the declarations in each module of the given
+// configuration that nothing refers to, grouped by module and omitting the
+// modules that have none.
+func unusedDeclarations(config *configs.Config, schemas *tofu.Schemas) ([]views.UnusedModule, tfdiags.Diagnostics) {
+	var ret []views.UnusedModule
+	decls, diags := findUnused(config, schemas)
+	for _, decl := range decls {
+		if len(ret) == 0 || ret[len(ret)-1].Path != decl.Module {
+			ret = append(ret, views.UnusedModule{Path: decl.Module})
+		}
+		mod := &ret[len(ret)-1]
+		mod.Declarations = append(mod.Declarations, views.UnusedDeclaration{
+			Address: decl.Address,
+			Kind:    decl.Kind,
+			Range:   whyRange(decl.DeclRange),
+		})
+	}
+	return ret, diags
+}
+
+// findUnused returns the declarations in the given configuration that nothing
+// refers to, ordered by module and then by position.
+//
+// Only the root module and the modules that it calls from a local path,
+// directly or through other local modules, are checked, because the others
+// can't be changed from within the configuration. The output values of the
+// root module are always used, because they're the result of the
+// configuration.
+func findUnused(config *configs.Config, schemas *tofu.Schemas) ([]unusedDeclaration, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+	refs := make(map[*configs.Config]map[string]bool)
+	references := func(cfg *configs.Config) map[string]bool {
+		if ret, ok := refs[cfg]; ok {
+			return ret
+		}
+		ret, moreDiags := moduleReferences(cfg, schemas)
+		diags = diags.Append(moreDiags)
+		refs[cfg] = ret
+		return ret
+	}
+
+	var ret []unusedDeclaration
+	for _, cfg := range config.AllModules() {
+		if !isLocalModule(cfg) {
+			continue
+		}
+		mod := cfg.Module
+		used := references(cfg)
+		path := cfg.Path.String()
+
+		add := func(kind, addr string, rng hcl.Range) {
+			ret = append(ret, unusedDeclaration{
+				Module:    path,
+				Kind:      kind,
+				Address:   addr,
+				DeclRange: rng,
+			})
+		}
+		for name, v := range mod.Variables {
+			if addr := "var." + name; !used[addr] {
+				add("variable", addr, v.DeclRange)
+			}
+		}
+		for name, l := range mod.Locals {
+			if addr := "local." + name; !used[addr] {
+				add("local", addr, l.DeclRange)
+			}
+		}
+		for _, r := range mod.DataResources {
+			if addr := r.Addr().String(); !used[addr] {
+				add("data", addr, r.DeclRange)
+			}
+		}
+		if cfg.Parent != nil {
+			callerUsed := references(cfg.Parent)
+			call := "module." + cfg.Path[len(cfg.Path)-1]
+			for name, o := range mod.Outputs {
+				if !callerUsed[call] && !callerUsed[call+"."+name] {
+					add("output", "output."+name, o.DeclRange)
+				}
+			}
+		}
+		providersUsed := usedProviderConfigs(cfg)
+		for _, pc := range mod.ProviderConfigs {
+			if addr := pc.Addr(); !providersUsed[addr] && !used[addr.String()] {
+				add("provider", addr.String(), pc.DeclRange)
+			}
+		}
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Module != ret[j].Module {
+			return ret[i].Module < ret[j].Module
+		}
+		a, b := ret[i].DeclRange, ret[j].DeclRange
+		if a.Filename != b.Filename {
+			return a.Filename < b.Filename
+		}
+		return a.Start.Byte < b.Start.Byte
+	})
+	return ret, diags
+}
+
+// usedProviderConfigs returns the provider configurations of the given module
+// that its resources use, or that it passes to the modules it calls, either
+// explicitly in the "providers" argument of a module call or implicitly to a
+// module call without one.
+func usedProviderConfigs(cfg *configs.Config) map[addrs.LocalProviderConfig]bool {
+	ret := make(map[addrs.LocalProviderConfig]bool)
+	mod := cfg.Module
+	for _, resources := range []map[string]*configs.Resource{mod.ManagedResources, mod.DataResources, mod.EphemeralResources} {
+		for _, r := range resources {
+			ret[r.ProviderConfigAddr()] = true
+		}
+	}
+	for _, check := range mod.Checks {
+		if check.DataResource != nil {
+			ret[check.DataResource.ProviderConfigAddr()] = true
+		}
+	}
+	for _, i := range mod.Import {
+		if i.ProviderConfigRef != nil {
+			ret[i.ProviderConfigRef.Addr()] = true
+		}
+	}
+	for name, mc := range mod.ModuleCalls {
+		for _, passed := range mc.Providers {
+			ret[passed.InParent.Addr()] = true
+		}
+		child, ok := cfg.Children[name]
+		if !ok || len(mc.Providers) != 0 {
+			continue
+		}
+		// A module call without a "providers" argument inherits the default
+		// configurations of the providers that the child module uses, unless
+		// the child module configures them itself.
+		for addr := range usedProviderConfigs(child) {
+			if addr.Alias != "" {
+				continue
+			}
+			if _, ok := child.Module.ProviderConfigs[addr.StringCompact()]; ok {
+				continue
+			}
+			provider := child.Module.ProviderForLocalConfig(addr)
+			ret[addrs.LocalProviderConfig{LocalName: mod.LocalNameForProvider(provider)}] = true
+		}
+	}
+	return ret
+}
+
+// isLocalModule returns true if the given module is the root module, or is
+// called from a local path by a module that is itself local.
+func isLocalModule(cfg *configs.Config) bool {
+	for ; cfg.Parent != nil; cfg = cfg.Parent {
+		if _, ok := cfg.SourceAddr.(addrs.ModuleSourceLocal); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// moduleReferences returns the references made anywhere in the configuration
+// of the given module, as the set of "var.NAME", "local.NAME",
+// "data.TYPE.NAME", "module.CALL" and "module.CALL.OUTPUT" prefixes of the
+// references. A reference to a whole module call, rather than to one of its
+// output values, only produces "module.CALL". A call to a provider-defined
+// function produces the address of the provider configuration that it uses,
+// such as "provider.aws" or "provider.aws.west".
+//
+// The references are found in the decoded module in the same way as when
+// building the graph, so the bodies of resources and provider configurations
+// are analyzed using the schemas of their providers. The validation rules of
+// an input variable referring to the variable itself don't count as using it.
+func moduleReferences(cfg *configs.Config, schemas *tofu.Schemas) (map[string]bool, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+	ret := make(map[string]bool)
+	add := func(refs []*addrs.Reference, _ tfdiags.Diagnostics) {
+		for _, ref := range refs {
+			if key := referenceKey(ref); key != "" {
+				ret[key] = true
+			}
+		}
+	}
+	addCheckRules := func(rules []*configs.CheckRule) {
+		for _, rule := range rules {
+			add(lang.ReferencesInExpr(addrs.ParseRef, rule.Condition))
+			add(lang.ReferencesInExpr(addrs.ParseRef, rule.ErrorMessage))
+		}
+	}
+	mod := cfg.Module
+
+	for name, v := range mod.Variables {
+		self := addrs.InputVariable{Name: name}.String()
+		for _, rule := range v.Validations {
+			for _, expr := range []hcl.Expression{rule.Condition, rule.ErrorMessage} {
+				refs, _ := lang.ReferencesInExpr(addrs.ParseRef, expr)
+				for _, ref := range refs {
+					if key := referenceKey(ref); key != "" && key != self {
+						ret[key] = true
+					}
+				}
+			}
+		}
+	}
+	for _, l := range mod.Locals {
+		add(lang.ReferencesInExpr(addrs.ParseRef, l.Expr))
+	}
+	for _, o := range mod.Outputs {
+		add(lang.ReferencesInExpr(addrs.ParseRef, o.Expr))
+		add(lang.References(addrs.ParseRef, o.DependsOn))
+		addCheckRules(o.Preconditions)
+	}
+
+	for name, mc := range mod.ModuleCalls {
+		add(lang.ReferencesInExpr(addrs.ParseRef, mc.Source))
+		add(lang.ReferencesInExpr(addrs.ParseRef, mc.Count))
+		add(lang.ReferencesInExpr(addrs.ParseRef, mc.ForEach))
+		add(lang.ReferencesInExpr(addrs.ParseRef, mc.ForEachKey))
+		add(lang.ReferencesInExpr(addrs.ParseRef, mc.Enabled))
+		add(lang.References(addrs.ParseRef, mc.DependsOn))
+		for _, passed := range mc.Providers {
+			add(lang.ReferencesInExpr(addrs.ParseRef, passed.InParent.KeyExpression))
+		}
+		// The arguments of a module call are the input variables of the
+		// child module.
+		if child, ok := cfg.Children[name]; ok {
+			schema := &configschema.Block{Attributes: make(map[string]*configschema.Attribute)}
+			for varName := range child.Module.Variables {
+				schema.Attributes[varName] = &configschema.Attribute{Type: cty.DynamicPseudoType, Optional: true}
+			}
+			add(lang.ReferencesInBlock(addrs.ParseRef, mc.Config, schema))
+		}
+	}
+
+	var resources []*configs.Resource
+	for _, rs := range []map[string]*configs.Resource{mod.ManagedResources, mod.DataResources, mod.EphemeralResources} {
+		for _, r := range rs {
+			resources = append(resources, r)
+		}
+	}
+	for _, check := range mod.Checks {
+		addCheckRules(check.Asserts)
+		if check.DataResource != nil {
+			resources = append(resources, check.DataResource)
+		}
+	}
+	for _, r := range resources {
+		add(lang.ReferencesInExpr(addrs.ParseRef, r.Count))
+		add(lang.ReferencesInExpr(addrs.ParseRef, r.ForEach))
+		add(lang.ReferencesInExpr(addrs.ParseRef, r.ForEachKey))
+		add(lang.ReferencesInExpr(addrs.ParseRef, r.Enabled))
+		add(lang.References(addrs.ParseRef, r.DependsOn))
+		if r.ProviderConfigRef != nil {
+			add(lang.ReferencesInExpr(addrs.ParseRef, r.ProviderConfigRef.KeyExpression))
+		}
+		for _, expr := range r.TriggersReplacement {
+			add(lang.ReferencesInExpr(addrs.ParseRef, expr))
+		}
+		addCheckRules(r.Preconditions)
+		addCheckRules(r.Postconditions)
+
+		schema, _ := schemas.ResourceTypeConfig(r.Provider, r.Mode, r.Type)
+		if schema == nil || schema.Block == nil {
+			diags = diags.Append(&hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Missing resource type schema",
+				Detail:   fmt.Sprintf("The provider %s did not include a schema for resource type %q, so the references in its configuration can't be found.", r.Provider, r.Type),
+				Subject:  r.TypeRange.Ptr(),
+			})
+			continue
+		}
+		add(lang.ReferencesInBlock(addrs.ParseRef, r.Config, schema.Block))
+
+		if r.Managed == nil {
+			continue
+		}
+		add(lang.ReferencesInExpr(addrs.ParseRef, r.Managed.PreventDestroy))
+		if r.Managed.Connection != nil {
+			add(lang.ReferencesInBlock(addrs.ParseRef, r.Managed.Connection.Config, shared.ConnectionBlockSupersetSchema))
+		}
+		for _, p := range r.Managed.Provisioners {
+			if p.Connection != nil {
+				add(lang.ReferencesInBlock(addrs.ParseRef, p.Connection.Config, shared.ConnectionBlockSupersetSchema))
+			}
+			if schema := schemas.ProvisionerConfig(p.Type); schema != nil {
+				add(lang.ReferencesInBlock(addrs.ParseRef, p.Config, schema))
+			}
+		}
+	}
+
+	for _, i := range mod.Import {
+		add(lang.ReferencesInExpr(addrs.ParseRef, i.ID))
+		add(lang.ReferencesInExpr(addrs.ParseRef, i.Identity))
+		add(lang.ReferencesInExpr(addrs.ParseRef, i.To))
+		add(lang.ReferencesInExpr(addrs.ParseRef, i.ForEach))
+		if i.ProviderConfigRef != nil {
+			add(lang.ReferencesInExpr(addrs.ParseRef, i.ProviderConfigRef.KeyExpression))
+		}
+	}
+
+	for _, pc := range mod.ProviderConfigs {
+		add(lang.ReferencesInExpr(addrs.ParseRef, pc.ForEach))
+		if schema := schemas.ProviderConfig(mod.ProviderForLocalConfig(pc.Addr())); schema != nil {
+			add(lang.ReferencesInBlock(addrs.ParseRef, pc.Config, schema))
+		}
+	}
+
+	return ret, diags
+}
+
+// referenceKey returns the key that moduleReferences records for the given
+// reference, or an empty string if it isn't a reference to an input variable,
+// local value, data source, module call or provider-defined function.
+func referenceKey(ref *addrs.Reference) string {
+	switch subject := ref.Subject.(type) {
+	case addrs.InputVariable:
+		return subject.String()
+	case addrs.LocalValue:
+		return subject.String()
+	case addrs.Resource:
+		if subject.Mode == addrs.DataResourceMode {
+			return subject.String()
+		}
+	case addrs.ResourceInstance:
+		if subject.Resource.Mode == addrs.DataResourceMode {
+			return subject.Resource.String()
+		}
+	case addrs.ModuleCall:
+		return subject.String()
+	case addrs.ModuleCallInstance:
+		return subject.Call.String()
+	case addrs.ModuleCallInstanceOutput:
+		return subject.Call.Call.String() + "." + subject.Name
+	case addrs.ProviderFunction:
+		return addrs.LocalProviderConfig{LocalName: subject.ProviderName, Alias: subject.ProviderAlias}.String()
+	}
+	return ""
+}
+
+func (c *UnusedCommand) Help() string {
+	helpText := `
+Usage: tofu [global options] unused [options] [DIR]
+
+  Reports the input variables, local values, output values, data sources and
+  provider configurations that nothing in the configuration refers to,
+  grouped by module.
+
+  The command checks the root module in the given directory, or the current
+  directory, and the modules that it calls from a local path. Output values
+  of the root module are always used, and output values of other modules are
+  used if the module calling them refers to them. Modules and providers must
+  be installed with "tofu init" first.
+
+Options:
+
+  -check               Exit with status 2 if there are any unused
+                       declarations, for use as a check in automation.
+
+  -no-color            If specified, output won't contain any color.
+
+  -json                Produce output in a machine-readable JSON format,
+                       suitable for use in automated systems.
+
+  -json-into=out.json  Produce the same output as -json, but sent directly
+                       to the given file. This allows automation to preserve
+                       the original human-readable output streams, while
+                       capturing more detailed logs for machine analysis.
+
+  -var 'foo=bar'       Set a value for one of the input variables in the root
+                       module of the configuration, for use in the module
+                       sources and other arguments that OpenTofu evaluates
+                       while loading the configuration. Use this option more
+                       than once to set more than one variable.
+
+  -var-file=filename   Load variable values from the given file, in addition
+                       to the default files terraform.tfvars and *.auto.tfvars.
+                       Use this option more than once to include more than one
+                       variables file.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *UnusedCommand) Synopsis() string {
+	return "Report unused variables, locals, outputs and data sources"
+}
@@ -0,0 +1,223 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/opentofu/opentofu/internal/command/workdir"
+	"github.com/opentofu/opentofu/internal/configs/configschema"
+	"github.com/opentofu/opentofu/internal/providers"
+	"github.com/opentofu/opentofu/internal/tofu"
+)
+
+// unusedTestProvider returns a mock provider with the schemas that the
+// "unused" fixtures need, so that the references in the configuration of
+// their resources and provider configurations can be found.
+func unusedTestProvider() *tofu.MockProvider {
+	p := validateTestProvider()
+	p.GetProviderSchemaResponse.Provider = providers.Schema{
+		Block: &configschema.Block{
+			Attributes: map[string]*configschema.Attribute{
+				"value": {Type: cty.String, Optional: true},
+			},
+		},
+	}
+	p.GetProviderSchemaResponse.DataSources = map[string]providers.Schema{
+		"test_data_source": {
+			Block: &configschema.Block{
+				Attributes: map[string]*configschema.Attribute{
+					"id": {Type: cty.String, Optional: true},
+				},
+			},
+		},
+	}
+	return p
+}
+
+func TestUnused(t *testing.T) {
+	td := t.TempDir()
+	testCopyDir(t, testFixturePath("unused"), td)
+	t.Chdir(td)
+
+	view, done := testView(t)
+	c := &UnusedCommand{
+		Meta: Meta{
+			WorkingDir:       workdir.NewDir("."),
+			testingOverrides: metaOverridesForProvider(unusedTestProvider()),
+			View:             view,
+		},
+	}
+
+	code := c.Run([]string{"-no-color"})
+	output := done(t)
+	if code != 0 {
+		t.Fatalf("unexpected exit status %d; want 0\ngot: %s", code, output.Stderr())
+	}
+
+	want := `Root module
+  var.unused                    main.tf:5
+  local.unused                  main.tf:16
+  data.test_data_source.unused  main.tf:23
+  provider.test.unused          main.tf:44
+
+module.child
+  var.unused     modules/child/main.tf:5
+  output.unused  modules/child/main.tf:14
+
+Found 6 unused declarations that nothing in the configuration refers to.
+`
+	if diff := cmp.Diff(want, output.Stdout()); diff != "" {
+		t.Errorf("wrong output\n%s", diff)
+	}
+}
+
+func TestUnused_json(t *testing.T) {
+	td := t.TempDir()
+	testCopyDir(t, testFixturePath("unused"), td)
+	t.Chdir(td)
+
+	view, done := testView(t)
+	c := &UnusedCommand{
+		Meta: Meta{
+			WorkingDir:       workdir.NewDir("."),
+			testingOverrides: metaOverridesForProvider(unusedTestProvider()),
+			View:             view,
+		},
+	}
+
+	code := c.Run([]string{"-json", "-check"})
+	output := done(t)
+	if code != 2 {
+		t.Fatalf("unexpected exit status %d; want 2\ngot: %s", code, output.Stderr())
+	}
+
+	var got struct {
+		UnusedCount int `json:"unused_count"`
+		Modules     []struct {
+			Path         string `json:"path"`
+			Declarations []struct {
+				Address string `json:"address"`
+				Kind    string `json:"kind"`
+			} `json:"declarations"`
+		} `json:"modules"`
+	}
+	if err := json.Unmarshal([]byte(output.Stdout()), &got); err != nil {
+		t.Fatalf("invalid JSON output: %s\n%s", err, output.Stdout())
+	}
+	if got.UnusedCount != 6 {
+		t.Errorf("wrong unused_count %d; want 6", got.UnusedCount)
+	}
+
+	var gotStrs []string
+	for _, mod := range got.Modules {
+		for _, decl := range mod.Declarations {
+			gotStrs = append(gotStrs, strings.TrimPrefix(mod.Path+" ", " ")+decl.Kind+" "+decl.Address)
+		}
+	}
+	want := []string{
+		"variable var.unused",
+		"local local.unused",
+		"data data.test_data_source.unused",
+		"provider provider.test.unused",
+		"module.child variable var.unused",
+		"module.child output output.unused",
+	}
+	if diff := cmp.Diff(want, gotStrs); diff != "" {
+		t.Errorf("wrong declarations\n%s", diff)
+	}
+}
+
+func TestUnused_providers(t *testing.T) {
+	td := t.TempDir()
+	testCopyDir(t, testFixturePath("unused-providers"), td)
+	t.Chdir(td)
+
+	view, done := testView(t)
+	c := &UnusedCommand{
+		Meta: Meta{
+			WorkingDir:       workdir.NewDir("."),
+			testingOverrides: metaOverridesForProvider(unusedTestProvider()),
+			View:             view,
+		},
+	}
+
+	code := c.Run([]string{"-no-color"})
+	output := done(t)
+	if code != 0 {
+		t.Fatalf("unexpected exit status %d; want 0\ngot: %s", code, output.Stderr())
+	}
+
+	want := `Root module
+  provider.test.unused  main.tf:15
+
+module.explicit
+  provider.test.unused  modules/explicit/main.tf:1
+
+Found 2 unused declarations that nothing in the configuration refers to.
+`
+	if diff := cmp.Diff(want, output.Stdout()); diff != "" {
+		t.Errorf("wrong output\n%s", diff)
+	}
+}
+
+func TestUnused_noneUnused(t *testing.T) {
+	td := t.TempDir()
+	testCopyDir(t, testFixturePath("unused-none"), td)
+	t.Chdir(td)
+
+	view, done := testView(t)
+	c := &UnusedCommand{
+		Meta: Meta{
+			WorkingDir:       workdir.NewDir("."),
+			testingOverrides: metaOverridesForProvider(unusedTestProvider()),
+			View:             view,
+		},
+	}
+
+	code := c.Run([]string{"-check", "-no-color"})
+	output := done(t)
+	if code != 0 {
+		t.Fatalf("unexpected exit status %d; want 0\ngot: %s\n%s", code, output.Stdout(), output.Stderr())
+	}
+	if got, want := output.Stdout(), "Success! Every variable, local value, output value, data source and provider configuration is used.\n"; got != want {
+		t.Errorf("wrong output %q; want %q", got, want)
+	}
+}
+
+func TestUnused_missingSchema(t *testing.T) {
+	td := t.TempDir()
+	testCopyDir(t, testFixturePath("unused-none"), td)
+	t.Chdir(td)
+
+	// The references in a resource can't be found without its schema, so
+	// the command fails rather than reporting what it refers to as unused.
+	view, done := testView(t)
+	c := &UnusedCommand{
+		Meta: Meta{
+			WorkingDir:       workdir.NewDir("."),
+			testingOverrides: metaOverridesForProvider(testProvider()),
+			View:             view,
+		},
+	}
+
+	code := c.Run([]string{"-no-color"})
+	output := done(t)
+	if code != 1 {
+		t.Fatalf("unexpected exit status %d; want 1\n%s", code, output.All())
+	}
+	if got, want := output.Stderr(), "Missing resource type schema"; !strings.Contains(got, want) {
+		t.Errorf("missing error %q\ngot: %s", want, got)
+	}
+	if got := output.Stdout(); got != "" {
+		t.Errorf("unexpected results\n%s", got)
+	}
+}
@@ -8,10 +8,16 @@ package command
 import (
 	"context"
 	"fmt"
+	"io/fs"
 	"path/filepath"
 	"strings"
 
+	version "github.com/hashicorp/go-version"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/zclconf/go-cty/cty"
+
 	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/backend"
 	"github.com/opentofu/opentofu/internal/command/arguments"
 	"github.com/opentofu/opentofu/internal/command/views"
 	"github.com/opentofu/opentofu/internal/configs"
@@ -24,6 +30,44 @@ type ValidateCommand struct {
 	Meta
 }
 
+// validateOptions are the options for validating a module, from the
+// arguments of the validate command.
+type validateOptions struct {
+	// TestDirectory and NoTests select the test files to validate alongside
+	// the configuration.
+	TestDirectory string
+	NoTests       bool
+
+	// Strict also validates the provider configurations that nothing uses.
+	// This is also set for CheckUnused, so that the provider configurations
+	// it reports as unused are checked too.
+	Strict bool
+
+	// CheckVars checks the input variable values given on the command line
+	// and in variable definitions files against the declarations of the
+	// root module variables, which are otherwise unknown.
+	CheckVars bool
+
+	// CheckUnused also reports the declarations that nothing in the
+	// configuration refers to.
+	CheckUnused bool
+
+	// Contract is the path of a module contract file that the module must
+	// implement, if any.
+	Contract string
+}
+
+func newValidateOptions(args *arguments.Validate) validateOptions {
+	return validateOptions{
+		TestDirectory: args.TestDirectory,
+		NoTests:       args.NoTests,
+		Strict:        args.Strict || args.CheckUnused,
+		CheckVars:     !args.Vars.Empty(),
+		CheckUnused:   args.CheckUnused,
+		Contract:      args.Contract,
+	}
+}
+
 func (c *ValidateCommand) Run(rawArgs []string) int {
 	ctx := c.CommandContext()
 
@@ -40,7 +84,7 @@ func (c *ValidateCommand) Run(rawArgs []string) int {
 		return 1
 	}
 
-	view := views.NewValidate(args.ViewOptions, c.View)
+	view := views.NewValidate(args, c.View)
 
 	// After this point, we must only produce JSON output if JSON mode is
 	// enabled, so all errors should be accumulated into diags and we'll
@@ -63,7 +107,22 @@ func (c *ValidateCommand) Run(rawArgs []string) int {
 	// Inject variables from args into meta for static evaluation
 	c.Meta.variableArgs = args.Vars.All()
 
-	validateDiags := c.validate(ctx, dir, args.TestDirectory, args.NoTests)
+	opts := newValidateOptions(args)
+
+	if args.Recursive {
+		modules, moreDiags := c.validateRecursive(ctx, dir, opts)
+		diags = diags.Append(moreDiags)
+		diags = diags.Append(c.providerDevOverrideRuntimeWarnings())
+		if args.WarningsAsErrors {
+			for i := range modules {
+				modules[i].Diagnostics = warningsAsErrors(modules[i].Diagnostics)
+			}
+			diags = warningsAsErrors(diags)
+		}
+		return view.RecursiveResults(modules, diags)
+	}
+
+	validateDiags := c.validate(ctx, dir, opts)
 	diags = diags.Append(validateDiags)
 
 	// Validating with dev overrides in effect means that the result might
@@ -72,43 +131,116 @@ func (c *ValidateCommand) Run(rawArgs []string) int {
 	// check before submitting a change.
 	diags = diags.Append(c.providerDevOverrideRuntimeWarnings())
 
+	if args.WarningsAsErrors {
+		diags = warningsAsErrors(diags)
+	}
+
 	return view.Results(diags)
 }
 
-func (c *ValidateCommand) validate(ctx context.Context, dir, testDir string, noTests bool) tfdiags.Diagnostics {
+// warningsAsErrors returns the given diagnostics with each warning changed
+// into an error, for the -warnings-as-errors option.
+func warningsAsErrors(diags tfdiags.Diagnostics) tfdiags.Diagnostics {
+	var ret tfdiags.Diagnostics
+	for _, diag := range diags {
+		if diag.Severity() == tfdiags.Warning {
+			diag = tfdiags.Override(diag, tfdiags.Error, nil)
+		}
+		ret = ret.Append(diag)
+	}
+	return ret
+}
+
+// validate loads and validates the configuration in the given directory.
+func (c *ValidateCommand) validate(ctx context.Context, dir string, opts validateOptions) tfdiags.Diagnostics {
 	var diags tfdiags.Diagnostics
 	var cfg *configs.Config
 
-	if noTests {
+	if opts.NoTests {
 		cfg, diags = c.loadConfig(ctx, dir)
 	} else {
-		cfg, diags = c.loadConfigWithTests(ctx, dir, testDir)
+		cfg, diags = c.loadConfigWithTests(ctx, dir, opts.TestDirectory)
 	}
 	if diags.HasErrors() {
 		return diags
 	}
 
-	validate := func(cfg *configs.Config) tfdiags.Diagnostics {
+	validateOpts := &tofu.ValidateOpts{Strict: opts.Strict}
+	if opts.CheckVars {
+		var varDiags tfdiags.Diagnostics
+		validateOpts.SetVariables, varDiags = c.validateVariableValues(cfg.Module.Variables)
+		diags = diags.Append(varDiags)
+		if varDiags.HasErrors() {
+			return diags
+		}
+	}
+
+	return diags.Append(c.validateConfig(ctx, cfg, opts, validateOpts))
+}
+
+// validateVariableValues returns the values for the given root module input
+// variables from the command line, variable definitions files and environment
+// variables, in the same way as for a plan.
+//
+// Unlike for a plan, a required variable without a value isn't an error: the
+// variable is unknown during validation instead.
+func (c *ValidateCommand) validateVariableValues(decls map[string]*configs.Variable) (tofu.InputValues, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	raw, moreDiags := c.collectVariableValues()
+	diags = diags.Append(moreDiags)
+	if moreDiags.HasErrors() {
+		return nil, diags
+	}
+
+	values, moreDiags := backend.ParseDeclaredVariableValues(raw, decls)
+	diags = diags.Append(moreDiags)
+	_, moreDiags = backend.ParseUndeclaredVariableValues(raw, decls)
+	diags = diags.Append(moreDiags)
+	return values, diags
+}
+
+// validateConfig validates the given configuration, and any test files loaded
+// into its root module. The input variable values in validateOpts are only
+// used for the configuration itself, not for the modules under test.
+func (c *ValidateCommand) validateConfig(ctx context.Context, cfg *configs.Config, opts validateOptions, validateOpts *tofu.ValidateOpts) tfdiags.Diagnostics {
+	var diags tfdiags.Diagnostics
+
+	validate := func(cfg *configs.Config, opts *tofu.ValidateOpts) tfdiags.Diagnostics {
 		var diags tfdiags.Diagnostics
 
-		opts, err := c.contextOpts(ctx)
+		ctxOpts, err := c.contextOpts(ctx)
 		if err != nil {
 			diags = diags.Append(err)
 			return diags
 		}
 
-		tfCtx, ctxDiags := tofu.NewContext(opts)
+		tfCtx, ctxDiags := tofu.NewContext(ctxOpts)
 		diags = diags.Append(ctxDiags)
 		if ctxDiags.HasErrors() {
 			return diags
 		}
 
-		return diags.Append(tfCtx.Validate(ctx, cfg))
+		return diags.Append(tfCtx.ValidateWithOpts(ctx, cfg, opts))
+	}
+
+	diags = diags.Append(validate(cfg, validateOpts))
+
+	// Lint rules only run for a valid configuration, because the problems
+	// that OpenTofu reports itself must be fixed first anyway.
+	if len(c.LintRules) != 0 && !diags.HasErrors() {
+		diags = diags.Append(c.lintConfig(ctx, cfg))
+	}
+
+	if opts.Contract != "" {
+		diags = diags.Append(c.checkContract(opts.Contract, cfg.Module))
 	}
 
-	diags = diags.Append(validate(cfg))
+	if opts.CheckUnused {
+		diags = diags.Append(c.checkUnusedDeclarations(ctx, cfg))
+	}
 
-	if noTests {
+	if opts.NoTests {
 		return diags
 	}
 
@@ -138,7 +270,7 @@ func (c *ValidateCommand) validate(ctx context.Context, dir, testDir string, noT
 						// not validate the same thing multiple times.
 
 						validatedModules[run.Module.Source.String()] = true
-						diags = diags.Append(validate(run.ConfigUnderTest))
+						diags = diags.Append(validate(run.ConfigUnderTest, &tofu.ValidateOpts{Strict: validateOpts.Strict}))
 					}
 
 				}
@@ -151,6 +283,207 @@ func (c *ValidateCommand) validate(ctx context.Context, dir, testDir string, noT
 	return diags
 }
 
+// validateRecursive validates the module in the given directory, if any, and
+// the module in each of its subdirectories, returning the diagnostics for
+// each module separately.
+//
+// The module in the given directory is validated in the same way as without
+// -recursive. Each of the others is validated standalone, because it might
+// not be called from anywhere yet: see validateStandalone.
+func (c *ValidateCommand) validateRecursive(ctx context.Context, root string, opts validateOptions) ([]views.ValidateModuleResult, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	loader, err := c.initConfigLoader()
+	if err != nil {
+		diags = diags.Append(err)
+		return nil, diags
+	}
+
+	var modules []views.ValidateModuleResult
+	err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		if path != root && configs.IsIgnoredFile(d.Name()) {
+			// This also skips the .terraform directory, which contains the
+			// installed copies of remote modules.
+			return filepath.SkipDir
+		}
+		if !loader.Parser().IsConfigDir(path) {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		var moduleDiags tfdiags.Diagnostics
+		if path == root {
+			moduleDiags = c.validate(ctx, path, opts)
+		} else {
+			moduleDiags = c.validateStandalone(ctx, path, opts)
+		}
+		modules = append(modules, views.ValidateModuleResult{
+			Path:        filepath.ToSlash(rel),
+			Diagnostics: moduleDiags,
+		})
+		return nil
+	})
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to search for modules",
+			fmt.Sprintf("OpenTofu could not search %s for modules: %s", root, err),
+		))
+		return modules, diags
+	}
+
+	if len(modules) == 0 {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"No modules found",
+			fmt.Sprintf("There are no OpenTofu configuration files in %s or any of its subdirectories.", root),
+		))
+	}
+	return modules, diags
+}
+
+// validateStandalone validates the module in the given directory as if it were
+// the root module of its own configuration, without the modules installed in
+// the working directory.
+//
+// Input variables without a default value are unknown, so that the module is
+// valid for all possible values, and only the modules that it calls from a
+// local path can be loaded.
+func (c *ValidateCommand) validateStandalone(ctx context.Context, dir string, opts validateOptions) tfdiags.Diagnostics {
+	var diags tfdiags.Diagnostics
+	dir = c.WorkingDir.NormalizePath(dir)
+
+	loader, err := c.initConfigLoader()
+	if err != nil {
+		diags = diags.Append(err)
+		return diags
+	}
+	parser := loader.Parser()
+
+	call := configs.NewStaticModuleCall(addrs.RootModule, hcl.Range{}, func(v *configs.Variable) (cty.Value, hcl.Diagnostics) {
+		if v.Default != cty.NilVal {
+			return v.Default, nil
+		}
+		return cty.UnknownVal(v.Type), nil
+	}, dir, "").WithDisabledFunctions(c.DisabledFunctions)
+
+	var mod *configs.Module
+	var hclDiags hcl.Diagnostics
+	if opts.NoTests {
+		mod, hclDiags = parser.LoadConfigDir(dir, call)
+	} else {
+		mod, hclDiags = parser.LoadConfigDirWithTests(dir, opts.TestDirectory, call)
+	}
+	diags = diags.Append(hclDiags)
+	if mod == nil || diags.HasErrors() {
+		return diags
+	}
+
+	walker := configs.ModuleWalkerFunc(func(ctx context.Context, req *configs.ModuleRequest) (*configs.Module, *version.Version, hcl.Diagnostics) {
+		local, ok := req.SourceAddr.(addrs.ModuleSourceLocal)
+		if !ok {
+			return nil, nil, hcl.Diagnostics{
+				{
+					Severity: hcl.DiagError,
+					Summary:  "Module not installed",
+					Detail:   fmt.Sprintf("OpenTofu validates the modules in subdirectories standalone, without the modules installed in the working directory, so it can only load the modules that they call from a local path. To validate this module with module %q installed, run \"tofu init\" and \"tofu validate\" in its directory instead.", req.Name),
+					Subject:  req.CallRange.Ptr(),
+				},
+			}
+		}
+
+		dir := filepath.Join(req.Parent.Module.SourceDir, string(local))
+		mod, diags := parser.LoadConfigDir(dir, req.Call)
+		if mod == nil {
+			// nil indicates a missing or unreadable directory, so we'll
+			// return a more specific error message here.
+			return nil, nil, hcl.Diagnostics{
+				{
+					Severity: hcl.DiagError,
+					Summary:  "Unreadable module directory",
+					Detail:   fmt.Sprintf("The directory %s could not be read for module %q.", dir, req.Name),
+					Subject:  req.CallRange.Ptr(),
+				},
+			}
+		}
+		return mod, nil, diags
+	})
+	cfg, hclDiags := configs.BuildConfig(ctx, mod, walker)
+	diags = diags.Append(hclDiags)
+	if diags.HasErrors() {
+		return diags
+	}
+
+	return diags.Append(c.validateConfig(ctx, cfg, opts, &tofu.ValidateOpts{Strict: opts.Strict}))
+}
+
+// checkContract loads the module contract at the given path and checks that
+// the given module implements it.
+func (c *ValidateCommand) checkContract(path string, mod *configs.Module) tfdiags.Diagnostics {
+	var diags tfdiags.Diagnostics
+
+	loader, err := c.initConfigLoader()
+	if err != nil {
+		diags = diags.Append(err)
+		return diags
+	}
+
+	contract, hclDiags := loader.Parser().LoadModuleContractFile(path)
+	diags = diags.Append(hclDiags)
+	if hclDiags.HasErrors() {
+		return diags
+	}
+
+	return diags.Append(contract.Check(mod))
+}
+
+// unusedDeclarationSummaries are the summaries of the warnings that
+// checkUnusedDeclarations returns for each kind of declaration.
+var unusedDeclarationSummaries = map[string]string{
+	"variable": "Unused input variable",
+	"local":    "Unused local value",
+	"output":   "Unused output value",
+	"data":     "Unused data source",
+	"provider": "Unused provider configuration",
+}
+
+// checkUnusedDeclarations returns a warning for each declaration in the given
+// configuration that nothing refers to, as for the "tofu unused" command.
+func (c *ValidateCommand) checkUnusedDeclarations(ctx context.Context, cfg *configs.Config) tfdiags.Diagnostics {
+	var diags tfdiags.Diagnostics
+
+	schemas, schemaDiags := c.MaybeGetSchemas(ctx, nil, cfg)
+	diags = diags.Append(schemaDiags)
+	if schemaDiags.HasErrors() {
+		return diags
+	}
+
+	decls, moreDiags := findUnused(cfg, schemas)
+	diags = diags.Append(moreDiags)
+	for _, decl := range decls {
+		addr := decl.Address
+		if decl.Module != "" {
+			addr = fmt.Sprintf("%s in %s", addr, decl.Module)
+		}
+		diags = diags.Append(&hcl.Diagnostic{
+			Severity: hcl.DiagWarning,
+			Summary:  unusedDeclarationSummaries[decl.Kind],
+			Detail:   fmt.Sprintf("Nothing in the configuration refers to %s, so it can be removed.", addr),
+			Subject:  decl.DeclRange.Ptr(),
+		})
+	}
+	return diags
+}
+
 func (c *ValidateCommand) Synopsis() string {
 	return "Check whether the configuration is valid"
 }
@@ -168,6 +501,13 @@ Usage: tofu [global options] validate [options]
   existing state. It is thus primarily useful for general verification of
   reusable modules, including correctness of attribute names and value types.
 
+  If any input variable values are set with -var or -var-file, validate also
+  checks them, and the values in the default variable definitions files,
+  against the type constraints and validation rules of the variables.
+
+  If the CLI configuration has any lint_rule blocks, validate also runs those
+  external commands to check the configuration against additional rules.
+
   It is safe to run this command automatically, for example as a post-save
   check in a text editor or as a test step for a re-usable module in a CI
   system.
@@ -183,6 +523,11 @@ Usage: tofu [global options] validate [options]
 
 Options:
 
+  -check-unused         Also report the input variables, local values, output
+                        values, data sources and provider configurations that
+                        nothing in the configuration refers to, as warnings.
+                        This implies -strict.
+
   -compact-warnings     If OpenTofu produces any warnings that are not
                         accompanied by errors, show them in a more compact
                         form that includes only the summary messages.
@@ -195,6 +540,21 @@ Options:
                         will be performed. All locations, for all errors
                         will be listed. Disabled by default
 
+  -contract=path        Also check that the module implements the module
+                        contract in the given file, which lists the input
+                        variables and output values that the module must
+                        declare.
+
+  -fix-suggestions      Include machine-applicable fix suggestions in the
+                        JSON output for diagnostics that have an unambiguous
+                        fix, such as a misspelled argument name. Requires
+                        -json or -json-into.
+
+  -format=sarif         Produce the machine-readable output in the Static
+                        Analysis Results Interchange Format (SARIF) 2.1.0
+                        instead, for code scanning tools such as GitHub code
+                        scanning. Requires -json or -json-into.
+
   -json                 Produce output in a machine-readable JSON format, 
                         suitable for use in text editor integrations and other 
                         automated systems. Always disables color.
@@ -208,18 +568,37 @@ Options:
 
   -no-tests             If specified, OpenTofu will not validate test files.
 
+  -recursive            Also validate the module in each subdirectory, including
+                        modules that no configuration calls yet, and group the
+                        results by module. The modules in subdirectories are
+                        validated standalone, with unknown values for their
+                        required input variables, and can only call other
+                        modules from a local path.
+
+  -strict               Also validate provider configuration blocks that
+                        nothing in the configuration uses, to catch mistakes
+                        such as misspelled argument names before anything
+                        starts to use them. This doesn't add any checks for
+                        resource blocks.
+
   -test-directory=path  Set the OpenTofu test directory, defaults to "tests". When set, the
                         test command will search for test files in the current directory and
                         in the one specified by the flag.
 
   -var 'foo=bar'        Set a value for one of the input variables in the root
-                        module of the configuration. Use this option more than
-                        once to set more than one variable.
+                        module of the configuration, and check the values of
+                        the input variables against their declarations. Use
+                        this option more than once to set more than one
+                        variable.
 
   -var-file=filename    Load variable values from the given file, in addition
                         to the default files terraform.tfvars and *.auto.tfvars.
                         Use this option more than once to include more than one
                         variables file.
+
+  -warnings-as-errors   Report any warnings as errors, so that validation
+                        fails if there are any warnings, such as for use of
+                        deprecated arguments.
 `
 	return strings.TrimSpace(helpText)
 }
@@ -0,0 +1,306 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/command/jsonconfig"
+	"github.com/opentofu/opentofu/internal/configs"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+	"github.com/opentofu/opentofu/internal/tofu"
+)
+
+// LintRule describes an external command that checks a configuration against
+// additional rules during "tofu validate", as configured by a "lint_rule"
+// block in the CLI configuration.
+type LintRule struct {
+	Name    string
+	Command string
+	Args    []string
+
+	// Timeout is the maximum time to wait for the command to exit, after
+	// which the rule is reported as failed.
+	Timeout time.Duration
+}
+
+// lintRuleWaitDelay is how long we wait for the output of a lint rule to be
+// closed after the command itself has exited or been killed, in case it
+// started child processes that are still holding it open.
+const lintRuleWaitDelay = 5 * time.Second
+
+// lintRuleOutputLimit is the maximum number of bytes of the error output of
+// a lint rule that we include in the diagnostic explaining why it failed.
+const lintRuleOutputLimit = 4096
+
+// lintRuleInput is the JSON document that a lint rule receives on its
+// standard input.
+type lintRuleInput struct {
+	FormatVersion string `json:"format_version"`
+
+	// ConfigDir is the directory containing the root module of the
+	// configuration, for rules that need to read the files directly.
+	ConfigDir string `json:"config_dir"`
+
+	// Configuration is the same representation of the configuration as in
+	// the output of "tofu show -json".
+	Configuration json.RawMessage `json:"configuration"`
+}
+
+// lintRuleOutput is the JSON document that a lint rule must write to its
+// standard output.
+type lintRuleOutput struct {
+	Diagnostics []lintRuleDiagnostic `json:"diagnostics"`
+}
+
+type lintRuleDiagnostic struct {
+	// Severity is either "error" or "warning".
+	Severity string `json:"severity"`
+	Summary  string `json:"summary"`
+	Detail   string `json:"detail"`
+
+	// Address optionally identifies the declaration that the diagnostic is
+	// about, such as "aws_instance.web" or "module.network.var.cidr", so that
+	// the diagnostic can refer to its source location.
+	Address string `json:"address"`
+}
+
+// lintConfig runs each of the lint rules from the CLI configuration against
+// the given configuration, returning the diagnostics that they report.
+func (c *ValidateCommand) lintConfig(ctx context.Context, cfg *configs.Config) tfdiags.Diagnostics {
+	var diags tfdiags.Diagnostics
+
+	opts, err := c.contextOpts(ctx)
+	if err != nil {
+		diags = diags.Append(err)
+		return diags
+	}
+	tfCtx, ctxDiags := tofu.NewContext(opts)
+	diags = diags.Append(ctxDiags)
+	if ctxDiags.HasErrors() {
+		return diags
+	}
+	schemas, moreDiags := tfCtx.Schemas(ctx, cfg, nil)
+	diags = diags.Append(moreDiags)
+	if moreDiags.HasErrors() {
+		return diags
+	}
+
+	configJSON, err := jsonconfig.Marshal(cfg, schemas)
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to run lint rules",
+			fmt.Sprintf("Failed to produce the JSON representation of the configuration for the lint rules: %s.", err),
+		))
+		return diags
+	}
+	input, err := json.Marshal(lintRuleInput{
+		FormatVersion: "1.0",
+		ConfigDir:     cfg.Module.SourceDir,
+		Configuration: configJSON,
+	})
+	if err != nil {
+		// Should never happen because we fully-control the input here
+		panic(err)
+	}
+
+	for _, rule := range c.LintRules {
+		diags = diags.Append(runLintRule(ctx, rule, input, cfg))
+	}
+	return diags
+}
+
+// runLintRule runs the given lint rule with the given input on its standard
+// input, and converts the diagnostics that it writes to its standard output.
+func runLintRule(stopCtx context.Context, rule *LintRule, input []byte, cfg *configs.Config) tfdiags.Diagnostics {
+	var diags tfdiags.Diagnostics
+
+	ctx, cancel := context.WithTimeout(stopCtx, rule.Timeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, rule.Command, rule.Args...)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	cmd.WaitDelay = lintRuleWaitDelay
+
+	log.Printf("[INFO] command/validate: running lint rule %q", rule.Name)
+	err := cmd.Run()
+	log.Printf("[DEBUG] command/validate: lint rule %q error output:\n%s", rule.Name, stderr.String())
+
+	var exitErr *exec.ExitError
+	switch {
+	case err == nil:
+	case stopCtx.Err() != nil:
+		diags = diags.Append(errors.New("execution halted"))
+		return diags
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Lint rule timed out",
+			fmt.Sprintf(
+				"The lint rule %q did not exit within %s.%s",
+				rule.Name, rule.Timeout, lintRuleOutputDetail(stderr.Bytes()),
+			),
+		))
+		return diags
+	case errors.As(err, &exitErr):
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Lint rule failed",
+			fmt.Sprintf(
+				"The lint rule %q exited with status %d. Lint rules must exit successfully and report any problems as diagnostics in their output.%s",
+				rule.Name, exitErr.ExitCode(), lintRuleOutputDetail(stderr.Bytes()),
+			),
+		))
+		return diags
+	default:
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to run lint rule",
+			fmt.Sprintf("Failed to run the lint rule %q: %s.", rule.Name, err),
+		))
+		return diags
+	}
+
+	var output lintRuleOutput
+	if err := json.Unmarshal(stdout.Bytes(), &output); err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid lint rule output",
+			fmt.Sprintf("The output of the lint rule %q is not a valid JSON object with a list of diagnostics: %s.", rule.Name, err),
+		))
+		return diags
+	}
+
+	for _, d := range output.Diagnostics {
+		diag := &hcl.Diagnostic{
+			Summary: d.Summary,
+			Detail:  fmt.Sprintf("Reported by lint rule %q.", rule.Name),
+		}
+		if d.Detail != "" {
+			diag.Detail = d.Detail + "\n\n" + diag.Detail
+		}
+		switch d.Severity {
+		case "error":
+			diag.Severity = hcl.DiagError
+		case "warning":
+			diag.Severity = hcl.DiagWarning
+		default:
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Invalid lint rule output",
+				fmt.Sprintf("The lint rule %q reported a diagnostic with invalid severity %q. The severity must be either \"error\" or \"warning\".", rule.Name, d.Severity),
+			))
+			continue
+		}
+		if d.Address != "" {
+			rng := lintRuleSubject(cfg, d.Address)
+			if rng == nil {
+				diag.Detail += fmt.Sprintf(" The rule referred to %s, which isn't declared in the configuration.", d.Address)
+			}
+			diag.Subject = rng
+		}
+		diags = diags.Append(diag)
+	}
+	return diags
+}
+
+// lintRuleSubject returns the source range of the declaration with the given
+// address in the given configuration, or nil if there is no such declaration.
+//
+// The address is of an input variable, local value, output value, module
+// call, or resource, optionally preceded by the address of the module that
+// declares it, such as "module.network.aws_subnet.private".
+func lintRuleSubject(cfg *configs.Config, addr string) *hcl.Range {
+	traversal, hclDiags := hclsyntax.ParseTraversalAbs([]byte(addr), "", hcl.InitialPos)
+	if hclDiags.HasErrors() {
+		return nil
+	}
+	var names []string
+	for _, step := range traversal {
+		switch step := step.(type) {
+		case hcl.TraverseRoot:
+			names = append(names, step.Name)
+		case hcl.TraverseAttr:
+			names = append(names, step.Name)
+		default:
+			// Instance keys aren't meaningful for the declarations.
+			continue
+		}
+	}
+
+	for len(names) > 2 && names[0] == "module" {
+		child, ok := cfg.Children[names[1]]
+		if !ok {
+			return nil
+		}
+		cfg, names = child, names[2:]
+	}
+	if len(names) < 2 {
+		return nil
+	}
+
+	mod := cfg.Module
+	switch names[0] {
+	case "var":
+		if v, ok := mod.Variables[names[1]]; ok {
+			return v.DeclRange.Ptr()
+		}
+	case "local":
+		if l, ok := mod.Locals[names[1]]; ok {
+			return l.DeclRange.Ptr()
+		}
+	case "output":
+		if o, ok := mod.Outputs[names[1]]; ok {
+			return o.DeclRange.Ptr()
+		}
+	case "module":
+		if mc, ok := mod.ModuleCalls[names[1]]; ok {
+			return mc.DeclRange.Ptr()
+		}
+	default:
+		res := addrs.Resource{Mode: addrs.ManagedResourceMode, Type: names[0], Name: names[1]}
+		if names[0] == "data" {
+			if len(names) < 3 {
+				return nil
+			}
+			res = addrs.Resource{Mode: addrs.DataResourceMode, Type: names[1], Name: names[2]}
+		}
+		if r := mod.ResourceByAddr(res); r != nil {
+			return r.DeclRange.Ptr()
+		}
+	}
+	return nil
+}
+
+// lintRuleOutputDetail returns the given error output of a lint rule
+// formatted for inclusion at the end of a diagnostic detail message, or an
+// empty string if there is no output.
+func lintRuleOutputDetail(output []byte) string {
+	if len(output) > lintRuleOutputLimit {
+		output = output[len(output)-lintRuleOutputLimit:]
+	}
+	text := strings.TrimSpace(string(output))
+	if text == "" {
+		return ""
+	}
+	return "\n\nThe lint rule produced the following output:\n" + text
+}
@@ -0,0 +1,138 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/opentofu/opentofu/internal/command/workdir"
+)
+
+func TestValidate_lintRules(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("lint rule tests use a POSIX shell")
+	}
+
+	inputPath := filepath.Join(t.TempDir(), "input.json")
+	shRule := func(script string, args ...string) *LintRule {
+		return &LintRule{
+			Name:    "test",
+			Command: "sh",
+			Args:    append([]string{"-c", script}, args...),
+			Timeout: time.Minute,
+		}
+	}
+
+	tests := map[string]struct {
+		rule     *LintRule
+		wantCode int
+		want     []string
+	}{
+		"no diagnostics": {
+			rule:     shRule(`cat > "$0"; echo '{"diagnostics":[]}'`, inputPath),
+			wantCode: 0,
+		},
+		"error with address": {
+			rule:     shRule(`echo '{"diagnostics":[{"severity":"error","summary":"Missing tags","detail":"Every instance must have tags.","address":"test_instance.foo"}]}'`),
+			wantCode: 1,
+			want: []string{
+				"Error: Missing tags",
+				`resource "test_instance" "foo"`,
+				"Every instance must have tags.",
+				`Reported by lint rule "test".`,
+			},
+		},
+		"warning": {
+			rule:     shRule(`echo '{"diagnostics":[{"severity":"warning","summary":"Deprecated variable name","address":"var.var_with_escaped_interp"}]}'`),
+			wantCode: 0,
+			want: []string{
+				"Warning: Deprecated variable name",
+				`variable "var_with_escaped_interp"`,
+			},
+		},
+		"undeclared address": {
+			rule:     shRule(`echo '{"diagnostics":[{"severity":"error","summary":"Bad","address":"module.nope.test_instance.foo"}]}'`),
+			wantCode: 1,
+			want: []string{
+				"Error: Bad",
+				"which isn't declared in the configuration.",
+			},
+		},
+		"invalid severity": {
+			rule:     shRule(`echo '{"diagnostics":[{"severity":"fatal","summary":"Bad"}]}'`),
+			wantCode: 1,
+			want:     []string{"Invalid lint rule output", `invalid severity "fatal"`},
+		},
+		"invalid output": {
+			rule:     shRule(`echo 'all good'`),
+			wantCode: 1,
+			want:     []string{"Invalid lint rule output"},
+		},
+		"failed": {
+			rule:     shRule(`echo 'crashed' >&2; exit 3`),
+			wantCode: 1,
+			want:     []string{"Lint rule failed", "exited with status 3", "crashed"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			view, done := testView(t)
+			c := &ValidateCommand{
+				Meta: Meta{
+					WorkingDir:       workdir.NewDir("."),
+					testingOverrides: metaOverridesForProvider(validateTestProvider()),
+					View:             view,
+					LintRules:        []*LintRule{test.rule},
+				},
+			}
+
+			code := c.Run([]string{"-no-color", testFixturePath("validate-valid")})
+			output := done(t)
+			if code != test.wantCode {
+				t.Fatalf("wrong exit status %d; want %d\n%s\n%s", code, test.wantCode, output.Stdout(), output.Stderr())
+			}
+			got := output.All()
+			for _, want := range test.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("output is missing %q\ngot:\n%s", want, got)
+				}
+			}
+		})
+	}
+
+	// The first rule saved its input, which must include the configuration.
+	raw, err := os.ReadFile(inputPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var input struct {
+		FormatVersion string `json:"format_version"`
+		ConfigDir     string `json:"config_dir"`
+		Configuration struct {
+			RootModule struct {
+				Resources []struct {
+					Address string `json:"address"`
+				} `json:"resources"`
+			} `json:"root_module"`
+		} `json:"configuration"`
+	}
+	if err := json.Unmarshal(raw, &input); err != nil {
+		t.Fatalf("invalid input: %s\n%s", err, raw)
+	}
+	if input.FormatVersion != "1.0" || input.ConfigDir == "" {
+		t.Errorf("wrong input header: %s", raw)
+	}
+	if res := input.Configuration.RootModule.Resources; len(res) != 1 || res[0].Address != "test_instance.foo" {
+		t.Errorf("wrong resources in input: %s", raw)
+	}
+}
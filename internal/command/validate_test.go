@@ -12,6 +12,7 @@ import (
 	"path"
 	"path/filepath"
 	"runtime"
+	"sort"
 	"strings"
 	"testing"
 
@@ -24,10 +25,29 @@ import (
 	"github.com/opentofu/opentofu/internal/configs/configschema"
 	"github.com/opentofu/opentofu/internal/providers"
 	"github.com/opentofu/opentofu/internal/terminal"
+	"github.com/opentofu/opentofu/internal/tofu"
 )
 
 func setupTest(t *testing.T, fixturepath string, args ...string) (*terminal.TestOutput, int) {
 	view, done := testView(t)
+	c := &ValidateCommand{
+		Meta: Meta{
+			WorkingDir:       workdir.NewDir("."),
+			testingOverrides: metaOverridesForProvider(validateTestProvider()),
+			View:             view,
+		},
+	}
+
+	args = append(args, "-no-color")
+	args = append(args, testFixturePath(fixturepath))
+
+	code := c.Run(args)
+	return done(t), code
+}
+
+// validateTestProvider returns a provider with the schema that the validate
+// test fixtures use.
+func validateTestProvider() *tofu.MockProvider {
 	p := testProvider()
 	p.GetProviderSchemaResponse = &providers.GetProviderSchemaResponse{
 		ResourceTypes: map[string]providers.Schema{
@@ -52,19 +72,7 @@ func setupTest(t *testing.T, fixturepath string, args ...string) (*terminal.Test
 			},
 		},
 	}
-	c := &ValidateCommand{
-		Meta: Meta{
-			WorkingDir:       workdir.NewDir("."),
-			testingOverrides: metaOverridesForProvider(p),
-			View:             view,
-		},
-	}
-
-	args = append(args, "-no-color")
-	args = append(args, testFixturePath(fixturepath))
-
-	code := c.Run(args)
-	return done(t), code
+	return p
 }
 
 func TestValidateCommand(t *testing.T) {
@@ -103,6 +111,184 @@ func TestValidateFailingCommand(t *testing.T) {
 	}
 }
 
+func TestValidateStrict(t *testing.T) {
+	// The provider configuration isn't used, so it's only checked in
+	// strict mode.
+	if output, code := setupTest(t, "validate-strict"); code != 0 {
+		t.Fatalf("unexpected failure without -strict: %d\n\n%s", code, output.Stderr())
+	}
+
+	output, code := setupTest(t, "validate-strict", "-strict")
+	if code != 1 {
+		t.Fatalf("Should have failed: %d\n\n%s", code, output.Stderr())
+	}
+	wantError := `An argument named "reigon" is not expected here.`
+	if !strings.Contains(output.Stderr(), wantError) {
+		t.Fatalf("Missing error string %q\n\n'%s'", wantError, output.Stderr())
+	}
+}
+
+func TestValidateCheckUnusedImpliesStrict(t *testing.T) {
+	output, code := setupTest(t, "validate-strict", "-check-unused")
+	if code != 1 {
+		t.Fatalf("Should have failed: %d\n\n%s", code, output.Stderr())
+	}
+	wantError := `An argument named "reigon" is not expected here.`
+	if !strings.Contains(output.Stderr(), wantError) {
+		t.Fatalf("Missing error string %q\n\n'%s'", wantError, output.Stderr())
+	}
+}
+
+func TestValidateMisspelledMetaArguments(t *testing.T) {
+	// Unknown arguments and blocks in a resource, such as misspelled
+	// meta-arguments, are reported whether or not -strict is set.
+	for _, args := range [][]string{nil, {"-strict"}} {
+		output, code := setupTest(t, "validate-invalid/misspelled_meta_arguments", args...)
+		if code != 1 {
+			t.Fatalf("Should have failed with %q: %d\n\n%s", args, code, output.Stderr())
+		}
+		for _, wantError := range []string{
+			`An argument named "depend_on" is not expected here.`,
+			`Blocks of type "lifecyle" are not expected here.`,
+		} {
+			if !strings.Contains(output.Stderr(), wantError) {
+				t.Errorf("Missing error string %q with %q\n\n'%s'", wantError, args, output.Stderr())
+			}
+		}
+	}
+}
+
+func TestValidateWarningsAsErrors(t *testing.T) {
+	output, code := setupTest(t, "validate-warnings")
+	if code != 0 {
+		t.Fatalf("unexpected failure without -warnings-as-errors: %d\n\n%s", code, output.Stderr())
+	}
+	wantWarning := "Warning: Version constraints inside provider configuration blocks are deprecated"
+	if !strings.Contains(output.All(), wantWarning) {
+		t.Fatalf("Missing warning %q\n\n%s", wantWarning, output.All())
+	}
+
+	output, code = setupTest(t, "validate-warnings", "-warnings-as-errors")
+	if code != 1 {
+		t.Fatalf("Should have failed: %d\n\n%s", code, output.Stderr())
+	}
+	wantError := "Error: Version constraints inside provider configuration blocks are deprecated"
+	if !strings.Contains(output.Stderr(), wantError) {
+		t.Fatalf("Missing error string %q\n\n%s", wantError, output.Stderr())
+	}
+
+	// A configuration without any warnings is still valid.
+	if output, code := setupTest(t, "validate-valid", "-warnings-as-errors"); code != 0 {
+		t.Fatalf("unexpected failure for a valid configuration: %d\n\n%s", code, output.Stderr())
+	}
+}
+
+func TestValidateCheckUnused(t *testing.T) {
+	td := t.TempDir()
+	testCopyDir(t, testFixturePath("unused"), td)
+	t.Chdir(td)
+
+	p := unusedTestProvider()
+	view, done := testView(t)
+	c := &ValidateCommand{
+		Meta: Meta{
+			WorkingDir:       workdir.NewDir("."),
+			testingOverrides: metaOverridesForProvider(p),
+			View:             view,
+		},
+	}
+
+	code := c.Run([]string{"-no-color", "-check-unused"})
+	output := done(t)
+	if code != 0 {
+		t.Fatalf("unexpected exit status %d; want 0\n%s", code, output.All())
+	}
+	got := output.All()
+	for _, want := range []string{
+		"Warning: Unused input variable",
+		"Nothing in the configuration refers to var.unused, so it can be removed.",
+		"Warning: Unused local value",
+		"Warning: Unused data source",
+		"Warning: Unused provider configuration",
+		"refers to output.unused in module.child",
+		"on modules/child/main.tf line 14:",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("output is missing %q\ngot:\n%s", want, got)
+		}
+	}
+
+	// Without the option, the unused declarations aren't reported.
+	view, done = testView(t)
+	c.View = view
+	if code := c.Run([]string{"-no-color"}); code != 0 {
+		t.Fatalf("unexpected exit status %d; want 0", code)
+	}
+	if got := done(t).All(); strings.Contains(got, "Unused") {
+		t.Errorf("unexpected unused declarations without -check-unused\n%s", got)
+	}
+}
+
+func TestValidateVars(t *testing.T) {
+	// Without any variable values, the variables are unknown.
+	if output, code := setupTest(t, "validate-vars"); code != 0 {
+		t.Fatalf("unexpected failure without -var: %d\n\n%s", code, output.Stderr())
+	}
+	if output, code := setupTest(t, "validate-vars", "-var", "instances=2"); code != 0 {
+		t.Fatalf("unexpected failure with valid -var: %d\n\n%s", code, output.Stderr())
+	}
+
+	tests := map[string]struct {
+		args      []string
+		wantError string
+	}{
+		"wrong type": {
+			[]string{"-var", "instances=many"},
+			`Invalid value for input variable`,
+		},
+		"failed validation rule": {
+			[]string{"-var", "instances=0"},
+			`At least one instance is required.`,
+		},
+		"undeclared variable": {
+			[]string{"-var", "region=a"},
+			`Value for undeclared variable`,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			output, code := setupTest(t, "validate-vars", test.args...)
+			if code != 1 {
+				t.Fatalf("Should have failed: %d\n\n%s", code, output.Stderr())
+			}
+			if !strings.Contains(output.Stderr(), test.wantError) {
+				t.Fatalf("Missing error string %q\n\n'%s'", test.wantError, output.Stderr())
+			}
+		})
+	}
+}
+
+func TestValidateContract(t *testing.T) {
+	contract := testFixturePath("validate-contract/contract.hcl")
+	if output, code := setupTest(t, "validate-contract", "-contract="+contract); code != 0 {
+		t.Fatalf("unexpected failure: %d\n\n%s", code, output.Stderr())
+	}
+
+	contract = testFixturePath("validate-contract/contract-unmet.hcl")
+	output, code := setupTest(t, "validate-contract", "-contract="+contract)
+	if code != 1 {
+		t.Fatalf("Should have failed: %d\n\n%s", code, output.Stderr())
+	}
+	for _, wantError := range []string{
+		"Error: Incompatible variable type",
+		"Error: Missing contract output",
+	} {
+		if !strings.Contains(output.Stderr(), wantError) {
+			t.Fatalf("Missing error string %q\n\n'%s'", wantError, output.Stderr())
+		}
+	}
+}
+
 func TestValidateFailingCommandMissingQuote(t *testing.T) {
 	output, code := setupTest(t, "validate-invalid/missing_quote")
 
@@ -447,3 +633,178 @@ func TestValidate_json(t *testing.T) {
 		})
 	}
 }
+
+func TestValidate_jsonFixSuggestions(t *testing.T) {
+	output, code := setupTest(t, "validate-fix-suggestions", "-json", "-fix-suggestions")
+	if code != 1 {
+		t.Fatalf("wrong exit code: want 1, got %d\n%s", code, output.All())
+	}
+
+	var got struct {
+		Diagnostics []struct {
+			Summary string `json:"summary"`
+			Fixes   []struct {
+				Range struct {
+					Start struct {
+						Line   int `json:"line"`
+						Column int `json:"column"`
+					} `json:"start"`
+				} `json:"range"`
+				Replacement string `json:"replacement"`
+			} `json:"fixes"`
+		} `json:"diagnostics"`
+	}
+	if err := json.Unmarshal([]byte(output.Stdout()), &got); err != nil {
+		t.Fatalf("failed to unmarshal actual JSON: %s\n%s", err, output.Stdout())
+	}
+
+	type fix struct {
+		Summary     string
+		Line        int
+		Column      int
+		Replacement string
+	}
+	var gotFixes []fix
+	for _, diag := range got.Diagnostics {
+		for _, f := range diag.Fixes {
+			gotFixes = append(gotFixes, fix{diag.Summary, f.Range.Start.Line, f.Range.Start.Column, f.Replacement})
+		}
+	}
+	sort.Slice(gotFixes, func(i, j int) bool {
+		return gotFixes[i].Line < gotFixes[j].Line
+	})
+	wantFixes := []fix{
+		{"Unsupported argument", 2, 3, "ami"},
+		{"Unsupported block type", 4, 3, "network_interface"},
+		{"Incorrect attribute value type", 10, 9, `"baz"`},
+	}
+	if diff := cmp.Diff(wantFixes, gotFixes); diff != "" {
+		t.Errorf("wrong fixes\n%s\n\nraw output:\n%s", diff, output.Stdout())
+	}
+}
+
+func TestValidate_sarif(t *testing.T) {
+	output, code := setupTest(t, "validate-fix-suggestions", "-json", "-format=sarif")
+	if code != 1 {
+		t.Fatalf("wrong exit code: want 1, got %d\n%s", code, output.All())
+	}
+
+	var got struct {
+		Version string `json:"version"`
+		Runs    []struct {
+			Results []struct {
+				RuleID    string `json:"ruleId"`
+				Level     string `json:"level"`
+				Locations []struct {
+					PhysicalLocation struct {
+						ArtifactLocation struct {
+							URI string `json:"uri"`
+						} `json:"artifactLocation"`
+						Region struct {
+							StartLine int `json:"startLine"`
+						} `json:"region"`
+					} `json:"physicalLocation"`
+				} `json:"locations"`
+			} `json:"results"`
+		} `json:"runs"`
+	}
+	if err := json.Unmarshal([]byte(output.Stdout()), &got); err != nil {
+		t.Fatalf("failed to unmarshal actual JSON: %s\n%s", err, output.Stdout())
+	}
+	if got.Version != "2.1.0" || len(got.Runs) != 1 {
+		t.Fatalf("wrong SARIF log\n%s", output.Stdout())
+	}
+
+	type result struct {
+		RuleID string
+		Level  string
+		URI    string
+		Line   int
+	}
+	var gotResults []result
+	for _, r := range got.Runs[0].Results {
+		if len(r.Locations) != 1 {
+			t.Fatalf("wrong number of locations for %s: %d", r.RuleID, len(r.Locations))
+		}
+		loc := r.Locations[0].PhysicalLocation
+		gotResults = append(gotResults, result{r.RuleID, r.Level, loc.ArtifactLocation.URI, loc.Region.StartLine})
+	}
+	sort.Slice(gotResults, func(i, j int) bool {
+		return gotResults[i].Line < gotResults[j].Line
+	})
+	uri := "testdata/validate-fix-suggestions/main.tf"
+	wantResults := []result{
+		{"unsupported-argument", "error", uri, 2},
+		{"unsupported-block-type", "error", uri, 4},
+		{"incorrect-attribute-value-type", "error", uri, 10},
+	}
+	if diff := cmp.Diff(wantResults, gotResults); diff != "" {
+		t.Errorf("wrong results\n%s\n\nraw output:\n%s", diff, output.Stdout())
+	}
+}
+
+func TestValidate_recursive(t *testing.T) {
+	output, code := setupTest(t, "validate-recursive", "-recursive")
+	if code != 1 {
+		t.Fatalf("wrong exit code: want 1, got %d\n%s", code, output.All())
+	}
+
+	got := output.All()
+	for _, want := range []string{
+		"Module .\nSuccess! The configuration is valid.",
+		"Module modules/network\nSuccess! The configuration is valid.",
+		"Module modules/network/subnet\nSuccess! The configuration is valid.",
+		"Module modules/unused\n",
+		"Reference to undeclared input variable",
+		"Error: 1 of 4 modules are invalid.",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("output does not contain %q\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, ".terraform") {
+		t.Errorf("output includes the .terraform directory\n%s", got)
+	}
+}
+
+func TestValidate_recursiveJSON(t *testing.T) {
+	output, code := setupTest(t, "validate-recursive", "-recursive", "-json")
+	if code != 1 {
+		t.Fatalf("wrong exit code: want 1, got %d\n%s", code, output.All())
+	}
+
+	var got struct {
+		Valid      bool `json:"valid"`
+		ErrorCount int  `json:"error_count"`
+		Modules    []struct {
+			Path        string            `json:"path"`
+			Valid       bool              `json:"valid"`
+			Diagnostics []json.RawMessage `json:"diagnostics"`
+		} `json:"modules"`
+	}
+	if err := json.Unmarshal([]byte(output.Stdout()), &got); err != nil {
+		t.Fatalf("failed to unmarshal actual JSON: %s\n%s", err, output.Stdout())
+	}
+	if got.Valid || got.ErrorCount != 1 {
+		t.Errorf("wrong summary: valid %t with %d errors", got.Valid, got.ErrorCount)
+	}
+
+	type module struct {
+		Path        string
+		Valid       bool
+		Diagnostics int
+	}
+	var gotModules []module
+	for _, m := range got.Modules {
+		gotModules = append(gotModules, module{m.Path, m.Valid, len(m.Diagnostics)})
+	}
+	wantModules := []module{
+		{".", true, 0},
+		{"modules/network", true, 0},
+		{"modules/network/subnet", true, 0},
+		{"modules/unused", false, 1},
+	}
+	if diff := cmp.Diff(wantModules, gotModules); diff != "" {
+		t.Errorf("wrong modules\n%s\n\nraw output:\n%s", diff, output.Stdout())
+	}
+}
@@ -19,6 +19,10 @@ import (
 // The Apply view is used for the apply command.
 type Apply interface {
 	ResourceCount(stateOutPath string)
+	// PartialResourceCount summarizes the changes that were made before the
+	// apply was interrupted by the user. It does nothing if the apply was
+	// not interrupted.
+	PartialResourceCount()
 	Outputs(outputValues map[string]*states.OutputValue)
 
 	Operation() Operation
@@ -73,6 +77,12 @@ func (m ApplyMulti) ResourceCount(stateOutPath string) {
 	}
 }
 
+func (m ApplyMulti) PartialResourceCount() {
+	for _, a := range m {
+		a.PartialResourceCount()
+	}
+}
+
 func (m ApplyMulti) Outputs(outputValues map[string]*states.OutputValue) {
 	for _, a := range m {
 		a.Outputs(outputValues)
@@ -175,6 +185,20 @@ func (v *ApplyHuman) ResourceCount(stateOutPath string) {
 	}
 }
 
+func (v *ApplyHuman) PartialResourceCount() {
+	if !v.countHook.Interrupted {
+		return
+	}
+	summary := partialChangeSummary(v.countHook, v.destroy)
+	v.view.streams.Printf(v.view.colorize.Color("[reset][bold][yellow]\n%s\n"), summary.String())
+	if len(summary.InProgress) > 0 {
+		v.view.streams.Printf("\n%s\n\n", format.WordWrap(partialApplyInProgress, v.view.outputColumns()))
+		for _, addr := range summary.InProgress {
+			v.view.streams.Printf("  - %s\n", addr)
+		}
+	}
+}
+
 func (v *ApplyHuman) Outputs(outputValues map[string]*states.OutputValue) {
 	if len(outputValues) > 0 {
 		v.view.streams.Print(v.view.colorize.Color("[reset][bold][green]\nOutputs:\n\n"))
@@ -208,6 +232,27 @@ func (v *ApplyHuman) Backend() Backend {
 	}
 }
 
+const partialApplyInProgress = "The following changes were still in progress when OpenTofu exited, so their outcome may not be recorded in the state. Run `tofu plan` to check whether the remote objects match the state before continuing."
+
+// partialChangeSummary returns a summary of the changes counted by the given
+// hook for an apply or destroy that was interrupted.
+func partialChangeSummary(countHook *countHook, destroy bool) *json.ChangeSummary {
+	operation := json.OperationApplied
+	if destroy {
+		operation = json.OperationDestroyed
+	}
+	return &json.ChangeSummary{
+		Add:         countHook.Added,
+		Change:      countHook.Changed,
+		Remove:      countHook.Removed,
+		Import:      countHook.Imported,
+		Forget:      countHook.Forgotten,
+		Operation:   operation,
+		Interrupted: true,
+		InProgress:  countHook.InProgress(),
+	}
+}
+
 const stateOutPathPostApply = "The state of your infrastructure has been saved to the path below. This state is required to modify and destroy your infrastructure, so keep it safe. To inspect the complete state use the `tofu show` command."
 
 // The ApplyJSON implementation renders streaming JSON logs, suitable for
@@ -237,6 +282,13 @@ func (v *ApplyJSON) ResourceCount(stateOutPath string) {
 	})
 }
 
+func (v *ApplyJSON) PartialResourceCount() {
+	if !v.countHook.Interrupted {
+		return
+	}
+	v.view.ChangeSummary(partialChangeSummary(v.countHook, v.destroy))
+}
+
 func (v *ApplyJSON) Outputs(outputValues map[string]*states.OutputValue) {
 	outputs, diags := json.OutputsFromMap(outputValues)
 	if diags.HasErrors() {
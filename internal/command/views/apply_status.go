@@ -0,0 +1,276 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package views
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	viewsjson "github.com/opentofu/opentofu/internal/command/views/json"
+	"github.com/opentofu/opentofu/internal/encryption"
+	"github.com/opentofu/opentofu/internal/plans"
+	"github.com/opentofu/opentofu/internal/states"
+	"github.com/opentofu/opentofu/internal/states/statefile"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+	"github.com/opentofu/opentofu/internal/tofu"
+)
+
+// Status phases and results reported by [ApplyStatus]. The phases are listed
+// in the order in which an apply goes through them, although it might skip
+// some of them, such as when applying a saved plan.
+const (
+	StatusPhasePlanning           = "planning"
+	StatusPhasePlanned            = "planned"
+	StatusPhaseWaitingForApproval = "waiting_for_approval"
+	StatusPhaseApplying           = "applying"
+
+	StatusResultComplete    = "complete"
+	StatusResultCancelled   = "cancelled"
+	StatusResultInterrupted = "interrupted"
+	StatusResultFailed      = "failed"
+)
+
+var statusPhaseOrder = []string{
+	"",
+	StatusPhasePlanning,
+	StatusPhasePlanned,
+	StatusPhaseWaitingForApproval,
+	StatusPhaseApplying,
+}
+
+// StatusLine is a single line written to a status channel.
+type StatusLine struct {
+	Timestamp string `json:"@timestamp"`
+
+	// Type is either "phase", for a transition to the phase given in Phase,
+	// or "summary", for the final result given in Result.
+	Type   string `json:"type"`
+	Phase  string `json:"phase,omitempty"`
+	Result string `json:"result,omitempty"`
+
+	// Changes counts the changes that are planned, for the "planned"
+	// phase, or that were made, for the summary.
+	Changes *viewsjson.ChangeSummary `json:"changes,omitempty"`
+}
+
+// NewStatusWriter returns a writer for status lines on the given stream,
+// which is safe to share between the views of operations that run
+// concurrently, such as an apply in several workspaces.
+func NewStatusWriter(w io.Writer) *StatusWriter {
+	return &StatusWriter{w: w}
+}
+
+// StatusWriter writes each status line as one JSON object per line.
+type StatusWriter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (w *StatusWriter) write(line StatusLine) {
+	line.Timestamp = time.Now().Format("2006-01-02T15:04:05.000000Z07:00")
+	raw, err := json.Marshal(line)
+	if err != nil {
+		log.Printf("[ERROR] Failed to serialize status line: %s", err)
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	// A wrapper that stops reading its status channel mustn't interrupt
+	// the operation, so we only log any errors here.
+	if _, err := w.w.Write(append(raw, '\n')); err != nil {
+		log.Printf("[WARN] Failed to write to the status channel: %s", err)
+	}
+}
+
+// NewApplyStatus returns an Apply implementation that reports the phases and
+// the final result of an apply or destroy to the given status writer.
+func NewApplyStatus(w *StatusWriter, destroy bool) *ApplyStatus {
+	v := &ApplyStatus{
+		w:         w,
+		destroy:   destroy,
+		countHook: &countHook{},
+	}
+	v.statusHook = &statusHook{view: v}
+	return v
+}
+
+// The ApplyStatus implementation writes concise status lines for a wrapper
+// to track the progress of an apply, alongside the primary human-readable or
+// JSON output. It reports only the transitions between the phases of the
+// operation and its final result.
+type ApplyStatus struct {
+	w       *StatusWriter
+	destroy bool
+
+	countHook  *countHook
+	statusHook *statusHook
+
+	mu     sync.Mutex
+	phase  string
+	result string
+}
+
+var _ Apply = (*ApplyStatus)(nil)
+
+// Planning reports that OpenTofu has started to plan the changes to apply.
+// The command calls it when it starts an apply without a saved plan.
+func (v *ApplyStatus) Planning() {
+	v.enterPhase(StatusPhasePlanning, nil)
+}
+
+// enterPhase reports the transition to the given phase, unless the apply is
+// already in that phase or a later one, or has finished. OpenTofu reports
+// some events in several phases, such as computing the final changes to
+// each resource instance while applying, so the phases only move forward.
+func (v *ApplyStatus) enterPhase(phase string, changes *viewsjson.ChangeSummary) {
+	v.mu.Lock()
+	if slices.Index(statusPhaseOrder, phase) <= slices.Index(statusPhaseOrder, v.phase) || v.result != "" {
+		v.mu.Unlock()
+		return
+	}
+	v.phase = phase
+	v.mu.Unlock()
+
+	v.w.write(StatusLine{Type: "phase", Phase: phase, Changes: changes})
+}
+
+// finish reports the final result of the apply, unless it was already
+// reported, such as when the apply was cancelled and then also reported as
+// failed by the command.
+func (v *ApplyStatus) finish(result string, changes *viewsjson.ChangeSummary) {
+	v.mu.Lock()
+	if v.result != "" {
+		v.mu.Unlock()
+		return
+	}
+	v.result = result
+	v.mu.Unlock()
+
+	v.w.write(StatusLine{Type: "summary", Result: result, Changes: changes})
+}
+
+func (v *ApplyStatus) changeSummary() *viewsjson.ChangeSummary {
+	operation := viewsjson.OperationApplied
+	if v.destroy {
+		operation = viewsjson.OperationDestroyed
+	}
+	return &viewsjson.ChangeSummary{
+		Add:       v.countHook.Added,
+		Change:    v.countHook.Changed,
+		Remove:    v.countHook.Removed,
+		Import:    v.countHook.Imported,
+		Forget:    v.countHook.Forgotten,
+		Operation: operation,
+	}
+}
+
+func (v *ApplyStatus) ResourceCount(stateOutPath string) {
+	v.finish(StatusResultComplete, v.changeSummary())
+}
+
+func (v *ApplyStatus) PartialResourceCount() {
+	// Unlike the other views, the status channel always reports a result,
+	// so that a wrapper knows the apply has finished even if it failed.
+	if v.countHook.Interrupted {
+		v.finish(StatusResultInterrupted, partialChangeSummary(v.countHook, v.destroy))
+		return
+	}
+	v.finish(StatusResultFailed, v.changeSummary())
+}
+
+func (v *ApplyStatus) Outputs(outputValues map[string]*states.OutputValue) {
+}
+
+func (v *ApplyStatus) Operation() Operation {
+	return &operationStatus{view: v}
+}
+
+func (v *ApplyStatus) Hooks() []tofu.Hook {
+	return []tofu.Hook{
+		v.countHook,
+		v.statusHook,
+	}
+}
+
+func (v *ApplyStatus) Diagnostics(diags tfdiags.Diagnostics) {
+	// The command stops as soon as it reports errors, including those that
+	// prevent the apply from starting at all, so this is the final result.
+	if diags.HasErrors() {
+		v.PartialResourceCount()
+	}
+}
+
+func (v *ApplyStatus) HelpPrompt() {
+}
+
+func (v *ApplyStatus) Backend() Backend {
+	return BackendMulti(nil)
+}
+
+// operationStatus is the Operation view of [ApplyStatus], reporting the
+// phases that the backend signals through the operation view.
+type operationStatus struct {
+	view *ApplyStatus
+}
+
+var _ Operation = (*operationStatus)(nil)
+
+func (v *operationStatus) Interrupted() {}
+
+func (v *operationStatus) FatalInterrupt() {}
+
+func (v *operationStatus) Stopping() {}
+
+func (v *operationStatus) Cancelled(planMode plans.Mode) {
+	v.view.finish(StatusResultCancelled, v.view.changeSummary())
+}
+
+func (v *operationStatus) WaitingForApproval(command string) {
+	v.view.enterPhase(StatusPhaseWaitingForApproval, nil)
+}
+
+func (v *operationStatus) EmergencyDumpState(stateFile *statefile.File, enc encryption.StateEncryption) error {
+	return nil
+}
+
+func (v *operationStatus) PlannedChange(change *plans.ResourceInstanceChangeSrc) {}
+
+func (v *operationStatus) Plan(plan *plans.Plan, schemas *tofu.Schemas) {
+	cs := planChangeSummary(plan)
+	if v.view.destroy {
+		cs.Operation = viewsjson.OperationDestroyed
+	} else {
+		cs.Operation = viewsjson.OperationApplied
+	}
+	v.view.enterPhase(StatusPhasePlanned, cs)
+}
+
+func (v *operationStatus) PlanNextStep(planPath string, genConfigPath string) {}
+
+func (v *operationStatus) Diagnostics(diags tfdiags.Diagnostics) {}
+
+// statusHook reports the applying phase of [ApplyStatus] when OpenTofu
+// starts to apply the first change.
+type statusHook struct {
+	tofu.NilHook
+
+	view *ApplyStatus
+}
+
+var _ tofu.Hook = (*statusHook)(nil)
+
+func (h *statusHook) PreApply(addr addrs.AbsResourceInstance, gen states.Generation, action plans.Action, priorState, plannedNewState cty.Value) (tofu.HookAction, error) {
+	h.view.enterPhase(StatusPhaseApplying, nil)
+	return tofu.HookActionContinue, nil
+}
@@ -0,0 +1,100 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package views
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	viewsjson "github.com/opentofu/opentofu/internal/command/views/json"
+	"github.com/opentofu/opentofu/internal/plans"
+	"github.com/opentofu/opentofu/internal/states"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+func TestApplyStatus(t *testing.T) {
+	addr := addrs.Resource{
+		Mode: addrs.ManagedResourceMode,
+		Type: "test_instance",
+		Name: "foo",
+	}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)
+
+	tests := map[string]struct {
+		destroy bool
+		events  func(v *ApplyStatus)
+		want    []StatusLine
+	}{
+		"phases only move forward": {
+			events: func(v *ApplyStatus) {
+				v.Planning()
+				v.Operation().WaitingForApproval("approve.sh")
+				v.Planning()
+				for _, h := range v.Hooks() {
+					_, _ = h.PreApply(addr, states.CurrentGen, plans.Create, cty.NullVal(cty.DynamicPseudoType), cty.NullVal(cty.DynamicPseudoType))
+					_, _ = h.PostApply(addr, states.CurrentGen, cty.NullVal(cty.DynamicPseudoType), nil)
+				}
+				v.ResourceCount("")
+			},
+			want: []StatusLine{
+				{Type: "phase", Phase: StatusPhasePlanning},
+				{Type: "phase", Phase: StatusPhaseWaitingForApproval},
+				{Type: "phase", Phase: StatusPhaseApplying},
+				{Type: "summary", Result: StatusResultComplete, Changes: &viewsjson.ChangeSummary{Add: 1, Operation: viewsjson.OperationApplied}},
+			},
+		},
+		"cancelled": {
+			destroy: true,
+			events: func(v *ApplyStatus) {
+				v.Planning()
+				v.Operation().Cancelled(plans.DestroyMode)
+				v.PartialResourceCount()
+				v.Operation().WaitingForApproval("approve.sh")
+			},
+			want: []StatusLine{
+				{Type: "phase", Phase: StatusPhasePlanning},
+				{Type: "summary", Result: StatusResultCancelled, Changes: &viewsjson.ChangeSummary{Operation: viewsjson.OperationDestroyed}},
+			},
+		},
+		"errors": {
+			events: func(v *ApplyStatus) {
+				var diags tfdiags.Diagnostics
+				v.Diagnostics(diags.Append(tfdiags.SimpleWarning("careful")))
+				v.Diagnostics(diags.Append(tfdiags.Sourceless(tfdiags.Error, "Boom", "")))
+				v.PartialResourceCount()
+			},
+			want: []StatusLine{
+				{Type: "summary", Result: StatusResultFailed, Changes: &viewsjson.ChangeSummary{Operation: viewsjson.OperationApplied}},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			v := NewApplyStatus(NewStatusWriter(&buf), tc.destroy)
+			tc.events(v)
+
+			var got []StatusLine
+			for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+				var status StatusLine
+				if err := json.Unmarshal([]byte(line), &status); err != nil {
+					t.Fatalf("invalid status line %q: %s", line, err)
+				}
+				status.Timestamp = ""
+				got = append(got, status)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("wrong status lines\n%s", diff)
+			}
+		})
+	}
+}
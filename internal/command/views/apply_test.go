@@ -10,8 +10,10 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/opentofu/opentofu/internal/addrs"
 	"github.com/opentofu/opentofu/internal/command/arguments"
 	"github.com/opentofu/opentofu/internal/lang/marks"
+	"github.com/opentofu/opentofu/internal/plans"
 	"github.com/opentofu/opentofu/internal/states"
 	"github.com/opentofu/opentofu/internal/terminal"
 	"github.com/zclconf/go-cty/cty"
@@ -180,6 +182,79 @@ func TestApply_resourceCount(t *testing.T) {
 	}
 }
 
+func TestApply_partialResourceCount(t *testing.T) {
+	testCases := map[string]struct {
+		destroy     bool
+		interrupted bool
+		want        string
+	}{
+		"apply": {
+			false,
+			true,
+			"Apply interrupted! Resources: 1 added, 0 changed, 0 destroyed.",
+		},
+		"destroy": {
+			true,
+			true,
+			"Destroy interrupted! Resources: 0 destroyed.",
+		},
+		"not interrupted": {
+			false,
+			false,
+			"",
+		},
+	}
+
+	views := []arguments.ViewType{arguments.ViewHuman, arguments.ViewJSON}
+
+	for name, tc := range testCases {
+		for _, viewType := range views {
+			t.Run(fmt.Sprintf("%s (%s view)", name, viewType), func(t *testing.T) {
+				streams, done := terminal.StreamsForTesting(t)
+				v := NewApply(arguments.ViewOptions{ViewType: viewType}, tc.destroy, NewView(streams))
+				hooks := v.Hooks()
+
+				var count *countHook
+				for _, hook := range hooks {
+					if ch, ok := hook.(*countHook); ok {
+						count = ch
+					}
+				}
+				if count == nil {
+					t.Fatalf("expected Hooks to include a countHook: %#v", hooks)
+				}
+
+				addr := addrs.Resource{
+					Mode: addrs.ManagedResourceMode,
+					Type: "test_instance",
+					Name: "foo",
+				}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)
+				count.Added = 1
+				_, _ = count.PreApply(addr, states.CurrentGen, plans.Create, cty.NullVal(cty.DynamicPseudoType), cty.NullVal(cty.DynamicPseudoType))
+				if tc.interrupted {
+					count.Stopping()
+				}
+
+				v.PartialResourceCount()
+
+				got := done(t).Stdout()
+				if tc.want == "" {
+					if strings.Contains(got, "interrupted") {
+						t.Errorf("unexpected summary: %q", got)
+					}
+					return
+				}
+				if !strings.Contains(got, tc.want) {
+					t.Errorf("wrong result\ngot:  %q\nwant: %q", got, tc.want)
+				}
+				if !strings.Contains(got, "test_instance.foo") {
+					t.Errorf("in-progress resource instance missing from output: %q", got)
+				}
+			})
+		}
+	}
+}
+
 func TestApplyHuman_resourceCountStatePath(t *testing.T) {
 	testCases := map[string]struct {
 		added        int
@@ -8,6 +8,7 @@ package views
 import (
 	"fmt"
 
+	"github.com/opentofu/opentofu/internal/command/views/json"
 	"github.com/opentofu/opentofu/internal/tfdiags"
 )
 
@@ -25,6 +26,9 @@ type Backend interface {
 	MigratingCloudToLocalType(toBackendType string)
 	BackendTypeChanged(oldBackendType string, newBackendType string)
 	BackendReconfigured()
+	BackendCapabilities(backendType string, supported, unsupported []string)
+	BackendBootstrapMissing(backendType, resource string, permissions []string)
+	BackendBootstrapCreated(backendType, resource string)
 	MigrationCompleted(workspaces []string, currentWs string)
 
 	StateLocker() StateLocker
@@ -121,6 +125,24 @@ func (m BackendMulti) BackendReconfigured() {
 	}
 }
 
+func (m BackendMulti) BackendCapabilities(backendType string, supported, unsupported []string) {
+	for _, v := range m {
+		v.BackendCapabilities(backendType, supported, unsupported)
+	}
+}
+
+func (m BackendMulti) BackendBootstrapMissing(backendType, resource string, permissions []string) {
+	for _, v := range m {
+		v.BackendBootstrapMissing(backendType, resource, permissions)
+	}
+}
+
+func (m BackendMulti) BackendBootstrapCreated(backendType, resource string) {
+	for _, v := range m {
+		v.BackendBootstrapCreated(backendType, resource)
+	}
+}
+
 func (m BackendMulti) MigrationCompleted(workspaces []string, currentWs string) {
 	for _, v := range m {
 		v.MigrationCompleted(workspaces, currentWs)
@@ -200,6 +222,28 @@ has changed. OpenTofu will now check for existing state in the backends.`
 	_, _ = v.view.streams.Println(v.view.colorize.Color(outputBackendReconfigure))
 }
 
+func (v *BackendHuman) BackendCapabilities(backendType string, supported, unsupported []string) {
+	_, _ = v.view.streams.Printf("- The %q backend detected the capabilities of its storage service:\n", backendType)
+	for _, name := range supported {
+		_, _ = v.view.streams.Printf("  - %s: supported\n", name)
+	}
+	for _, name := range unsupported {
+		_, _ = v.view.streams.Printf("  - %s: not supported\n", name)
+	}
+}
+
+func (v *BackendHuman) BackendBootstrapMissing(backendType, resource string, permissions []string) {
+	_, _ = v.view.streams.Printf("- The %q backend needs the %s, which does not exist yet.\n", backendType, resource)
+	_, _ = v.view.streams.Println("  Using it afterwards requires at least these permissions:")
+	for _, permission := range permissions {
+		_, _ = v.view.streams.Printf("  - %s\n", permission)
+	}
+}
+
+func (v *BackendHuman) BackendBootstrapCreated(backendType, resource string) {
+	_, _ = v.view.streams.Printf("- The %q backend created the %s.\n", backendType, resource)
+}
+
 func (v *BackendHuman) MigrationCompleted(workspaces []string, currentWs string) {
 	const msg = "[reset][bold]Migration complete! Your workspaces are as follows:[reset]"
 	_, _ = v.view.streams.Println(v.view.colorize.Color(msg))
@@ -215,6 +259,10 @@ func (v *BackendHuman) StateLocker() StateLocker {
 
 type BackendJSON struct {
 	view *JSONView
+
+	// initSummary, if set, records whether "tofu init" changed the backend
+	// configuration.
+	initSummary *json.InitSummary
 }
 
 var _ Backend = (*BackendJSON)(nil)
@@ -244,15 +292,18 @@ func (v *BackendJSON) UnconfiguringBackendType(backendType string) {
 }
 
 func (v *BackendJSON) BackendTypeUnset(backendType string) {
+	v.backendChanged()
 	v.view.Info(fmt.Sprintf("Successfully unset the backend %q. OpenTofu will now operate locally", backendType))
 }
 
 func (v *BackendJSON) BackendTypeSet(backendType string) {
+	v.backendChanged()
 	msg := fmt.Sprintf("Successfully configured the backend %q! OpenTofu will automatically use this backend unless the backend configuration changes", backendType)
 	v.view.Info(msg)
 }
 
 func (v *BackendJSON) CloudBackendUpdated() {
+	v.backendChanged()
 	v.view.Info("Cloud backend configuration has changed")
 }
 
@@ -274,10 +325,46 @@ func (v *BackendJSON) BackendReconfigured() {
 	v.view.Info(msg)
 }
 
+func (v *BackendJSON) BackendCapabilities(backendType string, supported, unsupported []string) {
+	v.view.log.Info(
+		fmt.Sprintf("The %q backend detected the capabilities of its storage service", backendType),
+		"type", "backend_capabilities",
+		"backend", backendType,
+		"supported", supported,
+		"unsupported", unsupported,
+	)
+}
+
+func (v *BackendJSON) BackendBootstrapMissing(backendType, resource string, permissions []string) {
+	v.view.log.Info(
+		fmt.Sprintf("The %q backend needs the %s, which does not exist yet", backendType, resource),
+		"type", "backend_bootstrap_missing",
+		"backend", backendType,
+		"resource", resource,
+		"permissions", permissions,
+	)
+}
+
+func (v *BackendJSON) BackendBootstrapCreated(backendType, resource string) {
+	v.view.log.Info(
+		fmt.Sprintf("The %q backend created the %s", backendType, resource),
+		"type", "backend_bootstrap_created",
+		"backend", backendType,
+		"resource", resource,
+	)
+}
+
 func (v *BackendJSON) MigrationCompleted(workspaces []string, currentWs string) {
+	v.backendChanged()
 	v.view.log.Info("Migration complete", "workspaces", workspaces, "current_workspace", currentWs)
 }
 
+func (v *BackendJSON) backendChanged() {
+	if v.initSummary != nil {
+		v.initSummary.BackendChanged = true
+	}
+}
+
 func (v *BackendJSON) StateLocker() StateLocker {
 	return &StateLockerJSON{
 		view: v.view,
@@ -195,6 +195,65 @@ OpenTofu detected that the backend type changed from "s3" to "gcs".
 
 OpenTofu has detected that the configuration specified for the backend
 has changed. OpenTofu will now check for existing state in the backends.
+`,
+		},
+		"backendCapabilities": {
+			viewCall: func(view Backend) {
+				view.BackendCapabilities("s3", []string{"checksums", "conditional writes"}, []string{"versioning"})
+			},
+			wantJson: []map[string]any{
+				{
+					"@level":      "info",
+					"@message":    "The \"s3\" backend detected the capabilities of its storage service",
+					"@module":     "tofu.ui",
+					"type":        "backend_capabilities",
+					"backend":     "s3",
+					"supported":   []any{"checksums", "conditional writes"},
+					"unsupported": []any{"versioning"},
+				},
+			},
+			wantStdout: `- The "s3" backend detected the capabilities of its storage service:
+  - checksums: supported
+  - conditional writes: supported
+  - versioning: not supported
+`,
+		},
+		"backendBootstrapMissing": {
+			viewCall: func(view Backend) {
+				view.BackendBootstrapMissing("s3", `S3 bucket "tofu-state"`, []string{"s3:ListBucket", "s3:GetObject"})
+			},
+			wantJson: []map[string]any{
+				{
+					"@level":      "info",
+					"@message":    "The \"s3\" backend needs the S3 bucket \"tofu-state\", which does not exist yet",
+					"@module":     "tofu.ui",
+					"type":        "backend_bootstrap_missing",
+					"backend":     "s3",
+					"resource":    `S3 bucket "tofu-state"`,
+					"permissions": []any{"s3:ListBucket", "s3:GetObject"},
+				},
+			},
+			wantStdout: `- The "s3" backend needs the S3 bucket "tofu-state", which does not exist yet.
+  Using it afterwards requires at least these permissions:
+  - s3:ListBucket
+  - s3:GetObject
+`,
+		},
+		"backendBootstrapCreated": {
+			viewCall: func(view Backend) {
+				view.BackendBootstrapCreated("s3", `S3 bucket "tofu-state"`)
+			},
+			wantJson: []map[string]any{
+				{
+					"@level":   "info",
+					"@message": "The \"s3\" backend created the S3 bucket \"tofu-state\"",
+					"@module":  "tofu.ui",
+					"type":     "backend_bootstrap_created",
+					"backend":  "s3",
+					"resource": `S3 bucket "tofu-state"`,
+				},
+			},
+			wantStdout: `- The "s3" backend created the S3 bucket "tofu-state".
 `,
 		},
 		"migrationCompleted": {
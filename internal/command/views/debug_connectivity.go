@@ -0,0 +1,154 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package views
+
+import (
+	"fmt"
+
+	"github.com/opentofu/opentofu/internal/command/arguments"
+	"github.com/opentofu/opentofu/internal/command/views/json"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// The DebugConnectivity view is used for the "debug connectivity" command.
+type DebugConnectivity interface {
+	Diagnostics(diags tfdiags.Diagnostics)
+
+	// Checking announces the URL the following checks are for.
+	Checking(url string)
+
+	// CheckResult reports the result of a single check, such as resolving
+	// the hostname or connecting to the server.
+	CheckResult(check string, passed bool, detail string)
+
+	// Summary reports the number of checks that failed, which is zero
+	// if all checks passed.
+	Summary(failed int)
+}
+
+// NewDebugConnectivity returns an initialized DebugConnectivity implementation for the given ViewType.
+func NewDebugConnectivity(args arguments.ViewOptions, view *View) DebugConnectivity {
+	var ret DebugConnectivity
+	switch args.ViewType {
+	case arguments.ViewJSON:
+		ret = &DebugConnectivityJSON{view: NewJSONView(view, nil)}
+	case arguments.ViewHuman:
+		ret = &DebugConnectivityHuman{view: view}
+	default:
+		panic(fmt.Sprintf("unknown view type %v", args.ViewType))
+	}
+
+	if args.JSONInto != nil {
+		ret = DebugConnectivityMulti{ret, &DebugConnectivityJSON{view: NewJSONView(view, args.JSONInto)}}
+	}
+	return ret
+}
+
+type DebugConnectivityMulti []DebugConnectivity
+
+var _ DebugConnectivity = (DebugConnectivityMulti)(nil)
+
+func (m DebugConnectivityMulti) Diagnostics(diags tfdiags.Diagnostics) {
+	for _, v := range m {
+		v.Diagnostics(diags)
+	}
+}
+
+func (m DebugConnectivityMulti) Checking(url string) {
+	for _, v := range m {
+		v.Checking(url)
+	}
+}
+
+func (m DebugConnectivityMulti) CheckResult(check string, passed bool, detail string) {
+	for _, v := range m {
+		v.CheckResult(check, passed, detail)
+	}
+}
+
+func (m DebugConnectivityMulti) Summary(failed int) {
+	for _, v := range m {
+		v.Summary(failed)
+	}
+}
+
+// The DebugConnectivityHuman implementation renders a line for each check.
+type DebugConnectivityHuman struct {
+	view *View
+}
+
+var _ DebugConnectivity = (*DebugConnectivityHuman)(nil)
+
+func (v *DebugConnectivityHuman) Diagnostics(diags tfdiags.Diagnostics) {
+	v.view.Diagnostics(diags)
+}
+
+func (v *DebugConnectivityHuman) Checking(url string) {
+	_, _ = v.view.streams.Printf("Checking connectivity to %s\n\n", url)
+}
+
+func (v *DebugConnectivityHuman) CheckResult(check string, passed bool, detail string) {
+	_, _ = v.view.streams.Println(v.view.colorize.Color(fmt.Sprintf("  %s  %-9s %s", checkStatus(passed), check+":", detail)))
+}
+
+func (v *DebugConnectivityHuman) Summary(failed int) {
+	_, _ = v.view.streams.Println(v.view.colorize.Color("\n" + checksSummary(failed)))
+}
+
+// checkStatus returns the colorized status shown at the start of the line
+// for each check, padded to the same width whether or not it passed.
+func checkStatus(passed bool) string {
+	if passed {
+		return "[green]ok[reset]  "
+	}
+	return "[red]fail[reset]"
+}
+
+// checksSummary returns the colorized summary shown after all of the checks.
+func checksSummary(failed int) string {
+	switch failed {
+	case 0:
+		return "[green]All checks passed."
+	case 1:
+		return "[red]1 check failed."
+	default:
+		return fmt.Sprintf("[red]%d checks failed.", failed)
+	}
+}
+
+// The DebugConnectivityJSON implementation renders a streaming JSON log
+// message for each check.
+type DebugConnectivityJSON struct {
+	view *JSONView
+}
+
+var _ DebugConnectivity = (*DebugConnectivityJSON)(nil)
+
+func (v *DebugConnectivityJSON) Diagnostics(diags tfdiags.Diagnostics) {
+	v.view.Diagnostics(diags)
+}
+
+func (v *DebugConnectivityJSON) Checking(url string) {
+	v.view.Info(fmt.Sprintf("Checking connectivity to %s", url))
+}
+
+func (v *DebugConnectivityJSON) CheckResult(check string, passed bool, detail string) {
+	v.view.log.Info(
+		fmt.Sprintf("%s: %s", check, detail),
+		"type", json.MessageConnectivityCheck,
+		"check", check,
+		"passed", passed,
+		"detail", detail,
+	)
+}
+
+func (v *DebugConnectivityJSON) Summary(failed int) {
+	if failed == 0 {
+		v.view.Info("All checks passed")
+		return
+	}
+	v.view.Info(fmt.Sprintf("%d of the checks failed", failed))
+}
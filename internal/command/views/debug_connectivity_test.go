@@ -0,0 +1,61 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package views
+
+import (
+	"testing"
+
+	"github.com/opentofu/opentofu/internal/command/arguments"
+	"github.com/opentofu/opentofu/internal/terminal"
+)
+
+func TestDebugConnectivityHuman(t *testing.T) {
+	streams, done := terminal.StreamsForTesting(t)
+	view := NewView(streams)
+	view.Configure(&arguments.View{NoColor: true})
+	v := NewDebugConnectivity(arguments.ViewOptions{ViewType: arguments.ViewHuman}, view)
+	v.Checking("https://registry.example.com/.well-known/terraform.json")
+	v.CheckResult("dns", true, "resolved registry.example.com to 192.0.2.1")
+	v.CheckResult("connect", false, "connection refused")
+	v.Summary(1)
+
+	got := done(t).Stdout()
+	want := `Checking connectivity to https://registry.example.com/.well-known/terraform.json
+
+  ok    dns:      resolved registry.example.com to 192.0.2.1
+  fail  connect:  connection refused
+
+1 check failed.
+`
+	if got != want {
+		t.Errorf("wrong output\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestDebugConnectivityJSON(t *testing.T) {
+	streams, done := terminal.StreamsForTesting(t)
+	v := NewDebugConnectivity(arguments.ViewOptions{ViewType: arguments.ViewJSON}, NewView(streams))
+	v.CheckResult("dns", true, "resolved registry.example.com to 192.0.2.1")
+	v.Summary(0)
+
+	want := []map[string]any{
+		{
+			"@level":   "info",
+			"@message": "dns: resolved registry.example.com to 192.0.2.1",
+			"@module":  "tofu.ui",
+			"type":     "connectivity_check",
+			"check":    "dns",
+			"passed":   true,
+			"detail":   "resolved registry.example.com to 192.0.2.1",
+		},
+		{
+			"@level":   "info",
+			"@message": "All checks passed",
+			"@module":  "tofu.ui",
+		},
+	}
+	testJSONViewOutputEquals(t, done(t).Stdout(), want)
+}
@@ -0,0 +1,142 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package views
+
+import (
+	"fmt"
+
+	"github.com/opentofu/opentofu/internal/command/arguments"
+	"github.com/opentofu/opentofu/internal/command/views/json"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// The Doctor view is used for the doctor command.
+type Doctor interface {
+	Diagnostics(diags tfdiags.Diagnostics)
+
+	// Section announces the group of checks that the following results
+	// belong to, such as "Registries" or "Backend".
+	Section(name string)
+
+	// CheckResult reports the result of a single check in the current
+	// section, such as the service discovery for one host.
+	CheckResult(check string, passed bool, detail string)
+
+	// Summary reports the number of checks that failed, which is zero
+	// if all checks passed.
+	Summary(failed int)
+}
+
+// NewDoctor returns an initialized Doctor implementation for the given ViewType.
+func NewDoctor(args arguments.ViewOptions, view *View) Doctor {
+	var ret Doctor
+	switch args.ViewType {
+	case arguments.ViewJSON:
+		ret = &DoctorJSON{view: NewJSONView(view, nil)}
+	case arguments.ViewHuman:
+		ret = &DoctorHuman{view: view}
+	default:
+		panic(fmt.Sprintf("unknown view type %v", args.ViewType))
+	}
+
+	if args.JSONInto != nil {
+		ret = DoctorMulti{ret, &DoctorJSON{view: NewJSONView(view, args.JSONInto)}}
+	}
+	return ret
+}
+
+type DoctorMulti []Doctor
+
+var _ Doctor = (DoctorMulti)(nil)
+
+func (m DoctorMulti) Diagnostics(diags tfdiags.Diagnostics) {
+	for _, v := range m {
+		v.Diagnostics(diags)
+	}
+}
+
+func (m DoctorMulti) Section(name string) {
+	for _, v := range m {
+		v.Section(name)
+	}
+}
+
+func (m DoctorMulti) CheckResult(check string, passed bool, detail string) {
+	for _, v := range m {
+		v.CheckResult(check, passed, detail)
+	}
+}
+
+func (m DoctorMulti) Summary(failed int) {
+	for _, v := range m {
+		v.Summary(failed)
+	}
+}
+
+// The DoctorHuman implementation renders a heading for each section and a
+// line for each check.
+type DoctorHuman struct {
+	view     *View
+	sections int
+}
+
+var _ Doctor = (*DoctorHuman)(nil)
+
+func (v *DoctorHuman) Diagnostics(diags tfdiags.Diagnostics) {
+	v.view.Diagnostics(diags)
+}
+
+func (v *DoctorHuman) Section(name string) {
+	if v.sections > 0 {
+		_, _ = v.view.streams.Println()
+	}
+	v.sections++
+	_, _ = v.view.streams.Println(v.view.colorize.Color(fmt.Sprintf("[bold]%s[reset]", name)))
+}
+
+func (v *DoctorHuman) CheckResult(check string, passed bool, detail string) {
+	_, _ = v.view.streams.Println(v.view.colorize.Color(fmt.Sprintf("  %s  %s: %s", checkStatus(passed), check, detail)))
+}
+
+func (v *DoctorHuman) Summary(failed int) {
+	_, _ = v.view.streams.Println(v.view.colorize.Color("\n" + checksSummary(failed)))
+}
+
+// The DoctorJSON implementation renders a streaming JSON log message for
+// each check, including the section it belongs to.
+type DoctorJSON struct {
+	view    *JSONView
+	section string
+}
+
+var _ Doctor = (*DoctorJSON)(nil)
+
+func (v *DoctorJSON) Diagnostics(diags tfdiags.Diagnostics) {
+	v.view.Diagnostics(diags)
+}
+
+func (v *DoctorJSON) Section(name string) {
+	v.section = name
+}
+
+func (v *DoctorJSON) CheckResult(check string, passed bool, detail string) {
+	v.view.log.Info(
+		fmt.Sprintf("%s: %s: %s", v.section, check, detail),
+		"type", json.MessageDoctorCheck,
+		"section", v.section,
+		"check", check,
+		"passed", passed,
+		"detail", detail,
+	)
+}
+
+func (v *DoctorJSON) Summary(failed int) {
+	if failed == 0 {
+		v.view.Info("All checks passed")
+		return
+	}
+	v.view.Info(fmt.Sprintf("%d of the checks failed", failed))
+}
@@ -0,0 +1,65 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package views
+
+import (
+	"testing"
+
+	"github.com/opentofu/opentofu/internal/command/arguments"
+	"github.com/opentofu/opentofu/internal/terminal"
+)
+
+func TestDoctorHuman(t *testing.T) {
+	streams, done := terminal.StreamsForTesting(t)
+	view := NewView(streams)
+	view.Configure(&arguments.View{NoColor: true})
+	v := NewDoctor(arguments.ViewOptions{ViewType: arguments.ViewHuman}, view)
+	v.Section("Registries")
+	v.CheckResult("registry.opentofu.org", true, "provides modules.v1, providers.v1")
+	v.Section("Plugin cache")
+	v.CheckResult("plugin_cache_dir", false, "/cache is not writable")
+	v.Summary(1)
+
+	got := done(t).Stdout()
+	want := `Registries
+  ok    registry.opentofu.org: provides modules.v1, providers.v1
+
+Plugin cache
+  fail  plugin_cache_dir: /cache is not writable
+
+1 check failed.
+`
+	if got != want {
+		t.Errorf("wrong output\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestDoctorJSON(t *testing.T) {
+	streams, done := terminal.StreamsForTesting(t)
+	v := NewDoctor(arguments.ViewOptions{ViewType: arguments.ViewJSON}, NewView(streams))
+	v.Section("Plugin cache")
+	v.CheckResult("plugin_cache_dir", false, "/cache is not writable")
+	v.Summary(1)
+
+	want := []map[string]any{
+		{
+			"@level":   "info",
+			"@message": "Plugin cache: plugin_cache_dir: /cache is not writable",
+			"@module":  "tofu.ui",
+			"type":     "doctor_check",
+			"section":  "Plugin cache",
+			"check":    "plugin_cache_dir",
+			"passed":   false,
+			"detail":   "/cache is not writable",
+		},
+		{
+			"@level":   "info",
+			"@message": "1 of the checks failed",
+			"@module":  "tofu.ui",
+		},
+	}
+	testJSONViewOutputEquals(t, done(t).Stdout(), want)
+}
@@ -6,6 +6,7 @@
 package views
 
 import (
+	"sort"
 	"sync"
 
 	"github.com/zclconf/go-cty/cty"
@@ -30,6 +31,9 @@ type countHook struct {
 	ToRemove       int
 	ToRemoveAndAdd int
 
+	// Interrupted is set when the operation was interrupted by the user.
+	Interrupted bool
+
 	sync.Mutex
 	pending map[string]plans.Action
 
@@ -48,6 +52,7 @@ func (h *countHook) Reset() {
 	h.Removed = 0
 	h.Imported = 0
 	h.Forgotten = 0
+	h.Interrupted = false
 }
 
 func (h *countHook) PreApply(addr addrs.AbsResourceInstance, gen states.Generation, action plans.Action, priorState, plannedNewState cty.Value) (tofu.HookAction, error) {
@@ -92,6 +97,30 @@ func (h *countHook) PostApply(addr addrs.AbsResourceInstance, gen states.Generat
 	return tofu.HookActionContinue, nil
 }
 
+func (h *countHook) Stopping() {
+	h.Lock()
+	defer h.Unlock()
+
+	h.Interrupted = true
+}
+
+// InProgress returns the sorted addresses of the resource instance objects
+// whose changes were started but never reported as complete, which after an
+// interrupt are the ones whose outcome may not be reflected in the state.
+func (h *countHook) InProgress() []string {
+	h.Lock()
+	defer h.Unlock()
+
+	var ret []string
+	for addr, action := range h.pending {
+		if action != plans.NoOp {
+			ret = append(ret, addr)
+		}
+	}
+	sort.Strings(ret)
+	return ret
+}
+
 func (h *countHook) PostDiff(addr addrs.AbsResourceInstance, gen states.Generation, action plans.Action, priorState, plannedNewState cty.Value) (tofu.HookAction, error) {
 	h.Lock()
 	defer h.Unlock()
@@ -7,6 +7,7 @@ package views
 
 import (
 	"bufio"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -19,6 +20,7 @@ import (
 	"github.com/opentofu/opentofu/internal/command/views/json"
 	"github.com/opentofu/opentofu/internal/lang/marks"
 	"github.com/opentofu/opentofu/internal/plans"
+	"github.com/opentofu/opentofu/internal/providers"
 	"github.com/opentofu/opentofu/internal/states"
 	"github.com/opentofu/opentofu/internal/tofu"
 )
@@ -57,6 +59,9 @@ type applyProgress struct {
 	action plans.Action
 	start  time.Time
 
+	// progress is the most recent progress reported by the provider, if any
+	progress *providers.Progress
+
 	// done is used for post-apply to stop the progress goroutine
 	done chan struct{}
 
@@ -102,12 +107,67 @@ func (h *jsonHook) applyingHeartbeat(progress applyProgress) {
 		case <-h.timeAfter(heartbeatInterval):
 		}
 
+		// The provider may have reported progress since we started.
+		h.applyingLock.Lock()
+		if current, ok := h.applying[progress.addr.String()]; ok {
+			progress.progress = current.progress
+		}
+		h.applyingLock.Unlock()
+
 		elapsed := h.timeNow().Round(time.Second).Sub(progress.start)
-		h.view.Hook(json.NewApplyProgress(progress.addr, progress.action, elapsed))
+		h.view.Hook(progress.hook(elapsed))
 		progress.elapsed <- elapsed
 	}
 }
 
+// hook returns the apply_progress message for the given elapsed time.
+func (p applyProgress) hook(elapsed time.Duration) json.Hook {
+	if p.progress != nil {
+		return json.NewApplyProviderProgress(p.addr, p.action, elapsed, p.progress.Phase, p.progress.Percent)
+	}
+	return json.NewApplyProgress(p.addr, p.action, elapsed)
+}
+
+// ApplyProgress emits an apply_progress message each time the provider
+// reports progress, and records it for subsequent heartbeat messages.
+func (h *jsonHook) ApplyProgress(addr addrs.AbsResourceInstance, gen states.Generation, progress providers.Progress) {
+	key := addr.String()
+
+	h.applyingLock.Lock()
+	current, ok := h.applying[key]
+	if !ok || current.action == plans.NoOp {
+		h.applyingLock.Unlock()
+		return
+	}
+	current.progress = &progress
+	h.applying[key] = current
+	h.applyingLock.Unlock()
+
+	elapsed := h.timeNow().Round(time.Second).Sub(current.start)
+	h.view.Hook(current.hook(elapsed))
+}
+
+// ApplySchedule emits an apply_schedule message describing the changes that
+// are still in progress.
+func (h *jsonHook) ApplySchedule(schedule tofu.ApplySchedule) {
+	now := h.timeNow().Round(time.Second)
+	entries := func(in []tofu.ApplyScheduleEntry) []json.ApplyScheduleEntry {
+		var ret []json.ApplyScheduleEntry
+		for _, entry := range in {
+			elapsed := now.Sub(entry.Since.Round(time.Second))
+			ret = append(ret, json.NewApplyScheduleEntry(entry.Addr, entry.Action, entry.Name, elapsed, entry.WaitingFor))
+		}
+		return ret
+	}
+	h.view.Hook(json.NewApplySchedule(entries(schedule.Running), entries(schedule.Queued), entries(schedule.Blocked)))
+}
+
+// ApplyBudgetExceeded emits an apply_budget_exceeded message when a change
+// takes longer than its resource's lifecycle block allows.
+func (h *jsonHook) ApplyBudgetExceeded(addr addrs.AbsResourceInstance, gen states.Generation, action plans.Action, budget time.Duration) {
+	h.view.Hook(json.NewApplyBudgetExceeded(addr, action, budget))
+}
+
 func (h *jsonHook) PostApply(addr addrs.AbsResourceInstance, gen states.Generation, newState cty.Value, err error) (tofu.HookAction, error) {
 	key := addr.String()
 	h.applyingLock.Lock()
@@ -136,6 +196,27 @@ func (h *jsonHook) PostApply(addr addrs.AbsResourceInstance, gen states.Generati
 	return tofu.HookActionContinue, nil
 }
 
+// Stopping emits a final progress message for each operation that is still
+// in progress when the user interrupts OpenTofu.
+func (h *jsonHook) Stopping() {
+	h.applyingLock.Lock()
+	var inProgress []applyProgress
+	for _, progress := range h.applying {
+		if progress.action != plans.NoOp {
+			inProgress = append(inProgress, progress)
+		}
+	}
+	h.applyingLock.Unlock()
+
+	sort.Slice(inProgress, func(i, j int) bool {
+		return inProgress[i].addr.Less(inProgress[j].addr)
+	})
+	for _, progress := range inProgress {
+		elapsed := h.timeNow().Round(time.Second).Sub(progress.start)
+		h.view.Hook(progress.hook(elapsed))
+	}
+}
+
 func (h *jsonHook) PreProvisionInstanceStep(addr addrs.AbsResourceInstance, typeName string) (tofu.HookAction, error) {
 	h.view.Hook(json.NewProvisionStart(addr, typeName))
 	return tofu.HookActionContinue, nil
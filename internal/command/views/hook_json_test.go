@@ -15,6 +15,7 @@ import (
 
 	"github.com/opentofu/opentofu/internal/addrs"
 	"github.com/opentofu/opentofu/internal/plans"
+	"github.com/opentofu/opentofu/internal/providers"
 	"github.com/opentofu/opentofu/internal/states"
 	"github.com/opentofu/opentofu/internal/terminal"
 	"github.com/opentofu/opentofu/internal/tofu"
@@ -193,6 +194,220 @@ func TestJSONHook_create(t *testing.T) {
 	testJSONViewOutputEquals(t, done(t).Stdout(), want)
 }
 
+func TestJSONHook_stopping(t *testing.T) {
+	streams, done := terminal.StreamsForTesting(t)
+	hook := newJSONHook(NewJSONView(NewView(streams), nil))
+
+	now := time.Now()
+	hook.timeNow = func() time.Time { return now }
+
+	addr := addrs.Resource{
+		Mode: addrs.ManagedResourceMode,
+		Type: "test_instance",
+		Name: "boop",
+	}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)
+	hook.applying[addr.String()] = applyProgress{
+		addr:   addr,
+		action: plans.Create,
+		start:  now.Round(time.Second).Add(-15 * time.Second),
+	}
+
+	hook.Stopping()
+
+	want := []map[string]any{
+		{
+			"@level":   "info",
+			"@message": "test_instance.boop: Still creating... [15s elapsed]",
+			"@module":  "tofu.ui",
+			"type":     "apply_progress",
+			"hook": map[string]any{
+				"action":          string("create"),
+				"elapsed_seconds": float64(15),
+				"resource": map[string]any{
+					"addr":             string("test_instance.boop"),
+					"implied_provider": string("test"),
+					"module":           string(""),
+					"resource":         string("test_instance.boop"),
+					"resource_key":     nil,
+					"resource_name":    string("boop"),
+					"resource_type":    string("test_instance"),
+				},
+			},
+		},
+	}
+	testJSONViewOutputEquals(t, done(t).Stdout(), want)
+}
+
+func TestJSONHook_applyProgress(t *testing.T) {
+	streams, done := terminal.StreamsForTesting(t)
+	hook := newJSONHook(NewJSONView(NewView(streams), nil))
+
+	now := time.Now()
+	hook.timeNow = func() time.Time { return now }
+
+	addr := addrs.Resource{
+		Mode: addrs.ManagedResourceMode,
+		Type: "test_instance",
+		Name: "boop",
+	}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)
+	hook.applying[addr.String()] = applyProgress{
+		addr:   addr,
+		action: plans.Create,
+		start:  now.Round(time.Second).Add(-5 * time.Second),
+	}
+
+	hook.ApplyProgress(addr, states.CurrentGen, providers.Progress{Phase: "booting", Percent: 40})
+	hook.ApplyProgress(addr, states.CurrentGen, providers.Progress{Phase: "configuring", Percent: -1})
+
+	wantResource := map[string]any{
+		"addr":             string("test_instance.boop"),
+		"implied_provider": string("test"),
+		"module":           string(""),
+		"resource":         string("test_instance.boop"),
+		"resource_key":     nil,
+		"resource_name":    string("boop"),
+		"resource_type":    string("test_instance"),
+	}
+	want := []map[string]any{
+		{
+			"@level":   "info",
+			"@message": "test_instance.boop: Still creating... [5s elapsed, 40%: booting]",
+			"@module":  "tofu.ui",
+			"type":     "apply_progress",
+			"hook": map[string]any{
+				"action":          string("create"),
+				"elapsed_seconds": float64(5),
+				"percent":         float64(40),
+				"phase":           string("booting"),
+				"resource":        wantResource,
+			},
+		},
+		{
+			"@level":   "info",
+			"@message": "test_instance.boop: Still creating... [5s elapsed, configuring]",
+			"@module":  "tofu.ui",
+			"type":     "apply_progress",
+			"hook": map[string]any{
+				"action":          string("create"),
+				"elapsed_seconds": float64(5),
+				"phase":           string("configuring"),
+				"resource":        wantResource,
+			},
+		},
+	}
+	testJSONViewOutputEquals(t, done(t).Stdout(), want)
+}
+
+func TestJSONHook_applySchedule(t *testing.T) {
+	streams, done := terminal.StreamsForTesting(t)
+	hook := newJSONHook(NewJSONView(NewView(streams), nil))
+
+	now := time.Now()
+	hook.timeNow = func() time.Time { return now }
+
+	addrA := addrs.Resource{
+		Mode: addrs.ManagedResourceMode,
+		Type: "test_instance",
+		Name: "a",
+	}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)
+	addrB := addrs.Resource{
+		Mode: addrs.ManagedResourceMode,
+		Type: "test_instance",
+		Name: "b",
+	}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)
+
+	hook.ApplySchedule(tofu.ApplySchedule{
+		Running: []tofu.ApplyScheduleEntry{
+			{Addr: addrA, Action: plans.Create, Name: "test_instance.a", Since: now.Round(time.Second).Add(-20 * time.Second)},
+		},
+		Blocked: []tofu.ApplyScheduleEntry{
+			{Addr: addrB, Action: plans.Update, Name: "test_instance.b", Since: now.Round(time.Second).Add(-30 * time.Second), WaitingFor: []string{"test_instance.a"}},
+		},
+	})
+
+	want := []map[string]any{
+		{
+			"@level":   "info",
+			"@message": "Apply schedule: 1 running, 0 queued, 1 blocked",
+			"@module":  "tofu.ui",
+			"type":     "apply_schedule",
+			"hook": map[string]any{
+				"running": []any{
+					map[string]any{
+						"action":          string("create"),
+						"elapsed_seconds": float64(20),
+						"name":            string("test_instance.a"),
+						"resource": map[string]any{
+							"addr":             string("test_instance.a"),
+							"implied_provider": string("test"),
+							"module":           string(""),
+							"resource":         string("test_instance.a"),
+							"resource_key":     nil,
+							"resource_name":    string("a"),
+							"resource_type":    string("test_instance"),
+						},
+					},
+				},
+				"queued": []any{},
+				"blocked": []any{
+					map[string]any{
+						"action":          string("update"),
+						"elapsed_seconds": float64(30),
+						"name":            string("test_instance.b"),
+						"resource": map[string]any{
+							"addr":             string("test_instance.b"),
+							"implied_provider": string("test"),
+							"module":           string(""),
+							"resource":         string("test_instance.b"),
+							"resource_key":     nil,
+							"resource_name":    string("b"),
+							"resource_type":    string("test_instance"),
+						},
+						"waiting_for": []any{string("test_instance.a")},
+					},
+				},
+			},
+		},
+	}
+	testJSONViewOutputEquals(t, done(t).Stdout(), want)
+}
+
+func TestJSONHook_applyBudgetExceeded(t *testing.T) {
+	streams, done := terminal.StreamsForTesting(t)
+	hook := newJSONHook(NewJSONView(NewView(streams), nil))
+
+	addr := addrs.Resource{
+		Mode: addrs.ManagedResourceMode,
+		Type: "test_instance",
+		Name: "boop",
+	}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)
+
+	hook.ApplyBudgetExceeded(addr, states.CurrentGen, plans.Create, 5*time.Minute)
+
+	want := []map[string]any{
+		{
+			"@level":   "info",
+			"@message": "test_instance.boop: Still creating after 5m0s, which is longer than expected",
+			"@module":  "tofu.ui",
+			"type":     "apply_budget_exceeded",
+			"hook": map[string]any{
+				"action":         string("create"),
+				"budget_seconds": float64(300),
+				"resource": map[string]any{
+					"addr":             string("test_instance.boop"),
+					"implied_provider": string("test"),
+					"module":           string(""),
+					"resource":         string("test_instance.boop"),
+					"resource_key":     nil,
+					"resource_name":    string("boop"),
+					"resource_type":    string("test_instance"),
+				},
+			},
+		},
+	}
+	testJSONViewOutputEquals(t, done(t).Stdout(), want)
+}
+
 func TestJSONHook_errors(t *testing.T) {
 	streams, done := terminal.StreamsForTesting(t)
 	hook := newJSONHook(NewJSONView(NewView(streams), nil))
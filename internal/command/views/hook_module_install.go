@@ -9,6 +9,8 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/go-version"
+
+	"github.com/opentofu/opentofu/internal/command/views/json"
 	"github.com/opentofu/opentofu/internal/initwd"
 )
 
@@ -37,11 +39,19 @@ func (h moduleInstallationHookHuman) Install(modulePath string, v *version.Versi
 	}
 }
 
+func (h moduleInstallationHookHuman) ResolveVersion(string, *initwd.ModuleVersionResolution) {
+	// Version resolutions are only explained on request, by the command.
+}
+
 // moduleInstallationHookJSON is the implementation of [initwd.ModuleInstallHooks] that prints the modules
 // installation progress information in JSON format.
 type moduleInstallationHookJSON struct {
 	v              *JSONView
 	showLocalPaths bool
+
+	// summary, if set, collects the installed modules for "tofu init" to
+	// report at the end.
+	summary *json.InitSummary
 }
 
 var _ initwd.ModuleInstallHooks = moduleInstallationHookJSON{}
@@ -52,16 +62,55 @@ func (h moduleInstallationHookJSON) Download(modulePath, packageAddr string, v *
 	} else {
 		h.v.Info(fmt.Sprintf("Downloading %s for %s...", packageAddr, modulePath))
 	}
+	if h.summary != nil {
+		mod := json.InitModule{
+			Path:   modulePath,
+			Source: packageAddr,
+		}
+		if v != nil {
+			mod.Version = v.String()
+		}
+		h.summary.Modules = append(h.summary.Modules, mod)
+	}
 }
 
-func (h moduleInstallationHookJSON) Install(modulePath string, _ *version.Version, localDir string) {
+func (h moduleInstallationHookJSON) Install(modulePath string, v *version.Version, localDir string) {
+	mod := json.InitModule{
+		Path: modulePath,
+		Dir:  localDir,
+	}
+	if v != nil {
+		mod.Version = v.String()
+	}
+	if h.summary != nil {
+		// If the module was downloaded then it's already in the summary,
+		// and so we only need to note where it was installed.
+		found := false
+		for i := range h.summary.Modules {
+			if h.summary.Modules[i].Path == modulePath {
+				h.summary.Modules[i].Dir = localDir
+				mod = h.summary.Modules[i]
+				found = true
+				break
+			}
+		}
+		if !found {
+			h.summary.Modules = append(h.summary.Modules, mod)
+		}
+	}
+
 	if h.showLocalPaths {
-		h.v.Info(fmt.Sprintf("installing %s in %s", modulePath, localDir))
+		h.v.log.Info(fmt.Sprintf("installing %s in %s", modulePath, localDir), "type", json.MessageInitModule, "module", mod)
 	} else {
-		h.v.Info(fmt.Sprintf("installing %s", modulePath))
+		mod.Dir = ""
+		h.v.log.Info(fmt.Sprintf("installing %s", modulePath), "type", json.MessageInitModule, "module", mod)
 	}
 }
 
+func (h moduleInstallationHookJSON) ResolveVersion(string, *initwd.ModuleVersionResolution) {
+	// Version resolutions are only explained on request, by the command.
+}
+
 // moduleInstallationHookMulti is the implementation of [initwd.ModuleInstallHooks] that wraps multiple
 // implementation of [initwd.ModuleInstallHooks] and acts as a proxy for all of those.
 // This is used for the `-json-into` flag.
@@ -80,3 +129,9 @@ func (m moduleInstallationHookMulti) Install(modulePath string, v *version.Versi
 		h.Install(modulePath, v, localDir)
 	}
 }
+
+func (m moduleInstallationHookMulti) ResolveVersion(modulePath string, resolution *initwd.ModuleVersionResolution) {
+	for _, h := range m {
+		h.ResolveVersion(modulePath, resolution)
+	}
+}
@@ -57,6 +57,11 @@ func TestModuleInstallationHooks(t *testing.T) {
 					"@level":   "info",
 					"@message": "installing root.networking",
 					"@module":  "tofu.ui",
+					"module": map[string]any{
+						"path":    "root.networking",
+						"version": "2.5.3",
+					},
+					"type": "init_module",
 				},
 			},
 			wantStdout: withNewline("- root.networking"),
@@ -71,6 +76,12 @@ func TestModuleInstallationHooks(t *testing.T) {
 					"@level":   "info",
 					"@message": "installing root.networking in /path/to/.terraform/modules/networking",
 					"@module":  "tofu.ui",
+					"module": map[string]any{
+						"dir":     "/path/to/.terraform/modules/networking",
+						"path":    "root.networking",
+						"version": "2.5.3",
+					},
+					"type": "init_module",
 				},
 			},
 			wantStdout: withNewline("- root.networking in /path/to/.terraform/modules/networking"),
@@ -9,6 +9,7 @@ import (
 	"bufio"
 	"bytes"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -66,6 +67,9 @@ type uiResourceState struct {
 	Op             uiResourceOp
 	Start          time.Time
 
+	// Progress is the most recent progress reported by the provider, if any.
+	Progress *providers.Progress
+
 	DoneCh chan struct{} // To be used for cancellation
 
 	done chan struct{} // used to coordinate tests
@@ -169,32 +173,187 @@ func (h *UiHook) stillApplying(state uiResourceState) {
 			// Timer up, show status
 		}
 
-		var msg string
-		switch state.Op {
-		case uiResourceModify:
-			msg = "Still modifying..."
-		case uiResourceDestroy:
-			msg = "Still destroying..."
-		case uiResourceCreate:
-			msg = "Still creating..."
-		case uiResourceRead:
-			msg = "Still reading..."
-		case uiResourceUnknown:
+		if state.Op == uiResourceUnknown {
 			return
 		}
 
-		idSuffix := ""
-		if state.IDKey != "" {
-			idSuffix = fmt.Sprintf("%s=%s, ", state.IDKey, truncateId(state.IDValue, maxIdLen))
+		// The provider may have reported progress since we started.
+		h.resourcesLock.Lock()
+		if current, ok := h.resources[state.DispAddr]; ok {
+			state.Progress = current.Progress
 		}
+		h.resourcesLock.Unlock()
 
-		h.println(fmt.Sprintf(
-			h.view.colorize.Color("[reset][bold]%s: %s [%s%s elapsed][reset]"),
-			state.DispAddr,
-			msg,
-			idSuffix,
-			time.Now().Round(time.Second).Sub(state.Start),
-		))
+		h.println(h.stillApplyingMsg(state))
+	}
+}
+
+// ApplyProgress records the progress reported by the provider so that it's
+// included in the periodic status messages, and reports it immediately when
+// the provider moves on to a new phase of the operation.
+func (h *UiHook) ApplyProgress(addr addrs.AbsResourceInstance, gen states.Generation, progress providers.Progress) {
+	key := addr.String()
+
+	h.resourcesLock.Lock()
+	state, ok := h.resources[key]
+	if !ok || state.Op == uiResourceNoOp {
+		h.resourcesLock.Unlock()
+		return
+	}
+	newPhase := state.Progress == nil || state.Progress.Phase != progress.Phase
+	state.Progress = &progress
+	h.resources[key] = state
+	h.resourcesLock.Unlock()
+
+	if newPhase {
+		h.println(h.stillApplyingMsg(state))
+	}
+}
+
+// ApplySchedule reports the changes that are running and what the others are
+// waiting for, if the user asked for it with -show-schedule.
+func (h *UiHook) ApplySchedule(schedule tofu.ApplySchedule) {
+	if !h.view.showSchedule {
+		return
+	}
+
+	now := time.Now().Round(time.Second)
+	var buf strings.Builder
+	fmt.Fprintf(&buf, h.view.colorize.Color("[reset][bold]Apply schedule: %d running, %d queued, %d blocked[reset]"),
+		len(schedule.Running), len(schedule.Queued), len(schedule.Blocked))
+	for _, entry := range schedule.Running {
+		fmt.Fprintf(&buf, "\n  %s: running for %s", entry.Name, now.Sub(entry.Since.Round(time.Second)))
+	}
+	for _, entry := range schedule.Queued {
+		fmt.Fprintf(&buf, "\n  %s: queued for %s, waiting for parallelism", entry.Name, now.Sub(entry.Since.Round(time.Second)))
+	}
+	for _, entry := range schedule.Blocked {
+		if len(entry.WaitingFor) == 0 {
+			fmt.Fprintf(&buf, "\n  %s: ready to start", entry.Name)
+			continue
+		}
+		fmt.Fprintf(&buf, "\n  %s: blocked by %s", entry.Name, strings.Join(entry.WaitingFor, ", "))
+	}
+	h.println(buf.String())
+}
+
+// ApplyBudgetExceeded warns that a change is taking longer than the
+// apply_within duration in its resource's lifecycle block.
+func (h *UiHook) ApplyBudgetExceeded(addr addrs.AbsResourceInstance, gen states.Generation, action plans.Action, budget time.Duration) {
+	dispAddr := addr.String()
+	if gen != states.CurrentGen {
+		dispAddr = fmt.Sprintf("%s (deposed object %s)", dispAddr, gen)
+	}
+
+	var verb string
+	switch action {
+	case plans.Delete:
+		verb = "destroying"
+	case plans.Create:
+		verb = "creating"
+	case plans.Update:
+		verb = "modifying"
+	case plans.Read:
+		verb = "reading"
+	default:
+		verb = "applying"
+	}
+
+	h.println(fmt.Sprintf(
+		h.view.colorize.Color("[reset][bold][yellow]%s: Still %s after %s, which is longer than expected[reset]"),
+		dispAddr,
+		verb,
+		budget,
+	))
+}
+
+// stillApplyingMsg returns the progress message for an operation that has
+// not yet completed.
+func (h *UiHook) stillApplyingMsg(state uiResourceState) string {
+	var msg string
+	switch state.Op {
+	case uiResourceModify:
+		msg = "Still modifying..."
+	case uiResourceDestroy:
+		msg = "Still destroying..."
+	case uiResourceCreate:
+		msg = "Still creating..."
+	case uiResourceRead:
+		msg = "Still reading..."
+	default:
+		msg = "Still in progress..."
+	}
+
+	idSuffix := ""
+	if state.IDKey != "" {
+		idSuffix = fmt.Sprintf("%s=%s, ", state.IDKey, truncateId(state.IDValue, maxIdLen))
+	}
+
+	progressSuffix := ""
+	if state.Progress != nil {
+		if progress := formatProgress(*state.Progress); progress != "" {
+			progressSuffix = ", " + progress
+		}
+	}
+
+	return fmt.Sprintf(
+		h.view.colorize.Color("[reset][bold]%s: %s [%s%s elapsed%s][reset]"),
+		state.DispAddr,
+		msg,
+		idSuffix,
+		time.Now().Round(time.Second).Sub(state.Start),
+		progressSuffix,
+	)
+}
+
+// formatProgress returns a short description of provider-reported progress,
+// such as "45%: waiting for instance to boot".
+func formatProgress(progress providers.Progress) string {
+	// The phase comes from the provider, so we make sure it can't disrupt
+	// the rest of the output.
+	phase := strings.TrimSpace(strings.Map(func(r rune) rune {
+		if !unicode.IsPrint(r) {
+			return ' '
+		}
+		return r
+	}, progress.Phase))
+
+	switch {
+	case progress.HasPercent() && phase != "":
+		return fmt.Sprintf("%d%%: %s", progress.Percent, phase)
+	case progress.HasPercent():
+		return fmt.Sprintf("%d%%", progress.Percent)
+	default:
+		return phase
+	}
+}
+
+// Stopping reports the operations that are still in progress when the user
+// interrupts OpenTofu, so that it's clear what OpenTofu is waiting for
+// before it can exit.
+func (h *UiHook) Stopping() {
+	h.resourcesLock.Lock()
+	var inProgress []uiResourceState
+	for _, state := range h.resources {
+		if state.Op != uiResourceNoOp {
+			inProgress = append(inProgress, state)
+		}
+	}
+	h.resourcesLock.Unlock()
+
+	if len(inProgress) == 0 {
+		return
+	}
+	sort.Slice(inProgress, func(i, j int) bool {
+		return inProgress[i].DispAddr < inProgress[j].DispAddr
+	})
+
+	h.println(fmt.Sprintf(
+		h.view.colorize.Color("[reset][bold]Waiting for %d operation(s) in progress to complete:[reset]"),
+		len(inProgress),
+	))
+	for _, state := range inProgress {
+		h.println(h.stillApplyingMsg(state))
 	}
 }
 
@@ -586,6 +586,155 @@ func TestPreRefresh_noID(t *testing.T) {
 	}
 }
 
+// Test that the Stopping hook reports the operations still in progress.
+func TestUiHookStopping(t *testing.T) {
+	streams, done := terminal.StreamsForTesting(t)
+	view := NewView(streams)
+	h := NewUiHook(view)
+	start := time.Now().Round(time.Second).Add(-30 * time.Second)
+	h.resources = map[string]uiResourceState{
+		"test_instance.foo": {
+			DispAddr: "test_instance.foo",
+			Op:       uiResourceCreate,
+			Start:    start,
+		},
+		"test_instance.bar": {
+			DispAddr: "test_instance.bar",
+			IDKey:    "id",
+			IDValue:  "abc123",
+			Op:       uiResourceDestroy,
+			Start:    start,
+		},
+		"test_instance.baz": {
+			DispAddr: "test_instance.baz",
+			Op:       uiResourceNoOp,
+			Start:    start,
+		},
+	}
+
+	h.Stopping()
+
+	result := done(t)
+	want := `Waiting for 2 operation(s) in progress to complete:
+test_instance.bar: Still destroying... [id=abc123, 30s elapsed]
+test_instance.foo: Still creating... [30s elapsed]
+`
+	if got := result.Stdout(); got != want {
+		t.Fatalf("unexpected output\n got: %q\nwant: %q", got, want)
+	}
+}
+
+// Test that the Stopping hook produces no output when nothing is in progress.
+func TestUiHookStopping_idle(t *testing.T) {
+	streams, done := terminal.StreamsForTesting(t)
+	view := NewView(streams)
+	h := NewUiHook(view)
+
+	h.Stopping()
+
+	if got := done(t).Stdout(); got != "" {
+		t.Fatalf("unexpected output: %q", got)
+	}
+}
+
+// Test that progress reported by the provider is shown when the phase changes.
+func TestUiHookApplyProgress(t *testing.T) {
+	streams, done := terminal.StreamsForTesting(t)
+	view := NewView(streams)
+	h := NewUiHook(view)
+	h.resources = map[string]uiResourceState{
+		"test_instance.foo": {
+			DispAddr: "test_instance.foo",
+			Op:       uiResourceCreate,
+			Start:    time.Now().Round(time.Second).Add(-30 * time.Second),
+		},
+	}
+
+	addr := addrs.Resource{
+		Mode: addrs.ManagedResourceMode,
+		Type: "test_instance",
+		Name: "foo",
+	}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)
+
+	h.ApplyProgress(addr, states.CurrentGen, providers.Progress{Phase: "booting", Percent: -1})
+	// A change in percentage alone doesn't produce output, to avoid
+	// flooding the output when providers report frequently.
+	h.ApplyProgress(addr, states.CurrentGen, providers.Progress{Phase: "booting", Percent: 10})
+	h.ApplyProgress(addr, states.CurrentGen, providers.Progress{Phase: "waiting\nfor health checks", Percent: 50})
+
+	if got, want := h.resources["test_instance.foo"].Progress, (&providers.Progress{Phase: "waiting\nfor health checks", Percent: 50}); *got != *want {
+		t.Errorf("wrong recorded progress %#v; want %#v", got, want)
+	}
+
+	result := done(t)
+	want := `test_instance.foo: Still creating... [30s elapsed, booting]
+test_instance.foo: Still creating... [30s elapsed, 50%: waiting for health checks]
+`
+	if got := result.Stdout(); got != want {
+		t.Fatalf("unexpected output\n got: %q\nwant: %q", got, want)
+	}
+}
+
+// Test that the apply schedule is shown only when requested.
+func TestUiHookApplySchedule(t *testing.T) {
+	streams, done := terminal.StreamsForTesting(t)
+	view := NewView(streams)
+	h := NewUiHook(view)
+
+	since := time.Now().Round(time.Second).Add(-30 * time.Second)
+	schedule := tofu.ApplySchedule{
+		Running: []tofu.ApplyScheduleEntry{
+			{Name: "test_instance.a", Action: plans.Create, Since: since},
+		},
+		Queued: []tofu.ApplyScheduleEntry{
+			{Name: "test_instance.b", Action: plans.Create, Since: since},
+		},
+		Blocked: []tofu.ApplyScheduleEntry{
+			{Name: "test_instance.c", Action: plans.Update, Since: since, WaitingFor: []string{"test_instance.a", "test_instance.b"}},
+			{Name: "test_instance.d", Action: plans.Delete, Since: since},
+		},
+	}
+
+	h.ApplySchedule(schedule)
+	view.SetShowSchedule(true)
+	h.ApplySchedule(schedule)
+
+	result := done(t)
+	want := `Apply schedule: 1 running, 1 queued, 2 blocked
+  test_instance.a: running for 30s
+  test_instance.b: queued for 30s, waiting for parallelism
+  test_instance.c: blocked by test_instance.a, test_instance.b
+  test_instance.d: ready to start
+`
+	if got := result.Stdout(); got != want {
+		t.Fatalf("unexpected output\n got: %q\nwant: %q", got, want)
+	}
+}
+
+// Test the warning for a change that takes longer than expected.
+func TestUiHookApplyBudgetExceeded(t *testing.T) {
+	streams, done := terminal.StreamsForTesting(t)
+	view := NewView(streams)
+	h := NewUiHook(view)
+
+	addr := addrs.Resource{
+		Mode: addrs.ManagedResourceMode,
+		Type: "test_instance",
+		Name: "foo",
+	}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)
+
+	h.ApplyBudgetExceeded(addr, states.CurrentGen, plans.Update, 90*time.Second)
+	h.ApplyBudgetExceeded(addr, states.DeposedKey("deadbeef"), plans.Delete, time.Minute)
+
+	result := done(t)
+	want := `test_instance.foo: Still modifying after 1m30s, which is longer than expected
+test_instance.foo (deposed object deadbeef): Still destroying after 1m0s, which is longer than expected
+`
+	if got := result.Stdout(); got != want {
+		t.Fatalf("unexpected output\n got: %q\nwant: %q", got, want)
+	}
+}
+
 // Test the very simple PreImportState hook.
 func TestPreImportState(t *testing.T) {
 	streams, done := terminal.StreamsForTesting(t)
@@ -7,9 +7,13 @@ package views
 
 import (
 	"fmt"
+	"strings"
+
+	ctyjson "github.com/zclconf/go-cty/cty/json"
 
 	"github.com/opentofu/opentofu/internal/addrs"
 	"github.com/opentofu/opentofu/internal/command/arguments"
+	"github.com/opentofu/opentofu/internal/command/views/json"
 	"github.com/opentofu/opentofu/internal/tfdiags"
 	"github.com/opentofu/opentofu/internal/tofu"
 )
@@ -24,6 +28,15 @@ type Import interface {
 	Success()
 	UnsupportedLocalOp()
 
+	// DiscoveredResources shows the existing objects of the given resource
+	// type that were found by "tofu import -discover", numbered from one in
+	// the given order.
+	DiscoveredResources(typeName string, resources []tofu.DiscoveredResource)
+	// ImportBlocksGenerated shows the import blocks generated by
+	// "tofu import -discover", which were written to the given path, or
+	// which are included in the output if the path is empty.
+	ImportBlocksGenerated(src []byte, path string)
+
 	// Backend returns the non-command view that contains methods to provide
 	// progress output for the backend operations.
 	Backend() Backend
@@ -82,6 +95,18 @@ func (m ImportMulti) UnsupportedLocalOp() {
 	}
 }
 
+func (m ImportMulti) DiscoveredResources(typeName string, resources []tofu.DiscoveredResource) {
+	for _, o := range m {
+		o.DiscoveredResources(typeName, resources)
+	}
+}
+
+func (m ImportMulti) ImportBlocksGenerated(src []byte, path string) {
+	for _, o := range m {
+		o.ImportBlocksGenerated(src, path)
+	}
+}
+
 func (m ImportMulti) Hooks() []tofu.Hook {
 	var hooks []tofu.Hook
 	for _, o := range m {
@@ -160,6 +185,38 @@ func (v *ImportHuman) UnsupportedLocalOp() {
 	v.Diagnostics(tfdiags.Diagnostics{diagUnsupportedLocalOp})
 }
 
+func (v *ImportHuman) DiscoveredResources(typeName string, resources []tofu.DiscoveredResource) {
+	if len(resources) == 0 {
+		_, _ = v.view.streams.Println(fmt.Sprintf("No existing objects of resource type %q were found.", typeName))
+		return
+	}
+
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "[reset][bold]Found %d existing object(s) of resource type %q:[reset]\n", len(resources), typeName)
+	for i, r := range resources {
+		fmt.Fprintf(&buf, "  %d. %s", i+1, r.DisplayName)
+		if r.ManagedBy != nil {
+			fmt.Fprintf(&buf, " [dim](already managed by %s)[reset]", r.ManagedBy)
+		}
+		buf.WriteString("\n")
+	}
+	_, _ = v.view.streams.Println(v.view.colorize.Color(buf.String()))
+}
+
+func (v *ImportHuman) ImportBlocksGenerated(src []byte, path string) {
+	const hint = `Run "tofu plan" to preview importing the objects. Each of the resources
+must have a configuration block, which "tofu plan -generate-config-out=PATH"
+can generate for you.`
+
+	if path == "" {
+		_, _ = v.view.streams.Println(string(src))
+		_, _ = v.view.streams.Println(hint)
+		return
+	}
+	output := v.view.colorize.Color(fmt.Sprintf("[reset][green]Import blocks written to %s.[reset]\n\n%s", path, hint))
+	_, _ = v.view.streams.Println(output)
+}
+
 func (v *ImportHuman) Hooks() []tofu.Hook {
 	return []tofu.Hook{NewUiHook(v.view)}
 }
@@ -203,6 +260,49 @@ func (v *ImportJSON) UnsupportedLocalOp() {
 	v.Diagnostics(tfdiags.Diagnostics{diagUnsupportedLocalOp})
 }
 
+func (v *ImportJSON) DiscoveredResources(typeName string, resources []tofu.DiscoveredResource) {
+	if len(resources) == 0 {
+		v.view.Info(fmt.Sprintf("No existing objects of resource type %q were found", typeName))
+		return
+	}
+	for i, r := range resources {
+		identity, err := ctyjson.Marshal(r.Identity, r.Identity.Type())
+		if err != nil {
+			// Identities come from the provider and conform to its
+			// schema, so this should never happen, but if it does then
+			// the identity is reported as null.
+			identity = nil
+		}
+		discovered := json.DiscoveredResource{
+			Index:        i + 1,
+			ResourceType: typeName,
+			DisplayName:  r.DisplayName,
+			Identity:     identity,
+		}
+		if r.ManagedBy != nil {
+			discovered.ManagedBy = r.ManagedBy.String()
+		}
+		v.view.log.Info(
+			discovered.String(),
+			"type", json.MessageImportDiscoveredResource,
+			"resource", discovered,
+		)
+	}
+}
+
+func (v *ImportJSON) ImportBlocksGenerated(src []byte, path string) {
+	msg := "Generated import blocks"
+	if path != "" {
+		msg = fmt.Sprintf("Import blocks written to %s", path)
+	}
+	v.view.log.Info(
+		msg,
+		"type", json.MessageImportBlocksGenerated,
+		"path", path,
+		"import_blocks", string(src),
+	)
+}
+
 func (v *ImportJSON) Hooks() []tofu.Hook {
 	return []tofu.Hook{newJSONHook(v.view)}
 }
@@ -10,6 +10,8 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/zclconf/go-cty/cty"
+
 	"github.com/opentofu/opentofu/internal/addrs"
 	"github.com/opentofu/opentofu/internal/command/arguments"
 	"github.com/opentofu/opentofu/internal/tfdiags"
@@ -109,6 +111,91 @@ configured to use a non-local backend. This backend doesn't support this
 operation.
 `,
 		},
+		"discovered resources": {
+			viewCall: func(v Import) {
+				managedBy := addrs.Resource{
+					Mode: addrs.ManagedResourceMode,
+					Type: "test_instance",
+					Name: "existing",
+				}.Absolute(addrs.RootModuleInstance).Instance(addrs.NoKey)
+				v.DiscoveredResources("test_instance", []tofu.DiscoveredResource{
+					{
+						DisplayName: "Instance a",
+						Identity:    cty.ObjectVal(map[string]cty.Value{"name": cty.StringVal("a")}),
+						ManagedBy:   &managedBy,
+					},
+					{
+						DisplayName: "Instance b",
+						Identity:    cty.ObjectVal(map[string]cty.Value{"name": cty.StringVal("b")}),
+					},
+				})
+			},
+			wantStdout: `Found 2 existing object(s) of resource type "test_instance":
+  1. Instance a (already managed by test_instance.existing)
+  2. Instance b
+
+`,
+			wantJson: []map[string]any{
+				{
+					"@level":   "info",
+					"@message": `Discovered test_instance "Instance a", already managed by test_instance.existing`,
+					"@module":  "tofu.ui",
+					"resource": map[string]any{
+						"index":         float64(1),
+						"resource_type": "test_instance",
+						"display_name":  "Instance a",
+						"identity":      map[string]any{"name": "a"},
+						"managed_by":    "test_instance.existing",
+					},
+					"type": "import_discovered_resource",
+				},
+				{
+					"@level":   "info",
+					"@message": `Discovered test_instance "Instance b"`,
+					"@module":  "tofu.ui",
+					"resource": map[string]any{
+						"index":         float64(2),
+						"resource_type": "test_instance",
+						"display_name":  "Instance b",
+						"identity":      map[string]any{"name": "b"},
+					},
+					"type": "import_discovered_resource",
+				},
+			},
+		},
+		"no discovered resources": {
+			viewCall: func(v Import) {
+				v.DiscoveredResources("test_instance", nil)
+			},
+			wantStdout: withNewline(`No existing objects of resource type "test_instance" were found.`),
+			wantJson: []map[string]any{
+				{
+					"@level":   "info",
+					"@message": `No existing objects of resource type "test_instance" were found`,
+					"@module":  "tofu.ui",
+				},
+			},
+		},
+		"import blocks written": {
+			viewCall: func(v Import) {
+				v.ImportBlocksGenerated([]byte("import {}\n"), "imports.tf")
+			},
+			wantStdout: withNewline(`Import blocks written to imports.tf.
+
+Run "tofu plan" to preview importing the objects. Each of the resources
+must have a configuration block, which "tofu plan -generate-config-out=PATH"
+can generate for you.`),
+			wantJson: []map[string]any{
+				{
+					"@level":        "info",
+					"@message":      "Import blocks written to imports.tf",
+					"@module":       "tofu.ui",
+					"import_blocks": "import {}\n",
+					"path":          "imports.tf",
+					"type":          "import_blocks_generated",
+				},
+			},
+		},
 		// Diagnostics
 		"warning": {
 			viewCall: func(v Import) {
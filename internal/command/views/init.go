@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/opentofu/opentofu/internal/command/arguments"
+	"github.com/opentofu/opentofu/internal/command/views/json"
 	"github.com/opentofu/opentofu/internal/initwd"
 	"github.com/opentofu/opentofu/internal/tfdiags"
 )
@@ -43,8 +44,21 @@ type Init interface {
 	ProviderInstallationInterrupted()
 	LockFileCreated()
 	LockFileChanged()
+
+	InferringProviderRequirements()
+	InferredProviderRequirements(module string, block string)
+
+	// VersionResolution explains how a version of a module or provider was
+	// selected, when requested with -explain-versions.
+	VersionResolution(r *json.VersionResolution)
+
 	Hooks(showLocalDir bool) initwd.ModuleInstallHooks
 
+	// Summary reports everything that init did, once it has completed
+	// successfully. backendType is the type of the backend configured for
+	// the working directory.
+	Summary(backendType string)
+
 	// Backend returns the non-command view that contains methods to provide
 	// progress output for the backend operations.
 	Backend() Backend
@@ -216,6 +230,30 @@ func (m InitMulti) LockFileChanged() {
 	}
 }
 
+func (m InitMulti) InferringProviderRequirements() {
+	for _, i := range m {
+		i.InferringProviderRequirements()
+	}
+}
+
+func (m InitMulti) InferredProviderRequirements(module string, block string) {
+	for _, i := range m {
+		i.InferredProviderRequirements(module, block)
+	}
+}
+
+func (m InitMulti) VersionResolution(r *json.VersionResolution) {
+	for _, i := range m {
+		i.VersionResolution(r)
+	}
+}
+
+func (m InitMulti) Summary(backendType string) {
+	for _, o := range m {
+		o.Summary(backendType)
+	}
+}
+
 func (m InitMulti) Hooks(showLocalPath bool) initwd.ModuleInstallHooks {
 	hooks := make([]initwd.ModuleInstallHooks, len(m))
 	for i, o := range m {
@@ -395,6 +433,68 @@ in the .terraform.lock.hcl file. Review those changes and commit them to your
 version control system if they represent changes you intended to make.`))
 }
 
+func (v *InitHuman) InferringProviderRequirements() {
+	_, _ = v.view.streams.Println(v.view.colorize.Color("\n[reset][bold]Inferring provider requirements..."))
+}
+
+func (v *InitHuman) InferredProviderRequirements(module string, block string) {
+	_, _ = v.view.streams.Println(v.view.colorize.Color(fmt.Sprintf(
+		"\nSome resources in [bold]%s[reset] rely on implied provider source addresses.\nAdd the following to that module to declare them explicitly:\n", module,
+	)))
+	_, _ = v.view.streams.Print(block)
+}
+
+func (v *InitHuman) VersionResolution(r *json.VersionResolution) {
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "\n[bold]Version selection for %s %s", r.Kind, r.Subject)
+	if r.Source != "" {
+		fmt.Fprintf(&buf, " (%s)", r.Source)
+	}
+	buf.WriteString(":[reset]\n  Constraints:\n")
+	if len(r.Constraints) == 0 {
+		buf.WriteString("    - none, so any version is acceptable\n")
+	}
+	for _, c := range r.Constraints {
+		constraint := "any version"
+		if c.Constraint != "" {
+			constraint = fmt.Sprintf("%q", c.Constraint)
+		}
+		module := c.Module
+		if module == "" {
+			module = "the root module"
+		}
+		fmt.Fprintf(&buf, "    - %s in %s", constraint, module)
+		if c.Filename != "" {
+			fmt.Fprintf(&buf, " (%s:%d)", c.Filename, c.Line)
+		}
+		buf.WriteString("\n")
+	}
+	buf.WriteString("  Candidates:\n")
+	if len(r.Candidates) == 0 {
+		buf.WriteString("    - none available\n")
+	}
+	for _, c := range r.Candidates {
+		if c.Rejected == "" {
+			fmt.Fprintf(&buf, "    - %s: [green]selected[reset]\n", c.Version)
+		} else {
+			fmt.Fprintf(&buf, "    - %s: %s\n", c.Version, c.Rejected)
+		}
+	}
+	if r.Locked != "" {
+		fmt.Fprintf(&buf, "  Locked in the dependency lock file: %s\n", r.Locked)
+	}
+	if r.Selected == "" {
+		buf.WriteString("  [red]No available version is acceptable.[reset]\n")
+	} else {
+		fmt.Fprintf(&buf, "  Selected: %s\n", r.Selected)
+	}
+	_, _ = v.view.streams.Print(v.view.colorize.Color(buf.String()))
+}
+
+func (v *InitHuman) Summary(_ string) {
+	// The human-readable output already describes each step as it happens.
+}
+
 func (v *InitHuman) Hooks(showLocalPath bool) initwd.ModuleInstallHooks {
 	return &moduleInstallationHookHuman{
 		v:              v.view,
@@ -410,6 +510,9 @@ func (v *InitHuman) Backend() Backend {
 
 type InitJSON struct {
 	view *JSONView
+
+	// summary collects what init did so far, to be reported by Summary.
+	summary *json.InitSummary
 }
 
 var _ Init = (*InitJSON)(nil)
@@ -466,15 +569,24 @@ func (v *InitJSON) InitializingProviderPlugins() {
 }
 
 func (v *InitJSON) ProviderAlreadyInstalled(provider string, version string, inCache bool) {
+	p := json.InitProvider{
+		Provider: provider,
+		Version:  version,
+		Action:   json.InitProviderAlreadyInstalled,
+		InCache:  inCache,
+	}
 	if inCache {
-		v.view.Info(fmt.Sprintf("Detected previously-installed %s v%s in the shared cache directory", provider, version))
+		v.providerEvent(fmt.Sprintf("Detected previously-installed %s v%s in the shared cache directory", provider, version), p)
 	} else {
-		v.view.Info(fmt.Sprintf("Using previously-installed %s v%s", provider, version))
+		v.providerEvent(fmt.Sprintf("Using previously-installed %s v%s", provider, version), p)
 	}
 }
 
 func (v *InitJSON) BuiltInProviderAvailable(provider string) {
-	v.view.Info(fmt.Sprintf("%s is built in to OpenTofu", provider))
+	v.providerEvent(fmt.Sprintf("%s is built in to OpenTofu", provider), json.InitProvider{
+		Provider: provider,
+		Action:   json.InitProviderBuiltIn,
+	})
 }
 
 func (v *InitJSON) ReusingLockFileVersion(provider string) {
@@ -490,7 +602,12 @@ func (v *InitJSON) FindingLatestProviderVersion(provider string) {
 }
 
 func (v *InitJSON) UsingProviderFromCache(provider string, version string) {
-	v.view.Info(fmt.Sprintf("Using %s v%s from the shared cache directory", provider, version))
+	v.providerEvent(fmt.Sprintf("Using %s v%s from the shared cache directory", provider, version), json.InitProvider{
+		Provider: provider,
+		Version:  version,
+		Action:   json.InitProviderLinkedFromCache,
+		InCache:  true,
+	})
 }
 
 func (v *InitJSON) InstallingProvider(provider string, version string, toCache bool) {
@@ -502,14 +619,43 @@ func (v *InitJSON) InstallingProvider(provider string, version string, toCache b
 }
 
 func (v *InitJSON) ProviderInstalled(provider string, version string, authResult string, keyID string) {
+	p := json.InitProvider{
+		Provider:       provider,
+		Version:        version,
+		Action:         json.InitProviderInstalled,
+		Authentication: authResult,
+		KeyID:          keyID,
+	}
 	if keyID != "" {
 		keyID = fmt.Sprintf(", key ID %s", keyID)
 	}
-	v.view.Info(fmt.Sprintf("Installed %s v%s (%s%s)", provider, version, authResult, keyID))
+	v.providerEvent(fmt.Sprintf("Installed %s v%s (%s%s)", provider, version, authResult, keyID), p)
 }
 
 func (v *InitJSON) ProviderInstalledSkippedSignature(provider string, version string) {
-	v.view.Warn(fmt.Sprintf("Installed %s v%s. Signature validation was skipped due to the registry not containing GPG keys for this provider", provider, version))
+	p := json.InitProvider{
+		Provider:         provider,
+		Version:          version,
+		Action:           json.InitProviderInstalled,
+		SignatureSkipped: true,
+	}
+	v.initSummary().Providers = append(v.initSummary().Providers, p)
+	v.view.log.Warn(
+		fmt.Sprintf("Installed %s v%s. Signature validation was skipped due to the registry not containing GPG keys for this provider", provider, version),
+		"type", json.MessageInitProvider,
+		"provider", p,
+	)
+}
+
+// providerEvent records the given provider in the summary and emits it as
+// an init_provider message with the given human-readable message.
+func (v *InitJSON) providerEvent(msg string, p json.InitProvider) {
+	v.initSummary().Providers = append(v.initSummary().Providers, p)
+	v.view.log.Info(
+		msg,
+		"type", json.MessageInitProvider,
+		"provider", p,
+	)
 }
 
 func (v *InitJSON) WaitingForCacheLock(cacheDir string) {
@@ -531,6 +677,7 @@ func (v *InitJSON) ProviderInstallationInterrupted() {
 }
 
 func (v *InitJSON) LockFileCreated() {
+	v.initSummary().LockFile = json.InitLockFileCreated
 	v.view.Info("OpenTofu has created a lock file .terraform.lock.hcl to record the provider " +
 		"selections it made above. Include this file in your version control repository " +
 		"so that OpenTofu can guarantee to make the same selections by default when " +
@@ -538,20 +685,73 @@ func (v *InitJSON) LockFileCreated() {
 }
 
 func (v *InitJSON) LockFileChanged() {
+	v.initSummary().LockFile = json.InitLockFileUpdated
 	v.view.Info("OpenTofu has made some changes to the provider dependency selections recorded " +
 		"in the .terraform.lock.hcl file. Review those changes and commit them to your " +
 		"version control system if they represent changes you intended to make.")
 }
 
+func (v *InitJSON) InferringProviderRequirements() {
+	v.view.Info("Inferring provider requirements...")
+}
+
+func (v *InitJSON) InferredProviderRequirements(module string, block string) {
+	v.view.Info(fmt.Sprintf("Suggested provider requirements for %s:\n%s", module, block))
+}
+
+func (v *InitJSON) VersionResolution(r *json.VersionResolution) {
+	v.view.log.Info(
+		r.String(),
+		"type", json.MessageInitVersionResolution,
+		"resolution", r,
+	)
+}
+
 func (v *InitJSON) Hooks(showLocalPath bool) initwd.ModuleInstallHooks {
 	return &moduleInstallationHookJSON{
 		v:              v.view,
 		showLocalPaths: showLocalPath,
+		summary:        v.initSummary(),
 	}
 }
 
 func (v *InitJSON) Backend() Backend {
 	return &BackendJSON{
-		view: v.view,
+		view:        v.view,
+		initSummary: v.initSummary(),
+	}
+}
+
+func (v *InitJSON) Summary(backendType string) {
+	summary := v.initSummary()
+	summary.Backend = backendType
+	if summary.Modules == nil {
+		summary.Modules = []json.InitModule{}
+	}
+	if summary.Providers == nil {
+		summary.Providers = []json.InitProvider{}
+	}
+	if summary.LockFile == "" {
+		summary.LockFile = json.InitLockFileUnchanged
+	}
+	summary.Changed = summary.BackendChanged || len(summary.Modules) > 0 || summary.LockFile != json.InitLockFileUnchanged
+	for _, p := range summary.Providers {
+		if p.Action == json.InitProviderInstalled || p.Action == json.InitProviderLinkedFromCache {
+			summary.Changed = true
+		}
+	}
+	v.view.log.Info(
+		summary.String(),
+		"type", json.MessageInitSummary,
+		"summary", summary,
+	)
+}
+
+// initSummary returns the summary that is collected as init progresses,
+// creating it on first use.
+func (v *InitJSON) initSummary() *json.InitSummary {
+	if v.summary == nil {
+		v.summary = &json.InitSummary{}
 	}
+	return v.summary
 }
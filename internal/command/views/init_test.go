@@ -12,6 +12,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/opentofu/opentofu/internal/command/arguments"
+	"github.com/opentofu/opentofu/internal/command/views/json"
 	"github.com/opentofu/opentofu/internal/terminal"
 	"github.com/opentofu/opentofu/internal/tfdiags"
 )
@@ -157,6 +158,12 @@ func TestInitViews(t *testing.T) {
 					"@level":   "info",
 					"@message": "Using previously-installed hashicorp/aws v5.0.0",
 					"@module":  "tofu.ui",
+					"provider": map[string]any{
+						"action":   "already_installed",
+						"provider": "hashicorp/aws",
+						"version":  "5.0.0",
+					},
+					"type": "init_provider",
 				},
 			},
 			wantStdout: withNewline("- Using previously-installed hashicorp/aws v5.0.0"),
@@ -170,6 +177,13 @@ func TestInitViews(t *testing.T) {
 					"@level":   "info",
 					"@message": "Detected previously-installed hashicorp/aws v5.0.0 in the shared cache directory",
 					"@module":  "tofu.ui",
+					"provider": map[string]any{
+						"action":   "already_installed",
+						"in_cache": true,
+						"provider": "hashicorp/aws",
+						"version":  "5.0.0",
+					},
+					"type": "init_provider",
 				},
 			},
 			wantStdout: withNewline("- Detected previously-installed hashicorp/aws v5.0.0 in the shared cache directory"),
@@ -183,6 +197,11 @@ func TestInitViews(t *testing.T) {
 					"@level":   "info",
 					"@message": "terraform is built in to OpenTofu",
 					"@module":  "tofu.ui",
+					"provider": map[string]any{
+						"action":   "built_in",
+						"provider": "terraform",
+					},
+					"type": "init_provider",
 				},
 			},
 			wantStdout: withNewline("- terraform is built in to OpenTofu"),
@@ -235,6 +254,13 @@ func TestInitViews(t *testing.T) {
 					"@level":   "info",
 					"@message": "Using hashicorp/aws v5.0.0 from the shared cache directory",
 					"@module":  "tofu.ui",
+					"provider": map[string]any{
+						"action":   "linked_from_cache",
+						"in_cache": true,
+						"provider": "hashicorp/aws",
+						"version":  "5.0.0",
+					},
+					"type": "init_provider",
 				},
 			},
 			wantStdout: withNewline("- Using hashicorp/aws v5.0.0 from the shared cache directory"),
@@ -274,6 +300,13 @@ func TestInitViews(t *testing.T) {
 					"@level":   "info",
 					"@message": "Installed hashicorp/aws v5.0.0 (signed by HashiCorp)",
 					"@module":  "tofu.ui",
+					"provider": map[string]any{
+						"action":         "installed",
+						"authentication": "signed by HashiCorp",
+						"provider":       "hashicorp/aws",
+						"version":        "5.0.0",
+					},
+					"type": "init_provider",
 				},
 			},
 			wantStdout: withNewline("- Installed hashicorp/aws v5.0.0 (signed by HashiCorp)"),
@@ -287,6 +320,14 @@ func TestInitViews(t *testing.T) {
 					"@level":   "info",
 					"@message": "Installed hashicorp/aws v5.0.0 (signed, key ID 34365D9472D7468F)",
 					"@module":  "tofu.ui",
+					"provider": map[string]any{
+						"action":         "installed",
+						"authentication": "signed",
+						"key_id":         "34365D9472D7468F",
+						"provider":       "hashicorp/aws",
+						"version":        "5.0.0",
+					},
+					"type": "init_provider",
 				},
 			},
 			wantStdout: withNewline("- Installed hashicorp/aws v5.0.0 (signed, key ID 34365D9472D7468F)"),
@@ -343,6 +384,32 @@ func TestInitViews(t *testing.T) {
 			},
 			wantStdout: withNewline("\nOpenTofu has made some changes to the provider dependency selections recorded\nin the .terraform.lock.hcl file. Review those changes and commit them to your\nversion control system if they represent changes you intended to make."),
 		},
+		"inferringProviderRequirements": {
+			viewCall: func(init Init) {
+				init.InferringProviderRequirements()
+			},
+			wantJson: []map[string]any{
+				{
+					"@level":   "info",
+					"@message": "Inferring provider requirements...",
+					"@module":  "tofu.ui",
+				},
+			},
+			wantStdout: withNewline("\nInferring provider requirements..."),
+		},
+		"inferredProviderRequirements": {
+			viewCall: func(init Init) {
+				init.InferredProviderRequirements("the root module", "terraform {\n}\n")
+			},
+			wantJson: []map[string]any{
+				{
+					"@level":   "info",
+					"@message": "Suggested provider requirements for the root module:\nterraform {\n}\n",
+					"@module":  "tofu.ui",
+				},
+			},
+			wantStdout: "\nSome resources in the root module rely on implied provider source addresses.\nAdd the following to that module to declare them explicitly:\n\nterraform {\n}\n",
+		},
 		// to stderr
 		"configError": {
 			viewCall: func(init Init) {
@@ -367,6 +434,13 @@ func TestInitViews(t *testing.T) {
 					"@level":   "warn",
 					"@message": "Installed hashicorp/random v3.0.0. Signature validation was skipped due to the registry not containing GPG keys for this provider",
 					"@module":  "tofu.ui",
+					"provider": map[string]any{
+						"action":            "installed",
+						"provider":          "hashicorp/random",
+						"signature_skipped": true,
+						"version":           "3.0.0",
+					},
+					"type": "init_provider",
 				},
 			},
 			wantStdout: withNewline("- Installed hashicorp/random v3.0.0. Signature validation was skipped due to the registry not containing GPG keys for this provider"),
@@ -400,6 +474,88 @@ func TestInitViews(t *testing.T) {
 			wantStdout: "",
 			wantStderr: withNewline("Provider installation was canceled by an interrupt signal."),
 		},
+		"summary_changed": {
+			viewCall: func(init Init) {
+				init.ProviderInstalled("hashicorp/aws", "5.0.0", "signed by HashiCorp", "")
+				init.Summary("s3")
+			},
+			wantJson: []map[string]any{
+				{
+					"@level":   "info",
+					"@message": "Installed hashicorp/aws v5.0.0 (signed by HashiCorp)",
+					"@module":  "tofu.ui",
+					"provider": map[string]any{
+						"action":         "installed",
+						"authentication": "signed by HashiCorp",
+						"provider":       "hashicorp/aws",
+						"version":        "5.0.0",
+					},
+					"type": "init_provider",
+				},
+				{
+					"@level":   "info",
+					"@message": "Initialization complete! Changes: 1 provider(s) installed.",
+					"@module":  "tofu.ui",
+					"summary": map[string]any{
+						"backend":         "s3",
+						"backend_changed": false,
+						"changed":         true,
+						"lock_file":       "unchanged",
+						"modules":         []any{},
+						"providers": []any{
+							map[string]any{
+								"action":         "installed",
+								"authentication": "signed by HashiCorp",
+								"provider":       "hashicorp/aws",
+								"version":        "5.0.0",
+							},
+						},
+					},
+					"type": "init_summary",
+				},
+			},
+			wantStdout: withNewline("- Installed hashicorp/aws v5.0.0 (signed by HashiCorp)"),
+		},
+		"summary_unchanged": {
+			viewCall: func(init Init) {
+				init.ProviderAlreadyInstalled("hashicorp/aws", "5.0.0", false)
+				init.Summary("local")
+			},
+			wantJson: []map[string]any{
+				{
+					"@level":   "info",
+					"@message": "Using previously-installed hashicorp/aws v5.0.0",
+					"@module":  "tofu.ui",
+					"provider": map[string]any{
+						"action":   "already_installed",
+						"provider": "hashicorp/aws",
+						"version":  "5.0.0",
+					},
+					"type": "init_provider",
+				},
+				{
+					"@level":   "info",
+					"@message": "Initialization complete! No changes were needed.",
+					"@module":  "tofu.ui",
+					"summary": map[string]any{
+						"backend":         "local",
+						"backend_changed": false,
+						"changed":         false,
+						"lock_file":       "unchanged",
+						"modules":         []any{},
+						"providers": []any{
+							map[string]any{
+								"action":   "already_installed",
+								"provider": "hashicorp/aws",
+								"version":  "5.0.0",
+							},
+						},
+					},
+					"type": "init_summary",
+				},
+			},
+			wantStdout: withNewline("- Using previously-installed hashicorp/aws v5.0.0"),
+		},
 		// Diagnostics
 		"warning": {
 			viewCall: func(init Init) {
@@ -447,6 +603,60 @@ func TestInitViews(t *testing.T) {
 				},
 			},
 		},
+		"versionResolution": {
+			viewCall: func(init Init) {
+				init.VersionResolution(&json.VersionResolution{
+					Kind:    "provider",
+					Subject: "registry.opentofu.org/hashicorp/null",
+					Constraints: []json.VersionConstraint{
+						{Constraint: ">= 3.0.0", Filename: "main.tf", Line: 4},
+						{Module: "module.child", Filename: "child/main.tf", Line: 2},
+					},
+					Candidates: []json.VersionCandidate{
+						{Version: "3.2.0", Rejected: "a newer version is locked"},
+						{Version: "3.1.0"},
+						{Version: "2.0.0", Rejected: `does not meet ">= 3.0.0" in the root module (main.tf:4)`},
+					},
+					Locked:   "3.1.0",
+					Selected: "3.1.0",
+				})
+			},
+			wantStdout: `
+Version selection for provider registry.opentofu.org/hashicorp/null:
+  Constraints:
+    - ">= 3.0.0" in the root module (main.tf:4)
+    - any version in module.child (child/main.tf:2)
+  Candidates:
+    - 3.2.0: a newer version is locked
+    - 3.1.0: selected
+    - 2.0.0: does not meet ">= 3.0.0" in the root module (main.tf:4)
+  Locked in the dependency lock file: 3.1.0
+  Selected: 3.1.0
+`,
+			wantJson: []map[string]any{
+				{
+					"@level":   "info",
+					"@message": "Selected provider registry.opentofu.org/hashicorp/null version 3.1.0",
+					"@module":  "tofu.ui",
+					"type":     "init_version_resolution",
+					"resolution": map[string]any{
+						"kind":    "provider",
+						"subject": "registry.opentofu.org/hashicorp/null",
+						"constraints": []any{
+							map[string]any{"constraint": ">= 3.0.0", "module": "", "filename": "main.tf", "line": float64(4)},
+							map[string]any{"constraint": "", "module": "module.child", "filename": "child/main.tf", "line": float64(2)},
+						},
+						"candidates": []any{
+							map[string]any{"version": "3.2.0", "rejected": "a newer version is locked"},
+							map[string]any{"version": "3.1.0"},
+							map[string]any{"version": "2.0.0", "rejected": `does not meet ">= 3.0.0" in the root module (main.tf:4)`},
+						},
+						"locked":   "3.1.0",
+						"selected": "3.1.0",
+					},
+				},
+			},
+		},
 		"multiple_diagnostics": {
 			viewCall: func(init Init) {
 				diags := tfdiags.Diagnostics{
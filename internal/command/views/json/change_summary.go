@@ -25,6 +25,13 @@ type ChangeSummary struct {
 	Remove    int       `json:"remove"`
 	Forget    int       `json:"forget"`
 	Operation Operation `json:"operation"`
+
+	// Interrupted is set when an apply or destroy was interrupted before
+	// completing, in which case the counts above cover only the changes
+	// that completed and InProgress lists the resource instances whose
+	// changes had started but not finished.
+	Interrupted bool     `json:"interrupted,omitempty"`
+	InProgress  []string `json:"in_progress,omitempty"`
 }
 
 // The summary strings for apply and plan are accidentally a public interface
@@ -34,7 +41,11 @@ func (cs *ChangeSummary) String() string {
 	var builder strings.Builder
 	switch cs.Operation {
 	case OperationApplied:
-		builder.WriteString("Apply complete! Resources: ")
+		if cs.Interrupted {
+			builder.WriteString("Apply interrupted! Resources: ")
+		} else {
+			builder.WriteString("Apply complete! Resources: ")
+		}
 		if cs.Import > 0 {
 			builder.WriteString(fmt.Sprintf("%d imported, ", cs.Import))
 		}
@@ -46,6 +57,9 @@ func (cs *ChangeSummary) String() string {
 		}
 		return builder.String()
 	case OperationDestroyed:
+		if cs.Interrupted {
+			return fmt.Sprintf("Destroy interrupted! Resources: %d destroyed.", cs.Remove)
+		}
 		return fmt.Sprintf("Destroy complete! Resources: %d destroyed.", cs.Remove)
 	case OperationPlanned:
 		builder.WriteString("Plan: ")
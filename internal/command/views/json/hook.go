@@ -54,12 +54,14 @@ func NewApplyStart(addr addrs.AbsResourceInstance, action plans.Action, idKey st
 	return hook
 }
 
-// ApplyProgress: currently triggered by a timer started on PreApply. In
-// future, this might also be triggered by provider progress reporting.
+// ApplyProgress: triggered by a timer started on PreApply, and whenever the
+// provider reports progress of the operation.
 type applyProgress struct {
 	Resource   jsonentities.ResourceAddr `json:"resource"`
 	Action     jsonentities.ChangeAction `json:"action"`
 	Elapsed    float64                   `json:"elapsed_seconds"`
+	Percent    *int                      `json:"percent,omitempty"`
+	Phase      string                    `json:"phase,omitempty"`
 	actionVerb string
 	elapsed    time.Duration
 }
@@ -71,7 +73,16 @@ func (h *applyProgress) HookType() MessageType {
 }
 
 func (h *applyProgress) String() string {
-	return fmt.Sprintf("%s: Still %s... [%s elapsed]", h.Resource.Addr, h.actionVerb, h.elapsed)
+	var progress string
+	switch {
+	case h.Percent != nil && h.Phase != "":
+		progress = fmt.Sprintf(", %d%%: %s", *h.Percent, h.Phase)
+	case h.Percent != nil:
+		progress = fmt.Sprintf(", %d%%", *h.Percent)
+	case h.Phase != "":
+		progress = ", " + h.Phase
+	}
+	return fmt.Sprintf("%s: Still %s... [%s elapsed%s]", h.Resource.Addr, h.actionVerb, h.elapsed, progress)
 }
 
 func NewApplyProgress(addr addrs.AbsResourceInstance, action plans.Action, elapsed time.Duration) Hook {
@@ -84,6 +95,107 @@ func NewApplyProgress(addr addrs.AbsResourceInstance, action plans.Action, elaps
 	}
 }
 
+// NewApplyProviderProgress is like NewApplyProgress but also includes the
+// progress most recently reported by the provider. A negative percent means
+// that the provider didn't estimate how far through the operation it is.
+func NewApplyProviderProgress(addr addrs.AbsResourceInstance, action plans.Action, elapsed time.Duration, phase string, percent int) Hook {
+	h := &applyProgress{
+		Resource:   jsonentities.NewResourceAddr(addr),
+		Action:     jsonentities.ParseChangeAction(action),
+		Elapsed:    elapsed.Seconds(),
+		Phase:      phase,
+		actionVerb: progressActionVerb(action),
+		elapsed:    elapsed,
+	}
+	if percent >= 0 {
+		h.Percent = &percent
+	}
+	return h
+}
+
+// ApplySchedule: triggered periodically during a long-running apply with the
+// changes that are running and what the others are waiting for.
+type applySchedule struct {
+	Running []ApplyScheduleEntry `json:"running"`
+	Queued  []ApplyScheduleEntry `json:"queued"`
+	Blocked []ApplyScheduleEntry `json:"blocked"`
+}
+
+// ApplyScheduleEntry is a single change in an apply_schedule message.
+type ApplyScheduleEntry struct {
+	Resource   jsonentities.ResourceAddr `json:"resource"`
+	Action     jsonentities.ChangeAction `json:"action"`
+	Name       string                    `json:"name"`
+	Elapsed    float64                   `json:"elapsed_seconds"`
+	WaitingFor []string                  `json:"waiting_for,omitempty"`
+}
+
+var _ Hook = (*applySchedule)(nil)
+
+func (h *applySchedule) HookType() MessageType {
+	return MessageApplySchedule
+}
+
+func (h *applySchedule) String() string {
+	return fmt.Sprintf("Apply schedule: %d running, %d queued, %d blocked", len(h.Running), len(h.Queued), len(h.Blocked))
+}
+
+func NewApplySchedule(running, queued, blocked []ApplyScheduleEntry) Hook {
+	// We always include all three lists, even if empty, so that consumers
+	// don't need to distinguish null from empty.
+	h := &applySchedule{
+		Running: running,
+		Queued:  queued,
+		Blocked: blocked,
+	}
+	for _, list := range []*[]ApplyScheduleEntry{&h.Running, &h.Queued, &h.Blocked} {
+		if *list == nil {
+			*list = []ApplyScheduleEntry{}
+		}
+	}
+	return h
+}
+
+func NewApplyScheduleEntry(addr addrs.AbsResourceInstance, action plans.Action, name string, elapsed time.Duration, waitingFor []string) ApplyScheduleEntry {
+	return ApplyScheduleEntry{
+		Resource:   jsonentities.NewResourceAddr(addr),
+		Action:     jsonentities.ParseChangeAction(action),
+		Name:       name,
+		Elapsed:    elapsed.Seconds(),
+		WaitingFor: waitingFor,
+	}
+}
+
+// ApplyBudgetExceeded: triggered when a change has been in progress for
+// longer than the apply_within duration in its resource's lifecycle block.
+type applyBudgetExceeded struct {
+	Resource   jsonentities.ResourceAddr `json:"resource"`
+	Action     jsonentities.ChangeAction `json:"action"`
+	Budget     float64                   `json:"budget_seconds"`
+	actionVerb string
+	budget     time.Duration
+}
+
+var _ Hook = (*applyBudgetExceeded)(nil)
+
+func (h *applyBudgetExceeded) HookType() MessageType {
+	return MessageApplyBudgetExceeded
+}
+
+func (h *applyBudgetExceeded) String() string {
+	return fmt.Sprintf("%s: Still %s after %s, which is longer than expected", h.Resource.Addr, h.actionVerb, h.budget)
+}
+
+func NewApplyBudgetExceeded(addr addrs.AbsResourceInstance, action plans.Action, budget time.Duration) Hook {
+	return &applyBudgetExceeded{
+		Resource:   jsonentities.NewResourceAddr(addr),
+		Action:     jsonentities.ParseChangeAction(action),
+		Budget:     budget.Seconds(),
+		actionVerb: progressActionVerb(action),
+		budget:     budget,
+	}
+}
+
 // ApplyComplete: triggered by PostApply hook
 type applyComplete struct {
 	Resource   jsonentities.ResourceAddr `json:"resource"`
@@ -0,0 +1,33 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package json
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// DiscoveredResource describes an existing remote object found by
+// "tofu import -discover".
+type DiscoveredResource struct {
+	// Index is the number that identifies the object when choosing which
+	// objects to import, starting at one.
+	Index        int             `json:"index"`
+	ResourceType string          `json:"resource_type"`
+	DisplayName  string          `json:"display_name"`
+	Identity     json.RawMessage `json:"identity"`
+
+	// ManagedBy is the address of the resource instance that already manages
+	// the object, if any.
+	ManagedBy string `json:"managed_by,omitempty"`
+}
+
+func (r DiscoveredResource) String() string {
+	if r.ManagedBy != "" {
+		return fmt.Sprintf("Discovered %s %q, already managed by %s", r.ResourceType, r.DisplayName, r.ManagedBy)
+	}
+	return fmt.Sprintf("Discovered %s %q", r.ResourceType, r.DisplayName)
+}
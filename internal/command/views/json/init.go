@@ -0,0 +1,172 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package json
+
+import (
+	"fmt"
+	"strings"
+)
+
+// InitProviderAction describes what "tofu init" did to make a provider
+// available in the working directory.
+type InitProviderAction string
+
+const (
+	InitProviderInstalled        InitProviderAction = "installed"
+	InitProviderLinkedFromCache  InitProviderAction = "linked_from_cache"
+	InitProviderAlreadyInstalled InitProviderAction = "already_installed"
+	InitProviderBuiltIn          InitProviderAction = "built_in"
+)
+
+// InitProvider describes a provider selected by "tofu init".
+type InitProvider struct {
+	Provider string             `json:"provider"`
+	Version  string             `json:"version,omitempty"`
+	Action   InitProviderAction `json:"action"`
+
+	// InCache is set when the provider package is in the shared plugin cache
+	// directory.
+	InCache bool `json:"in_cache,omitempty"`
+
+	// Authentication describes how the package was authenticated when it was
+	// installed, and KeyID lists the IDs of the keys it was signed with, if
+	// any. SignatureSkipped is set when the registry had no signing keys
+	// for the provider, and so only its checksum was verified.
+	Authentication   string `json:"authentication,omitempty"`
+	KeyID            string `json:"key_id,omitempty"`
+	SignatureSkipped bool   `json:"signature_skipped,omitempty"`
+}
+
+func (p InitProvider) String() string {
+	switch p.Action {
+	case InitProviderInstalled:
+		return fmt.Sprintf("Installed %s v%s", p.Provider, p.Version)
+	case InitProviderLinkedFromCache:
+		return fmt.Sprintf("Using %s v%s from the shared cache directory", p.Provider, p.Version)
+	case InitProviderAlreadyInstalled:
+		return fmt.Sprintf("Using previously-installed %s v%s", p.Provider, p.Version)
+	case InitProviderBuiltIn:
+		return fmt.Sprintf("%s is built in to OpenTofu", p.Provider)
+	default:
+		return p.Provider
+	}
+}
+
+// InitModule describes a module installed by "tofu init".
+type InitModule struct {
+	// Path is the module path of the call that the module was installed for,
+	// such as "network.subnets".
+	Path    string `json:"path"`
+	Source  string `json:"source,omitempty"`
+	Version string `json:"version,omitempty"`
+	Dir     string `json:"dir,omitempty"`
+}
+
+// VersionResolution explains how "tofu init -explain-versions" selected a
+// version of a module or provider, or why it couldn't select one.
+type VersionResolution struct {
+	// Kind is either "module" or "provider".
+	Kind string `json:"kind"`
+
+	// Subject is the module path of a module call, such as "network.subnets",
+	// or the address of a provider.
+	Subject string `json:"subject"`
+
+	// Source is the registry address of a module.
+	Source string `json:"source,omitempty"`
+
+	Constraints []VersionConstraint `json:"constraints"`
+
+	// Candidates are the versions available for selection, newest first.
+	Candidates []VersionCandidate `json:"candidates"`
+
+	// Locked is the version of a provider recorded in the dependency lock
+	// file, which init selects unless upgrading.
+	Locked string `json:"locked,omitempty"`
+
+	// Selected is empty if no available version is acceptable.
+	Selected string `json:"selected,omitempty"`
+}
+
+func (r *VersionResolution) String() string {
+	if r.Selected == "" {
+		return fmt.Sprintf("No available version of %s %s is acceptable", r.Kind, r.Subject)
+	}
+	return fmt.Sprintf("Selected %s %s version %s", r.Kind, r.Subject, r.Selected)
+}
+
+// VersionConstraint is a version constraint that applies to a module or
+// provider, along with where it's declared.
+type VersionConstraint struct {
+	// Constraint is empty if the declaration allows any version.
+	Constraint string `json:"constraint"`
+
+	// Module is the path of the module that declares the constraint, which
+	// is empty for the root module.
+	Module   string `json:"module"`
+	Filename string `json:"filename,omitempty"`
+	Line     int    `json:"line,omitempty"`
+}
+
+// VersionCandidate is one of the available versions of a module or
+// provider.
+type VersionCandidate struct {
+	Version string `json:"version"`
+
+	// Rejected is the reason that init didn't select this version, or empty
+	// for the selected version.
+	Rejected string `json:"rejected,omitempty"`
+}
+
+// InitLockFile describes what "tofu init" did to the dependency lock file.
+type InitLockFile string
+
+const (
+	InitLockFileUnchanged InitLockFile = "unchanged"
+	InitLockFileCreated   InitLockFile = "created"
+	InitLockFileUpdated   InitLockFile = "updated"
+)
+
+// InitSummary describes everything that a successful "tofu init" did to the
+// working directory.
+//
+// Changed is false only if init found everything already initialized, in
+// which case it made no changes and running it again would do the same.
+type InitSummary struct {
+	Backend        string         `json:"backend"`
+	BackendChanged bool           `json:"backend_changed"`
+	Modules        []InitModule   `json:"modules"`
+	Providers      []InitProvider `json:"providers"`
+	LockFile       InitLockFile   `json:"lock_file"`
+	Changed        bool           `json:"changed"`
+}
+
+func (s *InitSummary) String() string {
+	if !s.Changed {
+		return "Initialization complete! No changes were needed."
+	}
+
+	var changes []string
+	if s.BackendChanged {
+		changes = append(changes, fmt.Sprintf("backend %q configured", s.Backend))
+	}
+	if len(s.Modules) > 0 {
+		changes = append(changes, fmt.Sprintf("%d module(s) installed", len(s.Modules)))
+	}
+	var installed int
+	for _, p := range s.Providers {
+		if p.Action == InitProviderInstalled || p.Action == InitProviderLinkedFromCache {
+			installed++
+		}
+	}
+	if installed > 0 {
+		changes = append(changes, fmt.Sprintf("%d provider(s) installed", installed))
+	}
+	if s.LockFile != InitLockFileUnchanged {
+		changes = append(changes, fmt.Sprintf("lock file %s", s.LockFile))
+	}
+	return fmt.Sprintf("Initialization complete! Changes: %s.", strings.Join(changes, ", "))
+}
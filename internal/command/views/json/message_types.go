@@ -19,9 +19,23 @@ const (
 	MessageChangeSummary MessageType = "change_summary"
 	MessageOutputs       MessageType = "outputs"
 
+	// Stored plan messages
+	MessagePlanStored MessageType = "plan_stored"
+	MessageStoredPlan MessageType = "stored_plan"
+
+	// Diagnostic check results
+	MessageConnectivityCheck MessageType = "connectivity_check"
+	MessageDoctorCheck       MessageType = "doctor_check"
+
+	// Remote run results
+	MessageCostEstimate MessageType = "cost_estimate"
+	MessagePolicyCheck  MessageType = "policy_check"
+
 	// Hook-driven messages
 	MessageApplyStart              MessageType = "apply_start"
 	MessageApplyProgress           MessageType = "apply_progress"
+	MessageApplySchedule           MessageType = "apply_schedule"
+	MessageApplyBudgetExceeded     MessageType = "apply_budget_exceeded"
 	MessageApplyComplete           MessageType = "apply_complete"
 	MessageApplyErrored            MessageType = "apply_errored"
 	MessageProvisionStart          MessageType = "provision_start"
@@ -33,6 +47,17 @@ const (
 	MessageEphemeralActionStart    MessageType = "ephemeral_action_started"
 	MessageEphemeralActionComplete MessageType = "ephemeral_action_complete"
 
+	// Init messages
+	MessageInitModule   MessageType = "init_module"
+	MessageInitProvider MessageType = "init_provider"
+	MessageInitSummary  MessageType = "init_summary"
+
+	MessageInitVersionResolution MessageType = "init_version_resolution"
+
+	// Import messages
+	MessageImportDiscoveredResource MessageType = "import_discovered_resource"
+	MessageImportBlocksGenerated    MessageType = "import_blocks_generated"
+
 	// Test messages
 	MessageTestAbstract  MessageType = "test_abstract"
 	MessageTestFile      MessageType = "test_file"
@@ -0,0 +1,61 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package json
+
+import (
+	"fmt"
+)
+
+// CostEstimate describes the result of the cost estimation phase of a run
+// executed by a remote backend.
+type CostEstimate struct {
+	// Status is the final status of the cost estimate as reported by the
+	// remote backend, such as "finished", "errored", or
+	// "skipped_due_to_targeting".
+	Status string `json:"status"`
+
+	ResourcesCount        int    `json:"resources_count"`
+	MatchedResourcesCount int    `json:"matched_resources_count"`
+	PriorMonthlyCost      string `json:"prior_monthly_cost,omitempty"`
+	ProposedMonthlyCost   string `json:"proposed_monthly_cost,omitempty"`
+	DeltaMonthlyCost      string `json:"delta_monthly_cost,omitempty"`
+	ErrorMessage          string `json:"error_message,omitempty"`
+}
+
+func (ce *CostEstimate) String() string {
+	if ce.Status != "finished" {
+		return fmt.Sprintf("Cost estimation: %s", ce.Status)
+	}
+	return fmt.Sprintf(
+		"Cost estimation: %d of %d resources estimated, $%s/mo (delta $%s)",
+		ce.MatchedResourcesCount, ce.ResourcesCount, ce.ProposedMonthlyCost, ce.DeltaMonthlyCost,
+	)
+}
+
+// PolicyCheck describes the result of one policy check of a run executed by
+// a remote backend.
+type PolicyCheck struct {
+	// Scope is either "organization" or "workspace".
+	Scope string `json:"scope"`
+
+	// Status is the final status of the policy check as reported by the
+	// remote backend, such as "passed", "soft_failed", "hard_failed", or
+	// "errored".
+	Status string `json:"status"`
+
+	Passed         int  `json:"passed"`
+	AdvisoryFailed int  `json:"advisory_failed"`
+	SoftFailed     int  `json:"soft_failed"`
+	HardFailed     int  `json:"hard_failed"`
+	Overridable    bool `json:"overridable"`
+}
+
+func (pc *PolicyCheck) String() string {
+	return fmt.Sprintf(
+		"Policy check (%s): %s, %d passed, %d advisory failed, %d soft failed, %d hard failed",
+		pc.Scope, pc.Status, pc.Passed, pc.AdvisoryFailed, pc.SoftFailed, pc.HardFailed,
+	)
+}
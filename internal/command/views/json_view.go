@@ -143,6 +143,22 @@ func (v *JSONView) Outputs(outputs jsonentities.Outputs) {
 	)
 }
 
+func (v *JSONView) CostEstimate(ce *json.CostEstimate) {
+	v.log.Info(
+		ce.String(),
+		"type", json.MessageCostEstimate,
+		"cost_estimate", ce,
+	)
+}
+
+func (v *JSONView) PolicyCheck(pc *json.PolicyCheck) {
+	v.log.Info(
+		pc.String(),
+		"type", json.MessagePolicyCheck,
+		"policy_check", pc,
+	)
+}
+
 // Output is designed for supporting command.WrappedUi
 func (v *JSONView) Output(message string) {
 	v.log.Info(message, "type", "output")
@@ -67,6 +67,10 @@ func Marshal(f map[string]function.Function) ([]byte, tfdiags.Diagnostics) {
 			signatures.Signatures[name] = marshalCan(v)
 		case addrs.ParseFunction("try").FullyQualified().String():
 			signatures.Signatures[name] = marshalTry(v)
+		case addrs.ParseFunction("attempt").FullyQualified().String():
+			signatures.Signatures[name] = marshalAttempt(v)
+		case addrs.ParseFunction("retryuntil").FullyQualified().String():
+			signatures.Signatures[name] = marshalRetryUntil(v)
 		default:
 			signature, err := marshalFunction(v)
 			if err != nil {
@@ -154,3 +158,43 @@ func marshalCan(can function.Function) *FunctionSignature {
 		},
 	}
 }
+
+// marshalAttempt returns a static function signature for the attempt function.
+// We need this exception because the function implementation uses capsule
+// types that we can't marshal.
+func marshalAttempt(attempt function.Function) *FunctionSignature {
+	return &FunctionSignature{
+		Description: attempt.Description(),
+		ReturnType:  cty.DynamicPseudoType,
+		Parameters: []*parameter{
+			{
+				Name:        attempt.Params()[0].Name,
+				Description: attempt.Params()[0].Description,
+				IsNullable:  attempt.Params()[0].AllowNull,
+				Type:        cty.DynamicPseudoType,
+			},
+		},
+	}
+}
+
+// marshalRetryUntil returns a static function signature for the retryuntil
+// function. We need this exception because its condition parameter uses a
+// capsule type that we can't marshal, and because the function can't be
+// called outside of assertions to determine its return type.
+func marshalRetryUntil(retryUntil function.Function) *FunctionSignature {
+	params := retryUntil.Params()
+	return &FunctionSignature{
+		Description: retryUntil.Description(),
+		ReturnType:  cty.Bool,
+		Parameters: []*parameter{
+			{
+				Name:        params[0].Name,
+				Description: params[0].Description,
+				IsNullable:  params[0].AllowNull,
+				Type:        cty.DynamicPseudoType,
+			},
+			marshalParameter(&params[1]),
+		},
+		VariadicParameter: marshalParameter(retryUntil.VarParam()),
+	}
+}
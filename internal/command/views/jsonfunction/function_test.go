@@ -11,6 +11,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/hcl/v2/ext/tryfunc"
+	"github.com/opentofu/opentofu/internal/lang/funcs"
 	"github.com/zclconf/go-cty-debug/ctydebug"
 	"github.com/zclconf/go-cty/cty"
 	"github.com/zclconf/go-cty/cty/function"
@@ -155,6 +156,22 @@ func TestMarshal(t *testing.T) {
 			`{"format_version":"1.0","function_signatures":{"can":{"return_type":"bool","parameters":[{"name":"expression","type":"dynamic"}]}}}`,
 			"",
 		},
+		{
+			"attempt function marshalled correctly",
+			map[string]function.Function{
+				"attempt": funcs.AttemptFunc,
+			},
+			`{"format_version":"1.0","function_signatures":{"attempt":{"return_type":"dynamic","parameters":[{"name":"expression","type":"dynamic"}]}}}`,
+			"",
+		},
+		{
+			"retryuntil function marshalled correctly",
+			map[string]function.Function{
+				"retryuntil": funcs.RetryUntilFunc,
+			},
+			`{"format_version":"1.0","function_signatures":{"retryuntil":{"return_type":"bool","parameters":[{"name":"condition","type":"dynamic"},{"name":"timeout","type":"string"}],"variadic_parameter":{"name":"interval","type":"string"}}}}`,
+			"",
+		},
 		{
 			"core::can function marshalled correctly",
 			map[string]function.Function{
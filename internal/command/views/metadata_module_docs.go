@@ -0,0 +1,70 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package views
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/opentofu/opentofu/internal/command/moduledocs"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+type MetadataModuleDocs interface {
+	Diagnostics(diags tfdiags.Diagnostics)
+	// PrintModuleDocs returns true if it managed to print the documentation and false otherwise.
+	PrintModuleDocs(docs *moduledocs.Module) bool
+}
+
+// NewMetadataModuleDocs returns an initialized MetadataModuleDocs implementation
+// that prints the documentation in either JSON or Markdown format. As with
+// [NewMetadataFunctions], the diagnostics are always printed in human format.
+func NewMetadataModuleDocs(jsonFormat bool, view *View) MetadataModuleDocs {
+	if jsonFormat {
+		return &MetadataModuleDocsJSON{view: view}
+	}
+	return &MetadataModuleDocsMarkdown{view: view}
+}
+
+type MetadataModuleDocsJSON struct {
+	view *View
+}
+
+var _ MetadataModuleDocs = (*MetadataModuleDocsJSON)(nil)
+
+func (v *MetadataModuleDocsJSON) Diagnostics(diags tfdiags.Diagnostics) {
+	v.view.Diagnostics(diags)
+}
+
+func (v *MetadataModuleDocsJSON) PrintModuleDocs(docs *moduledocs.Module) bool {
+	raw, err := json.Marshal(docs)
+	if err != nil {
+		var diags tfdiags.Diagnostics
+		v.Diagnostics(diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to serialize module documentation",
+			fmt.Sprintf("Failed to serialize the module documentation as JSON: %s.", err),
+		)))
+		return false
+	}
+	_, _ = v.view.streams.Println(string(raw))
+	return true
+}
+
+type MetadataModuleDocsMarkdown struct {
+	view *View
+}
+
+var _ MetadataModuleDocs = (*MetadataModuleDocsMarkdown)(nil)
+
+func (v *MetadataModuleDocsMarkdown) Diagnostics(diags tfdiags.Diagnostics) {
+	v.view.Diagnostics(diags)
+}
+
+func (v *MetadataModuleDocsMarkdown) PrintModuleDocs(docs *moduledocs.Module) bool {
+	_, _ = v.view.streams.Print(moduledocs.Markdown(docs))
+	return true
+}
@@ -0,0 +1,52 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package views
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/opentofu/opentofu/internal/command/moduledocs"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+type MetadataVariablesSchema interface {
+	Diagnostics(diags tfdiags.Diagnostics)
+	// PrintSchema returns true if it managed to print the schema and false otherwise.
+	PrintSchema(schema *moduledocs.Schema) bool
+}
+
+// NewMetadataVariablesSchema returns an initialized MetadataVariablesSchema
+// implementation that prints the schema as an indented JSON document, so
+// that it can be saved to a file and read by people as well as tools.
+func NewMetadataVariablesSchema(view *View) MetadataVariablesSchema {
+	return &MetadataVariablesSchemaJSON{view: view}
+}
+
+type MetadataVariablesSchemaJSON struct {
+	view *View
+}
+
+var _ MetadataVariablesSchema = (*MetadataVariablesSchemaJSON)(nil)
+
+func (v *MetadataVariablesSchemaJSON) Diagnostics(diags tfdiags.Diagnostics) {
+	v.view.Diagnostics(diags)
+}
+
+func (v *MetadataVariablesSchemaJSON) PrintSchema(schema *moduledocs.Schema) bool {
+	raw, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		var diags tfdiags.Diagnostics
+		v.Diagnostics(diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to serialize variables schema",
+			fmt.Sprintf("Failed to serialize the variables schema as JSON: %s.", err),
+		)))
+		return false
+	}
+	_, _ = v.view.streams.Println(string(raw))
+	return true
+}
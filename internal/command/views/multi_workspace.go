@@ -0,0 +1,162 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package views
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/opentofu/opentofu/internal/terminal"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// MultiWorkspaceStatus is the outcome of running a command in one of the
+// workspaces given with the -workspaces option.
+type MultiWorkspaceStatus rune
+
+const (
+	MultiWorkspaceSucceeded MultiWorkspaceStatus = 'S'
+	// MultiWorkspaceChanged means that a plan run with -detailed-exitcode
+	// succeeded and found changes.
+	MultiWorkspaceChanged MultiWorkspaceStatus = 'C'
+	MultiWorkspaceFailed  MultiWorkspaceStatus = 'F'
+	// MultiWorkspaceSkipped means that the command didn't run in the
+	// workspace because OpenTofu was interrupted first.
+	MultiWorkspaceSkipped MultiWorkspaceStatus = 'K'
+)
+
+// MultiWorkspaceResult is the result of running a command in one workspace.
+type MultiWorkspaceResult struct {
+	Workspace string
+	Status    MultiWorkspaceStatus
+	ExitCode  int
+}
+
+// MultiWorkspace is the view for the plan and apply commands when they run
+// in several workspaces, which frames the output of the command in each
+// workspace.
+type MultiWorkspace interface {
+	Diagnostics(diags tfdiags.Diagnostics)
+
+	// WorkspaceStarted announces that the command is about to run in the
+	// given workspace, when running in one workspace at a time.
+	WorkspaceStarted(workspace string)
+
+	// WorkspaceView returns a view for running the command in the given
+	// workspace at the same time as in other workspaces, which prefixes each
+	// line of output with the workspace name so that the interleaved output
+	// remains readable. The returned function must be called once the
+	// command has finished, to flush its remaining output.
+	WorkspaceView(workspace string) (*View, func(), error)
+
+	// Summary describes the results of running the command in all of the
+	// workspaces.
+	Summary(results []MultiWorkspaceResult)
+}
+
+// NewMultiWorkspace returns an initialized MultiWorkspace implementation.
+// Only the human-readable view is supported, since the JSON output of the
+// commands doesn't identify the workspace that each message belongs to.
+func NewMultiWorkspace(view *View) MultiWorkspace {
+	return &MultiWorkspaceHuman{view: view}
+}
+
+type MultiWorkspaceHuman struct {
+	view *View
+
+	// mu serializes the prefixed lines written by concurrent workspaces.
+	mu sync.Mutex
+}
+
+var _ MultiWorkspace = (*MultiWorkspaceHuman)(nil)
+
+func (v *MultiWorkspaceHuman) Diagnostics(diags tfdiags.Diagnostics) {
+	v.view.Diagnostics(diags)
+}
+
+func (v *MultiWorkspaceHuman) WorkspaceStarted(workspace string) {
+	_, _ = v.view.streams.Println(v.view.colorize.Color(fmt.Sprintf("\n[reset][bold]Workspace %q:[reset]", workspace)))
+}
+
+func (v *MultiWorkspaceHuman) WorkspaceView(workspace string) (*View, func(), error) {
+	prefix := fmt.Sprintf("[%s] ", workspace)
+	stdout, flushStdout, err := v.prefixedStream(v.view.streams.Stdout, prefix)
+	if err != nil {
+		return nil, nil, err
+	}
+	stderr, flushStderr, err := v.prefixedStream(v.view.streams.Stderr, prefix)
+	if err != nil {
+		flushStdout()
+		return nil, nil, err
+	}
+
+	ret := NewView(&terminal.Streams{
+		Stdout: stdout,
+		Stderr: stderr,
+		Stdin:  v.view.streams.Stdin,
+	}).SetRunningInAutomation(v.view.runningInAutomation)
+	ret.colorize.Disable = v.view.colorize.Disable
+	return ret, func() {
+		flushStdout()
+		flushStderr()
+	}, nil
+}
+
+// prefixedStream returns a stream that copies each line written to it into
+// the given stream, after the given prefix.
+func (v *MultiWorkspaceHuman) prefixedStream(to *terminal.OutputStream, prefix string) (*terminal.OutputStream, func(), error) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		br := bufio.NewReader(r)
+		for {
+			line, err := br.ReadString('\n')
+			if line != "" {
+				if line[len(line)-1] != '\n' {
+					line += "\n"
+				}
+				v.mu.Lock()
+				_, _ = io.WriteString(to.File, prefix+line)
+				v.mu.Unlock()
+			}
+			if err != nil {
+				_ = r.Close()
+				return
+			}
+		}
+	}()
+
+	return &terminal.OutputStream{File: w}, func() {
+		_ = w.Close()
+		<-done
+	}, nil
+}
+
+func (v *MultiWorkspaceHuman) Summary(results []MultiWorkspaceResult) {
+	_, _ = v.view.streams.Println(v.view.colorize.Color("\n[reset][bold]Results by workspace:[reset]"))
+	for _, result := range results {
+		var status string
+		switch result.Status {
+		case MultiWorkspaceSucceeded:
+			status = "[green]succeeded[reset]"
+		case MultiWorkspaceChanged:
+			status = "[yellow]succeeded with changes[reset]"
+		case MultiWorkspaceSkipped:
+			status = "[yellow]skipped[reset]"
+		default:
+			status = fmt.Sprintf("[red]failed[reset] (exit status %d)", result.ExitCode)
+		}
+		_, _ = v.view.streams.Println(v.view.colorize.Color(fmt.Sprintf("  - %s: %s", result.Workspace, status)))
+	}
+}
@@ -31,6 +31,7 @@ type Operation interface {
 	FatalInterrupt()
 	Stopping()
 	Cancelled(planMode plans.Mode)
+	WaitingForApproval(command string)
 
 	EmergencyDumpState(stateFile *statefile.File, enc encryption.StateEncryption) error
 
@@ -41,6 +42,15 @@ type Operation interface {
 	Diagnostics(diags tfdiags.Diagnostics)
 }
 
+// RemoteRunResults is implemented by operation views that can record the
+// results of the cost estimation and policy check phases of runs executed by
+// a remote backend in a structured form. Views that don't implement this
+// interface see those results only as streamed human-readable output.
+type RemoteRunResults interface {
+	CostEstimate(ce *viewsjson.CostEstimate)
+	PolicyCheck(pc *viewsjson.PolicyCheck)
+}
+
 func NewOperation(vt arguments.ViewType, inAutomation bool, view *View) Operation {
 	switch vt {
 	case arguments.ViewHuman:
@@ -78,6 +88,12 @@ func (o OperationMulti) Cancelled(planMode plans.Mode) {
 	}
 }
 
+func (o OperationMulti) WaitingForApproval(command string) {
+	for _, operation := range o {
+		operation.WaitingForApproval(command)
+	}
+}
+
 func (o OperationMulti) EmergencyDumpState(stateFile *statefile.File, enc encryption.StateEncryption) error {
 	var errs []error
 	for _, operation := range o {
@@ -110,6 +126,22 @@ func (o OperationMulti) Diagnostics(diags tfdiags.Diagnostics) {
 	}
 }
 
+func (o OperationMulti) CostEstimate(ce *viewsjson.CostEstimate) {
+	for _, operation := range o {
+		if rv, ok := operation.(RemoteRunResults); ok {
+			rv.CostEstimate(ce)
+		}
+	}
+}
+
+func (o OperationMulti) PolicyCheck(pc *viewsjson.PolicyCheck) {
+	for _, operation := range o {
+		if rv, ok := operation.(RemoteRunResults); ok {
+			rv.PolicyCheck(pc)
+		}
+	}
+}
+
 type OperationHuman struct {
 	view *View
 
@@ -146,6 +178,10 @@ func (v *OperationHuman) Cancelled(planMode plans.Mode) {
 	}
 }
 
+func (v *OperationHuman) WaitingForApproval(command string) {
+	v.view.streams.Printf("\nWaiting for approval from the approval hook %q...\n", command)
+}
+
 func (v *OperationHuman) EmergencyDumpState(stateFile *statefile.File, enc encryption.StateEncryption) error {
 	stateBuf := new(bytes.Buffer)
 	jsonErr := statefile.Write(stateFile, stateBuf, enc)
@@ -168,6 +204,7 @@ func (v *OperationHuman) Plan(plan *plans.Plan, schemas *tofu.Schemas) {
 		Streams:             v.view.streams,
 		RunningInAutomation: v.inAutomation,
 		ShowSensitive:       v.view.showSensitive,
+		Noise:               v.view.noise,
 	}
 
 	jplan := jsonformat.Plan{
@@ -245,6 +282,7 @@ type OperationJSON struct {
 }
 
 var _ Operation = (*OperationJSON)(nil)
+var _ RemoteRunResults = (*OperationJSON)(nil)
 
 func (v *OperationJSON) Interrupted() {
 	v.view.Log(interrupted)
@@ -267,6 +305,10 @@ func (v *OperationJSON) Cancelled(planMode plans.Mode) {
 	}
 }
 
+func (v *OperationJSON) WaitingForApproval(command string) {
+	v.view.Log(fmt.Sprintf("Waiting for approval from the approval hook %q", command))
+}
+
 func (v *OperationJSON) EmergencyDumpState(stateFile *statefile.File, enc encryption.StateEncryption) error {
 	stateBuf := new(bytes.Buffer)
 	jsonErr := statefile.Write(stateFile, stateBuf, enc)
@@ -290,12 +332,40 @@ func (v *OperationJSON) Plan(plan *plans.Plan, schemas *tofu.Schemas) {
 		}
 	}
 
+	for _, change := range plan.Changes.Resources {
+		if change.Action == plans.Delete && change.Addr.Resource.Resource.Mode == addrs.DataResourceMode {
+			// Avoid rendering data sources on deletion
+			continue
+		}
+
+		if change.Action != plans.NoOp || !change.Addr.Equal(change.PrevRunAddr) || change.Importing != nil {
+			v.view.PlannedChange(jsonentities.NewResourceInstanceChange(change))
+		}
+	}
+
+	v.view.ChangeSummary(planChangeSummary(plan))
+
+	var rootModuleOutputs []*plans.OutputChangeSrc
+	for _, output := range plan.Changes.Outputs {
+		if !output.Addr.Module.IsRoot() {
+			continue
+		}
+		rootModuleOutputs = append(rootModuleOutputs, output)
+	}
+	if len(rootModuleOutputs) > 0 {
+		v.view.Outputs(viewsjson.OutputsFromChanges(rootModuleOutputs))
+	}
+}
+
+// planChangeSummary counts the changes to resource instances in the given
+// plan, in the form used for the summary of a plan.
+func planChangeSummary(plan *plans.Plan) *viewsjson.ChangeSummary {
 	cs := &viewsjson.ChangeSummary{
 		Operation: viewsjson.OperationPlanned,
 	}
 	for _, change := range plan.Changes.Resources {
 		if change.Action == plans.Delete && change.Addr.Resource.Resource.Mode == addrs.DataResourceMode {
-			// Avoid rendering data sources on deletion
+			// Data sources are not counted on deletion
 			continue
 		}
 
@@ -319,24 +389,8 @@ func (v *OperationJSON) Plan(plan *plans.Plan, schemas *tofu.Schemas) {
 		case plans.Forget:
 			cs.Forget++
 		}
-
-		if change.Action != plans.NoOp || !change.Addr.Equal(change.PrevRunAddr) || change.Importing != nil {
-			v.view.PlannedChange(jsonentities.NewResourceInstanceChange(change))
-		}
-	}
-
-	v.view.ChangeSummary(cs)
-
-	var rootModuleOutputs []*plans.OutputChangeSrc
-	for _, output := range plan.Changes.Outputs {
-		if !output.Addr.Module.IsRoot() {
-			continue
-		}
-		rootModuleOutputs = append(rootModuleOutputs, output)
-	}
-	if len(rootModuleOutputs) > 0 {
-		v.view.Outputs(viewsjson.OutputsFromChanges(rootModuleOutputs))
 	}
+	return cs
 }
 
 func (v *OperationJSON) PlannedChange(change *plans.ResourceInstanceChangeSrc) {
@@ -360,6 +414,14 @@ func (v *OperationJSON) Diagnostics(diags tfdiags.Diagnostics) {
 	v.view.Diagnostics(diags)
 }
 
+func (v *OperationJSON) CostEstimate(ce *viewsjson.CostEstimate) {
+	v.view.CostEstimate(ce)
+}
+
+func (v *OperationJSON) PolicyCheck(pc *viewsjson.PolicyCheck) {
+	v.view.PolicyCheck(pc)
+}
+
 const fatalInterrupt = `
 Two interrupts received. Exiting immediately. Note that data loss may have occurred.
 `
@@ -13,6 +13,7 @@ import (
 
 	"github.com/opentofu/opentofu/internal/addrs"
 	"github.com/opentofu/opentofu/internal/command/arguments"
+	viewsjson "github.com/opentofu/opentofu/internal/command/views/json"
 	"github.com/opentofu/opentofu/internal/encryption"
 	"github.com/opentofu/opentofu/internal/lang/globalref"
 	"github.com/opentofu/opentofu/internal/plans"
@@ -62,6 +63,17 @@ func TestOperation_cancelled(t *testing.T) {
 	}
 }
 
+func TestOperation_waitingForApproval(t *testing.T) {
+	streams, done := terminal.StreamsForTesting(t)
+	v := NewOperation(arguments.ViewHuman, false, NewView(streams))
+
+	v.WaitingForApproval("approve")
+
+	if got, want := done(t).Stdout(), "\nWaiting for approval from the approval hook \"approve\"...\n"; got != want {
+		t.Errorf("wrong result\ngot:  %q\nwant: %q", got, want)
+	}
+}
+
 func TestOperation_emergencyDumpState(t *testing.T) {
 	streams, done := terminal.StreamsForTesting(t)
 	v := NewOperation(arguments.ViewHuman, false, NewView(streams))
@@ -531,6 +543,60 @@ func TestOperationJSON_logs(t *testing.T) {
 	testJSONViewOutputEquals(t, done(t).Stdout(), want)
 }
 
+func TestOperationJSON_remoteRunResults(t *testing.T) {
+	streams, done := terminal.StreamsForTesting(t)
+	v := &OperationJSON{view: NewJSONView(NewView(streams), nil)}
+
+	v.CostEstimate(&viewsjson.CostEstimate{
+		Status:                "finished",
+		ResourcesCount:        2,
+		MatchedResourcesCount: 1,
+		PriorMonthlyCost:      "0.0",
+		ProposedMonthlyCost:   "6.78",
+		DeltaMonthlyCost:      "6.78",
+	})
+	v.PolicyCheck(&viewsjson.PolicyCheck{
+		Scope:      "organization",
+		Status:     "soft_failed",
+		Passed:     1,
+		SoftFailed: 1,
+	})
+
+	want := []map[string]any{
+		{
+			"@level":   "info",
+			"@message": "Cost estimation: 1 of 2 resources estimated, $6.78/mo (delta $6.78)",
+			"@module":  "tofu.ui",
+			"type":     "cost_estimate",
+			"cost_estimate": map[string]any{
+				"status":                  "finished",
+				"resources_count":         float64(2),
+				"matched_resources_count": float64(1),
+				"prior_monthly_cost":      "0.0",
+				"proposed_monthly_cost":   "6.78",
+				"delta_monthly_cost":      "6.78",
+			},
+		},
+		{
+			"@level":   "info",
+			"@message": "Policy check (organization): soft_failed, 1 passed, 0 advisory failed, 1 soft failed, 0 hard failed",
+			"@module":  "tofu.ui",
+			"type":     "policy_check",
+			"policy_check": map[string]any{
+				"scope":           "organization",
+				"status":          "soft_failed",
+				"passed":          float64(1),
+				"advisory_failed": float64(0),
+				"soft_failed":     float64(1),
+				"hard_failed":     float64(0),
+				"overridable":     false,
+			},
+		},
+	}
+
+	testJSONViewOutputEquals(t, done(t).Stdout(), want)
+}
+
 // This is a fairly circular test, but it's such a rarely executed code path
 // that I think it's probably still worth having. We're not testing against
 // a fixed state JSON output because this test ought not fail just because
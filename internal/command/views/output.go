@@ -18,6 +18,7 @@ import (
 	ctyjson "github.com/zclconf/go-cty/cty/json"
 
 	"github.com/opentofu/opentofu/internal/command/arguments"
+	"github.com/opentofu/opentofu/internal/command/jsonplan"
 	"github.com/opentofu/opentofu/internal/repl"
 	"github.com/opentofu/opentofu/internal/states"
 	"github.com/opentofu/opentofu/internal/tfdiags"
@@ -227,6 +228,17 @@ func (v *OutputJSON) Output(name string, outputs map[string]*states.OutputValue)
 			return diags
 		}
 		value := output.Value
+		if !value.IsWhollyKnown() {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Output value not yet known",
+				fmt.Sprintf(
+					"The value for output value %q won't be fully known until after a successful tofu apply, so it cannot be printed as a single JSON value.\n\nOmit the output name to see all of the output values along with which parts of them are unknown.",
+					name,
+				),
+			))
+			return diags
+		}
 
 		jsonOutput, err := ctyjson.Marshal(value, value.Type())
 		if err != nil {
@@ -250,11 +262,29 @@ func (v *OutputJSON) Output(name string, outputs map[string]*states.OutputValue)
 		Deprecated string          `json:"deprecated,omitempty"`
 		Type       json.RawMessage `json:"type"`
 		Value      json.RawMessage `json:"value"`
+
+		// Unknown is set only for outputs taken from a plan whose values
+		// won't be fully known until apply, using the same representation
+		// as "after_unknown" in the JSON plan output. Unknown parts of the
+		// value are rendered as null.
+		Unknown json.RawMessage `json:"unknown,omitempty"`
 	}
 	outputMetas := map[string]OutputMeta{}
 
 	for n, os := range outputs {
-		jsonVal, err := ctyjson.Marshal(os.Value, os.Value.Type())
+		val := os.Value
+		var jsonUnknown []byte
+		if !val.IsWhollyKnown() {
+			unknown := jsonplan.UnknownAsBool(val)
+			var err error
+			jsonUnknown, err = ctyjson.Marshal(unknown, unknown.Type())
+			if err != nil {
+				diags = diags.Append(err)
+				return diags
+			}
+			val = cty.UnknownAsNull(val)
+		}
+		jsonVal, err := ctyjson.Marshal(val, val.Type())
 		if err != nil {
 			diags = diags.Append(err)
 			return diags
@@ -269,6 +299,7 @@ func (v *OutputJSON) Output(name string, outputs map[string]*states.OutputValue)
 			Deprecated: os.Deprecated,
 			Type:       json.RawMessage(jsonType),
 			Value:      json.RawMessage(jsonVal),
+			Unknown:    json.RawMessage(jsonUnknown),
 		}
 	}
 
@@ -7,8 +7,11 @@ package views
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/opentofu/opentofu/internal/command/arguments"
+	"github.com/opentofu/opentofu/internal/command/format"
+	"github.com/opentofu/opentofu/internal/command/views/json"
 	"github.com/opentofu/opentofu/internal/tfdiags"
 	"github.com/opentofu/opentofu/internal/tofu"
 )
@@ -21,6 +24,10 @@ type Plan interface {
 	Diagnostics(diags tfdiags.Diagnostics)
 	HelpPrompt()
 
+	// PlanStored reports that the plan was saved in the backend with the
+	// given ID, as requested by the -store option.
+	PlanStored(id string)
+
 	// Backend returns the non-command view that contains methods to provide
 	// progress output for the backend operations.
 	Backend() Backend
@@ -82,6 +89,12 @@ func (m PlanMulti) HelpPrompt() {
 	}
 }
 
+func (m PlanMulti) PlanStored(id string) {
+	for _, plan := range m {
+		plan.PlanStored(id)
+	}
+}
+
 func (m PlanMulti) Backend() Backend {
 	ret := make([]Backend, len(m))
 	for i, v := range m {
@@ -116,6 +129,15 @@ func (v *PlanHuman) HelpPrompt() {
 	v.view.HelpPrompt("plan")
 }
 
+func (v *PlanHuman) PlanStored(id string) {
+	v.view.streams.Print(
+		format.WordWrap(
+			"\n"+strings.TrimSpace(fmt.Sprintf(planStoredOutput, id, id)),
+			v.view.outputColumns(),
+		) + "\n",
+	)
+}
+
 func (v *PlanHuman) Backend() Backend {
 	return &BackendHuman{
 		view: v.view,
@@ -147,8 +169,23 @@ func (v *PlanJSON) Diagnostics(diags tfdiags.Diagnostics) {
 func (v *PlanJSON) HelpPrompt() {
 }
 
+func (v *PlanJSON) PlanStored(id string) {
+	v.view.log.Info(
+		fmt.Sprintf("Stored the plan with ID %s", id),
+		"type", json.MessagePlanStored,
+		"plan_id", id,
+	)
+}
+
 func (v *PlanJSON) Backend() Backend {
 	return &BackendJSON{
 		view: v.view,
 	}
 }
+
+const planStoredOutput = `
+Stored the plan in the backend with ID: %s
+
+To perform exactly these actions, run the following command to apply:
+    tofu plan apply %s
+`
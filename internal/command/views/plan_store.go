@@ -0,0 +1,116 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package views
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/opentofu/opentofu/internal/command/arguments"
+	"github.com/opentofu/opentofu/internal/command/views/json"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// The PlanList view is used for the "plan list" command.
+type PlanList interface {
+	Diagnostics(diags tfdiags.Diagnostics)
+
+	// NoStoredPlans reports that there are no plans stored for the given
+	// workspace.
+	NoStoredPlans(workspace string)
+
+	// StoredPlan describes a single plan stored in the backend.
+	StoredPlan(id string, createdBy string, createdAt time.Time, configHash string)
+}
+
+// NewPlanList returns an initialized PlanList implementation for the given ViewType.
+func NewPlanList(args arguments.ViewOptions, view *View) PlanList {
+	var ret PlanList
+	switch args.ViewType {
+	case arguments.ViewJSON:
+		ret = &PlanListJSON{view: NewJSONView(view, nil)}
+	case arguments.ViewHuman:
+		ret = &PlanListHuman{view: view}
+	default:
+		panic(fmt.Sprintf("unknown view type %v", args.ViewType))
+	}
+
+	if args.JSONInto != nil {
+		ret = PlanListMulti{ret, &PlanListJSON{view: NewJSONView(view, args.JSONInto)}}
+	}
+	return ret
+}
+
+type PlanListMulti []PlanList
+
+var _ PlanList = (PlanListMulti)(nil)
+
+func (m PlanListMulti) Diagnostics(diags tfdiags.Diagnostics) {
+	for _, v := range m {
+		v.Diagnostics(diags)
+	}
+}
+
+func (m PlanListMulti) NoStoredPlans(workspace string) {
+	for _, v := range m {
+		v.NoStoredPlans(workspace)
+	}
+}
+
+func (m PlanListMulti) StoredPlan(id string, createdBy string, createdAt time.Time, configHash string) {
+	for _, v := range m {
+		v.StoredPlan(id, createdBy, createdAt, configHash)
+	}
+}
+
+// The PlanListHuman implementation renders a line for each stored plan.
+type PlanListHuman struct {
+	view *View
+}
+
+var _ PlanList = (*PlanListHuman)(nil)
+
+func (v *PlanListHuman) Diagnostics(diags tfdiags.Diagnostics) {
+	v.view.Diagnostics(diags)
+}
+
+func (v *PlanListHuman) NoStoredPlans(workspace string) {
+	_, _ = v.view.streams.Printf("No plans are stored for the workspace %q.\n", workspace)
+}
+
+func (v *PlanListHuman) StoredPlan(id string, createdBy string, createdAt time.Time, configHash string) {
+	if len(configHash) > 12 {
+		configHash = configHash[:12]
+	}
+	_, _ = v.view.streams.Printf("%s  %s  %s  config %s\n", id, createdAt.Local().Format(time.RFC3339), createdBy, configHash)
+}
+
+// The PlanListJSON implementation renders a streaming JSON log message for
+// each stored plan.
+type PlanListJSON struct {
+	view *JSONView
+}
+
+var _ PlanList = (*PlanListJSON)(nil)
+
+func (v *PlanListJSON) Diagnostics(diags tfdiags.Diagnostics) {
+	v.view.Diagnostics(diags)
+}
+
+func (v *PlanListJSON) NoStoredPlans(workspace string) {
+	v.view.Info(fmt.Sprintf("No plans are stored for the workspace %q", workspace))
+}
+
+func (v *PlanListJSON) StoredPlan(id string, createdBy string, createdAt time.Time, configHash string) {
+	v.view.log.Info(
+		fmt.Sprintf("Stored plan %s", id),
+		"type", json.MessageStoredPlan,
+		"plan_id", id,
+		"created_by", createdBy,
+		"created_at", createdAt.UTC().Format(time.RFC3339),
+		"config_hash", configHash,
+	)
+}
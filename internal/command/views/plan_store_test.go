@@ -0,0 +1,86 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package views
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/opentofu/opentofu/internal/command/arguments"
+	"github.com/opentofu/opentofu/internal/terminal"
+)
+
+func TestPlanHuman_planStored(t *testing.T) {
+	streams, done := terminal.StreamsForTesting(t)
+	v := NewPlan(arguments.ViewOptions{ViewType: arguments.ViewHuman}, NewView(streams))
+	v.PlanStored("abc123")
+
+	got := done(t).Stdout()
+	for _, want := range []string{
+		"Stored the plan in the backend with ID: abc123",
+		"tofu plan apply abc123",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("output is missing %q\n%s", want, got)
+		}
+	}
+}
+
+func TestPlanJSON_planStored(t *testing.T) {
+	streams, done := terminal.StreamsForTesting(t)
+	v := NewPlan(arguments.ViewOptions{ViewType: arguments.ViewJSON}, NewView(streams))
+	v.PlanStored("abc123")
+
+	want := []map[string]any{
+		{
+			"@level":   "info",
+			"@message": "Stored the plan with ID abc123",
+			"@module":  "tofu.ui",
+			"type":     "plan_stored",
+			"plan_id":  "abc123",
+		},
+	}
+	testJSONViewOutputEquals(t, done(t).Stdout(), want)
+}
+
+func TestPlanList(t *testing.T) {
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	t.Run("human", func(t *testing.T) {
+		streams, done := terminal.StreamsForTesting(t)
+		v := NewPlanList(arguments.ViewOptions{ViewType: arguments.ViewHuman}, NewView(streams))
+		v.StoredPlan("abc123", "alice", createdAt, "0123456789abcdef")
+		v.NoStoredPlans("default")
+
+		got := done(t).Stdout()
+		want := "abc123  " + createdAt.Local().Format(time.RFC3339) + "  alice  config 0123456789ab\n" +
+			"No plans are stored for the workspace \"default\".\n"
+		if got != want {
+			t.Errorf("wrong output\ngot:\n%s\nwant:\n%s", got, want)
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		streams, done := terminal.StreamsForTesting(t)
+		v := NewPlanList(arguments.ViewOptions{ViewType: arguments.ViewJSON}, NewView(streams))
+		v.StoredPlan("abc123", "alice", createdAt, "0123456789abcdef")
+
+		want := []map[string]any{
+			{
+				"@level":      "info",
+				"@message":    "Stored plan abc123",
+				"@module":     "tofu.ui",
+				"type":        "stored_plan",
+				"plan_id":     "abc123",
+				"created_by":  "alice",
+				"created_at":  "2024-01-02T03:04:05Z",
+				"config_hash": "0123456789abcdef",
+			},
+		}
+		testJSONViewOutputEquals(t, done(t).Stdout(), want)
+	})
+}
@@ -0,0 +1,288 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package views
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/command/arguments"
+	"github.com/opentofu/opentofu/internal/configs/configschema"
+	"github.com/opentofu/opentofu/internal/lang/marks"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// ProviderConfiguration is the effective configuration of a provider
+// configuration in the root module, after applying the provider override of
+// the current workspace.
+type ProviderConfiguration struct {
+	Addr  addrs.AbsProviderConfig
+	Local addrs.LocalProviderConfig
+
+	// Schema is the schema of the provider configuration, and Value is the
+	// value of the configuration, with its sensitive parts marked.
+	Schema *configschema.Block
+	Value  cty.Value
+
+	// OverrideFile is the workspace provider override file that overrides
+	// the arguments in Overridden, or empty if there is no override.
+	OverrideFile string
+	Overridden   []string
+}
+
+type ProvidersConfig interface {
+	Diagnostics(diags tfdiags.Diagnostics)
+	UnsupportedLocalOp()
+	ProviderConfigs(workspace string, configs []*ProviderConfiguration)
+
+	// Backend returns the non-command view that contains methods to provide
+	// progress output for the backend operations.
+	Backend() Backend
+}
+
+// NewProvidersConfig returns an initialized ProvidersConfig implementation for the given ViewType.
+func NewProvidersConfig(args arguments.ViewOptions, view *View) ProvidersConfig {
+	var ret ProvidersConfig
+	switch args.ViewType {
+	case arguments.ViewJSON:
+		ret = &ProvidersConfigJSON{view: NewJSONView(view, nil)}
+	case arguments.ViewHuman:
+		ret = &ProvidersConfigHuman{view: view}
+	default:
+		panic(fmt.Sprintf("unknown view type %v", args.ViewType))
+	}
+
+	if args.JSONInto != nil {
+		ret = &ProvidersConfigMulti{ret, &ProvidersConfigJSON{view: NewJSONView(view, args.JSONInto)}}
+	}
+	return ret
+}
+
+type ProvidersConfigHuman struct {
+	view *View
+}
+
+var _ ProvidersConfig = (*ProvidersConfigHuman)(nil)
+
+func (v *ProvidersConfigHuman) Diagnostics(diags tfdiags.Diagnostics) {
+	v.view.Diagnostics(diags)
+}
+
+func (v *ProvidersConfigHuman) UnsupportedLocalOp() {
+	v.view.Diagnostics(tfdiags.Diagnostics{diagUnsupportedLocalOp})
+}
+
+func (v *ProvidersConfigHuman) ProviderConfigs(workspace string, configs []*ProviderConfiguration) {
+	if len(configs) == 0 {
+		_, _ = v.view.streams.Println(fmt.Sprintf("The root module has no provider configurations for workspace %q.", workspace))
+		return
+	}
+
+	for i, config := range configs {
+		if i > 0 {
+			_, _ = v.view.streams.Println("")
+		}
+		_, _ = v.view.streams.Print(renderProviderConfiguration(config))
+	}
+}
+
+func (v *ProvidersConfigHuman) Backend() Backend {
+	return &BackendHuman{
+		view: v.view,
+	}
+}
+
+type ProvidersConfigMulti []ProvidersConfig
+
+var _ ProvidersConfig = (ProvidersConfigMulti)(nil)
+
+func (m ProvidersConfigMulti) Diagnostics(diags tfdiags.Diagnostics) {
+	for _, o := range m {
+		o.Diagnostics(diags)
+	}
+}
+
+func (m ProvidersConfigMulti) UnsupportedLocalOp() {
+	for _, o := range m {
+		o.UnsupportedLocalOp()
+	}
+}
+
+func (m ProvidersConfigMulti) ProviderConfigs(workspace string, configs []*ProviderConfiguration) {
+	for _, o := range m {
+		o.ProviderConfigs(workspace, configs)
+	}
+}
+
+func (m ProvidersConfigMulti) Backend() Backend {
+	return m[0].Backend()
+}
+
+type ProvidersConfigJSON struct {
+	view *JSONView
+}
+
+var _ ProvidersConfig = (*ProvidersConfigJSON)(nil)
+
+func (v *ProvidersConfigJSON) Diagnostics(diags tfdiags.Diagnostics) {
+	v.view.Diagnostics(diags)
+}
+
+func (v *ProvidersConfigJSON) UnsupportedLocalOp() {
+	v.view.Diagnostics(tfdiags.Diagnostics{diagUnsupportedLocalOp})
+}
+
+func (v *ProvidersConfigJSON) ProviderConfigs(workspace string, configs []*ProviderConfiguration) {
+	for _, config := range configs {
+		args, sensitive := providerConfigurationJSON(config)
+		overridden := config.Overridden
+		if overridden == nil {
+			overridden = []string{}
+		}
+		v.view.log.Info(
+			fmt.Sprintf("%s: effective configuration for workspace %q", config.Addr, workspace),
+			"type", "provider_config",
+			"address", config.Addr.String(),
+			"name", config.Local.LocalName,
+			"alias", config.Local.Alias,
+			"workspace", workspace,
+			"arguments", args,
+			"sensitive", sensitive,
+			"override_file", config.OverrideFile,
+			"overridden", overridden,
+		)
+	}
+}
+
+func (v *ProvidersConfigJSON) Backend() Backend {
+	return &BackendJSON{
+		view: v.view,
+	}
+}
+
+// renderProviderConfiguration returns the given provider configuration as a
+// "provider" block, leaving out the arguments that aren't set and hiding the
+// values of the sensitive ones.
+func renderProviderConfiguration(config *ProviderConfiguration) string {
+	var buf strings.Builder
+
+	if config.OverrideFile != "" {
+		fmt.Fprintf(&buf, "# Overridden by %s: %s\n", config.OverrideFile, strings.Join(config.Overridden, ", "))
+	}
+	fmt.Fprintf(&buf, "provider %q {\n", config.Local.LocalName)
+	if config.Local.Alias != "" {
+		fmt.Fprintf(&buf, "alias = %q\n", config.Local.Alias)
+	}
+	writeProviderConfigurationBody(&buf, config.Schema, config.Value)
+	buf.WriteString("}\n")
+
+	return string(hclwrite.Format([]byte(buf.String())))
+}
+
+func writeProviderConfigurationBody(buf *strings.Builder, schema *configschema.Block, val cty.Value) {
+	if val.IsNull() || !val.IsKnown() {
+		return
+	}
+
+	for _, name := range sortedKeys(schema.Attributes) {
+		attrVal := val.GetAttr(name)
+		switch {
+		case attrVal.IsNull():
+			continue
+		case marks.Contains(attrVal, marks.Sensitive):
+			fmt.Fprintf(buf, "%s = (sensitive value)\n", name)
+		case !attrVal.IsWhollyKnown():
+			fmt.Fprintf(buf, "%s = (known after apply)\n", name)
+		default:
+			fmt.Fprintf(buf, "%s = %s\n", name, hclwrite.TokensForValue(attrVal).Bytes())
+		}
+	}
+
+	for _, name := range sortedKeys(schema.BlockTypes) {
+		blockS := schema.BlockTypes[name]
+		blockVal := val.GetAttr(name)
+		if blockVal.IsNull() || !blockVal.IsKnown() {
+			continue
+		}
+		if blockVal.HasMark(marks.Sensitive) {
+			fmt.Fprintf(buf, "%s {} # sensitive\n", name)
+			continue
+		}
+		blockVal, _ = blockVal.Unmark()
+
+		switch blockS.Nesting {
+		case configschema.NestingSingle, configschema.NestingGroup:
+			fmt.Fprintf(buf, "%s {\n", name)
+			writeProviderConfigurationBody(buf, &blockS.Block, blockVal)
+			buf.WriteString("}\n")
+		case configschema.NestingList, configschema.NestingSet:
+			for it := blockVal.ElementIterator(); it.Next(); {
+				_, elem := it.Element()
+				fmt.Fprintf(buf, "%s {\n", name)
+				writeProviderConfigurationBody(buf, &blockS.Block, elem)
+				buf.WriteString("}\n")
+			}
+		case configschema.NestingMap:
+			elems := blockVal.AsValueMap()
+			for _, key := range sortedKeys(elems) {
+				fmt.Fprintf(buf, "%s %q {\n", name, key)
+				writeProviderConfigurationBody(buf, &blockS.Block, elems[key])
+				buf.WriteString("}\n")
+			}
+		}
+	}
+}
+
+// providerConfigurationJSON returns the JSON encodings of the arguments of
+// the given provider configuration that are set, along with the names of
+// the sensitive arguments, whose values are null.
+func providerConfigurationJSON(config *ProviderConfiguration) (map[string]json.RawMessage, []string) {
+	args := make(map[string]json.RawMessage)
+	sensitive := []string{}
+	if config.Value.IsNull() || !config.Value.IsKnown() {
+		return args, sensitive
+	}
+
+	for name, val := range config.Value.AsValueMap() {
+		if val.IsNull() {
+			continue
+		}
+		if marks.Contains(val, marks.Sensitive) {
+			args[name] = json.RawMessage("null")
+			sensitive = append(sensitive, name)
+			continue
+		}
+		val, _ = val.UnmarkDeep()
+		if !val.IsWhollyKnown() {
+			args[name] = json.RawMessage("null")
+			continue
+		}
+		raw, err := ctyjson.Marshal(val, val.Type())
+		if err != nil {
+			// Should never happen, because the value is wholly known.
+			panic(fmt.Sprintf("failed to marshal %s: %s", name, err))
+		}
+		args[name] = raw
+	}
+	sort.Strings(sensitive)
+	return args, sensitive
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,129 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package views
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/command/arguments"
+	"github.com/opentofu/opentofu/internal/configs/configschema"
+	"github.com/opentofu/opentofu/internal/lang/marks"
+)
+
+func TestProvidersConfigView(t *testing.T) {
+	schema := &configschema.Block{
+		Attributes: map[string]*configschema.Attribute{
+			"region": {Type: cty.String, Optional: true},
+			"token":  {Type: cty.String, Optional: true, Sensitive: true},
+			"tags":   {Type: cty.Map(cty.String), Optional: true},
+		},
+		BlockTypes: map[string]*configschema.NestedBlock{
+			"endpoint": {
+				Nesting: configschema.NestingList,
+				Block: configschema.Block{
+					Attributes: map[string]*configschema.Attribute{
+						"url": {Type: cty.String, Required: true},
+					},
+				},
+			},
+		},
+	}
+	config := &ProviderConfiguration{
+		Addr: addrs.AbsProviderConfig{
+			Module:   addrs.RootModule,
+			Provider: addrs.NewDefaultProvider("test"),
+			Alias:    "west",
+		},
+		Local:  addrs.LocalProviderConfig{LocalName: "test", Alias: "west"},
+		Schema: schema,
+		Value: cty.ObjectVal(map[string]cty.Value{
+			"region": cty.StringVal("us-west-2"),
+			"token":  cty.StringVal("secret").Mark(marks.Sensitive),
+			"tags":   cty.NullVal(cty.Map(cty.String)),
+			"endpoint": cty.ListVal([]cty.Value{
+				cty.ObjectVal(map[string]cty.Value{"url": cty.StringVal("https://a.example.com")}),
+				cty.ObjectVal(map[string]cty.Value{"url": cty.StringVal("https://b.example.com")}),
+			}),
+		}),
+		OverrideFile: "staging.tfoverride.hcl",
+		Overridden:   []string{"endpoint", "region"},
+	}
+
+	tests := map[string]struct {
+		configs    []*ProviderConfiguration
+		wantStdout string
+		wantJson   []map[string]any
+	}{
+		"no configurations": {
+			wantStdout: withNewline(`The root module has no provider configurations for workspace "staging".`),
+			wantJson:   []map[string]any{{}},
+		},
+		"overridden configuration": {
+			configs: []*ProviderConfiguration{config},
+			wantStdout: `# Overridden by staging.tfoverride.hcl: endpoint, region
+provider "test" {
+  alias  = "west"
+  region = "us-west-2"
+  token  = (sensitive value)
+  endpoint {
+    url = "https://a.example.com"
+  }
+  endpoint {
+    url = "https://b.example.com"
+  }
+}
+`,
+			wantJson: []map[string]any{
+				{
+					"@level":   "info",
+					"@message": `provider["registry.opentofu.org/hashicorp/test"].west: effective configuration for workspace "staging"`,
+					"@module":  "tofu.ui",
+					"type":     "provider_config",
+					"address":  `provider["registry.opentofu.org/hashicorp/test"].west`,
+					"name":     "test",
+					"alias":    "west",
+					"arguments": map[string]any{
+						"endpoint": []any{
+							map[string]any{"url": "https://a.example.com"},
+							map[string]any{"url": "https://b.example.com"},
+						},
+						"region": "us-west-2",
+						"token":  nil,
+					},
+					"sensitive":     []any{"token"},
+					"override_file": "staging.tfoverride.hcl",
+					"overridden":    []any{"endpoint", "region"},
+					"workspace":     "staging",
+				},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			{
+				view, done := testView(t)
+				v := NewProvidersConfig(arguments.ViewOptions{ViewType: arguments.ViewHuman}, view)
+				v.ProviderConfigs("staging", tc.configs)
+				output := done(t)
+				if diff := cmp.Diff(tc.wantStdout, output.Stdout()); diff != "" {
+					t.Errorf("invalid stdout (-want, +got):\n%s", diff)
+				}
+			}
+			{
+				view, done := testView(t)
+				v := NewProvidersConfig(arguments.ViewOptions{ViewType: arguments.ViewJSON}, view)
+				v.ProviderConfigs("staging", tc.configs)
+				output := done(t)
+				testJSONViewOutputEquals(t, output.Stdout(), tc.wantJson)
+			}
+		})
+	}
+}
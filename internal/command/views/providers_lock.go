@@ -16,6 +16,8 @@ type ProvidersLock interface {
 	Diagnostics(diags tfdiags.Diagnostics)
 	InstallationFetching(provider string, version string, platform string)
 	FetchPackageSuccess(keyID string, provider string, version string, platform string, auth string)
+	HashesFromCache(provider string, version string, platform string, path string)
+	LockConflictResolved(provider string, version string)
 	LockUpdateNewProvider(provider string, platform string)
 	LockUpdateNewHashForProvider(provider string, platform string)
 	LockUpdateNoChange(provider string, platform string)
@@ -61,6 +63,18 @@ func (v *ProvidersLockHuman) FetchPackageSuccess(keyID string, provider string,
 	_, _ = v.view.streams.Println(fmt.Sprintf("- Retrieved %s %s for %s (%s%s)", provider, version, platform, auth, keyID))
 }
 
+func (v *ProvidersLockHuman) HashesFromCache(provider string, version string, platform string, path string) {
+	_, _ = v.view.streams.Println(fmt.Sprintf("- Calculated checksums of %s %s for %s from the cached package at %s", provider, version, platform, path))
+}
+
+func (v *ProvidersLockHuman) LockConflictResolved(provider string, version string) {
+	if version == "" {
+		_, _ = v.view.streams.Println(fmt.Sprintf("- Resolved the lock file conflict for %s; No locked version meets the configuration's constraints, so a new version will be selected", provider))
+		return
+	}
+	_, _ = v.view.streams.Println(fmt.Sprintf("- Resolved the lock file conflict for %s by selecting version %s", provider, version))
+}
+
 func (v *ProvidersLockHuman) LockUpdateNewProvider(provider string, platform string) {
 	_, _ = v.view.streams.Println(
 		fmt.Sprintf(
@@ -124,6 +138,18 @@ func (m ProvidersLockMulti) FetchPackageSuccess(keyID string, provider string, v
 	}
 }
 
+func (m ProvidersLockMulti) HashesFromCache(provider string, version string, platform string, path string) {
+	for _, o := range m {
+		o.HashesFromCache(provider, version, platform, path)
+	}
+}
+
+func (m ProvidersLockMulti) LockConflictResolved(provider string, version string) {
+	for _, o := range m {
+		o.LockConflictResolved(provider, version)
+	}
+}
+
 func (m ProvidersLockMulti) LockUpdateNewProvider(provider string, platform string) {
 	for _, o := range m {
 		o.LockUpdateNewProvider(provider, platform)
@@ -170,6 +196,18 @@ func (v *ProvidersLockJSON) FetchPackageSuccess(keyID string, provider string, v
 	}
 }
 
+func (v *ProvidersLockJSON) HashesFromCache(provider string, version string, platform string, path string) {
+	v.view.Info(fmt.Sprintf("Calculated checksums of %s %s for %s from the cached package at %s", provider, version, platform, path))
+}
+
+func (v *ProvidersLockJSON) LockConflictResolved(provider string, version string) {
+	if version == "" {
+		v.view.Info(fmt.Sprintf("Resolved the lock file conflict for %s; No locked version meets the configuration's constraints, so a new version will be selected", provider))
+		return
+	}
+	v.view.Info(fmt.Sprintf("Resolved the lock file conflict for %s by selecting version %s", provider, version))
+}
+
 func (v *ProvidersLockJSON) LockUpdateNewProvider(provider string, platform string) {
 	v.view.Info(fmt.Sprintf("Obtained %s checksums for %s; This was a new provider and the checksums for this platform are now tracked in the lock file", provider, platform))
 }
@@ -63,6 +63,48 @@ func TestProvidersLockView(t *testing.T) {
 				},
 			},
 		},
+		"hashes from cache": {
+			viewCall: func(v ProvidersLock) {
+				v.HashesFromCache("registry.opentofu.org/test_ns/test_provider", "3.0.0", "linux_amd64", "/cache/terraform-provider-test_provider_3.0.0_linux_amd64.zip")
+			},
+			wantStdout: withNewline("- Calculated checksums of registry.opentofu.org/test_ns/test_provider 3.0.0 for linux_amd64 from the cached package at /cache/terraform-provider-test_provider_3.0.0_linux_amd64.zip"),
+			wantStderr: "",
+			wantJson: []map[string]any{
+				{
+					"@level":   "info",
+					"@message": "Calculated checksums of registry.opentofu.org/test_ns/test_provider 3.0.0 for linux_amd64 from the cached package at /cache/terraform-provider-test_provider_3.0.0_linux_amd64.zip",
+					"@module":  "tofu.ui",
+				},
+			},
+		},
+		"lock conflict resolved": {
+			viewCall: func(v ProvidersLock) {
+				v.LockConflictResolved("registry.opentofu.org/test_ns/test_provider", "3.0.0")
+			},
+			wantStdout: withNewline("- Resolved the lock file conflict for registry.opentofu.org/test_ns/test_provider by selecting version 3.0.0"),
+			wantStderr: "",
+			wantJson: []map[string]any{
+				{
+					"@level":   "info",
+					"@message": "Resolved the lock file conflict for registry.opentofu.org/test_ns/test_provider by selecting version 3.0.0",
+					"@module":  "tofu.ui",
+				},
+			},
+		},
+		"lock conflict resolved without version": {
+			viewCall: func(v ProvidersLock) {
+				v.LockConflictResolved("registry.opentofu.org/test_ns/test_provider", "")
+			},
+			wantStdout: withNewline("- Resolved the lock file conflict for registry.opentofu.org/test_ns/test_provider; No locked version meets the configuration's constraints, so a new version will be selected"),
+			wantStderr: "",
+			wantJson: []map[string]any{
+				{
+					"@level":   "info",
+					"@message": "Resolved the lock file conflict for registry.opentofu.org/test_ns/test_provider; No locked version meets the configuration's constraints, so a new version will be selected",
+					"@module":  "tofu.ui",
+				},
+			},
+		},
 		"lock update new provider": {
 			viewCall: func(v ProvidersLock) {
 				v.LockUpdateNewProvider("registry.opentofu.org/test_ns/test_provider", "linux_amd64")
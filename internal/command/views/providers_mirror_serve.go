@@ -0,0 +1,109 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package views
+
+import (
+	"fmt"
+
+	"github.com/opentofu/opentofu/internal/command/arguments"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+type ProvidersMirrorServe interface {
+	Diagnostics(diags tfdiags.Diagnostics)
+	Serving(dir string, url string)
+	Stopped()
+}
+
+// NewProvidersMirrorServe returns an initialized ProvidersMirrorServe implementation for the given ViewType.
+func NewProvidersMirrorServe(args arguments.ViewOptions, view *View) ProvidersMirrorServe {
+	var ret ProvidersMirrorServe
+	switch args.ViewType {
+	case arguments.ViewJSON:
+		ret = &ProvidersMirrorServeJSON{view: NewJSONView(view, nil)}
+	case arguments.ViewHuman:
+		ret = &ProvidersMirrorServeHuman{view: view}
+	default:
+		panic(fmt.Sprintf("unknown view type %v", args.ViewType))
+	}
+
+	if args.JSONInto != nil {
+		ret = &ProvidersMirrorServeMulti{ret, &ProvidersMirrorServeJSON{view: NewJSONView(view, args.JSONInto)}}
+	}
+	return ret
+}
+
+type ProvidersMirrorServeHuman struct {
+	view *View
+}
+
+var _ ProvidersMirrorServe = (*ProvidersMirrorServeHuman)(nil)
+
+func (v *ProvidersMirrorServeHuman) Diagnostics(diags tfdiags.Diagnostics) {
+	v.view.Diagnostics(diags)
+}
+
+func (v *ProvidersMirrorServeHuman) Serving(dir string, url string) {
+	_, _ = v.view.streams.Println(fmt.Sprintf(providersMirrorServingHuman, dir, url, url))
+}
+
+func (v *ProvidersMirrorServeHuman) Stopped() {
+	_, _ = v.view.streams.Println("Stopped serving the provider mirror.")
+}
+
+const providersMirrorServingHuman = `Serving the provider mirror in %s at %s
+
+To install providers from this mirror, add the following to the CLI
+configuration of each system that should use it:
+
+  provider_installation {
+    network_mirror {
+      url = %q
+    }
+  }
+
+Press Ctrl+C to stop the server.
+`
+
+type ProvidersMirrorServeMulti []ProvidersMirrorServe
+
+var _ ProvidersMirrorServe = (ProvidersMirrorServeMulti)(nil)
+
+func (m ProvidersMirrorServeMulti) Diagnostics(diags tfdiags.Diagnostics) {
+	for _, o := range m {
+		o.Diagnostics(diags)
+	}
+}
+
+func (m ProvidersMirrorServeMulti) Serving(dir string, url string) {
+	for _, o := range m {
+		o.Serving(dir, url)
+	}
+}
+
+func (m ProvidersMirrorServeMulti) Stopped() {
+	for _, o := range m {
+		o.Stopped()
+	}
+}
+
+type ProvidersMirrorServeJSON struct {
+	view *JSONView
+}
+
+var _ ProvidersMirrorServe = (*ProvidersMirrorServeJSON)(nil)
+
+func (v *ProvidersMirrorServeJSON) Diagnostics(diags tfdiags.Diagnostics) {
+	v.view.Diagnostics(diags)
+}
+
+func (v *ProvidersMirrorServeJSON) Serving(dir string, url string) {
+	v.view.Info(fmt.Sprintf("Serving the provider mirror in %s at %s", dir, url))
+}
+
+func (v *ProvidersMirrorServeJSON) Stopped() {
+	v.view.Info("Stopped serving the provider mirror")
+}
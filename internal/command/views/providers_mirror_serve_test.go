@@ -0,0 +1,86 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package views
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/opentofu/opentofu/internal/command/arguments"
+)
+
+func TestProvidersMirrorServeView(t *testing.T) {
+	tests := map[string]struct {
+		viewCall   func(v ProvidersMirrorServe)
+		wantJson   []map[string]any
+		wantStdout string
+	}{
+		"serving": {
+			viewCall: func(v ProvidersMirrorServe) {
+				v.Serving("mirror", "https://localhost:8443/")
+			},
+			wantStdout: `Serving the provider mirror in mirror at https://localhost:8443/
+
+To install providers from this mirror, add the following to the CLI
+configuration of each system that should use it:
+
+  provider_installation {
+    network_mirror {
+      url = "https://localhost:8443/"
+    }
+  }
+
+Press Ctrl+C to stop the server.
+
+`,
+			wantJson: []map[string]any{
+				{
+					"@level":   "info",
+					"@message": "Serving the provider mirror in mirror at https://localhost:8443/",
+					"@module":  "tofu.ui",
+				},
+			},
+		},
+		"stopped": {
+			viewCall: func(v ProvidersMirrorServe) {
+				v.Stopped()
+			},
+			wantStdout: withNewline("Stopped serving the provider mirror."),
+			wantJson: []map[string]any{
+				{
+					"@level":   "info",
+					"@message": "Stopped serving the provider mirror",
+					"@module":  "tofu.ui",
+				},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			{
+				view, done := testView(t)
+				tc.viewCall(NewProvidersMirrorServe(arguments.ViewOptions{ViewType: arguments.ViewHuman}, view))
+				output := done(t)
+				if diff := cmp.Diff("", output.Stderr()); diff != "" {
+					t.Errorf("invalid stderr (-want, +got):\n%s", diff)
+				}
+				if diff := cmp.Diff(tc.wantStdout, output.Stdout()); diff != "" {
+					t.Errorf("invalid stdout (-want, +got):\n%s", diff)
+				}
+			}
+			{
+				view, done := testView(t)
+				tc.viewCall(NewProvidersMirrorServe(arguments.ViewOptions{ViewType: arguments.ViewJSON}, view))
+				output := done(t)
+				if output.Stderr() != "" {
+					t.Errorf("expected no stderr but got:\n%s", output.Stderr())
+				}
+				testJSONViewOutputEquals(t, output.Stdout(), tc.wantJson)
+			}
+		})
+	}
+}
@@ -40,6 +40,11 @@ type Show interface {
 	// preferring planJSON if it is not nil and using plan otherwise.
 	DisplayPlan(ctx context.Context, plan *plans.Plan, planJSON *cloudplan.RemotePlanJSON, config *configs.Config, priorStateFile *statefile.File, schemas *tofu.Schemas) int
 
+	// DisplayPlanGraph renders the dependencies between the resource instances
+	// that the given plan changes, in the DOT language, returning a status
+	// code for "tofu show" to return.
+	DisplayPlanGraph(plan *plans.Plan) int
+
 	// DisplayConfig renders the given configuration, returning a status code for "tofu show" to return.
 	DisplayConfig(config *configs.Config, schemas *tofu.Schemas) int
 
@@ -90,6 +95,14 @@ func (m ShowMulti) DisplayPlan(ctx context.Context, plan *plans.Plan, planJSON *
 	return code
 }
 
+func (m ShowMulti) DisplayPlanGraph(plan *plans.Plan) int {
+	code := 0
+	for _, s := range m {
+		code = max(code, s.DisplayPlanGraph(plan))
+	}
+	return code
+}
+
 func (m ShowMulti) DisplayConfig(config *configs.Config, schemas *tofu.Schemas) int {
 	code := 0
 	for _, s := range m {
@@ -155,6 +168,7 @@ func (v *ShowHuman) DisplayPlan(_ context.Context, plan *plans.Plan, planJSON *c
 		Streams:             v.view.streams,
 		RunningInAutomation: v.view.runningInAutomation,
 		ShowSensitive:       v.view.showSensitive,
+		Noise:               v.view.noise,
 	}
 
 	// Prefer to display a pre-built JSON plan, if we got one; then, fall back
@@ -206,6 +220,11 @@ func (v *ShowHuman) DisplayPlan(_ context.Context, plan *plans.Plan, planJSON *c
 	return 0
 }
 
+func (v *ShowHuman) DisplayPlanGraph(plan *plans.Plan) int {
+	_, _ = v.view.streams.Print(string(planDependencyGraph(plan).Dot(nil)))
+	return 0
+}
+
 func (v *ShowHuman) DisplayConfig(config *configs.Config, schemas *tofu.Schemas) int {
 	// The human view should never be called for configuration display
 	// since we require -json for -config
@@ -267,6 +286,13 @@ func (v *ShowJSON) DisplayPlan(_ context.Context, plan *plans.Plan, planJSON *cl
 	return 0
 }
 
+func (v *ShowJSON) DisplayPlanGraph(_ *plans.Plan) int {
+	// Should not get here because the -graph option can't be combined with
+	// the JSON output.
+	v.view.streams.Eprintf("The plan graph is not available in JSON format")
+	return 1
+}
+
 func (v *ShowJSON) DisplayConfig(config *configs.Config, schemas *tofu.Schemas) int {
 	configJSON, err := jsonconfig.Marshal(config, schemas)
 	if err != nil {
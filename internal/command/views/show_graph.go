@@ -0,0 +1,122 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package views
+
+import (
+	"fmt"
+
+	"github.com/opentofu/opentofu/internal/dag"
+	"github.com/opentofu/opentofu/internal/plans"
+	"github.com/opentofu/opentofu/internal/states"
+)
+
+// planDependencyGraph returns a graph of the resource instance objects that
+// the given plan changes, with an edge from each object to each of the other
+// objects whose change must be complete before its own change can start
+// during apply.
+//
+// The graph is derived only from the data stored in the plan: the
+// dependencies recorded in the prior state for each object. Objects that
+// don't exist yet have no recorded dependencies, so the edges for them come
+// only from the objects that depend on them.
+func planDependencyGraph(plan *plans.Plan) *dag.AcyclicGraph {
+	g := &dag.AcyclicGraph{}
+	if plan == nil || plan.Changes == nil {
+		return g
+	}
+
+	var nodes []*planGraphNode
+	for _, change := range plan.Changes.Resources {
+		if change.Action == plans.NoOp {
+			continue
+		}
+		node := &planGraphNode{change: change}
+		if plan.PriorState != nil {
+			if is := plan.PriorState.ResourceInstance(change.Addr); is != nil {
+				node.obj = is.Current
+				if change.DeposedKey != states.NotDeposed {
+					node.obj = is.Deposed[change.DeposedKey]
+				}
+			}
+		}
+		nodes = append(nodes, node)
+		g.Add(node)
+	}
+
+	for _, node := range nodes {
+		if node.obj == nil {
+			continue
+		}
+		for _, dep := range node.obj.Dependencies {
+			for _, other := range nodes {
+				if other == node || !other.change.Addr.ContainingResource().Config().Equal(dep) {
+					continue
+				}
+				if node.change.Action == plans.Delete {
+					// An object must be destroyed before anything it
+					// depends on is changed, so the dependency waits for it.
+					g.Connect(dag.BasicEdge(other, node))
+				} else {
+					g.Connect(dag.BasicEdge(node, other))
+				}
+			}
+		}
+	}
+
+	// The dependencies recorded in state are transitive, so we remove the
+	// redundant edges to leave only those that matter for the ordering.
+	if len(g.Cycles()) == 0 {
+		g.TransitiveReduction()
+	}
+	return g
+}
+
+// planGraphNode is a resource instance object in the graph returned by
+// planDependencyGraph.
+type planGraphNode struct {
+	change *plans.ResourceInstanceChangeSrc
+
+	// obj is the object in the prior state, if there is one.
+	obj *states.ResourceInstanceObjectSrc
+}
+
+var _ dag.GraphNodeDotter = (*planGraphNode)(nil)
+
+func (n *planGraphNode) Name() string {
+	if n.change.DeposedKey != states.NotDeposed {
+		return fmt.Sprintf("%s (deposed object %s)", n.change.Addr, n.change.DeposedKey)
+	}
+	return n.change.Addr.String()
+}
+
+func (n *planGraphNode) DotNode(name string, _ *dag.DotOpts) *dag.DotNode {
+	return &dag.DotNode{
+		Name: name,
+		Attrs: map[string]string{
+			"label": fmt.Sprintf("%s (%s)", name, planGraphActionName(n.change.Action)),
+			"shape": "box",
+		},
+	}
+}
+
+func planGraphActionName(action plans.Action) string {
+	switch action {
+	case plans.Create:
+		return "create"
+	case plans.Read:
+		return "read"
+	case plans.Update:
+		return "update"
+	case plans.DeleteThenCreate, plans.CreateThenDelete, plans.ForgetThenCreate:
+		return "replace"
+	case plans.Delete:
+		return "destroy"
+	case plans.Forget:
+		return "forget"
+	default:
+		return action.String()
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/zclconf/go-cty/cty"
 
 	"github.com/opentofu/opentofu/internal/addrs"
@@ -90,6 +91,85 @@ func TestShowHuman_DisplayPlan(t *testing.T) {
 	}
 }
 
+func TestShowHuman_DisplayPlanGraph(t *testing.T) {
+	instance := func(name string) addrs.AbsResourceInstance {
+		return addrs.Resource{
+			Mode: addrs.ManagedResourceMode,
+			Type: "test_resource",
+			Name: name,
+		}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)
+	}
+	provider := addrs.AbsProviderConfig{
+		Provider: addrs.NewDefaultProvider("test"),
+		Module:   addrs.RootModule,
+	}
+
+	// "c" depends on "a" and "b", and "b" depends on "a". "d" is being
+	// destroyed and depends on "a", while "e" is unchanged.
+	priorState := states.BuildState(func(s *states.SyncState) {
+		for name, deps := range map[string][]string{
+			"a": nil,
+			"b": {"a"},
+			"c": {"a", "b"},
+			"d": {"a"},
+			"e": {"a"},
+		} {
+			obj := &states.ResourceInstanceObjectSrc{
+				Status:    states.ObjectReady,
+				AttrsJSON: []byte(`{"id":"` + name + `"}`),
+			}
+			for _, dep := range deps {
+				obj.Dependencies = append(obj.Dependencies, instance(dep).ContainingResource().Config())
+			}
+			s.SetResourceInstanceCurrent(instance(name), obj, provider, addrs.NoKey)
+		}
+	})
+	plan := &plans.Plan{
+		Changes:    plans.NewChanges(),
+		PriorState: priorState,
+	}
+	for name, action := range map[string]plans.Action{
+		"a": plans.Update,
+		"b": plans.DeleteThenCreate,
+		"c": plans.Update,
+		"d": plans.Delete,
+		"e": plans.NoOp,
+		"f": plans.Create,
+	} {
+		plan.Changes.SyncWrapper().AppendResourceInstanceChange(&plans.ResourceInstanceChangeSrc{
+			Addr:         instance(name),
+			ProviderAddr: provider,
+			ChangeSrc:    plans.ChangeSrc{Action: action},
+		})
+	}
+
+	streams, done := terminal.StreamsForTesting(t)
+	v := NewShow(arguments.ViewOptions{ViewType: arguments.ViewHuman}, NewView(streams))
+	if code := v.DisplayPlanGraph(plan); code != 0 {
+		t.Errorf("expected 0 return code, got %d", code)
+	}
+
+	got := done(t).Stdout()
+	want := `digraph {
+	compound = "true"
+	newrank = "true"
+	subgraph "root" {
+		"[root] test_resource.a" [label = "test_resource.a (update)", shape = "box"]
+		"[root] test_resource.b" [label = "test_resource.b (replace)", shape = "box"]
+		"[root] test_resource.c" [label = "test_resource.c (update)", shape = "box"]
+		"[root] test_resource.d" [label = "test_resource.d (destroy)", shape = "box"]
+		"[root] test_resource.f" [label = "test_resource.f (create)", shape = "box"]
+		"[root] test_resource.a" -> "[root] test_resource.d"
+		"[root] test_resource.b" -> "[root] test_resource.a"
+		"[root] test_resource.c" -> "[root] test_resource.b"
+	}
+}
+`
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("wrong output\n%s", diff)
+	}
+}
+
 func TestShowHuman_DisplayState(t *testing.T) {
 	testCases := map[string]struct {
 		stateFile  *statefile.File
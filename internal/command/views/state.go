@@ -29,6 +29,10 @@ type State interface {
 	StateLoadingFailure(baseError string)
 	StateSavingError(baseError string)
 
+	// `tofu state annotate` specific
+	ResourceAnnotateStatus(dryRun bool, target string)
+	AnnotateFinalStatus(count int)
+
 	// `tofu state list` specific
 	StateListAddr(resAddr addrs.AbsResourceInstance)
 
@@ -41,10 +45,16 @@ type State interface {
 	// `tofu state pull` specific
 	PrintPulledState(state string)
 
+	// `tofu state push` specific
+	StatePushConflict(conflict StatePushConflict)
+	StatePushCancelled()
+	StatePushSnapshotSaved(path string)
+
 	// `tofu state replace-provider` specific
 	NoMatchingResourcesForProviderReplacement()
-	ReplaceProviderOverview(from, to addrs.Provider, willReplace []*states.Resource)
+	ReplaceProviderOverview(replacements []ProviderReplacement)
 	ReplaceProviderCancelled()
+	DryRunReplacedProviderStatus(forResources int)
 	ProviderReplaced(forResources int)
 
 	// `tofu state rm` specific
@@ -58,11 +68,54 @@ type State interface {
 	NoInstanceFoundError()
 	ShowResourceState(ctx context.Context, stateFile *statefile.File, schemas *tofu.Schemas) int
 
+	// `tofu state upgrade-check` specific
+	StateUpgradeCheckResults(results []tofu.StateUpgradeCheckResult)
+
+	// `tofu state encrypt`, `tofu state decrypt`, and `tofu state rotate` specific
+	StateEncryptionChanged(change StateEncryptionChange)
+
 	// Backend returns the non-command view that contains methods to provide
 	// progress output for the backend operations.
 	Backend() Backend
 }
 
+// ProviderReplacement describes a provider that `tofu state replace-provider`
+// is replacing, along with the resources that use it.
+type ProviderReplacement struct {
+	From, To  addrs.Provider
+	Resources []*states.Resource
+}
+
+// StatePushConflict describes the destination state that a forced
+// `tofu state push` would overwrite, because it's newer than the pushed state
+// or has a different lineage.
+type StatePushConflict struct {
+	SourceLineage      string
+	SourceSerial       uint64
+	DestinationLineage string
+	DestinationSerial  uint64
+
+	// Lost are the resource instances that exist only in the destination
+	// state, and so would be lost by overwriting it.
+	Lost []addrs.AbsResourceInstance
+}
+
+// StateEncryptionChange describes how `tofu state encrypt`, `tofu state decrypt`,
+// or `tofu state rotate` rewrote the state under the current encryption
+// configuration.
+type StateEncryptionChange struct {
+	// Rewritten is false if the state already used the primary method of the
+	// encryption configuration, and so was left as it was.
+	Rewritten bool
+
+	// Encrypted is true if the state is now encrypted.
+	Encrypted bool
+
+	// Backups are the paths of the backup files of previous versions of the
+	// state that were also rewritten.
+	Backups []string
+}
+
 // NewState returns an initialized State implementation for the given ViewType.
 func NewState(args arguments.ViewOptions, view *View) State {
 	var ret State
@@ -145,15 +198,33 @@ func (m StateMulti) PrintPulledState(state string) {
 	}
 }
 
+func (m StateMulti) StatePushConflict(conflict StatePushConflict) {
+	for _, o := range m {
+		o.StatePushConflict(conflict)
+	}
+}
+
+func (m StateMulti) StatePushCancelled() {
+	for _, o := range m {
+		o.StatePushCancelled()
+	}
+}
+
+func (m StateMulti) StatePushSnapshotSaved(path string) {
+	for _, o := range m {
+		o.StatePushSnapshotSaved(path)
+	}
+}
+
 func (m StateMulti) NoMatchingResourcesForProviderReplacement() {
 	for _, o := range m {
 		o.NoMatchingResourcesForProviderReplacement()
 	}
 }
 
-func (m StateMulti) ReplaceProviderOverview(from, to addrs.Provider, willReplace []*states.Resource) {
+func (m StateMulti) ReplaceProviderOverview(replacements []ProviderReplacement) {
 	for _, o := range m {
-		o.ReplaceProviderOverview(from, to, willReplace)
+		o.ReplaceProviderOverview(replacements)
 	}
 }
 
@@ -163,12 +234,30 @@ func (m StateMulti) ReplaceProviderCancelled() {
 	}
 }
 
+func (m StateMulti) DryRunReplacedProviderStatus(forResources int) {
+	for _, o := range m {
+		o.DryRunReplacedProviderStatus(forResources)
+	}
+}
+
 func (m StateMulti) ProviderReplaced(forResources int) {
 	for _, o := range m {
 		o.ProviderReplaced(forResources)
 	}
 }
 
+func (m StateMulti) ResourceAnnotateStatus(dryRun bool, target string) {
+	for _, o := range m {
+		o.ResourceAnnotateStatus(dryRun, target)
+	}
+}
+
+func (m StateMulti) AnnotateFinalStatus(count int) {
+	for _, o := range m {
+		o.AnnotateFinalStatus(count)
+	}
+}
+
 func (m StateMulti) ResourceRemoveStatus(dryRun bool, target string) {
 	for _, o := range m {
 		o.ResourceRemoveStatus(dryRun, target)
@@ -213,6 +302,18 @@ func (m StateMulti) ShowResourceState(ctx context.Context, stateFile *statefile.
 	return ret
 }
 
+func (m StateMulti) StateUpgradeCheckResults(results []tofu.StateUpgradeCheckResult) {
+	for _, o := range m {
+		o.StateUpgradeCheckResults(results)
+	}
+}
+
+func (m StateMulti) StateEncryptionChanged(change StateEncryptionChange) {
+	for _, o := range m {
+		o.StateEncryptionChanged(change)
+	}
+}
+
 func (m StateMulti) Backend() Backend {
 	ret := make([]Backend, len(m))
 	for i, v := range m {
@@ -285,21 +386,58 @@ func (v *StateHuman) PrintPulledState(state string) {
 	_, _ = v.view.streams.Println(state)
 }
 
+func (v *StateHuman) StatePushConflict(conflict StatePushConflict) {
+	var reason string
+	switch {
+	case conflict.SourceLineage != conflict.DestinationLineage:
+		reason = "has a different lineage than"
+	case conflict.DestinationSerial > conflict.SourceSerial:
+		reason = "is newer than"
+	default:
+		reason = "has the same serial as, but different content than,"
+	}
+	_, _ = v.view.streams.Println(v.view.colorize.Color(fmt.Sprintf("[bold]The destination state %s the state being pushed.[reset]", reason)))
+	_, _ = v.view.streams.Println(fmt.Sprintf("  Destination: lineage %q, serial %d", conflict.DestinationLineage, conflict.DestinationSerial))
+	_, _ = v.view.streams.Println(fmt.Sprintf("  Source:      lineage %q, serial %d", conflict.SourceLineage, conflict.SourceSerial))
+	_, _ = v.view.streams.Println("")
+	if len(conflict.Lost) == 0 {
+		_, _ = v.view.streams.Println("No resource instances exist only in the destination state, but any other changes\nrecorded in it would be lost.")
+		return
+	}
+	_, _ = v.view.streams.Println(fmt.Sprintf("Forcing the push would lose %d resource instance(s) that exist only in the\ndestination state:", len(conflict.Lost)))
+	for _, addr := range conflict.Lost {
+		_, _ = v.view.streams.Println(v.view.colorize.Color(fmt.Sprintf("  [red]-[reset] %s", addr)))
+	}
+}
+
+func (v *StateHuman) StatePushCancelled() {
+	_, _ = v.view.streams.Println("Cancelled pushing the state.")
+}
+
+func (v *StateHuman) StatePushSnapshotSaved(path string) {
+	_, _ = v.view.streams.Println(fmt.Sprintf("Saved a snapshot of the overwritten state to %s", path))
+}
+
 func (v *StateHuman) NoMatchingResourcesForProviderReplacement() {
 	_, _ = v.view.streams.Println("No matching resources found.")
 }
 
-func (v *StateHuman) ReplaceProviderOverview(from, to addrs.Provider, willReplace []*states.Resource) {
+func (v *StateHuman) ReplaceProviderOverview(replacements []ProviderReplacement) {
 	colorize := v.view.colorize.Color
 	printer := func(args ...any) { _, _ = v.view.streams.Println(args...) }
 	printer("OpenTofu will perform the following actions:\n")
-	printer(colorize("  [yellow]~[reset] Updating provider:"))
-	printer(colorize(fmt.Sprintf("    [red]-[reset] %s", from)))
-	printer(colorize(fmt.Sprintf("    [green]+[reset] %s\n", to)))
+	for i, replacement := range replacements {
+		if i > 0 {
+			printer()
+		}
+		printer(colorize("  [yellow]~[reset] Updating provider:"))
+		printer(colorize(fmt.Sprintf("    [red]-[reset] %s", replacement.From)))
+		printer(colorize(fmt.Sprintf("    [green]+[reset] %s\n", replacement.To)))
 
-	printer(colorize(fmt.Sprintf("[bold]Changing[reset] %d resources:\n", len(willReplace))))
-	for _, resource := range willReplace {
-		printer(colorize(fmt.Sprintf("  %s", resource.Addr)))
+		printer(colorize(fmt.Sprintf("[bold]Changing[reset] %d resources:\n", len(replacement.Resources))))
+		for _, resource := range replacement.Resources {
+			printer(colorize(fmt.Sprintf("  %s", resource.Addr)))
+		}
 	}
 }
 
@@ -307,10 +445,30 @@ func (v *StateHuman) ReplaceProviderCancelled() {
 	_, _ = v.view.streams.Println("Cancelled replacing providers.")
 }
 
+func (v *StateHuman) DryRunReplacedProviderStatus(forResources int) {
+	_, _ = v.view.streams.Println(fmt.Sprintf("\nDry run: would have replaced provider for %d resources.", forResources))
+}
+
 func (v *StateHuman) ProviderReplaced(forResources int) {
 	_, _ = v.view.streams.Println(fmt.Sprintf("Successfully replaced provider for %d resources.", forResources))
 }
 
+func (v *StateHuman) ResourceAnnotateStatus(dryRun bool, target string) {
+	if dryRun {
+		_, _ = v.view.streams.Println(fmt.Sprintf("Would annotate %s", target))
+		return
+	}
+	_, _ = v.view.streams.Println(fmt.Sprintf("Annotated %s", target))
+}
+
+func (v *StateHuman) AnnotateFinalStatus(count int) {
+	if count == 0 {
+		// NOTE: printing nothing here since this case needs to be handled by the caller
+		return
+	}
+	_, _ = v.view.streams.Println(fmt.Sprintf("Successfully annotated %d resource instance(s).", count))
+}
+
 func (v *StateHuman) ResourceRemoveStatus(dryRun bool, target string) {
 	if dryRun {
 		_, _ = v.view.streams.Println(fmt.Sprintf("Would remove %s", target))
@@ -384,6 +542,42 @@ func (v *StateHuman) ShowResourceState(_ context.Context, stateFile *statefile.F
 	return 0
 }
 
+func (v *StateHuman) StateUpgradeCheckResults(results []tofu.StateUpgradeCheckResult) {
+	if len(results) == 0 {
+		_, _ = v.view.streams.Println("The state has no managed resource instances to check.")
+		return
+	}
+
+	var changed, failed int
+	for _, result := range results {
+		addr := result.Addr.String()
+		if result.DeposedKey != states.NotDeposed {
+			addr = fmt.Sprintf("%s (deposed object %s)", addr, result.DeposedKey)
+		}
+		switch result.Outcome {
+		case tofu.StateUpgradeFailed:
+			failed++
+			_, _ = v.view.streams.Println(v.view.colorize.Color(fmt.Sprintf("[red]![reset] [bold]%s[reset]: would fail to upgrade from schema version %d", addr, result.FromVersion)))
+		case tofu.StateUpgradeChanged:
+			changed++
+			_, _ = v.view.streams.Println(v.view.colorize.Color(fmt.Sprintf("[yellow]~[reset] [bold]%s[reset]: would upgrade from schema version %d to %d", addr, result.FromVersion, result.ToVersion)))
+		default:
+			_, _ = v.view.streams.Println(v.view.colorize.Color(fmt.Sprintf("  [bold]%s[reset]: unchanged at schema version %d", addr, result.ToVersion)))
+		}
+		for _, msg := range stateUpgradeCheckMessages(result.Diagnostics) {
+			_, _ = v.view.streams.Println("    " + msg)
+		}
+	}
+	_, _ = v.view.streams.Println(fmt.Sprintf("\nChecked %d resource instance object(s): %d unchanged, %d would change, %d would fail.", len(results), len(results)-changed-failed, changed, failed))
+}
+
+func (v *StateHuman) StateEncryptionChanged(change StateEncryptionChange) {
+	_, _ = v.view.streams.Println(stateEncryptionMessage(change))
+	for _, path := range change.Backups {
+		_, _ = v.view.streams.Println(fmt.Sprintf("Rewrote the backup file %s under the current encryption configuration.", path))
+	}
+}
+
 func (v *StateHuman) Backend() Backend {
 	return &BackendHuman{
 		view: v.view,
@@ -455,27 +649,72 @@ func (v *StateJSON) PrintPulledState(_ string) {
 	v.view.Error("printing the pulled state is not available in the JSON view. The `tofu state pull` should not be configured with the `-json` flag")
 }
 
+func (v *StateJSON) StatePushConflict(conflict StatePushConflict) {
+	lost := make([]string, len(conflict.Lost))
+	for i, addr := range conflict.Lost {
+		lost[i] = addr.String()
+	}
+	msg := fmt.Sprintf("Forcing the push would overwrite the destination state and lose %d resource instance(s)", len(lost))
+	v.view.log.Info(
+		msg,
+		"type", "state_push_conflict",
+		"source", map[string]any{"lineage": conflict.SourceLineage, "serial": conflict.SourceSerial},
+		"destination", map[string]any{"lineage": conflict.DestinationLineage, "serial": conflict.DestinationSerial},
+		"lost_resources", lost,
+	)
+}
+
+func (v *StateJSON) StatePushCancelled() {
+	v.view.Info("Cancelled pushing the state")
+}
+
+func (v *StateJSON) StatePushSnapshotSaved(path string) {
+	v.view.log.Info(fmt.Sprintf("Saved a snapshot of the overwritten state to %s", path), "type", "state_push_snapshot", "path", path)
+}
+
 func (v *StateJSON) NoMatchingResourcesForProviderReplacement() {
 	v.view.log.Info("No matching resources found")
 }
 
-func (v *StateJSON) ReplaceProviderOverview(from, to addrs.Provider, willReplace []*states.Resource) {
-	replacedResources := make([]string, len(willReplace))
-	for i, resource := range willReplace {
-		replacedResources[i] = resource.Addr.String()
+func (v *StateJSON) ReplaceProviderOverview(replacements []ProviderReplacement) {
+	for _, replacement := range replacements {
+		replacedResources := make([]string, len(replacement.Resources))
+		for i, resource := range replacement.Resources {
+			replacedResources[i] = resource.Addr.String()
+		}
+		msg := fmt.Sprintf("OpenTofu will replace provider from %s to %s for %d resources", replacement.From, replacement.To, len(replacement.Resources))
+		v.view.log.Info(msg, "resources", replacedResources, "type", "replace_provider", "from", replacement.From.String(), "to", replacement.To.String())
 	}
-	msg := fmt.Sprintf("OpenTofu will replace provider from %s to %s for %d resources", from, to, len(willReplace))
-	v.view.log.Info(msg, "resources", replacedResources, "type", "replace_provider", "from", from.String(), "to", to.String())
 }
 
 func (v *StateJSON) ReplaceProviderCancelled() {
 	v.view.Info("Cancelled replacing providers")
 }
 
+func (v *StateJSON) DryRunReplacedProviderStatus(forResources int) {
+	v.view.Info(fmt.Sprintf("Dry run: would have replaced provider for %d resources", forResources))
+}
+
 func (v *StateJSON) ProviderReplaced(forResources int) {
 	v.view.Info(fmt.Sprintf("Successfully replaced provider for %d resources", forResources))
 }
 
+func (v *StateJSON) ResourceAnnotateStatus(dryRun bool, target string) {
+	if dryRun {
+		v.view.Info(fmt.Sprintf("Would annotate %s", target))
+		return
+	}
+	v.view.Info(fmt.Sprintf("Annotated %s", target))
+}
+
+func (v *StateJSON) AnnotateFinalStatus(count int) {
+	if count == 0 {
+		// NOTE: printing nothing here since this case needs to be handled by the caller
+		return
+	}
+	v.view.Info(fmt.Sprintf("Successfully annotated %d resource instance(s)", count))
+}
+
 func (v *StateJSON) ResourceRemoveStatus(dryRun bool, target string) {
 	if dryRun {
 		v.view.Info(fmt.Sprintf("Would remove %s", target))
@@ -520,7 +759,7 @@ func (v *StateJSON) ShowResourceState(_ context.Context, stateFile *statefile.Fi
 		return 0
 	}
 
-	rawState, err := jsonstate.Marshal(stateFile, schemas)
+	rawState, err := jsonstate.MarshalWithInstanceMetadata(stateFile, schemas)
 	if err != nil {
 		v.Diagnostics(tfdiags.Diagnostics{}.Append(tfdiags.Sourceless(
 			tfdiags.Error,
@@ -533,12 +772,85 @@ func (v *StateJSON) ShowResourceState(_ context.Context, stateFile *statefile.Fi
 	return 0
 }
 
+func (v *StateJSON) StateUpgradeCheckResults(results []tofu.StateUpgradeCheckResult) {
+	var changed, failed int
+	for _, result := range results {
+		switch result.Outcome {
+		case tofu.StateUpgradeFailed:
+			failed++
+		case tofu.StateUpgradeChanged:
+			changed++
+		}
+		msg := fmt.Sprintf("%s: %s", result.Addr, result.Outcome)
+		v.view.log.Info(
+			msg,
+			"type", "state_upgrade_check",
+			"address", result.Addr.String(),
+			"deposed_key", result.DeposedKey.String(),
+			"provider", result.Provider.String(),
+			"from_version", result.FromVersion,
+			"to_version", result.ToVersion,
+			"outcome", string(result.Outcome),
+			"messages", stateUpgradeCheckMessages(result.Diagnostics),
+		)
+	}
+	v.view.Info(fmt.Sprintf("Checked %d resource instance object(s): %d unchanged, %d would change, %d would fail", len(results), len(results)-changed-failed, changed, failed))
+}
+
+func (v *StateJSON) StateEncryptionChanged(change StateEncryptionChange) {
+	backups := change.Backups
+	if backups == nil {
+		backups = []string{}
+	}
+	v.view.log.Info(
+		stateEncryptionMessage(change),
+		"type", "state_encryption",
+		"rewritten", change.Rewritten,
+		"encrypted", change.Encrypted,
+		"backups", backups,
+	)
+}
+
 func (v *StateJSON) Backend() Backend {
 	return &BackendJSON{
 		view: v.view,
 	}
 }
 
+// stateEncryptionMessage returns a description of the given change to the
+// encryption of the state.
+func stateEncryptionMessage(change StateEncryptionChange) string {
+	switch {
+	case !change.Rewritten:
+		return "The state already uses the current encryption configuration, so it was not changed."
+	case change.Encrypted:
+		return "The state is now encrypted using the current encryption configuration."
+	default:
+		return "The state is now stored without encryption."
+	}
+}
+
+// stateUpgradeCheckMessages returns a single-line description of each of the
+// given diagnostics, for showing alongside the result of a state upgrade
+// check.
+func stateUpgradeCheckMessages(diags tfdiags.Diagnostics) []string {
+	var ret []string
+	for _, diag := range diags {
+		desc := diag.Description()
+		msg := desc.Summary
+		if desc.Detail != "" {
+			msg = fmt.Sprintf("%s: %s", desc.Summary, desc.Detail)
+		}
+		if diag.Severity() == tfdiags.Warning {
+			msg = "Warning: " + msg
+		} else {
+			msg = "Error: " + msg
+		}
+		ret = append(ret, msg)
+	}
+	return ret
+}
+
 var (
 	diagErrStateNotFound = tfdiags.Sourceless(
 		tfdiags.Error,
@@ -256,19 +256,21 @@ persisted. Your existing states are untouched.
 		},
 		"replaceProviderOverview": {
 			viewCall: func(state State) {
-				state.ReplaceProviderOverview(
-					regaddr.NewProvider("registry1.org", "ns", "prov"),
-					regaddr.NewProvider("registry2.org", "ns", "prov"),
-					[]*states.Resource{
-						{
-							Addr: addrs.AbsResource{Resource: addrs.Resource{
-								Mode: addrs.ManagedResourceMode,
-								Type: "res",
-								Name: "foo",
-							}},
+				state.ReplaceProviderOverview([]ProviderReplacement{
+					{
+						From: regaddr.NewProvider("registry1.org", "ns", "prov"),
+						To:   regaddr.NewProvider("registry2.org", "ns", "prov"),
+						Resources: []*states.Resource{
+							{
+								Addr: addrs.AbsResource{Resource: addrs.Resource{
+									Mode: addrs.ManagedResourceMode,
+									Type: "res",
+									Name: "foo",
+								}},
+							},
 						},
 					},
-				)
+				})
 			},
 			wantJson: []map[string]any{
 				{
@@ -292,6 +294,89 @@ Changing 1 resources:
   res.foo
 `,
 		},
+		"replaceProviderOverview with multiple providers": {
+			viewCall: func(state State) {
+				state.ReplaceProviderOverview([]ProviderReplacement{
+					{
+						From: regaddr.NewProvider("registry1.org", "ns", "prov"),
+						To:   regaddr.NewProvider("registry2.org", "ns", "prov"),
+						Resources: []*states.Resource{
+							{
+								Addr: addrs.AbsResource{Resource: addrs.Resource{
+									Mode: addrs.ManagedResourceMode,
+									Type: "prov_res",
+									Name: "foo",
+								}},
+							},
+						},
+					},
+					{
+						From: regaddr.NewProvider("registry1.org", "ns", "other"),
+						To:   regaddr.NewProvider("registry2.org", "ns", "other"),
+						Resources: []*states.Resource{
+							{
+								Addr: addrs.AbsResource{Resource: addrs.Resource{
+									Mode: addrs.ManagedResourceMode,
+									Type: "other_res",
+									Name: "bar",
+								}},
+							},
+						},
+					},
+				})
+			},
+			wantJson: []map[string]any{
+				{
+					"@level":    "info",
+					"@message":  "OpenTofu will replace provider from registry1.org/ns/prov to registry2.org/ns/prov for 1 resources",
+					"resources": []any{"prov_res.foo"},
+					"@module":   "tofu.ui",
+					"type":      "replace_provider",
+					"from":      "registry1.org/ns/prov",
+					"to":        "registry2.org/ns/prov",
+				},
+				{
+					"@level":    "info",
+					"@message":  "OpenTofu will replace provider from registry1.org/ns/other to registry2.org/ns/other for 1 resources",
+					"resources": []any{"other_res.bar"},
+					"@module":   "tofu.ui",
+					"type":      "replace_provider",
+					"from":      "registry1.org/ns/other",
+					"to":        "registry2.org/ns/other",
+				},
+			},
+			wantStdout: `OpenTofu will perform the following actions:
+
+  ~ Updating provider:
+    - registry1.org/ns/prov
+    + registry2.org/ns/prov
+
+Changing 1 resources:
+
+  prov_res.foo
+
+  ~ Updating provider:
+    - registry1.org/ns/other
+    + registry2.org/ns/other
+
+Changing 1 resources:
+
+  other_res.bar
+`,
+		},
+		"dryRunReplacedProviderStatus": {
+			viewCall: func(state State) {
+				state.DryRunReplacedProviderStatus(3)
+			},
+			wantJson: []map[string]any{
+				{
+					"@level":   "info",
+					"@message": "Dry run: would have replaced provider for 3 resources",
+					"@module":  "tofu.ui",
+				},
+			},
+			wantStdout: "\nDry run: would have replaced provider for 3 resources.\n",
+		},
 		"replaceProviderCancelled": {
 			viewCall: func(state State) {
 				state.ReplaceProviderCancelled()
@@ -318,6 +403,45 @@ Changing 1 resources:
 			},
 			wantStdout: withNewline(`Successfully replaced provider for 2 resources.`),
 		},
+		"resourceAnnotateStatus with dryRun=true": {
+			viewCall: func(state State) {
+				state.ResourceAnnotateStatus(true, "test_res.name1")
+			},
+			wantJson: []map[string]any{
+				{
+					"@level":   "info",
+					"@message": "Would annotate test_res.name1",
+					"@module":  "tofu.ui",
+				},
+			},
+			wantStdout: withNewline("Would annotate test_res.name1"),
+		},
+		"resourceAnnotateStatus with dryRun=false": {
+			viewCall: func(state State) {
+				state.ResourceAnnotateStatus(false, "test_res.name1")
+			},
+			wantJson: []map[string]any{
+				{
+					"@level":   "info",
+					"@message": "Annotated test_res.name1",
+					"@module":  "tofu.ui",
+				},
+			},
+			wantStdout: withNewline("Annotated test_res.name1"),
+		},
+		"annotateFinalStatus with >0 resources": {
+			viewCall: func(state State) {
+				state.AnnotateFinalStatus(2)
+			},
+			wantJson: []map[string]any{
+				{
+					"@level":   "info",
+					"@message": "Successfully annotated 2 resource instance(s)",
+					"@module":  "tofu.ui",
+				},
+			},
+			wantStdout: withNewline("Successfully annotated 2 resource instance(s)."),
+		},
 		"resourceRemoveStatus with dryRun=true": {
 			viewCall: func(state State) {
 				state.ResourceRemoveStatus(true, "test_res.name1")
@@ -539,12 +663,13 @@ resource "test_resource" "foo" {
 						"root_module": map[string]any{
 							"resources": []any{
 								map[string]any{
-									"address":        "test_resource.foo",
-									"mode":           "managed",
-									"type":           "test_resource",
-									"name":           "foo",
-									"provider_name":  "registry.opentofu.org/hashicorp/test",
-									"schema_version": float64(0),
+									"address":         "test_resource.foo",
+									"mode":            "managed",
+									"type":            "test_resource",
+									"name":            "foo",
+									"provider_name":   "registry.opentofu.org/hashicorp/test",
+									"provider_config": `provider["registry.opentofu.org/hashicorp/test"]`,
+									"schema_version":  float64(0),
 									"values": map[string]any{
 										"foo": "value",
 										"id":  "bar",
@@ -557,6 +682,152 @@ resource "test_resource" "foo" {
 				},
 			},
 		},
+		"statePushConflict": {
+			viewCall: func(state State) {
+				state.StatePushConflict(StatePushConflict{
+					SourceLineage:      "hello",
+					SourceSerial:       1,
+					DestinationLineage: "hello",
+					DestinationSerial:  3,
+					Lost: []addrs.AbsResourceInstance{
+						addrs.Resource{
+							Mode: addrs.ManagedResourceMode,
+							Type: "test_instance",
+							Name: "foo",
+						}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance),
+					},
+				})
+			},
+			wantStdout: `The destination state is newer than the state being pushed.
+  Destination: lineage "hello", serial 3
+  Source:      lineage "hello", serial 1
+
+Forcing the push would lose 1 resource instance(s) that exist only in the
+destination state:
+  - test_instance.foo
+`,
+			wantJson: []map[string]any{
+				{
+					"@level":         "info",
+					"@message":       "Forcing the push would overwrite the destination state and lose 1 resource instance(s)",
+					"@module":        "tofu.ui",
+					"type":           "state_push_conflict",
+					"source":         map[string]any{"lineage": "hello", "serial": float64(1)},
+					"destination":    map[string]any{"lineage": "hello", "serial": float64(3)},
+					"lost_resources": []any{"test_instance.foo"},
+				},
+			},
+		},
+		"statePushSnapshotSaved": {
+			viewCall: func(state State) {
+				state.StatePushSnapshotSaved("terraform.tfstate.1700000000.backup")
+			},
+			wantStdout: withNewline("Saved a snapshot of the overwritten state to terraform.tfstate.1700000000.backup"),
+			wantJson: []map[string]any{
+				{
+					"@level":   "info",
+					"@message": "Saved a snapshot of the overwritten state to terraform.tfstate.1700000000.backup",
+					"@module":  "tofu.ui",
+					"type":     "state_push_snapshot",
+					"path":     "terraform.tfstate.1700000000.backup",
+				},
+			},
+		},
+		"stateUpgradeCheckResults": {
+			viewCall: func(state State) {
+				addr := addrs.Resource{
+					Mode: addrs.ManagedResourceMode,
+					Type: "test_instance",
+					Name: "foo",
+				}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)
+				provider := addrs.NewDefaultProvider("test")
+				state.StateUpgradeCheckResults([]tofu.StateUpgradeCheckResult{
+					{Addr: addr, Provider: provider, FromVersion: 0, ToVersion: 1, Outcome: tofu.StateUpgradeChanged},
+					{Addr: addr, DeposedKey: "00000001", Provider: provider, FromVersion: 0, ToVersion: 1, Outcome: tofu.StateUpgradeFailed, Diagnostics: tfdiags.Diagnostics{
+						tfdiags.Sourceless(tfdiags.Error, "Cannot upgrade", "Broken object."),
+					}},
+				})
+			},
+			wantStdout: `~ test_instance.foo: would upgrade from schema version 0 to 1
+! test_instance.foo (deposed object 00000001): would fail to upgrade from schema version 0
+    Error: Cannot upgrade: Broken object.
+
+Checked 2 resource instance object(s): 0 unchanged, 1 would change, 1 would fail.
+`,
+			wantJson: []map[string]any{
+				{
+					"@level":       "info",
+					"@message":     "test_instance.foo: changed",
+					"@module":      "tofu.ui",
+					"type":         "state_upgrade_check",
+					"address":      "test_instance.foo",
+					"deposed_key":  "",
+					"provider":     "registry.opentofu.org/hashicorp/test",
+					"from_version": float64(0),
+					"to_version":   float64(1),
+					"outcome":      "changed",
+					"messages":     nil,
+				},
+				{
+					"@level":       "info",
+					"@message":     "test_instance.foo: failed",
+					"@module":      "tofu.ui",
+					"type":         "state_upgrade_check",
+					"address":      "test_instance.foo",
+					"deposed_key":  "00000001",
+					"provider":     "registry.opentofu.org/hashicorp/test",
+					"from_version": float64(0),
+					"to_version":   float64(1),
+					"outcome":      "failed",
+					"messages":     []any{"Error: Cannot upgrade: Broken object."},
+				},
+				{
+					"@level":   "info",
+					"@message": "Checked 2 resource instance object(s): 0 unchanged, 1 would change, 1 would fail",
+					"@module":  "tofu.ui",
+				},
+			},
+		},
+		"stateEncryptionChanged": {
+			viewCall: func(state State) {
+				state.StateEncryptionChanged(StateEncryptionChange{
+					Rewritten: true,
+					Encrypted: true,
+					Backups:   []string{"terraform.tfstate.backup"},
+				})
+			},
+			wantStdout: `The state is now encrypted using the current encryption configuration.
+Rewrote the backup file terraform.tfstate.backup under the current encryption configuration.
+`,
+			wantJson: []map[string]any{
+				{
+					"@level":    "info",
+					"@message":  "The state is now encrypted using the current encryption configuration.",
+					"@module":   "tofu.ui",
+					"type":      "state_encryption",
+					"rewritten": true,
+					"encrypted": true,
+					"backups":   []any{"terraform.tfstate.backup"},
+				},
+			},
+		},
+		"stateEncryptionChanged unchanged": {
+			viewCall: func(state State) {
+				state.StateEncryptionChanged(StateEncryptionChange{Encrypted: true})
+			},
+			wantStdout: withNewline("The state already uses the current encryption configuration, so it was not changed."),
+			wantJson: []map[string]any{
+				{
+					"@level":    "info",
+					"@message":  "The state already uses the current encryption configuration, so it was not changed.",
+					"@module":   "tofu.ui",
+					"type":      "state_encryption",
+					"rewritten": false,
+					"encrypted": true,
+					"backups":   []any{},
+				},
+			},
+		},
 		// Diagnostics
 		"warning": {
 			viewCall: func(state State) {
@@ -0,0 +1,171 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package views
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/opentofu/opentofu/internal/command/arguments"
+	"github.com/opentofu/opentofu/internal/command/jsonentities"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// UnusedModule lists the declarations in a module that nothing in the
+// configuration refers to, as found by the "tofu unused" command.
+type UnusedModule struct {
+	// Path is the address of the module in the configuration, such as
+	// "module.network", or empty for the root module.
+	Path string `json:"path"`
+
+	Declarations []UnusedDeclaration `json:"declarations"`
+}
+
+// UnusedDeclaration is a single declaration that nothing refers to.
+type UnusedDeclaration struct {
+	// Address is the address of the declaration within its module, such as
+	// "var.region", "local.tags", "output.id", "data.aws_ami.ubuntu" or
+	// "provider.aws.west".
+	Address string `json:"address"`
+
+	// Kind is "variable", "local", "output", "data" or "provider".
+	Kind string `json:"kind"`
+
+	Range jsonentities.DiagnosticRange `json:"range"`
+}
+
+// The Unused view renders the unused declarations in a configuration.
+type Unused interface {
+	Results(modules []UnusedModule)
+	Diagnostics(diags tfdiags.Diagnostics)
+}
+
+// NewUnused returns an initialized Unused implementation for the given
+// ViewType.
+func NewUnused(args arguments.ViewOptions, view *View) Unused {
+	var unused Unused
+	switch args.ViewType {
+	case arguments.ViewJSON:
+		unused = &UnusedJSON{view: view, output: view.streams.Stdout.File}
+	case arguments.ViewHuman:
+		unused = &UnusedHuman{view: view}
+	default:
+		panic(fmt.Sprintf("unknown view type %v", args.ViewType))
+	}
+
+	if args.JSONInto != nil {
+		unused = UnusedMulti{unused, &UnusedJSON{view: view, output: args.JSONInto}}
+	}
+	return unused
+}
+
+type UnusedMulti []Unused
+
+var _ Unused = (UnusedMulti)(nil)
+
+func (m UnusedMulti) Results(modules []UnusedModule) {
+	for _, u := range m {
+		u.Results(modules)
+	}
+}
+
+func (m UnusedMulti) Diagnostics(diags tfdiags.Diagnostics) {
+	for _, u := range m {
+		u.Diagnostics(diags)
+	}
+}
+
+type UnusedHuman struct {
+	view *View
+}
+
+var _ Unused = (*UnusedHuman)(nil)
+
+func (v *UnusedHuman) Results(modules []UnusedModule) {
+	if len(modules) == 0 {
+		v.view.streams.Println(v.view.colorize.Color("[green][bold]Success![reset] Every variable, local value, output value, data source and provider configuration is used."))
+		return
+	}
+
+	var buf strings.Builder
+	count := 0
+	for _, mod := range modules {
+		if mod.Path == "" {
+			buf.WriteString("[bold]Root module[reset]\n")
+		} else {
+			buf.WriteString(fmt.Sprintf("[bold]%s[reset]\n", mod.Path))
+		}
+		width := 0
+		for _, decl := range mod.Declarations {
+			width = max(width, len(decl.Address))
+		}
+		for _, decl := range mod.Declarations {
+			buf.WriteString(fmt.Sprintf("  %-*s  %s:%d\n", width, decl.Address, decl.Range.Filename, decl.Range.Start.Line))
+			count++
+		}
+		buf.WriteString("\n")
+	}
+
+	noun := "declarations"
+	if count == 1 {
+		noun = "declaration"
+	}
+	buf.WriteString(fmt.Sprintf("Found %d unused %s that nothing in the configuration refers to.\n", count, noun))
+	_, _ = v.view.streams.Print(v.view.colorize.Color(buf.String()))
+}
+
+func (v *UnusedHuman) Diagnostics(diags tfdiags.Diagnostics) {
+	v.view.Diagnostics(diags)
+}
+
+type UnusedJSON struct {
+	view   *View
+	output *os.File
+}
+
+var _ Unused = (*UnusedJSON)(nil)
+
+func (v *UnusedJSON) Results(modules []UnusedModule) {
+	// FormatVersion represents the version of the json format and will be
+	// incremented for any change to this format that requires changes to a
+	// consuming parser.
+	const FormatVersion = "1.0"
+
+	type Output struct {
+		FormatVersion string         `json:"format_version"`
+		UnusedCount   int            `json:"unused_count"`
+		Modules       []UnusedModule `json:"modules"`
+	}
+
+	output := Output{
+		FormatVersion: FormatVersion,
+		Modules:       modules,
+	}
+	for _, mod := range modules {
+		output.UnusedCount += len(mod.Declarations)
+	}
+	if output.Modules == nil {
+		// Make sure this always appears as an array in our output, since
+		// this is easier to consume for dynamically-typed languages.
+		output.Modules = []UnusedModule{}
+	}
+
+	j, err := json.MarshalIndent(&output, "", "  ")
+	if err != nil {
+		// Should never happen because we fully-control the input here
+		panic(err)
+	}
+	fmt.Fprintln(v.output, string(j))
+}
+
+// Diagnostics should only be called if the configuration cannot be loaded.
+// In this case, we choose to render human-readable diagnostic output, as
+// ValidateJSON does.
+func (v *UnusedJSON) Diagnostics(diags tfdiags.Diagnostics) {
+	v.view.Diagnostics(diags)
+}
@@ -22,25 +22,47 @@ type Validate interface {
 	// returns a CLI exit code: 0 if there are no errors, 1 otherwise
 	Results(diags tfdiags.Diagnostics) int
 
+	// RecursiveResults renders the diagnostics returned from validating each
+	// of the modules in a directory tree, grouped by module, along with any
+	// diagnostics that don't belong to a particular module. It returns a CLI
+	// exit code: 0 if there are no errors, 1 otherwise
+	RecursiveResults(modules []ValidateModuleResult, diags tfdiags.Diagnostics) int
+
 	// Diagnostics renders early diagnostics, resulting from argument parsing.
 	Diagnostics(diags tfdiags.Diagnostics)
 }
 
-// NewValidate returns an initialized Validate implementation for the given ViewType.
-func NewValidate(args arguments.ViewOptions, view *View) Validate {
+// ValidateModuleResult is the result of validating one of the modules in a
+// directory tree.
+type ValidateModuleResult struct {
+	// Path is the directory containing the module, relative to the root of
+	// the directory tree and using forward slashes.
+	Path string
+
+	Diagnostics tfdiags.Diagnostics
+}
+
+// NewValidate returns an initialized Validate implementation for the given
+// ViewType and machine-readable output format.
+func NewValidate(args *arguments.Validate, view *View) Validate {
+	newMachineReadable := func(output *os.File) Validate {
+		if args.Format == arguments.ValidateFormatSARIF {
+			return &ValidateSARIF{view: view, output: output}
+		}
+		return &ValidateJSON{view: view, output: output, fixSuggestions: args.FixSuggestions}
+	}
+
 	var validate Validate
-	switch args.ViewType {
+	switch args.ViewOptions.ViewType {
 	case arguments.ViewJSON:
-		validate = &ValidateJSON{view: view, output: view.streams.Stdout.File}
+		validate = newMachineReadable(view.streams.Stdout.File)
 	case arguments.ViewHuman:
 		validate = &ValidateHuman{view: view}
 	default:
-		panic(fmt.Sprintf("unknown view type %v", args.ViewType))
+		panic(fmt.Sprintf("unknown view type %v", args.ViewOptions.ViewType))
 	}
-	if args.JSONInto != nil {
-		validate = ValidateMulti{validate, &ValidateJSON{
-			view: view, output: args.JSONInto,
-		}}
+	if args.ViewOptions.JSONInto != nil {
+		validate = ValidateMulti{validate, newMachineReadable(args.ViewOptions.JSONInto)}
 	}
 	return validate
 }
@@ -59,6 +81,17 @@ func (m ValidateMulti) Results(diags tfdiags.Diagnostics) int {
 	return code
 }
 
+// RecursiveResults renders the diagnostics returned from validating each of
+// the modules in a directory tree, and returns a CLI exit code: 0 if there are
+// no errors, 1 otherwise
+func (m ValidateMulti) RecursiveResults(modules []ValidateModuleResult, diags tfdiags.Diagnostics) int {
+	var code int
+	for _, v := range m {
+		code = max(code, v.RecursiveResults(modules, diags))
+	}
+	return code
+}
+
 // Diagnostics renders early diagnostics, resulting from argument parsing.
 func (m ValidateMulti) Diagnostics(diags tfdiags.Diagnostics) {
 	for _, v := range m {
@@ -94,10 +127,40 @@ func (v *ValidateHuman) Results(diags tfdiags.Diagnostics) int {
 	return 0
 }
 
+func (v *ValidateHuman) RecursiveResults(modules []ValidateModuleResult, diags tfdiags.Diagnostics) int {
+	columns := v.view.outputColumns()
+
+	v.Diagnostics(diags)
+
+	invalid := 0
+	for _, mod := range modules {
+		v.view.streams.Printf(v.view.colorize.Color("[bold]Module %s[reset]\n"), mod.Path)
+		if v.Results(mod.Diagnostics) != 0 {
+			invalid++
+		}
+		v.view.streams.Println()
+	}
+
+	if invalid != 0 {
+		v.view.streams.Println(format.WordWrap(fmt.Sprintf(v.view.colorize.Color(validateRecursiveErrors), invalid, len(modules)), columns))
+	} else if !diags.HasErrors() {
+		v.view.streams.Println(format.WordWrap(fmt.Sprintf(v.view.colorize.Color(validateRecursiveSuccess), len(modules)), columns))
+	}
+
+	if invalid != 0 || diags.HasErrors() {
+		return 1
+	}
+	return 0
+}
+
 const validateSuccess = "[green][bold]Success![reset] The configuration is valid."
 
 const validateWarnings = "[green][bold]Success![reset] The configuration is valid, but there were some validation warnings as shown above."
 
+const validateRecursiveSuccess = "[green][bold]Success![reset] All %d modules are valid."
+
+const validateRecursiveErrors = "[red][bold]Error:[reset] %d of %d modules are invalid."
+
 func (v *ValidateHuman) Diagnostics(diags tfdiags.Diagnostics) {
 	v.view.Diagnostics(diags)
 }
@@ -108,58 +171,109 @@ func (v *ValidateHuman) Diagnostics(diags tfdiags.Diagnostics) {
 type ValidateJSON struct {
 	view   *View
 	output *os.File
+
+	// fixSuggestions enables including suggested fixes in the diagnostics.
+	fixSuggestions bool
 }
 
 var _ Validate = (*ValidateJSON)(nil)
 
-func (v *ValidateJSON) Results(diags tfdiags.Diagnostics) int {
-	// FormatVersion represents the version of the json format and will be
-	// incremented for any change to this format that requires changes to a
-	// consuming parser.
-	const FormatVersion = "1.0"
+// validateJSONFormatVersion represents the version of the json format and
+// will be incremented for any change to this format that requires changes to
+// a consuming parser.
+const validateJSONFormatVersion = "1.0"
+
+// validateJSONSummary is the JSON representation of a set of validation
+// diagnostics.
+type validateJSONSummary struct {
+	// We include some summary information that is actually redundant
+	// with the detailed diagnostics, but avoids the need for callers
+	// to re-implement our logic for deciding these.
+	Valid        bool                       `json:"valid"`
+	ErrorCount   int                        `json:"error_count"`
+	WarningCount int                        `json:"warning_count"`
+	Diagnostics  []*jsonentities.Diagnostic `json:"diagnostics"`
+}
+
+// validateJSONModule is the JSON representation of the result of validating
+// one of the modules in a directory tree.
+type validateJSONModule struct {
+	Path string `json:"path"`
+	validateJSONSummary
+}
 
-	type Output struct {
-		FormatVersion string `json:"format_version"`
+// validateJSONOutput is the JSON object that ValidateJSON renders. Modules is
+// only included in the results of a recursive validation, in which case the
+// top-level summary covers all of the modules.
+type validateJSONOutput struct {
+	FormatVersion string `json:"format_version"`
+	validateJSONSummary
+	Modules []validateJSONModule `json:"modules,omitempty"`
+}
 
-		// We include some summary information that is actually redundant
-		// with the detailed diagnostics, but avoids the need for callers
-		// to re-implement our logic for deciding these.
-		Valid        bool                       `json:"valid"`
-		ErrorCount   int                        `json:"error_count"`
-		WarningCount int                        `json:"warning_count"`
-		Diagnostics  []*jsonentities.Diagnostic `json:"diagnostics"`
+func (v *ValidateJSON) Results(diags tfdiags.Diagnostics) int {
+	output := validateJSONOutput{
+		FormatVersion:       validateJSONFormatVersion,
+		validateJSONSummary: v.summary(diags),
 	}
+	return v.render(&output)
+}
 
-	output := Output{
-		FormatVersion: FormatVersion,
-		Valid:         true, // until proven otherwise
+func (v *ValidateJSON) RecursiveResults(modules []ValidateModuleResult, diags tfdiags.Diagnostics) int {
+	output := validateJSONOutput{
+		FormatVersion: validateJSONFormatVersion,
+		Modules:       make([]validateJSONModule, 0, len(modules)),
+	}
+	var all tfdiags.Diagnostics
+	all = all.Append(diags)
+	for _, mod := range modules {
+		output.Modules = append(output.Modules, validateJSONModule{
+			Path:                mod.Path,
+			validateJSONSummary: v.summary(mod.Diagnostics),
+		})
+		all = all.Append(mod.Diagnostics)
+	}
+	output.validateJSONSummary = v.summary(all)
+	return v.render(&output)
+}
+
+func (v *ValidateJSON) summary(diags tfdiags.Diagnostics) validateJSONSummary {
+	summary := validateJSONSummary{
+		Valid: true, // until proven otherwise
 	}
 	configSources := v.view.configSources()
 	for _, diag := range diags {
-		output.Diagnostics = append(output.Diagnostics, jsonentities.NewDiagnostic(diag, configSources))
+		jsonDiag := jsonentities.NewDiagnostic(diag, configSources)
+		if v.fixSuggestions {
+			jsonDiag.Fixes = jsonentities.NewDiagnosticFixes(diag, configSources)
+		}
+		summary.Diagnostics = append(summary.Diagnostics, jsonDiag)
 
 		switch diag.Severity() {
 		case tfdiags.Error:
-			output.ErrorCount++
-			output.Valid = false
+			summary.ErrorCount++
+			summary.Valid = false
 		case tfdiags.Warning:
-			output.WarningCount++
+			summary.WarningCount++
 		}
 	}
-	if output.Diagnostics == nil {
+	if summary.Diagnostics == nil {
 		// Make sure this always appears as an array in our output, since
 		// this is easier to consume for dynamically-typed languages.
-		output.Diagnostics = []*jsonentities.Diagnostic{}
+		summary.Diagnostics = []*jsonentities.Diagnostic{}
 	}
+	return summary
+}
 
-	j, err := json.MarshalIndent(&output, "", "  ")
+func (v *ValidateJSON) render(output *validateJSONOutput) int {
+	j, err := json.MarshalIndent(output, "", "  ")
 	if err != nil {
 		// Should never happen because we fully-control the input here
 		panic(err)
 	}
 	fmt.Fprintln(v.output, string(j))
 
-	if diags.HasErrors() {
+	if !output.Valid {
 		return 1
 	}
 	return 0
@@ -0,0 +1,202 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package views
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"github.com/opentofu/opentofu/internal/command/jsonentities"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+	tfversion "github.com/opentofu/opentofu/version"
+)
+
+// The ValidateSARIF implementation renders validation results in the Static
+// Analysis Results Interchange Format (SARIF) version 2.1.0, so that code
+// scanning tools can ingest them directly.
+//
+// Diagnostics don't have identifiers of their own, so each distinct summary
+// becomes a rule whose ID is derived from the summary.
+type ValidateSARIF struct {
+	view   *View
+	output *os.File
+}
+
+var _ Validate = (*ValidateSARIF)(nil)
+
+// The subset of the SARIF 2.1.0 object model that we produce. Refer to
+// https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html for the
+// meaning of each property.
+type (
+	sarifLog struct {
+		Schema  string     `json:"$schema"`
+		Version string     `json:"version"`
+		Runs    []sarifRun `json:"runs"`
+	}
+	sarifRun struct {
+		Tool    sarifTool     `json:"tool"`
+		Results []sarifResult `json:"results"`
+	}
+	sarifTool struct {
+		Driver sarifDriver `json:"driver"`
+	}
+	sarifDriver struct {
+		Name           string      `json:"name"`
+		InformationURI string      `json:"informationUri"`
+		Version        string      `json:"version"`
+		Rules          []sarifRule `json:"rules"`
+	}
+	sarifRule struct {
+		ID               string       `json:"id"`
+		ShortDescription sarifMessage `json:"shortDescription"`
+	}
+	sarifResult struct {
+		RuleID    string          `json:"ruleId"`
+		RuleIndex int             `json:"ruleIndex"`
+		Level     string          `json:"level"`
+		Message   sarifMessage    `json:"message"`
+		Locations []sarifLocation `json:"locations,omitempty"`
+	}
+	sarifMessage struct {
+		Text string `json:"text"`
+	}
+	sarifLocation struct {
+		PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+	}
+	sarifPhysicalLocation struct {
+		ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+		Region           sarifRegion           `json:"region"`
+	}
+	sarifArtifactLocation struct {
+		URI string `json:"uri"`
+	}
+	sarifRegion struct {
+		StartLine   int `json:"startLine"`
+		StartColumn int `json:"startColumn"`
+		EndLine     int `json:"endLine"`
+		EndColumn   int `json:"endColumn"`
+	}
+)
+
+func (v *ValidateSARIF) Results(diags tfdiags.Diagnostics) int {
+	run := sarifRun{
+		Tool: sarifTool{
+			Driver: sarifDriver{
+				Name:           "OpenTofu",
+				InformationURI: "https://opentofu.org",
+				Version:        tfversion.String(),
+				Rules:          []sarifRule{},
+			},
+		},
+		Results: []sarifResult{},
+	}
+
+	ruleIndex := make(map[string]int)
+	configSources := v.view.configSources()
+	for _, diag := range diags {
+		d := jsonentities.NewDiagnostic(diag, configSources)
+
+		id := sarifRuleID(d.Summary)
+		idx, ok := ruleIndex[id]
+		if !ok {
+			idx = len(run.Tool.Driver.Rules)
+			ruleIndex[id] = idx
+			run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifRule{
+				ID:               id,
+				ShortDescription: sarifMessage{Text: d.Summary},
+			})
+		}
+
+		result := sarifResult{
+			RuleID:    id,
+			RuleIndex: idx,
+			Level:     "warning",
+			Message:   sarifMessage{Text: d.Summary},
+		}
+		if diag.Severity() == tfdiags.Error {
+			result.Level = "error"
+		}
+		if d.Detail != "" {
+			result.Message.Text = fmt.Sprintf("%s\n\n%s", d.Summary, d.Detail)
+		}
+		if d.Range != nil {
+			result.Locations = []sarifLocation{{
+				PhysicalLocation: sarifPhysicalLocation{
+					ArtifactLocation: sarifArtifactLocation{URI: filepath.ToSlash(d.Range.Filename)},
+					Region: sarifRegion{
+						StartLine:   d.Range.Start.Line,
+						StartColumn: d.Range.Start.Column,
+						EndLine:     d.Range.End.Line,
+						EndColumn:   d.Range.End.Column,
+					},
+				},
+			}}
+		}
+		run.Results = append(run.Results, result)
+	}
+
+	log := sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs:    []sarifRun{run},
+	}
+	j, err := json.MarshalIndent(&log, "", "  ")
+	if err != nil {
+		// Should never happen because we fully-control the input here
+		panic(err)
+	}
+	fmt.Fprintln(v.output, string(j))
+
+	if diags.HasErrors() {
+		return 1
+	}
+	return 0
+}
+
+// RecursiveResults renders the diagnostics for all of the modules as a single
+// run, because each result's location already identifies the module's files.
+func (v *ValidateSARIF) RecursiveResults(modules []ValidateModuleResult, diags tfdiags.Diagnostics) int {
+	var all tfdiags.Diagnostics
+	all = all.Append(diags)
+	for _, mod := range modules {
+		all = all.Append(mod.Diagnostics)
+	}
+	return v.Results(all)
+}
+
+// Diagnostics should only be called if the validation walk cannot be executed.
+// In this case, we choose to render human-readable diagnostic output, as
+// ValidateJSON does.
+func (v *ValidateSARIF) Diagnostics(diags tfdiags.Diagnostics) {
+	v.view.Diagnostics(diags)
+}
+
+// sarifRuleID returns the rule ID for diagnostics with the given summary,
+// which is the summary in lowercase with each run of other characters than
+// letters and digits replaced by a hyphen.
+func sarifRuleID(summary string) string {
+	var b strings.Builder
+	hyphen := false
+	for _, r := range strings.ToLower(summary) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if hyphen && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			hyphen = false
+			b.WriteRune(r)
+			continue
+		}
+		hyphen = true
+	}
+	if b.Len() == 0 {
+		return "diagnostic"
+	}
+	return b.String()
+}
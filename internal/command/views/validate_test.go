@@ -7,12 +7,17 @@ package views
 
 import (
 	"encoding/json"
+	"path/filepath"
 	"strings"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/hcl/v2"
+
 	"github.com/opentofu/opentofu/internal/command/arguments"
 	"github.com/opentofu/opentofu/internal/terminal"
 	"github.com/opentofu/opentofu/internal/tfdiags"
+	tfversion "github.com/opentofu/opentofu/version"
 )
 
 func TestValidateHuman(t *testing.T) {
@@ -50,7 +55,7 @@ func TestValidateHuman(t *testing.T) {
 			streams, done := terminal.StreamsForTesting(t)
 			view := NewView(streams)
 			view.Configure(&arguments.View{NoColor: true})
-			v := NewValidate(arguments.ViewOptions{ViewType: arguments.ViewHuman}, view)
+			v := NewValidate(&arguments.Validate{ViewOptions: arguments.ViewOptions{ViewType: arguments.ViewHuman}}, view)
 
 			var diags tfdiags.Diagnostics
 
@@ -108,7 +113,7 @@ func TestValidateJSON(t *testing.T) {
 			streams, done := terminal.StreamsForTesting(t)
 			view := NewView(streams)
 			view.Configure(&arguments.View{NoColor: true})
-			v := NewValidate(arguments.ViewOptions{ViewType: arguments.ViewJSON}, view)
+			v := NewValidate(&arguments.Validate{ViewOptions: arguments.ViewOptions{ViewType: arguments.ViewJSON}}, view)
 
 			var diags tfdiags.Diagnostics
 
@@ -136,3 +141,94 @@ func TestValidateJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateSARIF(t *testing.T) {
+	streams, done := terminal.StreamsForTesting(t)
+	view := NewView(streams)
+	view.Configure(&arguments.View{NoColor: true})
+	v := NewValidate(&arguments.Validate{
+		Format:      arguments.ValidateFormatSARIF,
+		ViewOptions: arguments.ViewOptions{ViewType: arguments.ViewJSON},
+	}, view)
+
+	var diags tfdiags.Diagnostics
+	diags = diags.Append(&hcl.Diagnostic{
+		Severity: hcl.DiagError,
+		Summary:  "Unsupported argument",
+		Detail:   `An argument named "foo" is not expected here.`,
+		Subject: &hcl.Range{
+			Filename: filepath.Join("modules", "main.tf"),
+			Start:    hcl.Pos{Line: 2, Column: 3, Byte: 20},
+			End:      hcl.Pos{Line: 2, Column: 6, Byte: 23},
+		},
+	})
+	diags = diags.Append(tfdiags.Sourceless(
+		tfdiags.Warning,
+		"Your shoelaces are untied",
+		"",
+	))
+
+	if ret := v.Results(diags); ret != 1 {
+		t.Errorf("expected 1 return code, got %d", ret)
+	}
+
+	var got map[string]any
+	if err := json.Unmarshal([]byte(done(t).Stdout()), &got); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]any{
+		"$schema": "https://json.schemastore.org/sarif-2.1.0.json",
+		"version": "2.1.0",
+		"runs": []any{
+			map[string]any{
+				"tool": map[string]any{
+					"driver": map[string]any{
+						"name":           "OpenTofu",
+						"informationUri": "https://opentofu.org",
+						"version":        tfversion.String(),
+						"rules": []any{
+							map[string]any{
+								"id":               "unsupported-argument",
+								"shortDescription": map[string]any{"text": "Unsupported argument"},
+							},
+							map[string]any{
+								"id":               "your-shoelaces-are-untied",
+								"shortDescription": map[string]any{"text": "Your shoelaces are untied"},
+							},
+						},
+					},
+				},
+				"results": []any{
+					map[string]any{
+						"ruleId":    "unsupported-argument",
+						"ruleIndex": float64(0),
+						"level":     "error",
+						"message":   map[string]any{"text": "Unsupported argument\n\nAn argument named \"foo\" is not expected here."},
+						"locations": []any{
+							map[string]any{
+								"physicalLocation": map[string]any{
+									"artifactLocation": map[string]any{"uri": "modules/main.tf"},
+									"region": map[string]any{
+										"startLine":   float64(2),
+										"startColumn": float64(3),
+										"endLine":     float64(2),
+										"endColumn":   float64(6),
+									},
+								},
+							},
+						},
+					},
+					map[string]any{
+						"ruleId":    "your-shoelaces-are-untied",
+						"ruleIndex": float64(1),
+						"level":     "warning",
+						"message":   map[string]any{"text": "Your shoelaces are untied"},
+					},
+				},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("wrong output\n%s", diff)
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/opentofu/opentofu/internal/addrs"
 	"github.com/opentofu/opentofu/internal/command/arguments"
 	"github.com/opentofu/opentofu/internal/command/format"
+	"github.com/opentofu/opentofu/internal/command/jsonformat"
 	"github.com/opentofu/opentofu/internal/terminal"
 	"github.com/opentofu/opentofu/internal/tfdiags"
 )
@@ -45,6 +46,14 @@ type View struct {
 	// showSensitive is used to display the value of variables marked as sensitive.
 	showSensitive bool
 
+	// showSchedule is used to periodically display the changes that a
+	// long-running apply is running and what the others are waiting for.
+	showSchedule bool
+
+	// noise lists the attributes whose changes are hidden in human-readable
+	// plans.
+	noise jsonformat.NoiseRules
+
 	// Because some commands used before the UI to print diagnostics, those were printed using an [*ln] function, so
 	// we want to be able to configure this for some of the commands to be able to keep the behavior consistent.
 	diagsPrinter func(severity tfdiags.Severity, msg string)
@@ -59,6 +68,12 @@ type View struct {
 	// and filtering of deprecation diagnostics
 	isRemoteModuleSource func(addrs.Module) bool
 	moduleSourceAddrs    func(addrs.Module) addrs.ModuleSource
+
+	// generatedConfigOrigin describes where the configuration block containing
+	// the given range came from if OpenTofu generated it, so that diagnostics
+	// about generated configuration can refer to the request that caused it to
+	// be generated. It returns an empty string for any other range.
+	generatedConfigOrigin func(hcl.Range) string
 }
 
 // Initialize a View with the given streams, a disabled colorize object, and a
@@ -71,9 +86,10 @@ func NewView(streams *terminal.Streams) *View {
 			Disable: true,
 			Reset:   true,
 		},
-		configSources:        func() map[string]*hcl.File { return nil },
-		isRemoteModuleSource: func(addrs.Module) bool { return false },
-		moduleSourceAddrs:    func(addrs.Module) addrs.ModuleSource { return nil },
+		configSources:         func() map[string]*hcl.File { return nil },
+		isRemoteModuleSource:  func(addrs.Module) bool { return false },
+		moduleSourceAddrs:     func(addrs.Module) addrs.ModuleSource { return nil },
+		generatedConfigOrigin: func(hcl.Range) string { return "" },
 		diagsPrinter: func(severity tfdiags.Severity, msg string) {
 			if severity == tfdiags.Error {
 				_, _ = streams.Eprint(msg)
@@ -138,6 +154,13 @@ func (v *View) SetModuleSourceAddrs(cb func(addrs.Module) addrs.ModuleSource) {
 	v.moduleSourceAddrs = cb
 }
 
+// SetGeneratedConfigOrigin overrides the default callback, which treats all
+// configuration as written by hand, with one that can describe the origin of
+// generated configuration blocks.
+func (v *View) SetGeneratedConfigOrigin(cb func(hcl.Range) string) {
+	v.generatedConfigOrigin = cb
+}
+
 // Diagnostics renders a set of warnings and errors in human-readable form.
 // Warnings are printed to stdout, and errors to stderr.
 func (v *View) Diagnostics(diags tfdiags.Diagnostics) {
@@ -195,6 +218,12 @@ func (v *View) Diagnostics(diags tfdiags.Diagnostics) {
 	}
 
 	for _, diag := range diags {
+		if subject := diag.Source().Subject; subject != nil {
+			if origin := v.generatedConfigOrigin(subject.ToHCL()); origin != "" {
+				diag = generatedConfigDiagnostic{Diagnostic: diag, origin: origin}
+			}
+		}
+
 		var msg string
 		if v.colorize.Disable {
 			msg = format.DiagnosticPlain(diag, v.configSources(), v.streams.Stderr.Columns())
@@ -216,6 +245,23 @@ func (v *View) Diagnostics(diags tfdiags.Diagnostics) {
 	}
 }
 
+// generatedConfigDiagnostic adds a description of the origin of a generated
+// configuration block to a diagnostic about that block.
+type generatedConfigDiagnostic struct {
+	tfdiags.Diagnostic
+	origin string
+}
+
+func (d generatedConfigDiagnostic) Description() tfdiags.Description {
+	desc := d.Diagnostic.Description()
+	if desc.Detail == "" {
+		desc.Detail = d.origin
+	} else {
+		desc.Detail += "\n\n" + d.origin
+	}
+	return desc
+}
+
 // HelpPrompt is intended to be called from commands which fail to parse all
 // of their CLI arguments successfully. It refers users to the full help output
 // rather than rendering it directly, which can be overwhelming and confusing.
@@ -259,6 +305,16 @@ func (v *View) SetShowSensitive(showSensitive bool) {
 	v.showSensitive = showSensitive
 }
 
+// SetNoiseRules sets the attributes whose changes are hidden in
+// human-readable plans, usually read from the diff ignore file.
+func (v *View) SetNoiseRules(noise jsonformat.NoiseRules) {
+	v.noise = noise
+}
+
+func (v *View) SetShowSchedule(showSchedule bool) {
+	v.showSchedule = showSchedule
+}
+
 // Colorize returns the [colorstring.Colorize] object within to be used in other places.
 // TODO meta-refactor: this is a temporary solution. This should not be exposed. Whoever needs to use this
 //
@@ -401,6 +401,39 @@ foo bar warning
 				}
 			},
 		},
+		"diagnostics in generated config": {
+			diags: tfdiags.Diagnostics{}.
+				Append(&hcl.Diagnostic{
+					Severity: hcl.DiagError,
+					Summary:  "Error in generated config",
+					Detail:   "foo bar error",
+					Subject:  &hcl.Range{Filename: "generated.tf", Start: hcl.Pos{Line: 4, Column: 3, Byte: 40}},
+				}).
+				Append(&hcl.Diagnostic{
+					Severity: hcl.DiagWarning,
+					Summary:  "Warning in other config",
+					Detail:   "foo bar warning",
+					Subject:  &hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 4, Column: 3, Byte: 40}},
+				}),
+			setup: func(view *View) {
+				view.SetGeneratedConfigOrigin(func(rng hcl.Range) string {
+					if rng.Filename == "generated.tf" {
+						return "Generated from the import block at main.tf:1,1-7."
+					}
+					return ""
+				})
+			},
+			validate: func(t *testing.T, output *terminal.TestOutput) {
+				stderr := output.Stderr()
+				if want := "foo bar error\n\nGenerated from the import block at main.tf:1,1-7.\n"; !strings.Contains(stderr, want) {
+					t.Errorf("stderr should describe the origin of the generated config\nstderr:\n%s", stderr)
+				}
+				stdout := output.Stdout()
+				if strings.Contains(stdout, "Generated from") {
+					t.Errorf("stdout should not describe an origin for other config\nstdout:\n%s", stdout)
+				}
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -0,0 +1,270 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package views
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/opentofu/opentofu/internal/command/arguments"
+	"github.com/opentofu/opentofu/internal/command/jsonentities"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// WhyExplanation describes why a particular resource instance change was
+// planned, as calculated by the "tofu why" command.
+type WhyExplanation struct {
+	// Change is the planned change being explained.
+	Change *jsonentities.ResourceInstanceChange `json:"change"`
+
+	// Attributes are the top-level attributes and nested block types whose
+	// values will change, in lexical order.
+	Attributes []WhyAttribute `json:"attributes,omitempty"`
+
+	// Triggers are the replace_triggered_by expressions of the resource, when
+	// they are the reason for the resource instance being replaced.
+	Triggers []WhyExpression `json:"replace_triggered_by,omitempty"`
+
+	// Upstream are the changes planned for other resource instances that are
+	// referred to from the configuration of this resource.
+	Upstream []*jsonentities.ResourceInstanceChange `json:"upstream_changes,omitempty"`
+}
+
+// WhyAttribute describes the planned change to a single top-level attribute
+// or nested block type.
+type WhyAttribute struct {
+	Name string `json:"name"`
+
+	// ForcesReplacement is true if the provider reported that a change to
+	// this attribute cannot be made in-place.
+	ForcesReplacement bool `json:"forces_replacement,omitempty"`
+
+	// KnownAfterApply is true if at least part of the new value will not be
+	// known until apply.
+	KnownAfterApply bool `json:"known_after_apply,omitempty"`
+
+	// Expression is the configuration that produced the new value, or nil if
+	// the attribute isn't set in the configuration.
+	Expression *WhyExpression `json:"expression,omitempty"`
+}
+
+// WhyExpression describes a configuration expression that contributed to
+// a planned change.
+type WhyExpression struct {
+	// Source is the source code of the expression, or empty for nested
+	// blocks.
+	Source string                       `json:"source,omitempty"`
+	Range  jsonentities.DiagnosticRange `json:"range"`
+
+	// References are the references made in the expression, as written.
+	References []string `json:"references,omitempty"`
+
+	// Upstream are the addresses of the resource instances referred to by
+	// the expression that have changes planned.
+	Upstream []string `json:"upstream,omitempty"`
+}
+
+// The Why view renders an explanation of a planned resource instance change.
+type Why interface {
+	Explanation(explanation *WhyExplanation) int
+	Diagnostics(diags tfdiags.Diagnostics)
+}
+
+// NewWhy returns an initialized Why implementation for the given ViewType.
+func NewWhy(args arguments.ViewOptions, view *View) Why {
+	var why Why
+	switch args.ViewType {
+	case arguments.ViewJSON:
+		why = &WhyJSON{view: view, output: view.streams.Stdout.File}
+	case arguments.ViewHuman:
+		why = &WhyHuman{view: view}
+	default:
+		panic(fmt.Sprintf("unknown view type %v", args.ViewType))
+	}
+
+	if args.JSONInto != nil {
+		why = WhyMulti{why, &WhyJSON{view: view, output: args.JSONInto}}
+	}
+	return why
+}
+
+type WhyMulti []Why
+
+var _ Why = (WhyMulti)(nil)
+
+func (m WhyMulti) Explanation(explanation *WhyExplanation) int {
+	code := 0
+	for _, w := range m {
+		code = max(code, w.Explanation(explanation))
+	}
+	return code
+}
+
+func (m WhyMulti) Diagnostics(diags tfdiags.Diagnostics) {
+	for _, w := range m {
+		w.Diagnostics(diags)
+	}
+}
+
+type WhyHuman struct {
+	view *View
+}
+
+var _ Why = (*WhyHuman)(nil)
+
+func (v *WhyHuman) Explanation(explanation *WhyExplanation) int {
+	var buf strings.Builder
+
+	change := explanation.Change
+	buf.WriteString(fmt.Sprintf("[bold]%s[reset] %s", change.Resource.Addr, whyActionPhrase(change)))
+	if reason := whyReasonPhrase(change.Reason); reason != "" {
+		buf.WriteString(", " + reason)
+	}
+	buf.WriteString(".\n")
+
+	if len(explanation.Attributes) != 0 {
+		buf.WriteString("\n[bold]Changed attributes:[reset]\n")
+		for _, attr := range explanation.Attributes {
+			buf.WriteString("  " + attr.Name)
+			var notes []string
+			if attr.ForcesReplacement {
+				notes = append(notes, "[red]forces replacement[reset]")
+			}
+			if attr.KnownAfterApply {
+				notes = append(notes, "known after apply")
+			}
+			if len(notes) != 0 {
+				buf.WriteString(" (" + strings.Join(notes, ", ") + ")")
+			}
+			buf.WriteString("\n")
+			if attr.Expression != nil {
+				writeWhyExpression(&buf, attr.Expression)
+			}
+		}
+	}
+
+	if len(explanation.Triggers) != 0 {
+		buf.WriteString("\n[bold]Replacement triggered by:[reset]\n")
+		for _, trigger := range explanation.Triggers {
+			writeWhyExpression(&buf, &trigger)
+		}
+	}
+
+	if len(explanation.Upstream) != 0 {
+		buf.WriteString("\n[bold]Upstream changes:[reset]\n")
+		for _, upstream := range explanation.Upstream {
+			buf.WriteString(fmt.Sprintf("  %s %s", upstream.Resource.Addr, whyActionPhrase(upstream)))
+			if reason := whyReasonPhrase(upstream.Reason); reason != "" {
+				buf.WriteString(", " + reason)
+			}
+			buf.WriteString("\n")
+		}
+	}
+
+	_, _ = v.view.streams.Print(v.view.colorize.Color(buf.String()))
+	return 0
+}
+
+func (v *WhyHuman) Diagnostics(diags tfdiags.Diagnostics) {
+	v.view.Diagnostics(diags)
+}
+
+func writeWhyExpression(buf *strings.Builder, expr *WhyExpression) {
+	rng := expr.Range
+	if expr.Source != "" {
+		buf.WriteString(fmt.Sprintf("    %s:%d,%d: %s\n", rng.Filename, rng.Start.Line, rng.Start.Column, expr.Source))
+	} else {
+		buf.WriteString(fmt.Sprintf("    %s:%d,%d\n", rng.Filename, rng.Start.Line, rng.Start.Column))
+	}
+	for _, addr := range expr.Upstream {
+		buf.WriteString(fmt.Sprintf("      [dim]depends on %s, which has changes planned[reset]\n", addr))
+	}
+}
+
+func whyActionPhrase(change *jsonentities.ResourceInstanceChange) string {
+	switch change.Action {
+	case jsonentities.ActionCreate:
+		return "will be created"
+	case jsonentities.ActionRead:
+		return "will be read during apply"
+	case jsonentities.ActionUpdate:
+		return "will be updated in-place"
+	case jsonentities.ActionReplace:
+		return "must be replaced"
+	case jsonentities.ActionDelete:
+		return "will be destroyed"
+	case jsonentities.ActionForget:
+		return "will be removed from the state"
+	case jsonentities.ActionMove:
+		return fmt.Sprintf("has moved from %s", change.PreviousResource.Addr)
+	case jsonentities.ActionImport:
+		return "will be imported"
+	default:
+		return "has no changes planned"
+	}
+}
+
+func whyReasonPhrase(reason jsonentities.ChangeReason) string {
+	switch reason {
+	case jsonentities.ReasonTainted:
+		return "because it is tainted"
+	case jsonentities.ReasonRequested:
+		return "because replacement was requested with the -replace option"
+	case jsonentities.ReasonReplaceTriggeredBy:
+		return "because of a change to an object in its replace_triggered_by argument"
+	case jsonentities.ReasonCannotUpdate:
+		return "because some of its changed attributes cannot be updated in-place"
+	case jsonentities.ReasonDeleteBecauseNoResourceConfig:
+		return "because its resource is not in the configuration"
+	case jsonentities.ReasonDeleteBecauseWrongRepetition:
+		return "because its instance key does not match the repetition mode of its resource"
+	case jsonentities.ReasonDeleteBecauseCountIndex:
+		return "because its index is out of range for the resource's count"
+	case jsonentities.ReasonDeleteBecauseEachKey:
+		return "because its key is not in the resource's for_each"
+	case jsonentities.ReasonDeleteBecauseEnabledFalse:
+		return "because the resource is disabled"
+	case jsonentities.ReasonDeleteBecauseNoModule:
+		return "because its module is not in the configuration"
+	case jsonentities.ReasonDeleteBecauseNoMoveTarget:
+		return "because it was moved to an address that is not in the configuration"
+	case jsonentities.ReasonReadBecauseConfigUnknown:
+		return "because its configuration depends on values that will be known only after apply"
+	case jsonentities.ReasonReadBecauseDependencyPending:
+		return "because it depends on resources that have changes pending"
+	case jsonentities.ReasonReadBecauseCheckNested:
+		return "because it is declared in a check block"
+	case jsonentities.ReasonForgotBecauseLifecycleDestroyInConfig, jsonentities.ReasonForgotBecauseLifecycleDestroyInState:
+		return "because its lifecycle destroy argument is false"
+	default:
+		return ""
+	}
+}
+
+type WhyJSON struct {
+	view   *View
+	output *os.File
+}
+
+var _ Why = (*WhyJSON)(nil)
+
+func (v *WhyJSON) Explanation(explanation *WhyExplanation) int {
+	jsonExplanation, err := json.Marshal(explanation)
+	if err != nil {
+		v.view.streams.Eprintf("Failed to marshal explanation to JSON: %s", err)
+		return 1
+	}
+	fmt.Fprintln(v.output, string(jsonExplanation))
+	return 0
+}
+
+// Diagnostics should only be called if why cannot be executed, in which
+// case we render human-readable diagnostics in the same way as "tofu show".
+func (v *WhyJSON) Diagnostics(diags tfdiags.Diagnostics) {
+	v.view.Diagnostics(diags)
+}
@@ -0,0 +1,448 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hcldec"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/command/arguments"
+	"github.com/opentofu/opentofu/internal/command/jsonentities"
+	"github.com/opentofu/opentofu/internal/command/views"
+	"github.com/opentofu/opentofu/internal/configs"
+	"github.com/opentofu/opentofu/internal/configs/configload"
+	"github.com/opentofu/opentofu/internal/encryption"
+	"github.com/opentofu/opentofu/internal/lang"
+	"github.com/opentofu/opentofu/internal/plans"
+	"github.com/opentofu/opentofu/internal/plans/planfile"
+	"github.com/opentofu/opentofu/internal/providers"
+	"github.com/opentofu/opentofu/internal/states"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+	"github.com/opentofu/opentofu/internal/tofu"
+	"github.com/opentofu/opentofu/internal/tracing"
+	"github.com/opentofu/opentofu/internal/tracing/traceattrs"
+)
+
+// WhyCommand is a Command implementation that explains why a change to
+// a particular resource instance was included in a saved plan.
+type WhyCommand struct {
+	Meta
+}
+
+func (c *WhyCommand) Run(rawArgs []string) int {
+	ctx := c.CommandContext()
+
+	// Parse and apply global view arguments
+	common, rawArgs := arguments.ParseView(rawArgs)
+	c.View.Configure(common)
+
+	// Parse and validate flags
+	args, closer, diags := arguments.ParseWhy(rawArgs)
+	defer closer()
+	if diags.HasErrors() {
+		c.View.Diagnostics(diags)
+		c.View.HelpPrompt("why")
+		return 1
+	}
+
+	//nolint:ineffassign - As this is a high-level call, we want to ensure that we are correctly using the right ctx later on when
+	ctx, span := tracing.Tracer().Start(ctx, "Why",
+		tracing.SpanAttributes(
+			traceattrs.String("opentofu.why.view", args.ViewOptions.ViewType.String()),
+			traceattrs.String("opentofu.why.plan", args.PlanPath),
+			traceattrs.String("opentofu.why.address", args.TargetAddress.String()),
+		),
+	)
+	defer span.End()
+
+	view := views.NewWhy(args.ViewOptions, c.View)
+
+	// Check for user-supplied plugin path
+	var err error
+	if c.pluginPath, err = c.loadPluginPath(); err != nil {
+		diags = diags.Append(fmt.Errorf("error loading plugin path: %w", err))
+		view.Diagnostics(diags)
+		return 1
+	}
+
+	// Inject variables from args into meta for static evaluation
+	c.Meta.variableArgs = args.Vars.All()
+
+	enc, encDiags := c.Encryption(ctx)
+	diags = diags.Append(encDiags)
+	if encDiags.HasErrors() {
+		view.Diagnostics(diags)
+		return 1
+	}
+
+	explanation, whyDiags := c.why(ctx, args.PlanPath, args.TargetAddress, enc)
+	diags = diags.Append(whyDiags)
+	if whyDiags.HasErrors() {
+		view.Diagnostics(diags)
+		tracing.SetSpanError(span, whyDiags)
+		return 1
+	}
+	return view.Explanation(explanation)
+}
+
+func (c *WhyCommand) Help() string {
+	helpText := `
+Usage: tofu [global options] why -plan=FILENAME [options] ADDRESS
+
+  Explains why the change to the given resource instance was included in
+  a saved plan.
+
+  The explanation includes the reason for the planned action, the attributes
+  that will change and the configuration expressions that produced their new
+  values, and the changes planned for other resource instances that the
+  resource refers to.
+
+Options:
+
+  -plan=FILENAME      The saved plan file containing the change. Required.
+
+  -no-color           Disable terminal escape sequences.
+
+  -json               Show the explanation in a machine-readable form.
+
+  -json-into=out.json Produce the same output as -json, but sent directly
+                      to the given file.
+
+  -var 'foo=bar'      Set a value for one of the input variables in the root
+                      module of the configuration. Use this option more than
+                      once to set more than one variable.
+
+  -var-file=filename  Load variable values from the given file, in addition
+                      to the default files terraform.tfvars and *.auto.tfvars.
+                      Use this option more than once to include more than one
+                      variables file.
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *WhyCommand) Synopsis() string {
+	return "Explain why a resource change was planned"
+}
+
+func (c *WhyCommand) why(ctx context.Context, planPath string, addr addrs.AbsResourceInstance, enc encryption.Encryption) (*views.WhyExplanation, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	pf, err := planfile.OpenWrapped(planPath, enc.Plan())
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to read plan file",
+			fmt.Sprintf("Couldn't read the plan file %q: %s.", planPath, err),
+		))
+		return nil, diags
+	}
+	lp, ok := pf.Local()
+	if !ok {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Unsupported plan file",
+			"The why command can only explain changes in local plan files, not in saved cloud plans.",
+		))
+		return nil, diags
+	}
+
+	plan, err := lp.ReadPlan()
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to read plan file",
+			fmt.Sprintf("Couldn't read the plan from %q: %s.", planPath, err),
+		))
+		return nil, diags
+	}
+	stateFile, err := lp.ReadStateFile()
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to read plan file",
+			fmt.Sprintf("Couldn't read the prior state from %q: %s.", planPath, err),
+		))
+		return nil, diags
+	}
+
+	rc := plan.Changes.ResourceInstance(addr)
+	if rc == nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"No change for resource instance",
+			fmt.Sprintf("The saved plan does not include a change for %s.", addr),
+		))
+		return nil, diags
+	}
+
+	// We load the configuration from the snapshot ourselves, rather than
+	// using the plan reader, so that we can keep hold of the source code
+	// of the expressions we'll report.
+	snap, err := lp.ReadConfigSnapshot()
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to read configuration from plan file",
+			fmt.Sprintf("The configuration file snapshot in the plan file could not be read: %s.", err),
+		))
+		return nil, diags
+	}
+	rootCall, callDiags := c.rootModuleCall(ctx, ".")
+	diags = diags.Append(callDiags)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	loader := configload.NewLoaderFromSnapshot(snap)
+	config, configDiags := loader.LoadConfig(ctx, snap.Modules[""].Dir, rootCall.WithVariables(plan.VariableMapper()))
+	diags = diags.Append(configDiags)
+	if configDiags.HasErrors() {
+		return nil, diags
+	}
+
+	schemas, schemaDiags := c.MaybeGetSchemas(ctx, stateFile.State, config)
+	diags = diags.Append(schemaDiags)
+	if schemaDiags.HasErrors() {
+		return nil, diags
+	}
+
+	explanation, moreDiags := explainResourceInstanceChange(rc, plan.Changes, config, schemas, loader.Sources())
+	diags = diags.Append(moreDiags)
+	return explanation, diags
+}
+
+// explainResourceInstanceChange builds an explanation of the given change
+// from the other changes in the same plan and the configuration the plan was
+// created from.
+func explainResourceInstanceChange(rc *plans.ResourceInstanceChangeSrc, changes *plans.Changes, config *configs.Config, schemas *tofu.Schemas, sources map[string]*hcl.File) (*views.WhyExplanation, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+	addr := rc.Addr
+
+	explanation := &views.WhyExplanation{
+		Change: jsonentities.NewResourceInstanceChange(rc),
+	}
+
+	var schema *providers.Schema
+	if schemas != nil {
+		schema, _ = schemas.ResourceTypeConfig(rc.ProviderAddr.Provider, addr.Resource.Resource.Mode, addr.Resource.Resource.Type)
+	}
+	if schema == nil || schema.Block == nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Missing resource type schema",
+			fmt.Sprintf("The provider %s did not include a schema for resource type %q.", rc.ProviderAddr.Provider, addr.Resource.Resource.Type),
+		))
+		return nil, diags
+	}
+	change, err := rc.Decode(schema)
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to decode planned change",
+			fmt.Sprintf("The planned change for %s could not be decoded: %s.", addr, err),
+		))
+		return nil, diags
+	}
+
+	// A resource that is being deleted has no configuration to explain, and
+	// in-place changes are explained only by the configuration.
+	var rcfg *configs.Resource
+	if modCfg := config.DescendentForInstance(addr.Module); modCfg != nil {
+		rcfg = modCfg.Module.ResourceByAddr(addr.Resource.Resource)
+	}
+	var content *hcl.BodyContent
+	if rcfg != nil && change.Action != plans.Delete {
+		// Any problems with the configuration were already reported during
+		// planning.
+		content, _, _ = rcfg.Config.PartialContent(hcldec.ImpliedSchema(schema.Block.DecoderSpec()))
+	}
+
+	upstream := addrs.MakeMap[addrs.AbsResourceInstance, *plans.ResourceInstanceChangeSrc]()
+	explainExpr := func(rng hcl.Range, src string, refs []*addrs.Reference) *views.WhyExpression {
+		expr := &views.WhyExpression{
+			Source: src,
+			Range:  whyRange(rng),
+		}
+		for _, ref := range refs {
+			expr.References = append(expr.References, ref.DisplayString())
+			for _, upstreamChange := range referencedChanges(addr, ref, changes) {
+				expr.Upstream = append(expr.Upstream, upstreamChange.Addr.String())
+				upstream.Put(upstreamChange.Addr, upstreamChange)
+			}
+		}
+		slices.Sort(expr.References)
+		expr.References = slices.Compact(expr.References)
+		slices.Sort(expr.Upstream)
+		expr.Upstream = slices.Compact(expr.Upstream)
+		return expr
+	}
+
+	if change.Action != plans.Delete && change.Action != plans.Forget {
+		before, _ := change.Before.UnmarkDeep()
+		after, _ := change.After.UnmarkDeep()
+		var names []string
+		for name := range schema.Block.Attributes {
+			names = append(names, name)
+		}
+		for name := range schema.Block.BlockTypes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			beforeVal := whyAttrValue(before, name)
+			afterVal := whyAttrValue(after, name)
+			if beforeVal.RawEquals(afterVal) && afterVal.IsWhollyKnown() {
+				continue
+			}
+			if beforeVal.IsNull() && afterVal.IsNull() {
+				continue
+			}
+			attr := views.WhyAttribute{
+				Name:              name,
+				ForcesReplacement: whyForcesReplacement(rc.RequiredReplace, name),
+				KnownAfterApply:   !afterVal.IsWhollyKnown(),
+			}
+			if content != nil {
+				if hclAttr, ok := content.Attributes[name]; ok {
+					refs, _ := lang.ReferencesInExpr(addrs.ParseRef, hclAttr.Expr)
+					rng := hclAttr.Expr.Range()
+					attr.Expression = explainExpr(rng, whySource(sources, rng), refs)
+				} else if blockS, ok := schema.Block.BlockTypes[name]; ok {
+					var refs []*addrs.Reference
+					var rng *hcl.Range
+					for _, block := range content.Blocks.OfType(name) {
+						moreRefs, _ := lang.ReferencesInBlock(addrs.ParseRef, block.Body, &blockS.Block)
+						refs = append(refs, moreRefs...)
+						if rng == nil {
+							rng = block.DefRange.Ptr()
+						}
+					}
+					if rng != nil {
+						attr.Expression = explainExpr(*rng, "", refs)
+					}
+				}
+			}
+			explanation.Attributes = append(explanation.Attributes, attr)
+		}
+	}
+
+	if rcfg != nil && change.ActionReason == plans.ResourceInstanceReplaceByTriggers {
+		for _, expr := range rcfg.TriggersReplacement {
+			refs, _ := lang.ReferencesInExpr(addrs.ParseRef, expr)
+			rng := expr.Range()
+			explanation.Triggers = append(explanation.Triggers, *explainExpr(rng, whySource(sources, rng), refs))
+		}
+	}
+
+	if rcfg != nil {
+		// Changes to objects referred to only indirectly, such as through
+		// depends_on or the repetition arguments, are also upstream changes
+		// even though they don't affect any particular attribute.
+		var refs []*addrs.Reference
+		for _, traversal := range rcfg.DependsOn {
+			if ref, refDiags := addrs.ParseRef(traversal); !refDiags.HasErrors() {
+				refs = append(refs, ref)
+			}
+		}
+		for _, expr := range []hcl.Expression{rcfg.Count, rcfg.ForEach, rcfg.ForEachKey} {
+			moreRefs, _ := lang.ReferencesInExpr(addrs.ParseRef, expr)
+			refs = append(refs, moreRefs...)
+		}
+		if content != nil {
+			moreRefs, _ := lang.ReferencesInBlock(addrs.ParseRef, rcfg.Config, schema.Block)
+			refs = append(refs, moreRefs...)
+		}
+		for _, ref := range refs {
+			for _, upstreamChange := range referencedChanges(addr, ref, changes) {
+				upstream.Put(upstreamChange.Addr, upstreamChange)
+			}
+		}
+	}
+
+	for _, elem := range upstream.Elems {
+		explanation.Upstream = append(explanation.Upstream, jsonentities.NewResourceInstanceChange(elem.Value))
+	}
+	sort.Slice(explanation.Upstream, func(i, j int) bool {
+		return explanation.Upstream[i].Resource.Addr < explanation.Upstream[j].Resource.Addr
+	})
+
+	return explanation, diags
+}
+
+// referencedChanges returns the changes in the plan, other than no-op
+// changes, for the resource instances that the given reference from the
+// configuration of the given resource instance may refer to.
+func referencedChanges(from addrs.AbsResourceInstance, ref *addrs.Reference, changes *plans.Changes) []*plans.ResourceInstanceChangeSrc {
+	var ret []*plans.ResourceInstanceChangeSrc
+	for _, rc := range changes.Resources {
+		if rc.Action == plans.NoOp || rc.DeposedKey != states.NotDeposed || rc.Addr.Equal(from) {
+			continue
+		}
+		switch sub := ref.Subject.(type) {
+		case addrs.Resource:
+			if rc.Addr.ContainingResource().Equal(sub.Absolute(from.Module)) {
+				ret = append(ret, rc)
+			}
+		case addrs.ResourceInstance:
+			if rc.Addr.Equal(sub.Absolute(from.Module)) {
+				ret = append(ret, rc)
+			}
+		}
+	}
+	return ret
+}
+
+func whyAttrValue(obj cty.Value, name string) cty.Value {
+	if obj.IsNull() || !obj.IsKnown() || !obj.Type().IsObjectType() || !obj.Type().HasAttribute(name) {
+		return cty.NullVal(cty.DynamicPseudoType)
+	}
+	return obj.GetAttr(name)
+}
+
+func whyForcesReplacement(paths cty.PathSet, name string) bool {
+	for _, path := range paths.List() {
+		if len(path) == 0 {
+			continue
+		}
+		if step, ok := path[0].(cty.GetAttrStep); ok && step.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func whySource(sources map[string]*hcl.File, rng hcl.Range) string {
+	file, ok := sources[rng.Filename]
+	if !ok || file == nil {
+		return ""
+	}
+	return string(rng.SliceBytes(file.Bytes))
+}
+
+func whyRange(rng hcl.Range) jsonentities.DiagnosticRange {
+	return jsonentities.DiagnosticRange{
+		Filename: rng.Filename,
+		Start: jsonentities.Pos{
+			Line:   rng.Start.Line,
+			Column: rng.Start.Column,
+			Byte:   rng.Start.Byte,
+		},
+		End: jsonentities.Pos{
+			Line:   rng.End.Line,
+			Column: rng.End.Column,
+			Byte:   rng.End.Byte,
+		},
+	}
+}
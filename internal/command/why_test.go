@@ -0,0 +1,215 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/command/workdir"
+	"github.com/opentofu/opentofu/internal/plans"
+	"github.com/opentofu/opentofu/internal/states"
+)
+
+func TestWhy_replaceTriggeredBy(t *testing.T) {
+	planPath := whyFixturePlanFile(t)
+
+	view, done := testView(t)
+	c := &WhyCommand{
+		Meta: Meta{
+			WorkingDir:       workdir.NewDir("."),
+			testingOverrides: metaOverridesForProvider(showFixtureProvider()),
+			View:             view,
+		},
+	}
+
+	code := c.Run([]string{"-plan=" + planPath, "-no-color", "test_instance.foo"})
+	output := done(t)
+	if code != 0 {
+		t.Fatalf("unexpected exit status %d; want 0\ngot: %s", code, output.Stderr())
+	}
+
+	got := output.Stdout()
+	for _, want := range []string{
+		"test_instance.foo must be replaced, because of a change to an object in its replace_triggered_by argument.",
+		"  ami (known after apply)\n",
+		"main.tf:6,9: test_instance.bar.id\n",
+		"depends on test_instance.bar, which has changes planned",
+		"Replacement triggered by:",
+		"main.tf:9,29: test_instance.bar\n",
+		"Upstream changes:\n  test_instance.bar must be replaced, because some of its changed attributes cannot be updated in-place\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("output is missing %q\ngot:\n%s", want, got)
+		}
+	}
+}
+
+func TestWhy_json(t *testing.T) {
+	planPath := whyFixturePlanFile(t)
+
+	view, done := testView(t)
+	c := &WhyCommand{
+		Meta: Meta{
+			WorkingDir:       workdir.NewDir("."),
+			testingOverrides: metaOverridesForProvider(showFixtureProvider()),
+			View:             view,
+		},
+	}
+
+	code := c.Run([]string{"-plan=" + planPath, "-json", "test_instance.bar"})
+	output := done(t)
+	if code != 0 {
+		t.Fatalf("unexpected exit status %d; want 0\ngot: %s", code, output.Stderr())
+	}
+
+	var got struct {
+		Change struct {
+			Action string `json:"action"`
+			Reason string `json:"reason"`
+		} `json:"change"`
+		Attributes []struct {
+			Name              string `json:"name"`
+			ForcesReplacement bool   `json:"forces_replacement"`
+			KnownAfterApply   bool   `json:"known_after_apply"`
+			Expression        *struct {
+				Source string `json:"source"`
+			} `json:"expression"`
+		} `json:"attributes"`
+		Upstream []any `json:"upstream_changes"`
+	}
+	if err := json.Unmarshal([]byte(output.Stdout()), &got); err != nil {
+		t.Fatalf("invalid JSON output: %s\n%s", err, output.Stdout())
+	}
+
+	if got.Change.Action != "replace" || got.Change.Reason != "cannot_update" {
+		t.Errorf("wrong change: %#v", got.Change)
+	}
+	type attr struct {
+		Name              string
+		ForcesReplacement bool
+		KnownAfterApply   bool
+		Source            string
+	}
+	var gotAttrs []attr
+	for _, a := range got.Attributes {
+		ga := attr{Name: a.Name, ForcesReplacement: a.ForcesReplacement, KnownAfterApply: a.KnownAfterApply}
+		if a.Expression != nil {
+			ga.Source = a.Expression.Source
+		}
+		gotAttrs = append(gotAttrs, ga)
+	}
+	wantAttrs := []attr{
+		{Name: "ami", ForcesReplacement: true, Source: `"bar"`},
+		{Name: "id", KnownAfterApply: true},
+	}
+	if diff := cmp.Diff(wantAttrs, gotAttrs); diff != "" {
+		t.Errorf("wrong attributes\n%s", diff)
+	}
+	if len(got.Upstream) != 0 {
+		t.Errorf("unexpected upstream changes: %#v", got.Upstream)
+	}
+}
+
+func TestWhy_noChange(t *testing.T) {
+	planPath := whyFixturePlanFile(t)
+
+	view, done := testView(t)
+	c := &WhyCommand{
+		Meta: Meta{
+			WorkingDir:       workdir.NewDir("."),
+			testingOverrides: metaOverridesForProvider(showFixtureProvider()),
+			View:             view,
+		},
+	}
+
+	code := c.Run([]string{"-plan=" + planPath, "test_instance.baz"})
+	output := done(t)
+	if code != 1 {
+		t.Fatalf("unexpected exit status %d; want 1\ngot: %s", code, output.Stdout())
+	}
+	if got, want := output.Stderr(), "The saved plan does not include a change for test_instance.baz."; !strings.Contains(got, want) {
+		t.Fatalf("wrong error\ngot: %s\nwant: %s", got, want)
+	}
+}
+
+// whyFixturePlanFile creates a plan file for the configuration in
+// testdata/why, where test_instance.bar must be replaced because its "ami"
+// attribute changed and test_instance.foo is replaced because of its
+// replace_triggered_by argument.
+func whyFixturePlanFile(t *testing.T) string {
+	t.Helper()
+
+	_, snap := testModuleWithSnapshot(t, "why")
+	objectType := cty.Object(map[string]cty.Type{
+		"id":  cty.String,
+		"ami": cty.String,
+	})
+	dynamicValue := func(v cty.Value) plans.DynamicValue {
+		t.Helper()
+		raw, err := plans.NewDynamicValue(v, objectType)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return raw
+	}
+	provider := addrs.AbsProviderConfig{
+		Provider: addrs.NewDefaultProvider("test"),
+		Module:   addrs.RootModule,
+	}
+	instanceAddr := func(name string) addrs.AbsResourceInstance {
+		return addrs.Resource{
+			Mode: addrs.ManagedResourceMode,
+			Type: "test_instance",
+			Name: name,
+		}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)
+	}
+
+	plan := testPlan(t)
+	plan.Changes.SyncWrapper().AppendResourceInstanceChange(&plans.ResourceInstanceChangeSrc{
+		Addr:         instanceAddr("bar"),
+		PrevRunAddr:  instanceAddr("bar"),
+		ProviderAddr: provider,
+		ActionReason: plans.ResourceInstanceReplaceBecauseCannotUpdate,
+		RequiredReplace: cty.NewPathSet(
+			cty.GetAttrPath("ami"),
+		),
+		ChangeSrc: plans.ChangeSrc{
+			Action: plans.DeleteThenCreate,
+			Before: dynamicValue(cty.ObjectVal(map[string]cty.Value{
+				"id":  cty.StringVal("bar-id"),
+				"ami": cty.StringVal("old"),
+			})),
+			After: dynamicValue(cty.ObjectVal(map[string]cty.Value{
+				"id":  cty.UnknownVal(cty.String),
+				"ami": cty.StringVal("bar"),
+			})),
+		},
+	})
+	plan.Changes.SyncWrapper().AppendResourceInstanceChange(&plans.ResourceInstanceChangeSrc{
+		Addr:         instanceAddr("foo"),
+		PrevRunAddr:  instanceAddr("foo"),
+		ProviderAddr: provider,
+		ActionReason: plans.ResourceInstanceReplaceByTriggers,
+		ChangeSrc: plans.ChangeSrc{
+			Action: plans.DeleteThenCreate,
+			Before: dynamicValue(cty.ObjectVal(map[string]cty.Value{
+				"id":  cty.StringVal("foo-id"),
+				"ami": cty.StringVal("bar-id"),
+			})),
+			After: dynamicValue(cty.ObjectVal(map[string]cty.Value{
+				"id":  cty.UnknownVal(cty.String),
+				"ami": cty.UnknownVal(cty.String),
+			})),
+		},
+	})
+	return testPlanFile(t, snap, states.NewState(), plan)
+}
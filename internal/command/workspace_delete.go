@@ -14,9 +14,12 @@ import (
 	"github.com/mitchellh/cli"
 	"github.com/posener/complete"
 
+	"github.com/opentofu/opentofu/internal/backend"
 	"github.com/opentofu/opentofu/internal/command/arguments"
 	"github.com/opentofu/opentofu/internal/command/clistate"
 	"github.com/opentofu/opentofu/internal/command/views"
+	"github.com/opentofu/opentofu/internal/encryption"
+	"github.com/opentofu/opentofu/internal/plans"
 	"github.com/opentofu/opentofu/internal/states"
 	"github.com/opentofu/opentofu/internal/tfdiags"
 )
@@ -49,6 +52,12 @@ func (c *WorkspaceDeleteCommand) Run(rawArgs []string) int {
 		}
 		return cli.RunResultHelp
 	}
+
+	if diags := c.Meta.checkReadOnly("workspace delete"); diags.HasErrors() {
+		view.Diagnostics(diags)
+		return 1
+	}
+
 	c.Meta.variableArgs = args.Vars.All()
 
 	view.WarnWhenUsedAsEnvCmd(c.LegacyName)
@@ -133,30 +142,53 @@ func (c *WorkspaceDeleteCommand) Run(rawArgs []string) int {
 		return 1
 	}
 
-	var stateLocker clistate.Locker
-	if args.StateLock {
-		stateLocker = clistate.NewLocker(args.StateLockTimeout, backendView.StateLocker())
-		if diags := stateLocker.Lock(stateMgr, "state-replace-provider"); diags.HasErrors() {
-			view.Diagnostics(diags)
-			return 1
+	lockAndRefresh := func() (clistate.Locker, bool) {
+		var stateLocker clistate.Locker
+		if args.StateLock {
+			stateLocker = clistate.NewLocker(args.StateLockTimeout, backendView.StateLocker())
+			if diags := stateLocker.Lock(stateMgr, "workspace-delete"); diags.HasErrors() {
+				view.Diagnostics(diags)
+				return nil, false
+			}
+		} else {
+			stateLocker = clistate.NewNoopLocker()
+		}
+
+		if err := stateMgr.RefreshState(context.TODO()); err != nil {
+			// We need to release the lock before exit
+			stateLocker.Unlock()
+			view.Diagnostics(tfdiags.Diagnostics{tfdiags.Sourceless(
+				tfdiags.Error,
+				"Failed to refresh state",
+				fmt.Sprintf("State refresh failed: %s", err),
+			)})
+			return nil, false
 		}
-	} else {
-		stateLocker = clistate.NewNoopLocker()
+		return stateLocker, true
 	}
 
-	if err := stateMgr.RefreshState(context.TODO()); err != nil {
-		// We need to release the lock before exit
-		stateLocker.Unlock()
-		view.Diagnostics(tfdiags.Diagnostics{tfdiags.Sourceless(
-			tfdiags.Error,
-			"Failed to refresh state",
-			fmt.Sprintf("State refresh failed: %s", err),
-		)})
+	stateLocker, ok := lockAndRefresh()
+	if !ok {
 		return 1
 	}
 
 	hasResources := stateMgr.State().HasManagedResourceInstanceObjects()
 
+	if hasResources && args.Destroy {
+		// The destroy operation takes its own lock on the state, so we
+		// release ours while it runs and then check the state again
+		// afterwards, since the destroy may not have removed everything.
+		stateLocker.Unlock()
+		if code := c.destroyWorkspace(ctx, b, workspace, args, enc); code != 0 {
+			return code
+		}
+		stateLocker, ok = lockAndRefresh()
+		if !ok {
+			return 1
+		}
+		hasResources = stateMgr.State().HasManagedResourceInstanceObjects()
+	}
+
 	if hasResources && !args.Force {
 		// We'll collect a list of what's being managed here as extra context
 		// for the message.
@@ -176,7 +208,7 @@ func (c *WorkspaceDeleteCommand) Run(rawArgs []string) int {
 			tfdiags.Error,
 			"Workspace is not empty",
 			fmt.Sprintf(
-				"Workspace %q is currently tracking the following resource instances:%s\n\nDeleting this workspace would cause OpenTofu to lose track of any associated remote objects, which would then require you to delete them manually outside of OpenTofu. You should destroy these objects with OpenTofu before deleting the workspace.\n\nIf you want to delete this workspace anyway, and have OpenTofu forget about these managed objects, use the -force option to disable this safety check.",
+				"Workspace %q is currently tracking the following resource instances:%s\n\nDeleting this workspace would cause OpenTofu to lose track of any associated remote objects, which would then require you to delete them manually outside of OpenTofu. You should destroy these objects with OpenTofu before deleting the workspace, either separately or by using the -destroy option.\n\nIf you want to delete this workspace anyway, and have OpenTofu forget about these managed objects, use the -force option to disable this safety check.",
 				workspace, buf.String(),
 			),
 		))
@@ -214,6 +246,50 @@ func (c *WorkspaceDeleteCommand) Run(rawArgs []string) int {
 	return 0
 }
 
+// destroyWorkspace runs a destroy operation against the given workspace,
+// which need not be the currently-selected one, and returns the exit status
+// of the operation.
+func (c *WorkspaceDeleteCommand) destroyWorkspace(ctx context.Context, b backend.Enhanced, workspace string, args *arguments.WorkspaceDelete, enc encryption.Encryption) int {
+	view := views.NewApply(args.ViewOptions, true, c.View)
+
+	// FIXME: as in the apply command, the -input flag value is needed to
+	// initialize the operation, but there is no clear path to pass this
+	// value down, so we mutate the Meta object state for now.
+	c.Meta.input = args.ViewOptions.InputEnabled
+
+	var diags tfdiags.Diagnostics
+	opReq := c.Operation(ctx, b, view.Backend(), enc)
+	opReq.Workspace = workspace
+	opReq.AutoApprove = args.AutoApprove
+	opReq.ConfigDir = "."
+	opReq.PlanMode = plans.DestroyMode
+	opReq.PlanRefresh = true
+	opReq.Hooks = view.Hooks()
+	opReq.Type = backend.OperationTypeApply
+	opReq.View = view.Operation()
+
+	var err error
+	opReq.ConfigLoader, err = c.initConfigLoader()
+	if err != nil {
+		diags = diags.Append(fmt.Errorf("Failed to initialize config loader: %w", err))
+		view.Diagnostics(diags)
+		return 1
+	}
+
+	op, diags := c.RunOperation(ctx, b, opReq)
+	view.Diagnostics(diags)
+	if diags.HasErrors() {
+		view.PartialResourceCount()
+		return 1
+	}
+	if op.Result != backend.OperationSuccess {
+		view.PartialResourceCount()
+		return op.Result.ExitStatus()
+	}
+	view.ResourceCount("")
+	return 0
+}
+
 func (c *WorkspaceDeleteCommand) AutocompleteArgs() complete.Predictor {
 	return completePredictSequence{
 		c.completePredictWorkspaceName(c.CommandContext()),
@@ -223,7 +299,9 @@ func (c *WorkspaceDeleteCommand) AutocompleteArgs() complete.Predictor {
 
 func (c *WorkspaceDeleteCommand) AutocompleteFlags() complete.Flags {
 	return complete.Flags{
-		"-force": complete.PredictNothing,
+		"-auto-approve": complete.PredictNothing,
+		"-destroy":      complete.PredictNothing,
+		"-force":        complete.PredictNothing,
 	}
 }
 
@@ -236,10 +314,21 @@ Usage: tofu [global options] workspace delete [options] NAME
 
 Options:
 
+  -destroy             Destroy the infrastructure managed by the workspace
+                       before removing it, as "tofu destroy" would with the
+                       workspace selected. The workspace is only removed if
+                       the destroy succeeds.
+
+  -auto-approve        Skip interactive approval of the destroy plan when
+                       using -destroy.
+
   -force               Remove a workspace even if it is managing resources.
                        OpenTofu can no longer track or manage the workspace's
                        infrastructure.
 
+  -input=true          Ask for input for variables if not directly set, and
+                       for approval of the destroy plan when using -destroy.
+
   -lock=false          Don't hold a state lock during the operation. This is
                        dangerous if others might concurrently run commands
                        against the same workspace.
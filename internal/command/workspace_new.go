@@ -51,6 +51,12 @@ func (c *WorkspaceNewCommand) Run(rawArgs []string) int {
 		}
 		return cli.RunResultHelp
 	}
+
+	if diags := c.Meta.checkReadOnly("workspace new"); diags.HasErrors() {
+		view.Diagnostics(diags)
+		return 1
+	}
+
 	c.Meta.variableArgs = args.Vars.All()
 
 	view.WarnWhenUsedAsEnvCmd(c.LegacyName)
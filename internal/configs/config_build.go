@@ -38,6 +38,10 @@ func BuildConfig(ctx context.Context, root *Module, walker ModuleWalker) (*Confi
 	// Skip provider resolution if there are any errors, since the provider
 	// configurations themselves may not be valid.
 	if !diags.HasErrors() {
+		// Pass on the provider configurations that module calls propagate to
+		// their whole subtree before resolving them like any others.
+		cfg.propagateProviders(nil)
+
 		// Now that the config is built, we can connect the provider names to all
 		// the known types for validation.
 		providers := cfg.resolveProviderTypes()
@@ -105,7 +109,7 @@ func buildTestModules(ctx context.Context, root *Config, walker ModuleWalker) hc
 					},
 					root.Module.SourceDir,
 					root.Module.StaticEvaluator.call.workspace,
-				),
+				).WithDisabledFunctions(root.disabledFunctions()),
 
 				CallRange: run.Module.DeclRange,
 			}
@@ -141,6 +145,16 @@ func buildTestModules(ctx context.Context, root *Config, walker ModuleWalker) hc
 	return diags
 }
 
+// disabledFunctions returns the patterns of the functions that the static
+// evaluation of the given root module must not call, which the modules it
+// calls inherit.
+func (c *Config) disabledFunctions() []string {
+	if c.Module == nil || c.Module.StaticEvaluator == nil {
+		return nil
+	}
+	return c.Module.StaticEvaluator.call.disabledFunctions
+}
+
 func buildChildModules(ctx context.Context, parent *Config, walker ModuleWalker) (map[string]*Config, hcl.Diagnostics) {
 	var diags hcl.Diagnostics
 	ret := map[string]*Config{}
@@ -168,7 +182,7 @@ func buildChildModules(ctx context.Context, parent *Config, walker ModuleWalker)
 			VersionConstraint: call.Version,
 			Parent:            parent,
 			CallRange:         call.DeclRange,
-			Call:              NewStaticModuleCall(path, call.DeclRange, call.Variables, parent.Root.Module.SourceDir, call.Workspace),
+			Call:              NewStaticModuleCall(path, call.DeclRange, call.Variables, parent.Root.Module.SourceDir, call.Workspace).WithDisabledFunctions(parent.Root.disabledFunctions()),
 		}
 		if call.Source != nil {
 			// Invalid modules sometimes have a nil source field which is handled through loadModule below
@@ -78,6 +78,70 @@ func TestBuildConfig(t *testing.T) {
 	}
 }
 
+func TestBuildConfig_disabledFunctions(t *testing.T) {
+	parser := NewParser(nil)
+	call := RootModuleCallForTesting().WithDisabledFunctions([]string{"md5"})
+	mod, diags := parser.LoadConfigDir("testdata/config-build", call)
+	assertNoDiagnostics(t, diags)
+
+	cfg, diags := BuildConfig(t.Context(), mod, ModuleWalkerFunc(
+		func(_ context.Context, req *ModuleRequest) (*Module, *version.Version, hcl.Diagnostics) {
+			sourcePath := filepath.Join("testdata/config-build", req.SourceAddr.String())
+			mod, modDiags := parser.LoadConfigDir(sourcePath, req.Call)
+			return mod, nil, modDiags
+		},
+	))
+	assertNoDiagnostics(t, diags)
+
+	// Every module in the tree inherits the disabled functions of the root
+	// module call.
+	cfg.DeepEach(func(c *Config) {
+		got := c.Module.StaticEvaluator.call.disabledFunctions
+		if !reflect.DeepEqual(got, []string{"md5"}) {
+			t.Errorf("wrong disabled functions for %s: %#v", c.Path, got)
+		}
+	})
+}
+
+func TestBuildConfig_propagateProviders(t *testing.T) {
+	parser := NewParser(nil)
+	mod, diags := parser.LoadConfigDir("testdata/config-build-propagate-providers", RootModuleCallForTesting())
+	assertNoDiagnostics(t, diags)
+
+	cfg, diags := BuildConfig(t.Context(), mod, ModuleWalkerFunc(
+		func(_ context.Context, req *ModuleRequest) (*Module, *version.Version, hcl.Diagnostics) {
+			sourcePath := filepath.Join("testdata/config-build-propagate-providers", req.SourceAddr.String())
+			mod, modDiags := parser.LoadConfigDir(sourcePath, req.Call)
+			return mod, nil, modDiags
+		},
+	))
+	assertNoDiagnostics(t, diags)
+
+	passed := func(mc *ModuleCall) []string {
+		var ret []string
+		for _, p := range mc.Providers {
+			ret = append(ret, fmt.Sprintf("%s = %s (propagated: %t)", p.InChild, p.InParent, p.Propagated))
+		}
+		sort.Strings(ret)
+		return ret
+	}
+
+	mid := cfg.Children["mid"].Module
+	if got, want := passed(mid.ModuleCalls["leaf_a"]), []string{
+		"test.x = test.x (propagated: true)",
+		"test.y = test.y (propagated: true)",
+	}; !reflect.DeepEqual(got, want) {
+		t.Errorf("wrong providers for leaf_a\ngot:  %s\nwant: %s", spew.Sdump(got), spew.Sdump(want))
+	}
+	// The explicit mapping for test.x takes priority over the propagated one.
+	if got, want := passed(mid.ModuleCalls["leaf_b"]), []string{
+		"test.x = test.y (propagated: false)",
+		"test.y = test.y (propagated: true)",
+	}; !reflect.DeepEqual(got, want) {
+		t.Errorf("wrong providers for leaf_b\ngot:  %s\nwant: %s", spew.Sdump(got), spew.Sdump(want))
+	}
+}
+
 func TestBuildConfigDiags(t *testing.T) {
 	parser := NewParser(nil)
 	mod, diags := parser.LoadConfigDir("testdata/nested-errors", RootModuleCallForTesting())
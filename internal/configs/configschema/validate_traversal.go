@@ -83,8 +83,7 @@ func (b *Block) StaticValidateTraversal(traversal hcl.Traversal) tfdiags.Diagnos
 		// traversal to an unknown value of the attribute type and pass
 		// through HCL's own errors, since we don't want to replicate all
 		// of HCL's type checking rules here.
-		val := cty.UnknownVal(attrS.ImpliedType())
-		_, hclDiags := after.TraverseRel(val)
+		hclDiags := staticValidateValueTraversal(attrS.ImpliedType(), after)
 		return diags.Append(hclDiags)
 	}
 
@@ -147,10 +146,11 @@ func (b *NestedBlock) staticValidateTraversal(typeName string, traversal hcl.Tra
 		return diags
 
 	case NestingList:
-		if _, ok := next.(hcl.TraverseIndex); ok {
+		switch next.(type) {
+		case hcl.TraverseIndex, hcl.TraverseSplat:
 			moreDiags := b.Block.StaticValidateTraversal(after)
 			diags = diags.Append(moreDiags)
-		} else {
+		default:
 			diags = diags.Append(&hcl.Diagnostic{
 				Severity: hcl.DiagError,
 				Summary:  `Invalid operation`,
@@ -175,3 +175,42 @@ func (b *NestedBlock) staticValidateTraversal(typeName string, traversal hcl.Tra
 		return nil
 	}
 }
+
+// staticValidateValueTraversal applies the given traversal to an unknown value
+// of the given type, returning HCL's own errors for any invalid steps.
+//
+// HCL can't apply a splat step on its own, so each splat step is instead
+// checked to select the elements of a list or map, and the rest of the
+// traversal is then applied to an element.
+func staticValidateValueTraversal(ty cty.Type, traversal hcl.Traversal) hcl.Diagnostics {
+	for i, step := range traversal {
+		splat, ok := step.(hcl.TraverseSplat)
+		if !ok {
+			continue
+		}
+
+		val, diags := traversal[:i].TraverseRel(cty.UnknownVal(ty))
+		if diags.HasErrors() {
+			return diags
+		}
+		switch ty := val.Type(); {
+		case ty.IsListType() || ty.IsMapType():
+			return append(diags, staticValidateValueTraversal(ty.ElementType(), traversal[i+1:])...)
+		case ty.IsTupleType() || ty == cty.DynamicPseudoType:
+			// The elements of a tuple can each have a different type, and a
+			// dynamic value could be of any type at all, so we can't check
+			// the rest of the traversal statically.
+			return diags
+		default:
+			return append(diags, &hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  `Invalid splat operation`,
+				Detail:   fmt.Sprintf(`The splat operator [*] selects each element of a list or map, but this value is of type %s.`, ty.FriendlyName()),
+				Subject:  &splat.SrcRange,
+			})
+		}
+	}
+
+	_, diags := traversal.TraverseRel(cty.UnknownVal(ty))
+	return diags
+}
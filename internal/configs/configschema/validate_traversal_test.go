@@ -261,3 +261,82 @@ func TestStaticValidateTraversal(t *testing.T) {
 		})
 	}
 }
+
+func TestStaticValidateTraversal_splat(t *testing.T) {
+	nested := &Block{
+		Attributes: map[string]*Attribute{
+			"str": {Type: cty.String, Optional: true},
+		},
+	}
+	schema := &Block{
+		Attributes: map[string]*Attribute{
+			"str":  {Type: cty.String, Optional: true},
+			"map":  {Type: cty.Map(cty.Map(cty.String)), Optional: true},
+			"set":  {Type: cty.Set(cty.String), Optional: true},
+			"list": {Type: cty.List(cty.Object(map[string]cty.Type{"str": cty.String})), Optional: true},
+		},
+		BlockTypes: map[string]*NestedBlock{
+			"list_block": {Nesting: NestingList, Block: *nested},
+			"set_block":  {Nesting: NestingSet, Block: *nested},
+		},
+	}
+	attr := func(name string) hcl.Traverser { return hcl.TraverseAttr{Name: name} }
+	splat := hcl.TraverseSplat{}
+
+	tests := map[string]struct {
+		Traversal hcl.Traversal
+		WantError string
+	}{
+		"list_block[*].str": {
+			hcl.Traversal{attr("list_block"), splat, attr("str")},
+			``,
+		},
+		"list_block[*].nonexist": {
+			hcl.Traversal{attr("list_block"), splat, attr("nonexist")},
+			`Unsupported attribute: This object has no argument, nested block, or exported attribute named "nonexist".`,
+		},
+		"list[*].str": {
+			hcl.Traversal{attr("list"), splat, attr("str")},
+			``,
+		},
+		"map[*][\"key\"]": {
+			hcl.Traversal{attr("map"), splat, hcl.TraverseIndex{Key: cty.StringVal("key")}},
+			``,
+		},
+		"list[*].nonexist": {
+			hcl.Traversal{attr("list"), splat, attr("nonexist")},
+			`Unsupported attribute: This object does not have an attribute named "nonexist".`,
+		},
+		"str[*]": {
+			hcl.Traversal{attr("str"), splat},
+			`Invalid splat operation: The splat operator [*] selects each element of a list or map, but this value is of type string.`,
+		},
+		"set[*]": {
+			hcl.Traversal{attr("set"), splat},
+			`Invalid splat operation: The splat operator [*] selects each element of a list or map, but this value is of type set of string.`,
+		},
+		"set_block[*].str": {
+			hcl.Traversal{attr("set_block"), splat, attr("str")},
+			`Cannot index a set value: Block type "set_block" is represented by a set of objects, and set elements do not have addressable keys. To find elements matching specific criteria, use a "for" expression with an "if" clause.`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			diags := schema.StaticValidateTraversal(test.Traversal)
+			if test.WantError == "" {
+				if diags.HasErrors() {
+					t.Errorf("unexpected error: %s", diags.Err().Error())
+				}
+				return
+			}
+			err := diags.ErrWithWarnings()
+			if err == nil {
+				t.Fatalf("wrong error\ngot:  <no error>\nwant: %s", test.WantError)
+			}
+			if got := err.Error(); got != test.WantError {
+				t.Errorf("wrong error\ngot:  %s\nwant: %s", got, test.WantError)
+			}
+		})
+	}
+}
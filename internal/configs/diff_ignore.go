@@ -0,0 +1,145 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package configs
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// DiffIgnoreFilename is the name of the file in the working directory that
+// lists the attributes whose changes are noise in human-readable plans.
+const DiffIgnoreFilename = ".tofu-diffignore.hcl"
+
+// DiffIgnore represents an "ignore" block in a diff ignore file, which lists
+// attributes of a resource type whose changes are hidden in human-readable
+// plan output. It only changes how plans are displayed: the changes are
+// still planned and applied as usual, and still included in JSON output.
+type DiffIgnore struct {
+	// ResourceType is the resource type that the block applies to, or "*"
+	// for all resource types.
+	ResourceType string
+
+	// Attributes are the paths of the ignored attributes within the
+	// resource, made of attribute names, nested block type names, and map
+	// keys.
+	Attributes []hcl.Traversal
+
+	DeclRange hcl.Range
+}
+
+// LoadDiffIgnoreFile reads the file at the given path and parses it as a
+// diff ignore file, which contains only "ignore" blocks.
+//
+// It references the same LoadHCLFile as LoadConfigFile, so inherits the same
+// syntax selection behaviours.
+func (p *Parser) LoadDiffIgnoreFile(path string) ([]*DiffIgnore, hcl.Diagnostics) {
+	body, diags := p.LoadHCLFile(path)
+	if body == nil {
+		return nil, diags
+	}
+
+	content, moreDiags := body.Content(diffIgnoreFileSchema)
+	diags = append(diags, moreDiags...)
+
+	var ret []*DiffIgnore
+	for _, block := range content.Blocks {
+		ignore, moreDiags := decodeDiffIgnoreBlock(block)
+		diags = append(diags, moreDiags...)
+		if ignore != nil {
+			ret = append(ret, ignore)
+		}
+	}
+
+	return ret, diags
+}
+
+func decodeDiffIgnoreBlock(block *hcl.Block) (*DiffIgnore, hcl.Diagnostics) {
+	var diags hcl.Diagnostics
+
+	content, moreDiags := block.Body.Content(diffIgnoreBlockSchema)
+	diags = append(diags, moreDiags...)
+
+	ignore := &DiffIgnore{
+		ResourceType: block.Labels[0],
+		DeclRange:    block.DefRange,
+	}
+	if ignore.ResourceType != "*" && !hclsyntax.ValidIdentifier(ignore.ResourceType) {
+		diags = append(diags, &hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "Invalid resource type",
+			Detail:   fmt.Sprintf("The label of an ignore block must be a resource type name, or \"*\" for all resource types. %s", badIdentifierDetail),
+			Subject:  &block.LabelRanges[0],
+		})
+		return nil, diags
+	}
+
+	attr, exists := content.Attributes["attributes"]
+	if !exists {
+		return ignore, diags
+	}
+	exprs, listDiags := hcl.ExprList(attr.Expr)
+	diags = append(diags, listDiags...)
+	for _, expr := range exprs {
+		traversal, travDiags := hcl.AbsTraversalForExpr(expr)
+		diags = append(diags, travDiags...)
+		if travDiags.HasErrors() {
+			continue
+		}
+		if !validDiffIgnoreTraversal(traversal) {
+			diags = append(diags, &hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Invalid attribute path",
+				Detail:   "An ignored attribute path can only contain attribute names, nested block type names, and map keys given as strings, such as tags[\"Owner\"].",
+				Subject:  expr.Range().Ptr(),
+			})
+			continue
+		}
+		ignore.Attributes = append(ignore.Attributes, traversal)
+	}
+
+	return ignore, diags
+}
+
+// validDiffIgnoreTraversal returns true if each step of the given traversal
+// selects an attribute, a nested block type, or a map element by its key.
+// Elements of lists and sets can't be selected individually; a path through
+// a nested block type applies to all of its blocks instead.
+func validDiffIgnoreTraversal(traversal hcl.Traversal) bool {
+	for _, step := range traversal[1:] {
+		switch step := step.(type) {
+		case hcl.TraverseAttr:
+		case hcl.TraverseIndex:
+			if step.Key.Type() != cty.String || !step.Key.IsKnown() || step.Key.IsNull() {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+var diffIgnoreFileSchema = &hcl.BodySchema{
+	Blocks: []hcl.BlockHeaderSchema{
+		{
+			Type:       "ignore",
+			LabelNames: []string{"type"},
+		},
+	},
+}
+
+var diffIgnoreBlockSchema = &hcl.BodySchema{
+	Attributes: []hcl.AttributeSchema{
+		{
+			Name:     "attributes",
+			Required: true,
+		},
+	},
+}
@@ -0,0 +1,101 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package configs
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParserLoadDiffIgnoreFile(t *testing.T) {
+	tests := map[string]struct {
+		src       string
+		want      map[string][]int
+		wantDiags []string
+	}{
+		"valid": {
+			src: `
+ignore "aws_instance" {
+  attributes = [tags_all, metadata_options.http_tokens, tags["LastScanned"]]
+}
+
+ignore "*" {
+  attributes = [etag]
+}
+`,
+			want: map[string][]int{
+				"aws_instance": {1, 2, 2},
+				"*":            {1},
+			},
+		},
+		"list index": {
+			src: `
+ignore "aws_instance" {
+  attributes = [ebs_block_device[0].tags]
+}
+`,
+			want:      map[string][]int{"aws_instance": nil},
+			wantDiags: []string{"Invalid attribute path"},
+		},
+		"invalid resource type": {
+			src: `
+ignore "aws instance" {
+  attributes = [tags_all]
+}
+`,
+			wantDiags: []string{"Invalid resource type"},
+		},
+		"missing attributes": {
+			src: `
+ignore "aws_instance" {
+}
+`,
+			want:      map[string][]int{"aws_instance": nil},
+			wantDiags: []string{"Missing required argument"},
+		},
+		"other block type": {
+			src: `
+resource "aws_instance" "example" {
+}
+`,
+			wantDiags: []string{"Unsupported block type"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			parser := testParser(map[string]string{
+				DiffIgnoreFilename: test.src,
+			})
+			ignores, diags := parser.LoadDiffIgnoreFile(DiffIgnoreFilename)
+
+			var gotDiags []string
+			for _, diag := range diags {
+				gotDiags = append(gotDiags, diag.Summary)
+			}
+			if diff := cmp.Diff(test.wantDiags, gotDiags); diff != "" {
+				t.Errorf("wrong diagnostics\n%s", diff)
+			}
+
+			// Each ignored attribute is described by the length of its path.
+			var got map[string][]int
+			for _, ignore := range ignores {
+				if got == nil {
+					got = make(map[string][]int)
+				}
+				var lengths []int
+				for _, traversal := range ignore.Attributes {
+					lengths = append(lengths, len(traversal))
+				}
+				got[ignore.ResourceType] = lengths
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("wrong ignored attributes\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package configs
+
+import (
+	"github.com/hashicorp/hcl/v2"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/lang"
+)
+
+// decodeForEachKey decodes the "for_each_key" meta-argument, which gives the
+// instance key for each element when "for_each" is a list, set or tuple.
+//
+// The key expression is evaluated once per element of the collection, so the
+// only part of the "each" object available to it is each.value.
+func decodeForEachKey(attr *hcl.Attribute, forEach hcl.Expression, override bool) (hcl.Expression, hcl.Diagnostics) {
+	var diags hcl.Diagnostics
+
+	// An override file may only replace the key expression, relying on
+	// the for_each argument from the original block.
+	if forEach == nil && !override {
+		diags = append(diags, &hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  `Invalid "for_each_key" argument`,
+			Detail:   `The "for_each_key" meta-argument can only be used together with "for_each".`,
+			Subject:  &attr.NameRange,
+		})
+	}
+
+	refs, _ := lang.ReferencesInExpr(addrs.ParseRef, attr.Expr)
+	for _, ref := range refs {
+		if sub, ok := ref.Subject.(addrs.ForEachAttr); ok && sub.Name != "value" {
+			diags = append(diags, &hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  `Invalid "each" reference in "for_each_key"`,
+				Detail:   `The "for_each_key" expression calculates each.key, so only each.value may be used in it.`,
+				Subject:  ref.SourceRange.ToHCL().Ptr(),
+			})
+		}
+	}
+
+	return attr.Expr, diags
+}
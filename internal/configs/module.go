@@ -264,6 +264,12 @@ func NewModule(primaryFiles, overrideFiles []*File, call StaticModuleCall, sourc
 		diags = append(diags, mDiags...)
 	}
 
+	// The workspace provider overrides only affect the provider
+	// configurations, so there's nothing to apply when loading selectively.
+	if load == SelectiveLoadAll {
+		diags = append(diags, mod.applyProviderOverrides(call.providerOverrides)...)
+	}
+
 	for _, pc := range mod.ProviderConfigs {
 		pDiags := pc.decodeStaticFields(context.TODO(), mod.StaticEvaluator)
 		diags = append(diags, pDiags...)
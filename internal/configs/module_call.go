@@ -11,6 +11,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/gohcl"
 	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/zclconf/go-cty/cty"
 
@@ -36,12 +37,19 @@ type ModuleCall struct {
 	VersionAttr *hcl.Attribute
 	Version     VersionConstraint
 
-	Count   hcl.Expression
-	ForEach hcl.Expression
-	Enabled hcl.Expression
+	Count      hcl.Expression
+	ForEach    hcl.Expression
+	ForEachKey hcl.Expression
+	Enabled    hcl.Expression
 
 	Providers []PassedProviderConfig
 
+	// PropagateProviders is set when the provider configurations in Providers
+	// also apply to every module beneath the child module, unless a module
+	// call further down passes its own configuration under the same name.
+	PropagateProviders    bool
+	PropagateProvidersSet bool
+
 	DependsOn []hcl.Traversal
 
 	DeclRange hcl.Range
@@ -96,6 +104,12 @@ func decodeModuleBlock(block *hcl.Block, override bool) (*ModuleCall, hcl.Diagno
 		repetitionArgs++
 	}
 
+	if attr, exists := content.Attributes["for_each_key"]; exists {
+		var keyDiags hcl.Diagnostics
+		mc.ForEachKey, keyDiags = decodeForEachKey(attr, mc.ForEach, override)
+		diags = append(diags, keyDiags...)
+	}
+
 	if attr, exists := content.Attributes["depends_on"]; exists {
 		deps, depsDiags := decodeDependsOn(attr)
 		diags = append(diags, depsDiags...)
@@ -108,6 +122,12 @@ func decodeModuleBlock(block *hcl.Block, override bool) (*ModuleCall, hcl.Diagno
 		mc.Providers = append(mc.Providers, providers...)
 	}
 
+	if attr, exists := content.Attributes["propagate_providers"]; exists {
+		valDiags := gohcl.DecodeExpression(attr.Expr, nil, &mc.PropagateProviders)
+		diags = append(diags, valDiags...)
+		mc.PropagateProvidersSet = true
+	}
+
 	var seenLifecycle *hcl.Block
 	var seenEscapeBlock *hcl.Block
 	for _, block := range content.Blocks {
@@ -326,6 +346,12 @@ func (mc *ModuleCall) EntersNewPackage() bool {
 type PassedProviderConfig struct {
 	InChild  *ProviderConfigRef
 	InParent *ProviderConfigRef
+
+	// Propagated is set for a configuration passed down implicitly, because
+	// a module call further up uses propagate_providers. The module passes
+	// on its own configuration with the same name, whether or not it
+	// declares that name itself.
+	Propagated bool
 }
 
 func decodePassedProviderConfigs(attr *hcl.Attribute) ([]PassedProviderConfig, hcl.Diagnostics) {
@@ -380,12 +406,18 @@ var moduleBlockSchema = &hcl.BodySchema{
 		{
 			Name: "for_each",
 		},
+		{
+			Name: "for_each_key",
+		},
 		{
 			Name: "depends_on",
 		},
 		{
 			Name: "providers",
 		},
+		{
+			Name: "propagate_providers",
+		},
 	},
 	Blocks: []hcl.BlockHeaderSchema{
 		{Type: "_"}, // meta-argument escaping block
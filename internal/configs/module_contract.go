@@ -0,0 +1,272 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package configs
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/ext/typeexpr"
+	"github.com/hashicorp/hcl/v2/gohcl"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// ModuleContract describes the interface that a module must implement: the
+// input variables it must accept and the output values it must return.
+//
+// A contract is loaded from a separate file, so that a standard interface
+// can be published once and checked against many modules.
+type ModuleContract struct {
+	Variables map[string]*ContractVariable
+	Outputs   map[string]*ContractOutput
+}
+
+// ContractVariable is a "variable" block in a module contract.
+type ContractVariable struct {
+	Name string
+
+	// ConstraintType is the type constraint that the module's variable must
+	// declare, or cty.NilType if the contract doesn't constrain its type.
+	ConstraintType cty.Type
+
+	// Optional requires the module to declare a default value for the
+	// variable, so that callers can omit it.
+	Optional bool
+
+	DeclRange hcl.Range
+}
+
+// ContractOutput is an "output" block in a module contract.
+type ContractOutput struct {
+	Name string
+
+	// Sensitive is whether the module's output value must be marked as
+	// sensitive, which is only checked if SensitiveSet is true.
+	Sensitive    bool
+	SensitiveSet bool
+
+	DeclRange hcl.Range
+}
+
+// LoadModuleContractFile reads the file at the given path and parses it as a
+// module contract, which contains only "variable" and "output" blocks.
+//
+// It references the same LoadHCLFile as LoadConfigFile, so inherits the same
+// syntax selection behaviours.
+func (p *Parser) LoadModuleContractFile(path string) (*ModuleContract, hcl.Diagnostics) {
+	body, diags := p.LoadHCLFile(path)
+	if body == nil {
+		return nil, diags
+	}
+
+	content, moreDiags := body.Content(moduleContractFileSchema)
+	diags = append(diags, moreDiags...)
+
+	contract := &ModuleContract{
+		Variables: make(map[string]*ContractVariable),
+		Outputs:   make(map[string]*ContractOutput),
+	}
+	for _, block := range content.Blocks {
+		if !hclsyntax.ValidIdentifier(block.Labels[0]) {
+			diags = append(diags, &hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  fmt.Sprintf("Invalid %s name", block.Type),
+				Detail:   badIdentifierDetail,
+				Subject:  &block.LabelRanges[0],
+			})
+			continue
+		}
+
+		switch block.Type {
+		case "variable":
+			v, moreDiags := decodeContractVariableBlock(block)
+			diags = append(diags, moreDiags...)
+			if existing, exists := contract.Variables[v.Name]; exists {
+				diags = append(diags, &hcl.Diagnostic{
+					Severity: hcl.DiagError,
+					Summary:  "Duplicate variable declaration",
+					Detail:   fmt.Sprintf("A variable named %q was already declared at %s. Variable names must be unique within a module contract.", v.Name, existing.DeclRange),
+					Subject:  &v.DeclRange,
+				})
+				continue
+			}
+			contract.Variables[v.Name] = v
+
+		case "output":
+			o, moreDiags := decodeContractOutputBlock(block)
+			diags = append(diags, moreDiags...)
+			if existing, exists := contract.Outputs[o.Name]; exists {
+				diags = append(diags, &hcl.Diagnostic{
+					Severity: hcl.DiagError,
+					Summary:  "Duplicate output definition",
+					Detail:   fmt.Sprintf("An output named %q was already defined at %s. Output names must be unique within a module contract.", o.Name, existing.DeclRange),
+					Subject:  &o.DeclRange,
+				})
+				continue
+			}
+			contract.Outputs[o.Name] = o
+		}
+	}
+
+	return contract, diags
+}
+
+func decodeContractVariableBlock(block *hcl.Block) (*ContractVariable, hcl.Diagnostics) {
+	v := &ContractVariable{
+		Name:      block.Labels[0],
+		DeclRange: block.DefRange,
+	}
+
+	content, diags := block.Body.Content(contractVariableBlockSchema)
+
+	if attr, exists := content.Attributes["type"]; exists {
+		ty, _, _, tyDiags := decodeVariableType(attr.Expr)
+		diags = append(diags, tyDiags...)
+		if !tyDiags.HasErrors() {
+			v.ConstraintType = ty
+		}
+	}
+
+	if attr, exists := content.Attributes["optional"]; exists {
+		valDiags := gohcl.DecodeExpression(attr.Expr, nil, &v.Optional)
+		diags = append(diags, valDiags...)
+	}
+
+	return v, diags
+}
+
+func decodeContractOutputBlock(block *hcl.Block) (*ContractOutput, hcl.Diagnostics) {
+	o := &ContractOutput{
+		Name:      block.Labels[0],
+		DeclRange: block.DefRange,
+	}
+
+	content, diags := block.Body.Content(contractOutputBlockSchema)
+
+	if attr, exists := content.Attributes["sensitive"]; exists {
+		valDiags := gohcl.DecodeExpression(attr.Expr, nil, &o.Sensitive)
+		diags = append(diags, valDiags...)
+		o.SensitiveSet = true
+	}
+
+	return o, diags
+}
+
+// Check returns an error diagnostic for each way in which the given module
+// doesn't implement the contract.
+//
+// A module implements a contract if it declares every variable and output in
+// the contract. A variable with a type in the contract must have exactly that
+// type constraint in the module, and an optional variable must have a default
+// value. The module may declare additional variables and outputs, as long as
+// its variables that aren't in the contract have default values too, because
+// callers that follow the contract won't set them.
+func (c *ModuleContract) Check(mod *Module) hcl.Diagnostics {
+	var diags hcl.Diagnostics
+
+	for _, name := range slices.Sorted(maps.Keys(c.Variables)) {
+		cv := c.Variables[name]
+		v, exists := mod.Variables[name]
+		if !exists {
+			diags = append(diags, &hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Missing contract variable",
+				Detail:   fmt.Sprintf("The module contract requires the module to declare an input variable named %q.", name),
+				Subject:  cv.DeclRange.Ptr(),
+			})
+			continue
+		}
+
+		if cv.ConstraintType != cty.NilType && !v.ConstraintType.Equals(cv.ConstraintType) {
+			diags = append(diags, &hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Incompatible variable type",
+				Detail:   fmt.Sprintf("The module contract requires variable %q to have the type constraint %s, but the module declares it as %s.", name, typeexpr.TypeString(cv.ConstraintType), typeexpr.TypeString(v.ConstraintType)),
+				Subject:  v.DeclRange.Ptr(),
+			})
+		}
+
+		if cv.Optional && v.Required() {
+			diags = append(diags, &hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Missing default value",
+				Detail:   fmt.Sprintf("The module contract declares variable %q as optional, so the module must declare a default value for it.", name),
+				Subject:  v.DeclRange.Ptr(),
+			})
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(mod.Variables)) {
+		v := mod.Variables[name]
+		if _, exists := c.Variables[name]; exists || !v.Required() {
+			continue
+		}
+		diags = append(diags, &hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "Unexpected required variable",
+			Detail:   fmt.Sprintf("The module contract doesn't include the variable %q, so callers won't set it. Declare a default value for it, or add it to the module contract.", name),
+			Subject:  v.DeclRange.Ptr(),
+		})
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(c.Outputs)) {
+		co := c.Outputs[name]
+		o, exists := mod.Outputs[name]
+		if !exists {
+			diags = append(diags, &hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Missing contract output",
+				Detail:   fmt.Sprintf("The module contract requires the module to declare an output value named %q.", name),
+				Subject:  co.DeclRange.Ptr(),
+			})
+			continue
+		}
+
+		if co.SensitiveSet && o.Sensitive != co.Sensitive {
+			detail := fmt.Sprintf("The module contract requires output value %q to be marked as sensitive.", name)
+			if !co.Sensitive {
+				detail = fmt.Sprintf("The module contract requires output value %q not to be marked as sensitive.", name)
+			}
+			diags = append(diags, &hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Incompatible output sensitivity",
+				Detail:   detail,
+				Subject:  o.DeclRange.Ptr(),
+			})
+		}
+	}
+
+	return diags
+}
+
+var moduleContractFileSchema = &hcl.BodySchema{
+	Blocks: []hcl.BlockHeaderSchema{
+		{
+			Type:       "variable",
+			LabelNames: []string{"name"},
+		},
+		{
+			Type:       "output",
+			LabelNames: []string{"name"},
+		},
+	},
+}
+
+var contractVariableBlockSchema = &hcl.BodySchema{
+	Attributes: []hcl.AttributeSchema{
+		{Name: "type"},
+		{Name: "optional"},
+	},
+}
+
+var contractOutputBlockSchema = &hcl.BodySchema{
+	Attributes: []hcl.AttributeSchema{
+		{Name: "sensitive"},
+	},
+}
@@ -0,0 +1,208 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package configs
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParserLoadModuleContractFile(t *testing.T) {
+	tests := map[string]struct {
+		src       string
+		wantDiags []string
+	}{
+		"valid": {
+			src: `
+variable "name" {
+  type = string
+}
+
+variable "tags" {
+  type     = map(string)
+  optional = true
+}
+
+output "ids" {}
+
+output "secret" {
+  sensitive = true
+}
+`,
+		},
+		"invalid type": {
+			src: `
+variable "tags" {
+  type = mapping(string)
+}
+`,
+			wantDiags: []string{"Invalid type specification"},
+		},
+		"duplicate output": {
+			src: `
+output "ids" {}
+output "ids" {}
+`,
+			wantDiags: []string{"Duplicate output definition"},
+		},
+		"invalid name": {
+			src: `
+variable "1tags" {}
+`,
+			wantDiags: []string{"Invalid variable name"},
+		},
+		"other block type": {
+			src: `
+resource "aws_instance" "example" {
+}
+`,
+			wantDiags: []string{"Unsupported block type"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			parser := testParser(map[string]string{
+				"contract.hcl": test.src,
+			})
+			_, diags := parser.LoadModuleContractFile("contract.hcl")
+
+			var gotDiags []string
+			for _, diag := range diags {
+				gotDiags = append(gotDiags, diag.Summary)
+			}
+			if diff := cmp.Diff(test.wantDiags, gotDiags); diff != "" {
+				t.Errorf("wrong diagnostics\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestModuleContractCheck(t *testing.T) {
+	contractSrc := `
+variable "name" {
+  type = string
+}
+
+variable "tags" {
+  type     = map(string)
+  optional = true
+}
+
+output "ids" {}
+
+output "secret" {
+  sensitive = true
+}
+`
+
+	tests := map[string]struct {
+		src       string
+		wantDiags []string
+	}{
+		"compliant": {
+			src: `
+variable "name" {
+  type = string
+}
+
+variable "tags" {
+  type    = map(string)
+  default = {}
+}
+
+variable "extra" {
+  type    = number
+  default = 1
+}
+
+output "ids" {
+  value = []
+}
+
+output "secret" {
+  value     = "x"
+  sensitive = true
+}
+
+output "extra" {
+  value = 1
+}
+`,
+		},
+		"missing declarations": {
+			src: `
+variable "name" {
+  type = string
+}
+
+variable "tags" {
+  type    = map(string)
+  default = {}
+}
+`,
+			wantDiags: []string{
+				"Missing contract output",
+				"Missing contract output",
+			},
+		},
+		"mismatched declarations": {
+			src: `
+variable "name" {
+  type = list(string)
+}
+
+variable "tags" {
+  type = map(string)
+}
+
+variable "extra" {
+  type = number
+}
+
+output "ids" {
+  value = []
+}
+
+output "secret" {
+  value = "x"
+}
+`,
+			wantDiags: []string{
+				"Incompatible variable type",
+				"Missing default value",
+				"Unexpected required variable",
+				"Incompatible output sensitivity",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			parser := testParser(map[string]string{
+				"contract.hcl": contractSrc,
+				"mod/main.tf":  test.src,
+			})
+			contract, diags := parser.LoadModuleContractFile("contract.hcl")
+			if diags.HasErrors() {
+				t.Fatal(diags.Error())
+			}
+			mod, diags := parser.LoadConfigDir("mod", RootModuleCallForTesting())
+			if diags.HasErrors() {
+				t.Fatal(diags.Error())
+			}
+
+			var gotDiags []string
+			for _, diag := range contract.Check(mod) {
+				gotDiags = append(gotDiags, diag.Summary)
+			}
+			if diff := cmp.Diff(test.wantDiags, gotDiags); diff != "" {
+				t.Errorf("wrong diagnostics\n%s", diff)
+			}
+		})
+	}
+}
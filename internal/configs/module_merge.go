@@ -60,6 +60,7 @@ func (v *Variable) merge(ov *Variable) hcl.Diagnostics {
 	}
 	if ov.Default != cty.NilVal {
 		v.Default = ov.Default
+		v.DefaultExpr = ov.DefaultExpr
 	}
 	if ov.Type != cty.NilType {
 		v.Type = ov.Type
@@ -87,7 +88,11 @@ func (v *Variable) merge(ov *Variable) hcl.Diagnostics {
 	// literal value in config could've been converted to the overridden type
 	// constraint but the converted value cannot. In practice, this situation
 	// should be rare since most of our conversions are interchangeable.
-	if v.Default != cty.NilVal {
+	if v.DefaultExpr != nil {
+		// A deferred default value is only a placeholder until the graph
+		// walk, so we just need it to match the final type constraint.
+		v.Default = cty.UnknownVal(v.ConstraintType)
+	} else if v.Default != cty.NilVal {
 		val, err := convert.Convert(v.Default, v.ConstraintType)
 		if err != nil {
 			// What exactly we'll say in the error message here depends on whether
@@ -199,6 +204,10 @@ func (mc *ModuleCall) merge(omc *ModuleCall) hcl.Diagnostics {
 		mc.ForEach = omc.ForEach
 	}
 
+	if omc.ForEachKey != nil {
+		mc.ForEachKey = omc.ForEachKey
+	}
+
 	if omc.VersionAttr != nil {
 		mc.VersionAttr = omc.VersionAttr
 	}
@@ -209,6 +218,11 @@ func (mc *ModuleCall) merge(omc *ModuleCall) hcl.Diagnostics {
 		mc.Providers = omc.Providers
 	}
 
+	if omc.PropagateProvidersSet {
+		mc.PropagateProviders = omc.PropagateProviders
+		mc.PropagateProvidersSet = omc.PropagateProvidersSet
+	}
+
 	// We don't allow depends_on to be overridden because that is likely to
 	// cause confusing misbehavior.
 	if len(omc.DependsOn) != 0 {
@@ -238,6 +252,9 @@ func (r *Resource) merge(or *Resource, rps map[string]*RequiredProvider) hcl.Dia
 	if or.ForEach != nil {
 		r.ForEach = or.ForEach
 	}
+	if or.ForEachKey != nil {
+		r.ForEachKey = or.ForEachKey
+	}
 
 	if or.ProviderConfigRef != nil {
 		r.ProviderConfigRef = or.ProviderConfigRef
@@ -260,6 +277,19 @@ func (r *Resource) merge(or *Resource, rps map[string]*RequiredProvider) hcl.Dia
 			r.Managed.CreateBeforeDestroy = or.Managed.CreateBeforeDestroy
 			r.Managed.CreateBeforeDestroySet = or.Managed.CreateBeforeDestroySet
 		}
+		if or.Managed.ApplyPrioritySet {
+			r.Managed.ApplyPriority = or.Managed.ApplyPriority
+			r.Managed.ApplyPrioritySet = or.Managed.ApplyPrioritySet
+		}
+		if or.Managed.SerializeGroupSet {
+			r.Managed.SerializeGroup = or.Managed.SerializeGroup
+			r.Managed.SerializeGroupSet = or.Managed.SerializeGroupSet
+		}
+		if or.Managed.ApplyWithinSet {
+			r.Managed.ApplyWithin = or.Managed.ApplyWithin
+			r.Managed.ApplyWithinRange = or.Managed.ApplyWithinRange
+			r.Managed.ApplyWithinSet = or.Managed.ApplyWithinSet
+		}
 		if len(or.Managed.IgnoreChanges) != 0 {
 			r.Managed.IgnoreChanges = or.Managed.IgnoreChanges
 		}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/gohcl"
@@ -528,3 +529,46 @@ func TestModuleOverrideIgnoreAllChanges(t *testing.T) {
 		t.Fatalf("wrong result: expected r.Managed.IgnoreAllChanges to be true")
 	}
 }
+
+func TestModuleOverrideApplyPriority(t *testing.T) {
+	mod, diags := testModuleFromDir("testdata/valid-modules/override-apply-priority")
+	assertNoDiagnostics(t, diags)
+
+	r := mod.ManagedResources["test_instance.foo"]
+	if got, want := r.Managed.ApplyPriority, 5; got != want {
+		t.Fatalf("wrong result: expected r.Managed.ApplyPriority to be %d, got %d", want, got)
+	}
+}
+
+func TestModuleOverrideApplyWithin(t *testing.T) {
+	mod, diags := testModuleFromDir("testdata/valid-modules/override-apply-within")
+	assertNoDiagnostics(t, diags)
+
+	r := mod.ManagedResources["test_instance.foo"]
+	if got, want := r.Managed.ApplyWithin, 20*time.Minute; got != want {
+		t.Fatalf("wrong result: expected r.Managed.ApplyWithin to be %s, got %s", want, got)
+	}
+}
+
+func TestModuleOverrideSerializeGroup(t *testing.T) {
+	mod, diags := testModuleFromDir("testdata/valid-modules/override-serialize-group")
+	assertNoDiagnostics(t, diags)
+
+	r := mod.ManagedResources["test_instance.foo"]
+	if got, want := r.Managed.SerializeGroup, "b"; got != want {
+		t.Fatalf("wrong result: expected r.Managed.SerializeGroup to be %q, got %q", want, got)
+	}
+}
+
+func TestModuleOverridePropagateProviders(t *testing.T) {
+	mod, diags := testModuleFromDir("testdata/valid-modules/override-propagate-providers")
+	assertNoDiagnostics(t, diags)
+
+	mc := mod.ModuleCalls["example"]
+	if !mc.PropagateProviders {
+		t.Fatal("wrong result: expected mc.PropagateProviders to be true")
+	}
+	if got, want := len(mc.Providers), 1; got != want {
+		t.Fatalf("wrong result: expected %d passed provider, got %d", want, got)
+	}
+}
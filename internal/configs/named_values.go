@@ -33,6 +33,12 @@ type Variable struct {
 	Description string
 	Default     cty.Value
 
+	// DefaultExpr is the default value expression, retained only when it
+	// calls provider-defined functions and so cannot be evaluated until the
+	// providers are available during the graph walk. In that case Default is
+	// an unknown value of the variable's type, standing in for the result.
+	DefaultExpr hcl.Expression
+
 	// Only used inside modules that have *some* variable with ConstSet.
 	// This allows us to match terraform's validation in their imitation
 	// of our static eval concept.
@@ -172,7 +178,27 @@ func decodeVariableBlock(block *hcl.Block, override bool) (*Variable, hcl.Diagno
 		v.ConstSet = true
 	}
 
-	if attr, exists := content.Attributes["default"]; exists {
+	if attr, exists := content.Attributes["default"]; exists && exprCallsProviderFunction(attr.Expr) {
+		// Provider-defined functions are not available while decoding the
+		// configuration, so we defer evaluation of the default value until
+		// the graph walk. The expression still may not refer to any other
+		// objects, just as for a default value we can evaluate immediately.
+		for _, traversal := range attr.Expr.Variables() {
+			diags = append(diags, &hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Variables not allowed",
+				Detail:   "Variables may not be used here.",
+				Subject:  traversal.SourceRange().Ptr(),
+			})
+		}
+
+		v.DefaultExpr = attr.Expr
+		if v.ConstraintType != cty.NilType {
+			v.Default = cty.UnknownVal(v.ConstraintType)
+		} else {
+			v.Default = cty.DynamicVal
+		}
+	} else if exists {
 		val, valDiags := attr.Expr.Value(nil)
 		diags = append(diags, valDiags...)
 
@@ -484,6 +510,24 @@ func decodeVariableValidationBlock(varName string, block *hcl.Block, override bo
 	return vv, diags
 }
 
+// exprCallsProviderFunction returns true if the given expression includes
+// at least one call to a provider-defined function.
+func exprCallsProviderFunction(expr hcl.Expression) bool {
+	fexpr, ok := expr.(hcl.ExpressionWithFunctions)
+	if !ok {
+		return false
+	}
+	for _, fn := range fexpr.Functions() {
+		if len(fn) == 0 {
+			continue
+		}
+		if root, ok := fn[0].(hcl.TraverseRoot); ok && addrs.ParseFunction(root.Name).IsNamespace(addrs.FunctionNamespaceProvider) {
+			return true
+		}
+	}
+	return false
+}
+
 // Output represents an "output" block in a module or file.
 type Output struct {
 	Name        string
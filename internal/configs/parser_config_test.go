@@ -160,6 +160,24 @@ func TestParserLoadConfigFileFailureMessages(t *testing.T) {
 			"Unsuitable value type",
 			`Unsuitable value: a bool is required`,
 		},
+		{
+			"invalid-files/resource-lifecycle-badapplywithin.tf",
+			hcl.DiagError,
+			"Invalid apply_within duration",
+			`The apply_within argument must be a duration string such as "90s" or "5m": time: invalid duration "five minutes".`,
+		},
+		{
+			"invalid-files/resource-lifecycle-badpriority.tf",
+			hcl.DiagError,
+			"Unsuitable value type",
+			`Unsuitable value: value must be a whole number, between -9223372036854775808 and 9223372036854775807`,
+		},
+		{
+			"invalid-files/resource-lifecycle-emptyserializegroup.tf",
+			hcl.DiagError,
+			"Invalid serialize_group value",
+			"The serialize_group argument must be a non-empty string naming the group of resources whose changes are applied one at a time.",
+		},
 		{
 			"invalid-files/variable-complex-bad-default-inner-obj.tf",
 			hcl.DiagError,
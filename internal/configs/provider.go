@@ -50,6 +50,10 @@ type Provider struct {
 
 	ForEach   hcl.Expression
 	Instances map[addrs.InstanceKey]instances.RepetitionData
+
+	// WorkspaceOverride is the provider override for the current workspace
+	// that was merged into Config, or nil if there is none.
+	WorkspaceOverride *ProviderOverride
 }
 
 func decodeProviderBlock(block *hcl.Block) (*Provider, hcl.Diagnostics) {
@@ -0,0 +1,230 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package configs
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/gohcl"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+)
+
+// ProviderOverride represents a "provider_override" block in a workspace
+// provider override file, which overrides some of the arguments of a
+// provider configuration in the root module for a single workspace.
+type ProviderOverride struct {
+	Name       string
+	NameRange  hcl.Range
+	Alias      string
+	AliasRange *hcl.Range // nil if no alias set
+
+	// Config contains the overriding arguments, which are merged into the
+	// configuration of the provider in the same way as an override file.
+	Config hcl.Body
+
+	// Arguments are the names of the arguments and nested block types that
+	// the override sets, in lexical order.
+	Arguments []string
+
+	DeclRange hcl.Range
+}
+
+// Addr returns the address of the provider configuration that the override
+// applies to, relative to the root module.
+func (o *ProviderOverride) Addr() addrs.LocalProviderConfig {
+	return addrs.LocalProviderConfig{
+		LocalName: o.Name,
+		Alias:     o.Alias,
+	}
+}
+
+func (o *ProviderOverride) moduleUniqueKey() string {
+	if o.Alias != "" {
+		return fmt.Sprintf("%s.%s", o.Name, o.Alias)
+	}
+	return o.Name
+}
+
+// LoadProviderOverrideFile reads the file at the given path and parses it as
+// a workspace provider override file, which contains only
+// "provider_override" blocks.
+//
+// It references the same LoadHCLFile as LoadConfigFile, so inherits the same
+// syntax selection behaviours.
+func (p *Parser) LoadProviderOverrideFile(path string) ([]*ProviderOverride, hcl.Diagnostics) {
+	body, diags := p.LoadHCLFile(path)
+	if body == nil {
+		return nil, diags
+	}
+
+	content, moreDiags := body.Content(providerOverrideFileSchema)
+	diags = append(diags, moreDiags...)
+
+	var ret []*ProviderOverride
+	seen := make(map[string]*ProviderOverride)
+	for _, block := range content.Blocks {
+		override, moreDiags := decodeProviderOverrideBlock(block)
+		diags = append(diags, moreDiags...)
+		if override == nil {
+			continue
+		}
+
+		key := override.moduleUniqueKey()
+		if existing, exists := seen[key]; exists {
+			diags = append(diags, &hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Duplicate provider override",
+				Detail:   fmt.Sprintf("The provider configuration %s was already overridden at %s. Each provider configuration can be overridden only once per workspace.", override.Addr(), existing.DeclRange),
+				Subject:  &override.DeclRange,
+			})
+			continue
+		}
+		seen[key] = override
+		ret = append(ret, override)
+	}
+
+	return ret, diags
+}
+
+func decodeProviderOverrideBlock(block *hcl.Block) (*ProviderOverride, hcl.Diagnostics) {
+	var diags hcl.Diagnostics
+
+	content, config, moreDiags := block.Body.PartialContent(providerBlockSchema)
+	diags = append(diags, moreDiags...)
+
+	name := block.Labels[0]
+	nameDiags := checkProviderNameNormalized(name, block.DefRange)
+	diags = append(diags, nameDiags...)
+	if nameDiags.HasErrors() {
+		return nil, diags
+	}
+
+	override := &ProviderOverride{
+		Name:      name,
+		NameRange: block.LabelRanges[0],
+		Config:    config,
+		DeclRange: block.DefRange,
+	}
+
+	if attr, exists := content.Attributes["alias"]; exists {
+		valDiags := gohcl.DecodeExpression(attr.Expr, nil, &override.Alias)
+		diags = append(diags, valDiags...)
+		override.AliasRange = attr.Expr.Range().Ptr()
+
+		if !hclsyntax.ValidIdentifier(override.Alias) {
+			diags = append(diags, &hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Invalid provider configuration alias",
+				Detail:   fmt.Sprintf("An alias must be a valid name. %s", badIdentifierDetail),
+				Subject:  override.AliasRange,
+			})
+		}
+	}
+
+	// The alias identifies the configuration to override, and the other
+	// meta-arguments can't vary between workspaces.
+	for name, attr := range content.Attributes {
+		if name == "alias" {
+			continue
+		}
+		diags = append(diags, &hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "Unsupported argument in provider override",
+			Detail:   fmt.Sprintf("The meta-argument %q cannot be overridden per workspace. A provider override can only set the arguments that the provider itself defines.", name),
+			Subject:  &attr.NameRange,
+		})
+	}
+	for _, block := range content.Blocks {
+		diags = append(diags, &hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "Unsupported block type in provider override",
+			Detail:   fmt.Sprintf("Blocks of type %q are not supported in a provider override.", block.Type),
+			Subject:  &block.TypeRange,
+		})
+	}
+
+	override.Arguments = providerOverrideArguments(block.Body)
+
+	return override, diags
+}
+
+// providerOverrideArguments returns the names of the provider-specific
+// arguments and nested block types set in the given provider override body,
+// or nil if the body isn't written in the native syntax.
+func providerOverrideArguments(body hcl.Body) []string {
+	syntaxBody, ok := body.(*hclsyntax.Body)
+	if !ok {
+		return nil
+	}
+
+	seen := make(map[string]bool)
+	for name := range syntaxBody.Attributes {
+		seen[name] = true
+	}
+	for _, block := range syntaxBody.Blocks {
+		seen[block.Type] = true
+	}
+	for _, attr := range providerBlockSchema.Attributes {
+		delete(seen, attr.Name)
+	}
+
+	ret := make([]string, 0, len(seen))
+	for name := range seen {
+		ret = append(ret, name)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// applyProviderOverrides merges the given workspace provider overrides into
+// the provider configurations of the module, following the same rules as
+// override files.
+func (m *Module) applyProviderOverrides(overrides []*ProviderOverride) hcl.Diagnostics {
+	var diags hcl.Diagnostics
+
+	for _, o := range overrides {
+		key := o.moduleUniqueKey()
+		existing, exists := m.ProviderConfigs[key]
+		switch {
+		case exists:
+			existing.Config = MergeBodies(existing.Config, o.Config)
+			existing.WorkspaceOverride = o
+		case o.Alias == "":
+			// As with override files, an absent default provider
+			// configuration implies an empty one, which the workspace
+			// override is then overriding.
+			m.ProviderConfigs[key] = &Provider{
+				Name:              o.Name,
+				NameRange:         o.NameRange,
+				Config:            o.Config,
+				DeclRange:         o.DeclRange,
+				WorkspaceOverride: o,
+			}
+		default:
+			diags = append(diags, &hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Missing base provider configuration for override",
+				Detail:   fmt.Sprintf("There is no %s provider configuration with the alias %q. A workspace provider override can only override an aliased provider configuration that was already defined in the root module.", o.Name, o.Alias),
+				Subject:  &o.DeclRange,
+			})
+		}
+	}
+
+	return diags
+}
+
+var providerOverrideFileSchema = &hcl.BodySchema{
+	Blocks: []hcl.BlockHeaderSchema{
+		{
+			Type:       "provider_override",
+			LabelNames: []string{"name"},
+		},
+	},
+}
@@ -0,0 +1,194 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package configs
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/hcl/v2"
+)
+
+func TestParserLoadProviderOverrideFile(t *testing.T) {
+	tests := map[string]struct {
+		src       string
+		want      []string
+		wantArgs  [][]string
+		wantDiags []string
+	}{
+		"default and aliased": {
+			src: `
+provider_override "aws" {
+  region = "eu-west-1"
+  assume_role {
+    role_arn = "arn:aws:iam::123456789012:role/staging"
+  }
+}
+
+provider_override "aws" {
+  alias    = "dns"
+  endpoint = "https://dns.example.com"
+}
+`,
+			want:     []string{"aws", "aws.dns"},
+			wantArgs: [][]string{{"assume_role", "region"}, {"endpoint"}},
+		},
+		"duplicate": {
+			src: `
+provider_override "aws" {
+  region = "eu-west-1"
+}
+
+provider_override "aws" {
+  region = "eu-west-2"
+}
+`,
+			want:      []string{"aws"},
+			wantArgs:  [][]string{{"region"}},
+			wantDiags: []string{"Duplicate provider override"},
+		},
+		"meta-argument": {
+			src: `
+provider_override "aws" {
+  version = "~> 5.0"
+}
+`,
+			want:      []string{"aws"},
+			wantArgs:  [][]string{{}},
+			wantDiags: []string{"Unsupported argument in provider override"},
+		},
+		"other block type": {
+			src: `
+provider "aws" {
+  region = "eu-west-1"
+}
+`,
+			wantDiags: []string{"Unsupported block type"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			parser := testParser(map[string]string{
+				"staging.tfoverride.hcl": test.src,
+			})
+			overrides, diags := parser.LoadProviderOverrideFile("staging.tfoverride.hcl")
+
+			var gotDiags []string
+			for _, diag := range diags {
+				gotDiags = append(gotDiags, diag.Summary)
+			}
+			if diff := cmp.Diff(test.wantDiags, gotDiags); diff != "" {
+				t.Errorf("wrong diagnostics\n%s", diff)
+			}
+
+			var got []string
+			var gotArgs [][]string
+			for _, o := range overrides {
+				got = append(got, o.moduleUniqueKey())
+				gotArgs = append(gotArgs, o.Arguments)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("wrong overrides\n%s", diff)
+			}
+			if diff := cmp.Diff(test.wantArgs, gotArgs); diff != "" {
+				t.Errorf("wrong arguments\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestModuleApplyProviderOverrides(t *testing.T) {
+	parser := testParser(map[string]string{
+		"config/main.tf": `
+provider "aws" {
+  region  = "us-east-1"
+  profile = "shared"
+}
+
+provider "aws" {
+  alias  = "dns"
+  region = "us-east-1"
+}
+`,
+		"staging.tfoverride.hcl": `
+provider_override "aws" {
+  region = "eu-west-1"
+}
+
+provider_override "google" {
+  project = "staging"
+}
+`,
+		"typo.tfoverride.hcl": `
+provider_override "aws" {
+  alias  = "dsn"
+  region = "eu-west-1"
+}
+`,
+	})
+
+	overrides, diags := parser.LoadProviderOverrideFile("staging.tfoverride.hcl")
+	assertNoDiagnostics(t, diags)
+
+	call := RootModuleCallForTesting().WithProviderOverrides(overrides)
+	mod, diags := parser.LoadConfigDir("config", call)
+	assertNoDiagnostics(t, diags)
+
+	schema := &hcl.BodySchema{
+		Attributes: []hcl.AttributeSchema{
+			{Name: "region"},
+			{Name: "profile"},
+			{Name: "project"},
+		},
+	}
+	got := make(map[string]map[string]string)
+	for key, pc := range mod.ProviderConfigs {
+		content, diags := pc.Config.Content(schema)
+		assertNoDiagnostics(t, diags)
+		args := make(map[string]string)
+		for name, attr := range content.Attributes {
+			val, diags := attr.Expr.Value(nil)
+			assertNoDiagnostics(t, diags)
+			args[name] = val.AsString()
+		}
+		if pc.WorkspaceOverride != nil {
+			args["(override)"] = strings.Join(pc.WorkspaceOverride.Arguments, ",")
+		}
+		got[key] = args
+	}
+
+	want := map[string]map[string]string{
+		"aws": {
+			"region":     "eu-west-1",
+			"profile":    "shared",
+			"(override)": "region",
+		},
+		"aws.dns": {
+			"region": "us-east-1",
+		},
+		"google": {
+			"project":    "staging",
+			"(override)": "project",
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("wrong provider configurations\n%s", diff)
+	}
+
+	// An aliased override must match an existing configuration.
+	overrides, diags = parser.LoadProviderOverrideFile("typo.tfoverride.hcl")
+	assertNoDiagnostics(t, diags)
+	_, diags = parser.LoadConfigDir("config", RootModuleCallForTesting().WithProviderOverrides(overrides))
+	assertExactDiagnostics(t, diags, []string{
+		`typo.tfoverride.hcl:2,1-24: Missing base provider configuration for override; There is no aws provider configuration with the alias "dsn". A workspace provider override can only override an aliased provider configuration that was already defined in the root module.`,
+	})
+
+	// The overrides are ignored when only loading the backend.
+	_, diags = parser.LoadConfigDirSelective("config", RootModuleCallForTesting().WithProviderOverrides(overrides), SelectiveLoadBackend)
+	assertNoDiagnostics(t, diags)
+}
@@ -0,0 +1,94 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package configs
+
+import (
+	"sort"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+)
+
+// propagateProviders passes the provider configurations given to module calls
+// that set propagate_providers on to every module call beneath them, so that
+// the modules in between don't need to repeat the same "providers" mapping.
+//
+// The inherited argument holds the provider configurations that the module
+// at c passes on to its own children, keyed by their name within the module.
+// Each module call that doesn't already pass a configuration under one of
+// those names gets a PassedProviderConfig with the same name on both sides,
+// marked as Propagated. An explicit mapping in a module call further down
+// therefore applies to the rest of its subtree instead.
+//
+// A name stops propagating at a module that knows it as a different provider
+// type than its caller does, because the configuration can't be passed on
+// there.
+func (c *Config) propagateProviders(inherited map[string]*ProviderConfigRef) {
+	names := make([]string, 0, len(inherited))
+	for name := range inherited {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for callName, child := range c.Children {
+		mc := c.Module.ModuleCalls[callName]
+		if mc == nil {
+			continue
+		}
+
+		explicit := make(map[string]bool, len(mc.Providers))
+		for _, passed := range mc.Providers {
+			explicit[providerName(passed.InChild.Name, passed.InChild.Alias)] = true
+		}
+
+		configured := make(map[string]bool, len(child.Module.ProviderConfigs))
+		for _, pc := range child.Module.ProviderConfigs {
+			configured[providerName(pc.Name, pc.Alias)] = true
+		}
+
+		childInherited := make(map[string]*ProviderConfigRef)
+		for _, name := range names {
+			ref := inherited[name]
+			localAddr := addrs.LocalProviderConfig{LocalName: ref.Name}
+			if !c.Module.ProviderForLocalConfig(localAddr).Equals(child.Module.ProviderForLocalConfig(localAddr)) {
+				continue
+			}
+			childInherited[name] = ref
+
+			// A module with its own configuration under this name can't
+			// accept another one, but still passes its own on.
+			if explicit[name] || configured[name] {
+				continue
+			}
+			mc.Providers = append(mc.Providers, PassedProviderConfig{
+				InChild:    ref.propagatedRef(),
+				InParent:   ref.propagatedRef(),
+				Propagated: true,
+			})
+		}
+
+		if mc.PropagateProviders {
+			for _, passed := range mc.Providers {
+				if !passed.Propagated {
+					childInherited[providerName(passed.InChild.Name, passed.InChild.Alias)] = passed.InChild
+				}
+			}
+		}
+
+		child.propagateProviders(childInherited)
+	}
+}
+
+// propagatedRef returns a new reference to the same provider configuration
+// name as r, for use in a propagated PassedProviderConfig. The source ranges
+// still refer to the module call that set propagate_providers.
+func (r *ProviderConfigRef) propagatedRef() *ProviderConfigRef {
+	return &ProviderConfigRef{
+		Name:       r.Name,
+		NameRange:  r.NameRange,
+		Alias:      r.Alias,
+		AliasRange: r.AliasRange,
+	}
+}
@@ -540,6 +540,11 @@ func validateProviderConfigs(parentCall *ModuleCall, cfg *Config, noProviderConf
 			if passed.InParent.Alias != "" {
 				continue
 			}
+			// Propagated configurations are passed on whether or not this
+			// module refers to them, and were checked further up.
+			if passed.Propagated {
+				continue
+			}
 
 			name := passed.InParent.String()
 			_, confOK := configured[name]
@@ -672,7 +677,12 @@ func validateProviderConfigs(parentCall *ModuleCall, cfg *Config, noProviderConf
 
 		_, emptyConfig := emptyConfigs[name]
 
-		if !localName && !configAlias && !emptyConfig {
+		// A configuration passed to a whole subtree may only pass through
+		// modules that don't declare it, on its way to those further down
+		// that do.
+		passingThrough := passed.Propagated || parentCall.PropagateProviders
+
+		if !localName && !configAlias && !emptyConfig && !passingThrough {
 
 			// we still allow default configs, so switch to a warning if the incoming provider is a default
 			if addrs.IsDefaultProvider(providerAddr.Provider) {
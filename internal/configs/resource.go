@@ -7,6 +7,7 @@ package configs
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/gohcl"
@@ -29,6 +30,9 @@ type Resource struct {
 	Count   hcl.Expression
 	Enabled hcl.Expression
 	ForEach hcl.Expression
+	// ForEachKey, if set, calculates the instance key for each element when
+	// ForEach is a list, set or tuple.
+	ForEachKey hcl.Expression
 
 	ProviderConfigRef *ProviderConfigRef
 	Provider          addrs.Provider
@@ -79,7 +83,27 @@ type ManagedResource struct {
 	IgnoreChanges    []hcl.Traversal
 	IgnoreAllChanges bool
 
+	// ApplyPriority biases the order in which OpenTofu starts changes to
+	// this resource's instances during apply, relative to other changes whose
+	// dependencies are also complete. Higher values are started first. This
+	// corresponds to the `lifecycle.apply_priority` attribute.
+	ApplyPriority int
+
+	// SerializeGroup is the name of a group of resources whose changes are
+	// never applied concurrently, even when they don't depend on each other.
+	// This corresponds to the `lifecycle.serialize_group` attribute.
+	SerializeGroup string
+
+	// ApplyWithin is the longest that applying a change to a single instance
+	// of this resource is expected to take, or zero if there's no limit.
+	// This corresponds to the `lifecycle.apply_within` attribute.
+	ApplyWithin      time.Duration
+	ApplyWithinRange hcl.Range
+
 	CreateBeforeDestroySet bool
+	ApplyPrioritySet       bool
+	SerializeGroupSet      bool
+	ApplyWithinSet         bool
 }
 
 func (r *Resource) moduleUniqueKey() string {
@@ -168,6 +192,12 @@ func decodeResourceBlock(block *hcl.Block, override bool) (*Resource, hcl.Diagno
 		repetitionArgs++
 	}
 
+	if attr, exists := content.Attributes["for_each_key"]; exists {
+		var keyDiags hcl.Diagnostics
+		r.ForEachKey, keyDiags = decodeForEachKey(attr, r.ForEach, override)
+		diags = append(diags, keyDiags...)
+	}
+
 	if attr, exists := content.Attributes["provider"]; exists {
 		var providerDiags hcl.Diagnostics
 		r.ProviderConfigRef, providerDiags = decodeProviderConfigRef(attr.Expr, "provider")
@@ -220,6 +250,34 @@ func decodeResourceBlock(block *hcl.Block, override bool) (*Resource, hcl.Diagno
 				r.Managed.Destroy = attr.Expr
 			}
 
+			if attr, exists := lcContent.Attributes["apply_priority"]; exists {
+				valDiags := gohcl.DecodeExpression(attr.Expr, nil, &r.Managed.ApplyPriority)
+				diags = append(diags, valDiags...)
+				r.Managed.ApplyPrioritySet = true
+			}
+
+			if attr, exists := lcContent.Attributes["serialize_group"]; exists {
+				valDiags := gohcl.DecodeExpression(attr.Expr, nil, &r.Managed.SerializeGroup)
+				diags = append(diags, valDiags...)
+				if !valDiags.HasErrors() && r.Managed.SerializeGroup == "" {
+					diags = append(diags, &hcl.Diagnostic{
+						Severity: hcl.DiagError,
+						Summary:  "Invalid serialize_group value",
+						Detail:   "The serialize_group argument must be a non-empty string naming the group of resources whose changes are applied one at a time.",
+						Subject:  attr.Expr.Range().Ptr(),
+					})
+				}
+				r.Managed.SerializeGroupSet = true
+			}
+
+			if attr, exists := lcContent.Attributes["apply_within"]; exists {
+				applyWithin, valDiags := decodeApplyWithin(attr)
+				diags = append(diags, valDiags...)
+				r.Managed.ApplyWithin = applyWithin
+				r.Managed.ApplyWithinRange = attr.Expr.Range()
+				r.Managed.ApplyWithinSet = true
+			}
+
 			if attr, exists := lcContent.Attributes["replace_triggered_by"]; exists {
 				exprs, hclDiags := decodeReplaceTriggeredBy(attr.Expr)
 				diags = diags.Extend(hclDiags)
@@ -234,6 +292,11 @@ func decodeResourceBlock(block *hcl.Block, override bool) (*Resource, hcl.Diagno
 				// resource entirely.
 				//   ignore_changes = [ami, instance_type]
 				//   ignore_changes = all
+				// Map keys containing "*" are patterns matching any number of
+				// characters, in which a backslash escapes the character after
+				// it, and splat expressions select the same path within each
+				// element of a collection:
+				//   ignore_changes = [tags["kubernetes.io/*"], metadata[*].annotations]
 				// We also allow two legacy forms for compatibility with earlier
 				// versions:
 				//   ignore_changes = ["ami", "instance_type"]
@@ -269,7 +332,7 @@ func decodeResourceBlock(block *hcl.Block, override bool) (*Resource, hcl.Diagno
 						expr, shimDiags := shimTraversalInString(expr, false)
 						diags = append(diags, shimDiags...)
 
-						traversal, travDiags := hcl.RelTraversalForExpr(expr)
+						traversal, travDiags := ignoreChangesTraversalForExpr(expr)
 						diags = append(diags, travDiags...)
 						if len(traversal) != 0 {
 							r.Managed.IgnoreChanges = append(r.Managed.IgnoreChanges, traversal)
@@ -453,6 +516,20 @@ func decodeDataBlock(block *hcl.Block, override, nested bool) (*Resource, hcl.Di
 		})
 	}
 
+	if attr, exists := content.Attributes["for_each_key"]; exists && !nested {
+		var keyDiags hcl.Diagnostics
+		r.ForEachKey, keyDiags = decodeForEachKey(attr, r.ForEach, override)
+		diags = append(diags, keyDiags...)
+	} else if exists && nested {
+		// We don't allow for_each_key attributes in nested data blocks.
+		diags = append(diags, &hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  `Invalid "for_each_key" attribute`,
+			Detail:   `The "for_each_key" meta-argument is not supported within nested data blocks.`,
+			Subject:  &attr.NameRange,
+		})
+	}
+
 	if attr, exists := content.Attributes["enabled"]; exists && !nested {
 		r.Enabled = attr.Expr
 		enabledRng = attr.NameRange
@@ -642,6 +719,12 @@ func decodeEphemeralBlock(block *hcl.Block, override bool) (*Resource, hcl.Diagn
 		repetitionArgs++
 	}
 
+	if attr, exists := content.Attributes["for_each_key"]; exists {
+		var keyDiags hcl.Diagnostics
+		r.ForEachKey, keyDiags = decodeForEachKey(attr, r.ForEach, override)
+		diags = append(diags, keyDiags...)
+	}
+
 	if attr, exists := content.Attributes["provider"]; exists {
 		var providerDiags hcl.Diagnostics
 		r.ProviderConfigRef, providerDiags = decodeProviderConfigRef(attr.Expr, "provider")
@@ -704,6 +787,15 @@ func decodeEphemeralBlock(block *hcl.Block, override bool) (*Resource, hcl.Diagn
 			if _, exists := lcContent.Attributes["ignore_changes"]; exists {
 				diags = append(diags, invalidEphemeralLifecycleAttributeDiag("ignore_changes", block.DefRange))
 			}
+			if _, exists := lcContent.Attributes["apply_priority"]; exists {
+				diags = append(diags, invalidEphemeralLifecycleAttributeDiag("apply_priority", block.DefRange))
+			}
+			if _, exists := lcContent.Attributes["serialize_group"]; exists {
+				diags = append(diags, invalidEphemeralLifecycleAttributeDiag("serialize_group", block.DefRange))
+			}
+			if _, exists := lcContent.Attributes["apply_within"]; exists {
+				diags = append(diags, invalidEphemeralLifecycleAttributeDiag("apply_within", block.DefRange))
+			}
 			if attr, exists := lcContent.Attributes["enabled"]; exists {
 				r.Enabled = attr.Expr
 				enabledRng = attr.NameRange
@@ -784,6 +876,69 @@ func decodeEphemeralBlock(block *hcl.Block, override bool) (*Resource, hcl.Diagn
 	return r, diags
 }
 
+// ignoreChangesTraversalForExpr is like hcl.RelTraversalForExpr, but also
+// accepts splat expressions such as metadata[*].annotations. Each splat
+// operator becomes a hcl.TraverseSplat step with an empty Each, and the
+// steps that follow it apply to each element of the collection.
+func ignoreChangesTraversalForExpr(expr hcl.Expression) (hcl.Traversal, hcl.Diagnostics) {
+	switch expr := expr.(type) {
+	case *hclsyntax.SplatExpr:
+		traversal, diags := ignoreChangesTraversalForExpr(expr.Source)
+		if diags.HasErrors() {
+			return nil, diags
+		}
+		each, moreDiags := ignoreChangesTraversalForExpr(expr.Each)
+		diags = append(diags, moreDiags...)
+		if moreDiags.HasErrors() {
+			return nil, diags
+		}
+		traversal = append(traversal, hcl.TraverseSplat{SrcRange: expr.MarkerRange})
+		return append(traversal, each...), diags
+	case *hclsyntax.RelativeTraversalExpr:
+		traversal, diags := ignoreChangesTraversalForExpr(expr.Source)
+		if diags.HasErrors() {
+			return nil, diags
+		}
+		return append(traversal, expr.Traversal...), diags
+	case *hclsyntax.AnonSymbolExpr:
+		// This is the element of a collection within a splat expression,
+		// which the splat step itself represents.
+		return nil, nil
+	default:
+		return hcl.RelTraversalForExpr(expr)
+	}
+}
+
+// decodeApplyWithin decodes the lifecycle apply_within argument, which must
+// be a positive duration string.
+func decodeApplyWithin(attr *hcl.Attribute) (time.Duration, hcl.Diagnostics) {
+	var raw string
+	diags := gohcl.DecodeExpression(attr.Expr, nil, &raw)
+	if diags.HasErrors() {
+		return 0, diags
+	}
+	d, err := time.ParseDuration(raw)
+	switch {
+	case err != nil:
+		diags = append(diags, &hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "Invalid apply_within duration",
+			Detail:   fmt.Sprintf("The apply_within argument must be a duration string such as \"90s\" or \"5m\": %s.", err),
+			Subject:  attr.Expr.Range().Ptr(),
+		})
+		return 0, diags
+	case d <= 0:
+		diags = append(diags, &hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "Invalid apply_within duration",
+			Detail:   "The apply_within argument must be a positive duration.",
+			Subject:  attr.Expr.Range().Ptr(),
+		})
+		return 0, diags
+	}
+	return d, diags
+}
+
 // decodeReplaceTriggeredBy decodes and does basic validation of the
 // replace_triggered_by expressions, ensuring they only contains references to
 // a single resource, and the only extra variables are count.index or each.key.
@@ -1094,6 +1249,9 @@ var commonResourceAttributes = []hcl.AttributeSchema{
 	{
 		Name: "for_each",
 	},
+	{
+		Name: "for_each_key",
+	},
 	{
 		Name: "provider",
 	},
@@ -1151,6 +1309,15 @@ var resourceLifecycleBlockSchema = &hcl.BodySchema{
 		{
 			Name: "enabled",
 		},
+		{
+			Name: "apply_priority",
+		},
+		{
+			Name: "serialize_group",
+		},
+		{
+			Name: "apply_within",
+		},
 	},
 	Blocks: []hcl.BlockHeaderSchema{
 		{Type: "precondition"},
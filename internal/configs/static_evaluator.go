@@ -8,6 +8,7 @@ package configs
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/gohcl"
@@ -42,6 +43,9 @@ type StaticModuleCall struct {
 	vars      StaticModuleVariables
 	rootPath  string
 	workspace string
+
+	providerOverrides []*ProviderOverride
+	disabledFunctions []string
 }
 
 func NewStaticModuleCall(addr addrs.Module, declRange hcl.Range, vars StaticModuleVariables, rootPath string, workspace string) StaticModuleCall {
@@ -65,9 +69,31 @@ func (s StaticModuleCall) WithVariables(vars StaticModuleVariables) StaticModule
 		vars:      vars,
 		rootPath:  s.rootPath,
 		workspace: s.workspace,
+
+		providerOverrides: s.providerOverrides,
+		disabledFunctions: s.disabledFunctions,
 	}
 }
 
+// WithProviderOverrides returns a copy of the call that applies the given
+// workspace provider overrides to the provider configurations of the called
+// module. This is only meaningful for the root module.
+func (s StaticModuleCall) WithProviderOverrides(overrides []*ProviderOverride) StaticModuleCall {
+	ret := s
+	ret.providerOverrides = overrides
+	return ret
+}
+
+// WithDisabledFunctions returns a copy of the call whose static evaluation
+// refuses to call the functions matching the given patterns, as for the
+// disabled_functions CLI configuration setting. The modules that the called
+// module calls in turn inherit the same patterns.
+func (s StaticModuleCall) WithDisabledFunctions(patterns []string) StaticModuleCall {
+	ret := s
+	ret.disabledFunctions = patterns
+	return ret
+}
+
 // only used in testing
 func RootModuleCallForTesting() StaticModuleCall {
 	return NewStaticModuleCall(addrs.RootModule, hcl.Range{}, func(_ *Variable) (cty.Value, hcl.Diagnostics) {
@@ -81,14 +107,55 @@ func RootModuleCallForTesting() StaticModuleCall {
 type StaticEvaluator struct {
 	call StaticModuleCall
 	cfg  *Module
+
+	// locals caches the values of the local values that have been evaluated
+	// so far, because each reference to a local value would otherwise
+	// evaluate its expression again, along with those of all of the local
+	// values it refers to in turn. This is nil for a zero StaticEvaluator,
+	// which then doesn't cache anything.
+	//
+	// The graph walk doesn't need this, because it evaluates each local value
+	// only once for each module instance and then refers to the result.
+	locals *staticLocalValues
+}
+
+// staticLocalValues is a concurrency-safe cache of the values of the local
+// values of a module during static evaluation.
+type staticLocalValues struct {
+	mu     sync.Mutex
+	values map[string]cty.Value
 }
 
 // Creates a static evaluator based from the given module and module call
 func NewStaticEvaluator(mod *Module, call StaticModuleCall) *StaticEvaluator {
 	return &StaticEvaluator{
-		call: call,
-		cfg:  mod,
+		call:   call,
+		cfg:    mod,
+		locals: &staticLocalValues{values: make(map[string]cty.Value)},
+	}
+}
+
+// localValue returns the cached value of the local value with the given
+// name, if it has been evaluated already.
+func (s *StaticEvaluator) localValue(name string) (cty.Value, bool) {
+	if s.locals == nil {
+		return cty.NilVal, false
+	}
+	s.locals.mu.Lock()
+	defer s.locals.mu.Unlock()
+	val, ok := s.locals.values[name]
+	return val, ok
+}
+
+// setLocalValue records the value of the local value with the given name, so
+// that later references to it don't need to evaluate it again.
+func (s *StaticEvaluator) setLocalValue(name string, val cty.Value) {
+	if s.locals == nil {
+		return
 	}
+	s.locals.mu.Lock()
+	defer s.locals.mu.Unlock()
+	s.locals.values[name] = val
 }
 
 func (s *StaticEvaluator) scope(ident StaticIdentifier) *lang.Scope {
@@ -7,6 +7,7 @@ package configs
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/hcl/v2"
@@ -304,6 +305,85 @@ resource "foo" "bar" {}
 	})
 }
 
+func TestStaticEvaluator_disabledFunctions(t *testing.T) {
+	parser := testParser(map[string]string{"eval.tf": `
+locals {
+	hashed = md5("my-string")
+	upper  = upper("my-string")
+}
+`})
+	file, fileDiags := parser.LoadConfigFile("eval.tf")
+	if fileDiags.HasErrors() {
+		t.Fatal(fileDiags)
+	}
+
+	call := RootModuleCallForTesting().WithDisabledFunctions([]string{"md5"})
+	mod, diags := NewModule([]*File{file}, nil, call, "dir", SelectiveLoadAll)
+	assertNoDiagnostics(t, diags)
+
+	ident := StaticIdentifier{Subject: "local.hashed"}
+	_, diags = mod.StaticEvaluator.Evaluate(t.Context(), mod.Locals["hashed"].Expr, ident)
+	if !diags.HasErrors() {
+		t.Fatal("calling a disabled function succeeded; want error")
+	}
+	if got, want := diags.Error(), `the function "md5" has been disabled`; !strings.Contains(got, want) {
+		t.Errorf("wrong error\ngot:  %s\nwant: %s", got, want)
+	}
+
+	ident = StaticIdentifier{Subject: "local.upper"}
+	val, diags := mod.StaticEvaluator.Evaluate(t.Context(), mod.Locals["upper"].Expr, ident)
+	assertNoDiagnostics(t, diags)
+	if got, want := val, cty.StringVal("MY-STRING"); !got.RawEquals(want) {
+		t.Errorf("wrong result %#v; want %#v", got, want)
+	}
+}
+
+func TestStaticEvaluator_localValuesCached(t *testing.T) {
+	parser := testParser(map[string]string{"eval.tf": `
+locals {
+	a = "a"
+	b = "${local.a}-b"
+	c = local.missing
+}
+`})
+	file, fileDiags := parser.LoadConfigFile("eval.tf")
+	if fileDiags.HasErrors() {
+		t.Fatal(fileDiags)
+	}
+	mod, diags := NewModule([]*File{file}, nil, RootModuleCallForTesting(), "dir", SelectiveLoadAll)
+	assertNoDiagnostics(t, diags)
+	eval := mod.StaticEvaluator
+	ident := StaticIdentifier{Subject: "local.test"}
+
+	expr, _ := hclsyntax.ParseExpression([]byte(`local.b`), "eval.tf", hcl.InitialPos)
+	val, diags := eval.Evaluate(t.Context(), expr, ident)
+	assertNoDiagnostics(t, diags)
+	if want := cty.StringVal("a-b"); !val.RawEquals(want) {
+		t.Fatalf("wrong result %#v; want %#v", val, want)
+	}
+
+	// Evaluating local.b also evaluated local.a, so changing the expression
+	// of local.a now has no effect on either of them.
+	mod.Locals["a"].Expr = hcl.StaticExpr(cty.StringVal("changed"), hcl.Range{})
+	for _, name := range []string{"a", "b"} {
+		expr, _ := hclsyntax.ParseExpression([]byte("local."+name), "eval.tf", hcl.InitialPos)
+		val, diags := eval.Evaluate(t.Context(), expr, ident)
+		assertNoDiagnostics(t, diags)
+		if val.RawEquals(cty.StringVal("changed")) {
+			t.Errorf("local.%s was evaluated again", name)
+		}
+	}
+
+	// A local value with errors isn't cached, so each reference reports the
+	// errors again.
+	expr, _ = hclsyntax.ParseExpression([]byte(`local.c`), "eval.tf", hcl.InitialPos)
+	for range 2 {
+		if _, diags := eval.Evaluate(t.Context(), expr, ident); !diags.HasErrors() {
+			t.Fatal("succeeded; want error")
+		}
+	}
+}
+
 func TestStaticEvaluator_DecodeExpression(t *testing.T) {
 	dummyIdentifier := StaticIdentifier{Subject: "local.test"}
 	parser := testParser(map[string]string{"eval.tf": ""})
@@ -25,11 +25,12 @@ import (
 // newStaticScope creates a lang.Scope that's backed by the static view of the module represented by the StaticEvaluator
 func newStaticScope(eval *StaticEvaluator, stack0 StaticIdentifier, stack ...StaticIdentifier) *lang.Scope {
 	return &lang.Scope{
-		Data:        staticScopeData{eval, append([]StaticIdentifier{stack0}, stack...)},
-		ParseRef:    addrs.ParseRef,
-		BaseDir:     ".", // Always current working directory for now. (same as Evaluator.Scope())
-		PureOnly:    false,
-		ConsoleMode: false,
+		Data:              staticScopeData{eval, append([]StaticIdentifier{stack0}, stack...)},
+		ParseRef:          addrs.ParseRef,
+		BaseDir:           ".", // Always current working directory for now. (same as Evaluator.Scope())
+		PureOnly:          false,
+		ConsoleMode:       false,
+		DisabledFunctions: eval.call.disabledFunctions,
 	}
 }
 
@@ -139,6 +140,10 @@ func (s staticScopeData) GetLocalValue(ctx context.Context, ident addrs.LocalVal
 		})
 	}
 
+	if val, ok := s.eval.localValue(local.Name); ok {
+		return val, diags
+	}
+
 	id := StaticIdentifier{
 		Module:    s.eval.call.addr,
 		Subject:   fmt.Sprintf("local.%s", local.Name),
@@ -152,6 +157,12 @@ func (s staticScopeData) GetLocalValue(ctx context.Context, ident addrs.LocalVal
 	}
 
 	val, valDiags := scope.EvalExpr(ctx, local.Expr, cty.DynamicPseudoType)
+	if len(valDiags) == 0 {
+		// Only values without any diagnostics are cached, so that each
+		// reference to a local value with problems reports them in the
+		// context of that reference.
+		s.eval.setLocalValue(local.Name, val)
+	}
 	return val, s.enhanceDiagnostics(id, diags.Append(valDiags))
 }
 
@@ -0,0 +1,10 @@
+module "leaf_a" {
+  source = "./leaf"
+}
+
+module "leaf_b" {
+  source = "./leaf"
+  providers = {
+    test.x = test.y
+  }
+}
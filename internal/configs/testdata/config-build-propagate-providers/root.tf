@@ -0,0 +1,12 @@
+provider "test" {
+  alias = "z"
+}
+
+module "mid" {
+  source = "./mid"
+  providers = {
+    test.x = test.z
+    test.y = test.z
+  }
+  propagate_providers = true
+}
@@ -0,0 +1,13 @@
+resource "test" "missing_for_each" {
+  for_each_key = each.value.name # ERROR: Invalid "for_each_key" argument
+}
+
+data "test" "each_key" {
+  for_each     = var.items
+  for_each_key = each.key # ERROR: Invalid "each" reference in "for_each_key"
+}
+
+module "missing_for_each" {
+  source       = "./child"
+  for_each_key = each.value.name # ERROR: Invalid "for_each_key" argument
+}
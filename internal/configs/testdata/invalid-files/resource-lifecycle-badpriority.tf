@@ -0,0 +1,5 @@
+resource "example" "example" {
+  lifecycle {
+    apply_priority = 1.5
+  }
+}
@@ -0,0 +1,5 @@
+resource "example" "example" {
+  lifecycle {
+    serialize_group = ""
+  }
+}
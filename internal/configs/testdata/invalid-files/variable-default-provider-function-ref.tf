@@ -0,0 +1,8 @@
+variable "arn" {
+  type = string
+}
+
+variable "service" {
+  type    = string
+  default = provider::aws::arn_parse(var.arn).service
+}
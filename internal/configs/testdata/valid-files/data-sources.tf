@@ -13,3 +13,8 @@ data "http" "example2" {
     data.http.example1,
   ]
 }
+
+data "aws_ami" "keyed" {
+  for_each     = var.images
+  for_each_key = each.value.name
+}
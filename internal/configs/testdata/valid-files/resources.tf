@@ -2,8 +2,13 @@ resource "aws_security_group" "firewall" {
   lifecycle {
     create_before_destroy = true
     prevent_destroy = true
+    apply_priority = 10
+    serialize_group = "firewalls"
+    apply_within = "2m"
     ignore_changes = [
       description,
+      tags["kubernetes.io/*"],
+      ingress[*].description,
     ]
   }
 
@@ -47,3 +52,10 @@ resource "aws_instance" "depends" {
     replace_triggered_by = [ aws_instance.web[1], aws_security_group.firewall.id ]
   }
 }
+
+resource "aws_instance" "keyed" {
+  for_each     = var.instances
+  for_each_key = "${var.prefix}-${each.value.name}"
+
+  instance_type = each.value.type
+}
@@ -0,0 +1,12 @@
+terraform {
+  required_providers {
+    aws = {
+      source = "hashicorp/aws"
+    }
+  }
+}
+
+variable "service" {
+  type    = string
+  default = provider::aws::arn_parse("arn:aws:s3:::example").service
+}
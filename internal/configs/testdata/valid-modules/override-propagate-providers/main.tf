@@ -0,0 +1,6 @@
+module "example" {
+  source = "./example"
+  providers = {
+    test.x = test
+  }
+}
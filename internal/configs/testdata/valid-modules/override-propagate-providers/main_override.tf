@@ -0,0 +1,3 @@
+module "example" {
+  propagate_providers = true
+}
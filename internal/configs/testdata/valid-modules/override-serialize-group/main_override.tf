@@ -0,0 +1,5 @@
+resource "test_instance" "foo" {
+  lifecycle {
+    serialize_group = "b"
+  }
+}
@@ -0,0 +1,204 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package depsfile
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/getproviders"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// Markers that version control systems like git write around the conflicting
+// parts of a file that they failed to merge automatically. The base marker
+// appears only in the "diff3" conflict style.
+var (
+	conflictStartMarker = []byte("<<<<<<<")
+	conflictBaseMarker  = []byte("|||||||")
+	conflictSplitMarker = []byte("=======")
+	conflictEndMarker   = []byte(">>>>>>>")
+)
+
+// LoadConflictedLocksFromFile reads locks from the given file, which may
+// contain conflict markers left behind by a version control system that
+// failed to merge two versions of the file.
+//
+// The result has one Locks object for each side of the conflicts, with the
+// text outside of the conflicts shared by both. If the file has no conflict
+// markers then the result has only one Locks object, the same as
+// LoadLocksFromFile would return. Any common ancestor sections written by the
+// "diff3" conflict style are ignored.
+//
+// Source locations in the returned diagnostics refer to the reconstructed
+// side of the file rather than to the file on disk, and so their line numbers
+// may not match.
+func LoadConflictedLocksFromFile(filename string) ([]*Locks, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	src, err := os.ReadFile(filename)
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to read dependency lock file",
+			fmt.Sprintf("Could not read %s: %s.", filename, err),
+		))
+		return nil, diags
+	}
+
+	sides, err := splitConflictSides(src)
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid conflict markers in dependency lock file",
+			fmt.Sprintf("Could not resolve the conflicts in %s: %s.", filename, err),
+		))
+		return nil, diags
+	}
+
+	ret := make([]*Locks, 0, len(sides))
+	for _, side := range sides {
+		locks, moreDiags := LoadLocksFromBytes(side, filename)
+		diags = diags.Append(moreDiags)
+		ret = append(ret, locks)
+	}
+	return ret, diags
+}
+
+// splitConflictSides reconstructs each side of the conflicts in the given
+// source, returning just the source itself if it has no conflict markers.
+func splitConflictSides(src []byte) ([][]byte, error) {
+	const (
+		outside = iota
+		inOurs
+		inBase
+		inTheirs
+	)
+
+	var ours, theirs []byte
+	state := outside
+	conflicted := false
+	for i, line := range bytes.SplitAfter(src, []byte("\n")) {
+		switch {
+		case bytes.HasPrefix(line, conflictStartMarker):
+			if state != outside {
+				return nil, fmt.Errorf("unexpected conflict start marker on line %d", i+1)
+			}
+			state = inOurs
+			conflicted = true
+		case bytes.HasPrefix(line, conflictBaseMarker):
+			if state != inOurs {
+				return nil, fmt.Errorf("unexpected common ancestor marker on line %d", i+1)
+			}
+			state = inBase
+		case bytes.HasPrefix(line, conflictSplitMarker):
+			if state != inOurs && state != inBase {
+				return nil, fmt.Errorf("unexpected conflict separator on line %d", i+1)
+			}
+			state = inTheirs
+		case bytes.HasPrefix(line, conflictEndMarker):
+			if state != inTheirs {
+				return nil, fmt.Errorf("unexpected conflict end marker on line %d", i+1)
+			}
+			state = outside
+		default:
+			switch state {
+			case outside:
+				ours = append(ours, line...)
+				theirs = append(theirs, line...)
+			case inOurs:
+				ours = append(ours, line...)
+			case inTheirs:
+				theirs = append(theirs, line...)
+			}
+		}
+	}
+	if state != outside {
+		return nil, fmt.Errorf("the last conflict has no end marker")
+	}
+
+	if !conflicted {
+		return [][]byte{src}, nil
+	}
+	return [][]byte{ours, theirs}, nil
+}
+
+// MergeConflictedLocks combines the locks from each side of a conflicted
+// dependency lock file into a single set of locks that is consistent with
+// the given provider requirements.
+//
+// For each lockable provider in reqs, the result selects the newest of the
+// locked versions that meet its version constraints, with all of the
+// checksums that any of the sides recorded for that version. A provider that
+// has no such locked version is left out of the result, so that the provider
+// installer can select a new version for it. Locks for providers that are
+// not in reqs are also left out, because they are no longer needed.
+func MergeConflictedLocks(sides []*Locks, reqs getproviders.Requirements) *Locks {
+	ret := NewLocks()
+	for provider, constraints := range reqs {
+		if !ProviderIsLockable(provider) {
+			continue
+		}
+		acceptable := getproviders.MeetingConstraints(constraints)
+
+		var selected *ProviderLock
+		var hashes []getproviders.Hash
+		for _, side := range sides {
+			lock := side.Provider(provider)
+			if lock == nil || !acceptable.Has(lock.Version()) {
+				continue
+			}
+			if selected == nil || selected.Version().LessThan(lock.Version()) {
+				selected = lock
+				hashes = nil
+			}
+			if lock.Version().Same(selected.Version()) {
+				hashes = append(hashes, lock.AllHashes()...)
+			}
+		}
+		if selected == nil {
+			continue
+		}
+		ret.SetProvider(provider, selected.Version(), constraints, hashes)
+	}
+	return ret
+}
+
+// ConflictingProviders returns the addresses of the providers whose locks
+// differ between the given sides of a conflicted dependency lock file, in
+// a consistent order.
+func ConflictingProviders(sides []*Locks) []addrs.Provider {
+	seen := make(map[addrs.Provider]bool)
+	var ret []addrs.Provider
+	for _, side := range sides {
+		for provider := range side.AllProviders() {
+			if seen[provider] {
+				continue
+			}
+			seen[provider] = true
+			for _, other := range sides {
+				if !providerLocksEqual(side.Provider(provider), other.Provider(provider)) {
+					ret = append(ret, provider)
+					break
+				}
+			}
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].LessThan(ret[j])
+	})
+	return ret
+}
+
+func providerLocksEqual(a, b *ProviderLock) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Version().Same(b.Version()) && a.ContainsAll(b) && b.ContainsAll(a)
+}
@@ -0,0 +1,150 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package depsfile
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/getproviders"
+)
+
+func TestLoadConflictedLocksFromFile(t *testing.T) {
+	tests := map[string]struct {
+		src          string
+		wantVersions []string
+		wantErr      string
+	}{
+		"no conflicts": {
+			src: `
+provider "registry.opentofu.org/hashicorp/test" {
+  version = "1.0.0"
+}
+`,
+			wantVersions: []string{"1.0.0"},
+		},
+		"conflict": {
+			src: `
+provider "registry.opentofu.org/hashicorp/test" {
+<<<<<<< HEAD
+  version = "1.0.0"
+=======
+  version = "1.1.0"
+>>>>>>> feature
+}
+`,
+			wantVersions: []string{"1.0.0", "1.1.0"},
+		},
+		"diff3 conflict": {
+			src: `
+provider "registry.opentofu.org/hashicorp/test" {
+<<<<<<< HEAD
+  version = "1.0.0"
+||||||| base
+  version = "0.9.0"
+=======
+  version = "1.1.0"
+>>>>>>> feature
+}
+`,
+			wantVersions: []string{"1.0.0", "1.1.0"},
+		},
+		"unterminated conflict": {
+			src: `
+provider "registry.opentofu.org/hashicorp/test" {
+<<<<<<< HEAD
+  version = "1.0.0"
+=======
+  version = "1.1.0"
+}
+`,
+			wantErr: "Could not resolve the conflicts in .terraform.lock.hcl: the last conflict has no end marker.",
+		},
+		"separator outside conflict": {
+			src: `
+=======
+`,
+			wantErr: "Could not resolve the conflicts in .terraform.lock.hcl: unexpected conflict separator on line 2.",
+		},
+	}
+
+	provider := addrs.NewDefaultProvider("test")
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Chdir(t.TempDir())
+			if err := os.WriteFile(".terraform.lock.hcl", []byte(test.src), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			sides, diags := LoadConflictedLocksFromFile(".terraform.lock.hcl")
+			if test.wantErr != "" {
+				if !diags.HasErrors() {
+					t.Fatal("succeeded; want error")
+				}
+				if got := diags[0].Description().Detail; got != test.wantErr {
+					t.Fatalf("wrong error\ngot:  %s\nwant: %s", got, test.wantErr)
+				}
+				return
+			}
+			if diags.HasErrors() {
+				t.Fatal(diags.Err())
+			}
+
+			var gotVersions []string
+			for _, side := range sides {
+				gotVersions = append(gotVersions, side.Provider(provider).Version().String())
+			}
+			if diff := cmp.Diff(test.wantVersions, gotVersions); diff != "" {
+				t.Errorf("wrong versions\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMergeConflictedLocks(t *testing.T) {
+	fooProvider := addrs.MustParseProviderSourceString("test/foo")
+	barProvider := addrs.MustParseProviderSourceString("test/bar")
+	bazProvider := addrs.MustParseProviderSourceString("test/baz")
+	unusedProvider := addrs.MustParseProviderSourceString("test/unused")
+
+	v1 := getproviders.MustParseVersion("1.0.0")
+	v2 := getproviders.MustParseVersion("2.0.0")
+
+	ours := NewLocks()
+	ours.SetProvider(fooProvider, v1, nil, []getproviders.Hash{"h1:foo-linux"})
+	ours.SetProvider(barProvider, v1, nil, []getproviders.Hash{"h1:bar-1"})
+	ours.SetProvider(bazProvider, v2, nil, []getproviders.Hash{"h1:baz-2"})
+	ours.SetProvider(unusedProvider, v1, nil, nil)
+	theirs := NewLocks()
+	theirs.SetProvider(fooProvider, v1, nil, []getproviders.Hash{"h1:foo-darwin"})
+	theirs.SetProvider(barProvider, v2, nil, []getproviders.Hash{"h1:bar-2"})
+	theirs.SetProvider(bazProvider, v2, nil, []getproviders.Hash{"h1:baz-2"})
+	sides := []*Locks{ours, theirs}
+
+	reqs := getproviders.Requirements{
+		fooProvider: getproviders.MustParseVersionConstraints("~> 1.0"),
+		barProvider: nil,
+		// Neither side's version of this provider meets the constraint.
+		bazProvider: getproviders.MustParseVersionConstraints("< 2.0.0"),
+	}
+
+	got := MergeConflictedLocks(sides, reqs)
+	want := NewLocks()
+	want.SetProvider(fooProvider, v1, getproviders.MustParseVersionConstraints("~> 1.0"), []getproviders.Hash{"h1:foo-darwin", "h1:foo-linux"})
+	want.SetProvider(barProvider, v2, nil, []getproviders.Hash{"h1:bar-2"})
+	if !got.Equal(want) {
+		t.Errorf("wrong result\ngot:  %#v\nwant: %#v", got.AllProviders(), want.AllProviders())
+	}
+
+	gotConflicting := ConflictingProviders(sides)
+	wantConflicting := []addrs.Provider{barProvider, fooProvider, unusedProvider}
+	if diff := cmp.Diff(wantConflicting, gotConflicting); diff != "" {
+		t.Errorf("wrong conflicting providers\n%s", diff)
+	}
+}
@@ -88,6 +88,13 @@ type EnforceableTargetConfig struct {
 	Enforced bool           `hcl:"enforced,optional"`
 	Method   hcl.Expression `hcl:"method,optional"`
 	Fallback *TargetConfig  `hcl:"fallback,block"`
+
+	// BindWorkspace records the workspace name in the encrypted payload, so it can't be read in another workspace.
+	// This is only supported for the state target.
+	BindWorkspace bool `hcl:"bind_workspace,optional"`
+	// AllowUnboundWorkspace permits reading encrypted states that are not bound to a workspace yet while BindWorkspace
+	// is enabled. This is intended for migrating existing states.
+	AllowUnboundWorkspace bool `hcl:"allow_unbound_workspace,optional"`
 }
 
 // AsTargetConfig converts the struct into its parent TargetConfig.
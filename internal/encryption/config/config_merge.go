@@ -118,6 +118,9 @@ func mergeEnforceableTargetConfigs(cfg *EnforceableTargetConfig, override *Enfor
 		Enforced: cfg.Enforced || override.Enforced,
 		Method:   mergeTarget.Method,
 		Fallback: mergeTarget.Fallback,
+
+		BindWorkspace:         cfg.BindWorkspace || override.BindWorkspace,
+		AllowUnboundWorkspace: cfg.AllowUnboundWorkspace || override.AllowUnboundWorkspace,
 	}
 }
 
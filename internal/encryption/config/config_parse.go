@@ -59,6 +59,15 @@ func DecodeConfig(body hcl.Body, rng hcl.Range) (*EncryptionConfig, hcl.Diagnost
 		diags = diags.Extend(mDiags)
 	}
 
+	if cfg.Plan != nil && (cfg.Plan.BindWorkspace || cfg.Plan.AllowUnboundWorkspace) {
+		diags = append(diags, &hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "Unsupported workspace binding",
+			Detail:   "The bind_workspace and allow_unbound_workspace options are only supported in the state block, plan files are not stored per workspace.",
+			Subject:  rng.Ptr(),
+		})
+	}
+
 	if cfg.Remote != nil {
 		for i, t := range cfg.Remote.Targets {
 			for j, ot := range cfg.Remote.Targets {
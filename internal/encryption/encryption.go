@@ -69,7 +69,10 @@ func New(ctx context.Context, reg registry.Registry, cfg *config.EncryptionConfi
 	var encDiags hcl.Diagnostics
 
 	if cfg.State != nil {
-		enc.state, encDiags = newStateEncryption(ctx, enc, cfg.State.AsTargetConfig(), cfg.State.Enforced, "state", staticEval)
+		enc.state, encDiags = newStateEncryption(ctx, enc, cfg.State.AsTargetConfig(), cfg.State.Enforced, workspaceBinding{
+			enabled:      cfg.State.BindWorkspace,
+			allowUnbound: cfg.State.AllowUnboundWorkspace,
+		}, "state", staticEval)
 		diags = append(diags, encDiags...)
 	} else {
 		enc.state = StateEncryptionDisabled()
@@ -83,7 +86,7 @@ func New(ctx context.Context, reg registry.Registry, cfg *config.EncryptionConfi
 	}
 
 	if cfg.Remote != nil && cfg.Remote.Default != nil {
-		enc.remoteDefault, encDiags = newStateEncryption(ctx, enc, cfg.Remote.Default, false, "remote.default", staticEval)
+		enc.remoteDefault, encDiags = newStateEncryption(ctx, enc, cfg.Remote.Default, false, workspaceBinding{}, "remote.default", staticEval)
 		diags = append(diags, encDiags...)
 	} else {
 		enc.remoteDefault = StateEncryptionDisabled()
@@ -93,7 +96,7 @@ func New(ctx context.Context, reg registry.Registry, cfg *config.EncryptionConfi
 		for _, remoteTarget := range cfg.Remote.Targets {
 			// TODO the addr here should be generated in one place.
 			addr := "remote.remote_state_datasource." + remoteTarget.Name
-			enc.remotes[remoteTarget.Name], encDiags = newStateEncryption(ctx, enc, remoteTarget.AsTargetConfig(), false, addr, staticEval)
+			enc.remotes[remoteTarget.Name], encDiags = newStateEncryption(ctx, enc, remoteTarget.AsTargetConfig(), false, workspaceBinding{}, addr, staticEval)
 			diags = append(diags, encDiags...)
 		}
 	}
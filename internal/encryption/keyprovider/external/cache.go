@@ -0,0 +1,62 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package external
+
+import (
+	"bytes"
+	"maps"
+	"strings"
+	"sync"
+
+	"github.com/opentofu/opentofu/internal/encryption/keyprovider"
+)
+
+// outputCache holds the results of external commands with caching enabled. The key provider is built anew every time
+// the encryption configuration is set up, so the cache lives at the package level to last for the whole process.
+var outputCache = struct {
+	sync.Mutex
+	entries map[string]*OutputV1
+}{
+	entries: map[string]*OutputV1{},
+}
+
+// cachedOutput returns the cached output for the given command and input, or calls run and caches its result if there
+// is none. Failures are not cached, so a failed command is retried on the next call.
+func cachedOutput(command []string, input []byte, run func() (*OutputV1, error)) (*OutputV1, error) {
+	key := cacheKey(command, input)
+
+	outputCache.Lock()
+	defer outputCache.Unlock()
+
+	if cached, ok := outputCache.entries[key]; ok {
+		return cached.clone(), nil
+	}
+	result, err := run()
+	if err != nil {
+		return nil, err
+	}
+	outputCache.entries[key] = result.clone()
+	return result, nil
+}
+
+func cacheKey(command []string, input []byte) string {
+	// The null character can't be part of a command line argument, so it safely separates the parts of the key.
+	return strings.Join(command, "\x00") + "\x00\x00" + string(input)
+}
+
+// clone returns a copy of the output that doesn't share the key material with the original, so callers can't modify
+// the cached entry.
+func (o *OutputV1) clone() *OutputV1 {
+	return &OutputV1{
+		Keys: keyprovider.Output{
+			EncryptionKey: bytes.Clone(o.Keys.EncryptionKey),
+			DecryptionKey: bytes.Clone(o.Keys.DecryptionKey),
+		},
+		Meta: MetadataV1{
+			ExternalData: maps.Clone(o.Meta.ExternalData),
+		},
+	}
+}
@@ -8,6 +8,7 @@ package external
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/opentofu/opentofu/internal/encryption/keyprovider"
 	"github.com/opentofu/opentofu/internal/encryption/keyprovider/compliancetest"
@@ -55,12 +56,49 @@ func runTest(t *testing.T, command []string) {
 						if config.Command[0] != "test-provider" {
 							return fmt.Errorf("invalid command after parsing")
 						}
+						if keyProvider.timeout != defaultTimeout {
+							return fmt.Errorf("invalid default timeout: %s", keyProvider.timeout)
+						}
 						return nil
 					},
 				},
 				"empty-binary": {
 					HCL: `key_provider "external" "foo" {
     command = []
+}`,
+					ValidHCL:   true,
+					ValidBuild: false,
+				},
+				"timeout-and-cache": {
+					HCL: `key_provider "external" "foo" {
+    command = ["test-provider"]
+    timeout = "5s"
+    cache   = true
+}`,
+					ValidHCL:   true,
+					ValidBuild: true,
+					Validate: func(config *Config, keyProvider *keyProvider) error {
+						if keyProvider.timeout != 5*time.Second {
+							return fmt.Errorf("invalid timeout after parsing: %s", keyProvider.timeout)
+						}
+						if !keyProvider.cache {
+							return fmt.Errorf("caching is not enabled after parsing")
+						}
+						return nil
+					},
+				},
+				"invalid-timeout": {
+					HCL: `key_provider "external" "foo" {
+    command = ["test-provider"]
+    timeout = "five seconds"
+}`,
+					ValidHCL:   true,
+					ValidBuild: false,
+				},
+				"negative-timeout": {
+					HCL: `key_provider "external" "foo" {
+    command = ["test-provider"]
+    timeout = "-5s"
 }`,
 					ValidHCL:   true,
 					ValidBuild: false,
@@ -6,11 +6,22 @@
 package external
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/opentofu/opentofu/internal/encryption/keyprovider"
 )
 
+// defaultTimeout is the time the external command may run for when no timeout is configured.
+const defaultTimeout = time.Minute
+
 type Config struct {
 	Command []string `hcl:"command"`
+	// Timeout is the maximum duration the external command may run for, in the Go duration format.
+	Timeout string `hcl:"timeout,optional"`
+	// Cache instructs the key provider to only run the command once per distinct input for the lifetime of the
+	// OpenTofu process.
+	Cache bool `hcl:"cache,optional"`
 }
 
 func (c *Config) Build() (keyprovider.KeyProvider, keyprovider.KeyMeta, error) {
@@ -19,7 +30,24 @@ func (c *Config) Build() (keyprovider.KeyProvider, keyprovider.KeyMeta, error) {
 			Message: "the command option is required",
 		}
 	}
+	timeout := defaultTimeout
+	if c.Timeout != "" {
+		var err error
+		timeout, err = time.ParseDuration(c.Timeout)
+		if err != nil {
+			return nil, nil, &keyprovider.ErrInvalidConfiguration{
+				Message: fmt.Sprintf("invalid timeout %q (%v)", c.Timeout, err),
+			}
+		}
+		if timeout <= 0 {
+			return nil, nil, &keyprovider.ErrInvalidConfiguration{
+				Message: fmt.Sprintf("the timeout must be positive, %q given", c.Timeout),
+			}
+		}
+	}
 	return &keyProvider{
 		command: c.Command,
+		timeout: timeout,
+		cache:   c.Cache,
 	}, &MetadataV1{}, nil
 }
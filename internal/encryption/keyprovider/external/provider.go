@@ -20,6 +20,8 @@ import (
 
 type keyProvider struct {
 	command []string
+	timeout time.Duration
+	cache   bool
 }
 
 func (k keyProvider) Provide(rawMeta keyprovider.KeyMeta) (keyprovider.Output, keyprovider.KeyMeta, error) {
@@ -40,12 +42,31 @@ func (k keyProvider) Provide(rawMeta keyprovider.KeyMeta) (keyprovider.Output, k
 		}
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	var result *OutputV1
+	if k.cache {
+		result, err = cachedOutput(k.command, input, func() (*OutputV1, error) {
+			return k.run(input)
+		})
+	} else {
+		result, err = k.run(input)
+	}
+	if err != nil {
+		return keyprovider.Output{}, nil, err
+	}
+
+	return result.Keys, &result.Meta, nil
+}
+
+// run executes the external command with the given input and decodes its output.
+func (k keyProvider) run(input []byte) (*OutputV1, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), k.timeout)
 	defer cancel()
 
 	stderr := &bytes.Buffer{}
 
 	cmd := exec.CommandContext(ctx, k.command[0], k.command[1:]...)
+	// Child processes of the command may keep the output open after it has been killed, don't wait for them.
+	cmd.WaitDelay = time.Second
 
 	handler := &ioHandler{
 		false,
@@ -60,21 +81,27 @@ func (k keyProvider) Provide(rawMeta keyprovider.KeyMeta) (keyprovider.Output, k
 	cmd.Stderr = stderr
 	if err := cmd.Run(); err != nil {
 		if handler.err != nil {
-			return keyprovider.Output{}, nil, &keyprovider.ErrKeyProviderFailure{
+			return nil, &keyprovider.ErrKeyProviderFailure{
 				Message: "external key provider protocol failure",
 				Cause:   err,
 			}
 		}
 
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, &keyprovider.ErrKeyProviderFailure{
+				Message: fmt.Sprintf("the external command did not finish within %s\n\nStderr:\n-------\n%s", k.timeout, stderr),
+			}
+		}
+
 		var exitErr *exec.ExitError
 		if errors.As(err, &exitErr) {
 			if exitErr.ExitCode() != 0 {
-				return keyprovider.Output{}, nil, &keyprovider.ErrKeyProviderFailure{
+				return nil, &keyprovider.ErrKeyProviderFailure{
 					Message: fmt.Sprintf("the external command exited with a non-zero exit code (%v)\n\nStderr:\n-------\n%s", err, stderr),
 				}
 			}
 		}
-		return keyprovider.Output{}, nil, &keyprovider.ErrKeyProviderFailure{
+		return nil, &keyprovider.ErrKeyProviderFailure{
 			Message: fmt.Sprintf("the external command exited with an error (%v)\n\nStderr:\n-------\n%s", err, stderr),
 		}
 	}
@@ -83,12 +110,11 @@ func (k keyProvider) Provide(rawMeta keyprovider.KeyMeta) (keyprovider.Output, k
 	decoder := json.NewDecoder(bytes.NewReader(handler.output))
 	decoder.DisallowUnknownFields()
 	if err := decoder.Decode(&result); err != nil {
-		return keyprovider.Output{}, nil, &keyprovider.ErrKeyProviderFailure{
+		return nil, &keyprovider.ErrKeyProviderFailure{
 			Message: fmt.Sprintf("the external command returned an invalid JSON response (%v)\n\nStderr:\n-------\n%s", err, stderr),
 		}
 	}
-
-	return result.Keys, &result.Meta, nil
+	return result, nil
 }
 
 type ioHandler struct {
@@ -0,0 +1,113 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package external
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/opentofu/opentofu/internal/encryption/keyprovider"
+)
+
+// writeCountingProvider writes a POSIX shell key provider that appends a line to the returned counter file on every
+// run, optionally sleeping before it writes the key material.
+func writeCountingProvider(t *testing.T, sleep string) ([]string, string) {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("this test requires a POSIX shell")
+	}
+	dir := t.TempDir()
+	counter := filepath.Join(dir, "runs")
+	script := filepath.Join(dir, "provider.sh")
+	content := `#!/bin/sh
+set -e
+echo run >> "$1"
+echo '{"magic":"OpenTofu-External-Key-Provider","version":1}'
+cat > /dev/null
+sleep ` + sleep + `
+echo '{"keys":{"encryption_key":"AQIDBAUGBwgJCgsMDQ4PEA=="},"meta":{"external_data":{}}}'
+`
+	if err := os.WriteFile(script, []byte(content), 0700); err != nil { //nolint:gosec // The script must be executable.
+		t.Fatal(err)
+	}
+	return []string{"/bin/sh", script, counter}, counter
+}
+
+func countRuns(t *testing.T, counter string) int {
+	t.Helper()
+	data, err := os.ReadFile(counter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return strings.Count(string(data), "run\n")
+}
+
+func TestProvide_cache(t *testing.T) {
+	for name, cache := range map[string]bool{"enabled": true, "disabled": false} {
+		t.Run(name, func(t *testing.T) {
+			command, counter := writeCountingProvider(t, "0")
+			kp, meta, err := (&Config{Command: command, Cache: cache}).Build()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for range 3 {
+				output, _, err := kp.Provide(meta)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(output.EncryptionKey) != 16 {
+					t.Fatalf("incorrect encryption key: %v", output.EncryptionKey)
+				}
+				// Modifying the returned key must not corrupt the cached entry.
+				output.EncryptionKey[0] = 0
+			}
+			output, _, err := kp.Provide(meta)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if output.EncryptionKey[0] != 1 {
+				t.Fatalf("the returned key material is shared with the cache")
+			}
+
+			wantRuns := 4
+			if cache {
+				wantRuns = 1
+			}
+			if runs := countRuns(t, counter); runs != wantRuns {
+				t.Fatalf("expected %d runs of the external command, got %d", wantRuns, runs)
+			}
+
+			// A different input must not be served from the cache.
+			if _, _, err := kp.Provide(&MetadataV1{ExternalData: map[string]any{"foo": "bar"}}); err != nil {
+				t.Fatal(err)
+			}
+			if runs := countRuns(t, counter); runs != wantRuns+1 {
+				t.Fatalf("expected %d runs of the external command, got %d", wantRuns+1, runs)
+			}
+		})
+	}
+}
+
+func TestProvide_timeout(t *testing.T) {
+	command, _ := writeCountingProvider(t, "10")
+	kp, meta, err := (&Config{Command: command, Timeout: "100ms"}).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, err = kp.Provide(meta)
+	var failure *keyprovider.ErrKeyProviderFailure
+	if !errors.As(err, &failure) {
+		t.Fatalf("expected %T, got %T (%v)", failure, err, err)
+	}
+	if !strings.Contains(err.Error(), "did not finish within 100ms") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
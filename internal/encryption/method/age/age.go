@@ -0,0 +1,88 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package age
+
+import (
+	"bytes"
+	"errors"
+	"io"
+
+	agelib "filippo.io/age"
+
+	"github.com/opentofu/opentofu/internal/encryption/method"
+)
+
+// age encrypts data in the age v1 format for a set of recipients, and
+// decrypts data encrypted for any of its identities.
+type age struct {
+	recipients []agelib.Recipient
+	identities []agelib.Identity
+}
+
+// Encrypt encrypts the passed data for all configured recipients.
+func (a *age) Encrypt(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w, err := agelib.Encrypt(&buf, a.recipients...)
+	if err != nil {
+		return nil, &method.ErrEncryptionFailed{Cause: &method.ErrCryptoFailure{
+			Message: "failed to wrap the file key for the recipients",
+			Cause:   err,
+		}}
+	}
+	if _, err := w.Write(data); err != nil {
+		return nil, &method.ErrEncryptionFailed{Cause: &method.ErrCryptoFailure{
+			Message: "failed to encrypt the payload",
+			Cause:   err,
+		}}
+	}
+	if err := w.Close(); err != nil {
+		return nil, &method.ErrEncryptionFailed{Cause: &method.ErrCryptoFailure{
+			Message: "failed to encrypt the payload",
+			Cause:   err,
+		}}
+	}
+	return buf.Bytes(), nil
+}
+
+// Decrypt decrypts age-encrypted data using the first configured identity
+// that the data was encrypted for.
+func (a *age) Decrypt(data []byte) ([]byte, error) {
+	if len(a.identities) == 0 {
+		return nil, &method.ErrDecryptionKeyUnavailable{}
+	}
+	if len(data) == 0 {
+		return nil, &method.ErrDecryptionFailed{Cause: &method.ErrCryptoFailure{
+			Message: "cannot decrypt empty data",
+		}}
+	}
+
+	r, err := agelib.Decrypt(bytes.NewReader(data), a.identities...)
+	if err != nil {
+		var noMatch *agelib.NoIdentityMatchError
+		if errors.As(err, &noMatch) {
+			return nil, &method.ErrDecryptionFailed{Cause: &method.ErrCryptoFailure{
+				Message: "none of the configured identities match the recipients the data was encrypted for",
+			}}
+		}
+		return nil, &method.ErrDecryptionFailed{Cause: &method.ErrCryptoFailure{
+			Message: "failed to decrypt the file key",
+			Cause:   err,
+		}}
+	}
+	decrypted, err := io.ReadAll(r)
+	if err != nil {
+		return nil, &method.ErrDecryptionFailed{Cause: &method.ErrCryptoFailure{
+			Message: "failed to decrypt the payload",
+			Cause:   err,
+		}}
+	}
+	return decrypted, nil
+}
+
+func Is(m method.Method) bool {
+	_, ok := m.(*age)
+	return ok
+}
@@ -0,0 +1,215 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package age
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/ssh"
+
+	"github.com/opentofu/opentofu/internal/encryption/method"
+)
+
+func buildTestMethod(t *testing.T, recipients []string, identities []string) method.Method {
+	t.Helper()
+	m, err := (&Config{Recipients: recipients, Identities: identities}).Build()
+	if err != nil {
+		t.Fatalf("unexpected error from Build(): %v", err)
+	}
+	return m
+}
+
+// testSSHKeys returns an SSH public key in the authorized_keys format and the
+// corresponding private key in the OpenSSH PEM format.
+func testSSHKeys(t *testing.T, key any) (string, string) {
+	t.Helper()
+	block, err := ssh.MarshalPrivateKey(key, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := ssh.NewSignerFromKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(ssh.MarshalAuthorizedKey(signer.PublicKey())), string(pem.EncodeToMemory(block))
+}
+
+func TestAge_multipleRecipients(t *testing.T) {
+	encryptor := buildTestMethod(t, []string{testRecipient1, testRecipient2}, nil)
+	encrypted, err := encryptor.Encrypt([]byte("Hello world!"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, id := range []string{testIdentity1, testIdentity2} {
+		decrypted, err := buildTestMethod(t, []string{testRecipient1}, []string{id}).Decrypt(encrypted)
+		if err != nil {
+			t.Fatalf("failed to decrypt with %s: %v", id[:20], err)
+		}
+		if string(decrypted) != "Hello world!" {
+			t.Fatalf("incorrect decrypted data: %q", decrypted)
+		}
+	}
+}
+
+// TestAge_ageCLI checks that data encrypted by the age command line tool can be
+// decrypted. The test file was created with:
+//
+//	age -r age1ffhkdjzha5k673n8cyj7jffdfhtyr4gx88y80dxku4ywwz3fjdds2yqxt9 \
+//	    -R testdata/id_ed25519.pub -o testdata/hello.txt.age
+func TestAge_ageCLI(t *testing.T) {
+	encrypted, err := os.ReadFile(filepath.Join("testdata", "hello.txt.age"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sshIdentity, err := os.ReadFile(filepath.Join("testdata", "id_ed25519"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, id := range map[string]string{"X25519": testIdentity1, "ssh-ed25519": string(sshIdentity)} {
+		t.Run(name, func(t *testing.T) {
+			decrypted, err := buildTestMethod(t, []string{testRecipient1}, []string{id}).Decrypt(encrypted)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(decrypted) != "Hello from the age command line tool!\n" {
+				t.Fatalf("incorrect decrypted data: %q", decrypted)
+			}
+		})
+	}
+}
+
+func TestAge_wrongIdentity(t *testing.T) {
+	encrypted, err := buildTestMethod(t, []string{testRecipient1}, nil).Encrypt([]byte("Hello world!"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = buildTestMethod(t, []string{testRecipient1}, []string{testIdentity2}).Decrypt(encrypted)
+	var decryptionFailed *method.ErrDecryptionFailed
+	if !errors.As(err, &decryptionFailed) {
+		t.Fatalf("expected %T, got %T (%v)", decryptionFailed, err, err)
+	}
+	if !strings.Contains(err.Error(), "none of the configured identities match") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestAge_sshRecipients(t *testing.T) {
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	edPublic, edPrivate := testSSHKeys(t, edKey)
+	rsaPublic, rsaPrivate := testSSHKeys(t, rsaKey)
+
+	encrypted, err := buildTestMethod(t, []string{edPublic, rsaPublic, testRecipient1}, nil).Encrypt([]byte("Hello world!"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(encrypted, []byte("\n-> ssh-ed25519 ")) || !bytes.Contains(encrypted, []byte("\n-> ssh-rsa ")) {
+		t.Fatalf("missing SSH recipient stanzas in header:\n%s", encrypted)
+	}
+
+	for name, id := range map[string]string{"ssh-ed25519": edPrivate, "ssh-rsa": rsaPrivate} {
+		t.Run(name, func(t *testing.T) {
+			decrypted, err := buildTestMethod(t, []string{testRecipient1}, []string{id}).Decrypt(encrypted)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(decrypted) != "Hello world!" {
+				t.Fatalf("incorrect decrypted data: %q", decrypted)
+			}
+		})
+	}
+}
+
+func TestAge_shortRSAKey(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaPublic, _ := testSSHKeys(t, rsaKey)
+	_, err = (&Config{Recipients: []string{rsaPublic}}).Build()
+	if err == nil || !strings.Contains(err.Error(), "RSA key size is too small") {
+		t.Fatalf("expected error about the key length, got: %v", err)
+	}
+}
+
+// payloadChunkSize is the size of the chunks that the age format splits the
+// payload into.
+const payloadChunkSize = 64 * 1024
+
+func TestAge_payloadSizes(t *testing.T) {
+	m := buildTestMethod(t, []string{testRecipient1}, []string{testIdentity1})
+	for _, size := range []int{0, 1, payloadChunkSize - 1, payloadChunkSize, payloadChunkSize + 1, 3 * payloadChunkSize} {
+		data := make([]byte, size)
+		if _, err := rand.Read(data); err != nil {
+			t.Fatal(err)
+		}
+		encrypted, err := m.Encrypt(data)
+		if err != nil {
+			t.Fatalf("size %d: %v", size, err)
+		}
+		decrypted, err := m.Decrypt(encrypted)
+		if err != nil {
+			t.Fatalf("size %d: %v", size, err)
+		}
+		if !bytes.Equal(decrypted, data) {
+			t.Fatalf("size %d: incorrect decrypted data", size)
+		}
+	}
+}
+
+func TestAge_tampering(t *testing.T) {
+	m := buildTestMethod(t, []string{testRecipient1}, []string{testIdentity1})
+	encrypted, err := m.Encrypt(bytes.Repeat([]byte("a"), payloadChunkSize+10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	headerEnd := bytes.Index(encrypted, []byte("\n---")) + 1
+
+	tests := map[string]func([]byte) []byte{
+		"header": func(b []byte) []byte {
+			// Append a recipient stanza that isn't covered by the MAC.
+			b64 := base64.RawStdEncoding
+			extra := "-> X25519 " + b64.EncodeToString(make([]byte, 32)) + "\n" + b64.EncodeToString(make([]byte, 32)) + "\n"
+			return append(append(append([]byte{}, b[:headerEnd]...), extra...), b[headerEnd:]...)
+		},
+		"payload": func(b []byte) []byte {
+			b[len(b)-1] ^= 1
+			return b
+		},
+		"truncated": func(b []byte) []byte {
+			// Drop the last chunk, so the remaining chunk isn't marked final.
+			return b[:len(b)-26]
+		},
+	}
+	for name, tamper := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := m.Decrypt(tamper(append([]byte{}, encrypted...)))
+			var decryptionFailed *method.ErrDecryptionFailed
+			if !errors.As(err, &decryptionFailed) {
+				t.Fatalf("expected %T, got %T (%v)", decryptionFailed, err, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package age
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/opentofu/opentofu/internal/encryption/method/compliancetest"
+)
+
+const (
+	testIdentity1  = "AGE-SECRET-KEY-1JK7Y2P5KE9W623H674UVCU8JYXGNELHPLRYYWR9DSGZ9JU50NJSSR3X0GP"
+	testRecipient1 = "age1ffhkdjzha5k673n8cyj7jffdfhtyr4gx88y80dxku4ywwz3fjdds2yqxt9"
+	testIdentity2  = "AGE-SECRET-KEY-1V8KJ0JFGX0ZLW83MP28K5CURA4TKKGZDEK3LU5QV46DFTS2YKXWQQ0JA68"
+	testRecipient2 = "age155azy5u4mzfxp07errdwpq9zae6l64tswmgytwf6tlqdfl6zt4wq2z28r3"
+)
+
+func TestCompliance(t *testing.T) {
+	compliancetest.ComplianceTest(t, compliancetest.TestConfiguration[*descriptor, *Config, *age]{
+		Descriptor: New().(*descriptor),
+		HCLParseTestCases: map[string]compliancetest.HCLParseTestCase[*descriptor, *Config, *age]{
+			"empty": {
+				HCL:        `method "age" "foo" {}`,
+				ValidHCL:   false,
+				ValidBuild: false,
+			},
+			"invalid-recipients-type": {
+				HCL: `method "age" "foo" {
+						recipients = "` + testRecipient1 + `"
+					}`,
+				ValidHCL:   false,
+				ValidBuild: false,
+			},
+			"no-recipients": {
+				HCL: `method "age" "foo" {
+						recipients = []
+					}`,
+				ValidHCL:   true,
+				ValidBuild: false,
+			},
+			"invalid-recipient": {
+				HCL: `method "age" "foo" {
+						recipients = ["age1invalid"]
+					}`,
+				ValidHCL:   true,
+				ValidBuild: false,
+			},
+			"invalid-identity": {
+				HCL: `method "age" "foo" {
+						recipients = ["` + testRecipient1 + `"]
+						identities = ["AGE-SECRET-KEY-1INVALID"]
+					}`,
+				ValidHCL:   true,
+				ValidBuild: false,
+			},
+			"only-recipients": {
+				HCL: `method "age" "foo" {
+						recipients = ["` + testRecipient1 + `", "` + testRecipient2 + `"]
+					}`,
+				ValidHCL:   true,
+				ValidBuild: true,
+				Validate: func(config *Config, method *age) error {
+					if len(config.Recipients) != 2 {
+						return fmt.Errorf("incorrect number of recipients found after HCL parsing in config: %d", len(config.Recipients))
+					}
+					if len(method.recipients) != 2 {
+						return fmt.Errorf("incorrect number of recipients in method after Build(): %d", len(method.recipients))
+					}
+					if len(method.identities) != 0 {
+						return fmt.Errorf("identities found in method despite no identities being provided")
+					}
+					return nil
+				},
+			},
+			"recipients-and-identities": {
+				HCL: `method "age" "foo" {
+						recipients = ["` + testRecipient1 + `"]
+						identities = ["# comment\n` + testIdentity1 + `\n` + testIdentity2 + `\n"]
+					}`,
+				ValidHCL:   true,
+				ValidBuild: true,
+				Validate: func(config *Config, method *age) error {
+					if len(config.Identities) != 1 {
+						return fmt.Errorf("incorrect number of identities found after HCL parsing in config: %d", len(config.Identities))
+					}
+					if len(method.identities) != 2 {
+						return fmt.Errorf("incorrect number of identities in method after Build(): %d", len(method.identities))
+					}
+					return nil
+				},
+			},
+		},
+		EncryptDecryptTestCase: compliancetest.EncryptDecryptTestCase[*Config, *age]{
+			ValidEncryptOnlyConfig: &Config{
+				Recipients: []string{testRecipient1, testRecipient2},
+			},
+			ValidFullConfig: &Config{
+				Recipients: []string{testRecipient1},
+				Identities: []string{testIdentity2},
+			},
+		},
+	})
+}
@@ -0,0 +1,57 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package age
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/opentofu/opentofu/internal/encryption/method"
+)
+
+// Config is the configuration for the age method.
+type Config struct {
+	// Recipients are the public keys to encrypt for. Each recipient is either a native age public key
+	// ("age1...") or an SSH public key in the authorized_keys format ("ssh-ed25519 ..." or "ssh-rsa ...").
+	// Anyone holding the private key of any recipient can decrypt the data.
+	Recipients []string
+
+	// Identities are the private keys to decrypt with. Each item is either the contents of an age identity
+	// file, which can contain several native age secret keys ("AGE-SECRET-KEY-1..."), or an unencrypted SSH
+	// private key. Without identities, the method can only encrypt.
+	Identities []string
+}
+
+// Build checks the validity of the configuration and returns a ready-to-use age implementation.
+func (c *Config) Build() (method.Method, error) {
+	if len(c.Recipients) == 0 {
+		return nil, &method.ErrInvalidConfiguration{
+			Cause: errors.New("the age method requires at least one recipient"),
+		}
+	}
+
+	ret := &age{}
+	for i, raw := range c.Recipients {
+		r, err := parseRecipient(raw)
+		if err != nil {
+			return nil, &method.ErrInvalidConfiguration{
+				Cause: fmt.Errorf("invalid recipient at index %d: %w", i, err),
+			}
+		}
+		ret.recipients = append(ret.recipients, r)
+	}
+	for i, raw := range c.Identities {
+		// The identities are secret, so we don't include them in the errors.
+		ids, err := parseIdentities(raw)
+		if err != nil {
+			return nil, &method.ErrInvalidConfiguration{
+				Cause: fmt.Errorf("invalid identity at index %d: %w", i, err),
+			}
+		}
+		ret.identities = append(ret.identities, ids...)
+	}
+	return ret, nil
+}
@@ -0,0 +1,66 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package age
+
+import (
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/gohcl"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/opentofu/opentofu/internal/encryption/method"
+)
+
+// New creates a new descriptor for the age encryption method, which encrypts for a list of age or SSH public keys.
+func New() method.Descriptor {
+	return &descriptor{}
+}
+
+type descriptor struct {
+}
+
+func (f *descriptor) ID() method.ID {
+	return "age"
+}
+
+func (f *descriptor) DecodeConfig(methodCtx method.EvalContext, body hcl.Body) (method.Config, hcl.Diagnostics) {
+	var diags hcl.Diagnostics
+	methodCfg := &Config{}
+
+	content, contentDiags := body.Content(&hcl.BodySchema{
+		Attributes: []hcl.AttributeSchema{
+			{Name: "recipients", Required: true},
+			{Name: "identities", Required: false},
+		},
+	})
+	diags = diags.Extend(contentDiags)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	recipientsAttr := content.Attributes["recipients"]
+	recipientsVal, valueDiags := methodCtx.ValueForExpression(recipientsAttr.Expr)
+	diags = diags.Extend(valueDiags)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	decodeDiags := gohcl.DecodeExpression(&hclsyntax.LiteralValueExpr{Val: recipientsVal, SrcRange: recipientsAttr.Expr.Range()}, nil, &methodCfg.Recipients)
+	diags = diags.Extend(decodeDiags)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	if attr, ok := content.Attributes["identities"]; ok {
+		attrVal, attrDiags := methodCtx.ValueForExpression(attr.Expr)
+		diags = diags.Extend(attrDiags)
+		if diags.HasErrors() {
+			return nil, diags
+		}
+
+		decodeDiags := gohcl.DecodeExpression(&hclsyntax.LiteralValueExpr{Val: attrVal, SrcRange: attr.Expr.Range()}, nil, &methodCfg.Identities)
+		diags = diags.Extend(decodeDiags)
+	}
+
+	return methodCfg, diags
+}
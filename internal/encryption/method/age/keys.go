@@ -0,0 +1,56 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package age
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	agelib "filippo.io/age"
+	"filippo.io/age/agessh"
+	"golang.org/x/crypto/ssh"
+)
+
+// parseRecipient parses a recipient in either the native age format
+// ("age1...") or the format used by SSH authorized_keys files
+// ("ssh-ed25519 AAAA..." or "ssh-rsa AAAA...").
+func parseRecipient(raw string) (agelib.Recipient, error) {
+	raw = strings.TrimSpace(raw)
+	switch {
+	case strings.HasPrefix(raw, "age1"):
+		return agelib.ParseX25519Recipient(raw)
+	case strings.HasPrefix(raw, "ssh-"):
+		return agessh.ParseRecipient(raw)
+	default:
+		return nil, errors.New("unknown recipient type, expected an age public key (age1...) or an SSH public key (ssh-ed25519 or ssh-rsa)")
+	}
+}
+
+// parseIdentities parses the contents of either an age identity file, which
+// contains one native identity ("AGE-SECRET-KEY-1...") per line and can
+// contain comments, or an unencrypted SSH private key.
+func parseIdentities(raw string) ([]agelib.Identity, error) {
+	if strings.Contains(raw, "-----BEGIN") {
+		id, err := agessh.ParseIdentity([]byte(raw))
+		if err != nil {
+			var missing *ssh.PassphraseMissingError
+			if errors.As(err, &missing) {
+				return nil, errors.New("SSH private keys protected by a passphrase are not supported")
+			}
+			return nil, fmt.Errorf("malformed SSH private key: %w", err)
+		}
+		return []agelib.Identity{id}, nil
+	}
+
+	// The age parser rejects lines with surrounding whitespace, which is
+	// common in values coming from heredocs and environment variables.
+	lines := strings.Split(raw, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
+	}
+	return agelib.ParseIdentities(strings.NewReader(strings.Join(lines, "\n")))
+}
@@ -53,15 +53,48 @@ type StateEncryption interface {
 	// output to any additional functions that require a valid state file as it may not contain the fields typically
 	// present in a state file.
 	EncryptState([]byte) ([]byte, error)
+
+	// ForWorkspace returns a StateEncryption for the state of the given workspace.
+	//
+	// When using this function:
+	//
+	// Backends must call this function with the workspace name when creating the state manager for a workspace. If the
+	// user enabled workspace binding, the returned StateEncryption records the workspace name in the encrypted state
+	// and refuses to decrypt states that were encrypted for a different workspace.
+	ForWorkspace(workspace string) StateEncryption
+}
+
+// workspaceBinding describes how encrypted states are bound to the workspace they belong to.
+type workspaceBinding struct {
+	// enabled records the workspace in newly encrypted states and requires it when decrypting.
+	enabled bool
+	// allowUnbound accepts encrypted states without a workspace while enabled is set, so they can be migrated.
+	allowUnbound bool
+	// workspace is the name of the workspace the state belongs to, or empty if it is not known.
+	workspace string
 }
 
 type stateEncryption struct {
-	base *baseEncryption
+	base    *baseEncryption
+	binding workspaceBinding
 }
 
-func newStateEncryption(ctx context.Context, enc *encryption, target *config.TargetConfig, enforced bool, name string, staticEval *configs.StaticEvaluator) (StateEncryption, hcl.Diagnostics) {
+func newStateEncryption(ctx context.Context, enc *encryption, target *config.TargetConfig, enforced bool, binding workspaceBinding, name string, staticEval *configs.StaticEvaluator) (StateEncryption, hcl.Diagnostics) {
 	base, diags := newBaseEncryption(ctx, enc, target, enforced, name, staticEval)
-	return &stateEncryption{base}, diags
+	return &stateEncryption{base, binding}, diags
+}
+
+func (s *stateEncryption) ForWorkspace(workspace string) StateEncryption {
+	binding := s.binding
+	binding.workspace = workspace
+	return &stateEncryption{s.base, binding}
+}
+
+// boundState is the plaintext that is encrypted instead of the state itself when the state is bound to a workspace.
+// Since it is encrypted together with the state, the workspace name can't be altered without the encryption key.
+type boundState struct {
+	Workspace *string         `json:"encryption_workspace"`
+	State     json.RawMessage `json:"state"`
 }
 
 type statedata struct {
@@ -76,7 +109,18 @@ func (s *stateEncryption) EncryptState(plainState []byte) ([]byte, error) {
 		return nil, err
 	}
 
-	return s.base.encrypt(plainState, func(base basedata) interface{} {
+	payload := plainState
+	if s.binding.enabled && s.binding.workspace != "" {
+		payload, err = json.Marshal(boundState{
+			Workspace: &s.binding.workspace,
+			State:     plainState,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return s.base.encrypt(payload, func(base basedata) interface{} {
 		// Merge together the base encryption data and the passthrough fields
 		return struct {
 			statedata
@@ -109,6 +153,11 @@ func (s *stateEncryption) DecryptState(encryptedState []byte) ([]byte, Encryptio
 		return nil, status, err
 	}
 
+	decryptedState, status, err = s.checkWorkspace(encryptedState, decryptedState, status)
+	if err != nil {
+		return nil, status, err
+	}
+
 	// Make sure that the state passthrough fields match
 	var encrypted statedata
 	err = json.Unmarshal(encryptedState, &encrypted)
@@ -134,6 +183,32 @@ func (s *stateEncryption) DecryptState(encryptedState []byte) ([]byte, Encryptio
 	return decryptedState, status, nil
 }
 
+// checkWorkspace verifies that the decrypted payload belongs to the configured workspace and returns the state
+// contained in it.
+func (s *stateEncryption) checkWorkspace(encryptedState []byte, decryptedState []byte, status EncryptionStatus) ([]byte, EncryptionStatus, error) {
+	var bound boundState
+	if err := json.Unmarshal(decryptedState, &bound); err != nil || bound.Workspace == nil {
+		// The payload is a plain state, which is always the case for unencrypted states.
+		if encrypted, _ := IsEncryptionPayload(encryptedState); !encrypted || !s.binding.enabled || s.binding.workspace == "" {
+			return decryptedState, status, nil
+		}
+		if !s.binding.allowUnbound {
+			return nil, StatusUnknown, fmt.Errorf("the encrypted state is not bound to a workspace, but workspace binding is enabled; set allow_unbound_workspace = true in the state encryption configuration to migrate existing states to workspace %q", s.binding.workspace)
+		}
+		// Write the state again, so it gets bound to the workspace.
+		return decryptedState, StatusMigration, nil
+	}
+
+	if s.binding.workspace != "" && *bound.Workspace != s.binding.workspace {
+		return nil, StatusUnknown, fmt.Errorf("the state was encrypted for workspace %q and can't be used in workspace %q", *bound.Workspace, s.binding.workspace)
+	}
+	if !s.binding.enabled && status == StatusSatisfied {
+		// Workspace binding has been disabled, so write the state again without it.
+		status = StatusMigration
+	}
+	return bound.State, status, nil
+}
+
 func StateEncryptionDisabled() StateEncryption {
 	return &stateDisabled{}
 }
@@ -146,3 +221,6 @@ func (s *stateDisabled) EncryptState(plainState []byte) ([]byte, error) {
 func (s *stateDisabled) DecryptState(encryptedState []byte) ([]byte, EncryptionStatus, error) {
 	return encryptedState, StatusSatisfied, nil
 }
+func (s *stateDisabled) ForWorkspace(_ string) StateEncryption {
+	return s
+}
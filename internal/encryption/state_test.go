@@ -0,0 +1,141 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package encryption
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/opentofu/opentofu/internal/configs"
+	"github.com/opentofu/opentofu/internal/encryption/config"
+	"github.com/opentofu/opentofu/internal/encryption/keyprovider/pbkdf2"
+	"github.com/opentofu/opentofu/internal/encryption/method/aesgcm"
+	"github.com/opentofu/opentofu/internal/encryption/registry/lockingencryptionregistry"
+)
+
+func setupWorkspaceBindingTest(t *testing.T, stateOptions string) StateEncryption {
+	t.Helper()
+
+	reg := lockingencryptionregistry.New()
+	if err := reg.RegisterKeyProvider(pbkdf2.New()); err != nil {
+		t.Fatal(err)
+	}
+	if err := reg.RegisterMethod(aesgcm.New()); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, diags := config.LoadConfigFromString("test", `key_provider "pbkdf2" "basic" {
+			passphrase = "Hello world! 123"
+		}
+		method "aes_gcm" "example" {
+			keys = key_provider.pbkdf2.basic
+		}
+		state {
+			method = method.aes_gcm.example
+			`+stateOptions+`
+		}`)
+	if diags.HasErrors() {
+		t.Fatalf("%v", diags.Error())
+	}
+
+	staticEval := configs.NewStaticEvaluator(nil, configs.RootModuleCallForTesting())
+	enc, diags := New(t.Context(), reg, cfg, staticEval)
+	if diags.HasErrors() {
+		t.Fatalf("%v", diags.Error())
+	}
+	return enc.State()
+}
+
+func TestStateWorkspaceBinding(t *testing.T) {
+	testData := []byte(`{"serial":42,"lineage":"magic"}`)
+
+	bound := setupWorkspaceBindingTest(t, "bind_workspace = true")
+	unbound := setupWorkspaceBindingTest(t, "")
+	migrating := setupWorkspaceBindingTest(t, "bind_workspace = true\nallow_unbound_workspace = true")
+
+	encryptedBound, err := bound.ForWorkspace("prod").EncryptState(testData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encryptedUnbound, err := unbound.ForWorkspace("prod").EncryptState(testData)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		enc        StateEncryption
+		input      []byte
+		wantStatus EncryptionStatus
+		wantErr    string
+	}{
+		"same workspace": {
+			enc:        bound.ForWorkspace("prod"),
+			input:      encryptedBound,
+			wantStatus: StatusSatisfied,
+		},
+		"other workspace": {
+			enc:     bound.ForWorkspace("dev"),
+			input:   encryptedBound,
+			wantErr: `the state was encrypted for workspace "prod" and can't be used in workspace "dev"`,
+		},
+		"binding disabled, other workspace": {
+			enc:     unbound.ForWorkspace("dev"),
+			input:   encryptedBound,
+			wantErr: `the state was encrypted for workspace "prod" and can't be used in workspace "dev"`,
+		},
+		"binding disabled, same workspace": {
+			enc:        unbound.ForWorkspace("prod"),
+			input:      encryptedBound,
+			wantStatus: StatusMigration,
+		},
+		"unknown workspace": {
+			enc:        bound,
+			input:      encryptedBound,
+			wantStatus: StatusSatisfied,
+		},
+		"unbound state": {
+			enc:     bound.ForWorkspace("prod"),
+			input:   encryptedUnbound,
+			wantErr: "the encrypted state is not bound to a workspace",
+		},
+		"unbound state, migration": {
+			enc:        migrating.ForWorkspace("prod"),
+			input:      encryptedUnbound,
+			wantStatus: StatusMigration,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			decrypted, status, err := test.enc.DecryptState(test.input)
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("expected error containing %q, got: %v", test.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(decrypted) != string(testData) {
+				t.Fatalf("incorrect decrypted state: %s", decrypted)
+			}
+			if status != test.wantStatus {
+				t.Fatalf("expected status %d, got %d", test.wantStatus, status)
+			}
+		})
+	}
+}
+
+func TestStateWorkspaceBinding_planUnsupported(t *testing.T) {
+	_, diags := config.LoadConfigFromString("test", `plan {
+			method        = method.aes_gcm.example
+			bind_workspace = true
+		}`)
+	if !diags.HasErrors() || !strings.Contains(diags.Error(), "Unsupported workspace binding") {
+		t.Fatalf("expected an error about workspace binding in the plan block, got: %v", diags)
+	}
+}
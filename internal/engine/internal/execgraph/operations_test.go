@@ -253,6 +253,11 @@ func (m *managedResourceInstanceMockProvider) CallFunction(context.Context, prov
 	panic("unimplemented")
 }
 
+// ListResource implements providers.Configured.
+func (m *managedResourceInstanceMockProvider) ListResource(context.Context, providers.ListResourceRequest) providers.ListResourceResponse {
+	panic("unimplemented")
+}
+
 // Close implements providers.Configured.
 func (m *managedResourceInstanceMockProvider) Close(context.Context) error {
 	return nil
@@ -9,6 +9,9 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/hcl/v2"
 
 	"github.com/opentofu/opentofu/internal/tfdiags"
 )
@@ -33,6 +36,11 @@ type Change struct {
 	Addr            string
 	ImportID        string
 	GeneratedConfig string
+
+	// ImportDeclRange is the range of the import block that the config was
+	// generated for, if known, which is recorded in the source map written
+	// alongside the generated file.
+	ImportDeclRange *hcl.Range
 }
 
 func (c *Change) MaybeWriteConfig(writer io.Writer, out string) (io.Writer, bool, tfdiags.Diagnostics) {
@@ -57,7 +65,7 @@ func (c *Change) MaybeWriteConfig(writer io.Writer, out string) (io.Writer, bool
 					fmt.Sprintf("OpenTofu could not create the generated file (%s) in the target directory: %v. Depending on the error message, this may be a bug in OpenTofu itself. If so, please report it!", out, err)))
 				return nil, false, diags
 			} else {
-				writer = w
+				writer = newGeneratedFileWriter(w, out)
 			}
 
 			header := "# __generated__ by OpenTofu\n# Please review these resources and move them into your main configuration files.\n"
@@ -71,7 +79,14 @@ func (c *Change) MaybeWriteConfig(writer io.Writer, out string) (io.Writer, bool
 			header += fmt.Sprintf(" from %q", c.ImportID)
 		}
 		header += "\n"
-		if _, err := fmt.Fprintf(writer, "%s%s\n", header, c.GeneratedConfig); err != nil {
+		_, err := io.WriteString(writer, header)
+		if err == nil {
+			err = c.writeBlock(writer)
+		}
+		if err == nil {
+			_, err = io.WriteString(writer, "\n")
+		}
+		if err != nil {
 			diags = diags.Append(tfdiags.Sourceless(
 				tfdiags.Warning,
 				"Failed to save generated config",
@@ -82,3 +97,35 @@ func (c *Change) MaybeWriteConfig(writer io.Writer, out string) (io.Writer, bool
 
 	return writer, wroteConfig, diags
 }
+
+// writeBlock writes the generated config, recording its range in the source
+// map if the writer is tracking one.
+func (c *Change) writeBlock(writer io.Writer) error {
+	w, ok := writer.(*generatedFileWriter)
+	if !ok {
+		_, err := io.WriteString(writer, c.GeneratedConfig)
+		return err
+	}
+
+	start := w.pos
+	if _, err := io.WriteString(w, c.GeneratedConfig); err != nil {
+		return err
+	}
+	block := SourceMapBlock{
+		Address: c.Addr,
+		Range: SourceMapRange{
+			Filename: filepath.Base(w.filename),
+			Start:    start,
+			End:      w.pos,
+		},
+		Origin: SourceMapOrigin{
+			Kind:     SourceMapOriginImport,
+			ImportID: c.ImportID,
+		},
+	}
+	if c.ImportDeclRange != nil {
+		block.Origin.DeclRange = NewSourceMapRange(*c.ImportDeclRange)
+	}
+	w.sourceMap.Blocks = append(w.sourceMap.Blocks, block)
+	return nil
+}
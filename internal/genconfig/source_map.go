@@ -0,0 +1,297 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package genconfig
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/hashicorp/hcl/v2"
+
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// SourceMapFormatVersion is the version of the format of the source map
+// files written alongside generated configuration files.
+const SourceMapFormatVersion = "1.0"
+
+// SourceMapPath returns the path of the source map for the generated
+// configuration file at the given path.
+//
+// The source map uses a suffix that OpenTofu doesn't load as configuration,
+// so it can stay in the same directory as the generated file.
+func SourceMapPath(out string) string {
+	return out + ".map.json"
+}
+
+// SourceMap records where each block in a generated configuration file came
+// from, so that OpenTofu can refer back to the origin of a block when
+// reporting problems with it and so that reviewers can find the request that
+// caused each block to be generated.
+type SourceMap struct {
+	FormatVersion string `json:"format_version"`
+
+	// File is the base name of the generated configuration file.
+	File string `json:"file"`
+
+	// FileSHA256 is the checksum of the generated file as OpenTofu wrote it,
+	// which we use to ignore the source map once the file has been edited
+	// and so the recorded ranges might no longer be correct.
+	FileSHA256 string `json:"file_sha256"`
+
+	Blocks []SourceMapBlock `json:"blocks"`
+}
+
+// SourceMapBlock describes one generated block.
+type SourceMapBlock struct {
+	// Address is the address of the resource the block was generated for.
+	Address string `json:"address"`
+
+	// Range is the range of the block in the generated file.
+	Range SourceMapRange `json:"range"`
+
+	Origin SourceMapOrigin `json:"origin"`
+}
+
+// SourceMapOrigin describes the request that caused a block to be generated.
+type SourceMapOrigin struct {
+	// Kind is the kind of request, which is currently always "import".
+	Kind string `json:"kind"`
+
+	// ImportID is the ID of the imported object, for import requests.
+	ImportID string `json:"import_id,omitempty"`
+
+	// DeclRange is the range of the block that made the request, if known.
+	DeclRange *SourceMapRange `json:"decl_range,omitempty"`
+}
+
+// SourceMapOriginImport is the SourceMapOrigin kind of a block generated
+// for an import block.
+const SourceMapOriginImport = "import"
+
+// SourceMapRange is a range in a configuration file.
+type SourceMapRange struct {
+	Filename string       `json:"filename"`
+	Start    SourceMapPos `json:"start"`
+	End      SourceMapPos `json:"end"`
+}
+
+// SourceMapPos is a position in a configuration file. Line and Column are
+// 1-based, while Byte is a 0-based offset.
+type SourceMapPos struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+	Byte   int `json:"byte"`
+}
+
+// NewSourceMapRange returns the given HCL range as a SourceMapRange.
+func NewSourceMapRange(rng hcl.Range) *SourceMapRange {
+	return &SourceMapRange{
+		Filename: rng.Filename,
+		Start:    SourceMapPos{Line: rng.Start.Line, Column: rng.Start.Column, Byte: rng.Start.Byte},
+		End:      SourceMapPos{Line: rng.End.Line, Column: rng.End.Column, Byte: rng.End.Byte},
+	}
+}
+
+// String returns a compact representation of the range for use in messages,
+// such as "main.tf:3,1-7".
+func (r SourceMapRange) String() string {
+	return hcl.Range{
+		Filename: r.Filename,
+		Start:    hcl.Pos{Line: r.Start.Line, Column: r.Start.Column, Byte: r.Start.Byte},
+		End:      hcl.Pos{Line: r.End.Line, Column: r.End.Column, Byte: r.End.Byte},
+	}.String()
+}
+
+// Describe returns a sentence describing the origin of the block, for adding
+// to diagnostics about the generated block.
+func (b *SourceMapBlock) Describe() string {
+	var ret string
+	if b.Origin.DeclRange != nil {
+		ret = fmt.Sprintf("OpenTofu generated the configuration for %s from the import block at %s", b.Address, b.Origin.DeclRange)
+	} else {
+		ret = fmt.Sprintf("OpenTofu generated the configuration for %s from an import block", b.Address)
+	}
+	if b.Origin.ImportID != "" {
+		ret += fmt.Sprintf(", which imports the object with ID %q", b.Origin.ImportID)
+	}
+	return ret + "."
+}
+
+// BlockAt returns the generated block containing the start of the given
+// range, or nil if there is none.
+func (m *SourceMap) BlockAt(rng hcl.Range) *SourceMapBlock {
+	for i := range m.Blocks {
+		block := &m.Blocks[i]
+		if rng.Start.Byte >= block.Range.Start.Byte && rng.Start.Byte < block.Range.End.Byte {
+			return block
+		}
+	}
+	return nil
+}
+
+// LoadSourceMap reads the source map of the generated configuration file at
+// the given path. It returns nil without an error if there is no source map,
+// or if the generated file has changed since OpenTofu wrote it, since the
+// source map no longer describes the file in that case.
+func LoadSourceMap(generated string) (*SourceMap, error) {
+	src, err := os.ReadFile(SourceMapPath(generated))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var ret SourceMap
+	if err := json.Unmarshal(src, &ret); err != nil {
+		return nil, fmt.Errorf("invalid source map %s: %w", SourceMapPath(generated), err)
+	}
+	if ret.FormatVersion != SourceMapFormatVersion {
+		return nil, nil
+	}
+
+	content, err := os.ReadFile(generated)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(content)
+	if hex.EncodeToString(sum[:]) != ret.FileSHA256 {
+		return nil, nil
+	}
+	return &ret, nil
+}
+
+// SourceMaps finds the generated blocks containing ranges in configuration
+// files, loading the source map of each file at most once.
+type SourceMaps struct {
+	mu   sync.Mutex
+	maps map[string]*SourceMap
+}
+
+// NewSourceMaps returns an empty SourceMaps.
+func NewSourceMaps() *SourceMaps {
+	return &SourceMaps{
+		maps: make(map[string]*SourceMap),
+	}
+}
+
+// BlockAt returns the generated block containing the start of the given
+// range, or nil if the range isn't in a generated block.
+func (s *SourceMaps) BlockAt(rng hcl.Range) *SourceMapBlock {
+	if rng.Filename == "" {
+		return nil
+	}
+
+	s.mu.Lock()
+	m, loaded := s.maps[rng.Filename]
+	if !loaded {
+		// Any problem with a source map means we just can't describe the
+		// origins of the generated blocks, so we don't report it.
+		m, _ = LoadSourceMap(rng.Filename)
+		s.maps[rng.Filename] = m
+	}
+	s.mu.Unlock()
+
+	if m == nil {
+		return nil
+	}
+	return m.BlockAt(rng)
+}
+
+// Describe returns a description of the origin of the generated block
+// containing the start of the given range, or an empty string if the range
+// isn't in a generated block.
+func (s *SourceMaps) Describe(rng hcl.Range) string {
+	block := s.BlockAt(rng)
+	if block == nil {
+		return ""
+	}
+	return block.Describe()
+}
+
+// generatedFileWriter wraps the file that generated configuration is written
+// into, tracking the current position in the file so that we can record the
+// range of each block in the source map.
+type generatedFileWriter struct {
+	w   io.Writer
+	pos SourceMapPos
+	sum hash.Hash
+
+	filename  string
+	sourceMap SourceMap
+}
+
+var _ io.Writer = (*generatedFileWriter)(nil)
+
+func newGeneratedFileWriter(w io.Writer, filename string) *generatedFileWriter {
+	return &generatedFileWriter{
+		w:        w,
+		pos:      SourceMapPos{Line: 1, Column: 1},
+		sum:      sha256.New(),
+		filename: filename,
+		sourceMap: SourceMap{
+			FormatVersion: SourceMapFormatVersion,
+			File:          filepath.Base(filename),
+			Blocks:        []SourceMapBlock{},
+		},
+	}
+}
+
+func (w *generatedFileWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	w.sum.Write(p[:n])
+	for _, line := range bytes.SplitAfter(p[:n], []byte("\n")) {
+		if len(line) == 0 {
+			continue
+		}
+		w.pos.Byte += len(line)
+		if line[len(line)-1] == '\n' {
+			w.pos.Line++
+			w.pos.Column = 1
+		} else {
+			w.pos.Column += utf8.RuneCount(line)
+		}
+	}
+	return n, err
+}
+
+// WriteSourceMap writes the source map for the generated configuration file
+// written through the given writer, as returned by Change.MaybeWriteConfig.
+// It does nothing if the writer is nil because no configuration was
+// generated.
+//
+// A source map is only an aid for reviewing the generated configuration, so
+// failing to write it produces only a warning.
+func WriteSourceMap(writer io.Writer, out string) tfdiags.Diagnostics {
+	var diags tfdiags.Diagnostics
+	w, ok := writer.(*generatedFileWriter)
+	if !ok {
+		return diags
+	}
+	w.sourceMap.FileSHA256 = hex.EncodeToString(w.sum.Sum(nil))
+	src, err := json.MarshalIndent(w.sourceMap, "", "  ")
+	if err == nil {
+		err = os.WriteFile(SourceMapPath(out), append(src, '\n'), 0644)
+	}
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Warning,
+			"Failed to write source map for generated config",
+			fmt.Sprintf("OpenTofu could not write the source map for the generated config to %s: %s. The generated config is still valid, but OpenTofu can't refer to the import blocks the config was generated from.", SourceMapPath(out), err)))
+	}
+	return diags
+}
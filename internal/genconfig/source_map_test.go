@@ -0,0 +1,127 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package genconfig
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+func TestSourceMap(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "generated.tf")
+	changes := []Change{
+		{
+			Addr:            "test_instance.a",
+			ImportID:        "i-a",
+			GeneratedConfig: "resource \"test_instance\" \"a\" {\n  ami = \"bar\"\n}\n",
+			ImportDeclRange: &hcl.Range{
+				Filename: "main.tf",
+				Start:    hcl.Pos{Line: 1, Column: 1, Byte: 0},
+				End:      hcl.Pos{Line: 1, Column: 7, Byte: 6},
+			},
+		},
+		{
+			Addr: "test_instance.none",
+		},
+		{
+			Addr:            "test_instance.b",
+			ImportID:        "i-b",
+			GeneratedConfig: "resource \"test_instance\" \"b\" {\n  ami = \"baz\"\n}\n",
+		},
+	}
+
+	var writer io.Writer
+	for _, change := range changes {
+		w, _, diags := change.MaybeWriteConfig(writer, out)
+		if diags.HasErrors() {
+			t.Fatal(diags.Err())
+		}
+		writer = w
+	}
+	if diags := WriteSourceMap(writer, out); diags.HasErrors() {
+		t.Fatal(diags.Err())
+	}
+
+	sourceMap, err := LoadSourceMap(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sourceMap == nil {
+		t.Fatal("no source map")
+	}
+	if got, want := len(sourceMap.Blocks), 2; got != want {
+		t.Fatalf("wrong number of blocks %d; want %d", got, want)
+	}
+
+	// The recorded ranges must match the ranges that HCL finds when parsing
+	// the generated file.
+	src, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file, hclDiags := hclsyntax.ParseConfig(src, "generated.tf", hcl.InitialPos)
+	if hclDiags.HasErrors() {
+		t.Fatal(hclDiags.Error())
+	}
+	blocks := file.Body.(*hclsyntax.Body).Blocks
+	for i, block := range blocks {
+		rng := block.Range()
+		got := sourceMap.BlockAt(rng)
+		if got == nil {
+			t.Fatalf("no source map block for block %d at %s", i, rng)
+		}
+		if got.Address != changes[i*2].Addr {
+			t.Errorf("wrong block for %s: %s", rng, got.Address)
+		}
+		attr := block.Body.Attributes["ami"]
+		if got := sourceMap.BlockAt(attr.SrcRange); got == nil || got.Address != changes[i*2].Addr {
+			t.Errorf("wrong block for attribute in block %d", i)
+		}
+	}
+
+	if got := sourceMap.BlockAt(hcl.Range{Filename: "generated.tf", Start: hcl.InitialPos}); got != nil {
+		t.Errorf("unexpected block for the file header: %s", got.Address)
+	}
+
+	want := []string{
+		`OpenTofu generated the configuration for test_instance.a from the import block at main.tf:1,1-7, which imports the object with ID "i-a".`,
+		`OpenTofu generated the configuration for test_instance.b from an import block, which imports the object with ID "i-b".`,
+	}
+	var got []string
+	for _, block := range sourceMap.Blocks {
+		got = append(got, block.Describe())
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("wrong descriptions\n%s", diff)
+	}
+
+	// Once the generated file has been edited, the source map no longer
+	// applies.
+	if err := os.WriteFile(out, append([]byte("# edited\n"), src...), 0644); err != nil {
+		t.Fatal(err)
+	}
+	sourceMap, err = LoadSourceMap(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sourceMap != nil {
+		t.Error("source map loaded for an edited file")
+	}
+}
+
+func TestSourceMaps_noSourceMap(t *testing.T) {
+	maps := NewSourceMaps()
+	rng := hcl.Range{Filename: filepath.Join(t.TempDir(), "main.tf")}
+	if got := maps.Describe(rng); got != "" {
+		t.Errorf("unexpected description %q", got)
+	}
+}
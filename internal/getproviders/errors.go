@@ -176,6 +176,24 @@ func (err ErrProtocolNotSupported) Error() string {
 	)
 }
 
+// ErrNoMatchingVersions is an error type used to indicate that a provider
+// has available versions, but none of them meet the version constraints.
+//
+// Available lists all of the versions that were available, so that callers
+// can explain which constraints ruled each of them out.
+type ErrNoMatchingVersions struct {
+	Provider    addrs.Provider
+	Constraints VersionConstraints
+	Available   VersionList
+}
+
+func (err ErrNoMatchingVersions) Error() string {
+	return fmt.Sprintf(
+		"no available releases match the given constraints %s",
+		VersionConstraintsString(err.Constraints),
+	)
+}
+
 // ErrQueryFailed is an error type used to indicate that the hostname given
 // in a provider address does appear to be a provider registry but that when
 // we queried it for metadata for the given provider the server returned an
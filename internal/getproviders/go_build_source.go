@@ -0,0 +1,181 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package getproviders
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+)
+
+// GoBuildSource is a source that builds a single provider from a local
+// checkout of its Go source code, for use when developing a provider
+// alongside the configurations that use it.
+//
+// The source offers only the version number given when it was created, and
+// builds the provider on demand when asked for a package. The result of each
+// build is kept in a cache directory under the commit that the checkout was
+// at, so that the provider is only rebuilt when the source code changes. A
+// checkout with uncommitted changes is rebuilt every time.
+type GoBuildSource struct {
+	provider addrs.Provider
+	srcDir   string
+	version  Version
+	cacheDir string
+
+	// build is the function that builds the provider in srcDir into the
+	// executable file outFile for the given target platform. This is
+	// goBuild except in tests.
+	build func(ctx context.Context, srcDir, outFile string, target Platform) error
+
+	lock sync.Mutex
+}
+
+var _ Source = (*GoBuildSource)(nil)
+
+// NewGoBuildSource constructs and returns a new source that builds the given
+// provider from the Go module in srcDir, reporting it as the given version
+// and keeping the built packages in cacheDir.
+func NewGoBuildSource(_ context.Context, provider addrs.Provider, srcDir string, version Version, cacheDir string) *GoBuildSource {
+	return &GoBuildSource{
+		provider: provider,
+		srcDir:   srcDir,
+		version:  version,
+		cacheDir: cacheDir,
+		build:    goBuild,
+	}
+}
+
+// AvailableVersions returns the configured version of the provider, or no
+// versions at all for any other provider.
+func (s *GoBuildSource) AvailableVersions(_ context.Context, provider addrs.Provider) (VersionList, Warnings, error) {
+	if provider != s.provider {
+		return nil, nil, nil
+	}
+	return VersionList{s.version}, nil, nil
+}
+
+// PackageMeta builds the provider for the given target platform, unless the
+// cache directory already has a build of the current commit of the source
+// checkout, and returns the metadata for the directory containing the
+// executable.
+func (s *GoBuildSource) PackageMeta(ctx context.Context, provider addrs.Provider, version Version, target Platform) (PackageMeta, error) {
+	if provider != s.provider || version != s.version {
+		return PackageMeta{}, ErrProviderNotFound{
+			Provider: provider,
+			Sources:  []string{s.ForDisplay(provider)},
+		}
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	commit, dirty, err := gitCommit(ctx, s.srcDir)
+	if err != nil {
+		return PackageMeta{}, fmt.Errorf("failed to find the commit of the provider source code in %s: %w", s.srcDir, err)
+	}
+
+	pkgDir := filepath.Join(
+		s.cacheDir,
+		filepath.FromSlash(provider.Hostname.ForDisplay()), provider.Namespace, provider.Type,
+		version.String(), commit, target.String(),
+	)
+	exeName := fmt.Sprintf("terraform-provider-%s_v%s", provider.Type, version)
+	if target.OS == "windows" {
+		exeName += ".exe"
+	}
+	exePath := filepath.Join(pkgDir, exeName)
+
+	if _, err := os.Stat(exePath); err == nil && !dirty {
+		log.Printf("[TRACE] getproviders.GoBuildSource: using cached build of %s at %s", provider, pkgDir)
+	} else {
+		log.Printf("[INFO] getproviders.GoBuildSource: building %s %s for %s from %s", provider, version, target, s.srcDir)
+		// We build into an empty directory so that an earlier build of a
+		// checkout with uncommitted changes can't leave other files behind.
+		if err := os.RemoveAll(pkgDir); err != nil {
+			return PackageMeta{}, fmt.Errorf("failed to remove the previous build of %s: %w", provider, err)
+		}
+		if err := os.MkdirAll(pkgDir, 0o755); err != nil {
+			return PackageMeta{}, fmt.Errorf("failed to create build directory for %s: %w", provider, err)
+		}
+		if err := s.build(ctx, s.srcDir, exePath, target); err != nil {
+			// We don't want a partial build to be mistaken for a cached one.
+			_ = os.RemoveAll(pkgDir)
+			return PackageMeta{}, fmt.Errorf("failed to build %s from %s: %w", provider, s.srcDir, err)
+		}
+	}
+
+	return PackageMeta{
+		Provider:       provider,
+		Version:        version,
+		TargetPlatform: target,
+		Filename:       exeName,
+		Location:       PackageLocalDir(pkgDir),
+		// A provider that we just built from local source code can't be
+		// authenticated, but is as trustworthy as the source code itself.
+		Authentication: nil,
+	}, nil
+}
+
+func (s *GoBuildSource) ForDisplay(_ addrs.Provider) string {
+	return fmt.Sprintf("Go source code in %s", s.srcDir)
+}
+
+// gitCommit returns the commit that the git checkout containing the given
+// directory is at, and whether the checkout has any uncommitted changes.
+func gitCommit(ctx context.Context, dir string) (commit string, dirty bool, err error) {
+	out, err := runGit(ctx, dir, "rev-parse", "HEAD")
+	if err != nil {
+		return "", false, err
+	}
+	commit = strings.TrimSpace(out)
+
+	out, err = runGit(ctx, dir, "status", "--porcelain")
+	if err != nil {
+		return "", false, err
+	}
+	return commit, strings.TrimSpace(out) != "", nil
+}
+
+func runGit(ctx context.Context, dir string, args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "git", append([]string{"-C", dir}, args...)...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("git %s: %s", args[0], msg)
+		}
+		return "", fmt.Errorf("git %s: %w", args[0], err)
+	}
+	return stdout.String(), nil
+}
+
+// goBuild builds the Go module in srcDir into the executable file outFile
+// for the given target platform, using the "go" command from the PATH.
+func goBuild(ctx context.Context, srcDir, outFile string, target Platform) error {
+	var output bytes.Buffer
+	cmd := exec.CommandContext(ctx, "go", "build", "-o", outFile, ".")
+	cmd.Dir = srcDir
+	cmd.Env = append(os.Environ(), "GOOS="+target.OS, "GOARCH="+target.Arch, "CGO_ENABLED=0")
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(output.String()); msg != "" {
+			return fmt.Errorf("%w\n%s", err, msg)
+		}
+		return err
+	}
+	return nil
+}
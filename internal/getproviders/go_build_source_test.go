@@ -0,0 +1,166 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package getproviders
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+)
+
+func TestGoBuildSource(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not available")
+	}
+
+	srcDir := t.TempDir()
+	writeFile(t, filepath.Join(srcDir, "main.go"), "package main\n\nfunc main() {}\n")
+	runGitForTest(t, srcDir, "init", "-q")
+	runGitForTest(t, srcDir, "add", ".")
+	runGitForTest(t, srcDir, "commit", "-q", "-m", "initial")
+
+	provider := addrs.MustParseProviderSourceString("example.com/acme/widget")
+	version := MustParseVersion("0.1.0")
+	target := Platform{OS: "linux", Arch: "amd64"}
+	cacheDir := t.TempDir()
+
+	builds := 0
+	source := NewGoBuildSource(context.Background(), provider, srcDir, version, cacheDir)
+	source.build = func(_ context.Context, gotSrcDir, outFile string, gotTarget Platform) error {
+		builds++
+		if gotSrcDir != srcDir || gotTarget != target {
+			t.Errorf("wrong build arguments %s, %s", gotSrcDir, gotTarget)
+		}
+		return os.WriteFile(outFile, []byte("provider"), 0o755)
+	}
+
+	versions, _, err := source.AvailableVersions(context.Background(), provider)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(VersionList{version}, versions); diff != "" {
+		t.Errorf("wrong versions\n%s", diff)
+	}
+	versions, _, err = source.AvailableVersions(context.Background(), addrs.MustParseProviderSourceString("example.com/acme/other"))
+	if err != nil || len(versions) != 0 {
+		t.Errorf("unexpected versions %s for other provider: %v", versions, err)
+	}
+
+	meta, err := source.PackageMeta(context.Background(), provider, version, target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := meta.Filename, "terraform-provider-widget_v0.1.0"; got != want {
+		t.Errorf("wrong filename %q; want %q", got, want)
+	}
+	pkgDir := meta.Location.String()
+	if _, err := os.Stat(filepath.Join(pkgDir, meta.Filename)); err != nil {
+		t.Errorf("built executable is missing: %s", err)
+	}
+	if builds != 1 {
+		t.Errorf("wrong number of builds %d; want 1", builds)
+	}
+
+	// The checkout hasn't changed, so the cached build is used.
+	if _, err := source.PackageMeta(context.Background(), provider, version, target); err != nil {
+		t.Fatal(err)
+	}
+	if builds != 1 {
+		t.Errorf("wrong number of builds %d after unchanged checkout; want 1", builds)
+	}
+
+	// Uncommitted changes are always rebuilt.
+	writeFile(t, filepath.Join(srcDir, "main.go"), "package main\n\nfunc main() { println() }\n")
+	if _, err := source.PackageMeta(context.Background(), provider, version, target); err != nil {
+		t.Fatal(err)
+	}
+	if builds != 2 {
+		t.Errorf("wrong number of builds %d after uncommitted change; want 2", builds)
+	}
+
+	// A new commit is built into a different directory.
+	runGitForTest(t, srcDir, "commit", "-q", "-a", "-m", "change")
+	meta, err = source.PackageMeta(context.Background(), provider, version, target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if builds != 3 {
+		t.Errorf("wrong number of builds %d after new commit; want 3", builds)
+	}
+	if meta.Location.String() == pkgDir {
+		t.Errorf("new commit was built into the same directory %s", pkgDir)
+	}
+
+	if _, err := source.PackageMeta(context.Background(), provider, MustParseVersion("0.2.0"), target); err == nil {
+		t.Error("succeeded for a version other than the configured one; want error")
+	}
+}
+
+func TestGoBuildSource_notGitCheckout(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not available")
+	}
+
+	provider := addrs.MustParseProviderSourceString("example.com/acme/widget")
+	version := MustParseVersion("0.1.0")
+	source := NewGoBuildSource(context.Background(), provider, t.TempDir(), version, t.TempDir())
+	source.build = func(context.Context, string, string, Platform) error {
+		t.Fatal("unexpected build")
+		return nil
+	}
+
+	if _, err := source.PackageMeta(context.Background(), provider, version, CurrentPlatform); err == nil {
+		t.Fatal("succeeded; want error")
+	}
+}
+
+func TestGoBuild(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping a real Go build in short mode")
+	}
+
+	srcDir := t.TempDir()
+	writeFile(t, filepath.Join(srcDir, "go.mod"), "module example.com/acme/terraform-provider-widget\n\ngo 1.20\n")
+	writeFile(t, filepath.Join(srcDir, "main.go"), "package main\n\nfunc main() {}\n")
+	outFile := filepath.Join(t.TempDir(), "terraform-provider-widget")
+
+	if err := goBuild(context.Background(), srcDir, outFile, CurrentPlatform); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(outFile); err != nil {
+		t.Fatalf("built executable is missing: %s", err)
+	}
+
+	writeFile(t, filepath.Join(srcDir, "main.go"), "package main\n\nfunc main() { undefined() }\n")
+	if err := goBuild(context.Background(), srcDir, outFile, CurrentPlatform); err == nil {
+		t.Fatal("succeeded for invalid source code; want error")
+	}
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func runGitForTest(t *testing.T, dir string, args ...string) {
+	t.Helper()
+	cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+	cmd.Env = append(os.Environ(),
+		"GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com",
+		"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com",
+	)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("git %s failed: %s\n%s", args[0], err, out)
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package getproviders
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+)
+
+// InferProviderCandidates asks the given source which providers an
+// unqualified provider type name, such as one implied by a resource type
+// prefix, might refer to.
+//
+// The result contains the default provider for the type name if the source
+// knows of at least one version of it, along with whatever the default
+// registry's legacy provider lookup API reports for the same type name, if
+// the source includes a direct connection to the default registry. If the
+// registry reports that the provider has moved to another namespace then
+// only the new location is returned.
+//
+// The result is sorted and free of duplicates. An empty result means that
+// the source knows of no suitable provider at all, while more than one
+// result means that the type name alone is ambiguous.
+//
+// A non-nil error means that at least one of the queries failed for a
+// reason other than the provider not existing, so the result might be
+// incomplete.
+func InferProviderCandidates(ctx context.Context, typeName string, source Source) ([]addrs.Provider, error) {
+	var errs []error
+	found := make(map[addrs.Provider]struct{})
+
+	defaultAddr := addrs.NewDefaultProvider(typeName)
+	versions, _, err := source.AvailableVersions(ctx, defaultAddr)
+	switch {
+	case err == nil:
+		if len(versions) != 0 {
+			found[defaultAddr] = struct{}{}
+		}
+	case isProviderNotFoundErr(err):
+		// Not a candidate, but not a problem either.
+	default:
+		errs = append(errs, err)
+	}
+
+	if regSource := findLegacyProviderLookupSource(defaultRegistryHost, source); regSource != nil {
+		defaultNS, redirectNS, err := regSource.lookupLegacyProviderNamespace(ctx, defaultRegistryHost, typeName)
+		switch {
+		case err == nil && redirectNS != "":
+			// The provider at the registry's default namespace is retained
+			// only for compatibility with older versions, so it's not
+			// a useful suggestion.
+			delete(found, addrs.Provider{Hostname: defaultRegistryHost, Namespace: defaultNS, Type: typeName})
+			found[addrs.Provider{Hostname: defaultRegistryHost, Namespace: redirectNS, Type: typeName}] = struct{}{}
+		case err == nil:
+			found[addrs.Provider{Hostname: defaultRegistryHost, Namespace: defaultNS, Type: typeName}] = struct{}{}
+		case isProviderNotFoundErr(err):
+			// Not a candidate, but not a problem either.
+		default:
+			errs = append(errs, err)
+		}
+	}
+
+	ret := make([]addrs.Provider, 0, len(found))
+	for addr := range found {
+		ret = append(ret, addr)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].LessThan(ret[j])
+	})
+	return ret, errors.Join(errs...)
+}
+
+func isProviderNotFoundErr(err error) bool {
+	switch err.(type) {
+	case ErrProviderNotFound, ErrRegistryProviderNotKnown:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package getproviders
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+)
+
+func TestInferProviderCandidates(t *testing.T) {
+	t.Run("registry source", func(t *testing.T) {
+		// These rely on the "magic" legacy lookups implemented by the fake
+		// registry source returned by testRegistrySource.
+		source, _, close := testRegistrySource(t)
+		defer close()
+
+		tests := map[string][]addrs.Provider{
+			"legacy": {
+				{Hostname: defaultRegistryHost, Namespace: "legacycorp", Type: "legacy"},
+			},
+			"moved": {
+				{Hostname: defaultRegistryHost, Namespace: "acme", Type: "moved"},
+			},
+			"nonexist": {},
+		}
+		for typeName, want := range tests {
+			t.Run(typeName, func(t *testing.T) {
+				got, err := InferProviderCandidates(context.Background(), typeName, source)
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				if diff := cmp.Diff(want, got); diff != "" {
+					t.Errorf("wrong result\n%s", diff)
+				}
+			})
+		}
+	})
+	t.Run("multiple candidates", func(t *testing.T) {
+		defaultAddr := addrs.NewDefaultProvider("legacy")
+		source := MultiSource{
+			{Source: NewMockSource([]PackageMeta{
+				FakePackageMeta(defaultAddr, MustParseVersion("1.0.0"), VersionList{MustParseVersion("5.0")}, CurrentPlatform),
+			}, nil), Include: mustParseMultiSourceMatchingPatterns("hashicorp/*")},
+		}
+		regSource, _, close := testRegistrySource(t)
+		defer close()
+		source = append(source, MultiSourceSelector{Source: regSource, Exclude: mustParseMultiSourceMatchingPatterns("hashicorp/*")})
+
+		got, err := InferProviderCandidates(context.Background(), "legacy", source)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		want := []addrs.Provider{
+			defaultAddr,
+			{Hostname: defaultRegistryHost, Namespace: "legacycorp", Type: "legacy"},
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("wrong result\n%s", diff)
+		}
+	})
+}
@@ -8,6 +8,7 @@ package grpcwrap
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/opentofu/opentofu/internal/plugin/convert"
 	"github.com/opentofu/opentofu/internal/providers"
@@ -95,7 +96,9 @@ func (p *provider) GetSchema(_ context.Context, req *tfplugin5.GetProviderSchema
 	}
 
 	resp.ServerCapabilities = &tfplugin5.ServerCapabilities{
-		PlanDestroy: p.schema.ServerCapabilities.PlanDestroy,
+		PlanDestroy:   p.schema.ServerCapabilities.PlanDestroy,
+		ApplyProgress: p.schema.ServerCapabilities.ApplyProgress,
+		ChangeHints:   p.schema.ServerCapabilities.ChangeHints,
 	}
 
 	// include any diagnostics from the original GetSchema call
@@ -257,10 +260,11 @@ func (p *provider) ReadResource(ctx context.Context, req *tfplugin5.ReadResource
 	}
 
 	readReq := providers.ReadResourceRequest{
-		TypeName:     req.TypeName,
-		PriorState:   stateVal,
-		Private:      req.Private,
-		ProviderMeta: metaVal,
+		TypeName:        req.TypeName,
+		PriorState:      stateVal,
+		Private:         req.Private,
+		ProviderMeta:    metaVal,
+		PriorChangeHint: req.PriorChangeHint,
 	}
 	if req.CurrentIdentity != nil && req.CurrentIdentity.IdentityData != nil {
 		if identitySchema, ok := p.identitySchemas[req.TypeName]; ok {
@@ -278,6 +282,8 @@ func (p *provider) ReadResource(ctx context.Context, req *tfplugin5.ReadResource
 	if readResp.Diagnostics.HasErrors() {
 		return resp, nil
 	}
+	resp.ChangeHint = readResp.ChangeHint
+	resp.Unchanged = readResp.Unchanged
 	resp.Private = readResp.Private
 
 	dv, err := encodeDynamicValue(readResp.NewState, ty)
@@ -457,6 +463,39 @@ func (p *provider) ApplyResourceChange(ctx context.Context, req *tfplugin5.Apply
 	return resp, nil
 }
 
+func (p *provider) ApplyResourceChangeWithProgress(req *tfplugin5.ApplyResourceChange_Request, srv tfplugin5.Provider_ApplyResourceChangeWithProgressServer) error {
+	// The provider may report progress from several goroutines, but only one
+	// of them can send on the stream at a time.
+	var mu sync.Mutex
+	ctx := providers.ContextWithProgressReporter(srv.Context(), func(progress providers.Progress) {
+		mu.Lock()
+		defer mu.Unlock()
+		// Progress is only informational, so a failure to send it must not
+		// fail the apply. A broken stream will fail the final send below.
+		_ = srv.Send(&tfplugin5.ApplyResourceChangeWithProgress_Event{
+			Type: &tfplugin5.ApplyResourceChangeWithProgress_Event_Progress_{
+				Progress: &tfplugin5.ApplyResourceChangeWithProgress_Event_Progress{
+					Phase:   progress.Phase,
+					Percent: int32(progress.Percent),
+				},
+			},
+		})
+	})
+
+	resp, err := p.ApplyResourceChange(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	return srv.Send(&tfplugin5.ApplyResourceChangeWithProgress_Event{
+		Type: &tfplugin5.ApplyResourceChangeWithProgress_Event_Completed{
+			Completed: resp,
+		},
+	})
+}
+
 func (p *provider) ImportResourceState(ctx context.Context, req *tfplugin5.ImportResourceState_Request) (*tfplugin5.ImportResourceState_Response, error) {
 	resp := &tfplugin5.ImportResourceState_Response{}
 
@@ -8,6 +8,7 @@ package grpcwrap
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/opentofu/opentofu/internal/plugin6/convert"
 	"github.com/opentofu/opentofu/internal/providers"
@@ -79,7 +80,9 @@ func (p *provider6) GetProviderSchema(_ context.Context, req *tfplugin6.GetProvi
 	}
 
 	resp.ServerCapabilities = &tfplugin6.ServerCapabilities{
-		PlanDestroy: p.schema.ServerCapabilities.PlanDestroy,
+		PlanDestroy:   p.schema.ServerCapabilities.PlanDestroy,
+		ApplyProgress: p.schema.ServerCapabilities.ApplyProgress,
+		ChangeHints:   p.schema.ServerCapabilities.ChangeHints,
 	}
 
 	// include any diagnostics from the original GetSchema call
@@ -228,10 +231,11 @@ func (p *provider6) ReadResource(ctx context.Context, req *tfplugin6.ReadResourc
 	}
 
 	readReq := providers.ReadResourceRequest{
-		TypeName:     req.TypeName,
-		PriorState:   stateVal,
-		Private:      req.Private,
-		ProviderMeta: metaVal,
+		TypeName:        req.TypeName,
+		PriorState:      stateVal,
+		Private:         req.Private,
+		ProviderMeta:    metaVal,
+		PriorChangeHint: req.PriorChangeHint,
 	}
 	if req.CurrentIdentity != nil && req.CurrentIdentity.IdentityData != nil {
 		if identitySchema, ok := p.identitySchemas[req.TypeName]; ok {
@@ -249,6 +253,8 @@ func (p *provider6) ReadResource(ctx context.Context, req *tfplugin6.ReadResourc
 	if readResp.Diagnostics.HasErrors() {
 		return resp, nil
 	}
+	resp.ChangeHint = readResp.ChangeHint
+	resp.Unchanged = readResp.Unchanged
 	resp.Private = readResp.Private
 
 	dv, err := encodeDynamicValue6(readResp.NewState, ty)
@@ -428,6 +434,39 @@ func (p *provider6) ApplyResourceChange(ctx context.Context, req *tfplugin6.Appl
 	return resp, nil
 }
 
+func (p *provider6) ApplyResourceChangeWithProgress(req *tfplugin6.ApplyResourceChange_Request, srv tfplugin6.Provider_ApplyResourceChangeWithProgressServer) error {
+	// The provider may report progress from several goroutines, but only one
+	// of them can send on the stream at a time.
+	var mu sync.Mutex
+	ctx := providers.ContextWithProgressReporter(srv.Context(), func(progress providers.Progress) {
+		mu.Lock()
+		defer mu.Unlock()
+		// Progress is only informational, so a failure to send it must not
+		// fail the apply. A broken stream will fail the final send below.
+		_ = srv.Send(&tfplugin6.ApplyResourceChangeWithProgress_Event{
+			Type: &tfplugin6.ApplyResourceChangeWithProgress_Event_Progress_{
+				Progress: &tfplugin6.ApplyResourceChangeWithProgress_Event_Progress{
+					Phase:   progress.Phase,
+					Percent: int32(progress.Percent),
+				},
+			},
+		})
+	})
+
+	resp, err := p.ApplyResourceChange(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	return srv.Send(&tfplugin6.ApplyResourceChangeWithProgress_Event{
+		Type: &tfplugin6.ApplyResourceChangeWithProgress_Event_Completed{
+			Completed: resp,
+		},
+	})
+}
+
 func (p *provider6) ImportResourceState(ctx context.Context, req *tfplugin6.ImportResourceState_Request) (*tfplugin6.ImportResourceState_Response, error) {
 	resp := &tfplugin6.ImportResourceState_Response{}
 
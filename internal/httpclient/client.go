@@ -17,7 +17,8 @@ import (
 )
 
 // New returns the DefaultPooledClient from the cleanhttp
-// package that will also send a OpenTofu User-Agent string.
+// package that will also send a OpenTofu User-Agent string, and that
+// selects proxies as described by [SetProxyConfig].
 //
 // If the given context has an active OpenTelemetry trace span associated with
 // it then the returned client is also configured to collect traces for
@@ -29,6 +30,11 @@ import (
 // place, and does not actually make use of any information from that span.
 func New(ctx context.Context) *http.Client {
 	cli := cleanhttp.DefaultPooledClient()
+	if transport, ok := cli.Transport.(*http.Transport); ok {
+		// Our proxy selection extends the standard proxy environment
+		// variables with the settings from the CLI configuration.
+		transport.Proxy = proxyForRequest
+	}
 	cli.Transport = &userAgentRoundTripper{
 		userAgent: OpenTofuUserAgent(version.Version),
 		inner:     cli.Transport,
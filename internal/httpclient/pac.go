@@ -0,0 +1,1153 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package httpclient
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// PAC is a parsed proxy auto-configuration file, which selects a proxy for
+// each request using a JavaScript function named FindProxyForURL.
+//
+// OpenTofu does not embed a JavaScript engine, so PAC files are limited to
+// the subset of JavaScript that is typical for such files: function and
+// variable declarations, assignments, "if" statements, "return" statements,
+// and expressions using string, number and boolean values, the usual
+// operators, the toLowerCase, toUpperCase, indexOf and substring methods of
+// strings, and the standard PAC helper functions other than those relating to
+// dates and times. Parsing a file that uses anything else returns an error.
+//
+// Functions in a PAC file can call each other recursively, so each
+// evaluation is limited to pacMaxCallDepth nested calls and pacMaxSteps
+// steps, and fails with an error if it exceeds either of them.
+type PAC struct {
+	globals *pacScope
+
+	// lookupIP and myIPAddress are used by the helper functions that deal
+	// with IP addresses, and can be overridden in tests.
+	lookupIP    func(host string) ([]net.IP, error)
+	myIPAddress func() net.IP
+}
+
+// ParsePAC parses the given source code of a proxy auto-configuration file.
+func ParsePAC(src string) (*PAC, error) {
+	toks, err := pacLex(src)
+	if err != nil {
+		return nil, err
+	}
+	pac := &PAC{
+		globals:     &pacScope{vars: make(map[string]any)},
+		lookupIP:    net.LookupIP,
+		myIPAddress: localIPAddress,
+	}
+	p := &pacParser{toks: toks, pac: pac}
+	var init []pacStmt
+	for !p.at(pacTokenEOF, "") {
+		if p.at(pacTokenIdent, "function") {
+			if err := p.functionDecl(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		stmt, err := p.statement()
+		if err != nil {
+			return nil, err
+		}
+		init = append(init, stmt)
+	}
+	if _, ok := pac.globals.vars["FindProxyForURL"].(*pacFunction); !ok {
+		return nil, errors.New("the PAC file does not declare a FindProxyForURL function")
+	}
+	// Top-level statements run once, to initialize any global variables.
+	pac.globals.budget = &pacBudget{}
+	for _, stmt := range init {
+		if _, _, err := stmt(pac.globals); err != nil {
+			return nil, err
+		}
+	}
+	pac.globals.budget = nil
+	return pac, nil
+}
+
+// FindProxyForURL calls the FindProxyForURL function of the PAC file for the
+// given URL, returning the proxies it selected in order of preference. A nil
+// element represents connecting directly, without a proxy.
+func (p *PAC) FindProxyForURL(u *url.URL) ([]*url.URL, error) {
+	// The URL passed to the PAC file never includes credentials.
+	withoutUser := *u
+	withoutUser.User = nil
+
+	fn := p.globals.vars["FindProxyForURL"].(*pacFunction)
+	ret, err := fn.call(&pacBudget{}, []any{withoutUser.String(), u.Hostname()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate FindProxyForURL: %w", err)
+	}
+	result, ok := ret.(string)
+	if !ok {
+		return nil, fmt.Errorf("FindProxyForURL returned %s, but must return a string", pacTypeName(ret))
+	}
+	return parsePACResult(result)
+}
+
+// parsePACResult parses the result of a FindProxyForURL function, which is
+// a semicolon-separated list of entries such as "PROXY proxy.example.com:8080"
+// or "DIRECT".
+func parsePACResult(result string) ([]*url.URL, error) {
+	var ret []*url.URL
+	for entry := range strings.SplitSeq(result, ";") {
+		fields := strings.Fields(entry)
+		if len(fields) == 0 {
+			continue
+		}
+		kind := strings.ToUpper(fields[0])
+		if kind == "DIRECT" && len(fields) == 1 {
+			ret = append(ret, nil)
+			continue
+		}
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("invalid proxy %q in the result of FindProxyForURL", strings.TrimSpace(entry))
+		}
+		var scheme string
+		switch kind {
+		case "PROXY", "HTTP":
+			scheme = "http"
+		case "HTTPS":
+			scheme = "https"
+		case "SOCKS", "SOCKS5":
+			scheme = "socks5"
+		default:
+			return nil, fmt.Errorf("unsupported proxy type %q in the result of FindProxyForURL", fields[0])
+		}
+		ret = append(ret, &url.URL{Scheme: scheme, Host: fields[1]})
+	}
+	if len(ret) == 0 {
+		return nil, fmt.Errorf("FindProxyForURL returned no proxies")
+	}
+	return ret, nil
+}
+
+// localIPAddress returns the IP address of the interface that would be used
+// to reach the internet, or the loopback address if there is none. No
+// packets are sent to determine the address.
+func localIPAddress() net.IP {
+	conn, err := net.Dial("udp4", "192.0.2.1:53") // TEST-NET-1, never routed
+	if err != nil {
+		return net.IPv4(127, 0, 0, 1)
+	}
+	defer conn.Close()
+	return conn.LocalAddr().(*net.UDPAddr).IP
+}
+
+// pacMaxCallDepth and pacMaxSteps limit the work that a single evaluation
+// of a PAC file can do, so that a function that calls itself recursively
+// without end fails instead of exhausting the stack or never returning.
+const (
+	pacMaxCallDepth = 100
+	pacMaxSteps     = 100000
+)
+
+// pacBudget tracks the work done so far by a single evaluation of a PAC
+// file, across all of the functions it calls.
+type pacBudget struct {
+	depth int
+	steps int
+}
+
+// step records that the evaluation did one more unit of work, returning an
+// error if that exceeds pacMaxSteps.
+func (b *pacBudget) step() error {
+	b.steps++
+	if b.steps > pacMaxSteps {
+		return fmt.Errorf("evaluation exceeded the limit of %d steps", pacMaxSteps)
+	}
+	return nil
+}
+
+// pacScope is a set of variables, with an optional parent scope for the
+// variables that aren't declared in this scope.
+type pacScope struct {
+	vars   map[string]any
+	parent *pacScope
+
+	// budget is the budget of the evaluation that this scope belongs to.
+	budget *pacBudget
+}
+
+func (s *pacScope) lookup(name string) (any, bool) {
+	for scope := s; scope != nil; scope = scope.parent {
+		if v, ok := scope.vars[name]; ok {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+func (s *pacScope) assign(name string, v any) {
+	scope := s
+	for ; scope.parent != nil; scope = scope.parent {
+		if _, ok := scope.vars[name]; ok {
+			break
+		}
+	}
+	// As in JavaScript, assigning to an undeclared variable declares a
+	// global variable.
+	scope.vars[name] = v
+}
+
+// pacStmt runs a statement, returning true and the returned value if it
+// executed a return statement.
+type pacStmt func(s *pacScope) (bool, any, error)
+
+// pacExpr evaluates an expression.
+type pacExpr func(s *pacScope) (any, error)
+
+// pacFunction is a function declared in a PAC file.
+type pacFunction struct {
+	pac    *PAC
+	params []string
+	body   []pacStmt
+}
+
+func (f *pacFunction) call(budget *pacBudget, args []any) (any, error) {
+	if budget.depth >= pacMaxCallDepth {
+		return nil, fmt.Errorf("function calls are nested more than %d deep", pacMaxCallDepth)
+	}
+	budget.depth++
+	defer func() { budget.depth-- }()
+
+	scope := &pacScope{vars: make(map[string]any), parent: f.pac.globals, budget: budget}
+	for i, name := range f.params {
+		if i < len(args) {
+			scope.vars[name] = args[i]
+		} else {
+			scope.vars[name] = nil
+		}
+	}
+	for _, stmt := range f.body {
+		if err := budget.step(); err != nil {
+			return nil, err
+		}
+		returned, v, err := stmt(scope)
+		if err != nil || returned {
+			return v, err
+		}
+	}
+	return nil, nil
+}
+
+type pacBuiltin func(p *PAC, args []any) (any, error)
+
+var pacBuiltins = map[string]pacBuiltin{
+	"isPlainHostName": func(_ *PAC, args []any) (any, error) {
+		return !strings.Contains(pacString(pacArg(args, 0)), "."), nil
+	},
+	"dnsDomainIs": func(_ *PAC, args []any) (any, error) {
+		host := strings.ToLower(pacString(pacArg(args, 0)))
+		domain := strings.ToLower(pacString(pacArg(args, 1)))
+		return strings.HasSuffix(host, domain), nil
+	},
+	"localHostOrDomainIs": func(_ *PAC, args []any) (any, error) {
+		host := strings.ToLower(pacString(pacArg(args, 0)))
+		hostdom := strings.ToLower(pacString(pacArg(args, 1)))
+		if host == hostdom {
+			return true, nil
+		}
+		return !strings.Contains(host, ".") && strings.HasPrefix(hostdom, host+"."), nil
+	},
+	"dnsDomainLevels": func(_ *PAC, args []any) (any, error) {
+		return float64(strings.Count(pacString(pacArg(args, 0)), ".")), nil
+	},
+	"shExpMatch": func(_ *PAC, args []any) (any, error) {
+		return shExpMatch(pacString(pacArg(args, 0)), pacString(pacArg(args, 1))), nil
+	},
+	"isResolvable": func(p *PAC, args []any) (any, error) {
+		return p.resolve(pacString(pacArg(args, 0))) != nil, nil
+	},
+	"dnsResolve": func(p *PAC, args []any) (any, error) {
+		if ip := p.resolve(pacString(pacArg(args, 0))); ip != nil {
+			return ip.String(), nil
+		}
+		return nil, nil
+	},
+	"myIpAddress": func(p *PAC, _ []any) (any, error) {
+		return p.myIPAddress().String(), nil
+	},
+	"isInNet": func(p *PAC, args []any) (any, error) {
+		ip := p.resolve(pacString(pacArg(args, 0)))
+		pattern := net.ParseIP(pacString(pacArg(args, 1))).To4()
+		mask := net.ParseIP(pacString(pacArg(args, 2))).To4()
+		if ip == nil || pattern == nil || mask == nil {
+			return false, nil
+		}
+		m := net.IPMask(mask)
+		return ip.Mask(m).Equal(pattern.Mask(m)), nil
+	},
+	"alert": func(_ *PAC, args []any) (any, error) {
+		log.Printf("[DEBUG] PAC file alert: %s", pacString(pacArg(args, 0)))
+		return nil, nil
+	},
+}
+
+// resolve returns the IPv4 address of the given host, which may already be
+// an IP address, or nil if it can't be resolved.
+func (p *PAC) resolve(host string) net.IP {
+	if ip := net.ParseIP(host); ip != nil {
+		return ip.To4()
+	}
+	ips, err := p.lookupIP(host)
+	if err != nil {
+		return nil
+	}
+	for _, ip := range ips {
+		if ip4 := ip.To4(); ip4 != nil {
+			return ip4
+		}
+	}
+	return nil
+}
+
+// shExpMatch reports whether str matches the given shell expression, in
+// which "*" matches any sequence of characters and "?" matches any single
+// character.
+func shExpMatch(str, shexp string) bool {
+	// This matches greedily, going back to the most recent "*" on a
+	// mismatch, which takes time proportional to the product of the lengths
+	// rather than growing exponentially with the number of "*"s.
+	si, pi := 0, 0
+	starPi, starSi := -1, 0
+	for si < len(str) {
+		switch {
+		case pi < len(shexp) && shexp[pi] == '*':
+			starPi, starSi = pi, si
+			pi++
+		case pi < len(shexp) && (shexp[pi] == '?' || shexp[pi] == str[si]):
+			si++
+			pi++
+		case starPi >= 0:
+			starSi++
+			si, pi = starSi, starPi+1
+		default:
+			return false
+		}
+	}
+	for pi < len(shexp) && shexp[pi] == '*' {
+		pi++
+	}
+	return pi == len(shexp)
+}
+
+func pacArg(args []any, i int) any {
+	if i < len(args) {
+		return args[i]
+	}
+	return nil
+}
+
+// pacString converts a value to a string in the same way as JavaScript.
+func pacString(v any) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case nil:
+		return "null"
+	default:
+		return "[function]"
+	}
+}
+
+// pacTruthy reports whether the given value is considered true in a
+// condition, in the same way as JavaScript.
+func pacTruthy(v any) bool {
+	switch v := v.(type) {
+	case string:
+		return v != ""
+	case float64:
+		return v != 0
+	case bool:
+		return v
+	case nil:
+		return false
+	default:
+		return true
+	}
+}
+
+func pacTypeName(v any) string {
+	switch v.(type) {
+	case string:
+		return "a string"
+	case float64:
+		return "a number"
+	case bool:
+		return "a boolean"
+	case nil:
+		return "null"
+	default:
+		return "a function"
+	}
+}
+
+// pacEqual compares values in the same way as the JavaScript "==" operator,
+// for the types supported in PAC files.
+func pacEqual(a, b any) bool {
+	switch a := a.(type) {
+	case float64:
+		if b, ok := b.(string); ok {
+			n, err := strconv.ParseFloat(b, 64)
+			return err == nil && n == a
+		}
+	case string:
+		if b, ok := b.(float64); ok {
+			n, err := strconv.ParseFloat(a, 64)
+			return err == nil && n == b
+		}
+	}
+	return a == b
+}
+
+type pacTokenType int
+
+const (
+	pacTokenEOF pacTokenType = iota
+	pacTokenIdent
+	pacTokenString
+	pacTokenNumber
+	pacTokenPunct
+)
+
+type pacToken struct {
+	typ  pacTokenType
+	text string
+	line int
+}
+
+var pacPuncts = []string{
+	"===", "!==", "==", "!=", "<=", ">=", "&&", "||",
+	"(", ")", "{", "}", ",", ";", "!", "=", "<", ">", "+", "-", "?", ":", ".",
+}
+
+func pacLex(src string) ([]pacToken, error) {
+	var toks []pacToken
+	line := 1
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == '\n':
+			line++
+			i++
+		case c == ' ' || c == '\t' || c == '\r':
+			i++
+		case strings.HasPrefix(src[i:], "//"):
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+		case strings.HasPrefix(src[i:], "/*"):
+			end := strings.Index(src[i+2:], "*/")
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated comment on line %d of the PAC file", line)
+			}
+			line += strings.Count(src[i:i+2+end], "\n")
+			i += end + 4
+		case c == '"' || c == '\'':
+			var buf strings.Builder
+			j := i + 1
+			for ; j < len(src) && src[j] != c; j++ {
+				if src[j] == '\n' {
+					break
+				}
+				if src[j] == '\\' && j+1 < len(src) {
+					j++
+					switch src[j] {
+					case 'n':
+						buf.WriteByte('\n')
+					case 't':
+						buf.WriteByte('\t')
+					default:
+						buf.WriteByte(src[j])
+					}
+					continue
+				}
+				buf.WriteByte(src[j])
+			}
+			if j >= len(src) || src[j] != c {
+				return nil, fmt.Errorf("unterminated string on line %d of the PAC file", line)
+			}
+			toks = append(toks, pacToken{typ: pacTokenString, text: buf.String(), line: line})
+			i = j + 1
+		case c >= '0' && c <= '9':
+			j := i
+			for j < len(src) && (src[j] >= '0' && src[j] <= '9' || src[j] == '.') {
+				j++
+			}
+			toks = append(toks, pacToken{typ: pacTokenNumber, text: src[i:j], line: line})
+			i = j
+		case c == '_' || c == '$' || unicode.IsLetter(rune(c)):
+			j := i
+			for j < len(src) && (src[j] == '_' || src[j] == '$' || unicode.IsLetter(rune(src[j])) || unicode.IsDigit(rune(src[j]))) {
+				j++
+			}
+			toks = append(toks, pacToken{typ: pacTokenIdent, text: src[i:j], line: line})
+			i = j
+		default:
+			matched := false
+			for _, punct := range pacPuncts {
+				if strings.HasPrefix(src[i:], punct) {
+					toks = append(toks, pacToken{typ: pacTokenPunct, text: punct, line: line})
+					i += len(punct)
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, fmt.Errorf("unsupported character %q on line %d of the PAC file", c, line)
+			}
+		}
+	}
+	toks = append(toks, pacToken{typ: pacTokenEOF, line: line})
+	return toks, nil
+}
+
+type pacParser struct {
+	toks []pacToken
+	pos  int
+	pac  *PAC
+}
+
+func (p *pacParser) peek() pacToken {
+	return p.toks[p.pos]
+}
+
+func (p *pacParser) next() pacToken {
+	tok := p.toks[p.pos]
+	if tok.typ != pacTokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+// at reports whether the next token has the given type and, if text isn't
+// empty, the given text.
+func (p *pacParser) at(typ pacTokenType, text string) bool {
+	tok := p.peek()
+	return tok.typ == typ && (text == "" || tok.text == text)
+}
+
+func (p *pacParser) accept(text string) bool {
+	if p.at(pacTokenPunct, text) {
+		p.next()
+		return true
+	}
+	return false
+}
+
+func (p *pacParser) expect(text string) error {
+	if !p.accept(text) {
+		return p.unexpected(fmt.Sprintf("%q", text))
+	}
+	return nil
+}
+
+func (p *pacParser) expectIdent() (string, error) {
+	if !p.at(pacTokenIdent, "") {
+		return "", p.unexpected("a name")
+	}
+	return p.next().text, nil
+}
+
+func (p *pacParser) unexpected(want string) error {
+	tok := p.peek()
+	if tok.typ == pacTokenEOF {
+		return fmt.Errorf("unexpected end of the PAC file; expected %s", want)
+	}
+	return fmt.Errorf("unsupported syntax %q on line %d of the PAC file; expected %s", tok.text, tok.line, want)
+}
+
+// endStatement consumes the optional semicolon at the end of a statement.
+func (p *pacParser) endStatement() {
+	p.accept(";")
+}
+
+func (p *pacParser) functionDecl() error {
+	p.next() // "function"
+	name, err := p.expectIdent()
+	if err != nil {
+		return err
+	}
+	if err := p.expect("("); err != nil {
+		return err
+	}
+	var params []string
+	for !p.accept(")") {
+		if len(params) > 0 {
+			if err := p.expect(","); err != nil {
+				return err
+			}
+		}
+		param, err := p.expectIdent()
+		if err != nil {
+			return err
+		}
+		params = append(params, param)
+	}
+	body, err := p.block()
+	if err != nil {
+		return err
+	}
+	p.pac.globals.vars[name] = &pacFunction{pac: p.pac, params: params, body: body}
+	return nil
+}
+
+func (p *pacParser) block() ([]pacStmt, error) {
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	var stmts []pacStmt
+	for !p.accept("}") {
+		stmt, err := p.statement()
+		if err != nil {
+			return nil, err
+		}
+		stmts = append(stmts, stmt)
+	}
+	return stmts, nil
+}
+
+func (p *pacParser) statement() (pacStmt, error) {
+	switch {
+	case p.at(pacTokenPunct, "{"):
+		stmts, err := p.block()
+		if err != nil {
+			return nil, err
+		}
+		return func(s *pacScope) (bool, any, error) {
+			for _, stmt := range stmts {
+				if returned, v, err := stmt(s); err != nil || returned {
+					return returned, v, err
+				}
+			}
+			return false, nil, nil
+		}, nil
+
+	case p.accept(";"):
+		return func(*pacScope) (bool, any, error) { return false, nil, nil }, nil
+
+	case p.at(pacTokenIdent, "var"):
+		p.next()
+		var names []string
+		var values []pacExpr
+		for {
+			name, err := p.expectIdent()
+			if err != nil {
+				return nil, err
+			}
+			var value pacExpr
+			if p.accept("=") {
+				if value, err = p.expression(); err != nil {
+					return nil, err
+				}
+			}
+			names = append(names, name)
+			values = append(values, value)
+			if !p.accept(",") {
+				break
+			}
+		}
+		p.endStatement()
+		return func(s *pacScope) (bool, any, error) {
+			for i, name := range names {
+				var v any
+				if values[i] != nil {
+					var err error
+					if v, err = values[i](s); err != nil {
+						return false, nil, err
+					}
+				}
+				s.vars[name] = v
+			}
+			return false, nil, nil
+		}, nil
+
+	case p.at(pacTokenIdent, "if"):
+		p.next()
+		if err := p.expect("("); err != nil {
+			return nil, err
+		}
+		cond, err := p.expression()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		then, err := p.statement()
+		if err != nil {
+			return nil, err
+		}
+		var els pacStmt
+		if p.at(pacTokenIdent, "else") {
+			p.next()
+			if els, err = p.statement(); err != nil {
+				return nil, err
+			}
+		}
+		return func(s *pacScope) (bool, any, error) {
+			v, err := cond(s)
+			if err != nil {
+				return false, nil, err
+			}
+			if pacTruthy(v) {
+				return then(s)
+			}
+			if els != nil {
+				return els(s)
+			}
+			return false, nil, nil
+		}, nil
+
+	case p.at(pacTokenIdent, "return"):
+		p.next()
+		var value pacExpr
+		if !p.at(pacTokenPunct, ";") && !p.at(pacTokenPunct, "}") {
+			var err error
+			if value, err = p.expression(); err != nil {
+				return nil, err
+			}
+		}
+		p.endStatement()
+		return func(s *pacScope) (bool, any, error) {
+			if value == nil {
+				return true, nil, nil
+			}
+			v, err := value(s)
+			return true, v, err
+		}, nil
+
+	case p.at(pacTokenIdent, "") && p.toks[p.pos+1].typ == pacTokenPunct && p.toks[p.pos+1].text == "=":
+		name := p.next().text
+		p.next() // "="
+		value, err := p.expression()
+		if err != nil {
+			return nil, err
+		}
+		p.endStatement()
+		return func(s *pacScope) (bool, any, error) {
+			v, err := value(s)
+			if err != nil {
+				return false, nil, err
+			}
+			s.assign(name, v)
+			return false, nil, nil
+		}, nil
+
+	default:
+		expr, err := p.expression()
+		if err != nil {
+			return nil, err
+		}
+		p.endStatement()
+		return func(s *pacScope) (bool, any, error) {
+			_, err := expr(s)
+			return false, nil, err
+		}, nil
+	}
+}
+
+func (p *pacParser) expression() (pacExpr, error) {
+	cond, err := p.binary(0)
+	if err != nil {
+		return nil, err
+	}
+	if !p.accept("?") {
+		return cond, nil
+	}
+	then, err := p.expression()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect(":"); err != nil {
+		return nil, err
+	}
+	els, err := p.expression()
+	if err != nil {
+		return nil, err
+	}
+	return func(s *pacScope) (any, error) {
+		v, err := cond(s)
+		if err != nil {
+			return nil, err
+		}
+		if pacTruthy(v) {
+			return then(s)
+		}
+		return els(s)
+	}, nil
+}
+
+// pacBinaryOps are the supported binary operators, in order of increasing
+// precedence.
+var pacBinaryOps = [][]string{
+	{"||"},
+	{"&&"},
+	{"==", "!=", "===", "!=="},
+	{"<", ">", "<=", ">="},
+	{"+", "-"},
+}
+
+func (p *pacParser) binary(level int) (pacExpr, error) {
+	if level == len(pacBinaryOps) {
+		return p.unary()
+	}
+	lhs, err := p.binary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		var op string
+		for _, candidate := range pacBinaryOps[level] {
+			if p.at(pacTokenPunct, candidate) {
+				op = candidate
+				break
+			}
+		}
+		if op == "" {
+			return lhs, nil
+		}
+		p.next()
+		rhs, err := p.binary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		lhs = pacBinary(op, lhs, rhs)
+	}
+}
+
+func pacBinary(op string, lhs, rhs pacExpr) pacExpr {
+	return func(s *pacScope) (any, error) {
+		a, err := lhs(s)
+		if err != nil {
+			return nil, err
+		}
+		// The logical operators short-circuit, and return one of their
+		// operands rather than a boolean.
+		switch op {
+		case "||":
+			if pacTruthy(a) {
+				return a, nil
+			}
+			return rhs(s)
+		case "&&":
+			if !pacTruthy(a) {
+				return a, nil
+			}
+			return rhs(s)
+		}
+		b, err := rhs(s)
+		if err != nil {
+			return nil, err
+		}
+		switch op {
+		case "==":
+			return pacEqual(a, b), nil
+		case "!=":
+			return !pacEqual(a, b), nil
+		case "===":
+			return a == b, nil
+		case "!==":
+			return a != b, nil
+		case "+":
+			if an, ok := a.(float64); ok {
+				if bn, ok := b.(float64); ok {
+					return an + bn, nil
+				}
+			}
+			return pacString(a) + pacString(b), nil
+		}
+		an, aok := a.(float64)
+		bn, bok := b.(float64)
+		if !aok || !bok {
+			if op == "-" {
+				return nil, fmt.Errorf("cannot subtract %s from %s", pacTypeName(b), pacTypeName(a))
+			}
+			// Strings are compared lexically, as in JavaScript.
+			as, bs := pacString(a), pacString(b)
+			switch op {
+			case "<":
+				return as < bs, nil
+			case ">":
+				return as > bs, nil
+			case "<=":
+				return as <= bs, nil
+			default:
+				return as >= bs, nil
+			}
+		}
+		switch op {
+		case "-":
+			return an - bn, nil
+		case "<":
+			return an < bn, nil
+		case ">":
+			return an > bn, nil
+		case "<=":
+			return an <= bn, nil
+		default:
+			return an >= bn, nil
+		}
+	}
+}
+
+func (p *pacParser) unary() (pacExpr, error) {
+	switch {
+	case p.accept("!"):
+		operand, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return func(s *pacScope) (any, error) {
+			v, err := operand(s)
+			if err != nil {
+				return nil, err
+			}
+			return !pacTruthy(v), nil
+		}, nil
+	case p.accept("-"):
+		operand, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return func(s *pacScope) (any, error) {
+			v, err := operand(s)
+			if err != nil {
+				return nil, err
+			}
+			n, ok := v.(float64)
+			if !ok {
+				return nil, fmt.Errorf("cannot negate %s", pacTypeName(v))
+			}
+			return -n, nil
+		}, nil
+	}
+	return p.postfix()
+}
+
+// pacKeywords are the JavaScript keywords that can't be used as names, most
+// of which introduce syntax that PAC files in OpenTofu don't support.
+var pacKeywords = map[string]bool{
+	"break": true, "case": true, "catch": true, "continue": true, "delete": true,
+	"do": true, "else": true, "for": true, "function": true, "if": true,
+	"in": true, "instanceof": true, "let": true, "const": true, "new": true,
+	"return": true, "switch": true, "this": true, "throw": true, "try": true,
+	"typeof": true, "var": true, "void": true, "while": true,
+}
+
+func (p *pacParser) postfix() (pacExpr, error) {
+	if pacKeywords[p.peek().text] && p.at(pacTokenIdent, "") {
+		return nil, p.unexpected("an expression")
+	}
+	if p.at(pacTokenIdent, "") && p.toks[p.pos+1].typ == pacTokenPunct && p.toks[p.pos+1].text == "(" {
+		name := p.next().text
+		args, err := p.arguments()
+		if err != nil {
+			return nil, err
+		}
+		return p.methods(p.call(name, args))
+	}
+	expr, err := p.primary()
+	if err != nil {
+		return nil, err
+	}
+	return p.methods(expr)
+}
+
+// methods parses any method calls on the result of the given expression.
+func (p *pacParser) methods(expr pacExpr) (pacExpr, error) {
+	for p.accept(".") {
+		name, err := p.expectIdent()
+		if err != nil {
+			return nil, err
+		}
+		if name == "length" && !p.at(pacTokenPunct, "(") {
+			recv := expr
+			expr = func(s *pacScope) (any, error) {
+				v, err := recv(s)
+				if err != nil {
+					return nil, err
+				}
+				str, ok := v.(string)
+				if !ok {
+					return nil, fmt.Errorf("cannot get the length of %s", pacTypeName(v))
+				}
+				return float64(len(str)), nil
+			}
+			continue
+		}
+		args, err := p.arguments()
+		if err != nil {
+			return nil, err
+		}
+		expr, err = pacMethod(name, expr, args)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return expr, nil
+}
+
+func pacMethod(name string, recv pacExpr, args []pacExpr) (pacExpr, error) {
+	var method func(str string, args []any) any
+	switch name {
+	case "toLowerCase":
+		method = func(str string, _ []any) any { return strings.ToLower(str) }
+	case "toUpperCase":
+		method = func(str string, _ []any) any { return strings.ToUpper(str) }
+	case "indexOf":
+		method = func(str string, args []any) any {
+			return float64(strings.Index(str, pacString(pacArg(args, 0))))
+		}
+	case "substring":
+		method = func(str string, args []any) any {
+			clamp := func(v any, def int) int {
+				n, ok := v.(float64)
+				if !ok {
+					return def
+				}
+				return max(0, min(len(str), int(n)))
+			}
+			start, end := clamp(pacArg(args, 0), 0), clamp(pacArg(args, 1), len(str))
+			if start > end {
+				start, end = end, start
+			}
+			return str[start:end]
+		}
+	default:
+		return nil, fmt.Errorf("unsupported method %q in the PAC file", name)
+	}
+	return func(s *pacScope) (any, error) {
+		v, err := recv(s)
+		if err != nil {
+			return nil, err
+		}
+		str, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("cannot call %s on %s", name, pacTypeName(v))
+		}
+		argVals, err := pacEvalAll(s, args)
+		if err != nil {
+			return nil, err
+		}
+		return method(str, argVals), nil
+	}, nil
+}
+
+func (p *pacParser) call(name string, args []pacExpr) pacExpr {
+	return func(s *pacScope) (any, error) {
+		argVals, err := pacEvalAll(s, args)
+		if err != nil {
+			return nil, err
+		}
+		if v, ok := s.lookup(name); ok {
+			fn, ok := v.(*pacFunction)
+			if !ok {
+				return nil, fmt.Errorf("%s is not a function", name)
+			}
+			if err := s.budget.step(); err != nil {
+				return nil, err
+			}
+			return fn.call(s.budget, argVals)
+		}
+		if builtin, ok := pacBuiltins[name]; ok {
+			return builtin(p.pac, argVals)
+		}
+		return nil, fmt.Errorf("call to unsupported function %s", name)
+	}
+}
+
+func (p *pacParser) arguments() ([]pacExpr, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	var args []pacExpr
+	for !p.accept(")") {
+		if len(args) > 0 {
+			if err := p.expect(","); err != nil {
+				return nil, err
+			}
+		}
+		arg, err := p.expression()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+	}
+	return args, nil
+}
+
+func (p *pacParser) primary() (pacExpr, error) {
+	tok := p.peek()
+	switch tok.typ {
+	case pacTokenString:
+		p.next()
+		return func(*pacScope) (any, error) { return tok.text, nil }, nil
+	case pacTokenNumber:
+		p.next()
+		n, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q on line %d of the PAC file", tok.text, tok.line)
+		}
+		return func(*pacScope) (any, error) { return n, nil }, nil
+	case pacTokenIdent:
+		p.next()
+		switch tok.text {
+		case "true":
+			return func(*pacScope) (any, error) { return true, nil }, nil
+		case "false":
+			return func(*pacScope) (any, error) { return false, nil }, nil
+		case "null", "undefined":
+			return func(*pacScope) (any, error) { return nil, nil }, nil
+		}
+		return func(s *pacScope) (any, error) {
+			v, ok := s.lookup(tok.text)
+			if !ok {
+				return nil, fmt.Errorf("reference to undeclared variable %s", tok.text)
+			}
+			return v, nil
+		}, nil
+	}
+	if p.accept("(") {
+		expr, err := p.expression()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return expr, nil
+	}
+	return nil, p.unexpected("an expression")
+}
+
+func pacEvalAll(s *pacScope, exprs []pacExpr) ([]any, error) {
+	ret := make([]any, len(exprs))
+	for i, expr := range exprs {
+		v, err := expr(s)
+		if err != nil {
+			return nil, err
+		}
+		ret[i] = v
+	}
+	return ret, nil
+}
@@ -0,0 +1,223 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package httpclient
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+// ProxyRule selects the proxy to use for requests to hosts matching a
+// pattern, overriding both the PAC file and the proxy environment variables.
+type ProxyRule struct {
+	// Host is either a hostname, a pattern like "*.example.com" that matches
+	// any subdomain of a domain, or "*" to match all hosts.
+	Host string
+
+	// URL is the URL of the proxy, or nil to connect directly.
+	URL *url.URL
+}
+
+// Matches reports whether the rule applies to the given hostname.
+func (r ProxyRule) Matches(host string) bool {
+	pattern := strings.ToLower(r.Host)
+	host = strings.ToLower(host)
+	if pattern == "*" {
+		return true
+	}
+	if suffix, ok := strings.CutPrefix(pattern, "*"); ok {
+		return strings.HasSuffix(host, suffix)
+	}
+	return host == pattern
+}
+
+// ProxyConfig is the proxy configuration from the CLI configuration, which
+// extends the usual proxy environment variables.
+type ProxyConfig struct {
+	// Rules are the per-host proxy overrides. When more than one rule
+	// matches a host, the rule with the longest pattern wins.
+	Rules []ProxyRule
+
+	// PACFile is the local path or the http or https URL of a proxy
+	// auto-configuration file, or empty if there isn't one. See [PAC] for
+	// the subset of PAC files that OpenTofu supports.
+	PACFile string
+}
+
+// ProxySource describes why a particular proxy was selected for a request.
+type ProxySource string
+
+const (
+	ProxySourceRule        ProxySource = "CLI configuration"
+	ProxySourcePAC         ProxySource = "PAC file"
+	ProxySourceEnvironment ProxySource = "environment"
+)
+
+// ProxySelection is the result of selecting a proxy for a URL.
+type ProxySelection struct {
+	// URL is the URL of the selected proxy, or nil to connect directly.
+	URL *url.URL
+
+	// Source says where the selection came from.
+	Source ProxySource
+
+	// Rule is the matching rule from the CLI configuration, if Source is
+	// ProxySourceRule.
+	Rule *ProxyRule
+
+	// PACError is the error from loading or evaluating the PAC file, if
+	// there is a PAC file but it could not be used. In that case the
+	// selection falls back to the environment.
+	PACError error
+}
+
+// pacFileTimeout is the maximum time we wait to fetch a PAC file over HTTP.
+const pacFileTimeout = 10 * time.Second
+
+// proxyResolver selects proxies for requests according to a ProxyConfig.
+type proxyResolver struct {
+	config *ProxyConfig
+
+	pacOnce sync.Once
+	pac     *PAC
+	pacErr  error
+}
+
+var globalProxyResolver atomic.Pointer[proxyResolver]
+
+// SetProxyConfig sets the proxy configuration used by all of the HTTP clients
+// returned by [New] and [NewForRegistryRequests], including any that were
+// created before calling this function. A nil configuration means to use only
+// the usual proxy environment variables.
+//
+// This is intended to be called once during startup, after loading the CLI
+// configuration.
+func SetProxyConfig(config *ProxyConfig) {
+	if config == nil {
+		globalProxyResolver.Store(nil)
+		return
+	}
+	globalProxyResolver.Store(&proxyResolver{config: config})
+}
+
+// SelectProxy returns the proxy to use for a request to the given URL
+// according to the current proxy configuration.
+func SelectProxy(u *url.URL) (ProxySelection, error) {
+	return globalProxyResolver.Load().selectProxy(u)
+}
+
+// proxyForRequest is the Proxy function of the transports of our clients.
+func proxyForRequest(req *http.Request) (*url.URL, error) {
+	sel, err := SelectProxy(req.URL)
+	if err != nil {
+		return nil, err
+	}
+	if sel.PACError != nil {
+		log.Printf("[WARN] Ignoring the PAC file for %s: %s", req.URL.Redacted(), sel.PACError)
+	}
+	return sel.URL, nil
+}
+
+func (r *proxyResolver) selectProxy(u *url.URL) (ProxySelection, error) {
+	var sel ProxySelection
+	if r != nil {
+		if rule := r.matchingRule(u.Hostname()); rule != nil {
+			return ProxySelection{URL: rule.URL, Source: ProxySourceRule, Rule: rule}, nil
+		}
+		if r.config.PACFile != "" {
+			proxies, err := r.pacProxies(u)
+			if err == nil {
+				// We can only use one proxy, so we use the first one the
+				// PAC file chose.
+				return ProxySelection{URL: proxies[0], Source: ProxySourcePAC}, nil
+			}
+			sel.PACError = err
+		}
+	}
+
+	// We read the environment on each call, rather than once as
+	// http.ProxyFromEnvironment does, so that tests can vary it.
+	proxyURL, err := httpproxy.FromEnvironment().ProxyFunc()(u)
+	if err != nil {
+		return sel, fmt.Errorf("invalid proxy environment variable: %w", err)
+	}
+	sel.URL = proxyURL
+	sel.Source = ProxySourceEnvironment
+	return sel, nil
+}
+
+func (r *proxyResolver) matchingRule(host string) *ProxyRule {
+	var ret *ProxyRule
+	for i := range r.config.Rules {
+		rule := &r.config.Rules[i]
+		if !rule.Matches(host) {
+			continue
+		}
+		if ret == nil || len(rule.Host) > len(ret.Host) {
+			ret = rule
+		}
+	}
+	return ret
+}
+
+func (r *proxyResolver) pacProxies(u *url.URL) ([]*url.URL, error) {
+	r.pacOnce.Do(func() {
+		r.pac, r.pacErr = loadPAC(r.config.PACFile)
+	})
+	if r.pacErr != nil {
+		return nil, r.pacErr
+	}
+	return r.pac.FindProxyForURL(u)
+}
+
+// loadPAC reads and parses the PAC file at the given local path or http or
+// https URL.
+func loadPAC(location string) (*PAC, error) {
+	var src []byte
+	if strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://") {
+		ctx, cancel := context.WithTimeout(context.Background(), pacFileTimeout)
+		defer cancel()
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, location, nil)
+		if err != nil {
+			return nil, fmt.Errorf("invalid PAC file URL: %w", err)
+		}
+		// The PAC file itself is always fetched directly, because it is
+		// what tells us which proxy to use.
+		client := &http.Client{Transport: &http.Transport{Proxy: nil}}
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch the PAC file: %w", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("failed to fetch the PAC file: server returned %s", resp.Status)
+		}
+		if src, err = io.ReadAll(resp.Body); err != nil {
+			return nil, fmt.Errorf("failed to fetch the PAC file: %w", err)
+		}
+	} else {
+		var err error
+		if src, err = os.ReadFile(strings.TrimPrefix(location, "file://")); err != nil {
+			return nil, fmt.Errorf("failed to read the PAC file: %w", err)
+		}
+	}
+	pac, err := ParsePAC(string(src))
+	if err != nil {
+		return nil, fmt.Errorf("invalid PAC file %s: %w", location, err)
+	}
+	return pac, nil
+}
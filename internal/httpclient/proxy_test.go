@@ -0,0 +1,161 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package httpclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSelectProxy(t *testing.T) {
+	pacFile := filepath.Join(t.TempDir(), "proxy.pac")
+	err := os.WriteFile(pacFile, []byte(`
+function FindProxyForURL(url, host) {
+  if (dnsDomainIs(host, ".pac.example.com")) return "PROXY pac-proxy.example.com:3128; DIRECT";
+  return "DIRECT";
+}
+`), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	badPACFile := filepath.Join(t.TempDir(), "bad.pac")
+	if err := os.WriteFile(badPACFile, []byte(`function FindProxyForURL(url, host) {`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	recursivePACFile := filepath.Join(t.TempDir(), "recursive.pac")
+	if err := os.WriteFile(recursivePACFile, []byte(`function FindProxyForURL(url, host) { return FindProxyForURL(url, host); }`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	ruleProxy := &url.URL{Scheme: "http", Host: "rule-proxy.example.com:3128"}
+	rules := []ProxyRule{
+		{Host: "*.example.com", URL: ruleProxy},
+		{Host: "direct.example.com", URL: nil},
+	}
+
+	tests := map[string]struct {
+		config     *ProxyConfig
+		url        string
+		wantURL    string
+		wantSource ProxySource
+		wantPACErr bool
+	}{
+		"no config": {
+			url:        "https://registry.opentofu.org/",
+			wantURL:    "http://env-proxy.example.com:8080",
+			wantSource: ProxySourceEnvironment,
+		},
+		"wildcard rule": {
+			config:     &ProxyConfig{Rules: rules, PACFile: pacFile},
+			url:        "https://a.pac.example.com/",
+			wantURL:    ruleProxy.String(),
+			wantSource: ProxySourceRule,
+		},
+		"most specific rule": {
+			config:     &ProxyConfig{Rules: rules},
+			url:        "https://DIRECT.example.com/",
+			wantSource: ProxySourceRule,
+		},
+		"pac file": {
+			config:     &ProxyConfig{PACFile: pacFile},
+			url:        "https://a.pac.example.com/",
+			wantURL:    "http://pac-proxy.example.com:3128",
+			wantSource: ProxySourcePAC,
+		},
+		"pac file direct": {
+			config:     &ProxyConfig{PACFile: "file://" + pacFile},
+			url:        "https://registry.opentofu.org/",
+			wantSource: ProxySourcePAC,
+		},
+		"invalid pac file": {
+			config:     &ProxyConfig{PACFile: badPACFile},
+			url:        "https://registry.opentofu.org/",
+			wantURL:    "http://env-proxy.example.com:8080",
+			wantSource: ProxySourceEnvironment,
+			wantPACErr: true,
+		},
+		"recursive pac file": {
+			config:     &ProxyConfig{PACFile: recursivePACFile},
+			url:        "https://registry.opentofu.org/",
+			wantURL:    "http://env-proxy.example.com:8080",
+			wantSource: ProxySourceEnvironment,
+			wantPACErr: true,
+		},
+		"environment no_proxy": {
+			config:     &ProxyConfig{Rules: rules},
+			url:        "https://internal.test/",
+			wantSource: ProxySourceEnvironment,
+		},
+	}
+
+	t.Setenv("HTTPS_PROXY", "http://env-proxy.example.com:8080")
+	t.Setenv("NO_PROXY", "internal.test")
+	t.Cleanup(func() { SetProxyConfig(nil) })
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			SetProxyConfig(test.config)
+			u, err := url.Parse(test.url)
+			if err != nil {
+				t.Fatal(err)
+			}
+			sel, err := SelectProxy(u)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var gotURL string
+			if sel.URL != nil {
+				gotURL = sel.URL.String()
+			}
+			if gotURL != test.wantURL {
+				t.Errorf("wrong proxy URL %q; want %q", gotURL, test.wantURL)
+			}
+			if sel.Source != test.wantSource {
+				t.Errorf("wrong source %q; want %q", sel.Source, test.wantSource)
+			}
+			if gotPACErr := sel.PACError != nil; gotPACErr != test.wantPACErr {
+				t.Errorf("wrong PAC error %v", sel.PACError)
+			}
+		})
+	}
+}
+
+func TestNew_proxyRule(t *testing.T) {
+	// The "proxy" receives the requests for all other hosts, and so
+	// proves that the client used it.
+	var gotHost string
+	proxy := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		gotHost = req.URL.Host
+		rw.WriteHeader(http.StatusNoContent)
+	}))
+	defer proxy.Close()
+	proxyURL, err := url.Parse(proxy.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	SetProxyConfig(&ProxyConfig{
+		Rules: []ProxyRule{{Host: "registry.example.com", URL: proxyURL}},
+	})
+	t.Cleanup(func() { SetProxyConfig(nil) })
+
+	resp, err := New(context.Background()).Get("http://registry.example.com/.well-known/terraform.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("wrong status %s", resp.Status)
+	}
+	if gotHost != "registry.example.com" {
+		t.Errorf("proxy received request for %q; want registry.example.com", gotHost)
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"path"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/apparentlymart/go-versions/versions"
@@ -482,6 +483,23 @@ func (i *ModuleInstaller) installLocalModule(ctx context.Context, req *configs.M
 // public hashicorp/go-version API.
 var versionRegexp = regexp.MustCompile(version.VersionRegexpRaw)
 
+// modulePrereleaseRejection is the reason given for not selecting a
+// pre-release version of a registry module.
+const modulePrereleaseRejection = "it is a pre-release, which is only selected when it is the only version that the version constraint allows"
+
+// sortModuleVersionCandidates sorts the given candidates newest first, with
+// any invalid versions last.
+func sortModuleVersionCandidates(candidates []ModuleVersionCandidate) {
+	sort.SliceStable(candidates, func(i, j int) bool {
+		vi, erri := version.NewVersion(candidates[i].Version)
+		vj, errj := version.NewVersion(candidates[j].Version)
+		if erri != nil || errj != nil {
+			return erri == nil
+		}
+		return vi.GreaterThan(vj)
+	})
+}
+
 func (i *ModuleInstaller) installRegistryModule(ctx context.Context, req *configs.ModuleRequest, key string, instPath string, addr addrs.ModuleSourceRegistry, manifest modsdir.Manifest, hooks ModuleInstallHooks, fetcher *getmodules.PackageFetcher) (*configs.Module, *version.Version, hcl.Diagnostics) {
 	var diags hcl.Diagnostics
 
@@ -574,11 +592,26 @@ func (i *ModuleInstaller) installRegistryModule(ctx context.Context, req *config
 
 	modMeta := resp.Modules[0]
 
+	// We collect the reason for rejecting each of the available versions, so
+	// that the caller can explain the selection if it wants to.
+	resolution := &ModuleVersionResolution{
+		Source:     packageAddr.String(),
+		Constraint: req.VersionConstraint.Required.String(),
+		DeclRange:  req.VersionConstraint.DeclRange,
+	}
+	if resolution.Constraint == "" {
+		resolution.DeclRange = req.CallRange
+	}
+	reject := func(v, reason string) {
+		resolution.Candidates = append(resolution.Candidates, ModuleVersionCandidate{Version: v, Rejected: reason})
+	}
+
 	var latestMatch *version.Version
 	var latestVersion *version.Version
 	for _, mv := range modMeta.Versions {
 		v, err := version.NewVersion(mv.Version)
 		if err != nil {
+			reject(mv.Version, "the registry returned an invalid version string")
 			// Should never happen if the registry server is compliant with
 			// the protocol, but we'll warn if not to assist someone who
 			// might be developing a module registry server.
@@ -646,6 +679,7 @@ func (i *ModuleInstaller) installRegistryModule(ctx context.Context, req *config
 					acceptableVersions, err = versions.MeetingConstraintsString(strippedConstraint)
 					if err != nil {
 						log.Printf("[WARN] ModuleInstaller: %s ignoring %q because the stripped version constraints (%q) could not be parsed either: %s", key, v, strippedConstraint, err.Error())
+						reject(mv.Version, modulePrereleaseRejection)
 						continue
 					}
 				} else {
@@ -654,6 +688,7 @@ func (i *ModuleInstaller) installRegistryModule(ctx context.Context, req *config
 					// incorrect use of MeetingConstraintsString above. Refer to the earlier FIXME
 					// comment for more information.
 					log.Printf("[WARN] ModuleInstaller: %s ignoring %q because the version constraints (%q) could not be parsed: %s", key, v, strippedConstraint, err.Error())
+					reject(mv.Version, modulePrereleaseRejection)
 					continue
 				}
 			}
@@ -663,6 +698,7 @@ func (i *ModuleInstaller) installRegistryModule(ctx context.Context, req *config
 			version, err := versions.ParseVersion(v.String())
 			if err != nil {
 				log.Printf("[WARN] ModuleInstaller: %s ignoring %s because the version (%s) reported by the module could not be parsed: %s", key, v, v.String(), err.Error())
+				reject(mv.Version, "the registry returned an invalid version string")
 				continue
 			}
 
@@ -672,6 +708,7 @@ func (i *ModuleInstaller) installRegistryModule(ctx context.Context, req *config
 			// in the approach we want to.
 			if !acceptableVersions.Has(version) {
 				log.Printf("[TRACE] ModuleInstaller: %s ignoring %s because it is a pre-release and was not requested exactly", key, v)
+				reject(mv.Version, modulePrereleaseRejection)
 				continue
 			}
 			log.Printf("[TRACE] ModuleInstaller: %s accepting %s because it is a pre-release that was requested exactly", key, v)
@@ -690,8 +727,21 @@ func (i *ModuleInstaller) installRegistryModule(ctx context.Context, req *config
 			if latestMatch == nil || v.GreaterThan(latestMatch) {
 				latestMatch = v
 			}
+			// We don't know yet whether this is the newest match, so we'll
+			// decide whether it's rejected below.
+			reject(mv.Version, "")
+		} else {
+			reject(mv.Version, fmt.Sprintf("does not meet the version constraint %q", resolution.Constraint))
+		}
+	}
+	resolution.Selected = latestMatch
+	for i, candidate := range resolution.Candidates {
+		if candidate.Rejected == "" && candidate.Version != latestMatch.Original() {
+			resolution.Candidates[i].Rejected = "a newer version also meets the version constraint"
 		}
 	}
+	sortModuleVersionCandidates(resolution.Candidates)
+	hooks.ResolveVersion(key, resolution)
 
 	if latestVersion == nil {
 		diags = diags.Append(&hcl.Diagnostic{
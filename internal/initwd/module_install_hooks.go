@@ -7,6 +7,7 @@ package initwd
 
 import (
 	version "github.com/hashicorp/go-version"
+	"github.com/hashicorp/hcl/v2"
 )
 
 // ModuleInstallHooks is an interface used to provide notifications about the
@@ -24,6 +25,42 @@ type ModuleInstallHooks interface {
 	// Install is called for each module that is installed, even if it did
 	// not need to be downloaded from a remote source.
 	Install(moduleAddr string, version *version.Version, localPath string)
+
+	// ResolveVersion is called for each module that is installed from a
+	// module registry, once the installer has decided which of the versions
+	// available in the registry to use, or that none of them is acceptable.
+	ResolveVersion(moduleAddr string, resolution *ModuleVersionResolution)
+}
+
+// ModuleVersionResolution describes how the installer selected a version of
+// a module from a module registry, so that callers can explain a selection
+// that failed or was unexpected.
+type ModuleVersionResolution struct {
+	// Source is the registry address of the module.
+	Source string
+
+	// Constraint is the version constraint of the module call, which is
+	// empty if the call allows any version, and DeclRange is where it's
+	// declared.
+	Constraint string
+	DeclRange  hcl.Range
+
+	// Candidates are the versions that the registry offered, newest first.
+	Candidates []ModuleVersionCandidate
+
+	// Selected is the version that the installer selected, or nil if none
+	// of the candidates is acceptable.
+	Selected *version.Version
+}
+
+// ModuleVersionCandidate is one of the available versions of a module that
+// the installer considered.
+type ModuleVersionCandidate struct {
+	Version string
+
+	// Rejected is the reason the installer didn't select this version, or
+	// empty if it's the selected version.
+	Rejected string
 }
 
 // ModuleInstallHooksImpl is a do-nothing implementation of InstallHooks that
@@ -38,4 +75,7 @@ func (h ModuleInstallHooksImpl) Download(moduleAddr, packageAddr string, version
 func (h ModuleInstallHooksImpl) Install(moduleAddr string, version *version.Version, localPath string) {
 }
 
+func (h ModuleInstallHooksImpl) ResolveVersion(moduleAddr string, resolution *ModuleVersionResolution) {
+}
+
 var _ ModuleInstallHooks = ModuleInstallHooksImpl{}
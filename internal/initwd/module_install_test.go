@@ -16,6 +16,7 @@ import (
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	version "github.com/hashicorp/go-version"
 	"github.com/opentofu/svchost"
 
@@ -25,6 +26,7 @@ import (
 	"github.com/opentofu/opentofu/internal/copy"
 	"github.com/opentofu/opentofu/internal/getmodules"
 	"github.com/opentofu/opentofu/internal/registry"
+	"github.com/opentofu/opentofu/internal/registry/test"
 	"github.com/opentofu/opentofu/internal/tfdiags"
 
 	_ "github.com/opentofu/opentofu/internal/logging"
@@ -328,6 +330,61 @@ func TestModuleInstaller_Prerelease(t *testing.T) {
 	}
 }
 
+func TestModuleInstaller_versionResolution(t *testing.T) {
+	fixtureDir := filepath.Clean("testdata/registry-version-resolution")
+	dir := tempChdir(t, fixtureDir)
+
+	server := test.Registry()
+	defer server.Close()
+
+	hooks := &testInstallHooks{}
+	// The test registry serves its working directory as the package for
+	// these modules, so the configuration is in a subdirectory to stop the
+	// installed module from calling itself, and the modules are installed
+	// elsewhere.
+	modulesDir := t.TempDir()
+
+	loader := configload.NewLoaderForTests(t)
+	reg := registry.NewClient(t.Context(), test.Disco(server), nil)
+	fetcher := getmodules.NewPackageFetcher(t.Context(), nil)
+	inst := NewModuleInstaller(modulesDir, loader, reg, fetcher)
+	_, diags := inst.InstallModules(context.Background(), filepath.Join(dir, "root"), "tests", false, false, hooks, configs.RootModuleCallForTesting())
+	assertDiagnosticSummary(t, diags, "Unresolvable module version constraint")
+
+	wantSelected := version.Must(version.NewVersion("1.2.2"))
+	want := map[string]*ModuleVersionResolution{
+		"versions": {
+			Source:     "registry.opentofu.org/test-versions/name/provider",
+			Constraint: "~> 1.2",
+			Candidates: []ModuleVersionCandidate{
+				{Version: "2.2.0", Rejected: `does not meet the version constraint "~> 1.2"`},
+				{Version: "2.1.1", Rejected: `does not meet the version constraint "~> 1.2"`},
+				{Version: "1.2.2"},
+				{Version: "1.2.1", Rejected: "a newer version also meets the version constraint"},
+			},
+			Selected: wantSelected,
+		},
+		"unresolvable": {
+			Source:     "registry.opentofu.org/test-versions/name/provider",
+			Constraint: ">= 3.0.0",
+			Candidates: []ModuleVersionCandidate{
+				{Version: "2.2.0", Rejected: `does not meet the version constraint ">= 3.0.0"`},
+				{Version: "2.1.1", Rejected: `does not meet the version constraint ">= 3.0.0"`},
+				{Version: "1.2.2", Rejected: `does not meet the version constraint ">= 3.0.0"`},
+				{Version: "1.2.1", Rejected: `does not meet the version constraint ">= 3.0.0"`},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, hooks.Resolutions, cmpopts.IgnoreFields(ModuleVersionResolution{}, "DeclRange"), cmp.Comparer(func(a, b *version.Version) bool {
+		return a.Equal(b)
+	})); diff != "" {
+		t.Errorf("wrong resolutions\n%s", diff)
+	}
+	if got := hooks.Resolutions["versions"].DeclRange; filepath.Base(got.Filename) != "main.tf" || got.Start.Line != 3 {
+		t.Errorf("wrong constraint location %s", got)
+	}
+}
+
 func TestModuleInstaller_invalid_version_constraint_error(t *testing.T) {
 	fixtureDir := filepath.Clean("testdata/invalid-version-constraint")
 	dir := tempChdir(t, fixtureDir)
@@ -1025,6 +1082,11 @@ func TestModuleInstaller_nonExistentSubmodule(t *testing.T) {
 
 type testInstallHooks struct {
 	Calls []testInstallHookCall
+
+	// Resolutions are the version resolutions reported for each module, which
+	// are kept separately from Calls so that tests only need to consider them
+	// if they're interested.
+	Resolutions map[string]*ModuleVersionResolution
 }
 
 type testInstallHookCall struct {
@@ -1053,6 +1115,13 @@ func (h *testInstallHooks) Install(moduleAddr string, version *version.Version,
 	})
 }
 
+func (h *testInstallHooks) ResolveVersion(moduleAddr string, resolution *ModuleVersionResolution) {
+	if h.Resolutions == nil {
+		h.Resolutions = make(map[string]*ModuleVersionResolution)
+	}
+	h.Resolutions[moduleAddr] = resolution
+}
+
 // tempChdir copies the contents of the given directory to a temporary
 // directory and changes the test process's current working directory to
 // point to that directory. The temporary directory is deleted and the
@@ -0,0 +1,9 @@
+module "versions" {
+  source  = "test-versions/name/provider"
+  version = "~> 1.2"
+}
+
+module "unresolvable" {
+  source  = "test-versions/name/provider"
+  version = ">= 3.0.0"
+}
@@ -381,6 +381,15 @@ func (s *Scope) evalContext(ctx context.Context, parent *hcl.EvalContext, refs [
 		if subj, ok := ref.Subject.(addrs.ProviderFunction); ok {
 			// Inject function directly into context
 			if _, ok := hclCtx.Functions[subj.String()]; !ok {
+				if FunctionDisabled(s.DisabledFunctions, subj.String()) {
+					diags = diags.Append(&hcl.Diagnostic{
+						Severity: hcl.DiagError,
+						Summary:  "Function disabled",
+						Detail:   fmt.Sprintf("The provider-defined function %q cannot be used because it has been disabled in the CLI configuration for this environment.", subj.String()),
+						Subject:  ref.SourceRange.ToHCL().Ptr(),
+					})
+					continue
+				}
 				fn, fnDiags := s.ProviderFunctions(ctx, subj, ref.SourceRange)
 				diags = diags.Append(fnDiags)
 
@@ -71,7 +71,7 @@ func compileModuleInstanceProviderConfigs(
 				},
 			},
 			ProviderAddr:     providerAddr,
-			InstanceSelector: compileInstanceSelector(ctx, declScope, config.ForEach, nil, nil),
+			InstanceSelector: compileInstanceSelector(ctx, declScope, config.ForEach, nil, nil, nil),
 			CompileProviderInstance: func(ctx context.Context, key addrs.InstanceKey, repData instances.RepetitionData) *configgraph.ProviderInstance {
 				instanceScope := instanceLocalScope(declScope, repData)
 				return &configgraph.ProviderInstance{
@@ -139,7 +139,7 @@ func compileModuleInstanceProviderConfigs(
 					},
 				},
 				ProviderAddr:     providerAddr,
-				InstanceSelector: compileInstanceSelector(ctx, declScope, nil, nil, nil),
+				InstanceSelector: compileInstanceSelector(ctx, declScope, nil, nil, nil, nil),
 				CompileProviderInstance: func(ctx context.Context, key addrs.InstanceKey, repData instances.RepetitionData) *configgraph.ProviderInstance {
 					instanceScope := instanceLocalScope(declScope, repData)
 					return &configgraph.ProviderInstance{
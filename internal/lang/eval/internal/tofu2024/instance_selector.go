@@ -24,10 +24,27 @@ import (
 	"github.com/opentofu/opentofu/internal/tfdiags"
 )
 
-func compileInstanceSelector(ctx context.Context, declScope exprs.Scope, forEachExpr hcl.Expression, countExpr hcl.Expression, enabledExpr hcl.Expression) configgraph.InstanceSelector {
+func compileInstanceSelector(ctx context.Context, declScope exprs.Scope, forEachExpr hcl.Expression, forEachKeyExpr hcl.Expression, countExpr hcl.Expression, enabledExpr hcl.Expression) configgraph.InstanceSelector {
 	// We don't current verify that only one of the given expressions is set
 	// because we expect the configs package to check that.
 
+	if forEachExpr != nil && forEachKeyExpr != nil {
+		return compileInstanceSelectorForEachKeyed(ctx, exprs.NewClosure(
+			exprs.EvalableHCLExpression(forEachExpr),
+			declScope,
+		), func(elem cty.Value) exprs.Valuer {
+			// The configs package makes sure that each.value is the only
+			// part of "each" the key expression refers to, so each.key
+			// is just a placeholder here.
+			return exprs.NewClosure(
+				exprs.EvalableHCLExpression(forEachKeyExpr),
+				instanceLocalScope(declScope, instances.RepetitionData{
+					EachKey:   cty.NullVal(cty.String),
+					EachValue: elem,
+				}),
+			)
+		})
+	}
 	if forEachExpr != nil {
 		return compileInstanceSelectorForEach(ctx, exprs.NewClosure(
 			exprs.EvalableHCLExpression(forEachExpr),
@@ -285,6 +302,120 @@ func compileInstanceSelectorForEach(_ context.Context, forEachValuer exprs.Value
 	}
 }
 
+// compileInstanceSelectorForEachKeyed is like compileInstanceSelectorForEach,
+// but for a for_each argument used together with for_each_key. The for_each
+// value must then be a list, set, or tuple, and keyValuer returns the valuer
+// for the key of the instance that a particular element belongs to.
+func compileInstanceSelectorForEachKeyed(_ context.Context, forEachValuer exprs.Valuer, keyValuer func(elem cty.Value) exprs.Valuer) configgraph.InstanceSelector {
+	forEachValuer = configgraph.ValuerOnce(forEachValuer)
+	return &instanceSelector{
+		keyType:     addrs.StringKeyType,
+		sourceRange: forEachValuer.ValueSourceRange(),
+		selectInstances: func(ctx context.Context) (configgraph.Maybe[configgraph.InstancesSeq], cty.ValueMarks, tfdiags.Diagnostics) {
+			const errSummary = "Invalid for_each argument"
+
+			rawVal, diags := forEachValuer.Value(ctx)
+			if diags.HasErrors() {
+				return nil, nil, diags
+			}
+			rawVal, marks := rawVal.Unmark()
+			typ := rawVal.Type()
+			switch {
+			case typ.Equals(cty.DynamicPseudoType):
+				return nil, marks, diags
+			case !typ.IsListType() && !typ.IsSetType() && !typ.IsTupleType():
+				diags = diags.Append(&hcl.Diagnostic{
+					Severity: hcl.DiagError,
+					Summary:  errSummary,
+					Detail:   fmt.Sprintf("When \"for_each_key\" is set, the for_each value must be a list, set, or tuple, not %s.", typ.FriendlyName()),
+					Subject:  forEachValuer.ValueSourceRange().ToHCL().Ptr(),
+				})
+				return nil, marks, diags
+			case rawVal.IsNull():
+				diags = diags.Append(&hcl.Diagnostic{
+					Severity: hcl.DiagError,
+					Summary:  errSummary,
+					Detail:   "The for_each value must not be null.",
+					Subject:  forEachValuer.ValueSourceRange().ToHCL().Ptr(),
+				})
+				return nil, marks, diags
+			case !rawVal.IsKnown():
+				return nil, marks, diags
+			}
+
+			// We calculate all of the keys before returning the sequence so
+			// that we can report any problems with them, and so that the
+			// instances are unknown if any one of the keys is.
+			keys := make([]addrs.InstanceKey, 0, rawVal.LengthInt())
+			elems := make([]cty.Value, 0, rawVal.LengthInt())
+			seen := make(map[addrs.StringKey]bool, rawVal.LengthInt())
+			unknownKey := false
+			for _, elem := range rawVal.Elements() {
+				elem = elem.WithMarks(marks)
+				valuer := keyValuer(elem)
+				keyVal, moreDiags := valuer.Value(ctx)
+				diags = diags.Append(moreDiags)
+				if moreDiags.HasErrors() {
+					continue
+				}
+				keyVal, keyMarks := keyVal.Unmark()
+				if len(keyMarks) != 0 {
+					marks = cty.NewValueMarks(marks, keyMarks)
+				}
+				keyVal, err := convert.Convert(keyVal, cty.String)
+				if err == nil && keyVal.IsNull() {
+					err = errors.New("must not be null")
+				}
+				if err != nil {
+					diags = diags.Append(&hcl.Diagnostic{
+						Severity: hcl.DiagError,
+						Summary:  "Invalid for_each_key result",
+						Detail:   fmt.Sprintf("Unsuitable value for the \"for_each_key\" meta-argument: %s.", tfdiags.FormatError(err)),
+						Subject:  configgraph.MaybeHCLSourceRange(valuer.ValueSourceRange()),
+					})
+					continue
+				}
+				if !keyVal.IsKnown() {
+					unknownKey = true
+					continue
+				}
+				key := addrs.StringKey(keyVal.AsString())
+				if seen[key] {
+					diags = diags.Append(&hcl.Diagnostic{
+						Severity: hcl.DiagError,
+						Summary:  "Duplicate for_each key",
+						Detail:   fmt.Sprintf("The \"for_each_key\" expression produced the key %q for more than one element of the for_each value. Each instance must have a unique key.", string(key)),
+						Subject:  configgraph.MaybeHCLSourceRange(valuer.ValueSourceRange()),
+					})
+					continue
+				}
+				seen[key] = true
+				keys = append(keys, key)
+				elems = append(elems, elem)
+			}
+			switch {
+			case diags.HasErrors():
+				return nil, marks, diags
+			case unknownKey:
+				return nil, marks, diags
+			}
+
+			seq := func(yield func(addrs.InstanceKey, instances.RepetitionData) bool) {
+				for i, key := range keys {
+					more := yield(key, instances.RepetitionData{
+						EachKey:   cty.StringVal(string(key.(addrs.StringKey))),
+						EachValue: elems[i],
+					})
+					if !more {
+						break
+					}
+				}
+			}
+			return configgraph.Known(seq), marks, diags
+		},
+	}
+}
+
 type instanceSelector struct {
 	keyType         addrs.InstanceKeyType
 	sourceRange     *tfdiags.SourceRange
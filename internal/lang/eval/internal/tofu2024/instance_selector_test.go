@@ -13,6 +13,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/hashicorp/hcl/v2/hcltest"
 	"github.com/zclconf/go-cty-debug/ctydebug"
 	"github.com/zclconf/go-cty/cty"
@@ -27,7 +28,7 @@ import (
 
 func TestCompileInstanceSelectorSingleton(t *testing.T) {
 	ctx := grapheval.ContextWithNewWorker(t.Context())
-	selector := compileInstanceSelector(ctx, exprs.FlatScopeForTesting(nil), nil, nil, nil)
+	selector := compileInstanceSelector(ctx, exprs.FlatScopeForTesting(nil), nil, nil, nil, nil)
 	instsSeq, marks, diags := selector.Instances(ctx)
 	insts := configgraph.MapMaybe(instsSeq, func(s configgraph.InstancesSeq) map[addrs.InstanceKey]instances.RepetitionData {
 		return maps.Collect(s)
@@ -347,7 +348,101 @@ func TestCompileInstanceSelectorForEach(t *testing.T) {
 			},
 		},
 		func(ctx context.Context, e hcl.Expression) configgraph.InstanceSelector {
-			return compileInstanceSelector(ctx, scope, e, nil, nil)
+			return compileInstanceSelector(ctx, scope, e, nil, nil, nil)
+		},
+	)
+}
+
+func TestCompileInstanceSelectorForEachKeyed(t *testing.T) {
+	scope := exprs.FlatScopeForTesting(map[string]cty.Value{
+		"prefix": cty.StringVal("p"),
+	})
+	keyExpr, hclDiags := hclsyntax.ParseExpression([]byte(`"${prefix}-${each.value.name}"`), "", hcl.InitialPos)
+	if hclDiags.HasErrors() {
+		t.Fatal(hclDiags.Error())
+	}
+	rng := hcl.Range{
+		Start: hcl.InitialPos,
+		End:   hcl.InitialPos,
+	}
+	obj := func(name string) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{"name": cty.StringVal(name)})
+	}
+	objTy := obj("").Type()
+	diagsHasError := func(want string) func(*testing.T, tfdiags.Diagnostics) {
+		return func(t *testing.T, diags tfdiags.Diagnostics) {
+			if !diags.HasErrors() {
+				t.Fatalf("unexpected success")
+			}
+			s := diags.Err().Error()
+			if !strings.Contains(s, want) {
+				t.Errorf("missing expected error\ngot:  %s\nwant: %s", s, want)
+			}
+		}
+	}
+	testCompileInstanceSelector(t,
+		map[string]compileInstanceSelectorTest{
+			"list of objects": {
+				hcl.StaticExpr(cty.ListVal([]cty.Value{obj("a"), obj("b")}), rng),
+				configgraph.Known(map[addrs.InstanceKey]instances.RepetitionData{
+					addrs.StringKey("p-a"): {
+						EachKey:   cty.StringVal("p-a"),
+						EachValue: obj("a"),
+					},
+					addrs.StringKey("p-b"): {
+						EachKey:   cty.StringVal("p-b"),
+						EachValue: obj("b"),
+					},
+				}),
+				nil,
+				nil,
+			},
+			"empty list": {
+				hcl.StaticExpr(cty.ListValEmpty(objTy), rng),
+				configgraph.Known(map[addrs.InstanceKey]instances.RepetitionData{}),
+				nil,
+				nil,
+			},
+			"marked list": {
+				hcl.StaticExpr(cty.ListVal([]cty.Value{obj("a")}).Mark("!"), rng),
+				configgraph.Known(map[addrs.InstanceKey]instances.RepetitionData{
+					addrs.StringKey("p-a"): {
+						EachKey:   cty.StringVal("p-a"),
+						EachValue: obj("a").Mark("!"),
+					},
+				}),
+				cty.NewValueMarks("!"),
+				nil,
+			},
+			"unknown list": {
+				hcl.StaticExpr(cty.UnknownVal(cty.List(objTy)), rng),
+				nil,
+				nil,
+				nil,
+			},
+			"unknown key": {
+				hcl.StaticExpr(cty.ListVal([]cty.Value{
+					cty.ObjectVal(map[string]cty.Value{"name": cty.UnknownVal(cty.String)}),
+				}), rng),
+				nil,
+				nil,
+				nil,
+			},
+			"duplicate key": {
+				hcl.StaticExpr(cty.ListVal([]cty.Value{obj("a"), obj("a")}), rng),
+				nil,
+				nil,
+				diagsHasError(`The "for_each_key" expression produced the key "p-a" for more than one element`),
+			},
+			"map": {
+				hcl.StaticExpr(cty.MapVal(map[string]cty.Value{"a": obj("a")}), rng),
+				nil,
+				nil,
+				diagsHasError(`the for_each value must be a list, set, or tuple`),
+			},
+		},
+		func(ctx context.Context, e hcl.Expression) configgraph.InstanceSelector {
+			return compileInstanceSelector(ctx, scope, e, keyExpr, nil, nil)
 		},
 	)
 }
@@ -501,7 +596,7 @@ func TestCompileInstanceSelectorCount(t *testing.T) {
 			},
 		},
 		func(ctx context.Context, e hcl.Expression) configgraph.InstanceSelector {
-			return compileInstanceSelector(ctx, scope, nil, e, nil)
+			return compileInstanceSelector(ctx, scope, nil, nil, e, nil)
 		},
 	)
 }
@@ -607,7 +702,7 @@ func TestCompileInstanceSelectorEnabled(t *testing.T) {
 			},
 		},
 		func(ctx context.Context, e hcl.Expression) configgraph.InstanceSelector {
-			return compileInstanceSelector(ctx, scope, nil, nil, e)
+			return compileInstanceSelector(ctx, scope, nil, nil, nil, e)
 		},
 	)
 }
@@ -47,6 +47,28 @@ func (e DiagnosticCausedByConfidentialValues) DiagnosticCausedByConfidentialValu
 	return bool(e)
 }
 
+// diagnosticUnknownExpansion is an implementation of
+// tfdiags.DiagnosticExtraUnknownExpansion which we use in the "Extra" field of
+// the diagnostics returned by [UnknownExpansionDiagnostic].
+//
+// It also implements tfdiags.DiagnosticExtraBecauseUnknown, so that the
+// diagnostic renderer still describes which values in the expression were
+// unknown.
+type diagnosticUnknownExpansion struct {
+	expansion *tfdiags.UnknownExpansion
+}
+
+var _ tfdiags.DiagnosticExtraUnknownExpansion = diagnosticUnknownExpansion{}
+var _ tfdiags.DiagnosticExtraBecauseUnknown = diagnosticUnknownExpansion{}
+
+func (e diagnosticUnknownExpansion) DiagnosticUnknownExpansion() *tfdiags.UnknownExpansion {
+	return e.expansion
+}
+
+func (e diagnosticUnknownExpansion) DiagnosticCausedByUnknown() bool {
+	return true
+}
+
 // commandLineArgumentsSuggestion returns a representation of the given command line
 // arguments that includes suitable quoting or escaping to make it more likely to work
 // unaltered if copy-pasted into the command line on the current host system.
@@ -0,0 +1,238 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package evalchecks
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/lang"
+	"github.com/opentofu/opentofu/internal/lang/marks"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+const (
+	errInvalidUnknownDetailList = "The \"for_each\" collection is derived from resource attributes that cannot be determined until apply, and so OpenTofu cannot determine the full set of keys that will identify the instances of this resource.\n\nWhen working with unknown values in for_each, it's better to use a collection whose elements are defined statically in your configuration and where only the attributes not used by \"for_each_key\" contain apply-time results.\n\n"
+	errInvalidUnknownDetailKey  = "The \"for_each_key\" expression produced a key derived from resource attributes that cannot be determined until apply, and so OpenTofu cannot determine the full set of keys that will identify the instances of this resource.\n\nWhen working with unknown values in for_each, it's better to calculate the keys only from attributes that are defined statically in your configuration and place apply-time results only in the other attributes.\n\n"
+)
+
+// EvaluateForEachKeyedExpression is like EvaluateForEachExpression, but also
+// takes the expression given for a "for_each_key" argument.
+//
+// When keyExpr is nil this behaves exactly like EvaluateForEachExpression.
+// Otherwise the "for_each" value must be a list, set or tuple, and keyExpr is
+// evaluated for each of its elements, with each.value set to the element, to
+// calculate the key of the instance that element belongs to.
+func EvaluateForEachKeyedExpression(expr hcl.Expression, keyExpr hcl.Expression, ctx ContextFunc, excludableAddr addrs.Targetable) (map[string]cty.Value, tfdiags.Diagnostics) {
+	if keyExpr == nil {
+		return EvaluateForEachExpression(expr, ctx, excludableAddr)
+	}
+
+	const unknownsNotAllowed = false
+	forEachVal, diags := EvaluateForEachKeyedExpressionValue(expr, keyExpr, ctx, unknownsNotAllowed, excludableAddr)
+	// forEachVal might be unknown, but if it is then there should already
+	// be an error about it in diags, which we'll return below.
+
+	if forEachVal.IsNull() || !forEachVal.IsKnown() || markSafeLengthInt(forEachVal) == 0 {
+		return map[string]cty.Value{}, diags
+	}
+
+	return forEachVal.AsValueMap(), diags
+}
+
+// EvaluateForEachKeyedExpressionValue is like EvaluateForEachKeyedExpression
+// except that it returns a cty.Value which can be unknown.
+//
+// When keyExpr is non-nil, the result is an object whose attributes are the
+// calculated keys and whose attribute values are the corresponding elements.
+func EvaluateForEachKeyedExpressionValue(expr hcl.Expression, keyExpr hcl.Expression, hclCtxFunc ContextFunc, allowUnknown bool, excludableAddr addrs.Targetable) (cty.Value, tfdiags.Diagnostics) {
+	const tupleNotAllowed = false
+	if keyExpr == nil {
+		return EvaluateForEachExpressionValue(expr, hclCtxFunc, allowUnknown, tupleNotAllowed, excludableAddr)
+	}
+
+	var diags tfdiags.Diagnostics
+	nullMap := cty.NullVal(cty.Map(cty.DynamicPseudoType))
+
+	if expr == nil {
+		return nullMap, diags
+	}
+
+	refs, moreDiags := lang.ReferencesInExpr(addrs.ParseRef, expr)
+	diags = diags.Append(moreDiags)
+	keyRefs, moreDiags := lang.ReferencesInExpr(addrs.ParseRef, keyExpr)
+	diags = diags.Append(moreDiags)
+	for _, ref := range keyRefs {
+		// The "each" object is defined separately for each element below,
+		// so it must not be resolved by the surrounding scope.
+		if _, ok := ref.Subject.(addrs.ForEachAttr); !ok {
+			refs = append(refs, ref)
+		}
+	}
+
+	hclCtx, moreDiags := hclCtxFunc(refs)
+	diags = diags.Append(moreDiags)
+	if diags.HasErrors() { // Can't continue if we don't even have a valid scope
+		return nullMap, diags
+	}
+
+	forEachVal, forEachDiags := expr.Value(hclCtx)
+	diags = diags.Append(forEachDiags)
+
+	forEachVal, deprDiags := marks.ExtractDeprecatedDiagnosticsWithExpr(forEachVal, expr)
+	diags = diags.Append(deprDiags)
+
+	ty := forEachVal.Type()
+	if ty != cty.DynamicPseudoType && !ty.IsListType() && !ty.IsSetType() && !ty.IsTupleType() {
+		diags = diags.Append(&hcl.Diagnostic{
+			Severity:    hcl.DiagError,
+			Summary:     "Invalid for_each argument",
+			Detail:      fmt.Sprintf(`The given "for_each" argument value is unsuitable: when "for_each_key" is set, the "for_each" argument must be a list, set, or tuple, and you have provided a value of type %s.`, ty.FriendlyName()),
+			Subject:     expr.Range().Ptr(),
+			Expression:  expr,
+			EvalContext: hclCtx,
+		})
+		return nullMap, diags
+	}
+
+	checkedVal, checkDiags := performValueChecks(expr, hclCtx, allowUnknown, forEachVal, forEachVal, errInvalidUnknownDetailList, excludableAddr)
+	diags = diags.Append(checkDiags)
+	switch {
+	case diags.HasErrors() || checkedVal.IsNull():
+		return nullMap, diags
+	case !checkedVal.IsKnown():
+		return cty.DynamicVal, diags
+	}
+
+	return evaluateForEachKeys(expr, keyExpr, hclCtx, forEachVal, allowUnknown, excludableAddr, diags)
+}
+
+// evaluateForEachKeys converts the given known list, set or tuple into an
+// object by evaluating keyExpr for each of its elements.
+func evaluateForEachKeys(expr hcl.Expression, keyExpr hcl.Expression, hclCtx *hcl.EvalContext, forEachVal cty.Value, allowUnknown bool, excludableAddr addrs.Targetable, diags tfdiags.Diagnostics) (cty.Value, tfdiags.Diagnostics) {
+	nullMap := cty.NullVal(cty.Map(cty.DynamicPseudoType))
+
+	// Any marks on the collection as a whole apply to each of its elements.
+	unmarked, valMarks := forEachVal.Unmark()
+
+	result := make(map[string]cty.Value, unmarked.LengthInt())
+	unknownKey := false
+	for it := unmarked.ElementIterator(); it.Next(); {
+		_, elem := it.Element()
+		elem = elem.WithMarks(valMarks)
+
+		keyCtx := hclCtx.NewChild()
+		keyCtx.Variables = map[string]cty.Value{
+			"each": cty.ObjectVal(map[string]cty.Value{
+				"value": elem,
+			}),
+		}
+
+		keyVal, keyDiags := keyExpr.Value(keyCtx)
+		diags = diags.Append(keyDiags)
+		if keyDiags.HasErrors() {
+			continue
+		}
+		keyVal, deprDiags := marks.ExtractDeprecatedDiagnosticsWithExpr(keyVal, keyExpr)
+		diags = diags.Append(deprDiags)
+
+		key, moreDiags := forEachKeyString(keyExpr, keyCtx, keyVal, allowUnknown, excludableAddr)
+		diags = diags.Append(moreDiags)
+		if moreDiags.HasErrors() {
+			continue
+		}
+		if !keyVal.IsKnown() {
+			unknownKey = true
+			continue
+		}
+
+		if _, exists := result[key]; exists {
+			diags = diags.Append(&hcl.Diagnostic{
+				Severity:    hcl.DiagError,
+				Summary:     "Duplicate for_each key",
+				Detail:      fmt.Sprintf(`The "for_each_key" expression produced the key %q for more than one element of the "for_each" collection. Each instance must have a unique key, so the key expression must produce a different value for every element.`, key),
+				Subject:     keyExpr.Range().Ptr(),
+				Expression:  keyExpr,
+				EvalContext: keyCtx,
+			})
+			continue
+		}
+		result[key] = elem
+	}
+
+	switch {
+	case diags.HasErrors():
+		return nullMap, diags
+	case unknownKey:
+		return cty.DynamicVal, diags
+	}
+	return cty.ObjectVal(result), diags
+}
+
+// forEachKeyString validates a single result of a "for_each_key" expression
+// and converts it into an instance key string.
+//
+// If the key isn't known yet and unknown values are allowed, this returns an
+// empty string and no errors.
+func forEachKeyString(keyExpr hcl.Expression, keyCtx *hcl.EvalContext, keyVal cty.Value, allowUnknown bool, excludableAddr addrs.Targetable) (string, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	if keyVal.HasMark(marks.Sensitive) || keyVal.HasMark(marks.Ephemeral) {
+		return "", diags.Append(&hcl.Diagnostic{
+			Severity:    hcl.DiagError,
+			Summary:     "Invalid for_each_key result",
+			Detail:      "Sensitive or ephemeral values, or values derived from them, cannot be used as instance keys. If used, the value could be exposed as a resource instance key.",
+			Subject:     keyExpr.Range().Ptr(),
+			Expression:  keyExpr,
+			EvalContext: keyCtx,
+			Extra:       DiagnosticCausedByConfidentialValues(true),
+		})
+	}
+	keyVal, _ = keyVal.Unmark()
+
+	if !keyVal.IsKnown() {
+		if !allowUnknown {
+			diags = diags.Append(&hcl.Diagnostic{
+				Severity:    hcl.DiagError,
+				Summary:     "Invalid for_each_key result",
+				Detail:      errInvalidUnknownDetailKey + forEachCommandLineExcludeSuggestion(excludableAddr),
+				Subject:     keyExpr.Range().Ptr(),
+				Expression:  keyExpr,
+				EvalContext: keyCtx,
+				Extra:       DiagnosticCausedByUnknown(true),
+			})
+		}
+		return "", diags
+	}
+
+	if keyVal.IsNull() {
+		return "", diags.Append(&hcl.Diagnostic{
+			Severity:    hcl.DiagError,
+			Summary:     "Invalid for_each_key result",
+			Detail:      `The "for_each_key" expression produced a null value. Each element of the "for_each" collection must have a key.`,
+			Subject:     keyExpr.Range().Ptr(),
+			Expression:  keyExpr,
+			EvalContext: keyCtx,
+		})
+	}
+
+	strVal, err := convert.Convert(keyVal, cty.String)
+	if err != nil {
+		return "", diags.Append(&hcl.Diagnostic{
+			Severity:    hcl.DiagError,
+			Summary:     "Invalid for_each_key result",
+			Detail:      fmt.Sprintf(`The "for_each_key" expression must produce a string, but it produced a value of type %s.`, keyVal.Type().FriendlyName()),
+			Subject:     keyExpr.Range().Ptr(),
+			Expression:  keyExpr,
+			EvalContext: keyCtx,
+		})
+	}
+
+	return strVal.AsString(), diags
+}
@@ -0,0 +1,153 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package evalchecks
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hcltest"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/opentofu/opentofu/internal/lang/marks"
+)
+
+func TestEvaluateForEachKeyedExpression(t *testing.T) {
+	item := func(name string, size int) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"name": cty.StringVal(name),
+			"size": cty.NumberIntVal(int64(size)),
+		})
+	}
+
+	tests := map[string]struct {
+		ForEach cty.Value
+		Key     string
+		Want    map[string]cty.Value
+		WantErr string
+	}{
+		"list of objects": {
+			ForEach: cty.ListVal([]cty.Value{item("a", 1), item("b", 2)}),
+			Key:     "each.value.name",
+			Want: map[string]cty.Value{
+				"a": item("a", 1),
+				"b": item("b", 2),
+			},
+		},
+		"set of objects": {
+			ForEach: cty.SetVal([]cty.Value{item("a", 1), item("b", 2)}),
+			Key:     "each.value.name",
+			Want: map[string]cty.Value{
+				"a": item("a", 1),
+				"b": item("b", 2),
+			},
+		},
+		"tuple": {
+			ForEach: cty.TupleVal([]cty.Value{cty.StringVal("a"), cty.True}),
+			Key:     `"k-${each.value}"`,
+			Want: map[string]cty.Value{
+				"k-a":    cty.StringVal("a"),
+				"k-true": cty.True,
+			},
+		},
+		"number keys": {
+			ForEach: cty.ListVal([]cty.Value{item("a", 1), item("b", 2)}),
+			Key:     "each.value.size",
+			Want: map[string]cty.Value{
+				"1": item("a", 1),
+				"2": item("b", 2),
+			},
+		},
+		"empty list": {
+			ForEach: cty.ListValEmpty(cty.DynamicPseudoType),
+			Key:     "each.value.name",
+			Want:    map[string]cty.Value{},
+		},
+		"duplicate keys": {
+			ForEach: cty.ListVal([]cty.Value{item("a", 1), item("b", 2), item("a", 3)}),
+			Key:     "each.value.name",
+			WantErr: `Duplicate for_each key: The "for_each_key" expression produced the key "a" for more than one element`,
+		},
+		"map": {
+			ForEach: cty.MapVal(map[string]cty.Value{"a": item("a", 1)}),
+			Key:     "each.value.name",
+			WantErr: `when "for_each_key" is set, the "for_each" argument must be a list, set, or tuple, and you have provided a value of type map of object`,
+		},
+		"null key": {
+			ForEach: cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{"name": cty.NullVal(cty.String)})}),
+			Key:     "each.value.name",
+			WantErr: `The "for_each_key" expression produced a null value`,
+		},
+		"non-string key": {
+			ForEach: cty.ListVal([]cty.Value{item("a", 1)}),
+			Key:     "each.value",
+			WantErr: `The "for_each_key" expression must produce a string, but it produced a value of type object`,
+		},
+		"unknown key": {
+			ForEach: cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{"name": cty.UnknownVal(cty.String)})}),
+			Key:     "each.value.name",
+			WantErr: `The "for_each_key" expression produced a key derived from resource attributes that cannot be determined until apply`,
+		},
+		"unknown collection": {
+			ForEach: cty.UnknownVal(cty.List(item("a", 1).Type())),
+			Key:     "each.value.name",
+			WantErr: `The "for_each" collection is derived from resource attributes that cannot be determined until apply`,
+		},
+		"sensitive key": {
+			ForEach: cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{"name": cty.StringVal("a").Mark(marks.Sensitive)})}),
+			Key:     "each.value.name",
+			WantErr: "Sensitive or ephemeral values, or values derived from them, cannot be used as instance keys",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			keyExpr, hclDiags := hclsyntax.ParseExpression([]byte(test.Key), "", hcl.InitialPos)
+			if hclDiags.HasErrors() {
+				t.Fatal(hclDiags.Error())
+			}
+			got, diags := EvaluateForEachKeyedExpression(hcltest.MockExprLiteral(test.ForEach), keyExpr, mockRefsFunc(), nil)
+			if test.WantErr != "" {
+				if !diags.HasErrors() {
+					t.Fatalf("expected error containing %q, got none", test.WantErr)
+				}
+				if gotErr := diags.Err().Error(); !strings.Contains(gotErr, test.WantErr) {
+					t.Fatalf("expected error containing %q, got: %s", test.WantErr, gotErr)
+				}
+				return
+			}
+			if diags.HasErrors() {
+				t.Fatal(diags.Err())
+			}
+			if len(got) != len(test.Want) {
+				t.Fatalf("wrong result\ngot:  %#v\nwant: %#v", got, test.Want)
+			}
+			for k, want := range test.Want {
+				if !want.RawEquals(got[k]) {
+					t.Errorf("wrong value for key %q\ngot:  %#v\nwant: %#v", k, got[k], want)
+				}
+			}
+		})
+	}
+}
+
+func TestEvaluateForEachKeyedExpressionValue_unknown(t *testing.T) {
+	keyExpr, hclDiags := hclsyntax.ParseExpression([]byte("each.value.name"), "", hcl.InitialPos)
+	if hclDiags.HasErrors() {
+		t.Fatal(hclDiags.Error())
+	}
+	forEach := cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{"name": cty.UnknownVal(cty.String)})})
+
+	const unknownsAllowed = true
+	got, diags := EvaluateForEachKeyedExpressionValue(hcltest.MockExprLiteral(forEach), keyExpr, mockRefsFunc(), unknownsAllowed, nil)
+	if diags.HasErrors() {
+		t.Fatal(diags.Err())
+	}
+	if got.IsKnown() {
+		t.Fatalf("expected an unknown result, got %#v", got)
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package evalchecks
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// UnknownExpansionDiagnostic returns an error diagnostic reporting that the
+// instances of an object cannot be determined because its "count" or
+// "for_each" argument, given in expr, depends on values that will be known
+// only after apply.
+//
+// Unlike the generic errors returned by [EvaluateCountExpression] and
+// [EvaluateForEachExpression], the diagnostic lists the specific unknown
+// values described in the given analysis and suggests a sequence of
+// -target options to converge over multiple plan/apply rounds. The analysis
+// is also available to callers through [tfdiags.DiagnosticUnknownExpansion],
+// for example to include it in machine-readable output.
+func UnknownExpansionDiagnostic(expr hcl.Expression, hclCtx *hcl.EvalContext, expansion *tfdiags.UnknownExpansion) *hcl.Diagnostic {
+	return &hcl.Diagnostic{
+		Severity:    hcl.DiagError,
+		Summary:     fmt.Sprintf("Invalid %s argument", expansion.Argument),
+		Detail:      unknownExpansionDetail(expansion, runtime.GOOS),
+		Subject:     expr.Range().Ptr(),
+		Expression:  expr,
+		EvalContext: hclCtx,
+		Extra:       diagnosticUnknownExpansion{expansion: expansion},
+	}
+}
+
+func unknownExpansionDetail(expansion *tfdiags.UnknownExpansion, goos string) string {
+	var buf strings.Builder
+	if expansion.Argument == "count" {
+		fmt.Fprintf(&buf, "The \"count\" value depends on values that cannot be determined until apply, so OpenTofu cannot predict how many instances of %s will be created.", expansion.Addr)
+	} else {
+		fmt.Fprintf(&buf, "The %q value depends on values that cannot be determined until apply, so OpenTofu cannot determine the full set of keys that will identify the instances of %s.", expansion.Argument, expansion.Addr)
+	}
+
+	if len(expansion.UnknownValues) != 0 {
+		fmt.Fprintf(&buf, "\n\nThe %q value depends on the following values, which will be known only after apply:\n", expansion.Argument)
+		for _, v := range expansion.UnknownValues {
+			fmt.Fprintf(&buf, "  - %s\n", v)
+		}
+	} else {
+		buf.WriteString("\n")
+	}
+
+	if len(expansion.Targets) == 0 {
+		fmt.Fprintf(
+			&buf,
+			"\nTo work around this, use the planning option -exclude=%s to first apply without this object, and then apply normally to converge.",
+			commandLineArgumentsSuggestion([]string{expansion.Addr}, goos),
+		)
+		return buf.String()
+	}
+
+	args := make([]string, len(expansion.Targets))
+	for i, target := range expansion.Targets {
+		args[i] = "-target=" + commandLineArgumentsSuggestion([]string{target}, goos)
+	}
+	fmt.Fprintf(
+		&buf,
+		"\nTo work around this, first apply only the objects that these values come from:\n  tofu apply %s\nand then apply normally to converge.",
+		strings.Join(args, " "),
+	)
+	return buf.String()
+}
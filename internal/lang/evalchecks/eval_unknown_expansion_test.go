@@ -0,0 +1,114 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package evalchecks
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2/hcltest"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+func TestUnknownExpansionDiagnostic(t *testing.T) {
+	expansion := &tfdiags.UnknownExpansion{
+		Addr:          "module.app",
+		Argument:      "count",
+		UnknownValues: []string{"aws_instance.a.id"},
+		Targets:       []string{"aws_instance.a"},
+	}
+	var diags tfdiags.Diagnostics
+	diags = diags.Append(UnknownExpansionDiagnostic(hcltest.MockExprLiteral(cty.UnknownVal(cty.Number)), nil, expansion))
+
+	diag := diags[0]
+	if got, want := diag.Description().Summary, "Invalid count argument"; got != want {
+		t.Errorf("wrong summary\ngot:  %s\nwant: %s", got, want)
+	}
+	if got := tfdiags.DiagnosticUnknownExpansion(diag); got != expansion {
+		t.Errorf("wrong unknown expansion %#v", got)
+	}
+	if !tfdiags.DiagnosticCausedByUnknown(diag) {
+		t.Error("diagnostic is not marked as caused by unknown values")
+	}
+}
+
+func TestUnknownExpansionDetail(t *testing.T) {
+	tests := map[string]struct {
+		expansion *tfdiags.UnknownExpansion
+		goos      string
+		want      string
+	}{
+		"count with targets": {
+			&tfdiags.UnknownExpansion{
+				Addr:          "module.app",
+				Argument:      "count",
+				UnknownValues: []string{"aws_instance.a.id", "module.net.aws_vpc.main.id"},
+				Targets:       []string{"aws_instance.a", "module.net.aws_vpc.main"},
+			},
+			"linux",
+			`The "count" value depends on values that cannot be determined until apply, so OpenTofu cannot predict how many instances of module.app will be created.
+
+The "count" value depends on the following values, which will be known only after apply:
+  - aws_instance.a.id
+  - module.net.aws_vpc.main.id
+
+To work around this, first apply only the objects that these values come from:
+  tofu apply -target=aws_instance.a -target=module.net.aws_vpc.main
+and then apply normally to converge.`,
+		},
+		"for_each with quoted target": {
+			&tfdiags.UnknownExpansion{
+				Addr:          "module.app",
+				Argument:      "for_each",
+				UnknownValues: []string{`module.net["a b"].id`},
+				Targets:       []string{`module.net["a b"]`},
+			},
+			"linux",
+			`The "for_each" value depends on values that cannot be determined until apply, so OpenTofu cannot determine the full set of keys that will identify the instances of module.app.
+
+The "for_each" value depends on the following values, which will be known only after apply:
+  - module.net["a b"].id
+
+To work around this, first apply only the objects that these values come from:
+  tofu apply -target='module.net["a b"]'
+and then apply normally to converge.`,
+		},
+		"no targets": {
+			&tfdiags.UnknownExpansion{
+				Addr:          "module.app",
+				Argument:      "count",
+				UnknownValues: []string{"var.enabled"},
+			},
+			"windows",
+			`The "count" value depends on values that cannot be determined until apply, so OpenTofu cannot predict how many instances of module.app will be created.
+
+The "count" value depends on the following values, which will be known only after apply:
+  - var.enabled
+
+To work around this, use the planning option -exclude=module.app to first apply without this object, and then apply normally to converge.`,
+		},
+		"no unknown values": {
+			&tfdiags.UnknownExpansion{
+				Addr:     "module.app",
+				Argument: "count",
+			},
+			"linux",
+			`The "count" value depends on values that cannot be determined until apply, so OpenTofu cannot predict how many instances of module.app will be created.
+
+To work around this, use the planning option -exclude=module.app to first apply without this object, and then apply normally to converge.`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := unknownExpansionDetail(test.expansion, test.goos)
+			if got != test.want {
+				t.Errorf("wrong detail\ngot:\n%s\n\nwant:\n%s", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package funcs
+
+import (
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/ext/customdecode"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/function"
+)
+
+// AttemptFunc evaluates the expression given in its argument and returns an
+// object describing the outcome: the "value" attribute is the result of the
+// expression, or null if it failed, and the "error" attribute is the error
+// message if it failed, or null if it succeeded.
+//
+// Like "try" and "can", it can only capture the errors that occur during
+// dynamic evaluation of the expression.
+var AttemptFunc = function.New(&function.Spec{
+	Params: []function.Parameter{
+		{
+			Name: "expression",
+			Type: customdecode.ExpressionClosureType,
+		},
+	},
+	Type: func(args []cty.Value) (cty.Type, error) {
+		return attempt(args[0]).Type(), nil
+	},
+	Impl: func(args []cty.Value, retType cty.Type) (cty.Value, error) {
+		return attempt(args[0]), nil
+	},
+})
+
+func attempt(arg cty.Value) cty.Value {
+	closure := customdecode.ExpressionClosureFromVal(arg)
+	v, diags := closure.Value()
+	if diags.HasErrors() {
+		// The messages omit the source location, which is always the
+		// argument of this call, so that they can be compared in conditions
+		// and test assertions.
+		var msgs []string
+		for _, diag := range diags {
+			if diag.Severity != hcl.DiagError {
+				continue
+			}
+			msg := diag.Summary
+			if diag.Detail != "" {
+				msg += ": " + diag.Detail
+			}
+			msgs = append(msgs, msg)
+		}
+		return cty.ObjectVal(map[string]cty.Value{
+			"value": cty.NullVal(cty.DynamicPseudoType),
+			"error": cty.StringVal(strings.Join(msgs, "\n")),
+		})
+	}
+
+	if !v.IsWhollyKnown() {
+		// As with "try", an expression whose result isn't known yet might
+		// still fail once it is, so we can't yet say what the outcome is or
+		// even what type the value will have.
+		return cty.DynamicVal
+	}
+
+	return cty.ObjectVal(map[string]cty.Value{
+		"value": v,
+		"error": cty.NullVal(cty.String),
+	})
+}
+
+// Attempt evaluates the given expression closure, returning an object
+// describing its value or the error it produced.
+func Attempt(expr cty.Value) (cty.Value, error) {
+	return AttemptFunc.Call([]cty.Value{expr})
+}
@@ -0,0 +1,73 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package funcs
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/function"
+)
+
+func TestAttempt(t *testing.T) {
+	evalCtx := &hcl.EvalContext{
+		Variables: map[string]cty.Value{
+			"obj": cty.ObjectVal(map[string]cty.Value{
+				"bar": cty.StringVal("baz"),
+			}),
+			"unknown": cty.UnknownVal(cty.String),
+		},
+		Functions: map[string]function.Function{
+			"attempt": AttemptFunc,
+		},
+	}
+
+	tests := []struct {
+		expr string
+		want cty.Value
+	}{
+		{
+			`attempt(obj.bar)`,
+			cty.ObjectVal(map[string]cty.Value{
+				"value": cty.StringVal("baz"),
+				"error": cty.NullVal(cty.String),
+			}),
+		},
+		{
+			`attempt(obj.boop)`,
+			cty.ObjectVal(map[string]cty.Value{
+				"value": cty.NullVal(cty.DynamicPseudoType),
+				"error": cty.StringVal(`Unsupported attribute: This object does not have an attribute named "boop".`),
+			}),
+		},
+		{
+			`attempt(unknown)`,
+			cty.DynamicVal,
+		},
+		{
+			`attempt(obj.boop).error != null`,
+			cty.True,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expr, func(t *testing.T) {
+			expr, diags := hclsyntax.ParseExpression([]byte(test.expr), "", hcl.InitialPos)
+			if diags.HasErrors() {
+				t.Fatalf("unexpected parse errors: %s", diags.Error())
+			}
+			got, diags := expr.Value(evalCtx)
+			if diags.HasErrors() {
+				t.Fatalf("unexpected errors: %s", diags.Error())
+			}
+			if !got.RawEquals(test.want) {
+				t.Errorf("wrong result\ngot:  %#v\nwant: %#v", got, test.want)
+			}
+		})
+	}
+}
@@ -40,6 +40,48 @@ func MakeStaticTimestampFunc(static time.Time) function.Function {
 	})
 }
 
+// MakeStableTimestampFunc constructs a function that returns a string
+// representation of the date and time specified by the provided argument,
+// optionally truncated to a multiple of the granularity given in its
+// argument so that it remains the same across runs within that period.
+func MakeStableTimestampFunc(static time.Time) function.Function {
+	return function.New(&function.Spec{
+		Params: []function.Parameter{},
+		VarParam: &function.Parameter{
+			Name: "granularity",
+			Type: cty.String,
+		},
+		Type:         function.StaticReturnType(cty.String),
+		RefineResult: refineNotNull,
+		Impl: func(args []cty.Value, retType cty.Type) (cty.Value, error) {
+			if len(args) > 1 {
+				return cty.UnknownVal(cty.String), function.NewArgErrorf(1, "too many arguments; only a single granularity is allowed")
+			}
+			var granularity time.Duration
+			if len(args) == 1 {
+				var err error
+				granularity, err = time.ParseDuration(args[0].AsString())
+				if err != nil {
+					return cty.UnknownVal(cty.String), function.NewArgError(0, err)
+				}
+				if granularity <= 0 {
+					return cty.UnknownVal(cty.String), function.NewArgErrorf(0, "the granularity must be greater than zero")
+				}
+			}
+			// As with plantimestamp, the timestamp is zero during the
+			// validation phase and so the result isn't known yet.
+			if static.IsZero() {
+				return cty.UnknownVal(cty.String), nil
+			}
+			ts := static.UTC()
+			if granularity > 0 {
+				ts = ts.Truncate(granularity)
+			}
+			return cty.StringVal(ts.Format(time.RFC3339)), nil
+		},
+	})
+}
+
 // TimeAddFunc constructs a function that adds a duration to a timestamp, returning a new timestamp.
 var TimeAddFunc = function.New(&function.Spec{
 	Params: []function.Parameter{
@@ -231,3 +231,74 @@ func TestMakeStaticTimestampFunc(t *testing.T) {
 		})
 	}
 }
+
+func TestMakeStableTimestampFunc(t *testing.T) {
+	static := time.Date(2024, 3, 14, 15, 9, 26, 0, time.UTC)
+	tests := []struct {
+		Static time.Time
+		Args   []cty.Value
+		Want   cty.Value
+		Err    string
+	}{
+		{
+			Static: static,
+			Want:   cty.StringVal("2024-03-14T15:09:26Z"),
+		},
+		{
+			Static: static,
+			Args:   []cty.Value{cty.StringVal("1h")},
+			Want:   cty.StringVal("2024-03-14T15:00:00Z"),
+		},
+		{
+			Static: static,
+			Args:   []cty.Value{cty.StringVal("24h")},
+			Want:   cty.StringVal("2024-03-14T00:00:00Z"),
+		},
+		{
+			Static: time.Date(2024, 3, 14, 16, 9, 26, 0, time.FixedZone("CET", 3600)),
+			Args:   []cty.Value{cty.StringVal("24h")},
+			Want:   cty.StringVal("2024-03-14T00:00:00Z"),
+		},
+		{
+			Static: time.Time{},
+			Args:   []cty.Value{cty.StringVal("24h")},
+			Want:   cty.UnknownVal(cty.String).RefineNotNull(),
+		},
+		{
+			Static: static,
+			Args:   []cty.Value{cty.StringVal("a day")},
+			Err:    `time: invalid duration "a day"`,
+		},
+		{
+			Static: static,
+			Args:   []cty.Value{cty.StringVal("-1h")},
+			Err:    "the granularity must be greater than zero",
+		},
+		{
+			Static: static,
+			Args:   []cty.Value{cty.StringVal("1h"), cty.StringVal("1m")},
+			Err:    "too many arguments; only a single granularity is allowed",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("timestamp_stable(%#v)", test.Args), func(t *testing.T) {
+			got, err := MakeStableTimestampFunc(test.Static).Call(test.Args)
+			if test.Err != "" {
+				if err == nil {
+					t.Fatal("succeeded; want error")
+				}
+				if err.Error() != test.Err {
+					t.Fatalf("wrong error\ngot:  %s\nwant: %s", err, test.Err)
+				}
+				return
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !got.RawEquals(test.Want) {
+				t.Errorf("wrong result\ngot:  %#v\nwant: %#v", got, test.Want)
+			}
+		})
+	}
+}
@@ -43,6 +43,10 @@ var DescriptionList = map[string]descriptionEntry{
 		Description:      "`anytrue` returns `true` if any element in a given collection is `true` or `\"true\"`. It also returns `false` if the collection is empty.",
 		ParamDescription: []string{""},
 	},
+	"attempt": {
+		Description:      "`attempt` evaluates the given expression and returns an object whose `value` attribute is the result of the expression and whose `error` attribute is the error message if the evaluation failed.",
+		ParamDescription: []string{""},
+	},
 	"base64decode": {
 		Description:      "`base64decode` takes a string containing a Base64 character sequence and returns the original string.",
 		ParamDescription: []string{""},
@@ -157,6 +161,10 @@ var DescriptionList = map[string]descriptionEntry{
 		Description:      "`csvdecode` decodes a string containing CSV-formatted data and produces a list of maps representing that data.",
 		ParamDescription: []string{""},
 	},
+	"data": {
+		Description:      "`data` reads a data source of the given type using the given configuration, without it being declared in the configuration. It is only available in the `tofu console` command.",
+		ParamDescription: []string{"", ""},
+	},
 	"dirname": {
 		Description:      "`dirname` takes a string containing a filesystem path and removes the last portion from it.",
 		ParamDescription: []string{""},
@@ -353,6 +361,10 @@ var DescriptionList = map[string]descriptionEntry{
 		Description:      "`replace` searches a given string for another given substring, and replaces each occurrence with a given replacement string.",
 		ParamDescription: []string{"", "", ""},
 	},
+	"retryuntil": {
+		Description:      "`retryuntil` evaluates the given condition repeatedly until it returns `true` or until the given timeout has passed, and returns whether the condition was met. It can only be used in the assertions of check blocks and test run blocks.",
+		ParamDescription: []string{"", "", ""},
+	},
 	"reverse": {
 		Description:      "`reverse` takes a sequence and produces a new sequence of the same length with all of the same elements as the given sequence but in reverse order.",
 		ParamDescription: []string{""},
@@ -459,6 +471,10 @@ var DescriptionList = map[string]descriptionEntry{
 		Description:      "`timestamp` returns a UTC timestamp string in [RFC 3339](https://tools.ietf.org/html/rfc3339) format.",
 		ParamDescription: []string{},
 	},
+	"timestamp_stable": {
+		Description:      "`timestamp_stable` returns a UTC timestamp string in [RFC 3339](https://tools.ietf.org/html/rfc3339) format, fixed to a constant time representing the time of the plan and optionally truncated to a multiple of the given duration.",
+		ParamDescription: []string{""},
+	},
 	"plantimestamp": {
 		Description:      "`plantimestamp` returns a UTC timestamp string in [RFC 3339](https://tools.ietf.org/html/rfc3339) format, fixed to a constant time representing the time of the plan.",
 		ParamDescription: []string{},
@@ -0,0 +1,170 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package funcs
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/ext/customdecode"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+	"github.com/zclconf/go-cty/cty/function"
+)
+
+// defaultRetryInterval is the time that RetryUntilFunc waits between two
+// evaluations of its condition when the call doesn't specify an interval.
+const defaultRetryInterval = 5 * time.Second
+
+// maxRetryTimeout is the longest timeout that RetryUntilFunc accepts, so that
+// a mistaken duration can't hold up an operation for hours.
+const maxRetryTimeout = 10 * time.Minute
+
+// errRetryInterrupted is returned by RetryUntilFunc when the operation it is
+// evaluated for is interrupted while it is waiting.
+var errRetryInterrupted = errors.New("interrupted while waiting for the condition to be met")
+
+// RetryUntilFunc is the retryuntil function for contexts that can't be
+// interrupted. Refer to [MakeRetryUntilFunc] for its behavior.
+var RetryUntilFunc = MakeRetryUntilFunc(nil)
+
+// MakeRetryUntilFunc returns a function that evaluates the condition
+// expression given in its first argument repeatedly until it returns true or
+// until the timeout given in its second argument has passed, waiting for the
+// optional interval given in its third argument between two evaluations. The
+// timeout must not be longer than ten minutes.
+//
+// It returns true if the condition was met within the timeout and false
+// otherwise. If the last evaluation of the condition failed then the
+// function returns its error instead of false.
+//
+// The values that the condition refers to don't change between evaluations,
+// so retrying is only useful for conditions that call functions whose results
+// can change over time, such as provider-defined functions that query a
+// remote system.
+//
+// The function stops waiting and returns an error as soon as the given
+// channel is closed, which callers use to honor an interrupted operation. A
+// nil channel is never closed.
+func MakeRetryUntilFunc(stopped <-chan struct{}) function.Function {
+	return function.New(&function.Spec{
+		Params: []function.Parameter{
+			{
+				Name: "condition",
+				Type: customdecode.ExpressionClosureType,
+			},
+			{
+				Name: "timeout",
+				Type: cty.String,
+			},
+		},
+		VarParam: &function.Parameter{
+			Name: "interval",
+			Type: cty.String,
+		},
+		Type:         function.StaticReturnType(cty.Bool),
+		RefineResult: refineNotNull,
+		Impl: func(args []cty.Value, retType cty.Type) (cty.Value, error) {
+			if len(args) > 3 {
+				return cty.UnknownVal(cty.Bool), function.NewArgErrorf(3, "too many arguments; only an optional interval may follow the timeout")
+			}
+			timeout, err := parseRetryDuration(args[1])
+			if err != nil {
+				return cty.UnknownVal(cty.Bool), function.NewArgError(1, err)
+			}
+			if timeout > maxRetryTimeout {
+				return cty.UnknownVal(cty.Bool), function.NewArgErrorf(1, "the timeout must not be longer than %s", maxRetryTimeout)
+			}
+			interval := defaultRetryInterval
+			if len(args) == 3 {
+				interval, err = parseRetryDuration(args[2])
+				if err != nil {
+					return cty.UnknownVal(cty.Bool), function.NewArgError(2, err)
+				}
+				if interval == 0 {
+					return cty.UnknownVal(cty.Bool), function.NewArgErrorf(2, "the interval must be greater than zero")
+				}
+			}
+
+			closure := customdecode.ExpressionClosureFromVal(args[0])
+			deadline := time.Now().Add(timeout)
+			for {
+				v, err := retryCondition(closure)
+				if err == nil && (!v.IsKnown() || v.True()) {
+					// An unknown result can't become known by retrying, so
+					// we return it as-is for a later evaluation to decide.
+					return v, nil
+				}
+				if !time.Now().Add(interval).Before(deadline) {
+					if err != nil {
+						return cty.UnknownVal(cty.Bool), err
+					}
+					return cty.False, nil
+				}
+				select {
+				case <-stopped:
+					return cty.UnknownVal(cty.Bool), errRetryInterrupted
+				case <-time.After(interval):
+				}
+			}
+		},
+	})
+}
+
+// retryCondition evaluates the given condition closure once, returning its
+// result as a bool value with any marks removed.
+func retryCondition(closure *customdecode.ExpressionClosure) (cty.Value, error) {
+	v, diags := closure.Value()
+	if diags.HasErrors() {
+		return cty.UnknownVal(cty.Bool), diagsError(diags)
+	}
+	v, _ = v.Unmark()
+	v, err := convert.Convert(v, cty.Bool)
+	if err != nil {
+		return cty.UnknownVal(cty.Bool), fmt.Errorf("invalid condition result: %w", err)
+	}
+	if v.IsNull() {
+		return cty.UnknownVal(cty.Bool), errors.New("the condition must return either true or false, not null")
+	}
+	return v, nil
+}
+
+func parseRetryDuration(v cty.Value) (time.Duration, error) {
+	d, err := time.ParseDuration(v.AsString())
+	if err != nil {
+		return 0, err
+	}
+	if d < 0 {
+		return 0, errors.New("the duration must not be negative")
+	}
+	return d, nil
+}
+
+// diagsError returns an error summarizing the error diagnostics in the
+// given diagnostics.
+func diagsError(diags hcl.Diagnostics) error {
+	var errs []error
+	for _, diag := range diags {
+		if diag.Severity != hcl.DiagError {
+			continue
+		}
+		msg := diag.Summary
+		if diag.Detail != "" {
+			msg += ": " + diag.Detail
+		}
+		errs = append(errs, errors.New(msg))
+	}
+	return errors.Join(errs...)
+}
+
+// RetryUntil evaluates the given condition closure until it returns true or
+// until the given timeout has passed.
+func RetryUntil(condition, timeout cty.Value, interval ...cty.Value) (cty.Value, error) {
+	args := append([]cty.Value{condition, timeout}, interval...)
+	return RetryUntilFunc.Call(args)
+}
@@ -0,0 +1,149 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package funcs
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/function"
+)
+
+func TestRetryUntil(t *testing.T) {
+	// The "poll" function returns true once it has been called three times,
+	// standing in for a function that queries a remote system.
+	var calls int
+	poll := function.New(&function.Spec{
+		Type: function.StaticReturnType(cty.Bool),
+		Impl: func(args []cty.Value, retType cty.Type) (cty.Value, error) {
+			calls++
+			return cty.BoolVal(calls >= 3), nil
+		},
+	})
+	evalCtx := &hcl.EvalContext{
+		Variables: map[string]cty.Value{
+			"obj": cty.ObjectVal(map[string]cty.Value{
+				"bar": cty.StringVal("baz"),
+			}),
+			"unknown": cty.UnknownVal(cty.Bool),
+		},
+		Functions: map[string]function.Function{
+			"retryuntil": RetryUntilFunc,
+			"poll":       poll,
+		},
+	}
+
+	tests := []struct {
+		expr      string
+		want      cty.Value
+		wantCalls int
+		wantErr   string
+	}{
+		{
+			expr: `retryuntil(obj.bar == "baz", "1s")`,
+			want: cty.True,
+		},
+		{
+			expr:      `retryuntil(poll(), "1s", "10ms")`,
+			want:      cty.True,
+			wantCalls: 3,
+		},
+		{
+			expr:      `retryuntil(poll(), "15ms", "10ms")`,
+			want:      cty.False,
+			wantCalls: 2,
+		},
+		{
+			expr: `retryuntil(obj.bar == "boop", "0s")`,
+			want: cty.False,
+		},
+		{
+			expr: `retryuntil(unknown, "1s")`,
+			want: cty.UnknownVal(cty.Bool).RefineNotNull(),
+		},
+		{
+			expr:    `retryuntil(obj.boop, "0s")`,
+			wantErr: `Unsupported attribute: This object does not have an attribute named "boop".`,
+		},
+		{
+			expr:    `retryuntil(obj.bar, "0s")`,
+			wantErr: `invalid condition result: a bool is required`,
+		},
+		{
+			expr:    `retryuntil(true, "soon")`,
+			wantErr: `time: invalid duration "soon"`,
+		},
+		{
+			expr:    `retryuntil(true, "1s", "0s")`,
+			wantErr: `the interval must be greater than zero`,
+		},
+		{
+			expr:    `retryuntil(true, "11m")`,
+			wantErr: `the timeout must not be longer than 10m0s`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expr, func(t *testing.T) {
+			calls = 0
+			expr, diags := hclsyntax.ParseExpression([]byte(test.expr), "", hcl.InitialPos)
+			if diags.HasErrors() {
+				t.Fatalf("unexpected problems: %s", diags.Error())
+			}
+
+			got, diags := expr.Value(evalCtx)
+			if test.wantErr != "" {
+				if !diags.HasErrors() {
+					t.Fatal("succeeded; want error")
+				}
+				if got := diags[0].Detail; !strings.Contains(got, test.wantErr) {
+					t.Fatalf("wrong error\ngot:  %s\nwant: %s", got, test.wantErr)
+				}
+				return
+			}
+			if diags.HasErrors() {
+				t.Fatalf("unexpected problems: %s", diags.Error())
+			}
+			if !got.RawEquals(test.want) {
+				t.Errorf("wrong result\ngot:  %#v\nwant: %#v", got, test.want)
+			}
+			if calls != test.wantCalls {
+				t.Errorf("wrong number of calls %d; want %d", calls, test.wantCalls)
+			}
+		})
+	}
+}
+
+func TestRetryUntil_stopped(t *testing.T) {
+	stopped := make(chan struct{})
+	close(stopped)
+	evalCtx := &hcl.EvalContext{
+		Functions: map[string]function.Function{
+			"retryuntil": MakeRetryUntilFunc(stopped),
+		},
+	}
+
+	expr, diags := hclsyntax.ParseExpression([]byte(`retryuntil(false, "10m", "1m")`), "", hcl.InitialPos)
+	if diags.HasErrors() {
+		t.Fatalf("unexpected problems: %s", diags.Error())
+	}
+
+	start := time.Now()
+	_, diags = expr.Value(evalCtx)
+	if !diags.HasErrors() {
+		t.Fatal("succeeded; want error")
+	}
+	if got, want := diags[0].Detail, "interrupted while waiting"; !strings.Contains(got, want) {
+		t.Fatalf("wrong error\ngot:  %s\nwant: %s", got, want)
+	}
+	if elapsed := time.Since(start); elapsed > time.Minute {
+		t.Errorf("waited %s after being stopped", elapsed)
+	}
+}
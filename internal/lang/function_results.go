@@ -0,0 +1,201 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lang
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/function"
+)
+
+// memoizableFunctions are the names of the built-in functions whose results
+// can be safely reused for identical arguments throughout a single graph walk.
+//
+// These are functions that are both referentially transparent and relatively
+// expensive to call, and so repeated calls with the same arguments from many
+// different referencing nodes can dominate evaluation time. Functions that
+// are cheap to call are intentionally excluded because the cost of building
+// a cache key for them would exceed the cost of just calling them again.
+var memoizableFunctions = []string{
+	"csvdecode",
+	"jsondecode",
+	"templatefile",
+	"templatestring",
+	"yamldecode",
+}
+
+// FunctionResults is a concurrency-safe cache of function call results that
+// can be shared between many [Scope] objects participating in the same
+// operation, so that expensive pure function calls with identical arguments
+// are evaluated only once.
+//
+// The zero value is not usable; use [NewFunctionResults] to construct one.
+type FunctionResults struct {
+	mu      sync.Mutex
+	results map[string]cty.Value
+}
+
+// NewFunctionResults returns a new, empty [FunctionResults].
+func NewFunctionResults() *FunctionResults {
+	return &FunctionResults{
+		results: make(map[string]cty.Value),
+	}
+}
+
+// memoize wraps the given function so that its successful results are
+// recorded in the receiver and reused for any later call with equal
+// arguments from a scope with the same base directory.
+//
+// The function machinery removes the marks from the arguments for any
+// parameter that doesn't allow marked values before calling the wrapper,
+// and applies them to the result afterwards, so calls that differ only in
+// those marks share a result. Calls with marked arguments for parameters
+// that do allow them, or with arguments that are not wholly known, are
+// always passed through to the underlying function, because the result for
+// those depends on more than just the plain argument values.
+func (r *FunctionResults) memoize(name, baseDir string, fn function.Function) function.Function {
+	return function.New(&function.Spec{
+		Description: fn.Description(),
+		Params:      fn.Params(),
+		VarParam:    fn.VarParam(),
+		Type:        fn.ReturnTypeForValues,
+		Impl: func(args []cty.Value, retType cty.Type) (cty.Value, error) {
+			key, ok := functionResultKey(name, baseDir, args)
+			if !ok {
+				return fn.Call(args)
+			}
+
+			r.mu.Lock()
+			ret, cached := r.results[key]
+			r.mu.Unlock()
+			if cached {
+				return ret, nil
+			}
+
+			ret, err := fn.Call(args)
+			if err != nil {
+				// Errors are never cached, so that e.g. a file that is
+				// created later in the walk can still be read successfully.
+				return ret, err
+			}
+
+			r.mu.Lock()
+			r.results[key] = ret
+			r.mu.Unlock()
+			return ret, nil
+		},
+	})
+}
+
+// functionResultKey returns a string that uniquely identifies a call to the
+// named function with the given arguments, or false if the call is not
+// eligible for memoization.
+//
+// The base directory is included because functions like templatefile
+// resolve relative paths against it. The arguments are represented by a
+// SHA-256 hash rather than included directly, so that the cache doesn't keep
+// a copy of every large document that was decoded, and the hash is computed
+// directly from the values without serializing them first.
+func functionResultKey(name, baseDir string, args []cty.Value) (string, bool) {
+	h := sha256.New()
+	for _, arg := range args {
+		if !arg.IsWhollyKnown() || arg.ContainsMarked() {
+			return "", false
+		}
+		if !writeFunctionResultKeyValue(h, arg) {
+			return "", false
+		}
+	}
+
+	var buf strings.Builder
+	buf.WriteString(name)
+	buf.WriteByte(0)
+	buf.WriteString(baseDir)
+	buf.WriteByte(0)
+	buf.Write(h.Sum(nil))
+	return buf.String(), true
+}
+
+// writeFunctionResultKeyValue writes an unambiguous encoding of the given
+// wholly-known, unmarked value to w, for functionResultKey. It returns false
+// if the value can't be encoded because it contains a capsule value.
+//
+// Each value starts with a tag for its kind of type, and each variable-length
+// part with its length, so that no two different values have the same
+// encoding. Values of different types with otherwise equal encodings, such as
+// empty lists of different element types, are distinguished by also writing
+// the full type of null values and empty collections.
+func writeFunctionResultKeyValue(w io.Writer, val cty.Value) bool {
+	ty := val.Type()
+	writeString := func(tag byte, s string) {
+		fmt.Fprintf(w, "%c%d:%s", tag, len(s), s)
+	}
+
+	if val.IsNull() {
+		writeString('z', ty.GoString())
+		return true
+	}
+
+	switch {
+	case ty == cty.String:
+		writeString('s', val.AsString())
+	case ty == cty.Number:
+		writeString('n', val.AsBigFloat().Text('g', -1))
+	case ty == cty.Bool:
+		if val.True() {
+			writeString('b', "true")
+		} else {
+			writeString('b', "false")
+		}
+	case ty.IsObjectType() || ty.IsMapType():
+		tag := byte('o')
+		if ty.IsMapType() {
+			tag = 'm'
+		}
+		if val.LengthInt() == 0 {
+			writeString(tag, ty.GoString())
+			return true
+		}
+		fmt.Fprintf(w, "%c%d:", tag, val.LengthInt())
+		// ElementIterator returns the attributes of an object and the
+		// elements of a map in lexical order by name.
+		for it := val.ElementIterator(); it.Next(); {
+			k, v := it.Element()
+			writeString('k', k.AsString())
+			if !writeFunctionResultKeyValue(w, v) {
+				return false
+			}
+		}
+	case ty.IsListType() || ty.IsSetType() || ty.IsTupleType():
+		tag := byte('l')
+		switch {
+		case ty.IsSetType():
+			tag = 'e'
+		case ty.IsTupleType():
+			tag = 't'
+		}
+		if val.LengthInt() == 0 {
+			writeString(tag, ty.GoString())
+			return true
+		}
+		fmt.Fprintf(w, "%c%d:", tag, val.LengthInt())
+		for it := val.ElementIterator(); it.Next(); {
+			_, v := it.Element()
+			if !writeFunctionResultKeyValue(w, v) {
+				return false
+			}
+		}
+	default:
+		// Capsule types have no general encoding.
+		return false
+	}
+	return true
+}
@@ -0,0 +1,165 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lang
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/opentofu/opentofu/internal/lang/marks"
+)
+
+func TestFunctionResults(t *testing.T) {
+	dir := t.TempDir()
+	tmplPath := filepath.Join(dir, "greeting.tmpl")
+	writeTemplate := func(content string) {
+		t.Helper()
+		if err := os.WriteFile(tmplPath, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeTemplate("Hello, ${name}!")
+
+	results := NewFunctionResults()
+	newScope := func() *Scope {
+		return &Scope{BaseDir: dir, FunctionResults: results}
+	}
+	call := func(t *testing.T, scope *Scope, args ...cty.Value) cty.Value {
+		t.Helper()
+		got, err := scope.Functions()["templatefile"].Call(args)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		return got
+	}
+	vars := cty.ObjectVal(map[string]cty.Value{
+		"name": cty.StringVal("Jodie"),
+	})
+
+	got := call(t, newScope(), cty.StringVal("greeting.tmpl"), vars)
+	if want := cty.StringVal("Hello, Jodie!"); !want.RawEquals(got) {
+		t.Fatalf("wrong first result\ngot:  %#v\nwant: %#v", got, want)
+	}
+
+	// Changing the file on disk should not affect the result for the same
+	// arguments, even from a different scope sharing the same results,
+	// because the first result was memoized.
+	writeTemplate("Goodbye, ${name}!")
+	got = call(t, newScope(), cty.StringVal("greeting.tmpl"), vars)
+	if want := cty.StringVal("Hello, Jodie!"); !want.RawEquals(got) {
+		t.Fatalf("wrong memoized result\ngot:  %#v\nwant: %#v", got, want)
+	}
+
+	// Different arguments must produce a fresh call.
+	otherVars := cty.ObjectVal(map[string]cty.Value{
+		"name": cty.StringVal("Alex"),
+	})
+	got = call(t, newScope(), cty.StringVal("greeting.tmpl"), otherVars)
+	if want := cty.StringVal("Goodbye, Alex!"); !want.RawEquals(got) {
+		t.Fatalf("wrong result for different arguments\ngot:  %#v\nwant: %#v", got, want)
+	}
+
+	// The vars parameter doesn't allow marked values, so the function
+	// machinery removes the marks before the call and applies them to the
+	// result afterwards. The call therefore shares the memoized result of
+	// the call with unmarked vars, but the result still has the marks.
+	got = call(t, newScope(), cty.StringVal("greeting.tmpl"), vars.Mark(marks.Sensitive))
+	if want := cty.StringVal("Hello, Jodie!").Mark(marks.Sensitive); !want.RawEquals(got) {
+		t.Fatalf("wrong result for marked vars\ngot:  %#v\nwant: %#v", got, want)
+	}
+
+	// The path parameter allows marked values, so a call with a marked path
+	// is passed through to the underlying function, and reads the changed
+	// file.
+	got = call(t, newScope(), cty.StringVal("greeting.tmpl").Mark(marks.Sensitive), vars)
+	if want := cty.StringVal("Goodbye, Jodie!").Mark(marks.Sensitive); !want.RawEquals(got) {
+		t.Fatalf("wrong result for marked path\ngot:  %#v\nwant: %#v", got, want)
+	}
+
+	// A scope without shared results never memoizes anything.
+	got = call(t, &Scope{BaseDir: dir}, cty.StringVal("greeting.tmpl"), vars)
+	if want := cty.StringVal("Goodbye, Jodie!"); !want.RawEquals(got) {
+		t.Fatalf("wrong result without memoization\ngot:  %#v\nwant: %#v", got, want)
+	}
+}
+
+func TestFunctionResultsErrorsNotCached(t *testing.T) {
+	dir := t.TempDir()
+	results := NewFunctionResults()
+	args := []cty.Value{cty.StringVal("later.tmpl"), cty.EmptyObjectVal}
+
+	scope := &Scope{BaseDir: dir, FunctionResults: results}
+	if _, err := scope.Functions()["templatefile"].Call(args); err == nil {
+		t.Fatal("unexpected success reading a file that does not exist yet")
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "later.tmpl"), []byte("ok"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	got, err := scope.Functions()["templatefile"].Call(args)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if want := cty.StringVal("ok"); !want.RawEquals(got) {
+		t.Fatalf("wrong result\ngot:  %#v\nwant: %#v", got, want)
+	}
+}
+
+func TestFunctionResultKey(t *testing.T) {
+	// Each of these argument lists must produce a different key.
+	argLists := map[string][]cty.Value{
+		"string":            {cty.StringVal("1")},
+		"number":            {cty.NumberIntVal(1)},
+		"bool":              {cty.True},
+		"null string":       {cty.NullVal(cty.String)},
+		"null number":       {cty.NullVal(cty.Number)},
+		"two strings":       {cty.StringVal("a"), cty.StringVal("b")},
+		"joined string":     {cty.StringVal("ab")},
+		"list":              {cty.ListVal([]cty.Value{cty.StringVal("a")})},
+		"set":               {cty.SetVal([]cty.Value{cty.StringVal("a")})},
+		"tuple":             {cty.TupleVal([]cty.Value{cty.StringVal("a")})},
+		"empty string list": {cty.ListValEmpty(cty.String)},
+		"empty number list": {cty.ListValEmpty(cty.Number)},
+		"object":            {cty.ObjectVal(map[string]cty.Value{"a": cty.StringVal("b")})},
+		"map":               {cty.MapVal(map[string]cty.Value{"a": cty.StringVal("b")})},
+		"other object":      {cty.ObjectVal(map[string]cty.Value{"ab": cty.StringVal("")})},
+	}
+
+	keys := make(map[string]string)
+	for name, args := range argLists {
+		key, ok := functionResultKey("templatestring", ".", args)
+		if !ok {
+			t.Errorf("%s: not eligible for memoization", name)
+			continue
+		}
+		if other, exists := keys[key]; exists {
+			t.Errorf("%s and %s have the same key", name, other)
+		}
+		keys[key] = name
+	}
+
+	// The key must not grow with the size of the arguments.
+	small, _ := functionResultKey("yamldecode", ".", []cty.Value{cty.StringVal("a: b")})
+	large, _ := functionResultKey("yamldecode", ".", []cty.Value{cty.StringVal(strings.Repeat("a: b\n", 10000))})
+	if len(small) != len(large) {
+		t.Errorf("key length depends on the argument size: %d and %d", len(small), len(large))
+	}
+
+	// Unknown and marked arguments are never memoized.
+	for name, arg := range map[string]cty.Value{
+		"unknown": cty.UnknownVal(cty.String),
+		"marked":  cty.StringVal("a").Mark(marks.Sensitive),
+		"nested":  cty.ListVal([]cty.Value{cty.UnknownVal(cty.String)}),
+	} {
+		if _, ok := functionResultKey("yamldecode", ".", []cty.Value{arg}); ok {
+			t.Errorf("%s argument is eligible for memoization", name)
+		}
+	}
+}
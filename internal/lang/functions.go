@@ -7,6 +7,7 @@ package lang
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/hcl/v2/ext/tryfunc"
 	ctyyaml "github.com/zclconf/go-cty-yaml"
@@ -32,12 +33,24 @@ func (s *Scope) Functions() map[string]function.Function {
 	if s.funcs == nil {
 		s.funcs = makeBaseFunctionTable(s.BaseDir)
 		if s.ConsoleMode {
-			// The type function is only available in OpenTofu console.
+			// The type and data functions are only available in OpenTofu
+			// console.
 			s.funcs["type"] = funcs.TypeFunc
+			s.funcs["data"] = dataSourceFunction(s.DataSourceReader)
 		} else {
-			// The plantimestamp function doesn't make sense in the OpenTofu
-			// console.
+			// The plantimestamp and timestamp_stable functions don't make
+			// sense in the OpenTofu console.
 			s.funcs["plantimestamp"] = funcs.MakeStaticTimestampFunc(s.PlanTimestamp)
+			s.funcs["timestamp_stable"] = funcs.MakeStableTimestampFunc(s.PlanTimestamp)
+		}
+
+		if s.RetryAllowed {
+			s.funcs["retryuntil"] = funcs.MakeRetryUntilFunc(s.Stopped)
+		} else {
+			// The retryuntil function is always defined so that it appears
+			// in the function table consistently, but it can only be called
+			// from check block and test assertions.
+			s.funcs["retryuntil"] = retryNotAllowedFunction(funcs.RetryUntilFunc)
 		}
 
 		if s.PureOnly {
@@ -48,6 +61,12 @@ func (s *Scope) Functions() map[string]function.Function {
 			}
 		}
 
+		if s.FunctionResults != nil {
+			for _, name := range memoizableFunctions {
+				s.funcs[name] = s.FunctionResults.memoize(name, s.BaseDir, s.funcs[name])
+			}
+		}
+
 		coreNames := make([]string, 0)
 		// Add a description to each function and parameter based on the
 		// contents of descriptionList.
@@ -61,12 +80,128 @@ func (s *Scope) Functions() map[string]function.Function {
 		for _, name := range coreNames {
 			s.funcs[addrs.ParseFunction(name).FullyQualified().String()] = s.funcs[name]
 		}
+
+		if len(s.DisabledFunctions) != 0 {
+			for name, f := range s.funcs {
+				if FunctionDisabled(s.DisabledFunctions, name) {
+					s.funcs[name] = disabledFunction(name, f)
+				}
+			}
+		}
 	}
 	s.funcsLock.Unlock()
 
 	return s.funcs
 }
 
+// FunctionDisabled returns true if the function with the given name matches
+// any of the given patterns.
+//
+// Each pattern is either a function name, such as "file", or a namespace
+// prefix followed by "*", such as "provider::*" or "provider::aws::*", which
+// matches all functions in that namespace. Built-in functions match both
+// their short name and their fully-qualified "core::" name, so disabling
+// "file" also disables "core::file".
+func FunctionDisabled(patterns []string, name string) bool {
+	fullName := addrs.ParseFunction(name).FullyQualified().String()
+	for _, pattern := range patterns {
+		if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+			if strings.HasPrefix(name, prefix) || strings.HasPrefix(fullName, prefix) {
+				return true
+			}
+			continue
+		}
+		if addrs.ParseFunction(pattern).FullyQualified().String() == fullName {
+			return true
+		}
+	}
+	return false
+}
+
+// disabledFunction returns a placeholder for the given function that accepts
+// the same arguments but always fails, explaining that the function has been
+// disabled by the operator.
+func disabledFunction(name string, fn function.Function) function.Function {
+	err := fmt.Errorf("the function %q has been disabled in the CLI configuration for this environment", name)
+	return function.New(&function.Spec{
+		Description: fn.Description(),
+		Params:      fn.Params(),
+		VarParam:    fn.VarParam(),
+		Type: func(args []cty.Value) (cty.Type, error) {
+			return cty.DynamicPseudoType, err
+		},
+		Impl: func(args []cty.Value, retType cty.Type) (cty.Value, error) {
+			// It would be weird to get here because the Type function always
+			// fails, but we'll return an error here too anyway just to be
+			// robust.
+			return cty.DynamicVal, err
+		},
+	})
+}
+
+// retryNotAllowedFunction returns a function with the same signature as the
+// given one that always fails because retrying isn't allowed in the scope
+// the function is called from.
+func retryNotAllowedFunction(fn function.Function) function.Function {
+	err := fmt.Errorf("the retryuntil function can only be used in the assertions of check blocks and test run blocks")
+	return function.New(&function.Spec{
+		Description: fn.Description(),
+		Params:      fn.Params(),
+		VarParam:    fn.VarParam(),
+		Type: func(args []cty.Value) (cty.Type, error) {
+			return cty.DynamicPseudoType, err
+		},
+		Impl: func(args []cty.Value, retType cty.Type) (cty.Value, error) {
+			return cty.DynamicVal, err
+		},
+	})
+}
+
+// dataSourceFunction returns the console-only "data" function, which reads
+// a data source of the type given in its first argument using the
+// configuration object given in its second argument, and returns the
+// resulting object.
+//
+// If read is nil then the function is still defined, so that it appears in
+// the function table consistently, but always fails.
+func dataSourceFunction(read DataSourceReader) function.Function {
+	return function.New(&function.Spec{
+		Params: []function.Parameter{
+			{
+				Name: "type",
+				Type: cty.String,
+			},
+			{
+				Name: "config",
+				Type: cty.DynamicPseudoType,
+			},
+		},
+		Type: function.StaticReturnType(cty.DynamicPseudoType),
+		Impl: func(args []cty.Value, retType cty.Type) (cty.Value, error) {
+			if read == nil {
+				return cty.DynamicVal, fmt.Errorf("data sources cannot be read in this context")
+			}
+			if args[0].IsNull() {
+				return cty.DynamicVal, function.NewArgErrorf(0, "data source type must not be null")
+			}
+			config := args[1]
+			if config.IsNull() {
+				// Treat a null configuration the same as an empty one, which
+				// is convenient for data sources with no required arguments.
+				config = cty.EmptyObjectVal
+			}
+			if !config.Type().IsObjectType() && !config.Type().IsMapType() {
+				return cty.DynamicVal, function.NewArgErrorf(1, "data source configuration must be an object")
+			}
+			ret, diags := read(args[0].AsString(), config)
+			if diags.HasErrors() {
+				return cty.DynamicVal, diags.Err()
+			}
+			return ret, nil
+		},
+	})
+}
+
 // experimentalFunction checks whether the given experiment is enabled for
 // the receiving scope. If so, it will return the given function verbatim.
 // If not, it will return a placeholder function that just returns an
@@ -117,6 +252,7 @@ func makeBaseFunctionTable(baseDir string) map[string]function.Function {
 		"abspath":          funcs.AbsPathFunc,
 		"alltrue":          funcs.AllTrueFunc,
 		"anytrue":          funcs.AnyTrueFunc,
+		"attempt":          funcs.AttemptFunc,
 		"basename":         funcs.BasenameFunc,
 		"base64decode":     funcs.Base64DecodeFunc,
 		"base64encode":     funcs.Base64EncodeFunc,
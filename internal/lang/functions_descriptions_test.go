@@ -20,9 +20,9 @@ func TestFunctionDescriptions(t *testing.T) {
 	// WithNewDescriptions will panic if the number doesn't match.
 	allFunctions := scope.Functions()
 
-	// plantimestamp isn't available with ConsoleMode: true
+	// plantimestamp and timestamp_stable aren't available with ConsoleMode: true
 	// THis also includes the core:: prefixed functions
-	expectedFunctionCount := (len(funcs.DescriptionList) - 1) * 2
+	expectedFunctionCount := (len(funcs.DescriptionList) - 2) * 2
 
 	if len(allFunctions) != expectedFunctionCount {
 		t.Errorf("DescriptionList length expected: %d, got %d", len(allFunctions), expectedFunctionCount)
@@ -6,9 +6,11 @@
 package lang
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -17,9 +19,11 @@ import (
 	homedir "github.com/mitchellh/go-homedir"
 	"github.com/opentofu/opentofu/internal/addrs"
 	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/function"
 
 	"github.com/opentofu/opentofu/internal/experiments"
 	"github.com/opentofu/opentofu/internal/lang/marks"
+	"github.com/opentofu/opentofu/internal/tfdiags"
 )
 
 // TestFunctions tests that functions are callable through the functionality
@@ -91,6 +95,25 @@ func TestFunctions(t *testing.T) {
 			},
 		},
 
+		"attempt": {
+			{
+				`attempt(true)`,
+				cty.ObjectVal(map[string]cty.Value{
+					"value": cty.True,
+					"error": cty.NullVal(cty.String),
+				}),
+			},
+			{
+				// Note: like "can" and "try", "attempt" only captures the
+				// errors that happen during dynamic evaluation.
+				`attempt({}.baz)`,
+				cty.ObjectVal(map[string]cty.Value{
+					"value": cty.NullVal(cty.DynamicPseudoType),
+					"error": cty.StringVal(`Unsupported attribute: This object does not have an attribute named "baz".`),
+				}),
+			},
+		},
+
 		"base64decode": {
 			{
 				`base64decode("YWJjMTIzIT8kKiYoKSctPUB+")`,
@@ -774,6 +797,15 @@ func TestFunctions(t *testing.T) {
 			},
 		},
 
+		"retryuntil": {
+			{
+				// The retryuntil function is only allowed in the assertions
+				// of check blocks and test run blocks.
+				`can(retryuntil(true, "1s"))`,
+				cty.False,
+			},
+		},
+
 		"reverse": {
 			{
 				`reverse(["a", true, 0])`,
@@ -1018,6 +1050,17 @@ func TestFunctions(t *testing.T) {
 			},
 		},
 
+		"timestamp_stable": {
+			{
+				`timestamp_stable()`,
+				cty.StringVal("2004-04-25T15:00:00Z"),
+			},
+			{
+				`timestamp_stable("24h")`,
+				cty.StringVal("2004-04-25T00:00:00Z"),
+			},
+		},
+
 		"title": {
 			{
 				`title("hello")`,
@@ -1345,6 +1388,40 @@ func TestFunctions(t *testing.T) {
 	}
 }
 
+func TestFunctionsRetryAllowed(t *testing.T) {
+	expr, parseDiags := hclsyntax.ParseExpression([]byte(`retryuntil(true, "1s")`), "test.hcl", hcl.InitialPos)
+	if parseDiags.HasErrors() {
+		t.Fatal(parseDiags.Error())
+	}
+
+	for _, allowed := range []bool{false, true} {
+		t.Run(fmt.Sprintf("allowed=%t", allowed), func(t *testing.T) {
+			scope := &Scope{
+				Data:         &dataForTests{},
+				BaseDir:      "./testdata/functions-test",
+				RetryAllowed: allowed,
+			}
+			got, diags := scope.EvalExpr(t.Context(), expr, cty.DynamicPseudoType)
+			if !allowed {
+				if !diags.HasErrors() {
+					t.Fatal("succeeded; want error")
+				}
+				want := "the retryuntil function can only be used in the assertions of check blocks and test run blocks"
+				if got := diags.Err().Error(); !strings.Contains(got, want) {
+					t.Fatalf("wrong error\ngot:  %s\nwant: %s", got, want)
+				}
+				return
+			}
+			if diags.HasErrors() {
+				t.Fatal(diags.Err())
+			}
+			if !got.RawEquals(cty.True) {
+				t.Errorf("wrong result %#v; want true", got)
+			}
+		})
+	}
+}
+
 func TestFunctionsPrefixedCorrectly(t *testing.T) {
 	dir := t.TempDir()
 	baseFuncs := makeBaseFunctionTable(dir)
@@ -1362,6 +1439,97 @@ func TestFunctionsPrefixedCorrectly(t *testing.T) {
 	}
 }
 
+func TestFunctionDisabled(t *testing.T) {
+	tests := []struct {
+		patterns []string
+		name     string
+		want     bool
+	}{
+		{nil, "file", false},
+		{[]string{"file"}, "file", true},
+		{[]string{"file"}, "core::file", true},
+		{[]string{"core::file"}, "file", true},
+		{[]string{"file"}, "filebase64", false},
+		{[]string{"file"}, "provider::local::file", false},
+		{[]string{"provider::*"}, "provider::aws::arn_parse", true},
+		{[]string{"provider::*"}, "file", false},
+		{[]string{"provider::aws::*"}, "provider::aws::arn_parse", true},
+		{[]string{"provider::aws::*"}, "provider::azurerm::arn_parse", false},
+		{[]string{"core::*"}, "file", true},
+		{[]string{"*"}, "abs", true},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%s in %q", test.name, test.patterns), func(t *testing.T) {
+			got := FunctionDisabled(test.patterns, test.name)
+			if got != test.want {
+				t.Errorf("wrong result %t; want %t", got, test.want)
+			}
+		})
+	}
+}
+
+func TestScopeDisabledFunctions(t *testing.T) {
+	tests := map[string]struct {
+		src     string
+		wantErr string
+	}{
+		"allowed function": {
+			src: `upper("hello")`,
+		},
+		"disabled function": {
+			src:     `file("hello.txt")`,
+			wantErr: `the function "file" has been disabled in the CLI configuration for this environment`,
+		},
+		"disabled function with namespace": {
+			src:     `core::file("hello.txt")`,
+			wantErr: `the function "core::file" has been disabled in the CLI configuration for this environment`,
+		},
+		"disabled function called from template": {
+			src:     `templatestring("$${file(\"hello.txt\")}", {})`,
+			wantErr: `the function "file" has been disabled in the CLI configuration for this environment`,
+		},
+		"disabled provider function": {
+			src:     `provider::test::echo("hello")`,
+			wantErr: `The provider-defined function "provider::test::echo" cannot be used because it has been disabled in the CLI configuration for this environment.`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			scope := &Scope{
+				Data:              &dataForTests{},
+				ParseRef:          addrs.ParseRef,
+				BaseDir:           "./testdata/functions-test",
+				DisabledFunctions: []string{"file", "provider::*"},
+				ProviderFunctions: func(context.Context, addrs.ProviderFunction, tfdiags.SourceRange) (*function.Function, tfdiags.Diagnostics) {
+					t.Fatal("provider function should not have been requested")
+					return nil, nil
+				},
+			}
+
+			expr, parseDiags := hclsyntax.ParseExpression([]byte(test.src), "test.hcl", hcl.Pos{Line: 1, Column: 1})
+			if parseDiags.HasErrors() {
+				t.Fatal(parseDiags.Error())
+			}
+
+			_, diags := scope.EvalExpr(t.Context(), expr, cty.DynamicPseudoType)
+			if test.wantErr == "" {
+				if diags.HasErrors() {
+					t.Fatalf("unexpected errors: %s", diags.Err())
+				}
+				return
+			}
+			if !diags.HasErrors() {
+				t.Fatalf("unexpected success; want error containing %q", test.wantErr)
+			}
+			if got := diags.Err().Error(); !strings.Contains(got, test.wantErr) {
+				t.Errorf("wrong error\ngot:  %s\nwant: %s", got, test.wantErr)
+			}
+		})
+	}
+}
+
 const (
 	CipherBase64 = "eczGaDhXDbOFRZGhjx2etVzWbRqWDlmq0bvNt284JHVbwCgObiuyX9uV0LSAMY707IEgMkExJqXmsB4OWKxvB7epRB9G/3+F+pcrQpODlDuL9oDUAsa65zEpYF0Wbn7Oh7nrMQncyUPpyr9WUlALl0gRWytOA23S+y5joa4M34KFpawFgoqTu/2EEH4Xl1zo+0fy73fEto+nfkUY+meuyGZ1nUx/+DljP7ZqxHBFSlLODmtuTMdswUbHbXbWneW51D7Jm7xB8nSdiA2JQNK5+Sg5x8aNfgvFTt/m2w2+qpsyFa5Wjeu6fZmXSl840CA07aXbk9vN4I81WmJyblD/ZA=="
 	PrivateKey   = `
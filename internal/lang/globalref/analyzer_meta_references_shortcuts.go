@@ -55,8 +55,8 @@ func (a *Analyzer) ReferencesFromResourceInstance(addr addrs.AbsResourceInstance
 }
 
 // ReferencesFromResourceRepetition returns the references from the given
-// resource's for_each or count expression, or an empty set if the resource
-// doesn't use repetition.
+// resource's for_each (and for_each_key) or count expression, or an empty set
+// if the resource doesn't use repetition.
 //
 // This is a special-case sort of helper for use in situations where an
 // expression might refer to count.index, each.key, or each.value, and thus
@@ -82,6 +82,14 @@ func (a *Analyzer) ReferencesFromResourceRepetition(addr addrs.AbsResource) []Re
 	switch {
 	case rc.ForEach != nil:
 		refs, _ := lang.ReferencesInExpr(addrs.ParseRef, rc.ForEach)
+		// The for_each_key expression, if any, also decides each.key. Its
+		// own references to each.value are already covered by for_each.
+		keyRefs, _ := lang.ReferencesInExpr(addrs.ParseRef, rc.ForEachKey)
+		for _, ref := range keyRefs {
+			if _, ok := ref.Subject.(addrs.ForEachAttr); !ok {
+				refs = append(refs, ref)
+			}
+		}
 		return absoluteRefs(addr.Module, refs)
 	case rc.Count != nil:
 		refs, _ := lang.ReferencesInExpr(addrs.ParseRef, rc.Count)
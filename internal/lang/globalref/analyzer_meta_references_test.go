@@ -114,6 +114,14 @@ func TestAnalyzerMetaReferences(t *testing.T) {
 				`module.count[0]::var.a`,
 			},
 		},
+		{
+			`test_thing.keyed["2-hello world"]`,
+			`each.key`,
+			[]string{
+				"::local.a",
+				"::local.b",
+			},
+		},
 		{
 			`module.single`,
 			`var.a`,
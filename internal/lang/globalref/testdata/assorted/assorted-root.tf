@@ -20,6 +20,13 @@ resource "test_thing" "for_each" {
   }
 }
 
+resource "test_thing" "keyed" {
+  for_each     = [local.a]
+  for_each_key = "${local.b}-${each.value}"
+
+  string = each.key
+}
+
 resource "test_thing" "count" {
   for_each = length(local.a)
 
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/hcl/v2"
+	"github.com/zclconf/go-cty/cty"
 	"github.com/zclconf/go-cty/cty/function"
 
 	"github.com/opentofu/opentofu/internal/addrs"
@@ -69,15 +70,49 @@ type Scope struct {
 	// included in this scope.
 	ConsoleMode bool
 
+	// RetryAllowed can be set to true to allow the retryuntil function to be
+	// called from expressions evaluated in this scope. It is only set for
+	// the assertions of check blocks and test run blocks, whose results
+	// don't affect the planned changes.
+	RetryAllowed bool
+
+	// Stopped is an optional channel that is closed when the operation the
+	// scope belongs to is interrupted, so that the retryuntil function can
+	// stop waiting.
+	Stopped <-chan struct{}
+
 	// PlanTimestamp is a timestamp representing when the plan was made. It will
 	// either have been generated during this operation or read from the plan.
 	PlanTimestamp time.Time
 
+	// DisabledFunctions is an optional set of function name patterns that
+	// must not be callable from expressions evaluated in this scope. Refer
+	// to [FunctionDisabled] for the pattern syntax.
+	DisabledFunctions []string
+
+	// FunctionResults is an optional cache of function call results shared
+	// with other scopes used in the same operation. If set, calls to
+	// expensive pure functions are evaluated only once for each distinct
+	// set of arguments.
+	FunctionResults *FunctionResults
+
 	ProviderFunctions ProviderFunction
+
+	// DataSourceReader is used by the console-only "data" function to read
+	// data sources that are not declared in the configuration. If nil, the
+	// "data" function always returns an error.
+	DataSourceReader DataSourceReader
 }
 
 type ProviderFunction func(context.Context, addrs.ProviderFunction, tfdiags.SourceRange) (*function.Function, tfdiags.Diagnostics)
 
+// DataSourceReader reads a data source of the given type using the given
+// configuration value, returning the object that the provider produced.
+//
+// The configuration value may not exactly conform to the data source schema,
+// so implementations are responsible for converting it as necessary.
+type DataSourceReader func(typeName string, config cty.Value) (cty.Value, tfdiags.Diagnostics)
+
 // SetActiveExperiments allows a caller to declare that a set of experiments
 // is active for the module that the receiving Scope belongs to, which might
 // then cause the scope to activate some additional experimental behaviors.
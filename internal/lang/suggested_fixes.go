@@ -0,0 +1,193 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lang
+
+import (
+	"sort"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+
+	"github.com/opentofu/opentofu/internal/configs/configschema"
+	"github.com/opentofu/opentofu/internal/didyoumean"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// SuggestFixes returns the given diagnostics, produced by evaluating body
+// against schema, with a tfdiags.SuggestedFix attached to each of those for
+// which there is exactly one plausible correction: an argument or block type
+// name that is close to exactly one name in the schema, or a single value
+// given where the schema requires a collection of that value's type (or
+// vice-versa).
+//
+// The body must be the one from the configuration, before any dynamic blocks
+// are expanded. Fixes are only suggested for native syntax bodies, because
+// they rely on the structure of the source code.
+func SuggestFixes(diags tfdiags.Diagnostics, body hcl.Body, schema *configschema.Block) tfdiags.Diagnostics {
+	syntaxBody, ok := body.(*hclsyntax.Body)
+	if !ok || !diags.HasErrors() {
+		return diags
+	}
+	ret := make(tfdiags.Diagnostics, len(diags))
+	for i, diag := range diags {
+		ret[i] = diag
+		subject := diag.Source().Subject
+		if diag.Severity() != tfdiags.Error || subject == nil {
+			continue
+		}
+		var evalCtx *hcl.EvalContext
+		if fromExpr := diag.FromExpr(); fromExpr != nil {
+			evalCtx = fromExpr.EvalContext
+		}
+		if fix := suggestFix(subject, evalCtx, syntaxBody, schema); fix != nil {
+			ret[i] = tfdiags.Override(diag, diag.Severity(), func() tfdiags.DiagnosticExtraWrapper {
+				return &diagnosticSuggestedFix{fix: fix}
+			})
+		}
+	}
+	return ret
+}
+
+func suggestFix(subject *tfdiags.SourceRange, evalCtx *hcl.EvalContext, body *hclsyntax.Body, schema *configschema.Block) *tfdiags.SuggestedFix {
+	for name, attr := range body.Attributes {
+		attrS, known := schema.Attributes[name]
+		switch {
+		case !known && sameRange(subject, attr.NameRange):
+			var candidates []string
+			for candidate, candidateS := range schema.Attributes {
+				if _, defined := body.Attributes[candidate]; !defined && (candidateS.Optional || candidateS.Required) {
+					candidates = append(candidates, candidate)
+				}
+			}
+			return nameFix(name, candidates)
+		case known && evalCtx != nil && sameRange(subject, attr.Expr.Range()):
+			return attributeTypeFix(attr.Expr, attrS.ImpliedType(), evalCtx)
+		}
+	}
+
+	for _, block := range body.Blocks {
+		blockS, known := schema.BlockTypes[block.Type]
+		if !known {
+			if !sameRange(subject, block.TypeRange) {
+				continue
+			}
+			candidates := make([]string, 0, len(schema.BlockTypes))
+			for candidate := range schema.BlockTypes {
+				candidates = append(candidates, candidate)
+			}
+			return nameFix(block.Type, candidates)
+		}
+		if !rangeContains(block.Range(), subject) {
+			continue
+		}
+		if fix := suggestFix(subject, evalCtx, block.Body, &blockS.Block); fix != nil {
+			return fix
+		}
+	}
+
+	return nil
+}
+
+// nameFix returns a fix replacing the given name with the only one of the
+// candidates that is similar to it, or nil if there isn't exactly one.
+func nameFix(given string, candidates []string) *tfdiags.SuggestedFix {
+	sort.Strings(candidates)
+	var found string
+	for _, candidate := range candidates {
+		if didyoumean.NameSuggestion(given, []string{candidate}) == "" {
+			continue
+		}
+		if found != "" {
+			return nil
+		}
+		found = candidate
+	}
+	if found == "" {
+		return nil
+	}
+	return &tfdiags.SuggestedFix{Prefix: found}
+}
+
+// attributeTypeFix returns a fix for an attribute value that doesn't conform
+// to the type required by the schema, when the value can be made to conform
+// by either wrapping it in brackets or removing the brackets around it.
+func attributeTypeFix(expr hclsyntax.Expression, wantTy cty.Type, evalCtx *hcl.EvalContext) *tfdiags.SuggestedFix {
+	tuple, isTuple := expr.(*hclsyntax.TupleConsExpr)
+
+	if (wantTy.IsListType() || wantTy.IsSetType()) && wantTy.ElementType().IsPrimitiveType() {
+		if isTuple {
+			return nil
+		}
+		val, diags := expr.Value(evalCtx)
+		if diags.HasErrors() || !val.IsWhollyKnown() || val.Type().IsCollectionType() || val.Type().IsTupleType() {
+			return nil
+		}
+		if !valueConvertsTo(val, wantTy.ElementType()) {
+			return nil
+		}
+		keep := tfdiags.SourceRangeFromHCL(expr.Range())
+		return &tfdiags.SuggestedFix{Prefix: "[", Keep: &keep, Suffix: "]"}
+	}
+
+	if wantTy.IsPrimitiveType() {
+		if !isTuple || len(tuple.Exprs) != 1 {
+			return nil
+		}
+		elem := tuple.Exprs[0]
+		val, diags := elem.Value(evalCtx)
+		if diags.HasErrors() || !val.IsWhollyKnown() || !valueConvertsTo(val, wantTy) {
+			return nil
+		}
+		keep := tfdiags.SourceRangeFromHCL(elem.Range())
+		return &tfdiags.SuggestedFix{Keep: &keep}
+	}
+
+	return nil
+}
+
+func valueConvertsTo(val cty.Value, ty cty.Type) bool {
+	_, err := convert.Convert(val, ty)
+	return err == nil
+}
+
+func sameRange(a *tfdiags.SourceRange, b hcl.Range) bool {
+	rng := tfdiags.SourceRangeFromHCL(b)
+	return a.Filename == rng.Filename && a.Start.Byte == rng.Start.Byte && a.End.Byte == rng.End.Byte
+}
+
+func rangeContains(outer hcl.Range, inner *tfdiags.SourceRange) bool {
+	rng := tfdiags.SourceRangeFromHCL(outer)
+	return rng.Filename == inner.Filename && rng.Start.Byte <= inner.Start.Byte && inner.End.Byte <= rng.End.Byte
+}
+
+// diagnosticSuggestedFix is an implementation of
+// tfdiags.DiagnosticExtraSuggestedFix which we use in the "Extra" field of
+// the diagnostics that SuggestFixes finds a fix for.
+type diagnosticSuggestedFix struct {
+	fix *tfdiags.SuggestedFix
+
+	wrapped interface{}
+}
+
+var (
+	_ tfdiags.DiagnosticExtraSuggestedFix = (*diagnosticSuggestedFix)(nil)
+	_ tfdiags.DiagnosticExtraWrapper      = (*diagnosticSuggestedFix)(nil)
+	_ tfdiags.DiagnosticExtraUnwrapper    = (*diagnosticSuggestedFix)(nil)
+)
+
+func (e *diagnosticSuggestedFix) DiagnosticSuggestedFix() *tfdiags.SuggestedFix {
+	return e.fix
+}
+
+func (e *diagnosticSuggestedFix) WrapDiagnosticExtra(inner interface{}) {
+	e.wrapped = inner
+}
+
+func (e *diagnosticSuggestedFix) UnwrapDiagnosticExtra() interface{} {
+	return e.wrapped
+}
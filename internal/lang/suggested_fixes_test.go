@@ -0,0 +1,152 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lang
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/configs/configschema"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+func TestSuggestFixes(t *testing.T) {
+	schema := &configschema.Block{
+		Attributes: map[string]*configschema.Attribute{
+			"ami":   {Type: cty.String, Optional: true},
+			"tags":  {Type: cty.List(cty.String), Optional: true},
+			"ports": {Type: cty.Set(cty.Number), Optional: true},
+			"id":    {Type: cty.String, Computed: true},
+		},
+		BlockTypes: map[string]*configschema.NestedBlock{
+			"network_interface": {
+				Nesting: configschema.NestingList,
+				Block: configschema.Block{
+					Attributes: map[string]*configschema.Attribute{
+						"device_index": {Type: cty.Number, Optional: true},
+					},
+				},
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		src string
+		// want is the source code after applying the suggested fix, or
+		// empty if no fix should be suggested.
+		want string
+	}{
+		"misspelled argument": {
+			src:  `amii = "bar"`,
+			want: `ami = "bar"`,
+		},
+		"misspelled block type": {
+			src:  "network_interfac {\n}\n",
+			want: "network_interface {\n}\n",
+		},
+		"misspelled nested argument": {
+			src:  "network_interface {\n  device_indx = 0\n}\n",
+			want: "network_interface {\n  device_index = 0\n}\n",
+		},
+		"not similar to anything": {
+			src: `instance_type = "bar"`,
+		},
+		"computed attributes are not suggested": {
+			src: `idd = "bar"`,
+		},
+		"wrap single value in list": {
+			src:  `tags = "a"`,
+			want: `tags = ["a"]`,
+		},
+		"wrap single value in set": {
+			src:  `ports = 80`,
+			want: `ports = [80]`,
+		},
+		"unwrap single-element tuple": {
+			src:  `ami = ["a"]`,
+			want: `ami = "a"`,
+		},
+		"multiple elements are ambiguous": {
+			src: `ami = ["a", "b"]`,
+		},
+		"element of wrong type": {
+			src: `tags = {}`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			src := []byte(test.src)
+			file, parseDiags := hclsyntax.ParseConfig(src, "test.tf", hcl.InitialPos)
+			if parseDiags.HasErrors() {
+				t.Fatal(parseDiags.Error())
+			}
+			scope := &Scope{
+				Data:     &dataForTests{},
+				ParseRef: addrs.ParseRef,
+			}
+
+			_, diags := scope.EvalBlock(t.Context(), file.Body, schema)
+			diags = SuggestFixes(diags, file.Body, schema)
+			if !diags.HasErrors() {
+				t.Fatal("unexpected success")
+			}
+
+			var got string
+			for _, diag := range diags {
+				fix := tfdiags.DiagnosticSuggestedFix(diag)
+				if fix == nil {
+					continue
+				}
+				if got != "" {
+					t.Fatalf("more than one fix suggested")
+				}
+				replacement := fix.Prefix
+				if fix.Keep != nil {
+					replacement += string(src[fix.Keep.Start.Byte:fix.Keep.End.Byte])
+				}
+				replacement += fix.Suffix
+				subject := diag.Source().Subject
+				got = string(src[:subject.Start.Byte]) + replacement + string(src[subject.End.Byte:])
+			}
+			if got != test.want {
+				t.Errorf("wrong result\ngot:  %q\nwant: %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestSuggestFixes_ambiguousName(t *testing.T) {
+	schema := &configschema.Block{
+		Attributes: map[string]*configschema.Attribute{
+			"ami": {Type: cty.String, Optional: true},
+			"amo": {Type: cty.String, Optional: true},
+		},
+	}
+	file, parseDiags := hclsyntax.ParseConfig([]byte(`amii = "bar"`), "test.tf", hcl.InitialPos)
+	if parseDiags.HasErrors() {
+		t.Fatal(parseDiags.Error())
+	}
+	scope := &Scope{
+		Data:     &dataForTests{},
+		ParseRef: addrs.ParseRef,
+	}
+
+	_, diags := scope.EvalBlock(t.Context(), file.Body, schema)
+	diags = SuggestFixes(diags, file.Body, schema)
+	if !diags.HasErrors() {
+		t.Fatal("unexpected success")
+	}
+	for _, diag := range diags {
+		if fix := tfdiags.DiagnosticSuggestedFix(diag); fix != nil {
+			t.Errorf("unexpected fix %#v for an ambiguous name", fix)
+		}
+	}
+}
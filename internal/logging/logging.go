@@ -23,8 +23,8 @@ const (
 	envLogFile = "TF_LOG_PATH"
 
 	// Allow logging of specific subsystems.
-	// We only separate core and providers for now, but this could be extended
-	// to other loggers, like provisioners and remote-state backends.
+	// We only separate core, providers, and cloud here. TOFU_LOG supersedes
+	// these with a level for any subsystem; see subsystem.go.
 	envLogCore     = "TF_LOG_CORE"
 	envLogProvider = "TF_LOG_PROVIDER"
 	envLogCloud    = "TF_LOG_CLOUD"
@@ -113,19 +113,23 @@ func NewLogger(name string) hclog.Logger {
 	if name == "" {
 		panic("logger name required")
 	}
-	return &logPanicWrapper{
+	l := &logPanicWrapper{
 		Logger: logger.Named(name),
 	}
+	if level, ok := subsystemLogLevel(name); ok {
+		l.SetLevel(level)
+	}
+	return l
 }
 
-// NewProviderLogger returns a logger for the provider plugin, possibly with a
-// different log level from the global logger.
-func NewProviderLogger(prefix string) hclog.Logger {
+// NewProviderLogger returns a logger for a provider plugin of the given type,
+// possibly with a different log level from the global logger.
+func NewProviderLogger(prefix string, providerType string) hclog.Logger {
 	l := &logPanicWrapper{
 		Logger: logger.Named(prefix + "provider"),
 	}
 
-	level := providerLogLevel()
+	level := providerLogLevel(providerType)
 	logger.Debug("created provider logger", "level", level)
 
 	l.SetLevel(level)
@@ -146,13 +150,28 @@ func NewCloudLogger() hclog.Logger {
 	return l
 }
 
+// NewBackendLogger returns a logger for the backend of the given type,
+// possibly with a different log level from the global logger.
+func NewBackendLogger(backendType string) hclog.Logger {
+	l := &logPanicWrapper{
+		Logger: logger.Named("backend-" + backendType),
+	}
+	if level, ok := subsystemLogLevel(SubsystemBackend + "." + backendType); ok {
+		l.SetLevel(level)
+	}
+	return l
+}
+
 // CurrentLogLevel returns the current log level string based the environment vars
 func CurrentLogLevel() string {
 	ll, _ := globalLogLevel()
 	return strings.ToUpper(ll.String())
 }
 
-func providerLogLevel() hclog.Level {
+func providerLogLevel(providerType string) hclog.Level {
+	if level, ok := subsystemLogLevel(providerSubsystem(providerType)); ok {
+		return level
+	}
 	providerEnvLevel := strings.ToUpper(os.Getenv(envLogProvider))
 	if providerEnvLevel == "" {
 		providerEnvLevel = strings.ToUpper(os.Getenv(envLog))
@@ -162,6 +181,9 @@ func providerLogLevel() hclog.Level {
 }
 
 func cloudLogLevel() hclog.Level {
+	if level, ok := subsystemLogLevel(SubsystemCloud); ok {
+		return level
+	}
 	providerEnvLevel := strings.ToUpper(os.Getenv(envLogCloud))
 	if providerEnvLevel == "" {
 		providerEnvLevel = strings.ToUpper(os.Getenv(envLog))
@@ -171,7 +193,10 @@ func cloudLogLevel() hclog.Level {
 }
 
 func globalLogLevel() (hclog.Level, bool) {
-	var json bool
+	json := jsonLogFormat()
+	if level, ok := subsystemLogLevel(SubsystemCore); ok {
+		return level, json
+	}
 	envLevel := strings.ToUpper(os.Getenv(envLog))
 	if envLevel == "" {
 		envLevel = strings.ToUpper(os.Getenv(envLogCore))
@@ -0,0 +1,165 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logging
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+// These are the environment variables for configuring the log level of each
+// subsystem separately, and the format of the logs. When envTofuLog is set,
+// it replaces all of the TF_LOG variables other than TF_LOG_PATH.
+const (
+	envTofuLog       = "TOFU_LOG"
+	envTofuLogFormat = "TOFU_LOG_FORMAT"
+)
+
+// Subsystem names used in the TOFU_LOG environment variable, in addition to
+// the names of the loggers created by NewLogger.
+const (
+	SubsystemCore     = "core"
+	SubsystemProvider = "provider"
+	SubsystemCloud    = "cloud"
+	SubsystemBackend  = "backend"
+)
+
+// logSpec is the parsed form of the TOFU_LOG environment variable, such as
+// "warn,core=debug,provider.aws=trace".
+type logSpec struct {
+	// defaultLevel is the level for subsystems that aren't in levels, which
+	// can be set by an item in the spec without a subsystem name.
+	defaultLevel hclog.Level
+
+	// levels are the log levels of specific subsystems, indexed by their
+	// lowercase dotted names.
+	levels map[string]hclog.Level
+}
+
+// parseLogSpec parses the value of the TOFU_LOG environment variable,
+// reporting any invalid items on stderr and then ignoring them.
+func parseLogSpec(spec string) logSpec {
+	ret := logSpec{
+		defaultLevel: hclog.Off,
+		levels:       make(map[string]hclog.Level),
+	}
+	for _, item := range strings.Split(spec, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		subsystem, levelStr, hasSubsystem := strings.Cut(item, "=")
+		if !hasSubsystem {
+			levelStr, subsystem = subsystem, ""
+		}
+		subsystem = strings.ToLower(strings.TrimSpace(subsystem))
+		levelStr = strings.ToUpper(strings.TrimSpace(levelStr))
+		if hasSubsystem && subsystem == "" {
+			fmt.Fprintf(os.Stderr, "[WARN] Invalid %s item %q: subsystem name is empty\n", envTofuLog, item)
+			continue
+		}
+		if !isValidLogLevel(levelStr) {
+			fmt.Fprintf(os.Stderr, "[WARN] Invalid %s item %q: valid levels are: %+v\n", envTofuLog, item, ValidLevels)
+			continue
+		}
+		level := hclog.LevelFromString(levelStr)
+		if !hasSubsystem {
+			ret.defaultLevel = level
+			continue
+		}
+		ret.levels[subsystem] = level
+	}
+	return ret
+}
+
+// levelFor returns the log level for the given subsystem, using the level of
+// the most specific subsystem configured for it. For example, the level for
+// "provider.aws" is the level of "provider.aws" if set, or otherwise of
+// "provider", or otherwise the default level.
+func (s logSpec) levelFor(subsystem string) hclog.Level {
+	name := strings.ToLower(subsystem)
+	for {
+		if level, ok := s.levels[name]; ok {
+			return level
+		}
+		i := strings.LastIndexByte(name, '.')
+		if i < 0 {
+			return s.defaultLevel
+		}
+		name = name[:i]
+	}
+}
+
+// configuredLogSpec returns the parsed TOFU_LOG environment variable, and
+// false if it isn't set, in which case the TF_LOG variables apply.
+func configuredLogSpec() (logSpec, bool) {
+	spec := os.Getenv(envTofuLog)
+	if spec == "" {
+		return logSpec{}, false
+	}
+	return parseLogSpec(spec), true
+}
+
+// subsystemLogLevel returns the log level of the given subsystem configured
+// in TOFU_LOG, or false if TOFU_LOG isn't set.
+func subsystemLogLevel(subsystem string) (hclog.Level, bool) {
+	spec, ok := configuredLogSpec()
+	if !ok {
+		return hclog.NoLevel, false
+	}
+	return spec.levelFor(subsystem), true
+}
+
+// jsonLogFormat returns true if TOFU_LOG_FORMAT selects JSON logs.
+func jsonLogFormat() bool {
+	switch format := strings.ToLower(os.Getenv(envTofuLogFormat)); format {
+	case "", "text":
+		return false
+	case "json":
+		return true
+	default:
+		fmt.Fprintf(os.Stderr, "[WARN] Invalid %s: %q. Valid formats are: text, json\n", envTofuLogFormat, format)
+		return false
+	}
+}
+
+// ProviderPluginEnviron returns the environment for a provider plugin
+// process of the given type, with TF_LOG set to the level configured for the
+// provider in TOFU_LOG so that the plugin's own logging matches it. It returns
+// false if TOFU_LOG isn't set, in which case plugins inherit the environment
+// as-is.
+func ProviderPluginEnviron(providerType string) ([]string, bool) {
+	level, ok := subsystemLogLevel(providerSubsystem(providerType))
+	if !ok {
+		return nil, false
+	}
+
+	// TOFU_LOG's syntax isn't understood by the plugin SDKs, so we replace
+	// all of the log level variables with the single level that applies.
+	var ret []string
+	for _, kv := range os.Environ() {
+		name, _, _ := strings.Cut(kv, "=")
+		switch name {
+		case envLog, envLogCore, envLogProvider, envLogCloud, envTofuLog, envTofuLogFormat:
+			continue
+		}
+		ret = append(ret, kv)
+	}
+	if level != hclog.Off {
+		ret = append(ret, envLog+"="+strings.ToUpper(level.String()))
+	}
+	return ret, true
+}
+
+func providerSubsystem(providerType string) string {
+	if providerType == "" {
+		return SubsystemProvider
+	}
+	return SubsystemProvider + "." + providerType
+}
@@ -0,0 +1,97 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logging
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+func TestLogSpecLevelFor(t *testing.T) {
+	spec := parseLogSpec("warn, core=debug,Provider=INFO,provider.aws=trace,backend=error,bogus=loud,=trace")
+
+	tests := map[string]hclog.Level{
+		"core":           hclog.Debug,
+		"provider":       hclog.Info,
+		"provider.aws":   hclog.Trace,
+		"provider.azure": hclog.Info,
+		"backend.s3":     hclog.Error,
+		"provisioner":    hclog.Warn,
+		"bogus":          hclog.Warn,
+	}
+	for subsystem, want := range tests {
+		if got := spec.levelFor(subsystem); got != want {
+			t.Errorf("wrong level for %q: got %s, want %s", subsystem, got, want)
+		}
+	}
+}
+
+func TestLogSpecLevelFor_noDefault(t *testing.T) {
+	spec := parseLogSpec("provider.aws=trace")
+	if got := spec.levelFor("core"); got != hclog.Off {
+		t.Errorf("wrong level for core: got %s, want off", got)
+	}
+	if got := spec.levelFor("provider.aws"); got != hclog.Trace {
+		t.Errorf("wrong level for provider.aws: got %s, want trace", got)
+	}
+}
+
+func TestGlobalLogLevel(t *testing.T) {
+	t.Run("legacy", func(t *testing.T) {
+		t.Setenv(envTofuLog, "")
+		t.Setenv(envLog, "JSON")
+		level, json := globalLogLevel()
+		if level != hclog.Trace || !json {
+			t.Errorf("wrong result %s, %t", level, json)
+		}
+	})
+	t.Run("subsystems", func(t *testing.T) {
+		t.Setenv(envLog, "trace")
+		t.Setenv(envTofuLog, "core=warn,provider=debug")
+		t.Setenv(envTofuLogFormat, "json")
+		level, json := globalLogLevel()
+		if level != hclog.Warn || !json {
+			t.Errorf("wrong result %s, %t", level, json)
+		}
+		if got := providerLogLevel("aws"); got != hclog.Debug {
+			t.Errorf("wrong provider level %s", got)
+		}
+	})
+}
+
+func TestProviderPluginEnviron(t *testing.T) {
+	t.Run("legacy", func(t *testing.T) {
+		t.Setenv(envTofuLog, "")
+		t.Setenv(envLog, "debug")
+		if _, ok := ProviderPluginEnviron("aws"); ok {
+			t.Error("unexpected environment without TOFU_LOG")
+		}
+	})
+	t.Run("subsystems", func(t *testing.T) {
+		t.Setenv(envLog, "info")
+		t.Setenv(envLogProvider, "info")
+		t.Setenv(envTofuLog, "core=debug,provider.aws=trace")
+		env, ok := ProviderPluginEnviron("aws")
+		if !ok {
+			t.Fatal("no environment with TOFU_LOG")
+		}
+		if !slices.Contains(env, "TF_LOG=TRACE") {
+			t.Errorf("environment doesn't set TF_LOG=TRACE: %q", env)
+		}
+		for _, kv := range []string{"TF_LOG=info", "TF_LOG_PROVIDER=info", "TOFU_LOG=core=debug,provider.aws=trace"} {
+			if slices.Contains(env, kv) {
+				t.Errorf("environment still contains %q", kv)
+			}
+		}
+
+		env, _ = ProviderPluginEnviron("azure")
+		if slices.ContainsFunc(env, func(kv string) bool { return kv == "TF_LOG=TRACE" || kv == "TF_LOG=DEBUG" }) {
+			t.Errorf("environment enables logging for a provider without a level: %q", env)
+		}
+	})
+}
@@ -92,6 +92,9 @@ func (run *Run) GetReferences() ([]*addrs.Reference, tfdiags.Diagnostics) {
 
 	for _, rule := range run.Config.CheckRules {
 		for _, variable := range rule.Condition.Variables() {
+			if IsPlanReference(variable) {
+				continue
+			}
 			reference, diags := addrs.ParseRef(variable)
 			diagnostics = diagnostics.Append(diags)
 			if reference != nil {
@@ -99,6 +102,9 @@ func (run *Run) GetReferences() ([]*addrs.Reference, tfdiags.Diagnostics) {
 			}
 		}
 		for _, variable := range rule.ErrorMessage.Variables() {
+			if IsPlanReference(variable) {
+				continue
+			}
 			reference, diags := addrs.ParseRef(variable)
 			diagnostics = diagnostics.Append(diags)
 			if reference != nil {
@@ -110,6 +116,20 @@ func (run *Run) GetReferences() ([]*addrs.Reference, tfdiags.Diagnostics) {
 	return references, diagnostics
 }
 
+// IsPlanReference returns true if the given traversal refers to the planned
+// changes of the run block itself, through the `run.plan` object that is
+// available within its assertions.
+//
+// These references don't refer to anything within the configuration under
+// test, so they must be skipped when collecting references from assertions.
+func IsPlanReference(traversal hcl.Traversal) bool {
+	if len(traversal) < 2 || traversal.RootName() != "run" {
+		return false
+	}
+	attr, ok := traversal[1].(hcl.TraverseAttr)
+	return ok && attr.Name == "plan"
+}
+
 // ValidateExpectedFailures steps through the provided diagnostics (which should
 // be the result of a plan or an apply operation), and does 3 things:
 //  1. Removes diagnostics that match the expected failures from the config.
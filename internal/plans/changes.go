@@ -257,6 +257,15 @@ type ResourceInstanceChange struct {
 	// currently survive a round-trip through a saved plan file.
 	RequiredReplace cty.PathSet
 
+	// UnknownCauses maps the names of top-level attributes whose planned
+	// values are not known until apply to the references in their
+	// configuration that caused that, such as attributes of resource
+	// instances that are not yet created.
+	//
+	// Like RequiredReplace, this is retained only for UI-plan-rendering
+	// purposes and does not survive a round-trip through a saved plan file.
+	UnknownCauses map[string][]string
+
 	// Private allows a provider to stash any extra data that is opaque to
 	// OpenTofu that relates to this change. OpenTofu will save this
 	// byte-for-byte and return it to the provider in the apply call.
@@ -285,6 +294,7 @@ func (rc *ResourceInstanceChange) Encode(schema *providers.Schema) (*ResourceIns
 		ChangeSrc:       *cs,
 		ActionReason:    rc.ActionReason,
 		RequiredReplace: rc.RequiredReplace,
+		UnknownCauses:   rc.UnknownCauses,
 		Private:         rc.Private,
 	}, err
 }
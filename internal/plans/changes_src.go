@@ -7,6 +7,7 @@ package plans
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/opentofu/opentofu/internal/addrs"
 	"github.com/opentofu/opentofu/internal/providers"
@@ -72,6 +73,13 @@ type ResourceInstanceChangeSrc struct {
 	// Replace.
 	RequiredReplace cty.PathSet
 
+	// UnknownCauses maps the names of top-level attributes whose planned
+	// values are not known until apply to the references that caused that.
+	//
+	// See the field of the same name in ResourceInstanceChange for more
+	// details.
+	UnknownCauses map[string][]string
+
 	// Private allows a provider to stash any extra data that is opaque to
 	// OpenTofu that relates to this change. OpenTofu will save this
 	// byte-for-byte and return it to the provider in the apply call.
@@ -100,6 +108,7 @@ func (rcs *ResourceInstanceChangeSrc) Decode(schema *providers.Schema) (*Resourc
 		Change:          *change,
 		ActionReason:    rcs.ActionReason,
 		RequiredReplace: rcs.RequiredReplace,
+		UnknownCauses:   rcs.UnknownCauses,
 		Private:         rcs.Private,
 	}, nil
 }
@@ -120,6 +129,13 @@ func (rcs *ResourceInstanceChangeSrc) DeepCopy() *ResourceInstanceChangeSrc {
 
 	ret.RequiredReplace = cty.NewPathSet(ret.RequiredReplace.List()...)
 
+	if ret.UnknownCauses != nil {
+		ret.UnknownCauses = make(map[string][]string, len(rcs.UnknownCauses))
+		for name, causes := range rcs.UnknownCauses {
+			ret.UnknownCauses[name] = slices.Clone(causes)
+		}
+	}
+
 	if len(ret.Private) != 0 {
 		private := make([]byte, len(ret.Private))
 		copy(private, ret.Private)
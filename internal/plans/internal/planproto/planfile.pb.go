@@ -406,6 +406,9 @@ type Plan struct {
 	// plan, or else applying the plan will fail when it reaches a different
 	// conclusion about what action a particular resource instance needs.
 	ForceReplaceAddrs []string `protobuf:"bytes,16,rep,name=force_replace_addrs,json=forceReplaceAddrs,proto3" json:"force_replace_addrs,omitempty"`
+	// An optional human-readable explanation of why the resource instances
+	// in force_replace_addrs are being replaced, recorded for reviewers.
+	ForceReplaceReason string `protobuf:"bytes,23,opt,name=force_replace_reason,json=forceReplaceReason,proto3" json:"force_replace_reason,omitempty"`
 	// The version string for the OpenTofu binary that created this plan.
 	TerraformVersion string `protobuf:"bytes,14,opt,name=terraform_version,json=terraformVersion,proto3" json:"terraform_version,omitempty"`
 	// Backend is a description of the backend configuration and other related
@@ -547,6 +550,13 @@ func (x *Plan) GetForceReplaceAddrs() []string {
 	return nil
 }
 
+func (x *Plan) GetForceReplaceReason() string {
+	if x != nil {
+		return x.ForceReplaceReason
+	}
+	return ""
+}
+
 func (x *Plan) GetTerraformVersion() string {
 	if x != nil {
 		return x.TerraformVersion
@@ -1405,7 +1415,7 @@ var File_planfile_proto protoreflect.FileDescriptor
 
 const file_planfile_proto_rawDesc = "" +
 	"\n" +
-	"\x0eplanfile.proto\x12\x06tfplan\"\xeb\a\n" +
+	"\x0eplanfile.proto\x12\x06tfplan\"\x9d\b\n" +
 	"\x04Plan\x12\x18\n" +
 	"\aversion\x18\x01 \x01(\x04R\aversion\x12%\n" +
 	"\aui_mode\x18\x11 \x01(\x0e2\f.tfplan.ModeR\x06uiMode\x12\x18\n" +
@@ -1417,7 +1427,8 @@ const file_planfile_proto_rawDesc = "" +
 	"\rcheck_results\x18\x13 \x03(\v2\x14.tfplan.CheckResultsR\fcheckResults\x12!\n" +
 	"\ftarget_addrs\x18\x05 \x03(\tR\vtargetAddrs\x12#\n" +
 	"\rexclude_addrs\x18\x06 \x03(\tR\fexcludeAddrs\x12.\n" +
-	"\x13force_replace_addrs\x18\x10 \x03(\tR\x11forceReplaceAddrs\x12+\n" +
+	"\x13force_replace_addrs\x18\x10 \x03(\tR\x11forceReplaceAddrs\x120\n" +
+	"\x14force_replace_reason\x18\x17 \x01(\tR\x12forceReplaceReason\x12+\n" +
 	"\x11terraform_version\x18\x0e \x01(\tR\x10terraformVersion\x12)\n" +
 	"\abackend\x18\r \x01(\v2\x0f.tfplan.BackendR\abackend\x12K\n" +
 	"\x13relevant_attributes\x18\x0f \x03(\v2\x1a.tfplan.Plan.resource_attrR\x12relevantAttributes\x12\x1c\n" +
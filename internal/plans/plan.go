@@ -61,6 +61,12 @@ type Plan struct {
 	ForceReplaceAddrs []addrs.AbsResourceInstance
 	Backend           Backend
 
+	// ForceReplaceReason is an optional explanation, given by the user who
+	// created the plan, of why the instances in ForceReplaceAddrs are being
+	// replaced. It's for the benefit of anyone reviewing the plan and has no
+	// effect on how it's applied.
+	ForceReplaceReason string
+
 	// Errored is true if the Changes information is incomplete because
 	// the planning operation failed. An errored plan cannot be applied,
 	// but can be cautiously inspected for debugging purposes.
@@ -129,6 +135,10 @@ type Plan struct {
 	// Timestamp is the record of truth for when the plan happened.
 	Timestamp time.Time
 
+	// RunEnvironment describes the environment that created the plan, if it
+	// was created to be saved to a plan file.
+	RunEnvironment *RunEnvironment
+
 	// ExecutionGraph is an opaque representation of an execution graph for
 	// making the changes described in this plan. Exactly the same bytes should
 	// be used again when applying the plan to ensure that the apply phase
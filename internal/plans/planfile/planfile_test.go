@@ -108,6 +108,11 @@ func TestRoundtrip(t *testing.T) {
 		},
 	)
 
+	planIn.RunEnvironment, err = NewRunEnvironment(snapIn, locksIn, planIn.Backend, []string{"TF_LOG=trace"})
+	if err != nil {
+		t.Fatalf("failed to describe run environment: %s", err)
+	}
+
 	planFn := filepath.Join(t.TempDir(), "tfplan")
 
 	err = Create(planFn, CreateArgs{
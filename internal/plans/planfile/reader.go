@@ -25,6 +25,7 @@ import (
 const tfstateFilename = "tfstate"
 const tfstatePreviousFilename = "tfstate-prev"
 const dependencyLocksFilename = ".terraform.lock.hcl" // matches the conventional name in an input configuration
+const runEnvironmentFilename = "tfrunenv"
 
 // ErrUnusableLocalPlan is an error wrapper to indicate that we *think* the
 // input represents plan file data, but can't use it for some reason (as
@@ -161,6 +162,22 @@ func (r *Reader) ReadPlan() (*plans.Plan, error) {
 	ret.PrevRunState = prevRunStateFile.State
 	ret.PriorState = priorStateFile.State
 
+	// The run environment was a later addition, so older plan files don't
+	// include it.
+	for _, file := range r.zip.File {
+		if file.Name == runEnvironmentFilename {
+			r, err := file.Open()
+			if err != nil {
+				return nil, errUnusable(fmt.Errorf("failed to extract run environment from plan file: %w", err))
+			}
+			ret.RunEnvironment, err = readRunEnvironment(r)
+			if err != nil {
+				return nil, errUnusable(fmt.Errorf("failed to read run environment from plan file: %w", err))
+			}
+			break
+		}
+	}
+
 	return ret, nil
 }
 
@@ -0,0 +1,124 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package planfile
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"golang.org/x/mod/sumdb/dirhash"
+
+	"github.com/opentofu/opentofu/internal/configs/configload"
+	"github.com/opentofu/opentofu/internal/depsfile"
+	"github.com/opentofu/opentofu/internal/plans"
+	"github.com/opentofu/opentofu/version"
+)
+
+// runEnvironmentVariablePrefixes are the prefixes of the names of the
+// environment variables that are recorded in a run environment.
+var runEnvironmentVariablePrefixes = []string{"TF_", "TOFU_"}
+
+// NewRunEnvironment describes the environment that is creating a plan from
+// the given configuration snapshot, with the given dependency locks and for
+// the given backend, to embed in the plan file. environ is the environment
+// of the process, in the format returned by os.Environ.
+func NewRunEnvironment(snap *configload.Snapshot, locks *depsfile.Locks, backend plans.Backend, environ []string) (*plans.RunEnvironment, error) {
+	configHash := sha256.Sum256(backend.Config)
+	ret := &plans.RunEnvironment{
+		OpenTofuVersion:      version.String(),
+		Providers:            []plans.RunEnvironmentProvider{},
+		Modules:              []plans.RunEnvironmentModule{},
+		EnvironmentVariables: []string{},
+		Backend: plans.RunEnvironmentBackend{
+			Type:       backend.Type,
+			Workspace:  backend.Workspace,
+			ConfigHash: "sha256:" + hex.EncodeToString(configHash[:]),
+		},
+	}
+
+	if locks != nil {
+		for addr, lock := range locks.AllProviders() {
+			provider := plans.RunEnvironmentProvider{
+				Provider: addr.String(),
+				Version:  lock.Version().String(),
+				Hashes:   []string{},
+			}
+			for _, hash := range lock.AllHashes() {
+				provider.Hashes = append(provider.Hashes, hash.String())
+			}
+			ret.Providers = append(ret.Providers, provider)
+		}
+		sort.Slice(ret.Providers, func(i, j int) bool {
+			return ret.Providers[i].Provider < ret.Providers[j].Provider
+		})
+	}
+
+	if snap != nil {
+		for key, mod := range snap.Modules {
+			hash, err := snapshotModuleHash(mod)
+			if err != nil {
+				return nil, fmt.Errorf("failed to hash module %q: %w", key, err)
+			}
+			record := plans.RunEnvironmentModule{
+				Key:    key,
+				Source: mod.SourceAddr,
+				Hash:   hash,
+			}
+			if mod.Version != nil {
+				record.Version = mod.Version.String()
+			}
+			ret.Modules = append(ret.Modules, record)
+		}
+		sort.Slice(ret.Modules, func(i, j int) bool {
+			return ret.Modules[i].Key < ret.Modules[j].Key
+		})
+	}
+
+	for _, kv := range environ {
+		name, _, _ := strings.Cut(kv, "=")
+		for _, prefix := range runEnvironmentVariablePrefixes {
+			if strings.HasPrefix(name, prefix) {
+				ret.EnvironmentVariables = append(ret.EnvironmentVariables, name)
+				break
+			}
+		}
+	}
+	sort.Strings(ret.EnvironmentVariables)
+
+	return ret, nil
+}
+
+// snapshotModuleHash returns the checksum of the configuration files of the
+// given module.
+func snapshotModuleHash(mod *configload.SnapshotModule) (string, error) {
+	names := make([]string, 0, len(mod.Files))
+	for name := range mod.Files {
+		names = append(names, name)
+	}
+	return dirhash.Hash1(names, func(name string) (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(mod.Files[name])), nil
+	})
+}
+
+func writeRunEnvironment(env *plans.RunEnvironment, w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(env)
+}
+
+func readRunEnvironment(r io.Reader) (*plans.RunEnvironment, error) {
+	var ret plans.RunEnvironment
+	if err := json.NewDecoder(r).Decode(&ret); err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
@@ -0,0 +1,111 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package planfile
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/configs"
+	"github.com/opentofu/opentofu/internal/configs/configload"
+	"github.com/opentofu/opentofu/internal/depsfile"
+	"github.com/opentofu/opentofu/internal/getproviders"
+	"github.com/opentofu/opentofu/internal/plans"
+	tfversion "github.com/opentofu/opentofu/version"
+)
+
+func TestNewRunEnvironment(t *testing.T) {
+	fixtureDir := filepath.Join("testdata", "test-config")
+	loader, err := configload.NewLoader(&configload.Config{
+		ModulesDir: filepath.Join(fixtureDir, ".terraform", "modules"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, snap, diags := loader.LoadConfigWithSnapshot(t.Context(), fixtureDir, configs.RootModuleCallForTesting())
+	if diags.HasErrors() {
+		t.Fatal(diags.Error())
+	}
+
+	locks := depsfile.NewLocks()
+	locks.SetProvider(
+		addrs.NewDefaultProvider("boop"),
+		getproviders.MustParseVersion("1.0.0"),
+		getproviders.MustParseVersionConstraints(">= 1.0.0"),
+		[]getproviders.Hash{
+			getproviders.MustParseHash("fake:hello"),
+		},
+	)
+	backend := plans.Backend{
+		Type:      "local",
+		Config:    plans.DynamicValue([]byte("config placeholder")),
+		Workspace: "default",
+	}
+	environ := []string{
+		"TF_VAR_password=hunter2",
+		"HOME=/home/user",
+		"TOFU_ENABLE_FEATURE=1",
+		"TF_LOG=trace",
+	}
+
+	got, err := NewRunEnvironment(snap, locks, backend, environ)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := got.OpenTofuVersion, tfversion.String(); got != want {
+		t.Errorf("wrong version %q; want %q", got, want)
+	}
+	wantProviders := []plans.RunEnvironmentProvider{
+		{
+			Provider: "registry.opentofu.org/hashicorp/boop",
+			Version:  "1.0.0",
+			Hashes:   []string{"fake:hello"},
+		},
+	}
+	if diff := cmp.Diff(wantProviders, got.Providers); diff != "" {
+		t.Errorf("wrong providers\n%s", diff)
+	}
+	// Only the names of the variables are recorded, so that no secrets
+	// end up in the plan file.
+	wantVars := []string{"TF_LOG", "TF_VAR_password", "TOFU_ENABLE_FEATURE"}
+	if diff := cmp.Diff(wantVars, got.EnvironmentVariables); diff != "" {
+		t.Errorf("wrong environment variables\n%s", diff)
+	}
+	if got, want := got.Backend.ConfigHash, "sha256:"; !strings.HasPrefix(got, want) || strings.Contains(got, "placeholder") {
+		t.Errorf("wrong backend config hash %q", got)
+	}
+
+	var gotKeys []string
+	for _, mod := range got.Modules {
+		gotKeys = append(gotKeys, mod.Key)
+		if !strings.HasPrefix(mod.Hash, "h1:") {
+			t.Errorf("wrong hash %q for module %q", mod.Hash, mod.Key)
+		}
+		if mod.Key == "child_a" {
+			if mod.Source != "example.com/foo/bar_a/baz" || mod.Version != "1.0.1" {
+				t.Errorf("wrong source %q and version %q for module %q", mod.Source, mod.Version, mod.Key)
+			}
+		}
+	}
+	wantKeys := []string{"", "child_a", "child_a.child_c", "child_b", "child_b.child_d"}
+	if diff := cmp.Diff(wantKeys, gotKeys); diff != "" {
+		t.Errorf("wrong modules\n%s", diff)
+	}
+
+	// The checksums must be stable for the same configuration.
+	again, err := NewRunEnvironment(snap, locks, backend, environ)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(got, again); diff != "" {
+		t.Errorf("run environment is not deterministic\n%s", diff)
+	}
+}
@@ -241,6 +241,7 @@ func readTfplan(r io.Reader) (*plans.Plan, error) {
 		}
 		plan.ForceReplaceAddrs = append(plan.ForceReplaceAddrs, addr)
 	}
+	plan.ForceReplaceReason = rawPlan.ForceReplaceReason
 
 	for name, rawVal := range rawPlan.Variables {
 		val, err := valueFromTfplan(rawVal)
@@ -672,6 +673,7 @@ func writeTfplan(plan *plans.Plan, w io.Writer) error {
 	for _, replaceAddr := range plan.ForceReplaceAddrs {
 		rawPlan.ForceReplaceAddrs = append(rawPlan.ForceReplaceAddrs, replaceAddr.String())
 	}
+	rawPlan.ForceReplaceReason = plan.ForceReplaceReason
 
 	for name, val := range plan.VariableValues {
 		if is, ok := plan.EphemeralVariables[name]; ok && is {
@@ -284,6 +284,14 @@ func TestTFPlanRoundTrip(t *testing.T) {
 				Name: "woot",
 			}.Absolute(addrs.RootModuleInstance),
 		},
+		ForceReplaceAddrs: []addrs.AbsResourceInstance{
+			addrs.Resource{
+				Mode: addrs.ManagedResourceMode,
+				Type: "test_thing",
+				Name: "woot",
+			}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance),
+		},
+		ForceReplaceReason: "rotating credentials",
 		Backend: plans.Backend{
 			Type: "local",
 			Config: mustNewDynamicValue(
@@ -136,6 +136,22 @@ func Create(filename string, args CreateArgs, enc encryption.PlanEncryption) err
 		}
 	}
 
+	// tfrunenv file, describing the environment that created the plan
+	if args.Plan.RunEnvironment != nil {
+		w, err := zw.CreateHeader(&zip.FileHeader{
+			Name:     runEnvironmentFilename,
+			Method:   zip.Deflate,
+			Modified: time.Now(),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create embedded run environment file: %w", err)
+		}
+		err = writeRunEnvironment(args.Plan.RunEnvironment, w)
+		if err != nil {
+			return fmt.Errorf("failed to write run environment: %w", err)
+		}
+	}
+
 	// Finish zip file
 	zw.Close()
 	// Encrypt payload
@@ -0,0 +1,58 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plans
+
+// RunEnvironment describes the environment that created a saved plan, so
+// that an audit can establish exactly what produced a given plan file.
+//
+// It's recorded only for information, and has no effect on how the plan is
+// applied.
+type RunEnvironment struct {
+	// OpenTofuVersion is the version of OpenTofu that created the plan.
+	OpenTofuVersion string `json:"opentofu_version"`
+
+	Providers []RunEnvironmentProvider `json:"providers"`
+	Modules   []RunEnvironmentModule   `json:"modules"`
+
+	// EnvironmentVariables are the names, but not the values, of the
+	// environment variables for OpenTofu that were set when the plan was
+	// created.
+	EnvironmentVariables []string `json:"environment_variables"`
+
+	Backend RunEnvironmentBackend `json:"backend"`
+}
+
+// RunEnvironmentProvider describes a provider selected in the dependency
+// lock file when a plan was created.
+type RunEnvironmentProvider struct {
+	Provider string   `json:"provider"`
+	Version  string   `json:"version"`
+	Hashes   []string `json:"hashes"`
+}
+
+// RunEnvironmentModule describes a module in the configuration that a plan
+// was created from.
+type RunEnvironmentModule struct {
+	// Key is the module's key in the module manifest, which is empty for
+	// the root module.
+	Key     string `json:"key"`
+	Source  string `json:"source,omitempty"`
+	Version string `json:"version,omitempty"`
+
+	// Hash is a checksum of the module's configuration files, in the same
+	// "h1:" format as the checksums of provider packages.
+	Hash string `json:"hash"`
+}
+
+// RunEnvironmentBackend identifies the backend that a plan was created for.
+type RunEnvironmentBackend struct {
+	Type      string `json:"type"`
+	Workspace string `json:"workspace"`
+
+	// ConfigHash is a SHA-256 checksum of the backend configuration, which
+	// identifies it without revealing any credentials it might contain.
+	ConfigHash string `json:"config_hash"`
+}
@@ -9,17 +9,21 @@ import (
 	"fmt"
 	"path"
 	"runtime"
+	"strings"
 
 	"github.com/opentofu/opentofu/internal/tfdiags"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 // grpcErr extracts some known error types and formats them into better
 // representations for core. This must only be called from plugin methods.
-// Since we don't use RPC status errors for the plugin protocol, these do not
-// contain any useful details, and we can return some text that at least
-// indicates the plugin call and possible error condition.
+// Since we don't use RPC status errors for the plugin protocol, these usually
+// do not contain any useful details, and we can return some text that at least
+// indicates the plugin call and possible error condition. A plugin can still
+// attach machine-readable details to the status of an error, which we then
+// preserve in the diagnostic; see grpcErrDetails.
 func grpcErr(err error) (diags tfdiags.Diagnostics) {
 	if err == nil {
 		return
@@ -69,11 +73,106 @@ func grpcErr(err error) (diags tfdiags.Diagnostics) {
 			fmt.Sprintf("The %s method is not supported by this plugin.", requestName),
 		))
 	default:
-		diags = diags.Append(tfdiags.WholeContainingBody(
-			tfdiags.Error,
-			"Plugin error",
-			fmt.Sprintf("The plugin returned an unexpected error from %s: %v", requestName, err),
-		))
+		detail := fmt.Sprintf("The plugin returned an unexpected error from %s: %v", requestName, err)
+		details := grpcErrDetails(err)
+		if details == nil {
+			diags = diags.Append(tfdiags.WholeContainingBody(tfdiags.Error, "Plugin error", detail))
+			break
+		}
+
+		// The plugin described the cause of the error, so we keep those
+		// details with the diagnostic for machine-readable output. The
+		// remediation links are also useful to a human reader.
+		if len(details.Links) > 0 {
+			var buf strings.Builder
+			buf.WriteString(detail)
+			buf.WriteString("\n\nFor more information, refer to:")
+			for _, link := range details.Links {
+				if link.Description != "" {
+					fmt.Fprintf(&buf, "\n  - %s: %s", link.Description, link.URL)
+				} else {
+					fmt.Fprintf(&buf, "\n  - %s", link.URL)
+				}
+			}
+			detail = buf.String()
+		}
+		diag := tfdiags.WholeContainingBody(tfdiags.Error, "Plugin error", detail)
+		diags = diags.Append(tfdiags.Override(diag, tfdiags.Error, func() tfdiags.DiagnosticExtraWrapper {
+			return &providerErrorExtra{details: details}
+		}))
 	}
 	return
 }
+
+// grpcErrDetails returns the machine-readable details that the plugin
+// attached to the status of the given gRPC error, or nil if there are none.
+//
+// Plugins can describe the cause of an error with a google.rpc.ErrorInfo
+// message, link to documentation about how to resolve it with a
+// google.rpc.Help message, and indicate that the request can be retried with
+// a google.rpc.RetryInfo message. Any other kinds of status details are
+// ignored.
+func grpcErrDetails(err error) *tfdiags.ProviderError {
+	st, ok := status.FromError(err)
+	if !ok {
+		return nil
+	}
+
+	var ret *tfdiags.ProviderError
+	for _, detail := range st.Details() {
+		switch detail := detail.(type) {
+		case *errdetails.ErrorInfo:
+			if ret == nil {
+				ret = &tfdiags.ProviderError{}
+			}
+			ret.Reason = detail.GetReason()
+			ret.Domain = detail.GetDomain()
+			ret.Metadata = detail.GetMetadata()
+		case *errdetails.Help:
+			if ret == nil {
+				ret = &tfdiags.ProviderError{}
+			}
+			for _, link := range detail.GetLinks() {
+				ret.Links = append(ret.Links, tfdiags.ProviderErrorLink{
+					Description: link.GetDescription(),
+					URL:         link.GetUrl(),
+				})
+			}
+		case *errdetails.RetryInfo:
+			if ret == nil {
+				ret = &tfdiags.ProviderError{}
+			}
+			ret.Retryable = true
+			ret.RetryDelay = detail.GetRetryDelay().AsDuration()
+		}
+	}
+	if ret != nil {
+		ret.Status = st.Code().String()
+	}
+	return ret
+}
+
+// providerErrorExtra is an implementation of
+// tfdiags.DiagnosticExtraProviderError which we use in the "Extra" field of
+// the diagnostics returned by grpcErr when the plugin attached details to the
+// error.
+type providerErrorExtra struct {
+	details *tfdiags.ProviderError
+	wrapped interface{}
+}
+
+var _ tfdiags.DiagnosticExtraProviderError = (*providerErrorExtra)(nil)
+var _ tfdiags.DiagnosticExtraWrapper = (*providerErrorExtra)(nil)
+var _ tfdiags.DiagnosticExtraUnwrapper = (*providerErrorExtra)(nil)
+
+func (e *providerErrorExtra) DiagnosticProviderError() *tfdiags.ProviderError {
+	return e.details
+}
+
+func (e *providerErrorExtra) WrapDiagnosticExtra(inner interface{}) {
+	e.wrapped = inner
+}
+
+func (e *providerErrorExtra) UnwrapDiagnosticExtra() interface{} {
+	return e.wrapped
+}
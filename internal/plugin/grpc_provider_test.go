@@ -9,6 +9,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"slices"
 	"strings"
 	"testing"
@@ -18,7 +19,11 @@ import (
 	"github.com/zclconf/go-cty/cty"
 	"github.com/zclconf/go-cty/cty/msgpack"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/opentofu/opentofu/internal/configs/configschema"
@@ -747,6 +752,93 @@ func TestGRPCProvider_Configure(t *testing.T) {
 	checkDiags(t, resp.Diagnostics)
 }
 
+func TestGRPCProvider_Configure_GRPCErrorDetails(t *testing.T) {
+	client := mockProviderClient(t)
+	p := newGRPCProvider(client)
+
+	st, err := status.New(codes.PermissionDenied, "permission denied").WithDetails(
+		&errdetails.ErrorInfo{
+			Reason:   "MISSING_PERMISSION",
+			Domain:   "iam.example.com",
+			Metadata: map[string]string{"permission": "storage.buckets.create"},
+		},
+		&errdetails.Help{
+			Links: []*errdetails.Help_Link{
+				{Description: "Granting permissions", Url: "https://example.com/docs/iam"},
+			},
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client.EXPECT().Configure(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(nil, st.Err())
+
+	resp := p.ConfigureProvider(t.Context(), providers.ConfigureProviderRequest{
+		Config: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+	})
+	checkDiagsHasError(t, resp.Diagnostics)
+
+	got := tfdiags.DiagnosticProviderError(resp.Diagnostics[0])
+	want := &tfdiags.ProviderError{
+		Status:   "PermissionDenied",
+		Reason:   "MISSING_PERMISSION",
+		Domain:   "iam.example.com",
+		Metadata: map[string]string{"permission": "storage.buckets.create"},
+		Links: []tfdiags.ProviderErrorLink{
+			{Description: "Granting permissions", URL: "https://example.com/docs/iam"},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("wrong provider error details\n%s", diff)
+	}
+
+	wantDetail := "\n  - Granting permissions: https://example.com/docs/iam"
+	if detail := resp.Diagnostics[0].Description().Detail; !strings.Contains(detail, wantDetail) {
+		t.Errorf("missing remediation link in detail %q", detail)
+	}
+}
+
+func TestGRPCProvider_Configure_GRPCRetryInfo(t *testing.T) {
+	client := mockProviderClient(t)
+	p := newGRPCProvider(client)
+
+	st, err := status.New(codes.ResourceExhausted, "rate limit exceeded").WithDetails(
+		&errdetails.RetryInfo{
+			RetryDelay: durationpb.New(5 * time.Second),
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client.EXPECT().Configure(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(nil, st.Err())
+
+	resp := p.ConfigureProvider(t.Context(), providers.ConfigureProviderRequest{
+		Config: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+	})
+	checkDiagsHasError(t, resp.Diagnostics)
+
+	got := tfdiags.DiagnosticProviderError(resp.Diagnostics[0])
+	want := &tfdiags.ProviderError{
+		Status:     "ResourceExhausted",
+		Retryable:  true,
+		RetryDelay: 5 * time.Second,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("wrong provider error details\n%s", diff)
+	}
+}
 func TestGRPCProvider_Stop(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	client := mockproto.NewMockProviderClient(ctrl)
@@ -794,6 +886,48 @@ func TestGRPCProvider_ReadResource(t *testing.T) {
 	}
 }
 
+func TestGRPCProvider_ReadResourceChangeHint(t *testing.T) {
+	schema := providerProtoSchema()
+	schema.ServerCapabilities = &proto.ServerCapabilities{
+		ChangeHints: true,
+	}
+	client := mockProviderClientWithSchema(t, schema)
+	p := newGRPCProvider(client)
+
+	client.EXPECT().ReadResource(
+		gomock.Any(),
+		gomock.Any(),
+	).DoAndReturn(func(_ context.Context, req *proto.ReadResource_Request, _ ...grpc.CallOption) (*proto.ReadResource_Response, error) {
+		if got, want := req.PriorChangeHint, "etag-1"; got != want {
+			t.Errorf("wrong prior change hint %q; want %q", got, want)
+		}
+		return &proto.ReadResource_Response{
+			ChangeHint: "etag-1",
+			Unchanged:  true,
+		}, nil
+	})
+
+	if !p.GetProviderSchema(t.Context()).ServerCapabilities.ChangeHints {
+		t.Fatal("ChangeHints capability not set")
+	}
+
+	resp := p.ReadResource(t.Context(), providers.ReadResourceRequest{
+		TypeName: "resource",
+		PriorState: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("foo"),
+		}),
+		PriorChangeHint: "etag-1",
+	})
+	checkDiags(t, resp.Diagnostics)
+
+	if !resp.Unchanged {
+		t.Error("response is not marked as unchanged")
+	}
+	if got, want := resp.ChangeHint, "etag-1"; got != want {
+		t.Errorf("wrong change hint %q; want %q", got, want)
+	}
+}
+
 func TestGRPCProvider_ReadResourceJSON(t *testing.T) {
 	client := mockProviderClient(t)
 	p := newGRPCProvider(client)
@@ -1113,6 +1247,128 @@ func TestGRPCProvider_ApplyResourceChange(t *testing.T) {
 	}
 }
 
+// applyResourceChangeStream is a fake stream of
+// ApplyResourceChangeWithProgress events.
+type applyResourceChangeStream struct {
+	grpc.ClientStream
+
+	events []*proto.ApplyResourceChangeWithProgress_Event
+}
+
+func (s *applyResourceChangeStream) Recv() (*proto.ApplyResourceChangeWithProgress_Event, error) {
+	if len(s.events) == 0 {
+		return nil, io.EOF
+	}
+	event := s.events[0]
+	s.events = s.events[1:]
+	return event, nil
+}
+
+func TestGRPCProvider_ApplyResourceChangeWithProgress(t *testing.T) {
+	schema := providerProtoSchema()
+	schema.ServerCapabilities = &proto.ServerCapabilities{
+		ApplyProgress: true,
+	}
+	client := mockProviderClientWithSchema(t, schema)
+	p := newGRPCProvider(client)
+
+	client.EXPECT().ApplyResourceChangeWithProgress(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&applyResourceChangeStream{
+		events: []*proto.ApplyResourceChangeWithProgress_Event{
+			{
+				Type: &proto.ApplyResourceChangeWithProgress_Event_Progress_{
+					Progress: &proto.ApplyResourceChangeWithProgress_Event_Progress{
+						Phase:   "creating",
+						Percent: -1,
+					},
+				},
+			},
+			{
+				Type: &proto.ApplyResourceChangeWithProgress_Event_Progress_{
+					Progress: &proto.ApplyResourceChangeWithProgress_Event_Progress{
+						Phase:   "waiting for health checks",
+						Percent: 50,
+					},
+				},
+			},
+			{
+				Type: &proto.ApplyResourceChangeWithProgress_Event_Completed{
+					Completed: &proto.ApplyResourceChange_Response{
+						NewState: &proto.DynamicValue{
+							Msgpack: []byte("\x81\xa4attr\xa3bar"),
+						},
+					},
+				},
+			},
+		},
+	}, nil)
+
+	var got []providers.Progress
+	ctx := providers.ContextWithProgressReporter(t.Context(), func(progress providers.Progress) {
+		got = append(got, progress)
+	})
+	resp := p.ApplyResourceChange(ctx, providers.ApplyResourceChangeRequest{
+		TypeName: "resource",
+		PriorState: cty.NullVal(cty.Object(map[string]cty.Type{
+			"attr": cty.String,
+		})),
+		PlannedState: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("bar"),
+		}),
+		Config: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("bar"),
+		}),
+	})
+	checkDiags(t, resp.Diagnostics)
+
+	wantState := cty.ObjectVal(map[string]cty.Value{
+		"attr": cty.StringVal("bar"),
+	})
+	if !cmp.Equal(wantState, resp.NewState, typeComparer, valueComparer, equateEmpty) {
+		t.Error(cmp.Diff(wantState, resp.NewState, typeComparer, valueComparer, equateEmpty))
+	}
+
+	want := []providers.Progress{
+		{Phase: "creating", Percent: -1},
+		{Phase: "waiting for health checks", Percent: 50},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestGRPCProvider_ApplyResourceChangeWithProgressIncomplete(t *testing.T) {
+	schema := providerProtoSchema()
+	schema.ServerCapabilities = &proto.ServerCapabilities{
+		ApplyProgress: true,
+	}
+	client := mockProviderClientWithSchema(t, schema)
+	p := newGRPCProvider(client)
+
+	// A stream that ends without a final response must not be mistaken for
+	// a successful apply.
+	client.EXPECT().ApplyResourceChangeWithProgress(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&applyResourceChangeStream{}, nil)
+
+	resp := p.ApplyResourceChange(t.Context(), providers.ApplyResourceChangeRequest{
+		TypeName: "resource",
+		PriorState: cty.NullVal(cty.Object(map[string]cty.Type{
+			"attr": cty.String,
+		})),
+		PlannedState: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("bar"),
+		}),
+		Config: cty.ObjectVal(map[string]cty.Value{
+			"attr": cty.StringVal("bar"),
+		}),
+	})
+	checkDiagsHasError(t, resp.Diagnostics)
+}
+
 func TestGRPCProvider_ApplyResourceChangeJSON(t *testing.T) {
 	client := mockProviderClient(t)
 	p := newGRPCProvider(client)
@@ -1480,3 +1736,145 @@ func TestGRPCProvider_CallFunction(t *testing.T) {
 		t.Fatalf("%v", resp.Result)
 	}
 }
+
+// listResourceStream is a fake stream of ListResource events.
+type listResourceStream struct {
+	grpc.ClientStream
+
+	events []*proto.ListResource_Event
+}
+
+func (s *listResourceStream) Recv() (*proto.ListResource_Event, error) {
+	if len(s.events) == 0 {
+		return nil, io.EOF
+	}
+	event := s.events[0]
+	s.events = s.events[1:]
+	return event, nil
+}
+
+func TestGRPCProvider_ListResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mockproto.NewMockProviderClient(ctrl)
+
+	client.EXPECT().GetSchema(gomock.Any(), gomock.Any(), gomock.Any()).Return(&proto.GetProviderSchema_Response{
+		Provider: &proto.Schema{
+			Block: &proto.Schema_Block{},
+		},
+		ResourceSchemas: map[string]*proto.Schema{
+			"resource": {
+				Block: &proto.Schema_Block{
+					Attributes: []*proto.Schema_Attribute{
+						{
+							Name:     "id",
+							Type:     []byte(`"string"`),
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		ListResourceSchemas: map[string]*proto.Schema{
+			"resource": {
+				Block: &proto.Schema_Block{
+					Attributes: []*proto.Schema_Attribute{
+						{
+							Name:     "prefix",
+							Type:     []byte(`"string"`),
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}, nil)
+	client.EXPECT().GetResourceIdentitySchemas(gomock.Any(), gomock.Any()).Return(&proto.GetResourceIdentitySchemas_Response{
+		IdentitySchemas: map[string]*proto.ResourceIdentitySchema{
+			"resource": {
+				IdentityAttributes: []*proto.ResourceIdentitySchema_IdentityAttribute{
+					{
+						Name:              "id",
+						Type:              []byte(`"string"`),
+						RequiredForImport: true,
+					},
+				},
+			},
+		},
+	}, nil)
+
+	client.EXPECT().ListResource(
+		gomock.Any(),
+		gomock.Any(),
+	).DoAndReturn(func(_ context.Context, req *proto.ListResource_Request, _ ...grpc.CallOption) (proto.Provider_ListResourceClient, error) {
+		if got, want := req.TypeName, "resource"; got != want {
+			t.Errorf("wrong type name %q; want %q", got, want)
+		}
+		if got, want := req.Limit, int64(10); got != want {
+			t.Errorf("wrong limit %d; want %d", got, want)
+		}
+		return &listResourceStream{
+			events: []*proto.ListResource_Event{
+				{
+					DisplayName: "first",
+					Identity: &proto.ResourceIdentityData{
+						IdentityData: &proto.DynamicValue{Msgpack: []byte("\x81\xa2id\xa3foo")},
+					},
+				},
+				{
+					Diagnostic: []*proto.Diagnostic{
+						{
+							Severity: proto.Diagnostic_WARNING,
+							Summary:  "Skipped an object",
+						},
+					},
+				},
+				{
+					DisplayName: "second",
+					Identity: &proto.ResourceIdentityData{
+						IdentityData: &proto.DynamicValue{Msgpack: []byte("\x81\xa2id\xa3bar")},
+					},
+				},
+			},
+		}, nil
+	})
+
+	p := newGRPCProvider(client)
+	resp := p.ListResource(t.Context(), providers.ListResourceRequest{
+		TypeName: "resource",
+		Config: cty.ObjectVal(map[string]cty.Value{
+			"prefix": cty.StringVal("f"),
+		}),
+		Limit: 10,
+	})
+	if resp.Diagnostics.HasErrors() {
+		t.Fatal(resp.Diagnostics.Err())
+	}
+	if len(resp.Diagnostics) != 1 || resp.Diagnostics[0].Description().Summary != "Skipped an object" {
+		t.Errorf("wrong diagnostics %#v", resp.Diagnostics)
+	}
+
+	want := []providers.ListResourceResult{
+		{
+			DisplayName: "first",
+			Identity:    cty.ObjectVal(map[string]cty.Value{"id": cty.StringVal("foo")}),
+		},
+		{
+			DisplayName: "second",
+			Identity:    cty.ObjectVal(map[string]cty.Value{"id": cty.StringVal("bar")}),
+		},
+	}
+	if diff := cmp.Diff(want, resp.Results, typeComparer, valueComparer, equateEmpty); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestGRPCProvider_ListResourceUnsupported(t *testing.T) {
+	client := mockProviderClient(t)
+	p := newGRPCProvider(client)
+
+	resp := p.ListResource(t.Context(), providers.ListResourceRequest{
+		TypeName: "resource",
+		Config:   cty.EmptyObjectVal,
+	})
+	checkDiagsHasError(t, resp.Diagnostics)
+}
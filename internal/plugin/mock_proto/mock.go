@@ -63,6 +63,26 @@ func (mr *MockProviderClientMockRecorder) ApplyResourceChange(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyResourceChange", reflect.TypeOf((*MockProviderClient)(nil).ApplyResourceChange), varargs...)
 }
 
+// ApplyResourceChangeWithProgress mocks base method.
+func (m *MockProviderClient) ApplyResourceChangeWithProgress(ctx context.Context, in *tfplugin5.ApplyResourceChange_Request, opts ...grpc.CallOption) (tfplugin5.Provider_ApplyResourceChangeWithProgressClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ApplyResourceChangeWithProgress", varargs...)
+	ret0, _ := ret[0].(tfplugin5.Provider_ApplyResourceChangeWithProgressClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyResourceChangeWithProgress indicates an expected call of ApplyResourceChangeWithProgress.
+func (mr *MockProviderClientMockRecorder) ApplyResourceChangeWithProgress(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyResourceChangeWithProgress", reflect.TypeOf((*MockProviderClient)(nil).ApplyResourceChangeWithProgress), varargs...)
+}
+
 // CallFunction mocks base method.
 func (m *MockProviderClient) CallFunction(ctx context.Context, in *tfplugin5.CallFunction_Request, opts ...grpc.CallOption) (*tfplugin5.CallFunction_Response, error) {
 	m.ctrl.T.Helper()
@@ -9,6 +9,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 
 	plugin "github.com/hashicorp/go-plugin"
 	"github.com/zclconf/go-cty/cty"
@@ -113,6 +114,7 @@ func (p *GRPCProvider) getProviderSchema(ctx context.Context) (resp providers.Ge
 	resp.ResourceTypes = make(map[string]providers.Schema)
 	resp.DataSources = make(map[string]providers.Schema)
 	resp.EphemeralResources = make(map[string]providers.Schema)
+	resp.ListResources = make(map[string]providers.Schema)
 	resp.Functions = make(map[string]providers.FunctionSpec)
 
 	protoResp, err := p.getProtoProviderSchema(ctx)
@@ -158,6 +160,10 @@ func (p *GRPCProvider) getProviderSchema(ctx context.Context) (resp providers.Ge
 		resp.EphemeralResources[name] = convert.ProtoToEphemeralProviderSchema(res)
 	}
 
+	for name, res := range protoResp.ListResourceSchemas {
+		resp.ListResources[name] = convert.ProtoToProviderSchema(res)
+	}
+
 	identitySchemas, idsDiags := p.getResourceIdentitySchemas(ctx)
 	if idsDiags.HasErrors() {
 		// Identity schemas are an optional enhancement. A provider bug in
@@ -178,6 +184,8 @@ func (p *GRPCProvider) getProviderSchema(ctx context.Context) (resp providers.Ge
 	if protoResp.ServerCapabilities != nil {
 		resp.ServerCapabilities.PlanDestroy = protoResp.ServerCapabilities.PlanDestroy
 		resp.ServerCapabilities.GetProviderSchemaOptional = protoResp.ServerCapabilities.GetProviderSchemaOptional
+		resp.ServerCapabilities.ApplyProgress = protoResp.ServerCapabilities.ApplyProgress
+		resp.ServerCapabilities.ChangeHints = protoResp.ServerCapabilities.ChangeHints
 	}
 
 	return resp
@@ -554,6 +562,7 @@ func (p *GRPCProvider) ReadResource(ctx context.Context, r providers.ReadResourc
 		CurrentState:       &proto6.DynamicValue{Msgpack: mp},
 		Private:            r.Private,
 		ClientCapabilities: clientCapabilities,
+		PriorChangeHint:    r.PriorChangeHint,
 	}
 
 	// Attach the identity if it is available
@@ -583,6 +592,8 @@ func (p *GRPCProvider) ReadResource(ctx context.Context, r providers.ReadResourc
 		return resp
 	}
 	resp.Diagnostics = resp.Diagnostics.Append(convert.ProtoToDiagnostics(protoResp.Diagnostics))
+	resp.ChangeHint = protoResp.ChangeHint
+	resp.Unchanged = protoResp.Unchanged
 
 	state, err := decodeDynamicValue(protoResp.NewState, resSchema.Block.ImpliedType())
 	if err != nil {
@@ -787,7 +798,12 @@ func (p *GRPCProvider) ApplyResourceChange(ctx context.Context, r providers.Appl
 		protoReq.ProviderMeta = &proto6.DynamicValue{Msgpack: metaMP}
 	}
 
-	protoResp, err := p.client.ApplyResourceChange(ctx, protoReq)
+	var protoResp *proto6.ApplyResourceChange_Response
+	if schema.ServerCapabilities.ApplyProgress {
+		protoResp, err = p.applyResourceChangeWithProgress(ctx, protoReq)
+	} else {
+		protoResp, err = p.client.ApplyResourceChange(ctx, protoReq)
+	}
 	if err != nil {
 		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(err))
 		return resp
@@ -820,6 +836,35 @@ func (p *GRPCProvider) ApplyResourceChange(ctx context.Context, r providers.Appl
 	return resp
 }
 
+// applyResourceChangeWithProgress calls ApplyResourceChangeWithProgress,
+// passing each progress event to the reporter in the given context, and
+// returns the final response of the stream.
+func (p *GRPCProvider) applyResourceChangeWithProgress(ctx context.Context, req *proto6.ApplyResourceChange_Request) (*proto6.ApplyResourceChange_Response, error) {
+	stream, err := p.client.ApplyResourceChangeWithProgress(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		event, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("provider ended the apply without sending a final response")
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch event := event.Type.(type) {
+		case *proto6.ApplyResourceChangeWithProgress_Event_Progress_:
+			providers.ReportProgress(ctx, providers.Progress{
+				Phase:   event.Progress.GetPhase(),
+				Percent: int(event.Progress.GetPercent()),
+			})
+		case *proto6.ApplyResourceChangeWithProgress_Event_Completed:
+			return event.Completed, nil
+		}
+	}
+}
+
 func (p *GRPCProvider) ImportResourceState(ctx context.Context, r providers.ImportResourceStateRequest) (resp providers.ImportResourceStateResponse) {
 	logger.Trace("GRPCProvider.v6: ImportResourceState")
 
@@ -1230,6 +1275,83 @@ func (p *GRPCProvider) CallFunction(ctx context.Context, r providers.CallFunctio
 	return resp
 }
 
+func (p *GRPCProvider) ListResource(ctx context.Context, r providers.ListResourceRequest) (resp providers.ListResourceResponse) {
+	logger.Trace("GRPCProvider.v6: ListResource")
+
+	schema := p.GetProviderSchema(ctx)
+	resp.Diagnostics = schema.Diagnostics
+	if resp.Diagnostics.HasErrors() {
+		return resp
+	}
+
+	listSchema, ok := schema.ListResources[r.TypeName]
+	if !ok {
+		resp.Diagnostics = resp.Diagnostics.Append(fmt.Errorf("provider does not support listing resource type %q", r.TypeName))
+		return resp
+	}
+	resSchema, ok := schema.ResourceTypes[r.TypeName]
+	if !ok || resSchema.IdentitySchema == nil {
+		resp.Diagnostics = resp.Diagnostics.Append(fmt.Errorf("no identity schema available for resource type %q", r.TypeName))
+		return resp
+	}
+
+	config, err := msgpack.Marshal(r.Config, listSchema.Block.ImpliedType())
+	if err != nil {
+		resp.Diagnostics = resp.Diagnostics.Append(err)
+		return resp
+	}
+
+	protoReq := &proto6.ListResource_Request{
+		TypeName: r.TypeName,
+		Config: &proto6.DynamicValue{
+			Msgpack: config,
+		},
+		IncludeResourceObject: r.IncludeResourceObject,
+		Limit:                 r.Limit,
+	}
+
+	stream, err := p.client.ListResource(ctx, protoReq)
+	if err != nil {
+		resp.Diagnostics = resp.Diagnostics.Append(grpcErr(err))
+		return resp
+	}
+
+	for {
+		event, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			resp.Diagnostics = resp.Diagnostics.Append(grpcErr(err))
+			return resp
+		}
+		resp.Diagnostics = resp.Diagnostics.Append(convert.ProtoToDiagnostics(event.Diagnostic))
+		if event.Identity == nil || event.Identity.IdentityData == nil {
+			// This is an event reporting only diagnostics.
+			continue
+		}
+
+		result := providers.ListResourceResult{
+			DisplayName: event.DisplayName,
+		}
+		result.Identity, err = decodeDynamicValue(event.Identity.IdentityData, resSchema.IdentitySchema.ImpliedType())
+		if err != nil {
+			resp.Diagnostics = resp.Diagnostics.Append(err)
+			return resp
+		}
+		if event.ResourceObject != nil {
+			result.ResourceObject, err = decodeDynamicValue(event.ResourceObject, resSchema.Block.ImpliedType())
+			if err != nil {
+				resp.Diagnostics = resp.Diagnostics.Append(err)
+				return resp
+			}
+		}
+		resp.Results = append(resp.Results, result)
+	}
+
+	return resp
+}
+
 // closing the grpc connection is final, and tofu will call it at the end of every phase.
 func (p *GRPCProvider) Close(_ context.Context) error {
 	logger.Trace("GRPCProvider.v6: Close")
@@ -260,6 +260,11 @@ func (s simple) CallFunction(_ context.Context, r providers.CallFunctionRequest)
 	panic("Not Implemented")
 }
 
+func (s simple) ListResource(_ context.Context, r providers.ListResourceRequest) (resp providers.ListResourceResponse) {
+	resp.Diagnostics = resp.Diagnostics.Append(fmt.Errorf("unsupported list resource type %q", r.TypeName))
+	return resp
+}
+
 func (s simple) Close(_ context.Context) error {
 	return nil
 }
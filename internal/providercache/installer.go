@@ -423,7 +423,11 @@ func (i *Installer) ensureProviderVersionsNeed(
 			lock := locks.Provider(provider)
 			err = fmt.Errorf("the previously-selected version %s is no longer available", lock.Version())
 		} else {
-			err = fmt.Errorf("no available releases match the given constraints %s", getproviders.VersionConstraintsString(reqs[provider]))
+			err = getproviders.ErrNoMatchingVersions{
+				Provider:    provider,
+				Constraints: reqs[provider],
+				Available:   available,
+			}
 			log.Printf("[DEBUG] %s", err.Error())
 			log.Printf("[DEBUG] Available releases: %s", available)
 		}
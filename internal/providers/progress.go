@@ -0,0 +1,56 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package providers
+
+import (
+	"context"
+)
+
+// Progress describes how far a long-running provider operation, such as
+// ApplyResourceChange, has got towards completion.
+type Progress struct {
+	// Phase is a short human-readable description of what the provider is
+	// currently doing, such as "waiting for instance to become healthy".
+	// It may be empty if the provider only reports a percentage.
+	Phase string
+
+	// Percent is the estimated completion of the operation from 0 to 100,
+	// or -1 if the provider can't estimate how far through it is.
+	Percent int
+}
+
+// HasPercent returns true if the provider estimated a completion percentage.
+func (p Progress) HasPercent() bool {
+	return p.Percent >= 0
+}
+
+// ProgressReporter is a callback that receives progress updates for a
+// single provider operation.
+type ProgressReporter func(Progress)
+
+type progressReporterKey struct{}
+
+// ContextWithProgressReporter returns a context that carries the given
+// progress reporter, for passing to a provider operation whose progress the
+// caller wants to observe.
+func ContextWithProgressReporter(ctx context.Context, reporter ProgressReporter) context.Context {
+	return context.WithValue(ctx, progressReporterKey{}, reporter)
+}
+
+// ReportProgress sends a progress update to the reporter associated with the
+// given context, if any.
+//
+// Provider implementations call this while handling a request to let
+// OpenTofu show the user what a long-running operation is doing. It's safe
+// to call even if the caller isn't interested in progress, in which case it
+// does nothing.
+func ReportProgress(ctx context.Context, progress Progress) {
+	reporter, ok := ctx.Value(progressReporterKey{}).(ProgressReporter)
+	if !ok || reporter == nil {
+		return
+	}
+	reporter(progress)
+}
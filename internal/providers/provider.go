@@ -152,6 +152,12 @@ type Configured interface {
 	// GetFunctions returns a full list of functions defined in this provider. It should be a super
 	// set of the functions returned in GetProviderSchema()
 	GetFunctions(context.Context) GetFunctionsResponse
+
+	// ListResource requests that the provider list the existing remote
+	// objects of the given resource type that match the given configuration,
+	// so that they can be imported. Only the resource types that have a list
+	// resource schema in the provider schema can be listed.
+	ListResource(context.Context, ListResourceRequest) ListResourceResponse
 }
 
 // Interface represents the set of methods required for a complete resource
@@ -190,6 +196,11 @@ type GetProviderSchemaResponse struct {
 
 	// EphemeralResources maps the ephemeral type name to that type's schema.
 	EphemeralResources map[string]Schema
+
+	// ListResources maps the name of each resource type whose existing
+	// remote objects can be listed to the schema of the configuration for
+	// listing them.
+	ListResources map[string]Schema
 }
 
 type ResourceIdentitySchema struct {
@@ -235,6 +246,19 @@ type ServerCapabilities struct {
 	// In other words, the providers for which GetProviderSchemaOptional is false
 	// require their schema to be read after EVERY instantiation to function normally.
 	GetProviderSchemaOptional bool
+
+	// ApplyProgress signals that this provider can report the progress of
+	// ApplyResourceChange calls through ReportProgress. This capability is an
+	// OpenTofu extension to the plugin protocol.
+	ApplyProgress bool
+
+	// The ChangeHints capability indicates that this provider can return a
+	// lightweight change indicator, such as an ETag or a last-modified
+	// timestamp, for each object it reads, and that it will honor the
+	// PriorChangeHint field of ReadResourceRequest by reporting an object
+	// whose indicator is unchanged instead of reading it in full. This
+	// capability is an OpenTofu extension to the plugin protocol.
+	ChangeHints bool
 }
 
 type FunctionSpec struct {
@@ -413,6 +437,11 @@ type ReadResourceRequest struct {
 
 	// PriorIdentity contains the identity of the resource prior to the read.
 	PriorIdentity cty.Value
+
+	// PriorChangeHint is the change indicator that the provider returned
+	// when this object was last read, if any. It is set only for providers
+	// that declare the ChangeHints server capability.
+	PriorChangeHint string
 }
 
 type ReadResourceResponse struct {
@@ -428,6 +457,18 @@ type ReadResourceResponse struct {
 
 	// NewIdentity contains the identity of the resource after the read.
 	NewIdentity cty.Value
+
+	// ChangeHint is an opaque change indicator for the current remote object,
+	// which OpenTofu will store in state and send back as PriorChangeHint on
+	// the next read. It is used only for providers that declare the
+	// ChangeHints server capability.
+	ChangeHint string
+
+	// Unchanged indicates that the change indicator of the remote object
+	// still matches PriorChangeHint, and so the provider didn't read the
+	// object in full. When this is set, OpenTofu keeps the prior state of
+	// the object and ignores NewState, Private, and NewIdentity.
+	Unchanged bool
 }
 
 type PlanResourceChangeRequest struct {
@@ -735,6 +776,47 @@ type CloseEphemeralResourceResponse struct {
 	Diagnostics tfdiags.Diagnostics
 }
 
+type ListResourceRequest struct {
+	// TypeName is the name of the resource type to list.
+	TypeName string
+
+	// Config is the configuration for listing the objects, which the
+	// provider typically uses to filter them, conforming to the list resource
+	// schema of the resource type.
+	Config cty.Value
+
+	// IncludeResourceObject requests that the provider include the complete
+	// object in each result, rather than only its identity.
+	IncludeResourceObject bool
+
+	// Limit is the maximum number of results to return.
+	Limit int64
+}
+
+type ListResourceResponse struct {
+	// Results are the objects found, in the order the provider returned them.
+	Results []ListResourceResult
+
+	// Diagnostics contains any warnings or errors from the method call,
+	// including those reported for individual results.
+	Diagnostics tfdiags.Diagnostics
+}
+
+// ListResourceResult describes an existing remote object found by
+// ListResource.
+type ListResourceResult struct {
+	// DisplayName is a description of the object for showing in the UI.
+	DisplayName string
+
+	// Identity is the resource identity of the object, conforming to the
+	// identity schema of the resource type, which can be used to import it.
+	Identity cty.Value
+
+	// ResourceObject is the complete object, if IncludeResourceObject was
+	// set and the provider returned it, or cty.NilVal otherwise.
+	ResourceObject cty.Value
+}
+
 type GetFunctionsResponse struct {
 	Functions map[string]FunctionSpec
 
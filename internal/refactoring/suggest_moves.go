@@ -0,0 +1,184 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package refactoring
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/plans"
+	"github.com/opentofu/opentofu/internal/states"
+)
+
+// SuggestedMove is a "moved" block that would replace a planned pair of
+// actions destroying one instance of a resource and creating another with
+// the same attributes, such as after switching the resource from count to
+// for_each.
+type SuggestedMove struct {
+	From addrs.AbsResourceInstance
+	To   addrs.AbsResourceInstance
+}
+
+// SuggestMoves returns a move for each managed resource instance that the
+// given changes would destroy while also creating another instance of the
+// same resource with matching attributes, in a deterministic order.
+//
+// An attribute that won't be known until the new instance is created matches
+// any value, but the new instance must have at least one known attribute
+// that isn't null so that unrelated instances don't match trivially.
+func SuggestMoves(changes []*plans.ResourceInstanceChange) []SuggestedMove {
+	type candidates struct {
+		deletes []*plans.ResourceInstanceChange
+		creates []*plans.ResourceInstanceChange
+	}
+	byResource := make(map[string]*candidates)
+	var resources []string
+	for _, change := range changes {
+		if change.Addr.Resource.Resource.Mode != addrs.ManagedResourceMode || change.DeposedKey != states.NotDeposed {
+			continue
+		}
+		key := change.Addr.ConfigResource().String()
+		c, ok := byResource[key]
+		if !ok {
+			c = &candidates{}
+			byResource[key] = c
+			resources = append(resources, key)
+		}
+		switch change.Action {
+		case plans.Delete:
+			c.deletes = append(c.deletes, change)
+		case plans.Create:
+			c.creates = append(c.creates, change)
+		}
+	}
+	sort.Strings(resources)
+
+	var moves []SuggestedMove
+	for _, key := range resources {
+		c := byResource[key]
+		sortChanges(c.deletes)
+		sortChanges(c.creates)
+		paired := make([]bool, len(c.creates))
+		for _, del := range c.deletes {
+			for i, create := range c.creates {
+				if paired[i] || create.ProviderAddr.String() != del.ProviderAddr.String() {
+					continue
+				}
+				before, _ := del.Before.UnmarkDeep()
+				after, _ := create.After.UnmarkDeep()
+				if !hasKnownAttribute(after) || !valueMatches(before, after) {
+					continue
+				}
+				paired[i] = true
+				moves = append(moves, SuggestedMove{From: del.Addr, To: create.Addr})
+				break
+			}
+		}
+	}
+	return moves
+}
+
+// WriteSuggestedMoves writes a "moved" block for each of the given moves,
+// suitable for adding to the root module.
+func WriteSuggestedMoves(w io.Writer, moves []SuggestedMove) error {
+	for i, move := range moves {
+		if i > 0 {
+			if _, err := io.WriteString(w, "\n"); err != nil {
+				return err
+			}
+		}
+		_, err := fmt.Fprintf(w, "moved {\n  from = %s\n  to   = %s\n}\n", move.From, move.To)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sortChanges(changes []*plans.ResourceInstanceChange) {
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].Addr.Less(changes[j].Addr)
+	})
+}
+
+// hasKnownAttribute returns true if the given object has at least one
+// attribute whose value is known and not null.
+func hasKnownAttribute(v cty.Value) bool {
+	if v.IsNull() || !v.IsKnown() || !v.Type().IsObjectType() {
+		return false
+	}
+	for it := v.ElementIterator(); it.Next(); {
+		_, attr := it.Element()
+		if attr.IsKnown() && !attr.IsNull() {
+			return true
+		}
+	}
+	return false
+}
+
+// valueMatches returns true if the planned value would be equal to the prior
+// value once any unknown values within it are known.
+func valueMatches(prior, planned cty.Value) bool {
+	if !planned.IsKnown() {
+		return true
+	}
+	if !prior.IsKnown() {
+		return false
+	}
+	if prior.IsNull() || planned.IsNull() {
+		return prior.IsNull() && planned.IsNull()
+	}
+
+	ty := planned.Type()
+	switch {
+	case ty.IsObjectType():
+		if !prior.Type().IsObjectType() || len(prior.Type().AttributeTypes()) != len(ty.AttributeTypes()) {
+			return false
+		}
+		for name := range ty.AttributeTypes() {
+			if !prior.Type().HasAttribute(name) || !valueMatches(prior.GetAttr(name), planned.GetAttr(name)) {
+				return false
+			}
+		}
+		return true
+	case ty.IsMapType():
+		if !prior.Type().IsMapType() || prior.LengthInt() != planned.LengthInt() {
+			return false
+		}
+		for it := planned.ElementIterator(); it.Next(); {
+			k, v := it.Element()
+			if !prior.HasIndex(k).True() || !valueMatches(prior.Index(k), v) {
+				return false
+			}
+		}
+		return true
+	case ty.IsListType() || ty.IsTupleType():
+		if !prior.CanIterateElements() || prior.LengthInt() != planned.LengthInt() {
+			return false
+		}
+		for it := planned.ElementIterator(); it.Next(); {
+			k, v := it.Element()
+			if !valueMatches(prior.Index(k), v) {
+				return false
+			}
+		}
+		return true
+	case ty.IsSetType():
+		if !planned.IsWhollyKnown() {
+			// The elements of a set are identified by their values, so
+			// there's no way to tell which prior element an unknown one
+			// will become.
+			return true
+		}
+		return prior.Equals(planned).True()
+	default:
+		return prior.Type().Equals(ty) && prior.Equals(planned).True()
+	}
+}
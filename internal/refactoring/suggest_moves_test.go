@@ -0,0 +1,115 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package refactoring
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/plans"
+)
+
+func TestSuggestMoves(t *testing.T) {
+	provider := addrs.AbsProviderConfig{
+		Module:   addrs.RootModule,
+		Provider: addrs.NewDefaultProvider("test"),
+	}
+	instance := func(name string, tags cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"id":   cty.StringVal(name + "-id"),
+			"name": cty.StringVal(name),
+			"tags": tags,
+		})
+	}
+	planned := func(name string, tags cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"id":   cty.UnknownVal(cty.String),
+			"name": cty.StringVal(name),
+			"tags": tags,
+		})
+	}
+	change := func(addr string, action plans.Action, before, after cty.Value) *plans.ResourceInstanceChange {
+		return &plans.ResourceInstanceChange{
+			Addr:         mustAbsResourceInstanceAddr(addr),
+			PrevRunAddr:  mustAbsResourceInstanceAddr(addr),
+			ProviderAddr: provider,
+			Change: plans.Change{
+				Action: action,
+				Before: before,
+				After:  after,
+			},
+		}
+	}
+	objType := cty.Object(map[string]cty.Type{"id": cty.String, "name": cty.String, "tags": cty.Map(cty.String)})
+	tags := cty.MapVal(map[string]cty.Value{"env": cty.StringVal("prod")})
+	noTags := cty.NullVal(cty.Map(cty.String))
+
+	changes := []*plans.ResourceInstanceChange{
+		change(`test_instance.foo[1]`, plans.Delete, instance("b", tags), cty.NullVal(objType)),
+		change(`test_instance.foo[0]`, plans.Delete, instance("a", tags), cty.NullVal(objType)),
+		change(`test_instance.foo[2]`, plans.Delete, instance("c", tags), cty.NullVal(objType)),
+		change(`test_instance.foo["a"]`, plans.Create, cty.NullVal(objType), planned("a", tags)),
+		change(`test_instance.foo["b"]`, plans.Create, cty.NullVal(objType), planned("b", tags)),
+		// The tags don't match those of test_instance.foo[2].
+		change(`test_instance.foo["c"]`, plans.Create, cty.NullVal(objType), planned("c", noTags)),
+
+		// Instances of different resources never match.
+		change(`test_instance.bar`, plans.Delete, instance("d", tags), cty.NullVal(objType)),
+		change(`test_instance.baz["d"]`, plans.Create, cty.NullVal(objType), planned("d", tags)),
+
+		// Nor do data resources.
+		change(`data.test_source.qux[0]`, plans.Delete, instance("e", tags), cty.NullVal(objType)),
+		change(`data.test_source.qux["e"]`, plans.Create, cty.NullVal(objType), planned("e", tags)),
+
+		// Adding count to a resource in a module.
+		change(`module.child.test_instance.foo`, plans.Delete, instance("f", tags), cty.NullVal(objType)),
+		change(`module.child.test_instance.foo[0]`, plans.Create, cty.NullVal(objType), planned("f", tags)),
+	}
+
+	got := SuggestMoves(changes)
+	var gotStrs []string
+	for _, move := range got {
+		gotStrs = append(gotStrs, move.From.String()+" -> "+move.To.String())
+	}
+	want := []string{
+		`module.child.test_instance.foo -> module.child.test_instance.foo[0]`,
+		`test_instance.foo[0] -> test_instance.foo["a"]`,
+		`test_instance.foo[1] -> test_instance.foo["b"]`,
+	}
+	if diff := cmp.Diff(want, gotStrs); diff != "" {
+		t.Fatalf("wrong moves\n%s", diff)
+	}
+
+	var buf strings.Builder
+	if err := WriteSuggestedMoves(&buf, got[1:]); err != nil {
+		t.Fatal(err)
+	}
+	wantConfig := `moved {
+  from = test_instance.foo[0]
+  to   = test_instance.foo["a"]
+}
+
+moved {
+  from = test_instance.foo[1]
+  to   = test_instance.foo["b"]
+}
+`
+	if diff := cmp.Diff(wantConfig, buf.String()); diff != "" {
+		t.Errorf("wrong configuration\n%s", diff)
+	}
+}
+
+func mustAbsResourceInstanceAddr(s string) addrs.AbsResourceInstance {
+	addr, diags := addrs.ParseAbsResourceInstanceStr(s)
+	if diags.HasErrors() {
+		panic(diags.Err())
+	}
+	return addr
+}
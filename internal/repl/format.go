@@ -6,14 +6,102 @@
 package repl
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/hcl/v2/hclwrite"
 	"github.com/opentofu/opentofu/internal/lang/marks"
 	"github.com/zclconf/go-cty/cty"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
 )
 
+// OutputFormat is a way of rendering the values of expressions evaluated in
+// the console.
+type OutputFormat string
+
+const (
+	// OutputFormatDefault renders values using FormatValue.
+	OutputFormatDefault OutputFormat = ""
+
+	// OutputFormatJSON renders values as compact JSON, in the same way as
+	// the jsonencode function.
+	OutputFormatJSON OutputFormat = "json"
+
+	// OutputFormatHCL renders values as literal expressions in the OpenTofu
+	// language, ready to paste into configuration. Unlike OutputFormatDefault,
+	// this doesn't describe the exact types of the values.
+	OutputFormatHCL OutputFormat = "hcl"
+
+	// OutputFormatRaw renders strings, numbers, and bools without any
+	// quoting, which is useful for passing them to other programs.
+	OutputFormatRaw OutputFormat = "raw"
+)
+
+// ParseOutputFormat returns the output format with the given name, or false
+// if there is no such format. The empty name is the default format.
+func ParseOutputFormat(name string) (OutputFormat, bool) {
+	switch format := OutputFormat(name); format {
+	case OutputFormatDefault, OutputFormatJSON, OutputFormatHCL, OutputFormatRaw:
+		return format, true
+	default:
+		return OutputFormatDefault, false
+	}
+}
+
+// FormatValueAs formats a value in the given output format.
+//
+// Unlike the default format, the other formats have no way to show unknown,
+// sensitive, or ephemeral values, so this returns an error for values that
+// are or contain any of those.
+func FormatValueAs(v cty.Value, format OutputFormat) (string, error) {
+	if format == OutputFormatDefault {
+		return FormatValue(v, 0), nil
+	}
+
+	switch {
+	case !v.IsWhollyKnown():
+		return "", errors.New("the value will be known only after apply")
+	case marks.Contains(v, marks.Sensitive):
+		return "", errors.New("the value is sensitive")
+	case marks.Contains(v, marks.Ephemeral):
+		return "", errors.New("the value is ephemeral")
+	}
+	v, _ = v.UnmarkDeep()
+
+	switch format {
+	case OutputFormatJSON:
+		if v.IsNull() {
+			return "null", nil
+		}
+		buf, err := ctyjson.Marshal(v, v.Type())
+		if err != nil {
+			return "", err
+		}
+		return string(buf), nil
+	case OutputFormatHCL:
+		src := hclwrite.Format(hclwrite.TokensForValue(v).Bytes())
+		return string(src), nil
+	case OutputFormatRaw:
+		ty := v.Type()
+		switch {
+		case !ty.IsPrimitiveType():
+			return "", fmt.Errorf("only strings, numbers, and bools have a raw format, but this is %s", ty.FriendlyName())
+		case v.IsNull():
+			return "", errors.New("null values have no raw format")
+		case ty == cty.String:
+			return v.AsString(), nil
+		case ty == cty.Number:
+			return v.AsBigFloat().Text('f', -1), nil
+		default:
+			return strconv.FormatBool(v.True()), nil
+		}
+	default:
+		return "", fmt.Errorf("unsupported output format %q", format)
+	}
+}
+
 // FormatValue formats a value in a way that resembles OpenTofu language syntax
 // and uses the type conversion functions where necessary to indicate exactly
 // what type it is given, so that equality test failures can be quickly
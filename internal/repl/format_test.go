@@ -194,3 +194,104 @@ EOT_`,
 		})
 	}
 }
+
+func TestFormatValueAs(t *testing.T) {
+	obj := cty.ObjectVal(map[string]cty.Value{
+		"name":  cty.StringVal("web"),
+		"ports": cty.ListVal([]cty.Value{cty.NumberIntVal(80), cty.NumberIntVal(443)}),
+	})
+
+	tests := map[string]struct {
+		Val     cty.Value
+		Format  OutputFormat
+		Want    string
+		WantErr string
+	}{
+		"default": {
+			Val:    cty.StringVal("hello"),
+			Format: OutputFormatDefault,
+			Want:   `"hello"`,
+		},
+		"json object": {
+			Val:    obj,
+			Format: OutputFormatJSON,
+			Want:   `{"name":"web","ports":[80,443]}`,
+		},
+		"json null": {
+			Val:    cty.NullVal(cty.DynamicPseudoType),
+			Format: OutputFormatJSON,
+			Want:   `null`,
+		},
+		"hcl object": {
+			Val:    obj,
+			Format: OutputFormatHCL,
+			Want: `{
+  name  = "web"
+  ports = [80, 443]
+}`,
+		},
+		"hcl string": {
+			Val:    cty.StringVal("a \"quoted\" ${value}"),
+			Format: OutputFormatHCL,
+			Want:   `"a \"quoted\" $${value}"`,
+		},
+		"raw string": {
+			Val:    cty.StringVal("line one\nline two"),
+			Format: OutputFormatRaw,
+			Want:   "line one\nline two",
+		},
+		"raw number": {
+			Val:    cty.NumberFloatVal(1.5),
+			Format: OutputFormatRaw,
+			Want:   "1.5",
+		},
+		"raw bool": {
+			Val:    cty.True,
+			Format: OutputFormatRaw,
+			Want:   "true",
+		},
+		"raw list": {
+			Val:     cty.ListVal([]cty.Value{cty.StringVal("a")}),
+			Format:  OutputFormatRaw,
+			WantErr: "only strings, numbers, and bools have a raw format, but this is list of string",
+		},
+		"raw null": {
+			Val:     cty.NullVal(cty.String),
+			Format:  OutputFormatRaw,
+			WantErr: "null values have no raw format",
+		},
+		"unknown": {
+			Val:     cty.ListVal([]cty.Value{cty.UnknownVal(cty.String)}),
+			Format:  OutputFormatJSON,
+			WantErr: "the value will be known only after apply",
+		},
+		"sensitive": {
+			Val:     cty.ObjectVal(map[string]cty.Value{"a": cty.StringVal("secret").Mark(marks.Sensitive)}),
+			Format:  OutputFormatHCL,
+			WantErr: "the value is sensitive",
+		},
+		"ephemeral": {
+			Val:     cty.StringVal("token").Mark(marks.Ephemeral),
+			Format:  OutputFormatRaw,
+			WantErr: "the value is ephemeral",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := FormatValueAs(test.Val, test.Format)
+			if test.WantErr != "" {
+				if err == nil || err.Error() != test.WantErr {
+					t.Fatalf("wrong error\ngot:  %v\nwant: %s", err, test.WantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got != test.Want {
+				t.Errorf("wrong result\ngot:  %s\nwant: %s", got, test.Want)
+			}
+		})
+	}
+}
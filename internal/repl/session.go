@@ -25,6 +25,14 @@ import (
 type Session struct {
 	// Scope is the evaluation scope where expressions will be evaluated.
 	Scope *lang.Scope
+
+	// Format is the output format for the values of expressions, unless a
+	// line selects a different one with a prefix like ":json".
+	Format OutputFormat
+
+	// Reload, if set, re-reads the configuration and the variables to build
+	// a new scope to replace Scope, for the "reload" command.
+	Reload func() (*lang.Scope, tfdiags.Diagnostics)
 }
 
 // Handle handles a single line of input from the REPL.
@@ -42,13 +50,75 @@ func (s *Session) Handle(line string) (string, bool, tfdiags.Diagnostics) {
 	case strings.TrimSpace(line) == "help":
 		ret := s.handleHelp()
 		return ret, false, nil
+	case strings.TrimSpace(line) == "reload":
+		ret, diags := s.handleReload()
+		return ret, false, diags
+	case strings.HasPrefix(strings.TrimSpace(line), ":"):
+		ret, diags := s.handleFormatPrefix(line)
+		return ret, false, diags
 	default:
-		ret, diags := s.handleEval(line)
+		ret, diags := s.handleEval(line, s.Format)
 		return ret, false, diags
 	}
 }
 
-func (s *Session) handleEval(line string) (string, tfdiags.Diagnostics) {
+// handleReload replaces the session's scope with a new one built from the
+// current configuration and variables. If that fails, the session keeps its
+// previous scope so that it can still evaluate expressions.
+func (s *Session) handleReload() (string, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	if s.Reload == nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Reload not supported",
+			"This console session cannot reload the configuration.",
+		))
+		return "", diags
+	}
+
+	scope, moreDiags := s.Reload()
+	diags = diags.Append(moreDiags)
+	if scope == nil || diags.HasErrors() {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to reload configuration",
+			"Due to the problems above, the console continues to use the configuration and variables from before the reload.",
+		))
+		return "", diags
+	}
+	s.Scope = scope
+	return "Reloaded the configuration and variables.", diags
+}
+
+// handleFormatPrefix handles a line that starts with the name of an output
+// format, such as ":json expr", which evaluates the expression and renders
+// its value in that format.
+func (s *Session) handleFormatPrefix(line string) (string, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	name, expr, _ := strings.Cut(strings.TrimSpace(line)[1:], " ")
+	format, ok := ParseOutputFormat(name)
+	if !ok || format == OutputFormatDefault {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Unknown console command",
+			fmt.Sprintf("There is no console command named %q. To render the value of an expression in a specific format, write :json, :hcl, or :raw before it.", ":"+name),
+		))
+		return "", diags
+	}
+	if strings.TrimSpace(expr) == "" {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Missing expression",
+			fmt.Sprintf("The :%s command must be followed by an expression to evaluate.", format),
+		))
+		return "", diags
+	}
+	return s.handleEval(expr, format)
+}
+
+func (s *Session) handleEval(line string, format OutputFormat) (string, tfdiags.Diagnostics) {
 	var diags tfdiags.Diagnostics
 
 	// Parse the given line as an expression
@@ -91,7 +161,16 @@ func (s *Session) handleEval(line string) (string, tfdiags.Diagnostics) {
 		}
 	}
 
-	return FormatValue(val, 0), diags
+	ret, err := FormatValueAs(val, format)
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Cannot render value",
+			fmt.Sprintf("The value cannot be shown in the %s format: %s.", format, err),
+		))
+		return "", diags
+	}
+	return ret, diags
 }
 
 func (s *Session) handleHelp() string {
@@ -103,6 +182,14 @@ to the ID of "aws_instance.foo" if it exists in your state.
 
 Type in the interpolation to test and hit <enter> to see the result.
 
+To see the result in a specific format, write one of these before the
+interpolation:
+  :json  as JSON, in the same way as the jsonencode function.
+  :hcl   as a literal expression that you can paste into configuration.
+  :raw   as a string, number, or bool without quotes.
+
+To see changes to the configuration or to the variable files, type "reload".
+
 To exit the console, type "exit" and hit <enter>, or use Control-C or
 Control-D.
 `
@@ -18,9 +18,11 @@ import (
 	"github.com/opentofu/opentofu/internal/addrs"
 	"github.com/opentofu/opentofu/internal/configs/configschema"
 	"github.com/opentofu/opentofu/internal/initwd"
+	"github.com/opentofu/opentofu/internal/lang"
 	"github.com/opentofu/opentofu/internal/plugins"
 	"github.com/opentofu/opentofu/internal/providers"
 	"github.com/opentofu/opentofu/internal/states"
+	"github.com/opentofu/opentofu/internal/tfdiags"
 	"github.com/opentofu/opentofu/internal/tofu"
 
 	_ "github.com/opentofu/opentofu/internal/logging"
@@ -125,7 +127,7 @@ func TestSession_basicState(t *testing.T) {
 				{
 					Input:         "module.module.foo",
 					Error:         true,
-					ErrorContains: `Unsupported attribute: This object does not have an attribute named "foo"`,
+					ErrorContains: `Reference to undeclared output value: The module called by module.module does not declare an output value named "foo".`,
 				},
 			},
 		})
@@ -250,6 +252,88 @@ func TestSession_stateless(t *testing.T) {
 		})
 	})
 
+	t.Run("json prefix", func(t *testing.T) {
+		testSession(t, testSessionTest{
+			Inputs: []testSessionInput{
+				{
+					Input:  `:json {a = [1, "b"]}`,
+					Output: `{"a":[1,"b"]}`,
+				},
+			},
+		})
+	})
+
+	t.Run("hcl prefix", func(t *testing.T) {
+		testSession(t, testSessionTest{
+			Inputs: []testSessionInput{
+				{
+					Input:  `:hcl tolist(["a", "b"])`,
+					Output: `["a", "b"]`,
+				},
+			},
+		})
+	})
+
+	t.Run("raw prefix", func(t *testing.T) {
+		testSession(t, testSessionTest{
+			Inputs: []testSessionInput{
+				{
+					Input:  `:raw "hello"`,
+					Output: "hello",
+				},
+				{
+					Input:         `:raw ["hello"]`,
+					Error:         true,
+					ErrorContains: "cannot be shown in the raw format",
+				},
+			},
+		})
+	})
+
+	t.Run("session format", func(t *testing.T) {
+		testSession(t, testSessionTest{
+			Format: OutputFormatJSON,
+			Inputs: []testSessionInput{
+				{
+					Input:  `{a = "b"}`,
+					Output: `{"a":"b"}`,
+				},
+				{
+					Input:  `:raw "b"`,
+					Output: "b",
+				},
+				{
+					Input:  `type("foo")`,
+					Output: "string",
+				},
+			},
+		})
+	})
+
+	t.Run("unknown prefix", func(t *testing.T) {
+		testSession(t, testSessionTest{
+			Inputs: []testSessionInput{
+				{
+					Input:         `:yaml "hello"`,
+					Error:         true,
+					ErrorContains: `There is no console command named ":yaml"`,
+				},
+			},
+		})
+	})
+
+	t.Run("prefix without expression", func(t *testing.T) {
+		testSession(t, testSessionTest{
+			Inputs: []testSessionInput{
+				{
+					Input:         `:json`,
+					Error:         true,
+					ErrorContains: "must be followed by an expression",
+				},
+			},
+		})
+	})
+
 	t.Run("type equality checks are not permitted", func(t *testing.T) {
 		testSession(t, testSessionTest{
 			Inputs: []testSessionInput{
@@ -309,7 +393,8 @@ func testSession(t *testing.T, test testSessionTest) {
 
 	// Build the session
 	s := &Session{
-		Scope: scope,
+		Scope:  scope,
+		Format: test.Format,
 	}
 
 	// Test the inputs. We purposely don't use subtests here because
@@ -353,6 +438,7 @@ func testSession(t *testing.T, test testSessionTest) {
 type testSessionTest struct {
 	State  *states.State // State to use
 	Module string        // Module name in testdata to load
+	Format OutputFormat  // Output format of the session
 
 	// Inputs are the list of test inputs that are run in order.
 	// Each input can test the output of each step.
@@ -369,6 +455,65 @@ type testSessionInput struct {
 	ErrorContains  string
 }
 
+func TestSession_reload(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		reloaded := &lang.Scope{ConsoleMode: true}
+		s := &Session{
+			Scope: &lang.Scope{},
+			Reload: func() (*lang.Scope, tfdiags.Diagnostics) {
+				return reloaded, nil
+			},
+		}
+		out, exit, diags := s.Handle("reload")
+		if diags.HasErrors() {
+			t.Fatalf("unexpected errors: %s", diags.Err())
+		}
+		if exit {
+			t.Fatal("reload exited the session")
+		}
+		if got, want := out, "Reloaded the configuration and variables."; got != want {
+			t.Errorf("wrong output %q; want %q", got, want)
+		}
+		if s.Scope != reloaded {
+			t.Error("session doesn't use the reloaded scope")
+		}
+	})
+
+	t.Run("failure keeps the previous scope", func(t *testing.T) {
+		previous := &lang.Scope{}
+		s := &Session{
+			Scope: previous,
+			Reload: func() (*lang.Scope, tfdiags.Diagnostics) {
+				var diags tfdiags.Diagnostics
+				diags = diags.Append(tfdiags.Sourceless(tfdiags.Error, "Invalid configuration", "Oops."))
+				return &lang.Scope{}, diags
+			},
+		}
+		_, _, diags := s.Handle("reload")
+		if !diags.HasErrors() {
+			t.Fatal("expected errors")
+		}
+		if got, want := diags.Err().Error(), "continues to use the configuration"; !strings.Contains(got, want) {
+			t.Errorf("wrong error\ngot:  %s\nwant: %s", got, want)
+		}
+		if s.Scope != previous {
+			t.Error("session doesn't use the previous scope")
+		}
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		testSession(t, testSessionTest{
+			Inputs: []testSessionInput{
+				{
+					Input:         "reload",
+					Error:         true,
+					ErrorContains: "cannot reload the configuration",
+				},
+			},
+		})
+	})
+}
+
 func TestTypeString(t *testing.T) {
 	tests := []struct {
 		Input cty.Value
@@ -201,6 +201,15 @@ func (f *fakeProviderClient) GetFunctions(context.Context) providers.GetFunction
 	}
 }
 
+// ListResource implements [providers.Interface].
+func (f *fakeProviderClient) ListResource(context.Context, providers.ListResourceRequest) providers.ListResourceResponse {
+	var diags tfdiags.Diagnostics
+	diags = diags.Append(fmt.Errorf("fakeProviderClient does not support ListResource"))
+	return providers.ListResourceResponse{
+		Diagnostics: diags,
+	}
+}
+
 // CallFunction implements [providers.Interface].
 func (f *fakeProviderClient) CallFunction(context.Context, providers.CallFunctionRequest) providers.CallFunctionResponse {
 	return providers.CallFunctionResponse{
@@ -36,6 +36,13 @@ type ResourceInstanceObject struct {
 	// Identity is the resource identity for this instance
 	Identity cty.Value
 
+	// ChangeHint is an opaque change indicator, such as an ETag or a
+	// last-modified timestamp, that the provider returned when this object
+	// was last read. A provider that supports change hints can compare it
+	// with the current indicator of the remote object during refresh to
+	// report that the object is unchanged, without reading it in full.
+	ChangeHint string
+
 	// Status represents the "readiness" of the object as of the last time
 	// it was updated.
 	Status ObjectStatus
@@ -170,6 +177,7 @@ func (o *ResourceInstanceObject) Encode(ty cty.Type, schemaVersion uint64, ident
 		TransientPathValueMarks: allPVMs,
 		Private:                 o.Private,
 		IdentityJSON:            identityJSON,
+		ChangeHint:              o.ChangeHint,
 		Status:                  o.Status,
 		Dependencies:            dependencies,
 		CreateBeforeDestroy:     o.CreateBeforeDestroy,
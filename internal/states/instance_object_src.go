@@ -68,6 +68,7 @@ type ResourceInstanceObjectSrc struct {
 	// These fields all correspond to the fields of the same name on
 	// ResourceInstanceObject.
 	Private             []byte
+	ChangeHint          string
 	Status              ObjectStatus
 	Dependencies        []addrs.ConfigResource
 	CreateBeforeDestroy bool
@@ -157,6 +158,10 @@ func (os *ResourceInstanceObjectSrc) Equal(other *ResourceInstanceObjectSrc) boo
 		return false
 	}
 
+	if os.ChangeHint != other.ChangeHint {
+		return false
+	}
+
 	if os.Status != other.Status {
 		return false
 	}
@@ -249,6 +254,7 @@ func (os *ResourceInstanceObjectSrc) Decode(ty cty.Type) (*ResourceInstanceObjec
 		Dependencies:        os.Dependencies,
 		Private:             os.Private,
 		Identity:            identity,
+		ChangeHint:          os.ChangeHint,
 		CreateBeforeDestroy: os.CreateBeforeDestroy,
 		SkipDestroy:         os.SkipDestroy,
 		Deferred:            os.Deferred,
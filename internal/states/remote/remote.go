@@ -43,6 +43,23 @@ type OptionalClientLocker interface {
 	IsLockingEnabled() bool
 }
 
+// ClientVersioner is an optional interface that allows a remote state
+// backend to retrieve the previous versions of the state kept by its
+// storage, such as the object versions of a bucket with versioning
+// enabled.
+type ClientVersioner interface {
+	Client
+
+	// Versions returns the identifiers of the stored versions of the state,
+	// starting with the latest one. It returns statemgr.ErrHistoryUnsupported
+	// if the storage is not configured to keep previous versions.
+	Versions(context.Context) ([]string, error)
+
+	// GetVersion returns the version of the state with the given identifier,
+	// as returned by Versions, or nil if there is no such version.
+	GetVersion(ctx context.Context, id string) (*Payload, error)
+}
+
 // Payload is the return value from the remote state storage.
 type Payload struct {
 	MD5  []byte
@@ -57,6 +57,7 @@ type State struct {
 var _ statemgr.Full = (*State)(nil)
 var _ statemgr.Migrator = (*State)(nil)
 var _ statemgr.PersistentMeta = (*State)(nil)
+var _ statemgr.Historical = (*State)(nil)
 var _ local.IntermediateStateConditionalPersister = (*State)(nil)
 
 func NewState(client Client, enc encryption.StateEncryption) *State {
@@ -193,6 +194,52 @@ func (s *State) refreshState(ctx context.Context) error {
 	return nil
 }
 
+// statemgr.Historical impl.
+func (s *State) SnapshotBySerial(ctx context.Context, serial uint64) (*statefile.File, error) {
+	c, ok := s.Client.(ClientVersioner)
+	if !ok {
+		return nil, statemgr.ErrHistoryUnsupported
+	}
+
+	ids, err := c.Versions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The serial is only recorded in the state itself, so we need to read
+	// the versions one by one, starting with the latest. Only snapshots
+	// with the same lineage as the latest one belong to the current state,
+	// and their serials only ever increase, so we can stop as soon as we
+	// see an earlier serial than the one requested.
+	var lineage string
+	for _, id := range ids {
+		payload, err := c.GetVersion(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read state version %s: %w", id, err)
+		}
+		if payload == nil {
+			continue
+		}
+		stateFile, err := statefile.Read(bytes.NewReader(payload.Data), s.encryption)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read state version %s: %w", id, err)
+		}
+		if lineage == "" {
+			lineage = stateFile.Lineage
+		}
+		if stateFile.Lineage != lineage {
+			continue
+		}
+		if stateFile.Serial == serial {
+			return stateFile, nil
+		}
+		if stateFile.Serial < serial {
+			break
+		}
+	}
+	return nil, statemgr.ErrSnapshotNotFound{Serial: serial}
+}
+
 // statemgr.Persister impl.
 func (s *State) PersistState(ctx context.Context, schemas *tofu.Schemas) error {
 	s.mu.Lock()
@@ -6,8 +6,10 @@
 package remote
 
 import (
+	"bytes"
 	"context"
 	"log"
+	"strconv"
 	"sync"
 	"testing"
 
@@ -863,3 +865,142 @@ func TestState_IsLockingEnabled(t *testing.T) {
 		})
 	}
 }
+
+// mockClientVersioner is a mockClient that also keeps the previous
+// versions of the state.
+type mockClientVersioner struct {
+	*mockClient
+	versions [][]byte
+	reads    int
+}
+
+var _ ClientVersioner = &mockClientVersioner{}
+
+func (c *mockClientVersioner) Put(ctx context.Context, data []byte) error {
+	c.versions = append(c.versions, data)
+	return c.mockClient.Put(ctx, data)
+}
+
+func (c *mockClientVersioner) Versions(_ context.Context) ([]string, error) {
+	var ids []string
+	for i := len(c.versions) - 1; i >= 0; i-- {
+		ids = append(ids, strconv.Itoa(i))
+	}
+	return ids, nil
+}
+
+func (c *mockClientVersioner) GetVersion(_ context.Context, id string) (*Payload, error) {
+	c.reads++
+	i, err := strconv.Atoi(id)
+	if err != nil || i < 0 || i >= len(c.versions) {
+		return nil, nil
+	}
+	return &Payload{Data: c.versions[i]}, nil
+}
+
+func TestClientVersioner(t *testing.T) {
+	TestClientVersions(t, &mockClientVersioner{mockClient: &mockClient{}})
+}
+
+func TestState_SnapshotBySerial(t *testing.T) {
+	client := &mockClientVersioner{mockClient: &mockClient{}}
+	mgr := NewState(client, encryption.StateEncryptionDisabled())
+	if err := mgr.RefreshState(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+
+	// Persist three snapshots, each with a different output value.
+	for _, v := range []string{"a", "b", "c"} {
+		s := states.NewState()
+		s.RootModule().SetOutputValue("foo", cty.StringVal(v), false, "")
+		if err := mgr.WriteState(s); err != nil {
+			t.Fatal(err)
+		}
+		if err := mgr.PersistState(t.Context(), nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	latest := mgr.StateSnapshotMeta()
+
+	for serial, want := range map[uint64]string{
+		latest.Serial - 2: "a",
+		latest.Serial - 1: "b",
+		latest.Serial:     "c",
+	} {
+		f, err := statemgr.SnapshotBySerial(t.Context(), mgr, serial)
+		if err != nil {
+			t.Fatalf("serial %d: %s", serial, err)
+		}
+		if f.Serial != serial || f.Lineage != latest.Lineage {
+			t.Errorf("serial %d: wrong snapshot metadata %s %d", serial, f.Lineage, f.Serial)
+		}
+		if got := f.State.RootModule().OutputValues["foo"].Value; !got.RawEquals(cty.StringVal(want)) {
+			t.Errorf("serial %d: wrong output value %#v; want %q", serial, got, want)
+		}
+	}
+
+	// The latest transient snapshot is unaffected.
+	if got := mgr.State().RootModule().OutputValues["foo"].Value; !got.RawEquals(cty.StringVal("c")) {
+		t.Errorf("wrong latest output value %#v", got)
+	}
+
+	_, err := statemgr.SnapshotBySerial(t.Context(), mgr, latest.Serial+1)
+	if _, ok := err.(statemgr.ErrSnapshotNotFound); !ok {
+		t.Errorf("wrong error for a missing serial: %v", err)
+	}
+
+	_, err = statemgr.SnapshotBySerial(t.Context(), NewState(&mockClient{}, encryption.StateEncryptionDisabled()), 1)
+	if err != statemgr.ErrHistoryUnsupported {
+		t.Errorf("wrong error for a client without versions: %v", err)
+	}
+}
+
+func TestState_SnapshotBySerialLineage(t *testing.T) {
+	client := &mockClientVersioner{mockClient: &mockClient{}}
+	put := func(lineage string, serial uint64) {
+		var buf bytes.Buffer
+		sf := statefile.New(states.NewState(), lineage, serial)
+		if err := statefile.Write(sf, &buf, encryption.StateEncryptionDisabled()); err != nil {
+			t.Fatal(err)
+		}
+		if err := client.Put(t.Context(), buf.Bytes()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// The state was initialized again after serial 3 of the old lineage,
+	// and serial 2 of the new lineage is no longer stored.
+	put("old", 1)
+	put("old", 2)
+	put("old", 3)
+	put("new", 1)
+	put("new", 3)
+	mgr := NewState(client, encryption.StateEncryptionDisabled())
+
+	f, err := mgr.SnapshotBySerial(t.Context(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.Lineage != "new" || f.Serial != 1 {
+		t.Errorf("wrong snapshot %s %d; want new 1", f.Lineage, f.Serial)
+	}
+
+	// Serial 3 only exists with the new lineage, and serial 2 only with
+	// the old one, which is not the lineage of the current state.
+	f, err = mgr.SnapshotBySerial(t.Context(), 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.Lineage != "new" {
+		t.Errorf("wrong lineage %s; want new", f.Lineage)
+	}
+	client.reads = 0
+	_, err = mgr.SnapshotBySerial(t.Context(), 2)
+	if _, ok := err.(statemgr.ErrSnapshotNotFound); !ok {
+		t.Errorf("wrong error for a serial of another lineage: %v", err)
+	}
+	// The versions of the current lineage are in order, so there is no
+	// need to read those after the one with serial 1.
+	if client.reads != 2 {
+		t.Errorf("read %d versions; want 2", client.reads)
+	}
+}
@@ -108,3 +108,42 @@ func TestRemoteLocks(t *testing.T, a, b Client) {
 
 	// TODO: Should we enforce that Unlock requires the correct ID?
 }
+
+// TestClientVersions tests the retrieval of the previous versions of the
+// state from a remote.ClientVersioner that keeps them.
+func TestClientVersions(t *testing.T, c ClientVersioner) {
+	var want [][]byte
+	for serial := uint64(1); serial <= 3; serial++ {
+		var buf bytes.Buffer
+		sf := statefile.New(statemgr.TestFullInitialState(), "stub-lineage", serial)
+		if err := statefile.Write(sf, &buf, encryption.StateEncryptionDisabled()); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if err := c.Put(t.Context(), buf.Bytes()); err != nil {
+			t.Fatalf("put: %s", err)
+		}
+		want = append([][]byte{buf.Bytes()}, want...)
+	}
+
+	ids, err := c.Versions(t.Context())
+	if err != nil {
+		t.Fatalf("versions: %s", err)
+	}
+	if len(ids) < len(want) {
+		t.Fatalf("expected at least %d versions, got %d", len(want), len(ids))
+	}
+	for i, data := range want {
+		p, err := c.GetVersion(t.Context(), ids[i])
+		if err != nil {
+			t.Fatalf("get version %s: %s", ids[i], err)
+		}
+		if p == nil || !bytes.Equal(p.Data, data) {
+			t.Fatalf("wrong state for version %s\n\nexpected: %q", ids[i], string(data))
+		}
+	}
+
+	p, err := c.GetVersion(t.Context(), "does-not-exist")
+	if err == nil && p != nil {
+		t.Fatalf("expected no state for an unknown version, got: %q", string(p.Data))
+	}
+}
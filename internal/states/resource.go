@@ -7,6 +7,7 @@ package states
 
 import (
 	"fmt"
+	"maps"
 
 	"github.com/opentofu/opentofu/internal/addrs"
 )
@@ -100,6 +101,13 @@ type ResourceInstance struct {
 	// the resource instance's provider configuration. This is only set
 	// when using provider iteration on resources or modules
 	ProviderKey addrs.InstanceKey
+
+	// Annotations are free-form key/value pairs that users have attached
+	// to the resource instance using "tofu state annotate", such as the
+	// owner of the remote object or a related ticket. OpenTofu Core does
+	// not interpret them, but retains them for as long as the instance
+	// exists, including when it is moved to a new address.
+	Annotations map[string]string
 }
 
 // NewResourceInstance constructs and returns a new ResourceInstance, ready to
@@ -161,6 +169,10 @@ func (i *ResourceInstance) Equal(other *ResourceInstance) bool {
 		}
 	}
 
+	if !maps.Equal(i.Annotations, other.Annotations) {
+		return false
+	}
+
 	return true
 }
 
@@ -116,10 +116,16 @@ func (i *ResourceInstance) DeepCopy() *ResourceInstance {
 		deposed[k] = obj.DeepCopy()
 	}
 
+	var annotations map[string]string
+	if i.Annotations != nil {
+		annotations = maps.Clone(i.Annotations)
+	}
+
 	return &ResourceInstance{
 		Current:     i.Current.DeepCopy(),
 		Deposed:     deposed,
 		ProviderKey: i.ProviderKey,
+		Annotations: annotations,
 	}
 }
 
@@ -190,6 +196,7 @@ func (os *ResourceInstanceObjectSrc) DeepCopy() *ResourceInstanceObjectSrc {
 		Status:                  os.Status,
 		SchemaVersion:           os.SchemaVersion,
 		Private:                 private,
+		ChangeHint:              os.ChangeHint,
 		AttrsFlat:               attrsFlat,
 		AttrsJSON:               attrsJSON,
 		AttrSensitivePaths:      attrPaths,
@@ -235,6 +242,7 @@ func (o *ResourceInstanceObject) DeepCopy() *ResourceInstanceObject {
 		Status:              o.Status,
 		Private:             private,
 		Identity:            o.Identity,
+		ChangeHint:          o.ChangeHint,
 		Dependencies:        dependencies,
 		CreateBeforeDestroy: o.CreateBeforeDestroy,
 		SkipDestroy:         o.SkipDestroy,
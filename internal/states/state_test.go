@@ -745,6 +745,7 @@ func TestState_MoveAbsResourceInstance(t *testing.T) {
 	)
 	// src resource from the state above
 	src := addrs.Resource{Mode: addrs.ManagedResourceMode, Type: "test_thing", Name: "foo"}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)
+	state.SyncWrapper().SetResourceInstanceAnnotations(src, map[string]string{"owner": "team-a"})
 
 	t.Run("resource to resource instance", func(t *testing.T) {
 		s := state.DeepCopy()
@@ -765,6 +766,9 @@ func TestState_MoveAbsResourceInstance(t *testing.T) {
 		if got == nil {
 			t.Fatalf("dst resource not in state")
 		}
+		if got, want := got.Annotations["owner"], "team-a"; got != want {
+			t.Errorf("wrong owner annotation after move %q; want %q", got, want)
+		}
 	})
 
 	t.Run("move to new module", func(t *testing.T) {
@@ -6,6 +6,7 @@ import (
 	"bytes"
 	"errors"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/opentofu/opentofu/internal/encryption"
@@ -53,3 +54,106 @@ func TestReadEmptyJsonWithEncryption(t *testing.T) {
 		t.Fatalf("expected encryption error, got %v", err)
 	}
 }
+
+func TestReadNewerFormatVersion(t *testing.T) {
+	tests := map[string][]string{
+		"testdata/read/v5-compatible.tfstate": {
+			"uses format version 5",
+			"created by OpenTofu 999.0.0",
+			`tofu state downgrade`,
+		},
+		"testdata/read/v5-incompatible.tfstate": {
+			"uses format version 5",
+			"  - future_top_level\n",
+			`  - outputs["name"].future_output_setting`,
+			"  - resources[0].instances[0].future_instance_setting",
+		},
+	}
+
+	for filename, want := range tests {
+		t.Run(filename, func(t *testing.T) {
+			f, err := os.Open(filename)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer f.Close()
+
+			_, err = Read(f, encryption.StateEncryptionDisabled())
+			var unusable *ErrUnusableState
+			if !errors.As(err, &unusable) {
+				t.Fatalf("expected ErrUnusableState, got %#v", err)
+			}
+			for _, want := range want {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("error message does not contain %q\n%s", want, err)
+				}
+			}
+		})
+	}
+}
+
+func TestDowngrade(t *testing.T) {
+	t.Run("compatible", func(t *testing.T) {
+		f, err := os.Open("testdata/read/v5-compatible.tfstate")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+
+		file, err := Downgrade(f, encryption.StateEncryptionDisabled())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := file.Lineage, "f2968801-fa14-41ab-a044-224f3a4adf04"; got != want {
+			t.Errorf("wrong lineage %q; want %q", got, want)
+		}
+		if got, want := file.Serial, uint64(3); got != want {
+			t.Errorf("wrong serial %d; want %d", got, want)
+		}
+		if got := file.State.RootModule().Resources["null_resource.foo"]; got == nil {
+			t.Errorf("resource null_resource.foo is missing from the result")
+		}
+
+		// The result must be readable using the current format.
+		var buf bytes.Buffer
+		if err := Write(file, &buf, encryption.StateEncryptionDisabled()); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := Read(&buf, encryption.StateEncryptionDisabled()); err != nil {
+			t.Fatalf("failed to read downgraded state: %s", err)
+		}
+	})
+	t.Run("incompatible", func(t *testing.T) {
+		f, err := os.Open("testdata/read/v5-incompatible.tfstate")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+
+		_, err = Downgrade(f, encryption.StateEncryptionDisabled())
+		if err == nil {
+			t.Fatal("succeeded; want error")
+		}
+		for _, want := range []string{"cannot be downgraded without losing information", "resources[0].instances[0].future_instance_setting"} {
+			if !strings.Contains(err.Error(), want) {
+				t.Errorf("error message does not contain %q\n%s", want, err)
+			}
+		}
+	})
+	t.Run("current format", func(t *testing.T) {
+		f, err := os.Open("testdata/roundtrip/v4-simple.in.tfstate")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+
+		if _, err := Downgrade(f, encryption.StateEncryptionDisabled()); err != nil {
+			t.Fatal(err)
+		}
+	})
+	t.Run("empty", func(t *testing.T) {
+		if _, err := Downgrade(bytes.NewReader(nil), encryption.StateEncryptionDisabled()); !errors.Is(err, ErrNoState) {
+			t.Fatalf("expected ErrNoState, got %#v", err)
+		}
+	})
+}
@@ -241,6 +241,8 @@ func prepareStateV4(sV4 *stateV4) (*File, tfdiags.Diagnostics) {
 				obj.Private = raw
 			}
 
+			obj.ChangeHint = isV4.ChangeHint
+
 			// Read identity from state file
 			if isV4.Identity != nil {
 				identityJSON, err := json.Marshal(isV4.Identity)
@@ -373,6 +375,8 @@ func prepareStateV4(sV4 *stateV4) (*File, tfdiags.Diagnostics) {
 		diags = diags.Append(moreDiags)
 	}
 
+	diags = diags.Append(decodeAnnotationsV4(sV4.Annotations, state))
+
 	file.State = state
 	return file, diags
 }
@@ -503,6 +507,7 @@ func writeStateV4(file *File, w io.Writer, enc encryption.StateEncryption) tfdia
 	}
 
 	sV4.CheckResults = encodeCheckResultsV4(file.State.CheckResults)
+	sV4.Annotations = encodeAnnotationsV4(file.State)
 
 	sV4.normalize()
 
@@ -607,6 +612,7 @@ func appendInstanceObjectStateV4(rs *states.Resource, is *states.ResourceInstanc
 		AttributesRaw:           obj.AttrsJSON,
 		AttributeSensitivePaths: attributeSensitivePaths,
 		PrivateRaw:              privateRaw,
+		ChangeHint:              obj.ChangeHint,
 		Dependencies:            deps,
 		CreateBeforeDestroy:     obj.CreateBeforeDestroy,
 		SkipDestroy:             obj.SkipDestroy,
@@ -615,6 +621,59 @@ func appendInstanceObjectStateV4(rs *states.Resource, is *states.ResourceInstanc
 	}), diags
 }
 
+// decodeAnnotationsV4 attaches the given resource instance annotations to the
+// corresponding instances in the given state, which must already contain all
+// of the resource instances decoded from the same state snapshot.
+func decodeAnnotationsV4(in []annotationsV4, state *states.State) tfdiags.Diagnostics {
+	var diags tfdiags.Diagnostics
+
+	for _, aV4 := range in {
+		addr, addrDiags := addrs.ParseAbsResourceInstanceStr(aV4.Instance)
+		if addrDiags.HasErrors() {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Invalid resource instance annotations in state",
+				fmt.Sprintf("Annotations are recorded for %q, which is not a valid resource instance address.", aV4.Instance),
+			))
+			continue
+		}
+		inst := state.ResourceInstance(addr)
+		if inst == nil {
+			// The annotations belong to an instance that no longer exists,
+			// so there's nothing to attach them to.
+			continue
+		}
+		if len(aV4.Values) != 0 {
+			inst.Annotations = aV4.Values
+		}
+	}
+
+	return diags
+}
+
+// encodeAnnotationsV4 collects the annotations of all of the resource
+// instances in the given state, in a predictable order.
+func encodeAnnotationsV4(state *states.State) []annotationsV4 {
+	var ret []annotationsV4
+	for _, ms := range state.Modules {
+		for _, rs := range ms.Resources {
+			for key, is := range rs.Instances {
+				if len(is.Annotations) == 0 {
+					continue
+				}
+				ret = append(ret, annotationsV4{
+					Instance: rs.Addr.Instance(key).String(),
+					Values:   is.Annotations,
+				})
+			}
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Instance < ret[j].Instance
+	})
+	return ret
+}
+
 func decodeCheckResultsV4(in []checkResultsV4) (*states.CheckResults, tfdiags.Diagnostics) {
 	var diags tfdiags.Diagnostics
 
@@ -782,6 +841,7 @@ type stateV4 struct {
 	RootOutputs      map[string]outputStateV4 `json:"outputs"`
 	Resources        []resourceStateV4        `json:"resources"`
 	CheckResults     []checkResultsV4         `json:"check_results"`
+	Annotations      []annotationsV4          `json:"annotations,omitempty"`
 }
 
 // normalize makes some in-place changes to normalize the way items are
@@ -825,6 +885,7 @@ type instanceObjectStateV4 struct {
 	AttributeSensitivePaths json.RawMessage   `json:"sensitive_attributes,omitempty"`
 
 	PrivateRaw []byte `json:"private,omitempty"`
+	ChangeHint string `json:"change_hint,omitempty"`
 
 	Dependencies []string `json:"dependencies,omitempty"`
 
@@ -835,6 +896,11 @@ type instanceObjectStateV4 struct {
 	IdentitySchemaVersion *uint64         `json:"identity_schema_version,omitempty"`
 }
 
+type annotationsV4 struct {
+	Instance string            `json:"instance"`
+	Values   map[string]string `json:"values"`
+}
+
 type checkResultsV4 struct {
 	ObjectKind string                 `json:"object_kind"`
 	ConfigAddr string                 `json:"config_addr"`
@@ -0,0 +1,216 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package statefile
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/opentofu/opentofu/internal/encryption"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+	tfversion "github.com/opentofu/opentofu/version"
+)
+
+// maxUnrecognizedFieldsReported limits how many unrecognized fields are
+// listed in diagnostics, to keep the messages readable for large states.
+const maxUnrecognizedFieldsReported = 10
+
+// Downgrade reads a state file that uses a newer format version than this
+// version of OpenTofu supports, and converts it to the current format.
+//
+// Newer format versions are understood only to the extent that they are a
+// superset of the current format. The conversion is refused with an error
+// describing the unrecognized parts of the state unless it can be done
+// without losing any information.
+//
+// A state file that already uses a supported format version is returned
+// as-is, as if it were read with Read.
+func Downgrade(r io.Reader, enc encryption.StateEncryption) (*File, error) {
+	src, err := io.ReadAll(r)
+	if err != nil {
+		var diags tfdiags.Diagnostics
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to read state file",
+			fmt.Sprintf("The state file could not be read: %s", err),
+		))
+		return nil, diags.Err()
+	}
+	if len(src) == 0 {
+		return nil, ErrNoState
+	}
+
+	decrypted, status, err := enc.DecryptState(src)
+	if err != nil {
+		return nil, err
+	}
+
+	version, diags := sniffJSONStateVersion(decrypted)
+	if diags.HasErrors() {
+		return nil, diags.Err()
+	}
+	if version <= 4 {
+		state, err := readState(decrypted)
+		if err != nil {
+			return nil, err
+		}
+		state.EncryptionStatus = status
+		return state, nil
+	}
+
+	state, unrecognized, diags := readStateFuture(decrypted)
+	if diags.HasErrors() {
+		return nil, errUnusable(diags.Err())
+	}
+	if len(unrecognized) > 0 {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"State cannot be downgraded without losing information",
+			fmt.Sprintf(
+				"The state file uses format version %d, and contains data that OpenTofu %s does not understand:\n%s\n\nTo continue, upgrade to a newer version of OpenTofu.",
+				version, tfversion.SemVer.String(), formatUnrecognizedFields(unrecognized),
+			),
+		))
+		return nil, errUnusable(diags.Err())
+	}
+	state.EncryptionStatus = status
+	return state, nil
+}
+
+// readStateFuture attempts to read a state file that uses a newer format
+// version than this version of OpenTofu supports, by interpreting it as the
+// latest format version we do support.
+//
+// The second return value lists the paths of any parts of the source that
+// aren't part of our latest format and so would be lost if the result were
+// written back out. If the source isn't compatible with our latest format at
+// all then the result has error diagnostics.
+func readStateFuture(src []byte) (*File, []string, tfdiags.Diagnostics) {
+	file, diags := readStateV4(src)
+	if diags.HasErrors() {
+		return nil, nil, diags
+	}
+	unrecognized, err := unrecognizedFieldsV4(src)
+	if err != nil {
+		diags = diags.Append(jsonUnmarshalDiags(err))
+		return nil, nil, diags
+	}
+	return file, unrecognized, diags
+}
+
+// newerFormatDetail returns additional detail for the error message about a
+// state file that uses a newer format version than we support, explaining
+// whether it could be downgraded.
+func newerFormatDetail(src []byte) string {
+	_, unrecognized, diags := readStateFuture(src)
+	switch {
+	case diags.HasErrors():
+		return ""
+	case len(unrecognized) > 0:
+		return fmt.Sprintf("\n\nOpenTofu does not understand the following data in this state file:\n%s", formatUnrecognizedFields(unrecognized))
+	default:
+		return "\n\nAll of the data in this state file is understood by this version of OpenTofu, so it can be converted to a supported format without losing information by running \"tofu state downgrade\"."
+	}
+}
+
+func formatUnrecognizedFields(fields []string) string {
+	var buf strings.Builder
+	for i, field := range fields {
+		if i == maxUnrecognizedFieldsReported {
+			fmt.Fprintf(&buf, "  - ... and %d more\n", len(fields)-i)
+			break
+		}
+		fmt.Fprintf(&buf, "  - %s\n", field)
+	}
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// unrecognizedFieldsV4 returns the paths of all of the object properties in
+// the given JSON source that don't correspond to a field of stateV4 or its
+// nested types, sorted lexically.
+func unrecognizedFieldsV4(src []byte) ([]string, error) {
+	dec := json.NewDecoder(bytes.NewReader(src))
+	dec.UseNumber()
+	var raw any
+	if err := dec.Decode(&raw); err != nil {
+		return nil, err
+	}
+
+	var ret []string
+	collectUnrecognizedFields(raw, reflect.TypeOf(stateV4{}), "", &ret)
+	sort.Strings(ret)
+	return ret, nil
+}
+
+var rawMessageType = reflect.TypeOf(json.RawMessage(nil))
+
+func collectUnrecognizedFields(raw any, ty reflect.Type, path string, ret *[]string) {
+	for ty.Kind() == reflect.Pointer {
+		ty = ty.Elem()
+	}
+	if ty == rawMessageType || ty.Kind() == reflect.Interface {
+		// Arbitrary JSON is allowed here, such as resource attributes whose
+		// structure is decided by the provider.
+		return
+	}
+
+	switch raw := raw.(type) {
+	case map[string]any:
+		switch ty.Kind() {
+		case reflect.Struct:
+			fields := jsonFieldsByName(ty)
+			for name, v := range raw {
+				fieldPath := name
+				if path != "" {
+					fieldPath = path + "." + name
+				}
+				field, ok := fields[name]
+				if !ok {
+					*ret = append(*ret, fieldPath)
+					continue
+				}
+				collectUnrecognizedFields(v, field.Type, fieldPath, ret)
+			}
+		case reflect.Map:
+			for k, v := range raw {
+				collectUnrecognizedFields(v, ty.Elem(), fmt.Sprintf("%s[%q]", path, k), ret)
+			}
+		}
+	case []any:
+		if ty.Kind() != reflect.Slice && ty.Kind() != reflect.Array {
+			return
+		}
+		for i, v := range raw {
+			collectUnrecognizedFields(v, ty.Elem(), fmt.Sprintf("%s[%d]", path, i), ret)
+		}
+	}
+}
+
+// jsonFieldsByName returns the fields of the given struct type keyed by the
+// property name used for them in JSON.
+func jsonFieldsByName(ty reflect.Type) map[string]reflect.StructField {
+	ret := make(map[string]reflect.StructField, ty.NumField())
+	for i := range ty.NumField() {
+		field := ty.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		ret[name] = field
+	}
+	return ret
+}
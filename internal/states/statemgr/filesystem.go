@@ -14,6 +14,7 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"runtime"
 	"sync"
 	"time"
 
@@ -65,6 +66,11 @@ type Filesystem struct {
 	writtenBackup  bool
 
 	encryption encryption.StateEncryption
+
+	// fsync makes writes durable: failing to flush the state file to stable
+	// storage is an error rather than just being logged, and the backup
+	// file and the directories containing the files are flushed too.
+	fsync bool
 }
 
 var (
@@ -112,6 +118,16 @@ func (s *Filesystem) SetBackupPath(path string) {
 	s.writtenBackup = false
 }
 
+// SetFsync configures whether the receiver makes sure that each state
+// snapshot and backup file it writes has reached stable storage before
+// reporting success, including the directory entries for newly-created
+// files.
+//
+// This must be called before any other state methods are called.
+func (s *Filesystem) SetFsync(enabled bool) {
+	s.fsync = enabled
+}
+
 // BackupPath returns the manager's backup path if backup files are enabled,
 // or an empty string otherwise.
 func (s *Filesystem) BackupPath() string {
@@ -190,7 +206,7 @@ func (s *Filesystem) PersistState(_ context.Context, schemas *tofu.Schemas) erro
 	return s.persistState(schemas)
 }
 
-func (s *Filesystem) persistState(schemas *tofu.Schemas) error {
+func (s *Filesystem) persistState(schemas *tofu.Schemas) (retErr error) {
 	// TODO: this should use a more robust method of writing state, by first
 	// writing to a temp file on the same filesystem, and renaming the file over
 	// the original.
@@ -205,6 +221,9 @@ func (s *Filesystem) persistState(schemas *tofu.Schemas) error {
 	defer func() {
 		if err := s.stateFileOut.Sync(); err != nil {
 			log.Printf("[ERROR] Unable to sync statefile %s: %s", s.path, err.Error())
+			if s.fsync && retErr == nil {
+				retErr = fmt.Errorf("failed to sync local state file: %w", err)
+			}
 		}
 	}()
 
@@ -218,6 +237,9 @@ func (s *Filesystem) persistState(schemas *tofu.Schemas) error {
 	if !s.writtenBackup && s.backupFile != nil && s.backupPath != "" {
 		if !statefile.StatesMarshalEqual(state, s.backupFile.State) {
 			log.Printf("[TRACE] statemgr.Filesystem: creating backup snapshot at %s", s.backupPath)
+			if err := os.MkdirAll(filepath.Dir(s.backupPath), 0755); err != nil {
+				return fmt.Errorf("failed to create local state backup directory: %w", err)
+			}
 			bfh, err := os.Create(s.backupPath)
 			if err != nil {
 				return fmt.Errorf("failed to create local state backup file: %w", err)
@@ -228,6 +250,14 @@ func (s *Filesystem) persistState(schemas *tofu.Schemas) error {
 			if err != nil {
 				return fmt.Errorf("failed to write to local state backup file: %w", err)
 			}
+			if s.fsync {
+				if err := bfh.Sync(); err != nil {
+					return fmt.Errorf("failed to sync local state backup file: %w", err)
+				}
+				if err := syncDir(filepath.Dir(s.backupPath)); err != nil {
+					return fmt.Errorf("failed to sync local state backup directory: %w", err)
+				}
+			}
 
 			s.writtenBackup = true
 		} else {
@@ -550,10 +580,34 @@ func (s *Filesystem) createStateFiles() error {
 		return err
 	}
 
+	if s.fsync && s.created {
+		// The new file's directory entry must be durable too, or the file
+		// could disappear along with the state we write into it.
+		if err := syncDir(filepath.Dir(s.path)); err != nil {
+			f.Close()
+			return fmt.Errorf("failed to sync local state directory: %w", err)
+		}
+	}
+
 	s.stateFileOut = f
 	return nil
 }
 
+// syncDir flushes the directory entries of the given directory to stable
+// storage. Windows doesn't support syncing directories, and its filesystems
+// persist directory entries as part of the file writes anyway.
+func syncDir(dir string) error {
+	if runtime.GOOS == "windows" {
+		return nil
+	}
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
+
 func (s *Filesystem) prepareBackupFile() error {
 	// If the file already existed with content then that'll be the content
 	// of our backup file if we write a change later.
@@ -185,6 +185,31 @@ func TestFilesystem_backup(t *testing.T) {
 	}
 }
 
+func TestFilesystem_fsync(t *testing.T) {
+	defer testOverrideVersion(t, "1.2.3")()
+	ls := testFilesystem(t)
+	ls.SetFsync(true)
+
+	// The backup directory doesn't exist yet, so it must be created.
+	backupPath := filepath.Join(t.TempDir(), "backups", "terraform.tfstate.backup")
+	ls.SetBackupPath(backupPath)
+
+	TestFull(t, ls)
+
+	bfh, err := os.Open(backupPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bfh.Close()
+	bf, err := statefile.Read(bfh, encryption.StateEncryptionDisabled())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bf.State.Equal(TestFullInitialState()) {
+		t.Error("wrong backup state")
+	}
+}
+
 // This test verifies a particularly tricky behavior where the input file
 // is overridden and backups are enabled at the same time. This combination
 // requires special care because we must ensure that when we create a backup
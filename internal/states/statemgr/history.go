@@ -0,0 +1,65 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package statemgr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/opentofu/opentofu/internal/states/statefile"
+)
+
+// ErrHistoryUnsupported is returned by SnapshotBySerial when the given state
+// manager does not retain previous persistent snapshots.
+var ErrHistoryUnsupported = errors.New("the state storage does not retain previous state snapshots")
+
+// ErrSnapshotNotFound is returned by SnapshotBySerial when the storage has
+// no snapshot with the requested serial.
+type ErrSnapshotNotFound struct {
+	Serial uint64
+}
+
+func (e ErrSnapshotNotFound) Error() string {
+	return fmt.Sprintf("there is no stored state snapshot with serial %d", e.Serial)
+}
+
+// Historical is an optional interface implemented by persistent state
+// managers that retain the previous persistent snapshots, such as those
+// storing the state in a versioned object store.
+//
+// This interface is used when available by function SnapshotBySerial. See
+// that function for more information on how it is used.
+type Historical interface {
+	// SnapshotBySerial retrieves from persistent storage the most recent
+	// snapshot with the given serial, including its metadata.
+	//
+	// It returns ErrSnapshotNotFound if there is no such snapshot, and
+	// ErrHistoryUnsupported if the particular storage configured for the
+	// manager turns out not to retain previous snapshots.
+	//
+	// This does not affect the latest transient snapshot of the manager.
+	SnapshotBySerial(ctx context.Context, serial uint64) (*statefile.File, error)
+}
+
+// SnapshotBySerial retrieves the persistent snapshot with the given serial
+// from the given manager, which must implement the optional interface
+// Historical. Otherwise, it returns ErrHistoryUnsupported.
+//
+// The latest snapshot is available through this function as well as any
+// earlier ones, so callers can use it without first checking whether the
+// requested serial is the latest.
+//
+// This function doesn't do any locking of its own, so if the state manager
+// also implements Locker the caller should hold a lock on it if it requires
+// the result to be consistent with concurrent writes.
+func SnapshotBySerial(ctx context.Context, mgr Storage, serial uint64) (*statefile.File, error) {
+	mgrH, ok := mgr.(Historical)
+	if !ok {
+		return nil, ErrHistoryUnsupported
+	}
+	return mgrH.SnapshotBySerial(ctx, serial)
+}
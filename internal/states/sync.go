@@ -7,6 +7,7 @@ package states
 
 import (
 	"log"
+	"maps"
 	"sync"
 
 	"github.com/opentofu/opentofu/internal/addrs"
@@ -234,6 +235,27 @@ func (s *SyncState) SetResourceInstance(addr addrs.AbsResourceInstance, inst *Re
 	s.maybePruneModule(addr.Module)
 }
 
+// SetResourceInstanceAnnotations replaces all of the annotations of the
+// resource instance with the given address. Pass a nil or empty map to remove
+// all of the annotations.
+//
+// Annotations belong to the instance rather than to any of its objects, so
+// this method does nothing if the instance isn't already tracked in state.
+func (s *SyncState) SetResourceInstanceAnnotations(addr addrs.AbsResourceInstance, annotations map[string]string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	inst := s.state.ResourceInstance(addr)
+	if inst == nil {
+		return
+	}
+	if len(annotations) == 0 {
+		inst.Annotations = nil
+		return
+	}
+	inst.Annotations = maps.Clone(annotations)
+}
+
 // RemoveResource removes the entire state for the given resource, taking with
 // it any instances associated with the resource. This should generally be
 // called only for resource objects whose instances have all been destroyed,
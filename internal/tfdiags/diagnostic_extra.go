@@ -5,6 +5,10 @@
 
 package tfdiags
 
+import (
+	"time"
+)
+
 // This "Extra" idea is something we've inherited from HCL's diagnostic model,
 // and so it's primarily to expose that functionality from wrapped HCL
 // diagnostics but other diagnostic types could potentially implement this
@@ -203,3 +207,145 @@ func DoNotConsolidateDiagnostic(diag Diagnostic) bool {
 	}
 	return maybe.DoNotConsolidateDiagnostic()
 }
+
+// DiagnosticExtraUnknownExpansion is an interface implemented by values in
+// the Extra field of Diagnostic when the diagnostic reports that the instances
+// of an object cannot be determined because its "count" or "for_each"
+// argument depends on values that will be known only after apply.
+type DiagnosticExtraUnknownExpansion interface {
+	// DiagnosticUnknownExpansion returns the analysis of which unknown values
+	// the argument depends on, or nil if no analysis is available.
+	DiagnosticUnknownExpansion() *UnknownExpansion
+}
+
+// UnknownExpansion describes why the instances of an object cannot be
+// determined during planning, and how the user might work around that.
+type UnknownExpansion struct {
+	// Addr is the address of the object whose instances cannot be
+	// determined, such as "module.network".
+	Addr string
+
+	// Argument is the name of the argument whose value is unknown, which is
+	// either "count" or "for_each".
+	Argument string
+
+	// UnknownValues are the references that the argument depends on, either
+	// directly or through local values, whose values will be known only
+	// after apply, such as "aws_instance.example.id".
+	UnknownValues []string
+
+	// Targets are the addresses of the objects that produce the unknown
+	// values, which the user can apply first using the -target planning
+	// option so that the argument is known in the next plan.
+	Targets []string
+}
+
+// DiagnosticUnknownExpansion returns the unknown expansion analysis included
+// in the given diagnostic, or nil if it has none.
+//
+// This is a wrapper around checking if the diagnostic's extra info implements
+// interface DiagnosticExtraUnknownExpansion and then calling its method if so.
+func DiagnosticUnknownExpansion(diag Diagnostic) *UnknownExpansion {
+	maybe := ExtraInfo[DiagnosticExtraUnknownExpansion](diag)
+	if maybe == nil {
+		return nil
+	}
+	return maybe.DiagnosticUnknownExpansion()
+}
+
+// DiagnosticExtraSuggestedFix is an interface implemented by values in the
+// Extra field of Diagnostic when there is exactly one plausible correction to
+// the source code that would resolve the diagnostic.
+type DiagnosticExtraSuggestedFix interface {
+	// DiagnosticSuggestedFix returns the suggested fix, or nil if there is
+	// no suggestion.
+	DiagnosticSuggestedFix() *SuggestedFix
+}
+
+// SuggestedFix is a machine-applicable edit that replaces the source code
+// covered by the subject of the diagnostic it is attached to.
+//
+// The replacement is Prefix, followed by the original source code covered by
+// Keep if it is set, followed by Suffix. Keep allows a fix to reuse part of
+// the original source code, such as when wrapping an expression in brackets,
+// without the diagnostic needing access to the source code itself.
+type SuggestedFix struct {
+	Prefix string
+	Keep   *SourceRange
+	Suffix string
+}
+
+// DiagnosticSuggestedFix returns the suggested fix included in the given
+// diagnostic, or nil if it has none.
+//
+// This is a wrapper around checking if the diagnostic's extra info implements
+// interface DiagnosticExtraSuggestedFix and then calling its method if so.
+func DiagnosticSuggestedFix(diag Diagnostic) *SuggestedFix {
+	maybe := ExtraInfo[DiagnosticExtraSuggestedFix](diag)
+	if maybe == nil {
+		return nil
+	}
+	return maybe.DiagnosticSuggestedFix()
+}
+
+// DiagnosticExtraProviderError is an interface implemented by values in the
+// Extra field of Diagnostic when the diagnostic reports an error that a
+// provider returned together with machine-readable details about its cause.
+type DiagnosticExtraProviderError interface {
+	// DiagnosticProviderError returns the details that the provider attached
+	// to the error, or nil if there are none.
+	DiagnosticProviderError() *ProviderError
+}
+
+// ProviderError describes the machine-readable details that a provider
+// attached to an error, so that automation can react to particular kinds of
+// failure without parsing the error message.
+type ProviderError struct {
+	// Status is the name of the gRPC status code of the error, such as
+	// "PermissionDenied" or "ResourceExhausted".
+	Status string
+
+	// Reason is a short identifier for the cause of the error chosen by the
+	// provider, such as "QUOTA_EXCEEDED".
+	Reason string
+
+	// Domain identifies the service or system that reported the error, such
+	// as "iam.example.com".
+	Domain string
+
+	// Metadata holds additional details about the cause of the error, such
+	// as the permission that the failed operation requires.
+	Metadata map[string]string
+
+	// Links refer to documentation about how to resolve the error.
+	Links []ProviderErrorLink
+
+	// Retryable is true if the provider indicated that the failed request
+	// can be retried, such as after a temporary network failure or when a
+	// remote API is rate limiting requests.
+	Retryable bool
+
+	// RetryDelay is how long the provider asked to wait before retrying the
+	// request, or zero if it didn't ask for a particular delay.
+	RetryDelay time.Duration
+}
+
+// ProviderErrorLink is a link to documentation that a provider attached to an
+// error.
+type ProviderErrorLink struct {
+	Description string
+	URL         string
+}
+
+// DiagnosticProviderError returns the provider error details included in the
+// given diagnostic, or nil if it has none.
+//
+// This is a wrapper around checking if the diagnostic's extra info implements
+// interface DiagnosticExtraProviderError and then calling its method if so.
+func DiagnosticProviderError(diag Diagnostic) *ProviderError {
+	maybe := ExtraInfo[DiagnosticExtraProviderError](diag)
+	if maybe == nil {
+		return nil
+	}
+	return maybe.DiagnosticProviderError()
+}
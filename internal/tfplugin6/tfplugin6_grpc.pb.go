@@ -1,9 +1,9 @@
 // Copyright IBM Corp. 2014, 2026
 // SPDX-License-Identifier: MPL-2.0
 
-// Terraform Plugin RPC protocol version 6.10
+// Terraform Plugin RPC protocol version 6.11
 //
-// This file defines version 6.10 of the RPC protocol. To implement a plugin
+// This file defines version 6.11 of the RPC protocol. To implement a plugin
 // against this protocol, copy this definition into your own codebase and
 // use protoc to generate stubs for your target language.
 //
@@ -52,6 +52,7 @@ const (
 	Provider_ReadResource_FullMethodName                    = "/tfplugin6.Provider/ReadResource"
 	Provider_PlanResourceChange_FullMethodName              = "/tfplugin6.Provider/PlanResourceChange"
 	Provider_ApplyResourceChange_FullMethodName             = "/tfplugin6.Provider/ApplyResourceChange"
+	Provider_ApplyResourceChangeWithProgress_FullMethodName = "/tfplugin6.Provider/ApplyResourceChangeWithProgress"
 	Provider_ImportResourceState_FullMethodName             = "/tfplugin6.Provider/ImportResourceState"
 	Provider_MoveResourceState_FullMethodName               = "/tfplugin6.Provider/MoveResourceState"
 	Provider_ReadDataSource_FullMethodName                  = "/tfplugin6.Provider/ReadDataSource"
@@ -107,6 +108,11 @@ type ProviderClient interface {
 	ReadResource(ctx context.Context, in *ReadResource_Request, opts ...grpc.CallOption) (*ReadResource_Response, error)
 	PlanResourceChange(ctx context.Context, in *PlanResourceChange_Request, opts ...grpc.CallOption) (*PlanResourceChange_Response, error)
 	ApplyResourceChange(ctx context.Context, in *ApplyResourceChange_Request, opts ...grpc.CallOption) (*ApplyResourceChange_Response, error)
+	// ApplyResourceChangeWithProgress is an OpenTofu extension that behaves
+	// like ApplyResourceChange, but lets the provider report the progress of
+	// a long-running change before sending the final response. It is called
+	// only for providers that declare the apply_progress server capability.
+	ApplyResourceChangeWithProgress(ctx context.Context, in *ApplyResourceChange_Request, opts ...grpc.CallOption) (Provider_ApplyResourceChangeWithProgressClient, error)
 	ImportResourceState(ctx context.Context, in *ImportResourceState_Request, opts ...grpc.CallOption) (*ImportResourceState_Response, error)
 	MoveResourceState(ctx context.Context, in *MoveResourceState_Request, opts ...grpc.CallOption) (*MoveResourceState_Response, error)
 	ReadDataSource(ctx context.Context, in *ReadDataSource_Request, opts ...grpc.CallOption) (*ReadDataSource_Response, error)
@@ -263,6 +269,38 @@ func (c *providerClient) ApplyResourceChange(ctx context.Context, in *ApplyResou
 	return out, nil
 }
 
+func (c *providerClient) ApplyResourceChangeWithProgress(ctx context.Context, in *ApplyResourceChange_Request, opts ...grpc.CallOption) (Provider_ApplyResourceChangeWithProgressClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Provider_ServiceDesc.Streams[0], Provider_ApplyResourceChangeWithProgress_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &providerApplyResourceChangeWithProgressClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Provider_ApplyResourceChangeWithProgressClient interface {
+	Recv() (*ApplyResourceChangeWithProgress_Event, error)
+	grpc.ClientStream
+}
+
+type providerApplyResourceChangeWithProgressClient struct {
+	grpc.ClientStream
+}
+
+func (x *providerApplyResourceChangeWithProgressClient) Recv() (*ApplyResourceChangeWithProgress_Event, error) {
+	m := new(ApplyResourceChangeWithProgress_Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *providerClient) ImportResourceState(ctx context.Context, in *ImportResourceState_Request, opts ...grpc.CallOption) (*ImportResourceState_Response, error) {
 	out := new(ImportResourceState_Response)
 	err := c.cc.Invoke(ctx, Provider_ImportResourceState_FullMethodName, in, out, opts...)
@@ -336,7 +374,7 @@ func (c *providerClient) CloseEphemeralResource(ctx context.Context, in *CloseEp
 }
 
 func (c *providerClient) ListResource(ctx context.Context, in *ListResource_Request, opts ...grpc.CallOption) (Provider_ListResourceClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Provider_ServiceDesc.Streams[0], Provider_ListResource_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Provider_ServiceDesc.Streams[1], Provider_ListResource_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -413,7 +451,7 @@ func (c *providerClient) ConfigureStateStore(ctx context.Context, in *ConfigureS
 }
 
 func (c *providerClient) ReadStateBytes(ctx context.Context, in *ReadStateBytes_Request, opts ...grpc.CallOption) (Provider_ReadStateBytesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Provider_ServiceDesc.Streams[1], Provider_ReadStateBytes_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Provider_ServiceDesc.Streams[2], Provider_ReadStateBytes_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -445,7 +483,7 @@ func (x *providerReadStateBytesClient) Recv() (*ReadStateBytes_Response, error)
 }
 
 func (c *providerClient) WriteStateBytes(ctx context.Context, opts ...grpc.CallOption) (Provider_WriteStateBytesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Provider_ServiceDesc.Streams[2], Provider_WriteStateBytes_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Provider_ServiceDesc.Streams[3], Provider_WriteStateBytes_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -524,7 +562,7 @@ func (c *providerClient) PlanAction(ctx context.Context, in *PlanAction_Request,
 }
 
 func (c *providerClient) InvokeAction(ctx context.Context, in *InvokeAction_Request, opts ...grpc.CallOption) (Provider_InvokeActionClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Provider_ServiceDesc.Streams[3], Provider_InvokeAction_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Provider_ServiceDesc.Streams[4], Provider_InvokeAction_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -602,6 +640,11 @@ type ProviderServer interface {
 	ReadResource(context.Context, *ReadResource_Request) (*ReadResource_Response, error)
 	PlanResourceChange(context.Context, *PlanResourceChange_Request) (*PlanResourceChange_Response, error)
 	ApplyResourceChange(context.Context, *ApplyResourceChange_Request) (*ApplyResourceChange_Response, error)
+	// ApplyResourceChangeWithProgress is an OpenTofu extension that behaves
+	// like ApplyResourceChange, but lets the provider report the progress of
+	// a long-running change before sending the final response. It is called
+	// only for providers that declare the apply_progress server capability.
+	ApplyResourceChangeWithProgress(*ApplyResourceChange_Request, Provider_ApplyResourceChangeWithProgressServer) error
 	ImportResourceState(context.Context, *ImportResourceState_Request) (*ImportResourceState_Response, error)
 	MoveResourceState(context.Context, *MoveResourceState_Request) (*MoveResourceState_Response, error)
 	ReadDataSource(context.Context, *ReadDataSource_Request) (*ReadDataSource_Response, error)
@@ -683,6 +726,9 @@ func (UnimplementedProviderServer) PlanResourceChange(context.Context, *PlanReso
 func (UnimplementedProviderServer) ApplyResourceChange(context.Context, *ApplyResourceChange_Request) (*ApplyResourceChange_Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ApplyResourceChange not implemented")
 }
+func (UnimplementedProviderServer) ApplyResourceChangeWithProgress(*ApplyResourceChange_Request, Provider_ApplyResourceChangeWithProgressServer) error {
+	return status.Errorf(codes.Unimplemented, "method ApplyResourceChangeWithProgress not implemented")
+}
 func (UnimplementedProviderServer) ImportResourceState(context.Context, *ImportResourceState_Request) (*ImportResourceState_Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ImportResourceState not implemented")
 }
@@ -984,6 +1030,27 @@ func _Provider_ApplyResourceChange_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Provider_ApplyResourceChangeWithProgress_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ApplyResourceChange_Request)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProviderServer).ApplyResourceChangeWithProgress(m, &providerApplyResourceChangeWithProgressServer{stream})
+}
+
+type Provider_ApplyResourceChangeWithProgressServer interface {
+	Send(*ApplyResourceChangeWithProgress_Event) error
+	grpc.ServerStream
+}
+
+type providerApplyResourceChangeWithProgressServer struct {
+	grpc.ServerStream
+}
+
+func (x *providerApplyResourceChangeWithProgressServer) Send(m *ApplyResourceChangeWithProgress_Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Provider_ImportResourceState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ImportResourceState_Request)
 	if err := dec(in); err != nil {
@@ -1570,6 +1637,11 @@ var Provider_ServiceDesc = grpc.ServiceDesc{
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ApplyResourceChangeWithProgress",
+			Handler:       _Provider_ApplyResourceChangeWithProgress_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "ListResource",
 			Handler:       _Provider_ListResource_Handler,
@@ -0,0 +1,247 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tofu
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/dag"
+	"github.com/opentofu/opentofu/internal/logging"
+	"github.com/opentofu/opentofu/internal/plans"
+	"github.com/opentofu/opentofu/internal/states"
+)
+
+// applyScheduleInterval is how often an apply walk reports its schedule to
+// the ApplySchedule hook. Walks that finish sooner than this never report
+// their schedule, since there's nothing to investigate.
+var applyScheduleInterval = 10 * time.Second
+
+// ApplySchedule describes the resource instance changes of an apply
+// operation that are still in progress at one moment, so that the UI can
+// show which changes are running and what the others are waiting for.
+type ApplySchedule struct {
+	// Running are the changes that are being applied.
+	Running []ApplyScheduleEntry
+
+	// Queued are the changes whose dependencies are complete, but which are
+	// waiting because the walk is already running as many operations as the
+	// parallelism limit allows.
+	Queued []ApplyScheduleEntry
+
+	// Blocked are the changes that are waiting for their dependencies.
+	Blocked []ApplyScheduleEntry
+}
+
+// Empty returns true if there are no changes in progress.
+func (s ApplySchedule) Empty() bool {
+	return len(s.Running) == 0 && len(s.Queued) == 0 && len(s.Blocked) == 0
+}
+
+// ApplyScheduleEntry is a single resource instance change in an
+// ApplySchedule.
+type ApplyScheduleEntry struct {
+	Addr       addrs.AbsResourceInstance
+	DeposedKey states.DeposedKey
+	Action     plans.Action
+
+	// Name is the name of the step of the apply operation that makes the
+	// change, which distinguishes the steps that create and destroy objects
+	// when replacing a resource instance.
+	Name string
+
+	// Since is when the change started running or was queued. It's the
+	// start of the apply operation for blocked changes.
+	Since time.Time
+
+	// WaitingFor are the names of the steps that a blocked change is waiting
+	// for, which are either other resource instance changes or steps, such
+	// as configuring a provider, that are already running. It's empty if
+	// the change is about to be queued.
+	WaitingFor []string
+}
+
+type applyStepState rune
+
+const (
+	applyStepQueued  applyStepState = 'Q'
+	applyStepRunning applyStepState = 'R'
+	applyStepDone    applyStepState = 'D'
+)
+
+type applyStepStatus struct {
+	state applyStepState
+	since time.Time
+}
+
+// applyScheduleTracker records the progress of each step of an apply walk,
+// as reported by ContextGraphWalker.Execute, to produce ApplySchedule
+// snapshots.
+type applyScheduleTracker struct {
+	graph   *Graph
+	changes *plans.ChangesSync
+	start   time.Time
+
+	mu     sync.Mutex
+	status map[dag.Vertex]applyStepStatus
+}
+
+func newApplyScheduleTracker(graph *Graph, changes *plans.ChangesSync) *applyScheduleTracker {
+	return &applyScheduleTracker{
+		graph:   graph,
+		changes: changes,
+		start:   time.Now(),
+		status:  make(map[dag.Vertex]applyStepStatus),
+	}
+}
+
+func (t *applyScheduleTracker) update(v dag.Vertex, state applyStepState) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.status[v] = applyStepStatus{state: state, since: time.Now()}
+}
+
+// Snapshot returns the current schedule of the walk.
+func (t *applyScheduleTracker) Snapshot() ApplySchedule {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var ret ApplySchedule
+	seen := make(map[dag.Vertex]struct{})
+	add := func(v dag.Vertex) {
+		if _, ok := seen[v]; ok {
+			return
+		}
+		seen[v] = struct{}{}
+		entry, ok := t.entry(v)
+		if !ok {
+			return
+		}
+		status, started := t.status[v]
+		switch {
+		case !started:
+			entry.Since = t.start
+			entry.WaitingFor = t.waitingFor(v)
+			ret.Blocked = append(ret.Blocked, entry)
+		case status.state == applyStepQueued:
+			entry.Since = status.since
+			ret.Queued = append(ret.Queued, entry)
+		case status.state == applyStepRunning:
+			entry.Since = status.since
+			ret.Running = append(ret.Running, entry)
+		}
+	}
+
+	// The dynamic subgraphs of the walk are only visible through the
+	// steps that have started.
+	for _, v := range t.graph.Vertices() {
+		add(v)
+	}
+	for v := range t.status {
+		add(v)
+	}
+
+	for _, entries := range [][]ApplyScheduleEntry{ret.Running, ret.Queued, ret.Blocked} {
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].Name < entries[j].Name
+		})
+	}
+	return ret
+}
+
+// entry returns the schedule entry for the given step, if it's a change to
+// a resource instance.
+func (t *applyScheduleTracker) entry(v dag.Vertex) (ApplyScheduleEntry, bool) {
+	rn, ok := v.(GraphNodeResourceInstance)
+	if !ok {
+		return ApplyScheduleEntry{}, false
+	}
+	if _, ok := v.(GraphNodeExecutable); !ok {
+		return ApplyScheduleEntry{}, false
+	}
+	addr := rn.ResourceInstanceAddr()
+	deposedKey := states.NotDeposed
+	if dn, ok := v.(GraphNodeDeposedResourceInstanceObject); ok {
+		deposedKey = dn.DeposedInstanceObjectKey()
+	}
+	change := t.changes.GetResourceInstanceChange(addr, deposedKey)
+	if change == nil || change.Action == plans.NoOp {
+		return ApplyScheduleEntry{}, false
+	}
+	return ApplyScheduleEntry{
+		Addr:       addr,
+		DeposedKey: deposedKey,
+		Action:     change.Action,
+		Name:       dag.VertexName(v),
+	}, true
+}
+
+// waitingFor returns the names of the nearest incomplete steps that the
+// given step depends on, looking through any steps that haven't started and
+// aren't resource instance changes. The caller must hold t.mu.
+func (t *applyScheduleTracker) waitingFor(v dag.Vertex) []string {
+	var ret []string
+	visited := make(map[dag.Vertex]struct{})
+	var visit func(dag.Vertex)
+	visit = func(v dag.Vertex) {
+		for _, dep := range t.graph.DownEdges(v) {
+			if _, ok := visited[dep]; ok {
+				continue
+			}
+			visited[dep] = struct{}{}
+
+			status, started := t.status[dep]
+			_, isChange := t.entry(dep)
+			switch {
+			case started && status.state == applyStepDone:
+				// Nothing to wait for here.
+			case started || isChange:
+				ret = append(ret, dag.VertexName(dep))
+			default:
+				visit(dep)
+			}
+		}
+	}
+	visit(v)
+	sort.Strings(ret)
+	return ret
+}
+
+// watchSchedule calls the ApplySchedule hook with a snapshot of the given
+// tracker every applyScheduleInterval until the returned stop channel is
+// closed. The returned wait channel is closed once the watcher has returned.
+func (c *Context) watchSchedule(tracker *applyScheduleTracker) (chan struct{}, <-chan struct{}) {
+	stop := make(chan struct{})
+	wait := make(chan struct{})
+
+	panicHandler := logging.PanicHandlerWithTraceFn()
+	go func() {
+		defer panicHandler()
+		defer close(wait)
+
+		ticker := time.NewTicker(applyScheduleInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+			}
+
+			schedule := tracker.Snapshot()
+			if schedule.Empty() {
+				continue
+			}
+			for _, h := range c.hooks {
+				h.ApplySchedule(schedule)
+			}
+		}
+	}()
+
+	return stop, wait
+}
@@ -44,7 +44,26 @@ type ContextOpts struct {
 	Plugins     plugins.Library
 	Encryption  encryption.Encryption
 
+	// ReadParallelism and WriteParallelism, if set, are separate limits for
+	// the nodes that only read remote objects, such as refreshing and reading
+	// data sources, and for the nodes that change them during apply. They
+	// apply in addition to Parallelism, which always limits the total number
+	// of nodes that run at once, so a value greater than Parallelism has no
+	// effect.
+	ReadParallelism  int
+	WriteParallelism int
+
 	UIInput UIInput
+
+	// DisabledFunctions is an optional set of function name patterns that
+	// must not be callable from any expression in the configuration. Refer
+	// to [lang.FunctionDisabled] for the pattern syntax.
+	DisabledFunctions []string
+
+	// ProviderReadRetries is the number of times to retry a request to read
+	// a resource or data source when it fails with an error that the
+	// provider marked as retryable.
+	ProviderReadRetries int
 }
 
 // ContextMeta is metadata about the running context. This is information
@@ -83,14 +102,20 @@ type Context struct {
 	sh      *stopHook
 	uiInput UIInput
 
-	parallelSem         Semaphore
-	l                   sync.Mutex // Lock acquired during any task
+	parallelSem         *PrioritySemaphore
+	readSem             *PrioritySemaphore // nil if reads have no limit of their own
+	writeSem            *PrioritySemaphore // nil if writes have no limit of their own
+	l                   sync.Mutex         // Lock acquired during any task
 	providerInputConfig map[string]map[string]cty.Value
 	runCond             *sync.Cond
 	runContext          context.Context
 	runContextCancel    context.CancelFunc
 
 	encryption encryption.Encryption
+
+	disabledFunctions []string
+
+	providerReadRetries int
 }
 
 // (additional methods on Context can be found in context_*.go files.)
@@ -132,6 +157,26 @@ func NewContext(opts *ContextOpts) (*Context, tfdiags.Diagnostics) {
 		par = 10
 	}
 
+	readPar, writePar := opts.ReadParallelism, opts.WriteParallelism
+	if readPar < 0 || writePar < 0 {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid parallelism value",
+			fmt.Sprintf("The read and write parallelism must be positive values. Not %d and %d.", readPar, writePar),
+		))
+		return nil, diags
+	}
+
+	// Reads and writes can each have a lower limit of their own, within the
+	// overall limit that they share.
+	var readSem, writeSem *PrioritySemaphore
+	if readPar != 0 && readPar < par {
+		readSem = NewPrioritySemaphore(readPar)
+	}
+	if writePar != 0 && writePar < par {
+		writeSem = NewPrioritySemaphore(writePar)
+	}
+
 	plugins := newContextPlugins(opts.Plugins)
 
 	log.Printf("[TRACE] tofu.NewContext: complete")
@@ -143,11 +188,17 @@ func NewContext(opts *ContextOpts) (*Context, tfdiags.Diagnostics) {
 
 		plugins: plugins,
 
-		parallelSem:         NewSemaphore(par),
+		parallelSem:         NewPrioritySemaphore(par),
+		readSem:             readSem,
+		writeSem:            writeSem,
 		providerInputConfig: make(map[string]map[string]cty.Value),
 		sh:                  sh,
 
 		encryption: opts.Encryption,
+
+		disabledFunctions: opts.DisabledFunctions,
+
+		providerReadRetries: opts.ProviderReadRetries,
 	}, diags
 }
 
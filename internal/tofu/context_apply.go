@@ -41,6 +41,12 @@ type ApplyOpts struct {
 	// SuppressForgetErrorsDuringDestroy suppresses the error that would otherwise
 	// be raised when a destroy operation completes with forgotten instances remaining.
 	SuppressForgetErrorsDuringDestroy bool
+
+	// PreflightProviders causes Apply to configure every provider instance
+	// declared in the configuration before starting the apply walk, so that
+	// problems such as invalid credentials are all reported before any
+	// changes are made.
+	PreflightProviders bool
 }
 
 // Apply performs the actions described by the given Plan object and returns
@@ -75,6 +81,13 @@ func (c *Context) Apply(ctx context.Context, plan *plans.Plan, config *configs.C
 		return nil, diags
 	}
 
+	if opts != nil && opts.PreflightProviders {
+		diags = diags.Append(c.preflightProviders(ctx, plan, config, opts))
+		if diags.HasErrors() {
+			return nil, diags
+		}
+	}
+
 	var forgetCount int
 
 	for _, rc := range plan.Changes.Resources {
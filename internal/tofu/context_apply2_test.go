@@ -7185,3 +7185,440 @@ func TestContext2Apply_ephemeralInModuleWithExpansion(t *testing.T) {
 
 	}
 }
+
+// progressReportingProvider wraps a MockProvider to report the given progress
+// updates from ApplyResourceChange before completing the change.
+type progressReportingProvider struct {
+	*MockProvider
+	progress []providers.Progress
+}
+
+func (p *progressReportingProvider) ApplyResourceChange(ctx context.Context, req providers.ApplyResourceChangeRequest) providers.ApplyResourceChangeResponse {
+	for _, progress := range p.progress {
+		providers.ReportProgress(ctx, progress)
+	}
+	return p.MockProvider.ApplyResourceChange(ctx, req)
+}
+
+func TestContext2Apply_providerProgress(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+resource "test_object" "a" {
+}
+`,
+	})
+
+	want := []providers.Progress{
+		{Phase: "creating", Percent: -1},
+		{Phase: "waiting for object to become ready", Percent: 50},
+	}
+	p := &progressReportingProvider{
+		MockProvider: simpleMockProvider(),
+		progress:     want,
+	}
+	h := new(MockHook)
+	ctx := testContext2(t, &ContextOpts{
+		Hooks: []Hook{h},
+		Plugins: plugins.NewLibrary(map[addrs.Provider]providers.Factory{
+			addrs.NewDefaultProvider("test"): testProviderFuncFixed(p),
+		}, nil),
+	})
+
+	plan, diags := ctx.Plan(context.Background(), m, states.NewState(), DefaultPlanOpts)
+	assertNoErrors(t, diags)
+
+	_, diags = ctx.Apply(context.Background(), plan, m, nil)
+	assertNoErrors(t, diags)
+
+	if !h.ApplyProgressCalled {
+		t.Fatal("ApplyProgress hook was not called")
+	}
+	if got, want := h.ApplyProgressAddr, mustResourceInstanceAddr("test_object.a"); !got.Equal(want) {
+		t.Errorf("wrong address %s; want %s", got, want)
+	}
+	if diff := cmp.Diff(want, h.ApplyProgressProgress); diff != "" {
+		t.Errorf("wrong progress\n%s", diff)
+	}
+}
+
+func TestContext2Apply_preflightProviders(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+			provider "aws" {
+				region = "east"
+			}
+
+			provider "aws" {
+				alias  = "west"
+				region = "west"
+			}
+
+			resource "aws_instance" "east" {
+			}
+
+			resource "aws_instance" "west" {
+				provider = aws.west
+			}
+		`,
+	})
+	p := testProvider("aws")
+	p.PlanResourceChangeFn = testDiffFn
+	ctx := testContext2(t, &ContextOpts{
+		Plugins: plugins.NewLibrary(map[addrs.Provider]providers.Factory{
+			addrs.NewDefaultProvider("aws"): testProviderFuncFixed(p),
+		}, nil),
+	})
+
+	plan, diags := ctx.Plan(context.Background(), m, states.NewState(), DefaultPlanOpts)
+	assertNoErrors(t, diags)
+
+	// Both provider configurations now fail to configure, as if their
+	// credentials had expired since the plan was created.
+	var mu sync.Mutex
+	var configured []string
+	p.ConfigureProviderFn = func(req providers.ConfigureProviderRequest) (resp providers.ConfigureProviderResponse) {
+		region := req.Config.GetAttr("region").AsString()
+		mu.Lock()
+		configured = append(configured, region)
+		mu.Unlock()
+		resp.Diagnostics = resp.Diagnostics.Append(fmt.Errorf("invalid credentials for %s", region))
+		return resp
+	}
+
+	_, diags = ctx.Apply(context.Background(), plan, m, &ApplyOpts{
+		PreflightProviders: true,
+	})
+	if !diags.HasErrors() {
+		t.Fatal("succeeded; want errors")
+	}
+	if p.ApplyResourceChangeCalled {
+		t.Fatal("ApplyResourceChange called; the apply walk should not start when the preflight checks fail")
+	}
+
+	slices.Sort(configured)
+	if diff := cmp.Diff([]string{"east", "west"}, configured); diff != "" {
+		t.Fatalf("wrong provider configurations checked\n%s", diff)
+	}
+	errStr := diags.Err().Error()
+	for _, want := range []string{"invalid credentials for east", "invalid credentials for west"} {
+		if !strings.Contains(errStr, want) {
+			t.Errorf("missing error %q\ngot: %s", want, errStr)
+		}
+	}
+
+	// Once the providers can be configured again, the preflight checks pass
+	// and the apply proceeds as normal.
+	p.ConfigureProviderFn = nil
+	state, diags := ctx.Apply(context.Background(), plan, m, &ApplyOpts{
+		PreflightProviders: true,
+	})
+	assertNoErrors(t, diags)
+	if got := len(state.RootModule().Resources); got != 2 {
+		t.Fatalf("wrong number of resources in state %d; want 2", got)
+	}
+}
+
+// scheduleRecordingHook records the first apply schedule it's given and then
+// closes reported.
+type scheduleRecordingHook struct {
+	NilHook
+
+	once     sync.Once
+	first    ApplySchedule
+	reported chan struct{}
+}
+
+func (h *scheduleRecordingHook) ApplySchedule(schedule ApplySchedule) {
+	h.once.Do(func() {
+		h.first = schedule
+		close(h.reported)
+	})
+}
+
+func TestContext2Apply_applySchedule(t *testing.T) {
+	defer func(interval time.Duration) {
+		applyScheduleInterval = interval
+	}(applyScheduleInterval)
+	applyScheduleInterval = 10 * time.Millisecond
+
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+resource "test_object" "a" {
+  test_string = "a"
+}
+
+resource "test_object" "b" {
+  test_string = "${test_object.a.test_string}-b"
+}
+`,
+	})
+
+	h := &scheduleRecordingHook{reported: make(chan struct{})}
+	p := simpleMockProvider()
+	p.ApplyResourceChangeFn = func(req providers.ApplyResourceChangeRequest) providers.ApplyResourceChangeResponse {
+		// test_object.a keeps running until the schedule has been reported
+		// at least once, so that the schedule catches it in progress.
+		if req.Config.GetAttr("test_string").RawEquals(cty.StringVal("a")) {
+			select {
+			case <-h.reported:
+			case <-time.After(10 * time.Second):
+			}
+		}
+		return providers.ApplyResourceChangeResponse{NewState: req.PlannedState}
+	}
+	ctx := testContext2(t, &ContextOpts{
+		Hooks: []Hook{h},
+		Plugins: plugins.NewLibrary(map[addrs.Provider]providers.Factory{
+			addrs.NewDefaultProvider("test"): testProviderFuncFixed(p),
+		}, nil),
+	})
+
+	plan, diags := ctx.Plan(context.Background(), m, states.NewState(), DefaultPlanOpts)
+	assertNoErrors(t, diags)
+
+	_, diags = ctx.Apply(context.Background(), plan, m, nil)
+	assertNoErrors(t, diags)
+
+	select {
+	case <-h.reported:
+	default:
+		t.Fatal("ApplySchedule hook was not called")
+	}
+
+	if got := len(h.first.Running); got != 1 {
+		t.Fatalf("wrong number of running changes %d; want 1", got)
+	}
+	if got, want := h.first.Running[0].Addr, mustResourceInstanceAddr("test_object.a"); !got.Equal(want) {
+		t.Errorf("wrong running change %s; want %s", got, want)
+	}
+	if got := len(h.first.Blocked); got != 1 {
+		t.Fatalf("wrong number of blocked changes %d; want 1", got)
+	}
+	blocked := h.first.Blocked[0]
+	if got, want := blocked.Addr, mustResourceInstanceAddr("test_object.b"); !got.Equal(want) {
+		t.Errorf("wrong blocked change %s; want %s", got, want)
+	}
+	if got, want := blocked.Action, plans.Create; got != want {
+		t.Errorf("wrong action %s; want %s", got, want)
+	}
+	if diff := cmp.Diff([]string{"test_object.a"}, blocked.WaitingFor); diff != "" {
+		t.Errorf("wrong dependencies\n%s", diff)
+	}
+}
+
+func TestContext2Apply_lifecycleApplyWithin(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+resource "test_object" "slow" {
+  lifecycle {
+    apply_within = "10ms"
+  }
+}
+
+resource "test_object" "fast" {
+  test_string = "fast"
+
+  lifecycle {
+    apply_within = "1h"
+  }
+}
+`,
+	})
+
+	p := simpleMockProvider()
+	p.ApplyResourceChangeFn = func(req providers.ApplyResourceChangeRequest) providers.ApplyResourceChangeResponse {
+		if req.PlannedState.GetAttr("test_string").IsNull() {
+			time.Sleep(100 * time.Millisecond)
+		}
+		return providers.ApplyResourceChangeResponse{NewState: req.PlannedState}
+	}
+	h := new(MockHook)
+	ctx := testContext2(t, &ContextOpts{
+		Hooks: []Hook{h},
+		Plugins: plugins.NewLibrary(map[addrs.Provider]providers.Factory{
+			addrs.NewDefaultProvider("test"): testProviderFuncFixed(p),
+		}, nil),
+	})
+
+	plan, diags := ctx.Plan(context.Background(), m, states.NewState(), DefaultPlanOpts)
+	assertNoErrors(t, diags)
+
+	_, diags = ctx.Apply(context.Background(), plan, m, nil)
+	assertNoErrors(t, diags)
+
+	if len(diags) != 1 {
+		t.Fatalf("expected one warning, got %d:\n%s", len(diags), diags.ErrWithWarnings())
+	}
+	if got, want := diags[0].Description().Summary, "Resource change took longer than expected"; got != want {
+		t.Errorf("wrong warning summary %q; want %q", got, want)
+	}
+	if got, want := diags[0].Description().Detail, "test_object.slow"; !strings.Contains(got, want) {
+		t.Errorf("warning detail %q doesn't mention %s", got, want)
+	}
+
+	if !h.ApplyBudgetExceededCalled {
+		t.Fatal("ApplyBudgetExceeded hook was not called")
+	}
+	if got, want := h.ApplyBudgetExceededAddr, mustResourceInstanceAddr("test_object.slow"); !got.Equal(want) {
+		t.Errorf("wrong address %s; want %s", got, want)
+	}
+	if got, want := h.ApplyBudgetExceededBudget, 10*time.Millisecond; got != want {
+		t.Errorf("wrong budget %s; want %s", got, want)
+	}
+}
+
+// concurrencyHook records the largest number of changes that were being
+// applied at the same time.
+type concurrencyHook struct {
+	NilHook
+
+	mu     sync.Mutex
+	active int
+	max    int
+}
+
+func (h *concurrencyHook) PreApply(addr addrs.AbsResourceInstance, gen states.Generation, action plans.Action, priorState, plannedNewState cty.Value) (HookAction, error) {
+	h.mu.Lock()
+	h.active++
+	h.max = max(h.max, h.active)
+	h.mu.Unlock()
+
+	// Give any other changes that could be applied concurrently the chance
+	// to start.
+	time.Sleep(20 * time.Millisecond)
+	return HookActionContinue, nil
+}
+
+func (h *concurrencyHook) PostApply(addr addrs.AbsResourceInstance, gen states.Generation, newState cty.Value, err error) (HookAction, error) {
+	h.mu.Lock()
+	h.active--
+	h.mu.Unlock()
+	return HookActionContinue, nil
+}
+
+func TestContext2Apply_serializeGroup(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+resource "test_object" "a" {
+  count = 3
+
+  lifecycle {
+    serialize_group = "migrations"
+  }
+}
+
+resource "test_object" "b" {
+  lifecycle {
+    serialize_group = "migrations"
+  }
+}
+`,
+	})
+
+	h := &concurrencyHook{}
+	p := simpleMockProvider()
+	ctx := testContext2(t, &ContextOpts{
+		Hooks: []Hook{h},
+		Plugins: plugins.NewLibrary(map[addrs.Provider]providers.Factory{
+			addrs.NewDefaultProvider("test"): testProviderFuncFixed(p),
+		}, nil),
+	})
+
+	plan, diags := ctx.Plan(context.Background(), m, states.NewState(), DefaultPlanOpts)
+	assertNoErrors(t, diags)
+
+	state, diags := ctx.Apply(context.Background(), plan, m, nil)
+	assertNoErrors(t, diags)
+
+	if got := len(state.AllResourceInstanceObjectAddrs()); got != 4 {
+		t.Fatalf("wrong number of resource instances %d; want 4", got)
+	}
+	if h.max != 1 {
+		t.Errorf("%d changes in the same serialize group were applied concurrently", h.max)
+	}
+
+	// Destroying the resources is serialized in the same way.
+	h.max = 0
+	plan, diags = ctx.Plan(context.Background(), m, state, &PlanOpts{Mode: plans.DestroyMode})
+	assertNoErrors(t, diags)
+
+	_, diags = ctx.Apply(context.Background(), plan, m, nil)
+	assertNoErrors(t, diags)
+
+	if h.max != 1 {
+		t.Errorf("%d changes in the same serialize group were destroyed concurrently", h.max)
+	}
+}
+
+func TestContext2Apply_writeParallelism(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+resource "test_object" "a" {
+  count = 6
+}
+`,
+	})
+
+	tests := map[string]struct {
+		parallelism, readParallelism, writeParallelism int
+		want                                           int
+	}{
+		"write limit below overall limit": {
+			parallelism:      10,
+			writeParallelism: 2,
+			want:             2,
+		},
+		"write limit above overall limit": {
+			// The overall limit still applies when only the write limit
+			// is set.
+			parallelism:      2,
+			writeParallelism: 5,
+			want:             2,
+		},
+		"read limit only": {
+			// The read limit doesn't apply to writes, but the overall
+			// limit still does.
+			parallelism:     3,
+			readParallelism: 1,
+			want:            3,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			h := &concurrencyHook{}
+			p := simpleMockProvider()
+			ctx := testContext2(t, &ContextOpts{
+				Hooks: []Hook{h},
+				Plugins: plugins.NewLibrary(map[addrs.Provider]providers.Factory{
+					addrs.NewDefaultProvider("test"): testProviderFuncFixed(p),
+				}, nil),
+				Parallelism:      test.parallelism,
+				ReadParallelism:  test.readParallelism,
+				WriteParallelism: test.writeParallelism,
+			})
+
+			plan, diags := ctx.Plan(context.Background(), m, states.NewState(), DefaultPlanOpts)
+			assertNoErrors(t, diags)
+
+			_, diags = ctx.Apply(context.Background(), plan, m, nil)
+			assertNoErrors(t, diags)
+
+			if h.max != test.want {
+				t.Errorf("%d changes were applied concurrently; want %d", h.max, test.want)
+			}
+		})
+	}
+}
+
+func TestContext2Apply_invalidReadParallelism(t *testing.T) {
+	_, diags := NewContext(&ContextOpts{
+		ReadParallelism: -1,
+	})
+	if !diags.HasErrors() {
+		t.Fatal("succeeded; want error")
+	}
+	if got, want := diags.Err().Error(), "The read and write parallelism must be positive values. Not -1 and 0."; !strings.Contains(got, want) {
+		t.Fatalf("wrong error\ngot:  %s\nwant: %s", got, want)
+	}
+}
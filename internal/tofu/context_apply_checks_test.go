@@ -95,6 +95,56 @@ check "passing" {
 				},
 			},
 		},
+		"retrying": {
+			configs: map[string]string{
+				"main.tf": `
+provider "checks" {}
+
+check "retrying" {
+  data "checks_object" "positive" {}
+
+  assert {
+    condition     = retryuntil(data.checks_object.positive.number >= 0, "1s", "10ms")
+    error_message = "negative number"
+  }
+}
+`,
+			},
+			plan: map[string]checksTestingStatus{
+				"retrying": {
+					status: checks.StatusPass,
+				},
+			},
+			apply: map[string]checksTestingStatus{
+				"retrying": {
+					status: checks.StatusPass,
+				},
+			},
+			provider: &MockProvider{
+				Meta: "checks",
+				GetProviderSchemaResponse: &providers.GetProviderSchemaResponse{
+					DataSources: map[string]providers.Schema{
+						"checks_object": {
+							Block: &configschema.Block{
+								Attributes: map[string]*configschema.Attribute{
+									"number": {
+										Type:     cty.Number,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+				ReadDataSourceFn: func(request providers.ReadDataSourceRequest) providers.ReadDataSourceResponse {
+					return providers.ReadDataSourceResponse{
+						State: cty.ObjectVal(map[string]cty.Value{
+							"number": cty.NumberIntVal(0),
+						}),
+					}
+				},
+			},
+		},
 		"failing": {
 			configs: map[string]string{
 				"main.tf": `
@@ -315,7 +365,7 @@ check "resource_block" {
 					status: checks.StatusUnknown,
 				},
 			},
-			planWarning: "Check block assertion known after apply: The condition could not be evaluated at this time, a result will be known when this plan is applied.",
+			planWarning: "Check block assertion known after apply: The condition could not be evaluated at this time, a result will be known when this plan is applied. The condition depends on data.checks_object.data_block.number, which will be known only after apply.",
 			apply: map[string]checksTestingStatus{
 				"resource_block": {
 					status: checks.StatusPass,
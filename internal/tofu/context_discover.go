@@ -0,0 +1,250 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tofu
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/configs"
+	"github.com/opentofu/opentofu/internal/configs/configschema"
+	"github.com/opentofu/opentofu/internal/providers"
+	"github.com/opentofu/opentofu/internal/states"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+	"github.com/opentofu/opentofu/internal/tracing"
+)
+
+// DiscoverOpts are the options for Context.DiscoverResources.
+type DiscoverOpts struct {
+	// SetVariables are the raw values for root module variables, which are
+	// needed to evaluate the provider configuration.
+	SetVariables InputValues
+
+	// Filters are the values for the arguments of the list resource schema
+	// of the resource type, which the provider typically uses to filter the
+	// objects it finds. Each value is converted to the type of its argument,
+	// and all other arguments are null.
+	Filters map[string]cty.Value
+
+	// Limit is the maximum number of objects to discover, or zero to use
+	// the provider's own limit.
+	Limit int64
+}
+
+// DiscoveredResource is an existing remote object found by
+// Context.DiscoverResources.
+type DiscoveredResource struct {
+	// DisplayName is the provider's description of the object.
+	DisplayName string
+
+	// Identity is the resource identity of the object, which can be used in
+	// an import block to import it.
+	Identity cty.Value
+
+	// ManagedBy is the address of the resource instance whose identity in
+	// the given state matches the identity of the object, or nil if the
+	// object doesn't seem to be managed by OpenTofu yet.
+	ManagedBy *addrs.AbsResourceInstance
+}
+
+// DiscoverResources asks the provider of the given resource type to list the
+// existing remote objects of that type, so that they can be imported.
+//
+// The provider is configured using the configuration that a resource of the
+// given type would use if it were declared in the root module without a
+// "provider" argument, evaluated in the same way as for the "tofu console"
+// command. Only providers that support listing the given resource type can
+// discover its objects.
+//
+// This takes no action against remote APIs other than those the provider
+// takes to configure itself and to list the objects, and doesn't modify the
+// given state.
+func (c *Context) DiscoverResources(ctx context.Context, config *configs.Config, state *states.State, typeName string, opts *DiscoverOpts) ([]DiscoveredResource, tfdiags.Diagnostics) {
+	defer c.acquireRun("discover")()
+
+	ctx, span := tracing.Tracer().Start(
+		ctx, "Discover resources",
+	)
+	defer span.End()
+
+	walker, diags := c.evalWalk(ctx, config, state, &EvalOpts{SetVariables: opts.SetVariables})
+	if diags.HasErrors() {
+		tracing.SetSpanError(span, diags)
+		return nil, diags
+	}
+	evalCtx := walker.EnterPath(addrs.RootModuleInstance)
+
+	providerConfigAddr, providerConfig, providerSchema, moreDiags := evalResourceProvider(ctx, evalCtx, config, addrs.RootModuleInstance, addrs.ManagedResourceMode, typeName, "discover existing resources")
+	diags = diags.Append(moreDiags)
+	if diags.HasErrors() {
+		tracing.SetSpanError(span, diags)
+		return nil, diags
+	}
+	resourceSchema, _ := providerSchema.SchemaForResourceType(addrs.ManagedResourceMode, typeName)
+	listSchema, ok := providerSchema.ListResources[typeName]
+	if resourceSchema == nil || resourceSchema.IdentitySchema == nil || !ok || listSchema.Block == nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Resource type cannot be discovered",
+			fmt.Sprintf("The provider %s does not support listing the existing objects of resource type %q.", providerConfigAddr.Provider, typeName),
+		))
+		tracing.SetSpanError(span, diags)
+		return nil, diags
+	}
+
+	listConfig, moreDiags := discoverListConfig(listSchema.Block, opts.Filters)
+	diags = diags.Append(moreDiags)
+	if diags.HasErrors() {
+		tracing.SetSpanError(span, diags)
+		return nil, diags
+	}
+
+	log.Printf("[TRACE] DiscoverResources: starting %s to list resource type %q", providerConfigAddr, typeName)
+	provider, moreDiags := startEvalProvider(ctx, evalCtx, providerConfigAddr, providerConfig, providerSchema)
+	diags = diags.Append(moreDiags)
+	if diags.HasErrors() {
+		tracing.SetSpanError(span, diags)
+		return nil, diags
+	}
+	defer func() {
+		if err := provider.Close(context.WithoutCancel(ctx)); err != nil {
+			log.Printf("[WARN] DiscoverResources: failed to close %s: %s", providerConfigAddr, err)
+		}
+	}()
+
+	resp := provider.ListResource(ctx, providers.ListResourceRequest{
+		TypeName: typeName,
+		Config:   listConfig,
+		Limit:    opts.Limit,
+	})
+	diags = diags.Append(resp.Diagnostics)
+	if diags.HasErrors() {
+		tracing.SetSpanError(span, diags)
+		return nil, diags
+	}
+
+	managed := discoverManagedIdentities(state, typeName, resourceSchema.IdentitySchema.ImpliedType())
+	ret := make([]DiscoveredResource, 0, len(resp.Results))
+	for _, result := range resp.Results {
+		discovered := DiscoveredResource{
+			DisplayName: result.DisplayName,
+			Identity:    result.Identity,
+		}
+		for _, m := range managed {
+			if m.identity.RawEquals(result.Identity) {
+				addr := m.addr
+				discovered.ManagedBy = &addr
+				break
+			}
+		}
+		ret = append(ret, discovered)
+	}
+
+	return ret, diags
+}
+
+// discoverListConfig returns the configuration for listing the objects of a
+// resource type with the given list resource schema, using the given values
+// for its top-level arguments.
+func discoverListConfig(schema *configschema.Block, filters map[string]cty.Value) (cty.Value, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	vals := make(map[string]cty.Value)
+	for name, val := range schema.EmptyValue().AsValueMap() {
+		vals[name] = val
+	}
+
+	names := make([]string, 0, len(filters))
+	for name := range filters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		attr, ok := schema.Attributes[name]
+		if !ok {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Unsupported filter",
+				fmt.Sprintf("The provider doesn't support filtering the existing objects by %q.", name),
+			))
+			continue
+		}
+		val, err := convert.Convert(filters[name], attr.ImpliedType())
+		if err != nil {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Invalid filter value",
+				fmt.Sprintf("The value for filter %q is not valid: %s.", name, tfdiags.FormatError(err)),
+			))
+			continue
+		}
+		vals[name] = val
+	}
+
+	names = names[:0]
+	for name := range schema.Attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if schema.Attributes[name].Required && vals[name].IsNull() {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Missing required filter",
+				fmt.Sprintf("The provider requires a value for filter %q to find the existing objects.", name),
+			))
+		}
+	}
+	if diags.HasErrors() {
+		return cty.NilVal, diags
+	}
+
+	return cty.ObjectVal(vals), diags
+}
+
+type discoverManagedIdentity struct {
+	addr     addrs.AbsResourceInstance
+	identity cty.Value
+}
+
+// discoverManagedIdentities returns the identities recorded in the given
+// state for the current objects of the managed resources of the given type.
+func discoverManagedIdentities(state *states.State, typeName string, identityType cty.Type) []discoverManagedIdentity {
+	var ret []discoverManagedIdentity
+	if state == nil {
+		return ret
+	}
+	for _, ms := range state.Modules {
+		for _, rs := range ms.Resources {
+			if rs.Addr.Resource.Mode != addrs.ManagedResourceMode || rs.Addr.Resource.Type != typeName {
+				continue
+			}
+			for key, is := range rs.Instances {
+				if is.Current == nil || len(is.Current.IdentityJSON) == 0 {
+					continue
+				}
+				identity, err := ctyjson.Unmarshal(is.Current.IdentityJSON, identityType)
+				if err != nil {
+					// The identity might have been saved with an older
+					// version of the identity schema, so we can't tell.
+					continue
+				}
+				ret = append(ret, discoverManagedIdentity{
+					addr:     rs.Addr.Instance(key),
+					identity: identity,
+				})
+			}
+		}
+	}
+	return ret
+}
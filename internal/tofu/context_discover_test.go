@@ -0,0 +1,209 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tofu
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/configs/configschema"
+	"github.com/opentofu/opentofu/internal/plugins"
+	"github.com/opentofu/opentofu/internal/providers"
+	"github.com/opentofu/opentofu/internal/states"
+)
+
+func TestContextDiscoverResources(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+variable "region" {
+  type    = string
+  default = "eu-west-1"
+}
+
+provider "test" {
+  region = var.region
+}
+
+resource "test_instance" "existing" {
+  name = "a"
+}
+`,
+	})
+
+	p := discoverTestProvider()
+	p.ListResourceResponse = &providers.ListResourceResponse{
+		Results: []providers.ListResourceResult{
+			{
+				DisplayName: "Instance a",
+				Identity:    cty.ObjectVal(map[string]cty.Value{"name": cty.StringVal("a")}),
+			},
+			{
+				DisplayName: "Instance b",
+				Identity:    cty.ObjectVal(map[string]cty.Value{"name": cty.StringVal("b")}),
+			},
+		},
+	}
+	ctx := testContext2(t, &ContextOpts{
+		Plugins: plugins.NewLibrary(map[addrs.Provider]providers.Factory{
+			addrs.NewDefaultProvider("test"): testProviderFuncFixed(p),
+		}, nil),
+	})
+
+	state := states.BuildState(func(s *states.SyncState) {
+		s.SetResourceInstanceCurrent(
+			mustResourceInstanceAddr("test_instance.existing"),
+			&states.ResourceInstanceObjectSrc{
+				Status:       states.ObjectReady,
+				AttrsJSON:    []byte(`{"id":"i-a","name":"a"}`),
+				IdentityJSON: []byte(`{"name":"a"}`),
+			},
+			mustProviderConfig(`provider["registry.opentofu.org/hashicorp/test"]`),
+			addrs.NoKey,
+		)
+	})
+
+	got, diags := ctx.DiscoverResources(t.Context(), m, state, "test_instance", &DiscoverOpts{
+		SetVariables: testInputValuesUnset(m.Module.Variables),
+		Filters: map[string]cty.Value{
+			"prefix": cty.StringVal("instance-"),
+		},
+		Limit: 10,
+	})
+	assertNoErrors(t, diags)
+
+	if !p.ConfigureProviderCalled {
+		t.Fatal("provider was not configured")
+	}
+	if got, want := p.ConfigureProviderRequest.Config.GetAttr("region"), cty.StringVal("eu-west-1"); !got.RawEquals(want) {
+		t.Errorf("wrong provider region %#v; want %#v", got, want)
+	}
+	req := p.ListResourceRequest
+	if req.TypeName != "test_instance" || req.Limit != 10 {
+		t.Errorf("wrong list request %#v", req)
+	}
+	if got, want := req.Config.GetAttr("prefix"), cty.StringVal("instance-"); !got.RawEquals(want) {
+		t.Errorf("wrong prefix filter %#v; want %#v", got, want)
+	}
+	if got := req.Config.GetAttr("tags"); !got.IsNull() {
+		t.Errorf("unset filter is %#v; want null", got)
+	}
+
+	if len(got) != 2 {
+		t.Fatalf("wrong number of results %d; want 2", len(got))
+	}
+	if got[0].DisplayName != "Instance a" || got[0].ManagedBy == nil || got[0].ManagedBy.String() != "test_instance.existing" {
+		t.Errorf("wrong first result %#v", got[0])
+	}
+	if got[1].DisplayName != "Instance b" || got[1].ManagedBy != nil {
+		t.Errorf("wrong second result %#v", got[1])
+	}
+}
+
+func TestContextDiscoverResources_invalid(t *testing.T) {
+	tests := map[string]struct {
+		typeName string
+		filters  map[string]cty.Value
+		wantErr  string
+	}{
+		"unsupported filter": {
+			"test_instance",
+			map[string]cty.Value{"color": cty.StringVal("blue")},
+			`The provider doesn't support filtering the existing objects by "color".`,
+		},
+		"invalid filter value": {
+			"test_instance",
+			map[string]cty.Value{"tags": cty.StringVal("blue")},
+			`The value for filter "tags" is not valid`,
+		},
+		"not listable": {
+			"test_other",
+			nil,
+			`does not support listing the existing objects of resource type "test_other"`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			m := testModuleInline(t, map[string]string{
+				"main.tf": `
+provider "test" {
+  region = "eu-west-1"
+}
+`,
+			})
+			p := discoverTestProvider()
+			ctx := testContext2(t, &ContextOpts{
+				Plugins: plugins.NewLibrary(map[addrs.Provider]providers.Factory{
+					addrs.NewDefaultProvider("test"): testProviderFuncFixed(p),
+				}, nil),
+			})
+
+			_, diags := ctx.DiscoverResources(t.Context(), m, states.NewState(), test.typeName, &DiscoverOpts{
+				Filters: test.filters,
+			})
+			if !diags.HasErrors() {
+				t.Fatal("succeeded; want error")
+			}
+			if got := diags.Err().Error(); !strings.Contains(got, test.wantErr) {
+				t.Errorf("wrong error\ngot:  %s\nwant: %s", got, test.wantErr)
+			}
+			if p.ListResourceCalled {
+				t.Error("provider was asked to list resources despite the error")
+			}
+		})
+	}
+}
+
+func discoverTestProvider() *MockProvider {
+	p := &MockProvider{}
+	p.GetProviderSchemaResponse = &providers.GetProviderSchemaResponse{
+		Provider: providers.Schema{
+			Block: &configschema.Block{
+				Attributes: map[string]*configschema.Attribute{
+					"region": {Type: cty.String, Optional: true},
+				},
+			},
+		},
+		ResourceTypes: map[string]providers.Schema{
+			"test_instance": {
+				Block: &configschema.Block{
+					Attributes: map[string]*configschema.Attribute{
+						"id":   {Type: cty.String, Computed: true},
+						"name": {Type: cty.String, Optional: true},
+					},
+				},
+				IdentitySchema: &configschema.Object{
+					Attributes: map[string]*configschema.Attribute{
+						"name": {Type: cty.String, Required: true},
+					},
+					Nesting: configschema.NestingSingle,
+				},
+				IdentitySchemaVersion: 1,
+			},
+			"test_other": {
+				Block: &configschema.Block{
+					Attributes: map[string]*configschema.Attribute{
+						"id": {Type: cty.String, Computed: true},
+					},
+				},
+			},
+		},
+		ListResources: map[string]providers.Schema{
+			"test_instance": {
+				Block: &configschema.Block{
+					Attributes: map[string]*configschema.Attribute{
+						"prefix": {Type: cty.String, Optional: true},
+						"tags":   {Type: cty.Map(cty.String), Optional: true},
+					},
+				},
+			},
+		},
+	}
+	return p
+}
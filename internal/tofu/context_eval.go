@@ -32,7 +32,9 @@ type EvalOpts struct {
 //
 // This function takes no action against remote APIs but it does need access
 // to all provider and provisioner instances in order to obtain their schemas
-// for type checking.
+// for type checking. The returned scope can read data sources through
+// the console-only "data" function and can call provider-defined functions,
+// both of which start new provider instances only when called.
 //
 // The result is an evaluation scope that can be used to resolve references
 // against the root module. If the returned diagnostics contains errors then
@@ -44,7 +46,6 @@ func (c *Context) Eval(ctx context.Context, config *configs.Config, state *state
 	// command. Internally, we create an evaluator in c.walk before walking
 	// the graph, and create scopes in ContextGraphWalker.
 
-	var diags tfdiags.Diagnostics
 	defer c.acquireRun("eval")()
 
 	ctx, span := tracing.Tracer().Start(
@@ -52,6 +53,33 @@ func (c *Context) Eval(ctx context.Context, config *configs.Config, state *state
 	)
 	defer span.End()
 
+	walker, diags := c.evalWalk(ctx, config, state, opts)
+	if walker == nil {
+		return nil, diags
+	}
+
+	// This is a bit weird since we don't normally evaluate outside of
+	// the context of a walk, but we'll "re-enter" our desired path here
+	// just to get hold of an EvalContext for it. ContextGraphWalker
+	// caches its contexts, so we should get hold of the context that was
+	// previously used for evaluation here, unless we skipped walking.
+	evalCtx := walker.EnterPath(moduleAddr)
+	scope := evalCtx.EvaluationScope(nil, nil, EvalDataForNoInstanceKey)
+	scope.ProviderFunctions = evalProviderFunctions(evalCtx, config, moduleAddr)
+	scope.DataSourceReader = evalDataSourceReader(ctx, evalCtx, config, moduleAddr)
+	return scope, diags
+}
+
+// evalWalk walks the "eval" graph for the given configuration and state, to
+// evaluate the ephemeral values that Eval and DiscoverResources need, and
+// returns the graph walker whose evaluation contexts retain the results.
+//
+// The returned walker is nil only if the graph couldn't be built. If the walk
+// itself failed then the evaluation contexts of the returned walker might
+// refer only to the unmodified state.
+func (c *Context) evalWalk(ctx context.Context, config *configs.Config, state *states.State, opts *EvalOpts) (*ContextGraphWalker, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
 	// Start with a copy of state so that we don't affect the instance that
 	// the caller is holding.
 	state = state.DeepCopy()
@@ -102,11 +130,5 @@ func (c *Context) Eval(ctx context.Context, config *configs.Config, state *state
 		walker = c.graphWalker(walkEval, walkOpts)
 	}
 
-	// This is a bit weird since we don't normally evaluate outside of
-	// the context of a walk, but we'll "re-enter" our desired path here
-	// just to get hold of an EvalContext for it. ContextGraphWalker
-	// caches its contexts, so we should get hold of the context that was
-	// previously used for evaluation here, unless we skipped walking.
-	evalCtx := walker.EnterPath(moduleAddr)
-	return evalCtx.EvaluationScope(nil, nil, EvalDataForNoInstanceKey), diags
+	return walker, diags
 }
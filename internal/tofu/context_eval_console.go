@@ -0,0 +1,272 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tofu
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/function"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/configs"
+	"github.com/opentofu/opentofu/internal/lang"
+	"github.com/opentofu/opentofu/internal/providers"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// evalDataSourceReader returns a [lang.DataSourceReader] that reads data
+// sources on behalf of the "data" function in the console, using the provider
+// configuration that a data resource of the same type would use if it were
+// declared in the given module without a "provider" argument.
+//
+// Each read starts, configures and then closes a separate provider instance,
+// so that nothing remains running between reads.
+func evalDataSourceReader(ctx context.Context, evalCtx EvalContext, config *configs.Config, moduleAddr addrs.ModuleInstance) lang.DataSourceReader {
+	return func(typeName string, configVal cty.Value) (cty.Value, tfdiags.Diagnostics) {
+		var diags tfdiags.Diagnostics
+
+		providerConfigAddr, providerConfig, providerSchema, moreDiags := evalResourceProvider(ctx, evalCtx, config, moduleAddr, addrs.DataResourceMode, typeName, "read data sources in the console")
+		diags = diags.Append(moreDiags)
+		if diags.HasErrors() {
+			return cty.DynamicVal, diags
+		}
+		schema, _ := providerSchema.SchemaForResourceType(addrs.DataResourceMode, typeName)
+		if schema == nil {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Invalid data source",
+				fmt.Sprintf("The provider %s does not support data source %q.", providerConfigAddr.Provider, typeName),
+			))
+			return cty.DynamicVal, diags
+		}
+
+		configVal, err := schema.Block.CoerceValue(configVal)
+		if err != nil {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Invalid data source configuration",
+				fmt.Sprintf("The configuration for data source %q is not valid: %s", typeName, tfdiags.FormatError(err)),
+			))
+			return cty.DynamicVal, diags
+		}
+		if !configVal.IsWhollyKnown() {
+			return cty.UnknownVal(schema.Block.ImpliedType()), diags
+		}
+
+		log.Printf("[TRACE] evalDataSourceReader: starting %s to read data source %q", providerConfigAddr, typeName)
+		provider, moreDiags := startEvalProvider(ctx, evalCtx, providerConfigAddr, providerConfig, providerSchema)
+		diags = diags.Append(moreDiags)
+		if diags.HasErrors() {
+			return cty.DynamicVal, diags
+		}
+		defer func() {
+			if err := provider.Close(context.WithoutCancel(ctx)); err != nil {
+				log.Printf("[WARN] evalDataSourceReader: failed to close %s: %s", providerConfigAddr, err)
+			}
+		}()
+
+		// Unmark before sending to provider, will re-mark before returning
+		configVal, pvm := configVal.UnmarkDeepWithPaths()
+
+		validateResp := provider.ValidateDataResourceConfig(ctx, providers.ValidateDataResourceConfigRequest{
+			TypeName: typeName,
+			Config:   configVal,
+		})
+		diags = diags.Append(validateResp.Diagnostics)
+		if diags.HasErrors() {
+			return cty.DynamicVal, diags
+		}
+
+		resp := provider.ReadDataSource(ctx, providers.ReadDataSourceRequest{
+			TypeName:     typeName,
+			Config:       configVal,
+			ProviderMeta: cty.NullVal(cty.DynamicPseudoType),
+		})
+		diags = diags.Append(resp.Diagnostics)
+		if diags.HasErrors() {
+			return cty.DynamicVal, diags
+		}
+
+		newVal := resp.State
+		if newVal == cty.NilVal || newVal.IsNull() {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Provider produced null object",
+				fmt.Sprintf(
+					"Provider %q produced a null value for data source %q.\n\nThis is a bug in the provider, which should be reported in the provider's own issue tracker.",
+					providerConfigAddr, typeName,
+				),
+			))
+			return cty.DynamicVal, diags
+		}
+		for _, err := range newVal.Type().TestConformance(schema.Block.ImpliedType()) {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Provider produced invalid object",
+				fmt.Sprintf(
+					"Provider %q produced an invalid value for data source %q.\n\nThis is a bug in the provider, which should be reported in the provider's own issue tracker.",
+					providerConfigAddr, tfdiags.FormatErrorPrefixed(err, typeName),
+				),
+			))
+		}
+		if diags.HasErrors() {
+			return cty.DynamicVal, diags
+		}
+
+		if len(pvm) > 0 {
+			newVal = newVal.MarkWithPaths(pvm)
+		}
+		newVal = newVal.MarkWithPaths(schema.Block.ValueMarks(newVal, nil, nil))
+		return newVal, diags
+	}
+}
+
+// evalResourceProvider returns the address and the configuration, if any, of
+// the provider configuration that a resource of the given mode and type would
+// use if it were declared in the given module without a "provider" argument,
+// along with the schema of its provider. The given use completes the error
+// message for a provider configuration that can't be used outside of a
+// graph walk, such as "read data sources in the console".
+func evalResourceProvider(ctx context.Context, evalCtx EvalContext, config *configs.Config, moduleAddr addrs.ModuleInstance, mode addrs.ResourceMode, typeName string, use string) (addrs.AbsProviderConfig, *configs.Provider, providers.ProviderSchema, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	modCfg := config.DescendentForInstance(moduleAddr)
+	if modCfg == nil {
+		// Should not get here, because the caller would've failed to
+		// build the evaluation scope for this module.
+		return addrs.AbsProviderConfig{}, nil, providers.ProviderSchema{}, diags.Append(fmt.Errorf("no configuration for %s", moduleAddr))
+	}
+
+	localName := addrs.Resource{Mode: mode, Type: typeName}.ImpliedProvider()
+	providerAddr := modCfg.Module.ImpliedProviderForUnqualifiedType(localName)
+	providerConfigAddr := addrs.AbsProviderConfig{
+		Module:   moduleAddr.Module(),
+		Provider: providerAddr,
+	}
+	providerConfig := modCfg.Module.ProviderConfigs[localName]
+	if providerConfig != nil && providerConfig.Instances != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Unsupported provider configuration",
+			fmt.Sprintf("The default configuration for %s uses for_each, so it cannot be used to %s.", providerConfigAddr, use),
+		))
+		return providerConfigAddr, nil, providers.ProviderSchema{}, diags
+	}
+
+	if !evalCtx.Providers().HasProvider(providerAddr) {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Provider not available",
+			fmt.Sprintf("The provider %s is not available. Declare it in the configuration and run \"tofu init\" to install it.", providerAddr),
+		))
+		return providerConfigAddr, nil, providers.ProviderSchema{}, diags
+	}
+
+	providerSchema, schemaDiags := evalCtx.Providers().GetProviderSchema(ctx, providerAddr)
+	diags = diags.Append(schemaDiags)
+	return providerConfigAddr, providerConfig, providerSchema, diags
+}
+
+// startEvalProvider starts a new instance of the given provider and configures
+// it using the given configuration, evaluated in the given context. The caller
+// must close the returned instance once it's finished with it.
+func startEvalProvider(ctx context.Context, evalCtx EvalContext, providerConfigAddr addrs.AbsProviderConfig, providerConfig *configs.Provider, providerSchema providers.ProviderSchema) (providers.Interface, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	configBody := buildProviderConfig(ctx, evalCtx, providerConfigAddr, providerConfig)
+	providerConfigVal, _, evalDiags := evalCtx.EvaluateBlock(ctx, configBody, providerSchema.Provider.Block, nil, EvalDataForNoInstanceKey)
+	diags = diags.Append(evalDiags)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	if !providerConfigVal.IsWhollyKnown() {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid provider configuration",
+			fmt.Sprintf("The configuration for %s depends on values that cannot be determined until apply.", providerConfigAddr),
+		))
+		return nil, diags
+	}
+
+	provider, configureDiags := evalCtx.Providers().NewConfiguredProvider(ctx, providerConfigAddr.Provider, providerConfigVal)
+	diags = diags.Append(configureDiags)
+	return provider, diags
+}
+
+// evalProviderFunctions returns a [lang.ProviderFunction] that makes the
+// functions declared in provider schemas callable from expressions evaluated
+// in the console, including functions that are not referenced anywhere in the
+// configuration.
+//
+// The provider instances used during the eval walk are closed once the walk
+// is complete, so each call instead starts and then closes a separate
+// unconfigured provider instance. Functions that a provider offers only once
+// configured are therefore not available here.
+func evalProviderFunctions(evalCtx EvalContext, config *configs.Config, moduleAddr addrs.ModuleInstance) lang.ProviderFunction {
+	return func(ctx context.Context, pf addrs.ProviderFunction, rng tfdiags.SourceRange) (*function.Function, tfdiags.Diagnostics) {
+		var diags tfdiags.Diagnostics
+
+		modCfg := config.DescendentForInstance(moduleAddr)
+		if modCfg == nil {
+			// Should not get here, because the caller would've failed to
+			// build the evaluation scope for this module.
+			return nil, diags.Append(fmt.Errorf("no configuration for %s", moduleAddr))
+		}
+
+		providerAddr := modCfg.Module.ProviderForLocalConfig(addrs.LocalProviderConfig{LocalName: pf.ProviderName})
+		if !evalCtx.Providers().HasProvider(providerAddr) {
+			return nil, diags.Append(&hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Provider not available",
+				Detail:   fmt.Sprintf("The provider %s is not available, so the function %q cannot be called. Declare it in the configuration and run \"tofu init\" to install it.", providerAddr, pf),
+				Subject:  rng.ToHCL().Ptr(),
+			})
+		}
+
+		providerSchema, schemaDiags := evalCtx.Providers().GetProviderSchema(ctx, providerAddr)
+		diags = diags.Append(schemaDiags)
+		if diags.HasErrors() {
+			return nil, diags
+		}
+		spec, ok := providerSchema.Functions[pf.Function]
+		if !ok {
+			return nil, diags.Append(&hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Function not found in provider",
+				Detail:   fmt.Sprintf("Function %q was not registered by provider", pf),
+				Subject:  rng.ToHCL().Ptr(),
+			})
+		}
+
+		// The function returned by providerFunction is used here only as a
+		// template for the signature, because its provider is started on
+		// demand for each call.
+		sig := providerFunction(ctx, pf.Function, spec, nil)
+		fn := function.New(&function.Spec{
+			Description: sig.Description(),
+			Params:      sig.Params(),
+			VarParam:    sig.VarParam(),
+			Type:        function.StaticReturnType(spec.Return),
+			Impl: func(args []cty.Value, retType cty.Type) (cty.Value, error) {
+				provider, diags := evalCtx.Providers().NewProvider(ctx, providerAddr)
+				if diags.HasErrors() {
+					return cty.UnknownVal(retType), diags.Err()
+				}
+				defer func() {
+					if err := provider.Close(context.WithoutCancel(ctx)); err != nil {
+						log.Printf("[WARN] evalProviderFunctions: failed to close %s: %s", providerAddr, err)
+					}
+				}()
+				return providerFunction(ctx, pf.Function, spec, provider).Call(args)
+			},
+		})
+		return &fn, diags
+	}
+}
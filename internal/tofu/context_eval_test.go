@@ -7,6 +7,7 @@ package tofu
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/hcl/v2"
@@ -135,3 +136,140 @@ output "out" {
 	})
 	assertNoErrors(t, diags)
 }
+
+func TestContextEval_dataSourceFunction(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+variable "region" {
+  type    = string
+  default = "us-east-1"
+}
+
+provider "test" {
+  test_string = var.region
+}
+`,
+	})
+
+	p := simpleMockProvider()
+	p.ReadDataSourceFn = func(req providers.ReadDataSourceRequest) providers.ReadDataSourceResponse {
+		if !p.ConfigureProviderCalled {
+			t.Errorf("data source read before the provider was configured")
+		}
+		state := req.Config.AsValueMap()
+		state["test_string"] = cty.StringVal(p.ConfigureProviderRequest.Config.GetAttr("test_string").AsString() + "/" + req.Config.GetAttr("test_string").AsString())
+		return providers.ReadDataSourceResponse{
+			State: cty.ObjectVal(state),
+		}
+	}
+	ctx := testContext2(t, &ContextOpts{
+		Plugins: plugins.NewLibrary(map[addrs.Provider]providers.Factory{
+			addrs.NewDefaultProvider("test"): testProviderFuncFixed(p),
+		}, nil),
+	})
+
+	scope, diags := ctx.Eval(context.Background(), m, states.NewState(), addrs.RootModuleInstance, &EvalOpts{
+		SetVariables: testInputValuesUnset(m.Module.Variables),
+	})
+	if diags.HasErrors() {
+		t.Fatalf("Eval errors: %s", diags.Err())
+	}
+	scope.ConsoleMode = true
+
+	t.Run("valid", func(t *testing.T) {
+		expr, _ := hclsyntax.ParseExpression([]byte(`data("test_object", { test_string = "foo", test_list = ["bar"] })`), "<test-input>", hcl.Pos{Line: 1, Column: 1})
+		got, diags := scope.EvalExpr(t.Context(), expr, cty.DynamicPseudoType)
+		if diags.HasErrors() {
+			t.Fatalf("unexpected error: %s", diags.Err())
+		}
+
+		want := cty.ObjectVal(map[string]cty.Value{
+			"test_string": cty.StringVal("us-east-1/foo"),
+			"test_number": cty.NullVal(cty.Number),
+			"test_bool":   cty.NullVal(cty.Bool),
+			"test_list":   cty.ListVal([]cty.Value{cty.StringVal("bar")}),
+			"test_map":    cty.NullVal(cty.Map(cty.String)),
+		})
+		if !got.RawEquals(want) {
+			t.Fatalf("wrong result\ngot:  %#v\nwant: %#v", got, want)
+		}
+		if !p.CloseCalled {
+			t.Errorf("provider was not closed after reading the data source")
+		}
+	})
+
+	t.Run("unsupported type", func(t *testing.T) {
+		expr, _ := hclsyntax.ParseExpression([]byte(`data("test_nonexist", {})`), "<test-input>", hcl.Pos{Line: 1, Column: 1})
+		_, diags := scope.EvalExpr(t.Context(), expr, cty.DynamicPseudoType)
+		if !diags.HasErrors() {
+			t.Fatal("succeeded; want error")
+		}
+		if got, want := diags.Err().Error(), `does not support data source "test_nonexist"`; !strings.Contains(got, want) {
+			t.Fatalf("wrong error\ngot:  %s\nwant: message containing %q", got, want)
+		}
+	})
+
+	t.Run("invalid configuration", func(t *testing.T) {
+		expr, _ := hclsyntax.ParseExpression([]byte(`data("test_object", { nonexist = true })`), "<test-input>", hcl.Pos{Line: 1, Column: 1})
+		_, diags := scope.EvalExpr(t.Context(), expr, cty.DynamicPseudoType)
+		if !diags.HasErrors() {
+			t.Fatal("succeeded; want error")
+		}
+		if got, want := diags.Err().Error(), `unexpected attribute "nonexist"`; !strings.Contains(got, want) {
+			t.Fatalf("wrong error\ngot:  %s\nwant: message containing %q", got, want)
+		}
+	})
+}
+
+func TestContextEval_providerFunction(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+terraform {
+  required_providers {
+    test = {
+      source = "hashicorp/test"
+    }
+  }
+}
+`,
+	})
+
+	p := simpleMockProvider()
+	p.GetProviderSchemaResponse.Functions = map[string]providers.FunctionSpec{
+		"upper": {
+			Parameters: []providers.FunctionParameterSpec{
+				{
+					Name: "in",
+					Type: cty.String,
+				},
+			},
+			Return: cty.String,
+		},
+	}
+	p.CallFunctionFn = func(req providers.CallFunctionRequest) providers.CallFunctionResponse {
+		return providers.CallFunctionResponse{
+			Result: cty.StringVal(strings.ToUpper(req.Arguments[0].AsString())),
+		}
+	}
+	ctx := testContext2(t, &ContextOpts{
+		Plugins: plugins.NewLibrary(map[addrs.Provider]providers.Factory{
+			addrs.NewDefaultProvider("test"): testProviderFuncFixed(p),
+		}, nil),
+	})
+
+	scope, diags := ctx.Eval(context.Background(), m, states.NewState(), addrs.RootModuleInstance, &EvalOpts{})
+	if diags.HasErrors() {
+		t.Fatalf("Eval errors: %s", diags.Err())
+	}
+
+	// The function isn't referenced anywhere in the configuration, but it
+	// should still be callable in the console.
+	expr, _ := hclsyntax.ParseExpression([]byte(`provider::test::upper("foo")`), "<test-input>", hcl.Pos{Line: 1, Column: 1})
+	got, diags := scope.EvalExpr(t.Context(), expr, cty.DynamicPseudoType)
+	if diags.HasErrors() {
+		t.Fatalf("unexpected error: %s", diags.Err())
+	}
+	if want := cty.StringVal("FOO"); !got.RawEquals(want) {
+		t.Fatalf("wrong result\ngot:  %#v\nwant: %#v", got, want)
+	}
+}
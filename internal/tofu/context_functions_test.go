@@ -15,8 +15,10 @@ import (
 	"github.com/opentofu/opentofu/internal/addrs"
 	"github.com/opentofu/opentofu/internal/configs/configschema"
 	"github.com/opentofu/opentofu/internal/lang/marks"
+	"github.com/opentofu/opentofu/internal/plans"
 	"github.com/opentofu/opentofu/internal/plugins"
 	"github.com/opentofu/opentofu/internal/providers"
+	"github.com/opentofu/opentofu/internal/states"
 	"github.com/opentofu/opentofu/internal/tfdiags"
 	"github.com/zclconf/go-cty/cty"
 	"github.com/zclconf/go-cty/cty/function"
@@ -821,3 +823,178 @@ variable "value" { }
 		t.Fatalf("Expected function call")
 	}
 }
+
+// Provider functions called in variable default values
+func TestContext2Functions_providerFunctionsVariableDefault(t *testing.T) {
+	p := testProvider("aws")
+	p.GetProviderSchemaResponse = &providers.GetProviderSchemaResponse{
+		Functions: map[string]providers.FunctionSpec{
+			"arn_parse": providers.FunctionSpec{
+				Parameters: []providers.FunctionParameterSpec{{
+					Name: "arn",
+					Type: cty.String,
+				}},
+				Return: cty.Object(map[string]cty.Type{"service": cty.String}),
+			},
+		},
+	}
+	p.CallFunctionFn = func(req providers.CallFunctionRequest) providers.CallFunctionResponse {
+		service := strings.Split(req.Arguments[0].AsString(), ":")[2]
+		return providers.CallFunctionResponse{
+			Result: cty.ObjectVal(map[string]cty.Value{"service": cty.StringVal(service)}),
+		}
+	}
+
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+terraform {
+  required_providers {
+    aws = ">=5.70.0"
+  }
+}
+
+variable "service" {
+  type    = string
+  default = provider::aws::arn_parse("arn:aws:s3:::root").service
+}
+
+module "mod" {
+  source = "./mod"
+}
+
+output "root" {
+  value = var.service
+}
+
+output "mod" {
+  value = module.mod.service
+}
+`,
+		"mod/mod.tf": `
+terraform {
+  required_providers {
+    aws = ">=5.70.0"
+  }
+}
+
+variable "service" {
+  type    = string
+  default = provider::aws::arn_parse("arn:aws:iam:::child").service
+
+  validation {
+    condition     = var.service == provider::aws::arn_parse("arn:aws:iam:::other").service
+    error_message = "Must be an IAM ARN."
+  }
+}
+
+output "service" {
+  value = var.service
+}
+`,
+	})
+
+	ctx := testContext2(t, &ContextOpts{
+		Plugins: plugins.NewLibrary(map[addrs.Provider]providers.Factory{
+			addrs.NewDefaultProvider("aws"): testProviderFuncFixed(p),
+		}, nil),
+	})
+
+	diags := ctx.Validate(context.Background(), m)
+	if diags.HasErrors() {
+		t.Fatal(diags.Err())
+	}
+
+	plan, diags := ctx.Plan(context.Background(), m, states.NewState(), &PlanOpts{
+		Mode:         plans.NormalMode,
+		SetVariables: testInputValuesUnset(m.Module.Variables),
+	})
+	if diags.HasErrors() {
+		t.Fatal(diags.Err())
+	}
+
+	for name, want := range map[string]cty.Value{
+		"root": cty.StringVal("s3"),
+		"mod":  cty.StringVal("iam"),
+	} {
+		outChangeSrc := plan.Changes.OutputValue(addrs.RootModuleInstance.OutputValue(name))
+		if outChangeSrc == nil {
+			t.Errorf("no change planned for output value %q", name)
+			continue
+		}
+		outChange, err := outChangeSrc.Decode()
+		if err != nil {
+			t.Fatalf("failed to decode output value %q: %s", name, err)
+		}
+		if got := outChange.After; !want.RawEquals(got) {
+			t.Errorf("wrong value for output value %q\ngot:  %#v\nwant: %#v", name, got, want)
+		}
+	}
+}
+
+// A set variable doesn't depend on the provider for its default value
+func TestContext2Functions_providerFunctionsVariableDefaultOverridden(t *testing.T) {
+	p := testProvider("aws")
+	p.GetProviderSchemaResponse = &providers.GetProviderSchemaResponse{
+		Functions: map[string]providers.FunctionSpec{
+			"arn_parse": providers.FunctionSpec{
+				Parameters: []providers.FunctionParameterSpec{{
+					Name: "arn",
+					Type: cty.String,
+				}},
+				Return: cty.String,
+			},
+		},
+	}
+	p.CallFunctionResponse = &providers.CallFunctionResponse{
+		Result: cty.StringVal("from-default"),
+	}
+
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+terraform {
+  required_providers {
+    aws = ">=5.70.0"
+  }
+}
+
+variable "service" {
+  type    = string
+  default = provider::aws::arn_parse("arn:aws:s3:::root")
+}
+
+output "root" {
+  value = var.service
+}
+`,
+	})
+
+	ctx := testContext2(t, &ContextOpts{
+		Plugins: plugins.NewLibrary(map[addrs.Provider]providers.Factory{
+			addrs.NewDefaultProvider("aws"): testProviderFuncFixed(p),
+		}, nil),
+	})
+
+	plan, diags := ctx.Plan(context.Background(), m, states.NewState(), &PlanOpts{
+		Mode: plans.NormalMode,
+		SetVariables: InputValues{
+			"service": &InputValue{
+				Value:      cty.StringVal("from-caller"),
+				SourceType: ValueFromCLIArg,
+			},
+		},
+	})
+	if diags.HasErrors() {
+		t.Fatal(diags.Err())
+	}
+	if p.CallFunctionCalled {
+		t.Error("unexpected call to the provider function for an unused default")
+	}
+
+	outChange, err := plan.Changes.OutputValue(addrs.RootModuleInstance.OutputValue("root")).Decode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := outChange.After, cty.StringVal("from-caller"); !want.RawEquals(got) {
+		t.Errorf("wrong value for output value\ngot:  %#v\nwant: %#v", got, want)
+	}
+}
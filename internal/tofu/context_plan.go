@@ -44,6 +44,13 @@ type PlanOpts struct {
 	// instance using its corresponding provider.
 	SkipRefresh bool
 
+	// If RefreshTargets has a non-zero length then OpenTofu refreshes only
+	// the managed resource instances mentioned in this set, and trusts the
+	// current values in the prior state for all of the others as if
+	// SkipRefresh were set for them. It has no effect if SkipRefresh is set,
+	// and is used only in the normal and destroy planning modes.
+	RefreshTargets []addrs.Targetable
+
 	// PreDestroyRefresh indicated that this is being passed to a plan used to
 	// refresh the state immediately before a destroy plan.
 	// FIXME: This is a temporary fix to allow the pre-destroy refresh to
@@ -91,6 +98,11 @@ type PlanOpts struct {
 	// fully-functional new object.
 	ForceReplace []addrs.AbsResourceInstance
 
+	// ForceReplaceReason is an optional explanation of why the instances in
+	// ForceReplace are being replaced, which is recorded in the resulting
+	// plan for the benefit of anyone reviewing it.
+	ForceReplaceReason string
+
 	// ExternalReferences allows the external caller to pass in references to
 	// nodes that should not be pruned even if they are not referenced within
 	// the actual graph.
@@ -275,6 +287,10 @@ The -target and -exclude options are not for routine use, and are provided only
 		plan.EphemeralVariables = config.Module.EphemeralVariablesHints()
 		plan.TargetAddrs = opts.Targets
 		plan.ExcludeAddrs = opts.Excludes
+		plan.ForceReplaceAddrs = opts.ForceReplace
+		if len(opts.ForceReplace) > 0 {
+			plan.ForceReplaceReason = opts.ForceReplaceReason
+		}
 	} else if !diags.HasErrors() {
 		panic("nil plan but no errors")
 	}
@@ -881,6 +897,7 @@ func (c *Context) planGraph(ctx context.Context, config *configs.Config, prevRun
 			Excludes:                opts.Excludes,
 			ForceReplace:            opts.ForceReplace,
 			skipRefresh:             opts.SkipRefresh,
+			refreshTargets:          opts.RefreshTargets,
 			preDestroyRefresh:       opts.PreDestroyRefresh,
 			Operation:               walkPlan,
 			ExternalReferences:      opts.ExternalReferences,
@@ -16,6 +16,7 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/google/go-cmp/cmp"
@@ -334,6 +335,76 @@ resource "test_object" "a" {
 	assertNoErrors(t, diags)
 }
 
+func TestContext2Plan_propagateProviders(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+provider "test" {
+  alias = "z"
+  test_string = "config"
+}
+
+module "mid" {
+  source = "./mid"
+  providers = {
+    test.x = test.z
+  }
+  propagate_providers = true
+}
+`,
+
+		// The module in between doesn't declare test.x at all.
+		"mid/main.tf": `
+module "leaf" {
+  source = "../leaf"
+}
+`,
+
+		"leaf/main.tf": `
+terraform {
+  required_providers {
+    test = {
+      source = "registry.opentofu.org/hashicorp/test"
+      configuration_aliases = [ test.x ]
+	}
+  }
+}
+
+resource "test_object" "a" {
+  provider = test.x
+}
+`,
+	})
+
+	p := simpleMockProvider()
+
+	// The resource within the module should be using the provider configured
+	// from the root module. We should never see an empty configuration.
+	p.ConfigureProviderFn = func(req providers.ConfigureProviderRequest) (resp providers.ConfigureProviderResponse) {
+		if req.Config.GetAttr("test_string").IsNull() {
+			resp.Diagnostics = resp.Diagnostics.Append(errors.New("missing test_string value"))
+		}
+		return resp
+	}
+
+	ctx := testContext2(t, &ContextOpts{
+		Plugins: plugins.NewLibrary(map[addrs.Provider]providers.Factory{
+			addrs.NewDefaultProvider("test"): testProviderFuncFixed(p),
+		}, nil),
+	})
+
+	plan, diags := ctx.Plan(context.Background(), m, states.NewState(), DefaultPlanOpts)
+	assertNoErrors(t, diags)
+
+	addr := mustResourceInstanceAddr("module.mid.module.leaf.test_object.a")
+	rc := plan.Changes.ResourceInstance(addr)
+	if rc == nil {
+		t.Fatalf("no planned change for %s", addr)
+	}
+	if got, want := rc.ProviderAddr.String(), `provider["registry.opentofu.org/hashicorp/test"].z`; got != want {
+		t.Errorf("wrong provider for %s\ngot:  %s\nwant: %s", addr, got, want)
+	}
+}
+
 func TestContext2Plan_dataReferencesResourceInModules(t *testing.T) {
 	p := testProvider("test")
 	p.ReadDataSourceFn = func(req providers.ReadDataSourceRequest) (resp providers.ReadDataSourceResponse) {
@@ -6764,6 +6835,192 @@ import {
 	}
 }
 
+func TestContext2Plan_relinkByIdentity(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+resource "test_instance" "foo" {
+  name = "my-resource"
+}
+`,
+	})
+
+	p := testProvider("test")
+	p.GetProviderSchemaResponse = &providers.GetProviderSchemaResponse{
+		ResourceTypes: map[string]providers.Schema{
+			"test_instance": {
+				Block: &configschema.Block{
+					Attributes: map[string]*configschema.Attribute{
+						"id":   {Type: cty.String, Computed: true},
+						"name": {Type: cty.String, Optional: true},
+					},
+				},
+				IdentitySchema: &configschema.Object{
+					Attributes: map[string]*configschema.Attribute{
+						"name": {Type: cty.String, Required: true},
+					},
+					Nesting: configschema.NestingSingle,
+				},
+			},
+		},
+	}
+	p.ReadResourceFn = func(req providers.ReadResourceRequest) providers.ReadResourceResponse {
+		// The object recorded in state was deleted, and then recreated
+		// outside of OpenTofu with a new ID.
+		if req.PriorState.GetAttr("id").AsString() == "old-id" {
+			return providers.ReadResourceResponse{
+				NewState: cty.NullVal(req.PriorState.Type()),
+			}
+		}
+		return providers.ReadResourceResponse{
+			NewState:    req.PriorState,
+			NewIdentity: req.PriorIdentity,
+		}
+	}
+	p.ImportResourceStateFn = func(req providers.ImportResourceStateRequest) providers.ImportResourceStateResponse {
+		return providers.ImportResourceStateResponse{
+			ImportedResources: []providers.ImportedResource{
+				{
+					TypeName: "test_instance",
+					State: cty.ObjectVal(map[string]cty.Value{
+						"id":   cty.StringVal("new-id"),
+						"name": req.Target.Identity.GetAttr("name"),
+					}),
+					Identity: req.Target.Identity,
+				},
+			},
+		}
+	}
+
+	addr := mustResourceInstanceAddr("test_instance.foo")
+	state := states.BuildState(func(s *states.SyncState) {
+		s.SetResourceInstanceCurrent(addr, &states.ResourceInstanceObjectSrc{
+			AttrsJSON:    []byte(`{"id":"old-id","name":"my-resource"}`),
+			IdentityJSON: []byte(`{"name":"my-resource"}`),
+			Status:       states.ObjectReady,
+		}, mustProviderConfig(`provider["registry.opentofu.org/hashicorp/test"]`), addrs.NoKey)
+	})
+
+	ctx := testContext2(t, &ContextOpts{
+		Plugins: plugins.NewLibrary(map[addrs.Provider]providers.Factory{
+			addrs.NewDefaultProvider("test"): testProviderFuncFixed(p),
+		}, nil),
+	})
+
+	plan, diags := ctx.Plan(context.Background(), m, state, DefaultPlanOpts)
+	assertNoErrors(t, diags)
+
+	if !p.ImportResourceStateCalled {
+		t.Fatal("ImportResourceState wasn't called; should've been")
+	}
+	if got, want := p.ImportResourceStateRequest.Target.Identity, cty.ObjectVal(map[string]cty.Value{"name": cty.StringVal("my-resource")}); !got.RawEquals(want) {
+		t.Errorf("wrong import identity\ngot:  %#v\nwant: %#v", got, want)
+	}
+	var warned bool
+	for _, diag := range diags {
+		if diag.Severity() == tfdiags.Warning && diag.Description().Summary == "Resource re-linked by identity" {
+			warned = true
+		}
+	}
+	if !warned {
+		t.Errorf("missing warning about re-linking the resource")
+	}
+
+	change := plan.Changes.ResourceInstance(addr)
+	if change == nil {
+		t.Fatalf("no planned change for %s", addr)
+	}
+	if got, want := change.Action, plans.NoOp; got != want {
+		t.Errorf("wrong action %s; want %s", got, want)
+	}
+
+	refreshed := plan.PriorState.ResourceInstance(addr)
+	if refreshed == nil || refreshed.Current == nil {
+		t.Fatalf("no refreshed state for %s", addr)
+	}
+	if got, want := string(refreshed.Current.AttrsJSON), `{"id":"new-id","name":"my-resource"}`; got != want {
+		t.Errorf("wrong refreshed object\ngot:  %s\nwant: %s", got, want)
+	}
+}
+
+func TestContext2Plan_importIdentityAlreadyManaged(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+resource "test_instance" "foo" {
+}
+
+resource "test_instance" "bar" {
+}
+
+import {
+  to = test_instance.bar
+  id = "123"
+}
+`,
+	})
+
+	p := testProvider("test")
+	p.GetProviderSchemaResponse = &providers.GetProviderSchemaResponse{
+		ResourceTypes: map[string]providers.Schema{
+			"test_instance": {
+				Block: &configschema.Block{
+					Attributes: map[string]*configschema.Attribute{
+						"id": {Type: cty.String, Computed: true},
+					},
+				},
+				IdentitySchema: &configschema.Object{
+					Attributes: map[string]*configschema.Attribute{
+						"id": {Type: cty.String, Required: true},
+					},
+					Nesting: configschema.NestingSingle,
+				},
+			},
+		},
+	}
+	p.ImportResourceStateFn = func(req providers.ImportResourceStateRequest) providers.ImportResourceStateResponse {
+		return providers.ImportResourceStateResponse{
+			ImportedResources: []providers.ImportedResource{
+				{
+					TypeName: "test_instance",
+					State: cty.ObjectVal(map[string]cty.Value{
+						"id": cty.StringVal(req.Target.ID),
+					}),
+					Identity: cty.ObjectVal(map[string]cty.Value{
+						"id": cty.StringVal(req.Target.ID),
+					}),
+				},
+			},
+		}
+	}
+	p.ReadResourceFn = func(req providers.ReadResourceRequest) providers.ReadResourceResponse {
+		return providers.ReadResourceResponse{
+			NewState:    req.PriorState,
+			NewIdentity: req.PriorIdentity,
+		}
+	}
+
+	state := states.BuildState(func(s *states.SyncState) {
+		s.SetResourceInstanceCurrent(mustResourceInstanceAddr("test_instance.foo"), &states.ResourceInstanceObjectSrc{
+			AttrsJSON:    []byte(`{"id":"123"}`),
+			IdentityJSON: []byte(`{"id":"123"}`),
+			Status:       states.ObjectReady,
+		}, mustProviderConfig(`provider["registry.opentofu.org/hashicorp/test"]`), addrs.NoKey)
+	})
+
+	ctx := testContext2(t, &ContextOpts{
+		Plugins: plugins.NewLibrary(map[addrs.Provider]providers.Factory{
+			addrs.NewDefaultProvider("test"): testProviderFuncFixed(p),
+		}, nil),
+	})
+
+	_, diags := ctx.Plan(context.Background(), m, state, DefaultPlanOpts)
+	if !diags.HasErrors() {
+		t.Fatal("succeeded; want error")
+	}
+	if got, want := diags.Err().Error(), "same resource identity as the object already tracked by test_instance.foo"; !strings.Contains(got, want) {
+		t.Fatalf("wrong error\ngot:  %s\nwant: message containing %q", got, want)
+	}
+}
+
 func TestContext2Plan_importIdFunc(t *testing.T) {
 	p := testProvider("aws")
 	m := testModule(t, "import-id-func")
@@ -9089,3 +9346,606 @@ func TestContext2Plan_moduleDependsOnWithCheck(t *testing.T) {
 		t.Fatalf("unexpected errors: %s", diags.Err())
 	}
 }
+
+func TestContext2Plan_disabledFunctions(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+locals {
+  greeting = upper("hello")
+  secret   = pathexpand("~/.ssh/id_rsa")
+}
+
+output "greeting" {
+  value = local.greeting
+}
+`,
+	})
+
+	ctx := testContext2(t, &ContextOpts{
+		DisabledFunctions: []string{"pathexpand"},
+	})
+
+	_, diags := ctx.Plan(context.Background(), m, states.NewState(), DefaultPlanOpts)
+	if !diags.HasErrors() {
+		t.Fatal("unexpected success; want error about disabled function")
+	}
+	if got, want := diags.Err().Error(), `the function "pathexpand" has been disabled in the CLI configuration for this environment`; !strings.Contains(got, want) {
+		t.Errorf("wrong error\ngot:  %s\nwant: %s", got, want)
+	}
+}
+
+func TestContext2Plan_forEachKey(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+locals {
+  prefix = "item"
+  items = [
+    { name = "small", size = 1 },
+    { name = "large", size = 10 },
+  ]
+}
+
+resource "test_object" "a" {
+  for_each     = local.items
+  for_each_key = "${local.prefix}-${each.value.name}"
+
+  test_string = each.key
+  test_number = each.value.size
+}
+
+module "child" {
+  source       = "./child"
+  for_each     = toset(local.items)
+  for_each_key = each.value.name
+
+  size = each.value.size
+}
+`,
+		"child/main.tf": `
+variable "size" {
+  type = number
+}
+
+resource "test_object" "b" {
+  test_number = var.size
+}
+`,
+	})
+
+	p := simpleMockProvider()
+	ctx := testContext2(t, &ContextOpts{
+		Plugins: plugins.NewLibrary(map[addrs.Provider]providers.Factory{
+			addrs.NewDefaultProvider("test"): testProviderFuncFixed(p),
+		}, nil),
+	})
+
+	diags := ctx.Validate(context.Background(), m)
+	assertNoErrors(t, diags)
+
+	plan, diags := ctx.Plan(context.Background(), m, states.NewState(), DefaultPlanOpts)
+	assertNoErrors(t, diags)
+
+	schema := p.GetProviderSchemaResponse.ResourceTypes["test_object"]
+	wantNumbers := map[string]int64{
+		`test_object.a["item-small"]`:         1,
+		`test_object.a["item-large"]`:         10,
+		`module.child["small"].test_object.b`: 1,
+		`module.child["large"].test_object.b`: 10,
+	}
+	if got, want := len(plan.Changes.Resources), len(wantNumbers); got != want {
+		t.Fatalf("expected %d changes, got %d", want, got)
+	}
+	for addr, want := range wantNumbers {
+		change := plan.Changes.ResourceInstance(mustResourceInstanceAddr(addr))
+		if change == nil {
+			t.Fatalf("no planned change for %s", addr)
+		}
+		decoded, err := change.Decode(&schema)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, _ := decoded.After.GetAttr("test_number").AsBigFloat().Int64()
+		if got != want {
+			t.Errorf("wrong test_number for %s: got %d, want %d", addr, got, want)
+		}
+	}
+
+	change := plan.Changes.ResourceInstance(mustResourceInstanceAddr(`test_object.a["item-small"]`))
+	decoded, err := change.Decode(&schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := decoded.After.GetAttr("test_string"), cty.StringVal("item-small"); !got.RawEquals(want) {
+		t.Errorf("wrong each.key\ngot:  %#v\nwant: %#v", got, want)
+	}
+}
+
+func TestContext2Plan_forEachKeyDuplicate(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+resource "test_object" "a" {
+  for_each     = [{ name = "a" }, { name = "b" }, { name = "a" }]
+  for_each_key = each.value.name
+}
+`,
+	})
+
+	p := simpleMockProvider()
+	ctx := testContext2(t, &ContextOpts{
+		Plugins: plugins.NewLibrary(map[addrs.Provider]providers.Factory{
+			addrs.NewDefaultProvider("test"): testProviderFuncFixed(p),
+		}, nil),
+	})
+
+	_, diags := ctx.Plan(context.Background(), m, states.NewState(), DefaultPlanOpts)
+	if !diags.HasErrors() {
+		t.Fatal("succeeded; want errors")
+	}
+	if got, want := diags.Err().Error(), `Duplicate for_each key: The "for_each_key" expression produced the key "a" for more than one element`; !strings.Contains(got, want) {
+		t.Errorf("wrong error\ngot:  %s\nwant: error containing %q", got, want)
+	}
+}
+
+func TestContext2Plan_unknownCauses(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+resource "test_object" "a" {
+}
+
+locals {
+  id = "prefix-${test_object.a.id}"
+}
+
+resource "test_object" "b" {
+  test_string = local.id
+  test_number = 1
+}
+
+module "child" {
+  source = "./child"
+  id     = test_object.a.id
+}
+
+resource "test_object" "c" {
+  test_string = module.child.id
+}
+
+check "known" {
+  assert {
+    condition     = length(local.id) > 10
+    error_message = "The ID is too short."
+  }
+}
+`,
+		"child/main.tf": `
+variable "id" {
+  type = string
+}
+
+output "id" {
+  value = var.id
+}
+`,
+	})
+
+	p := simpleMockProvider()
+	p.GetProviderSchemaResponse = &providers.GetProviderSchemaResponse{
+		ResourceTypes: map[string]providers.Schema{
+			"test_object": {
+				Block: &configschema.Block{
+					Attributes: map[string]*configschema.Attribute{
+						"id":          {Type: cty.String, Computed: true},
+						"test_string": {Type: cty.String, Optional: true},
+						"test_number": {Type: cty.Number, Optional: true},
+					},
+				},
+			},
+		},
+	}
+	ctx := testContext2(t, &ContextOpts{
+		Plugins: plugins.NewLibrary(map[addrs.Provider]providers.Factory{
+			addrs.NewDefaultProvider("test"): testProviderFuncFixed(p),
+		}, nil),
+	})
+
+	plan, diags := ctx.Plan(context.Background(), m, states.NewState(), DefaultPlanOpts)
+	assertNoErrors(t, diags)
+
+	for addr, want := range map[string]map[string][]string{
+		"test_object.a": nil,
+		"test_object.b": {"test_string": {"test_object.a.id"}},
+		"test_object.c": {"test_string": {"test_object.a.id"}},
+	} {
+		change := plan.Changes.ResourceInstance(mustResourceInstanceAddr(addr))
+		if change == nil {
+			t.Fatalf("no planned change for %s", addr)
+		}
+		if diff := cmp.Diff(want, change.UnknownCauses); diff != "" {
+			t.Errorf("wrong unknown causes for %s\n%s", addr, diff)
+		}
+	}
+
+	var found bool
+	for _, diag := range diags {
+		if diag.Severity() == tfdiags.Warning && strings.Contains(diag.Description().Detail, "The condition depends on test_object.a.id, which will be known only after apply.") {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("missing check assertion warning naming the unknown cause\n%s", diags.ErrWithWarnings())
+	}
+}
+
+func TestContext2Plan_moduleExpansionUnknown(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+resource "test_object" "a" {
+}
+
+locals {
+  enabled = test_object.a.id != ""
+}
+
+module "source" {
+  source = "./source"
+}
+
+module "counted" {
+  source = "./empty"
+  count  = local.enabled ? 1 : 0
+}
+
+module "each" {
+  source   = "./empty"
+  for_each = toset([module.source.id, "static"])
+}
+`,
+		"source/main.tf": `
+resource "test_object" "b" {
+}
+
+output "id" {
+  value = test_object.b.id
+}
+`,
+		"empty/main.tf": ``,
+	})
+
+	p := simpleMockProvider()
+	p.GetProviderSchemaResponse = &providers.GetProviderSchemaResponse{
+		ResourceTypes: map[string]providers.Schema{
+			"test_object": {
+				Block: &configschema.Block{
+					Attributes: map[string]*configschema.Attribute{
+						"id": {Type: cty.String, Computed: true},
+					},
+				},
+			},
+		},
+	}
+	ctx := testContext2(t, &ContextOpts{
+		Plugins: plugins.NewLibrary(map[addrs.Provider]providers.Factory{
+			addrs.NewDefaultProvider("test"): testProviderFuncFixed(p),
+		}, nil),
+	})
+
+	_, diags := ctx.Plan(context.Background(), m, states.NewState(), DefaultPlanOpts)
+	if !diags.HasErrors() {
+		t.Fatal("succeeded; want errors")
+	}
+
+	want := map[string]*tfdiags.UnknownExpansion{
+		"module.counted": {
+			Addr:          "module.counted",
+			Argument:      "count",
+			UnknownValues: []string{"test_object.a.id"},
+			Targets:       []string{"test_object.a"},
+		},
+		"module.each": {
+			Addr:          "module.each",
+			Argument:      "for_each",
+			UnknownValues: []string{"module.source.test_object.b.id"},
+			Targets:       []string{"module.source.test_object.b"},
+		},
+	}
+	got := make(map[string]*tfdiags.UnknownExpansion)
+	for _, diag := range diags {
+		if expansion := tfdiags.DiagnosticUnknownExpansion(diag); expansion != nil {
+			got[expansion.Addr] = expansion
+			if !tfdiags.DiagnosticCausedByUnknown(diag) {
+				t.Errorf("diagnostic for %s is not marked as caused by unknown values", expansion.Addr)
+			}
+		}
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("wrong unknown expansions\n%s\n\nall diagnostics:\n%s", diff, diags.Err())
+	}
+}
+
+func TestContext2Plan_refreshTargets(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+resource "test_object" "a" {
+  test_string = "a"
+}
+
+resource "test_object" "b" {
+  count       = 2
+  test_string = "b${count.index}"
+}
+
+module "child" {
+  source = "./child"
+}
+`,
+		"child/main.tf": `
+resource "test_object" "c" {
+  test_string = "c"
+}
+`,
+	})
+
+	state := states.BuildState(func(s *states.SyncState) {
+		for addr, arg := range map[string]string{
+			"test_object.a":              "a",
+			"test_object.b[0]":           "b0",
+			"test_object.b[1]":           "b1",
+			"module.child.test_object.c": "c",
+		} {
+			s.SetResourceInstanceCurrent(mustResourceInstanceAddr(addr), &states.ResourceInstanceObjectSrc{
+				AttrsJSON: []byte(fmt.Sprintf(`{"test_string":%q}`, arg)),
+				Status:    states.ObjectReady,
+			}, mustProviderConfig(`provider["registry.opentofu.org/hashicorp/test"]`), addrs.NoKey)
+		}
+	})
+
+	for _, mode := range []plans.Mode{plans.NormalMode, plans.DestroyMode} {
+		t.Run(mode.String(), func(t *testing.T) {
+			p := simpleMockProvider()
+			var mu sync.Mutex
+			var refreshed []string
+			p.ReadResourceFn = func(req providers.ReadResourceRequest) (resp providers.ReadResourceResponse) {
+				mu.Lock()
+				defer mu.Unlock()
+				refreshed = append(refreshed, req.PriorState.GetAttr("test_string").AsString())
+				resp.NewState = req.PriorState
+				return resp
+			}
+			ctx := testContext2(t, &ContextOpts{
+				Plugins: plugins.NewLibrary(map[addrs.Provider]providers.Factory{
+					addrs.NewDefaultProvider("test"): testProviderFuncFixed(p),
+				}, nil),
+			})
+
+			_, diags := ctx.Plan(context.Background(), m, state, &PlanOpts{
+				Mode: mode,
+				RefreshTargets: []addrs.Targetable{
+					mustResourceInstanceAddr("test_object.b[1]"),
+					addrs.RootModuleInstance.Child("child", addrs.NoKey),
+				},
+			})
+			assertNoErrors(t, diags)
+
+			slices.Sort(refreshed)
+			if diff := cmp.Diff([]string{"b1", "c"}, refreshed); diff != "" {
+				t.Errorf("wrong refreshed objects\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestContext2Plan_providerReadRetries(t *testing.T) {
+	defer func(delay time.Duration) { providerReadRetryBaseDelay = delay }(providerReadRetryBaseDelay)
+	providerReadRetryBaseDelay = 0
+
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+data "test_object" "a" {
+  test_string = "foo"
+}
+
+resource "test_object" "b" {
+  test_string = "bar"
+}
+`,
+	})
+
+	state := states.BuildState(func(s *states.SyncState) {
+		s.SetResourceInstanceCurrent(mustResourceInstanceAddr(`test_object.b`), &states.ResourceInstanceObjectSrc{
+			AttrsJSON: []byte(`{"test_string":"bar"}`),
+			Status:    states.ObjectReady,
+		}, mustProviderConfig(`provider["registry.opentofu.org/hashicorp/test"]`), addrs.NoKey)
+	})
+
+	tests := map[string]struct {
+		retryable bool
+		failures  int
+		wantCalls int
+		wantErr   bool
+	}{
+		"retryable error then success": {
+			retryable: true,
+			failures:  2,
+			wantCalls: 3,
+		},
+		"retryable error exceeding retries": {
+			retryable: true,
+			failures:  5,
+			wantCalls: 3,
+			wantErr:   true,
+		},
+		"non-retryable error": {
+			retryable: false,
+			failures:  1,
+			wantCalls: 1,
+			wantErr:   true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			readErr := func() tfdiags.Diagnostics {
+				var diags tfdiags.Diagnostics
+				diag := &hcl.Diagnostic{
+					Severity: hcl.DiagError,
+					Summary:  "Plugin error",
+					Detail:   "Too many requests",
+				}
+				if test.retryable {
+					diag.Extra = testRetryableProviderError{}
+				}
+				return diags.Append(diag)
+			}
+
+			var lock sync.Mutex
+			var dataCalls, resourceCalls int
+			p := simpleMockProvider()
+			p.ReadDataSourceFn = func(req providers.ReadDataSourceRequest) (resp providers.ReadDataSourceResponse) {
+				lock.Lock()
+				defer lock.Unlock()
+				dataCalls++
+				if dataCalls <= test.failures {
+					resp.Diagnostics = readErr()
+					return resp
+				}
+				resp.State = req.Config
+				return resp
+			}
+			p.ReadResourceFn = func(req providers.ReadResourceRequest) (resp providers.ReadResourceResponse) {
+				lock.Lock()
+				defer lock.Unlock()
+				resourceCalls++
+				if resourceCalls <= test.failures {
+					resp.Diagnostics = readErr()
+					return resp
+				}
+				resp.NewState = req.PriorState
+				return resp
+			}
+
+			ctx := testContext2(t, &ContextOpts{
+				Plugins: plugins.NewLibrary(map[addrs.Provider]providers.Factory{
+					addrs.NewDefaultProvider("test"): testProviderFuncFixed(p),
+				}, nil),
+				ProviderReadRetries: 2,
+			})
+
+			_, diags := ctx.Plan(context.Background(), m, state, DefaultPlanOpts)
+			if got := diags.HasErrors(); got != test.wantErr {
+				t.Fatalf("wrong error result %t; want %t\n%s", got, test.wantErr, diags.ErrWithWarnings())
+			}
+			if dataCalls != test.wantCalls {
+				t.Errorf("wrong number of ReadDataSource calls %d; want %d", dataCalls, test.wantCalls)
+			}
+			if resourceCalls != test.wantCalls {
+				t.Errorf("wrong number of ReadResource calls %d; want %d", resourceCalls, test.wantCalls)
+			}
+		})
+	}
+}
+
+// testRetryableProviderError is a diagnostic extra value that marks a
+// provider error as retryable, as the plugin client does for errors that
+// carry google.rpc.RetryInfo details.
+type testRetryableProviderError struct{}
+
+func (testRetryableProviderError) DiagnosticProviderError() *tfdiags.ProviderError {
+	return &tfdiags.ProviderError{
+		Status:    "ResourceExhausted",
+		Retryable: true,
+	}
+}
+
+// refreshConcurrencyHook records the greatest number of objects that are
+// refreshed at the same time.
+type refreshConcurrencyHook struct {
+	NilHook
+
+	mu     sync.Mutex
+	active int
+	max    int
+}
+
+func (h *refreshConcurrencyHook) PreRefresh(addr addrs.AbsResourceInstance, gen states.Generation, priorState cty.Value) (HookAction, error) {
+	h.mu.Lock()
+	h.active++
+	h.max = max(h.max, h.active)
+	h.mu.Unlock()
+
+	// Give any other objects that could be refreshed concurrently the chance
+	// to start.
+	time.Sleep(20 * time.Millisecond)
+	return HookActionContinue, nil
+}
+
+func (h *refreshConcurrencyHook) PostRefresh(addr addrs.AbsResourceInstance, gen states.Generation, priorState cty.Value, newState cty.Value) (HookAction, error) {
+	h.mu.Lock()
+	h.active--
+	h.mu.Unlock()
+	return HookActionContinue, nil
+}
+
+func TestContext2Plan_readParallelism(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+resource "test_object" "a" {
+  count = 6
+}
+`,
+	})
+	state := states.BuildState(func(s *states.SyncState) {
+		for i := range 6 {
+			s.SetResourceInstanceCurrent(mustResourceInstanceAddr(fmt.Sprintf("test_object.a[%d]", i)), &states.ResourceInstanceObjectSrc{
+				AttrsJSON: []byte(`{}`),
+				Status:    states.ObjectReady,
+			}, mustProviderConfig(`provider["registry.opentofu.org/hashicorp/test"]`), addrs.NoKey)
+		}
+	})
+
+	tests := map[string]struct {
+		parallelism, readParallelism, writeParallelism int
+		want                                           int
+	}{
+		"read limit below overall limit": {
+			parallelism:     10,
+			readParallelism: 2,
+			want:            2,
+		},
+		"read limit above overall limit": {
+			// The overall limit still applies when only the read limit
+			// is set.
+			parallelism:     2,
+			readParallelism: 5,
+			want:            2,
+		},
+		"write limit only": {
+			// A plan only reads, so the write limit has no effect.
+			parallelism:      3,
+			writeParallelism: 1,
+			want:             3,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			h := &refreshConcurrencyHook{}
+			p := simpleMockProvider()
+			ctx := testContext2(t, &ContextOpts{
+				Hooks: []Hook{h},
+				Plugins: plugins.NewLibrary(map[addrs.Provider]providers.Factory{
+					addrs.NewDefaultProvider("test"): testProviderFuncFixed(p),
+				}, nil),
+				Parallelism:      test.parallelism,
+				ReadParallelism:  test.readParallelism,
+				WriteParallelism: test.writeParallelism,
+			})
+
+			_, diags := ctx.Plan(context.Background(), m, state, DefaultPlanOpts)
+			assertNoErrors(t, diags)
+
+			if h.max != test.want {
+				t.Errorf("%d objects were refreshed concurrently; want %d", h.max, test.want)
+			}
+		})
+	}
+}
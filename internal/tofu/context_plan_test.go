@@ -5580,6 +5580,132 @@ func TestContext2Plan_ignoreChangesInMap(t *testing.T) {
 	}), ric.After)
 }
 
+func TestContext2Plan_ignoreChangesPatterns(t *testing.T) {
+	p := testProvider("test")
+
+	p.GetProviderSchemaResponse = getProviderSchemaResponseFromProviderSchema(&ProviderSchema{
+		ResourceTypes: map[string]*configschema.Block{
+			"test_ignore_changes_patterns": {
+				Attributes: map[string]*configschema.Attribute{
+					"tags": {Type: cty.Map(cty.String), Optional: true},
+				},
+				BlockTypes: map[string]*configschema.NestedBlock{
+					"metadata": {
+						Nesting: configschema.NestingList,
+						Block: configschema.Block{
+							Attributes: map[string]*configschema.Attribute{
+								"name":        {Type: cty.String, Optional: true},
+								"annotations": {Type: cty.Map(cty.String), Optional: true},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	p.PlanResourceChangeFn = func(req providers.PlanResourceChangeRequest) providers.PlanResourceChangeResponse {
+		return providers.PlanResourceChangeResponse{
+			PlannedState: req.ProposedNewState,
+		}
+	}
+
+	s := states.BuildState(func(ss *states.SyncState) {
+		ss.SetResourceInstanceCurrent(
+			mustResourceInstanceAddr("test_ignore_changes_patterns.foo"),
+			&states.ResourceInstanceObjectSrc{
+				Status: states.ObjectReady,
+				AttrsJSON: []byte(`{
+					"tags": {"kubernetes.io/cluster/a": "owned", "kubernetes.io/role": "node", "team": "from state", "a*b": "from state", "axb": "from state"},
+					"metadata": [
+						{"name": "first from state", "annotations": {"controller": "from state"}},
+						{"name": "second from state", "annotations": {}}
+					]
+				}`),
+			},
+			mustProviderConfig(`provider["registry.opentofu.org/hashicorp/test"]`),
+			addrs.NoKey,
+		)
+	})
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+resource "test_ignore_changes_patterns" "foo" {
+  tags = {
+    "kubernetes.io/role" = "from config"
+    "kubernetes.io/new"  = "from config"
+    team                 = "from config"
+    "a*b"                = "from config"
+    axb                  = "from config"
+  }
+  metadata {
+    name = "first from config"
+  }
+  metadata {
+    name        = "second from config"
+    annotations = {
+      added = "from config"
+    }
+  }
+
+  lifecycle {
+    ignore_changes = [tags["kubernetes.io/*"], tags["a\\*b"], metadata[*].annotations]
+  }
+}
+`,
+	})
+
+	ctx := testContext2(t, &ContextOpts{
+		Plugins: plugins.NewLibrary(map[addrs.Provider]providers.Factory{
+			addrs.NewDefaultProvider("test"): testProviderFuncFixed(p),
+		}, nil),
+	})
+
+	if diags := ctx.Validate(context.Background(), m); diags.HasErrors() {
+		t.Fatalf("unexpected validation errors: %s", diags.Err())
+	}
+
+	plan, diags := ctx.Plan(context.Background(), m, s, DefaultPlanOpts)
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+
+	schema := p.GetProviderSchemaResponse.ResourceTypes["test_ignore_changes_patterns"]
+
+	if got, want := len(plan.Changes.Resources), 1; got != want {
+		t.Fatalf("wrong number of changes %d; want %d", got, want)
+	}
+
+	res := plan.Changes.Resources[0]
+	ric, err := res.Decode(&schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Action != plans.Update {
+		t.Fatalf("resource %s should be updated, got %s", ric.Addr, res.Action)
+	}
+
+	checkVals(t, objectVal(t, schema.Block, map[string]cty.Value{
+		"tags": cty.MapVal(map[string]cty.Value{
+			"kubernetes.io/cluster/a": cty.StringVal("owned"),
+			"kubernetes.io/role":      cty.StringVal("node"),
+			"team":                    cty.StringVal("from config"),
+			"a*b":                     cty.StringVal("from state"),
+			"axb":                     cty.StringVal("from config"),
+		}),
+		"metadata": cty.ListVal([]cty.Value{
+			cty.ObjectVal(map[string]cty.Value{
+				"name": cty.StringVal("first from config"),
+				"annotations": cty.MapVal(map[string]cty.Value{
+					"controller": cty.StringVal("from state"),
+				}),
+			}),
+			cty.ObjectVal(map[string]cty.Value{
+				"name":        cty.StringVal("second from config"),
+				"annotations": cty.MapValEmpty(cty.String),
+			}),
+		}),
+	}), ric.After)
+}
+
 func TestContext2Plan_ignoreChangesSensitive(t *testing.T) {
 	m := testModule(t, "plan-ignore-changes-sensitive")
 	p := testProvider("aws")
@@ -0,0 +1,76 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tofu
+
+import (
+	"context"
+	"log"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/configs"
+	"github.com/opentofu/opentofu/internal/plans"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+	"github.com/opentofu/opentofu/internal/tracing"
+)
+
+// preflightProviders configures each provider instance declared in the given
+// configuration, using the prior state of the given plan to evaluate the
+// provider configurations, and returns any diagnostics the providers
+// reported while being configured.
+//
+// This takes no action against remote APIs other than whatever the providers
+// themselves do while being configured, which typically includes verifying
+// their credentials. The provider instances are closed again before
+// returning, so the apply walk starts its own instances as usual.
+//
+// Provider configurations that refer to values that will only be known after
+// apply are configured with those values unknown, in the same way as during
+// the plan walk.
+func (c *Context) preflightProviders(ctx context.Context, plan *plans.Plan, config *configs.Config, opts *ApplyOpts) tfdiags.Diagnostics {
+	var diags tfdiags.Diagnostics
+
+	ctx, span := tracing.Tracer().Start(
+		ctx, "Provider preflight checks",
+	)
+	defer span.End()
+
+	variables, moreDiags := c.mergePlanAndApplyVariables(config, plan, opts)
+	diags = diags.Append(moreDiags)
+	if moreDiags.HasErrors() {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Building and walking 'eval' graph to check provider configurations")
+
+	providerFunctionTracker := make(ProviderFunctionMapping)
+
+	graph, moreDiags := (&EvalGraphBuilder{
+		Config:                  config,
+		State:                   plan.PriorState,
+		RootVariableValues:      variables,
+		Plugins:                 c.plugins,
+		ProviderFunctionTracker: providerFunctionTracker,
+		ConfigureProviders:      true,
+	}).Build(ctx, addrs.RootModuleInstance)
+	diags = diags.Append(moreDiags)
+	if moreDiags.HasErrors() {
+		tracing.SetSpanError(span, diags)
+		return diags
+	}
+
+	walker, moreDiags := c.walk(ctx, graph, walkEval, &graphWalkOpts{
+		InputState:              plan.PriorState.DeepCopy(),
+		Config:                  config,
+		ProviderFunctionTracker: providerFunctionTracker,
+	})
+	diags = diags.Append(moreDiags)
+	if walker != nil {
+		diags = diags.Append(walker.NonFatalDiagnostics)
+	}
+
+	tracing.SetSpanError(span, diags)
+	return diags
+}
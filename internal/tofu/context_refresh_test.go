@@ -83,6 +83,127 @@ func TestContext2Refresh(t *testing.T) {
 	}
 }
 
+func TestContext2Refresh_changeHintUnchanged(t *testing.T) {
+	p := testProvider("aws")
+	p.GetProviderSchemaResponse.ServerCapabilities.ChangeHints = true
+	m := testModule(t, "refresh-basic")
+
+	state := states.NewState()
+	root := state.EnsureModule(addrs.RootModuleInstance)
+	root.SetResourceInstanceCurrent(
+		mustResourceInstanceAddr("aws_instance.web").Resource,
+		&states.ResourceInstanceObjectSrc{
+			Status:     states.ObjectReady,
+			AttrsJSON:  []byte(`{"id":"foo","foo":"bar"}`),
+			ChangeHint: "etag-1",
+		},
+		mustProviderConfig(`provider["registry.opentofu.org/hashicorp/aws"]`),
+		addrs.NoKey,
+	)
+
+	ctx := testContext2(t, &ContextOpts{
+		Plugins: plugins.NewLibrary(map[addrs.Provider]providers.Factory{
+			addrs.NewDefaultProvider("aws"): testProviderFuncFixed(p),
+		}, nil),
+	})
+
+	schema := p.GetProviderSchemaResponse.ResourceTypes["aws_instance"].Block
+	ty := schema.ImpliedType()
+
+	var gotHint string
+	p.ReadResourceFn = func(req providers.ReadResourceRequest) providers.ReadResourceResponse {
+		gotHint = req.PriorChangeHint
+		// The provider doesn't read the object in full, so it doesn't
+		// return a new state at all.
+		return providers.ReadResourceResponse{
+			NewState:   cty.NullVal(ty),
+			ChangeHint: req.PriorChangeHint,
+			Unchanged:  true,
+		}
+	}
+
+	s, diags := ctx.Refresh(context.Background(), m, state, &PlanOpts{Mode: plans.NormalMode})
+	if diags.HasErrors() {
+		t.Fatal(diags.Err())
+	}
+
+	if gotHint != "etag-1" {
+		t.Fatalf("wrong PriorChangeHint %q; want %q", gotHint, "etag-1")
+	}
+
+	got := s.RootModule().Resources["aws_instance.web"].Instances[addrs.NoKey].Current
+	if got == nil {
+		t.Fatal("aws_instance.web was removed from state")
+	}
+	fromState, err := got.Decode(ty)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := fromState.Value.GetAttr("foo"), cty.StringVal("bar"); !got.RawEquals(want) {
+		t.Errorf("wrong foo value %#v; want %#v", got, want)
+	}
+	if got.ChangeHint != "etag-1" {
+		t.Errorf("wrong change hint %q; want %q", got.ChangeHint, "etag-1")
+	}
+}
+
+func TestContext2Refresh_changeHintChanged(t *testing.T) {
+	p := testProvider("aws")
+	p.GetProviderSchemaResponse.ServerCapabilities.ChangeHints = true
+	m := testModule(t, "refresh-basic")
+
+	state := states.NewState()
+	root := state.EnsureModule(addrs.RootModuleInstance)
+	root.SetResourceInstanceCurrent(
+		mustResourceInstanceAddr("aws_instance.web").Resource,
+		&states.ResourceInstanceObjectSrc{
+			Status:     states.ObjectReady,
+			AttrsJSON:  []byte(`{"id":"foo","foo":"bar"}`),
+			ChangeHint: "etag-1",
+		},
+		mustProviderConfig(`provider["registry.opentofu.org/hashicorp/aws"]`),
+		addrs.NoKey,
+	)
+
+	ctx := testContext2(t, &ContextOpts{
+		Plugins: plugins.NewLibrary(map[addrs.Provider]providers.Factory{
+			addrs.NewDefaultProvider("aws"): testProviderFuncFixed(p),
+		}, nil),
+	})
+
+	schema := p.GetProviderSchemaResponse.ResourceTypes["aws_instance"].Block
+	ty := schema.ImpliedType()
+	readState, err := hcl2shim.HCL2ValueFromFlatmap(map[string]string{"id": "foo", "foo": "baz"}, ty)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p.ReadResourceResponse = &providers.ReadResourceResponse{
+		NewState:   readState,
+		ChangeHint: "etag-2",
+	}
+
+	s, diags := ctx.Refresh(context.Background(), m, state, &PlanOpts{Mode: plans.NormalMode})
+	if diags.HasErrors() {
+		t.Fatal(diags.Err())
+	}
+
+	got := s.RootModule().Resources["aws_instance.web"].Instances[addrs.NoKey].Current
+	if got == nil {
+		t.Fatal("aws_instance.web was removed from state")
+	}
+	fromState, err := got.Decode(ty)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := fromState.Value.GetAttr("foo"), cty.StringVal("baz"); !got.RawEquals(want) {
+		t.Errorf("wrong foo value %#v; want %#v", got, want)
+	}
+	if got.ChangeHint != "etag-2" {
+		t.Errorf("wrong change hint %q; want %q", got.ChangeHint, "etag-2")
+	}
+}
+
 func TestContext2Refresh_dynamicAttr(t *testing.T) {
 	m := testModule(t, "refresh-dynamic")
 
@@ -0,0 +1,190 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tofu
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/providers"
+	"github.com/opentofu/opentofu/internal/states"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// StateUpgradeOutcome is the result of running a provider's state upgrade
+// logic against a resource instance object.
+type StateUpgradeOutcome string
+
+const (
+	// StateUpgradeUnchanged means that the upgrade would leave the object
+	// as it is.
+	StateUpgradeUnchanged StateUpgradeOutcome = "unchanged"
+
+	// StateUpgradeChanged means that the upgrade would succeed, but would
+	// change the object or its schema version.
+	StateUpgradeChanged StateUpgradeOutcome = "changed"
+
+	// StateUpgradeFailed means that the upgrade would fail, and so OpenTofu
+	// wouldn't be able to use the object at all.
+	StateUpgradeFailed StateUpgradeOutcome = "failed"
+)
+
+// StateUpgradeCheckResult describes what upgrading the state of a single
+// resource instance object to the current schema of its provider would do.
+type StateUpgradeCheckResult struct {
+	Addr       addrs.AbsResourceInstance
+	DeposedKey states.DeposedKey
+	Provider   addrs.Provider
+
+	// FromVersion is the schema version that the object was saved with, and
+	// ToVersion is the current schema version of its resource type. ToVersion
+	// is zero if the provider or resource type isn't available.
+	FromVersion uint64
+	ToVersion   uint64
+
+	Outcome StateUpgradeOutcome
+
+	// Diagnostics are the problems reported while upgrading the object,
+	// which include at least one error if the upgrade failed.
+	Diagnostics tfdiags.Diagnostics
+}
+
+// StateUpgradeCheck runs the state upgrade logic of the available providers
+// against each managed resource instance object in the given state, as
+// OpenTofu would at the start of a plan, and reports the outcome for each
+// of them.
+//
+// The given state is not modified, and the providers are never configured,
+// so this doesn't access any remote objects. The returned diagnostics
+// describe problems that prevented the check itself, while problems with
+// upgrading individual objects are reported in their results.
+func (c *Context) StateUpgradeCheck(ctx context.Context, state *states.State) ([]StateUpgradeCheckResult, tfdiags.Diagnostics) {
+	defer c.acquireRun("state upgrade check")()
+
+	var diags tfdiags.Diagnostics
+	var results []StateUpgradeCheckResult
+	if state == nil {
+		return results, diags
+	}
+
+	instances := make(map[addrs.Provider]providers.Interface)
+	defer func() {
+		for _, provider := range instances {
+			_ = provider.Close(ctx)
+		}
+	}()
+
+	for _, ms := range state.Modules {
+		for _, rs := range ms.Resources {
+			if rs.Addr.Resource.Mode != addrs.ManagedResourceMode {
+				// Only managed resources have versioned schemas.
+				continue
+			}
+			providerAddr := rs.ProviderConfig.Provider
+
+			var objs []StateUpgradeCheckResult
+			for key, is := range rs.Instances {
+				addr := rs.Addr.Instance(key)
+				if is.Current != nil {
+					objs = append(objs, StateUpgradeCheckResult{Addr: addr, DeposedKey: states.NotDeposed, Provider: providerAddr, FromVersion: is.Current.SchemaVersion})
+				}
+				for dk, obj := range is.Deposed {
+					objs = append(objs, StateUpgradeCheckResult{Addr: addr, DeposedKey: dk, Provider: providerAddr, FromVersion: obj.SchemaVersion})
+				}
+			}
+			if len(objs) == 0 {
+				continue
+			}
+
+			schema, version, schemaDiags := c.plugins.ResourceTypeSchema(ctx, providerAddr, rs.Addr.Resource.Mode, rs.Addr.Resource.Type)
+			if !schemaDiags.HasErrors() && schema == nil {
+				schemaDiags = schemaDiags.Append(tfdiags.Sourceless(
+					tfdiags.Error,
+					"Resource type not supported",
+					fmt.Sprintf("The provider %s does not support resource type %q.", providerAddr, rs.Addr.Resource.Type),
+				))
+			}
+			provider := instances[providerAddr]
+			if !schemaDiags.HasErrors() && provider == nil {
+				var providerDiags tfdiags.Diagnostics
+				provider, providerDiags = c.plugins.providers.NewProvider(ctx, providerAddr)
+				schemaDiags = schemaDiags.Append(providerDiags)
+				if !providerDiags.HasErrors() {
+					instances[providerAddr] = provider
+				}
+			}
+
+			for _, result := range objs {
+				if schemaDiags.HasErrors() {
+					result.Outcome = StateUpgradeFailed
+					result.Diagnostics = schemaDiags
+					results = append(results, result)
+					continue
+				}
+
+				result.ToVersion = version
+				obj := rs.Instance(result.Addr.Resource.Key).Current
+				if result.DeposedKey != states.NotDeposed {
+					obj = rs.Instance(result.Addr.Resource.Key).Deposed[result.DeposedKey]
+				}
+				// The upgrade may modify the object it's given, so we give
+				// it a copy to leave the state untouched.
+				upgraded, upgradeDiags := upgradeResourceState(stateTransformArgs{
+					currentAddr:          result.Addr,
+					prevAddr:             result.Addr,
+					provider:             provider,
+					objectSrc:            obj.DeepCopy(),
+					currentSchema:        schema,
+					currentSchemaVersion: version,
+				})
+				result.Diagnostics = upgradeDiags
+				switch {
+				case upgradeDiags.HasErrors():
+					result.Outcome = StateUpgradeFailed
+				case obj.SchemaVersion != version || !equivalentStateJSON(obj.AttrsJSON, upgraded.AttrsJSON):
+					result.Outcome = StateUpgradeChanged
+				default:
+					result.Outcome = StateUpgradeUnchanged
+				}
+				results = append(results, result)
+			}
+		}
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if a, b := results[i].Addr.String(), results[j].Addr.String(); a != b {
+			return a < b
+		}
+		return results[i].DeposedKey < results[j].DeposedKey
+	})
+	return results, diags
+}
+
+// equivalentStateJSON returns true if the given JSON encodings of resource
+// instance objects represent the same values, regardless of formatting and
+// the order of their attributes. Legacy flatmap states have no JSON encoding
+// and always need upgrading, so they are never equivalent.
+func equivalentStateJSON(a, b []byte) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return false
+	}
+	if bytes.Equal(a, b) {
+		return true
+	}
+	var av, bv interface{}
+	if err := json.Unmarshal(a, &av); err != nil {
+		return false
+	}
+	if err := json.Unmarshal(b, &bv); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(av, bv)
+}
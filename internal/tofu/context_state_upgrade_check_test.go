@@ -0,0 +1,124 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tofu
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/configs/configschema"
+	"github.com/opentofu/opentofu/internal/plugins"
+	"github.com/opentofu/opentofu/internal/providers"
+	"github.com/opentofu/opentofu/internal/states"
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+func TestContext2StateUpgradeCheck(t *testing.T) {
+	p := testProvider("test")
+	p.GetProviderSchemaResponse = getProviderSchemaResponseFromProviderSchema(&ProviderSchema{
+		ResourceTypes: map[string]*configschema.Block{
+			"test_thing": {
+				Attributes: map[string]*configschema.Attribute{
+					"name": {Type: cty.String, Optional: true},
+				},
+			},
+		},
+		ResourceTypeSchemaVersions: map[string]uint64{
+			"test_thing": 2,
+		},
+	})
+	p.UpgradeResourceStateFn = func(req providers.UpgradeResourceStateRequest) (resp providers.UpgradeResourceStateResponse) {
+		switch {
+		case bytes.Contains(req.RawStateJSON, []byte("broken")):
+			resp.Diagnostics = resp.Diagnostics.Append(tfdiags.Sourceless(tfdiags.Error, "Cannot upgrade", "Broken object."))
+		case req.Version < 2:
+			// Imagine that version 2 renamed all of the objects.
+			resp.UpgradedState = cty.ObjectVal(map[string]cty.Value{
+				"name": cty.StringVal("renamed"),
+			})
+		default:
+			resp.UpgradedState = cty.ObjectVal(map[string]cty.Value{
+				"name": cty.StringVal("current"),
+			})
+		}
+		return resp
+	}
+
+	providerAddr := addrs.AbsProviderConfig{
+		Provider: addrs.NewDefaultProvider("test"),
+		Module:   addrs.RootModule,
+	}
+	thing := func(typeName, name string) addrs.AbsResourceInstance {
+		return addrs.Resource{
+			Mode: addrs.ManagedResourceMode,
+			Type: typeName,
+			Name: name,
+		}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)
+	}
+	obj := func(version uint64, attrs string) *states.ResourceInstanceObjectSrc {
+		return &states.ResourceInstanceObjectSrc{
+			Status:        states.ObjectReady,
+			SchemaVersion: version,
+			AttrsJSON:     []byte(attrs),
+		}
+	}
+	state := states.BuildState(func(s *states.SyncState) {
+		s.SetResourceInstanceCurrent(thing("test_thing", "current"), obj(2, `{"name":"current"}`), providerAddr, addrs.NoKey)
+		s.SetResourceInstanceCurrent(thing("test_thing", "old"), obj(1, `{"name":"old"}`), providerAddr, addrs.NoKey)
+		s.SetResourceInstanceDeposed(thing("test_thing", "old"), states.DeposedKey("00000001"), obj(2, `{"name":"current"}`), providerAddr, addrs.NoKey)
+		s.SetResourceInstanceCurrent(thing("test_thing", "broken"), obj(1, `{"name":"broken"}`), providerAddr, addrs.NoKey)
+		s.SetResourceInstanceCurrent(thing("test_thing", "future"), obj(3, `{"name":"current"}`), providerAddr, addrs.NoKey)
+		s.SetResourceInstanceCurrent(thing("test_unknown", "foo"), obj(0, `{}`), providerAddr, addrs.NoKey)
+	})
+	before := state.DeepCopy()
+
+	ctx := testContext2(t, &ContextOpts{
+		Plugins: plugins.NewLibrary(map[addrs.Provider]providers.Factory{
+			addrs.NewDefaultProvider("test"): func() (providers.Interface, error) {
+				// Providers aren't configured before upgrading state in a
+				// check, which the plugin protocol allows but the mock
+				// provider doesn't.
+				p.ConfigureProviderCalled = true
+				return p, nil
+			},
+		}, nil),
+	})
+
+	results, diags := ctx.StateUpgradeCheck(t.Context(), state)
+	assertNoErrors(t, diags)
+
+	type outcome struct {
+		addr      string
+		deposed   states.DeposedKey
+		from, to  uint64
+		outcome   StateUpgradeOutcome
+		hasErrors bool
+	}
+	want := []outcome{
+		{"test_thing.broken", states.NotDeposed, 1, 2, StateUpgradeFailed, true},
+		{"test_thing.current", states.NotDeposed, 2, 2, StateUpgradeUnchanged, false},
+		{"test_thing.future", states.NotDeposed, 3, 2, StateUpgradeFailed, true},
+		{"test_thing.old", states.NotDeposed, 1, 2, StateUpgradeChanged, false},
+		{"test_thing.old", states.DeposedKey("00000001"), 2, 2, StateUpgradeUnchanged, false},
+		{"test_unknown.foo", states.NotDeposed, 0, 0, StateUpgradeFailed, true},
+	}
+	if len(results) != len(want) {
+		t.Fatalf("wrong number of results %d; want %d", len(results), len(want))
+	}
+	for i, result := range results {
+		got := outcome{result.Addr.String(), result.DeposedKey, result.FromVersion, result.ToVersion, result.Outcome, result.Diagnostics.HasErrors()}
+		if got != want[i] {
+			t.Errorf("wrong result %d\ngot:  %#v\nwant: %#v", i, got, want[i])
+		}
+	}
+
+	if !state.Equal(before) {
+		t.Error("state was modified by the check")
+	}
+}
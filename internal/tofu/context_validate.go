@@ -17,6 +17,19 @@ import (
 	"github.com/zclconf/go-cty/cty"
 )
 
+// ValidateOpts are the options for Context.ValidateWithOpts.
+type ValidateOpts struct {
+	// Strict causes validation of the provider configuration blocks that
+	// nothing in the configuration uses, as for Context.ValidateStrict.
+	Strict bool
+
+	// SetVariables are the values to use for some or all of the root module
+	// input variables, which are then checked against their type constraints
+	// and validation rules. The root module input variables that have no
+	// value here are unknown, as for Context.Validate.
+	SetVariables InputValues
+}
+
 // Validate performs semantic validation of a configuration, and returns
 // any warnings or errors.
 //
@@ -29,6 +42,26 @@ import (
 // all of the same checks as Validate, in addition to the other work it does
 // to consider the previous run state and the planning options.
 func (c *Context) Validate(ctx context.Context, config *configs.Config) tfdiags.Diagnostics {
+	return c.ValidateWithOpts(ctx, config, &ValidateOpts{})
+}
+
+// ValidateStrict performs the same checks as Validate, and additionally
+// validates the provider configuration blocks that nothing in the
+// configuration uses.
+//
+// Validate skips those blocks because the provider would never be
+// configured with them, but that means that mistakes such as a misspelled
+// argument name remain hidden until something starts to use the provider.
+func (c *Context) ValidateStrict(ctx context.Context, config *configs.Config) tfdiags.Diagnostics {
+	return c.ValidateWithOpts(ctx, config, &ValidateOpts{Strict: true})
+}
+
+// ValidateWithOpts performs the same checks as Validate, with the behavior
+// adjusted by the given options.
+//
+// Setting values for root module input variables allows checking the values
+// that will be used for a plan without creating one.
+func (c *Context) ValidateWithOpts(ctx context.Context, config *configs.Config, opts *ValidateOpts) tfdiags.Diagnostics {
 	defer c.acquireRun("validate")()
 
 	var diags tfdiags.Diagnostics
@@ -51,10 +84,15 @@ func (c *Context) Validate(ctx context.Context, config *configs.Config) tfdiags.
 
 	// Validate is to check if the given module is valid regardless of
 	// input values, current state, etc. Therefore we populate all of the
-	// input values with unknown values of the expected type, allowing us
-	// to perform a type check without assuming any particular values.
+	// input values that the caller didn't set with unknown values of the
+	// expected type, allowing us to perform a type check without assuming
+	// any particular values.
 	varValues := make(InputValues)
 	for name, variable := range config.Module.Variables {
+		if val, ok := opts.SetVariables[name]; ok && val.Value != cty.NilVal {
+			varValues[name] = val
+			continue
+		}
 		ty := variable.Type
 		if ty == cty.NilType {
 			// Can't predict the type at all, so we'll just mark it as
@@ -86,6 +124,7 @@ func (c *Context) Validate(ctx context.Context, config *configs.Config) tfdiags.
 		Operation:               walkValidate,
 		ProviderFunctionTracker: providerFunctionTracker,
 		ImportTargets:           importTargets,
+		keepUnusedProviders:     opts.Strict,
 	}).Build(ctx, addrs.RootModuleInstance)
 	diags = diags.Append(moreDiags)
 	if moreDiags.HasErrors() {
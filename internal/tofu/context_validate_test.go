@@ -2969,3 +2969,172 @@ resource "test_instance" "b" {
 		})
 	}
 }
+
+func TestContext2ValidateStrict_unusedProviderConfig(t *testing.T) {
+	p := testProvider("test")
+	p.GetProviderSchemaResponse = getProviderSchemaResponseFromProviderSchema(&ProviderSchema{
+		Provider: &configschema.Block{
+			Attributes: map[string]*configschema.Attribute{
+				"region": {Type: cty.String, Optional: true},
+			},
+		},
+		ResourceTypes: map[string]*configschema.Block{
+			"test_instance": {
+				Attributes: map[string]*configschema.Attribute{
+					"id": {Type: cty.String, Computed: true},
+				},
+			},
+		},
+	})
+
+	tests := map[string]struct {
+		files   map[string]string
+		wantErr string
+	}{
+		"valid": {
+			files: map[string]string{
+				"main.tf": `
+provider "test" {
+  region = "a"
+}
+
+provider "test" {
+  alias  = "unused"
+  region = "b"
+}
+
+resource "test_instance" "a" {
+}
+`,
+			},
+		},
+		"unknown argument in unused root provider": {
+			files: map[string]string{
+				"main.tf": `
+provider "test" {
+  alias  = "unused"
+  reigon = "b"
+}
+
+resource "test_instance" "a" {
+}
+`,
+			},
+			wantErr: `An argument named "reigon" is not expected here.`,
+		},
+		"unknown argument in unused child module provider": {
+			files: map[string]string{
+				"main.tf": `
+module "child" {
+  source = "./child"
+}
+`,
+				"child/main.tf": `
+provider "test" {
+  regoin = var.region
+}
+
+variable "region" {
+  type    = string
+  default = "a"
+}
+`,
+			},
+			wantErr: `An argument named "regoin" is not expected here.`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			m := testModuleInline(t, test.files)
+			ctx := testContext2(t, &ContextOpts{
+				Plugins: plugins.NewLibrary(map[addrs.Provider]providers.Factory{
+					addrs.NewDefaultProvider("test"): testProviderFuncFixed(p),
+				}, nil),
+			})
+
+			// Without strict mode, nothing uses the misconfigured
+			// providers and so their configuration isn't checked.
+			diags := ctx.Validate(context.Background(), m)
+			assertNoErrors(t, diags)
+
+			diags = ctx.ValidateStrict(context.Background(), m)
+			if test.wantErr == "" {
+				assertNoErrors(t, diags)
+				return
+			}
+			if !diags.HasErrors() {
+				t.Fatal("succeeded; want error")
+			}
+			if got := diags.Err().Error(); !strings.Contains(got, test.wantErr) {
+				t.Fatalf("wrong error\ngot:  %s\nwant: message containing %q", got, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestContext2ValidateWithOpts_setVariables(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+variable "instances" {
+  type = number
+
+  validation {
+    condition     = var.instances > 0
+    error_message = "At least one instance is required."
+  }
+}
+
+variable "name" {
+  type = string
+}
+`,
+	})
+
+	tests := map[string]struct {
+		vars    InputValues
+		wantErr string
+	}{
+		"valid": {
+			vars: InputValues{
+				"instances": {Value: cty.StringVal("2"), SourceType: ValueFromCLIArg},
+				"name":      {Value: cty.StringVal("a"), SourceType: ValueFromCLIArg},
+			},
+		},
+		"unset variables are unknown": {
+			vars: InputValues{},
+		},
+		"wrong type": {
+			vars: InputValues{
+				"instances": {Value: cty.StringVal("many"), SourceType: ValueFromCLIArg},
+			},
+			wantErr: `Invalid value for input variable`,
+		},
+		"failed validation rule": {
+			vars: InputValues{
+				"instances": {Value: cty.StringVal("0"), SourceType: ValueFromCLIArg},
+			},
+			wantErr: `At least one instance is required.`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := testContext2(t, &ContextOpts{})
+
+			diags := ctx.ValidateWithOpts(context.Background(), m, &ValidateOpts{
+				SetVariables: test.vars,
+			})
+			if test.wantErr == "" {
+				assertNoErrors(t, diags)
+				return
+			}
+			if !diags.HasErrors() {
+				t.Fatal("succeeded; want error")
+			}
+			if got := diags.Err().Error(); !strings.Contains(got, test.wantErr) {
+				t.Fatalf("wrong error\ngot:  %s\nwant: message containing %q", got, test.wantErr)
+			}
+		})
+	}
+}
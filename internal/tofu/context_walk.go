@@ -57,12 +57,24 @@ func (c *Context) walk(ctx context.Context, graph *Graph, operation walkOperatio
 	// Watch for a stop so we can call the provider Stop() API.
 	watchStop, watchWait := c.watchStop(walker)
 
+	// Report the progress of long-running apply walks to the hooks.
+	var scheduleStop chan struct{}
+	var scheduleWait <-chan struct{}
+	if operation == walkApply || operation == walkDestroy {
+		walker.applySchedule = newApplyScheduleTracker(graph, walker.Changes)
+		scheduleStop, scheduleWait = c.watchSchedule(walker.applySchedule)
+	}
+
 	// Walk the real graph, this will block until it completes
 	diags := graph.Walk(ctx, walker)
 
 	// Close the channel so the watcher stops, and wait for it to return.
 	close(watchStop)
 	<-watchWait
+	if scheduleStop != nil {
+		close(scheduleStop)
+		<-scheduleWait
+	}
 
 	return walker, diags
 }
@@ -129,6 +141,13 @@ func (c *Context) graphWalker(operation walkOperation, opts *graphWalkOpts) *Con
 		panic("Context.graphWalker call without Config")
 	}
 
+	// Only the plan walk explains its unknown values, since that's the only
+	// walk whose unknown values are shown to the user.
+	var unknownCauses *UnknownCauses
+	if operation == walkPlan {
+		unknownCauses = NewUnknownCauses()
+	}
+
 	checkState := checks.NewState(opts.Config)
 	if opts.PlanTimeCheckResults != nil {
 		// We'll re-report all of the same objects we determined during the
@@ -153,6 +172,7 @@ func (c *Context) graphWalker(operation walkOperation, opts *graphWalkOpts) *Con
 		InstanceExpander:        instances.NewExpander(),
 		MoveResults:             opts.MoveResults,
 		ImportResolver:          NewImportResolver(),
+		UnknownCauses:           unknownCauses,
 		Operation:               operation,
 		StopContext:             c.runContext,
 		PlanTimestamp:           opts.PlanTimeTimestamp,
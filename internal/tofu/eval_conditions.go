@@ -103,6 +103,10 @@ func validateCheckRule(ctx context.Context, addr addrs.CheckRule, rule *configs.
 		}
 	}
 	scope := evalCtx.EvaluationScope(selfReference, sourceReference, keyData)
+	// The assertions of check blocks only produce warnings, so they are
+	// allowed to wait for a condition to be met.
+	scope.RetryAllowed = addr.Type == addrs.CheckAssertion
+	scope.Stopped = evalCtx.Stopped()
 
 	hclCtx, moreDiags := scope.EvalContext(ctx, refs)
 	diags = diags.Append(moreDiags)
@@ -136,10 +140,14 @@ func evalCheckRule(ctx context.Context, addr addrs.CheckRule, rule *configs.Chec
 
 		// Check assertions warn if a status is unknown.
 		if addr.Type == addrs.CheckAssertion {
+			detail := "The condition could not be evaluated at this time, a result will be known when this plan is applied."
+			if causes := evalCtx.UnknownCauses().ForExprs(evalCtx.Path(), hclCtx, rule.Condition); len(causes) != 0 {
+				detail += fmt.Sprintf(" The condition depends on %s, which will be known only after apply.", strings.Join(causes, ", "))
+			}
 			diags = diags.Append(&hcl.Diagnostic{
 				Severity:    hcl.DiagWarning,
 				Summary:     fmt.Sprintf("%s known after apply", addr.Type.Description()),
-				Detail:      "The condition could not be evaluated at this time, a result will be known when this plan is applied.",
+				Detail:      detail,
 				Subject:     rule.Condition.Range().Ptr(),
 				Expression:  rule.Condition,
 				EvalContext: hclCtx,
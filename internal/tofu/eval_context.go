@@ -171,10 +171,22 @@ type EvalContext interface {
 	// and have a configuration
 	ImportResolver() *ImportResolver
 
+	// UnknownCauses returns a helper object for tracking which references are
+	// responsible for named values not being known until apply, so that the
+	// plan can explain its unknown values to the user.
+	//
+	// This is nil for all walks other than planning.
+	UnknownCauses() *UnknownCauses
+
 	// WithPath returns a copy of the context with the internal path set to the
 	// path argument.
 	WithPath(path addrs.ModuleInstance) EvalContext
 
 	// Returns the currently configured encryption setup
 	GetEncryption() encryption.Encryption
+
+	// ProviderReadRetries returns the number of times to retry a request to
+	// read a resource or data source when it fails with an error that the
+	// provider marked as retryable.
+	ProviderReadRetries() int
 }
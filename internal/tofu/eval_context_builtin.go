@@ -75,8 +75,11 @@ type BuiltinEvalContext struct {
 	InstanceExpanderValue   *instances.Expander
 	MoveResultsValue        refactoring.MoveResults
 	ImportResolverValue     *ImportResolver
+	UnknownCausesValue      *UnknownCauses
 	Encryption              encryption.Encryption
 	ProviderFunctionTracker ProviderFunctionMapping
+
+	ProviderReadRetriesValue int
 }
 
 // BuiltinEvalContext implements EvalContext
@@ -165,11 +168,11 @@ func (c *BuiltinEvalContext) Provisioners() plugins.ProvisionerManager {
 func (c *BuiltinEvalContext) EvaluateBlock(ctx context.Context, body hcl.Body, schema *configschema.Block, self addrs.Referenceable, keyData InstanceKeyEvalData) (cty.Value, hcl.Body, tfdiags.Diagnostics) {
 	var diags tfdiags.Diagnostics
 	scope := c.EvaluationScope(self, nil, keyData)
-	body, evalDiags := scope.ExpandBlock(ctx, body, schema)
-	diags = diags.Append(evalDiags)
-	val, evalDiags := scope.EvalBlock(ctx, body, schema)
+	expanded, evalDiags := scope.ExpandBlock(ctx, body, schema)
 	diags = diags.Append(evalDiags)
-	return val, body, diags
+	val, evalDiags := scope.EvalBlock(ctx, expanded, schema)
+	diags = diags.Append(lang.SuggestFixes(evalDiags, body, schema))
+	return val, expanded, diags
 }
 
 func (c *BuiltinEvalContext) EvaluateExpr(ctx context.Context, expr hcl.Expression, wantType cty.Type, self addrs.Referenceable) (cty.Value, tfdiags.Diagnostics) {
@@ -357,7 +360,7 @@ func (c *BuiltinEvalContext) EvaluationScope(self addrs.Referenceable, source ad
 			return nil, tfdiags.Diagnostics{}.Append(&hcl.Diagnostic{
 				Severity: hcl.DiagError,
 				Summary:  "Uninitialized function provider",
-				Detail:   fmt.Sprintf("Provider %q has not yet been initialized", providedBy.Provider.String()),
+				Detail:   fmt.Sprintf("Provider %q has not yet been initialized, so the function %q cannot be called here. Provider-defined functions can be used only where the provider is available, which may require adding the provider to this module's required_providers block.", providedBy.Provider.String(), pf.String()),
 				Subject:  rng.ToHCL().Ptr(),
 			})
 		}
@@ -454,6 +457,14 @@ func (c *BuiltinEvalContext) ImportResolver() *ImportResolver {
 	return c.ImportResolverValue
 }
 
+func (c *BuiltinEvalContext) UnknownCauses() *UnknownCauses {
+	return c.UnknownCausesValue
+}
+
 func (c *BuiltinEvalContext) GetEncryption() encryption.Encryption {
 	return c.Encryption
 }
+
+func (c *BuiltinEvalContext) ProviderReadRetries() int {
+	return c.ProviderReadRetriesValue
+}
@@ -126,8 +126,13 @@ type MockEvalContext struct {
 	ImportResolverCalled  bool
 	ImportResolverResults *ImportResolver
 
+	UnknownCausesCalled  bool
+	UnknownCausesResults *UnknownCauses
+
 	InstanceExpanderCalled   bool
 	InstanceExpanderExpander *instances.Expander
+
+	ProviderReadRetriesValue int
 }
 
 // MockEvalContext implements EvalContext
@@ -343,6 +348,11 @@ func (c *MockEvalContext) ImportResolver() *ImportResolver {
 	return c.ImportResolverResults
 }
 
+func (c *MockEvalContext) UnknownCauses() *UnknownCauses {
+	c.UnknownCausesCalled = true
+	return c.UnknownCausesResults
+}
+
 func (c *MockEvalContext) InstanceExpander() *instances.Expander {
 	c.InstanceExpanderCalled = true
 	return c.InstanceExpanderExpander
@@ -351,3 +361,7 @@ func (c *MockEvalContext) InstanceExpander() *instances.Expander {
 func (c *MockEvalContext) GetEncryption() encryption.Encryption {
 	return encryption.Disabled()
 }
+
+func (c *MockEvalContext) ProviderReadRetries() int {
+	return c.ProviderReadRetriesValue
+}
@@ -34,8 +34,8 @@ func evalContextEvaluate(ctx context.Context, evalCtx EvalContext) evalchecks.Ev
 	}
 }
 
-func evaluateForEachExpression(ctx context.Context, expr hcl.Expression, evalCtx EvalContext, excludeableAddr addrs.Targetable) (map[string]cty.Value, tfdiags.Diagnostics) {
-	return evalchecks.EvaluateForEachExpression(expr, evalContextScope(ctx, evalCtx), excludeableAddr)
+func evaluateForEachKeyedExpression(ctx context.Context, expr hcl.Expression, keyExpr hcl.Expression, evalCtx EvalContext, excludeableAddr addrs.Targetable) (map[string]cty.Value, tfdiags.Diagnostics) {
+	return evalchecks.EvaluateForEachKeyedExpression(expr, keyExpr, evalContextScope(ctx, evalCtx), excludeableAddr)
 }
 
 func evaluateEnabledExpression(ctx context.Context, expr hcl.Expression, evalCtx EvalContext) (bool, tfdiags.Diagnostics) {
@@ -46,6 +46,10 @@ func evaluateForEachExpressionValue(ctx context.Context, expr hcl.Expression, ev
 	return evalchecks.EvaluateForEachExpressionValue(expr, evalContextScope(ctx, evalCtx), allowUnknown, allowTuple, excludeableAddr)
 }
 
+func evaluateForEachKeyedExpressionValue(ctx context.Context, expr hcl.Expression, keyExpr hcl.Expression, evalCtx EvalContext, allowUnknown bool, excludeableAddr addrs.Targetable) (cty.Value, tfdiags.Diagnostics) {
+	return evalchecks.EvaluateForEachKeyedExpressionValue(expr, keyExpr, evalContextScope(ctx, evalCtx), allowUnknown, excludeableAddr)
+}
+
 func evaluateCountExpression(ctx context.Context, expr hcl.Expression, evalCtx EvalContext, excludeableAddr addrs.Targetable) (int, tfdiags.Diagnostics) {
 	return evalchecks.EvaluateCountExpression(expr, evalContextEvaluate(ctx, evalCtx), excludeableAddr)
 }
@@ -25,6 +25,31 @@ import (
 	"github.com/opentofu/opentofu/internal/tfdiags"
 )
 
+// evalVariableDefault returns the given variable configuration with its
+// deferred default value expression, if any, evaluated in the module where
+// the variable is declared.
+//
+// Default values that call provider-defined functions cannot be evaluated
+// while decoding the configuration, so package configs leaves a placeholder
+// in Default and retains the expression in DefaultExpr for us to evaluate
+// once the providers are available.
+func evalVariableDefault(ctx context.Context, evalCtx EvalContext, addr addrs.AbsInputVariableInstance, cfg *configs.Variable) (*configs.Variable, tfdiags.Diagnostics) {
+	if cfg.DefaultExpr == nil {
+		return cfg, nil
+	}
+	log.Printf("[TRACE] evalVariableDefault: evaluating the default value for %s", addr)
+
+	scope := evalCtx.WithPath(addr.Module).EvaluationScope(nil, nil, EvalDataForNoInstanceKey)
+	val, diags := scope.EvalExpr(ctx, cfg.DefaultExpr, cty.DynamicPseudoType)
+	if diags.HasErrors() {
+		return cfg, diags
+	}
+
+	ret := *cfg
+	ret.Default = val
+	return &ret, diags
+}
+
 func prepareFinalInputVariableValue(addr addrs.AbsInputVariableInstance, raw *InputValue, cfg *configs.Variable) (cty.Value, tfdiags.Diagnostics) {
 	var diags tfdiags.Diagnostics
 
@@ -74,6 +74,15 @@ type Evaluator struct {
 	InstanceExpander *instances.Expander
 
 	PlanTimestamp time.Time
+
+	// DisabledFunctions is an optional set of function name patterns that
+	// must not be callable in this evaluator's scopes.
+	DisabledFunctions []string
+
+	// FunctionResults is a cache of function call results shared by all
+	// evaluators in the same graph walk, or nil if function results should
+	// not be reused.
+	FunctionResults *lang.FunctionResults
 }
 
 // Scope creates an evaluation scope for the given module path and optional
@@ -91,6 +100,8 @@ func (e *Evaluator) Scope(data lang.Data, self addrs.Referenceable, source addrs
 		PureOnly:          e.Operation != walkApply && e.Operation != walkDestroy && e.Operation != walkEval,
 		BaseDir:           ".", // Always current working directory for now.
 		PlanTimestamp:     e.PlanTimestamp,
+		DisabledFunctions: e.DisabledFunctions,
+		FunctionResults:   e.FunctionResults,
 		ProviderFunctions: functions,
 	}
 }
@@ -315,6 +315,26 @@ func candidateSuggestion(addr addrs.Resource, cfg *configs.Config) interface{} {
 		}
 	}
 
+	// Otherwise we'll look for a near-miss of the type and name among the
+	// resources of the same mode, to catch simple typos.
+	var resources map[string]*configs.Resource
+	switch addr.Mode {
+	case addrs.ManagedResourceMode:
+		resources = cfg.Module.ManagedResources
+	case addrs.DataResourceMode:
+		resources = cfg.Module.DataResources
+	case addrs.EphemeralResourceMode:
+		resources = cfg.Module.EphemeralResources
+	}
+	suggestions := make([]string, 0, len(resources))
+	for _, rc := range resources {
+		suggestions = append(suggestions, rc.Addr().String())
+	}
+	sort.Strings(suggestions)
+	if suggestion := didyoumean.NameSuggestion(addr.String(), suggestions); suggestion != "" {
+		return fmt.Sprintf("\n\nDid you mean %s?", suggestion)
+	}
+
 	return ""
 }
 
@@ -322,7 +342,9 @@ func (d *evaluationStateData) staticValidateModuleCallReference(modCfg *configs.
 	var diags tfdiags.Diagnostics
 
 	// For now, our focus here is just in testing that the referenced module
-	// call exists. All other validation is deferred until evaluation time.
+	// call exists and, if possible, that the referenced output is declared
+	// in the child module. All other validation is deferred until
+	// evaluation time.
 	_, exists := modCfg.Module.ModuleCalls[addr.Name]
 	if !exists {
 		var suggestions []string
@@ -344,6 +366,54 @@ func (d *evaluationStateData) staticValidateModuleCallReference(modCfg *configs.
 		return diags
 	}
 
+	diags = diags.Append(staticValidateModuleOutputReference(modCfg.Children[addr.Name], addr, remain))
+	return diags
+}
+
+// staticValidateModuleOutputReference checks that the first attribute in
+// the given traversal after a module call reference, if any, names an
+// output value that is declared in the called module.
+func staticValidateModuleOutputReference(childCfg *configs.Config, addr addrs.ModuleCall, remain hcl.Traversal) tfdiags.Diagnostics {
+	var diags tfdiags.Diagnostics
+
+	if childCfg == nil {
+		// The child module might not have been loaded if e.g. it failed to
+		// install, in which case some other layer has already reported that.
+		return diags
+	}
+	if len(remain) > 0 {
+		if _, ok := remain[0].(hcl.TraverseIndex); ok {
+			// Skip over the instance key for a multi-instance module call.
+			remain = remain[1:]
+		}
+	}
+	if len(remain) == 0 {
+		return diags
+	}
+	step, ok := remain[0].(hcl.TraverseAttr)
+	if !ok {
+		return diags
+	}
+	if _, exists := childCfg.Module.Outputs[step.Name]; exists {
+		return diags
+	}
+
+	suggestions := make([]string, 0, len(childCfg.Module.Outputs))
+	for name := range childCfg.Module.Outputs {
+		suggestions = append(suggestions, name)
+	}
+	sort.Strings(suggestions)
+	suggestion := didyoumean.NameSuggestion(step.Name, suggestions)
+	if suggestion != "" {
+		suggestion = fmt.Sprintf(" Did you mean %q?", suggestion)
+	}
+
+	diags = diags.Append(&hcl.Diagnostic{
+		Severity: hcl.DiagError,
+		Summary:  `Reference to undeclared output value`,
+		Detail:   fmt.Sprintf(`The module called by module.%s does not declare an output value named %q.%s`, addr.Name, step.Name, suggestion),
+		Subject:  step.SrcRange.Ptr(),
+	})
 	return diags
 }
 
@@ -99,6 +99,42 @@ Did you mean the ephemeral resource ephemeral.foo.bar?`,
 
 Did you mean the data resource data.beep.boop?`,
 		},
+		{
+			Ref: "aws_instance.no_cuont",
+			WantErr: `Reference to undeclared resource: There is no managed resource "aws_instance" "no_cuont" definition in the root module.
+
+Did you mean aws_instance.no_count?`,
+		},
+		{
+			Ref: "data.beep.bop",
+			WantErr: `Reference to undeclared resource: There is no data resource "beep" "bop" definition in the root module.
+
+Did you mean data.beep.boop?`,
+		},
+		{
+			Ref:     "module.child.instance_id",
+			WantErr: ``,
+		},
+		{
+			Ref:     "module.chidl.instance_id",
+			WantErr: `Reference to undeclared module: No module call named "chidl" is declared in the root module. Did you mean "child"?`,
+		},
+		{
+			Ref:     "module.child.instance_ids",
+			WantErr: `Reference to undeclared output value: The module called by module.child does not declare an output value named "instance_ids". Did you mean "instance_id"?`,
+		},
+		{
+			Ref:     "module.children[0].instance_id",
+			WantErr: ``,
+		},
+		{
+			Ref:     "module.children[0].instnace_id",
+			WantErr: `Reference to undeclared output value: The module called by module.children does not declare an output value named "instnace_id". Did you mean "instance_id"?`,
+		},
+		{
+			Ref:     "module.child.unrelated",
+			WantErr: `Reference to undeclared output value: The module called by module.child does not declare an output value named "unrelated".`,
+		},
 	}
 
 	cfg := testModule(t, "static-validate-refs")
@@ -47,6 +47,11 @@ type EvalGraphBuilder struct {
 	Plugins *contextPlugins
 
 	ProviderFunctionTracker ProviderFunctionMapping
+
+	// ConfigureProviders causes the provider nodes in the graph to configure
+	// their provider instances, rather than only starting them up to get
+	// their schemas. This is used for the apply preflight checks.
+	ConfigureProviders bool
 }
 
 // See GraphBuilder
@@ -60,6 +65,11 @@ func (b *EvalGraphBuilder) Build(ctx context.Context, path addrs.ModuleInstance)
 // See GraphBuilder
 func (b *EvalGraphBuilder) Steps() []GraphTransformer {
 	concreteProvider := func(a *NodeAbstractProvider) dag.Vertex {
+		if b.ConfigureProviders {
+			return &NodeApplyableProvider{
+				NodeAbstractProvider: a,
+			}
+		}
 		return &NodeEvalableProvider{
 			NodeAbstractProvider: a,
 		}
@@ -113,7 +123,7 @@ func (b *EvalGraphBuilder) Steps() []GraphTransformer {
 		// have to connect again later for providers and so on.
 		&ReferenceTransformer{},
 
-		// Although we don't configure providers, we do still start them up
+		// Even when we don't configure providers, we do still start them up
 		// to get their schemas, and so we must shut them down again here.
 		&CloseProviderTransformer{},
 
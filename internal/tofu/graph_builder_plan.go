@@ -59,6 +59,10 @@ type PlanGraphBuilder struct {
 	// skipRefresh indicates that we should skip refreshing managed resources
 	skipRefresh bool
 
+	// refreshTargets, if not empty, limits refreshing to the managed
+	// resource instances they contain.
+	refreshTargets []addrs.Targetable
+
 	// preDestroyRefresh indicates that we are executing the refresh which
 	// happens immediately before a destroy plan, which happens to use the
 	// normal planing mode so skipPlanChanges cannot be set.
@@ -99,6 +103,10 @@ type PlanGraphBuilder struct {
 	GenerateConfigPath string
 
 	ProviderFunctionTracker ProviderFunctionMapping
+
+	// keepUnusedProviders retains the provider configurations that nothing
+	// uses, so that a validate walk checks them against their schemas.
+	keepUnusedProviders bool
 }
 
 // See GraphBuilder
@@ -226,7 +234,7 @@ func (b *PlanGraphBuilder) Steps() []GraphTransformer {
 		&ProviderFunctionTransformer{Config: b.Config, ProviderFunctionTracker: b.ProviderFunctionTracker},
 
 		// Remove unused providers and proxies
-		&PruneProviderTransformer{},
+		&PruneProviderTransformer{KeepConfigured: b.keepUnusedProviders},
 
 		// Create expansion nodes for all of the module calls. This must
 		// come after all other transformers that create nodes representing
@@ -296,6 +304,7 @@ func (b *PlanGraphBuilder) initPlan() {
 		return &nodeExpandPlannableResource{
 			NodeAbstractResource: a,
 			skipRefresh:          b.skipRefresh,
+			refreshTargets:       b.refreshTargets,
 			skipPlanChanges:      b.skipPlanChanges,
 			preDestroyRefresh:    b.preDestroyRefresh,
 			forceReplace:         b.ForceReplace,
@@ -305,7 +314,7 @@ func (b *PlanGraphBuilder) initPlan() {
 	b.ConcreteResourceOrphan = func(a *NodeAbstractResourceInstance) dag.Vertex {
 		return &NodePlannableResourceInstanceOrphan{
 			NodeAbstractResourceInstance: a,
-			skipRefresh:                  b.skipRefresh,
+			skipRefresh:                  skipRefreshFor(a.Addr, b.skipRefresh, b.refreshTargets),
 			skipPlanChanges:              b.skipPlanChanges,
 			RemoveStatements:             b.RemoveStatements,
 		}
@@ -316,7 +325,7 @@ func (b *PlanGraphBuilder) initPlan() {
 			NodeAbstractResourceInstance: a,
 			DeposedKey:                   key,
 
-			skipRefresh:      b.skipRefresh,
+			skipRefresh:      skipRefreshFor(a.Addr, b.skipRefresh, b.refreshTargets),
 			skipPlanChanges:  b.skipPlanChanges,
 			RemoveStatements: b.RemoveStatements,
 		}
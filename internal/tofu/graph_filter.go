@@ -0,0 +1,115 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tofu
+
+import (
+	"fmt"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/dag"
+)
+
+// GraphFilter describes which vertices of a graph to keep when rendering it
+// for the UI. A vertex is kept only if it matches all of the fields that are
+// set.
+type GraphFilter struct {
+	// Module, if not nil, keeps only the vertices that belong to the given
+	// module or to any of its descendant modules.
+	Module addrs.Module
+
+	// ResourceType, if not empty, keeps only the vertices representing
+	// resources of the given type.
+	ResourceType string
+
+	// Focus, if not nil, keeps only the vertices representing the given
+	// resource, and the vertices it depends on or that depend on it,
+	// directly or indirectly.
+	Focus *addrs.ConfigResource
+}
+
+// FilterGraph returns a new graph containing only the vertices of the given
+// graph selected by the filter.
+//
+// When a dependency between two of the kept vertices goes through vertices
+// that were removed, the result has an edge directly between the two kept
+// vertices, so that the filtered graph still shows every dependency.
+func FilterGraph(g *Graph, filter *GraphFilter) (*Graph, error) {
+	var focus dag.Set
+	if filter.Focus != nil {
+		focus = make(dag.Set)
+		for _, v := range g.Vertices() {
+			rv, ok := v.(GraphNodeConfigResource)
+			if !ok || !rv.ResourceAddr().Equal(*filter.Focus) {
+				continue
+			}
+			focus.Add(v)
+			deps, err := g.Ancestors(v)
+			if err != nil {
+				return nil, err
+			}
+			dependents, err := g.Descendents(v)
+			if err != nil {
+				return nil, err
+			}
+			for _, s := range []dag.Set{deps, dependents} {
+				for _, dv := range s {
+					focus.Add(dv)
+				}
+			}
+		}
+		if focus.Len() == 0 {
+			return nil, fmt.Errorf("the graph has no resource %s", *filter.Focus)
+		}
+	}
+
+	keep := func(v dag.Vertex) bool {
+		if focus != nil && !focus.Include(v) {
+			return false
+		}
+		if filter.Module != nil {
+			mv, ok := v.(GraphNodeModulePath)
+			if !ok || !filter.Module.TargetContains(mv.ModulePath()) {
+				return false
+			}
+		}
+		if filter.ResourceType != "" {
+			rv, ok := v.(GraphNodeConfigResource)
+			if !ok || rv.ResourceAddr().Resource.Type != filter.ResourceType {
+				return false
+			}
+		}
+		return true
+	}
+
+	ret := &Graph{Path: g.Path}
+	for _, v := range g.Vertices() {
+		if keep(v) {
+			ret.Add(v)
+		}
+	}
+
+	// For each kept vertex, we follow its dependencies through any removed
+	// vertices until we reach kept vertices, and connect to those.
+	for _, v := range ret.Vertices() {
+		seen := make(dag.Set)
+		next := g.DownEdges(v).List()
+		for len(next) > 0 {
+			dep := next[len(next)-1]
+			next = next[:len(next)-1]
+			if seen.Include(dep) {
+				continue
+			}
+			seen.Add(dep)
+			if ret.HasVertex(dep) {
+				ret.Connect(dag.BasicEdge(v, dep))
+				continue
+			}
+			next = append(next, g.DownEdges(dep).List()...)
+		}
+	}
+
+	return ret, nil
+}
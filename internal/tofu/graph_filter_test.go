@@ -0,0 +1,149 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tofu
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/dag"
+)
+
+// testFilterResource is a graph vertex representing a resource, for testing
+// FilterGraph.
+type testFilterResource struct {
+	addr addrs.ConfigResource
+}
+
+func (n *testFilterResource) Name() string                       { return n.addr.String() }
+func (n *testFilterResource) ModulePath() addrs.Module           { return n.addr.Module }
+func (n *testFilterResource) ResourceAddr() addrs.ConfigResource { return n.addr }
+
+// testFilterLocal is a graph vertex in a module that isn't a resource.
+type testFilterLocal struct {
+	name string
+}
+
+func (n *testFilterLocal) Name() string             { return "local." + n.name }
+func (n *testFilterLocal) ModulePath() addrs.Module { return addrs.RootModule }
+
+func testFilterGraph(t *testing.T) *Graph {
+	t.Helper()
+	resource := func(addr string) *testFilterResource {
+		abs, diags := addrs.ParseAbsResourceStr(addr)
+		if diags.HasErrors() {
+			t.Fatal(diags.Err())
+		}
+		return &testFilterResource{addr: abs.Config()}
+	}
+
+	// module.app.aws_vpc.network <- local.subnets <- module.app.aws_instance.web <- aws_lb.front
+	// aws_s3_bucket.logs is unrelated to the others.
+	network := resource("module.app.aws_vpc.network")
+	subnets := &testFilterLocal{name: "subnets"}
+	web := resource("module.app.aws_instance.web")
+	front := resource("aws_lb.front")
+	logs := resource("aws_s3_bucket.logs")
+
+	g := &Graph{}
+	for _, v := range []dag.Vertex{network, subnets, web, front, logs} {
+		g.Add(v)
+	}
+	g.Connect(dag.BasicEdge(subnets, network))
+	g.Connect(dag.BasicEdge(web, subnets))
+	g.Connect(dag.BasicEdge(front, web))
+	return g
+}
+
+func TestFilterGraph(t *testing.T) {
+	webAddr := addrs.ConfigResource{
+		Module: addrs.RootModule.Child("app"),
+		Resource: addrs.Resource{
+			Mode: addrs.ManagedResourceMode,
+			Type: "aws_instance",
+			Name: "web",
+		},
+	}
+
+	tests := map[string]struct {
+		filter *GraphFilter
+		want   string
+	}{
+		"module": {
+			// The dependency of web on network goes through local.subnets,
+			// which is in the root module, so it becomes a direct edge.
+			&GraphFilter{Module: addrs.RootModule.Child("app")},
+			`
+module.app.aws_instance.web
+  module.app.aws_vpc.network
+module.app.aws_vpc.network
+`,
+		},
+		"resource type": {
+			&GraphFilter{ResourceType: "aws_lb"},
+			`
+aws_lb.front
+`,
+		},
+		"focus": {
+			&GraphFilter{Focus: &webAddr},
+			`
+aws_lb.front
+  module.app.aws_instance.web
+local.subnets
+  module.app.aws_vpc.network
+module.app.aws_instance.web
+  local.subnets
+module.app.aws_vpc.network
+`,
+		},
+		"combined": {
+			&GraphFilter{Focus: &webAddr, ResourceType: "aws_vpc"},
+			`
+module.app.aws_vpc.network
+`,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := FilterGraph(testFilterGraph(t), test.filter)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, want := strings.TrimSpace(got.String()), strings.TrimSpace(test.want); got != want {
+				t.Errorf("wrong graph\ngot:\n%s\n\nwant:\n%s", got, want)
+			}
+		})
+	}
+}
+
+func TestFilterGraph_rootModule(t *testing.T) {
+	g := testFilterGraph(t)
+	got, err := FilterGraph(g, &GraphFilter{Module: addrs.RootModule})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Without a resource type, every vertex in the root module or below is
+	// kept, so the graph is unchanged.
+	if got, want := got.String(), g.String(); got != want {
+		t.Errorf("wrong graph\ngot:\n%s\n\nwant:\n%s", got, want)
+	}
+}
+
+func TestFilterGraph_focusNotFound(t *testing.T) {
+	addr := addrs.ConfigResource{
+		Resource: addrs.Resource{
+			Mode: addrs.ManagedResourceMode,
+			Type: "aws_instance",
+			Name: "missing",
+		},
+	}
+	_, err := FilterGraph(testFilterGraph(t), &GraphFilter{Focus: &addr})
+	if err == nil || !strings.Contains(err.Error(), "the graph has no resource aws_instance.missing") {
+		t.Fatalf("wrong error: %v", err)
+	}
+}
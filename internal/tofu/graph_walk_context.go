@@ -17,6 +17,7 @@ import (
 	"github.com/opentofu/opentofu/internal/configs"
 	"github.com/opentofu/opentofu/internal/encryption"
 	"github.com/opentofu/opentofu/internal/instances"
+	"github.com/opentofu/opentofu/internal/lang"
 	"github.com/opentofu/opentofu/internal/plans"
 	"github.com/opentofu/opentofu/internal/refactoring"
 	"github.com/opentofu/opentofu/internal/states"
@@ -37,6 +38,7 @@ type ContextGraphWalker struct {
 	Checks                  *checks.State           // Used for safe concurrent writes of checkable objects and their check results
 	InstanceExpander        *instances.Expander     // Tracks our gradual expansion of module and resource instances
 	ImportResolver          *ImportResolver         // Tracks import targets as they are being resolved
+	UnknownCauses           *UnknownCauses          // Tracks the causes of unknown values during planning
 	MoveResults             refactoring.MoveResults // Read-only record of earlier processing of move statements
 	Operation               walkOperation
 	StopContext             context.Context
@@ -58,6 +60,15 @@ type ContextGraphWalker struct {
 	variableValues     map[string]map[string]cty.Value
 
 	providerInputConfigLock sync.Mutex
+
+	functionResults *lang.FunctionResults
+
+	// applySchedule records the progress of each node during an apply walk,
+	// if set.
+	applySchedule *applyScheduleTracker
+
+	serializeGroupsLock sync.Mutex
+	serializeGroups     map[string]*sync.Mutex
 }
 
 var _ GraphWalker = (*ContextGraphWalker)(nil)
@@ -94,28 +105,32 @@ func (w *ContextGraphWalker) EvalContext() EvalContext {
 		VariableValuesLock: &w.variableValuesLock,
 		InstanceExpander:   w.InstanceExpander,
 		PlanTimestamp:      w.PlanTimestamp,
+		DisabledFunctions:  w.Context.disabledFunctions,
+		FunctionResults:    w.functionResults,
 	}
 
 	ctx := &BuiltinEvalContext{
-		StopContext:             w.StopContext,
-		Hooks:                   w.Context.hooks,
-		InputValue:              w.Context.uiInput,
-		InstanceExpanderValue:   w.InstanceExpander,
-		Plugins:                 w.Context.plugins,
-		MoveResultsValue:        w.MoveResults,
-		ImportResolverValue:     w.ImportResolver,
-		ProviderInputConfigLock: &w.providerInputConfigLock,
-		ProviderInputConfig:     w.Context.providerInputConfig,
-		ChangesValue:            w.Changes,
-		ChecksValue:             w.Checks,
-		StateValue:              w.State,
-		RefreshStateValue:       w.RefreshState,
-		PrevRunStateValue:       w.PrevRunState,
-		Evaluator:               evaluator,
-		VariableValues:          w.variableValues,
-		VariableValuesLock:      &w.variableValuesLock,
-		Encryption:              w.Encryption,
-		ProviderFunctionTracker: w.ProviderFunctionTracker,
+		StopContext:              w.StopContext,
+		Hooks:                    w.Context.hooks,
+		InputValue:               w.Context.uiInput,
+		InstanceExpanderValue:    w.InstanceExpander,
+		Plugins:                  w.Context.plugins,
+		MoveResultsValue:         w.MoveResults,
+		ImportResolverValue:      w.ImportResolver,
+		UnknownCausesValue:       w.UnknownCauses,
+		ProviderInputConfigLock:  &w.providerInputConfigLock,
+		ProviderInputConfig:      w.Context.providerInputConfig,
+		ChangesValue:             w.Changes,
+		ChecksValue:              w.Checks,
+		StateValue:               w.State,
+		RefreshStateValue:        w.RefreshState,
+		PrevRunStateValue:        w.PrevRunState,
+		Evaluator:                evaluator,
+		VariableValues:           w.variableValues,
+		VariableValuesLock:       &w.variableValuesLock,
+		Encryption:               w.Encryption,
+		ProviderFunctionTracker:  w.ProviderFunctionTracker,
+		ProviderReadRetriesValue: w.Context.providerReadRetries,
 	}
 
 	return ctx
@@ -131,12 +146,110 @@ func (w *ContextGraphWalker) init() {
 	for k, iv := range w.RootVariableValues {
 		w.variableValues[""][k] = iv.Value
 	}
+
+	// Results of expensive pure function calls are shared across the whole
+	// walk, except when applying: provisioners and providers may legitimately
+	// change files on disk during apply, which templatefile might then read.
+	if w.Operation != walkApply && w.Operation != walkDestroy {
+		w.functionResults = lang.NewFunctionResults()
+	}
 }
 
 func (w *ContextGraphWalker) Execute(ctx context.Context, evalCtx EvalContext, n GraphNodeExecutable) tfdiags.Diagnostics {
-	// Acquire a lock on the semaphore
-	w.Context.parallelSem.Acquire()
+	// Acquire a lock on the semaphore. When the walk is already running as
+	// many nodes as the parallelism limit allows, nodes with a higher apply
+	// priority are started first. Nodes only get here once all of their
+	// dependencies are complete, so this doesn't affect correctness.
+	priority := 0
+	group := ""
+	if w.Operation == walkApply || w.Operation == walkDestroy {
+		if pn, ok := n.(GraphNodeApplyPriority); ok {
+			priority = pn.ApplyPriority()
+		}
+		if gn, ok := n.(GraphNodeSerializeGroup); ok {
+			group = gn.SerializeGroup()
+		}
+	}
+	if w.applySchedule != nil {
+		w.applySchedule.update(n, applyStepQueued)
+		defer w.applySchedule.update(n, applyStepDone)
+	}
+	// Nodes in the same serialize group take turns, as if each depended on
+	// the one before it. The group lock is taken before the semaphore so
+	// that nodes waiting for their turn don't use up the parallelism limit.
+	if group != "" {
+		lock := w.serializeGroupLock(group)
+		lock.Lock()
+		defer lock.Unlock()
+	}
+	// The separate limit for reads or writes, if any, is taken before the
+	// overall limit for the same reason. Other nodes, such as those for
+	// providers, outputs, and expanding resources, only have the overall
+	// limit.
+	var kindSem *PrioritySemaphore
+	switch {
+	case isWriteNode(w.Operation, n):
+		kindSem = w.Context.writeSem
+	case isReadNode(w.Operation, n):
+		kindSem = w.Context.readSem
+	}
+	if kindSem != nil {
+		kindSem.Acquire(priority)
+		defer kindSem.Release()
+	}
+	w.Context.parallelSem.Acquire(priority)
 	defer w.Context.parallelSem.Release()
+	if w.applySchedule != nil {
+		w.applySchedule.update(n, applyStepRunning)
+	}
 
 	return n.Execute(ctx, evalCtx, w.Operation)
 }
+
+// isWriteNode returns true if the given node may change remote objects when
+// executed in a walk for the given operation, and so is limited by the write
+// parallelism rather than the read parallelism, in addition to the overall
+// parallelism.
+func isWriteNode(op walkOperation, n GraphNodeExecutable) bool {
+	if op != walkApply && op != walkDestroy {
+		return false
+	}
+	rn, ok := n.(GraphNodeResourceInstance)
+	return ok && rn.ResourceInstanceAddr().Resource.Resource.Mode == addrs.ManagedResourceMode
+}
+
+// isReadNode returns true if the given node only reads remote objects when
+// executed in a walk for the given operation, by refreshing a managed
+// resource instance or reading a data resource instance, and so is limited by
+// the read parallelism in addition to the overall parallelism.
+func isReadNode(op walkOperation, n GraphNodeExecutable) bool {
+	rn, ok := n.(GraphNodeResourceInstance)
+	if !ok {
+		return false
+	}
+	switch op {
+	case walkPlan, walkPlanDestroy, walkImport:
+		return true
+	case walkApply, walkDestroy:
+		return rn.ResourceInstanceAddr().Resource.Resource.Mode == addrs.DataResourceMode
+	default:
+		return false
+	}
+}
+
+// serializeGroupLock returns the lock that nodes in the given serialize group
+// hold while they execute.
+func (w *ContextGraphWalker) serializeGroupLock(group string) *sync.Mutex {
+	w.serializeGroupsLock.Lock()
+	defer w.serializeGroupsLock.Unlock()
+
+	if w.serializeGroups == nil {
+		w.serializeGroups = make(map[string]*sync.Mutex)
+	}
+	lock, ok := w.serializeGroups[group]
+	if !ok {
+		lock = &sync.Mutex{}
+		w.serializeGroups[group] = lock
+	}
+	return lock
+}
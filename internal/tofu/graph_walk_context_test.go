@@ -0,0 +1,62 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tofu
+
+import (
+	"testing"
+)
+
+func TestIsReadNode(t *testing.T) {
+	managed := NewNodeAbstractResourceInstance(mustResourceInstanceAddr("test_object.a"))
+	data := NewNodeAbstractResourceInstance(mustResourceInstanceAddr("data.test_object.a"))
+
+	tests := map[string]struct {
+		op   walkOperation
+		node GraphNodeExecutable
+		want bool
+	}{
+		"refresh": {
+			op:   walkPlan,
+			node: &NodePlannableResourceInstance{NodeAbstractResourceInstance: managed},
+			want: true,
+		},
+		"read data source during plan": {
+			op:   walkPlan,
+			node: &NodePlannableResourceInstance{NodeAbstractResourceInstance: data},
+			want: true,
+		},
+		"read data source during apply": {
+			op:   walkApply,
+			node: &NodeApplyableResourceInstance{NodeAbstractResourceInstance: data},
+			want: true,
+		},
+		"apply change": {
+			op:   walkApply,
+			node: &NodeApplyableResourceInstance{NodeAbstractResourceInstance: managed},
+			want: false,
+		},
+		"validate": {
+			op:   walkValidate,
+			node: &NodePlannableResourceInstance{NodeAbstractResourceInstance: managed},
+			want: false,
+		},
+		"provider": {
+			op: walkPlan,
+			node: &NodeApplyableProvider{NodeAbstractProvider: &NodeAbstractProvider{
+				Addr: mustProviderConfig(`provider["registry.opentofu.org/hashicorp/test"]`),
+			}},
+			want: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := isReadNode(test.op, test.node); got != test.want {
+				t.Errorf("isReadNode() = %t; want %t", got, test.want)
+			}
+		})
+	}
+}
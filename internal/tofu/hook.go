@@ -6,6 +6,8 @@
 package tofu
 
 import (
+	"time"
+
 	"github.com/zclconf/go-cty/cty"
 
 	"github.com/opentofu/opentofu/internal/addrs"
@@ -41,6 +43,21 @@ type Hook interface {
 	PreApply(addr addrs.AbsResourceInstance, gen states.Generation, action plans.Action, priorState, plannedNewState cty.Value) (HookAction, error)
 	PostApply(addr addrs.AbsResourceInstance, gen states.Generation, newState cty.Value, err error) (HookAction, error)
 
+	// ApplyProgress is called between PreApply and PostApply each time the
+	// provider reports progress of the in-progress apply action. It cannot
+	// control whether the action continues.
+	ApplyProgress(addr addrs.AbsResourceInstance, gen states.Generation, progress providers.Progress)
+
+	// ApplySchedule is called periodically during a long-running apply
+	// operation with the changes that are running and what the others are
+	// waiting for. It cannot control whether the operation continues.
+	ApplySchedule(schedule ApplySchedule)
+
+	// ApplyBudgetExceeded is called when a change to a resource instance has
+	// been in progress for longer than the apply_within duration given in
+	// the resource's lifecycle block. The change continues regardless.
+	ApplyBudgetExceeded(addr addrs.AbsResourceInstance, gen states.Generation, action plans.Action, budget time.Duration)
+
 	// PreDiff and PostDiff are called before and after a provider is given
 	// the opportunity to customize the proposed new state to produce the
 	// planned new state.
@@ -154,6 +171,15 @@ func (*NilHook) PostApply(addr addrs.AbsResourceInstance, gen states.Generation,
 	return HookActionContinue, nil
 }
 
+func (*NilHook) ApplyProgress(addr addrs.AbsResourceInstance, gen states.Generation, progress providers.Progress) {
+}
+
+func (*NilHook) ApplySchedule(schedule ApplySchedule) {
+}
+
+func (*NilHook) ApplyBudgetExceeded(addr addrs.AbsResourceInstance, gen states.Generation, action plans.Action, budget time.Duration) {
+}
+
 func (*NilHook) PreDiff(addr addrs.AbsResourceInstance, gen states.Generation, priorState, proposedNewState cty.Value) (HookAction, error) {
 	return HookActionContinue, nil
 }
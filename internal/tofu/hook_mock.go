@@ -7,6 +7,7 @@ package tofu
 
 import (
 	"sync"
+	"time"
 
 	"github.com/zclconf/go-cty/cty"
 
@@ -39,6 +40,19 @@ type MockHook struct {
 	PostApplyReturnError error
 	PostApplyFn          func(addrs.AbsResourceInstance, states.Generation, cty.Value, error) (HookAction, error)
 
+	ApplyProgressCalled   bool
+	ApplyProgressAddr     addrs.AbsResourceInstance
+	ApplyProgressGen      states.Generation
+	ApplyProgressProgress []providers.Progress
+
+	ApplyScheduleCalled   bool
+	ApplyScheduleSchedule []ApplySchedule
+
+	ApplyBudgetExceededCalled bool
+	ApplyBudgetExceededAddr   addrs.AbsResourceInstance
+	ApplyBudgetExceededAction plans.Action
+	ApplyBudgetExceededBudget time.Duration
+
 	PreDiffCalled        bool
 	PreDiffAddr          addrs.AbsResourceInstance
 	PreDiffGen           states.Generation
@@ -220,6 +234,34 @@ func (h *MockHook) PostApply(addr addrs.AbsResourceInstance, gen states.Generati
 	return h.PostApplyReturn, h.PostApplyReturnError
 }
 
+func (h *MockHook) ApplyProgress(addr addrs.AbsResourceInstance, gen states.Generation, progress providers.Progress) {
+	h.Lock()
+	defer h.Unlock()
+
+	h.ApplyProgressCalled = true
+	h.ApplyProgressAddr = addr
+	h.ApplyProgressGen = gen
+	h.ApplyProgressProgress = append(h.ApplyProgressProgress, progress)
+}
+
+func (h *MockHook) ApplySchedule(schedule ApplySchedule) {
+	h.Lock()
+	defer h.Unlock()
+
+	h.ApplyScheduleCalled = true
+	h.ApplyScheduleSchedule = append(h.ApplyScheduleSchedule, schedule)
+}
+
+func (h *MockHook) ApplyBudgetExceeded(addr addrs.AbsResourceInstance, gen states.Generation, action plans.Action, budget time.Duration) {
+	h.Lock()
+	defer h.Unlock()
+
+	h.ApplyBudgetExceededCalled = true
+	h.ApplyBudgetExceededAddr = addr
+	h.ApplyBudgetExceededAction = action
+	h.ApplyBudgetExceededBudget = budget
+}
+
 func (h *MockHook) PreDiff(addr addrs.AbsResourceInstance, gen states.Generation, priorState, proposedNewState cty.Value) (HookAction, error) {
 	h.Lock()
 	defer h.Unlock()
@@ -8,6 +8,7 @@ package tofu
 import (
 	"errors"
 	"sync/atomic"
+	"time"
 
 	"github.com/zclconf/go-cty/cty"
 
@@ -33,6 +34,15 @@ func (h *stopHook) PostApply(addr addrs.AbsResourceInstance, gen states.Generati
 	return h.hook()
 }
 
+func (h *stopHook) ApplyProgress(addr addrs.AbsResourceInstance, gen states.Generation, progress providers.Progress) {
+}
+
+func (h *stopHook) ApplySchedule(schedule ApplySchedule) {
+}
+
+func (h *stopHook) ApplyBudgetExceeded(addr addrs.AbsResourceInstance, gen states.Generation, action plans.Action, budget time.Duration) {
+}
+
 func (h *stopHook) PreDiff(addr addrs.AbsResourceInstance, gen states.Generation, priorState, proposedNewState cty.Value) (HookAction, error) {
 	return h.hook()
 }
@@ -8,6 +8,7 @@ package tofu
 import (
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/zclconf/go-cty/cty"
 
@@ -53,6 +54,24 @@ func (h *testHook) PostApply(addr addrs.AbsResourceInstance, gen states.Generati
 	return HookActionContinue, nil
 }
 
+func (h *testHook) ApplyProgress(addr addrs.AbsResourceInstance, gen states.Generation, progress providers.Progress) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.Calls = append(h.Calls, &testHookCall{"ApplyProgress", addr.String()})
+}
+
+func (h *testHook) ApplySchedule(schedule ApplySchedule) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.Calls = append(h.Calls, &testHookCall{"ApplySchedule", ""})
+}
+
+func (h *testHook) ApplyBudgetExceeded(addr addrs.AbsResourceInstance, gen states.Generation, action plans.Action, budget time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.Calls = append(h.Calls, &testHookCall{"ApplyBudgetExceeded", addr.String()})
+}
+
 func (h *testHook) PreDiff(addr addrs.AbsResourceInstance, gen states.Generation, priorState, proposedNewState cty.Value) (HookAction, error) {
 	h.mu.Lock()
 	defer h.mu.Unlock()
@@ -171,6 +171,7 @@ func (n *NodeLocal) Execute(ctx context.Context, evalCtx EvalContext, op walkOpe
 	}
 
 	state.SetLocalValue(addr.Absolute(evalCtx.Path()), val)
+	recordUnknownCauses(ctx, evalCtx, nil, addr.Absolute(evalCtx.Path()).String(), val, expr)
 
 	return diags
 }
@@ -9,10 +9,13 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/hcl/v2"
+
 	"github.com/opentofu/opentofu/internal/addrs"
 	"github.com/opentofu/opentofu/internal/configs"
 	"github.com/opentofu/opentofu/internal/dag"
 	"github.com/opentofu/opentofu/internal/lang"
+	"github.com/opentofu/opentofu/internal/lang/evalchecks"
 	"github.com/opentofu/opentofu/internal/tfdiags"
 )
 
@@ -77,6 +80,10 @@ func (n *nodeExpandModule) References() []*addrs.Reference {
 		forEachRefs, _ := lang.ReferencesInExpr(addrs.ParseRef, n.ModuleCall.ForEach)
 		refs = append(refs, forEachRefs...)
 	}
+	if n.ModuleCall.ForEachKey != nil {
+		keyRefs, _ := lang.ReferencesInExpr(addrs.ParseRef, n.ModuleCall.ForEachKey)
+		refs = append(refs, keyRefs...)
+	}
 	if n.ModuleCall.Enabled != nil {
 		enabledRefs, _ := lang.ReferencesInExpr(addrs.ParseRef, n.ModuleCall.Enabled)
 		refs = append(refs, enabledRefs...)
@@ -132,6 +139,7 @@ func (n *nodeExpandModule) Execute(ctx context.Context, evalCtx EvalContext, op
 		switch {
 		case n.ModuleCall.Count != nil:
 			count, ctDiags := evaluateCountExpression(ctx, n.ModuleCall.Count, evalCtx, module)
+			ctDiags = n.explainUnknownExpansion(ctx, evalCtx, "count", n.ModuleCall.Count, ctDiags)
 			diags = diags.Append(ctDiags)
 			if diags.HasErrors() {
 				return diags
@@ -139,7 +147,8 @@ func (n *nodeExpandModule) Execute(ctx context.Context, evalCtx EvalContext, op
 			expander.SetModuleCount(module, call, count)
 
 		case n.ModuleCall.ForEach != nil:
-			forEach, feDiags := evaluateForEachExpression(ctx, n.ModuleCall.ForEach, evalCtx, module)
+			forEach, feDiags := evaluateForEachKeyedExpression(ctx, n.ModuleCall.ForEach, n.ModuleCall.ForEachKey, evalCtx, module)
+			feDiags = n.explainUnknownExpansion(ctx, evalCtx, "for_each", n.ModuleCall.ForEach, feDiags)
 			diags = diags.Append(feDiags)
 			if diags.HasErrors() {
 				return diags
@@ -162,6 +171,41 @@ func (n *nodeExpandModule) Execute(ctx context.Context, evalCtx EvalContext, op
 
 }
 
+// explainUnknownExpansion replaces any error in diags reporting that the given
+// count or for_each expression of the module call has an unknown value with a
+// more detailed error, which lists the unknown values that the expression
+// depends on and suggests how to apply the objects producing them first.
+//
+// evalCtx must be for the module instance containing the module call.
+func (n *nodeExpandModule) explainUnknownExpansion(ctx context.Context, evalCtx EvalContext, argument string, expr hcl.Expression, diags tfdiags.Diagnostics) tfdiags.Diagnostics {
+	if !diags.HasErrors() {
+		return diags
+	}
+
+	exprRange := tfdiags.SourceRangeFromHCL(expr.Range())
+	var ret tfdiags.Diagnostics
+	for _, diag := range diags {
+		subject := diag.Source().Subject
+		if diag.Severity() != tfdiags.Error || !tfdiags.DiagnosticCausedByUnknown(diag) || subject == nil || *subject != exprRange {
+			ret = ret.Append(diag)
+			continue
+		}
+
+		_, call := n.Addr.Call()
+		expansion := &tfdiags.UnknownExpansion{
+			Addr:     evalCtx.Path().ChildCall(call.Name).String(),
+			Argument: argument,
+		}
+		expansion.UnknownValues = unknownCausesInScope(ctx, evalCtx, nil, expr)
+		expansion.Targets = unknownCausesTargets(expansion.UnknownValues)
+
+		refs, _ := lang.ReferencesInExpr(addrs.ParseRef, expr)
+		hclCtx, _ := evalContextScope(ctx, evalCtx)(refs)
+		ret = ret.Append(evalchecks.UnknownExpansionDiagnostic(expr, hclCtx, expansion))
+	}
+	return ret
+}
+
 // nodeCloseModule represents an expanded module during apply, and is visited
 // after all other module instance nodes. This node will depend on all module
 // instance resource and outputs, and anything depending on the module should
@@ -286,8 +330,7 @@ func (n *nodeValidateModule) Execute(ctx context.Context, evalCtx EvalContext, o
 
 		case n.ModuleCall.ForEach != nil:
 			const unknownsAllowed = true
-			const tupleNotAllowed = false
-			_, forEachDiags := evaluateForEachExpressionValue(ctx, n.ModuleCall.ForEach, evalCtx, unknownsAllowed, tupleNotAllowed, module)
+			_, forEachDiags := evaluateForEachKeyedExpressionValue(ctx, n.ModuleCall.ForEach, n.ModuleCall.ForEachKey, evalCtx, unknownsAllowed, module)
 			diags = diags.Append(forEachDiags)
 		}
 
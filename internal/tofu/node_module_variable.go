@@ -41,6 +41,7 @@ var (
 	_ GraphNodeReferencer                            = (*nodeExpandModuleVariable)(nil)
 	_ graphNodeTemporaryValue                        = (*nodeExpandModuleVariable)(nil)
 	_ graphNodeRetainedByPruneUnusedNodesTransformer = (*nodeExpandModuleVariable)(nil)
+	_ graphNodeVariableDefaultReferencer             = (*nodeExpandModuleVariable)(nil)
 )
 
 func (n *nodeExpandModuleVariable) retainDuringUnusedPruning() {}
@@ -104,6 +105,17 @@ func (n *nodeExpandModuleVariable) References() []*addrs.Reference {
 	return refs
 }
 
+// graphNodeVariableDefaultReferencer
+func (n *nodeExpandModuleVariable) DefaultReferences() []*addrs.Reference {
+	// The default value is used only if the module call doesn't set the
+	// variable.
+	if n.Expr != nil || n.Config == nil {
+		return nil
+	}
+	refs, _ := lang.ProviderFunctionsInExpr(addrs.ParseRef, n.Config.DefaultExpr)
+	return refs
+}
+
 // GraphNodeReferenceOutside implementation
 func (n *nodeExpandModuleVariable) ReferenceOutside() (selfPath, referencePath addrs.Module) {
 	return n.Module, n.Module.Parent()
@@ -207,6 +219,7 @@ func (n *nodeModuleVariable) evalModuleVariable(ctx context.Context, evalCtx Eva
 	var diags tfdiags.Diagnostics
 	var givenVal cty.Value
 	var errSourceRange tfdiags.SourceRange
+	config := n.Config
 	if expr := n.Expr; expr != nil {
 		var moduleInstanceRepetitionData instances.RepetitionData
 
@@ -232,12 +245,20 @@ func (n *nodeModuleVariable) evalModuleVariable(ctx context.Context, evalCtx Eva
 		if moreDiags.HasErrors() {
 			return cty.DynamicVal, diags.ErrWithWarnings()
 		}
+		recordUnknownCauses(ctx, evalCtx, scope, n.Addr.String(), val, expr)
 		givenVal = val
 		errSourceRange = tfdiags.SourceRangeFromHCL(expr.Range())
 	} else {
 		// We'll use cty.NilVal to represent the variable not being set at all.
 		givenVal = cty.NilVal
 		errSourceRange = tfdiags.SourceRangeFromHCL(n.Config.DeclRange) // we use the declaration range as a fallback for an undefined variable
+
+		cfg, moreDiags := evalVariableDefault(ctx, evalCtx, n.Addr, n.Config)
+		diags = diags.Append(moreDiags)
+		if moreDiags.HasErrors() {
+			return cty.DynamicVal, diags.ErrWithWarnings()
+		}
+		config = cfg
 	}
 
 	// We construct a synthetic InputValue here to pretend as if this were
@@ -249,7 +270,7 @@ func (n *nodeModuleVariable) evalModuleVariable(ctx context.Context, evalCtx Eva
 		SourceRange: errSourceRange,
 	}
 
-	finalVal, moreDiags := prepareFinalInputVariableValue(n.Addr, rawVal, n.Config)
+	finalVal, moreDiags := prepareFinalInputVariableValue(n.Addr, rawVal, config)
 	diags = diags.Append(moreDiags)
 
 	return finalVal, diags.ErrWithWarnings()
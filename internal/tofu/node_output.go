@@ -354,6 +354,9 @@ func (n *NodeApplyableOutput) Execute(ctx context.Context, evalCtx EvalContext,
 			var evalDiags tfdiags.Diagnostics
 			val, evalDiags = evalCtx.EvaluateExpr(ctx, n.Config.Expr, cty.DynamicPseudoType, nil)
 			diags = diags.Append(evalDiags)
+			if !evalDiags.HasErrors() {
+				recordUnknownCauses(ctx, evalCtx, nil, n.Addr.String(), val, n.Config.Expr)
+			}
 
 		// If the module is being overridden and we have a value to use,
 		// we just use it
@@ -66,6 +66,21 @@ type GraphNodeResourceInstance interface {
 	StateDependencies() []addrs.ConfigResource
 }
 
+// GraphNodeApplyPriority is implemented by nodes that can be given a
+// scheduling priority during the apply walk. When more nodes are ready to
+// run than the parallelism limit allows, those with a higher priority are
+// started first.
+type GraphNodeApplyPriority interface {
+	ApplyPriority() int
+}
+
+// GraphNodeSerializeGroup is implemented by nodes that can belong to a
+// serialize group during the apply walk. Nodes in the same group are never
+// executed concurrently. An empty string means the node isn't in a group.
+type GraphNodeSerializeGroup interface {
+	SerializeGroup() string
+}
+
 // NodeAbstractResource represents a resource that has no associated
 // operations. It registers all the interfaces for a resource that common
 // across multiple operation types.
@@ -128,6 +143,8 @@ var (
 	_ GraphNodeAttachProviderMetaConfigs = (*NodeAbstractResource)(nil)
 	_ GraphNodeTargetable                = (*NodeAbstractResource)(nil)
 	_ graphNodeAttachResourceDependsOn   = (*NodeAbstractResource)(nil)
+	_ GraphNodeApplyPriority             = (*NodeAbstractResource)(nil)
+	_ GraphNodeSerializeGroup            = (*NodeAbstractResource)(nil)
 	_ dag.GraphNodeDotter                = (*NodeAbstractResource)(nil)
 )
 
@@ -188,6 +205,8 @@ func (n *NodeAbstractResource) References() []*addrs.Reference {
 		result = append(result, refs...)
 		refs, _ = lang.ReferencesInExpr(addrs.ParseRef, c.ForEach)
 		result = append(result, refs...)
+		refs, _ = lang.ReferencesInExpr(addrs.ParseRef, c.ForEachKey)
+		result = append(result, refs...)
 		refs, _ = lang.ReferencesInExpr(addrs.ParseRef, c.Enabled)
 		result = append(result, refs...)
 
@@ -332,6 +351,22 @@ func (n *NodeAbstractResource) RootReferences() []*addrs.Reference {
 	return root
 }
 
+// GraphNodeApplyPriority
+func (n *NodeAbstractResource) ApplyPriority() int {
+	if n.Config == nil || n.Config.Managed == nil {
+		return 0
+	}
+	return n.Config.Managed.ApplyPriority
+}
+
+// GraphNodeSerializeGroup
+func (n *NodeAbstractResource) SerializeGroup() string {
+	if n.Config == nil || n.Config.Managed == nil {
+		return ""
+	}
+	return n.Config.Managed.SerializeGroup
+}
+
 func (n *NodeAbstractResource) DependsOn() []*addrs.Reference {
 	var result []*addrs.Reference
 	if c := n.Config; c != nil {
@@ -557,7 +592,7 @@ func (n *NodeAbstractResource) writeResourceState(ctx context.Context, evalCtx E
 		expander.SetResourceEnabled(addr.Module, n.Addr.Resource, enabled)
 
 	case n.Config != nil && n.Config.ForEach != nil:
-		forEach, forEachDiags := evaluateForEachExpression(ctx, n.Config.ForEach, evalCtx, addr)
+		forEach, forEachDiags := evaluateForEachKeyedExpression(ctx, n.Config.ForEach, n.Config.ForEachKey, evalCtx, addr)
 		diags = diags.Append(forEachDiags)
 		if forEachDiags.HasErrors() {
 			return diags
@@ -11,6 +11,7 @@ import (
 	"log"
 	"strings"
 	"sync/atomic"
+	"time"
 
 	"github.com/hashicorp/hcl/v2"
 	"github.com/zclconf/go-cty/cty"
@@ -1027,8 +1028,16 @@ func (n *NodeAbstractResourceInstance) refresh(ctx context.Context, evalCtx Eval
 		ProviderMeta:  metaConfigVal,
 		PriorIdentity: state.Identity,
 	}
+	changeHints := providerSchema.ServerCapabilities.ChangeHints
+	if changeHints {
+		providerReq.PriorChangeHint = state.ChangeHint
+	}
 
-	resp := provider.ReadResource(ctx, providerReq)
+	var resp providers.ReadResourceResponse
+	retryProviderRead(ctx, evalCtx, absAddr.String(), func() tfdiags.Diagnostics {
+		resp = provider.ReadResource(ctx, providerReq)
+		return resp.Diagnostics
+	})
 	if n.Config != nil {
 		resp.Diagnostics = resp.Diagnostics.InConfigBody(n.Config.Config, n.Addr.String())
 	}
@@ -1038,6 +1047,22 @@ func (n *NodeAbstractResourceInstance) refresh(ctx context.Context, evalCtx Eval
 		return state, diags
 	}
 
+	if changeHints && resp.Unchanged && state.ChangeHint != "" {
+		// The provider has confirmed that the remote object still matches
+		// the change indicator we saved when it was last read, so the
+		// prior state is still current and we don't need to process a new
+		// value at all.
+		log.Printf("[TRACE] NodeAbstractResourceInstance.refresh: %s is unchanged according to its change hint", absAddr)
+		ret := state.DeepCopy()
+		if resp.ChangeHint != "" {
+			ret.ChangeHint = resp.ChangeHint
+		}
+		diags = diags.Append(evalCtx.Hook(func(h Hook) (HookAction, error) {
+			return h.PostRefresh(absAddr, hookGen, priorVal, priorVal)
+		}))
+		return ret, diags
+	}
+
 	if resp.NewState == cty.NilVal {
 		// This ought not to happen in real cases since it's not possible to
 		// send NilVal over the plugin RPC channel, but it can come up in
@@ -1059,6 +1084,23 @@ func (n *NodeAbstractResourceInstance) refresh(ctx context.Context, evalCtx Eval
 		return state, diags
 	}
 
+	if resp.NewState.IsNull() && deposedKey == states.NotDeposed {
+		// The object we were tracking is gone, but if the provider supports
+		// resource identities then another object might now exist with
+		// the same identity, such as if it was recreated outside of OpenTofu.
+		if relinked, ok := n.relinkByIdentity(ctx, evalCtx, provider, schema, state, metaConfigVal); ok {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Warning,
+				"Resource re-linked by identity",
+				fmt.Sprintf(
+					"The remote object previously tracked by %s no longer exists, but the provider found another object with the same resource identity. OpenTofu will track that object for %s from now on.",
+					absAddr, absAddr,
+				),
+			))
+			resp = relinked
+		}
+	}
+
 	newState := objchange.NormalizeObjectFromLegacySDK(resp.NewState, schema.Block)
 	if !newState.RawEquals(resp.NewState) {
 		// We had to fix up this object in some way, and we still need to
@@ -1071,6 +1113,10 @@ func (n *NodeAbstractResourceInstance) refresh(ctx context.Context, evalCtx Eval
 	ret.Value = newState
 	ret.Private = resp.Private
 	ret.Identity = resp.NewIdentity
+	ret.ChangeHint = ""
+	if changeHints {
+		ret.ChangeHint = resp.ChangeHint
+	}
 
 	// We have no way to exempt provider using the legacy SDK from this check,
 	// so we can only log inconsistencies with the updated state values.
@@ -1158,7 +1204,7 @@ func (n *NodeAbstractResourceInstance) plan(
 	}
 
 	// Evaluate the configuration
-	forEach, _ := evaluateForEachExpression(ctx, n.Config.ForEach, evalCtx, n.Addr)
+	forEach, _ := evaluateForEachKeyedExpression(ctx, n.Config.ForEach, n.Config.ForEachKey, evalCtx, n.Addr)
 
 	keyData = EvalDataForInstanceKey(n.ResourceInstanceAddr().Resource.Key, forEach)
 
@@ -1661,6 +1707,7 @@ func (n *NodeAbstractResourceInstance) plan(
 		},
 		ActionReason:    actionReason,
 		RequiredReplace: reqRep,
+		UnknownCauses:   unknownAttributeCauses(ctx, evalCtx, config.Config, schema.Block, keyData, plannedNewVal),
 	}
 
 	// Update our return state
@@ -1688,10 +1735,9 @@ func (n *NodeAbstractResource) processIgnoreChanges(prior, config cty.Value, sch
 		return config, nil
 	}
 
-	ignoreChanges := traversalsToPaths(n.Config.Managed.IgnoreChanges)
 	ignoreAll := n.Config.Managed.IgnoreAllChanges
 
-	if len(ignoreChanges) == 0 && !ignoreAll {
+	if len(n.Config.Managed.IgnoreChanges) == 0 && !ignoreAll {
 		return config, nil
 	}
 
@@ -1725,6 +1771,7 @@ func (n *NodeAbstractResource) processIgnoreChanges(prior, config cty.Value, sch
 		return config, nil
 	}
 
+	ignoreChanges := ignoreChangesPaths(n.Config.Managed.IgnoreChanges, prior, config)
 	ret, diags := processIgnoreChangesIndividual(prior, config, ignoreChanges)
 
 	return ret, diags
@@ -1741,6 +1788,122 @@ func traversalsToPaths(traversals []hcl.Traversal) []cty.Path {
 	return paths
 }
 
+// ignoreChangesPaths converts the ignore_changes traversals to the cty.Path
+// values they select within the prior and config values.
+//
+// A splat step selects each element of a list, tuple, or map, and a map key
+// containing "*" is a pattern selecting each key that matches it. These are
+// expanded to the elements that exist in either of the two values, so that
+// ignoring changes restores removed elements and drops added ones.
+func ignoreChangesPaths(traversals []hcl.Traversal, prior, config cty.Value) []cty.Path {
+	// We only need the structure of the values to find the paths.
+	prior, _ = prior.UnmarkDeep()
+	config, _ = config.UnmarkDeep()
+
+	var paths []cty.Path
+	for _, traversal := range traversals {
+		paths = append(paths, expandIgnoreChangesTraversal(nil, traversal, prior, config)...)
+	}
+	return paths
+}
+
+func expandIgnoreChangesTraversal(prefix cty.Path, traversal hcl.Traversal, prior, config cty.Value) []cty.Path {
+	for i, step := range traversal {
+		var match func(key cty.Value) bool
+		switch step := step.(type) {
+		case hcl.TraverseSplat:
+			match = func(cty.Value) bool { return true }
+		case hcl.TraverseIndex:
+			if !isIgnoreChangesKeyPattern(step.Key) {
+				continue
+			}
+			pattern := step.Key.AsString()
+			match = func(key cty.Value) bool {
+				return key.Type() == cty.String && ignoreChangesKeyMatches(pattern, key.AsString())
+			}
+		default:
+			continue
+		}
+
+		collPath := append(prefix.Copy(), traversalToPath(traversal[:i])...)
+		priorColl, _ := collPath.Apply(prior)
+		configColl, _ := collPath.Apply(config)
+
+		var paths []cty.Path
+		for _, key := range ignoreChangesElementKeys(priorColl, configColl) {
+			if !match(key) {
+				continue
+			}
+			elemPath := append(collPath.Copy(), cty.IndexStep{Key: key})
+			priorElem, _ := elemPath.Apply(prior)
+			configElem, _ := elemPath.Apply(config)
+			paths = append(paths, expandIgnoreChangesTraversal(elemPath, traversal[i+1:], priorElem, configElem)...)
+		}
+		return paths
+	}
+	return []cty.Path{append(prefix.Copy(), traversalToPath(traversal)...)}
+}
+
+// ignoreChangesElementKeys returns the keys of the elements of the given
+// lists, tuples, or maps, without duplicates. Values of any other type, and
+// null or unknown values, have no elements.
+func ignoreChangesElementKeys(vals ...cty.Value) []cty.Value {
+	var keys []cty.Value
+	seen := make(map[string]bool)
+	for _, val := range vals {
+		if val == cty.NilVal || val.IsNull() || !val.IsKnown() {
+			continue
+		}
+		ty := val.Type()
+		if !ty.IsListType() && !ty.IsTupleType() && !ty.IsMapType() {
+			continue
+		}
+		for it := val.ElementIterator(); it.Next(); {
+			key, _ := it.Element()
+			if k := key.GoString(); !seen[k] {
+				seen[k] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	return keys
+}
+
+// isIgnoreChangesKeyPattern returns true if the given index key is a string
+// containing "*", which ignore_changes treats as a pattern matching map keys.
+// Other keys, including those containing backslashes, select a single element
+// as usual.
+func isIgnoreChangesKeyPattern(key cty.Value) bool {
+	return key.Type() == cty.String && key.IsKnown() && !key.IsNull() && strings.Contains(key.AsString(), "*")
+}
+
+// ignoreChangesKeyMatches returns true if the given map key matches the given
+// pattern, in which each "*" matches any sequence of characters, including
+// none at all, and a backslash matches the character after it literally. For
+// example, the key written as "a\\*" in the configuration only matches "a*".
+func ignoreChangesKeyMatches(pattern, key string) bool {
+	for len(pattern) > 0 {
+		switch pattern[0] {
+		case '*':
+			for i := len(key); i >= 0; i-- {
+				if ignoreChangesKeyMatches(pattern[1:], key[i:]) {
+					return true
+				}
+			}
+			return false
+		case '\\':
+			if len(pattern) > 1 {
+				pattern = pattern[1:]
+			}
+		}
+		if len(key) == 0 || key[0] != pattern[0] {
+			return false
+		}
+		pattern, key = pattern[1:], key[1:]
+	}
+	return len(key) == 0
+}
+
 func traversalToPath(traversal hcl.Traversal) cty.Path {
 	path := make(cty.Path, len(traversal))
 	for si, step := range traversal {
@@ -1757,6 +1920,12 @@ func traversalToPath(traversal hcl.Traversal) cty.Path {
 			path[si] = cty.IndexStep{
 				Key: ts.Key,
 			}
+		case hcl.TraverseSplat:
+			// A splat step in ignore_changes stands for any element, which
+			// is only meaningful when looking up an attribute in a schema.
+			path[si] = cty.IndexStep{
+				Key: cty.UnknownVal(cty.DynamicPseudoType),
+			}
 		default:
 			panic(fmt.Sprintf("unsupported traversal step %#v", step))
 		}
@@ -1985,7 +2154,10 @@ func (n *NodeAbstractResourceInstance) readDataSource(ctx context.Context, evalC
 		// Special case for terraform_remote_state
 		resp = tfp.ReadDataSourceEncrypted(ctx, req, n.Addr, evalCtx.GetEncryption())
 	} else {
-		resp = provider.ReadDataSource(ctx, req)
+		retryProviderRead(ctx, evalCtx, n.Addr.String(), func() tfdiags.Diagnostics {
+			resp = provider.ReadDataSource(ctx, req)
+			return resp.Diagnostics
+		})
 	}
 	diags = diags.Append(resp.Diagnostics.InConfigBody(config.Config, n.Addr.String()))
 	if diags.HasErrors() {
@@ -2184,7 +2356,7 @@ func (n *NodeAbstractResourceInstance) planDataSource(ctx context.Context, evalC
 	objTy := schema.Block.ImpliedType()
 	priorVal := cty.NullVal(objTy)
 
-	forEach, _ := evaluateForEachExpression(ctx, config.ForEach, evalCtx, n.Addr)
+	forEach, _ := evaluateForEachKeyedExpression(ctx, config.ForEach, config.ForEachKey, evalCtx, n.Addr)
 	keyData = EvalDataForInstanceKey(n.ResourceInstanceAddr().Resource.Key, forEach)
 
 	checkDiags := evalCheckRules(
@@ -2478,7 +2650,7 @@ func (n *NodeAbstractResourceInstance) applyDataSource(ctx context.Context, eval
 		return nil, keyData, diags
 	}
 
-	forEach, _ := evaluateForEachExpression(ctx, config.ForEach, evalCtx, n.Addr)
+	forEach, _ := evaluateForEachKeyedExpression(ctx, config.ForEach, config.ForEachKey, evalCtx, n.Addr)
 	keyData = EvalDataForInstanceKey(n.Addr.Resource.Key, forEach)
 
 	checkDiags := evalCheckRules(
@@ -2803,7 +2975,7 @@ func (n *NodeAbstractResourceInstance) applyProvisioners(ctx context.Context, ev
 func (n *NodeAbstractResourceInstance) evalProvisionerConfig(ctx context.Context, evalCtx EvalContext, body hcl.Body, self cty.Value, schema *configschema.Block) (cty.Value, tfdiags.Diagnostics) {
 	var diags tfdiags.Diagnostics
 
-	forEach, forEachDiags := evaluateForEachExpression(ctx, n.Config.ForEach, evalCtx, n.Addr)
+	forEach, forEachDiags := evaluateForEachKeyedExpression(ctx, n.Config.ForEach, n.Config.ForEachKey, evalCtx, n.Addr)
 	diags = diags.Append(forEachDiags)
 
 	keyData := EvalDataForInstanceKey(n.ResourceInstanceAddr().Resource.Key, forEach)
@@ -2940,7 +3112,34 @@ func (n *NodeAbstractResourceInstance) apply(
 		return newState, diags
 	}
 
-	resp := provider.ApplyResourceChange(ctx, providers.ApplyResourceChangeRequest{
+	// Providers can report progress of long-running changes while the
+	// request is in progress, which we forward to the hooks for display.
+	progressCtx := providers.ContextWithProgressReporter(ctx, func(progress providers.Progress) {
+		_ = evalCtx.Hook(func(h Hook) (HookAction, error) {
+			h.ApplyProgress(n.Addr, change.DeposedKey.Generation(), progress)
+			return HookActionContinue, nil
+		})
+	})
+
+	// If the resource declares how long its changes are expected to take,
+	// we tell the hooks as soon as the change takes longer, and warn about
+	// it once the change is complete.
+	var managed *configs.ManagedResource
+	if n.Config != nil && n.Config.Managed != nil && n.Config.Managed.ApplyWithin > 0 {
+		managed = n.Config.Managed
+	}
+	var budgetTimer *time.Timer
+	applyStart := time.Now()
+	if managed != nil {
+		budgetTimer = time.AfterFunc(managed.ApplyWithin, func() {
+			_ = evalCtx.Hook(func(h Hook) (HookAction, error) {
+				h.ApplyBudgetExceeded(n.Addr, change.DeposedKey.Generation(), change.Action, managed.ApplyWithin)
+				return HookActionContinue, nil
+			})
+		})
+	}
+
+	resp := provider.ApplyResourceChange(progressCtx, providers.ApplyResourceChangeRequest{
 		TypeName:        n.Addr.Resource.Resource.Type,
 		PriorState:      unmarkedBefore,
 		Config:          unmarkedConfigVal,
@@ -2950,6 +3149,21 @@ func (n *NodeAbstractResourceInstance) apply(
 		ProviderMeta:    metaConfigVal,
 	})
 
+	if managed != nil {
+		budgetTimer.Stop()
+		if elapsed := time.Since(applyStart); elapsed > managed.ApplyWithin {
+			diags = diags.Append(&hcl.Diagnostic{
+				Severity: hcl.DiagWarning,
+				Summary:  "Resource change took longer than expected",
+				Detail: fmt.Sprintf(
+					"Applying the change to %s took %s, which is longer than the %s given in the apply_within argument of its lifecycle block. If this keeps happening, the remote API may be degraded, or the expected duration may need adjusting.",
+					n.Addr, elapsed.Round(time.Millisecond), managed.ApplyWithin,
+				),
+				Subject: managed.ApplyWithinRange.Ptr(),
+			})
+		}
+	}
+
 	applyDiags := resp.Diagnostics
 	if applyConfig != nil {
 		applyDiags = applyDiags.InConfigBody(applyConfig.Config, n.Addr.String())
@@ -3335,7 +3549,7 @@ func (n *NodeAbstractResourceInstance) planEphemeralResource(ctx context.Context
 	objTy := schema.Block.ImpliedType()
 	priorVal := cty.NullVal(objTy)
 
-	forEach, _ := evaluateForEachExpression(ctx, config.ForEach, evalCtx, n.Addr)
+	forEach, _ := evaluateForEachKeyedExpression(ctx, config.ForEach, config.ForEachKey, evalCtx, n.Addr)
 	keyData = EvalDataForInstanceKey(n.ResourceInstanceAddr().Resource.Key, forEach)
 
 	checkDiags := evalCheckRules(
@@ -265,3 +265,29 @@ func TestFilterResourceProvisioners(t *testing.T) {
 		})
 	}
 }
+
+func TestIgnoreChangesKeyMatches(t *testing.T) {
+	tests := []struct {
+		pattern, key string
+		want         bool
+	}{
+		{`kubernetes.io/*`, `kubernetes.io/role`, true},
+		{`kubernetes.io/*`, `kubernetes.io/`, true},
+		{`kubernetes.io/*`, `example.com/role`, false},
+		{`*/role`, `kubernetes.io/role`, true},
+		{`a*b*c`, `abc`, true},
+		{`a*b*c`, `axxbyyc`, true},
+		{`a*b*c`, `axxbyy`, false},
+		{`a*c`, `abcbc`, true},
+		{`a\*b`, `a*b`, true},
+		{`a\*b`, `axb`, false},
+		{`a\*b*`, `a*bcd`, true},
+		{`a\\*`, `a\bc`, true},
+		{`a\\*`, `abc`, false},
+	}
+	for _, test := range tests {
+		if got := ignoreChangesKeyMatches(test.pattern, test.key); got != test.want {
+			t.Errorf("ignoreChangesKeyMatches(%q, %q) = %t; want %t", test.pattern, test.key, got, test.want)
+		}
+	}
+}
@@ -32,6 +32,10 @@ type nodeExpandPlannableResource struct {
 	// skipRefresh indicates that we should skip refreshing individual instances
 	skipRefresh bool
 
+	// refreshTargets, if not empty, limits refreshing to the instances they
+	// contain.
+	refreshTargets []addrs.Targetable
+
 	preDestroyRefresh bool
 
 	// skipPlanChanges indicates we should skip trying to plan change actions
@@ -146,7 +150,7 @@ func (n *nodeExpandPlannableResource) DynamicExpand(evalCtx EvalContext) (*Graph
 
 		return &NodePlannableResourceInstanceOrphan{
 			NodeAbstractResourceInstance: a,
-			skipRefresh:                  n.skipRefresh,
+			skipRefresh:                  skipRefreshFor(a.Addr, n.skipRefresh, n.refreshTargets),
 			skipPlanChanges:              n.skipPlanChanges,
 		}
 	}
@@ -384,7 +388,7 @@ func (n *nodeExpandPlannableResource) resourceInstanceSubgraph(ctx context.Conte
 			// to force on CreateBeforeDestroy due to dependencies on other
 			// nodes that have it.
 			ForceCreateBeforeDestroy: n.CreateBeforeDestroy(),
-			skipRefresh:              n.skipRefresh,
+			skipRefresh:              skipRefreshFor(a.Addr, n.skipRefresh, n.refreshTargets),
 			skipPlanChanges:          n.skipPlanChanges,
 			forceReplace:             n.forceReplace,
 		}
@@ -414,7 +418,7 @@ func (n *nodeExpandPlannableResource) resourceInstanceSubgraph(ctx context.Conte
 
 		return &NodePlannableResourceInstanceOrphan{
 			NodeAbstractResourceInstance: a,
-			skipRefresh:                  n.skipRefresh,
+			skipRefresh:                  skipRefreshFor(a.Addr, n.skipRefresh, n.refreshTargets),
 			skipPlanChanges:              n.skipPlanChanges,
 		}
 	}
@@ -486,3 +490,18 @@ func (n *nodeExpandPlannableResource) Close() (diags tfdiags.Diagnostics) {
 	}
 	return diags
 }
+
+// skipRefreshFor returns true if the resource instance with the given address
+// shouldn't be refreshed, either because refreshing is disabled altogether or
+// because refreshTargets is not empty and none of its targets contain it.
+func skipRefreshFor(addr addrs.AbsResourceInstance, skipRefresh bool, refreshTargets []addrs.Targetable) bool {
+	if skipRefresh || len(refreshTargets) == 0 {
+		return skipRefresh
+	}
+	for _, target := range refreshTargets {
+		if target.TargetContains(addr) {
+			return false
+		}
+	}
+	return true
+}
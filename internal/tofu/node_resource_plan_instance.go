@@ -495,7 +495,7 @@ func (n *NodePlannableResourceInstance) managedResourceExecute(ctx context.Conte
 		// values, which could result in a post-condition check relying on that
 		// value being inaccurate. Unless we decide to store the value of the
 		// for-each expression in state, this is unavoidable.
-		forEach, _ := evaluateForEachExpression(ctx, n.Config.ForEach, evalCtx, n.ResourceAddr())
+		forEach, _ := evaluateForEachKeyedExpression(ctx, n.Config.ForEach, n.Config.ForEachKey, evalCtx, n.ResourceAddr())
 		repeatData := EvalDataForInstanceKey(n.ResourceInstanceAddr().Resource.Key, forEach)
 
 		checkDiags := evalCheckRules(
@@ -629,6 +629,21 @@ func (n *NodePlannableResourceInstance) importState(ctx context.Context, evalCtx
 
 	importedState := imported[0].AsInstanceObject()
 
+	// An object that is already tracked elsewhere in the state must not be
+	// imported again, because both instances would then manage it.
+	if otherAddr, tracked := resourceInstanceWithIdentity(evalCtx, absAddr, n.ResolvedProvider.ProviderConfig.Provider, importedState.Identity); tracked {
+		diags = diags.Append(&hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "Resource already managed by OpenTofu",
+			Detail: fmt.Sprintf(
+				"The object imported for %s has the same resource identity as the object already tracked by %s, so both would manage the same remote object. To manage this object at the new address instead, use a \"moved\" block.",
+				absAddr, otherAddr,
+			),
+			Subject: n.importTarget.Config.DeclRange.Ptr(),
+		})
+		return nil, diags
+	}
+
 	if importedState.Value.IsNull() {
 		importDesc := n.importTarget.ID
 		if importDesc == "" {
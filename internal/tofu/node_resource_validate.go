@@ -240,7 +240,7 @@ func (n *NodeValidatableResource) validateResource(ctx context.Context, evalCtx
 		}
 
 		// Evaluate the for_each expression here so we can expose the diagnostics
-		forEachDiags := validateForEach(ctx, evalCtx, n.Config.ForEach)
+		forEachDiags := validateForEach(ctx, evalCtx, n.Config.ForEach, n.Config.ForEachKey)
 		diags = diags.Append(forEachDiags)
 	}
 
@@ -592,11 +592,10 @@ func validateCount(ctx context.Context, evalCtx EvalContext, expr hcl.Expression
 	return diags
 }
 
-func validateForEach(ctx context.Context, evalCtx EvalContext, expr hcl.Expression) (diags tfdiags.Diagnostics) {
+func validateForEach(ctx context.Context, evalCtx EvalContext, expr hcl.Expression, keyExpr hcl.Expression) (diags tfdiags.Diagnostics) {
 	const unknownsAllowed = true
-	const tupleNotAllowed = false
 
-	val, forEachDiags := evaluateForEachExpressionValue(ctx, expr, evalCtx, unknownsAllowed, tupleNotAllowed, nil)
+	val, forEachDiags := evaluateForEachKeyedExpressionValue(ctx, expr, keyExpr, evalCtx, unknownsAllowed, nil)
 	// If the value isn't known then that's the best we can do for now, but
 	// we'll check more thoroughly during the plan walk
 	if !val.IsKnown() {
@@ -14,6 +14,7 @@ import (
 	"github.com/opentofu/opentofu/internal/addrs"
 	"github.com/opentofu/opentofu/internal/configs"
 	"github.com/opentofu/opentofu/internal/dag"
+	"github.com/opentofu/opentofu/internal/lang"
 	"github.com/opentofu/opentofu/internal/tfdiags"
 )
 
@@ -34,6 +35,7 @@ var (
 	_ GraphNodeExecutable     = (*NodeRootVariable)(nil)
 	_ GraphNodeModuleInstance = (*NodeRootVariable)(nil)
 	_ GraphNodeReferenceable  = (*NodeRootVariable)(nil)
+	_ GraphNodeReferencer     = (*NodeRootVariable)(nil)
 )
 
 func (n *NodeRootVariable) Name() string {
@@ -54,8 +56,20 @@ func (n *NodeRootVariable) ReferenceableAddrs() []addrs.Referenceable {
 	return []addrs.Referenceable{n.Addr}
 }
 
+// GraphNodeReferencer
+func (n *NodeRootVariable) References() []*addrs.Reference {
+	// Root module variables don't depend on anything, except for the
+	// providers of any functions called in a default value that will be used
+	// because the caller didn't set the variable.
+	if n.Config == nil || (n.RawValue != nil && n.RawValue.Value != cty.NilVal) {
+		return nil
+	}
+	refs, _ := lang.ProviderFunctionsInExpr(addrs.ParseRef, n.Config.DefaultExpr)
+	return refs
+}
+
 // GraphNodeExecutable
-func (n *NodeRootVariable) Execute(_ context.Context, evalCtx EvalContext, op walkOperation) tfdiags.Diagnostics {
+func (n *NodeRootVariable) Execute(ctx context.Context, evalCtx EvalContext, op walkOperation) tfdiags.Diagnostics {
 	// Root module variables are special in that they are provided directly
 	// by the caller (usually, the CLI layer) and so we don't really need to
 	// evaluate them in the usual sense, but we do need to process the raw
@@ -87,10 +101,20 @@ func (n *NodeRootVariable) Execute(_ context.Context, evalCtx EvalContext, op wa
 		}
 	}
 
+	cfg := n.Config
+	if givenVal.Value == cty.NilVal {
+		var moreDiags tfdiags.Diagnostics
+		cfg, moreDiags = evalVariableDefault(ctx, evalCtx, addr, cfg)
+		diags = diags.Append(moreDiags)
+		if moreDiags.HasErrors() {
+			return diags
+		}
+	}
+
 	finalVal, moreDiags := prepareFinalInputVariableValue(
 		addr,
 		givenVal,
-		n.Config,
+		cfg,
 	)
 	diags = diags.Append(moreDiags)
 	if moreDiags.HasErrors() {
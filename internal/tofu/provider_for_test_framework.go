@@ -199,6 +199,10 @@ func (p providerForTest) CallFunction(ctx context.Context, r providers.CallFunct
 	return p.internal.CallFunction(ctx, r)
 }
 
+func (p providerForTest) ListResource(ctx context.Context, r providers.ListResourceRequest) providers.ListResourceResponse {
+	return p.internal.ListResource(ctx, r)
+}
+
 func (p providerForTest) Close(ctx context.Context) error {
 	return p.internal.Close(ctx)
 }
@@ -130,6 +130,11 @@ type MockProvider struct {
 	CallFunctionRequest  providers.CallFunctionRequest
 	CallFunctionFn       func(providers.CallFunctionRequest) providers.CallFunctionResponse
 
+	ListResourceCalled   bool
+	ListResourceResponse *providers.ListResourceResponse
+	ListResourceRequest  providers.ListResourceRequest
+	ListResourceFn       func(providers.ListResourceRequest) providers.ListResourceResponse
+
 	CloseCalled bool
 	CloseError  error
 }
@@ -763,6 +768,30 @@ func (p *MockProvider) GetFunctions(ctx context.Context) (resp providers.GetFunc
 	return resp
 }
 
+func (p *MockProvider) ListResource(ctx context.Context, r providers.ListResourceRequest) (resp providers.ListResourceResponse) {
+	tracing.ContextProbeReport(ctx, 0)
+	p.Lock()
+	defer p.Unlock()
+
+	if !p.ConfigureProviderCalled {
+		resp.Diagnostics = resp.Diagnostics.Append(fmt.Errorf("Configure not called before ListResource %q", r.TypeName))
+		return resp
+	}
+
+	p.ListResourceCalled = true
+	p.ListResourceRequest = r
+
+	if p.ListResourceFn != nil {
+		return p.ListResourceFn(r)
+	}
+
+	if p.ListResourceResponse != nil {
+		resp = *p.ListResourceResponse
+	}
+
+	return resp
+}
+
 func (p *MockProvider) CallFunction(ctx context.Context, r providers.CallFunctionRequest) (resp providers.CallFunctionResponse) {
 	tracing.ContextProbeReport(ctx, 0)
 	p.Lock()
@@ -0,0 +1,91 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tofu
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+// providerReadRetryBaseDelay is how long to wait before the first retry of a
+// provider read request, if the provider didn't ask for a particular delay.
+// The delay doubles for each further retry, up to providerReadRetryMaxDelay.
+//
+// This is a variable only so that tests can shorten it.
+var providerReadRetryBaseDelay = time.Second
+
+// providerReadRetryMaxDelay is the longest that OpenTofu waits before
+// retrying a provider read request, even if the provider asked for a longer
+// delay.
+const providerReadRetryMaxDelay = 30 * time.Second
+
+// retryProviderRead calls the given function, which sends a request to read
+// a resource or data source to a provider and returns the diagnostics from
+// its response. While the request fails only with errors that the provider
+// marked as retryable, it calls the function again after a delay, up to the
+// number of retries that evalCtx allows. It stops waiting and returns early
+// if ctx is cancelled or the operation is stopped.
+//
+// A provider marks an error as retryable by attaching a google.rpc.RetryInfo
+// detail to the gRPC status of its response, which the plugin client turns
+// into a tfdiags.ProviderError. Errors without that detail are never retried,
+// whatever their status code, because only the provider knows whether the
+// failure is temporary.
+//
+// Only requests that read remote objects can be retried like this, because
+// they have no side effects and so are safe to repeat.
+func retryProviderRead(ctx context.Context, evalCtx EvalContext, addr string, read func() tfdiags.Diagnostics) {
+	backoff := providerReadRetryBaseDelay
+	for retry := 1; retry <= evalCtx.ProviderReadRetries(); retry++ {
+		diags := read()
+		delay, ok := providerReadRetryDelay(diags)
+		if !ok {
+			return
+		}
+		if delay == 0 {
+			delay = backoff
+		}
+		backoff = min(backoff*2, providerReadRetryMaxDelay)
+
+		log.Printf("[WARN] Provider reported a retryable error reading %s, so retrying in %s (retry %d of %d): %s", addr, delay, retry, evalCtx.ProviderReadRetries(), diags.Err())
+		select {
+		case <-ctx.Done():
+			return
+		case <-evalCtx.Stopped():
+			return
+		case <-time.After(delay):
+		}
+	}
+	read()
+}
+
+// providerReadRetryDelay returns whether the given diagnostics allow retrying
+// the request that produced them, and how long the provider asked to wait
+// before doing so, if at all, up to providerReadRetryMaxDelay.
+//
+// A request can be retried only if it failed, and the provider marked all of
+// its errors as retryable.
+func providerReadRetryDelay(diags tfdiags.Diagnostics) (time.Duration, bool) {
+	if !diags.HasErrors() {
+		return 0, false
+	}
+
+	var delay time.Duration
+	for _, diag := range diags {
+		if diag.Severity() != tfdiags.Error {
+			continue
+		}
+		providerErr := tfdiags.DiagnosticProviderError(diag)
+		if providerErr == nil || !providerErr.Retryable {
+			return 0, false
+		}
+		delay = max(delay, providerErr.RetryDelay)
+	}
+	return min(delay, providerReadRetryMaxDelay), true
+}
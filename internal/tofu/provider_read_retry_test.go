@@ -0,0 +1,126 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tofu
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/hcl/v2"
+
+	"github.com/opentofu/opentofu/internal/tfdiags"
+)
+
+func TestProviderReadRetryDelay(t *testing.T) {
+	providerErr := func(retryable bool, delay time.Duration) tfdiags.Diagnostics {
+		var diags tfdiags.Diagnostics
+		return diags.Append(&hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "Plugin error",
+			Extra: testProviderErrorExtra{&tfdiags.ProviderError{
+				Status:     "ResourceExhausted",
+				Retryable:  retryable,
+				RetryDelay: delay,
+			}},
+		})
+	}
+
+	tests := map[string]struct {
+		diags     tfdiags.Diagnostics
+		wantDelay time.Duration
+		wantRetry bool
+	}{
+		"no errors": {
+			diags: nil,
+		},
+		"error without provider error details": {
+			// This is what the plugin client returns for a gRPC error
+			// without a google.rpc.RetryInfo detail.
+			diags: tfdiags.Diagnostics{}.Append(tfdiags.Sourceless(tfdiags.Error, "Plugin error", "rpc error: code = Unavailable")),
+		},
+		"error not marked as retryable": {
+			diags: providerErr(false, 0),
+		},
+		"retryable without a delay": {
+			diags:     providerErr(true, 0),
+			wantRetry: true,
+		},
+		"retryable with a delay": {
+			diags:     providerErr(true, 5*time.Second),
+			wantDelay: 5 * time.Second,
+			wantRetry: true,
+		},
+		"retryable with a delay longer than the maximum": {
+			diags:     providerErr(true, time.Hour),
+			wantDelay: providerReadRetryMaxDelay,
+			wantRetry: true,
+		},
+		"retryable and non-retryable errors": {
+			diags:     providerErr(true, time.Second).Append(providerErr(false, 0)),
+			wantRetry: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotDelay, gotRetry := providerReadRetryDelay(test.diags)
+			if gotRetry != test.wantRetry {
+				t.Errorf("wrong retry result %t; want %t", gotRetry, test.wantRetry)
+			}
+			if gotRetry && gotDelay != test.wantDelay {
+				t.Errorf("wrong delay %s; want %s", gotDelay, test.wantDelay)
+			}
+		})
+	}
+}
+
+func TestRetryProviderRead_cancelled(t *testing.T) {
+	evalCtx := &MockEvalContext{
+		StoppedValue:             make(chan struct{}),
+		ProviderReadRetriesValue: 3,
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	calls := 0
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		retryProviderRead(ctx, evalCtx, "test_object.a", func() tfdiags.Diagnostics {
+			calls++
+			var diags tfdiags.Diagnostics
+			return diags.Append(&hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Plugin error",
+				Extra: testProviderErrorExtra{&tfdiags.ProviderError{
+					Retryable:  true,
+					RetryDelay: time.Hour,
+				}},
+			})
+		})
+	}()
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("retryProviderRead didn't return after the context was cancelled")
+	}
+	if calls != 1 {
+		t.Errorf("read called %d times; want 1", calls)
+	}
+}
+
+// testProviderErrorExtra is a diagnostic extra value that attaches the given
+// provider error details to a diagnostic, as the plugin client does for gRPC
+// errors with status details.
+type testProviderErrorExtra struct {
+	err *tfdiags.ProviderError
+}
+
+func (e testProviderErrorExtra) DiagnosticProviderError() *tfdiags.ProviderError {
+	return e.err
+}
@@ -0,0 +1,139 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tofu
+
+import (
+	"context"
+	"log"
+
+	"github.com/zclconf/go-cty/cty"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/providers"
+	"github.com/opentofu/opentofu/internal/states"
+)
+
+// resourceInstanceWithIdentity searches the state for a current object of
+// a managed resource instance other than addr, belonging to the same resource
+// type and provider, whose resource identity is equal to the given identity.
+//
+// Resource identities are stable identifiers declared by the provider, so two
+// instances with the same identity are tracking the same remote object.
+//
+// The refresh state is preferred when available, because the working state
+// may already contain planned objects whose identity is not yet known.
+func resourceInstanceWithIdentity(evalCtx EvalContext, addr addrs.AbsResourceInstance, provider addrs.Provider, identity cty.Value) (addrs.AbsResourceInstance, bool) {
+	if identity == cty.NilVal || identity.IsNull() || !identity.IsWhollyKnown() {
+		return addrs.AbsResourceInstance{}, false
+	}
+	identity, _ = identity.UnmarkDeep()
+
+	syncState := evalCtx.RefreshState()
+	if syncState == nil {
+		syncState = evalCtx.State()
+	}
+	state := syncState.Lock()
+	defer syncState.Unlock()
+
+	for _, ms := range state.Modules {
+		for _, rs := range ms.Resources {
+			if rs.Addr.Resource.Mode != addrs.ManagedResourceMode || rs.Addr.Resource.Type != addr.Resource.Resource.Type {
+				continue
+			}
+			if rs.ProviderConfig.Provider != provider {
+				continue
+			}
+			for key, is := range rs.Instances {
+				instAddr := rs.Addr.Instance(key)
+				if is.Current == nil || len(is.Current.IdentityJSON) == 0 || instAddr.Equal(addr) {
+					continue
+				}
+				other, err := ctyjson.Unmarshal(is.Current.IdentityJSON, identity.Type())
+				if err != nil {
+					// The identity was recorded using a different schema
+					// version, so it can't be compared.
+					continue
+				}
+				if eq := other.Equals(identity); eq.IsKnown() && eq.True() {
+					return instAddr, true
+				}
+			}
+		}
+	}
+	return addrs.AbsResourceInstance{}, false
+}
+
+// relinkByIdentity tries to find a replacement for a remote object that the
+// provider reported as no longer existing during refresh, by importing
+// whatever object now has the resource identity recorded in state.
+//
+// This allows OpenTofu to continue tracking objects that were recreated
+// outside of OpenTofu with the same logical identity but a different
+// provider-internal ID. The second return value is false if the provider
+// doesn't support identities for this resource type, if no object has the
+// recorded identity, or if the object found is already tracked by another
+// resource instance, in which case the caller should treat the object as
+// deleted as usual.
+func (n *NodeAbstractResourceInstance) relinkByIdentity(ctx context.Context, evalCtx EvalContext, provider providers.Interface, schema *providers.Schema, state *states.ResourceInstanceObject, metaConfigVal cty.Value) (providers.ReadResourceResponse, bool) {
+	absAddr := n.Addr
+	if schema.IdentitySchema == nil || state.Identity == cty.NilVal || state.Identity.IsNull() || !state.Identity.IsWhollyKnown() {
+		return providers.ReadResourceResponse{}, false
+	}
+
+	log.Printf("[TRACE] relinkByIdentity: %s no longer exists, so looking for an object with the same identity", absAddr)
+	importResp := provider.ImportResourceState(ctx, providers.ImportResourceStateRequest{
+		TypeName: absAddr.Resource.Resource.Type,
+		Target:   providers.ImportTarget{Identity: state.Identity},
+	})
+	if importResp.Diagnostics.HasErrors() {
+		// Failing to find a replacement is the expected outcome when the
+		// object was deleted for good, so this isn't reported as an error.
+		log.Printf("[TRACE] relinkByIdentity: no object found for %s: %s", absAddr, importResp.Diagnostics.Err())
+		return providers.ReadResourceResponse{}, false
+	}
+	var imported []providers.ImportedResource
+	for _, obj := range importResp.ImportedResources {
+		if obj.TypeName == absAddr.Resource.Resource.Type {
+			imported = append(imported, obj)
+		}
+	}
+	if len(imported) != 1 || imported[0].State == cty.NilVal || imported[0].State.IsNull() {
+		log.Printf("[TRACE] relinkByIdentity: import for %s returned %d objects, so not re-linking", absAddr, len(imported))
+		return providers.ReadResourceResponse{}, false
+	}
+
+	identity := imported[0].Identity
+	if identity == cty.NilVal || identity.IsNull() {
+		identity = state.Identity
+	}
+	readResp := provider.ReadResource(ctx, providers.ReadResourceRequest{
+		TypeName:      absAddr.Resource.Resource.Type,
+		PriorState:    imported[0].State,
+		Private:       imported[0].Private,
+		ProviderMeta:  metaConfigVal,
+		PriorIdentity: identity,
+	})
+	if readResp.Diagnostics.HasErrors() || readResp.NewState == cty.NilVal || readResp.NewState.IsNull() {
+		log.Printf("[TRACE] relinkByIdentity: object imported for %s could not be read, so not re-linking", absAddr)
+		return providers.ReadResourceResponse{}, false
+	}
+	if errs := readResp.NewState.Type().TestConformance(schema.Block.ImpliedType()); len(errs) > 0 {
+		log.Printf("[WARN] relinkByIdentity: provider produced an invalid object for %s, so not re-linking", absAddr)
+		return providers.ReadResourceResponse{}, false
+	}
+	if readResp.NewIdentity == cty.NilVal || readResp.NewIdentity.IsNull() {
+		readResp.NewIdentity = identity
+	}
+
+	if otherAddr, tracked := resourceInstanceWithIdentity(evalCtx, absAddr, n.ResolvedProvider.ProviderConfig.Provider, readResp.NewIdentity); tracked {
+		log.Printf("[TRACE] relinkByIdentity: object found for %s is already tracked by %s, so not re-linking", absAddr, otherAddr)
+		return providers.ReadResourceResponse{}, false
+	}
+
+	log.Printf("[TRACE] relinkByIdentity: re-linking %s to the object with the same identity", absAddr)
+	return readResp, true
+}
@@ -55,8 +55,8 @@ func (c *Context) TestContext(config *configs.Config, state *states.State, plan
 // configs.TestRun against the embedded state.
 //
 // The provided plan is import as it is needed to evaluate the `plantimestamp`
-// function, but no data or changes from the embedded plan is referenced in
-// this function.
+// function and to describe the planned actions through `run.plan`, but the
+// values within the embedded changes are not referenced in this function.
 func (ctx *TestContext) EvaluateAgainstState(run *moduletest.Run) {
 	defer ctx.acquireRun("evaluate")()
 	ctx.evaluate(ctx.State.SyncWrapper(), plans.NewChanges().SyncWrapper(), run, walkApply)
@@ -110,12 +110,22 @@ func (tc *TestContext) evaluate(state *states.SyncState, changes *plans.ChangesS
 		BaseDir:       ".",
 		PureOnly:      operation != walkApply,
 		PlanTimestamp: tc.Plan.Timestamp,
+		RetryAllowed:  true,
+		Stopped:       tc.runContext.Done(),
+
+		DisabledFunctions: tc.disabledFunctions,
 		ProviderFunctions: func(ctx context.Context, pf addrs.ProviderFunction, rng tfdiags.SourceRange) (*function.Function, tfdiags.Diagnostics) {
 			// TODO pass in tc.plugins
 			return evalContextProviderFunction(ctx, nil, walkPlan, pf, rng)
 		},
 	}
 
+	// The planned changes are exposed to the assertions as run.plan, so they
+	// can check which actions the plan would take.
+	runPlanVal := cty.ObjectVal(map[string]cty.Value{
+		"plan": testRunPlanValue(tc.Plan),
+	})
+
 	// We're going to assume the run has passed, and then if anything fails this
 	// value will be updated.
 	run.Status = run.Status.Merge(moduletest.Pass)
@@ -124,14 +134,15 @@ func (tc *TestContext) evaluate(state *states.SyncState, changes *plans.ChangesS
 	for _, rule := range run.Config.CheckRules {
 		var diags tfdiags.Diagnostics
 
-		refs, moreDiags := lang.ReferencesInExpr(addrs.ParseRefFromTestingScope, rule.Condition)
+		refs, moreDiags := lang.ReferencesInExpr(parseAssertionRef, rule.Condition)
 		diags = diags.Append(moreDiags)
-		moreRefs, moreDiags := lang.ReferencesInExpr(addrs.ParseRefFromTestingScope, rule.ErrorMessage)
+		moreRefs, moreDiags := lang.ReferencesInExpr(parseAssertionRef, rule.ErrorMessage)
 		diags = diags.Append(moreDiags)
 		refs = append(refs, moreRefs...)
 
 		hclCtx, moreDiags := scope.EvalContext(context.TODO(), refs)
 		diags = diags.Append(moreDiags)
+		hclCtx.Variables["run"] = runPlanVal
 
 		errorMessage, moreDiags := evalCheckErrorMessage(rule.ErrorMessage, hclCtx)
 		diags = diags.Append(moreDiags)
@@ -220,3 +231,76 @@ func synchronizeStates(state, plannedState *states.State) *states.SyncState {
 	}
 	return newState.SyncWrapper()
 }
+
+// parseAssertionRef parses references within test assertions in the same way
+// as addrs.ParseRefFromTestingScope, except that references to run.plan are
+// skipped because the evaluation context provides that object directly.
+func parseAssertionRef(traversal hcl.Traversal) (*addrs.Reference, tfdiags.Diagnostics) {
+	if moduletest.IsPlanReference(traversal) {
+		return nil, nil
+	}
+	return addrs.ParseRefFromTestingScope(traversal)
+}
+
+// testRunPlanValue returns the value of the run.plan object within test
+// assertions.
+//
+// Its resource_changes attribute is an object with an attribute for each
+// resource instance address in the plan, describing the planned change for
+// that instance. The actions are given in the same form as the "actions"
+// property of the JSON plan output, so that assertions can compare them
+// directly with a tuple such as ["delete", "create"].
+func testRunPlanValue(plan *plans.Plan) cty.Value {
+	changes := make(map[string]cty.Value)
+	if plan != nil && plan.Changes != nil {
+		for _, rc := range plan.Changes.Resources {
+			if rc.DeposedKey != states.NotDeposed {
+				// Deposed objects have no address of their own to refer to.
+				continue
+			}
+			addr := rc.Addr.String()
+			changes[addr] = cty.ObjectVal(map[string]cty.Value{
+				"address": cty.StringVal(addr),
+				"actions": testRunPlanActions(rc.Action),
+			})
+		}
+	}
+
+	return cty.ObjectVal(map[string]cty.Value{
+		"resource_changes": cty.ObjectVal(changes),
+	})
+}
+
+// testRunPlanActions returns the action names for the given action, matching
+// the representation used by the JSON plan output.
+func testRunPlanActions(action plans.Action) cty.Value {
+	var names []string
+	switch action {
+	case plans.NoOp:
+		names = []string{"no-op"}
+	case plans.Create:
+		names = []string{"create"}
+	case plans.Delete:
+		names = []string{"delete"}
+	case plans.Update:
+		names = []string{"update"}
+	case plans.CreateThenDelete:
+		names = []string{"create", "delete"}
+	case plans.Read:
+		names = []string{"read"}
+	case plans.DeleteThenCreate:
+		names = []string{"delete", "create"}
+	case plans.Forget:
+		names = []string{"forget"}
+	case plans.ForgetThenCreate:
+		names = []string{"forget", "create"}
+	default:
+		names = []string{action.String()}
+	}
+
+	vals := make([]cty.Value, len(names))
+	for i, name := range names {
+		vals[i] = cty.StringVal(name)
+	}
+	return cty.TupleVal(vals)
+}
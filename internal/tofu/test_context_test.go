@@ -565,6 +565,178 @@ run "test_case" {
 				},
 			},
 		},
+		"plan_actions_passing": {
+			configs: map[string]string{
+				"main.tf": `
+resource "test_resource" "a" {
+	value = "Hello, world!"
+}
+
+resource "test_resource" "b" {
+	value = "Hello, world!"
+}
+`,
+				"main.tftest.hcl": `
+run "test_case" {
+	assert {
+		condition = run.plan.resource_changes["test_resource.a"].actions == ["delete", "create"]
+		error_message = "resources would be replaced"
+	}
+}
+`,
+			},
+			state: states.NewState(),
+			plan: &plans.Plan{
+				Changes: &plans.Changes{
+					Resources: []*plans.ResourceInstanceChangeSrc{
+						{
+							Addr: addrs.Resource{
+								Mode: addrs.ManagedResourceMode,
+								Type: "test_resource",
+								Name: "a",
+							}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance),
+							ProviderAddr: addrs.AbsProviderConfig{
+								Module:   addrs.RootModule,
+								Provider: addrs.NewDefaultProvider("test"),
+							},
+							ChangeSrc: plans.ChangeSrc{
+								Action: plans.DeleteThenCreate,
+								Before: encodeDynamicValue(t, cty.ObjectVal(map[string]cty.Value{
+									"value": cty.StringVal("Hello!"),
+								})),
+								After: encodeDynamicValue(t, cty.ObjectVal(map[string]cty.Value{
+									"value": cty.StringVal("Hello, world!"),
+								})),
+							},
+						},
+						{
+							Addr: addrs.Resource{
+								Mode: addrs.ManagedResourceMode,
+								Type: "test_resource",
+								Name: "b",
+							}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance),
+							ProviderAddr: addrs.AbsProviderConfig{
+								Module:   addrs.RootModule,
+								Provider: addrs.NewDefaultProvider("test"),
+							},
+							ChangeSrc: plans.ChangeSrc{
+								Action: plans.Create,
+								Before: nil,
+								After: encodeDynamicValue(t, cty.ObjectVal(map[string]cty.Value{
+									"value": cty.StringVal("Hello, world!"),
+								})),
+							},
+						},
+					},
+				},
+			},
+			provider: &MockProvider{
+				GetProviderSchemaResponse: &providers.GetProviderSchemaResponse{
+					ResourceTypes: map[string]providers.Schema{
+						"test_resource": {
+							Block: &configschema.Block{
+								Attributes: map[string]*configschema.Attribute{
+									"value": {
+										Type:     cty.String,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedStatus: moduletest.Pass,
+		},
+		"plan_actions_failing": {
+			configs: map[string]string{
+				"main.tf": `
+resource "test_resource" "a" {
+	value = "Hello, world!"
+}
+
+resource "test_resource" "b" {
+	value = "Hello, world!"
+}
+`,
+				"main.tftest.hcl": `
+run "test_case" {
+	assert {
+		condition = alltrue([for change in run.plan.resource_changes : !contains(change.actions, "delete")])
+		error_message = "resources would be destroyed"
+	}
+}
+`,
+			},
+			state: states.NewState(),
+			plan: &plans.Plan{
+				Changes: &plans.Changes{
+					Resources: []*plans.ResourceInstanceChangeSrc{
+						{
+							Addr: addrs.Resource{
+								Mode: addrs.ManagedResourceMode,
+								Type: "test_resource",
+								Name: "a",
+							}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance),
+							ProviderAddr: addrs.AbsProviderConfig{
+								Module:   addrs.RootModule,
+								Provider: addrs.NewDefaultProvider("test"),
+							},
+							ChangeSrc: plans.ChangeSrc{
+								Action: plans.DeleteThenCreate,
+								Before: encodeDynamicValue(t, cty.ObjectVal(map[string]cty.Value{
+									"value": cty.StringVal("Hello!"),
+								})),
+								After: encodeDynamicValue(t, cty.ObjectVal(map[string]cty.Value{
+									"value": cty.StringVal("Hello, world!"),
+								})),
+							},
+						},
+						{
+							Addr: addrs.Resource{
+								Mode: addrs.ManagedResourceMode,
+								Type: "test_resource",
+								Name: "b",
+							}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance),
+							ProviderAddr: addrs.AbsProviderConfig{
+								Module:   addrs.RootModule,
+								Provider: addrs.NewDefaultProvider("test"),
+							},
+							ChangeSrc: plans.ChangeSrc{
+								Action: plans.Create,
+								Before: nil,
+								After: encodeDynamicValue(t, cty.ObjectVal(map[string]cty.Value{
+									"value": cty.StringVal("Hello, world!"),
+								})),
+							},
+						},
+					},
+				},
+			},
+			provider: &MockProvider{
+				GetProviderSchemaResponse: &providers.GetProviderSchemaResponse{
+					ResourceTypes: map[string]providers.Schema{
+						"test_resource": {
+							Block: &configschema.Block{
+								Attributes: map[string]*configschema.Attribute{
+									"value": {
+										Type:     cty.String,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedStatus: moduletest.Fail,
+			expectedDiags: []tfdiags.Description{
+				{
+					Summary: "Test assertion failed",
+					Detail:  "resources would be destroyed",
+				},
+			},
+		},
 	}
 	for name, tc := range tcs {
 		t.Run(name, func(t *testing.T) {
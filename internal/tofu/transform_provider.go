@@ -354,6 +354,19 @@ func (t *ProviderUnconfiguredTransformer) Transform(_ context.Context, g *Graph)
 	return nil
 }
 
+// graphNodeVariableDefaultReferencer is implemented by nodes representing
+// module input variables whose default value expression might call
+// provider-defined functions.
+type graphNodeVariableDefaultReferencer interface {
+	GraphNodeReferencer
+
+	// DefaultReferences returns the provider function references in the
+	// variable's default value expression, which are always interpreted in
+	// the node's own module path, or nil if the default value will not be
+	// used.
+	DefaultReferences() []*addrs.Reference
+}
+
 // ProviderFunctionTransformer is a GraphTransformer that maps nodes which reference functions to providers
 // within the graph. This will error if there are any provider functions that don't map to known providers.
 type ProviderFunctionTransformer struct {
@@ -404,6 +417,16 @@ func (t *ProviderFunctionTransformer) Transform(_ context.Context, g *Graph) err
 					refs = append(refs, NodeReference{ref, addrs.RootModule})
 				}
 			}
+
+			// The default value of a module variable is declared in the
+			// variable's own module, rather than in the calling module where
+			// its other references are resolved.
+			if dr, ok := v.(graphNodeVariableDefaultReferencer); ok {
+				for _, ref := range dr.DefaultReferences() {
+					refs = append(refs, NodeReference{ref, nr.ModulePath()})
+				}
+			}
+
 			// Now that we have a set of the references, Let's iterate over them
 			for _, nodeRef := range refs {
 				ref := nodeRef.ref
@@ -636,7 +659,11 @@ func (t *MissingProviderTransformer) Transform(_ context.Context, g *Graph) erro
 // anything, and provider proxies. This avoids the provider being initialized
 // and configured.  This both saves resources but also avoids errors since
 // configuration may imply initialization which may require auth.
-type PruneProviderTransformer struct{}
+type PruneProviderTransformer struct {
+	// KeepConfigured preserves unused providers that have a configuration
+	// block, so that strict validation can check those blocks too.
+	KeepConfigured bool
+}
 
 func (t *PruneProviderTransformer) Transform(_ context.Context, g *Graph) error {
 	for _, v := range g.Vertices() {
@@ -655,6 +682,10 @@ func (t *PruneProviderTransformer) Transform(_ context.Context, g *Graph) error
 
 		// Remove providers with no dependencies.
 		if g.UpEdges(v).Len() == 0 {
+			if pv, ok := v.(*NodeApplyableProvider); ok && t.KeepConfigured && pv.Config != nil {
+				log.Printf("[DEBUG] keeping unused %s to validate its configuration", dag.VertexName(v))
+				continue
+			}
 			log.Printf("[DEBUG] pruning unused %s", dag.VertexName(v))
 			g.Remove(v)
 		}
@@ -0,0 +1,285 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tofu
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/configs/configschema"
+	"github.com/opentofu/opentofu/internal/lang"
+)
+
+// UnknownCauses tracks, during planning, the references that are
+// responsible for named values (local values, module input variables and
+// output values) not being known until apply.
+//
+// Resource instances and check assertions use this to explain their own
+// unknown values in terms of the upstream objects they ultimately depend on,
+// rather than only in terms of the named values they refer to directly.
+//
+// All methods are safe to call on a nil *UnknownCauses, in which case nothing
+// is tracked. This is the case for all walks other than planning.
+type UnknownCauses struct {
+	mu     sync.Mutex
+	causes map[string][]string
+}
+
+func NewUnknownCauses() *UnknownCauses {
+	return &UnknownCauses{
+		causes: make(map[string][]string),
+	}
+}
+
+// Record saves the causes of the value with the given absolute address being
+// unknown, replacing any previously recorded causes.
+func (u *UnknownCauses) Record(addr string, causes []string) {
+	if u == nil {
+		return
+	}
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if len(causes) == 0 {
+		delete(u.causes, addr)
+		return
+	}
+	u.causes[addr] = causes
+}
+
+// Get returns the causes previously recorded for the given absolute address,
+// or nil if the value is known or nothing was recorded for it.
+func (u *UnknownCauses) Get(addr string) []string {
+	if u == nil {
+		return nil
+	}
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return u.causes[addr]
+}
+
+// ForExprs returns the sorted references in the given expressions whose values
+// in hclCtx are not wholly known, with any references to named values replaced
+// by the causes recorded for those values.
+//
+// The references are relative to the given module instance, so results for
+// a non-root module are prefixed with its address.
+func (u *UnknownCauses) ForExprs(path addrs.ModuleInstance, hclCtx *hcl.EvalContext, exprs ...hcl.Expression) []string {
+	if u == nil || hclCtx == nil {
+		return nil
+	}
+
+	var ret []string
+	for _, expr := range exprs {
+		if expr == nil {
+			continue
+		}
+		for _, traversal := range expr.Variables() {
+			val, diags := traversal.TraverseAbs(hclCtx)
+			if diags.HasErrors() || val.IsWhollyKnown() {
+				continue
+			}
+			ref, refDiags := addrs.ParseRef(traversal)
+			if refDiags.HasErrors() {
+				continue
+			}
+			ret = append(ret, u.forRef(path, ref)...)
+		}
+	}
+
+	slices.Sort(ret)
+	return slices.Compact(ret)
+}
+
+func (u *UnknownCauses) forRef(path addrs.ModuleInstance, ref *addrs.Reference) []string {
+	var namedAddr string
+	switch sub := ref.Subject.(type) {
+	case addrs.LocalValue:
+		namedAddr = sub.Absolute(path).String()
+	case addrs.InputVariable:
+		namedAddr = sub.Absolute(path).String()
+	case addrs.ModuleCallInstanceOutput:
+		namedAddr = addrs.OutputValue{Name: sub.Name}.Absolute(path.Child(sub.Call.Call.Name, sub.Call.Key)).String()
+	}
+	if namedAddr != "" {
+		if causes := u.Get(namedAddr); len(causes) != 0 {
+			return causes
+		}
+	}
+
+	// Otherwise the reference itself is the most precise cause we know of.
+	if path.IsRoot() {
+		return []string{ref.DisplayString()}
+	}
+	return []string{path.String() + "." + ref.DisplayString()}
+}
+
+// recordUnknownCauses records the causes of val being unknown under the given
+// absolute address, if planning is tracking unknown causes and any part of val
+// is unknown. The expressions are evaluated in the given scope, which defaults
+// to the scope of the current module when nil.
+func recordUnknownCauses(ctx context.Context, evalCtx EvalContext, scope *lang.Scope, addr string, val cty.Value, exprs ...hcl.Expression) {
+	tracker := evalCtx.UnknownCauses()
+	if tracker == nil || val.IsWhollyKnown() {
+		return
+	}
+	tracker.Record(addr, unknownCausesInScope(ctx, evalCtx, scope, exprs...))
+}
+
+// unknownAttributeCauses returns the causes of each top-level attribute of
+// the given planned value being unknown, for those attributes that are set in
+// the configuration body. Attributes that are unknown only because the
+// provider decides their values during apply have no causes.
+func unknownAttributeCauses(ctx context.Context, evalCtx EvalContext, body hcl.Body, schema *configschema.Block, keyData InstanceKeyEvalData, plannedVal cty.Value) map[string][]string {
+	if evalCtx.UnknownCauses() == nil || body == nil || plannedVal.IsNull() || plannedVal.IsWhollyKnown() {
+		return nil
+	}
+	plannedVal, _ = plannedVal.UnmarkDeep()
+	if !plannedVal.IsKnown() || !plannedVal.Type().IsObjectType() {
+		return nil
+	}
+
+	bodySchema := &hcl.BodySchema{}
+	for name := range schema.Attributes {
+		bodySchema.Attributes = append(bodySchema.Attributes, hcl.AttributeSchema{Name: name})
+	}
+	// Any problems with the body were already reported when evaluating it.
+	content, _, _ := body.PartialContent(bodySchema)
+	if content == nil {
+		return nil
+	}
+
+	scope := evalCtx.EvaluationScope(nil, nil, keyData)
+	var ret map[string][]string
+	for name, attr := range content.Attributes {
+		if !plannedVal.Type().HasAttribute(name) || plannedVal.GetAttr(name).IsWhollyKnown() {
+			continue
+		}
+		if causes := unknownCausesInScope(ctx, evalCtx, scope, attr.Expr); len(causes) != 0 {
+			if ret == nil {
+				ret = make(map[string][]string)
+			}
+			ret[name] = causes
+		}
+	}
+	return ret
+}
+
+func unknownCausesInScope(ctx context.Context, evalCtx EvalContext, scope *lang.Scope, exprs ...hcl.Expression) []string {
+	if scope == nil {
+		scope = evalCtx.EvaluationScope(nil, nil, EvalDataForNoInstanceKey)
+		if scope == nil { // sometimes nil in tests, due to incomplete mocks
+			return nil
+		}
+	}
+
+	var refs []*addrs.Reference
+	for _, expr := range exprs {
+		moreRefs, _ := lang.ReferencesInExpr(addrs.ParseRef, expr)
+		refs = append(refs, moreRefs...)
+	}
+	// Any problems with the references were already reported when
+	// evaluating the expressions themselves.
+	hclCtx, diags := scope.EvalContext(ctx, refs)
+	if diags.HasErrors() {
+		return nil
+	}
+	return evalCtx.UnknownCauses().ForExprs(evalCtx.Path(), hclCtx, exprs...)
+}
+
+// unknownCausesTargets returns the sorted addresses of the objects producing
+// the given causes, as returned by [UnknownCauses.ForExprs], in the form
+// expected by the -target planning option. Causes that don't come from an
+// object that can be targeted, such as root module input variables, are
+// ignored.
+func unknownCausesTargets(causes []string) []string {
+	var ret []string
+	for _, cause := range causes {
+		if target := unknownCauseTarget(cause); target != "" {
+			ret = append(ret, target)
+		}
+	}
+	slices.Sort(ret)
+	return slices.Compact(ret)
+}
+
+func unknownCauseTarget(cause string) string {
+	traversal, diags := hclsyntax.ParseTraversalAbs([]byte(cause), "", hcl.InitialPos)
+	if diags.HasErrors() {
+		return ""
+	}
+
+	// Causes in non-root modules are prefixed with the address of the module
+	// instance. That is ambiguous with references to module outputs, so we
+	// try each of the possible module prefixes, starting with the longest.
+	prefixLens := []int{0}
+	for i := 0; i+1 < len(traversal); {
+		var name string
+		switch step := traversal[i].(type) {
+		case hcl.TraverseRoot:
+			name = step.Name
+		case hcl.TraverseAttr:
+			name = step.Name
+		}
+		if name != "module" {
+			break
+		}
+		if _, ok := traversal[i+1].(hcl.TraverseAttr); !ok {
+			break
+		}
+		i += 2
+		if i < len(traversal) {
+			if _, ok := traversal[i].(hcl.TraverseIndex); ok {
+				i++
+			}
+		}
+		prefixLens = append(prefixLens, i)
+	}
+
+	for _, n := range slices.Backward(prefixLens) {
+		if n == len(traversal) {
+			continue
+		}
+		module := addrs.RootModuleInstance
+		if n != 0 {
+			parsed, diags := addrs.ParseModuleInstance(traversal[:n])
+			if diags.HasErrors() {
+				continue
+			}
+			module = parsed
+		}
+
+		rel := slices.Clone(traversal[n:])
+		if step, ok := rel[0].(hcl.TraverseAttr); ok {
+			rel[0] = hcl.TraverseRoot{Name: step.Name, SrcRange: step.SrcRange}
+		}
+		ref, diags := addrs.ParseRef(rel)
+		if diags.HasErrors() {
+			continue
+		}
+
+		switch subject := ref.Subject.(type) {
+		case addrs.Resource:
+			return subject.Absolute(module).String()
+		case addrs.ResourceInstance:
+			return subject.ContainingResource().Absolute(module).String()
+		case addrs.ModuleCall:
+			return module.Child(subject.Name, addrs.NoKey).String()
+		case addrs.ModuleCallInstance:
+			return module.Child(subject.Call.Name, subject.Key).String()
+		case addrs.ModuleCallInstanceOutput:
+			return module.Child(subject.Call.Call.Name, subject.Call.Key).String()
+		default:
+			return ""
+		}
+	}
+	return ""
+}
@@ -0,0 +1,40 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tofu
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestUnknownCausesTargets(t *testing.T) {
+	got := unknownCausesTargets([]string{
+		"test_object.a.id",
+		"test_object.a.name",
+		"test_object.b[0].id",
+		"data.test_data_source.c.id",
+		"module.child.id",
+		"module.child.test_object.d.id",
+		`module.each["x"].test_object.e.id`,
+		"module.a.module.b.id",
+		"module.child.var.id",
+		"var.root",
+		"local.root",
+	})
+	want := []string{
+		"data.test_data_source.c",
+		"module.a.module.b",
+		"module.child",
+		"module.child.test_object.d",
+		`module.each["x"].test_object.e`,
+		"test_object.a",
+		"test_object.b",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("wrong targets\n%s", diff)
+	}
+}
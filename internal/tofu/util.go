@@ -5,6 +5,11 @@
 
 package tofu
 
+import (
+	"container/heap"
+	"sync"
+)
+
 // Semaphore is a wrapper around a channel to provide
 // utility methods to clarify that we are treating the
 // channel as a semaphore
@@ -46,3 +51,97 @@ func (s Semaphore) Release() {
 		panic("release without an acquire")
 	}
 }
+
+// PrioritySemaphore is a counting semaphore which, when all of its slots are
+// in use, grants the next available slot to the waiter with the highest
+// priority, and to waiters of equal priority in the order they started
+// waiting.
+type PrioritySemaphore struct {
+	mu      sync.Mutex
+	limit   int
+	used    int
+	waiters prioritySemaphoreQueue
+	seq     uint64
+}
+
+// NewPrioritySemaphore creates a priority semaphore that allows up
+// to a given limit of simultaneous acquisitions
+func NewPrioritySemaphore(n int) *PrioritySemaphore {
+	if n <= 0 {
+		panic("semaphore with limit <=0")
+	}
+	return &PrioritySemaphore{limit: n}
+}
+
+// Acquire is used to acquire an available slot with the given priority.
+// Blocks until available.
+func (s *PrioritySemaphore) Acquire(priority int) {
+	s.mu.Lock()
+	if s.used < s.limit && len(s.waiters) == 0 {
+		s.used++
+		s.mu.Unlock()
+		return
+	}
+	w := &prioritySemaphoreWaiter{
+		priority: priority,
+		seq:      s.seq,
+		ready:    make(chan struct{}),
+	}
+	s.seq++
+	heap.Push(&s.waiters, w)
+	s.mu.Unlock()
+
+	<-w.ready
+}
+
+// Release is used to return a slot. Acquire must
+// be called as a pre-condition.
+func (s *PrioritySemaphore) Release() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.used == 0 {
+		panic("release without an acquire")
+	}
+	if len(s.waiters) != 0 {
+		// The slot passes directly to the next waiter, so the number of
+		// slots in use doesn't change.
+		w := heap.Pop(&s.waiters).(*prioritySemaphoreWaiter)
+		close(w.ready)
+		return
+	}
+	s.used--
+}
+
+type prioritySemaphoreWaiter struct {
+	priority int
+	seq      uint64
+	ready    chan struct{}
+}
+
+// prioritySemaphoreQueue implements heap.Interface, ordering waiters by
+// descending priority and then by ascending arrival order.
+type prioritySemaphoreQueue []*prioritySemaphoreWaiter
+
+func (q prioritySemaphoreQueue) Len() int { return len(q) }
+
+func (q prioritySemaphoreQueue) Less(i, j int) bool {
+	if q[i].priority != q[j].priority {
+		return q[i].priority > q[j].priority
+	}
+	return q[i].seq < q[j].seq
+}
+
+func (q prioritySemaphoreQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *prioritySemaphoreQueue) Push(x any) {
+	*q = append(*q, x.(*prioritySemaphoreWaiter))
+}
+
+func (q *prioritySemaphoreQueue) Pop() any {
+	old := *q
+	n := len(old)
+	w := old[n-1]
+	old[n-1] = nil
+	*q = old[:n-1]
+	return w
+}
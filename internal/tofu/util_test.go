@@ -8,6 +8,8 @@ package tofu
 import (
 	"testing"
 	"time"
+
+	"github.com/google/go-cmp/cmp"
 )
 
 func TestSemaphore(t *testing.T) {
@@ -36,3 +38,61 @@ func TestSemaphore(t *testing.T) {
 	}()
 	s.Release()
 }
+
+func TestPrioritySemaphore(t *testing.T) {
+	s := NewPrioritySemaphore(1)
+	timer := time.AfterFunc(5*time.Second, func() {
+		panic("deadlock")
+	})
+	defer timer.Stop()
+
+	s.Acquire(0)
+
+	// Queue up waiters one at a time, so that their arrival order is known.
+	order := make(chan string, 4)
+	waiters := []struct {
+		name     string
+		priority int
+	}{
+		{"low", -1},
+		{"default-1", 0},
+		{"high", 10},
+		{"default-2", 0},
+	}
+	for i, w := range waiters {
+		go func() {
+			s.Acquire(w.priority)
+			order <- w.name
+			s.Release()
+		}()
+		for {
+			s.mu.Lock()
+			queued := len(s.waiters)
+			s.mu.Unlock()
+			if queued == i+1 {
+				break
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	s.Release()
+
+	var got []string
+	for range waiters {
+		got = append(got, <-order)
+	}
+	want := []string{"high", "default-1", "default-2", "low"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("wrong acquisition order\n%s", diff)
+	}
+
+	// This release should panic
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatalf("should panic")
+		}
+	}()
+	s.Release()
+}
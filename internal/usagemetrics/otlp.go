@@ -0,0 +1,90 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package usagemetrics
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"go.opentelemetry.io/contrib/exporters/autoexport"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// otlpExporter exports events as OpenTelemetry metrics.
+//
+// We use a meter provider of our own rather than the global one, so that the
+// metrics are exported only for those who opted in to usage metrics, and so
+// that the resource describing the process has none of the host details that
+// the tracing resource includes.
+type otlpExporter struct {
+	provider *sdkmetric.MeterProvider
+
+	duration metric.Float64Histogram
+	options  metric.Int64Counter
+}
+
+func newOTLPExporter(ctx context.Context) (*otlpExporter, error) {
+	reader, err := autoexport.NewMetricReader(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdkmetric.NewMeterProvider(
+		sdkmetric.WithReader(reader),
+		sdkmetric.WithResource(resource.NewSchemaless(
+			attribute.String("service.name", "OpenTofu CLI"),
+		)),
+	)
+	meter := provider.Meter("github.com/opentofu/opentofu/internal/usagemetrics")
+
+	duration, err := meter.Float64Histogram(
+		"tofu.command.duration",
+		metric.WithDescription("Duration of OpenTofu CLI commands."),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, errors.Join(err, provider.Shutdown(ctx))
+	}
+	options, err := meter.Int64Counter(
+		"tofu.command.options",
+		metric.WithDescription("Number of OpenTofu CLI commands run with each command-line option."),
+	)
+	if err != nil {
+		return nil, errors.Join(err, provider.Shutdown(ctx))
+	}
+
+	return &otlpExporter{
+		provider: provider,
+		duration: duration,
+		options:  options,
+	}, nil
+}
+
+// export records the metrics for the given event and then shuts down the
+// meter provider, which flushes the metrics to the exporter.
+func (e *otlpExporter) export(ctx context.Context, event Event) error {
+	attrs := []attribute.KeyValue{
+		attribute.String("tofu.command", event.Command),
+		attribute.String("tofu.exit_code", strconv.Itoa(event.ExitCode)),
+		attribute.Bool("tofu.automation", event.Automation),
+		attribute.String("tofu.version", event.Version),
+		attribute.String("os.type", event.OS),
+		attribute.String("host.arch", event.Arch),
+	}
+	e.duration.Record(ctx, float64(event.DurationMS)/1000, metric.WithAttributes(attrs...))
+	for _, option := range event.Options {
+		e.options.Add(ctx, 1, metric.WithAttributes(
+			attribute.String("tofu.command", event.Command),
+			attribute.String("tofu.option", option),
+		))
+	}
+
+	return e.provider.Shutdown(ctx)
+}
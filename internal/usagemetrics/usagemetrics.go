@@ -0,0 +1,149 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package usagemetrics records anonymous timings and feature usage of
+// OpenTofu CLI commands, for those who opt in with a usage_metrics block in
+// the CLI configuration.
+//
+// Each command run is recorded as a single JSON object appended to a local
+// file, and can optionally also be exported as OpenTelemetry metrics. Nothing
+// is sent over the network unless OTLP export is explicitly enabled.
+//
+// The recorded events deliberately contain no information that could identify
+// a user, a configuration or the infrastructure it manages: only the command
+// name, the names (but not the values) of the options given, the duration and
+// the exit status, along with the OpenTofu version and platform.
+package usagemetrics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Event describes a single run of an OpenTofu command.
+type Event struct {
+	// Time is when the command started, truncated to the minute.
+	Time time.Time `json:"time"`
+
+	// Command is the name of the command, such as "plan" or "state list".
+	Command string `json:"command"`
+
+	// Options are the names of the command-line options given to the
+	// command, such as "-target", without their values.
+	Options []string `json:"options,omitempty"`
+
+	DurationMS int64 `json:"duration_ms"`
+	ExitCode   int   `json:"exit_code"`
+
+	// Automation is true if TF_IN_AUTOMATION is set, to distinguish
+	// commands run by people from those run in automation.
+	Automation bool `json:"automation"`
+
+	Version string `json:"version"`
+	OS      string `json:"os"`
+	Arch    string `json:"arch"`
+}
+
+// NewEvent returns an event for a run of the given command that started at
+// the given time and has now completed with the given exit status.
+//
+// Only the names of any options in args are recorded.
+func NewEvent(command string, args []string, start time.Time, exitCode int, version string) Event {
+	return Event{
+		Time:       start.UTC().Truncate(time.Minute),
+		Command:    command,
+		Options:    OptionNames(args),
+		DurationMS: time.Since(start).Milliseconds(),
+		ExitCode:   exitCode,
+		Automation: os.Getenv("TF_IN_AUTOMATION") != "",
+		Version:    version,
+		OS:         runtime.GOOS,
+		Arch:       runtime.GOARCH,
+	}
+}
+
+// OptionNames returns the sorted, unique names of the command-line options
+// in the given arguments, discarding their values and all other arguments.
+func OptionNames(args []string) []string {
+	var ret []string
+	for _, arg := range args {
+		if arg == "--" {
+			break
+		}
+		if len(arg) < 2 || arg[0] != '-' || (arg[1] >= '0' && arg[1] <= '9') {
+			// Not an option, or a negative number given as an option value.
+			continue
+		}
+		name, _, _ := strings.Cut(arg, "=")
+		name = "-" + strings.TrimLeft(name, "-")
+		if !slices.Contains(ret, name) {
+			ret = append(ret, name)
+		}
+	}
+	slices.Sort(ret)
+	return ret
+}
+
+// Recorder writes events to a local file and, optionally, to an
+// OpenTelemetry metrics exporter.
+type Recorder struct {
+	path     string
+	exporter *otlpExporter
+}
+
+// NewRecorder returns a recorder that appends events to the file at the
+// given path. If otlp is set, the recorder also exports each event as
+// OpenTelemetry metrics, using the exporter configured by the standard
+// OTEL_EXPORTER_OTLP_* environment variables.
+func NewRecorder(ctx context.Context, path string, otlp bool) (*Recorder, error) {
+	r := &Recorder{path: path}
+	if otlp {
+		exporter, err := newOTLPExporter(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize OpenTelemetry metrics exporter: %w", err)
+		}
+		r.exporter = exporter
+	}
+	return r, nil
+}
+
+// Record writes the given event, and then flushes the exported metrics if
+// OTLP export is enabled. It must be called at most once for each recorder.
+func (r *Recorder) Record(ctx context.Context, event Event) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return err
+	}
+	// The file may be shared by concurrent OpenTofu processes, so each event
+	// is written with a single append.
+	f, err := os.OpenFile(r.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(line)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	if r.exporter != nil {
+		return r.exporter.export(ctx, event)
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package usagemetrics
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestOptionNames(t *testing.T) {
+	tests := map[string]struct {
+		args []string
+		want []string
+	}{
+		"none": {
+			nil,
+			nil,
+		},
+		"values discarded": {
+			[]string{"-var", "password=hunter2", "-target=aws_instance.secret", "-json"},
+			[]string{"-json", "-target", "-var"},
+		},
+		"double dashes and duplicates": {
+			[]string{"--var-file=secret.tfvars", "-var-file", "other.tfvars", "-lock=false"},
+			[]string{"-lock", "-var-file"},
+		},
+		"positional arguments and negative numbers": {
+			[]string{"-parallelism", "-1", "plan.tfplan", "-"},
+			[]string{"-parallelism"},
+		},
+		"end of options": {
+			[]string{"-no-color", "--", "-not-an-option"},
+			[]string{"-no-color"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := OptionNames(test.args)
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("wrong result\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRecorder(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "metrics", "usage.jsonl")
+	start := time.Date(2024, 3, 1, 12, 34, 56, 0, time.UTC)
+
+	events := []Event{
+		{Time: start.Truncate(time.Minute), Command: "plan", Options: []string{"-out"}, DurationMS: 1500, ExitCode: 2, Version: "1.2.3", OS: "linux", Arch: "amd64"},
+		{Time: start.Truncate(time.Minute), Command: "apply", DurationMS: 30000, Version: "1.2.3", OS: "linux", Arch: "amd64"},
+	}
+	for _, event := range events {
+		// Each command creates its own recorder, so we do the same here.
+		recorder, err := NewRecorder(t.Context(), path, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := recorder.Record(t.Context(), event); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	var got []Event
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		var event Event
+		if err := json.Unmarshal(sc.Bytes(), &event); err != nil {
+			t.Fatalf("invalid line %q: %s", sc.Text(), err)
+		}
+		got = append(got, event)
+	}
+	if err := sc.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(events, got); diff != "" {
+		t.Errorf("wrong events\n%s", diff)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if perm := info.Mode().Perm(); runtime.GOOS != "windows" && perm&0o077 != 0 {
+		t.Errorf("metrics file is accessible to other users: %s", perm)
+	}
+}
+
+func TestNewEvent(t *testing.T) {
+	t.Setenv("TF_IN_AUTOMATION", "1")
+	start := time.Now().Add(-2 * time.Second)
+
+	event := NewEvent("state list", []string{"-state=secret.tfstate", "aws_instance.secret"}, start, 0, "1.2.3")
+	if got, want := event.Command, "state list"; got != want {
+		t.Errorf("wrong command %q; want %q", got, want)
+	}
+	if diff := cmp.Diff([]string{"-state"}, event.Options); diff != "" {
+		t.Errorf("wrong options\n%s", diff)
+	}
+	if event.DurationMS < 2000 {
+		t.Errorf("wrong duration %dms; want at least 2000ms", event.DurationMS)
+	}
+	if !event.Time.Equal(start.UTC().Truncate(time.Minute)) {
+		t.Errorf("wrong time %s; want it truncated to the minute", event.Time)
+	}
+	if !event.Automation {
+		t.Error("event doesn't record that it's running in automation")
+	}
+}
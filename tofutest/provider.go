@@ -0,0 +1,201 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tofutest
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/ext/typeexpr"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+
+	"github.com/opentofu/opentofu/internal/configs/configschema"
+	"github.com/opentofu/opentofu/internal/providers"
+	"github.com/opentofu/opentofu/internal/tofu"
+)
+
+// MockProvider describes a provider that the harness uses instead of a real
+// provider plugin. It accepts any configuration that matches its schemas and
+// doesn't manage any real infrastructure.
+//
+// When a resource is created or updated, or a data source is read, each
+// computed attribute that isn't set in the configuration takes the Value
+// given in its schema, or the zero value of its type if there is none.
+type MockProvider struct {
+	// Provider is the schema of the provider configuration block.
+	Provider Schema
+
+	// Resources and DataSources are the schemas of the resource types and
+	// the data sources of the provider, indexed by type name.
+	Resources   map[string]Schema
+	DataSources map[string]Schema
+}
+
+// Schema describes the arguments of a provider, resource, or data source
+// configuration block.
+type Schema struct {
+	Attributes map[string]Attribute
+}
+
+// Attribute describes an attribute of a configuration block.
+type Attribute struct {
+	// Type is the type constraint of the attribute, written in the same
+	// syntax as the "type" argument of a variable block, such as "string" or
+	// "list(object({name = string}))".
+	Type string
+
+	Required  bool
+	Optional  bool
+	Computed  bool
+	Sensitive bool
+
+	// Value is the value that a computed attribute takes when it isn't set
+	// in the configuration. It must be a value that the encoding/json
+	// package can marshal to a JSON representation of the attribute type.
+	Value any
+}
+
+// factory returns a provider factory that creates a new mock provider for
+// each OpenTofu command, so that no state is shared between commands.
+func (p *MockProvider) factory() (providers.Factory, error) {
+	schema, err := p.schema()
+	if err != nil {
+		return nil, err
+	}
+	return func() (providers.Interface, error) {
+		return &tofu.MockProvider{
+			GetProviderSchemaResponse: &schema,
+			ApplyResourceChangeFn: func(req providers.ApplyResourceChangeRequest) (resp providers.ApplyResourceChangeResponse) {
+				if req.PlannedState.IsNull() {
+					resp.NewState = req.PlannedState
+					return resp
+				}
+				newState, err := p.computedValues(req.PlannedState, p.Resources[req.TypeName], cty.Value.IsKnown)
+				if err != nil {
+					resp.Diagnostics = resp.Diagnostics.Append(err)
+					return resp
+				}
+				resp.NewState = newState
+				resp.Private = req.PlannedPrivate
+				return resp
+			},
+			ReadDataSourceFn: func(req providers.ReadDataSourceRequest) (resp providers.ReadDataSourceResponse) {
+				state, err := p.computedValues(req.Config, p.DataSources[req.TypeName], func(v cty.Value) bool {
+					return !v.IsNull()
+				})
+				if err != nil {
+					resp.Diagnostics = resp.Diagnostics.Append(err)
+					return resp
+				}
+				resp.State = state
+				return resp
+			},
+		}, nil
+	}, nil
+}
+
+// computedValues sets each computed attribute of the given value that isn't
+// already set, according to the given function, to the value given in the
+// schema.
+func (p *MockProvider) computedValues(val cty.Value, schema Schema, isSet func(cty.Value) bool) (cty.Value, error) {
+	attrs := val.AsValueMap()
+	for name, attr := range schema.Attributes {
+		if !attr.Computed || isSet(attrs[name]) {
+			continue
+		}
+		v, err := attr.value(attrs[name].Type())
+		if err != nil {
+			return cty.NilVal, fmt.Errorf("invalid value for attribute %q: %w", name, err)
+		}
+		attrs[name] = v
+	}
+	return cty.ObjectVal(attrs), nil
+}
+
+func (a Attribute) value(ty cty.Type) (cty.Value, error) {
+	if a.Value == nil {
+		return zeroValue(ty), nil
+	}
+	raw, err := json.Marshal(a.Value)
+	if err != nil {
+		return cty.NilVal, err
+	}
+	return ctyjson.Unmarshal(raw, ty)
+}
+
+func zeroValue(ty cty.Type) cty.Value {
+	switch {
+	case ty == cty.String:
+		return cty.StringVal("")
+	case ty == cty.Number:
+		return cty.Zero
+	case ty == cty.Bool:
+		return cty.False
+	case ty.IsListType():
+		return cty.ListValEmpty(ty.ElementType())
+	case ty.IsSetType():
+		return cty.SetValEmpty(ty.ElementType())
+	case ty.IsMapType():
+		return cty.MapValEmpty(ty.ElementType())
+	default:
+		return cty.NullVal(ty)
+	}
+}
+
+func (p *MockProvider) schema() (providers.GetProviderSchemaResponse, error) {
+	var resp providers.GetProviderSchemaResponse
+	var err error
+	if resp.Provider.Block, err = p.Provider.block(); err != nil {
+		return resp, fmt.Errorf("invalid provider schema: %w", err)
+	}
+	resp.ResourceTypes = make(map[string]providers.Schema, len(p.Resources))
+	for name, schema := range p.Resources {
+		block, err := schema.block()
+		if err != nil {
+			return resp, fmt.Errorf("invalid schema for resource type %q: %w", name, err)
+		}
+		resp.ResourceTypes[name] = providers.Schema{Block: block}
+	}
+	resp.DataSources = make(map[string]providers.Schema, len(p.DataSources))
+	for name, schema := range p.DataSources {
+		block, err := schema.block()
+		if err != nil {
+			return resp, fmt.Errorf("invalid schema for data source %q: %w", name, err)
+		}
+		resp.DataSources[name] = providers.Schema{Block: block}
+	}
+	return resp, nil
+}
+
+func (s Schema) block() (*configschema.Block, error) {
+	block := &configschema.Block{
+		Attributes: make(map[string]*configschema.Attribute, len(s.Attributes)),
+	}
+	for name, attr := range s.Attributes {
+		expr, diags := hclsyntax.ParseExpression([]byte(attr.Type), "", hcl.InitialPos)
+		if diags.HasErrors() {
+			return nil, fmt.Errorf("invalid type for attribute %q: %w", name, diags)
+		}
+		ty, diags := typeexpr.TypeConstraint(expr)
+		if diags.HasErrors() {
+			return nil, fmt.Errorf("invalid type for attribute %q: %w", name, diags)
+		}
+		block.Attributes[name] = &configschema.Attribute{
+			Type:      ty,
+			Required:  attr.Required,
+			Optional:  attr.Optional,
+			Computed:  attr.Computed,
+			Sensitive: attr.Sensitive,
+		}
+	}
+	if err := block.InternalValidate(); err != nil {
+		return nil, err
+	}
+	return block, nil
+}
@@ -0,0 +1,7 @@
+variable "network_id" {
+  type = string
+}
+
+output "network_id" {
+  value = var.network_id
+}
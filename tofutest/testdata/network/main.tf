@@ -0,0 +1,42 @@
+terraform {
+  required_providers {
+    test = {
+      source = "hashicorp/test"
+    }
+  }
+
+  backend "s3" {
+    bucket = "not-used-by-tests"
+    key    = "network.tfstate"
+  }
+}
+
+variable "cidr_block" {
+  type = string
+}
+
+resource "test_network" "main" {
+  cidr_block = var.cidr_block
+}
+
+data "test_zone" "main" {
+  name = "example.com"
+}
+
+module "subnet" {
+  source = "../modules/subnet"
+
+  network_id = test_network.main.id
+}
+
+output "network_id" {
+  value = test_network.main.id
+}
+
+output "zone_id" {
+  value = data.test_zone.main.id
+}
+
+output "subnet_network_id" {
+  value = module.subnet.network_id
+}
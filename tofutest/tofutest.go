@@ -0,0 +1,265 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package tofutest is a harness for testing OpenTofu modules from Go tests.
+//
+// The harness runs OpenTofu commands such as init, plan, and apply in the
+// test process itself, rather than running a tofu executable, and uses mock
+// providers instead of installing provider plugins. Each harness works on its
+// own copy of the module in a temporary directory, which also holds its
+// state, so tests don't change the module or any real infrastructure:
+//
+//	func TestNetwork(t *testing.T) {
+//		h := tofutest.New(t, "../modules/network",
+//			tofutest.WithProvider("hashicorp/aws", &tofutest.MockProvider{
+//				Resources: map[string]tofutest.Schema{
+//					"aws_vpc": {Attributes: map[string]tofutest.Attribute{
+//						"cidr_block": {Type: "string", Required: true},
+//						"id":         {Type: "string", Computed: true, Value: "vpc-123"},
+//					}},
+//				},
+//			}),
+//		)
+//		h.Init()
+//		h.Apply("-var", "cidr_block=10.0.0.0/16")
+//		if got := h.Outputs()["vpc_id"]; got != "vpc-123" {
+//			t.Errorf("wrong vpc_id %v", got)
+//		}
+//	}
+//
+// Because OpenTofu commands use the current working directory, the harness
+// changes it to the copy of the module for the rest of the test, so tests
+// using a harness can't run in parallel.
+package tofutest
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/mitchellh/cli"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	backendInit "github.com/opentofu/opentofu/internal/backend/init"
+	"github.com/opentofu/opentofu/internal/command"
+	"github.com/opentofu/opentofu/internal/command/views"
+	"github.com/opentofu/opentofu/internal/command/workdir"
+	"github.com/opentofu/opentofu/internal/copy"
+	"github.com/opentofu/opentofu/internal/providers"
+	"github.com/opentofu/opentofu/internal/terminal"
+)
+
+// backendOverrideFilename is the name of the override file that the harness
+// writes into its copy of the module, so that the state is kept in the
+// temporary directory whatever backend the module configures.
+const backendOverrideFilename = "tofutest_override.tf"
+
+const backendOverride = `terraform {
+  backend "local" {}
+}
+`
+
+// initBackends registers the available backends, which the CLI otherwise
+// does on startup.
+var initBackends = sync.OnceFunc(func() {
+	backendInit.Init(nil)
+})
+
+// Harness runs OpenTofu commands against a copy of a module.
+type Harness struct {
+	t   *testing.T
+	dir string
+
+	root      string
+	providers map[addrs.Provider]providers.Factory
+}
+
+// Option configures a [Harness].
+type Option func(h *Harness) error
+
+// WithProvider makes the harness use the given mock provider for the provider
+// with the given source address, such as "hashicorp/aws".
+func WithProvider(source string, p *MockProvider) Option {
+	return func(h *Harness) error {
+		addr, diags := addrs.ParseProviderSourceString(source)
+		if diags.HasErrors() {
+			return fmt.Errorf("invalid provider source %q: %w", source, diags.Err())
+		}
+		factory, err := p.factory()
+		if err != nil {
+			return fmt.Errorf("invalid mock provider %s: %w", source, err)
+		}
+		h.providers[addr] = factory
+		return nil
+	}
+}
+
+// WithModuleRoot makes the harness copy the given directory, which must
+// contain the module directory given to [New], instead of only the module
+// directory itself. This is needed for modules that call other modules using
+// relative paths outside of the module directory, such as "../vpc".
+func WithModuleRoot(root string) Option {
+	return func(h *Harness) error {
+		h.root = root
+		return nil
+	}
+}
+
+// New returns a harness for the module in the given directory. It fails the
+// test if the module can't be copied or an option is invalid.
+func New(t *testing.T, dir string, opts ...Option) *Harness {
+	t.Helper()
+	initBackends()
+
+	h := &Harness{
+		t:         t,
+		root:      dir,
+		providers: make(map[addrs.Provider]providers.Factory),
+	}
+	for _, opt := range opts {
+		if err := opt(h); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rel, err := filepath.Rel(h.root, dir)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		t.Fatalf("module directory %s is not within the module root %s", dir, h.root)
+	}
+	tmp := t.TempDir()
+	if err := copy.CopyDir(tmp, h.root); err != nil {
+		t.Fatalf("failed to copy %s: %s", h.root, err)
+	}
+	h.dir = filepath.Join(tmp, rel)
+	if err := os.WriteFile(filepath.Join(h.dir, backendOverrideFilename), []byte(backendOverride), 0644); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(h.dir)
+
+	return h
+}
+
+// Dir returns the directory of the copy of the module that the harness runs
+// commands in.
+func (h *Harness) Dir() string {
+	return h.dir
+}
+
+// Result is the result of running an OpenTofu command.
+type Result struct {
+	ExitCode int
+	Stdout   string
+	Stderr   string
+}
+
+// Run runs the OpenTofu command with the given name, such as "plan" or
+// "state list", with the given arguments. Unlike the other methods of
+// Harness, it doesn't fail the test if the command fails.
+func (h *Harness) Run(name string, args ...string) *Result {
+	h.t.Helper()
+
+	streams, done := terminal.StreamsForTesting(h.t)
+	meta := command.Meta{
+		WorkingDir: workdir.NewDir("."),
+		View:       views.NewView(streams),
+	}
+	meta.OverrideProviders(h.providers)
+
+	cmd, ok := commands(meta)[name]
+	if !ok {
+		h.t.Fatalf("unsupported command %q", name)
+	}
+	// The output is for the test to check rather than for a terminal, so
+	// it never includes color codes.
+	code := cmd.Run(append([]string{"-no-color"}, args...))
+	output := done(h.t)
+
+	return &Result{
+		ExitCode: code,
+		Stdout:   output.Stdout(),
+		Stderr:   output.Stderr(),
+	}
+}
+
+// Init runs "tofu init" with the given arguments, failing the test if it
+// fails.
+func (h *Harness) Init(args ...string) *Result {
+	h.t.Helper()
+	return h.mustRun("init", append([]string{"-input=false"}, args...)...)
+}
+
+// Plan runs "tofu plan" with the given arguments, failing the test if it
+// fails.
+func (h *Harness) Plan(args ...string) *Result {
+	h.t.Helper()
+	return h.mustRun("plan", append([]string{"-input=false"}, args...)...)
+}
+
+// Apply runs "tofu apply" with the given arguments, without asking for
+// approval, failing the test if it fails.
+func (h *Harness) Apply(args ...string) *Result {
+	h.t.Helper()
+	return h.mustRun("apply", append([]string{"-input=false", "-auto-approve"}, args...)...)
+}
+
+// Destroy runs "tofu destroy" with the given arguments, without asking for
+// approval, failing the test if it fails.
+func (h *Harness) Destroy(args ...string) *Result {
+	h.t.Helper()
+	return h.mustRun("destroy", append([]string{"-input=false", "-auto-approve"}, args...)...)
+}
+
+// Outputs returns the values of the root module outputs in the current
+// state, decoded from JSON in the same way as by the encoding/json package,
+// failing the test if they can't be read.
+func (h *Harness) Outputs() map[string]any {
+	h.t.Helper()
+	result := h.mustRun("output", "-json")
+
+	var outputs map[string]struct {
+		Value any `json:"value"`
+	}
+	if err := json.Unmarshal([]byte(result.Stdout), &outputs); err != nil {
+		h.t.Fatalf("invalid output values: %s\n%s", err, result.Stdout)
+	}
+	ret := make(map[string]any, len(outputs))
+	for name, output := range outputs {
+		ret[name] = output.Value
+	}
+	return ret
+}
+
+func (h *Harness) mustRun(name string, args ...string) *Result {
+	h.t.Helper()
+	result := h.Run(name, args...)
+	if result.ExitCode != 0 {
+		h.t.Fatalf("tofu %s failed with exit status %d\n%s", name, result.ExitCode, result.Stderr)
+	}
+	return result
+}
+
+// commands returns the commands that a harness can run, using the given
+// Meta.
+func commands(meta command.Meta) map[string]cli.Command {
+	return map[string]cli.Command{
+		"init":             &command.InitCommand{Meta: meta},
+		"validate":         &command.ValidateCommand{Meta: meta},
+		"plan":             &command.PlanCommand{Meta: meta},
+		"apply":            &command.ApplyCommand{Meta: meta},
+		"destroy":          &command.ApplyCommand{Meta: meta, Destroy: true},
+		"refresh":          &command.RefreshCommand{Meta: meta},
+		"output":           &command.OutputCommand{Meta: meta},
+		"show":             &command.ShowCommand{Meta: meta},
+		"state list":       &command.StateListCommand{Meta: meta},
+		"state show":       &command.StateShowCommand{Meta: meta},
+		"state rm":         &command.StateRmCommand{StateMeta: command.StateMeta{Meta: meta}},
+		"workspace new":    &command.WorkspaceNewCommand{Meta: meta},
+		"workspace select": &command.WorkspaceSelectCommand{Meta: meta},
+	}
+}
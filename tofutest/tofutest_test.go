@@ -0,0 +1,112 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tofutest
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func testProvider() *MockProvider {
+	return &MockProvider{
+		Resources: map[string]Schema{
+			"test_network": {Attributes: map[string]Attribute{
+				"cidr_block": {Type: "string", Required: true},
+				"id":         {Type: "string", Computed: true, Value: "net-123"},
+				"tags":       {Type: "map(string)", Computed: true},
+			}},
+		},
+		DataSources: map[string]Schema{
+			"test_zone": {Attributes: map[string]Attribute{
+				"name": {Type: "string", Required: true},
+				"id":   {Type: "string", Computed: true, Value: "zone-456"},
+			}},
+		},
+	}
+}
+
+func TestHarness(t *testing.T) {
+	moduleDir, err := filepath.Abs("testdata/network")
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := New(t, filepath.Join("testdata", "network"),
+		WithModuleRoot("testdata"),
+		WithProvider("hashicorp/test", testProvider()),
+	)
+
+	h.Init()
+	plan := h.Plan("-var", "cidr_block=10.0.0.0/16")
+	if got, want := plan.Stdout, "Plan: 1 to add, 0 to change, 0 to destroy."; !strings.Contains(got, want) {
+		t.Errorf("wrong plan output\ngot: %s\nwant substring: %s", got, want)
+	}
+
+	h.Apply("-var", "cidr_block=10.0.0.0/16")
+	want := map[string]any{
+		"network_id":        "net-123",
+		"subnet_network_id": "net-123",
+		"zone_id":           "zone-456",
+	}
+	if diff := cmp.Diff(want, h.Outputs()); diff != "" {
+		t.Errorf("wrong outputs\n%s", diff)
+	}
+
+	list := h.Run("state list")
+	if got, want := list.Stdout, "data.test_zone.main\ntest_network.main\n"; got != want {
+		t.Errorf("wrong resources\ngot:  %q\nwant: %q", got, want)
+	}
+
+	h.Destroy("-var", "cidr_block=10.0.0.0/16")
+	if got := h.Run("state list").Stdout; got != "" {
+		t.Errorf("resources left after destroy: %s", got)
+	}
+
+	// The state is kept in the copy of the module, using the local backend
+	// instead of the one configured by the module, which is left unchanged.
+	if _, err := os.Stat(filepath.Join(h.Dir(), "terraform.tfstate")); err != nil {
+		t.Errorf("no local state: %s", err)
+	}
+	if _, err := os.Stat(filepath.Join(moduleDir, backendOverrideFilename)); !os.IsNotExist(err) {
+		t.Errorf("the original module was changed")
+	}
+}
+
+func TestHarness_failure(t *testing.T) {
+	h := New(t, filepath.Join("testdata", "network"),
+		WithModuleRoot("testdata"),
+		WithProvider("hashicorp/test", testProvider()),
+	)
+	h.Init()
+
+	result := h.Run("plan", "-input=false")
+	if result.ExitCode != 1 {
+		t.Fatalf("wrong exit status %d; want 1\n%s", result.ExitCode, result.Stdout)
+	}
+	if got, want := result.Stderr, "No value for required variable"; !strings.Contains(got, want) {
+		t.Errorf("wrong error\ngot: %s\nwant substring: %s", got, want)
+	}
+}
+
+func TestMockProvider_invalidSchema(t *testing.T) {
+	p := &MockProvider{
+		Resources: map[string]Schema{
+			"test_network": {Attributes: map[string]Attribute{
+				"id": {Type: "strin", Computed: true},
+			}},
+		},
+	}
+	_, err := p.factory()
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if got, want := err.Error(), `invalid schema for resource type "test_network": invalid type for attribute "id"`; !strings.Contains(got, want) {
+		t.Errorf("wrong error\ngot: %s\nwant substring: %s", got, want)
+	}
+}
@@ -0,0 +1,27 @@
+variable "age_identity" {
+  type      = string
+  sensitive = true
+  # Set this to the contents of your age identity file or
+  # unencrypted SSH private key, for example via TF_VAR_age_identity.
+}
+
+terraform {
+  encryption {
+    method "age" "team" {
+      recipients = [
+        # age public keys of the team members:
+        "age1ql3z7hjy54pw3hyww5ayyfg7zqgvc7w3j2elw8zmrj2kg5sfn9aqmcac8p",
+        # SSH public keys are supported too:
+        "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIN1ibiijTy5iTzYrAmYqsoVVInpOnX8cwaLGjHbuCWpf alice@example.com",
+      ]
+      identities = [var.age_identity]
+    }
+
+    state {
+      method = method.age.team
+    }
+    plan {
+      method = method.age.team
+    }
+  }
+}
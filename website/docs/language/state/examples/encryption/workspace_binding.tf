@@ -0,0 +1,20 @@
+terraform {
+  encryption {
+    key_provider "pbkdf2" "mykey" {
+      passphrase = "correct-horse-battery-staple"
+    }
+    method "aes_gcm" "new_method" {
+      keys = key_provider.pbkdf2.mykey
+    }
+    state {
+      method = method.aes_gcm.new_method
+
+      # Store the workspace name in the encrypted state.
+      bind_workspace = true
+
+      # Accept encrypted states that are not bound to a workspace yet.
+      # Remove this once all workspaces have been migrated.
+      allow_unbound_workspace = true
+    }
+  }
+}